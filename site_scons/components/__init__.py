@@ -382,6 +382,7 @@ def define_components(reqs):
                            '--without-iscsi-initiator',
                            '--without-isal',
                            '--without-vtune',
+                           '--with-idxd',
                            '--with-shared',
                            f'--target-arch={spdk_arch}'],
                           ['make', f'CONFIG_ARCH={spdk_arch}'],
@@ -10,6 +10,7 @@
 #include <spdk/string.h>
 #include <spdk/util.h>
 #include <spdk/json.h>
+#include <spdk/log.h>
 #include <spdk/thread.h>
 #include <spdk/nvme.h>
 #include <spdk/nvmf_spec.h>
@@ -22,6 +23,8 @@
 #define JSON_MAX_CHARS 4096
 #define JSON_NOT_FOUND    1
 #define BDEV_NAME_MAX_LEN 256
+#define SPDK_LOG_FLAGS_MAX 32
+#define NS_SELECT_MAX      128
 
 struct
 json_config_ctx {
@@ -138,14 +141,20 @@ accel_props_decoders[] = {
 };
 
 struct rpc_srv_info {
-	bool	 enable;
-	char	*sock_addr;
+	bool		 enable;
+	char		*sock_addr;
+	uint32_t	 sock_uid;
+	uint32_t	 sock_gid;
+	uint32_t	 sock_mode;
 };
 
 static struct spdk_json_object_decoder
 rpc_srv_decoders[] = {
 	{"enable", offsetof(struct rpc_srv_info, enable), spdk_json_decode_bool},
 	{"sock_addr", offsetof(struct rpc_srv_info, sock_addr), spdk_json_decode_string},
+	{"sock_uid", offsetof(struct rpc_srv_info, sock_uid), spdk_json_decode_uint32, true},
+	{"sock_gid", offsetof(struct rpc_srv_info, sock_gid), spdk_json_decode_uint32, true},
+	{"sock_mode", offsetof(struct rpc_srv_info, sock_mode), spdk_json_decode_uint32, true},
 };
 
 struct auto_faulty_info {
@@ -160,6 +169,80 @@ static struct spdk_json_object_decoder auto_faulty_decoders[] = {
     {"max_csum_errs", offsetof(struct auto_faulty_info, max_csum_errs), spdk_json_decode_uint32},
 };
 
+struct spdk_log_info {
+	char  *level;
+	char  *print_level;
+	size_t num_flags;
+	char  *flags[SPDK_LOG_FLAGS_MAX];
+};
+
+static int
+decode_spdk_log_flags(const struct spdk_json_val *val, void *out)
+{
+	struct spdk_log_info *info = SPDK_CONTAINEROF(out, struct spdk_log_info, flags);
+
+	return spdk_json_decode_array(val, spdk_json_decode_string, info->flags,
+				      SPDK_LOG_FLAGS_MAX, &info->num_flags, sizeof(char *));
+}
+
+static struct spdk_json_object_decoder spdk_log_decoders[] = {
+    {"level", offsetof(struct spdk_log_info, level), spdk_json_decode_string, true},
+    {"print_level", offsetof(struct spdk_log_info, print_level), spdk_json_decode_string, true},
+    {"flags", offsetof(struct spdk_log_info, flags), decode_spdk_log_flags, true},
+};
+
+struct ns_select_info {
+	size_t num_bdevs;
+	char  *bdevs[NS_SELECT_MAX];
+};
+
+static int
+decode_ns_select_bdevs(const struct spdk_json_val *val, void *out)
+{
+	struct ns_select_info *info = SPDK_CONTAINEROF(out, struct ns_select_info, bdevs);
+
+	return spdk_json_decode_array(val, spdk_json_decode_string, info->bdevs, NS_SELECT_MAX,
+				      &info->num_bdevs, sizeof(char *));
+}
+
+static struct spdk_json_object_decoder ns_select_decoders[] = {
+    {"bdev_names", offsetof(struct ns_select_info, bdevs), decode_ns_select_bdevs},
+};
+
+static const struct {
+	const char          *name;
+	enum spdk_log_level  level;
+} spdk_log_levels[] = {
+    {"DISABLED", SPDK_LOG_DISABLED}, {"ERROR", SPDK_LOG_ERROR}, {"WARNING", SPDK_LOG_WARN},
+    {"NOTICE", SPDK_LOG_NOTICE},     {"INFO", SPDK_LOG_INFO},   {"DEBUG", SPDK_LOG_DEBUG},
+};
+
+static int
+parse_spdk_log_level(const char *name, enum spdk_log_level *level)
+{
+	size_t i;
+
+	for (i = 0; i < SPDK_COUNTOF(spdk_log_levels); i++) {
+		if (strcasecmp(name, spdk_log_levels[i].name) == 0) {
+			*level = spdk_log_levels[i].level;
+			return 0;
+		}
+	}
+
+	D_ERROR("unknown SPDK log level '%s'\n", name);
+	return -DER_INVAL;
+}
+
+struct spdk_env_opts_info {
+	bool     no_huge;
+	uint32_t mem_size_mb;
+};
+
+static struct spdk_json_object_decoder spdk_env_opts_decoders[] = {
+    {"no_huge", offsetof(struct spdk_env_opts_info, no_huge), spdk_json_decode_bool},
+    {"mem_size_mb", offsetof(struct spdk_env_opts_info, mem_size_mb), spdk_json_decode_uint32},
+};
+
 static int
 is_addr_in_allowlist(char *pci_addr, const struct spdk_pci_addr *allowlist,
 		     int num_allowlist_devices)
@@ -400,6 +483,31 @@ load_vmd_subsystem_config(struct json_config_ctx *ctx, bool *vmd_enabled)
 	return 0;
 }
 
+/*
+ * Only local PCIe controllers belong in the SPDK PCI allowed list, remote NVMe-oF controllers
+ * (RDMA/TCP) have IP transport addresses. SPDK defaults to PCIe when trtype is omitted.
+ */
+static bool
+is_pcie_controller(struct spdk_json_val *params)
+{
+	struct spdk_json_val	*key, *value;
+
+	key = spdk_json_object_first(params);
+	while (key != NULL) {
+		if (spdk_json_strequal(key, "trtype")) {
+			value = json_value(key);
+			if (value == NULL)
+				return false;
+			return value->len == strlen(SPDK_NVME_TRANSPORT_NAME_PCIE) &&
+			       strncasecmp(value->start, SPDK_NVME_TRANSPORT_NAME_PCIE,
+					   value->len) == 0;
+		}
+		key = spdk_json_next(key);
+	}
+
+	return true;
+}
+
 static int
 add_traddrs_from_bdev_subsys(struct json_config_ctx *ctx, bool vmd_enabled,
 			     struct spdk_env_opts *opts)
@@ -427,6 +535,11 @@ add_traddrs_from_bdev_subsys(struct json_config_ctx *ctx, bool vmd_enabled,
 		D_GOTO(free_method, rc = -DER_INVAL);
 	}
 
+	if (!is_pcie_controller(cfg.params)) {
+		D_DEBUG(DB_MGMT, "Skipping non-PCIe controller in SPDK allowed list\n");
+		goto free_method;
+	}
+
 	D_ALLOC(traddr, SPDK_NVMF_TRADDR_MAX_LEN + 1);
 	if (traddr == NULL)
 		D_GOTO(free_method, rc = -DER_NOMEM);
@@ -503,7 +616,13 @@ check_name_from_bdev_subsys(struct json_config_ctx *ctx)
 	}
 
 	if (strcmp(cfg.method, NVME_CONF_ATTACH_CONTROLLER) != 0 &&
-	    strcmp(cfg.method, NVME_CONF_AIO_CREATE) != 0) {
+	    strcmp(cfg.method, NVME_CONF_AIO_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_NULL_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_MALLOC_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_RAID_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_DELAY_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_CRYPTO_CREATE) != 0 &&
+	    strcmp(cfg.method, NVME_CONF_OCF_CREATE) != 0) {
 		goto free_method;
 	}
 
@@ -903,18 +1022,28 @@ bio_read_accel_props(const char *nvme_conf)
  * \param[in]	nvme_conf	JSON config file path
  * \param[out]	enable		Flag to enable the RPC server
  * \param[out]	sock_addr	Path in which to create socket file
+ * \param[out]	sock_uid	Owner to set on socket file, UINT32_MAX to leave unchanged
+ * \param[out]	sock_gid	Group to set on socket file, UINT32_MAX to leave unchanged
+ * \param[out]	sock_mode	Permissions to set on socket file, zero to leave unchanged
  *
  * \returns	 Zero on success, negative on failure (DER)
  */
 int
-bio_read_rpc_srv_settings(const char *nvme_conf, bool *enable, const char **sock_addr)
+bio_read_rpc_srv_settings(const char *nvme_conf, bool *enable, const char **sock_addr,
+			  uint32_t *sock_uid, uint32_t *sock_gid, uint32_t *sock_mode)
 {
-	struct rpc_srv_info      rpc_srv_settings = {};
+	struct rpc_srv_info      rpc_srv_settings = {
+		.sock_uid = UINT32_MAX,
+		.sock_gid = UINT32_MAX,
+	};
 	int			 rc;
 
 	D_ASSERT(enable != NULL);
 	D_ASSERT(sock_addr != NULL);
 	D_ASSERT(*sock_addr == NULL);
+	D_ASSERT(sock_uid != NULL);
+	D_ASSERT(sock_gid != NULL);
+	D_ASSERT(sock_mode != NULL);
 
 	rc = decode_daos_object(nvme_conf, NVME_CONF_SET_SPDK_RPC_SERVER, rpc_srv_decoders,
 				SPDK_COUNTOF(rpc_srv_decoders), &rpc_srv_settings);
@@ -924,11 +1053,21 @@ bio_read_rpc_srv_settings(const char *nvme_conf, bool *enable, const char **sock
 		return rc;
 	}
 
+	if (rpc_srv_settings.sock_mode & ~(uint32_t)0777) {
+		D_ERROR("'%s' invalid socket mode %o\n", NVME_CONF_SET_SPDK_RPC_SERVER,
+			rpc_srv_settings.sock_mode);
+		return -DER_INVAL;
+	}
+
 	*enable = rpc_srv_settings.enable;
 	*sock_addr = rpc_srv_settings.sock_addr;
+	*sock_uid = rpc_srv_settings.sock_uid;
+	*sock_gid = rpc_srv_settings.sock_gid;
+	*sock_mode = rpc_srv_settings.sock_mode;
 
-	D_INFO("'%s' read from config: enabled=%d, addr %s\n", NVME_CONF_SET_SPDK_RPC_SERVER,
-	       *enable, (char *)*sock_addr);
+	D_INFO("'%s' read from config: enabled=%d, addr %s, uid %u, gid %u, mode %o\n",
+	       NVME_CONF_SET_SPDK_RPC_SERVER, *enable, (char *)*sock_addr, *sock_uid, *sock_gid,
+	       *sock_mode);
 
 	return 0;
 }
@@ -1125,3 +1264,190 @@ free_json:
 
 	return rc;
 }
+
+/**
+ * Set output parameters based on JSON config settings for running the SPDK environment without
+ * hugepages.
+ *
+ * \param[in]	nvme_conf	JSON config file path
+ * \param[out]	no_huge		Flag to run SPDK without hugepages
+ * \param[out]	mem_size_mb	Amount of heap memory (MB) to reserve for DMA buffers
+ *
+ * \returns	 Zero on success, negative on failure (DER)
+ */
+int
+bio_read_spdk_env_opts(const char *nvme_conf, bool *no_huge, uint32_t *mem_size_mb)
+{
+	struct spdk_env_opts_info env_opts = {};
+	int                       rc;
+
+	D_ASSERT(no_huge != NULL);
+	D_ASSERT(mem_size_mb != NULL);
+
+	rc = decode_daos_object(nvme_conf, NVME_CONF_SET_SPDK_ENV_OPTS, spdk_env_opts_decoders,
+				SPDK_COUNTOF(spdk_env_opts_decoders), &env_opts);
+	if (rc != 0) {
+		if (rc == JSON_NOT_FOUND) {
+			rc       = 0;
+			*no_huge = false;
+		}
+		return rc;
+	}
+
+	if (env_opts.no_huge && env_opts.mem_size_mb == 0) {
+		D_ERROR("'%s' requires non-zero mem_size_mb when no_huge is set\n",
+			NVME_CONF_SET_SPDK_ENV_OPTS);
+		return -DER_INVAL;
+	}
+
+	*no_huge     = env_opts.no_huge;
+	*mem_size_mb = env_opts.mem_size_mb;
+
+	D_INFO("'%s' read from config: no_huge=%d, mem_size_mb=%u\n", NVME_CONF_SET_SPDK_ENV_OPTS,
+	       *no_huge, *mem_size_mb);
+
+	return 0;
+}
+
+/**
+ * Apply SPDK log level and flag settings from the JSON config used to initialize SPDK subsystems.
+ *
+ * \param[in]	nvme_conf	JSON config file path
+ *
+ * \returns	 Zero on success, negative on failure (DER)
+ */
+int
+bio_set_spdk_log(const char *nvme_conf)
+{
+	struct spdk_log_info log_info = {};
+	enum spdk_log_level  level;
+	size_t               i;
+	int                  rc;
+
+	rc = decode_daos_object(nvme_conf, NVME_CONF_SET_SPDK_LOG, spdk_log_decoders,
+				SPDK_COUNTOF(spdk_log_decoders), &log_info);
+	if (rc != 0) {
+		if (rc == JSON_NOT_FOUND)
+			rc = 0;
+		return rc;
+	}
+
+	if (log_info.level != NULL) {
+		rc = parse_spdk_log_level(log_info.level, &level);
+		if (rc != 0)
+			goto out;
+		spdk_log_set_level(level);
+	}
+
+	if (log_info.print_level != NULL) {
+		rc = parse_spdk_log_level(log_info.print_level, &level);
+		if (rc != 0)
+			goto out;
+		spdk_log_set_print_level(level);
+	}
+
+	for (i = 0; i < log_info.num_flags; i++) {
+		if (spdk_log_set_flag(log_info.flags[i]) != 0) {
+			D_ERROR("unknown SPDK log flag '%s'\n", log_info.flags[i]);
+			D_GOTO(out, rc = -DER_INVAL);
+		}
+	}
+
+	D_INFO("'%s' read from config: level=%s, print_level=%s, %zu flags\n",
+	       NVME_CONF_SET_SPDK_LOG, log_info.level ? log_info.level : "default",
+	       log_info.print_level ? log_info.print_level : "default", log_info.num_flags);
+out:
+	free(log_info.level);
+	free(log_info.print_level);
+	for (i = 0; i < log_info.num_flags; i++)
+		free(log_info.flags[i]);
+
+	return rc;
+}
+
+/* NVMe namespace bdevs selected in config, other namespaces of their controllers are ignored */
+static struct ns_select_info ns_select = {};
+
+/**
+ * Read the NVMe namespace bdevs explicitly selected for controllers from the JSON config file.
+ *
+ * \param[in]	nvme_conf	JSON config file path
+ *
+ * \returns	 Zero on success, negative on failure (DER)
+ */
+int
+bio_read_ns_select(const char *nvme_conf)
+{
+	size_t i;
+	int    rc;
+
+	rc = decode_daos_object(nvme_conf, NVME_CONF_SET_NS_SELECT, ns_select_decoders,
+				SPDK_COUNTOF(ns_select_decoders), &ns_select);
+	if (rc != 0) {
+		if (rc == JSON_NOT_FOUND)
+			rc = 0;
+		return rc;
+	}
+
+	for (i = 0; i < ns_select.num_bdevs; i++)
+		D_INFO("'%s' read from config: %s\n", NVME_CONF_SET_NS_SELECT,
+		       ns_select.bdevs[i]);
+
+	return 0;
+}
+
+/** Release the namespace selections read by bio_read_ns_select(). */
+void
+bio_free_ns_select(void)
+{
+	size_t i;
+
+	for (i = 0; i < ns_select.num_bdevs; i++)
+		free(ns_select.bdevs[i]);
+	ns_select.num_bdevs = 0;
+}
+
+/*
+ * Return the length of the controller name prefix of an NVMe namespace bdev name of the form
+ * "<ctrlr>n<nsid>", or zero if the name isn't of that form.
+ */
+static size_t
+ns_bdev_ctrlr_len(const char *bdev_name)
+{
+	const char *ns = strrchr(bdev_name, 'n');
+
+	if (ns == NULL || ns == bdev_name || ns[1] == '\0' ||
+	    strspn(ns + 1, "0123456789") != strlen(ns + 1))
+		return 0;
+
+	return ns - bdev_name;
+}
+
+/**
+ * Check whether a bdev should be used by DAOS given the NVMe namespaces selected in config. Only
+ * the selected namespace of a controller with a selection is used, bdevs of other controllers
+ * are unaffected.
+ *
+ * \param[in]	bdev_name	SPDK bdev name
+ *
+ * \returns	 False if the bdev is an unselected namespace of a controller, true otherwise
+ */
+bool
+bio_bdev_ns_selected(const char *bdev_name)
+{
+	size_t len = ns_bdev_ctrlr_len(bdev_name);
+	size_t i;
+
+	if (len == 0)
+		return true;
+
+	for (i = 0; i < ns_select.num_bdevs; i++) {
+		if (ns_bdev_ctrlr_len(ns_select.bdevs[i]) != len ||
+		    strncmp(ns_select.bdevs[i], bdev_name, len) != 0)
+			continue;
+
+		return strcmp(ns_select.bdevs[i], bdev_name) == 0;
+	}
+
+	return true;
+}
@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2018-2025 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -229,6 +230,29 @@ blob_msg_close(void *msg_arg)
 	spdk_blob_close(blob, blob_close_cb, msg_arg);
 }
 
+static void
+blob_resize_cb(void *arg, int rc)
+{
+	struct blob_msg_arg	*bma = arg;
+
+	if (rc) {
+		blob_common_cb(&bma->bma_cp_arg, rc);
+		return;
+	}
+
+	/* Persist the new cluster count before completing the request */
+	spdk_blob_sync_md(bma->bma_ioc->bic_blob, blob_cb, arg);
+}
+
+static void
+blob_msg_resize(void *msg_arg)
+{
+	struct blob_msg_arg	*arg = msg_arg;
+	struct spdk_blob	*blob = arg->bma_ioc->bic_blob;
+
+	spdk_blob_resize(blob, arg->bma_opts.num_clusters, blob_resize_cb, msg_arg);
+}
+
 static void
 bio_bs_unhold(struct bio_blobstore *bbs)
 {
@@ -1186,6 +1210,76 @@ skip_media_error:
 	return rc;
 }
 
+int
+bio_blob_resize(struct bio_io_context *ioctxt, uint64_t blob_sz)
+{
+	struct blob_msg_arg	 bma = { 0 };
+	struct blob_cp_arg	*ba = &bma.bma_cp_arg;
+	struct bio_blobstore	*bbs;
+	uint64_t		 cluster_sz, old_clusters;
+	int			 rc;
+
+	D_ASSERT(ioctxt->bic_xs_ctxt != NULL);
+	D_ASSERT(ioctxt->bic_xs_blobstore != NULL);
+	bbs = ioctxt->bic_xs_blobstore->bxb_blobstore;
+
+	if (!is_blob_valid(ioctxt)) {
+		D_ERROR("Blobstore is invalid. blob:%p, closing:%d\n",
+			ioctxt->bic_blob, ioctxt->bic_closing);
+		return -DER_NO_HDL;
+	}
+
+	cluster_sz = spdk_bs_get_cluster_size(bbs->bb_bs);
+	bma.bma_opts.num_clusters = (blob_sz + cluster_sz - 1) / cluster_sz;
+	old_clusters = spdk_blob_get_num_clusters(ioctxt->bic_blob);
+
+	if (bma.bma_opts.num_clusters < old_clusters) {
+		D_ERROR("Can't shrink blob %p from "DF_U64" to "DF_U64" clusters\n",
+			ioctxt->bic_blob, old_clusters, bma.bma_opts.num_clusters);
+		return -DER_INVAL;
+	}
+
+	if (bma.bma_opts.num_clusters > old_clusters) {
+		rc = blob_cp_arg_init(ba);
+		if (rc)
+			return rc;
+
+		rc = bio_bs_hold(bbs);
+		if (rc) {
+			blob_cp_arg_fini(ba);
+			return rc;
+		}
+
+		D_DEBUG(DB_MGMT, "Resizing blob %p from "DF_U64" to "DF_U64" clusters\n",
+			ioctxt->bic_blob, old_clusters, bma.bma_opts.num_clusters);
+
+		ba->bca_inflights = 1;
+		bma.bma_ioc = ioctxt;
+		spdk_thread_send_msg(owner_thread(bbs), blob_msg_resize, &bma);
+
+		/* Wait for blob resize done */
+		blob_wait_completion(ioctxt->bic_xs_ctxt, ba);
+		rc = ba->bca_rc;
+
+		bio_bs_unhold(bbs);
+		blob_cp_arg_fini(ba);
+
+		if (rc) {
+			D_ERROR("Resize blob %p failed for xs:%p pool:"DF_UUID" rc:%d\n",
+				ioctxt->bic_blob, ioctxt->bic_xs_ctxt,
+				DP_UUID(ioctxt->bic_pool_id), rc);
+			return rc;
+		}
+	}
+
+	/* Blobs recreated on device replacement will use the new size */
+	rc = smd_pool_set_blob_sz(ioctxt->bic_pool_id, SMD_DEV_TYPE_DATA, blob_sz);
+	if (rc)
+		D_ERROR("Failed to update blob size for pool:"DF_UUID". %d\n",
+			DP_UUID(ioctxt->bic_pool_id), rc);
+	return rc;
+}
+
 static int
 blob_unmap_sgl(struct bio_io_context *ioctxt, d_sg_list_t *unmap_sgl, uint32_t blk_sz,
 	       unsigned int start_idx, unsigned int unmap_cnt)
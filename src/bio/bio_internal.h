@@ -493,6 +493,12 @@ enum {
 	BDEV_CLASS_NVME = 0,
 	BDEV_CLASS_MALLOC,
 	BDEV_CLASS_AIO,
+	BDEV_CLASS_NULL,
+	BDEV_CLASS_RAID,
+	BDEV_CLASS_DELAY,
+	BDEV_CLASS_ERROR,
+	BDEV_CLASS_CRYPTO,
+	BDEV_CLASS_OCF,
 	BDEV_CLASS_UNKNOWN
 };
 
@@ -505,6 +511,18 @@ get_bdev_type(struct spdk_bdev *bdev)
 		return BDEV_CLASS_MALLOC;
 	else if (strcmp(spdk_bdev_get_product_name(bdev), "AIO disk") == 0)
 		return BDEV_CLASS_AIO;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "Null disk") == 0)
+		return BDEV_CLASS_NULL;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "Raid Volume") == 0)
+		return BDEV_CLASS_RAID;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "delay") == 0)
+		return BDEV_CLASS_DELAY;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "Error disk") == 0)
+		return BDEV_CLASS_ERROR;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "crypto") == 0)
+		return BDEV_CLASS_CRYPTO;
+	else if (strcmp(spdk_bdev_get_product_name(bdev), "SPDK OCF") == 0)
+		return BDEV_CLASS_OCF;
 	else
 		return BDEV_CLASS_UNKNOWN;
 }
@@ -668,10 +686,21 @@ bio_set_hotplug_filter(const char *nvme_conf);
 int
 bio_read_accel_props(const char *nvme_conf);
 int
-bio_read_rpc_srv_settings(const char *nvme_conf, bool *enable, const char **sock_addr);
+bio_read_rpc_srv_settings(const char *nvme_conf, bool *enable, const char **sock_addr,
+			  uint32_t *sock_uid, uint32_t *sock_gid, uint32_t *sock_mode);
 int
 bio_read_auto_faulty_criteria(const char *nvme_conf, bool *enable, uint32_t *max_io_errs,
 			      uint32_t *max_csum_errs);
 int
+bio_set_spdk_log(const char *nvme_conf);
+int
+bio_read_spdk_env_opts(const char *nvme_conf, bool *no_huge, uint32_t *mem_size_mb);
+int
+bio_read_ns_select(const char *nvme_conf);
+void
+bio_free_ns_select(void);
+bool
+bio_bdev_ns_selected(const char *bdev_name);
+int
 bio_decode_bdev_params(struct bio_dev_info *b_info, const void *json, int json_size);
 #endif /* __BIO_INTERNAL_H__ */
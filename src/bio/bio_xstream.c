@@ -9,6 +9,7 @@
 #include <sys/types.h>
 #include <sys/stat.h>
 #include <fcntl.h>
+#include <unistd.h>
 #include <uuid/uuid.h>
 #include <abt.h>
 #include <spdk/log.h>
@@ -67,7 +68,8 @@ struct bio_nvme_data {
 	ABT_mutex		 bd_mutex;
 	ABT_cond		 bd_barrier;
 	/* SPDK bdev type */
-	int			 bd_bdev_class;
+	/* Bitmask of BDEV_CLASS_* bdev classes to be used */
+	unsigned int		 bd_bdev_classes;
 	/* How many xstreams has initialized NVMe context */
 	int			 bd_xstream_cnt;
 	/* The thread responsible for SPDK bdevs init/fini */
@@ -87,6 +89,10 @@ struct bio_nvme_data {
 	/* Setting to enable SPDK JSON-RPC server */
 	bool			 bd_enable_rpc_srv;
 	const char		*bd_rpc_srv_addr;
+	/* Ownership and permissions to set on SPDK JSON-RPC server socket */
+	uint32_t		 bd_rpc_srv_uid;
+	uint32_t		 bd_rpc_srv_gid;
+	uint32_t		 bd_rpc_srv_mode;
 };
 
 static struct bio_nvme_data nvme_glb;
@@ -97,6 +103,9 @@ bio_spdk_conf_read(struct spdk_env_opts *opts)
 {
 	bool			enable_rpc_srv = false;
 	bool                    vmd_enabled    = false;
+	bool                    no_huge        = false;
+	uint32_t                mem_size_mb    = 0;
+	char                   *env_context    = NULL;
 	int			roles = 0;
 	int                     rc;
 
@@ -106,7 +115,7 @@ bio_spdk_conf_read(struct spdk_env_opts *opts)
 		return rc;
 	}
 	nvme_glb.bd_nvme_roles = roles;
-	bio_vmd_enabled        = vmd_enabled && (nvme_glb.bd_bdev_class == BDEV_CLASS_NVME);
+	bio_vmd_enabled        = vmd_enabled && (nvme_glb.bd_bdev_classes & (1U << BDEV_CLASS_NVME));
 
 	rc = bio_set_hotplug_filter(nvme_glb.bd_nvme_conf);
 	if (rc != 0) {
@@ -121,7 +130,8 @@ bio_spdk_conf_read(struct spdk_env_opts *opts)
 	}
 
 	rc = bio_read_rpc_srv_settings(nvme_glb.bd_nvme_conf, &enable_rpc_srv,
-				       &nvme_glb.bd_rpc_srv_addr);
+				       &nvme_glb.bd_rpc_srv_addr, &nvme_glb.bd_rpc_srv_uid,
+				       &nvme_glb.bd_rpc_srv_gid, &nvme_glb.bd_rpc_srv_mode);
 	if (rc != 0) {
 		DL_ERROR(rc, "Failed to read SPDK JSON-RPC server settings");
 		return rc;
@@ -142,6 +152,33 @@ bio_spdk_conf_read(struct spdk_env_opts *opts)
 		return rc;
 	}
 
+	rc = bio_set_spdk_log(nvme_glb.bd_nvme_conf);
+	if (rc != 0) {
+		DL_ERROR(rc, "Failed to apply SPDK log settings");
+		return rc;
+	}
+
+	rc = bio_read_ns_select(nvme_glb.bd_nvme_conf);
+	if (rc != 0) {
+		DL_ERROR(rc, "Failed to read NVMe namespace selections");
+		return rc;
+	}
+
+	rc = bio_read_spdk_env_opts(nvme_glb.bd_nvme_conf, &no_huge, &mem_size_mb);
+	if (rc != 0) {
+		DL_ERROR(rc, "Failed to read SPDK env options");
+		return rc;
+	}
+	if (no_huge) {
+		/* Allocate DMA memory from the heap, physical addresses are unavailable */
+		D_ASPRINTF(env_context, "%s --no-huge", dpdk_cli_override_opts);
+		if (env_context == NULL)
+			return -DER_NOMEM;
+		opts->env_context = env_context;
+		opts->mem_size    = mem_size_mb;
+		opts->iova_mode   = "va";
+	}
+
 	return 0;
 }
 
@@ -195,6 +232,8 @@ bio_spdk_env_init(void)
 	}
 out:
 	D_FREE(opts.pci_allowed);
+	if (opts.env_context != dpdk_cli_override_opts)
+		D_FREE(opts.env_context);
 	return rc;
 }
 
@@ -212,12 +251,82 @@ init_chk_cnt()
 	return (init_cnt == 0) ? 1 : init_cnt;
 }
 
+static inline bool
+bdev_class_enabled(struct spdk_bdev *bdev)
+{
+	int type = get_bdev_type(bdev);
+
+	if (type == BDEV_CLASS_UNKNOWN)
+		return false;
+	if ((nvme_glb.bd_bdev_classes & (1U << type)) == 0)
+		return false;
+	/* Skip namespaces other than the one selected in config for an NVMe controller */
+	return type != BDEV_CLASS_NVME || bio_bdev_ns_selected(spdk_bdev_get_name(bdev));
+}
+
+/*
+ * VOS_BDEV_CLASS holds a comma separated list of the bdev classes in use, so
+ * that an engine may combine e.g. an NVMe tier with an AIO file tier.
+ */
+static int
+parse_bdev_classes(void)
+{
+	char	*env = NULL;
+	char	*tok, *saveptr = NULL;
+	int	 rc = 0;
+
+	nvme_glb.bd_bdev_classes = 0;
+
+	d_agetenv_str(&env, "VOS_BDEV_CLASS");
+	if (env == NULL) {
+		nvme_glb.bd_bdev_classes = 1U << BDEV_CLASS_NVME;
+		return 0;
+	}
+
+	for (tok = strtok_r(env, ",", &saveptr); tok != NULL;
+	     tok = strtok_r(NULL, ",", &saveptr)) {
+		if (strcasecmp(tok, "NVME") == 0) {
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_NVME;
+		} else if (strcasecmp(tok, "AIO") == 0) {
+			D_WARN("AIO device(s) will be used!\n");
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_AIO;
+		} else if (strcasecmp(tok, "MALLOC") == 0) {
+			D_WARN("Malloc device(s) will be used!\n");
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_MALLOC;
+		} else if (strcasecmp(tok, "NULL") == 0) {
+			D_WARN("Null device(s) will be used, data will not be stored!\n");
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_NULL;
+		} else if (strcasecmp(tok, "RAID") == 0) {
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_RAID;
+		} else if (strcasecmp(tok, "DELAY") == 0) {
+			D_WARN("Delay device(s) will be used, I/O latency is injected!\n");
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_DELAY;
+		} else if (strcasecmp(tok, "ERROR") == 0) {
+			D_WARN("Error injection device(s) will be used!\n");
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_ERROR;
+		} else if (strcasecmp(tok, "CRYPTO") == 0) {
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_CRYPTO;
+		} else if (strcasecmp(tok, "OCF") == 0) {
+			nvme_glb.bd_bdev_classes |= 1U << BDEV_CLASS_OCF;
+		} else {
+			D_ERROR("Unknown bdev class '%s' in VOS_BDEV_CLASS\n", tok);
+			rc = -DER_INVAL;
+			break;
+		}
+	}
+	d_freeenv_str(&env);
+
+	if (rc == 0 && nvme_glb.bd_bdev_classes == 0)
+		nvme_glb.bd_bdev_classes = 1U << BDEV_CLASS_NVME;
+
+	return rc;
+}
+
 int
 bio_nvme_init_ext(const char *nvme_conf, int numa_node, unsigned int mem_size,
 		  unsigned int hugepage_size, unsigned int tgt_nr, bool bypass_health_collect,
 		  bool init_spdk)
 {
-	char		*env;
 	int		 rc, fd;
 	unsigned int	 size_mb = BIO_DMA_CHUNK_MB;
 
@@ -238,6 +347,9 @@ bio_nvme_init_ext(const char *nvme_conf, int numa_node, unsigned int mem_size,
 	nvme_glb.bd_bypass_health_collect = bypass_health_collect;
 	nvme_glb.bd_enable_rpc_srv = false;
 	nvme_glb.bd_rpc_srv_addr = NULL;
+	nvme_glb.bd_rpc_srv_uid = UINT32_MAX;
+	nvme_glb.bd_rpc_srv_gid = UINT32_MAX;
+	nvme_glb.bd_rpc_srv_mode = 0;
 	D_INIT_LIST_HEAD(&nvme_glb.bd_bdevs);
 
 	rc = ABT_mutex_create(&nvme_glb.bd_mutex);
@@ -313,12 +425,9 @@ bio_nvme_init_ext(const char *nvme_conf, int numa_node, unsigned int mem_size,
 	nvme_glb.bd_bs_opts.cluster_sz = DAOS_BS_CLUSTER_SZ;
 	nvme_glb.bd_bs_opts.max_channel_ops = BIO_BS_MAX_CHANNEL_OPS;
 
-	d_agetenv_str(&env, "VOS_BDEV_CLASS");
-	if (env && strcasecmp(env, "AIO") == 0) {
-		D_WARN("AIO device(s) will be used!\n");
-		nvme_glb.bd_bdev_class = BDEV_CLASS_AIO;
-	}
-	d_freeenv_str(&env);
+	rc = parse_bdev_classes();
+	if (rc != 0)
+		goto free_cond;
 
 	if (numa_node > 0) {
 		bio_numa_node = (unsigned int)numa_node;
@@ -397,6 +506,7 @@ bio_nvme_fini(void)
 	D_ASSERT(nvme_glb.bd_init_thread == NULL);
 	D_ASSERT(d_list_empty(&nvme_glb.bd_bdevs));
 	D_FREE(nvme_glb.bd_nvme_conf);
+	bio_free_ns_select();
 }
 
 static inline bool
@@ -1005,8 +1115,12 @@ init_bio_bdevs(struct bio_xs_context *ctxt)
 		return -DER_NONEXIST;
 	}
 
-	for (bdev = spdk_bdev_first(); bdev != NULL; bdev = spdk_bdev_next(bdev)) {
-		if (nvme_glb.bd_bdev_class != get_bdev_type(bdev))
+	/*
+	 * Only consider leaf bdevs, bdevs claimed by a virtual bdev (e.g. the members of a RAID
+	 * bdev) are accessed through the virtual bdev built on top of them.
+	 */
+	for (bdev = spdk_bdev_first_leaf(); bdev != NULL; bdev = spdk_bdev_next_leaf(bdev)) {
+		if (!bdev_class_enabled(bdev))
 			continue;
 
 		bdev_name = spdk_bdev_get_name(bdev);
@@ -1023,8 +1137,8 @@ init_bio_bdevs(struct bio_xs_context *ctxt)
 			return rc;
 	}
 
-	for (bdev = spdk_bdev_first(); bdev != NULL; bdev = spdk_bdev_next(bdev)) {
-		if (nvme_glb.bd_bdev_class != get_bdev_type(bdev))
+	for (bdev = spdk_bdev_first_leaf(); bdev != NULL; bdev = spdk_bdev_next_leaf(bdev)) {
+		if (!bdev_class_enabled(bdev))
 			continue;
 
 		d_bdev = lookup_dev_by_name(spdk_bdev_get_name(bdev));
@@ -1584,6 +1698,40 @@ bio_xsctxt_free(struct bio_xs_context *ctxt)
 	D_FREE(ctxt);
 }
 
+/*
+ * Apply configured ownership and permissions to the SPDK JSON-RPC server socket so that
+ * unprivileged monitoring agents can connect to it.
+ */
+static int
+set_rpc_srv_sock_perms(void)
+{
+	const char	*addr = nvme_glb.bd_rpc_srv_addr;
+	int		 rc;
+
+	/* chown() leaves the owner or group unchanged for an id of -1 (UINT32_MAX) */
+	if (nvme_glb.bd_rpc_srv_uid != UINT32_MAX || nvme_glb.bd_rpc_srv_gid != UINT32_MAX) {
+		rc = chown(addr, (uid_t)nvme_glb.bd_rpc_srv_uid, (gid_t)nvme_glb.bd_rpc_srv_gid);
+		if (rc != 0) {
+			rc = daos_errno2der(errno);
+			D_ERROR("failed to set owner of SPDK JSON-RPC socket %s to %u:%u, "DF_RC"\n",
+				addr, nvme_glb.bd_rpc_srv_uid, nvme_glb.bd_rpc_srv_gid, DP_RC(rc));
+			return rc;
+		}
+	}
+
+	if (nvme_glb.bd_rpc_srv_mode != 0) {
+		rc = chmod(addr, (mode_t)nvme_glb.bd_rpc_srv_mode);
+		if (rc != 0) {
+			rc = daos_errno2der(errno);
+			D_ERROR("failed to set mode of SPDK JSON-RPC socket %s to %o, "DF_RC"\n",
+				addr, nvme_glb.bd_rpc_srv_mode, DP_RC(rc));
+			return rc;
+		}
+	}
+
+	return 0;
+}
+
 int
 bio_xsctxt_alloc(struct bio_xs_context **pctxt, int tgt_id, bool self_polling)
 {
@@ -1690,6 +1838,12 @@ bio_xsctxt_alloc(struct bio_xs_context **pctxt, int tgt_id, bool self_polling)
 				goto out;
 			}
 
+			rc = set_rpc_srv_sock_perms();
+			if (rc != 0) {
+				spdk_rpc_finish();
+				goto out;
+			}
+
 			/* Set SPDK JSON-RPC server state to receive and process RPCs */
 			spdk_rpc_set_state(SPDK_RPC_RUNTIME);
 			D_DEBUG(DB_MGMT, "SPDK JSON-RPC server listening at %s\n",
@@ -1828,9 +1982,12 @@ scan_bio_bdevs(struct bio_xs_context *ctxt, uint64_t now)
 	if (nvme_glb.bd_scan_age + scan_period >= now)
 		return;
 
-	/* Iterate SPDK bdevs to detect hot plugged device */
-	for (bdev = spdk_bdev_first(); bdev != NULL; bdev = spdk_bdev_next(bdev)) {
-		if (nvme_glb.bd_bdev_class != get_bdev_type(bdev))
+	/*
+	 * Iterate leaf SPDK bdevs to detect hot plugged device, bdevs in use are claimed by their
+	 * blobstore and have already been created.
+	 */
+	for (bdev = spdk_bdev_first_leaf(); bdev != NULL; bdev = spdk_bdev_next_leaf(bdev)) {
+		if (!bdev_class_enabled(bdev))
 			continue;
 
 		bdev_name = spdk_bdev_get_name(bdev);
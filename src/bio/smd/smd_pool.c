@@ -540,3 +540,40 @@ out:
 	smd_db_unlock();
 	return rc;
 }
+
+int
+smd_pool_set_blob_sz(uuid_t pool_id, enum smd_dev_type st, uint64_t blob_sz)
+{
+	struct smd_pool	pool;
+	struct d_uuid	id;
+	int		rc;
+
+	D_ASSERT(st < SMD_DEV_TYPE_MAX);
+	uuid_copy(id.uuid, pool_id);
+
+	smd_db_lock();
+	rc = smd_db_fetch(TABLE_POOLS[st], &id, sizeof(id), &pool, sizeof(pool));
+	if (rc) {
+		D_ERROR("Failed to fetch smd entry of type %d for "DF_UUID". "DF_RC"\n",
+			st, DP_UUID(&id.uuid), DP_RC(rc));
+		goto out;
+	}
+
+	if (pool.sp_blob_sz == blob_sz)
+		goto out;
+
+	if (pool.sp_blob_sz > blob_sz) {
+		D_ERROR("Pool "DF_UUID" blob size can't shrink. "DF_U64" > "DF_U64"\n",
+			DP_UUID(&id.uuid), pool.sp_blob_sz, blob_sz);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	pool.sp_blob_sz = blob_sz;
+	rc = smd_db_upsert(TABLE_POOLS[st], &id, sizeof(id), &pool, sizeof(pool));
+	if (rc)
+		D_ERROR("Update pool "DF_UUID" failed. "DF_RC"\n",
+			DP_UUID(&id.uuid), DP_RC(rc));
+out:
+	smd_db_unlock();
+	return rc;
+}
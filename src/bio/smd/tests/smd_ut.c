@@ -441,6 +441,24 @@ ut_pool(void **state)
 		smd_pool_free_info(pool_info);
 	}
 
+	rc = smd_pool_set_blob_sz(id1, SMD_DEV_TYPE_DATA, 300);
+	assert_rc_equal(rc, 0);
+
+	rc = smd_pool_set_blob_sz(id1, SMD_DEV_TYPE_DATA, 300);
+	assert_rc_equal(rc, 0);
+
+	rc = smd_pool_set_blob_sz(id1, SMD_DEV_TYPE_DATA, 200);
+	assert_rc_equal(rc, -DER_INVAL);
+
+	rc = smd_pool_set_blob_sz(id3, SMD_DEV_TYPE_DATA, 300);
+	assert_rc_equal(rc, -DER_NONEXIST);
+
+	rc = smd_pool_get_info(id1, &pool_info);
+	assert_rc_equal(rc, 0);
+	assert_int_equal(pool_info->spi_blob_sz[SMD_DEV_TYPE_DATA], 300);
+	assert_int_equal(pool_info->spi_blob_sz[SMD_DEV_TYPE_META], 100);
+	smd_pool_free_info(pool_info);
+
 	for (st = SMD_DEV_TYPE_DATA; st < SMD_DEV_TYPE_MAX; st++) {
 		rc = smd_pool_del_tgt(id1, 6, st);
 		assert_rc_equal(rc, -DER_NONEXIST);
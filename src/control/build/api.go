@@ -0,0 +1,130 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package build
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// ControlAPIVersion is the version of the control API implemented by this build. It is
+	// only incremented for changes that components implementing an older version cannot
+	// handle, e.g. a change in the meaning of an existing field. Additions that older
+	// components can safely ignore are advertised as features instead.
+	ControlAPIVersion = 1
+	// MinControlAPIVersion is the oldest control API version implemented by a peer that this
+	// build interoperates with.
+	MinControlAPIVersion = 1
+
+	// DaosAPIVersionHeader defines the header name used to convey the control API version.
+	DaosAPIVersionHeader = "x-daos-api-version"
+)
+
+// Feature is an optional capability of the control API. Servers advertise the features they
+// support so that clients can tell which requests each server is able to handle in a system
+// running mixed versions, e.g. during a rolling upgrade.
+type Feature string
+
+func (f Feature) String() string {
+	return string(f)
+}
+
+// Features of the control API that may not be supported by all servers.
+const (
+	FeatureAuditQuery   Feature = "audit-query"
+	FeatureReloadCerts  Feature = "reload-certs"
+	FeatureEventsQuery  Feature = "events-query"
+	FeaturePoolResize   Feature = "pool-resize"
+	FeaturePoolClone    Feature = "pool-clone"
+	FeaturePoolProfiles Feature = "pool-profiles"
+	FeatureJobs         Feature = "jobs"
+	FeatureTokenAuth    Feature = "token-auth"
+	FeatureHTTPGateway  Feature = "http-gateway"
+)
+
+// featureMethods maps features to the control API methods that require them.
+var featureMethods = map[Feature][]string{
+	FeatureAuditQuery:   {"/ctl.CtlSvc/AuditQuery"},
+	FeatureReloadCerts:  {"/ctl.CtlSvc/ReloadCerts"},
+	FeatureEventsQuery:  {"/mgmt.MgmtSvc/SystemEventsQuery"},
+	FeaturePoolResize:   {"/mgmt.MgmtSvc/PoolResize"},
+	FeaturePoolClone:    {"/mgmt.MgmtSvc/PoolClone"},
+	FeaturePoolProfiles: {"/mgmt.MgmtSvc/PoolProfileSet", "/mgmt.MgmtSvc/PoolProfileGet"},
+	FeatureJobs: {
+		"/mgmt.MgmtSvc/JobSubmit", "/mgmt.MgmtSvc/JobList", "/mgmt.MgmtSvc/JobCancel",
+	},
+}
+
+// ServerFeatures returns the features implemented by a server of this build, in name order.
+// Features that depend on the server configuration are not included.
+func ServerFeatures() []Feature {
+	features := make([]Feature, 0, len(featureMethods))
+	for feature := range featureMethods {
+		features = append(features, feature)
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i] < features[j] })
+
+	return features
+}
+
+// MethodFeature returns the feature required by the given control API method, if any.
+func MethodFeature(method string) (Feature, bool) {
+	for feature, methods := range featureMethods {
+		for _, m := range methods {
+			if m == method {
+				return feature, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// CheckAPIVersion checks that a peer implementing the given control API version can
+// interoperate with this build. Peers that predate control API versioning, indicated by a
+// version of zero, are only subject to the component version compatibility rules.
+func CheckAPIVersion(peerVersion uint32) error {
+	if peerVersion != 0 && peerVersion < MinControlAPIVersion {
+		return errors.Errorf("control API version %d is older than the minimum supported "+
+			"version %d", peerVersion, MinControlAPIVersion)
+	}
+
+	return nil
+}
+
+// APIVersionFromMD returns the control API version in the supplied metadata. A version of
+// zero is returned if the metadata doesn't include a version, as is the case for components
+// that predate control API versioning.
+func APIVersionFromMD(md metadata.MD) (uint32, error) {
+	values := md.Get(DaosAPIVersionHeader)
+	if len(values) == 0 {
+		return 0, nil
+	}
+
+	version, err := strconv.ParseUint(values[0], 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s header %q", DaosAPIVersionHeader, values[0])
+	}
+
+	return uint32(version), nil
+}
+
+// APIVersionFromContext returns the control API version sent by the peer in the incoming
+// request headers, or zero if the peer did not send one.
+func APIVersionFromContext(ctx context.Context) (uint32, error) {
+	if ctx == nil {
+		return 0, errors.New("nil context")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	return APIVersionFromMD(md)
+}
@@ -0,0 +1,128 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestBuild_ServerFeatures(t *testing.T) {
+	features := ServerFeatures()
+
+	if len(features) != len(featureMethods) {
+		t.Fatalf("expected %d features, got %d", len(featureMethods), len(features))
+	}
+	for i := 1; i < len(features); i++ {
+		if features[i-1] >= features[i] {
+			t.Fatalf("features not in name order: %v", features)
+		}
+	}
+	for _, feature := range features {
+		if feature == FeatureTokenAuth || feature == FeatureHTTPGateway {
+			t.Fatalf("unexpected config-dependent feature %q", feature)
+		}
+	}
+}
+
+func TestBuild_MethodFeature(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method     string
+		expFeature Feature
+		expFound   bool
+	}{
+		"no feature required": {
+			method: "/ctl.CtlSvc/StorageScan",
+		},
+		"ctl method": {
+			method:     "/ctl.CtlSvc/ReloadCerts",
+			expFeature: FeatureReloadCerts,
+			expFound:   true,
+		},
+		"mgmt method": {
+			method:     "/mgmt.MgmtSvc/JobCancel",
+			expFeature: FeatureJobs,
+			expFound:   true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotFeature, gotFound := MethodFeature(tc.method)
+
+			test.AssertEqual(t, tc.expFound, gotFound, "unexpected found result")
+			test.AssertEqual(t, tc.expFeature, gotFeature, "unexpected feature")
+		})
+	}
+}
+
+func TestBuild_CheckAPIVersion(t *testing.T) {
+	for name, tc := range map[string]struct {
+		version uint32
+		expErr  error
+	}{
+		"legacy peer": {},
+		"current version": {
+			version: ControlAPIVersion,
+		},
+		"newer version": {
+			version: ControlAPIVersion + 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, CheckAPIVersion(tc.version))
+		})
+	}
+}
+
+func TestBuild_APIVersionFromContext(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ctx        context.Context
+		expVersion uint32
+		expErr     error
+	}{
+		"nil context": {
+			expErr: errors.New("nil context"),
+		},
+		"no metadata in context": {
+			ctx: test.Context(t),
+		},
+		"no version in context": {
+			ctx: metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+				DaosComponentHeader, ComponentAgent.String(),
+			)),
+		},
+		"invalid version": {
+			ctx: metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+				DaosAPIVersionHeader, "one",
+			)),
+			expErr: errors.New("invalid x-daos-api-version header"),
+		},
+		"good version": {
+			ctx: metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+				DaosAPIVersionHeader, "3",
+			)),
+			expVersion: 3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotVersion, gotErr := APIVersionFromContext(tc.ctx)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expVersion, gotVersion); diff != "" {
+				t.Fatalf("unexpected version (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package build
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/metadata"
@@ -43,7 +45,7 @@ func FromContext(ctx context.Context) (*VersionedComponent, error) {
 	return NewVersionedComponent(comp, compVersion[0])
 }
 
-// ToContext adds the component and version to the context.
+// ToContext adds the component, version and control API version to the context.
 func ToContext(parent context.Context, comp Component, verStr string) (context.Context, error) {
 	if parent == nil {
 		return nil, errors.New("nil context")
@@ -65,5 +67,6 @@ func ToContext(parent context.Context, comp Component, verStr string) (context.C
 	return metadata.AppendToOutgoingContext(parent,
 		DaosComponentHeader, comp.String(),
 		DaosVersionHeader, version.String(),
+		DaosAPIVersionHeader, strconv.Itoa(ControlAPIVersion),
 	), nil
 }
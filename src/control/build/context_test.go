@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +9,7 @@ package build
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -109,6 +111,7 @@ func TestBuild_ToContext(t *testing.T) {
 			expMD: metadata.Pairs(
 				DaosComponentHeader, ComponentAgent.String(),
 				DaosVersionHeader, "2.3.108",
+				DaosAPIVersionHeader, strconv.Itoa(ControlAPIVersion),
 			),
 		},
 	} {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 // (C) Copyright 2025 Google LLC
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
@@ -137,6 +138,7 @@ type Config struct {
 	FabricInterfaces    []*NUMAFabricConfig        `yaml:"fabric_ifaces,omitempty"`
 	ProviderIdx         uint                       // TODO SRS-31: Enable with multiprovider functionality
 	Telemetry           TelemetryConfig            `yaml:",inline"`
+	HealthProbePort     int                        `yaml:"health_probe_port,omitempty"`
 }
 
 // Validate performs basic validation of the configuration.
@@ -157,6 +159,17 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if c.HealthProbePort < 0 {
+		return fmt.Errorf("invalid health_probe_port: %d", c.HealthProbePort)
+	}
+	if c.HealthProbePort > 0 && c.HealthProbePort == c.Telemetry.Port {
+		return errors.New("health_probe_port must differ from telemetry_port")
+	}
+
+	if err := c.CredentialConfig.Validate(); err != nil {
+		return errors.Wrap(err, "credential_config")
+	}
+
 	return nil
 }
 
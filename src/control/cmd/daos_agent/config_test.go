@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 // (C) Copyright 2025 Google LLC
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
@@ -51,6 +52,8 @@ cache_expiration: 30
 disable_auto_evict: true
 credential_config:
   cache_expiration: 10m
+  cache_group_check: 30s
+  delegation_map_file: /etc/daos/daos_agent_delegation.yml
   client_user_map:
     1000:
       user: frodo
@@ -160,7 +163,9 @@ exclude_fabric_ifaces: ["ib3"]
 				CacheExpiration:  refreshMinutes(30 * time.Minute),
 				DisableAutoEvict: true,
 				CredentialConfig: &security.CredentialConfig{
-					CacheExpiration: time.Minute * 10,
+					CacheExpiration:   time.Minute * 10,
+					CacheGroupCheck:   time.Second * 30,
+					DelegationMapFile: "/etc/daos/daos_agent_delegation.yml",
 					ClientUserMap: map[uint32]*security.MappedClientUser{
 						1000: {
 							User:   "frodo",
@@ -293,6 +298,32 @@ telemetry_retain: foo
 `,
 			expErr: errors.New("time.Duration"),
 		},
+		"credential cache group check without expiration": {
+			input: `
+credential_config:
+  cache_group_check: 30s
+`,
+			expErr: errors.New("cache_group_check requires cache_expiration"),
+		},
+		"negative credential cache expiration": {
+			input: `
+credential_config:
+  cache_expiration: -1m
+`,
+			expErr: errors.New("cache_expiration must not be negative"),
+		},
+		"credential cache with group check": {
+			input: `
+credential_config:
+  cache_expiration: 5m
+  cache_group_check: 30s
+`,
+			expCfg: cfgWith(DefaultConfig(), func(cfg *Config) *Config {
+				cfg.CredentialConfig.CacheExpiration = 5 * time.Minute
+				cfg.CredentialConfig.CacheGroupCheck = 30 * time.Second
+				return cfg
+			}),
+		},
 		"minimal telemetry config": {
 			input: `
 telemetry_port: 1234
@@ -317,6 +348,28 @@ telemetry_retain: 10s
 				return cfg
 			}),
 		},
+		"negative health probe port": {
+			input: `
+health_probe_port: -1
+`,
+			expErr: errors.New("invalid health_probe_port"),
+		},
+		"health probe port conflicts with telemetry port": {
+			input: `
+telemetry_port: 1234
+health_probe_port: 1234
+`,
+			expErr: errors.New("health_probe_port must differ from telemetry_port"),
+		},
+		"health probe port": {
+			input: `
+health_probe_port: 9192
+`,
+			expCfg: cfgWith(DefaultConfig(), func(cfg *Config) *Config {
+				cfg.HealthProbePort = 9192
+				return cfg
+			}),
+		},
 		"telemetry config with enabled pattern": {
 			input: `
 telemetry_port: 1234
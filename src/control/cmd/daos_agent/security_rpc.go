@@ -12,9 +12,14 @@ import (
 	"fmt"
 	"net"
 	"os/user"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/lib/cache"
@@ -28,12 +33,41 @@ type (
 	// credSignerFn defines the function signature for signing credentials.
 	credSignerFn func(context.Context, *auth.CredentialRequest) (*auth.Credential, error)
 
-	// credentialCache implements a cache for signed credentials.
+	// groupIDsLookupFn defines the function signature for looking up the
+	// group memberships of a user.
+	groupIDsLookupFn func(uid uint32) ([]string, error)
+
+	// credentialCacheStats contains statistics about the use of the
+	// credential cache.
+	credentialCacheStats struct {
+		Entries       int    `json:"entries"`
+		Hits          uint64 `json:"hits"`
+		Misses        uint64 `json:"misses"`
+		Invalidations uint64 `json:"invalidations"`
+	}
+
+	// cachedUserGroups records the group memberships of a user with cached
+	// credentials at the time they were last checked.
+	cachedUserGroups struct {
+		groupIDs  string
+		checkedAt time.Time
+	}
+
+	// credentialCache implements a cache for signed credentials. If
+	// groupCheck is set, the group memberships of each user with cached
+	// credentials are checked at that interval, and all of the user's
+	// cached credentials are invalidated if the memberships have changed.
 	credentialCache struct {
-		log          logging.Logger
-		cache        *cache.ItemCache
-		credLifetime time.Duration
-		cacheMissFn  credSignerFn
+		log            logging.Logger
+		cache          *cache.ItemCache
+		credLifetime   time.Duration
+		cacheMissFn    credSignerFn
+		groupCheck     time.Duration
+		lookupGroupIDs groupIDsLookupFn
+
+		mutex      sync.Mutex
+		userGroups map[uint32]*cachedUserGroups
+		stats      credentialCacheStats
 	}
 
 	// cachedCredential wraps a cached credential and implements the cache.ExpirableItem interface.
@@ -48,6 +82,7 @@ type (
 	securityConfig struct {
 		credentials *security.CredentialConfig
 		transport   *security.TransportConfig
+		delegations security.DelegationMap
 	}
 
 	// SecurityModule is the security drpc module struct
@@ -68,13 +103,20 @@ func NewSecurityModule(log logging.Logger, cfg *securityConfig) *SecurityModule
 	credSigner := auth.GetSignedCredential
 	if cfg.credentials.CacheExpiration > 0 {
 		credCache = &credentialCache{
-			log:          log,
-			cache:        cache.NewItemCache(log),
-			credLifetime: cfg.credentials.CacheExpiration,
-			cacheMissFn:  auth.GetSignedCredential,
+			log:            log,
+			cache:          cache.NewItemCache(log),
+			credLifetime:   cfg.credentials.CacheExpiration,
+			cacheMissFn:    auth.GetSignedCredential,
+			groupCheck:     cfg.credentials.CacheGroupCheck,
+			lookupGroupIDs: lookupGroupIDs,
+			userGroups:     make(map[uint32]*cachedUserGroups),
 		}
 		credSigner = credCache.getSignedCredential
 		log.Noticef("credential cache enabled (entry lifetime: %s)", cfg.credentials.CacheExpiration)
+		if cfg.credentials.CacheGroupCheck > 0 {
+			log.Noticef("credential cache group membership check enabled (interval: %s)",
+				cfg.credentials.CacheGroupCheck)
+		}
 	}
 
 	return &SecurityModule{
@@ -85,8 +127,22 @@ func NewSecurityModule(log logging.Logger, cfg *securityConfig) *SecurityModule
 	}
 }
 
+// credUidKeyPrefix returns the prefix of the keys of all cached credentials
+// for a user.
+func credUidKeyPrefix(uid uint32) string {
+	return fmt.Sprintf("%d:", uid)
+}
+
 func credReqKey(req *auth.CredentialRequest) string {
-	return fmt.Sprintf("%d:%d:%s", req.DomainInfo.Uid(), req.DomainInfo.Gid(), req.DomainInfo.Ctx())
+	return fmt.Sprintf("%s%d:%s", credUidKeyPrefix(req.DomainInfo.Uid()), req.DomainInfo.Gid(), req.DomainInfo.Ctx())
+}
+
+func lookupGroupIDs(uid uint32) ([]string, error) {
+	u, err := user.LookupId(strconv.Itoa(int(uid)))
+	if err != nil {
+		return nil, err
+	}
+	return u.GroupIds()
 }
 
 // Key returns the key for the cached credential.
@@ -107,10 +163,91 @@ func (cred *cachedCredential) IsExpired() bool {
 	return time.Now().After(cred.expiredAt)
 }
 
+// checkUserGroups invalidates the cached credentials of a user if their group
+// memberships have changed since they were last checked. The memberships are
+// looked up at most once per check interval for each user, so that a large
+// number of clients started by the same user do not result in a lookup for
+// each client.
+func (cc *credentialCache) checkUserGroups(uid uint32) {
+	if cc.groupCheck == 0 {
+		return
+	}
+
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	ug, found := cc.userGroups[uid]
+	if found && time.Since(ug.checkedAt) < cc.groupCheck {
+		return
+	}
+
+	groupIDs, err := cc.lookupGroupIDs(uid)
+	if err != nil {
+		// Users that can't be looked up (e.g. mapped client users) are
+		// only subject to the cache expiration.
+		cc.log.Tracef("unable to check groups for uid %d: %s", uid, err)
+		delete(cc.userGroups, uid)
+		return
+	}
+	sort.Strings(groupIDs)
+	cur := strings.Join(groupIDs, ",")
+
+	if found && ug.groupIDs != cur {
+		prefix := credUidKeyPrefix(uid)
+		for _, key := range cc.cache.Keys() {
+			if strings.HasPrefix(key, prefix) {
+				cc.cache.Delete(key)
+				cc.stats.Invalidations++
+			}
+		}
+		cc.log.Debugf("group memberships changed for uid %d; invalidated cached credentials", uid)
+	}
+
+	cc.userGroups[uid] = &cachedUserGroups{
+		groupIDs:  cur,
+		checkedAt: time.Now(),
+	}
+}
+
+// Flush removes all cached credentials.
+func (cc *credentialCache) Flush() {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	for _, key := range cc.cache.Keys() {
+		cc.cache.Delete(key)
+		cc.stats.Invalidations++
+	}
+	cc.userGroups = make(map[uint32]*cachedUserGroups)
+}
+
+// Stats returns a snapshot of the credential cache statistics.
+func (cc *credentialCache) Stats() credentialCacheStats {
+	cc.mutex.Lock()
+	defer cc.mutex.Unlock()
+
+	stats := cc.stats
+	stats.Entries = len(cc.cache.Keys())
+	return stats
+}
+
 func (cc *credentialCache) getSignedCredential(ctx context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
 	key := credReqKey(req)
+	cc.checkUserGroups(req.DomainInfo.Uid())
+
+	var missed bool
+	defer func() {
+		cc.mutex.Lock()
+		defer cc.mutex.Unlock()
+		if missed {
+			cc.stats.Misses++
+		} else {
+			cc.stats.Hits++
+		}
+	}()
 
 	createItem := func() (cache.Item, error) {
+		missed = true
 		cc.log.Tracef("cache miss for %s", key)
 		cred, err := cc.cacheMissFn(ctx, req)
 		if err != nil {
@@ -146,10 +283,24 @@ func newCachedCredential(key string, cred *auth.Credential, lifetime time.Durati
 	}, nil
 }
 
+// RefreshCache removes all cached credentials, so that credentials are
+// regenerated with current user and group information.
+func (m *SecurityModule) RefreshCache() {
+	if m.credCache == nil {
+		return
+	}
+
+	m.credCache.Flush()
+	m.log.Debug("credential cache flushed")
+}
+
 // GetMethod gets the corresponding Method for a method ID.
 func (m *SecurityModule) GetMethod(id int32) (drpc.Method, error) {
-	if id == daos.MethodRequestCredentials.ID() {
+	switch id {
+	case daos.MethodRequestCredentials.ID():
 		return daos.MethodRequestCredentials, nil
+	case daos.MethodRequestDelegatedCredentials.ID():
+		return daos.MethodRequestDelegatedCredentials, nil
 	}
 
 	return nil, fmt.Errorf("invalid method ID %d for module %s", id, m.String())
@@ -161,11 +312,14 @@ func (m *SecurityModule) String() string {
 
 // HandleCall is the handler for calls to the SecurityModule
 func (m *SecurityModule) HandleCall(ctx context.Context, session *drpc.Session, method drpc.Method, body []byte) ([]byte, error) {
-	if method != daos.MethodRequestCredentials {
-		return nil, drpc.UnknownMethodFailure()
+	switch method {
+	case daos.MethodRequestCredentials:
+		return m.getCredential(ctx, session)
+	case daos.MethodRequestDelegatedCredentials:
+		return m.getDelegatedCredential(ctx, session, body)
 	}
 
-	return m.getCredential(ctx, session)
+	return nil, drpc.UnknownMethodFailure()
 }
 
 // getCredentials generates a signed user credential based on the data attached to
@@ -223,6 +377,69 @@ func (m *SecurityModule) getCredential(ctx context.Context, session *drpc.Sessio
 	return drpc.Marshal(resp)
 }
 
+// lookupDelegatedUser resolves the uid requested by a delegating client to the
+// user mapped to the delegator, which may be identified by user name or uid.
+func (m *SecurityModule) lookupDelegatedUser(delegatorUid, uid uint32) *security.MappedClientUser {
+	if u, err := user.LookupId(strconv.Itoa(int(delegatorUid))); err == nil {
+		if mu := m.config.delegations.Lookup(u.Username, uid); mu != nil {
+			return mu
+		}
+	}
+
+	return m.config.delegations.Lookup(strconv.Itoa(int(delegatorUid)), uid)
+}
+
+// getDelegatedCredential generates a signed credential for a user on behalf of
+// the delegating client attached to the Unix Domain Socket. The delegating
+// client must be in the delegation map, and the requested user must be mapped
+// to it. Delegated credentials are not cached, as they do not correspond to the
+// identity of the client.
+func (m *SecurityModule) getDelegatedCredential(ctx context.Context, session *drpc.Session, body []byte) ([]byte, error) {
+	if session == nil {
+		return nil, drpc.NewFailureWithMessage("session is nil")
+	}
+
+	uConn, ok := session.Conn.(*net.UnixConn)
+	if !ok {
+		return nil, drpc.NewFailureWithMessage("connection is not a unix socket")
+	}
+
+	req := new(auth.GetDelegatedCredReq)
+	if err := proto.Unmarshal(body, req); err != nil {
+		return nil, drpc.UnmarshalingPayloadFailure()
+	}
+
+	info, err := security.DomainInfoFromUnixConn(m.log, uConn)
+	if err != nil {
+		m.log.Errorf("Unable to get credentials for client socket: %s", err)
+		return m.credRespWithStatus(daos.MiscError)
+	}
+
+	mu := m.lookupDelegatedUser(info.Uid(), req.Uid)
+	if mu == nil {
+		m.log.Errorf("%s: not permitted to request credentials for uid %d", info, req.Uid)
+		return m.credRespWithStatus(daos.NoPermission)
+	}
+
+	signingKey, err := m.config.transport.PrivateKey()
+	if err != nil {
+		m.log.Errorf("%s: failed to get signing key: %s", info, err)
+		return m.credRespWithStatus(daos.BadCert)
+	}
+
+	credReq := auth.NewCredentialRequest(info, signingKey)
+	credReq.WithUserAndGroup(mu.User, mu.Group, mu.Groups...)
+	cred, err := auth.GetSignedCredential(ctx, credReq)
+	if err != nil {
+		m.log.Errorf("%s: failed to get delegated credential for uid %d: %s", info, req.Uid, err)
+		return m.credRespWithStatus(daos.MiscError)
+	}
+	m.log.Debugf("%s: issued delegated credential for uid %d (user %s)", info, req.Uid, mu.User)
+
+	resp := &auth.GetCredResp{Cred: cred}
+	return drpc.Marshal(resp)
+}
+
 func (m *SecurityModule) credRespWithStatus(status daos.Status) ([]byte, error) {
 	resp := &auth.GetCredResp{Status: int32(status)}
 	return drpc.Marshal(resp)
@@ -10,8 +10,10 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net"
 	"os/user"
+	"strconv"
 	"syscall"
 	"testing"
 	"time"
@@ -48,6 +50,10 @@ func TestAgent_SecurityModule_GetMethod(t *testing.T) {
 			methodID:  daos.MethodRequestCredentials.ID(),
 			expMethod: daos.MethodRequestCredentials,
 		},
+		"request-delegated-cred": {
+			methodID:  daos.MethodRequestDelegatedCredentials.ID(),
+			expMethod: daos.MethodRequestDelegatedCredentials,
+		},
 		"unknown": {
 			methodID: -1,
 			expErr:   errors.New("method ID -1"),
@@ -433,3 +439,261 @@ func TestAgent_SecurityCachedCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestAgent_SecurityCachedCredentials_GroupCheck(t *testing.T) {
+	newCred := func(data string) *auth.Credential {
+		return &auth.Credential{
+			Token:  &auth.Token{Flavor: auth.Flavor_AUTH_SYS, Data: []byte(data)},
+			Origin: "test-origin",
+		}
+	}
+	req1 := &auth.CredentialRequest{
+		DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""),
+	}
+	req2 := &auth.CredentialRequest{
+		DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 9999}, ""),
+	}
+	otherReq := &auth.CredentialRequest{
+		DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 4321, Gid: 5678}, ""),
+	}
+
+	for name, tc := range map[string]struct {
+		groupCheck     time.Duration
+		lookupErr      error
+		changeGroups   bool
+		expCred        *auth.Credential
+		expOtherCached bool
+		expStats       credentialCacheStats
+	}{
+		"group check disabled": {
+			changeGroups:   true,
+			expCred:        newCred("1234:5678:-0"),
+			expOtherCached: true,
+			expStats:       credentialCacheStats{Entries: 3, Hits: 1, Misses: 3},
+		},
+		"groups unchanged": {
+			groupCheck:     time.Nanosecond,
+			expCred:        newCred("1234:5678:-0"),
+			expOtherCached: true,
+			expStats:       credentialCacheStats{Entries: 3, Hits: 1, Misses: 3},
+		},
+		"groups changed": {
+			groupCheck:     time.Nanosecond,
+			changeGroups:   true,
+			expCred:        newCred("1234:5678:-1"),
+			expOtherCached: true,
+			expStats:       credentialCacheStats{Entries: 2, Misses: 4, Invalidations: 2},
+		},
+		"groups changed within check interval": {
+			groupCheck:     time.Hour,
+			changeGroups:   true,
+			expCred:        newCred("1234:5678:-0"),
+			expOtherCached: true,
+			expStats:       credentialCacheStats{Entries: 3, Hits: 1, Misses: 3},
+		},
+		"lookup failed": {
+			groupCheck:     time.Nanosecond,
+			lookupErr:      errors.New("unknown user"),
+			changeGroups:   true,
+			expCred:        newCred("1234:5678:-0"),
+			expOtherCached: true,
+			expStats:       credentialCacheStats{Entries: 3, Hits: 1, Misses: 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := defaultTestSecurityConfig()
+			cfg.credentials.CacheExpiration = time.Hour
+			cfg.credentials.CacheGroupCheck = tc.groupCheck
+			mod := NewSecurityModule(log, cfg)
+
+			calls := make(map[string]int)
+			mod.credCache.cacheMissFn = func(_ context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+				key := credReqKey(req)
+				defer func() { calls[key]++ }()
+				return newCred(fmt.Sprintf("%s-%d", key, calls[key])), nil
+			}
+			groups := []string{"5678", "100"}
+			mod.credCache.lookupGroupIDs = func(uid uint32) ([]string, error) {
+				if tc.lookupErr != nil {
+					return nil, tc.lookupErr
+				}
+				return groups, nil
+			}
+
+			for _, req := range []*auth.CredentialRequest{req1, req2, otherReq} {
+				if _, err := mod.credCache.getSignedCredential(test.Context(t), req); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if tc.changeGroups {
+				groups = []string{"5678", "200"}
+			}
+
+			cred, err := mod.credCache.getSignedCredential(test.Context(t), req1)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expCred, cred, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected credential (-want +got):\n%s", diff)
+			}
+
+			test.AssertEqual(t, tc.expOtherCached, mod.credCache.cache.Has(credReqKey(otherReq)),
+				"unexpected cache state for other user")
+			if diff := cmp.Diff(tc.expStats, mod.credCache.Stats()); diff != "" {
+				t.Errorf("unexpected stats (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAgent_SecurityModule_RefreshCache(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	// No cache configured
+	NewSecurityModule(log, defaultTestSecurityConfig()).RefreshCache()
+
+	cfg := defaultTestSecurityConfig()
+	cfg.credentials.CacheExpiration = time.Hour
+	mod := NewSecurityModule(log, cfg)
+	mod.credCache.cacheMissFn = func(_ context.Context, req *auth.CredentialRequest) (*auth.Credential, error) {
+		return &auth.Credential{Origin: "test-origin"}, nil
+	}
+
+	req := &auth.CredentialRequest{
+		DomainInfo: security.InitDomainInfo(&syscall.Ucred{Uid: 1234, Gid: 5678}, ""),
+	}
+	if _, err := mod.credCache.getSignedCredential(test.Context(t), req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mod.RefreshCache()
+
+	if diff := cmp.Diff(credentialCacheStats{Misses: 1, Invalidations: 1}, mod.credCache.Stats()); diff != "" {
+		t.Errorf("unexpected stats (-want +got):\n%s", diff)
+	}
+}
+
+func TestAgent_SecurityRPC_getDelegatedCredential(t *testing.T) {
+	delegator := strconv.Itoa(unix.Getuid())
+	alice := &security.MappedClientUser{
+		User:   "alice",
+		Group:  "users",
+		Groups: []string{"project1"},
+	}
+	nobody := &security.MappedClientUser{
+		User:  "nobody",
+		Group: "nobody",
+	}
+
+	for name, tc := range map[string]struct {
+		delegations security.DelegationMap
+		req         proto.Message
+		body        []byte
+		expStatus   daos.Status
+		expSys      *auth.Sys
+		expErr      error
+	}{
+		"bad payload": {
+			delegations: security.DelegationMap{
+				delegator: security.ClientUserMap{1000: alice},
+			},
+			body:   []byte("garbage"),
+			expErr: drpc.UnmarshalingPayloadFailure(),
+		},
+		"no delegations": {
+			req:       &auth.GetDelegatedCredReq{Uid: 1000},
+			expStatus: daos.NoPermission,
+		},
+		"delegator not in map": {
+			delegations: security.DelegationMap{
+				"not-a-delegator": security.ClientUserMap{1000: alice},
+			},
+			req:       &auth.GetDelegatedCredReq{Uid: 1000},
+			expStatus: daos.NoPermission,
+		},
+		"uid not mapped": {
+			delegations: security.DelegationMap{
+				delegator: security.ClientUserMap{1000: alice},
+			},
+			req:       &auth.GetDelegatedCredReq{Uid: 1001},
+			expStatus: daos.NoPermission,
+		},
+		"mapped uid": {
+			delegations: security.DelegationMap{
+				delegator: security.ClientUserMap{1000: alice},
+			},
+			req: &auth.GetDelegatedCredReq{Uid: 1000},
+			expSys: &auth.Sys{
+				User:   "alice@",
+				Group:  "users@",
+				Groups: []string{"project1@"},
+			},
+		},
+		"default mapping": {
+			delegations: security.DelegationMap{
+				delegator: security.ClientUserMap{
+					1000:       alice,
+					^uint32(0): nobody,
+				},
+			},
+			req: &auth.GetDelegatedCredReq{Uid: 1001},
+			expSys: &auth.Sys{
+				User:  "nobody@",
+				Group: "nobody@",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			conn, cleanup := setupTestUnixConn(t)
+			defer cleanup()
+
+			cfg := defaultTestSecurityConfig()
+			cfg.delegations = tc.delegations
+			mod := NewSecurityModule(log, cfg)
+
+			body := tc.body
+			if tc.req != nil {
+				var err error
+				if body, err = proto.Marshal(tc.req); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			respBytes, err := mod.HandleCall(test.Context(t), newTestSession(t, log, conn),
+				daos.MethodRequestDelegatedCredentials, body)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			expectCredResp(t, respBytes, int32(tc.expStatus), tc.expSys != nil)
+			if tc.expSys == nil {
+				return
+			}
+
+			resp := new(auth.GetCredResp)
+			if err := proto.Unmarshal(respBytes, resp); err != nil {
+				t.Fatal(err)
+			}
+			sys, err := auth.AuthSysFromAuthToken(resp.Cred.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cmpOpts := cmp.Options{
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&auth.Sys{}, "machinename", "secctx"),
+			}
+			if diff := cmp.Diff(tc.expSys, sys, cmpOpts...); diff != "" {
+				t.Errorf("unexpected credential token (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
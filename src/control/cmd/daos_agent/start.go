@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -23,8 +24,10 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/atm"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
 	"github.com/daos-stack/daos/src/control/lib/hardware/hwloc"
+	"github.com/daos-stack/daos/src/control/lib/health"
 	"github.com/daos-stack/daos/src/control/lib/systemd"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type ctxKey string
@@ -92,13 +95,28 @@ func (cmd *startCmd) Execute(_ []string) error {
 	procmon.startMonitoring(ctx, cmd.cfg.EvictOnStart)
 	cmd.Debugf("started process monitor: %s", time.Since(procmonStart))
 
+	go security.WatchCertFiles(ctx, cmd.Logger, cmd.cfg.TransportConfig)
+	go security.WatchCRL(ctx, cmd.Logger, cmd.cfg.TransportConfig)
+
+	secCfg := &securityConfig{
+		transport:   cmd.cfg.TransportConfig,
+		credentials: cmd.cfg.CredentialConfig,
+	}
+	if mapFile := cmd.cfg.CredentialConfig.DelegationMapFile; mapFile != "" {
+		if secCfg.delegations, err = security.LoadDelegationMap(mapFile); err != nil {
+			return err
+		}
+		cmd.Noticef("credential delegation enabled for %d delegating users", len(secCfg.delegations))
+	}
+	secMod := NewSecurityModule(cmd.Logger, secCfg)
+
 	var clientMetricSource *promexp.ClientSource
 	if cmd.cfg.TelemetryExportEnabled() {
 		if ctx, clientMetricSource, err = promexp.NewClientSource(ctx); err != nil {
 			return errors.Wrap(err, "unable to create client metrics source")
 		}
 		telemetryStart := time.Now()
-		shutdown, err := startPrometheusExporter(ctx, cmd, clientMetricSource, cmd.cfg)
+		shutdown, err := startPrometheusExporter(ctx, cmd, clientMetricSource, secMod.credCache, cmd.cfg)
 		if err != nil {
 			return errors.Wrap(err, "unable to start prometheus exporter")
 		}
@@ -107,11 +125,7 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 
 	drpcRegStart := time.Now()
-	secCfg := &securityConfig{
-		transport:   cmd.cfg.TransportConfig,
-		credentials: cmd.cfg.CredentialConfig,
-	}
-	drpcServer.RegisterRPCModule(NewSecurityModule(cmd.Logger, secCfg))
+	drpcServer.RegisterRPCModule(secMod)
 	mgmtMod := &mgmtModule{
 		log:           cmd.Logger,
 		sys:           cmd.cfg.SystemName,
@@ -142,6 +156,24 @@ func (cmd *startCmd) Execute(_ []string) error {
 	}
 	cmd.Debugf("dRPC socket server started: %s", time.Since(drpcSrvStart))
 
+	if cmd.cfg.HealthProbePort > 0 {
+		shutdown, err := health.Start(cmd.Logger, cmd.cfg.HealthProbePort, &health.Config{
+			Liveness: []*health.Check{
+				{Name: "drpc", Fn: health.DrpcSocketCheck(sockPath)},
+			},
+			Readiness: []*health.Check{
+				{Name: "attach-info", Fn: func(ctx context.Context) error {
+					_, err := cache.GetAttachInfo(ctx, cmd.cfg.SystemName)
+					return err
+				}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		defer shutdown()
+	}
+
 	cmd.Debugf("startup complete in %s", time.Since(startedAt))
 	cmd.Infof("%s (pid %d) listening on %s", versionString(), os.Getpid(), sockPath)
 	if err := systemd.Ready(); err != nil && err != systemd.ErrSdNotifyNoSocket {
@@ -172,6 +204,7 @@ func (cmd *startCmd) Execute(_ []string) error {
 			case syscall.SIGUSR2:
 				cmd.Infof("Signal received. Caught %s; refreshing caches", sig)
 				mgmtMod.RefreshCache(ctx)
+				secMod.RefreshCache()
 			default:
 				shutdownRcvd = time.Now()
 				cmd.Infof("Signal received.  Caught %s; shutting down", sig)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 // (C) Copyright 2025 Google LLC
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
@@ -16,7 +17,48 @@ import (
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, cs *promexp.ClientSource, cfg *Config) (func(), error) {
+// credCacheCollector exports the statistics of the agent credential cache.
+type credCacheCollector struct {
+	credCache     *credentialCache
+	entries       *prometheus.Desc
+	hits          *prometheus.Desc
+	misses        *prometheus.Desc
+	invalidations *prometheus.Desc
+}
+
+func newCredCacheCollector(credCache *credentialCache) *credCacheCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("agent", "credential_cache", name), help, nil, nil)
+	}
+
+	return &credCacheCollector{
+		credCache:     credCache,
+		entries:       desc("entries", "Number of cached credentials."),
+		hits:          desc("hits_total", "Number of credential requests served from the cache."),
+		misses:        desc("misses_total", "Number of credential requests that generated a new credential."),
+		invalidations: desc("invalidations_total", "Number of cached credentials invalidated before expiring."),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *credCacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.entries
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.invalidations
+}
+
+// Collect implements prometheus.Collector.
+func (c *credCacheCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.credCache.Stats()
+
+	ch <- prometheus.MustNewConstMetric(c.entries, prometheus.GaugeValue, float64(stats.Entries))
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.invalidations, prometheus.CounterValue, float64(stats.Invalidations))
+}
+
+func startPrometheusExporter(ctx context.Context, log logging.Logger, cs *promexp.ClientSource, credCache *credentialCache, cfg *Config) (func(), error) {
 	expCfg := &promexp.ExporterConfig{
 		Port:  cfg.Telemetry.Port,
 		Title: "DAOS Client Telemetry",
@@ -29,6 +71,10 @@ func startPrometheusExporter(ctx context.Context, log logging.Logger, cs *promex
 			}
 			prometheus.MustRegister(c)
 
+			if credCache != nil {
+				prometheus.MustRegister(newCredCacheCollector(credCache))
+			}
+
 			return nil
 		},
 	}
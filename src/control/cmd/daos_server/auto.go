@@ -8,25 +8,35 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
 )
 
 // configCmd is the struct representing the top-level config subcommand.
 type configCmd struct {
-	Generate configGenCmd `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable locally-attached hardware devices"`
+	Generate   configGenCmd        `command:"generate" alias:"gen" description:"Generate DAOS server configuration file based on discoverable locally-attached hardware devices"`
+	Migrate    configMigrateCmd    `command:"migrate" description:"Print DAOS server configuration file upgraded to the current schema version"`
+	GenSystemd configGenSystemdCmd `command:"gen-systemd" description:"Print systemd drop-in for daos_server service that matches the engines in the configuration file"`
 }
 
 type configGenCmd struct {
@@ -34,7 +44,12 @@ type configGenCmd struct {
 	cmdutil.LogCmd
 	cmdutil.ConfGenCmd
 
-	SkipPrep bool `long:"skip-prep" description:"Skip preparation of devices during scan."`
+	SkipPrep    bool `long:"skip-prep" description:"Skip preparation of devices during scan."`
+	Interactive bool `short:"i" long:"interactive" description:"Prompt for generation constraints and review the rationale before accepting the generated config"`
+	Rationale   bool `long:"rationale" description:"Print the reasons for the choices made as comments at the top of the generated config"`
+
+	stdin     io.Reader
+	promptOut io.Writer
 }
 
 type getFabricFn func(context.Context, logging.Logger, string) (*control.HostFabric, error)
@@ -75,6 +90,8 @@ func getLocalStorage(ctx context.Context, log logging.Logger, skipPrep bool) (*c
 		return nil, errors.Wrap(err, "get hugepage info")
 	}
 
+	log.Info("Scan locally-attached NVMe storage...")
+
 	nvmeResp, errNvme := scanNVMe(snc, smi)
 	if errNvme != nil {
 		return nil, errors.Wrap(errNvme, "nvme scan")
@@ -108,27 +125,28 @@ func getLocalStorage(ctx context.Context, log logging.Logger, skipPrep bool) (*c
 	}, nil
 }
 
-func (cmd *configGenCmd) confGen(ctx context.Context, getFabric getFabricFn, getStorage getStorageFn) (*config.Server, error) {
-	cmd.Debugf("ConfGen called with command parameters %+v", cmd)
-
-	prov := cmd.NetProvider
+func (cmd *configGenCmd) fetchHardware(ctx context.Context, prov string, getFabric getFabricFn, getStorage getStorageFn) (*control.HostFabric, *control.HostStorage, error) {
 	if prov == allProviders {
 		prov = ""
 	}
 
 	hf, err := getFabric(ctx, cmd.Logger, prov)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	cmd.Debugf("fetched host fabric info on localhost: %+v", hf)
 
 	hs, err := getStorage(ctx, cmd.Logger, cmd.SkipPrep)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	cmd.Debugf("fetched host storage info on localhost: %+v", hs)
 
+	return hf, hs, nil
+}
+
+func (cmd *configGenCmd) generate(hf *control.HostFabric, hs *control.HostStorage) (*control.ConfGenerateResp, error) {
 	cmd.CheckDeprecated(cmd.Logger)
 
 	req := new(control.ConfGenerateReq)
@@ -157,25 +175,265 @@ func (cmd *configGenCmd) confGen(ctx context.Context, getFabric getFabricFn, get
 	}
 
 	cmd.Debugf("control API ConfGenerate resp: %+v", resp)
-	return &resp.Server, nil
+	return resp, nil
+}
+
+func (cmd *configGenCmd) confGen(ctx context.Context, getFabric getFabricFn, getStorage getStorageFn) (*control.ConfGenerateResp, error) {
+	cmd.Debugf("ConfGen called with command parameters %+v", cmd)
+
+	hf, hs, err := cmd.fetchHardware(ctx, cmd.NetProvider, getFabric, getStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd.generate(hf, hs)
+}
+
+// formatConfig returns the generated config file contents, preceded by the generation rationale
+// as comments if requested.
+func (cmd *configGenCmd) formatConfig(resp *control.ConfGenerateResp) (string, error) {
+	bytes, err := yaml.Marshal(&resp.Server)
+	if err != nil {
+		return "", err
+	}
+
+	var bld strings.Builder
+	if cmd.Rationale || cmd.Interactive {
+		for _, line := range resp.Rationale {
+			fmt.Fprintf(&bld, "# rationale: %s\n", line)
+		}
+	}
+	bld.Write(bytes)
+
+	return bld.String(), nil
 }
 
 func (cmd *configGenCmd) confGenPrint(ctx context.Context, getFabric getFabricFn, getStorage getStorageFn) error {
-	cfg, err := cmd.confGen(ctx, getFabric, getStorage)
+	if cmd.Interactive {
+		return cmd.confGenInteractive(ctx, getFabric, getStorage)
+	}
+
+	resp, err := cmd.confGen(ctx, getFabric, getStorage)
 	if err != nil {
 		return err
 	}
 
-	bytes, err := yaml.Marshal(cfg)
+	out, err := cmd.formatConfig(resp)
 	if err != nil {
 		return err
 	}
 
 	// Print generated config yaml file contents to stdout.
-	cmd.Info(string(bytes))
+	cmd.Info(out)
 	return nil
 }
 
+// confGenPrompter reads answers to config generate questions. Questions are written to stderr
+// so that stdout only contains the generated config.
+type confGenPrompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func (p *confGenPrompter) ask(question, current string) (string, error) {
+	fmt.Fprintf(p.out, "%s [%s]: ", question, current)
+
+	line, err := p.in.ReadString('\n')
+	if err == io.EOF && line == "" {
+		return "", errors.New("unexpected end of input")
+	} else if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if line = strings.TrimSpace(line); line == "" {
+		return current, nil
+	}
+	return line, nil
+}
+
+func (p *confGenPrompter) askInt(question string, current int) (int, error) {
+	for {
+		ans, err := p.ask(question, strconv.Itoa(current))
+		if err != nil {
+			return 0, err
+		}
+		if n, err := strconv.Atoi(ans); err == nil && n >= 0 {
+			return n, nil
+		}
+		fmt.Fprintf(p.out, "invalid value %q, enter a non-negative number\n", ans)
+	}
+}
+
+func (p *confGenPrompter) askBool(question string, current bool) (bool, error) {
+	curStr := "no"
+	if current {
+		curStr = "yes"
+	}
+
+	for {
+		ans, err := p.ask(question, curStr)
+		if err != nil {
+			return false, err
+		}
+		switch strings.ToLower(ans) {
+		case "yes", "y":
+			return true, nil
+		case "no", "n":
+			return false, nil
+		}
+		fmt.Fprintf(p.out, "invalid value %q, enter yes or no\n", ans)
+	}
+}
+
+// askList prompts for a comma separated list, "none" clears the current value.
+func (p *confGenPrompter) askList(question, current string) (string, error) {
+	curStr := current
+	if curStr == "" {
+		curStr = "none"
+	}
+
+	ans, err := p.ask(question, curStr)
+	if err != nil || ans == "none" {
+		return "", err
+	}
+	return ans, nil
+}
+
+// promptConstraints updates the command parameters that affect config generation from answers
+// read by the prompter.
+func (cmd *configGenCmd) promptConstraints(p *confGenPrompter) (err error) {
+	if cmd.NrEngines, err = p.askInt("Number of engines, 0 for one per NUMA node",
+		cmd.NrEngines); err != nil {
+		return
+	}
+
+	for {
+		if cmd.NetClass, err = p.ask("Network device class (ethernet or infiniband)",
+			cmd.NetClass); err != nil {
+			return
+		}
+		if cmd.NetClass == "ethernet" || cmd.NetClass == "infiniband" {
+			break
+		}
+		fmt.Fprintf(p.out, "invalid network device class %q\n", cmd.NetClass)
+		cmd.NetClass = "infiniband"
+	}
+
+	prov := cmd.NetProvider
+	if prov == "" {
+		prov = allProviders
+	}
+	if prov, err = p.ask("Fabric provider", prov); err != nil {
+		return
+	}
+	cmd.NetProvider = prov
+	if prov == allProviders {
+		cmd.NetProvider = ""
+	}
+
+	if cmd.SCMOnly, err = p.askBool("Generate SCM-only config without NVMe SSDs",
+		cmd.SCMOnly); err != nil {
+		return
+	}
+	if cmd.UseTmpfsSCM, err = p.askBool("Use tmpfs for SCM rather than PMem",
+		cmd.UseTmpfsSCM); err != nil {
+		return
+	}
+	if cmd.UseTmpfsSCM {
+		if cmd.ExtMetadataPath, err = p.askList("Control metadata path for MD-on-SSD",
+			cmd.ExtMetadataPath); err != nil {
+			return
+		}
+		cmd.BalancedSCM = false
+	} else {
+		cmd.ExtMetadataPath = ""
+		if cmd.BalancedSCM, err = p.askBool("Require one equally sized PMem namespace "+
+			"per engine", cmd.BalancedSCM); err != nil {
+			return
+		}
+	}
+
+	if cmd.MinTargets, err = p.askInt("Minimum number of targets per engine",
+		cmd.MinTargets); err != nil {
+		return
+	}
+	if cmd.SysCores, err = p.askInt("Cores per engine to reserve for system usage, 0 for "+
+		"default", cmd.SysCores); err != nil {
+		return
+	}
+	if !cmd.SCMOnly {
+		if cmd.ExcludedPCIAddrs, err = p.askList("SSD PCI addresses to exclude",
+			cmd.ExcludedPCIAddrs); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// confGenInteractive prompts for constraints and generates a config until the user accepts the
+// result. Hardware is only scanned once and interfaces for all providers are fetched so that the
+// provider can be changed between attempts.
+func (cmd *configGenCmd) confGenInteractive(ctx context.Context, getFabric getFabricFn, getStorage getStorageFn) error {
+	hf, hs, err := cmd.fetchHardware(ctx, allProviders, getFabric, getStorage)
+	if err != nil {
+		return err
+	}
+
+	p := &confGenPrompter{
+		in:  bufio.NewReader(os.Stdin),
+		out: os.Stderr,
+	}
+	if cmd.stdin != nil {
+		p.in = bufio.NewReader(cmd.stdin)
+	}
+	if cmd.promptOut != nil {
+		p.out = cmd.promptOut
+	}
+
+	fmt.Fprintln(p.out, "Enter constraints for config generation, press enter to keep the "+
+		"value in brackets.")
+	for {
+		if err := cmd.promptConstraints(p); err != nil {
+			return err
+		}
+
+		resp, genErr := cmd.generate(hf, hs)
+		if genErr != nil {
+			fmt.Fprintf(p.out, "Config generation failed: %s\n", genErr)
+			retry, err := p.askBool("Adjust constraints and try again", true)
+			if err != nil {
+				return err
+			}
+			if !retry {
+				return genErr
+			}
+			continue
+		}
+
+		fmt.Fprintln(p.out, "Config generated:")
+		for _, line := range resp.Rationale {
+			fmt.Fprintf(p.out, "  %s\n", line)
+		}
+		accept, err := p.askBool("Accept generated config", true)
+		if err != nil {
+			return err
+		}
+		if !accept {
+			continue
+		}
+
+		out, err := cmd.formatConfig(resp)
+		if err != nil {
+			return err
+		}
+
+		// Print generated config yaml file contents to stdout.
+		cmd.Info(out)
+		return nil
+	}
+}
+
 // Execute is run when configGenCmd activates.
 //
 // Attempt to auto generate a server config file with populated storage and network hardware
@@ -188,3 +446,107 @@ func (cmd *configGenCmd) Execute(_ []string) error {
 
 	return cmd.confGenPrint(cmd.MustLogCtx(), getLocalFabric, getLocalStorage)
 }
+
+type configMigrateCmd struct {
+	cmdutil.LogCmd
+	ConfigPath string `short:"o" long:"config" description:"Server config file path"`
+}
+
+// findConfigPath returns the supplied config file path or the default location if unset.
+func findConfigPath(path string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	return build.FindConfigFilePath(defaultConfigFile)
+}
+
+func (cmd *configMigrateCmd) migrate() (string, error) {
+	path, err := findConfigPath(cmd.ConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	in, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "reading %q", path)
+	}
+
+	out, changes, err := config.MigrateConfig(in)
+	if err != nil {
+		return "", errors.WithMessagef(err, "migration of %q failed", path)
+	}
+
+	var bld strings.Builder
+	if len(changes) == 0 {
+		fmt.Fprintf(&bld, "# %s is already at config version %d\n", path,
+			config.ConfigVersion)
+	}
+	for _, change := range changes {
+		fmt.Fprintf(&bld, "# migrated: %s\n", change)
+	}
+	bld.Write(out)
+
+	return bld.String(), nil
+}
+
+// Execute is run when configMigrateCmd activates.
+//
+// Read a server config file, upgrade any legacy parameters to the current schema and print the
+// result to stdout. The config file itself is left unmodified.
+func (cmd *configMigrateCmd) Execute(_ []string) error {
+	out, err := cmd.migrate()
+	if err != nil {
+		return err
+	}
+
+	cmd.Info(out)
+	return nil
+}
+
+type configGenSystemdCmd struct {
+	cmdutil.LogCmd
+	ConfigPath  string `short:"o" long:"config" description:"Server config file path"`
+	CPUAffinity bool   `long:"cpu-affinity" description:"Confine the service to the CPU cores planned for engines on this host"`
+}
+
+func (cmd *configGenSystemdCmd) genSystemd(ctx context.Context, topoProv hardware.TopologyProvider) (string, error) {
+	path, err := findConfigPath(cmd.ConfigPath)
+	if err != nil {
+		return "", err
+	}
+
+	cfg := config.DefaultServer()
+	if err := cfg.SetPath(path); err != nil {
+		return "", err
+	}
+	if err := cfg.Load(cmd.Logger); err != nil {
+		return "", errors.Wrapf(err, "failed to load config from %s", cfg.Path)
+	}
+
+	var allocs []*engine.CoreAllocation
+	if cmd.CPUAffinity {
+		topo, err := topoProv.GetTopology(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "fetching local hardware topology")
+		}
+		if allocs, err = engine.PlanCores(topo, cfg.Engines...); err != nil {
+			return "", errors.Wrap(err, "engine core allocation")
+		}
+	}
+
+	return cfg.SystemdDropIn(allocs)
+}
+
+// Execute is run when configGenSystemdCmd activates.
+//
+// Load the server config file and print a systemd drop-in for the daos_server service with
+// resource limits, unit dependencies and optionally CPU affinity derived from the engine configs.
+func (cmd *configGenSystemdCmd) Execute(_ []string) error {
+	out, err := cmd.genSystemd(cmd.MustLogCtx(), topology.DefaultProvider(cmd.Logger))
+	if err != nil {
+		return err
+	}
+
+	cmd.Info(out)
+	return nil
+}
@@ -149,6 +149,41 @@ func TestDaosServer_Auto_Commands(t *testing.T) {
 			}()),
 			nil,
 		},
+		{
+			"Generate with constraints; interactive",
+			"config generate -r foo --min-targets 8 --sys-cores 4 --exclude-pci " +
+				"0000:81:00.0 --balanced-scm --rationale -i",
+			printCommand(t, func() *configGenCmd {
+				cmd := &configGenCmd{}
+				cmd.MgmtSvcReplicas = "foo"
+				cmd.NetClass = "infiniband"
+				cmd.MinTargets = 8
+				cmd.SysCores = 4
+				cmd.ExcludedPCIAddrs = "0000:81:00.0"
+				cmd.BalancedSCM = true
+				cmd.Rationale = true
+				cmd.Interactive = true
+				return cmd
+			}()),
+			nil,
+		},
+		{
+			"Migrate config",
+			"config migrate -o /foo/daos_server.yml",
+			printCommand(t, &configMigrateCmd{
+				ConfigPath: "/foo/daos_server.yml",
+			}),
+			nil,
+		},
+		{
+			"Generate systemd drop-in",
+			"config gen-systemd -o /foo/daos_server.yml --cpu-affinity",
+			printCommand(t, &configGenSystemdCmd{
+				ConfigPath:  "/foo/daos_server.yml",
+				CPUAffinity: true,
+			}),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"network quack",
@@ -174,6 +209,11 @@ func TestDaosServer_Auto_confGenCmd_Convert(t *testing.T) {
 	cmd.UseTmpfsSCM = true
 	cmd.ExtMetadataPath = "/opt/daos_md"
 	cmd.FabricPorts = "12345,13345"
+	cmd.MinTargets = 8
+	cmd.SysCores = 4
+	cmd.ExcludedPCIAddrs = "0000:81:00.0,0000:82:00.0"
+	cmd.BalancedSCM = true
+	cmd.Interactive = true
 
 	req := new(control.ConfGenerateReq)
 	if err := convert.Types(cmd, req); err != nil {
@@ -181,14 +221,18 @@ func TestDaosServer_Auto_confGenCmd_Convert(t *testing.T) {
 	}
 
 	expReq := &control.ConfGenerateReq{
-		NrEngines:       1,
-		NetProvider:     "ofi+tcp",
-		SCMOnly:         true,
-		MgmtSvcReplicas: []string{"foo", "bar"},
-		NetClass:        hardware.Infiniband,
-		UseTmpfsSCM:     true,
-		ExtMetadataPath: "/opt/daos_md",
-		FabricPorts:     []int{12345, 13345},
+		NrEngines:        1,
+		NetProvider:      "ofi+tcp",
+		SCMOnly:          true,
+		MgmtSvcReplicas:  []string{"foo", "bar"},
+		NetClass:         hardware.Infiniband,
+		UseTmpfsSCM:      true,
+		ExtMetadataPath:  "/opt/daos_md",
+		FabricPorts:      []int{12345, 13345},
+		MinTargets:       8,
+		SysCores:         4,
+		ExcludedPCIAddrs: []string{"0000:81:00.0", "0000:82:00.0"},
+		BalancedSCM:      true,
 	}
 
 	if diff := cmp.Diff(expReq, req); diff != "" {
@@ -556,11 +600,12 @@ func TestDaosServer_Auto_confGen(t *testing.T) {
 				return
 			}
 
-			gotCfg, gotErr := cmd.confGen(test.Context(t), gf, gs)
+			gotResp, gotErr := cmd.confGen(test.Context(t), gf, gs)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
 			}
+			gotCfg := &gotResp.Server
 
 			cmpOpts := []cmp.Option{
 				cmp.Comparer(func(x, y *storage.BdevDeviceList) bool {
@@ -582,6 +627,112 @@ func TestDaosServer_Auto_confGen(t *testing.T) {
 	}
 }
 
+func TestDaosServer_Auto_confGenInteractive(t *testing.T) {
+	hf := &control.HostFabric{
+		Interfaces: []*control.HostFabricInterface{
+			{Provider: "ofi+psm2", Device: "ib0", NumaNode: 0, NetDevClass: 32, Priority: 0},
+			{Provider: "ofi+psm2", Device: "ib1", NumaNode: 1, NetDevClass: 32, Priority: 1},
+		},
+		NumaCount:    2,
+		CoresPerNuma: 26,
+	}
+	hs := &control.HostStorage{
+		ScmNamespaces: storage.ScmNamespaces{
+			storage.MockScmNamespace(0),
+			storage.MockScmNamespace(1),
+		},
+		SysMemInfo: defSysMemInfo(),
+		NvmeDevices: storage.NvmeControllers{
+			storage.MockNvmeController(1),
+			storage.MockNvmeController(2),
+			storage.MockNvmeController(3),
+			storage.MockNvmeController(4),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		input        string
+		expErr       error
+		expPrompts   []string
+		expOutPrefix string
+	}{
+		"no input": {
+			expErr: errors.New("unexpected end of input"),
+		},
+		"accept defaults": {
+			input:        strings.Repeat("\n", 10),
+			expOutPrefix: "# rationale: 2 engines, one per numa node",
+		},
+		"unmet constraint; retry": {
+			// First attempt requires 32 targets, second attempt clears the requirement.
+			input: "\n\n\n\n\n\n32\n\n\n" + "\n" +
+				"\n\n\n\n\n\n0\n\n\n" + "\n",
+			expPrompts: []string{
+				"Config generation failed: generated config has 18 targets per engine, " +
+					"fewer than the requested minimum 32",
+				"18 targets and 4 helper threads per engine",
+			},
+			expOutPrefix: "# rationale: 2 engines, one per numa node",
+		},
+		"invalid answers": {
+			input: "two\n2\nloopback\n\n\nmaybe\nno\n\n\n\n\n\n\n",
+			expPrompts: []string{
+				`invalid value "two", enter a non-negative number`,
+				`invalid network device class "loopback"`,
+				`invalid value "maybe", enter yes or no`,
+				"2 engines as requested",
+			},
+			expOutPrefix: "# rationale: 2 engines as requested",
+		},
+		"unbalanced scm; give up": {
+			input:  "\n\n\n\n\nyes\n\n\n\nno\n",
+			expErr: errors.New("scm is not balanced"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+			log.SetLevel(logging.LogLevelInfo)
+
+			var prompts strings.Builder
+			cmd := &configGenCmd{
+				Interactive: true,
+				stdin:       strings.NewReader(tc.input),
+				promptOut:   &prompts,
+			}
+			cmd.MgmtSvcReplicas = "localhost"
+			cmd.NetClass = "infiniband"
+			cmd.Logger = log
+
+			gf := func(_ context.Context, _ logging.Logger, prov string) (*control.HostFabric, error) {
+				if prov != "" {
+					return nil, errors.Errorf("unexpected provider filter %q", prov)
+				}
+				return hf, nil
+			}
+			gs := func(_ context.Context, _ logging.Logger, _ bool) (*control.HostStorage, error) {
+				return hs, nil
+			}
+
+			gotErr := cmd.confGenPrint(test.Context(t), gf, gs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, exp := range tc.expPrompts {
+				test.AssertTrue(t, strings.Contains(prompts.String(), exp),
+					fmt.Sprintf("expected %q in prompt output:\n%s", exp, prompts.String()))
+			}
+
+			outFirstLine := strings.Split(buf.String(), "\n")[0]
+			test.AssertTrue(t, strings.Contains(outFirstLine, tc.expOutPrefix),
+				fmt.Sprintf("expected %q in the first line of output: %q",
+					tc.expOutPrefix, outFirstLine))
+		})
+	}
+}
+
 // TestDaosServer_Auto_Commands_JSON verifies that the JSON-output flag is disabled for config
 // generate commands.
 func TestDaosServer_Auto_Commands_JSON(t *testing.T) {
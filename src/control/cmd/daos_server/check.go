@@ -0,0 +1,625 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
+	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	// certExpiryWarning is the remaining validity period below which a certificate is
+	// reported as about to expire.
+	certExpiryWarning = 30 * 24 * time.Hour
+	// minRecommendedNoFile is the lowest open file limit that is not reported as too
+	// low for the engines.
+	minRecommendedNoFile = 65536
+)
+
+// preflightStatus is the outcome of a preflight check.
+type preflightStatus string
+
+const (
+	preflightPass preflightStatus = "PASS"
+	preflightWarn preflightStatus = "WARN"
+	preflightFail preflightStatus = "FAIL"
+	preflightSkip preflightStatus = "SKIP"
+)
+
+// preflightResult describes the outcome of a preflight check and how to resolve any problem
+// that it found.
+type preflightResult struct {
+	Check       string          `json:"check"`
+	Status      preflightStatus `json:"status"`
+	Details     string          `json:"details"`
+	Remediation string          `json:"remediation,omitempty"`
+}
+
+func newPreflightResult(check string, status preflightStatus, details, remediation string) *preflightResult {
+	return &preflightResult{
+		Check:       check,
+		Status:      status,
+		Details:     details,
+		Remediation: remediation,
+	}
+}
+
+type (
+	getRlimitFn func(int, *syscall.Rlimit) error
+	adjtimexFn  func(*unix.Timex) (int, error)
+	ifAddrsFn   func(string) ([]net.Addr, error)
+)
+
+// preflightCmd validates that the host is ready to run the engines in the server config file
+// before daos_server is started for the first time.
+type preflightCmd struct {
+	cmdutil.JSONOutputCmd
+	cmdutil.LogCmd
+	cfgCmd
+
+	// Dependencies on the host, which may be replaced for testing.
+	sysRoot        string
+	getMemInfo     func() (*common.SysMemInfo, error)
+	isIOMMUEnabled iommuCheckFn
+	scanFabric     fabricScanFn
+	ifAddrs        ifAddrsFn
+	getRlimit      getRlimitFn
+	adjtimex       adjtimexFn
+	geteuid        func() int
+	now            func() time.Time
+}
+
+func lookupIFAddrs(name string) ([]net.Addr, error) {
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, err
+	}
+	return iface.Addrs()
+}
+
+func (cmd *preflightCmd) setDefaults() {
+	if cmd.sysRoot == "" {
+		cmd.sysRoot = "/"
+	}
+	if cmd.getMemInfo == nil {
+		cmd.getMemInfo = common.GetSysMemInfo
+	}
+	if cmd.isIOMMUEnabled == nil {
+		cmd.isIOMMUEnabled = topology.DefaultIOMMUDetector(cmd.Logger).IsIOMMUEnabled
+	}
+	if cmd.scanFabric == nil {
+		cmd.scanFabric = network.DefaultFabricScanner(cmd.Logger).Scan
+	}
+	if cmd.ifAddrs == nil {
+		cmd.ifAddrs = lookupIFAddrs
+	}
+	if cmd.getRlimit == nil {
+		cmd.getRlimit = syscall.Getrlimit
+	}
+	if cmd.adjtimex == nil {
+		cmd.adjtimex = unix.Adjtimex
+	}
+	if cmd.geteuid == nil {
+		cmd.geteuid = os.Geteuid
+	}
+	if cmd.now == nil {
+		cmd.now = time.Now
+	}
+}
+
+func (cmd *preflightCmd) hostPath(elem ...string) string {
+	return filepath.Join(append([]string{cmd.sysRoot}, elem...)...)
+}
+
+func (cmd *preflightCmd) checkConfig() *preflightResult {
+	const name = "config"
+
+	if err := cmd.config.Validate(cmd.Logger); err != nil {
+		return newPreflightResult(name, preflightFail, err.Error(),
+			fmt.Sprintf("correct the server config file %s", cmd.config.Path))
+	}
+	if len(cmd.config.Engines) == 0 {
+		return newPreflightResult(name, preflightWarn, "no engines configured",
+			"add engine sections to the server config file, e.g. with daos_server config generate")
+	}
+
+	return newPreflightResult(name, preflightPass,
+		fmt.Sprintf("%d engines configured in %s", len(cmd.config.Engines), cmd.config.Path), "")
+}
+
+func (cmd *preflightCmd) checkHugepages() *preflightResult {
+	const name = "hugepages"
+
+	if cmd.config.DisableHugepages {
+		return newPreflightResult(name, preflightSkip, "hugepages disabled in config", "")
+	}
+
+	smi, err := cmd.getMemInfo()
+	if err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("unable to read memory info: %s", err), "")
+	}
+	if smi.HugepageSizeKiB == 0 {
+		return newPreflightResult(name, preflightFail, "hugepages are not supported by the kernel",
+			"use a kernel with hugetlbfs support")
+	}
+
+	if err := cmd.config.SetNrHugepages(cmd.Logger, smi.HugepageSizeKiB); err != nil {
+		return newPreflightResult(name, preflightFail, err.Error(), "")
+	}
+	required := cmd.config.NrHugepages
+	pageSize := uint64(smi.HugepageSizeKiB) * humanize.KiByte
+
+	if smi.HugepagesTotal >= required {
+		return newPreflightResult(name, preflightPass,
+			fmt.Sprintf("%d of %d required hugepages allocated", smi.HugepagesTotal, required), "")
+	}
+
+	missing := uint64(required - smi.HugepagesTotal)
+	if missing*pageSize > uint64(smi.MemAvailableKiB)*humanize.KiByte {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("%d hugepages required but only %s of memory available to allocate them",
+				required, humanize.IBytes(uint64(smi.MemAvailableKiB)*humanize.KiByte)),
+			"reduce nr_hugepages or the engine target count, or free system memory")
+	}
+
+	return newPreflightResult(name, preflightWarn,
+		fmt.Sprintf("%d of %d required hugepages allocated, the rest will be allocated on start",
+			smi.HugepagesTotal, required),
+		fmt.Sprintf("allocate hugepages at boot to avoid fragmentation, e.g. set vm.nr_hugepages = %d "+
+			"in /etc/sysctl.d/", required))
+}
+
+func (cmd *preflightCmd) checkIOMMU() *preflightResult {
+	const name = "iommu/vfio"
+
+	if !cmd.config.GetBdevConfigs().HaveRealNVMe() {
+		return newPreflightResult(name, preflightSkip, "no NVMe SSDs configured", "")
+	}
+
+	iommuEnabled, err := cmd.isIOMMUEnabled()
+	if err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("unable to detect IOMMU: %s", err), "")
+	}
+	privileged := cmd.geteuid() == 0
+
+	switch {
+	case cmd.config.DisableVFIO && !privileged:
+		return newPreflightResult(name, preflightFail,
+			"disable_vfio is set while running as non-root user",
+			"set disable_vfio: false or run daos_server as root")
+	case cmd.config.DisableVFIO:
+		return newPreflightResult(name, preflightWarn, "VFIO disabled in config, UIO will be used",
+			"set disable_vfio: false so that daos_server can run as non-root user")
+	case !iommuEnabled && !privileged:
+		return newPreflightResult(name, preflightFail,
+			"no IOMMU detected while running as non-root user",
+			"enable VT-d or AMD-Vi in the BIOS and add intel_iommu=on or amd_iommu=on to the "+
+				"kernel command line")
+	case !iommuEnabled:
+		return newPreflightResult(name, preflightWarn,
+			"no IOMMU detected, NVMe SSDs can only be used by root and VMD is disabled",
+			"enable VT-d or AMD-Vi in the BIOS and add intel_iommu=on or amd_iommu=on to the "+
+				"kernel command line")
+	}
+
+	if _, err := os.Stat(cmd.hostPath("dev", "vfio", "vfio")); err != nil {
+		return newPreflightResult(name, preflightFail, "IOMMU enabled but VFIO is not available",
+			"load the vfio-pci kernel module")
+	}
+
+	return newPreflightResult(name, preflightPass, "IOMMU enabled and VFIO available", "")
+}
+
+// readSysfsAttr reads an attribute of the device backing a block device.
+func (cmd *preflightCmd) readSysfsAttr(blockDev, attr string) (string, error) {
+	data, err := os.ReadFile(cmd.hostPath("sys", "block", filepath.Base(blockDev), "device", attr))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (cmd *preflightCmd) checkPMem() []*preflightResult {
+	const name = "pmem"
+	const remediation = "run daos_server scm prepare to create PMem namespaces in fsdax mode"
+
+	var results []*preflightResult
+	for _, ec := range cmd.config.Engines {
+		check := fmt.Sprintf("%s (engine %d)", name, ec.Index)
+		for _, sc := range ec.Storage.Tiers.ScmConfigs() {
+			if sc.Class != storage.ClassDcpm {
+				continue
+			}
+
+			var problems []string
+			for _, dev := range sc.Scm.DeviceList {
+				if _, err := os.Stat(cmd.hostPath(dev)); err != nil {
+					problems = append(problems, fmt.Sprintf("%s not found", dev))
+					continue
+				}
+				mode, err := cmd.readSysfsAttr(dev, "mode")
+				if err != nil {
+					problems = append(problems, fmt.Sprintf("%s: unable to read mode: %s", dev, err))
+					continue
+				}
+				if mode != "fsdax" {
+					problems = append(problems, fmt.Sprintf("%s is in %s mode", dev, mode))
+				}
+			}
+
+			if len(problems) > 0 {
+				results = append(results, newPreflightResult(check, preflightFail,
+					strings.Join(problems, "; "), remediation))
+				continue
+			}
+			results = append(results, newPreflightResult(check, preflightPass,
+				fmt.Sprintf("%s in fsdax mode", strings.Join(sc.Scm.DeviceList, ",")), ""))
+		}
+	}
+
+	if len(results) == 0 {
+		return []*preflightResult{newPreflightResult(name, preflightSkip, "no PMem configured", "")}
+	}
+	return results
+}
+
+// checkFabric verifies the fabric interface of each engine and returns the NUMA node of each
+// interface found, to be used for the NUMA alignment check.
+func (cmd *preflightCmd) checkFabric(ctx context.Context) ([]*preflightResult, map[uint32]uint) {
+	const name = "fabric"
+
+	ifaceNUMA := make(map[uint32]uint)
+	if len(cmd.config.Engines) == 0 {
+		return []*preflightResult{newPreflightResult(name, preflightSkip, "no engines configured", "")}, ifaceNUMA
+	}
+
+	provider, err := cmd.config.Fabric.GetPrimaryProvider()
+	if err != nil {
+		return []*preflightResult{newPreflightResult(name, preflightFail, err.Error(),
+			"set provider in the server config file")}, ifaceNUMA
+	}
+	scanHint := fmt.Sprintf("run daos_server network scan -p %s and set fabric_iface to a listed "+
+		"interface, or install the libfabric provider", provider)
+
+	fis, err := cmd.scanFabric(ctx, provider)
+	if err != nil {
+		return []*preflightResult{newPreflightResult(name, preflightFail,
+			fmt.Sprintf("fabric scan failed: %s", err), "")}, ifaceNUMA
+	}
+
+	var results []*preflightResult
+	for _, ec := range cmd.config.Engines {
+		check := fmt.Sprintf("%s (engine %d)", name, ec.Index)
+
+		iface, err := ec.Fabric.GetPrimaryInterface()
+		if err != nil {
+			results = append(results, newPreflightResult(check, preflightFail, err.Error(),
+				"set fabric_iface in the engine section of the server config file"))
+			continue
+		}
+
+		fi, err := fis.GetInterfaceOnNetDevice(iface, provider)
+		if err != nil {
+			results = append(results, newPreflightResult(check, preflightFail,
+				fmt.Sprintf("provider %s not available on %s", provider, iface), scanHint))
+			continue
+		}
+		ifaceNUMA[ec.Index] = fi.NUMANode
+
+		addrs, err := cmd.ifAddrs(iface)
+		if err != nil || len(addrs) == 0 {
+			results = append(results, newPreflightResult(check, preflightFail,
+				fmt.Sprintf("no network addresses for interface %s", iface),
+				fmt.Sprintf("configure an IP address on %s", iface)))
+			continue
+		}
+
+		results = append(results, newPreflightResult(check, preflightPass,
+			fmt.Sprintf("%s available on %s", provider, iface), ""))
+	}
+
+	return results, ifaceNUMA
+}
+
+func (cmd *preflightCmd) checkNUMA(ifaceNUMA map[uint32]uint) []*preflightResult {
+	const name = "numa"
+
+	var results []*preflightResult
+	for _, ec := range cmd.config.Engines {
+		check := fmt.Sprintf("%s (engine %d)", name, ec.Index)
+
+		var node uint
+		var source string
+		ifNode, haveIF := ifaceNUMA[ec.Index]
+		switch {
+		case ec.PinnedNumaNode != nil:
+			node, source = *ec.PinnedNumaNode, "pinned_numa_node"
+		case haveIF:
+			node, source = ifNode, "fabric interface"
+		default:
+			results = append(results, newPreflightResult(check, preflightSkip,
+				"NUMA affinity unknown", ""))
+			continue
+		}
+
+		var problems []string
+		if haveIF && ifNode != node {
+			problems = append(problems, fmt.Sprintf("fabric interface on NUMA node %d", ifNode))
+		}
+		for _, sc := range ec.Storage.Tiers.ScmConfigs() {
+			if sc.Class != storage.ClassDcpm {
+				continue
+			}
+			for _, dev := range sc.Scm.DeviceList {
+				attr, err := cmd.readSysfsAttr(dev, "numa_node")
+				if err != nil {
+					continue
+				}
+				var devNode int
+				if _, err := fmt.Sscanf(attr, "%d", &devNode); err != nil || devNode < 0 {
+					continue
+				}
+				if uint(devNode) != node {
+					problems = append(problems, fmt.Sprintf("%s on NUMA node %d", dev, devNode))
+				}
+			}
+		}
+
+		if len(problems) > 0 {
+			results = append(results, newPreflightResult(check, preflightFail,
+				fmt.Sprintf("engine on NUMA node %d (%s) but %s", node, source,
+					strings.Join(problems, ", ")),
+				"assign the fabric interface and PMem namespaces attached to the same NUMA "+
+					"node to each engine"))
+			continue
+		}
+		results = append(results, newPreflightResult(check, preflightPass,
+			fmt.Sprintf("devices on NUMA node %d", node), ""))
+	}
+
+	if len(results) == 0 {
+		return []*preflightResult{newPreflightResult(name, preflightSkip, "no engines configured", "")}
+	}
+	return results
+}
+
+func (cmd *preflightCmd) checkRlimits() *preflightResult {
+	const name = "ulimits"
+
+	var memlock, nofile syscall.Rlimit
+	if err := cmd.getRlimit(unix.RLIMIT_MEMLOCK, &memlock); err != nil {
+		return newPreflightResult(name, preflightFail, fmt.Sprintf("reading memlock limit: %s", err), "")
+	}
+	if err := cmd.getRlimit(unix.RLIMIT_NOFILE, &nofile); err != nil {
+		return newPreflightResult(name, preflightFail, fmt.Sprintf("reading nofile limit: %s", err), "")
+	}
+
+	privileged := cmd.geteuid() == 0
+	for _, ec := range cmd.config.Engines {
+		if _, err := ec.CheckRlimits(privileged); err != nil {
+			return newPreflightResult(name, preflightFail, err.Error(),
+				"lower the engine rlimits in the server config file or raise the hard limits "+
+					"of daos_server")
+		}
+	}
+
+	var problems []string
+	if engine.Rlimit(memlock.Cur) != engine.RlimitUnlimited {
+		problems = append(problems, fmt.Sprintf("memlock %s is not unlimited",
+			engine.Rlimit(memlock.Cur)))
+	}
+	if nofile.Cur < minRecommendedNoFile {
+		problems = append(problems, fmt.Sprintf("nofile %d is below %d", nofile.Cur,
+			minRecommendedNoFile))
+	}
+	if len(problems) > 0 {
+		return newPreflightResult(name, preflightWarn, strings.Join(problems, ", "),
+			"set LimitMEMLOCK=infinity and LimitNOFILE=infinity as in the daos_server systemd "+
+				"unit, or configure rlimits for each engine")
+	}
+
+	return newPreflightResult(name, preflightPass,
+		fmt.Sprintf("memlock %s, nofile %s", engine.Rlimit(memlock.Cur), engine.Rlimit(nofile.Cur)), "")
+}
+
+func (cmd *preflightCmd) checkTimeSync() *preflightResult {
+	const name = "time sync"
+
+	var tx unix.Timex
+	state, err := cmd.adjtimex(&tx)
+	if err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("unable to read kernel clock state: %s", err), "")
+	}
+	if state == unix.TIME_ERROR || tx.Status&unix.STA_UNSYNC != 0 {
+		return newPreflightResult(name, preflightFail, "system clock is not synchronized",
+			"enable NTP synchronization, e.g. with chronyd, on all servers and clients")
+	}
+
+	return newPreflightResult(name, preflightPass,
+		fmt.Sprintf("system clock synchronized (max error %dus)", tx.Maxerror), "")
+}
+
+// checkCertExpiry reports whether a certificate is, or is about to become, invalid.
+func (cmd *preflightCmd) checkCertExpiry(desc string, cert *x509.Certificate) (preflightStatus, string) {
+	now := cmd.now()
+	switch {
+	case now.Before(cert.NotBefore):
+		return preflightFail, fmt.Sprintf("%s not valid until %s", desc, cert.NotBefore.Format(time.RFC3339))
+	case now.After(cert.NotAfter):
+		return preflightFail, fmt.Sprintf("%s expired on %s", desc, cert.NotAfter.Format(time.RFC3339))
+	case cert.NotAfter.Sub(now) < certExpiryWarning:
+		return preflightWarn, fmt.Sprintf("%s expires on %s", desc, cert.NotAfter.Format(time.RFC3339))
+	}
+	return preflightPass, ""
+}
+
+func (cmd *preflightCmd) checkCertificates() *preflightResult {
+	const name = "certificates"
+	const remediation = "generate new certificates with daos_server security gen-certs"
+
+	tc := cmd.config.TransportConfig
+	if tc == nil || tc.AllowInsecure {
+		return newPreflightResult(name, preflightSkip, "transport security disabled", "")
+	}
+
+	caCert, err := security.LoadCertificate(tc.CARootPath)
+	if err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("loading CA certificate: %s", err), remediation)
+	}
+	cert, err := security.LoadCertificate(tc.CertificatePath)
+	if err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("loading server certificate: %s", err), remediation)
+	}
+	if _, err := security.LoadPrivateKey(tc.PrivateKeyPath); err != nil {
+		return newPreflightResult(name, preflightFail,
+			fmt.Sprintf("loading server key: %s", err), remediation)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: cmd.now(),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		status, details := cmd.checkCertExpiry("server certificate", cert)
+		if status != preflightFail {
+			details = fmt.Sprintf("server certificate not signed by CA: %s", err)
+		}
+		return newPreflightResult(name, preflightFail, details, remediation)
+	}
+
+	worst := preflightPass
+	var problems []string
+	for _, c := range []struct {
+		desc string
+		cert *x509.Certificate
+	}{
+		{"CA certificate", caCert},
+		{"server certificate", cert},
+	} {
+		status, details := cmd.checkCertExpiry(c.desc, c.cert)
+		if status == preflightPass {
+			continue
+		}
+		if status == preflightFail {
+			worst = preflightFail
+		} else if worst == preflightPass {
+			worst = status
+		}
+		problems = append(problems, details)
+	}
+	if len(problems) > 0 {
+		return newPreflightResult(name, worst, strings.Join(problems, "; "), remediation)
+	}
+
+	return newPreflightResult(name, preflightPass,
+		fmt.Sprintf("server certificate valid until %s", cert.NotAfter.Format(time.RFC3339)), "")
+}
+
+// runChecks runs all of the preflight checks in order.
+func (cmd *preflightCmd) runChecks(ctx context.Context) []*preflightResult {
+	results := []*preflightResult{cmd.checkConfig()}
+	if results[0].Status == preflightFail {
+		// The remaining checks depend on a valid config.
+		return results
+	}
+
+	results = append(results, cmd.checkHugepages(), cmd.checkIOMMU())
+	results = append(results, cmd.checkPMem()...)
+
+	fabricResults, ifaceNUMA := cmd.checkFabric(ctx)
+	results = append(results, fabricResults...)
+	results = append(results, cmd.checkNUMA(ifaceNUMA)...)
+
+	return append(results, cmd.checkRlimits(), cmd.checkTimeSync(), cmd.checkCertificates())
+}
+
+func printPreflightResults(out io.Writer, results []*preflightResult) error {
+	checkTitle := "Check"
+	statusTitle := "Status"
+	detailsTitle := "Details"
+
+	var table []txtfmt.TableRow
+	var hints []string
+	for _, res := range results {
+		table = append(table, txtfmt.TableRow{
+			checkTitle:   res.Check,
+			statusTitle:  string(res.Status),
+			detailsTitle: res.Details,
+		})
+		if res.Remediation != "" && (res.Status == preflightFail || res.Status == preflightWarn) {
+			hints = append(hints, fmt.Sprintf("  %s: %s", res.Check, res.Remediation))
+		}
+	}
+
+	ew := txtfmt.NewErrWriter(out)
+	tf := txtfmt.NewTableFormatter(checkTitle, statusTitle, detailsTitle)
+	tf.InitWriter(ew)
+	tf.Format(table)
+
+	if len(hints) > 0 {
+		fmt.Fprintf(ew, "\nRemediation:\n%s\n", strings.Join(hints, "\n"))
+	}
+
+	return ew.Err
+}
+
+func (cmd *preflightCmd) Execute(_ []string) error {
+	cmd.setDefaults()
+
+	results := cmd.runChecks(cmd.MustLogCtx())
+
+	var failed int
+	for _, res := range results {
+		if res.Status == preflightFail {
+			failed++
+		}
+	}
+	var err error
+	if failed > 0 {
+		err = errors.Errorf("%d of %d preflight checks failed", failed, len(results))
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(results, err)
+	}
+
+	var bld strings.Builder
+	if perr := printPreflightResults(&bld, results); perr != nil {
+		return perr
+	}
+	cmd.Info(bld.String())
+
+	return err
+}
@@ -0,0 +1,546 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func newTestPreflightCmd(t *testing.T, log logging.Logger, cfg *config.Server) *preflightCmd {
+	t.Helper()
+
+	cmd := &preflightCmd{
+		geteuid: func() int { return 1000 },
+	}
+	cmd.Logger = log
+	cmd.config = cfg
+
+	return cmd
+}
+
+func mockPMemEngine(idx uint32, devs ...string) *engine.Config {
+	return engine.MockConfig().
+		WithIndex(idx).
+		WithStorage(
+			storage.NewTierConfig().
+				WithStorageClass(storage.ClassDcpm.String()).
+				WithScmMountPoint("/mnt/daos").
+				WithScmDeviceList(devs...),
+		)
+}
+
+// writeSysBlockAttr creates a block device and a sysfs attribute of its device under the root.
+func writeSysBlockAttr(t *testing.T, root, dev, attr, value string) {
+	t.Helper()
+
+	devPath := filepath.Join(root, "dev", dev)
+	attrPath := filepath.Join(root, "sys", "block", dev, "device", attr)
+	for _, path := range []string{devPath, attrPath} {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(devPath, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(attrPath, []byte(value+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDaosServer_preflightCmd_checkHugepages(t *testing.T) {
+	mockMemInfo := func(total, availKiB int) *common.SysMemInfo {
+		return &common.SysMemInfo{
+			MemInfo: common.MemInfo{
+				HugepagesTotal:  total,
+				HugepageSizeKiB: 2048,
+				MemAvailableKiB: availKiB,
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		cfg       *config.Server
+		memInfo   *common.SysMemInfo
+		memErr    error
+		expStatus preflightStatus
+		expDetail string
+	}{
+		"hugepages disabled": {
+			cfg:       config.DefaultServer().WithDisableHugepages(true),
+			expStatus: preflightSkip,
+		},
+		"meminfo fails": {
+			cfg:       config.DefaultServer(),
+			memErr:    errors.New("no meminfo"),
+			expStatus: preflightFail,
+			expDetail: "no meminfo",
+		},
+		"not supported": {
+			cfg:       config.DefaultServer(),
+			memInfo:   &common.SysMemInfo{},
+			expStatus: preflightFail,
+			expDetail: "not supported",
+		},
+		"allocated": {
+			cfg:       config.DefaultServer().WithNrHugepages(1024),
+			memInfo:   mockMemInfo(1024, 0),
+			expStatus: preflightPass,
+			expDetail: "1024 of 1024",
+		},
+		"allocated on start": {
+			cfg:       config.DefaultServer().WithNrHugepages(1024),
+			memInfo:   mockMemInfo(512, 4*1024*1024),
+			expStatus: preflightWarn,
+			expDetail: "512 of 1024",
+		},
+		"insufficient memory": {
+			cfg:       config.DefaultServer().WithNrHugepages(1024),
+			memInfo:   mockMemInfo(0, 1024),
+			expStatus: preflightFail,
+			expDetail: "1024 hugepages required",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestPreflightCmd(t, log, tc.cfg)
+			cmd.getMemInfo = func() (*common.SysMemInfo, error) {
+				return tc.memInfo, tc.memErr
+			}
+
+			res := cmd.checkHugepages()
+
+			test.AssertEqual(t, tc.expStatus, res.Status, res.Details)
+			test.AssertTrue(t, strings.Contains(res.Details, tc.expDetail),
+				"unexpected details: "+res.Details)
+		})
+	}
+}
+
+func TestDaosServer_preflightCmd_checkIOMMU(t *testing.T) {
+	nvmeCfg := func() *config.Server {
+		return config.DefaultServer().WithEngines(
+			engine.MockConfig().WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(test.MockPCIAddr(1)),
+			),
+		)
+	}
+
+	for name, tc := range map[string]struct {
+		cfg       *config.Server
+		iommu     bool
+		euid      int
+		vfio      bool
+		expStatus preflightStatus
+	}{
+		"no nvme": {
+			cfg:       config.DefaultServer(),
+			expStatus: preflightSkip,
+		},
+		"vfio disabled; non-root": {
+			cfg:       nvmeCfg().WithDisableVFIO(true),
+			iommu:     true,
+			euid:      1000,
+			expStatus: preflightFail,
+		},
+		"vfio disabled; root": {
+			cfg:       nvmeCfg().WithDisableVFIO(true),
+			iommu:     true,
+			expStatus: preflightWarn,
+		},
+		"iommu disabled; non-root": {
+			cfg:       nvmeCfg(),
+			euid:      1000,
+			expStatus: preflightFail,
+		},
+		"iommu disabled; root": {
+			cfg:       nvmeCfg(),
+			expStatus: preflightWarn,
+		},
+		"vfio device missing": {
+			cfg:       nvmeCfg(),
+			iommu:     true,
+			euid:      1000,
+			expStatus: preflightFail,
+		},
+		"iommu and vfio available": {
+			cfg:       nvmeCfg(),
+			iommu:     true,
+			euid:      1000,
+			vfio:      true,
+			expStatus: preflightPass,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+			if tc.vfio {
+				vfioPath := filepath.Join(tmpDir, "dev", "vfio", "vfio")
+				if err := os.MkdirAll(filepath.Dir(vfioPath), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(vfioPath, nil, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			cmd := newTestPreflightCmd(t, log, tc.cfg)
+			cmd.sysRoot = tmpDir
+			cmd.isIOMMUEnabled = func() (bool, error) { return tc.iommu, nil }
+			cmd.geteuid = func() int { return tc.euid }
+
+			res := cmd.checkIOMMU()
+
+			test.AssertEqual(t, tc.expStatus, res.Status, res.Details)
+		})
+	}
+}
+
+func TestDaosServer_preflightCmd_checkPMem(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	writeSysBlockAttr(t, tmpDir, "pmem0", "mode", "fsdax")
+	writeSysBlockAttr(t, tmpDir, "pmem1", "mode", "devdax")
+
+	cmd := newTestPreflightCmd(t, log, config.DefaultServer().WithEngines(
+		mockPMemEngine(0, "/dev/pmem0"),
+		mockPMemEngine(1, "/dev/pmem1", "/dev/pmem2"),
+	))
+	cmd.sysRoot = tmpDir
+
+	expResults := []*preflightResult{
+		newPreflightResult("pmem (engine 0)", preflightPass, "/dev/pmem0 in fsdax mode", ""),
+		newPreflightResult("pmem (engine 1)", preflightFail,
+			"/dev/pmem1 is in devdax mode; /dev/pmem2 not found",
+			"run daos_server scm prepare to create PMem namespaces in fsdax mode"),
+	}
+	if diff := cmp.Diff(expResults, cmd.checkPMem()); diff != "" {
+		t.Fatalf("unexpected results (-want, +got):\n%s\n", diff)
+	}
+
+	cmd.config = config.DefaultServer()
+	test.AssertEqual(t, preflightSkip, cmd.checkPMem()[0].Status, "")
+}
+
+func TestDaosServer_preflightCmd_checkFabricNUMA(t *testing.T) {
+	fis := hardware.NewFabricInterfaceSet(
+		&hardware.FabricInterface{
+			Name:          "eth0",
+			NetInterfaces: common.NewStringSet("eth0"),
+			Providers:     hardware.NewFabricProviderSet(&hardware.FabricProvider{Name: "ofi+tcp"}),
+			NUMANode:      0,
+		},
+		&hardware.FabricInterface{
+			Name:          "eth1",
+			NetInterfaces: common.NewStringSet("eth1"),
+			Providers:     hardware.NewFabricProviderSet(&hardware.FabricProvider{Name: "ofi+tcp"}),
+			NUMANode:      1,
+		},
+	)
+
+	for name, tc := range map[string]struct {
+		engines    []*engine.Config
+		noAddrs    bool
+		expFabric  []preflightStatus
+		expNUMA    []preflightStatus
+		expDetails string
+	}{
+		"aligned": {
+			engines: []*engine.Config{
+				mockPMemEngine(0, "/dev/pmem0").WithFabricInterface("eth0"),
+				mockPMemEngine(1, "/dev/pmem1").WithFabricInterface("eth1"),
+			},
+			expFabric: []preflightStatus{preflightPass, preflightPass},
+			expNUMA:   []preflightStatus{preflightPass, preflightPass},
+		},
+		"interface not found": {
+			engines: []*engine.Config{
+				mockPMemEngine(0, "/dev/pmem0").WithFabricInterface("ib0"),
+			},
+			expFabric: []preflightStatus{preflightFail},
+			expNUMA:   []preflightStatus{preflightSkip},
+		},
+		"no addresses": {
+			engines: []*engine.Config{
+				mockPMemEngine(0, "/dev/pmem0").WithFabricInterface("eth0"),
+			},
+			noAddrs:   true,
+			expFabric: []preflightStatus{preflightFail},
+			expNUMA:   []preflightStatus{preflightPass},
+		},
+		"pmem on wrong node": {
+			engines: []*engine.Config{
+				mockPMemEngine(0, "/dev/pmem1").WithFabricInterface("eth0"),
+			},
+			expFabric:  []preflightStatus{preflightPass},
+			expNUMA:    []preflightStatus{preflightFail},
+			expDetails: "/dev/pmem1 on NUMA node 1",
+		},
+		"pinned to wrong node": {
+			engines: []*engine.Config{
+				mockPMemEngine(0, "/dev/pmem0").WithFabricInterface("eth0").
+					WithPinnedNumaNode(1),
+			},
+			expFabric:  []preflightStatus{preflightPass},
+			expNUMA:    []preflightStatus{preflightFail},
+			expDetails: "fabric interface on NUMA node 0, /dev/pmem0 on NUMA node 0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+			writeSysBlockAttr(t, tmpDir, "pmem0", "numa_node", "0")
+			writeSysBlockAttr(t, tmpDir, "pmem1", "numa_node", "1")
+
+			cfg := config.DefaultServer().WithFabricProvider("ofi+tcp").WithEngines(tc.engines...)
+			cmd := newTestPreflightCmd(t, log, cfg)
+			cmd.sysRoot = tmpDir
+			cmd.scanFabric = func(context.Context, ...string) (*hardware.FabricInterfaceSet, error) {
+				return fis, nil
+			}
+			cmd.ifAddrs = func(string) ([]net.Addr, error) {
+				if tc.noAddrs {
+					return nil, nil
+				}
+				return []net.Addr{&net.IPNet{IP: net.IPv4(10, 0, 0, 1)}}, nil
+			}
+
+			fabricResults, ifaceNUMA := cmd.checkFabric(test.Context(t))
+			numaResults := cmd.checkNUMA(ifaceNUMA)
+
+			statuses := func(results []*preflightResult) (s []preflightStatus) {
+				for _, res := range results {
+					s = append(s, res.Status)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.expFabric, statuses(fabricResults)); diff != "" {
+				t.Fatalf("unexpected fabric results (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expNUMA, statuses(numaResults)); diff != "" {
+				t.Fatalf("unexpected numa results (-want, +got):\n%s\n", diff)
+			}
+			for _, res := range numaResults {
+				test.AssertTrue(t, strings.Contains(res.Details, tc.expDetails),
+					"unexpected details: "+res.Details)
+			}
+		})
+	}
+}
+
+func TestDaosServer_preflightCmd_checkRlimits(t *testing.T) {
+	for name, tc := range map[string]struct {
+		memlock   uint64
+		nofile    uint64
+		expStatus preflightStatus
+	}{
+		"unlimited": {
+			memlock:   uint64(engine.RlimitUnlimited),
+			nofile:    1048576,
+			expStatus: preflightPass,
+		},
+		"memlock limited": {
+			memlock:   65536,
+			nofile:    1048576,
+			expStatus: preflightWarn,
+		},
+		"nofile too low": {
+			memlock:   uint64(engine.RlimitUnlimited),
+			nofile:    1024,
+			expStatus: preflightWarn,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestPreflightCmd(t, log, config.DefaultServer())
+			cmd.getRlimit = func(resource int, rl *syscall.Rlimit) error {
+				switch resource {
+				case unix.RLIMIT_MEMLOCK:
+					rl.Cur, rl.Max = tc.memlock, tc.memlock
+				case unix.RLIMIT_NOFILE:
+					rl.Cur, rl.Max = tc.nofile, tc.nofile
+				}
+				return nil
+			}
+
+			res := cmd.checkRlimits()
+
+			test.AssertEqual(t, tc.expStatus, res.Status, res.Details)
+		})
+	}
+}
+
+func TestDaosServer_preflightCmd_checkTimeSync(t *testing.T) {
+	for name, tc := range map[string]struct {
+		state     int
+		status    int32
+		err       error
+		expStatus preflightStatus
+	}{
+		"synchronized": {
+			state:     unix.TIME_OK,
+			expStatus: preflightPass,
+		},
+		"unsynchronized": {
+			state:     unix.TIME_ERROR,
+			status:    unix.STA_UNSYNC,
+			expStatus: preflightFail,
+		},
+		"adjtimex fails": {
+			err:       errors.New("EPERM"),
+			expStatus: preflightFail,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cmd := newTestPreflightCmd(t, log, config.DefaultServer())
+			cmd.adjtimex = func(tx *unix.Timex) (int, error) {
+				tx.Status = tc.status
+				return tc.state, tc.err
+			}
+
+			res := cmd.checkTimeSync()
+
+			test.AssertEqual(t, tc.expStatus, res.Status, res.Details)
+		})
+	}
+}
+
+func TestDaosServer_preflightCmd_checkCertificates(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	validity := 365 * 24 * time.Hour
+	gen, err := security.GenerateCerts(&security.CertGenConfig{
+		Dir:      tmpDir,
+		Validity: validity,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		insecure  bool
+		keyPath   string
+		now       time.Time
+		expStatus preflightStatus
+		expDetail string
+	}{
+		"insecure": {
+			insecure:  true,
+			expStatus: preflightSkip,
+		},
+		"valid": {
+			now:       now,
+			expStatus: preflightPass,
+		},
+		"missing key": {
+			keyPath:   filepath.Join(tmpDir, "missing.key"),
+			now:       now,
+			expStatus: preflightFail,
+			expDetail: "loading server key",
+		},
+		"expiring soon": {
+			now:       now.Add(validity - 7*24*time.Hour),
+			expStatus: preflightWarn,
+			expDetail: "server certificate expires on",
+		},
+		"expired": {
+			now:       now.Add(validity + 24*time.Hour),
+			expStatus: preflightFail,
+			expDetail: "server certificate expired on",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer()
+			cfg.TransportConfig.AllowInsecure = tc.insecure
+			cfg.TransportConfig.CARootPath = gen.CACert
+			cfg.TransportConfig.CertificatePath = gen.Server.Cert
+			cfg.TransportConfig.PrivateKeyPath = gen.Server.Key
+			if tc.keyPath != "" {
+				cfg.TransportConfig.PrivateKeyPath = tc.keyPath
+			}
+
+			cmd := newTestPreflightCmd(t, log, cfg)
+			cmd.now = func() time.Time { return tc.now }
+
+			res := cmd.checkCertificates()
+
+			test.AssertEqual(t, tc.expStatus, res.Status, res.Details)
+			test.AssertTrue(t, strings.Contains(res.Details, tc.expDetail),
+				"unexpected details: "+res.Details)
+		})
+	}
+}
+
+func TestDaosServer_printPreflightResults(t *testing.T) {
+	var bld strings.Builder
+	if err := printPreflightResults(&bld, []*preflightResult{
+		newPreflightResult("config", preflightPass, "2 engines configured", ""),
+		newPreflightResult("time sync", preflightFail, "system clock is not synchronized",
+			"enable NTP"),
+		newPreflightResult("pmem", preflightSkip, "no PMem configured", "unused hint"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	expOut := strings.Join([]string{
+		"Check     Status Details                          ",
+		"-----     ------ -------                          ",
+		"config    PASS   2 engines configured             ",
+		"time sync FAIL   system clock is not synchronized ",
+		"pmem      SKIP   no PMem configured               ",
+		"",
+		"Remediation:",
+		"  time sync: enable NTP",
+		"",
+	}, "\n")
+	if diff := cmp.Diff(expOut, bld.String()); diff != "" {
+		t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+	}
+}
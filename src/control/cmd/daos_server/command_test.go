@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -119,6 +120,10 @@ func TestPreExecCheckBypass(t *testing.T) {
 		"version": {
 			cmdLine: "version",
 		},
+		"security gen-certs": {
+			cmdLine: "security gen-certs --days=0",
+			expErr:  errors.New("greater than zero"),
+		},
 		"start (should fail)": {
 			cmdLine: "start",
 			expErr:  errors.New("ouch"),
@@ -135,7 +140,7 @@ func TestPreExecCheckBypass(t *testing.T) {
 					},
 				},
 			}
-			err := parseOpts([]string{tc.cmdLine}, &opts, log)
+			err := parseOpts(strings.Split(tc.cmdLine, " "), &opts, log)
 			test.CmpErr(t, tc.expErr, err)
 		})
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -49,6 +50,7 @@ type mainOpts struct {
 	// Define subcommands
 	SCM      scmStorageCmd           `command:"scm" description:"Perform tasks related to locally-attached SCM storage"`
 	NVMe     nvmeStorageCmd          `command:"nvme" description:"Perform tasks related to locally-attached NVMe storage"`
+	Storage  storageCmd              `command:"storage" description:"Perform tasks related to locally-attached storage"`
 	Start    startCmd                `command:"start" description:"Start daos_server"`
 	Network  networkCmd              `command:"network" description:"Perform network device scan based on fabric provider"`
 	Version  versionCmd              `command:"version" description:"Print daos_server version"`
@@ -56,6 +58,8 @@ type mainOpts struct {
 	DumpTopo cmdutil.DumpTopologyCmd `command:"dump-topology" description:"Dump system topology"`
 	Support  supportCmd              `command:"support" description:"Perform debug tasks to help support team"`
 	Config   configCmd               `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on the local server"`
+	Security securityCmd             `command:"security" alias:"sec" description:"Perform tasks related to DAOS certificates"`
+	Check    preflightCmd            `command:"check" description:"Check that the host is ready to run the engines in the config file"`
 
 	// Allow a set of tests to be run before executing commands.
 	preExecTests []execTestFn
@@ -117,6 +121,8 @@ func parseOpts(args []string, opts *mainOpts, log *logging.LeveledLogger) error
 			// No pre-exec tests or setup needed for these commands; just
 			// execute them directly.
 			return cmd.Execute(nil)
+		case *genCertsCmd:
+			// Certificates are generated without the privileged helper.
 		default:
 			for _, test := range opts.preExecTests {
 				if err := test(); err != nil {
@@ -0,0 +1,214 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	serverCertOwner = "daos_server"
+	agentCertOwner  = "daos_agent"
+)
+
+// securityCmd is the struct representing the top-level security subcommand.
+type securityCmd struct {
+	GenCerts genCertsCmd `command:"gen-certs" description:"Generate a CA and the certificates of each DAOS component"`
+}
+
+// remoteExecFn runs a command on a remote host with the given data as its standard input.
+type remoteExecFn func(host string, args []string, stdin []byte) error
+
+// certInstallFile describes a generated file to be installed on a remote host.
+type certInstallFile struct {
+	src   string
+	dest  string
+	mode  os.FileMode
+	owner string
+}
+
+// genCertsCmd generates the certificates of a DAOS system and optionally installs them on
+// each host, replacing the gen_certificates.sh script.
+type genCertsCmd struct {
+	cmdutil.LogCmd
+	Dir           string         `short:"d" long:"dir" default:"." description:"Directory in which to create the daosCA directory"`
+	Days          uint           `long:"days" default:"1095" description:"Number of days the generated certificates are valid for"`
+	CACert        string         `long:"ca-cert" description:"Sign with an existing CA certificate instead of generating a new CA"`
+	CAKey         string         `long:"ca-key" description:"Key of the existing CA certificate"`
+	HostList      ui.HostSetFlag `short:"l" long:"host-list" description:"Server hosts to generate per-host certificates for"`
+	AgentHostList ui.HostSetFlag `short:"a" long:"agent-host-list" description:"Client hosts to install the agent certificate on"`
+	Install       bool           `short:"i" long:"install" description:"Install the certificates on each host over SSH"`
+	InstallDir    string         `long:"install-dir" default:"/etc/daos/certs" description:"Directory to install the certificates to on each host"`
+	SSH           string         `long:"ssh" default:"ssh" description:"Remote shell command used to install the certificates"`
+
+	remoteExec remoteExecFn
+}
+
+func sshExec(sshCmd string) remoteExecFn {
+	return func(host string, args []string, stdin []byte) error {
+		sshArgs := strings.Fields(sshCmd)
+		if len(sshArgs) == 0 {
+			return errors.New("empty remote shell command")
+		}
+		sshArgs = append(sshArgs, host)
+		sshArgs = append(sshArgs, args...)
+
+		cmd := exec.Command(sshArgs[0], sshArgs[1:]...)
+		cmd.Stdin = bytes.NewReader(stdin)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return errors.Wrapf(err, "%s: %s", strings.Join(sshArgs, " "),
+				strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+}
+
+func hostListSlice(name string, hl *ui.HostSetFlag) ([]string, error) {
+	if hl.HasPattern() {
+		return nil, errors.Errorf("host patterns are not supported in %s", name)
+	}
+	return hl.Slice(), nil
+}
+
+// serverInstallFiles returns the files to install on a server host.
+func serverInstallFiles(gen *security.GeneratedCerts, host, dir string) []certInstallFile {
+	return []certInstallFile{
+		{src: gen.CACert, dest: filepath.Join(dir, "daosCA.crt"), mode: 0644},
+		{src: gen.HostServers[host].Cert, dest: filepath.Join(dir, "server.crt"), mode: 0644, owner: serverCertOwner},
+		{src: gen.HostServers[host].Key, dest: filepath.Join(dir, "server.key"), mode: security.MaxUserOnlyKeyPerm, owner: serverCertOwner},
+		{src: filepath.Join(gen.ClientsDir, "agent.crt"), dest: filepath.Join(dir, "clients", "agent.crt"), mode: 0644, owner: serverCertOwner},
+		{src: filepath.Join(gen.ClientsDir, "admin.crt"), dest: filepath.Join(dir, "clients", "admin.crt"), mode: 0644, owner: serverCertOwner},
+	}
+}
+
+// agentInstallFiles returns the files to install on a client host.
+func agentInstallFiles(gen *security.GeneratedCerts, dir string) []certInstallFile {
+	return []certInstallFile{
+		{src: gen.CACert, dest: filepath.Join(dir, "daosCA.crt"), mode: 0644},
+		{src: gen.Agent.Cert, dest: filepath.Join(dir, "agent.crt"), mode: 0644, owner: agentCertOwner},
+		{src: gen.Agent.Key, dest: filepath.Join(dir, "agent.key"), mode: security.MaxUserOnlyKeyPerm, owner: agentCertOwner},
+	}
+}
+
+func (cmd *genCertsCmd) installFiles(host string, files []certInstallFile) error {
+	for _, f := range files {
+		data, err := os.ReadFile(f.src)
+		if err != nil {
+			return err
+		}
+
+		args := []string{"install", "-D", "-m", fmt.Sprintf("%04o", f.mode)}
+		if f.owner != "" {
+			args = append(args, "-o", f.owner, "-g", f.owner)
+		}
+		args = append(args, "/dev/stdin", f.dest)
+
+		if err := cmd.remoteExec(host, args, data); err != nil {
+			return errors.Wrapf(err, "installing %s on %s", f.dest, host)
+		}
+		cmd.Debugf("installed %s on %s", f.dest, host)
+	}
+
+	return nil
+}
+
+func (cmd *genCertsCmd) install(gen *security.GeneratedCerts, servers, agents []string) error {
+	if cmd.remoteExec == nil {
+		cmd.remoteExec = sshExec(cmd.SSH)
+	}
+
+	var failed []string
+	for _, host := range servers {
+		if err := cmd.installFiles(host, serverInstallFiles(gen, host, cmd.InstallDir)); err != nil {
+			cmd.Error(err.Error())
+			failed = append(failed, host)
+			continue
+		}
+		cmd.Infof("Installed server certificates on %s", host)
+	}
+	for _, host := range agents {
+		if err := cmd.installFiles(host, agentInstallFiles(gen, cmd.InstallDir)); err != nil {
+			cmd.Error(err.Error())
+			failed = append(failed, host)
+			continue
+		}
+		cmd.Infof("Installed agent certificates on %s", host)
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("failed to install certificates on %d host(s): %s", len(failed),
+			strings.Join(failed, ","))
+	}
+	return nil
+}
+
+func (cmd *genCertsCmd) Execute(_ []string) error {
+	if cmd.Days == 0 {
+		return errors.New("--days must be greater than zero")
+	}
+	servers, err := hostListSlice("--host-list", &cmd.HostList)
+	if err != nil {
+		return err
+	}
+	agents, err := hostListSlice("--agent-host-list", &cmd.AgentHostList)
+	if err != nil {
+		return err
+	}
+	if !cmd.Install && len(agents) > 0 {
+		return errors.New("--agent-host-list requires --install")
+	}
+	if cmd.Install && len(servers)+len(agents) == 0 {
+		return errors.New("--install requires --host-list or --agent-host-list")
+	}
+
+	gen, err := security.GenerateCerts(&security.CertGenConfig{
+		Dir:         cmd.Dir,
+		Validity:    time.Duration(cmd.Days) * 24 * time.Hour,
+		ServerHosts: servers,
+		CACertPath:  cmd.CACert,
+		CAKeyPath:   cmd.CAKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	line := func(desc, path string) {
+		fmt.Fprintf(&sb, "  %-32s %s\n", desc+":", path)
+	}
+	fmt.Fprintf(&sb, "Generated certificates in %s\n", gen.CADir)
+	if gen.CAKey != "" {
+		line("CA key (keep private)", gen.CAKey)
+	}
+	line("CA certificate (all hosts)", gen.CACert)
+	line("daos_server", gen.Server.Cert)
+	line("daos_agent", gen.Agent.Cert)
+	line("dmg", gen.Admin.Cert)
+	line("client certificates (servers)", gen.ClientsDir)
+	for _, host := range servers {
+		line("daos_server on "+host, gen.HostServers[host].Cert)
+	}
+	cmd.Info(sb.String())
+
+	if !cmd.Install {
+		return nil
+	}
+	return cmd.install(gen, servers, agents)
+}
@@ -0,0 +1,151 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockRemoteInstall struct {
+	host string
+	dest string
+	mode string
+	own  string
+	data string
+}
+
+func TestDaosServer_Security_GenCerts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args         []string
+		failHost     string
+		expErr       error
+		expInstalled []mockRemoteInstall
+	}{
+		"zero days": {
+			args:   []string{"--days=0"},
+			expErr: errors.New("greater than zero"),
+		},
+		"invalid host list": {
+			args:   []string{"--host-list=host[1-"},
+			expErr: errors.New("invalid range"),
+		},
+		"host pattern": {
+			args:   []string{"--host-list=/host.*/"},
+			expErr: errors.New("host patterns are not supported"),
+		},
+		"agent hosts without install": {
+			args:   []string{"--agent-host-list=client1"},
+			expErr: errors.New("requires --install"),
+		},
+		"install without hosts": {
+			args:   []string{"--install"},
+			expErr: errors.New("requires --host-list"),
+		},
+		"generate only": {
+			args: []string{"--host-list=server[1-2]"},
+		},
+		"install": {
+			args: []string{"--host-list=server1", "--agent-host-list=client1", "--install"},
+			expInstalled: []mockRemoteInstall{
+				{"client1", "/etc/daos/certs/agent.crt", "0644", "daos_agent", "agent.crt"},
+				{"client1", "/etc/daos/certs/agent.key", "0400", "daos_agent", "agent.key"},
+				{"client1", "/etc/daos/certs/daosCA.crt", "0644", "", "daosCA.crt"},
+				{"server1", "/etc/daos/certs/clients/admin.crt", "0644", "daos_server", "clients/admin.crt"},
+				{"server1", "/etc/daos/certs/clients/agent.crt", "0644", "daos_server", "clients/agent.crt"},
+				{"server1", "/etc/daos/certs/daosCA.crt", "0644", "", "daosCA.crt"},
+				{"server1", "/etc/daos/certs/server.crt", "0644", "daos_server", "hosts/server1/server.crt"},
+				{"server1", "/etc/daos/certs/server.key", "0400", "daos_server", "hosts/server1/server.key"},
+			},
+		},
+		"install fails on one host": {
+			args:     []string{"--host-list=server[1-2]", "--install"},
+			failHost: "server1",
+			expErr:   errors.New("failed to install certificates on 1 host(s): server1"),
+			expInstalled: []mockRemoteInstall{
+				{"server2", "/etc/daos/certs/clients/admin.crt", "0644", "daos_server", "clients/admin.crt"},
+				{"server2", "/etc/daos/certs/clients/agent.crt", "0644", "daos_server", "clients/agent.crt"},
+				{"server2", "/etc/daos/certs/daosCA.crt", "0644", "", "daosCA.crt"},
+				{"server2", "/etc/daos/certs/server.crt", "0644", "daos_server", "hosts/server2/server.crt"},
+				{"server2", "/etc/daos/certs/server.key", "0400", "daos_server", "hosts/server2/server.key"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			var opts mainOpts
+			args := append([]string{"security", "gen-certs", "--dir", testDir}, tc.args...)
+			var installed []mockRemoteInstall
+			certsDir := filepath.Join(testDir, "daosCA", "certs")
+			opts.Security.GenCerts.remoteExec = func(host string, args []string, stdin []byte) error {
+				if host == tc.failHost {
+					return errors.New("connection refused")
+				}
+
+				inst := mockRemoteInstall{
+					host: host,
+					dest: args[len(args)-1],
+					data: string(stdin),
+				}
+				for i := 0; i < len(args)-1; i++ {
+					switch args[i] {
+					case "-m":
+						inst.mode = args[i+1]
+					case "-o":
+						inst.own = args[i+1]
+					}
+				}
+				installed = append(installed, inst)
+				return nil
+			}
+
+			gotErr := parseOpts(args, &opts, log)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			// The expected data is the path of the generated file that was installed.
+			for i, inst := range tc.expInstalled {
+				data, err := os.ReadFile(filepath.Join(certsDir, inst.data))
+				if err != nil {
+					t.Fatal(err)
+				}
+				tc.expInstalled[i].data = string(data)
+			}
+			sort.Slice(installed, func(i, j int) bool {
+				if installed[i].host != installed[j].host {
+					return installed[i].host < installed[j].host
+				}
+				return installed[i].dest < installed[j].dest
+			})
+			if diff := cmp.Diff(tc.expInstalled, installed, cmp.AllowUnexported(mockRemoteInstall{})); diff != "" {
+				t.Fatalf("unexpected installed files (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expErr != nil && len(tc.expInstalled) == 0 {
+				return
+			}
+			for _, host := range opts.Security.GenCerts.HostList.Slice() {
+				hostCert := filepath.Join(certsDir, "hosts", host, "server.crt")
+				if _, err := os.Stat(hostCert); err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ type startCmd struct {
 	SocketDir   string  `short:"d" long:"socket_dir" description:"Location for all daos_server & daos_engine sockets"`
 	Insecure    bool    `short:"i" long:"insecure" description:"Allow for insecure connections"`
 	AutoFormat  bool    `long:"auto-format" description:"Automatically format storage on server start to bring-up engines without requiring dmg storage format command"`
+	ForceRegen  bool    `long:"force-regenerate" description:"Regenerate engine bdev config files that have been modified or no longer match the server config instead of failing to start"`
 }
 
 func (cmd *startCmd) setCLIOverrides() error {
@@ -101,6 +102,7 @@ func (cmd *startCmd) Execute(args []string) error {
 	}
 
 	cmd.config.AutoFormat = cmd.AutoFormat
+	cmd.config.ForceBdevConfigRegen = cmd.ForceRegen
 
 	return cmd.start(cmd.Logger, cmd.config)
 }
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/hardware/hwloc"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+)
+
+type storageCmd struct {
+	Render renderConfigCmd `command:"render-config" description:"Print the SPDK JSON config that would be generated for an engine"`
+}
+
+type renderConfigCmd struct {
+	nvmeCmd
+	EngineIndex uint `short:"e" long:"engine" description:"Index of the engine in the server config file to render the SPDK config for"`
+	SkipPrep    bool `long:"skip-prep" description:"Skip preparation of devices during the scan performed when VMD is enabled."`
+	getTopo     func(context.Context) (*hardware.Topology, error)
+}
+
+// renderConfig builds the SPDK config write request for the selected engine using the same path as
+// the engine provider and returns the JSON content that would be written. When VMD is enabled,
+// locally-attached NVMe storage is scanned so that VMD endpoint addresses can be replaced with
+// those of the backing devices.
+func renderConfig(cmd *renderConfigCmd, smi *common.SysMemInfo) ([]byte, error) {
+	if cmd.config == nil {
+		return nil, errors.New("server config file is required to render an spdk config")
+	}
+	nrEngines := len(cmd.config.Engines)
+	if int(cmd.EngineIndex) >= nrEngines {
+		return nil, errors.Errorf("engine index %d out of range (%d engines in config)",
+			cmd.EngineIndex, nrEngines)
+	}
+
+	storageCfg := cmd.config.Engines[cmd.EngineIndex].Storage
+	storageCfg.EngineIdx = cmd.EngineIndex
+	if err := storageCfg.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "engine %d storage config", cmd.EngineIndex)
+	}
+	if len(storageCfg.Tiers.BdevConfigs()) == 0 {
+		return nil, errors.Errorf("no bdev tiers configured for engine %d", cmd.EngineIndex)
+	}
+
+	vmdEnabled := isVMDEnabled(cmd.config)
+
+	var ctrlrs storage.NvmeControllers
+	if vmdEnabled && storageCfg.Tiers.HaveRealNVMe() {
+		resp, err := scanNVMe(&scanNVMeCmd{
+			nvmeCmd:  cmd.nvmeCmd,
+			SkipPrep: cmd.SkipPrep,
+		}, smi)
+		if err != nil {
+			return nil, errors.Wrap(err, "nvme scan")
+		}
+		ctrlrs = resp.Controllers
+	}
+
+	getTopo := cmd.getTopo
+	if getTopo == nil {
+		getTopo = hwloc.NewProvider(cmd.Logger).GetTopology
+	}
+
+	req, err := storage.BdevWriteConfigRequestFromConfig(context.Background(), cmd.Logger,
+		&storageCfg, vmdEnabled, getTopo, ctrlrs)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating write config request")
+	}
+
+	return bdev.RenderJsonConfig(cmd.Logger, req)
+}
+
+func (cmd *renderConfigCmd) Execute(_ []string) error {
+	cmd.Debugf("executing render config command: %+v", cmd)
+
+	smi, err := common.GetSysMemInfo()
+	if err != nil {
+		return errors.Wrap(err, "get meminfo")
+	}
+
+	buf, err := renderConfig(cmd, smi)
+	if err != nil {
+		return errors.Wrap(err, "storage render-config")
+	}
+
+	// Print directly to stdout so that the config can be redirected to a file.
+	_, err = fmt.Fprintln(os.Stdout, string(buf))
+	return err
+}
@@ -67,7 +67,7 @@ func nvmeBdevsFromCfg(cfg *config.Server) *storage.BdevDeviceList {
 		bdevCfgs = append(bdevCfgs, ec.Storage.Tiers.BdevConfigs()...)
 	}
 
-	bds := bdevCfgs.NVMeBdevs()
+	bds := bdevCfgs.NVMeBdevsWithSpares()
 	if bds.Len() == 0 {
 		return nil
 	}
@@ -468,8 +468,6 @@ func scanNVMe(cmd *scanNVMeCmd, smi *common.SysMemInfo) (_ *storage.BdevScanResp
 		}()
 	}
 
-	cmd.Info("Scan locally-attached NVMe storage...")
-
 	cmd.Tracef("nvme scan request: %+v", req)
 	return cmd.ctlSvc.NvmeScan(req)
 }
@@ -482,6 +480,8 @@ func (cmd *scanNVMeCmd) Execute(_ []string) (err error) {
 		return errors.Wrap(err, "get meminfo")
 	}
 
+	cmd.Info("Scan locally-attached NVMe storage...")
+
 	resp, err := scanNVMe(cmd, smi)
 	if err != nil {
 		return errors.Wrap(err, "nvme scan backend")
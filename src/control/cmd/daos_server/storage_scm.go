@@ -8,6 +8,7 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -31,12 +32,15 @@ type scmStorageCmd struct {
 	Prepare prepareSCMCmd `command:"prepare" description:"Prepare SCM devices so that they can be used with DAOS"`
 	Reset   resetSCMCmd   `command:"reset" description:"Reset SCM devices that have been used with DAOS"`
 	Scan    scanSCMCmd    `command:"scan" description:"Scan SCM devices"`
+	Unlock  unlockSCMCmd  `command:"unlock" description:"Unlock passphrase-protected PMem modules"`
 }
 
 type prepareSCMCmd struct {
 	scmCmd
-	NrNamespacesPerSocket uint `short:"S" long:"scm-ns-per-socket" description:"Number of PMem namespaces to create per socket" default:"1"`
-	Force                 bool `short:"f" long:"force" description:"Perform SCM operations without waiting for confirmation"`
+	NrNamespacesPerSocket uint   `short:"S" long:"scm-ns-per-socket" description:"Number of PMem namespaces to create per socket" default:"1"`
+	NamespaceMode         string `short:"m" long:"ns-mode" description:"Mode of PMem namespaces to create, only fsdax namespaces can be used by DAOS engines" choice:"fsdax" choice:"devdax" default:"fsdax"`
+	Force                 bool   `short:"f" long:"force" description:"Perform SCM operations without waiting for confirmation"`
+	DryRun                bool   `short:"d" long:"dry-run" description:"Report the goals and namespaces that would be created without applying them"`
 }
 
 // Read SocketID from config if not set explicitly in command.
@@ -61,19 +65,23 @@ func preparePMem(cmd *prepareSCMCmd) (*storage.ScmPrepareResponse, error) {
 		getSockFromCmd(&cmd.scmCmd)
 	}
 
-	cmd.Info(MsgStoragePrepareWarn)
-	if !cmd.Force {
-		if cmd.JSONOutputEnabled() {
-			return nil, errNoForceWithJSON
-		}
-		if !common.GetConsent(cmd) {
-			return nil, errNoConsent
+	if !cmd.DryRun {
+		cmd.Info(MsgStoragePrepareWarn)
+		if !cmd.Force {
+			if cmd.JSONOutputEnabled() {
+				return nil, errNoForceWithJSON
+			}
+			if !common.GetConsent(cmd) {
+				return nil, errNoConsent
+			}
 		}
 	}
 
 	req := storage.ScmPrepareRequest{
 		SocketID:              cmd.SocketID,
 		NrNamespacesPerSocket: cmd.NrNamespacesPerSocket,
+		NamespaceMode:         storage.ScmNamespaceMode(cmd.NamespaceMode),
+		DryRun:                cmd.DryRun,
 	}
 	cmd.Tracef("scm prepare request: %+v", req)
 
@@ -90,6 +98,9 @@ func preparePMem(cmd *prepareSCMCmd) (*storage.ScmPrepareResponse, error) {
 	if resp.Socket == nil {
 		return nil, errors.New("scm prepare returned nil socket state")
 	}
+	if cmd.DryRun {
+		return resp, nil
+	}
 	state := resp.Socket.State
 
 	if resp.RebootRequired {
@@ -137,6 +148,10 @@ func (cmd *prepareSCMCmd) Execute(_ []string) error {
 		return err
 	}
 
+	if cmd.DryRun {
+		return printScmPreview(&cmd.scmCmd, resp)
+	}
+
 	if resp != nil && len(resp.Namespaces) != 0 {
 		if cmd.JSONOutputEnabled() {
 			return cmd.OutputJSON(resp.Namespaces, nil)
@@ -153,9 +168,28 @@ func (cmd *prepareSCMCmd) Execute(_ []string) error {
 	return nil
 }
 
+// printScmPreview outputs the changes reported by a dry-run PMem prepare or reset.
+func printScmPreview(cmd *scmCmd, resp *storage.ScmPrepareResponse) error {
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, nil)
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintScmPreview(resp.Preview, &bld); err != nil {
+		return err
+	}
+	if resp.RebootRequired {
+		fmt.Fprintln(&bld, "Reboot would be required to apply region goals.")
+	}
+	cmd.Infof("%s\n", bld.String())
+
+	return nil
+}
+
 type resetSCMCmd struct {
 	scmCmd
-	Force bool `short:"f" long:"force" description:"Perform PMem prepare operation without waiting for confirmation"`
+	Force  bool `short:"f" long:"force" description:"Perform PMem prepare operation without waiting for confirmation"`
+	DryRun bool `short:"d" long:"dry-run" description:"Report the namespaces and regions that would be removed without applying changes"`
 }
 
 func resetPMem(cmd *resetSCMCmd) error {
@@ -165,19 +199,22 @@ func resetPMem(cmd *resetSCMCmd) error {
 		getSockFromCmd(&cmd.scmCmd)
 	}
 
-	cmd.Info(MsgStoragePrepareWarn)
-	if !cmd.Force {
-		if cmd.JSONOutputEnabled() {
-			return errNoForceWithJSON
-		}
-		if !common.GetConsent(cmd) {
-			return errNoConsent
+	if !cmd.DryRun {
+		cmd.Info(MsgStoragePrepareWarn)
+		if !cmd.Force {
+			if cmd.JSONOutputEnabled() {
+				return errNoForceWithJSON
+			}
+			if !common.GetConsent(cmd) {
+				return errNoConsent
+			}
 		}
 	}
 
 	resetReq := storage.ScmPrepareRequest{
 		SocketID: cmd.SocketID,
 		Reset:    true,
+		DryRun:   cmd.DryRun,
 	}
 	cmd.Tracef("scm prepare (reset) request: %+v", resetReq)
 
@@ -188,6 +225,10 @@ func resetPMem(cmd *resetSCMCmd) error {
 	}
 	cmd.Tracef("scm prepare (reset) response: %+v", resetResp)
 
+	if cmd.DryRun {
+		return printScmPreview(&cmd.scmCmd, resetResp)
+	}
+
 	state := resetResp.Socket.State
 
 	if resetResp.RebootRequired {
@@ -275,6 +316,67 @@ func (cmd *scanSCMCmd) Execute(_ []string) error {
 			return err
 		}
 	}
+	pretty.PrintScmCapabilities(resp.Capabilities, &bld)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+type unlockSCMCmd struct {
+	scmCmd
+	KeyFile    string   `short:"k" long:"key-file" description:"File containing the PMem passphrase in ipmctl source format, overrides scm_unlock in the server config file"`
+	DeviceUIDs []string `short:"u" long:"uid" description:"UID of a PMem module to unlock, may be repeated (default: all locked modules)"`
+}
+
+func unlockPMem(cmd *unlockSCMCmd) (*storage.ScmUnlockResponse, error) {
+	cmd.Info("Unlocking locally-attached PMem...")
+
+	keyFile := cmd.KeyFile
+	if keyFile == "" {
+		if cmd.config == nil || cmd.config.ScmUnlock == nil {
+			return nil, errors.New("(-k|--key-file) or scm_unlock in server config file required")
+		}
+
+		path, cleanup, err := cmd.config.ScmUnlock.PassphraseFile()
+		if err != nil {
+			return nil, err
+		}
+		defer cleanup()
+		keyFile = path
+	}
+
+	req := storage.ScmUnlockRequest{
+		PassphraseFile: keyFile,
+		DeviceUIDs:     cmd.DeviceUIDs,
+	}
+	cmd.Tracef("scm unlock request: %+v", req)
+
+	return cmd.ctlSvc.ScmUnlock(req)
+}
+
+func (cmd *unlockSCMCmd) Execute(_ []string) error {
+	cmd.Debugf("executing unlock scm command: %+v", cmd)
+
+	resp, err := unlockPMem(cmd)
+	if err != nil {
+		return err
+	}
+	cmd.Tracef("scm unlock response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp.Modules, nil)
+	}
+
+	if len(resp.Modules) == 0 {
+		cmd.Info("No locked PMem modules found")
+		return nil
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Unlocked %d PMem module(s)\n", len(resp.Modules))
+	if err := pretty.PrintScmModules(resp.Modules, &bld); err != nil {
+		return err
+	}
 	cmd.Info(bld.String())
 
 	return nil
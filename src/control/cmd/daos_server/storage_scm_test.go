@@ -146,6 +146,8 @@ func TestDaosServer_preparePMem(t *testing.T) {
 	for name, tc := range map[string]struct {
 		noForce   bool
 		zeroNrNs  bool
+		nsMode    string
+		dryRun    bool
 		sockID    *uint
 		prepResp  *storage.ScmPrepareResponse
 		prepErr   error
@@ -189,6 +191,49 @@ func TestDaosServer_preparePMem(t *testing.T) {
 			},
 			expLogMsg: storage.ScmMsgRebootRequired,
 		},
+		"create namespaces; devdax mode": {
+			nsMode: "devdax",
+			prepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoFreeCap,
+				},
+				Namespaces: storage.ScmNamespaces{storage.MockScmNamespace()},
+			},
+			expCalls: []storage.ScmPrepareRequest{
+				{NrNamespacesPerSocket: 1, NamespaceMode: storage.ScmNsModeDevdax},
+			},
+			expResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoFreeCap,
+				},
+				Namespaces: storage.ScmNamespaces{storage.MockScmNamespace()},
+			},
+		},
+		"dry run; no consent required": {
+			noForce: true,
+			dryRun:  true,
+			prepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoRegions,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{Goals: []string{"ipmctl create -f -goal PersistentMemoryType=AppDirect"}},
+				},
+			},
+			expCalls: []storage.ScmPrepareRequest{
+				{NrNamespacesPerSocket: 1, DryRun: true},
+			},
+			expResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoRegions,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{Goals: []string{"ipmctl create -f -goal PersistentMemoryType=AppDirect"}},
+				},
+			},
+		},
 		"create regions; reboot required; single socket": {
 			sockID: &one,
 			prepResp: &storage.ScmPrepareResponse{
@@ -274,6 +319,8 @@ func TestDaosServer_preparePMem(t *testing.T) {
 				nrNs = 0
 			}
 			cmd.NrNamespacesPerSocket = nrNs
+			cmd.NamespaceMode = tc.nsMode
+			cmd.DryRun = tc.dryRun
 
 			gotResp, gotErr := preparePMem(&cmd)
 			test.CmpErr(t, tc.expErr, gotErr)
@@ -323,6 +370,7 @@ func TestDaosServer_resetPMem(t *testing.T) {
 
 	for name, tc := range map[string]struct {
 		noForce   bool
+		dryRun    bool
 		sockID    *uint
 		prepResp  *storage.ScmPrepareResponse
 		prepErr   error
@@ -412,6 +460,26 @@ func TestDaosServer_resetPMem(t *testing.T) {
 			},
 			expLogMsg: "have been removed and regions (some with an unexpected",
 		},
+		"remove regions; dry run": {
+			noForce: true,
+			dryRun:  true,
+			prepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoFreeCap,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{
+						Goals:            []string{"ipmctl create -f -goal PersistentMemoryType=AppDirect"},
+						RemoveNamespaces: []string{"namespace0.0"},
+					},
+				},
+			},
+			expCalls: []storage.ScmPrepareRequest{
+				{Reset: true, DryRun: true},
+			},
+			expLogMsg: "namespace0.0",
+		},
 		"no modules": {
 			prepResp: &storage.ScmPrepareResponse{
 				Socket: &storage.ScmSocketState{
@@ -464,7 +532,8 @@ func TestDaosServer_resetPMem(t *testing.T) {
 			msb, mockInitFn := getMockScmCmdInit(log, smbc, nil)
 
 			cmd := resetSCMCmd{
-				Force: !tc.noForce,
+				Force:  !tc.noForce,
+				DryRun: tc.dryRun,
 			}
 			cmd.LogCmd = cmdutil.LogCmd{
 				Logger: log,
@@ -597,13 +666,24 @@ func TestDaosServer_SCM_Commands(t *testing.T) {
 	runCmdTests(t, []cmdTest{
 		{
 			"Prepare namespaces with all opts",
-			"scm prepare -S 2 -f --socket 0",
+			"scm prepare -S 2 -f --socket 0 --ns-mode devdax",
 			printCommand(t, &prepareSCMCmd{
 				NrNamespacesPerSocket: 2,
+				NamespaceMode:         "devdax",
 				Force:                 true,
 			}),
 			nil,
 		},
+		{
+			"Prepare namespaces; dry run",
+			"scm prepare --dry-run",
+			printCommand(t, &prepareSCMCmd{
+				NrNamespacesPerSocket: 1,
+				NamespaceMode:         "fsdax",
+				DryRun:                true,
+			}),
+			nil,
+		},
 		{
 			"Prepare namespaces; bad opt",
 			"scm prepare -X",
@@ -636,6 +716,15 @@ func TestDaosServer_SCM_Commands(t *testing.T) {
 			printCommand(t, &scanSCMCmd{}),
 			nil,
 		},
+		{
+			"Unlock modules with all opts",
+			"scm unlock --key-file /etc/daos/pmem_passphrase -u 0x0001 -u 0x0101",
+			printCommand(t, &unlockSCMCmd{
+				KeyFile:    "/etc/daos/pmem_passphrase",
+				DeviceUIDs: []string{"0x0001", "0x0101"},
+			}),
+			nil,
+		},
 	})
 }
 
@@ -652,6 +741,11 @@ func TestDaosServer_SCM_Commands_JSON(t *testing.T) {
 	// Use a normal logger to verify that we don't mess up JSON output.
 	log, buf := logging.NewTestCommandLineLogger()
 
+	lockedModule := storage.MockScmModule()
+	lockedModule.SecurityState = storage.ScmSecurityLocked
+	unlockedModule := storage.MockScmModule()
+	unlockedModule.SecurityState = storage.ScmSecurityUnlocked
+
 	runJSONCmdTests(t, log, buf, []jsonCmdTest{
 		{
 			"Prepare namespaces; no force",
@@ -776,6 +870,40 @@ func TestDaosServer_SCM_Commands_JSON(t *testing.T) {
 			errors.New(fmt.Sprintf("failed to load config from %s: stat %s: "+
 				"no such file or directory", badDir, badDir)),
 		},
+		{
+			"Unlock modules; none locked",
+			"scm unlock -j -k /etc/daos/pmem_passphrase",
+			genSetSCMHelpers(log, scm.MockBackendConfig{
+				GetModulesRes: storage.ScmModules{
+					storage.MockScmModule(),
+				},
+			}),
+			storage.ScmModules{},
+			nil,
+		},
+		{
+			"Unlock modules",
+			"scm unlock -j -k /etc/daos/pmem_passphrase",
+			genSetSCMHelpers(log, scm.MockBackendConfig{
+				GetModulesRes: storage.ScmModules{
+					lockedModule,
+				},
+			}),
+			storage.ScmModules{unlockedModule},
+			nil,
+		},
+		{
+			"Unlock modules; returns error",
+			"scm unlock -j -k /etc/daos/pmem_passphrase",
+			genSetSCMHelpers(log, scm.MockBackendConfig{
+				GetModulesRes: storage.ScmModules{
+					lockedModule,
+				},
+				UnlockModulesErr: errors.New("bad unlock"),
+			}),
+			nil,
+			errors.New("bad unlock"),
+		},
 	})
 }
 
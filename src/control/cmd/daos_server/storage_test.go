@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+)
+
+func TestDaosServer_Storage_Commands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Render config",
+			"storage render-config",
+			printCommand(t, &renderConfigCmd{}),
+			nil,
+		},
+		{
+			"Render config; engine index",
+			"storage render-config --engine 1",
+			printCommand(t, &renderConfigCmd{EngineIndex: 1}),
+			nil,
+		},
+		{
+			"Render config; skip prep",
+			"storage render-config -e 1 --skip-prep",
+			printCommand(t, &renderConfigCmd{EngineIndex: 1, SkipPrep: true}),
+			nil,
+		},
+		{
+			"Nonexistent subcommand",
+			"storage quack",
+			"",
+			errors.New("Unknown command"),
+		},
+	})
+}
+
+func TestDaosServer_renderConfig(t *testing.T) {
+	const (
+		vmdAddr         = "0000:5d:05.5"
+		vmdBackingAddr1 = "5d0505:01:00.0"
+		vmdBackingAddr2 = "5d0505:03:00.0"
+	)
+
+	nvmeEngine := func(idx int, addrs ...string) *engine.Config {
+		if len(addrs) == 0 {
+			addrs = []string{test.MockPCIAddr(int32(idx + 1))}
+		}
+		return engine.MockConfig().
+			WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmMountPoint(fmt.Sprintf("/mnt/daos%d", idx)).
+					WithScmDeviceList(fmt.Sprintf("/dev/pmem%d", idx)),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(addrs...),
+			)
+	}
+	backingCtrlrs := storage.NvmeControllers{
+		&storage.NvmeController{PciAddr: vmdBackingAddr1},
+		&storage.NvmeController{PciAddr: vmdBackingAddr2},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg          *config.Server
+		engineIndex  uint
+		bmbc         bdev.MockBackendConfig
+		expSubstr    []string
+		expNotSubstr []string
+		expScan      bool
+		expErr       error
+	}{
+		"no config": {
+			expErr: errors.New("server config file is required"),
+		},
+		"engine index out of range": {
+			cfg: config.DefaultServer().WithDisableVMD(true).
+				WithEngines(nvmeEngine(0)),
+			engineIndex: 1,
+			expErr:      errors.New("engine index 1 out of range (1 engines in config)"),
+		},
+		"no bdev tiers": {
+			cfg: config.DefaultServer().WithDisableVMD(true).WithEngines(
+				engine.MockConfig().WithStorage(
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassDcpm.String()).
+						WithScmMountPoint("/mnt/daos0").
+						WithScmDeviceList("/dev/pmem0"),
+				),
+			),
+			expErr: errors.New("no bdev tiers configured for engine 0"),
+		},
+		"vmd disabled; second engine": {
+			cfg: config.DefaultServer().WithDisableVMD(true).
+				WithEngines(nvmeEngine(0), nvmeEngine(1)),
+			engineIndex: 1,
+			expSubstr: []string{
+				`"method": "bdev_nvme_attach_controller"`,
+				fmt.Sprintf(`"traddr": "%s"`, test.MockPCIAddr(2)),
+			},
+		},
+		"vmd enabled; scan fails": {
+			cfg: config.DefaultServer().WithEngines(nvmeEngine(0, vmdAddr)),
+			bmbc: bdev.MockBackendConfig{
+				ScanErr: errors.New("scan failed"),
+			},
+			expScan: true,
+			expErr:  errors.New("scan failed"),
+		},
+		"vmd enabled; backing device addresses substituted": {
+			cfg: config.DefaultServer().WithEngines(nvmeEngine(0, vmdAddr)),
+			bmbc: bdev.MockBackendConfig{
+				ScanRes: &storage.BdevScanResponse{
+					Controllers: backingCtrlrs,
+				},
+			},
+			expScan: true,
+			expSubstr: []string{
+				`"method": "enable_vmd"`,
+				fmt.Sprintf(`"traddr": "%s"`, vmdBackingAddr1),
+				fmt.Sprintf(`"traddr": "%s"`, vmdBackingAddr2),
+			},
+			expNotSubstr: []string{
+				fmt.Sprintf(`"traddr": "%s"`, vmdAddr),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mbb, mockInitFn := getMockNvmeCmdInit(log, tc.bmbc, tc.cfg)
+
+			cmd := &renderConfigCmd{
+				EngineIndex: tc.engineIndex,
+				SkipPrep:    true,
+				getTopo:     storage.MockGetTopology,
+			}
+			cmd.LogCmd = cmdutil.LogCmd{
+				Logger: log,
+			}
+			cmd.config = tc.cfg
+			if err := cmd.initWith(mockInitFn); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			gotBuf, gotErr := renderConfig(cmd, defSysMemInfo())
+
+			mbb.RLock()
+			if tc.expScan != (len(mbb.ScanCalls) == 1) {
+				t.Fatalf("unexpected number of scan calls: %d", len(mbb.ScanCalls))
+			}
+			mbb.RUnlock()
+
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, sub := range tc.expSubstr {
+				if !strings.Contains(string(gotBuf), sub) {
+					t.Fatalf("expected %q in rendered config:\n%s", sub, gotBuf)
+				}
+			}
+			for _, sub := range tc.expNotSubstr {
+				if strings.Contains(string(gotBuf), sub) {
+					t.Fatalf("unexpected %q in rendered config:\n%s", sub, gotBuf)
+				}
+			}
+		})
+	}
+}
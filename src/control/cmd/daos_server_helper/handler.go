@@ -226,6 +226,31 @@ func (h *scmPrepHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Res
 	return pbin.NewResponseWithPayload(pRes)
 }
 
+// scmUnlockHandler implements the ScmUnlock method.
+type scmUnlockHandler struct {
+	scmHandler
+}
+
+func (h *scmUnlockHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var uReq storage.ScmUnlockRequest
+	if err := json.Unmarshal(req.Payload, &uReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	uRes, err := h.scmProvider.Unlock(uReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(uRes)
+}
+
 // bdevHandler provides the ability to set up the bdev.Provider for bdev methods.
 type bdevHandler struct {
 	bdevProvider *bdev.Provider
@@ -359,3 +384,123 @@ func (h *bdevReadConfigHandler) Handle(log logging.Logger, req *pbin.Request) *p
 
 	return pbin.NewResponseWithPayload(fRes)
 }
+
+type bdevAttachControllerHandler struct {
+	bdevHandler
+}
+
+func (h *bdevAttachControllerHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var fReq storage.BdevAttachRequest
+	if err := json.Unmarshal(req.Payload, &fReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fRes, err := h.bdevProvider.AttachController(fReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(fRes)
+}
+
+type bdevDetachControllerHandler struct {
+	bdevHandler
+}
+
+func (h *bdevDetachControllerHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var fReq storage.BdevDetachRequest
+	if err := json.Unmarshal(req.Payload, &fReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fRes, err := h.bdevProvider.DetachController(fReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(fRes)
+}
+
+type bdevInjectErrorsHandler struct {
+	bdevHandler
+}
+
+func (h *bdevInjectErrorsHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var fReq storage.BdevInjectErrorsRequest
+	if err := json.Unmarshal(req.Payload, &fReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fRes, err := h.bdevProvider.InjectErrors(fReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(fRes)
+}
+
+type bdevCreateNamespacesHandler struct {
+	bdevHandler
+}
+
+func (h *bdevCreateNamespacesHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var fReq storage.BdevNamespaceCreateRequest
+	if err := json.Unmarshal(req.Payload, &fReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fRes, err := h.bdevProvider.CreateNamespaces(fReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(fRes)
+}
+
+type bdevDeleteNamespaceHandler struct {
+	bdevHandler
+}
+
+func (h *bdevDeleteNamespaceHandler) Handle(log logging.Logger, req *pbin.Request) *pbin.Response {
+	if req == nil {
+		return getNilRequestResp()
+	}
+
+	var fReq storage.BdevNamespaceDeleteRequest
+	if err := json.Unmarshal(req.Payload, &fReq); err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	h.setupProvider(log)
+
+	fRes, err := h.bdevProvider.DeleteNamespace(fReq)
+	if err != nil {
+		return pbin.NewResponseWithError(err)
+	}
+
+	return pbin.NewResponseWithPayload(fRes)
+}
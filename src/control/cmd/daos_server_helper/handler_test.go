@@ -569,6 +569,79 @@ func TestDaosAdmin_ScmPrepHandler(t *testing.T) {
 	}
 }
 
+func TestDaosAdmin_ScmUnlockHandler(t *testing.T) {
+	scmUnlockReqPayload, err := json.Marshal(storage.ScmUnlockRequest{
+		ForwardableRequest: pbin.ForwardableRequest{Forwarded: true},
+		PassphraseFile:     "/etc/daos/pmem.key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locked := storage.MockScmModule(0)
+	locked.SecurityState = storage.ScmSecurityLocked
+	unlocked := storage.MockScmModule(0)
+	unlocked.SecurityState = storage.ScmSecurityUnlocked
+
+	for name, tc := range map[string]struct {
+		req        *pbin.Request
+		smbc       *scm.MockBackendConfig
+		expPayload *storage.ScmUnlockResponse
+		expErr     *fault.Fault
+	}{
+		"nil request": {
+			expErr: pbin.PrivilegedHelperRequestFailed("nil request"),
+		},
+		"ScmUnlock nil payload": {
+			req: &pbin.Request{
+				Method: "ScmUnlock",
+			},
+			expErr: pbin.PrivilegedHelperRequestFailed("unexpected end of JSON input"),
+		},
+		"ScmUnlock success": {
+			req: &pbin.Request{
+				Method:  "ScmUnlock",
+				Payload: scmUnlockReqPayload,
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes: storage.ScmModules{locked},
+			},
+			expPayload: &storage.ScmUnlockResponse{
+				Modules: storage.ScmModules{unlocked},
+			},
+		},
+		"ScmUnlock failure": {
+			req: &pbin.Request{
+				Method:  "ScmUnlock",
+				Payload: scmUnlockReqPayload,
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes:    storage.ScmModules{locked},
+				UnlockModulesErr: errors.New("bad passphrase"),
+			},
+			expErr: pbin.PrivilegedHelperRequestFailed("bad passphrase"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			sp := scm.NewMockProvider(log, tc.smbc, nil)
+			handler := &scmUnlockHandler{scmHandler: scmHandler{scmProvider: sp}}
+
+			resp := handler.Handle(log, tc.req)
+
+			if diff := cmp.Diff(tc.expErr, resp.Error); diff != "" {
+				t.Errorf("got wrong fault (-want, +got)\n%s\n", diff)
+			}
+			if tc.expPayload == nil {
+				tc.expPayload = &storage.ScmUnlockResponse{}
+			}
+			expectPayload(t, resp, &storage.ScmUnlockResponse{}, tc.expPayload)
+		})
+	}
+}
+
 func TestDaosAdmin_ScmScanHandler(t *testing.T) {
 	scmScanReqPayload, err := json.Marshal(storage.ScmScanRequest{
 		ForwardableRequest: pbin.ForwardableRequest{Forwarded: true},
@@ -42,10 +42,16 @@ func addMethodHandlers(app *pbin.App) {
 	app.AddHandler("ScmCheckFormat", &scmFormatCheckHandler{})
 	app.AddHandler("ScmScan", &scmScanHandler{})
 	app.AddHandler("ScmPrepare", &scmPrepHandler{})
+	app.AddHandler("ScmUnlock", &scmUnlockHandler{})
 
 	app.AddHandler("BdevPrepare", &bdevPrepHandler{})
 	app.AddHandler("BdevScan", &bdevScanHandler{})
 	app.AddHandler("BdevFormat", &bdevFormatHandler{})
 	app.AddHandler("BdevWriteConfig", &bdevWriteConfigHandler{})
 	app.AddHandler("BdevReadConfig", &bdevReadConfigHandler{})
+	app.AddHandler("BdevAttachController", &bdevAttachControllerHandler{})
+	app.AddHandler("BdevDetachController", &bdevDetachControllerHandler{})
+	app.AddHandler("BdevInjectErrors", &bdevInjectErrorsHandler{})
+	app.AddHandler("BdevCreateNamespaces", &bdevCreateNamespacesHandler{})
+	app.AddHandler("BdevDeleteNamespace", &bdevDeleteNamespaceHandler{})
 }
@@ -598,6 +598,7 @@ allow_numa_imbalance: false
 control_log_mask: INFO
 control_log_file: /var/log/daos/daos_server.log
 core_dump_filter: 19
+version: 2
 name: daos_server
 socket_dir: /var/run/daos_server
 provider: ofi+verbs
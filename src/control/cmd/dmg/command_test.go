@@ -11,6 +11,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path"
@@ -21,11 +22,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -161,6 +164,8 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemQueryResp{})
 	case *control.SystemCleanupReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemCleanupResp{})
+	case *control.SystemEventsQueryReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemEventsQueryResp{})
 	case *control.LeaderQueryReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.LeaderQueryResp{})
 	case *control.ListPoolsReq:
@@ -180,6 +185,43 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolRanksResp{})
 	case *control.PoolExtendReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolExtendResp{})
+	case *control.PoolResizeReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolResizeResp{})
+	case *control.PoolCloneReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolCloneResp{})
+	case *control.PoolProfileSetReq, *control.PoolProfileDeleteReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
+	case *control.PoolProfileGetReq:
+		profiles := make(map[string]string)
+		for _, name := range req.Names {
+			if name == "prod" {
+				profiles[name] = `{"properties":{"reclaim":"lazy"},"tier_ratio":[0.1,0.9],"nsvc":5}`
+			}
+		}
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolProfileGetResp{
+			Profiles: profiles,
+		})
+	case *control.ContListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.ListContResp{
+			Containers: []*mgmtpb.ListContResp_Cont{
+				{Uuid: test.MockUUID(1), Label: "cont1"},
+			},
+		})
+	case *control.JobSubmitReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.JobSubmitResp{Id: "job-1"})
+	case *control.JobListReq:
+		jobs := []*mgmtpb.Job{}
+		for _, id := range req.IDs {
+			jobs = append(jobs, &mgmtpb.Job{
+				Id:      id,
+				State:   mgmtpb.JobState_JOB_SUCCEEDED,
+				Created: "2025-03-01T10:00:00Z",
+				Updated: "2025-03-01T10:01:00Z",
+			})
+		}
+		resp = control.MockMSResponse("", nil, &mgmtpb.JobListResp{Jobs: jobs})
+	case *control.JobCancelReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.PoolRebuildManageReq:
 		if req.OpCode == control.PoolRebuildOpCodeStart {
 			resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
@@ -320,3 +362,125 @@ func TestNoCommand(t *testing.T) {
 	err := parseOpts([]string{}, &opts, nil, log)
 	testExpectedError(t, fmt.Errorf("Please specify one command"), err)
 }
+
+func TestDmg_resolveHostSetFlag(t *testing.T) {
+	members := []*mgmtpb.SystemMember{
+		{Rank: 0, Addr: "10.0.0.1:10001", FaultDomain: "/rack0/node1"},
+		{Rank: 1, Addr: "10.0.0.1:10001", FaultDomain: "/rack0/node1"},
+		{Rank: 2, Addr: "10.0.0.2:10001", FaultDomain: "/rack0/node2"},
+		{Rank: 3, Addr: "10.0.0.3:10001", FaultDomain: "/rack1/node3"},
+		{Rank: 4, Addr: "10.0.0.9:10001"},
+	}
+
+	for name, tc := range map[string]struct {
+		arg         string
+		uErr        error
+		expHostList []string
+		expErr      error
+	}{
+		"no pattern": {
+			arg:         "node[1-2]",
+			expHostList: []string{"node1", "node2"},
+		},
+		"pattern": {
+			arg:         "/^node[12]$/",
+			expHostList: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+		},
+		"pattern with exclusion": {
+			arg:         "/^node/!node2",
+			expHostList: []string{"10.0.0.1:10001", "10.0.0.3:10001"},
+		},
+		"pattern matches address of member without fault domain": {
+			arg:         `/^10\.0\.0\.9$/`,
+			expHostList: []string{"10.0.0.9:10001"},
+		},
+		"no matches": {
+			arg:    "/^server/",
+			expErr: errors.New("no hosts selected"),
+		},
+		"query fails": {
+			arg:    "/^node/",
+			uErr:   errors.New("whoops"),
+			expErr: errors.New("whoops"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := &control.MockInvokerConfig{
+				UnaryError: tc.uErr,
+				UnaryResponse: control.MockMSResponse("", nil,
+					&mgmtpb.SystemQueryResp{Members: members}),
+			}
+
+			var flag ui.HostSetFlag
+			if err := flag.UnmarshalFlag(tc.arg); err != nil {
+				t.Fatal(err)
+			}
+
+			gotErr := resolveHostSetFlag(test.Context(t), control.NewMockInvoker(log, mic), &flag)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expHostList, flag.Slice()); diff != "" {
+				t.Fatalf("unexpected host list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_outputFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		opts      cliOptions
+		expFormat cmdutil.OutputFormat
+		expFields []string
+		expErr    error
+	}{
+		"defaults": {},
+		"table": {
+			opts: cliOptions{Format: "table"},
+		},
+		"json flag": {
+			opts:      cliOptions{JSON: true},
+			expFormat: cmdutil.FormatJSON,
+		},
+		"json flag and format": {
+			opts:      cliOptions{JSON: true, Format: "json"},
+			expFormat: cmdutil.FormatJSON,
+		},
+		"json flag conflicts with format": {
+			opts:   cliOptions{JSON: true, Format: "csv"},
+			expErr: errors.New("can not be used with --format=csv"),
+		},
+		"yaml": {
+			opts:      cliOptions{Format: "yaml"},
+			expFormat: cmdutil.FormatYAML,
+		},
+		"csv with fields": {
+			opts:      cliOptions{Format: "csv", Fields: "rank, addr,,state"},
+			expFormat: cmdutil.FormatCSV,
+			expFields: []string{"rank", "addr", "state"},
+		},
+		"fields select table": {
+			opts:      cliOptions{Fields: "rank,fault_domain"},
+			expFormat: cmdutil.FormatTable,
+			expFields: []string{"rank", "fault_domain"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotFormat, gotFields, gotErr := tc.opts.outputFormat()
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expFormat, gotFormat, "unexpected format")
+			if diff := cmp.Diff(tc.expFields, gotFields); diff != "" {
+				t.Fatalf("unexpected fields (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
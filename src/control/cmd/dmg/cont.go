@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,9 +8,12 @@
 package main
 
 import (
+	"strings"
+
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/ui"
 )
@@ -30,9 +34,64 @@ type contCmd struct {
 
 // ContCmd is the struct representing the top-level container subcommand.
 type ContCmd struct {
+	List     ContListCmd     `command:"list" alias:"ls" description:"List the containers in a DAOS pool"`
+	Query    ContQueryCmd    `command:"query" description:"Display the details of a DAOS container"`
 	SetOwner ContSetOwnerCmd `command:"set-owner" description:"Change the owner for a DAOS container"`
 }
 
+// ContListCmd is the struct representing the command to list the containers in a DAOS pool.
+type ContListCmd struct {
+	poolCmd
+}
+
+// Execute runs the container list command
+func (cmd *ContListCmd) Execute(_ []string) error {
+	req := &control.ContListReq{
+		PoolID: cmd.PoolID().String(),
+	}
+
+	resp, err := control.ContList(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintContainers(&bld, resp.Containers...)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// ContQueryCmd is the struct representing the command to display the details of a DAOS container.
+type ContQueryCmd struct {
+	contCmd
+}
+
+// Execute runs the container query command
+func (cmd *ContQueryCmd) Execute(_ []string) error {
+	req := &control.ContQueryReq{
+		PoolID: cmd.poolCmd.Args.Pool.String(),
+		ContID: cmd.Args.Cont.String(),
+	}
+
+	resp, err := control.ContQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	pretty.PrintContainers(&bld, resp)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
 // ContSetOwnerCmd is the struct representing the command to change the owner of a DAOS container.
 type ContSetOwnerCmd struct {
 	contCmd
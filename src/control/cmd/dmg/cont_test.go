@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -85,3 +86,61 @@ func TestContSetOwnerCommand(t *testing.T) {
 		},
 	})
 }
+
+func TestContListCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"List with no arguments",
+			"cont list",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"List containers",
+			fmt.Sprintf("cont list %s", testPoolUUID),
+			printRequest(t, &control.ContListReq{
+				PoolID: testPoolUUID.String(),
+			}),
+			nil,
+		},
+		{
+			"List containers with pool label",
+			"cont ls tank",
+			printRequest(t, &control.ContListReq{
+				PoolID: "tank",
+			}),
+			nil,
+		},
+	})
+}
+
+func TestContQueryCommand(t *testing.T) {
+	testPoolUUID := uuid.New()
+
+	runCmdTests(t, []cmdTest{
+		{
+			"Query with no container",
+			fmt.Sprintf("cont query %s", testPoolUUID),
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Query container by label",
+			fmt.Sprintf("cont query %s cont1", testPoolUUID),
+			printRequest(t, &control.ContListReq{
+				PoolID: testPoolUUID.String(),
+			}),
+			nil,
+		},
+		{
+			"Query unknown container",
+			fmt.Sprintf("cont query %s missing", testPoolUUID),
+			printRequest(t, &control.ContListReq{
+				PoolID: testPoolUUID.String(),
+			}),
+			errors.New("DER_NONEXIST"),
+		},
+	})
+}
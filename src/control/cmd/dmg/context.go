@@ -0,0 +1,195 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+const dmgConfigFile = ".daos/dmg.yml"
+
+// dmgFlagDefaults holds the default values of global flags, applied when the
+// flags are not given on the command line.
+type dmgFlagDefaults struct {
+	AllowProxy bool   `yaml:"allow_proxy"`
+	Insecure   bool   `yaml:"insecure"`
+	Debug      bool   `yaml:"debug"`
+	JSON       bool   `yaml:"json"`
+	JSONLogs   bool   `yaml:"json_logging"`
+	LogFile    string `yaml:"log_file"`
+}
+
+// dmgContext describes how to connect to a DAOS system. Settings that are
+// not given are taken from the control configuration file.
+type dmgContext struct {
+	ConfigPath    string   `yaml:"config_path"`
+	SystemName    string   `yaml:"name"`
+	ControlPort   int      `yaml:"port"`
+	HostList      []string `yaml:"hostlist"`
+	AllowInsecure *bool    `yaml:"allow_insecure"`
+	CARootPath    string   `yaml:"ca_cert"`
+	CertPath      string   `yaml:"cert"`
+	KeyPath       string   `yaml:"key"`
+}
+
+// dmgConfig is the per-user dmg configuration, containing default flag values
+// and named contexts for the DAOS systems managed by the user.
+type dmgConfig struct {
+	DefaultContext string                 `yaml:"default_context"`
+	Defaults       dmgFlagDefaults        `yaml:"defaults"`
+	Contexts       map[string]*dmgContext `yaml:"contexts"`
+	Path           string                 `yaml:"-"`
+}
+
+// dmgConfigPath returns the path to the per-user dmg configuration file.
+func dmgConfigPath() string {
+	// If we can't determine $HOME it's weird but not fatal.
+	userHome, _ := os.UserHomeDir()
+	return filepath.Join(userHome, dmgConfigFile)
+}
+
+// loadDmgConfig reads the dmg configuration from the given path. A missing
+// file is not an error and results in a nil config.
+func loadDmgConfig(cfgPath string) (*dmgConfig, error) {
+	data, err := os.ReadFile(cfgPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	cfg := new(dmgConfig)
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", cfgPath)
+	}
+	cfg.Path = cfgPath
+
+	if cfg.DefaultContext != "" {
+		if _, err := cfg.getContext(cfg.DefaultContext); err != nil {
+			return nil, errors.Wrap(err, "default_context")
+		}
+	}
+	for name, dc := range cfg.Contexts {
+		if dc == nil {
+			return nil, errors.Errorf("context %q is empty in %s", name, cfgPath)
+		}
+		if dc.SystemName != "" && !daos.SystemNameIsValid(dc.SystemName) {
+			return nil, errors.Errorf("invalid system name %q in context %q", dc.SystemName, name)
+		}
+	}
+
+	return cfg, nil
+}
+
+// getContext returns the named context.
+func (cfg *dmgConfig) getContext(name string) (*dmgContext, error) {
+	if dc, found := cfg.Contexts[name]; found {
+		return dc, nil
+	}
+
+	names := make([]string, 0, len(cfg.Contexts))
+	for n := range cfg.Contexts {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	return nil, errors.Errorf("unknown context %q in %s (available: %s)", name, cfg.Path,
+		strings.Join(names, ", "))
+}
+
+// selectContext returns the context requested on the command line, or the
+// default context if none was requested. A nil context is returned if no
+// context is requested or set as the default.
+func (cfg *dmgConfig) selectContext(name string) (*dmgContext, error) {
+	if cfg == nil {
+		if name != "" {
+			return nil, errors.Errorf("context %q requested but %s does not exist",
+				name, dmgConfigPath())
+		}
+		return nil, nil
+	}
+
+	if name == "" {
+		name = cfg.DefaultContext
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return cfg.getContext(name)
+}
+
+// applyDefaults sets the global flags that were not given on the command line
+// to their configured default values.
+func (cfg *dmgConfig) applyDefaults(opts *cliOptions) {
+	if cfg == nil {
+		return
+	}
+
+	def := cfg.Defaults
+	opts.AllowProxy = opts.AllowProxy || def.AllowProxy
+	opts.Insecure = opts.Insecure || def.Insecure
+	opts.Debug = opts.Debug || def.Debug
+	opts.JSONLogs = opts.JSONLogs || def.JSONLogs
+	// JSON output is only a default if no other output format was requested.
+	if opts.Format == "" {
+		opts.JSON = opts.JSON || def.JSON
+	}
+	if opts.LogFile == "" {
+		opts.LogFile = def.LogFile
+	}
+}
+
+// configPath returns the control configuration file to load, preferring the
+// path given on the command line.
+func (dc *dmgContext) configPath(cliPath string) string {
+	if cliPath != "" || dc == nil {
+		return cliPath
+	}
+	return dc.ConfigPath
+}
+
+// apply overrides the control configuration with the settings of the context.
+func (dc *dmgContext) apply(cfg *control.Config) {
+	if dc == nil {
+		return
+	}
+
+	if dc.SystemName != "" {
+		cfg.SystemName = dc.SystemName
+	}
+	if dc.ControlPort != 0 {
+		cfg.ControlPort = dc.ControlPort
+	}
+	if len(dc.HostList) > 0 {
+		cfg.HostList = dc.HostList
+	}
+
+	tc := cfg.TransportConfig
+	if dc.AllowInsecure != nil {
+		tc.AllowInsecure = *dc.AllowInsecure
+	}
+	if dc.CARootPath != "" {
+		tc.CARootPath = dc.CARootPath
+	}
+	if dc.CertPath != "" {
+		tc.CertificatePath = dc.CertPath
+	}
+	if dc.KeyPath != "" {
+		tc.PrivateKeyPath = dc.KeyPath
+	}
+}
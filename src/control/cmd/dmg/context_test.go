@@ -0,0 +1,274 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const testDmgConfig = `
+default_context: prod
+defaults:
+  json: true
+  log_file: /tmp/dmg.log
+contexts:
+  prod:
+    name: prod_sys
+    port: 10002
+    hostlist: ['prod-[01-64]']
+    ca_cert: /etc/daos/prod/daosCA.crt
+    cert: /etc/daos/prod/admin.crt
+    key: /etc/daos/prod/admin.key
+  test:
+    config_path: /etc/daos/test_control.yml
+    allow_insecure: true
+`
+
+func TestDmg_loadDmgConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content string
+		noFile  bool
+		expCfg  *dmgConfig
+		expErr  error
+	}{
+		"missing file": {
+			noFile: true,
+		},
+		"valid": {
+			content: testDmgConfig,
+			expCfg: &dmgConfig{
+				DefaultContext: "prod",
+				Defaults: dmgFlagDefaults{
+					JSON:    true,
+					LogFile: "/tmp/dmg.log",
+				},
+				Contexts: map[string]*dmgContext{
+					"prod": {
+						SystemName:  "prod_sys",
+						ControlPort: 10002,
+						HostList:    []string{"prod-[01-64]"},
+						CARootPath:  "/etc/daos/prod/daosCA.crt",
+						CertPath:    "/etc/daos/prod/admin.crt",
+						KeyPath:     "/etc/daos/prod/admin.key",
+					},
+					"test": {
+						ConfigPath:    "/etc/daos/test_control.yml",
+						AllowInsecure: func() *bool { b := true; return &b }(),
+					},
+				},
+			},
+		},
+		"unknown field": {
+			content: "contexts:\n  prod:\n    hosts: [foo]\n",
+			expErr:  errors.New("field hosts not found"),
+		},
+		"unknown default context": {
+			content: "default_context: dev\ncontexts:\n  prod:\n    port: 10001\n",
+			expErr:  errors.New(`unknown context "dev"`),
+		},
+		"empty context": {
+			content: "contexts:\n  prod:\n",
+			expErr:  errors.New(`context "prod" is empty`),
+		},
+		"invalid system name": {
+			content: "contexts:\n  prod:\n    name: 'a_system_name_that_is_much_too_long'\n",
+			expErr:  errors.New("invalid system name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfgPath := filepath.Join(dir, "dmg.yml")
+			if !tc.noFile {
+				cfgPath = test.CreateTestFile(t, dir, tc.content)
+			}
+
+			gotCfg, gotErr := loadDmgConfig(cfgPath)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expCfg != nil {
+				tc.expCfg.Path = cfgPath
+			}
+			if diff := cmp.Diff(tc.expCfg, gotCfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDmg_dmgConfig_selectContext(t *testing.T) {
+	prod := &dmgContext{SystemName: "prod"}
+	cfg := &dmgConfig{
+		DefaultContext: "prod",
+		Contexts: map[string]*dmgContext{
+			"prod": prod,
+			"test": {SystemName: "test"},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg    *dmgConfig
+		name   string
+		expCtx *dmgContext
+		expErr error
+	}{
+		"no config": {},
+		"no config; context requested": {
+			name:   "prod",
+			expErr: errors.New(`context "prod" requested`),
+		},
+		"default context": {
+			cfg:    cfg,
+			expCtx: prod,
+		},
+		"no default context": {
+			cfg: &dmgConfig{Contexts: cfg.Contexts},
+		},
+		"requested context": {
+			cfg:    cfg,
+			name:   "test",
+			expCtx: cfg.Contexts["test"],
+		},
+		"unknown context": {
+			cfg:    cfg,
+			name:   "dev",
+			expErr: errors.New(`unknown context "dev"`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCtx, gotErr := tc.cfg.selectContext(tc.name)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if gotCtx != tc.expCtx {
+				t.Fatalf("expected context %+v, got %+v", tc.expCtx, gotCtx)
+			}
+		})
+	}
+}
+
+func TestDmg_dmgConfig_applyDefaults(t *testing.T) {
+	defaults := dmgFlagDefaults{
+		Insecure: true,
+		JSON:     true,
+		LogFile:  "/tmp/dmg.log",
+	}
+
+	for name, tc := range map[string]struct {
+		cfg     *dmgConfig
+		opts    cliOptions
+		expOpts cliOptions
+	}{
+		"no config": {
+			opts:    cliOptions{Debug: true},
+			expOpts: cliOptions{Debug: true},
+		},
+		"defaults applied": {
+			cfg:  &dmgConfig{Defaults: defaults},
+			opts: cliOptions{Debug: true},
+			expOpts: cliOptions{
+				Debug:    true,
+				Insecure: true,
+				JSON:     true,
+				LogFile:  "/tmp/dmg.log",
+			},
+		},
+		"command line takes precedence": {
+			cfg:  &dmgConfig{Defaults: defaults},
+			opts: cliOptions{LogFile: "/tmp/other.log", Format: "yaml"},
+			expOpts: cliOptions{
+				Insecure: true,
+				LogFile:  "/tmp/other.log",
+				Format:   "yaml",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.cfg.applyDefaults(&tc.opts)
+
+			test.AssertEqual(t, tc.expOpts.Insecure, tc.opts.Insecure, "insecure")
+			test.AssertEqual(t, tc.expOpts.Debug, tc.opts.Debug, "debug")
+			test.AssertEqual(t, tc.expOpts.JSON, tc.opts.JSON, "json")
+			test.AssertEqual(t, tc.expOpts.LogFile, tc.opts.LogFile, "log file")
+			test.AssertEqual(t, tc.expOpts.Format, tc.opts.Format, "format")
+		})
+	}
+}
+
+func TestDmg_dmgContext_apply(t *testing.T) {
+	insecure := true
+
+	for name, tc := range map[string]struct {
+		dc      *dmgContext
+		cliPath string
+		expPath string
+		expCfg  func(*control.Config)
+	}{
+		"no context": {
+			cliPath: "/etc/daos/daos_control.yml",
+			expPath: "/etc/daos/daos_control.yml",
+		},
+		"context config path": {
+			dc:      &dmgContext{ConfigPath: "/etc/daos/test_control.yml"},
+			expPath: "/etc/daos/test_control.yml",
+		},
+		"command line config path takes precedence": {
+			dc:      &dmgContext{ConfigPath: "/etc/daos/test_control.yml"},
+			cliPath: "/etc/daos/daos_control.yml",
+			expPath: "/etc/daos/daos_control.yml",
+		},
+		"overrides": {
+			dc: &dmgContext{
+				SystemName:    "prod",
+				ControlPort:   10002,
+				HostList:      []string{"prod-[01-64]"},
+				AllowInsecure: &insecure,
+				CertPath:      "/etc/daos/prod/admin.crt",
+			},
+			expCfg: func(cfg *control.Config) {
+				cfg.SystemName = "prod"
+				cfg.ControlPort = 10002
+				cfg.HostList = []string{"prod-[01-64]"}
+				cfg.TransportConfig.AllowInsecure = true
+				cfg.TransportConfig.CertificatePath = "/etc/daos/prod/admin.crt"
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expPath, tc.dc.configPath(tc.cliPath), "config path")
+
+			gotCfg := control.DefaultConfig()
+			tc.dc.apply(gotCfg)
+
+			expCfg := control.DefaultConfig()
+			if tc.expCfg != nil {
+				tc.expCfg(expCfg)
+			}
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(security.CertificateConfig{}),
+			}
+			if diff := cmp.Diff(expCfg, gotCfg, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -116,12 +117,16 @@ type firmwareUpdateCmd struct {
 	ctlInvokerCmd
 	hostListCmd
 	cmdutil.JSONOutputCmd
-	DeviceType  string `short:"t" long:"type" choice:"nvme" choice:"scm" required:"1" description:"Type of storage devices to update"`
-	FilePath    string `short:"p" long:"path" required:"1" description:"Path to the firmware file accessible from all nodes"`
-	Devices     string `short:"d" long:"devices" description:"Comma-separated list of device identifiers to update"`
-	ModelID     string `short:"m" long:"model" description:"Limit update to a model ID"`
-	FirmwareRev string `short:"f" long:"fwrev" description:"Limit update to a current firmware revision"`
-	Verbose     bool   `short:"v" long:"verbose" description:"Display verbose output"`
+	DeviceType   string `short:"t" long:"type" choice:"nvme" choice:"scm" required:"1" description:"Type of storage devices to update"`
+	FilePath     string `short:"p" long:"path" required:"1" description:"Path to the firmware file accessible from all nodes"`
+	Devices      string `short:"d" long:"devices" description:"Comma-separated list of device identifiers to update"`
+	ModelID      string `short:"m" long:"model" description:"Limit update to a model ID"`
+	FirmwareRev  string `short:"f" long:"fwrev" description:"Limit update to a current firmware revision"`
+	Slot         uint32 `short:"s" long:"slot" description:"NVMe firmware slot to write the image to (1-7), chosen by the device if unset"`
+	Stage        bool   `long:"stage" description:"Stage NVMe firmware for activation on the next controller reset rather than immediately"`
+	Activate     bool   `long:"activate" description:"Verify and activate SCM firmware once it has been staged on all hosts"`
+	RollbackPath string `long:"rollback-path" description:"Path to SCM firmware to re-stage on all hosts if any step of the update fails"`
+	Verbose      bool   `short:"v" long:"verbose" description:"Display verbose output"`
 }
 
 // Execute runs the firmware update command.
@@ -132,6 +137,10 @@ func (cmd *firmwareUpdateCmd) Execute(args []string) error {
 		FirmwarePath: cmd.FilePath,
 		ModelID:      cmd.ModelID,
 		FirmwareRev:  cmd.FirmwareRev,
+		Slot:         cmd.Slot,
+		Stage:        cmd.Stage,
+		ScmActivate:  cmd.Activate,
+		RollbackPath: cmd.RollbackPath,
 	}
 
 	if cmd.isSCMUpdate() {
@@ -181,10 +190,18 @@ func (cmd *firmwareUpdateCmd) printUpdateResult(resp *control.FirmwareUpdateResp
 }
 
 func (cmd *firmwareUpdateCmd) printSCMUpdateResult(resp *control.FirmwareUpdateResp, out io.Writer) error {
+	printMap := pretty.PrintSCMFirmwareUpdateMap
 	if cmd.Verbose {
-		return pretty.PrintSCMFirmwareUpdateMapVerbose(resp.HostSCMResult, out)
+		printMap = pretty.PrintSCMFirmwareUpdateMapVerbose
+	}
+	if err := printMap(resp.HostSCMResult, out); err != nil {
+		return err
+	}
+
+	if cmd.Activate || cmd.RollbackPath != "" {
+		pretty.PrintSCMFirmwareUpdateSummary(resp, out)
 	}
-	return pretty.PrintSCMFirmwareUpdateMap(resp.HostSCMResult, out)
+	return nil
 }
 
 func (cmd *firmwareUpdateCmd) printNVMeUpdateResult(resp *control.FirmwareUpdateResp, out io.Writer) error {
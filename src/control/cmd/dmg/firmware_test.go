@@ -16,6 +16,13 @@ import (
 )
 
 func TestFirmwareCommands(t *testing.T) {
+	scmActivateReq := &control.FirmwareUpdateReq{
+		FirmwarePath: "/dont/care",
+		Type:         control.DeviceTypeSCM,
+		ScmActivate:  true,
+		RollbackPath: "/old/fw",
+	}
+
 	runCmdTests(t, []cmdTest{
 		{
 			"Query with no args defaults to all",
@@ -163,6 +170,48 @@ func TestFirmwareCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Update NVMe with slot and stage",
+			"firmware update --type=nvme --path=/dont/care --slot=2 --stage",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					FirmwarePath: "/dont/care",
+					Type:         control.DeviceTypeNVMe,
+					Slot:         2,
+					Stage:        true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Update SCM with activate and rollback",
+			"firmware update --type=scm --path=/dont/care --activate --rollback-path=/old/fw",
+			strings.Join([]string{
+				// stage, verify and activate
+				printRequest(t, scmActivateReq),
+				printRequest(t, scmActivateReq),
+				printRequest(t, scmActivateReq),
+			}, " "),
+			nil,
+		},
+		{
+			"Update NVMe with activate",
+			"firmware update --type=nvme --path=/dont/care --activate",
+			"",
+			errors.New("only apply to SCM"),
+		},
+		{
+			"Storage subcommand update with NVMe",
+			"storage firmware update --type=nvme --path=/dont/care -s 3",
+			strings.Join([]string{
+				printRequest(t, &control.FirmwareUpdateReq{
+					FirmwarePath: "/dont/care",
+					Type:         control.DeviceTypeNVMe,
+					Slot:         3,
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Update with model ID",
 			"firmware update --type=scm --path=/dont/care --model=Model1",
@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// jobCmd is the struct representing the top-level job subcommand.
+type jobCmd struct {
+	List   jobListCmd   `command:"list" alias:"ls" description:"List asynchronous jobs held by the management service"`
+	Status jobStatusCmd `command:"status" description:"Display the state of a job"`
+	Wait   jobWaitCmd   `command:"wait" description:"Wait for a job to finish, displaying its progress"`
+	Cancel jobCancelCmd `command:"cancel" description:"Cancel a running job"`
+}
+
+type jobBaseCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+type jobIDCmd struct {
+	jobBaseCmd
+
+	Args struct {
+		ID string `positional-arg-name:"<job ID>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// jobListCmd is the struct representing the command to list jobs.
+type jobListCmd struct {
+	jobBaseCmd
+}
+
+// Execute is run when jobListCmd subcommand is activated
+func (cmd *jobListCmd) Execute(_ []string) error {
+	resp, err := control.JobList(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.JobListReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "job list failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintJobs(&bld, resp.Jobs...)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// jobStatusCmd is the struct representing the command to display the state of a job.
+type jobStatusCmd struct {
+	jobIDCmd
+}
+
+// Execute is run when jobStatusCmd subcommand is activated
+func (cmd *jobStatusCmd) Execute(_ []string) error {
+	req := &control.JobListReq{IDs: []string{cmd.Args.ID}}
+	resp, err := control.JobList(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "job status failed")
+	}
+
+	return printJobResult(cmd.Logger, resp.Jobs...)
+}
+
+// jobWaitCmd is the struct representing the command to wait for a job to finish.
+type jobWaitCmd struct {
+	jobIDCmd
+}
+
+// Execute is run when jobWaitCmd subcommand is activated
+func (cmd *jobWaitCmd) Execute(_ []string) error {
+	var lastProgress uint32
+	req := &control.JobWaitReq{
+		ID: cmd.Args.ID,
+		OnUpdate: func(job *control.Job) {
+			if cmd.JSONOutputEnabled() || job.Finished() || job.Progress == lastProgress {
+				return
+			}
+			lastProgress = job.Progress
+			cmd.Infof("Job %s: %d%% complete", job.ID, job.Progress)
+		},
+	}
+
+	job, err := control.JobWait(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(job, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "job wait failed")
+	}
+
+	return printJobResult(cmd.Logger, job)
+}
+
+// jobCancelCmd is the struct representing the command to cancel a running job.
+type jobCancelCmd struct {
+	jobIDCmd
+}
+
+// Execute is run when jobCancelCmd subcommand is activated
+func (cmd *jobCancelCmd) Execute(_ []string) error {
+	req := &control.JobCancelReq{ID: cmd.Args.ID}
+	err := control.JobCancel(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Job %s canceled", cmd.Args.ID)
+	return nil
+}
+
+// printJobResult displays the state of the supplied jobs, including the pool created by a
+// successful pool create job, and returns an error if any of the jobs did not succeed.
+func printJobResult(log logging.Logger, jobs ...*control.Job) error {
+	var bld strings.Builder
+	pretty.PrintJobs(&bld, jobs...)
+	for _, job := range jobs {
+		if job.PoolCreate != nil {
+			bld.WriteString("\n")
+			if err := pretty.PrintPoolCreateResponse(job.PoolCreate, &bld); err != nil {
+				return err
+			}
+		}
+	}
+	log.Info(bld.String())
+
+	for _, job := range jobs {
+		if job.State != control.JobStateSucceeded && job.Finished() {
+			return errors.Errorf("job %s %s", job.ID, job.State)
+		}
+	}
+
+	return nil
+}
+
+// jobSubmitOutput is the JSON output of commands run with --async.
+type jobSubmitOutput struct {
+	JobIDs []string `json:"job_ids"`
+}
+
+// outputJobIDs reports the identifiers of jobs submitted by a command run with --async.
+func outputJobIDs(log logging.Logger, jsonCmd *cmdutil.JSONOutputCmd, opName string, ids []string, err error) error {
+	if jsonCmd.JSONOutputEnabled() {
+		return jsonCmd.OutputJSON(&jobSubmitOutput{JobIDs: ids}, err)
+	}
+
+	for _, id := range ids {
+		log.Infof("%s job submitted: %s", opName, id)
+	}
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		log.Info("Run 'dmg job wait <job ID>' to follow progress")
+	}
+
+	return nil
+}
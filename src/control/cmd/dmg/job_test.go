@@ -0,0 +1,56 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_JobCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"List jobs",
+			"job list",
+			printRequest(t, &control.JobListReq{}),
+			nil,
+		},
+		{
+			"Job status without ID",
+			"job status",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Job status",
+			"job status job-1",
+			printRequest(t, &control.JobListReq{IDs: []string{"job-1"}}),
+			nil,
+		},
+		{
+			"Wait for job",
+			"job wait job-1",
+			printRequest(t, &control.JobListReq{IDs: []string{"job-1"}}),
+			nil,
+		},
+		{
+			"Cancel job without ID",
+			"job cancel",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Cancel job",
+			"job cancel job-1",
+			printRequest(t, &control.JobCancelReq{ID: "job-1"}),
+			nil,
+		},
+	})
+}
@@ -68,7 +68,7 @@ func TestDmg_JsonOutput(t *testing.T) {
 			testArgs := append([]string{"-i", "--json"}, args...)
 			switch strings.Join(args, " ") {
 			case "version", "telemetry config", "telemetry run", "config generate",
-				"manpage", "system set-prop", "support collect-log", "check repair":
+				"manpage", "system set-prop", "support collect-log", "check repair", "shell":
 				return
 			case "storage nvme-rebind":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
@@ -76,12 +76,22 @@ func TestDmg_JsonOutput(t *testing.T) {
 			case "storage nvme-add-device":
 				testArgs = append(testArgs, "-l", "foo.com", "-a",
 					test.MockPCIAddr(), "-e", "0")
+			case "storage nvme-ns-create":
+				testArgs = append(testArgs, "-l", "foo.com", "-a",
+					test.MockPCIAddr())
+			case "storage nvme-ns-delete":
+				testArgs = append(testArgs, "-l", "foo.com", "-a",
+					test.MockPCIAddr(), "-n", "1")
+			case "storage render-config":
+				testArgs = append(testArgs, "-l", "foo.com")
 			case "storage set nvme-faulty":
 				testArgs = append(testArgs, "--host", "foo.com", "--force", "-u",
 					test.MockUUID())
 			case "storage replace nvme":
 				testArgs = append(testArgs, "--host", "foo.com", "--old-uuid",
 					test.MockUUID(), "--new-uuid", test.MockUUID())
+			case "storage firmware update":
+				testArgs = append(testArgs, "-t", "nvme", "-p", "/dont/care")
 			case "storage led identify", "storage led check", "storage led clear":
 				testArgs = append(testArgs, test.MockUUID())
 			case "pool create":
@@ -99,12 +109,28 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, test.MockUUID(), "label")
 			case "pool extend", "pool exclude", "pool drain", "pool reintegrate":
 				testArgs = append(testArgs, test.MockUUID(), "--ranks", "0")
+			case "pool resize":
+				testArgs = append(testArgs, test.MockUUID(), "-n", "10G")
+			case "pool clone":
+				testArgs = append(testArgs, test.MockUUID(), "clone")
+			case "pool profile set", "pool profile get", "pool profile delete":
+				testArgs = append(testArgs, "prod")
+			case "job status", "job wait", "job cancel":
+				testArgs = append(testArgs, "job-1")
 			case "pool query-targets":
 				testArgs = append(testArgs, test.MockUUID(), "--rank", "0", "--target-idx", "1,3,5,7")
 			case "container set-owner":
 				testArgs = append(testArgs, "--user", "foo", test.MockUUID(), test.MockUUID())
+			case "container list":
+				testArgs = append(testArgs, test.MockUUID())
+			case "container query":
+				testArgs = append(testArgs, test.MockUUID(), "cont1")
 			case "telemetry metrics list", "telemetry metrics query":
 				return // These commands query via http directly
+			case "system rolling-restart":
+				return // Requires joined ranks in the system query response
+			case "server standby":
+				testArgs = append(testArgs, "-e", "0")
 			case "system cleanup":
 				testArgs = append(testArgs, "hostname")
 			case "check set-policy":
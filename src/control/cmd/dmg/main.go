@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -28,6 +30,7 @@ import (
 
 type (
 	hostListGetter interface {
+		resolveHostList(context.Context, control.UnaryInvoker) error
 		getHostList() []string
 	}
 
@@ -36,7 +39,7 @@ type (
 	}
 
 	hostListCmd struct {
-		HostList ui.HostSetFlag `short:"l" long:"host-list" description:"A comma separated list of addresses <ipv4addr/hostname> to connect to"`
+		HostList ui.HostSetFlag `short:"l" long:"host-list" description:"A comma separated list of addresses <ipv4addr/hostname> to connect to, hosts following ! are excluded, or /regex/ to select system members by host name"`
 		hostlist []string
 	}
 
@@ -76,6 +79,38 @@ func (cmd *ctlInvokerCmd) setInvoker(c control.Invoker) {
 	cmd.ctlInvoker = c
 }
 
+// resolveHostSetFlag replaces a host pattern in the flag with the addresses of the system
+// members whose host names match it. The host name of a member is taken from the bottom level
+// of its fault domain, falling back to its IP address.
+func resolveHostSetFlag(ctx context.Context, rpcClient control.UnaryInvoker, flag *ui.HostSetFlag) error {
+	if !flag.HasPattern() {
+		return nil
+	}
+
+	resp, err := control.SystemQuery(ctx, rpcClient, &control.SystemQueryReq{})
+	if err != nil {
+		return errors.Wrapf(err, "querying system members to select hosts by %s", flag)
+	}
+
+	candidates := make(map[string]string)
+	for _, m := range resp.Members {
+		if m.Addr == nil {
+			continue
+		}
+		name := m.Addr.IP.String()
+		if !m.FaultDomain.Empty() {
+			name = m.FaultDomain.BottomLevel()
+		}
+		candidates[name] = m.Addr.String()
+	}
+
+	return flag.Select(candidates)
+}
+
+func (cmd *hostListCmd) resolveHostList(ctx context.Context, rpcClient control.UnaryInvoker) error {
+	return resolveHostSetFlag(ctx, rpcClient, &cmd.HostList)
+}
+
 func (cmd *hostListCmd) getHostList() []string {
 	if cmd.hostlist == nil && !cmd.HostList.Empty() {
 		cmd.hostlist = cmd.HostList.Slice()
@@ -98,8 +133,11 @@ type cliOptions struct {
 	Debug          bool             `short:"d" long:"debug" description:"Enable debug output"`
 	LogFile        string           `long:"log-file" description:"Log command output to the specified file"`
 	JSON           bool             `short:"j" long:"json" description:"Enable JSON output"`
+	Format         string           `long:"format" choice:"table" choice:"json" choice:"yaml" choice:"csv" description:"Output format, json, yaml and csv are machine-readable"`
+	Fields         string           `long:"fields" description:"Comma separated list of fields to output, nested fields are separated by '.'"`
 	JSONLogs       bool             `short:"J" long:"json-logging" description:"Enable JSON-formatted log output"`
 	ConfigPath     string           `short:"o" long:"config-path" description:"Client config file path"`
+	Context        string           `long:"context" description:"Named context in ~/.daos/dmg.yml of the DAOS system to connect to"`
 	Server         serverCmd        `command:"server" alias:"srv" description:"Perform tasks related to remote servers"`
 	Storage        storageCmd       `command:"storage" alias:"sto" description:"Perform tasks related to storage attached to remote servers"`
 	Config         configCmd        `command:"config" alias:"cfg" description:"Perform tasks related to configuration of hardware on remote servers"`
@@ -112,6 +150,9 @@ type cliOptions struct {
 	ServerVersion  serverVersionCmd `command:"server-version" description:"Print server version"`
 	Telemetry      telemCmd         `command:"telemetry" alias:"telem" description:"Perform telemetry operations"`
 	Check          checkCmdRoot     `command:"check" description:"Check system health"`
+	Job            jobCmd           `command:"job" description:"Perform tasks related to asynchronous jobs run by the management service"`
+	Security       securityCmd      `command:"security" alias:"sec" description:"Perform tasks related to the certificates used to secure the control plane"`
+	Shell          shellCmd         `command:"shell" description:"Run dmg commands interactively, reusing connections between commands"`
 	ManPage        cmdutil.ManCmd   `command:"manpage" hidden:"true"`
 	faultsCmdRoot                   // compiled out for release builds
 	firmwareOption                  // build with tag "firmware" to enable
@@ -130,9 +171,8 @@ func (cmd *versionCmd) Execute(_ []string) error {
 		return cmd.OutputJSON(json.RawMessage(buf), nil)
 	}
 
-	fmt.Println(build.String(build.AdminUtilName))
-	os.Exit(0)
-	return nil
+	_, err := fmt.Println(build.String(build.AdminUtilName))
+	return err
 }
 
 type serverVersionCmd struct {
@@ -177,6 +217,28 @@ func exitWithError(log logging.Logger, err error) {
 	os.Exit(1)
 }
 
+// outputFormat returns the output format and fields requested on the command line. An empty
+// format indicates the command's human-readable output.
+func (opts *cliOptions) outputFormat() (cmdutil.OutputFormat, []string, error) {
+	format := cmdutil.OutputFormat(opts.Format)
+	if opts.JSON {
+		if format != "" && format != cmdutil.FormatJSON {
+			return "", nil, errors.Errorf("--json can not be used with --format=%s", format)
+		}
+		format = cmdutil.FormatJSON
+	}
+
+	fields := cmdutil.ParseFields(opts.Fields)
+	if format == cmdutil.FormatTable && len(fields) == 0 {
+		format = ""
+	}
+	if format == "" && len(fields) > 0 {
+		format = cmdutil.FormatTable
+	}
+
+	return format, fields, nil
+}
+
 func parseOpts(args []string, opts *cliOptions, invoker control.Invoker, log *logging.LeveledLogger) error {
 	var wroteJSON atm.Bool
 	p := flags.NewParser(opts, flags.Default)
@@ -199,6 +261,16 @@ and access control settings, along with system wide operations.`
 			return cmd.Execute(args)
 		}
 
+		dmgCfg, err := loadDmgConfig(dmgConfigPath())
+		if err != nil {
+			return errors.Wrap(err, "failed to load dmg configuration")
+		}
+		dmgCfg.applyDefaults(opts)
+		dmgCtx, err := dmgCfg.selectContext(opts.Context)
+		if err != nil {
+			return err
+		}
+
 		if !opts.AllowProxy {
 			common.ScrubProxyVariables()
 		}
@@ -232,10 +304,23 @@ and access control settings, along with system wide operations.`
 			log.WithJSONOutput()
 		}
 
-		if jsonCmd, ok := cmd.(cmdutil.JSONOutputter); ok && opts.JSON {
-			jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
-			// disable output on stdout other than JSON
-			log.ClearLevel(logging.LogLevelInfo)
+		format, fields, err := opts.outputFormat()
+		if err != nil {
+			return err
+		}
+		if format != "" {
+			jsonCmd, ok := cmd.(cmdutil.JSONOutputter)
+			switch {
+			case ok:
+				jsonCmd.EnableJSONOutput(os.Stdout, &wroteJSON)
+				if fmtCmd, ok := cmd.(cmdutil.FormatSetter); ok {
+					fmtCmd.SetOutputFormat(format, fields)
+				}
+				// disable output on stdout other than the formatted response
+				log.ClearLevel(logging.LogLevelInfo)
+			case !opts.JSON:
+				return errors.New("--format and --fields are not supported by this command")
+			}
 		}
 
 		if logCmd, ok := cmd.(cmdutil.LogSetter); ok {
@@ -248,7 +333,7 @@ and access control settings, along with system wide operations.`
 			return cmd.Execute(args)
 		}
 
-		ctlCfg, err := control.LoadConfig(opts.ConfigPath)
+		ctlCfg, err := control.LoadConfig(dmgCtx.configPath(opts.ConfigPath))
 		if err != nil {
 			if errors.Cause(err) != control.ErrNoConfigFile {
 				return errors.Wrap(err, "failed to load control configuration")
@@ -259,6 +344,10 @@ and access control settings, along with system wide operations.`
 		if ctlCfg.Path != "" {
 			log.Debugf("control config loaded from %s", ctlCfg.Path)
 		}
+		if dmgCtx != nil {
+			log.Debugf("using context from %s", dmgCfg.Path)
+			dmgCtx.apply(ctlCfg)
+		}
 
 		if opts.Insecure {
 			ctlCfg.TransportConfig.AllowInsecure = true
@@ -272,9 +361,34 @@ and access control settings, along with system wide operations.`
 			ctlCmd.setInvoker(invoker)
 		}
 
+		if shell, ok := cmd.(*shellCmd); ok {
+			// Each line entered in the shell is parsed as a separate dmg
+			// invocation with the global options given to the shell.
+			shell.setLineRunner(func(lineArgs []string) error {
+				lineOpts := &cliOptions{
+					AllowProxy: opts.AllowProxy,
+					Insecure:   opts.Insecure,
+					Debug:      opts.Debug,
+					LogFile:    opts.LogFile,
+					JSONLogs:   opts.JSONLogs,
+					ConfigPath: opts.ConfigPath,
+					Context:    opts.Context,
+				}
+				return parseOpts(lineArgs, lineOpts, invoker, logging.NewCommandLineLogger())
+			})
+		}
+
+		hlCtx, err := logging.ToContext(context.Background(), log)
+		if err != nil {
+			return err
+		}
+
 		// Handle the deprecated global hostlist flag
 		if !opts.HostList.Empty() {
 			if hlCmd, ok := cmd.(hostListSetter); ok {
+				if err := resolveHostSetFlag(hlCtx, invoker, &opts.HostList); err != nil {
+					return err
+				}
 				hlCmd.setHostList(&opts.HostList.HostSet)
 			} else {
 				return &flags.Error{
@@ -285,6 +399,9 @@ and access control settings, along with system wide operations.`
 		}
 
 		if hlCmd, ok := cmd.(hostListGetter); ok {
+			if err := hlCmd.resolveHostList(hlCtx, invoker); err != nil {
+				return err
+			}
 			hl := hlCmd.getHostList()
 			if len(hl) > 0 {
 				ctlCfg.HostList = hl
@@ -309,8 +426,8 @@ and access control settings, along with system wide operations.`
 	}
 
 	_, err := p.ParseArgs(args)
-	if opts.JSON && wroteJSON.IsFalse() {
-		return cmdutil.OutputJSON(os.Stdout, nil, err)
+	if format, fields, fmtErr := opts.outputFormat(); fmtErr == nil && format != "" && wroteJSON.IsFalse() {
+		return cmdutil.OutputFormatted(os.Stdout, format, fields, nil, err)
 	}
 	return err
 }
@@ -322,9 +439,14 @@ func main() {
 	ctlInvoker := control.NewClient(
 		control.WithClientLogger(log),
 		control.WithClientComponent(build.ComponentAdmin),
+		control.WithClientConnCache(),
 	)
 
-	if err := parseOpts(os.Args[1:], &opts, ctlInvoker, log); err != nil {
+	err := parseOpts(os.Args[1:], &opts, ctlInvoker, log)
+	if closeErr := ctlInvoker.Close(); closeErr != nil {
+		log.Debugf("failed to close connections: %s", closeErr)
+	}
+	if err != nil {
 		if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
 			log.Info(fe.Error())
 			os.Exit(0)
@@ -15,6 +15,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -37,6 +38,8 @@ type PoolCmd struct {
 	Evict        poolEvictCmd        `command:"evict" description:"Evict all pool connections to a DAOS pool"`
 	List         poolListCmd         `command:"list" alias:"ls" description:"List DAOS pools"`
 	Extend       poolExtendCmd       `command:"extend" description:"Extend a DAOS pool to include new ranks"`
+	Resize       poolResizeCmd       `command:"resize" description:"Grow the per-rank NVMe allocation of a DAOS pool"`
+	Clone        poolCloneCmd        `command:"clone" description:"Create a new empty DAOS pool with the layout, properties and ACL of an existing pool, containers are not copied"`
 	Exclude      poolExcludeCmd      `command:"exclude" description:"Exclude targets from a set of ranks"`
 	Drain        poolDrainCmd        `command:"drain" description:"Drain targets from a set of ranks"`
 	Reintegrate  poolReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate targets for a set of rank"`
@@ -50,13 +53,15 @@ type PoolCmd struct {
 	GetProp      poolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
 	Upgrade      poolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
 	Rebuild      poolRebuildCmd      `command:"rebuild" description:"Manage interactive rebuild process for pools"`
+	Profile      poolProfileCmd      `command:"profile" description:"Manage pool profiles stored by the management service"`
 }
 
 var (
 	// Default to 6% SCM:94% NVMe
 	defaultTierRatios         = []float64{0.06, 0.94}
 	errPoolCreateIncompatOpts = errors.New("unsupported option combination, use (--scm-size and " +
-		"--nvme-size) or (--meta-size and --data-size) or (--size)")
+		"--nvme-size) or (--meta-size and --data-size) or (--scm-per-rank and --nvme-per-rank) " +
+		"or (--size)")
 )
 
 type tierRatioFlag struct {
@@ -171,24 +176,30 @@ type poolCreateCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	cmdutil.JSONOutputCmd
-	GroupName  ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
-	UserName   ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
-	Properties PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
-	ACLFile    string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
-	Size       poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
-	TierRatio  tierRatioFlag       `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
-	NumRanks   uint32              `short:"k" long:"nranks" description:"Number of ranks to use (auto)"`
-	NumSvcReps uint32              `short:"v" long:"nsvc" description:"Number of pool service replicas"`
-	ScmSize    ui.ByteSizeFlag     `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
-	NVMeSize   ui.ByteSizeFlag     `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
-	MetaSize   ui.ByteSizeFlag     `long:"meta-size" description:"Per-engine Metadata-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
-	DataSize   ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
-	MemRatio   tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
-	RankList   ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	GroupName   ui.ACLPrincipalFlag `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
+	UserName    ui.ACLPrincipalFlag `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
+	Properties  PoolSetPropsFlag    `short:"P" long:"properties" description:"Pool properties to be set"`
+	ACLFile     string              `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
+	Size        poolSizeFlag        `short:"z" long:"size" description:"Total size of DAOS pool or its percentage ratio (auto)"`
+	TierRatio   tierRatioFlag       `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage (auto; default: 6,94)"`
+	NumRanks    uint32              `short:"k" long:"nranks" description:"Number of ranks to use (auto)"`
+	NumSvcReps  uint32              `short:"v" long:"nsvc" description:"Number of pool service replicas"`
+	ScmSize     ui.ByteSizeFlag     `short:"s" long:"scm-size" description:"Per-engine SCM allocation for DAOS pool (manual)"`
+	NVMeSize    ui.ByteSizeFlag     `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
+	MetaSize    ui.ByteSizeFlag     `long:"meta-size" description:"Per-engine Metadata-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
+	DataSize    ui.ByteSizeFlag     `long:"data-size" description:"Per-engine Data-on-SSD allocation for DAOS pool (manual). Only valid in MD-on-SSD mode"`
+	MemRatio    tierRatioFlag       `long:"mem-ratio" description:"Percentage of the pool metadata storage size (on SSD) that should be used as the memory file size (on ram-disk). Default value is 100% and only valid in MD-on-SSD mode"`
+	RankList    ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	ScmPerRank  poolSizeFlag        `long:"scm-per-rank" description:"Per-rank SCM (or metadata in MD-on-SSD mode) allocation for DAOS pool as a size or a percentage of the space available on each rank, checked against a storage query (per-rank)"`
+	NVMePerRank poolSizeFlag        `long:"nvme-per-rank" description:"Per-rank NVMe (or data in MD-on-SSD mode) allocation for DAOS pool as a size or a percentage of the space available on each rank, checked against a storage query (per-rank)"`
+	ProfileName string              `long:"profile" description:"Name of a stored pool profile to set pool properties, ACL, service replicas and tier ratio from. Values supplied on the command line take precedence"`
+	Async       bool                `long:"async" description:"Submit the pool create as a job run by the management service and return its job ID without waiting"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>" required:"1"`
 	} `positional-args:"yes"`
+
+	profile *control.PoolProfile
 }
 
 func ratio2Percentage(log logging.Logger, scm, nvme float64) (p float64) {
@@ -252,6 +263,9 @@ func (cmd *poolCreateCmd) storageAutoTotal(req *control.PoolCreateReq) error {
 
 	req.NumRanks = cmd.NumRanks
 	req.TierRatio = cmd.TierRatio.Ratios()
+	if !cmd.TierRatio.IsSet() && cmd.profile != nil && len(cmd.profile.TierRatio) > 0 {
+		req.TierRatio = cmd.profile.TierRatio
+	}
 	req.TotalBytes = cmd.Size.Bytes
 
 	// Pass --mem-ratio or zero if unset.
@@ -270,6 +284,39 @@ func (cmd *poolCreateCmd) storageAutoTotal(req *control.PoolCreateReq) error {
 	return nil
 }
 
+func perRankTierSize(psf poolSizeFlag) control.PoolTierSize {
+	if psf.IsRatio() {
+		return control.PoolTierSize{AvailFrac: float64(psf.availRatio) / 100.0}
+	}
+	return control.PoolTierSize{Bytes: psf.Bytes}
+}
+
+func (cmd *poolCreateCmd) storagePerRank(req *control.PoolCreateReq) error {
+	switch {
+	case cmd.NumRanks > 0:
+		return errIncompatFlags("nranks", "scm-per-rank")
+	case cmd.TierRatio.IsSet():
+		return errIncompatFlags("tier-ratio", "scm-per-rank")
+	case !cmd.ScmPerRank.IsSet():
+		return errors.New("--nvme-per-rank cannot be set without --scm-per-rank")
+	}
+
+	req.PerRankTiers = []control.PoolTierSize{
+		perRankTierSize(cmd.ScmPerRank),
+		perRankTierSize(cmd.NVMePerRank),
+	}
+
+	// Pass --mem-ratio or zero if unset.
+	if err := cmd.setMemRatio(req, 0.0); err != nil {
+		return err
+	}
+
+	cmd.Infof("Creating DAOS pool with per-rank storage allocation: %s SCM, %s NVMe",
+		cmd.ScmPerRank, cmd.NVMePerRank)
+
+	return nil
+}
+
 func (cmd *poolCreateCmd) storageManualMdOnSsd(req *control.PoolCreateReq) error {
 	metaBytes := cmd.MetaSize.Bytes
 	dataBytes := cmd.DataSize.Bytes
@@ -349,17 +396,32 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if cmd.ProfileName != "" {
+		var err error
+		cmd.profile, err = cmd.getProfile(ctx, cmd.ProfileName)
+		if err != nil {
+			return err
+		}
+		if err := cmd.profile.Apply(req); err != nil {
+			return err
+		}
+		cmd.Debugf("applied pool profile %q to pool create request", cmd.ProfileName)
+	}
+
 	// Refuse unsupported input value combinations.
 
 	pmemParams := cmd.ScmSize.IsSet() || cmd.NVMeSize.IsSet()
 	mdParams := cmd.MetaSize.IsSet() || cmd.DataSize.IsSet()
+	perRankParams := cmd.ScmPerRank.IsSet() || cmd.NVMePerRank.IsSet()
 
 	switch {
-	case (pmemParams || mdParams) && cmd.Size.IsSet():
+	case (pmemParams || mdParams || perRankParams) && cmd.Size.IsSet():
 		return errPoolCreateIncompatOpts
 	case pmemParams && mdParams:
 		return errPoolCreateIncompatOpts
-	case !pmemParams && !mdParams && !cmd.Size.IsSet():
+	case perRankParams && (pmemParams || mdParams):
+		return errPoolCreateIncompatOpts
+	case !pmemParams && !mdParams && !perRankParams && !cmd.Size.IsSet():
 		return errPoolCreateIncompatOpts
 	}
 
@@ -378,6 +440,12 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 			return err
 		}
 
+	// Per-rank storage values resolved against available space.
+	case perRankParams:
+		if err := cmd.storagePerRank(req); err != nil {
+			return err
+		}
+
 	// Manual selection of storage values.
 	default:
 		if err := cmd.storageManual(req); err != nil {
@@ -385,6 +453,14 @@ func (cmd *poolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Async {
+		id, err := control.PoolCreateJob(ctx, cmd.ctlInvoker, req)
+		if err != nil {
+			return outputJobIDs(cmd.Logger, &cmd.JSONOutputCmd, "Pool-create", nil, err)
+		}
+		return outputJobIDs(cmd.Logger, &cmd.JSONOutputCmd, "Pool-create", []string{id}, nil)
+	}
+
 	resp, err := control.PoolCreate(ctx, cmd.ctlInvoker, req)
 
 	if cmd.JSONOutputEnabled() {
@@ -489,6 +565,7 @@ type poolDestroyCmd struct {
 	poolCmd
 	Recursive bool `short:"r" long:"recursive" description:"Remove pool with existing containers"`
 	Force     bool `short:"f" long:"force" description:"Forcibly remove pool with active client connections"`
+	Async     bool `long:"async" description:"Submit the pool destroy as a job run by the management service and return its job ID without waiting"`
 }
 
 // Execute is run when PoolDestroyCmd subcommand is activated
@@ -501,6 +578,14 @@ func (cmd *poolDestroyCmd) Execute(args []string) error {
 		Recursive: cmd.Recursive,
 	}
 
+	if cmd.Async {
+		id, err := control.PoolDestroyJob(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+		if err != nil {
+			return outputJobIDs(cmd.Logger, &cmd.JSONOutputCmd, "Pool-destroy", nil, err)
+		}
+		return outputJobIDs(cmd.Logger, &cmd.JSONOutputCmd, "Pool-destroy", []string{id}, nil)
+	}
+
 	err := control.PoolDestroy(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		msg = errors.WithMessage(err, "failed").Error()
@@ -644,10 +729,72 @@ func (cmd *poolExtendCmd) Execute(args []string) error {
 	return err
 }
 
+// poolResizeCmd is the struct representing the command to grow the per-rank NVMe allocation
+// of a DAOS pool on the ranks it already occupies.
+type poolResizeCmd struct {
+	poolCmd
+	NVMeSize ui.ByteSizeFlag `short:"n" long:"nvme-size" required:"1" description:"New per-rank NVMe (or data in MD-on-SSD mode) allocation for the pool"`
+}
+
+// Execute is run when poolResizeCmd subcommand is activated
+func (cmd *poolResizeCmd) Execute(args []string) error {
+	req := &control.PoolResizeReq{
+		ID:        cmd.PoolID().String(),
+		TierBytes: []uint64{0, cmd.NVMeSize.Bytes},
+	}
+
+	resp, err := control.PoolResize(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool resize failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolResizeResp(resp, &bld)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolCloneCmd is the struct representing the command to clone a DAOS pool.
+type poolCloneCmd struct {
+	poolCmd
+	Args struct {
+		Label string `positional-arg-name:"<new pool label>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when poolCloneCmd subcommand is activated
+func (cmd *poolCloneCmd) Execute(args []string) error {
+	req := &control.PoolCloneReq{
+		ID:    cmd.PoolID().String(),
+		Label: cmd.Args.Label,
+	}
+
+	resp, err := control.PoolClone(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "pool clone failed")
+	}
+
+	cmd.Infof("Pool %s cloned to %s (UUID: %s)", cmd.PoolID(), req.Label, resp.UUID)
+
+	return nil
+}
+
 // poolReintegrateCmd is the struct representing the command to Add a DAOS target.
 type poolReintegrateCmd struct {
 	poolRanksCmd
 	TargetIdx string `long:"target-idx" description:"Comma-separated list of target index(es) to be reintegrated into each rank"`
+	Async     bool   `long:"async" description:"Submit a job per rank run by the management service that completes when the resulting rebuild has finished and return the job IDs without waiting"`
 }
 
 // Execute is run when poolReintegrateCmd subcommand is activated
@@ -663,6 +810,11 @@ func (cmd *poolReintegrateCmd) Execute(args []string) error {
 		TargetIdx: idxList,
 	}
 
+	if cmd.Async {
+		ids, err := control.PoolReintegrateJob(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+		return outputJobIDs(cmd.Logger, &cmd.JSONOutputCmd, "Pool-reintegrate", ids, err)
+	}
+
 	resp, err := control.PoolReintegrate(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		return err
@@ -686,8 +838,10 @@ func (cmd *poolReintegrateCmd) Execute(args []string) error {
 // poolQueryCmd is the struct representing the command to query a DAOS pool.
 type poolQueryCmd struct {
 	poolCmd
-	ShowEnabledRanks bool `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
-	HealthOnly       bool `short:"t" long:"health-only" description:"Only perform pool health related queries"`
+	watchCmd
+	ShowEnabledRanks bool          `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
+	HealthOnly       bool          `short:"t" long:"health-only" description:"Only perform pool health related queries"`
+	History          time.Duration `long:"history" description:"Show the pool space usage and rebuild status captured by the management service over the given period (e.g. 24h)"`
 }
 
 // Execute is run when PoolQueryCmd subcommand is activated
@@ -705,8 +859,22 @@ func (cmd *poolQueryCmd) Execute(args []string) error {
 	}
 	req.QueryMask.SetOptions(daos.PoolQueryOptionDisabledEngines)
 
-	resp, err := control.PoolQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.History < 0 {
+		return errors.New("--history period must be positive")
+	}
+	req.HistoryPeriod = cmd.History
+
+	return cmd.watch(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd, func(ctx context.Context) error {
+		return cmd.query(ctx, req)
+	})
+}
+
+func (cmd *poolQueryCmd) query(ctx context.Context, req *control.PoolQueryReq) error {
+	resp, err := control.PoolQuery(ctx, cmd.ctlInvoker, req)
 	if cmd.JSONOutputEnabled() {
+		if cmd.History > 0 {
+			return cmd.OutputJSON(resp, err)
+		}
 		var poolInfo *daos.PoolInfo
 		if resp != nil {
 			poolInfo = &resp.PoolInfo
@@ -722,6 +890,10 @@ func (cmd *poolQueryCmd) Execute(args []string) error {
 	if err := pretty.PrintPoolQueryResponse(resp, &bld); err != nil {
 		return err
 	}
+	if cmd.History > 0 {
+		fmt.Fprintf(&bld, "\nPool usage history (last %s):\n", cmd.History)
+		pretty.PrintPoolUsageHistory(resp.History, resp.MdOnSsdActive, &bld)
+	}
 
 	cmd.Debugf("Pool query options: %s", resp.PoolInfo.QueryMask)
 	cmd.Info(bld.String())
@@ -943,20 +1115,58 @@ func (cmd *poolGetACLCmd) writeACLToFile(acl string) error {
 	return nil
 }
 
-// poolOverwriteACLCmd represents the command to overwrite the Access Control
-// List of a DAOS pool.
-type poolOverwriteACLCmd struct {
-	poolCmd
-	ACLFile string `short:"a" long:"acl-file" required:"1" description:"Path for new Access Control List file"`
+// aclModifyFlags are the flags shared by the commands that modify the Access
+// Control List of a DAOS pool.
+type aclModifyFlags struct {
+	DryRun          bool `long:"dry-run" required:"0" description:"Display the changes that would be made to the ACL without applying them"`
+	CheckPrincipals bool `long:"check-principals" required:"0" description:"Check that the users and groups in the ACL exist in the directory configured on this host"`
 }
 
-// Execute is run when the PoolOverwriteACLCmd subcommand is activated
-func (cmd *poolOverwriteACLCmd) Execute(args []string) error {
-	acl, err := control.ReadACLFile(cmd.ACLFile)
+// checkACL performs the optional checks on a new or updated ACL.
+func (f *aclModifyFlags) checkACL(acl *control.AccessControlList) error {
+	if !f.CheckPrincipals {
+		return nil
+	}
+	return control.CheckACLPrincipals(acl)
+}
+
+// showACLChanges displays the changes that applying the supplied ACL would
+// make to the current ACL of the pool. The current entries are replaced
+// entirely if replace is set, otherwise they are updated.
+func (cmd *poolCmd) showACLChanges(acl *control.AccessControlList, replace bool) error {
+	req := &control.PoolGetACLReq{ID: cmd.PoolID().String()}
+
+	resp, err := control.PoolGetACL(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, err)
+		}
+		return errors.Wrap(err, "Pool-get-ACL command failed")
+	}
+
+	newACL := &control.AccessControlList{Entries: acl.Entries}
+	if !replace {
+		newACL = control.MergeACL(resp.ACL, acl)
+	}
+	if err := control.CheckACLSize(newACL); err != nil {
 		return err
 	}
 
+	changes := control.DiffACL(resp.ACL, newACL)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(changes, nil)
+	}
+
+	var out strings.Builder
+	pretty.PrintACLChanges(&out, changes)
+	cmd.Infof("Changes to ACL of pool %s (dry run):\n%s", cmd.PoolID(), out.String())
+
+	return nil
+}
+
+// overwriteACL replaces the Access Control List of the pool with the supplied
+// one in a single request.
+func (cmd *poolCmd) overwriteACL(acl *control.AccessControlList) error {
 	req := &control.PoolOverwriteACLReq{
 		ID:  cmd.PoolID().String(),
 		ACL: acl,
@@ -978,12 +1188,39 @@ func (cmd *poolOverwriteACLCmd) Execute(args []string) error {
 	return nil
 }
 
+// poolOverwriteACLCmd represents the command to overwrite the Access Control
+// List of a DAOS pool.
+type poolOverwriteACLCmd struct {
+	poolCmd
+	aclModifyFlags
+	ACLFile string `short:"a" long:"acl-file" required:"1" description:"Path for new Access Control List file"`
+}
+
+// Execute is run when the PoolOverwriteACLCmd subcommand is activated
+func (cmd *poolOverwriteACLCmd) Execute(args []string) error {
+	acl, err := control.ReadValidACLFile(cmd.ACLFile)
+	if err != nil {
+		return err
+	}
+	if err := cmd.checkACL(acl); err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		return cmd.showACLChanges(acl, true)
+	}
+
+	return cmd.overwriteACL(acl)
+}
+
 // poolUpdateACLCmd represents the command to update the Access Control List of
 // a DAOS pool.
 type poolUpdateACLCmd struct {
 	poolCmd
+	aclModifyFlags
 	ACLFile string `short:"a" long:"acl-file" required:"0" description:"Path for new Access Control List file"`
 	Entry   string `short:"e" long:"entry" required:"0" description:"Single Access Control Entry to add or update"`
+	Replace bool   `long:"replace" required:"0" description:"Replace all entries of the ACL with those in the ACL file in a single request"`
 }
 
 // Execute is run when the PoolUpdateACLCmd subcommand is activated
@@ -991,19 +1228,35 @@ func (cmd *poolUpdateACLCmd) Execute(args []string) error {
 	if (cmd.ACLFile == "" && cmd.Entry == "") || (cmd.ACLFile != "" && cmd.Entry != "") {
 		return errors.New("either ACL file or entry parameter is required")
 	}
+	if cmd.Replace && cmd.ACLFile == "" {
+		return errors.New("--replace requires an ACL file")
+	}
 
 	var acl *control.AccessControlList
 	if cmd.ACLFile != "" {
-		aclFileResult, err := control.ReadACLFile(cmd.ACLFile)
+		aclFileResult, err := control.ReadValidACLFile(cmd.ACLFile)
 		if err != nil {
 			return err
 		}
 		acl = aclFileResult
 	} else {
+		if err := control.ValidateACE(cmd.Entry); err != nil {
+			return err
+		}
 		acl = &control.AccessControlList{
 			Entries: []string{cmd.Entry},
 		}
 	}
+	if err := cmd.checkACL(acl); err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		return cmd.showACLChanges(acl, cmd.Replace)
+	}
+	if cmd.Replace {
+		return cmd.overwriteACL(acl)
+	}
 
 	req := &control.PoolUpdateACLReq{
 		ID:  cmd.PoolID().String(),
@@ -0,0 +1,179 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// poolProfileCmd represents the pool profile subcommand.
+type poolProfileCmd struct {
+	Set    poolProfileSetCmd    `command:"set" description:"Create or replace a pool profile"`
+	Get    poolProfileGetCmd    `command:"get" description:"Display a pool profile"`
+	List   poolProfileListCmd   `command:"list" alias:"ls" description:"List pool profiles"`
+	Delete poolProfileDeleteCmd `command:"delete" description:"Delete a pool profile"`
+}
+
+type poolProfileBaseCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+type poolProfileNameCmd struct {
+	poolProfileBaseCmd
+
+	Args struct {
+		Name string `positional-arg-name:"<profile name>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// poolProfileSetCmd is the struct representing the command to store a pool profile.
+type poolProfileSetCmd struct {
+	poolProfileNameCmd
+	Properties PoolSetPropsFlag `short:"P" long:"properties" description:"Pool properties to be set on pools created from the profile"`
+	ACLFile    string           `short:"a" long:"acl-file" description:"Access Control List file path for pools created from the profile"`
+	TierRatio  tierRatioFlag    `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pools created from the profile with --size"`
+	NumSvcReps uint32           `short:"v" long:"nsvc" description:"Number of pool service replicas"`
+}
+
+// Execute is run when poolProfileSetCmd subcommand is activated
+func (cmd *poolProfileSetCmd) Execute(_ []string) error {
+	profile := &control.PoolProfile{
+		Name:       cmd.Args.Name,
+		NumSvcReps: cmd.NumSvcReps,
+	}
+
+	for name, prop := range cmd.Properties.ToSet {
+		if name == "label" {
+			return errors.New("label property can't be set in a pool profile")
+		}
+		if profile.Properties == nil {
+			profile.Properties = make(map[string]string)
+		}
+		// Only the values from the last --properties flag are kept unparsed.
+		val, found := cmd.Properties.ParsedProps[name]
+		if !found {
+			val = prop.StringValue()
+		}
+		profile.Properties[name] = val
+	}
+
+	if cmd.ACLFile != "" {
+		var err error
+		profile.ACL, err = control.ReadACLFile(cmd.ACLFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.TierRatio.IsSet() {
+		profile.TierRatio = cmd.TierRatio.Ratios()
+	}
+
+	req := &control.PoolProfileSetReq{Profile: profile}
+	err := control.PoolProfileSet(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool profile set failed")
+	}
+
+	cmd.Infof("Pool profile %s stored", profile.Name)
+	return nil
+}
+
+// poolProfileGetCmd is the struct representing the command to display a pool profile.
+type poolProfileGetCmd struct {
+	poolProfileNameCmd
+}
+
+// Execute is run when poolProfileGetCmd subcommand is activated
+func (cmd *poolProfileGetCmd) Execute(_ []string) error {
+	req := &control.PoolProfileGetReq{Names: []string{cmd.Args.Name}}
+	resp, err := control.PoolProfileGet(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool profile get failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolProfiles(&bld, true, resp.Profiles...)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolProfileListCmd is the struct representing the command to list stored pool profiles.
+type poolProfileListCmd struct {
+	poolProfileBaseCmd
+}
+
+// Execute is run when poolProfileListCmd subcommand is activated
+func (cmd *poolProfileListCmd) Execute(_ []string) error {
+	resp, err := control.PoolProfileGet(cmd.MustLogCtx(), cmd.ctlInvoker,
+		new(control.PoolProfileGetReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool profile list failed")
+	}
+
+	var bld strings.Builder
+	pretty.PrintPoolProfiles(&bld, false, resp.Profiles...)
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// poolProfileDeleteCmd is the struct representing the command to delete a pool profile.
+type poolProfileDeleteCmd struct {
+	poolProfileNameCmd
+}
+
+// Execute is run when poolProfileDeleteCmd subcommand is activated
+func (cmd *poolProfileDeleteCmd) Execute(_ []string) error {
+	req := &control.PoolProfileDeleteReq{Name: cmd.Args.Name}
+	err := control.PoolProfileDelete(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return errors.Wrap(err, "pool profile delete failed")
+	}
+
+	cmd.Infof("Pool profile %s deleted", cmd.Args.Name)
+	return nil
+}
+
+// getProfile fetches the named pool profile from the management service.
+func (cmd *poolCreateCmd) getProfile(ctx context.Context, name string) (*control.PoolProfile, error) {
+	req := &control.PoolProfileGetReq{Names: []string{name}}
+	resp, err := control.PoolProfileGet(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching pool profile %q", name)
+	}
+	for _, pp := range resp.Profiles {
+		if pp.Name == name {
+			return pp, nil
+		}
+	}
+
+	return nil, errors.Errorf("pool profile %q not found", name)
+}
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -171,6 +172,10 @@ func TestPoolCommands(t *testing.T) {
 
 	testEmptyFile := test.CreateTestFile(t, tmpDir, "")
 
+	// An ACL file with comments and an invalid entry
+	testInvalidACLFile := test.CreateTestFile(t, tmpDir,
+		"# Pool ACL\nA:G:GROUP@:rw # owner group\nA::OWNER@:rx\n")
+
 	// Subdirectory with no write perms
 	testNoPermDir := filepath.Join(tmpDir, "badpermsdir")
 	if err := os.Mkdir(testNoPermDir, 0444); err != nil {
@@ -230,6 +235,57 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with unknown profile",
+			fmt.Sprintf("pool create --size %s --profile test foo", testSizeStr),
+			"",
+			errors.New(`pool profile "test" not found`),
+		},
+		{
+			"Create pool with profile",
+			fmt.Sprintf("pool create --size %s --profile prod --nsvc 3 foo", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileGetReq{
+					Names: []string{"prod"},
+				}),
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.1, 0.9},
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					NumSvcReps: 3,
+					Ranks:      []ranklist.Rank{},
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "foo"),
+						propWithVal("reclaim", "lazy"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with profile and tier ratio",
+			fmt.Sprintf("pool create --size %s --profile prod -t 20,80 -P reclaim:time foo",
+				testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileGetReq{
+					Names: []string{"prod"},
+				}),
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.2, 0.8},
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					NumSvcReps: 5,
+					Ranks:      []ranklist.Rank{},
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "foo"),
+						propWithVal("reclaim", "time"),
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Create pool with missing size",
 			"pool create label",
@@ -383,6 +439,36 @@ func TestPoolCommands(t *testing.T) {
 			"",
 			errors.New("cannot be set without --scm-size"),
 		},
+		{
+			"Create pool with incompatible arguments (per-rank with size)",
+			"pool create label --size 50% --scm-per-rank 10%",
+			"",
+			errPoolCreateIncompatOpts,
+		},
+		{
+			"Create pool with incompatible arguments (per-rank with scm-size)",
+			fmt.Sprintf("pool create label --scm-size %s --nvme-per-rank 10%%", testSizeStr),
+			"",
+			errPoolCreateIncompatOpts,
+		},
+		{
+			"Create pool with incompatible arguments (per-rank with nranks)",
+			"pool create label --scm-per-rank 10% --nranks 2",
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Create pool with incompatible arguments (nvme-per-rank without scm-per-rank)",
+			"pool create label --nvme-per-rank 1T",
+			"",
+			errors.New("cannot be set without --scm-per-rank"),
+		},
+		{
+			"Create pool with invalid per-rank ratio",
+			"pool create label --scm-per-rank 101% --nvme-per-rank 1T",
+			"",
+			errors.New("invalid full size ratio"),
+		},
 		{
 			"Create pool with minimal arguments",
 			fmt.Sprintf("pool create label --scm-size %s --nsvc 3", testSizeStr),
@@ -744,6 +830,89 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Resize pool with no size",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("the required flag `-n, --nvme-size' was not specified"),
+		},
+		{
+			"Resize pool NVMe",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --nvme-size 200G",
+			printRequest(t, &control.PoolResizeReq{
+				ID:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				TierBytes: []uint64{0, 200 * humanize.GByte},
+			}),
+			nil,
+		},
+		{
+			"Resize pool SCM",
+			"pool resize 031bcaf8-f0f5-42ef-b3c5-ee048676dceb -s 20G -n 200G",
+			"",
+			errors.New("unknown flag `s'"),
+		},
+		{
+			"Clone pool with missing label",
+			"pool clone 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Clone pool",
+			"pool clone 031bcaf8-f0f5-42ef-b3c5-ee048676dceb clone1",
+			printRequest(t, &control.PoolCloneReq{
+				ID:    "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				Label: "clone1",
+			}),
+			nil,
+		},
+		{
+			"Set pool profile with label property",
+			"pool profile set prod -P label:foo",
+			"",
+			errors.New("label property can't be set"),
+		},
+		{
+			"Set pool profile",
+			"pool profile set prod-ec -P reclaim:lazy,rd_fac:2 -t 10,90 -v 5",
+			printRequest(t, &control.PoolProfileSetReq{
+				Profile: &control.PoolProfile{
+					Name:       "prod-ec",
+					Properties: map[string]string{"reclaim": "lazy", "rd_fac": "2"},
+					TierRatio:  []float64{0.1, 0.9},
+					NumSvcReps: 5,
+				},
+			}),
+			nil,
+		},
+		{
+			"Get pool profile with missing name",
+			"pool profile get",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Get pool profile",
+			"pool profile get prod",
+			printRequest(t, &control.PoolProfileGetReq{
+				Names: []string{"prod"},
+			}),
+			nil,
+		},
+		{
+			"List pool profiles",
+			"pool profile list",
+			printRequest(t, &control.PoolProfileGetReq{}),
+			nil,
+		},
+		{
+			"Delete pool profile",
+			"pool profile delete prod",
+			printRequest(t, &control.PoolProfileDeleteReq{
+				Name: "prod",
+			}),
+			nil,
+		},
 		// Reintegrate testing with multiple ranks is verified at the control API layer.
 		{
 			"Reintegrate a target with single target idx",
@@ -781,6 +950,37 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Reintegrate ranks asynchronously",
+			"pool reintegrate 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --ranks 0,1 --async",
+			strings.Join([]string{
+				printRequest(t, &control.JobSubmitReq{
+					JobSubmitReq: mgmtpb.JobSubmitReq{
+						Sys: "daos_server-unset",
+						Op: &mgmtpb.JobSubmitReq_PoolReint{
+							PoolReint: &mgmtpb.PoolReintReq{
+								Id:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+								Rank:      0,
+								TargetIdx: []uint32{},
+							},
+						},
+					},
+				}),
+				printRequest(t, &control.JobSubmitReq{
+					JobSubmitReq: mgmtpb.JobSubmitReq{
+						Sys: "daos_server-unset",
+						Op: &mgmtpb.JobSubmitReq_PoolReint{
+							PoolReint: &mgmtpb.PoolReintReq{
+								Id:        "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+								Rank:      1,
+								TargetIdx: []uint32{},
+							},
+						},
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Destroy pool with force",
 			"pool destroy 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --force",
@@ -803,6 +1003,24 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Destroy pool asynchronously",
+			"pool destroy 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --force --async",
+			strings.Join([]string{
+				printRequest(t, &control.JobSubmitReq{
+					JobSubmitReq: mgmtpb.JobSubmitReq{
+						Sys: "daos_server-unset",
+						Op: &mgmtpb.JobSubmitReq_PoolDestroy{
+							PoolDestroy: &mgmtpb.PoolDestroyReq{
+								Id:    "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+								Force: true,
+							},
+						},
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Evict pool",
 			"pool evict 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
@@ -1100,6 +1318,55 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Update pool ACL with invalid entry",
+			"pool update-acl 12345678-1234-1234-1234-1234567890ab --entry A:G:OWNER@:rw",
+			"",
+			errors.New("the G flag is not valid for OWNER@"),
+		},
+		{
+			"Update pool ACL with invalid ACL file",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --acl-file %s", testInvalidACLFile),
+			"",
+			errors.New("line 2: entry \"A::OWNER@:rx\": invalid permission 'x'"),
+		},
+		{
+			"Update pool ACL with replace and entry",
+			"pool update-acl 12345678-1234-1234-1234-1234567890ab --entry A::user@:rw --replace",
+			"",
+			errors.New("--replace requires an ACL file"),
+		},
+		{
+			"Update pool ACL with replace",
+			fmt.Sprintf("pool update-acl 12345678-1234-1234-1234-1234567890ab --acl-file %s --replace", testACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolOverwriteACLReq{
+					ID:  "12345678-1234-1234-1234-1234567890ab",
+					ACL: testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Update pool ACL dry run",
+			"pool update-acl 12345678-1234-1234-1234-1234567890ab --entry A::user@:rw --dry-run",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Overwrite pool ACL dry run",
+			fmt.Sprintf("pool overwrite-acl 12345678-1234-1234-1234-1234567890ab --acl-file %s --dry-run", testACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Delete pool ACL without principal flag",
 			"pool delete-acl 12345678-1234-1234-1234-1234567890ab",
@@ -1163,6 +1430,24 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool with history",
+			"pool query --history 24h 12345678-1234-1234-1234-1234567890ab",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID:            "12345678-1234-1234-1234-1234567890ab",
+					QueryMask:     daos.DefaultPoolQueryMask,
+					HistoryPeriod: 24 * time.Hour,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool with bad history period",
+			"pool query --history 1d 12345678-1234-1234-1234-1234567890ab",
+			"",
+			errors.New("invalid"),
+		},
 		{
 			"Query pool with UUID and enabled ranks",
 			"pool query --show-enabled 12345678-1234-1234-1234-1234567890ab",
@@ -0,0 +1,84 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintAuditQueryResp generates a human-readable table of the entries in the supplied audit
+// query response. The request arguments and the unverified user reported by the client are
+// only displayed in verbose mode.
+func PrintAuditQueryResp(resp *control.AuditQueryResp, out, outErr io.Writer, verbose bool) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	if len(resp.Entries) == 0 {
+		fmt.Fprintln(out, "No audit log entries found")
+		return nil
+	}
+
+	timeTitle := "Time"
+	hostTitle := "Host"
+	clientTitle := "Client"
+	userTitle := "User"
+	methodTitle := "Method"
+	durationTitle := "Duration"
+	resultTitle := "Result"
+	claimedUserTitle := "Claimed User"
+	argsTitle := "Arguments"
+
+	titles := []string{timeTitle, hostTitle, clientTitle, userTitle, methodTitle,
+		durationTitle, resultTitle}
+	if verbose {
+		titles = append(titles, claimedUserTitle, argsTitle)
+	}
+
+	table := []txtfmt.TableRow{}
+	for _, entry := range resp.Entries {
+		result := "OK"
+		if entry.Error != "" {
+			result = entry.Error
+		}
+		user := entry.User
+		if user == "" {
+			user = "-"
+		}
+		claimedUser := entry.ClaimedUser
+		if claimedUser == "" {
+			claimedUser = "-"
+		}
+
+		row := txtfmt.TableRow{
+			timeTitle:     entry.Time.Format(time.RFC3339),
+			hostTitle:     entry.Host,
+			clientTitle:   entry.Client,
+			userTitle:     user,
+			methodTitle:   path.Base(entry.Method),
+			durationTitle: (time.Duration(entry.DurationUs) * time.Microsecond).String(),
+			resultTitle:   result,
+		}
+		if verbose {
+			row[claimedUserTitle] = claimedUser
+			row[argsTitle] = string(entry.Args)
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(titles...)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
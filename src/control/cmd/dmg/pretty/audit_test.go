@@ -0,0 +1,107 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintAuditQueryResp(t *testing.T) {
+	t0 := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	entries := []*control.AuditEntry{
+		{
+			Host:        "host1",
+			Time:        t0,
+			Method:      "/mgmt.MgmtSvc/PoolCreate",
+			Client:      "10.0.0.1:4242",
+			User:        "root",
+			ClaimedUser: "alice",
+			Args:        json.RawMessage(`{"sys":"daos_server"}`),
+			DurationUs:  2000,
+		},
+		{
+			Host:       "host2",
+			Time:       t0.Add(time.Minute),
+			Method:     "/ctl.CtlSvc/StorageFormat",
+			Client:     "10.0.0.2:4242",
+			Args:       json.RawMessage(`{}`),
+			Error:      "storage busy",
+			DurationUs: 250000,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp      *control.AuditQueryResp
+		verbose   bool
+		expStdout string
+		expStderr string
+	}{
+		"no entries": {
+			resp: new(control.AuditQueryResp),
+			expStdout: `
+No audit log entries found
+`,
+		},
+		"entries; one host fails": {
+			resp: &control.AuditQueryResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host3",
+						Error: "audit log disabled",
+					}),
+				Entries: entries,
+			},
+			expStdout: `
+Time                 Host  Client        User Method        Duration Result       
+----                 ----  ------        ---- ------        -------- ------       
+2025-01-02T15:04:05Z host1 10.0.0.1:4242 root PoolCreate    2ms      OK           
+2025-01-02T15:05:05Z host2 10.0.0.2:4242 -    StorageFormat 250ms    storage busy 
+`,
+			expStderr: `
+Errors:
+  Hosts Error              
+  ----- -----              
+  host3 audit log disabled 
+
+`,
+		},
+		"verbose": {
+			resp: &control.AuditQueryResp{
+				Entries: entries,
+			},
+			verbose: true,
+			expStdout: `
+Time                 Host  Client        User Method        Duration Result       Claimed User Arguments             
+----                 ----  ------        ---- ------        -------- ------       ------------ ---------             
+2025-01-02T15:04:05Z host1 10.0.0.1:4242 root PoolCreate    2ms      OK           alice        {"sys":"daos_server"} 
+2025-01-02T15:05:05Z host2 10.0.0.2:4242 -    StorageFormat 250ms    storage busy -            {}                    
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			if err := PrintAuditQueryResp(tc.resp, &out, &outErr, tc.verbose); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
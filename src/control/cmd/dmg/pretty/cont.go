@@ -0,0 +1,52 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintContainers generates a human-readable table of the supplied containers.
+func PrintContainers(out io.Writer, containers ...*control.ContainerInfo) {
+	if len(containers) == 0 {
+		fmt.Fprintln(out, "No containers in pool")
+		return
+	}
+
+	labelTitle := "Label"
+	uuidTitle := "UUID"
+	ownerTitle := "Owner"
+	groupTitle := "Group"
+	snapTitle := "Snapshots"
+
+	table := []txtfmt.TableRow{}
+	for _, cont := range containers {
+		if cont == nil {
+			continue
+		}
+
+		label := cont.Label
+		if label == "" {
+			label = "-"
+		}
+		table = append(table, txtfmt.TableRow{
+			labelTitle: label,
+			uuidTitle:  cont.UUID,
+			ownerTitle: cont.OwnerUser,
+			groupTitle: cont.OwnerGroup,
+			snapTitle:  fmt.Sprintf("%d", cont.NumSnapshots),
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(labelTitle, uuidTitle, ownerTitle, groupTitle, snapTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
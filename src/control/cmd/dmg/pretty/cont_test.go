@@ -0,0 +1,59 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintContainers(t *testing.T) {
+	for name, tc := range map[string]struct {
+		containers []*control.ContainerInfo
+		expOut     string
+	}{
+		"no containers": {
+			expOut: "No containers in pool\n",
+		},
+		"containers": {
+			containers: []*control.ContainerInfo{
+				{
+					UUID:       test.MockUUID(1),
+					Label:      "results",
+					OwnerUser:  "alice@",
+					OwnerGroup: "builders@",
+				},
+				{
+					UUID:         test.MockUUID(2),
+					OwnerUser:    "bob@",
+					OwnerGroup:   "builders@",
+					NumSnapshots: 2,
+				},
+			},
+			expOut: `
+Label   UUID                                 Owner  Group     Snapshots 
+-----   ----                                 -----  -----     --------- 
+results 00000001-0001-0001-0001-000000000001 alice@ builders@ 0         
+-       00000002-0002-0002-0002-000000000002 bob@   builders@ 2         
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintContainers(&bld, tc.containers...)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -331,6 +331,27 @@ func PrintSCMFirmwareUpdateMap(fwMap control.HostSCMUpdateMap, out io.Writer,
 		})
 }
 
+// PrintSCMFirmwareUpdateSummary describes the outcome of a staged SCM firmware update performed
+// across multiple hosts.
+func PrintSCMFirmwareUpdateSummary(resp *control.FirmwareUpdateResp, out io.Writer) {
+	if resp == nil {
+		return
+	}
+
+	switch {
+	case !resp.ScmUpdateFailed() && resp.ScmPhase == storage.ScmFirmwarePhaseActivate:
+		fmt.Fprintln(out, "Staged firmware was activated on all hosts, no power cycle is required.")
+	case !resp.ScmUpdateFailed():
+		fmt.Fprintln(out, "Firmware was staged on all hosts.")
+	case resp.ScmRolledBack:
+		fmt.Fprintf(out, "Firmware update failed in %s phase, rollback firmware was staged "+
+			"on all hosts.\n", resp.ScmPhase)
+	default:
+		fmt.Fprintf(out, "Firmware update failed in %s phase, no firmware was activated.\n",
+			resp.ScmPhase)
+	}
+}
+
 func condenseSCMUpdateMap(fwMap control.HostSCMUpdateMap) (hostDeviceResultMap, []hostDeviceError, error) {
 	successes := make(hostDeviceResultMap)
 	errors := make([]hostDeviceError, 0)
@@ -1449,3 +1449,68 @@ host2
 		})
 	}
 }
+
+func TestPretty_PrintSCMFirmwareUpdateSummary(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp      *control.FirmwareUpdateResp
+		expResult string
+	}{
+		"nil": {},
+		"staged": {
+			resp: &control.FirmwareUpdateResp{},
+			expResult: `
+Firmware was staged on all hosts.
+`,
+		},
+		"activated": {
+			resp: &control.FirmwareUpdateResp{
+				ScmPhase: storage.ScmFirmwarePhaseActivate,
+			},
+			expResult: `
+Staged firmware was activated on all hosts, no power cycle is required.
+`,
+		},
+		"verify failed": {
+			resp: &control.FirmwareUpdateResp{
+				HostSCMResult: control.HostSCMUpdateMap{
+					"host1": {
+						{
+							Module: *storage.MockScmModule(1),
+							Error:  errors.New("verify: no firmware staged"),
+						},
+					},
+				},
+				ScmPhase: storage.ScmFirmwarePhaseVerify,
+			},
+			expResult: `
+Firmware update failed in verify phase, no firmware was activated.
+`,
+		},
+		"verify failed; rolled back": {
+			resp: &control.FirmwareUpdateResp{
+				HostSCMResult: control.HostSCMUpdateMap{
+					"host1": {
+						{
+							Module: *storage.MockScmModule(1),
+							Error:  errors.New("verify: no firmware staged"),
+						},
+					},
+				},
+				ScmPhase:      storage.ScmFirmwarePhaseVerify,
+				ScmRolledBack: true,
+			},
+			expResult: `
+Firmware update failed in verify phase, rollback firmware was staged on all hosts.
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSCMFirmwareUpdateSummary(tc.resp, &bld)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expResult, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
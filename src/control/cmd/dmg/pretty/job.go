@@ -0,0 +1,61 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintJobs generates a human-readable table of the supplied asynchronous jobs followed by the
+// errors of any failed jobs.
+func PrintJobs(out io.Writer, jobs ...*control.Job) {
+	if len(jobs) == 0 {
+		fmt.Fprintln(out, "No jobs found")
+		return
+	}
+
+	idTitle := "ID"
+	opTitle := "Operation"
+	targetTitle := "Pool"
+	stateTitle := "State"
+	progressTitle := "Progress"
+	updatedTitle := "Updated"
+
+	table := []txtfmt.TableRow{}
+	var failed []*control.Job
+	for _, job := range jobs {
+		if job == nil {
+			continue
+		}
+
+		table = append(table, txtfmt.TableRow{
+			idTitle:       job.ID,
+			opTitle:       job.Op,
+			targetTitle:   job.Target,
+			stateTitle:    job.State.String(),
+			progressTitle: fmt.Sprintf("%d%%", job.Progress),
+			updatedTitle:  job.Updated.Format(time.RFC3339),
+		})
+		if job.Error != "" {
+			failed = append(failed, job)
+		}
+	}
+
+	tf := txtfmt.NewTableFormatter(idTitle, opTitle, targetTitle, stateTitle, progressTitle,
+		updatedTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	for _, job := range failed {
+		fmt.Fprintf(out, "Job %s failed: %s\n", job.ID, job.Error)
+	}
+}
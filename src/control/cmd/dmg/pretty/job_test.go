@@ -0,0 +1,68 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintJobs(t *testing.T) {
+	updated := time.Date(2025, 3, 1, 10, 1, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		jobs   []*control.Job
+		expOut string
+	}{
+		"no jobs": {
+			expOut: "No jobs found\n",
+		},
+		"jobs": {
+			jobs: []*control.Job{
+				{
+					ID:       "job-1",
+					Op:       "pool create",
+					Target:   test.MockUUID(1),
+					State:    control.JobStateSucceeded,
+					Progress: 100,
+					Updated:  updated,
+				},
+				{
+					ID:       "job-2",
+					Op:       "pool reintegrate",
+					Target:   test.MockUUID(2),
+					State:    control.JobStateFailed,
+					Progress: 50,
+					Error:    "rebuild failed: DER_NOSPACE(-1007): No space on storage target",
+					Updated:  updated,
+				},
+			},
+			expOut: `
+ID    Operation        Pool                                 State     Progress Updated              
+--    ---------        ----                                 -----     -------- -------              
+job-1 pool create      00000001-0001-0001-0001-000000000001 succeeded 100%     2025-03-01T10:01:00Z 
+job-2 pool reintegrate 00000002-0002-0002-0002-000000000002 failed    50%      2025-03-01T10:01:00Z 
+Job job-2 failed: rebuild failed: DER_NOSPACE(-1007): No space on storage target
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintJobs(&bld, tc.jobs...)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
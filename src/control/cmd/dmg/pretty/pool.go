@@ -10,6 +10,9 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -30,6 +33,58 @@ func PrintPoolQueryResponse(pqr *control.PoolQueryResp, out io.Writer, opts ...P
 	return pretty.PrintPoolInfo(&pqr.PoolInfo, out)
 }
 
+// PrintPoolUsageHistory displays a table of the pool usage samples captured by the MS, one row per
+// sample in the order supplied.
+func PrintPoolUsageHistory(history []*control.PoolUsageSample, mdOnSsd bool, out io.Writer) {
+	if len(history) == 0 {
+		fmt.Fprintln(out, "No pool usage history captured in the requested period")
+		return
+	}
+
+	tierTitles := []string{"SCM Used", "NVMe Used"}
+	if mdOnSsd {
+		tierTitles = []string{"Meta Used", "Data Used"}
+	}
+	timeTitle := "Time"
+	rebuildTitle := "Rebuild"
+	disabledTitle := "Disabled Targets"
+
+	table := []txtfmt.TableRow{}
+	for _, sample := range history {
+		if sample == nil {
+			continue
+		}
+
+		row := txtfmt.TableRow{
+			timeTitle:     sample.Timestamp.Format(time.RFC3339),
+			rebuildTitle:  "-",
+			disabledTitle: fmt.Sprintf("%d", sample.DisabledTargets),
+		}
+		for tierIdx, title := range tierTitles {
+			row[title] = "-"
+			if tierIdx >= len(sample.TierStats) || sample.TierStats[tierIdx].Total == 0 {
+				continue
+			}
+			ts := sample.TierStats[tierIdx]
+			used := ts.Total - ts.Free
+			row[title] = fmt.Sprintf("%s (%d%%)", humanize.Bytes(used), used*100/ts.Total)
+		}
+		if sample.Rebuild != nil {
+			row[rebuildTitle] = sample.Rebuild.State.String()
+			if sample.Rebuild.State == daos.PoolRebuildStateBusy {
+				row[rebuildTitle] += fmt.Sprintf(" (%d objs, %d recs)", sample.Rebuild.Objects,
+					sample.Rebuild.Records)
+			}
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(append(append([]string{timeTitle}, tierTitles...),
+		rebuildTitle, disabledTitle)...)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
 // PrintPoolQueryTargetResponse generates a human-readable representation of the supplied
 // PoolQueryTargetResp struct and writes it to the supplied io.Writer.
 func PrintPoolQueryTargetResponse(pqtr *control.PoolQueryTargetResp, out io.Writer, opts ...PrintConfigOption) error {
@@ -101,6 +156,26 @@ func getPoolCreateRespRowsMdOnSsd(tierBytes []uint64, tierRatios []float64, numR
 	return
 }
 
+// getPoolCreateLayoutRow describes the per-rank allocation computed from the space available
+// on each rank.
+func getPoolCreateLayoutRow(layout *control.PoolCreateLayout, mdOnSsd bool) txtfmt.TableRow {
+	tierNames := []string{"SCM", "NVMe"}
+	if mdOnSsd {
+		tierNames = []string{"Metadata", "Data"}
+	}
+
+	allocs := make([]string, 0, len(layout.TierBytes))
+	for tierIdx, tierBytes := range layout.TierBytes {
+		if tierIdx >= len(tierNames) || tierIdx >= len(layout.AvailBytes) {
+			break
+		}
+		allocs = append(allocs, fmt.Sprintf("%s of %s %s", humanize.Bytes(tierBytes),
+			humanize.Bytes(layout.AvailBytes[tierIdx]), tierNames[tierIdx]))
+	}
+
+	return txtfmt.TableRow{"Per-rank Allocation": strings.Join(allocs, ", ")}
+}
+
 // PrintPoolCreateResponse generates a human-readable representation of the pool create
 // response and prints it to the supplied io.Writer.
 func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ...PrintConfigOption) error {
@@ -147,11 +222,35 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 		title, tierRows = getPoolCreateRespRows(pcr.TierBytes, tierRatios, numRanks)
 	}
 	fmtArgs = append(fmtArgs, tierRows...)
+	if pcr.Layout != nil {
+		fmtArgs = append(fmtArgs, getPoolCreateLayoutRow(pcr.Layout, pcr.MdOnSsdActive))
+	}
 
 	_, err := fmt.Fprintln(out, txtfmt.FormatEntity(title, fmtArgs))
 	return err
 }
 
+// PrintPoolResizeResp generates a human-readable representation of the new per-rank pool
+// storage allocation and prints it to the supplied io.Writer.
+func PrintPoolResizeResp(resp *control.PoolResizeResp, out io.Writer) {
+	if resp == nil || len(resp.TierBytes) == 0 {
+		fmt.Fprintln(out, "Pool resized")
+		return
+	}
+
+	tierNames := []string{"SCM", "NVMe"}
+	allocs := make([]string, 0, len(resp.TierBytes))
+	for tierIdx, tierBytes := range resp.TierBytes {
+		name := fmt.Sprintf("tier %d", tierIdx)
+		if tierIdx < len(tierNames) {
+			name = tierNames[tierIdx]
+		}
+		allocs = append(allocs, fmt.Sprintf("%s %s", humanize.Bytes(tierBytes), name))
+	}
+
+	fmt.Fprintf(out, "Pool resized, per-rank allocation: %s\n", strings.Join(allocs, ", "))
+}
+
 // PrintListPoolsResponse generates a human-readable representation of the
 // supplied ListPoolsResp struct and writes it to the supplied io.Writer.
 // Additional columns for pool UUID and service replicas if verbose is set.
@@ -198,6 +297,94 @@ func PrintPoolProperties(poolID string, out io.Writer, properties ...*daos.PoolP
 	tf.Format(table)
 }
 
+// PrintPoolProfiles displays a table of stored pool profiles. The ACL entries of each profile are
+// printed after the table if showACL is set.
+func PrintPoolProfiles(out io.Writer, showACL bool, profiles ...*control.PoolProfile) {
+	if len(profiles) == 0 {
+		fmt.Fprintln(out, "No pool profiles stored")
+		return
+	}
+
+	nameTitle := "Name"
+	propsTitle := "Properties"
+	ratioTitle := "Tier Ratio"
+	svcTitle := "Service Replicas"
+	aclTitle := "ACL Entries"
+
+	table := []txtfmt.TableRow{}
+	for _, pp := range profiles {
+		if pp == nil {
+			continue
+		}
+
+		props := make([]string, 0, len(pp.Properties))
+		for name, val := range pp.Properties {
+			props = append(props, fmt.Sprintf("%s:%s", name, val))
+		}
+		sort.Strings(props)
+
+		ratios := make([]string, 0, len(pp.TierRatio))
+		for _, ratio := range pp.TierRatio {
+			ratios = append(ratios, PrintTierRatio(ratio))
+		}
+
+		row := txtfmt.TableRow{
+			nameTitle:  pp.Name,
+			propsTitle: "-",
+			ratioTitle: "-",
+			svcTitle:   "-",
+			aclTitle:   "0",
+		}
+		if len(props) > 0 {
+			row[propsTitle] = strings.Join(props, ",")
+		}
+		if len(ratios) > 0 {
+			row[ratioTitle] = strings.Join(ratios, ",")
+		}
+		if pp.NumSvcReps > 0 {
+			row[svcTitle] = fmt.Sprintf("%d", pp.NumSvcReps)
+		}
+		if pp.ACL != nil {
+			row[aclTitle] = fmt.Sprintf("%d", len(pp.ACL.Entries))
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(nameTitle, propsTitle, ratioTitle, svcTitle, aclTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	if !showACL {
+		return
+	}
+	for _, pp := range profiles {
+		if pp == nil || pp.ACL == nil {
+			continue
+		}
+		fmt.Fprintf(out, "\nPool profile %s ACL:\n%s", pp.Name, control.FormatACLDefault(pp.ACL))
+	}
+}
+
+// PrintACLChanges displays the changes that would be made to the entries of a pool ACL. Added
+// entries are prefixed with "+", removed entries with "-" and modified entries with "~".
+func PrintACLChanges(out io.Writer, changes []*control.ACLChange) {
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "No changes to ACL")
+		return
+	}
+
+	for _, c := range changes {
+		switch {
+		case c.Old == "":
+			fmt.Fprintf(out, "+ %s\n", c.New)
+		case c.New == "":
+			fmt.Fprintf(out, "- %s\n", c.Old)
+		default:
+			fmt.Fprintf(out, "~ %s -> %s\n", c.Old, c.New)
+		}
+	}
+}
+
 // PrintPoolRanksResps generates a table showing results of operations on pool ranks. Each row will
 // indicate a common result for a group of ranks on a pool.
 func PrintPoolRanksResps(out io.Writer, resps ...*control.PoolRanksResp) error {
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -393,6 +394,34 @@ Pool created with 5.66%%,94.34%% storage tier ratio
   Data Storage     : 40 GB (10 GB / rank)                
   Memory File Size : 1.2 GB (300 MB / rank)              
 
+`, test.MockPoolUUID()),
+		},
+		"per-rank layout": {
+			pcr: &control.PoolCreateResp{
+				UUID:     test.MockUUID(),
+				SvcReps:  mockRanks(0, 1, 2),
+				TgtRanks: mockRanks(0, 1, 2, 3),
+				TierBytes: []uint64{
+					600 * humanize.MByte,
+					10 * humanize.GByte,
+				},
+				Layout: &control.PoolCreateLayout{
+					AvailBytes: []uint64{1200 * humanize.MByte, 20 * humanize.GByte},
+					TierBytes:  []uint64{600 * humanize.MByte, 10 * humanize.GByte},
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Pool created with 5.66%%,94.34%% storage tier ratio
+-------------------------------------------------
+  UUID                 : %s     
+  Service Leader       : 0                                        
+  Service Ranks        : [0-2]                                    
+  Storage Ranks        : [0-3]                                    
+  Total Size           : 42 GB                                    
+  Storage tier 0 (SCM) : 2.4 GB (600 MB / rank)                   
+  Storage tier 1 (NVMe): 40 GB (10 GB / rank)                     
+  Per-rank Allocation  : 600 MB of 1.2 GB SCM, 10 GB of 20 GB NVMe
+
 `, test.MockPoolUUID()),
 		},
 		"no nvme": {
@@ -432,6 +461,189 @@ Pool created with 100.00%% storage tier ratio
 	}
 }
 
+func TestPretty_PrintPoolUsageHistory(t *testing.T) {
+	history := []*control.PoolUsageSample{
+		{
+			Timestamp: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+			TierStats: []*daos.StorageUsageStats{
+				{Total: 100 * humanize.GByte, Free: 80 * humanize.GByte},
+				{Total: 1 * humanize.TByte, Free: 900 * humanize.GByte},
+			},
+			Rebuild: &daos.PoolRebuildStatus{State: daos.PoolRebuildStateIdle},
+		},
+		{
+			Timestamp: time.Date(2025, 6, 1, 13, 0, 0, 0, time.UTC),
+			TierStats: []*daos.StorageUsageStats{
+				{Total: 100 * humanize.GByte, Free: 70 * humanize.GByte},
+				{Total: 1 * humanize.TByte, Free: 750 * humanize.GByte},
+			},
+			Rebuild: &daos.PoolRebuildStatus{
+				State:   daos.PoolRebuildStateBusy,
+				Objects: 10,
+				Records: 200,
+			},
+			DisabledTargets: 8,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		history []*control.PoolUsageSample
+		mdOnSsd bool
+		expOut  string
+	}{
+		"no history": {
+			expOut: "No pool usage history captured in the requested period\n",
+		},
+		"history": {
+			history: history,
+			expOut: `
+Time                 SCM Used    NVMe Used    Rebuild                  Disabled Targets 
+----                 --------    ---------    -------                  ---------------- 
+2025-06-01T12:00:00Z 20 GB (20%) 100 GB (10%) idle                     0                
+2025-06-01T13:00:00Z 30 GB (30%) 250 GB (25%) busy (10 objs, 200 recs) 8                
+`,
+		},
+		"history; md-on-ssd": {
+			history: history[:1],
+			mdOnSsd: true,
+			expOut: `
+Time                 Meta Used   Data Used    Rebuild Disabled Targets 
+----                 ---------   ---------    ------- ---------------- 
+2025-06-01T12:00:00Z 20 GB (20%) 100 GB (10%) idle    0                
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolUsageHistory(tc.history, tc.mdOnSsd, &bld)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolResizeResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp   *control.PoolResizeResp
+		expOut string
+	}{
+		"nil response": {
+			expOut: "Pool resized\n",
+		},
+		"two tiers": {
+			resp: &control.PoolResizeResp{
+				TierBytes: []uint64{20 * humanize.GByte, 200 * humanize.GByte},
+			},
+			expOut: "Pool resized, per-rank allocation: 20 GB SCM, 200 GB NVMe\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolResizeResp(tc.resp, &bld)
+
+			if diff := cmp.Diff(tc.expOut, bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolProfiles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		profiles []*control.PoolProfile
+		showACL  bool
+		expOut   string
+	}{
+		"no profiles": {
+			expOut: "No pool profiles stored\n",
+		},
+		"profiles without ACL": {
+			profiles: []*control.PoolProfile{
+				{
+					Name:       "prod-ec",
+					Properties: map[string]string{"reclaim": "lazy", "rd_fac": "2"},
+					ACL:        &control.AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+					TierRatio:  []float64{0.1, 0.9},
+					NumSvcReps: 5,
+				},
+				{Name: "test"},
+			},
+			expOut: `
+Name    Properties            Tier Ratio    Service Replicas ACL Entries 
+----    ----------            ----------    ---------------- ----------- 
+prod-ec rd_fac:2,reclaim:lazy 10.00%,90.00% 5                1           
+test    -                     -             -                0           
+`,
+		},
+		"profiles with ACL": {
+			profiles: []*control.PoolProfile{
+				{
+					Name:       "prod-ec",
+					Properties: map[string]string{"reclaim": "lazy", "rd_fac": "2"},
+					ACL:        &control.AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+					TierRatio:  []float64{0.1, 0.9},
+					NumSvcReps: 5,
+				},
+				{Name: "test"},
+			},
+			showACL: true,
+			expOut: `
+Name    Properties            Tier Ratio    Service Replicas ACL Entries 
+----    ----------            ----------    ---------------- ----------- 
+prod-ec rd_fac:2,reclaim:lazy 10.00%,90.00% 5                1           
+test    -                     -             -                0           
+
+Pool profile prod-ec ACL:
+# Entries:
+A::OWNER@:rw
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintPoolProfiles(&bld, tc.showACL, tc.profiles...)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintACLChanges(t *testing.T) {
+	for name, tc := range map[string]struct {
+		changes []*control.ACLChange
+		expOut  string
+	}{
+		"no changes": {
+			expOut: "No changes to ACL\n",
+		},
+		"mixed changes": {
+			changes: []*control.ACLChange{
+				{Principal: "OWNER@", Old: "A::OWNER@:rw", New: "A::OWNER@:rwdtTaAo"},
+				{Principal: "u:user1@", New: "A::user1@:r"},
+				{Principal: "g:group1@", Old: "A:G:group1@:rw"},
+			},
+			expOut: `
+~ A::OWNER@:rw -> A::OWNER@:rwdtTaAo
++ A::user1@:r
+- A:G:group1@:rw
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintACLChanges(&bld, tc.changes)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expOut, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_PrintListPoolsResponse(t *testing.T) {
 	exampleTierStats := []*daos.StorageUsageStats{
 		{
@@ -0,0 +1,56 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"io"
+	"sort"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// PrintReloadCertsResp generates a human-readable table of the certificates loaded by each
+// host in the supplied reload certificates response.
+func PrintReloadCertsResp(resp *control.ReloadCertsResp, out, outErr io.Writer) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	if len(resp.HostCerts) == 0 {
+		return nil
+	}
+
+	hostTitle := "Host"
+	cnTitle := "Common Name"
+	serialTitle := "Serial"
+	expiresTitle := "Expires"
+
+	hosts := make([]string, 0, len(resp.HostCerts))
+	for host := range resp.HostCerts {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	table := []txtfmt.TableRow{}
+	for _, host := range hosts {
+		cert := resp.HostCerts[host]
+		table = append(table, txtfmt.TableRow{
+			hostTitle:    host,
+			cnTitle:      cert.CommonName,
+			serialTitle:  cert.Serial,
+			expiresTitle: cert.NotAfter.Format(time.RFC3339),
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, cnTitle, serialTitle, expiresTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintReloadCertsResp(t *testing.T) {
+	notAfter := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+	certInfo := func(serial string) *control.CertInfo {
+		return &control.CertInfo{
+			CommonName: "server",
+			Serial:     serial,
+			NotBefore:  notAfter.Add(-365 * 24 * time.Hour),
+			NotAfter:   notAfter,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp      *control.ReloadCertsResp
+		expStdout string
+		expStderr string
+	}{
+		"empty response": {
+			resp: new(control.ReloadCertsResp),
+		},
+		"one fail; two reloaded": {
+			resp: &control.ReloadCertsResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host1",
+						Error: "revoked",
+					}),
+				HostCerts: map[string]*control.CertInfo{
+					"host3": certInfo("20"),
+					"host2": certInfo("1f"),
+				},
+			},
+			expStdout: `
+Host  Common Name Serial Expires              
+----  ----------- ------ -------              
+host2 server      1f     2026-01-02T15:04:05Z 
+host3 server      20     2026-01-02T15:04:05Z 
+`,
+			expStderr: `
+Errors:
+  Hosts Error   
+  ----- -----   
+  host1 revoked 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			if err := PrintReloadCertsResp(tc.resp, &out, &outErr); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,9 +8,13 @@
 package pretty
 
 import (
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
 
 // PrintSetEngineLogMasksResp generates a human-readable representation of the supplied response.
@@ -20,3 +25,118 @@ func PrintSetEngineLogMasksResp(resp *control.SetEngineLogMasksResp, out, outErr
 
 	return PrintHostStorageSuccesses("Engine log-masks updated", resp.HostStorage, out)
 }
+
+// PrintServerReloadConfigResp generates a human-readable representation of the supplied response.
+func PrintServerReloadConfigResp(resp *control.ServerReloadConfigResp, out, outErr io.Writer) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	hosts := make([]string, 0, len(resp.HostChanges))
+	for host := range resp.HostChanges {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		changes := resp.HostChanges[host]
+		if len(changes) == 0 {
+			fmt.Fprintf(out, "%s: config reloaded, no changes applied\n", host)
+			continue
+		}
+		fmt.Fprintf(out, "%s: config reloaded, changes applied:\n", host)
+		for _, change := range changes {
+			fmt.Fprintf(out, "  %s\n", change)
+		}
+	}
+
+	return nil
+}
+
+// PrintServerSetEngineStandbyResp generates a human-readable representation of the supplied
+// response.
+func PrintServerSetEngineStandbyResp(engineIdx uint32, resp *control.ServerSetEngineStandbyResp, out, outErr io.Writer) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	hosts := make([]string, 0, len(resp.HostStandby))
+	for host := range resp.HostStandby {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		state := "cleared"
+		if resp.HostStandby[host] {
+			state = "set"
+		}
+		fmt.Fprintf(out, "%s: engine %d standby %s\n", host, engineIdx, state)
+	}
+
+	return nil
+}
+
+// PrintServerCapabilitiesResp generates a human-readable table of the version and control API
+// features reported by each host in the supplied handshake response, followed by the features
+// that are not supported by all of the hosts.
+func PrintServerCapabilitiesResp(resp *control.HandshakeResp, out, outErr io.Writer) error {
+	if err := PrintResponseErrors(resp, outErr); err != nil {
+		return err
+	}
+
+	if len(resp.HostCapabilities) == 0 {
+		return nil
+	}
+
+	hostTitle := "Host"
+	versionTitle := "Version"
+	apiTitle := "API Version"
+	featuresTitle := "Features"
+
+	hosts := make([]string, 0, len(resp.HostCapabilities))
+	for host := range resp.HostCapabilities {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	featureHosts := make(map[string]int)
+	table := []txtfmt.TableRow{}
+	for _, host := range hosts {
+		caps := resp.HostCapabilities[host]
+		row := txtfmt.TableRow{
+			hostTitle:     host,
+			versionTitle:  "unknown",
+			apiTitle:      "legacy",
+			featuresTitle: "None",
+		}
+		if !caps.IsLegacy() {
+			row[versionTitle] = caps.Version
+			row[apiTitle] = fmt.Sprintf("%d (min %d)", caps.APIVersion, caps.MinAPIVersion)
+		}
+		if len(caps.Features) > 0 {
+			row[featuresTitle] = strings.Join(caps.Features, ",")
+		}
+		for _, feature := range caps.Features {
+			featureHosts[feature]++
+		}
+		table = append(table, row)
+	}
+
+	tf := txtfmt.NewTableFormatter(hostTitle, versionTitle, apiTitle, featuresTitle)
+	tf.InitWriter(out)
+	tf.Format(table)
+
+	var partial []string
+	for feature, count := range featureHosts {
+		if count < len(hosts) {
+			partial = append(partial, feature)
+		}
+	}
+	if len(partial) > 0 {
+		sort.Strings(partial)
+		fmt.Fprintf(out, "\nFeatures not supported by all hosts: %s\n", strings.Join(partial, ","))
+	}
+
+	return nil
+}
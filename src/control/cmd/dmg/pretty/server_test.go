@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -130,3 +131,188 @@ Errors:
 		})
 	}
 }
+
+func TestPretty_PrintServerReloadConfigResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp      *control.ServerReloadConfigResp
+		expStdout string
+		expStderr string
+	}{
+		"empty response": {
+			resp: new(control.ServerReloadConfigResp),
+		},
+		"one fail; changes and no changes": {
+			resp: &control.ServerReloadConfigResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host1",
+						Error: "immutable",
+					}),
+				HostChanges: map[string][]string{
+					"host3": nil,
+					"host2": {
+						"control_log_mask: INFO -> DEBUG",
+						"telemetry_port: 9191 -> 9192",
+					},
+				},
+			},
+			expStdout: `
+host2: config reloaded, changes applied:
+  control_log_mask: INFO -> DEBUG
+  telemetry_port: 9191 -> 9192
+host3: config reloaded, no changes applied
+`,
+			expStderr: `
+Errors:
+  Hosts Error     
+  ----- -----     
+  host1 immutable 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			if err := PrintServerReloadConfigResp(tc.resp, &out, &outErr); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintServerSetEngineStandbyResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp      *control.ServerSetEngineStandbyResp
+		expStdout string
+		expStderr string
+	}{
+		"empty response": {
+			resp: new(control.ServerSetEngineStandbyResp),
+		},
+		"one fail; set and cleared": {
+			resp: &control.ServerSetEngineStandbyResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host1",
+						Error: "running",
+					}),
+				HostStandby: map[string]bool{
+					"host3": false,
+					"host2": true,
+				},
+			},
+			expStdout: `
+host2: engine 1 standby set
+host3: engine 1 standby cleared
+`,
+			expStderr: `
+Errors:
+  Hosts Error   
+  ----- -----   
+  host1 running 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			if err := PrintServerSetEngineStandbyResp(1, tc.resp, &out, &outErr); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintServerCapabilitiesResp(t *testing.T) {
+	caps := func(features ...string) *control.ServerCapabilities {
+		return &control.ServerCapabilities{
+			Version:       "2.8.0",
+			APIVersion:    1,
+			MinAPIVersion: 1,
+			Features:      features,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		resp      *control.HandshakeResp
+		expStdout string
+		expStderr string
+	}{
+		"empty response": {
+			resp: new(control.HandshakeResp),
+		},
+		"matching features": {
+			resp: &control.HandshakeResp{
+				HostCapabilities: map[string]*control.ServerCapabilities{
+					"host1": caps("jobs"),
+				},
+			},
+			expStdout: `
+Host  Version API Version Features 
+----  ------- ----------- -------- 
+host1 2.8.0   1 (min 1)   jobs     
+`,
+		},
+		"one fail; mixed versions": {
+			resp: &control.HandshakeResp{
+				HostErrorsResp: control.MockHostErrorsResp(t,
+					&control.MockHostError{
+						Hosts: "host4",
+						Error: "refused",
+					}),
+				HostCapabilities: map[string]*control.ServerCapabilities{
+					"host3": caps("jobs"),
+					"host2": caps("jobs", "reload-certs"),
+					"host1": {},
+				},
+			},
+			expStdout: `
+Host  Version API Version Features          
+----  ------- ----------- --------          
+host1 unknown legacy      None              
+host2 2.8.0   1 (min 1)   jobs,reload-certs 
+host3 2.8.0   1 (min 1)   jobs              
+
+Features not supported by all hosts: jobs,reload-certs
+`,
+			expStderr: `
+Errors:
+  Hosts Error   
+  ----- -----   
+  host4 refused 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out, outErr strings.Builder
+
+			if err := PrintServerCapabilitiesResp(tc.resp, &out, &outErr); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStdout, "\n"), out.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(strings.TrimLeft(tc.expStderr, "\n"), outErr.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
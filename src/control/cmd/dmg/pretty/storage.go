@@ -12,6 +12,8 @@ import (
 	"io"
 	"strings"
 
+	"github.com/dustin/go-humanize"
+
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
@@ -35,6 +37,7 @@ func printHostStorageMapVerbose(hsm control.HostStorageMap, out io.Writer, opts
 				return err
 			}
 		}
+		PrintScmCapabilities(hss.HostStorage.ScmCapabilities, out)
 		fmt.Fprintln(out)
 		if err := PrintNvmeControllers(hss.HostStorage.NvmeDevices, out, opts...); err != nil {
 			return err
@@ -82,6 +85,69 @@ func PrintHostStorageMap(hsm control.HostStorageMap, out io.Writer, opts ...Prin
 	return nil
 }
 
+// PrintUnusedStorageDevices generates a human-readable representation of the devices in the
+// supplied HostStorageMap that are not assigned to any engine and writes it to the supplied
+// io.Writer.
+func PrintUnusedStorageDevices(hsm control.HostStorageMap, out io.Writer, opts ...PrintConfigOption) error {
+	if len(hsm) == 0 {
+		return nil
+	}
+
+	typeTitle := "Type"
+	addrTitle := "Address"
+	numaTitle := "NUMA"
+	driverTitle := "Driver"
+	modelTitle := "Model"
+	serialTitle := "Serial"
+	fwTitle := "FW Revision"
+	capacityTitle := "Capacity"
+
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		hosts := getPrintHosts(hss.HostSet.RangedString(), opts...)
+		lineBreak := strings.Repeat("-", len(hosts))
+		fmt.Fprintf(out, "%s\n%s\n%s\n", lineBreak, hosts, lineBreak)
+
+		devs := hss.HostStorage.UnusedDevices()
+		if len(devs) == 0 {
+			fmt.Fprintf(out, "No unused devices found\n\n")
+			continue
+		}
+
+		tablePrint := txtfmt.NewTableFormatter(typeTitle, addrTitle, numaTitle, driverTitle,
+			modelTitle, serialTitle, fwTitle, capacityTitle)
+		tablePrint.InitWriter(out)
+		table := []txtfmt.TableRow{}
+
+		for _, dev := range devs {
+			row := txtfmt.TableRow{
+				typeTitle:     "NVMe",
+				addrTitle:     dev.Address,
+				numaTitle:     fmt.Sprint(dev.NumaNode),
+				driverTitle:   dev.Driver,
+				modelTitle:    dev.Model,
+				serialTitle:   dev.Serial,
+				fwTitle:       dev.FwRev,
+				capacityTitle: humanize.Bytes(dev.Capacity),
+			}
+			if dev.Type == control.StorageDeviceTypeScm {
+				row[typeTitle] = "SCM"
+			}
+			for _, title := range []string{driverTitle, modelTitle, serialTitle, fwTitle} {
+				if row[title] == "" {
+					row[title] = "N/A"
+				}
+			}
+			table = append(table, row)
+		}
+
+		tablePrint.Format(table)
+		fmt.Fprintln(out)
+	}
+
+	return nil
+}
+
 func printStorageFormatMapVerbose(hsm control.HostStorageMap, out io.Writer, opts ...PrintConfigOption) error {
 	for _, key := range hsm.Keys() {
 		hss := hsm[key]
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -17,6 +18,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -167,6 +169,46 @@ func printNvmeHealth(stat *storage.NvmeHealth, out io.Writer, opts ...PrintConfi
 	return w.Err
 }
 
+func getRemainingEnduranceString(secs uint64) string {
+	const day = 24 * 60 * 60
+
+	switch {
+	case secs == 0:
+		return "Unknown"
+	case secs < day:
+		return (time.Duration(secs) * time.Second).String()
+	default:
+		return fmt.Sprintf("%d days", secs/day)
+	}
+}
+
+func printNvmeHealthTrend(trend *storage.NvmeHealthTrend, out io.Writer) error {
+	w := txtfmt.NewErrWriter(out)
+
+	if trend == nil {
+		return w.Err
+	}
+
+	fmt.Fprintln(out, "Wear Trend:")
+
+	iw := txtfmt.NewIndentWriter(out)
+
+	fmt.Fprintf(iw, "Samples:%d (%s - %s)\n", trend.NrSamples,
+		getTimestampString(trend.FirstTimestamp), getTimestampString(trend.LastTimestamp))
+	fmt.Fprintf(iw, "Host Write Rate:%s/s\n", humanize.Bytes(uint64(trend.HostWriteRate)))
+	fmt.Fprintf(iw, "Write Amplification:%.02f\n", trend.WriteAmp)
+	fmt.Fprintf(iw, "Endurance Used:%d%%\n", trend.WearUsed)
+	fmt.Fprintf(iw, "Predicted Endurance Remaining:%s\n",
+		getRemainingEnduranceString(trend.RemainingSecs))
+	fmt.Fprintf(iw, "Failure Score:%d", trend.FailureScore)
+	if len(trend.FailureReasons) > 0 {
+		fmt.Fprintf(iw, " (%s)", strings.Join(trend.FailureReasons, ", "))
+	}
+	fmt.Fprintln(iw)
+
+	return w.Err
+}
+
 // Strip NVMe controller format results of skip entries.
 func parseNvmeFormatResults(inResults storage.NvmeControllers) storage.NvmeControllers {
 	parsedResults := make(storage.NvmeControllers, 0, len(inResults))
@@ -230,15 +272,7 @@ func rolesRankFromSmd(ctrlr *storage.NvmeController) (string, string) {
 }
 
 // PrintNvmeControllers displays controller details in a verbose table.
-func PrintNvmeControllers(controllers storage.NvmeControllers, out io.Writer, opts ...PrintConfigOption) error {
-	w := txtfmt.NewErrWriter(out)
-
-	iw := txtfmt.NewIndentWriter(out)
-	if len(controllers) == 0 {
-		fmt.Fprintln(iw, "No NVMe devices found")
-		return w.Err
-	}
-
+func printNvmeControllerTable(controllers storage.NvmeControllers, out io.Writer) {
 	pciTitle := "NVMe PCI"
 	modelTitle := "Model"
 	fwTitle := "FW Revision"
@@ -267,6 +301,63 @@ func PrintNvmeControllers(controllers storage.NvmeControllers, out io.Writer, op
 	}
 
 	formatter.Format(table)
+}
+
+// groupNvmeControllersByVMD splits controllers into those that are not behind a VMD domain and
+// those that are VMD backing devices, keyed by the address of their VMD domain.
+func groupNvmeControllersByVMD(controllers storage.NvmeControllers) (storage.NvmeControllers, map[string]storage.NvmeControllers) {
+	var plain storage.NvmeControllers
+	domains := make(map[string]storage.NvmeControllers)
+
+	for _, ctrlr := range controllers {
+		addr, err := hardware.NewPCIAddress(ctrlr.PciAddr)
+		if err != nil || !addr.IsVMDBackingAddress() {
+			plain = append(plain, ctrlr)
+			continue
+		}
+		dom := addr.VMDAddr.String()
+		domains[dom] = append(domains[dom], ctrlr)
+	}
+
+	return plain, domains
+}
+
+// PrintNvmeControllers displays controller details in a verbose table. VMD backing devices are
+// listed in a separate table for each VMD domain.
+func PrintNvmeControllers(controllers storage.NvmeControllers, out io.Writer, opts ...PrintConfigOption) error {
+	w := txtfmt.NewErrWriter(out)
+
+	iw := txtfmt.NewIndentWriter(out)
+	if len(controllers) == 0 {
+		fmt.Fprintln(iw, "No NVMe devices found")
+		return w.Err
+	}
+
+	plain, domains := groupNvmeControllersByVMD(controllers)
+	if len(domains) == 0 {
+		printNvmeControllerTable(controllers, out)
+		return w.Err
+	}
+
+	if len(plain) > 0 {
+		printNvmeControllerTable(plain, out)
+		fmt.Fprintln(out)
+	}
+
+	domAddrs := make([]string, 0, len(domains))
+	for dom := range domains {
+		domAddrs = append(domAddrs, dom)
+	}
+	sort.Strings(domAddrs)
+
+	for i, dom := range domAddrs {
+		if i > 0 {
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "VMD Domain %s\n", dom)
+		printNvmeControllerTable(domains[dom], txtfmt.NewIndentWriter(out))
+	}
+
 	return w.Err
 }
 
@@ -300,3 +391,60 @@ func PrintNvmeHealthMap(hsm control.HostStorageMap, out io.Writer, opts ...Print
 
 	return w.Err
 }
+
+// PrintNvmeNamespaces displays the identifiers and capacities of NVMe namespaces in a table.
+func PrintNvmeNamespaces(nss []*storage.NvmeNamespace, out io.Writer) error {
+	w := txtfmt.NewErrWriter(out)
+
+	if len(nss) == 0 {
+		fmt.Fprintln(out, "No NVMe namespaces")
+		return w.Err
+	}
+
+	idTitle := "Namespace ID"
+	capacityTitle := "Capacity"
+
+	formatter := txtfmt.NewTableFormatter(idTitle, capacityTitle)
+	formatter.InitWriter(out)
+	var table []txtfmt.TableRow
+
+	sort.Slice(nss, func(i, j int) bool { return nss[i].ID < nss[j].ID })
+
+	for _, ns := range nss {
+		table = append(table, txtfmt.TableRow{
+			idTitle:       fmt.Sprint(ns.ID),
+			capacityTitle: humanize.Bytes(ns.Size),
+		})
+	}
+
+	formatter.Format(table)
+	return w.Err
+}
+
+// PrintNvmeReplaceResp displays the progress of a guided NVMe SSD replacement along with any action
+// required for the replacement to progress.
+func PrintNvmeReplaceResp(resp *control.NvmeReplaceResp, out io.Writer) error {
+	devStr := func(uuid, pciAddr string) string {
+		if uuid == "" {
+			uuid = "-"
+		}
+		if pciAddr == "" {
+			pciAddr = "-"
+		}
+		return fmt.Sprintf("%s (%s)", uuid, pciAddr)
+	}
+
+	rows := []txtfmt.TableRow{
+		{"Host": resp.Host},
+		{"Rank": resp.Rank.String()},
+		{"Stage": resp.Stage.String()},
+		{"Old Device": devStr(resp.OldUUID, resp.OldPCIAddr)},
+		{"New Device": devStr(resp.NewUUID, resp.NewPCIAddr)},
+	}
+	if resp.Info != "" {
+		rows = append(rows, txtfmt.TableRow{"Action Required": resp.Info})
+	}
+
+	_, err := fmt.Fprintln(out, txtfmt.FormatEntity("NVMe SSD Replacement", rows))
+	return err
+}
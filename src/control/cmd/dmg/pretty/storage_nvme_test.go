@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -57,10 +58,35 @@ NVMe PCI     Model   FW Revision Socket Capacity Role(s) Rank
 				&storage.NvmeController{PciAddr: "050505:03:00.0"},
 			},
 			expPrintStr: `
-NVMe PCI       Model FW Revision Socket Capacity Role(s) Rank 
---------       ----- ----------- ------ -------- ------- ---- 
-050505:01:00.0                   0      0 B      NA      None 
-050505:03:00.0                   0      0 B      NA      None 
+VMD Domain 0000:05:05.5
+  NVMe PCI       Model FW Revision Socket Capacity Role(s) Rank 
+  --------       ----- ----------- ------ -------- ------- ---- 
+  050505:01:00.0                   0      0 B      NA      None 
+  050505:03:00.0                   0      0 B      NA      None 
+`,
+		},
+		"vmd backing devices; multiple domains and non-vmd device": {
+			devices: storage.NvmeControllers{
+				&storage.NvmeController{PciAddr: "5d0505:01:00.0"},
+				&storage.NvmeController{PciAddr: "050505:03:00.0"},
+				&storage.NvmeController{PciAddr: "0000:81:00.0"},
+				&storage.NvmeController{PciAddr: "050505:01:00.0"},
+			},
+			expPrintStr: `
+NVMe PCI     Model FW Revision Socket Capacity Role(s) Rank 
+--------     ----- ----------- ------ -------- ------- ---- 
+0000:81:00.0                   0      0 B      NA      None 
+
+VMD Domain 0000:05:05.5
+  NVMe PCI       Model FW Revision Socket Capacity Role(s) Rank 
+  --------       ----- ----------- ------ -------- ------- ---- 
+  050505:01:00.0                   0      0 B      NA      None 
+  050505:03:00.0                   0      0 B      NA      None 
+
+VMD Domain 0000:5d:05.5
+  NVMe PCI       Model FW Revision Socket Capacity Role(s) Rank 
+  --------       ----- ----------- ------ -------- ------- ---- 
+  5d0505:01:00.0                   0      0 B      NA      None 
 `,
 		},
 		"controllers with roles": {
@@ -395,3 +421,181 @@ PCI:%s Model:%s FW:%s Socket:%d Capacity:%s
 		})
 	}
 }
+
+func TestPretty_PrintNvmeNamespaces(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nss         []*storage.NvmeNamespace
+		expPrintStr string
+	}{
+		"no namespaces": {
+			expPrintStr: `
+No NVMe namespaces
+`,
+		},
+		"unordered namespaces": {
+			nss: []*storage.NvmeNamespace{
+				{ID: 2, Size: 2 * humanize.TByte},
+				{ID: 1, Size: 2 * humanize.TByte},
+			},
+			expPrintStr: `
+Namespace ID Capacity 
+------------ -------- 
+1            2.0 TB   
+2            2.0 TB   
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintNvmeNamespaces(tc.nss, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_printNvmeHealthTrend(t *testing.T) {
+	for name, tc := range map[string]struct {
+		trend       *storage.NvmeHealthTrend
+		expPrintStr string
+	}{
+		"no trend": {},
+		"remaining endurance unknown": {
+			trend: &storage.NvmeHealthTrend{
+				NrSamples:      1,
+				FirstTimestamp: 1700000000,
+				LastTimestamp:  1700000000,
+			},
+			expPrintStr: fmt.Sprintf(`
+Wear Trend:
+  Samples:1 (%[1]s - %[1]s)
+  Host Write Rate:0 B/s
+  Write Amplification:0.00
+  Endurance Used:0%%
+  Predicted Endurance Remaining:Unknown
+  Failure Score:0
+`, getTimestampString(1700000000)),
+		},
+		"remaining endurance in days": {
+			trend: &storage.NvmeHealthTrend{
+				NrSamples:      3,
+				FirstTimestamp: 1700000000,
+				LastTimestamp:  1700086400,
+				HostWriteRate:  2e6,
+				WriteAmp:       2.5,
+				WearUsed:       10,
+				RemainingSecs:  400 * 86400,
+			},
+			expPrintStr: fmt.Sprintf(`
+Wear Trend:
+  Samples:3 (%s - %s)
+  Host Write Rate:2.0 MB/s
+  Write Amplification:2.50
+  Endurance Used:10%%
+  Predicted Endurance Remaining:400 days
+  Failure Score:0
+`, getTimestampString(1700000000), getTimestampString(1700086400)),
+		},
+		"remaining endurance under a day": {
+			trend: &storage.NvmeHealthTrend{
+				NrSamples:      2,
+				FirstTimestamp: 1700000000,
+				LastTimestamp:  1700086400,
+				HostWriteRate:  2e6,
+				WriteAmp:       1,
+				WearUsed:       99,
+				RemainingSecs:  3600,
+				FailureScore:   100,
+				FailureReasons: []string{
+					"99% of endurance used",
+					"endurance predicted to be exhausted in 1h0m0s",
+				},
+			},
+			expPrintStr: fmt.Sprintf(`
+Wear Trend:
+  Samples:2 (%s - %s)
+  Host Write Rate:2.0 MB/s
+  Write Amplification:1.00
+  Endurance Used:99%%
+  Predicted Endurance Remaining:1h0m0s
+  Failure Score:100 (99%% of endurance used, endurance predicted to be exhausted in 1h0m0s)
+`, getTimestampString(1700000000), getTimestampString(1700086400)),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := printNvmeHealthTrend(tc.trend, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintNvmeReplaceResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.NvmeReplaceResp
+		expPrintStr string
+	}{
+		"awaiting device": {
+			resp: &control.NvmeReplaceResp{
+				Host:       "host1",
+				Rank:       1,
+				Stage:      control.NvmeReplaceAwaitDevice,
+				OldUUID:    "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				OldPCIAddr: "0000:01:00.0",
+				NewPCIAddr: "0000:02:00.0",
+				Info:       "insert new SSD at 0000:02:00.0",
+			},
+			expPrintStr: `
+NVMe SSD Replacement
+--------------------
+  Host            : host1                                              
+  Rank            : 1                                                  
+  Stage           : awaiting-device                                    
+  Old Device      : 842c739b-86b5-462f-a7ba-b4a91b674f3d (0000:01:00.0)
+  New Device      : - (0000:02:00.0)                                   
+  Action Required : insert new SSD at 0000:02:00.0                     
+
+`,
+		},
+		"done": {
+			resp: &control.NvmeReplaceResp{
+				Host:       "host1",
+				Stage:      control.NvmeReplaceDone,
+				OldUUID:    "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				OldPCIAddr: "0000:01:00.0",
+				NewUUID:    "a4c2ae2e-e8b2-4a4e-9b4c-0a9e4d3c5b21",
+				NewPCIAddr: "0000:01:00.0",
+			},
+			expPrintStr: `
+NVMe SSD Replacement
+--------------------
+  Host       : host1                                              
+  Rank       : 0                                                  
+  Stage      : done                                               
+  Old Device : 842c739b-86b5-462f-a7ba-b4a91b674f3d (0000:01:00.0)
+  New Device : a4c2ae2e-e8b2-4a4e-9b4c-0a9e4d3c5b21 (0000:01:00.0)
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintNvmeReplaceResp(tc.resp, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -71,6 +71,80 @@ func PrintHostStorageUsageMap(hsm control.HostStorageMap, out io.Writer) {
 	}
 
 	tablePrint.Format(table)
+	printPoolUsage(hsm, out)
+	printScmOwnership(hsm, out)
+}
+
+// printPoolUsage generates a table of the capacity of each SCM mount and NVMe device used by each
+// pool, if any pool usage was returned.
+func printPoolUsage(hsm control.HostStorageMap, out io.Writer) {
+	hostsTitle := "Hosts"
+	deviceTitle := "Device"
+	poolTitle := "Pool"
+	usedTitle := "Used"
+	shareTitle := "Device-Share"
+
+	table := []txtfmt.TableRow{}
+	addRows := func(hosts, device string, rank ranklist.Rank, total uint64, usage []*storage.PoolStorageUsage) {
+		for _, pu := range usage {
+			table = append(table, txtfmt.TableRow{
+				hostsTitle:  hosts,
+				deviceTitle: device,
+				rankTitle:   rank.String(),
+				poolTitle:   pu.UUID,
+				usedTitle:   humanize.Bytes(pu.UsedBytes),
+				shareTitle:  common.PercentageString(pu.UsedBytes, total),
+			})
+		}
+	}
+
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		hosts := getPrintHosts(hss.HostSet.RangedString())
+
+		for _, ns := range hss.HostStorage.ScmNamespaces {
+			if ns.Mount != nil {
+				addRows(hosts, ns.Mount.Path, ns.Mount.Rank, ns.Mount.TotalBytes,
+					ns.Mount.PoolUsage)
+			}
+		}
+		for _, ctrlr := range hss.HostStorage.NvmeDevices {
+			for _, sd := range ctrlr.SmdDevices {
+				addRows(hosts, ctrlr.PciAddr, sd.Rank, sd.TotalBytes, sd.PoolUsage)
+			}
+		}
+	}
+	if len(table) == 0 {
+		return
+	}
+
+	fmt.Fprintf(out, "\nPool usage per device:\n")
+	tablePrint := txtfmt.NewTableFormatter(hostsTitle, deviceTitle, rankTitle, poolTitle,
+		usedTitle, shareTitle)
+	tablePrint.InitWriter(out)
+	tablePrint.Format(table)
+}
+
+// printScmOwnership warns about SCM mounts that are not labeled as owned by the engine using them.
+func printScmOwnership(hsm control.HostStorageMap, out io.Writer) {
+	for _, key := range hsm.Keys() {
+		hss := hsm[key]
+		hosts := getPrintHosts(hss.HostSet.RangedString())
+
+		for _, ns := range hss.HostStorage.ScmNamespaces {
+			if ns.Mount == nil {
+				continue
+			}
+			switch ns.Mount.Ownership {
+			case storage.ScmOwnershipForeign:
+				fmt.Fprintf(out, "WARNING: %s: SCM mount %s is owned by %s\n", hosts,
+					ns.Mount.Path, ns.Mount.Owner)
+			case storage.ScmOwnershipUnowned:
+				fmt.Fprintf(out, "WARNING: %s: SCM mount %s has no owner label\n", hosts,
+					ns.Mount.Path)
+			}
+		}
+	}
 }
 
 const (
@@ -291,7 +365,12 @@ func PrintHostStorageUsageMapMdOnSsd(hsm control.HostStorageMap, out, dbg io.Wri
 	}
 	fmt.Fprintf(out, "\n")
 
-	return printTierUsageTable(hsm, tierRoles, out, dbg, showUsable)
+	if err := printTierUsageTable(hsm, tierRoles, out, dbg, showUsable); err != nil {
+		return err
+	}
+	printPoolUsage(hsm, out)
+
+	return nil
 }
 
 // NVMe controller namespace ID (NSID) should only be displayed if >= 1. Zero value should be
@@ -382,6 +461,10 @@ func PrintSmdInfoMap(omitDevs, omitPools bool, hsm control.HostStorageMap, out i
 						txtfmt.NewIndentWriter(iw1), opts...); err != nil {
 						return err
 					}
+					if err := printNvmeHealthTrend(device.Ctrlr.HealthTrend,
+						txtfmt.NewIndentWriter(iw1)); err != nil {
+						return err
+					}
 					fmt.Fprintln(out)
 				}
 			} else {
@@ -27,6 +27,8 @@ func TestPretty_PrintHostStorageUsageMap(t *testing.T) {
 		withSpaceUsage = control.MockServerScanResp(t, "withSpaceUsage")
 		noStorage      = control.MockServerScanResp(t, "noStorage")
 		bothFailed     = control.MockServerScanResp(t, "bothFailed")
+		withForeignScm = control.MockServerScanResp(t, "withForeignScm")
+		withPoolUsage  = control.MockServerScanResp(t, "withPoolUsage")
 	)
 
 	for name, tc := range map[string]struct {
@@ -116,6 +118,49 @@ host1 0 B       0 B      N/A      0 B        0 B       N/A
 Hosts SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
 ----- --------- -------- -------- ---------- --------- --------- 
 host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %      
+`,
+		},
+		"single host with foreign scm": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{
+						{
+							Addr:    "host1",
+							Message: withForeignScm,
+						},
+					},
+				},
+			},
+			expPrintStr: `
+Hosts SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
+----- --------- -------- -------- ---------- --------- --------- 
+host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %      
+WARNING: host1: SCM mount /mnt/daos1 is owned by system other engine 0 tier 0
+`,
+		},
+		"single host with pool usage": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: &control.UnaryResponse{
+					Responses: []*control.HostResponse{
+						{
+							Addr:    "host1",
+							Message: withPoolUsage,
+						},
+					},
+				},
+			},
+			expPrintStr: `
+Hosts SCM-Total SCM-Free SCM-Used NVMe-Total NVMe-Free NVMe-Used 
+----- --------- -------- -------- ---------- --------- --------- 
+host1 3.0 TB    750 GB   75 %     36 TB      27 TB     25 %      
+
+Pool usage per device:
+Hosts Device       Rank Pool                                 Used   Device-Share 
+----- ------       ---- ----                                 ----   ------------ 
+host1 /mnt/daos0   0    00000001-0001-0001-0001-000000000001 250 GB 25 %         
+host1 0000:01:00.0 0    00000001-0001-0001-0001-000000000001 100 GB 10 %         
+host1 0000:01:00.0 0    00000002-0002-0002-0002-000000000002 250 GB 25 %         
+host1 0000:02:00.0 0    00000001-0001-0001-0001-000000000001 500 GB 25 %         
 `,
 		},
 	} {
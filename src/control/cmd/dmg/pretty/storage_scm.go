@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 
@@ -64,11 +65,41 @@ func PrintScmModules(modules storage.ScmModules, out io.Writer, opts ...PrintCon
 	uidTitle := "UID"
 	partNumTitle := "Part Number"
 	healthTitle := "Health"
+	securityTitle := "Security"
+	mediaTempTitle := "Media Temp"
+	ctrlrTempTitle := "Ctrlr Temp"
+	spareTitle := "Spare"
+	lifeTitle := "Life Remaining"
 
-	formatter := txtfmt.NewTableFormatter(
+	titles := []string{
 		physicalIdTitle, socketTitle, memCtrlrTitle, channelTitle, slotTitle, capacityTitle,
 		uidTitle, partNumTitle, healthTitle,
-	)
+	}
+	// Only display health statistic columns if any have been reported.
+	withStats := false
+	for _, m := range modules {
+		if m.MediaTemperature != 0 || m.ControllerTemperature != 0 ||
+			m.SparePercentage != 0 || m.LifespanRemaining != 0 {
+			withStats = true
+			break
+		}
+	}
+	// Only display security state column if reported by any module.
+	withSecurity := false
+	for _, m := range modules {
+		if m.SecurityState != "" {
+			withSecurity = true
+			break
+		}
+	}
+	if withSecurity {
+		titles = append(titles, securityTitle)
+	}
+	if withStats {
+		titles = append(titles, mediaTempTitle, ctrlrTempTitle, spareTitle, lifeTitle)
+	}
+
+	formatter := txtfmt.NewTableFormatter(titles...)
 	formatter.InitWriter(out)
 	var table []txtfmt.TableRow
 
@@ -84,6 +115,15 @@ func PrintScmModules(modules storage.ScmModules, out io.Writer, opts ...PrintCon
 		row[uidTitle] = m.UID
 		row[partNumTitle] = m.PartNumber
 		row[healthTitle] = m.HealthState
+		if withSecurity {
+			row[securityTitle] = string(m.SecurityState)
+		}
+		if withStats {
+			row[mediaTempTitle] = printScmModuleStat(m.MediaTemperature, "C")
+			row[ctrlrTempTitle] = printScmModuleStat(m.ControllerTemperature, "C")
+			row[spareTitle] = printScmModuleStat(m.SparePercentage, "%")
+			row[lifeTitle] = printScmModuleStat(m.LifespanRemaining, "%")
+		}
 
 		table = append(table, row)
 	}
@@ -92,6 +132,25 @@ func PrintScmModules(modules storage.ScmModules, out io.Writer, opts ...PrintCon
 	return w.Err
 }
 
+// printScmModuleStat formats a PMem module health statistic, zero indicates the value was not reported.
+func printScmModuleStat(val uint32, unit string) string {
+	if val == 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d%s", val, unit)
+}
+
+// PrintScmCapabilities displays a note listing PMem operations that are unavailable with the
+// discovery backend in use. Nothing is displayed if all operations are supported.
+func PrintScmCapabilities(caps *storage.ScmCapabilities, out io.Writer) {
+	if !caps.Limited() {
+		return
+	}
+
+	fmt.Fprintf(out, "PMem discovered through %s, unavailable operations: %s\n", caps.Backend,
+		strings.Join(caps.Unavailable(), ", "))
+}
+
 // PrintScmNamespaces displays pmem block device details in a verbose table.
 //
 // TODO: un-export function when not needed in cmd/daos_server/storage.go
@@ -124,3 +183,47 @@ func PrintScmNamespaces(namespaces storage.ScmNamespaces, out io.Writer, opts ..
 	formatter.Format(table)
 	return w.Err
 }
+
+// PrintScmPreview displays the changes that a PMem prepare or reset would make on each socket.
+func PrintScmPreview(preview []*storage.ScmSocketPreview, out io.Writer, opts ...PrintConfigOption) error {
+	w := txtfmt.NewErrWriter(out)
+	iw := txtfmt.NewIndentWriter(out)
+	if len(preview) == 0 {
+		fmt.Fprintln(iw, "No PMem changes required")
+		return w.Err
+	}
+
+	socketTitle := "Socket"
+	goalsTitle := "Goals"
+	removeTitle := "Remove Namespaces"
+	createTitle := "Create Namespaces"
+	capacityTitle := "Capacity"
+
+	formatter := txtfmt.NewTableFormatter(socketTitle, goalsTitle, removeTitle, createTitle,
+		capacityTitle)
+	formatter.InitWriter(out)
+	var table []txtfmt.TableRow
+
+	for _, sp := range preview {
+		row := txtfmt.TableRow{socketTitle: fmt.Sprint(sp.SocketID)}
+		row[goalsTitle] = "None"
+		if len(sp.Goals) > 0 {
+			row[goalsTitle] = strings.Join(sp.Goals, "; ")
+		}
+		row[removeTitle] = "None"
+		if len(sp.RemoveNamespaces) > 0 {
+			row[removeTitle] = strings.Join(sp.RemoveNamespaces, ", ")
+		}
+		row[createTitle] = "None"
+		if sp.CreateNamespaces > 0 {
+			row[createTitle] = fmt.Sprintf("%d x %s", sp.CreateNamespaces,
+				humanize.Bytes(sp.NamespaceSize))
+		}
+		row[capacityTitle] = humanize.Bytes(sp.Capacity)
+
+		table = append(table, row)
+	}
+
+	formatter.Format(table)
+	return w.Err
+}
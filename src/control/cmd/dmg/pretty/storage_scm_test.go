@@ -5,3 +5,105 @@
 //
 
 package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestPretty_PrintScmModules(t *testing.T) {
+	withHealth := storage.ScmModules{storage.MockScmModule(0), storage.MockScmModule(1)}
+	withHealth[0].MediaTemperature = 36
+	withHealth[0].ControllerTemperature = 41
+	withHealth[0].LifespanRemaining = 98
+	withHealth[1].SparePercentage = 100
+	withSecurity := storage.ScmModules{storage.MockScmModule(0), storage.MockScmModule(1)}
+	withSecurity[0].SecurityState = storage.ScmSecurityLocked
+	withSecurity[1].SecurityState = storage.ScmSecurityDisabled
+
+	for name, tc := range map[string]struct {
+		modules     storage.ScmModules
+		expPrintStr string
+	}{
+		"no modules": {
+			expPrintStr: `
+  No SCM modules found
+`,
+		},
+		"no health statistics": {
+			modules: storage.ScmModules{storage.MockScmModule(0)},
+			expPrintStr: `
+SCM Module Socket Memory Ctrlr Channel Channel Slot Capacity UID     Part Number Health  
+---------- ------ ------------ ------- ------------ -------- ---     ----------- ------  
+0          0      0            0       0            954 MiB  Device0 PartNumber0 Healthy 
+`,
+		},
+		"with health statistics": {
+			modules: withHealth,
+			expPrintStr: `
+SCM Module Socket Memory Ctrlr Channel Channel Slot Capacity UID     Part Number Health  Media Temp Ctrlr Temp Spare Life Remaining 
+---------- ------ ------------ ------- ------------ -------- ---     ----------- ------  ---------- ---------- ----- -------------- 
+0          0      0            0       0            954 MiB  Device0 PartNumber0 Healthy 36C        41C        N/A   98%            
+1          1      1            1       1            954 MiB  Device1 PartNumber1 Healthy N/A        N/A        100%  N/A            
+`,
+		},
+		"with security state": {
+			modules: withSecurity,
+			expPrintStr: `
+SCM Module Socket Memory Ctrlr Channel Channel Slot Capacity UID     Part Number Health  Security 
+---------- ------ ------------ ------- ------------ -------- ---     ----------- ------  -------- 
+0          0      0            0       0            954 MiB  Device0 PartNumber0 Healthy Locked   
+1          1      1            1       1            954 MiB  Device1 PartNumber1 Healthy Disabled 
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintScmModules(tc.modules, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintScmCapabilities(t *testing.T) {
+	for name, tc := range map[string]struct {
+		caps        *storage.ScmCapabilities
+		expPrintStr string
+	}{
+		"nil capabilities": {},
+		"full capabilities": {
+			caps: &storage.ScmCapabilities{
+				Backend:  storage.ScmBackendTools,
+				Prepare:  true,
+				Firmware: true,
+				Health:   true,
+			},
+		},
+		"sysfs backend": {
+			caps: &storage.ScmCapabilities{
+				Backend: storage.ScmBackendSysfs,
+			},
+			expPrintStr: `
+PMem discovered through sysfs, unavailable operations: prepare, firmware, health
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintScmCapabilities(tc.caps, &bld)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected print output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
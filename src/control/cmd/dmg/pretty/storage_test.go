@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -875,6 +876,86 @@ NVMe PCI     Model FW Revision Socket Capacity Role(s)       Rank
 	}
 }
 
+func TestPretty_PrintUnusedStorageDevices(t *testing.T) {
+	usedScm := storage.MockScmNamespace(0)
+	usedScm.Binding = &storage.DeviceBinding{EngineIdx: 0, TierIdx: 0, Class: storage.ClassDcpm}
+	usedNvme := storage.MockNvmeController(1)
+	usedNvme.Binding = &storage.DeviceBinding{EngineIdx: 0, TierIdx: 1, Class: storage.ClassNvme}
+	kernelNvme := &storage.NvmeController{
+		PciAddr:  "0000:83:00.0",
+		SocketID: 1,
+		Driver:   "nvme",
+		Model:    "model2",
+		Serial:   "serial2",
+		FwRev:    "fwRev2",
+		Namespaces: []*storage.NvmeNamespace{
+			{ID: 1, Size: 2000000000000},
+		},
+	}
+	vfioNvme := &storage.NvmeController{
+		PciAddr: "0000:84:00.0",
+		Driver:  "vfio-pci",
+	}
+
+	for name, tc := range map[string]struct {
+		hsm         control.HostStorageMap
+		expPrintStr string
+	}{
+		"empty map": {
+			hsm: control.HostStorageMap{},
+		},
+		"unused devices on one host": {
+			hsm: mockHostStorageMap(t,
+				&mockHostStorage{
+					hostAddr: "host1",
+					storage: &control.HostStorage{
+						ScmNamespaces: storage.ScmNamespaces{
+							usedScm, storage.MockScmNamespace(1),
+						},
+						NvmeDevices: storage.NvmeControllers{
+							usedNvme, kernelNvme, vfioNvme,
+						},
+					},
+				},
+				&mockHostStorage{
+					hostAddr: "host2",
+					storage: &control.HostStorage{
+						ScmNamespaces: storage.ScmNamespaces{usedScm},
+						NvmeDevices:   storage.NvmeControllers{usedNvme},
+					},
+				},
+			),
+			expPrintStr: `
+-----
+host1
+-----
+Type Address      NUMA Driver   Model  Serial  FW Revision Capacity 
+---- -------      ---- ------   -----  ------  ----------- -------- 
+SCM  pmem1        1    N/A      N/A    N/A     N/A         2.0 TB   
+NVMe 0000:83:00.0 1    nvme     model2 serial2 fwRev2      2.0 TB   
+NVMe 0000:84:00.0 0    vfio-pci N/A    N/A     N/A         0 B      
+
+-----
+host2
+-----
+No unused devices found
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintUnusedStorageDevices(tc.hsm, &bld); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_PrintStorageFormatMap(t *testing.T) {
 	for name, tc := range map[string]struct {
 		resp        *control.StorageFormatResp
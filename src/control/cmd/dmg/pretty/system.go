@@ -180,10 +180,104 @@ func PrintSystemStartResponse(out, outErr io.Writer, resp *control.SystemStartRe
 	return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts, &resp.AbsentRanks)
 }
 
+// printSystemMsgTable groups ranks by result message. Unlike other results, the message is
+// reported for successful operations, e.g. as it indicates whether in-flight I/O completed
+// during a drain or how far the staged shutdown of an engine progressed before it stopped.
+func printSystemMsgTable(out io.Writer, results system.MemberResults) error {
+	groups := make(system.RankGroups)
+	for _, r := range results {
+		msg := r.Msg
+		if msg == "" {
+			msg = "OK"
+		}
+		key := r.Action + rowFieldSep + strings.Replace(msg, rowFieldSep, " ", -1)
+		if _, exists := groups[key]; !exists {
+			groups[key] = ranklist.MustCreateRankSet("")
+		}
+		groups[key].Add(r.Rank)
+	}
+
+	if err := tabulateRankGroups(out, groups, "Rank", "Operation", "Result"); err != nil {
+		return errors.Wrap(err, "printing result table")
+	}
+
+	return nil
+}
+
 // PrintSystemStopResponse generates a human-readable representation of the
-// supplied SystemStopResp struct and writes it to the supplied io.Writer.
-func PrintSystemStopResponse(out, outErr io.Writer, resp *control.SystemStopResp) error {
-	return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts, &resp.AbsentRanks)
+// supplied SystemStopResp struct and writes it to the supplied io.Writer. In
+// verbose mode the shutdown progress of each rank is displayed.
+func PrintSystemStopResponse(out, outErr io.Writer, resp *control.SystemStopResp, opts ...PrintConfigOption) error {
+	if len(resp.DrainResults) > 0 {
+		if err := printSystemMsgTable(out, resp.DrainResults); err != nil {
+			return err
+		}
+	}
+
+	if !getPrintConfig(opts...).Verbose || len(resp.Results) == 0 {
+		return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts,
+			&resp.AbsentRanks)
+	}
+
+	if err := printSystemMsgTable(out, resp.Results); err != nil {
+		return err
+	}
+	printAbsentHosts(outErr, &resp.AbsentHosts)
+	printAbsentRanks(outErr, &resp.AbsentRanks)
+
+	return nil
+}
+
+// PrintSystemEraseResponse generates a human-readable representation of the supplied
+// SystemEraseResp struct and writes it to the supplied io.Writer. The result of each rank
+// reports what was destroyed.
+func PrintSystemEraseResponse(out, outErr io.Writer, resp *control.SystemEraseResp) error {
+	if len(resp.Results) == 0 {
+		return printSystemResults(out, outErr, resp.Results, &resp.AbsentHosts,
+			&resp.AbsentRanks)
+	}
+
+	if err := printSystemMsgTable(out, resp.Results); err != nil {
+		return err
+	}
+	printAbsentHosts(outErr, &resp.AbsentHosts)
+	printAbsentRanks(outErr, &resp.AbsentRanks)
+
+	return nil
+}
+
+// PrintSystemRollingRestartResponse generates a human-readable representation of the supplied
+// SystemRollingRestartResp struct and writes it to the supplied io.Writer.
+func PrintSystemRollingRestartResponse(out io.Writer, resp *control.SystemRollingRestartResp) {
+	if len(resp.Batches) == 0 {
+		fmt.Fprintln(out, "No ranks restarted")
+		return
+	}
+
+	titles := []string{"Ranks", "Fault Domains", "Result"}
+	formatter := txtfmt.NewTableFormatter(titles...)
+
+	var table []txtfmt.TableRow
+	for _, b := range resp.Batches {
+		result := "OK"
+		switch {
+		case b.Error != "":
+			result = b.Error
+		case len(b.DegradedPools) > 0:
+			result = "OK, degraded pools: " + strings.Join(b.DegradedPools, ",")
+		}
+		table = append(table, txtfmt.TableRow{
+			"Ranks":         b.Ranks.String(),
+			"Fault Domains": strings.Join(b.FaultDomains, ","),
+			"Result":        result,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	if resp.NotRestarted.Count() > 0 {
+		fmt.Fprintf(out, "Ranks not restarted: %s\n", resp.NotRestarted)
+	}
 }
 
 func printSystemCleanupRespVerbose(out io.Writer, resp *control.SystemCleanupResp) {
@@ -222,3 +316,70 @@ func PrintSystemCleanupResponse(out io.Writer, resp *control.SystemCleanupResp,
 
 	fmt.Fprintln(out, "System Cleanup Success")
 }
+
+// PrintSystemEvents writes a line for each of the supplied RAS events retained by the MS,
+// oldest first. In verbose mode, all of the fields of each event are displayed.
+func PrintSystemEvents(out io.Writer, evts []*control.SystemEvent, verbose bool) {
+	for _, se := range evts {
+		evt := se.Event
+		if verbose {
+			fmt.Fprintf(out, "seq: [%d] %s\n", se.Seq, evt.PrintRAS())
+			continue
+		}
+
+		rank := "-"
+		if ranklist.Rank(evt.Rank) != ranklist.NilRank {
+			rank = fmt.Sprintf("%d", evt.Rank)
+		}
+		fmt.Fprintf(out, "%s %-7s %s rank %s %s: %s", evt.Timestamp, evt.Severity,
+			evt.Hostname, rank, evt.ID, evt.Msg)
+		if evt.Count > 0 {
+			fmt.Fprintf(out, " (repeated %d times)", evt.Count)
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+// PrintSystemCrashReports writes a table of the supplied engine crash reports, oldest first.
+// The terminating signal is displayed in place of the exit status if the engine was killed
+// by a signal.
+func PrintSystemCrashReports(out io.Writer, reports []*control.SystemCrashReport) {
+	timeTitle := "Time"
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	engineTitle := "Engine"
+	exitTitle := "Exit"
+	coreTitle := "Core Dump"
+	reportTitle := "Report"
+
+	formatter := txtfmt.NewTableFormatter(timeTitle, hostTitle, rankTitle, engineTitle,
+		exitTitle, coreTitle, reportTitle)
+	var table []txtfmt.TableRow
+
+	for _, cr := range reports {
+		rank := "-"
+		if cr.Rank != ranklist.NilRank {
+			rank = cr.Rank.String()
+		}
+		exit := cr.ExitStatus
+		if cr.Signal != "" {
+			exit = "signal: " + cr.Signal
+		}
+		core := cr.CoreDump
+		if core == "" {
+			core = "-"
+		}
+
+		table = append(table, txtfmt.TableRow{
+			timeTitle:   cr.Timestamp,
+			hostTitle:   cr.Hostname,
+			rankTitle:   rank,
+			engineTitle: fmt.Sprintf("%d", cr.InstanceIdx),
+			exitTitle:   exit,
+			coreTitle:   core,
+			reportTitle: cr.ReportPath,
+		})
+	}
+
+	fmt.Fprint(out, formatter.Format(table))
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -529,6 +530,7 @@ func TestPretty_PrintSystemStopResp(t *testing.T) {
 		resp        *control.SystemStopResp
 		absentHosts string
 		absentRanks string
+		verbose     bool
 		expPrintStr string
 	}{
 		"empty response": {
@@ -577,6 +579,64 @@ Rank  Operation Result
 2     stop      fail,  / 
 0     stop      failed   
 
+`,
+		},
+		"response with drain results": {
+			resp: &control.SystemStopResp{
+				Results: successResults,
+				DrainResults: MemberResults{
+					&MemberResult{Rank: 0, Action: "drain", Msg: "drained"},
+					&MemberResult{
+						Rank: 1, Action: "drain",
+						Msg: "timed out with 4 RPCs in flight",
+					},
+					&MemberResult{Rank: 2, Action: "drain", Msg: "drained"},
+					NewMemberResult(3, errors.New("drain failed"), MemberStateErrored,
+						"drain"),
+				},
+			},
+			expPrintStr: `
+Rank  Operation Result                          
+----  --------- ------                          
+[0,2] drain     drained                         
+3     drain     drain failed                    
+1     drain     timed out with 4 RPCs in flight 
+
+Rank  Operation Result 
+----  --------- ------ 
+[0-3] stop      OK     
+
+`,
+		},
+		"verbose response with shutdown progress": {
+			resp: &control.SystemStopResp{
+				Results: MemberResults{
+					&MemberResult{
+						Rank: 0, Action: "stop", State: MemberStateStopped,
+						Msg: "notified, exited after SIGINT",
+					},
+					&MemberResult{
+						Rank: 1, Action: "stop", State: MemberStateStopped,
+						Msg: "notified, flush timed out after 1m0s, exited after SIGKILL",
+					},
+					&MemberResult{
+						Rank: 2, Action: "stop", State: MemberStateStopped,
+						Msg: "notified, exited after SIGINT",
+					},
+					NewMemberResult(3, errors.New("rank failed to stop"),
+						MemberStateErrored, "stop"),
+				},
+			},
+			absentRanks: "7",
+			verbose:     true,
+			expPrintStr: `
+Rank  Operation Result                                                     
+----  --------- ------                                                     
+[0,2] stop      notified, exited after SIGINT                              
+1     stop      notified, flush timed out after 1m0s, exited after SIGKILL 
+3     stop      rank failed to stop                                        
+
+Unknown 1 rank: 7
 `,
 		},
 		"normal response with missing hosts and ranks": {
@@ -602,7 +662,8 @@ Unknown 3 hosts: foo[7-9]
 			var bld strings.Builder
 			// pass the same io writer to standard and error stream
 			// parameters to mimic combined output seen on terminal
-			if err := PrintSystemStopResponse(&bld, &bld, tc.resp); err != nil {
+			if err := PrintSystemStopResponse(&bld, &bld, tc.resp,
+				PrintWithVerboseOutput(tc.verbose)); err != nil {
 				t.Fatal(err)
 			}
 
@@ -612,3 +673,222 @@ Unknown 3 hosts: foo[7-9]
 		})
 	}
 }
+
+func TestPretty_PrintSystemEraseResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemEraseResp
+		absentHosts string
+		absentRanks string
+		expPrintStr string
+	}{
+		"empty response with missing ranks": {
+			resp:        &control.SystemEraseResp{},
+			absentRanks: "7-9",
+			expPrintStr: `
+No results returned
+Unknown 3 ranks: 7-9
+`,
+		},
+		"erase report": {
+			resp: &control.SystemEraseResp{
+				Results: MemberResults{
+					&MemberResult{
+						Rank: 0, Action: "erase", State: MemberStateAwaitFormat,
+						Msg: "superblock removed, SCM at /mnt/daos0 wiped (3 entries removed)",
+					},
+					&MemberResult{
+						Rank: 1, Action: "erase", State: MemberStateAwaitFormat,
+						Msg: "superblock removed, SCM at /mnt/daos1 not mounted, not wiped",
+					},
+					&MemberResult{
+						Rank: 2, Action: "erase", State: MemberStateAwaitFormat,
+						Msg: "superblock removed, SCM at /mnt/daos0 wiped (3 entries removed)",
+					},
+					NewMemberResult(3, errors.New("permission denied"),
+						MemberStateErrored, "erase"),
+				},
+			},
+			absentRanks: "7",
+			expPrintStr: `
+Rank  Operation Result                                                          
+----  --------- ------                                                          
+[0,2] erase     superblock removed, SCM at /mnt/daos0 wiped (3 entries removed) 
+3     erase     permission denied                                               
+1     erase     superblock removed, SCM at /mnt/daos1 not mounted, not wiped    
+
+Unknown 1 rank: 7
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.resp.AbsentRanks = *MustCreateRankSet(tc.absentRanks)
+			tc.resp.AbsentHosts = *hostlist.MustCreateSet(tc.absentHosts)
+
+			var bld strings.Builder
+			if err := PrintSystemEraseResponse(&bld, &bld, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemRollingRestartResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemRollingRestartResp
+		expPrintStr string
+	}{
+		"no batches": {
+			resp: &control.SystemRollingRestartResp{},
+			expPrintStr: `
+No ranks restarted
+`,
+		},
+		"aborted": {
+			resp: &control.SystemRollingRestartResp{
+				Batches: []*control.RollingRestartBatch{
+					{
+						FaultDomains: []string{"/rack0/node0"},
+						Ranks:        MustCreateRankSet("0-1"),
+						Stage:        control.RollingRestartDone,
+					},
+					{
+						FaultDomains:  []string{"/rack0/node1"},
+						Ranks:         MustCreateRankSet("2"),
+						Stage:         control.RollingRestartDone,
+						DegradedPools: []string{"pool1"},
+					},
+					{
+						FaultDomains: []string{"/rack0/node2", "/rack0/node3"},
+						Ranks:        MustCreateRankSet("4-5"),
+						Stage:        control.RollingRestartFailed,
+						Error:        "stop: check results for failed rank 4",
+					},
+				},
+				NotRestarted: MustCreateRankSet("6-7"),
+			},
+			expPrintStr: `
+Ranks Fault Domains             Result                                
+----- -------------             ------                                
+0-1   /rack0/node0              OK                                    
+2     /rack0/node1              OK, degraded pools: pool1             
+4-5   /rack0/node2,/rack0/node3 stop: check results for failed rank 4 
+
+Ranks not restarted: 6-7
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemRollingRestartResponse(&bld, tc.resp)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemEvents(t *testing.T) {
+	evts := []*control.SystemEvent{
+		{
+			Seq: 1,
+			Event: &events.RASEvent{
+				ID:        events.RASEngineDied,
+				Timestamp: "2025-01-02T15:04:05.000+00:00",
+				Severity:  events.RASSeverityError,
+				Hostname:  "host1",
+				Rank:      3,
+				Msg:       "DAOS engine 0 exited unexpectedly",
+			},
+		},
+		{
+			Seq: 2,
+			Event: &events.RASEvent{
+				ID:        events.RASSystemFabricProvChanged,
+				Timestamp: "2025-01-02T15:05:05.000+00:00",
+				Severity:  events.RASSeverityNotice,
+				Hostname:  "host2",
+				Rank:      uint32(NilRank),
+				Msg:       "fabric provider changed",
+				Count:     4,
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		evts        []*control.SystemEvent
+		verbose     bool
+		expPrintStr string
+	}{
+		"no events": {},
+		"events": {
+			evts: evts,
+			expPrintStr: fmt.Sprintf(`
+2025-01-02T15:04:05.000+00:00 ERROR   host1 rank 3 %s: DAOS engine 0 exited unexpectedly
+2025-01-02T15:05:05.000+00:00 NOTICE  host2 rank - %s: fabric provider changed (repeated 4 times)
+`, events.RASEngineDied, events.RASSystemFabricProvChanged),
+		},
+		"verbose": {
+			evts:    evts[:1],
+			verbose: true,
+			expPrintStr: fmt.Sprintf(`
+seq: [1] %s
+`, evts[0].Event.PrintRAS()),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			PrintSystemEvents(&bld, tc.evts, tc.verbose)
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemCrashReports(t *testing.T) {
+	reports := []*control.SystemCrashReport{
+		{
+			Timestamp: "2025-01-02T15:04:05.000+00:00",
+			Hostname:  "host1",
+			Rank:      3,
+			EngineCrashInfo: events.EngineCrashInfo{
+				InstanceIdx: 0,
+				ExitStatus:  "/usr/bin/daos_engine exited: signal: aborted (core dumped)",
+				Signal:      "aborted",
+				CoreDump:    "/var/crash/core.1234",
+				ReportPath:  "/var/log/daos/crash/engine0-20250102T150405Z-1234.json",
+			},
+		},
+		{
+			Timestamp: "2025-01-02T16:04:05.000+00:00",
+			Hostname:  "host2",
+			Rank:      NilRank,
+			EngineCrashInfo: events.EngineCrashInfo{
+				InstanceIdx: 1,
+				ExitStatus:  "/usr/bin/daos_engine exited: exit status 1",
+				ReportPath:  "/var/log/daos/crash/engine1-20250102T160405Z-5678.json",
+			},
+		},
+	}
+
+	expPrintStr := strings.Join([]string{
+		"Time                          Host  Rank Engine Exit                                       Core Dump            Report                                                 ",
+		"----                          ----  ---- ------ ----                                       ---------            ------                                                 ",
+		"2025-01-02T15:04:05.000+00:00 host1 3    0      signal: aborted                            /var/crash/core.1234 /var/log/daos/crash/engine0-20250102T150405Z-1234.json ",
+		"2025-01-02T16:04:05.000+00:00 host2 -    1      /usr/bin/daos_engine exited: exit status 1 -                    /var/log/daos/crash/engine1-20250102T160405Z-5678.json ",
+		"",
+	}, "\n")
+
+	var bld strings.Builder
+	PrintSystemCrashReports(&bld, reports)
+
+	if diff := cmp.Diff(expPrintStr, bld.String()); diff != "" {
+		t.Fatalf("unexpected string output (-want, +got):\n%s\n", diff)
+	}
+}
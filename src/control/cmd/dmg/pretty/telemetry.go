@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
@@ -77,6 +79,31 @@ func PrintMetricsQueryResp(out io.Writer, resp *control.MetricsQueryResp) error
 	return nil
 }
 
+// PrintMetricsHistoryResp formats a MetricsHistoryResp as a list of samples, each of which
+// includes the metric sets sampled at that time.
+func PrintMetricsHistoryResp(out io.Writer, resp *control.MetricsHistoryResp) error {
+	if resp == nil {
+		return errors.New("nil response")
+	}
+
+	if len(resp.Samples) == 0 {
+		fmt.Fprintf(out, "No samples found\n")
+		return nil
+	}
+
+	for _, sample := range resp.Samples {
+		fmt.Fprintf(out, "Sampled at %s\n", sample.Timestamp.Format(time.RFC3339))
+
+		iw := txtfmt.NewIndentWriter(out)
+		if err := PrintMetricsQueryResp(iw, &control.MetricsQueryResp{
+			MetricSets: sample.MetricSets,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func printMetrics(out io.Writer, metrics []daos.Metric, metricType daos.MetricType) {
 	if len(metrics) == 0 {
 		fmt.Fprintf(out, "No metrics found\n")
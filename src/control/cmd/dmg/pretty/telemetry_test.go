@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/control"
@@ -441,3 +443,76 @@ func TestPretty_PrintMetricsQueryResp(t *testing.T) {
 		})
 	}
 }
+
+func TestPretty_PrintMetricsHistoryResp(t *testing.T) {
+	ts := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		resp      *control.MetricsHistoryResp
+		expOutput string
+		expErr    error
+	}{
+		"nil resp": {
+			expErr: errors.New("nil response"),
+		},
+		"no samples": {
+			resp: &control.MetricsHistoryResp{},
+			expOutput: `
+No samples found
+`,
+		},
+		"samples": {
+			resp: &control.MetricsHistoryResp{
+				Samples: []*control.MetricsSample{
+					{
+						Timestamp: ts,
+						MetricSets: []*daos.MetricSet{
+							{
+								Name:        "test_metric_1",
+								Description: "Test Metric",
+							},
+						},
+					},
+					{
+						Timestamp: ts.Add(10 * time.Second),
+						MetricSets: []*daos.MetricSet{
+							{
+								Name:        "my_gauge",
+								Description: "A test gauge",
+								Type:        daos.MetricTypeGauge,
+								Metrics: []daos.Metric{
+									&daos.SimpleMetric{
+										Labels: map[string]string{},
+										Value:  125,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expOutput: `
+Sampled at 2025-06-01T12:00:00Z
+  - Metric Set: test_metric_1 (Type: Unknown)
+    Test Metric
+      No metrics found
+
+Sampled at 2025-06-01T12:00:10Z
+  - Metric Set: my_gauge (Type: Gauge)
+    A test gauge
+      Metric Labels Value 
+      ------ ------ ----- 
+      Gauge  N/A    125   
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintMetricsHistoryResp(&bld, tc.resp)
+
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, strings.TrimLeft(tc.expOutput, "\n"), bld.String(), "")
+		})
+	}
+}
@@ -0,0 +1,67 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// securityCmd is the struct representing the top-level security subcommand.
+type securityCmd struct {
+	ReloadCerts securityReloadCertsCmd `command:"reload-certs" description:"Reload the certificates and certificate revocation list of each server from disk without restarting"`
+}
+
+// securityReloadCertsCmd is the struct representing the command to reload the certificates of
+// the servers in the hostlist.
+type securityReloadCertsCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when securityReloadCertsCmd activates.
+func (cmd *securityReloadCertsCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "certificate reload failed")
+	}()
+
+	req := new(control.ReloadCertsReq)
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Tracef("reload certificates request: %+v", req)
+
+	resp, err := control.ReloadCerts(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("reload certificates response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintReloadCertsResp(resp, &out, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
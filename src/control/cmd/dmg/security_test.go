@@ -0,0 +1,38 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestDmg_SecurityCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Reload certificates",
+			"security reload-certs",
+			printRequest(t, &control.ReloadCertsReq{}),
+			nil,
+		},
+		{
+			"Reload certificates with alias",
+			"sec reload-certs",
+			printRequest(t, &control.ReloadCertsReq{}),
+			nil,
+		},
+		{
+			"Reload certificates with invalid flag",
+			"security reload-certs --force",
+			"",
+			errors.New("unknown flag"),
+		},
+	})
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,7 +19,10 @@ import (
 
 // serverCmd is the struct representing the top-level server subcommand.
 type serverCmd struct {
-	SetLogMasks serverSetLogMasksCmd `command:"set-logmasks" alias:"slm" description:"Set log masks for a set of facilities to a given level and optionally specify debug streams to enable. Setting will be applied to all running DAOS I/O Engines present in the configured dmg hostlist."`
+	SetLogMasks  serverSetLogMasksCmd  `command:"set-logmasks" alias:"slm" description:"Set log masks for a set of facilities to a given level and optionally specify debug streams to enable. Setting will be applied to all running DAOS I/O Engines present in the configured dmg hostlist."`
+	ReloadConfig serverReloadConfigCmd `command:"reload-config" description:"Re-read the server config file on each host and apply changes to parameters that can be updated without restarting, such as log masks, the telemetry port and the MS replica list."`
+	Capabilities serverCapabilitiesCmd `command:"capabilities" alias:"caps" description:"Display the version, control API version and optional control API features of each server. Use this to check which requests can be made of each server while the system is running mixed versions, e.g. during a rolling upgrade."`
+	Standby      serverStandbyCmd      `command:"standby" description:"Place an engine in standby mode so that it is not started, or clear standby mode with --clear. The engine's rank must be stopped before entering standby. Standby set with this command lasts until daos_server restarts, set standby in the server config file to make it persistent."`
 }
 
 // serverSetLogMasksCmd is the struct representing the command to set engine log
@@ -72,3 +76,144 @@ func (cmd *serverSetLogMasksCmd) Execute(_ []string) (errOut error) {
 
 	return resp.Errors()
 }
+
+// serverReloadConfigCmd is the struct representing the command to reload the config file of
+// running servers.
+type serverReloadConfigCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when serverReloadConfigCmd activates.
+func (cmd *serverReloadConfigCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "server config reload failed")
+	}()
+
+	req := new(control.ServerReloadConfigReq)
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Tracef("server reload config request: %+v", req)
+
+	resp, err := control.ServerReloadConfig(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("server reload config response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintServerReloadConfigResp(resp, &out, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
+
+// serverCapabilitiesCmd is the struct representing the command to display the control API
+// capabilities of servers.
+type serverCapabilitiesCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when serverCapabilitiesCmd activates.
+func (cmd *serverCapabilitiesCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "server capabilities query failed")
+	}()
+
+	req := new(control.HandshakeReq)
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Tracef("handshake request: %+v", req)
+
+	resp, err := control.Handshake(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("handshake response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintServerCapabilitiesResp(resp, &out, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
+
+// serverStandbyCmd is the struct representing the command to place an engine in or take it out
+// of standby mode.
+type serverStandbyCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	EngineIdx uint32 `short:"e" long:"engine" required:"1" description:"Index of the engine in the server config file"`
+	Clear     bool   `short:"c" long:"clear" description:"Take the engine out of standby mode so that it can be started"`
+}
+
+// Execute is run when serverStandbyCmd activates.
+func (cmd *serverStandbyCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "set engine standby failed")
+	}()
+
+	req := &control.ServerSetEngineStandbyReq{
+		EngineIdx: cmd.EngineIdx,
+		Standby:   !cmd.Clear,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Tracef("set engine standby request: %+v", req)
+
+	resp, err := control.ServerSetEngineStandby(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("set engine standby response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintServerSetEngineStandbyResp(req.EngineIdx, resp, &out,
+		&outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -56,5 +57,44 @@ func TestDmg_ServerCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"Reload server config",
+			"server reload-config",
+			printRequest(t, &control.ServerReloadConfigReq{}),
+			nil,
+		},
+		{
+			"Server capabilities",
+			"server capabilities",
+			printRequest(t, &control.HandshakeReq{}),
+			nil,
+		},
+		{
+			"Server capabilities with alias",
+			"server caps",
+			printRequest(t, &control.HandshakeReq{}),
+			nil,
+		},
+		{
+			"Set engine standby",
+			"server standby --engine 1",
+			printRequest(t, &control.ServerSetEngineStandbyReq{
+				EngineIdx: 1,
+				Standby:   true,
+			}),
+			nil,
+		},
+		{
+			"Clear engine standby",
+			"server standby -e 0 --clear",
+			printRequest(t, &control.ServerSetEngineStandbyReq{}),
+			nil,
+		},
+		{
+			"Set engine standby; missing engine index",
+			"server standby",
+			"",
+			errMissingFlag,
+		},
 	})
 }
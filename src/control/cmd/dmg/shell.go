@@ -0,0 +1,408 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/desertbit/go-shlex"
+	"github.com/desertbit/readline"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	shellPrompt      = "dmg> "
+	shellHistoryFile = ".dmg_history"
+	// shellCacheTTL is how long the cached system membership and pools are
+	// used for completion before being refreshed.
+	shellCacheTTL = 30 * time.Second
+	// shellCacheTimeout bounds the time spent refreshing the cache.
+	shellCacheTimeout = 10 * time.Second
+)
+
+var (
+	shellExitCmds = []string{"exit", "quit"}
+	shellHelpCmd  = "help"
+	// option names whose values are completed with the cached ranks or hosts
+	shellRankOpts = map[string]bool{"rank": true, "ranks": true, "svcl": true}
+	shellHostOpts = map[string]bool{"host": true, "host-list": true, "rank-hosts": true}
+)
+
+type shellLineRunner func(args []string) error
+
+// shellCmd is the struct representing the command to run an interactive dmg
+// shell. Commands entered in the shell share a single client so connections
+// to servers are reused between commands.
+type shellCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	HistoryFile string `long:"history-file" description:"File to record command history in (default ~/.dmg_history)"`
+
+	runLine shellLineRunner
+}
+
+func (cmd *shellCmd) setLineRunner(runLine shellLineRunner) {
+	cmd.runLine = runLine
+}
+
+func (cmd *shellCmd) historyFile() string {
+	if cmd.HistoryFile != "" {
+		return cmd.HistoryFile
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, shellHistoryFile)
+}
+
+// execLine runs a single line of input and returns true if the shell should
+// exit. Errors are reported and do not end the shell.
+func (cmd *shellCmd) execLine(line string) bool {
+	args, err := shlex.Split(line, true)
+	if err != nil {
+		cmd.Errorf("invalid input: %s", err)
+		return false
+	}
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case shellExitCmds[0], shellExitCmds[1]:
+		return true
+	case shellHelpCmd:
+		args = append(args[1:], "--help")
+	case "shell":
+		cmd.Error("already running a dmg shell")
+		return false
+	}
+
+	if err := cmd.runLine(args); err != nil {
+		if fe, ok := errors.Cause(err).(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			cmd.Info(fe.Error())
+			return false
+		}
+		cmd.Errorf("ERROR: %s", err)
+	}
+
+	return false
+}
+
+func (cmd *shellCmd) Execute(_ []string) error {
+	if cmd.runLine == nil {
+		return errors.New("no command runner set for shell")
+	}
+
+	ctx, err := cmd.LogCtx()
+	if err != nil {
+		return err
+	}
+	cache := newShellCache(cmd.Logger, cmd.ctlInvoker)
+	cache.refreshIfStale(ctx)
+
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:          shellPrompt,
+		HistoryFile:     cmd.historyFile(),
+		AutoComplete:    newShellCompleter(cache),
+		InterruptPrompt: "^C",
+		EOFPrompt:       shellExitCmds[0],
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to start shell")
+	}
+	defer rl.Close()
+
+	for {
+		line, err := rl.Readline()
+		switch {
+		case err == readline.ErrInterrupt:
+			continue
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if cmd.execLine(line) {
+			return nil
+		}
+		cache.refreshIfStale(ctx)
+	}
+}
+
+// shellCache holds the system membership and pools used to complete command
+// arguments in the shell. The cache is refreshed in the background so that
+// completion never waits on the management service.
+type shellCache struct {
+	sync.RWMutex
+	log        logging.Logger
+	invoker    control.UnaryInvoker
+	updated    time.Time
+	refreshing bool
+	ranks      []string
+	hosts      []string
+	pools      []string
+}
+
+func newShellCache(log logging.Logger, invoker control.UnaryInvoker) *shellCache {
+	return &shellCache{
+		log:     log,
+		invoker: invoker,
+	}
+}
+
+func (sc *shellCache) refresh(parent context.Context) error {
+	ctx, cancel := context.WithTimeout(parent, shellCacheTimeout)
+	defer cancel()
+
+	sysResp, err := control.SystemQuery(ctx, sc.invoker, &control.SystemQueryReq{})
+	if err != nil {
+		return errors.Wrap(err, "system query")
+	}
+	poolResp, err := control.ListPools(ctx, sc.invoker, &control.ListPoolsReq{NoQuery: true})
+	if err != nil {
+		return errors.Wrap(err, "list pools")
+	}
+
+	var ranks, hosts, pools []string
+	seenHosts := make(map[string]bool)
+	for _, m := range sysResp.Members {
+		ranks = append(ranks, m.Rank.String())
+		host := m.Addr.IP.String()
+		if !m.FaultDomain.Empty() {
+			host = m.FaultDomain.BottomLevel()
+		}
+		if !seenHosts[host] {
+			seenHosts[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	for _, p := range poolResp.Pools {
+		if p.Label != "" {
+			pools = append(pools, p.Label)
+		}
+		pools = append(pools, p.UUID.String())
+	}
+	sort.Strings(hosts)
+	sort.Strings(pools)
+
+	sc.Lock()
+	defer sc.Unlock()
+	sc.ranks, sc.hosts, sc.pools = ranks, hosts, pools
+
+	return nil
+}
+
+// refreshIfStale starts a background refresh of the cache if it has expired
+// and no refresh is already in progress.
+func (sc *shellCache) refreshIfStale(ctx context.Context) {
+	sc.Lock()
+	defer sc.Unlock()
+
+	if sc.refreshing || time.Since(sc.updated) < shellCacheTTL {
+		return
+	}
+	sc.refreshing = true
+
+	go func() {
+		if err := sc.refresh(ctx); err != nil {
+			sc.log.Debugf("unable to refresh shell completion cache: %s", err)
+		}
+
+		sc.Lock()
+		defer sc.Unlock()
+		sc.refreshing = false
+		sc.updated = time.Now()
+	}()
+}
+
+func (sc *shellCache) getRanks() []string {
+	sc.RLock()
+	defer sc.RUnlock()
+	return sc.ranks
+}
+
+func (sc *shellCache) getHosts() []string {
+	sc.RLock()
+	defer sc.RUnlock()
+	return sc.hosts
+}
+
+func (sc *shellCache) getPools() []string {
+	sc.RLock()
+	defer sc.RUnlock()
+	return sc.pools
+}
+
+// shellCompleter completes commands, options and option values in the shell
+// by walking the dmg command tree.
+type shellCompleter struct {
+	parser *flags.Parser
+	cache  *shellCache
+}
+
+func newShellCompleter(cache *shellCache) *shellCompleter {
+	return &shellCompleter{
+		parser: flags.NewParser(&cliOptions{}, flags.Default),
+		cache:  cache,
+	}
+}
+
+func visibleOptions(group *flags.Group) []*flags.Option {
+	if group.Hidden {
+		return nil
+	}
+
+	var opts []*flags.Option
+	for _, opt := range group.Options() {
+		if !opt.Hidden && opt.LongName != "" {
+			opts = append(opts, opt)
+		}
+	}
+	for _, sub := range group.Groups() {
+		opts = append(opts, visibleOptions(sub)...)
+	}
+	return opts
+}
+
+func findOption(path []*flags.Command, name string) *flags.Option {
+	for i := len(path) - 1; i >= 0; i-- {
+		if strings.HasPrefix(name, "--") {
+			if opt := path[i].FindOptionByLongName(strings.TrimPrefix(name, "--")); opt != nil {
+				return opt
+			}
+		} else if len(name) == 2 && name[0] == '-' {
+			if opt := path[i].FindOptionByShortName(rune(name[1])); opt != nil {
+				return opt
+			}
+		}
+	}
+	return nil
+}
+
+func takesValue(opt *flags.Option) bool {
+	return opt != nil && opt.Field().Type.Kind() != reflect.Bool
+}
+
+// isPoolIDArg returns true if the positional argument identifies an existing
+// pool, e.g. "<pool label or UUID>".
+func isPoolIDArg(arg *flags.Arg) bool {
+	return strings.Contains(arg.Name, "pool") && strings.Contains(arg.Name, "UUID")
+}
+
+// optionValues returns the cached values that may be supplied for the option.
+func (c *shellCompleter) optionValues(opt *flags.Option) []string {
+	switch {
+	case opt == nil || c.cache == nil:
+		return nil
+	case shellRankOpts[opt.LongName]:
+		return c.cache.getRanks()
+	case shellHostOpts[opt.LongName]:
+		return c.cache.getHosts()
+	}
+	return nil
+}
+
+// candidates returns the possible completions of the last word given the
+// words preceding it.
+func (c *shellCompleter) candidates(words []string, last string) []string {
+	path := []*flags.Command{c.parser.Command}
+	var positionals int
+	var prevOpt *flags.Option
+
+	for _, word := range words {
+		cur := path[len(path)-1]
+		switch {
+		case prevOpt != nil:
+			prevOpt = nil
+		case strings.HasPrefix(word, "-"):
+			if !strings.Contains(word, "=") {
+				if opt := findOption(path, word); takesValue(opt) {
+					prevOpt = opt
+				}
+			}
+		default:
+			if sub := cur.Find(word); sub != nil && positionals == 0 {
+				path = append(path, sub)
+				continue
+			}
+			positionals++
+		}
+	}
+	cur := path[len(path)-1]
+
+	if prevOpt != nil {
+		return c.optionValues(prevOpt)
+	}
+
+	var cands []string
+	if strings.HasPrefix(last, "-") {
+		if idx := strings.Index(last, "="); idx > 0 {
+			for _, val := range c.optionValues(findOption(path, last[:idx])) {
+				cands = append(cands, last[:idx+1]+val)
+			}
+			return cands
+		}
+		for _, cmd := range path {
+			for _, opt := range visibleOptions(cmd.Group) {
+				cands = append(cands, "--"+opt.LongName)
+			}
+		}
+		return cands
+	}
+
+	if len(path) == 1 {
+		cands = append(cands, shellExitCmds...)
+		cands = append(cands, shellHelpCmd)
+	}
+	if positionals == 0 {
+		for _, sub := range cur.Commands() {
+			if !sub.Hidden && sub.Name != "shell" {
+				cands = append(cands, sub.Name)
+			}
+		}
+	}
+	if args := cur.Args(); positionals < len(args) && isPoolIDArg(args[positionals]) && c.cache != nil {
+		cands = append(cands, c.cache.getPools()...)
+	}
+
+	return cands
+}
+
+// Do implements readline.AutoCompleter, returning the suffixes of the
+// candidates that match the word being completed along with its length.
+func (c *shellCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	input := string(line[:pos])
+	words := strings.Fields(input)
+	last := ""
+	if len(words) > 0 && !strings.HasSuffix(input, " ") {
+		last = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	var matches [][]rune
+	for _, cand := range c.candidates(words, last) {
+		if strings.HasPrefix(cand, last) && cand != last {
+			matches = append(matches, []rune(cand[len(last):]+" "))
+		}
+	}
+
+	return matches, len([]rune(last))
+}
@@ -0,0 +1,218 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestDmg_shellCmd_execLine(t *testing.T) {
+	for name, tc := range map[string]struct {
+		line    string
+		runErr  error
+		expArgs []string
+		expExit bool
+		expLog  string
+	}{
+		"empty": {},
+		"exit": {
+			line:    "exit",
+			expExit: true,
+		},
+		"quit": {
+			line:    "  quit ",
+			expExit: true,
+		},
+		"command": {
+			line:    "pool query 'my pool'",
+			expArgs: []string{"pool", "query", "my pool"},
+		},
+		"help": {
+			line:    "help system",
+			expArgs: []string{"system", "--help"},
+		},
+		"nested shell": {
+			line:   "shell",
+			expLog: "already running a dmg shell",
+		},
+		"unterminated quote": {
+			line:   "pool query 'foo",
+			expLog: "invalid input",
+		},
+		"command fails": {
+			line:    "system query",
+			runErr:  errors.New("whoops"),
+			expArgs: []string{"system", "query"},
+			expLog:  "whoops",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotArgs []string
+			cmd := &shellCmd{}
+			cmd.SetLog(log)
+			cmd.setLineRunner(func(args []string) error {
+				gotArgs = args
+				return tc.runErr
+			})
+
+			gotExit := cmd.execLine(tc.line)
+			test.AssertEqual(t, tc.expExit, gotExit, "unexpected exit result")
+			if diff := cmp.Diff(tc.expArgs, gotArgs); diff != "" {
+				t.Fatalf("unexpected args (-want, +got):\n%s\n", diff)
+			}
+			if tc.expLog != "" && !strings.Contains(buf.String(), tc.expLog) {
+				t.Fatalf("expected log to contain %q", tc.expLog)
+			}
+		})
+	}
+}
+
+func TestDmg_shellCache_refresh(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mic := &control.MockInvokerConfig{
+		UnaryResponseSet: []*control.UnaryResponse{
+			control.MockMSResponse("", nil, &mgmtpb.SystemQueryResp{
+				Members: []*mgmtpb.SystemMember{
+					{Rank: 0, Addr: "10.0.0.1:10001", FaultDomain: "/rack0/node1"},
+					{Rank: 1, Addr: "10.0.0.1:10001", FaultDomain: "/rack0/node1"},
+					{Rank: 2, Addr: "10.0.0.2:10001"},
+				},
+			}),
+			control.MockMSResponse("", nil, &mgmtpb.ListPoolsResp{
+				Pools: []*mgmtpb.ListPoolsResp_Pool{
+					{
+						Uuid:  test.MockUUID(1),
+						Label: "tank",
+						State: daos.PoolServiceStateReady.String(),
+					},
+				},
+			}),
+		},
+	}
+
+	cache := newShellCache(log, control.NewMockInvoker(log, mic))
+	if err := cache.refresh(test.Context(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, cmpPair := range map[string][2][]string{
+		"ranks": {{"0", "1", "2"}, cache.getRanks()},
+		"hosts": {{"10.0.0.2", "node1"}, cache.getHosts()},
+		"pools": {{test.MockUUID(1), "tank"}, cache.getPools()},
+	} {
+		if diff := cmp.Diff(cmpPair[0], cmpPair[1]); diff != "" {
+			t.Fatalf("unexpected %s (-want, +got):\n%s\n", name, diff)
+		}
+	}
+}
+
+func TestDmg_shellCompleter_Do(t *testing.T) {
+	cache := &shellCache{
+		updated: time.Now(),
+		ranks:   []string{"0", "1", "12"},
+		hosts:   []string{"node1", "node2"},
+		pools:   []string{"tank", "test"},
+	}
+
+	for name, tc := range map[string]struct {
+		line       string
+		expMatches []string
+		expLen     int
+	}{
+		"top-level commands": {
+			line:       "sy",
+			expMatches: []string{"stem "},
+			expLen:     2,
+		},
+		"builtins": {
+			line:       "ex",
+			expMatches: []string{"it "},
+			expLen:     2,
+		},
+		"hidden commands omitted": {
+			line: "manp",
+		},
+		"subcommands": {
+			line:       "system qu",
+			expMatches: []string{"ery "},
+			expLen:     2,
+		},
+		"options": {
+			line:       "system query --rank-h",
+			expMatches: []string{"osts "},
+			expLen:     7,
+		},
+		"global options": {
+			line:       "system query --insec",
+			expMatches: []string{"ure "},
+			expLen:     7,
+		},
+		"rank values": {
+			line:       "system stop --ranks 1",
+			expMatches: []string{"2 "},
+			expLen:     1,
+		},
+		"rank values after equals": {
+			line:       "system stop --ranks=",
+			expMatches: []string{"0 ", "1 ", "12 "},
+			expLen:     8,
+		},
+		"host values": {
+			line:       "storage scan -l ",
+			expMatches: []string{"node1 ", "node2 "},
+		},
+		"pool labels": {
+			line:       "pool query t",
+			expMatches: []string{"ank ", "est "},
+			expLen:     1,
+		},
+		"pool labels after options": {
+			line:       "pool query --health-only te",
+			expMatches: []string{"st "},
+			expLen:     2,
+		},
+		"no pool label after pool": {
+			line: "pool query tank t",
+		},
+		"no pool labels for create": {
+			line: "pool create t",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			comp := newShellCompleter(cache)
+
+			gotMatches, gotLen := comp.Do([]rune(tc.line), len([]rune(tc.line)))
+
+			var gotStrs []string
+			for _, m := range gotMatches {
+				gotStrs = append(gotStrs, string(m))
+			}
+			sort.Strings(gotStrs)
+			if diff := cmp.Diff(tc.expMatches, gotStrs); diff != "" {
+				t.Fatalf("unexpected matches (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expLen, gotLen, "unexpected completion length")
+		})
+	}
+}
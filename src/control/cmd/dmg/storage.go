@@ -8,6 +8,8 @@
 package main
 
 import (
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -15,6 +17,7 @@ import (
 	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 )
 
 // storageCmd is the struct representing the top-level storage subcommand.
@@ -24,9 +27,13 @@ type storageCmd struct {
 	Query         storageQueryCmd   `command:"query" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
 	NvmeRebind    nvmeRebindCmd     `command:"nvme-rebind" description:"Detach NVMe SSD from kernel driver and rebind to userspace driver for use with DAOS."`
 	NvmeAddDevice nvmeAddDeviceCmd  `command:"nvme-add-device" description:"Add a hot-inserted NVMe SSD to a specific engine configuration to enable the new device to be used."`
+	NvmeNsCreate  nvmeNsCreateCmd   `command:"nvme-ns-create" description:"Create namespaces on an NVMe SSD to divide its capacity between DAOS engines."`
+	NvmeNsDelete  nvmeNsDeleteCmd   `command:"nvme-ns-delete" description:"Delete a namespace on an NVMe SSD."`
+	RenderConfig  renderConfigCmd   `command:"render-config" description:"Print the SPDK JSON config that would be generated for an engine without writing it."`
 	Set           setFaultyCmd      `command:"set" description:"Manually set the device state."`
 	Replace       storageReplaceCmd `command:"replace" description:"Replace a storage device that has been hot-removed with a new device."`
 	LedManage     ledManageCmd      `command:"led" description:"Manage LED status for supported drives."`
+	Firmware      firmwareCmd       `command:"firmware" description:"Query and update storage device firmware on remote servers."`
 }
 
 // storageScanCmd is the struct representing the scan storage subcommand.
@@ -37,6 +44,13 @@ type storageScanCmd struct {
 	cmdutil.JSONOutputCmd
 	Verbose    bool `short:"v" long:"verbose" description:"List SCM & NVMe device details"`
 	NvmeHealth bool `short:"n" long:"nvme-health" description:"Display NVMe device health statistics"`
+	Unused     bool `short:"u" long:"unused" description:"List devices not assigned to any engine"`
+}
+
+// unusedStorageDevices lists the devices not assigned to any engine on a set of hosts.
+type unusedStorageDevices struct {
+	Hosts   string                   `json:"hosts"`
+	Devices []*control.StorageDevice `json:"devices"`
 }
 
 // Execute is run when storageScanCmd activates.
@@ -46,11 +60,15 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 	if cmd.Verbose && cmd.NvmeHealth {
 		return errors.New("cannot use --verbose with --nvme-health")
 	}
+	if cmd.Unused && cmd.NvmeHealth {
+		return errors.New("cannot use --unused with --nvme-health")
+	}
 
 	req := &control.StorageScanReq{
 		NvmeHealth: cmd.NvmeHealth,
-		// Strip nvme details if verbose and health flags are unset.
-		NvmeBasic: !(cmd.Verbose || cmd.NvmeHealth),
+		// Strip nvme details if verbose, health and unused flags are unset.
+		NvmeBasic: !(cmd.Verbose || cmd.NvmeHealth || cmd.Unused),
+		Bindings:  cmd.Unused,
 	}
 	req.SetHostList(cmd.getHostList())
 
@@ -64,6 +82,21 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 	cmd.Debugf("storage scan response: %+v", resp.HostStorage)
 
 	if cmd.JSONOutputEnabled() {
+		if cmd.Unused {
+			unused := []*unusedStorageDevices{}
+			for _, key := range resp.HostStorage.Keys() {
+				hss := resp.HostStorage[key]
+				devs := hss.HostStorage.UnusedDevices()
+				if devs == nil {
+					devs = []*control.StorageDevice{}
+				}
+				unused = append(unused, &unusedStorageDevices{
+					Hosts:   hss.HostSet.RangedString(),
+					Devices: devs,
+				})
+			}
+			return cmd.OutputJSON(unused, resp.Errors())
+		}
 		return cmd.OutputJSON(resp, resp.Errors())
 	}
 
@@ -76,7 +109,11 @@ func (cmd *storageScanCmd) Execute(_ []string) error {
 	}
 
 	var out strings.Builder
-	if cmd.NvmeHealth {
+	if cmd.Unused {
+		if err := pretty.PrintUnusedStorageDevices(resp.HostStorage, &out); err != nil {
+			return err
+		}
+	} else if cmd.NvmeHealth {
 		if err := pretty.PrintNvmeHealthMap(resp.HostStorage, &out); err != nil {
 			return err
 		}
@@ -247,3 +284,162 @@ func (cmd *nvmeAddDeviceCmd) Execute(args []string) error {
 
 	return resp.Errors()
 }
+
+// nvmeNsCreateCmd is the struct representing the nvme-ns-create storage subcommand.
+//
+// If no size is specified, the unallocated capacity of the SSD is split evenly between the
+// namespaces created.
+type nvmeNsCreateCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	PCIAddr string          `short:"a" long:"pci-address" required:"1" description:"NVMe SSD PCI address to create namespaces on."`
+	Size    ui.ByteSizeFlag `short:"s" long:"size" description:"Size of each namespace (default: split unallocated capacity)."`
+	Count   uint32          `short:"c" long:"count" default:"1" description:"Number of namespaces to create."`
+}
+
+// Execute is run when nvmeNsCreateCmd activates.
+//
+// Create namespaces on NVMe SSD that is not in use by a running engine on single server.
+func (cmd *nvmeNsCreateCmd) Execute(args []string) error {
+	ctx := cmd.MustLogCtx()
+
+	if len(cmd.getHostList()) != 1 {
+		return errors.New("command expects a single host in hostlist")
+	}
+	if cmd.Size.IsSet() && cmd.Size.Bytes == 0 {
+		return errors.New("namespace size must be nonzero")
+	}
+
+	req := &control.NvmeNsCreateReq{
+		PCIAddr: cmd.PCIAddr,
+		Size:    cmd.Size.Bytes,
+		Count:   cmd.Count,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Debugf("nvme namespace create req: %+v", req)
+	resp, err := control.StorageNvmeNsCreate(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+		return resp.Errors()
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintNvmeNamespaces(resp.Namespaces, &out); err != nil {
+		return err
+	}
+	cmd.Infof("Created namespaces on %s:\n%s", cmd.PCIAddr, out.String())
+
+	return nil
+}
+
+// nvmeNsDeleteCmd is the struct representing the nvme-ns-delete storage subcommand.
+type nvmeNsDeleteCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	PCIAddr string `short:"a" long:"pci-address" required:"1" description:"NVMe SSD PCI address to delete namespace on."`
+	NsID    uint32 `short:"n" long:"ns-id" required:"1" description:"Identifier of namespace to delete."`
+}
+
+// Execute is run when nvmeNsDeleteCmd activates.
+//
+// Delete namespace on NVMe SSD that is not in use by a running engine on single server.
+func (cmd *nvmeNsDeleteCmd) Execute(args []string) error {
+	ctx := cmd.MustLogCtx()
+
+	if len(cmd.getHostList()) != 1 {
+		return errors.New("command expects a single host in hostlist")
+	}
+
+	req := &control.NvmeNsDeleteReq{
+		PCIAddr: cmd.PCIAddr,
+		NsID:    cmd.NsID,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Debugf("nvme namespace delete req: %+v", req)
+	resp, err := control.StorageNvmeNsDelete(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	} else {
+		cmd.Info("Command completed successfully")
+	}
+
+	return resp.Errors()
+}
+
+// renderConfigCmd is the struct representing the render-config storage subcommand.
+type renderConfigCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	EngineIndex uint32 `short:"e" long:"engine" description:"Index of the engine in the server config file."`
+}
+
+// Execute is run when renderConfigCmd activates.
+//
+// Render the SPDK JSON config of an engine on a single server. Config is printed to stdout so that
+// it can be redirected to a file.
+func (cmd *renderConfigCmd) Execute(args []string) error {
+	ctx := cmd.MustLogCtx()
+
+	if len(cmd.getHostList()) != 1 {
+		return errors.New("command expects a single host in hostlist")
+	}
+
+	req := &control.StorageRenderConfigReq{
+		EngineIdx: cmd.EngineIndex,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Debugf("storage render config req: %+v", req)
+	resp, err := control.StorageRenderConfig(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+		return resp.Errors()
+	}
+
+	_, err = fmt.Fprintln(os.Stdout, resp.Config)
+	return err
+}
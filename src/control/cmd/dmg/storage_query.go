@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -82,22 +83,23 @@ type storageQueryCmd struct {
 type listDevicesQueryCmd struct {
 	smdQueryCmd
 	rankCmd
+	watchCmd
 	Health      bool   `short:"b" long:"health" description:"Include device health in results"`
 	UUID        string `short:"u" long:"uuid" description:"Device UUID (all devices if blank)"`
 	EvictedOnly bool   `short:"e" long:"show-evicted" description:"Show only evicted faulty devices"`
 }
 
 func (cmd *listDevicesQueryCmd) Execute(_ []string) error {
-	ctx := cmd.MustLogCtx()
-
-	req := &control.SmdQueryReq{
-		OmitPools:        true,
-		IncludeBioHealth: cmd.Health,
-		Rank:             cmd.GetRank(),
-		UUID:             cmd.UUID,
-		FaultyDevsOnly:   cmd.EvictedOnly,
-	}
-	return cmd.makeRequest(ctx, req)
+	return cmd.watch(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd, func(ctx context.Context) error {
+		req := &control.SmdQueryReq{
+			OmitPools:        true,
+			IncludeBioHealth: cmd.Health,
+			Rank:             cmd.GetRank(),
+			UUID:             cmd.UUID,
+			FaultyDevsOnly:   cmd.EvictedOnly,
+		}
+		return cmd.makeRequest(ctx, req)
+	})
 }
 
 type listPoolsQueryCmd struct {
@@ -259,13 +261,26 @@ type storageReplaceCmd struct {
 type nvmeReplaceCmd struct {
 	smdManageCmd
 	singleHostCmd
-	OldDevUUID string `long:"old-uuid" description:"Device UUID of hot-removed SSD" required:"1"`
-	NewDevUUID string `long:"new-uuid" description:"Device UUID of new device" required:"1"`
+	OldDevUUID string `long:"old-uuid" description:"Device UUID of SSD to replace" required:"1"`
+	NewDevUUID string `long:"new-uuid" description:"Device UUID of new device, skips the guided replacement"`
+	NewPCIAddr string `long:"new-pci-address" description:"PCI address of new SSD if not inserted in the slot of the old SSD"`
+	Timeout    uint32 `long:"timeout" description:"Number of minutes to blink the status LED of the old SSD for"`
+	Abort      bool   `long:"abort" description:"Discard the progress of a guided replacement"`
 }
 
 // Execute is run when storageReplaceCmd activates
 // Replace a hot-removed device with a newly plugged device, or reuse a FAULTY device
+//
+// If the new device UUID is not given, a guided replacement is performed by the server which can
+// be resumed by running the command again.
 func (cmd *nvmeReplaceCmd) Execute(_ []string) error {
+	if cmd.NewDevUUID == "" {
+		return cmd.guidedReplace()
+	}
+	if cmd.NewPCIAddr != "" || cmd.Timeout != 0 || cmd.Abort {
+		return errors.New("--new-uuid can not be set with guided replacement options")
+	}
+
 	if cmd.OldDevUUID == cmd.NewDevUUID {
 		cmd.Notice("Attempting to reuse a previously set FAULTY device!")
 	}
@@ -279,28 +294,72 @@ func (cmd *nvmeReplaceCmd) Execute(_ []string) error {
 	return cmd.makeRequest(cmd.MustLogCtx(), req)
 }
 
+func (cmd *nvmeReplaceCmd) guidedReplace() error {
+	if cmd.Abort && (cmd.NewPCIAddr != "" || cmd.Timeout != 0) {
+		return errors.New("--abort can not be set with other replacement options")
+	}
+
+	req := &control.NvmeReplaceReq{
+		OldUUID:      cmd.OldDevUUID,
+		NewPCIAddr:   cmd.NewPCIAddr,
+		IdentifyMins: cmd.Timeout,
+		Abort:        cmd.Abort,
+	}
+	req.SetHostList(cmd.Host.Slice())
+
+	cmd.Debugf("nvme replace req: %+v", req)
+	resp, err := control.StorageNvmeReplace(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var outErr strings.Builder
+	if err := pretty.PrintResponseErrors(resp, &outErr); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+
+	if resp.Host != "" {
+		var out strings.Builder
+		if err := pretty.PrintNvmeReplaceResp(resp, &out); err != nil {
+			return err
+		}
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
+
 type ledCmd struct {
 	smdManageCmd
 	hostListCmd
 	Args struct {
-		IDs string `positional-arg-name:"ids" description:"Comma-separated list of identifiers which could be either VMD backing device (NVMe SSD) PCI addresses or device UUIDs. All SSDs selected if arg not provided."`
+		IDs string `positional-arg-name:"ids" description:"Comma-separated list of identifiers which could be either NVMe SSD PCI addresses or device UUIDs. All SSDs selected if arg not provided."`
 	} `positional-args:"yes"`
 }
 
 type ledManageCmd struct {
-	Check    ledCheckCmd    `command:"check" description:"Retrieve the current LED state of specified VMD device."`
-	Identify ledIdentifyCmd `command:"identify" description:"Blink the status LED on specified VMD device (for the purpose of visual SSD identification). Default duration is 2 minutes."`
+	Check    ledCheckCmd    `command:"check" description:"Retrieve the current LED state of specified NVMe SSDs."`
+	Identify ledIdentifyCmd `command:"identify" description:"Blink the status LED on specified NVMe SSDs (for the purpose of visual SSD identification). Default duration is 2 minutes."`
+	Clear    ledClearCmd    `command:"clear" description:"Turn off the blinking status LED on specified NVMe SSDs."`
 }
 
 type ledIdentifyCmd struct {
 	ledCmd
 	Timeout uint32 `long:"timeout" description:"Number of minutes to blink the status LED for"`
-	Reset   bool   `long:"reset" description:"Reset blinking LED on specified VMD device back to previous state"`
+	Reset   bool   `long:"reset" description:"Reset blinking LED on specified NVMe SSDs back to previous state"`
 }
 
 // Execute is run when ledIdentifyCmd activates.
 //
-// Runs SPDK VMD API commands to set the LED state on the VMD to "IDENTIFY" (4Hz blink).
+// Sets the LED state on the SSDs to "IDENTIFY" (4Hz blink). SSDs behind VMD are managed through
+// SPDK VMD API commands and directly attached SSDs through their hotplug slot.
 func (cmd *ledIdentifyCmd) Execute(_ []string) error {
 	if cmd.Args.IDs == "" {
 		cmd.Debugf("neither a pci address or a uuid has been supplied so select all")
@@ -326,7 +385,7 @@ type ledCheckCmd struct {
 
 // Execute is run when ledCheckCmd activates.
 //
-// Runs SPDK VMD API commands to query the LED state on VMD devices
+// Queries the LED state on the SSDs.
 func (cmd *ledCheckCmd) Execute(_ []string) error {
 	if cmd.Args.IDs == "" {
 		cmd.Debugf("neither a pci address or a uuid has been supplied so select all")
@@ -338,3 +397,22 @@ func (cmd *ledCheckCmd) Execute(_ []string) error {
 	req.SetHostList(cmd.getHostList())
 	return cmd.makeRequest(cmd.MustLogCtx(), req, pretty.PrintOnlyLEDInfo())
 }
+
+type ledClearCmd struct {
+	ledCmd
+}
+
+// Execute is run when ledClearCmd activates.
+//
+// Resets the LED state on the SSDs, cancelling any identify timeout.
+func (cmd *ledClearCmd) Execute(_ []string) error {
+	if cmd.Args.IDs == "" {
+		cmd.Debugf("neither a pci address or a uuid has been supplied so select all")
+	}
+	req := &control.SmdManageReq{
+		Operation: control.LedResetOp,
+		IDs:       cmd.Args.IDs,
+	}
+	req.SetHostList(cmd.getHostList())
+	return cmd.makeRequest(cmd.MustLogCtx(), req, pretty.PrintOnlyLEDInfo())
+}
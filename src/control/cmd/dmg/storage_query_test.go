@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -229,10 +230,57 @@ func TestStorageQueryCommands(t *testing.T) {
 			nil,
 		},
 		{
-			"Try to replace a device without a new device UUID specified",
+			"Replace a device with guided replacement",
 			"storage replace nvme -l foo --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d",
+			printRequest(t, func() *control.NvmeReplaceReq {
+				req := &control.NvmeReplaceReq{
+					OldUUID: "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+				}
+				req.SetHostList([]string{"foo"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Replace a device with guided replacement; new pci address and timeout",
+			"storage replace nvme -l foo --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d " +
+				"--new-pci-address 0000:85:00.0 --timeout 5",
+			printRequest(t, func() *control.NvmeReplaceReq {
+				req := &control.NvmeReplaceReq{
+					OldUUID:      "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+					NewPCIAddr:   "0000:85:00.0",
+					IdentifyMins: 5,
+				}
+				req.SetHostList([]string{"foo"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Abort guided replacement",
+			"storage replace nvme -l foo --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d --abort",
+			printRequest(t, func() *control.NvmeReplaceReq {
+				req := &control.NvmeReplaceReq{
+					OldUUID: "842c739b-86b5-462f-a7ba-b4a91b674f3d",
+					Abort:   true,
+				}
+				req.SetHostList([]string{"foo"})
+				return req
+			}()),
+			nil,
+		},
+		{
+			"Abort guided replacement with other options",
+			"storage replace nvme -l foo --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d --abort --timeout 5",
 			"",
-			errors.New("the required flag `--new-uuid' was not specified"),
+			errors.New("--abort can not be set"),
+		},
+		{
+			"Guided replacement options with new device UUID",
+			"storage replace nvme -l foo --old-uuid 842c739b-86b5-462f-a7ba-b4a91b674f3d " +
+				"--new-uuid 2ccb8afb-5d32-454e-86e3-762ec5dca7be --new-pci-address 0000:85:00.0",
+			"",
+			errors.New("--new-uuid can not be set"),
 		},
 		{
 			"Identify device without device UUID or PCI address specified",
@@ -311,6 +359,23 @@ func TestStorageQueryCommands(t *testing.T) {
 			}),
 			nil,
 		},
+		{
+			"Clear LED on multiple devices",
+			"storage led clear 842c739b-86b5-462f-a7ba-b4a91b674f3d,0000:01:00.0",
+			printRequest(t, &control.SmdManageReq{
+				Operation: control.LedResetOp,
+				IDs:       "842c739b-86b5-462f-a7ba-b4a91b674f3d,0000:01:00.0",
+			}),
+			nil,
+		},
+		{
+			"Clear LED without device UUID or PCI address specified",
+			"storage led clear",
+			printRequest(t, &control.SmdManageReq{
+				Operation: control.LedResetOp,
+			}),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage query quack",
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
@@ -28,6 +30,15 @@ func TestStorageCommands(t *testing.T) {
 		req.SetHostList([]string{"foo2.com"})
 		return req
 	}
+	nvmeNsCreateReq := func(size uint64, count uint32) *control.NvmeNsCreateReq {
+		req := &control.NvmeNsCreateReq{PCIAddr: "0000:80:00.0", Size: size, Count: count}
+		req.SetHostList([]string{"foo2.com"})
+		return req
+	}
+	nvmeNsDeleteReq := &control.NvmeNsDeleteReq{PCIAddr: "0000:80:00.0", NsID: 2}
+	nvmeNsDeleteReq.SetHostList([]string{"foo2.com"})
+	renderConfigReq := &control.StorageRenderConfigReq{EngineIdx: 1}
+	renderConfigReq.SetHostList([]string{"foo2.com"})
 
 	runCmdTests(t, []cmdTest{
 		{
@@ -88,6 +99,18 @@ func TestStorageCommands(t *testing.T) {
 			"",
 			errors.New("cannot use --verbose"),
 		},
+		{
+			"Scan unused devices",
+			"storage scan --unused",
+			printRequest(t, &control.StorageScanReq{Bindings: true}),
+			nil,
+		},
+		{
+			"Scan unused devices with NVMe health",
+			"storage scan -u --nvme-health",
+			"",
+			errors.New("cannot use --unused"),
+		},
 		{
 			"Rebind NVMe; no PCI address",
 			"storage nvme-rebind",
@@ -154,6 +177,72 @@ func TestStorageCommands(t *testing.T) {
 			printRequest(t, nvmeAddDeviceReq().WithStorageTierIndex(0)),
 			nil,
 		},
+		{
+			"Create NVMe namespaces; no PCI address",
+			"storage nvme-ns-create -l foo2.com",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Create NVMe namespaces; 2 hosts in hostlist",
+			"storage nvme-ns-create -l foo[1,2].com --pci-address 0000:80:00.0",
+			"",
+			errors.New("expects a single host"),
+		},
+		{
+			"Create NVMe namespaces; zero size",
+			"storage nvme-ns-create -l foo2.com -a 0000:80:00.0 -s 0",
+			"",
+			errors.New("size must be nonzero"),
+		},
+		{
+			"Create NVMe namespaces; defaults",
+			"storage nvme-ns-create -l foo2.com -a 0000:80:00.0",
+			printRequest(t, nvmeNsCreateReq(0, 1)),
+			nil,
+		},
+		{
+			"Create NVMe namespaces; split capacity",
+			"storage nvme-ns-create -l foo2.com -a 0000:80:00.0 -c 2",
+			printRequest(t, nvmeNsCreateReq(0, 2)),
+			nil,
+		},
+		{
+			"Create NVMe namespaces; long opts",
+			"storage nvme-ns-create --host-list foo2.com --pci-address 0000:80:00.0 --size 1TB --count 3",
+			printRequest(t, nvmeNsCreateReq(humanize.TByte, 3)),
+			nil,
+		},
+		{
+			"Delete NVMe namespace; no namespace ID",
+			"storage nvme-ns-delete -l foo2.com -a 0000:80:00.0",
+			"",
+			errors.New("required flag"),
+		},
+		{
+			"Delete NVMe namespace; 0 hosts in hostlist",
+			"storage nvme-ns-delete -a 0000:80:00.0 -n 2",
+			"",
+			errors.New("expects a single host"),
+		},
+		{
+			"Delete NVMe namespace",
+			"storage nvme-ns-delete -l foo2.com --pci-address 0000:80:00.0 --ns-id 2",
+			printRequest(t, nvmeNsDeleteReq),
+			nil,
+		},
+		{
+			"Render config; 0 hosts in hostlist",
+			"storage render-config -e 1",
+			"",
+			errors.New("expects a single host"),
+		},
+		{
+			"Render config",
+			"storage render-config -l foo2.com --engine 1",
+			printRequest(t, renderConfigReq),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage quack",
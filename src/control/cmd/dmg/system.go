@@ -8,9 +8,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
@@ -29,24 +34,27 @@ var errNoRanks = errors.New("no ranks or hosts specified")
 
 // SystemCmd is the struct representing the top-level system subcommand.
 type SystemCmd struct {
-	LeaderQuery  leaderQueryCmd        `command:"leader-query" description:"Query for current Management Service leader"`
-	Query        systemQueryCmd        `command:"query" description:"Query DAOS system status"`
-	Stop         systemStopCmd         `command:"stop" description:"Perform controlled shutdown of DAOS system"`
-	Start        systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
-	Exclude      systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
-	ClearExclude systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
-	Drain        systemDrainCmd        `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
-	Reintegrate  systemReintegrateCmd  `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
-	Erase        systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
-	ListPools    poolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
-	Cleanup      systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
-	SetAttr      systemSetAttrCmd      `command:"set-attr" description:"Set system attributes"`
-	GetAttr      systemGetAttrCmd      `command:"get-attr" description:"Get system attributes"`
-	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
-	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
-	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
-	Rebuild      systemRebuildCmd      `command:"rebuild" description:"Interactive rebuild commands"`
-	SelfHeal     systemSelfHealCmd     `command:"self-heal" description:"Self-heal commands for auto recovery"`
+	LeaderQuery    leaderQueryCmd          `command:"leader-query" description:"Query for current Management Service leader"`
+	Query          systemQueryCmd          `command:"query" description:"Query DAOS system status"`
+	Stop           systemStopCmd           `command:"stop" description:"Perform controlled shutdown of DAOS system"`
+	Start          systemStartCmd          `command:"start" description:"Perform start of stopped DAOS system"`
+	RollingRestart systemRollingRestartCmd `command:"rolling-restart" description:"Restart DAOS system ranks one fault domain at a time, waiting for pools to recover between batches"`
+	Exclude        systemExcludeCmd        `command:"exclude" description:"Exclude ranks from DAOS system"`
+	ClearExclude   systemClearExcludeCmd   `command:"clear-exclude" description:"Clear excluded state for ranks"`
+	Drain          systemDrainCmd          `command:"drain" description:"Drain ranks or hosts from all relevant pools in DAOS system"`
+	Reintegrate    systemReintegrateCmd    `command:"reintegrate" alias:"reint" description:"Reintegrate ranks or hosts into all relevant pools in DAOS system"`
+	Erase          systemEraseCmd          `command:"erase" description:"Erase system metadata prior to reformat"`
+	ListPools      poolListCmd             `command:"list-pools" description:"List all pools in the DAOS system"`
+	Cleanup        systemCleanupCmd        `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
+	SetAttr        systemSetAttrCmd        `command:"set-attr" description:"Set system attributes"`
+	GetAttr        systemGetAttrCmd        `command:"get-attr" description:"Get system attributes"`
+	DelAttr        systemDelAttrCmd        `command:"del-attr" description:"Delete system attributes"`
+	SetProp        systemSetPropCmd        `command:"set-prop" description:"Set system properties"`
+	GetProp        systemGetPropCmd        `command:"get-prop" description:"Get system properties"`
+	Rebuild        systemRebuildCmd        `command:"rebuild" description:"Interactive rebuild commands"`
+	SelfHeal       systemSelfHealCmd       `command:"self-heal" description:"Self-heal commands for auto recovery"`
+	Audit          systemAuditCmd          `command:"audit" description:"Audit log of management requests"`
+	Events         systemEventsCmd         `command:"events" description:"RAS events retained by the Management Service"`
 }
 
 type baseCtlCmd struct {
@@ -123,9 +131,11 @@ type liveRankListCmd struct {
 // systemQueryCmd is the struct representing the command to query system status.
 type systemQueryCmd struct {
 	baseRankListCmd
+	watchCmd
 	Verbose      bool                  `long:"verbose" short:"v" description:"Display more member details"`
 	NotOK        bool                  `long:"not-ok" description:"Display components in need of administrative investigation"`
 	WantedStates ui.MemberStateSetFlag `long:"with-states" description:"Only show engines in one of a set of comma-separated states"`
+	CrashReports bool                  `long:"crash-reports" description:"List recent engine crash reports from all servers instead of member states"`
 }
 
 // Execute is run when systemQueryCmd activates.
@@ -140,13 +150,50 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
 	}
+	if cmd.CrashReports {
+		if cmd.NotOK || !cmd.WantedStates.Empty() || cmd.Hosts.Count() > 0 {
+			return errors.New("--crash-reports option cannot be set with --not-ok, " +
+				"--with-states or --rank-hosts")
+		}
+		return cmd.watch(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd,
+			cmd.queryCrashReports)
+	}
+
+	return cmd.watch(cmd.MustLogCtx(), cmd.Logger, &cmd.JSONOutputCmd, cmd.query)
+}
+
+func (cmd *systemQueryCmd) queryCrashReports(ctx context.Context) error {
+	req := &control.SystemCrashReportsReq{Ranks: &cmd.Ranks.RankSet}
+
+	resp, err := control.SystemCrashReports(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, nil)
+	}
+
+	if len(resp.Reports) == 0 {
+		cmd.Info("No crash reports found")
+		return nil
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemCrashReports(&out, resp.Reports)
+	cmd.Info(out.String())
+
+	return nil
+}
+
+func (cmd *systemQueryCmd) query(ctx context.Context) error {
 	req := new(control.SystemQueryReq)
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
 	req.NotOK = cmd.NotOK
 	req.WantedStates = cmd.WantedStates.States
 
-	resp, err := control.SystemQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	resp, err := control.SystemQuery(ctx, cmd.ctlInvoker, req)
 	if err != nil {
 		return err // control api returned an error, disregard response
 	}
@@ -169,24 +216,68 @@ func (cmd *systemQueryCmd) Execute(_ []string) (errOut error) {
 }
 
 type systemEraseCmd struct {
-	baseCmd
-	ctlInvokerCmd
+	baseRankListCmd
+	Force bool `short:"f" long:"force" description:"Do not require confirmation when erasing selected ranks or hosts"`
 }
 
+// Execute is run when systemEraseCmd activates.
+//
+// If ranks or hosts are selected, only the superblocks and SCM contents of the selected ranks are
+// erased, which requires confirmation as the data held by the ranks is destroyed.
 func (cmd *systemEraseCmd) Execute(_ []string) error {
-	resp, err := control.SystemErase(cmd.MustLogCtx(), cmd.ctlInvoker, new(control.SystemEraseReq))
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+
+	req := new(control.SystemEraseReq)
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	if req.IsSelective() {
+		selection := "ranks " + req.Ranks.String()
+		if req.Hosts.Count() > 0 {
+			selection = "ranks on hosts " + req.Hosts.String()
+		}
+		cmd.Noticef("This command will permanently destroy the superblocks and SCM "+
+			"contents of %s!", selection)
+		if !cmd.Force && !cmd.JSONOutputEnabled() {
+			if !common.GetConsent(cmd.Logger) {
+				return errors.New("consent not given")
+			}
+		}
+	}
+
+	resp, err := control.SystemErase(cmd.MustLogCtx(), cmd.ctlInvoker, req)
 	if err != nil {
 		return err
 	}
 
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	if req.IsSelective() {
+		var out, outErr strings.Builder
+		if err := pretty.PrintSystemEraseResponse(&out, &outErr, resp); err != nil {
+			return err
+		}
+		if outErr.Len() > 0 {
+			cmd.Error(outErr.String())
+		}
+		cmd.Info(out.String())
+	}
+
 	return resp.Errors()
 }
 
 // systemStopCmd is the struct representing the command to shutdown DAOS system.
 type systemStopCmd struct {
 	liveRankListCmd
-	Force bool `long:"force" description:"Force stop DAOS system members"`
-	Full  bool `long:"full" hidden:"true" description:"Attempt a graceful shutdown of DAOS system. Experimental and not for use in production environments"`
+	Force        bool          `long:"force" description:"Force stop DAOS system members"`
+	Full         bool          `long:"full" hidden:"true" description:"Attempt a graceful shutdown of DAOS system. Experimental and not for use in production environments"`
+	Drain        bool          `long:"drain" description:"Refuse new pool connections and wait for in-flight I/O to complete before stopping DAOS system members"`
+	DrainTimeout time.Duration `long:"drain-timeout" description:"Maximum time to wait for in-flight I/O to complete when draining (default 2m)"`
+	Verbose      bool          `long:"verbose" short:"v" description:"Display shutdown progress of each member"`
 }
 
 // Execute is run when systemStopCmd activates.
@@ -205,6 +296,12 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	if cmd.Full && !cmd.Ranks.Empty() {
 		return errIncompatFlags("full", "ranks")
 	}
+	if cmd.Force && cmd.Drain {
+		return errIncompatFlags("force", "drain")
+	}
+	if cmd.DrainTimeout != 0 && !cmd.Drain {
+		return errors.New("--drain-timeout cannot be set without --drain")
+	}
 
 	if err := cmd.validateHostsRanks(); err != nil {
 		return err
@@ -213,6 +310,8 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 		Force:               cmd.Force,
 		Full:                cmd.Full,
 		IgnoreAdminExcluded: cmd.IgnoreAdminExcluded,
+		Drain:               cmd.Drain,
+		DrainTimeout:        cmd.DrainTimeout,
 	}
 	req.Hosts.Replace(&cmd.Hosts.HostSet)
 	req.Ranks.Replace(&cmd.Ranks.RankSet)
@@ -227,7 +326,8 @@ func (cmd *systemStopCmd) Execute(_ []string) (errOut error) {
 	}
 
 	var out, outErr strings.Builder
-	if err := pretty.PrintSystemStopResponse(&out, &outErr, resp); err != nil {
+	if err := pretty.PrintSystemStopResponse(&out, &outErr, resp,
+		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
 		return err
 	}
 	cmd.Info(out.String())
@@ -243,6 +343,58 @@ type systemStartCmd struct {
 	liveRankListCmd
 }
 
+// systemRollingRestartCmd is the struct representing the command to restart system ranks in
+// batches of fault domains.
+type systemRollingRestartCmd struct {
+	baseRankListCmd
+	BatchSize       uint          `long:"batch-size" default:"1" description:"Number of fault domains to restart at the same time"`
+	AbortOnDegraded bool          `long:"abort-on-degraded" description:"Stop the rolling restart if any pool is degraded once a batch has settled"`
+	SettleTimeout   time.Duration `long:"settle-timeout" description:"Maximum time to wait for each batch to rejoin and for pool rebuilds to complete (default 30m)"`
+}
+
+// Execute is run when systemRollingRestartCmd activates.
+func (cmd *systemRollingRestartCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system rolling-restart failed")
+	}()
+
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	if cmd.BatchSize == 0 {
+		return errors.New("--batch-size must be greater than zero")
+	}
+
+	req := &control.SystemRollingRestartReq{
+		BatchSize:       cmd.BatchSize,
+		AbortOnDegraded: cmd.AbortOnDegraded,
+		SettleTimeout:   cmd.SettleTimeout,
+	}
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+	if !cmd.JSONOutputEnabled() {
+		req.OnUpdate = func(b *control.RollingRestartBatch) {
+			cmd.Infof("ranks %s (%s): %s", b.Ranks, strings.Join(b.FaultDomains, ","),
+				b.Stage)
+		}
+	}
+
+	resp, err := control.SystemRollingRestart(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemRollingRestartResponse(&out, resp)
+	cmd.Info(out.String())
+
+	return resp.Errors()
+}
+
 // Execute is run when systemStartCmd activates.
 func (cmd *systemStartCmd) Execute(_ []string) (errOut error) {
 	defer func() {
@@ -805,3 +957,189 @@ func (cmd *systemSelfHealEvalCmd) Execute(_ []string) (errOut error) {
 	cmd.Info("System self-heal eval request succeeded")
 	return nil
 }
+
+type systemAuditCmd struct {
+	Query systemAuditQueryCmd `command:"query" description:"Display recent entries from the audit logs of management requests handled by DAOS servers"`
+}
+
+// systemAuditQueryCmd is the struct representing the command to query the audit logs of
+// management requests handled by servers.
+type systemAuditQueryCmd struct {
+	baseCmd
+	ctlInvokerCmd
+	hostListCmd
+	cmdutil.JSONOutputCmd
+	MaxEntries uint32 `short:"n" long:"max-entries" default:"50" description:"Maximum number of most recent entries to display, all if zero"`
+	Since      string `short:"s" long:"since" description:"Only display entries recorded since the given RFC3339 time (e.g. 2025-01-02T15:04:05Z) or duration ago (e.g. 1h30m)"`
+	Method     string `short:"m" long:"method" description:"Only display entries for methods containing the given string (e.g. PoolCreate)"`
+	Verbose    bool   `short:"v" long:"verbose" description:"Display request arguments"`
+}
+
+// parseSince returns the time represented by the given RFC3339 time or duration before now.
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(since)
+	if err != nil || d < 0 {
+		return time.Time{}, errors.Errorf("invalid --since value %q, expected RFC3339 time or positive duration", since)
+	}
+
+	return time.Now().Add(-d), nil
+}
+
+// Execute is run when systemAuditQueryCmd activates.
+func (cmd *systemAuditQueryCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system audit query failed")
+	}()
+
+	since, err := parseSince(cmd.Since)
+	if err != nil {
+		return err
+	}
+
+	req := &control.AuditQueryReq{
+		MaxEntries: cmd.MaxEntries,
+		Since:      since,
+		Method:     cmd.Method,
+	}
+	req.SetHostList(cmd.getHostList())
+
+	cmd.Tracef("system audit query request: %+v", req)
+
+	resp, err := control.AuditQuery(cmd.MustLogCtx(), cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	cmd.Tracef("system audit query response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, resp.Errors())
+	}
+
+	var out, outErr strings.Builder
+	if err := pretty.PrintAuditQueryResp(resp, &out, &outErr, cmd.Verbose); err != nil {
+		return err
+	}
+	if outErr.Len() > 0 {
+		cmd.Error(outErr.String())
+	}
+	if out.Len() > 0 {
+		cmd.Info(out.String())
+	}
+
+	return resp.Errors()
+}
+
+type systemEventsCmd struct {
+	Query systemEventsQueryCmd `command:"query" description:"Display recent RAS events retained by the Management Service"`
+}
+
+// systemEventsQueryCmd is the struct representing the command to query the RAS events retained
+// by the MS.
+type systemEventsQueryCmd struct {
+	baseCtlCmd
+	MaxEvents uint32         `short:"n" long:"max-events" default:"50" description:"Maximum number of most recent events to display, all if zero"`
+	Severity  string         `long:"severity" description:"Only display events of at least the given severity (error, warning or notice)"`
+	Ranks     ui.RankSetFlag `short:"r" long:"rank" description:"Only display events raised by the given ranks"`
+	Since     string         `short:"s" long:"since" description:"Only display events raised since the given RFC3339 time (e.g. 2025-01-02T15:04:05Z) or duration ago (e.g. 1h30m)"`
+	Follow    bool           `short:"f" long:"follow" description:"Continue to display new events as they are retained until interrupted"`
+	Interval  time.Duration  `long:"interval" default:"2s" description:"Interval at which to poll for new events in follow mode"`
+	Verbose   bool           `short:"v" long:"verbose" description:"Display all event fields"`
+}
+
+func (cmd *systemEventsQueryCmd) query(ctx context.Context, req *control.SystemEventsQueryReq) (*control.SystemEventsQueryResp, error) {
+	cmd.Tracef("system events query request: %+v", req)
+
+	resp, err := control.SystemEventsQuery(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Tracef("system events query response: %+v", resp)
+
+	if cmd.JSONOutputEnabled() {
+		return resp, cmd.OutputJSON(resp, nil)
+	}
+
+	if len(resp.Events) == 0 {
+		if !cmd.Follow {
+			cmd.Info("No events found")
+		}
+		return resp, nil
+	}
+
+	var out strings.Builder
+	pretty.PrintSystemEvents(&out, resp.Events, cmd.Verbose)
+	cmd.Info(out.String())
+
+	return resp, nil
+}
+
+// Execute is run when systemEventsQueryCmd activates.
+func (cmd *systemEventsQueryCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events query failed")
+	}()
+
+	since, err := parseSince(cmd.Since)
+	if err != nil {
+		return err
+	}
+	if cmd.Follow && cmd.Interval <= 0 {
+		return errors.New("--interval must be positive")
+	}
+
+	req := &control.SystemEventsQueryReq{
+		MinSeverity: cmd.Severity,
+		Ranks:       &cmd.Ranks.RankSet,
+		Since:       since,
+		MaxEvents:   cmd.MaxEvents,
+	}
+
+	ctx := cmd.MustLogCtx()
+	if !cmd.Follow {
+		_, err := cmd.query(ctx, req)
+		return err
+	}
+
+	cmd.EnableStreamOutput()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Fail early if the first query is rejected, e.g. because of an invalid filter. Errors
+	// from subsequent queries are logged rather than ending the command.
+	resp, err := cmd.query(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(cmd.Interval)
+	defer ticker.Stop()
+
+	for {
+		// Only request events retained since the last query.
+		req.AfterSeq = resp.LastSeq
+		req.MaxEvents = 0
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := cmd.query(ctx, req)
+		if err != nil {
+			if ctx.Err() == nil {
+				cmd.Error(err.Error())
+			}
+			continue
+		}
+		resp = next
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -141,6 +142,22 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errors.New("--not-ok and --with-states options cannot be set together"),
 		},
+		{
+			"system query crash reports",
+			"system query --crash-reports --ranks 1-2",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsQueryReq{
+					Ranks: ranklist.MustCreateRankSet("1-2"),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system query crash reports with states specified",
+			"system query --crash-reports --with-states joined",
+			"",
+			errors.New("--crash-reports option cannot be set with"),
+		},
 		{
 			"system query verbose",
 			"system query --verbose",
@@ -165,6 +182,37 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system stop with drain",
+			"system stop --drain",
+			strings.Join([]string{
+				printRequest(t, &control.SystemStopReq{Drain: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"system stop with drain and timeout",
+			"system stop --drain --drain-timeout 30s",
+			strings.Join([]string{
+				printRequest(t, &control.SystemStopReq{
+					Drain:        true,
+					DrainTimeout: 30 * time.Second,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system stop with drain and force",
+			"system stop --drain --force",
+			"",
+			errIncompatFlags("force", "drain"),
+		},
+		{
+			"system stop with drain timeout but no drain",
+			"system stop --drain-timeout 30s",
+			"",
+			errors.New("cannot be set without --drain"),
+		},
 		{
 			"system stop with single rank",
 			"system stop --ranks 0",
@@ -237,6 +285,45 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system rolling-restart with no joined ranks",
+			"system rolling-restart",
+			"",
+			errors.New("no joined ranks found to restart"),
+		},
+		{
+			"system rolling-restart with zero batch size",
+			"system rolling-restart --batch-size 0",
+			"",
+			errors.New("--batch-size must be greater than zero"),
+		},
+		{
+			"system rolling-restart with both hosts and ranks specified",
+			"system rolling-restart --rank-hosts bar9 --ranks 0",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
+		{
+			"system erase",
+			"system erase",
+			strings.Join([]string{
+				printRequest(t, &control.SystemQueryReq{FailOnUnavailable: true}),
+				printRequest(t, &control.SystemEraseReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system erase with ranks; mgmt service unavailable",
+			"system erase --ranks 1-2 --force",
+			"",
+			system.ErrRaftUnavail,
+		},
+		{
+			"system erase with both hosts and ranks specified",
+			"system erase --rank-hosts bar9 --ranks 0 --force",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
 		{
 			"system start with no arguments",
 			"system start",
@@ -432,6 +519,68 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system audit query with defaults",
+			"system audit query",
+			strings.Join([]string{
+				printRequest(t, &control.AuditQueryReq{
+					MaxEntries: 50,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit query with filters",
+			"system audit query -n 0 --since 2025-01-02T15:04:05Z --method PoolCreate",
+			strings.Join([]string{
+				printRequest(t, &control.AuditQueryReq{
+					Since:  time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC),
+					Method: "PoolCreate",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit query with invalid since",
+			"system audit query --since yesterday",
+			"",
+			errors.New("invalid --since value"),
+		},
+		{
+			"system events query with defaults",
+			"system events query",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsQueryReq{
+					Ranks:     new(ranklist.RankSet),
+					MaxEvents: 50,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events query with filters",
+			"system events query -n 0 --severity error --rank 3 --since 2025-01-02T15:04:05Z",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsQueryReq{
+					MinSeverity: "error",
+					Ranks:       ranklist.MustCreateRankSet("3"),
+					Since:       time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC),
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events query with invalid since",
+			"system events query --since yesterday",
+			"",
+			errors.New("invalid --since value"),
+		},
+		{
+			"system events follow with invalid interval",
+			"system events query --follow --interval 0s",
+			"",
+			errors.New("--interval must be positive"),
+		},
 		{
 			"leader query",
 			"system leader-query",
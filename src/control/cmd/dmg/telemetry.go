@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -356,8 +357,9 @@ type metricsQueryCmd struct {
 	baseCmd
 	cmdutil.JSONOutputCmd
 	singleHostCmd
-	Port    uint32 `short:"p" long:"port" default:"9191" description:"Telemetry port on the host"`
-	Metrics string `short:"m" long:"metrics" default:"" description:"Comma-separated list of metric names"`
+	Port    uint32        `short:"p" long:"port" default:"9191" description:"Telemetry port on the host"`
+	Metrics string        `short:"m" long:"metrics" default:"" description:"Comma-separated list of metric names"`
+	Since   time.Duration `short:"s" long:"since" description:"Query the values retained by the host that were sampled within this duration (e.g. 10m)"`
 }
 
 // Execute runs the command to query metrics from the DAOS storage nodes.
@@ -367,6 +369,10 @@ func (cmd *metricsQueryCmd) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Since != 0 {
+		return cmd.queryHistory(host)
+	}
+
 	req := new(control.MetricsQueryReq)
 	req.Port = cmd.Port
 	req.Host = host
@@ -391,3 +397,27 @@ func (cmd *metricsQueryCmd) Execute(args []string) error {
 	}
 	return nil
 }
+
+// queryHistory queries the metrics values retained by the DAOS storage node.
+func (cmd *metricsQueryCmd) queryHistory(host string) error {
+	req := new(control.MetricsHistoryReq)
+	req.Port = cmd.Port
+	req.Host = host
+	req.MetricNames = common.TokenizeCommaSeparatedString(cmd.Metrics)
+	req.Since = cmd.Since
+
+	if !cmd.JSONOutputEnabled() {
+		cmd.Info(getConnectingMsg(req.Host, req.Port))
+	}
+
+	resp, err := control.MetricsHistory(cmd.MustLogCtx(), req)
+	if err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	return pretty.PrintMetricsHistoryResp(os.Stdout, resp)
+}
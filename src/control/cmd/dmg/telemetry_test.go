@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,6 +28,12 @@ func TestTelemetryCommands(t *testing.T) {
 			"",
 			errors.New("single host"),
 		},
+		{
+			"query history with bad duration",
+			"telemetry metrics query -l host1 --since bogus",
+			"",
+			errors.New("invalid duration"),
+		},
 	})
 }
 
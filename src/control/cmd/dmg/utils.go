@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,13 +9,20 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/logging"
 )
 
 type singleHostFlag ui.HostSetFlag
@@ -69,3 +77,40 @@ func ratiosToSingleFraction(ratios []float64) (float32, error) {
 	// Precision loss deemed acceptable with conversion from float64 to float32.
 	return float32(ratios[0]), nil
 }
+
+// watchCmd can be embedded in query commands to repeat the query at an interval.
+type watchCmd struct {
+	Watch time.Duration `long:"watch" description:"Repeat the query at the given interval (e.g. 5s) until interrupted"`
+}
+
+// watch runs the query once or, if a watch interval is set, repeatedly until the context is
+// canceled or the command is interrupted. Errors from a repeated query are logged rather than
+// ending the watch. Responses output as JSON are written one per line.
+func (cmd *watchCmd) watch(ctx context.Context, log logging.Logger, jsonCmd *cmdutil.JSONOutputCmd, query func(context.Context) error) error {
+	if cmd.Watch == 0 {
+		return query(ctx)
+	}
+	if cmd.Watch < 0 {
+		return errors.New("--watch interval must be positive")
+	}
+
+	jsonCmd.EnableStreamOutput()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ticker := time.NewTicker(cmd.Watch)
+	defer ticker.Stop()
+
+	for {
+		log.Infof("Every %s: %s\n", cmd.Watch, time.Now().Format(time.RFC1123))
+		if err := query(ctx); err != nil && ctx.Err() == nil {
+			log.Error(err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,13 +9,18 @@ package main
 
 import (
 	"bytes"
+	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/logging"
 )
 
 func mockHostGroups(t *testing.T) hostlist.HostGroups {
@@ -108,3 +114,78 @@ func TestDmg_singleHostFlag_UnmarshalFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestDmg_watchCmd(t *testing.T) {
+	for name, tc := range map[string]struct {
+		interval   time.Duration
+		queryErr   error
+		stopAfter  int
+		json       bool
+		expCalls   int
+		expJSONOut int
+		expErr     error
+	}{
+		"no watch": {
+			expCalls: 1,
+		},
+		"no watch; query fails": {
+			queryErr: errors.New("whoops"),
+			expCalls: 1,
+			expErr:   errors.New("whoops"),
+		},
+		"negative interval": {
+			interval: -time.Second,
+			expErr:   errors.New("must be positive"),
+		},
+		"watch until canceled": {
+			interval:  time.Millisecond,
+			stopAfter: 3,
+			expCalls:  3,
+		},
+		"watch continues after failed query": {
+			interval:  time.Millisecond,
+			queryErr:  errors.New("whoops"),
+			stopAfter: 2,
+			expCalls:  2,
+		},
+		"watch streams json": {
+			interval:   time.Millisecond,
+			stopAfter:  3,
+			json:       true,
+			expCalls:   3,
+			expJSONOut: 3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+
+			var jsonCmd cmdutil.JSONOutputCmd
+			var jsonOut strings.Builder
+			if tc.json {
+				jsonCmd.EnableJSONOutput(&jsonOut, nil)
+			}
+
+			var calls int
+			cmd := &watchCmd{Watch: tc.interval}
+			gotErr := cmd.watch(ctx, log, &jsonCmd, func(context.Context) error {
+				calls++
+				if calls == tc.stopAfter {
+					cancel()
+				}
+				if tc.json {
+					return jsonCmd.OutputJSON(calls, tc.queryErr)
+				}
+				return tc.queryErr
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			test.AssertEqual(t, tc.expCalls, calls, "unexpected number of queries")
+			test.AssertEqual(t, tc.expJSONOut, strings.Count(jsonOut.String(), "\n"),
+				"unexpected number of json lines")
+		})
+	}
+}
@@ -0,0 +1,316 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package cmdutil
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+// OutputFormat selects how a command's response is rendered.
+type OutputFormat string
+
+// Supported output formats.
+const (
+	FormatTable OutputFormat = "table"
+	FormatJSON  OutputFormat = "json"
+	FormatYAML  OutputFormat = "yaml"
+	FormatCSV   OutputFormat = "csv"
+)
+
+// fieldSep separates the names of nested fields, e.g. "fault_domain.name".
+const fieldSep = "."
+
+var _ FormatSetter = (*JSONOutputCmd)(nil)
+
+// FormatSetter is an interface for commands whose machine-readable output can be
+// rendered in a format other than JSON or restricted to a set of fields.
+type FormatSetter interface {
+	SetOutputFormat(OutputFormat, []string)
+}
+
+// ParseFields splits a comma separated list of field names.
+func ParseFields(in string) []string {
+	var fields []string
+	for _, f := range strings.Split(in, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// toGeneric converts the input into the generic form produced by decoding its
+// JSON representation, so that all responses can be handled uniformly.
+func toGeneric(in interface{}) (interface{}, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func flattenInto(row map[string]interface{}, prefix string, obj map[string]interface{}) {
+	for key, val := range obj {
+		if prefix != "" {
+			key = prefix + fieldSep + key
+		}
+		if nested, ok := val.(map[string]interface{}); ok && len(nested) > 0 {
+			flattenInto(row, key, nested)
+			continue
+		}
+		row[key] = val
+	}
+}
+
+func toRow(val interface{}) map[string]interface{} {
+	row := make(map[string]interface{})
+	if obj, ok := val.(map[string]interface{}); ok {
+		flattenInto(row, "", obj)
+	} else {
+		row["value"] = val
+	}
+	return row
+}
+
+func isObjectList(val interface{}) ([]interface{}, bool) {
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	for _, elem := range list {
+		if _, ok := elem.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+	return list, true
+}
+
+// toRows converts a generic response into rows. A list is a row per element and
+// an object with a single list of objects, e.g. the members in a system query
+// response, is a row per object in the list. Any other object is a single row.
+func toRows(in interface{}) []map[string]interface{} {
+	var list []interface{}
+	switch val := in.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		list = val
+	case map[string]interface{}:
+		var lists, populated [][]interface{}
+		for _, field := range val {
+			if l, ok := isObjectList(field); ok {
+				lists = append(lists, l)
+				if len(l) > 0 {
+					populated = append(populated, l)
+				}
+			}
+		}
+		switch {
+		case len(lists) == 1:
+			list = lists[0]
+		case len(populated) == 1:
+			list = populated[0]
+		default:
+			list = []interface{}{val}
+		}
+	default:
+		list = []interface{}{val}
+	}
+
+	rows := make([]map[string]interface{}, 0, len(list))
+	for _, elem := range list {
+		rows = append(rows, toRow(elem))
+	}
+	return rows
+}
+
+// selectFields returns the rows restricted to the requested fields, or all
+// fields if none are requested, along with the resulting column names.
+func selectFields(rows []map[string]interface{}, fields []string) ([]map[string]interface{}, []string, error) {
+	if len(fields) == 0 {
+		keys := make(map[string]struct{})
+		for _, row := range rows {
+			for key := range row {
+				keys[key] = struct{}{}
+			}
+		}
+		for key := range keys {
+			fields = append(fields, key)
+		}
+		sort.Strings(fields)
+		return rows, fields, nil
+	}
+
+	selected := make([]map[string]interface{}, 0, len(rows))
+	found := make(map[string]bool)
+	for _, row := range rows {
+		sel := make(map[string]interface{})
+		for _, field := range fields {
+			if val, exists := row[field]; exists {
+				found[field] = true
+				sel[field] = val
+			}
+		}
+		selected = append(selected, sel)
+	}
+	if len(rows) > 0 {
+		for _, field := range fields {
+			if !found[field] {
+				return nil, nil, errors.Errorf("unknown output field %q", field)
+			}
+		}
+	}
+
+	return selected, fields, nil
+}
+
+func fmtValue(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
+
+func writeCSV(writer io.Writer, rows []map[string]interface{}, fields []string) error {
+	w := csv.NewWriter(writer)
+	if err := w.Write(fields); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := make([]string, len(fields))
+		for i, field := range fields {
+			record[i] = fmtValue(row[field])
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+func writeTable(writer io.Writer, rows []map[string]interface{}, fields []string) error {
+	table := make([]txtfmt.TableRow, 0, len(rows))
+	for _, row := range rows {
+		tRow := make(txtfmt.TableRow)
+		for _, field := range fields {
+			tRow[field] = fmtValue(row[field])
+		}
+		table = append(table, tRow)
+	}
+
+	_, err := fmt.Fprint(writer, txtfmt.NewTableFormatter(fields...).Format(table))
+	return err
+}
+
+func writeYAML(writer io.Writer, in interface{}, inErr error, stream bool) error {
+	errStr, status := errorStatus(inErr)
+
+	data, err := yaml.Marshal(struct {
+		Response interface{} `yaml:"response"`
+		Error    *string     `yaml:"error"`
+		Status   int         `yaml:"status"`
+	}{in, errStr, status})
+	if err != nil {
+		return err
+	}
+
+	if stream {
+		// separate the documents in the stream
+		data = append([]byte("---\n"), data...)
+	}
+	if _, err = writer.Write(data); err != nil {
+		return err
+	}
+
+	return inErr
+}
+
+// OutputFormatted writes the given data or error to the given writer in the
+// requested format. If fields are supplied, only those fields of each row of
+// the data are output. The table format is only used when fields are
+// supplied, otherwise commands use their own human-readable output.
+func OutputFormatted(writer io.Writer, format OutputFormat, fields []string, in interface{}, inErr error) error {
+	return outputFormatted(writer, format, fields, in, inErr, false)
+}
+
+// outputFormatted writes the data in the requested format. If the output is
+// part of a stream of responses, JSON is written on a single line and YAML
+// documents are separated.
+func outputFormatted(writer io.Writer, format OutputFormat, fields []string, in interface{}, inErr error, stream bool) error {
+	tabular := format == FormatCSV || format == FormatTable
+	if (format == "" || format == FormatJSON) && len(fields) == 0 {
+		return writeJSON(writer, in, inErr, stream)
+	}
+	if tabular && in == nil {
+		return inErr
+	}
+
+	generic, err := toGeneric(in)
+	if err != nil {
+		return err
+	}
+
+	var rows []map[string]interface{}
+	if tabular || len(fields) > 0 {
+		rows, fields, err = selectFields(toRows(generic), fields)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch format {
+	case FormatCSV:
+		if err := writeCSV(writer, rows, fields); err != nil {
+			return err
+		}
+		return inErr
+	case FormatTable:
+		if err := writeTable(writer, rows, fields); err != nil {
+			return err
+		}
+		return inErr
+	}
+
+	var resp interface{} = generic
+	if len(fields) > 0 && generic != nil {
+		resp = rows
+	}
+	if format == FormatYAML {
+		return writeYAML(writer, resp, inErr, stream)
+	}
+	return writeJSON(writer, resp, inErr, stream)
+}
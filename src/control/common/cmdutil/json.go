@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -27,24 +28,40 @@ type (
 	}
 )
 
+// errorStatus returns the message and DAOS status to report for the error.
+func errorStatus(inErr error) (*string, int) {
+	if inErr == nil {
+		return nil, 0
+	}
+
+	str := inErr.Error()
+	if s, ok := errors.Cause(inErr).(daos.Status); ok {
+		return &str, int(s)
+	}
+	return &str, int(daos.MiscError)
+}
+
 // OutputJSON writes the given data or error to the given writer as JSON.
 func OutputJSON(writer io.Writer, in interface{}, inErr error) error {
-	status := 0
-	var errStr *string
-	if inErr != nil {
-		errStr = func() *string { str := inErr.Error(); return &str }()
-		if s, ok := errors.Cause(inErr).(daos.Status); ok {
-			status = int(s)
-		} else {
-			status = int(daos.MiscError)
-		}
-	}
+	return writeJSON(writer, in, inErr, false)
+}
 
-	data, err := json.MarshalIndent(struct {
+func writeJSON(writer io.Writer, in interface{}, inErr error, compact bool) error {
+	errStr, status := errorStatus(inErr)
+
+	out := struct {
 		Response interface{} `json:"response"`
 		Error    *string     `json:"error"`
 		Status   int         `json:"status"`
-	}{in, errStr, status}, "", "  ")
+	}{in, errStr, status}
+
+	var data []byte
+	var err error
+	if compact {
+		data, err = json.Marshal(out)
+	} else {
+		data, err = json.MarshalIndent(out, "", "  ")
+	}
 	if err != nil {
 		return err
 	}
@@ -62,6 +79,9 @@ type JSONOutputCmd struct {
 	writer      io.Writer
 	jsonEnabled atm.Bool
 	wroteJSON   *atm.Bool
+	format      OutputFormat
+	fields      []string
+	stream      bool
 }
 
 // EnableJSONOutput enables JSON output to the given writer. The
@@ -81,11 +101,25 @@ func (cmd *JSONOutputCmd) JSONOutputEnabled() bool {
 	return cmd.jsonEnabled.IsTrue()
 }
 
-// OutputJSON writes the given data or error to the command's writer as JSON.
+// SetOutputFormat selects the format and fields used when output is enabled.
+// JSON is output with all fields by default.
+func (cmd *JSONOutputCmd) SetOutputFormat(format OutputFormat, fields []string) {
+	cmd.format = format
+	cmd.fields = fields
+}
+
+// EnableStreamOutput allows a command to output more than one response, e.g.
+// when a query is repeated. JSON responses are written one per line.
+func (cmd *JSONOutputCmd) EnableStreamOutput() {
+	cmd.stream = true
+}
+
+// OutputJSON writes the given data or error to the command's writer as JSON,
+// or in the format selected with SetOutputFormat.
 func (cmd *JSONOutputCmd) OutputJSON(in interface{}, err error) error {
-	if cmd.JSONOutputEnabled() && cmd.wroteJSON.IsFalse() {
+	if cmd.JSONOutputEnabled() && (cmd.stream || cmd.wroteJSON.IsFalse()) {
 		cmd.wroteJSON.SetTrue()
-		return OutputJSON(cmd.writer, in, err)
+		return outputFormatted(cmd.writer, cmd.format, cmd.fields, in, err, cmd.stream)
 	}
 
 	return nil
@@ -1,4 +1,5 @@
 // (C) Copyright 2019-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -240,6 +241,127 @@ func (x *ResponseState) GetInfo() string {
 	return ""
 }
 
+// DeviceBinding identifies the engine storage tier that a device is assigned to in the server
+// configuration.
+type DeviceBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EngineIdx uint32 `protobuf:"varint,1,opt,name=engine_idx,json=engineIdx,proto3" json:"engine_idx,omitempty"` // Index of engine using the device
+	TierIdx   uint32 `protobuf:"varint,2,opt,name=tier_idx,json=tierIdx,proto3" json:"tier_idx,omitempty"`       // Index of storage tier within engine
+	Class     string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`                           // Storage class of tier
+}
+
+func (x *DeviceBinding) Reset() {
+	*x = DeviceBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_common_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceBinding) ProtoMessage() {}
+
+func (x *DeviceBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_common_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceBinding.ProtoReflect.Descriptor instead.
+func (*DeviceBinding) Descriptor() ([]byte, []int) {
+	return file_ctl_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeviceBinding) GetEngineIdx() uint32 {
+	if x != nil {
+		return x.EngineIdx
+	}
+	return 0
+}
+
+func (x *DeviceBinding) GetTierIdx() uint32 {
+	if x != nil {
+		return x.TierIdx
+	}
+	return 0
+}
+
+func (x *DeviceBinding) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+// PoolStorageUsage is the capacity of a storage device consumed by a pool.
+type PoolStorageUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid      string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                             // UUID of pool
+	UsedBytes uint64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"` // Bytes of the device allocated to the pool
+}
+
+func (x *PoolStorageUsage) Reset() {
+	*x = PoolStorageUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_common_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolStorageUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolStorageUsage) ProtoMessage() {}
+
+func (x *PoolStorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_common_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolStorageUsage.ProtoReflect.Descriptor instead.
+func (*PoolStorageUsage) Descriptor() ([]byte, []int) {
+	return file_ctl_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PoolStorageUsage) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PoolStorageUsage) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
 var File_ctl_common_proto protoreflect.FileDescriptor
 
 var file_ctl_common_proto_rawDesc = []byte{
@@ -253,26 +375,37 @@ var file_ctl_common_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0xe9, 0x01, 0x0a, 0x0e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f,
-	0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12,
-	0x13, 0x0a, 0x0f, 0x43, 0x54, 0x4c, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
-	0x53, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x0c, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01,
-	0x12, 0x19, 0x0a, 0x0c, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x56, 0x4d, 0x45,
-	0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x18, 0x0a, 0x0b, 0x43,
-	0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x43, 0x4d, 0x10, 0xfd, 0xff, 0xff, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x18, 0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x5f, 0x41, 0x50, 0x50, 0x10, 0xfc, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12,
-	0x1c, 0x0a, 0x0f, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0xfb, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x18, 0x0a,
-	0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x10, 0xfa, 0xff, 0xff,
-	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x5f, 0x0a, 0x0d, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
+	0x69, 0x65, 0x72, 0x49, 0x64, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x10,
+	0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x2a, 0xe9, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x54, 0x4c, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x43, 0x54, 0x4c, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x19, 0x0a,
+	0x0c, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x10, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x19, 0x0a, 0x0c, 0x43, 0x54, 0x4c, 0x5f,
+	0x45, 0x52, 0x52, 0x5f, 0x4e, 0x56, 0x4d, 0x45, 0x10, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x12, 0x18, 0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x53,
+	0x43, 0x4d, 0x10, 0xfd, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x18, 0x0a,
+	0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x41, 0x50, 0x50, 0x10, 0xfc, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x1c, 0x0a, 0x0f, 0x43, 0x54, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0xfb, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x18, 0x0a, 0x0b, 0x43, 0x54, 0x4c, 0x5f, 0x4e, 0x4f, 0x5f,
+	0x49, 0x4d, 0x50, 0x4c, 0x10, 0xfa, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72,
+	0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -288,12 +421,14 @@ func file_ctl_common_proto_rawDescGZIP() []byte {
 }
 
 var file_ctl_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ctl_common_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ctl_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_ctl_common_proto_goTypes = []interface{}{
-	(ResponseStatus)(0),   // 0: ctl.ResponseStatus
-	(*EmptyReq)(nil),      // 1: ctl.EmptyReq
-	(*FilePath)(nil),      // 2: ctl.FilePath
-	(*ResponseState)(nil), // 3: ctl.ResponseState
+	(ResponseStatus)(0),      // 0: ctl.ResponseStatus
+	(*EmptyReq)(nil),         // 1: ctl.EmptyReq
+	(*FilePath)(nil),         // 2: ctl.FilePath
+	(*ResponseState)(nil),    // 3: ctl.ResponseState
+	(*DeviceBinding)(nil),    // 4: ctl.DeviceBinding
+	(*PoolStorageUsage)(nil), // 5: ctl.PoolStorageUsage
 }
 var file_ctl_common_proto_depIdxs = []int32{
 	0, // 0: ctl.ResponseState.status:type_name -> ctl.ResponseStatus
@@ -346,6 +481,30 @@ func file_ctl_common_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_common_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceBinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_common_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolStorageUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -353,7 +512,7 @@ func file_ctl_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_common_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -37,128 +38,210 @@ var file_ctl_ctl_proto_rawDesc = []byte{
 	0x74, 0x6c, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10,
 	0x63, 0x74, 0x6c, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x11, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x32, 0xfe, 0x06, 0x0a, 0x06, 0x43, 0x74, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x3a,
-	0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e,
-	0x64, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65,
-	0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41,
-	0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x74, 0x6c, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x74, 0x6c, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x74, 0x6c, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x73,
+	0x68, 0x61, 0x6b, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x85, 0x0c, 0x0a, 0x06, 0x43,
+	0x74, 0x6c, 0x53, 0x76, 0x63, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76,
+	0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e,
+	0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76,
+	0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x74,
 	0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x53, 0x63, 0x61, 0x6e, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f,
-	0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x0d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d,
-	0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53,
-	0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
-	0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f,
-	0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74,
-	0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x75, 0x74, 0x64,
-	0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52,
-	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61,
-	0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09, 0x53, 0x74, 0x6f,
-	0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x12,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d,
+	0x65, 0x4e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x13, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x3a, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x13,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x46,
+	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73,
+	0x12, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x61, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x4c,
+	0x6f, 0x67, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x3d, 0x0a,
+	0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
+	0x12, 0x18, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a,
+	0x0a, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74,
 	0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2d, 0x0a, 0x0a,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61,
-	0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x09,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52,
+	0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x2d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x0d, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12,
+	0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x65, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09,
+	0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
+	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_ctl_ctl_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),     // 0: ctl.StorageScanReq
-	(*StorageFormatReq)(nil),   // 1: ctl.StorageFormatReq
-	(*NvmeRebindReq)(nil),      // 2: ctl.NvmeRebindReq
-	(*NvmeAddDeviceReq)(nil),   // 3: ctl.NvmeAddDeviceReq
-	(*NetworkScanReq)(nil),     // 4: ctl.NetworkScanReq
-	(*FirmwareQueryReq)(nil),   // 5: ctl.FirmwareQueryReq
-	(*FirmwareUpdateReq)(nil),  // 6: ctl.FirmwareUpdateReq
-	(*SmdQueryReq)(nil),        // 7: ctl.SmdQueryReq
-	(*SmdManageReq)(nil),       // 8: ctl.SmdManageReq
-	(*SetLogMasksReq)(nil),     // 9: ctl.SetLogMasksReq
-	(*RanksReq)(nil),           // 10: ctl.RanksReq
-	(*CollectLogReq)(nil),      // 11: ctl.CollectLogReq
-	(*StorageScanResp)(nil),    // 12: ctl.StorageScanResp
-	(*StorageFormatResp)(nil),  // 13: ctl.StorageFormatResp
-	(*NvmeRebindResp)(nil),     // 14: ctl.NvmeRebindResp
-	(*NvmeAddDeviceResp)(nil),  // 15: ctl.NvmeAddDeviceResp
-	(*NetworkScanResp)(nil),    // 16: ctl.NetworkScanResp
-	(*FirmwareQueryResp)(nil),  // 17: ctl.FirmwareQueryResp
-	(*FirmwareUpdateResp)(nil), // 18: ctl.FirmwareUpdateResp
-	(*SmdQueryResp)(nil),       // 19: ctl.SmdQueryResp
-	(*SmdManageResp)(nil),      // 20: ctl.SmdManageResp
-	(*SetLogMasksResp)(nil),    // 21: ctl.SetLogMasksResp
-	(*RanksResp)(nil),          // 22: ctl.RanksResp
-	(*CollectLogResp)(nil),     // 23: ctl.CollectLogResp
+	(*StorageScanReq)(nil),          // 0: ctl.StorageScanReq
+	(*StorageFormatReq)(nil),        // 1: ctl.StorageFormatReq
+	(*NvmeRebindReq)(nil),           // 2: ctl.NvmeRebindReq
+	(*NvmeAddDeviceReq)(nil),        // 3: ctl.NvmeAddDeviceReq
+	(*NvmeReplaceReq)(nil),          // 4: ctl.NvmeReplaceReq
+	(*NvmeNsCreateReq)(nil),         // 5: ctl.NvmeNsCreateReq
+	(*NvmeNsDeleteReq)(nil),         // 6: ctl.NvmeNsDeleteReq
+	(*StorageRenderConfigReq)(nil),  // 7: ctl.StorageRenderConfigReq
+	(*NetworkScanReq)(nil),          // 8: ctl.NetworkScanReq
+	(*FirmwareQueryReq)(nil),        // 9: ctl.FirmwareQueryReq
+	(*FirmwareUpdateReq)(nil),       // 10: ctl.FirmwareUpdateReq
+	(*SmdQueryReq)(nil),             // 11: ctl.SmdQueryReq
+	(*SmdManageReq)(nil),            // 12: ctl.SmdManageReq
+	(*SetLogMasksReq)(nil),          // 13: ctl.SetLogMasksReq
+	(*ReloadConfigReq)(nil),         // 14: ctl.ReloadConfigReq
+	(*SetEngineStandbyReq)(nil),     // 15: ctl.SetEngineStandbyReq
+	(*RanksReq)(nil),                // 16: ctl.RanksReq
+	(*CollectLogReq)(nil),           // 17: ctl.CollectLogReq
+	(*AuditQueryReq)(nil),           // 18: ctl.AuditQueryReq
+	(*ReloadCertsReq)(nil),          // 19: ctl.ReloadCertsReq
+	(*HandshakeReq)(nil),            // 20: ctl.HandshakeReq
+	(*StorageScanResp)(nil),         // 21: ctl.StorageScanResp
+	(*StorageFormatResp)(nil),       // 22: ctl.StorageFormatResp
+	(*NvmeRebindResp)(nil),          // 23: ctl.NvmeRebindResp
+	(*NvmeAddDeviceResp)(nil),       // 24: ctl.NvmeAddDeviceResp
+	(*NvmeReplaceResp)(nil),         // 25: ctl.NvmeReplaceResp
+	(*NvmeNsCreateResp)(nil),        // 26: ctl.NvmeNsCreateResp
+	(*NvmeNsDeleteResp)(nil),        // 27: ctl.NvmeNsDeleteResp
+	(*StorageRenderConfigResp)(nil), // 28: ctl.StorageRenderConfigResp
+	(*NetworkScanResp)(nil),         // 29: ctl.NetworkScanResp
+	(*FirmwareQueryResp)(nil),       // 30: ctl.FirmwareQueryResp
+	(*FirmwareUpdateResp)(nil),      // 31: ctl.FirmwareUpdateResp
+	(*SmdQueryResp)(nil),            // 32: ctl.SmdQueryResp
+	(*SmdManageResp)(nil),           // 33: ctl.SmdManageResp
+	(*SetLogMasksResp)(nil),         // 34: ctl.SetLogMasksResp
+	(*ReloadConfigResp)(nil),        // 35: ctl.ReloadConfigResp
+	(*SetEngineStandbyResp)(nil),    // 36: ctl.SetEngineStandbyResp
+	(*RanksResp)(nil),               // 37: ctl.RanksResp
+	(*CollectLogResp)(nil),          // 38: ctl.CollectLogResp
+	(*AuditQueryResp)(nil),          // 39: ctl.AuditQueryResp
+	(*ReloadCertsResp)(nil),         // 40: ctl.ReloadCertsResp
+	(*HandshakeResp)(nil),           // 41: ctl.HandshakeResp
 }
 var file_ctl_ctl_proto_depIdxs = []int32{
 	0,  // 0: ctl.CtlSvc.StorageScan:input_type -> ctl.StorageScanReq
 	1,  // 1: ctl.CtlSvc.StorageFormat:input_type -> ctl.StorageFormatReq
 	2,  // 2: ctl.CtlSvc.StorageNvmeRebind:input_type -> ctl.NvmeRebindReq
 	3,  // 3: ctl.CtlSvc.StorageNvmeAddDevice:input_type -> ctl.NvmeAddDeviceReq
-	4,  // 4: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
-	5,  // 5: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
-	6,  // 6: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
-	7,  // 7: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
-	8,  // 8: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
-	9,  // 9: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
-	10, // 10: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
-	10, // 11: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
-	10, // 12: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
-	10, // 13: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
-	11, // 14: ctl.CtlSvc.CollectLog:input_type -> ctl.CollectLogReq
-	12, // 15: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
-	13, // 16: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
-	14, // 17: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
-	15, // 18: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
-	16, // 19: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
-	17, // 20: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
-	18, // 21: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
-	19, // 22: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
-	20, // 23: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
-	21, // 24: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
-	22, // 25: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
-	22, // 26: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
-	22, // 27: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
-	22, // 28: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
-	23, // 29: ctl.CtlSvc.CollectLog:output_type -> ctl.CollectLogResp
-	15, // [15:30] is the sub-list for method output_type
-	0,  // [0:15] is the sub-list for method input_type
+	4,  // 4: ctl.CtlSvc.StorageNvmeReplace:input_type -> ctl.NvmeReplaceReq
+	5,  // 5: ctl.CtlSvc.StorageNvmeNsCreate:input_type -> ctl.NvmeNsCreateReq
+	6,  // 6: ctl.CtlSvc.StorageNvmeNsDelete:input_type -> ctl.NvmeNsDeleteReq
+	7,  // 7: ctl.CtlSvc.StorageRenderConfig:input_type -> ctl.StorageRenderConfigReq
+	8,  // 8: ctl.CtlSvc.NetworkScan:input_type -> ctl.NetworkScanReq
+	9,  // 9: ctl.CtlSvc.FirmwareQuery:input_type -> ctl.FirmwareQueryReq
+	10, // 10: ctl.CtlSvc.FirmwareUpdate:input_type -> ctl.FirmwareUpdateReq
+	11, // 11: ctl.CtlSvc.SmdQuery:input_type -> ctl.SmdQueryReq
+	12, // 12: ctl.CtlSvc.SmdManage:input_type -> ctl.SmdManageReq
+	13, // 13: ctl.CtlSvc.SetEngineLogMasks:input_type -> ctl.SetLogMasksReq
+	14, // 14: ctl.CtlSvc.ReloadConfig:input_type -> ctl.ReloadConfigReq
+	15, // 15: ctl.CtlSvc.SetEngineStandby:input_type -> ctl.SetEngineStandbyReq
+	16, // 16: ctl.CtlSvc.PrepShutdownRanks:input_type -> ctl.RanksReq
+	16, // 17: ctl.CtlSvc.DrainRanks:input_type -> ctl.RanksReq
+	16, // 18: ctl.CtlSvc.StopRanks:input_type -> ctl.RanksReq
+	16, // 19: ctl.CtlSvc.ResetFormatRanks:input_type -> ctl.RanksReq
+	16, // 20: ctl.CtlSvc.StartRanks:input_type -> ctl.RanksReq
+	17, // 21: ctl.CtlSvc.CollectLog:input_type -> ctl.CollectLogReq
+	18, // 22: ctl.CtlSvc.AuditQuery:input_type -> ctl.AuditQueryReq
+	19, // 23: ctl.CtlSvc.ReloadCerts:input_type -> ctl.ReloadCertsReq
+	20, // 24: ctl.CtlSvc.Handshake:input_type -> ctl.HandshakeReq
+	21, // 25: ctl.CtlSvc.StorageScan:output_type -> ctl.StorageScanResp
+	22, // 26: ctl.CtlSvc.StorageFormat:output_type -> ctl.StorageFormatResp
+	23, // 27: ctl.CtlSvc.StorageNvmeRebind:output_type -> ctl.NvmeRebindResp
+	24, // 28: ctl.CtlSvc.StorageNvmeAddDevice:output_type -> ctl.NvmeAddDeviceResp
+	25, // 29: ctl.CtlSvc.StorageNvmeReplace:output_type -> ctl.NvmeReplaceResp
+	26, // 30: ctl.CtlSvc.StorageNvmeNsCreate:output_type -> ctl.NvmeNsCreateResp
+	27, // 31: ctl.CtlSvc.StorageNvmeNsDelete:output_type -> ctl.NvmeNsDeleteResp
+	28, // 32: ctl.CtlSvc.StorageRenderConfig:output_type -> ctl.StorageRenderConfigResp
+	29, // 33: ctl.CtlSvc.NetworkScan:output_type -> ctl.NetworkScanResp
+	30, // 34: ctl.CtlSvc.FirmwareQuery:output_type -> ctl.FirmwareQueryResp
+	31, // 35: ctl.CtlSvc.FirmwareUpdate:output_type -> ctl.FirmwareUpdateResp
+	32, // 36: ctl.CtlSvc.SmdQuery:output_type -> ctl.SmdQueryResp
+	33, // 37: ctl.CtlSvc.SmdManage:output_type -> ctl.SmdManageResp
+	34, // 38: ctl.CtlSvc.SetEngineLogMasks:output_type -> ctl.SetLogMasksResp
+	35, // 39: ctl.CtlSvc.ReloadConfig:output_type -> ctl.ReloadConfigResp
+	36, // 40: ctl.CtlSvc.SetEngineStandby:output_type -> ctl.SetEngineStandbyResp
+	37, // 41: ctl.CtlSvc.PrepShutdownRanks:output_type -> ctl.RanksResp
+	37, // 42: ctl.CtlSvc.DrainRanks:output_type -> ctl.RanksResp
+	37, // 43: ctl.CtlSvc.StopRanks:output_type -> ctl.RanksResp
+	37, // 44: ctl.CtlSvc.ResetFormatRanks:output_type -> ctl.RanksResp
+	37, // 45: ctl.CtlSvc.StartRanks:output_type -> ctl.RanksResp
+	38, // 46: ctl.CtlSvc.CollectLog:output_type -> ctl.CollectLogResp
+	39, // 47: ctl.CtlSvc.AuditQuery:output_type -> ctl.AuditQueryResp
+	40, // 48: ctl.CtlSvc.ReloadCerts:output_type -> ctl.ReloadCertsResp
+	41, // 49: ctl.CtlSvc.Handshake:output_type -> ctl.HandshakeResp
+	25, // [25:50] is the sub-list for method output_type
+	0,  // [0:25] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -176,6 +259,9 @@ func file_ctl_ctl_proto_init() {
 	file_ctl_ranks_proto_init()
 	file_ctl_server_proto_init()
 	file_ctl_support_proto_init()
+	file_ctl_audit_proto_init()
+	file_ctl_certs_proto_init()
+	file_ctl_handshake_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -29,17 +30,27 @@ const (
 	CtlSvc_StorageFormat_FullMethodName        = "/ctl.CtlSvc/StorageFormat"
 	CtlSvc_StorageNvmeRebind_FullMethodName    = "/ctl.CtlSvc/StorageNvmeRebind"
 	CtlSvc_StorageNvmeAddDevice_FullMethodName = "/ctl.CtlSvc/StorageNvmeAddDevice"
+	CtlSvc_StorageNvmeReplace_FullMethodName   = "/ctl.CtlSvc/StorageNvmeReplace"
+	CtlSvc_StorageNvmeNsCreate_FullMethodName  = "/ctl.CtlSvc/StorageNvmeNsCreate"
+	CtlSvc_StorageNvmeNsDelete_FullMethodName  = "/ctl.CtlSvc/StorageNvmeNsDelete"
+	CtlSvc_StorageRenderConfig_FullMethodName  = "/ctl.CtlSvc/StorageRenderConfig"
 	CtlSvc_NetworkScan_FullMethodName          = "/ctl.CtlSvc/NetworkScan"
 	CtlSvc_FirmwareQuery_FullMethodName        = "/ctl.CtlSvc/FirmwareQuery"
 	CtlSvc_FirmwareUpdate_FullMethodName       = "/ctl.CtlSvc/FirmwareUpdate"
 	CtlSvc_SmdQuery_FullMethodName             = "/ctl.CtlSvc/SmdQuery"
 	CtlSvc_SmdManage_FullMethodName            = "/ctl.CtlSvc/SmdManage"
 	CtlSvc_SetEngineLogMasks_FullMethodName    = "/ctl.CtlSvc/SetEngineLogMasks"
+	CtlSvc_ReloadConfig_FullMethodName         = "/ctl.CtlSvc/ReloadConfig"
+	CtlSvc_SetEngineStandby_FullMethodName     = "/ctl.CtlSvc/SetEngineStandby"
 	CtlSvc_PrepShutdownRanks_FullMethodName    = "/ctl.CtlSvc/PrepShutdownRanks"
+	CtlSvc_DrainRanks_FullMethodName           = "/ctl.CtlSvc/DrainRanks"
 	CtlSvc_StopRanks_FullMethodName            = "/ctl.CtlSvc/StopRanks"
 	CtlSvc_ResetFormatRanks_FullMethodName     = "/ctl.CtlSvc/ResetFormatRanks"
 	CtlSvc_StartRanks_FullMethodName           = "/ctl.CtlSvc/StartRanks"
 	CtlSvc_CollectLog_FullMethodName           = "/ctl.CtlSvc/CollectLog"
+	CtlSvc_AuditQuery_FullMethodName           = "/ctl.CtlSvc/AuditQuery"
+	CtlSvc_ReloadCerts_FullMethodName          = "/ctl.CtlSvc/ReloadCerts"
+	CtlSvc_Handshake_FullMethodName            = "/ctl.CtlSvc/Handshake"
 )
 
 // CtlSvcClient is the client API for CtlSvc service.
@@ -60,6 +71,14 @@ type CtlSvcClient interface {
 	StorageNvmeRebind(ctx context.Context, in *NvmeRebindReq, opts ...grpc.CallOption) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDeviceReq, opts ...grpc.CallOption) (*NvmeAddDeviceResp, error)
+	// Replace a faulty SSD, resuming from the last completed stage of a previous attempt
+	StorageNvmeReplace(ctx context.Context, in *NvmeReplaceReq, opts ...grpc.CallOption) (*NvmeReplaceResp, error)
+	// Create namespaces on an SSD, carving its capacity between DAOS engines
+	StorageNvmeNsCreate(ctx context.Context, in *NvmeNsCreateReq, opts ...grpc.CallOption) (*NvmeNsCreateResp, error)
+	// Delete a namespace from an SSD
+	StorageNvmeNsDelete(ctx context.Context, in *NvmeNsDeleteReq, opts ...grpc.CallOption) (*NvmeNsDeleteResp, error)
+	// Render the SPDK JSON config of an engine without writing it
+	StorageRenderConfig(ctx context.Context, in *StorageRenderConfigReq, opts ...grpc.CallOption) (*StorageRenderConfigResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -72,8 +91,15 @@ type CtlSvcClient interface {
 	SmdManage(ctx context.Context, in *SmdManageReq, opts ...grpc.CallOption) (*SmdManageResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq, opts ...grpc.CallOption) (*SetLogMasksResp, error)
+	// Re-read the server config file and apply hot-reloadable parameters.
+	ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error)
+	// Set or clear standby mode for a DAOS I/O Engine on a host.
+	SetEngineStandby(ctx context.Context, in *SetEngineStandbyReq, opts ...grpc.CallOption) (*SetEngineStandbyResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
+	// Drain DAOS I/O Engines on a host of pool connections and in-flight I/O ahead of
+	// shutdown. (gRPC fanout)
+	DrainRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
 	StopRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// ResetFormat DAOS I/O Engines on a host. (gRPC fanout)
@@ -82,6 +108,12 @@ type CtlSvcClient interface {
 	StartRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(ctx context.Context, in *CollectLogReq, opts ...grpc.CallOption) (*CollectLogResp, error)
+	// Retrieve recent entries from the audit log of management requests handled by a host
+	AuditQuery(ctx context.Context, in *AuditQueryReq, opts ...grpc.CallOption) (*AuditQueryResp, error)
+	// Reload TLS certificates and certificate revocation list from disk
+	ReloadCerts(ctx context.Context, in *ReloadCertsReq, opts ...grpc.CallOption) (*ReloadCertsResp, error)
+	// Exchange control API versions and retrieve the features supported by a server
+	Handshake(ctx context.Context, in *HandshakeReq, opts ...grpc.CallOption) (*HandshakeResp, error)
 }
 
 type ctlSvcClient struct {
@@ -132,6 +164,46 @@ func (c *ctlSvcClient) StorageNvmeAddDevice(ctx context.Context, in *NvmeAddDevi
 	return out, nil
 }
 
+func (c *ctlSvcClient) StorageNvmeReplace(ctx context.Context, in *NvmeReplaceReq, opts ...grpc.CallOption) (*NvmeReplaceResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NvmeReplaceResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeReplace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) StorageNvmeNsCreate(ctx context.Context, in *NvmeNsCreateReq, opts ...grpc.CallOption) (*NvmeNsCreateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NvmeNsCreateResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeNsCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) StorageNvmeNsDelete(ctx context.Context, in *NvmeNsDeleteReq, opts ...grpc.CallOption) (*NvmeNsDeleteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NvmeNsDeleteResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageNvmeNsDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) StorageRenderConfig(ctx context.Context, in *StorageRenderConfigReq, opts ...grpc.CallOption) (*StorageRenderConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorageRenderConfigResp)
+	err := c.cc.Invoke(ctx, CtlSvc_StorageRenderConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(NetworkScanResp)
@@ -192,6 +264,26 @@ func (c *ctlSvcClient) SetEngineLogMasks(ctx context.Context, in *SetLogMasksReq
 	return out, nil
 }
 
+func (c *ctlSvcClient) ReloadConfig(ctx context.Context, in *ReloadConfigReq, opts ...grpc.CallOption) (*ReloadConfigResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadConfigResp)
+	err := c.cc.Invoke(ctx, CtlSvc_ReloadConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) SetEngineStandby(ctx context.Context, in *SetEngineStandbyReq, opts ...grpc.CallOption) (*SetEngineStandbyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEngineStandbyResp)
+	err := c.cc.Invoke(ctx, CtlSvc_SetEngineStandby_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RanksResp)
@@ -202,6 +294,16 @@ func (c *ctlSvcClient) PrepShutdownRanks(ctx context.Context, in *RanksReq, opts
 	return out, nil
 }
 
+func (c *ctlSvcClient) DrainRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RanksResp)
+	err := c.cc.Invoke(ctx, CtlSvc_DrainRanks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ctlSvcClient) StopRanks(ctx context.Context, in *RanksReq, opts ...grpc.CallOption) (*RanksResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RanksResp)
@@ -242,6 +344,36 @@ func (c *ctlSvcClient) CollectLog(ctx context.Context, in *CollectLogReq, opts .
 	return out, nil
 }
 
+func (c *ctlSvcClient) AuditQuery(ctx context.Context, in *AuditQueryReq, opts ...grpc.CallOption) (*AuditQueryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditQueryResp)
+	err := c.cc.Invoke(ctx, CtlSvc_AuditQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) ReloadCerts(ctx context.Context, in *ReloadCertsReq, opts ...grpc.CallOption) (*ReloadCertsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadCertsResp)
+	err := c.cc.Invoke(ctx, CtlSvc_ReloadCerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ctlSvcClient) Handshake(ctx context.Context, in *HandshakeReq, opts ...grpc.CallOption) (*HandshakeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandshakeResp)
+	err := c.cc.Invoke(ctx, CtlSvc_Handshake_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CtlSvcServer is the server API for CtlSvc service.
 // All implementations must embed UnimplementedCtlSvcServer
 // for forward compatibility.
@@ -260,6 +392,14 @@ type CtlSvcServer interface {
 	StorageNvmeRebind(context.Context, *NvmeRebindReq) (*NvmeRebindResp, error)
 	// Add newly inserted SSD to DAOS engine config
 	StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error)
+	// Replace a faulty SSD, resuming from the last completed stage of a previous attempt
+	StorageNvmeReplace(context.Context, *NvmeReplaceReq) (*NvmeReplaceResp, error)
+	// Create namespaces on an SSD, carving its capacity between DAOS engines
+	StorageNvmeNsCreate(context.Context, *NvmeNsCreateReq) (*NvmeNsCreateResp, error)
+	// Delete a namespace from an SSD
+	StorageNvmeNsDelete(context.Context, *NvmeNsDeleteReq) (*NvmeNsDeleteResp, error)
+	// Render the SPDK JSON config of an engine without writing it
+	StorageRenderConfig(context.Context, *StorageRenderConfigReq) (*StorageRenderConfigResp, error)
 	// Perform a fabric scan to determine the available provider, device, NUMA node combinations
 	NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error)
 	// Retrieve firmware details from storage devices on server
@@ -272,8 +412,15 @@ type CtlSvcServer interface {
 	SmdManage(context.Context, *SmdManageReq) (*SmdManageResp, error)
 	// Set log level for DAOS I/O Engines on a host.
 	SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error)
+	// Re-read the server config file and apply hot-reloadable parameters.
+	ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigResp, error)
+	// Set or clear standby mode for a DAOS I/O Engine on a host.
+	SetEngineStandby(context.Context, *SetEngineStandbyReq) (*SetEngineStandbyResp, error)
 	// Prepare DAOS I/O Engines on a host for controlled shutdown. (gRPC fanout)
 	PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error)
+	// Drain DAOS I/O Engines on a host of pool connections and in-flight I/O ahead of
+	// shutdown. (gRPC fanout)
+	DrainRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Stop DAOS I/O Engines on a host. (gRPC fanout)
 	StopRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// ResetFormat DAOS I/O Engines on a host. (gRPC fanout)
@@ -282,6 +429,12 @@ type CtlSvcServer interface {
 	StartRanks(context.Context, *RanksReq) (*RanksResp, error)
 	// Perform a Log collection on Servers for support/debug purpose
 	CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error)
+	// Retrieve recent entries from the audit log of management requests handled by a host
+	AuditQuery(context.Context, *AuditQueryReq) (*AuditQueryResp, error)
+	// Reload TLS certificates and certificate revocation list from disk
+	ReloadCerts(context.Context, *ReloadCertsReq) (*ReloadCertsResp, error)
+	// Exchange control API versions and retrieve the features supported by a server
+	Handshake(context.Context, *HandshakeReq) (*HandshakeResp, error)
 	mustEmbedUnimplementedCtlSvcServer()
 }
 
@@ -304,6 +457,18 @@ func (UnimplementedCtlSvcServer) StorageNvmeRebind(context.Context, *NvmeRebindR
 func (UnimplementedCtlSvcServer) StorageNvmeAddDevice(context.Context, *NvmeAddDeviceReq) (*NvmeAddDeviceResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeAddDevice not implemented")
 }
+func (UnimplementedCtlSvcServer) StorageNvmeReplace(context.Context, *NvmeReplaceReq) (*NvmeReplaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeReplace not implemented")
+}
+func (UnimplementedCtlSvcServer) StorageNvmeNsCreate(context.Context, *NvmeNsCreateReq) (*NvmeNsCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeNsCreate not implemented")
+}
+func (UnimplementedCtlSvcServer) StorageNvmeNsDelete(context.Context, *NvmeNsDeleteReq) (*NvmeNsDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageNvmeNsDelete not implemented")
+}
+func (UnimplementedCtlSvcServer) StorageRenderConfig(context.Context, *StorageRenderConfigReq) (*StorageRenderConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRenderConfig not implemented")
+}
 func (UnimplementedCtlSvcServer) NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkScan not implemented")
 }
@@ -322,9 +487,18 @@ func (UnimplementedCtlSvcServer) SmdManage(context.Context, *SmdManageReq) (*Smd
 func (UnimplementedCtlSvcServer) SetEngineLogMasks(context.Context, *SetLogMasksReq) (*SetLogMasksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetEngineLogMasks not implemented")
 }
+func (UnimplementedCtlSvcServer) ReloadConfig(context.Context, *ReloadConfigReq) (*ReloadConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedCtlSvcServer) SetEngineStandby(context.Context, *SetEngineStandbyReq) (*SetEngineStandbyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEngineStandby not implemented")
+}
 func (UnimplementedCtlSvcServer) PrepShutdownRanks(context.Context, *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepShutdownRanks not implemented")
 }
+func (UnimplementedCtlSvcServer) DrainRanks(context.Context, *RanksReq) (*RanksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainRanks not implemented")
+}
 func (UnimplementedCtlSvcServer) StopRanks(context.Context, *RanksReq) (*RanksResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopRanks not implemented")
 }
@@ -337,6 +511,15 @@ func (UnimplementedCtlSvcServer) StartRanks(context.Context, *RanksReq) (*RanksR
 func (UnimplementedCtlSvcServer) CollectLog(context.Context, *CollectLogReq) (*CollectLogResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectLog not implemented")
 }
+func (UnimplementedCtlSvcServer) AuditQuery(context.Context, *AuditQueryReq) (*AuditQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditQuery not implemented")
+}
+func (UnimplementedCtlSvcServer) ReloadCerts(context.Context, *ReloadCertsReq) (*ReloadCertsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadCerts not implemented")
+}
+func (UnimplementedCtlSvcServer) Handshake(context.Context, *HandshakeReq) (*HandshakeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Handshake not implemented")
+}
 func (UnimplementedCtlSvcServer) mustEmbedUnimplementedCtlSvcServer() {}
 func (UnimplementedCtlSvcServer) testEmbeddedByValue()                {}
 
@@ -430,6 +613,78 @@ func _CtlSvc_StorageNvmeAddDevice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_StorageNvmeReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NvmeReplaceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageNvmeReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageNvmeReplace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageNvmeReplace(ctx, req.(*NvmeReplaceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_StorageNvmeNsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NvmeNsCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageNvmeNsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageNvmeNsCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageNvmeNsCreate(ctx, req.(*NvmeNsCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_StorageNvmeNsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NvmeNsDeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageNvmeNsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageNvmeNsDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageNvmeNsDelete(ctx, req.(*NvmeNsDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_StorageRenderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageRenderConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).StorageRenderConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_StorageRenderConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).StorageRenderConfig(ctx, req.(*StorageRenderConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NetworkScanReq)
 	if err := dec(in); err != nil {
@@ -538,6 +793,42 @@ func _CtlSvc_SetEngineLogMasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).ReloadConfig(ctx, req.(*ReloadConfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_SetEngineStandby_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEngineStandbyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).SetEngineStandby(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_SetEngineStandby_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).SetEngineStandby(ctx, req.(*SetEngineStandbyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RanksReq)
 	if err := dec(in); err != nil {
@@ -556,6 +847,24 @@ func _CtlSvc_PrepShutdownRanks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_DrainRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RanksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).DrainRanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_DrainRanks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).DrainRanks(ctx, req.(*RanksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CtlSvc_StopRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RanksReq)
 	if err := dec(in); err != nil {
@@ -628,6 +937,60 @@ func _CtlSvc_CollectLog_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CtlSvc_AuditQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).AuditQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_AuditQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).AuditQuery(ctx, req.(*AuditQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_ReloadCerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadCertsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).ReloadCerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_ReloadCerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).ReloadCerts(ctx, req.(*ReloadCertsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CtlSvc_Handshake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandshakeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CtlSvcServer).Handshake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CtlSvc_Handshake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CtlSvcServer).Handshake(ctx, req.(*HandshakeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CtlSvc_ServiceDesc is the grpc.ServiceDesc for CtlSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -651,6 +1014,22 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageNvmeAddDevice",
 			Handler:    _CtlSvc_StorageNvmeAddDevice_Handler,
 		},
+		{
+			MethodName: "StorageNvmeReplace",
+			Handler:    _CtlSvc_StorageNvmeReplace_Handler,
+		},
+		{
+			MethodName: "StorageNvmeNsCreate",
+			Handler:    _CtlSvc_StorageNvmeNsCreate_Handler,
+		},
+		{
+			MethodName: "StorageNvmeNsDelete",
+			Handler:    _CtlSvc_StorageNvmeNsDelete_Handler,
+		},
+		{
+			MethodName: "StorageRenderConfig",
+			Handler:    _CtlSvc_StorageRenderConfig_Handler,
+		},
 		{
 			MethodName: "NetworkScan",
 			Handler:    _CtlSvc_NetworkScan_Handler,
@@ -675,10 +1054,22 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetEngineLogMasks",
 			Handler:    _CtlSvc_SetEngineLogMasks_Handler,
 		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _CtlSvc_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "SetEngineStandby",
+			Handler:    _CtlSvc_SetEngineStandby_Handler,
+		},
 		{
 			MethodName: "PrepShutdownRanks",
 			Handler:    _CtlSvc_PrepShutdownRanks_Handler,
 		},
+		{
+			MethodName: "DrainRanks",
+			Handler:    _CtlSvc_DrainRanks_Handler,
+		},
 		{
 			MethodName: "StopRanks",
 			Handler:    _CtlSvc_StopRanks_Handler,
@@ -695,6 +1086,18 @@ var CtlSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CollectLog",
 			Handler:    _CtlSvc_CollectLog_Handler,
 		},
+		{
+			MethodName: "AuditQuery",
+			Handler:    _CtlSvc_AuditQuery_Handler,
+		},
+		{
+			MethodName: "ReloadCerts",
+			Handler:    _CtlSvc_ReloadCerts_Handler,
+		},
+		{
+			MethodName: "Handshake",
+			Handler:    _CtlSvc_Handshake_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ctl/ctl.proto",
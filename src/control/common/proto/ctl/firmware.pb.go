@@ -72,6 +72,55 @@ func (FirmwareUpdateReq_DeviceType) EnumDescriptor() ([]byte, []int) {
 	return file_ctl_firmware_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type FirmwareUpdateReq_ScmPhase int32
+
+const (
+	FirmwareUpdateReq_STAGE    FirmwareUpdateReq_ScmPhase = 0 // Write firmware image to SCM modules
+	FirmwareUpdateReq_VERIFY   FirmwareUpdateReq_ScmPhase = 1 // Check firmware image has been staged
+	FirmwareUpdateReq_ACTIVATE FirmwareUpdateReq_ScmPhase = 2 // Activate staged firmware
+)
+
+// Enum value maps for FirmwareUpdateReq_ScmPhase.
+var (
+	FirmwareUpdateReq_ScmPhase_name = map[int32]string{
+		0: "STAGE",
+		1: "VERIFY",
+		2: "ACTIVATE",
+	}
+	FirmwareUpdateReq_ScmPhase_value = map[string]int32{
+		"STAGE":    0,
+		"VERIFY":   1,
+		"ACTIVATE": 2,
+	}
+)
+
+func (x FirmwareUpdateReq_ScmPhase) Enum() *FirmwareUpdateReq_ScmPhase {
+	p := new(FirmwareUpdateReq_ScmPhase)
+	*p = x
+	return p
+}
+
+func (x FirmwareUpdateReq_ScmPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirmwareUpdateReq_ScmPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctl_firmware_proto_enumTypes[1].Descriptor()
+}
+
+func (FirmwareUpdateReq_ScmPhase) Type() protoreflect.EnumType {
+	return &file_ctl_firmware_proto_enumTypes[1]
+}
+
+func (x FirmwareUpdateReq_ScmPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirmwareUpdateReq_ScmPhase.Descriptor instead.
+func (FirmwareUpdateReq_ScmPhase) EnumDescriptor() ([]byte, []int) {
+	return file_ctl_firmware_proto_rawDescGZIP(), []int{4, 1}
+}
+
 type FirmwareQueryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -345,11 +394,14 @@ type FirmwareUpdateReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirmwarePath string                       `protobuf:"bytes,1,opt,name=firmwarePath,proto3" json:"firmwarePath,omitempty"`                        // Path to firmware file
-	Type         FirmwareUpdateReq_DeviceType `protobuf:"varint,2,opt,name=type,proto3,enum=ctl.FirmwareUpdateReq_DeviceType" json:"type,omitempty"` // Type of device this firmware applies to
-	DeviceIDs    []string                     `protobuf:"bytes,3,rep,name=deviceIDs,proto3" json:"deviceIDs,omitempty"`                              // Devices this update applies to
-	ModelID      string                       `protobuf:"bytes,4,opt,name=modelID,proto3" json:"modelID,omitempty"`                                  // Model ID this update applies to
-	FirmwareRev  string                       `protobuf:"bytes,5,opt,name=firmwareRev,proto3" json:"firmwareRev,omitempty"`                          // Starting FW rev this update applies to
+	FirmwarePath string                       `protobuf:"bytes,1,opt,name=firmwarePath,proto3" json:"firmwarePath,omitempty"`                              // Path to firmware file
+	Type         FirmwareUpdateReq_DeviceType `protobuf:"varint,2,opt,name=type,proto3,enum=ctl.FirmwareUpdateReq_DeviceType" json:"type,omitempty"`       // Type of device this firmware applies to
+	DeviceIDs    []string                     `protobuf:"bytes,3,rep,name=deviceIDs,proto3" json:"deviceIDs,omitempty"`                                    // Devices this update applies to
+	ModelID      string                       `protobuf:"bytes,4,opt,name=modelID,proto3" json:"modelID,omitempty"`                                        // Model ID this update applies to
+	FirmwareRev  string                       `protobuf:"bytes,5,opt,name=firmwareRev,proto3" json:"firmwareRev,omitempty"`                                // Starting FW rev this update applies to
+	Slot         uint32                       `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`                                             // NVMe firmware slot to update, 0 lets the controller choose
+	Stage        bool                         `protobuf:"varint,7,opt,name=stage,proto3" json:"stage,omitempty"`                                           // Stage NVMe firmware for activation on next controller reset
+	ScmPhase     FirmwareUpdateReq_ScmPhase   `protobuf:"varint,8,opt,name=scmPhase,proto3,enum=ctl.FirmwareUpdateReq_ScmPhase" json:"scmPhase,omitempty"` // Step of a staged SCM firmware update to perform
 }
 
 func (x *FirmwareUpdateReq) Reset() {
@@ -419,6 +471,27 @@ func (x *FirmwareUpdateReq) GetFirmwareRev() string {
 	return ""
 }
 
+func (x *FirmwareUpdateReq) GetSlot() uint32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *FirmwareUpdateReq) GetStage() bool {
+	if x != nil {
+		return x.Stage
+	}
+	return false
+}
+
+func (x *FirmwareUpdateReq) GetScmPhase() FirmwareUpdateReq_ScmPhase {
+	if x != nil {
+		return x.ScmPhase
+	}
+	return FirmwareUpdateReq_STAGE
+}
+
 type ScmFirmwareUpdateResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -630,7 +703,7 @@ var file_ctl_firmware_proto_rawDesc = []byte{
 	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
 	0x4e, 0x76, 0x6d, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x51, 0x75, 0x65, 0x72,
 	0x79, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0b, 0x6e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55,
+	0x74, 0x73, 0x22, 0x81, 0x03, 0x0a, 0x11, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x72, 0x6d,
 	0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
 	0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x35, 0x0a, 0x04,
@@ -642,33 +715,42 @@ var file_ctl_firmware_proto_rawDesc = []byte{
 	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x66,
 	0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x22, 0x1f, 0x0a,
-	0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x53,
-	0x43, 0x4d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x56, 0x4d, 0x65, 0x10, 0x01, 0x22, 0x55,
-	0x0a, 0x15, 0x53, 0x63, 0x6d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63,
-	0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x16, 0x4e, 0x76, 0x6d, 0x65, 0x46, 0x69, 0x72,
+	0x52, 0x0b, 0x66, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x63, 0x6d, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x2e, 0x53, 0x63, 0x6d, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x08, 0x73, 0x63, 0x6d, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x22, 0x1f, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x43, 0x4d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x56, 0x4d, 0x65, 0x10, 0x01, 0x22, 0x2f, 0x0a, 0x08, 0x53, 0x63, 0x6d, 0x50, 0x68, 0x61, 0x73,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x41, 0x54, 0x45, 0x10, 0x02, 0x22, 0x55, 0x0a, 0x15, 0x53, 0x63, 0x6d, 0x46, 0x69, 0x72,
 	0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x8f, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x73, 0x63, 0x6d, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x53, 0x63, 0x6d, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x73, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76,
-	0x6d, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x52, 0x0b, 0x6e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f,
-	0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x26, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a,
+	0x16, 0x4e, 0x76, 0x6d, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64,
+	0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a,
+	0x0a, 0x0a, 0x73, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x46, 0x69, 0x72, 0x6d,
+	0x77, 0x61, 0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a,
+	0x73, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x76,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x46, 0x69, 0x72, 0x6d, 0x77, 0x61,
+	0x72, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0b, 0x6e, 0x76,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -683,35 +765,37 @@ func file_ctl_firmware_proto_rawDescGZIP() []byte {
 	return file_ctl_firmware_proto_rawDescData
 }
 
-var file_ctl_firmware_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctl_firmware_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_ctl_firmware_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_ctl_firmware_proto_goTypes = []interface{}{
 	(FirmwareUpdateReq_DeviceType)(0), // 0: ctl.FirmwareUpdateReq.DeviceType
-	(*FirmwareQueryReq)(nil),          // 1: ctl.FirmwareQueryReq
-	(*ScmFirmwareQueryResp)(nil),      // 2: ctl.ScmFirmwareQueryResp
-	(*NvmeFirmwareQueryResp)(nil),     // 3: ctl.NvmeFirmwareQueryResp
-	(*FirmwareQueryResp)(nil),         // 4: ctl.FirmwareQueryResp
-	(*FirmwareUpdateReq)(nil),         // 5: ctl.FirmwareUpdateReq
-	(*ScmFirmwareUpdateResp)(nil),     // 6: ctl.ScmFirmwareUpdateResp
-	(*NvmeFirmwareUpdateResp)(nil),    // 7: ctl.NvmeFirmwareUpdateResp
-	(*FirmwareUpdateResp)(nil),        // 8: ctl.FirmwareUpdateResp
-	(*ScmModule)(nil),                 // 9: ctl.ScmModule
-	(*NvmeController)(nil),            // 10: ctl.NvmeController
+	(FirmwareUpdateReq_ScmPhase)(0),   // 1: ctl.FirmwareUpdateReq.ScmPhase
+	(*FirmwareQueryReq)(nil),          // 2: ctl.FirmwareQueryReq
+	(*ScmFirmwareQueryResp)(nil),      // 3: ctl.ScmFirmwareQueryResp
+	(*NvmeFirmwareQueryResp)(nil),     // 4: ctl.NvmeFirmwareQueryResp
+	(*FirmwareQueryResp)(nil),         // 5: ctl.FirmwareQueryResp
+	(*FirmwareUpdateReq)(nil),         // 6: ctl.FirmwareUpdateReq
+	(*ScmFirmwareUpdateResp)(nil),     // 7: ctl.ScmFirmwareUpdateResp
+	(*NvmeFirmwareUpdateResp)(nil),    // 8: ctl.NvmeFirmwareUpdateResp
+	(*FirmwareUpdateResp)(nil),        // 9: ctl.FirmwareUpdateResp
+	(*ScmModule)(nil),                 // 10: ctl.ScmModule
+	(*NvmeController)(nil),            // 11: ctl.NvmeController
 }
 var file_ctl_firmware_proto_depIdxs = []int32{
-	9,  // 0: ctl.ScmFirmwareQueryResp.module:type_name -> ctl.ScmModule
-	10, // 1: ctl.NvmeFirmwareQueryResp.device:type_name -> ctl.NvmeController
-	2,  // 2: ctl.FirmwareQueryResp.scmResults:type_name -> ctl.ScmFirmwareQueryResp
-	3,  // 3: ctl.FirmwareQueryResp.nvmeResults:type_name -> ctl.NvmeFirmwareQueryResp
+	10, // 0: ctl.ScmFirmwareQueryResp.module:type_name -> ctl.ScmModule
+	11, // 1: ctl.NvmeFirmwareQueryResp.device:type_name -> ctl.NvmeController
+	3,  // 2: ctl.FirmwareQueryResp.scmResults:type_name -> ctl.ScmFirmwareQueryResp
+	4,  // 3: ctl.FirmwareQueryResp.nvmeResults:type_name -> ctl.NvmeFirmwareQueryResp
 	0,  // 4: ctl.FirmwareUpdateReq.type:type_name -> ctl.FirmwareUpdateReq.DeviceType
-	9,  // 5: ctl.ScmFirmwareUpdateResp.module:type_name -> ctl.ScmModule
-	6,  // 6: ctl.FirmwareUpdateResp.scmResults:type_name -> ctl.ScmFirmwareUpdateResp
-	7,  // 7: ctl.FirmwareUpdateResp.nvmeResults:type_name -> ctl.NvmeFirmwareUpdateResp
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	1,  // 5: ctl.FirmwareUpdateReq.scmPhase:type_name -> ctl.FirmwareUpdateReq.ScmPhase
+	10, // 6: ctl.ScmFirmwareUpdateResp.module:type_name -> ctl.ScmModule
+	7,  // 7: ctl.FirmwareUpdateResp.scmResults:type_name -> ctl.ScmFirmwareUpdateResp
+	8,  // 8: ctl.FirmwareUpdateResp.nvmeResults:type_name -> ctl.NvmeFirmwareUpdateResp
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_ctl_firmware_proto_init() }
@@ -824,7 +908,7 @@ func file_ctl_firmware_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_firmware_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
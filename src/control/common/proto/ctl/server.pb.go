@@ -178,6 +178,216 @@ func (x *SetLogMasksResp) GetErrors() []string {
 	return nil
 }
 
+// ReloadConfigReq requests that the server re-reads its config file and applies changes to
+// parameters that can be updated while engines are running.
+type ReloadConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system name
+}
+
+func (x *ReloadConfigReq) Reset() {
+	*x = ReloadConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigReq) ProtoMessage() {}
+
+func (x *ReloadConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigReq.ProtoReflect.Descriptor instead.
+func (*ReloadConfigReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReloadConfigReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+// ReloadConfigResp returns the config changes that were applied.
+type ReloadConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []string `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // descriptions of applied changes
+}
+
+func (x *ReloadConfigResp) Reset() {
+	*x = ReloadConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloadConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResp) ProtoMessage() {}
+
+func (x *ReloadConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResp.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReloadConfigResp) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// SetEngineStandbyReq sets or clears standby mode for an engine. An engine in standby mode is not
+// started by the server until standby is cleared.
+type SetEngineStandbyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys       string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`                               // DAOS system name
+	EngineIdx uint32 `protobuf:"varint,2,opt,name=engine_idx,json=engineIdx,proto3" json:"engine_idx,omitempty"` // index of engine in server config
+	Standby   bool   `protobuf:"varint,3,opt,name=standby,proto3" json:"standby,omitempty"`                      // enter standby if true, leave standby if false
+}
+
+func (x *SetEngineStandbyReq) Reset() {
+	*x = SetEngineStandbyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEngineStandbyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEngineStandbyReq) ProtoMessage() {}
+
+func (x *SetEngineStandbyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEngineStandbyReq.ProtoReflect.Descriptor instead.
+func (*SetEngineStandbyReq) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetEngineStandbyReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *SetEngineStandbyReq) GetEngineIdx() uint32 {
+	if x != nil {
+		return x.EngineIdx
+	}
+	return 0
+}
+
+func (x *SetEngineStandbyReq) GetStandby() bool {
+	if x != nil {
+		return x.Standby
+	}
+	return false
+}
+
+// SetEngineStandbyResp returns the standby state of the engine after the request.
+type SetEngineStandbyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standby bool `protobuf:"varint,1,opt,name=standby,proto3" json:"standby,omitempty"` // engine is in standby mode
+}
+
+func (x *SetEngineStandbyResp) Reset() {
+	*x = SetEngineStandbyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetEngineStandbyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEngineStandbyResp) ProtoMessage() {}
+
+func (x *SetEngineStandbyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEngineStandbyResp.ProtoReflect.Descriptor instead.
+func (*SetEngineStandbyResp) Descriptor() ([]byte, []int) {
+	return file_ctl_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetEngineStandbyResp) GetStandby() bool {
+	if x != nil {
+		return x.Standby
+	}
+	return false
+}
+
 var File_ctl_server_proto protoreflect.FileDescriptor
 
 var file_ctl_server_proto_rawDesc = []byte{
@@ -201,11 +411,25 @@ var file_ctl_server_proto_rawDesc = []byte{
 	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
-	0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
-	0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73,
-	0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x22, 0x23, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -220,10 +444,14 @@ func file_ctl_server_proto_rawDescGZIP() []byte {
 	return file_ctl_server_proto_rawDescData
 }
 
-var file_ctl_server_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ctl_server_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_ctl_server_proto_goTypes = []interface{}{
-	(*SetLogMasksReq)(nil),  // 0: ctl.SetLogMasksReq
-	(*SetLogMasksResp)(nil), // 1: ctl.SetLogMasksResp
+	(*SetLogMasksReq)(nil),       // 0: ctl.SetLogMasksReq
+	(*SetLogMasksResp)(nil),      // 1: ctl.SetLogMasksResp
+	(*ReloadConfigReq)(nil),      // 2: ctl.ReloadConfigReq
+	(*ReloadConfigResp)(nil),     // 3: ctl.ReloadConfigResp
+	(*SetEngineStandbyReq)(nil),  // 4: ctl.SetEngineStandbyReq
+	(*SetEngineStandbyResp)(nil), // 5: ctl.SetEngineStandbyResp
 }
 var file_ctl_server_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -263,6 +491,54 @@ func file_ctl_server_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloadConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEngineStandbyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEngineStandbyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -270,7 +546,7 @@ func file_ctl_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
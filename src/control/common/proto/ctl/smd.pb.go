@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -714,6 +715,9 @@ type NvmeController struct {
 	PciDevType  string                      `protobuf:"bytes,11,opt,name=pci_dev_type,json=pciDevType,proto3" json:"pci_dev_type,omitempty"`               // PCI device type, vmd or pci
 	VendorId    string                      `protobuf:"bytes,12,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`                       // controller's vendor ID
 	PciCfg      string                      `protobuf:"bytes,13,opt,name=pci_cfg,json=pciCfg,proto3" json:"pci_cfg,omitempty"`                             // PCIe configuration space
+	Driver      string                      `protobuf:"bytes,14,opt,name=driver,proto3" json:"driver,omitempty"`                                           // kernel driver bound to PCI device
+	Binding     *DeviceBinding              `protobuf:"bytes,15,opt,name=binding,proto3" json:"binding,omitempty"`                                         // engine tier using controller, unset if unused
+	HealthTrend *NvmeHealthTrend            `protobuf:"bytes,16,opt,name=health_trend,json=healthTrend,proto3" json:"health_trend,omitempty"`              // wear trend from health history, unset if not retained
 }
 
 func (x *NvmeController) Reset() {
@@ -839,6 +843,139 @@ func (x *NvmeController) GetPciCfg() string {
 	return ""
 }
 
+func (x *NvmeController) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *NvmeController) GetBinding() *DeviceBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
+func (x *NvmeController) GetHealthTrend() *NvmeHealthTrend {
+	if x != nil {
+		return x.HealthTrend
+	}
+	return nil
+}
+
+// NvmeHealthTrend summarizes the wear of an NVMe controller over its retained health history.
+type NvmeHealthTrend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NrSamples      uint32   `protobuf:"varint,1,opt,name=nr_samples,json=nrSamples,proto3" json:"nr_samples,omitempty"`                // number of retained health samples
+	FirstTimestamp uint64   `protobuf:"varint,2,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"` // timestamp of oldest sample
+	LastTimestamp  uint64   `protobuf:"varint,3,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`    // timestamp of newest sample
+	HostWriteRate  float64  `protobuf:"fixed64,4,opt,name=host_write_rate,json=hostWriteRate,proto3" json:"host_write_rate,omitempty"` // host bytes written per second over the history
+	WriteAmp       float64  `protobuf:"fixed64,5,opt,name=write_amp,json=writeAmp,proto3" json:"write_amp,omitempty"`                  // ratio of NAND to host bytes written
+	WearUsed       uint32   `protobuf:"varint,6,opt,name=wear_used,json=wearUsed,proto3" json:"wear_used,omitempty"`                   // percentage of rated endurance used
+	RemainingSecs  uint64   `protobuf:"varint,7,opt,name=remaining_secs,json=remainingSecs,proto3" json:"remaining_secs,omitempty"`    // predicted seconds of endurance remaining, zero if unknown
+	FailureScore   uint32   `protobuf:"varint,8,opt,name=failure_score,json=failureScore,proto3" json:"failure_score,omitempty"`       // likelihood of device failure from 0 to 100
+	FailureReasons []string `protobuf:"bytes,9,rep,name=failure_reasons,json=failureReasons,proto3" json:"failure_reasons,omitempty"`  // reasons contributing to failure score
+}
+
+func (x *NvmeHealthTrend) Reset() {
+	*x = NvmeHealthTrend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_smd_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeHealthTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeHealthTrend) ProtoMessage() {}
+
+func (x *NvmeHealthTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_smd_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeHealthTrend.ProtoReflect.Descriptor instead.
+func (*NvmeHealthTrend) Descriptor() ([]byte, []int) {
+	return file_ctl_smd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NvmeHealthTrend) GetNrSamples() uint32 {
+	if x != nil {
+		return x.NrSamples
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetFirstTimestamp() uint64 {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetLastTimestamp() uint64 {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetHostWriteRate() float64 {
+	if x != nil {
+		return x.HostWriteRate
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetWriteAmp() float64 {
+	if x != nil {
+		return x.WriteAmp
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetWearUsed() uint32 {
+	if x != nil {
+		return x.WearUsed
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetRemainingSecs() uint64 {
+	if x != nil {
+		return x.RemainingSecs
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetFailureScore() uint32 {
+	if x != nil {
+		return x.FailureScore
+	}
+	return 0
+}
+
+func (x *NvmeHealthTrend) GetFailureReasons() []string {
+	if x != nil {
+		return x.FailureReasons
+	}
+	return nil
+}
+
 // SmdDevice represents a DAOS BIO device, identified by a UUID written into a label stored on a
 // SPDK blobstore created on a NVMe namespace. Multiple SmdDevices may exist per NVMe controller.
 type SmdDevice struct {
@@ -846,26 +983,27 @@ type SmdDevice struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid             string          `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                     // UUID of blobstore
-	TgtIds           []int32         `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`                           // VOS target IDs
-	TotalBytes       uint64          `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`                      // blobstore clusters total bytes
-	AvailBytes       uint64          `protobuf:"varint,7,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`                      // Available RAW storage for data
-	ClusterSize      uint64          `protobuf:"varint,8,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`                   // blobstore cluster size in bytes
-	Rank             uint32          `protobuf:"varint,9,opt,name=rank,proto3" json:"rank,omitempty"`                                                    // DAOS I/O Engine using controller
-	RoleBits         uint32          `protobuf:"varint,10,opt,name=role_bits,json=roleBits,proto3" json:"role_bits,omitempty"`                           // Device active roles (bitmask)
-	MetaSize         uint64          `protobuf:"varint,11,opt,name=meta_size,json=metaSize,proto3" json:"meta_size,omitempty"`                           // Size of the metadata (i.e. vos file index) blob
-	MetaWalSize      uint64          `protobuf:"varint,12,opt,name=meta_wal_size,json=metaWalSize,proto3" json:"meta_wal_size,omitempty"`                // Size of the metadata WAL blob
-	RdbSize          uint64          `protobuf:"varint,13,opt,name=rdb_size,json=rdbSize,proto3" json:"rdb_size,omitempty"`                              // Size of the RDB blob
-	RdbWalSize       uint64          `protobuf:"varint,14,opt,name=rdb_wal_size,json=rdbWalSize,proto3" json:"rdb_wal_size,omitempty"`                   // Size of the RDB WAL blob
-	UsableBytes      uint64          `protobuf:"varint,15,opt,name=usable_bytes,json=usableBytes,proto3" json:"usable_bytes,omitempty"`                  // Effective storage available for data
-	Ctrlr            *NvmeController `protobuf:"bytes,16,opt,name=ctrlr,proto3" json:"ctrlr,omitempty"`                                                  // Backing NVMe controller of SMD device
-	CtrlrNamespaceId uint32          `protobuf:"varint,17,opt,name=ctrlr_namespace_id,json=ctrlrNamespaceId,proto3" json:"ctrlr_namespace_id,omitempty"` // NVMe namespace id hosting SMD blobstore
+	Uuid             string              `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                                                     // UUID of blobstore
+	TgtIds           []int32             `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"`                           // VOS target IDs
+	TotalBytes       uint64              `protobuf:"varint,6,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`                      // blobstore clusters total bytes
+	AvailBytes       uint64              `protobuf:"varint,7,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"`                      // Available RAW storage for data
+	ClusterSize      uint64              `protobuf:"varint,8,opt,name=cluster_size,json=clusterSize,proto3" json:"cluster_size,omitempty"`                   // blobstore cluster size in bytes
+	Rank             uint32              `protobuf:"varint,9,opt,name=rank,proto3" json:"rank,omitempty"`                                                    // DAOS I/O Engine using controller
+	RoleBits         uint32              `protobuf:"varint,10,opt,name=role_bits,json=roleBits,proto3" json:"role_bits,omitempty"`                           // Device active roles (bitmask)
+	MetaSize         uint64              `protobuf:"varint,11,opt,name=meta_size,json=metaSize,proto3" json:"meta_size,omitempty"`                           // Size of the metadata (i.e. vos file index) blob
+	MetaWalSize      uint64              `protobuf:"varint,12,opt,name=meta_wal_size,json=metaWalSize,proto3" json:"meta_wal_size,omitempty"`                // Size of the metadata WAL blob
+	RdbSize          uint64              `protobuf:"varint,13,opt,name=rdb_size,json=rdbSize,proto3" json:"rdb_size,omitempty"`                              // Size of the RDB blob
+	RdbWalSize       uint64              `protobuf:"varint,14,opt,name=rdb_wal_size,json=rdbWalSize,proto3" json:"rdb_wal_size,omitempty"`                   // Size of the RDB WAL blob
+	UsableBytes      uint64              `protobuf:"varint,15,opt,name=usable_bytes,json=usableBytes,proto3" json:"usable_bytes,omitempty"`                  // Effective storage available for data
+	Ctrlr            *NvmeController     `protobuf:"bytes,16,opt,name=ctrlr,proto3" json:"ctrlr,omitempty"`                                                  // Backing NVMe controller of SMD device
+	CtrlrNamespaceId uint32              `protobuf:"varint,17,opt,name=ctrlr_namespace_id,json=ctrlrNamespaceId,proto3" json:"ctrlr_namespace_id,omitempty"` // NVMe namespace id hosting SMD blobstore
+	PoolUsage        []*PoolStorageUsage `protobuf:"bytes,18,rep,name=pool_usage,json=poolUsage,proto3" json:"pool_usage,omitempty"`                         // Capacity of blobstore used by each pool
 }
 
 func (x *SmdDevice) Reset() {
 	*x = SmdDevice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[3]
+		mi := &file_ctl_smd_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -878,7 +1016,7 @@ func (x *SmdDevice) String() string {
 func (*SmdDevice) ProtoMessage() {}
 
 func (x *SmdDevice) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[3]
+	mi := &file_ctl_smd_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -891,7 +1029,7 @@ func (x *SmdDevice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdDevice.ProtoReflect.Descriptor instead.
 func (*SmdDevice) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{3}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SmdDevice) GetUuid() string {
@@ -992,6 +1130,13 @@ func (x *SmdDevice) GetCtrlrNamespaceId() uint32 {
 	return 0
 }
 
+func (x *SmdDevice) GetPoolUsage() []*PoolStorageUsage {
+	if x != nil {
+		return x.PoolUsage
+	}
+	return nil
+}
+
 type SmdDevReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1001,7 +1146,7 @@ type SmdDevReq struct {
 func (x *SmdDevReq) Reset() {
 	*x = SmdDevReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[4]
+		mi := &file_ctl_smd_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1014,7 +1159,7 @@ func (x *SmdDevReq) String() string {
 func (*SmdDevReq) ProtoMessage() {}
 
 func (x *SmdDevReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[4]
+	mi := &file_ctl_smd_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1172,7 @@ func (x *SmdDevReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdDevReq.ProtoReflect.Descriptor instead.
 func (*SmdDevReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{4}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{5}
 }
 
 type SmdDevResp struct {
@@ -1042,7 +1187,7 @@ type SmdDevResp struct {
 func (x *SmdDevResp) Reset() {
 	*x = SmdDevResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[5]
+		mi := &file_ctl_smd_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1055,7 +1200,7 @@ func (x *SmdDevResp) String() string {
 func (*SmdDevResp) ProtoMessage() {}
 
 func (x *SmdDevResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[5]
+	mi := &file_ctl_smd_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1213,7 @@ func (x *SmdDevResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdDevResp.ProtoReflect.Descriptor instead.
 func (*SmdDevResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{5}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SmdDevResp) GetStatus() int32 {
@@ -1094,7 +1239,7 @@ type SmdPoolReq struct {
 func (x *SmdPoolReq) Reset() {
 	*x = SmdPoolReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[6]
+		mi := &file_ctl_smd_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1107,7 +1252,7 @@ func (x *SmdPoolReq) String() string {
 func (*SmdPoolReq) ProtoMessage() {}
 
 func (x *SmdPoolReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[6]
+	mi := &file_ctl_smd_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1265,7 @@ func (x *SmdPoolReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolReq.ProtoReflect.Descriptor instead.
 func (*SmdPoolReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{6}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{7}
 }
 
 type SmdPoolResp struct {
@@ -1135,7 +1280,7 @@ type SmdPoolResp struct {
 func (x *SmdPoolResp) Reset() {
 	*x = SmdPoolResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[7]
+		mi := &file_ctl_smd_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1148,7 +1293,7 @@ func (x *SmdPoolResp) String() string {
 func (*SmdPoolResp) ProtoMessage() {}
 
 func (x *SmdPoolResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[7]
+	mi := &file_ctl_smd_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1306,7 @@ func (x *SmdPoolResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolResp.ProtoReflect.Descriptor instead.
 func (*SmdPoolResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{7}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SmdPoolResp) GetStatus() int32 {
@@ -1193,7 +1338,7 @@ type SmdQueryReq struct {
 func (x *SmdQueryReq) Reset() {
 	*x = SmdQueryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[8]
+		mi := &file_ctl_smd_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1206,7 +1351,7 @@ func (x *SmdQueryReq) String() string {
 func (*SmdQueryReq) ProtoMessage() {}
 
 func (x *SmdQueryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[8]
+	mi := &file_ctl_smd_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1364,7 @@ func (x *SmdQueryReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryReq.ProtoReflect.Descriptor instead.
 func (*SmdQueryReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{8}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SmdQueryReq) GetOmitDevices() bool {
@@ -1269,7 +1414,7 @@ type SmdQueryResp struct {
 func (x *SmdQueryResp) Reset() {
 	*x = SmdQueryResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[9]
+		mi := &file_ctl_smd_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1282,7 +1427,7 @@ func (x *SmdQueryResp) String() string {
 func (*SmdQueryResp) ProtoMessage() {}
 
 func (x *SmdQueryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[9]
+	mi := &file_ctl_smd_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1295,7 +1440,7 @@ func (x *SmdQueryResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{9}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SmdQueryResp) GetStatus() int32 {
@@ -1326,7 +1471,7 @@ type LedManageReq struct {
 func (x *LedManageReq) Reset() {
 	*x = LedManageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[10]
+		mi := &file_ctl_smd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1339,7 +1484,7 @@ func (x *LedManageReq) String() string {
 func (*LedManageReq) ProtoMessage() {}
 
 func (x *LedManageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[10]
+	mi := &file_ctl_smd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1497,7 @@ func (x *LedManageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LedManageReq.ProtoReflect.Descriptor instead.
 func (*LedManageReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{10}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LedManageReq) GetIds() string {
@@ -1395,7 +1540,7 @@ type DevReplaceReq struct {
 func (x *DevReplaceReq) Reset() {
 	*x = DevReplaceReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[11]
+		mi := &file_ctl_smd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1408,7 +1553,7 @@ func (x *DevReplaceReq) String() string {
 func (*DevReplaceReq) ProtoMessage() {}
 
 func (x *DevReplaceReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[11]
+	mi := &file_ctl_smd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +1566,7 @@ func (x *DevReplaceReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevReplaceReq.ProtoReflect.Descriptor instead.
 func (*DevReplaceReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{11}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DevReplaceReq) GetOldDevUuid() string {
@@ -1449,7 +1594,7 @@ type SetFaultyReq struct {
 func (x *SetFaultyReq) Reset() {
 	*x = SetFaultyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[12]
+		mi := &file_ctl_smd_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1462,7 +1607,7 @@ func (x *SetFaultyReq) String() string {
 func (*SetFaultyReq) ProtoMessage() {}
 
 func (x *SetFaultyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[12]
+	mi := &file_ctl_smd_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1475,7 +1620,7 @@ func (x *SetFaultyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetFaultyReq.ProtoReflect.Descriptor instead.
 func (*SetFaultyReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{12}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SetFaultyReq) GetUuid() string {
@@ -1497,7 +1642,7 @@ type DevManageResp struct {
 func (x *DevManageResp) Reset() {
 	*x = DevManageResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[13]
+		mi := &file_ctl_smd_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1510,7 +1655,7 @@ func (x *DevManageResp) String() string {
 func (*DevManageResp) ProtoMessage() {}
 
 func (x *DevManageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[13]
+	mi := &file_ctl_smd_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1523,7 +1668,7 @@ func (x *DevManageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DevManageResp.ProtoReflect.Descriptor instead.
 func (*DevManageResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{13}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DevManageResp) GetStatus() int32 {
@@ -1546,7 +1691,6 @@ type SmdManageReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Op:
-	//
 	//	*SmdManageReq_Led
 	//	*SmdManageReq_Replace
 	//	*SmdManageReq_Faulty
@@ -1556,7 +1700,7 @@ type SmdManageReq struct {
 func (x *SmdManageReq) Reset() {
 	*x = SmdManageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[14]
+		mi := &file_ctl_smd_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1569,7 +1713,7 @@ func (x *SmdManageReq) String() string {
 func (*SmdManageReq) ProtoMessage() {}
 
 func (x *SmdManageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[14]
+	mi := &file_ctl_smd_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1582,7 +1726,7 @@ func (x *SmdManageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageReq.ProtoReflect.Descriptor instead.
 func (*SmdManageReq) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{14}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{15}
 }
 
 func (m *SmdManageReq) GetOp() isSmdManageReq_Op {
@@ -1646,7 +1790,7 @@ type SmdManageResp struct {
 func (x *SmdManageResp) Reset() {
 	*x = SmdManageResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[15]
+		mi := &file_ctl_smd_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1659,7 +1803,7 @@ func (x *SmdManageResp) String() string {
 func (*SmdManageResp) ProtoMessage() {}
 
 func (x *SmdManageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[15]
+	mi := &file_ctl_smd_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,7 +1816,7 @@ func (x *SmdManageResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp.ProtoReflect.Descriptor instead.
 func (*SmdManageResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{15}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SmdManageResp) GetRanks() []*SmdManageResp_RankResp {
@@ -1691,12 +1835,13 @@ type NvmeController_Namespace struct {
 	Id           uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                          // namespace id
 	Size         uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                                      // device capacity in bytes
 	CtrlrPciAddr string `protobuf:"bytes,3,opt,name=ctrlr_pci_addr,json=ctrlrPciAddr,proto3" json:"ctrlr_pci_addr,omitempty"` // parent controller PCI address
+	Zoned        bool   `protobuf:"varint,4,opt,name=zoned,proto3" json:"zoned,omitempty"`                                    // zoned namespace (ZNS) command set
 }
 
 func (x *NvmeController_Namespace) Reset() {
 	*x = NvmeController_Namespace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[16]
+		mi := &file_ctl_smd_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1709,7 +1854,7 @@ func (x *NvmeController_Namespace) String() string {
 func (*NvmeController_Namespace) ProtoMessage() {}
 
 func (x *NvmeController_Namespace) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[16]
+	mi := &file_ctl_smd_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,20 +1891,29 @@ func (x *NvmeController_Namespace) GetCtrlrPciAddr() string {
 	return ""
 }
 
+func (x *NvmeController_Namespace) GetZoned() bool {
+	if x != nil {
+		return x.Zoned
+	}
+	return false
+}
+
 type SmdPoolResp_Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid   string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                           // UUID of VOS pool
-	TgtIds []int32  `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"` // VOS target IDs
-	Blobs  []uint64 `protobuf:"varint,3,rep,packed,name=blobs,proto3" json:"blobs,omitempty"`                 // SPDK blobs
+	Uuid     string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`                           // UUID of VOS pool
+	TgtIds   []int32  `protobuf:"varint,2,rep,packed,name=tgt_ids,json=tgtIds,proto3" json:"tgt_ids,omitempty"` // VOS target IDs
+	Blobs    []uint64 `protobuf:"varint,3,rep,packed,name=blobs,proto3" json:"blobs,omitempty"`                 // SPDK blobs
+	ScmSize  uint64   `protobuf:"varint,4,opt,name=scm_size,json=scmSize,proto3" json:"scm_size,omitempty"`     // Size of the VOS file of each target
+	BlobSize uint64   `protobuf:"varint,5,opt,name=blob_size,json=blobSize,proto3" json:"blob_size,omitempty"`  // Size of the data blob of each target
 }
 
 func (x *SmdPoolResp_Pool) Reset() {
 	*x = SmdPoolResp_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[17]
+		mi := &file_ctl_smd_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1772,7 +1926,7 @@ func (x *SmdPoolResp_Pool) String() string {
 func (*SmdPoolResp_Pool) ProtoMessage() {}
 
 func (x *SmdPoolResp_Pool) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[17]
+	mi := &file_ctl_smd_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1785,7 +1939,7 @@ func (x *SmdPoolResp_Pool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdPoolResp_Pool.ProtoReflect.Descriptor instead.
 func (*SmdPoolResp_Pool) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{7, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *SmdPoolResp_Pool) GetUuid() string {
@@ -1809,6 +1963,20 @@ func (x *SmdPoolResp_Pool) GetBlobs() []uint64 {
 	return nil
 }
 
+func (x *SmdPoolResp_Pool) GetScmSize() uint64 {
+	if x != nil {
+		return x.ScmSize
+	}
+	return 0
+}
+
+func (x *SmdPoolResp_Pool) GetBlobSize() uint64 {
+	if x != nil {
+		return x.BlobSize
+	}
+	return 0
+}
+
 type SmdQueryResp_Pool struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1822,7 +1990,7 @@ type SmdQueryResp_Pool struct {
 func (x *SmdQueryResp_Pool) Reset() {
 	*x = SmdQueryResp_Pool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[18]
+		mi := &file_ctl_smd_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1835,7 +2003,7 @@ func (x *SmdQueryResp_Pool) String() string {
 func (*SmdQueryResp_Pool) ProtoMessage() {}
 
 func (x *SmdQueryResp_Pool) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[18]
+	mi := &file_ctl_smd_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +2016,7 @@ func (x *SmdQueryResp_Pool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp_Pool.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp_Pool) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{9, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *SmdQueryResp_Pool) GetUuid() string {
@@ -1885,7 +2053,7 @@ type SmdQueryResp_RankResp struct {
 func (x *SmdQueryResp_RankResp) Reset() {
 	*x = SmdQueryResp_RankResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[19]
+		mi := &file_ctl_smd_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1898,7 +2066,7 @@ func (x *SmdQueryResp_RankResp) String() string {
 func (*SmdQueryResp_RankResp) ProtoMessage() {}
 
 func (x *SmdQueryResp_RankResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[19]
+	mi := &file_ctl_smd_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2079,7 @@ func (x *SmdQueryResp_RankResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdQueryResp_RankResp.ProtoReflect.Descriptor instead.
 func (*SmdQueryResp_RankResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{9, 1}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{10, 1}
 }
 
 func (x *SmdQueryResp_RankResp) GetRank() uint32 {
@@ -1947,7 +2115,7 @@ type SmdManageResp_Result struct {
 func (x *SmdManageResp_Result) Reset() {
 	*x = SmdManageResp_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[20]
+		mi := &file_ctl_smd_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1960,7 +2128,7 @@ func (x *SmdManageResp_Result) String() string {
 func (*SmdManageResp_Result) ProtoMessage() {}
 
 func (x *SmdManageResp_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[20]
+	mi := &file_ctl_smd_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2141,7 @@ func (x *SmdManageResp_Result) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp_Result.ProtoReflect.Descriptor instead.
 func (*SmdManageResp_Result) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{15, 0}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *SmdManageResp_Result) GetStatus() int32 {
@@ -2002,7 +2170,7 @@ type SmdManageResp_RankResp struct {
 func (x *SmdManageResp_RankResp) Reset() {
 	*x = SmdManageResp_RankResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_smd_proto_msgTypes[21]
+		mi := &file_ctl_smd_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2015,7 +2183,7 @@ func (x *SmdManageResp_RankResp) String() string {
 func (*SmdManageResp_RankResp) ProtoMessage() {}
 
 func (x *SmdManageResp_RankResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_smd_proto_msgTypes[21]
+	mi := &file_ctl_smd_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,7 +2196,7 @@ func (x *SmdManageResp_RankResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmdManageResp_RankResp.ProtoReflect.Descriptor instead.
 func (*SmdManageResp_RankResp) Descriptor() ([]byte, []int) {
-	return file_ctl_smd_proto_rawDescGZIP(), []int{15, 1}
+	return file_ctl_smd_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *SmdManageResp_RankResp) GetRank() uint32 {
@@ -2049,225 +2217,264 @@ var File_ctl_smd_proto protoreflect.FileDescriptor
 
 var file_ctl_smd_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x03, 0x63, 0x74, 0x6c, 0x22, 0x61, 0x0a, 0x0c, 0x42, 0x69, 0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x72, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x72, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x97, 0x10, 0x0a, 0x0d, 0x42, 0x69, 0x6f, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6e, 0x5f,
-	0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x77, 0x61, 0x72, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x63, 0x72, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x74, 0x72, 0x6c, 0x5f, 0x62, 0x75, 0x73, 0x79,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x74, 0x72,
-	0x6c, 0x42, 0x75, 0x73, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x77,
-	0x65, 0x72, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x48, 0x6f, 0x75,
-	0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x73, 0x68, 0x75,
-	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x6e,
-	0x73, 0x61, 0x66, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x72, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x65, 0x72, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x69, 0x6f, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x69, 0x6f,
-	0x52, 0x65, 0x61, 0x64, 0x45, 0x72, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x62, 0x69, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x73, 0x12, 0x24,
-	0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x5f, 0x65, 0x72, 0x72, 0x73,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x69, 0x6f, 0x55, 0x6e, 0x6d, 0x61, 0x70,
-	0x45, 0x72, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
-	0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
-	0x65, 0x6d, 0x70, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x74, 0x65, 0x6d, 0x70, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x5f, 0x73, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x53, 0x70, 0x61, 0x72, 0x65, 0x57, 0x61,
-	0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x12, 0x64, 0x65, 0x76, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x57, 0x61, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c,
-	0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65,
-	0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x6f,
-	0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x4d,
-	0x65, 0x6d, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6e, 0x74, 0x5f,
-	0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x2f,
-	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63,
-	0x6e, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x52, 0x61, 0x77, 0x12,
-	0x2d, 0x0a, 0x13, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6e,
-	0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x65, 0x72,
-	0x61, 0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d, 0x12, 0x2b,
-	0x0a, 0x12, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6e, 0x74,
-	0x5f, 0x72, 0x61, 0x77, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x72, 0x61, 0x73,
-	0x65, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x52, 0x61, 0x77, 0x12, 0x33, 0x0a, 0x16, 0x77,
-	0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74,
-	0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x77, 0x65, 0x61,
-	0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d,
-	0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74,
-	0x4d, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x21, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67,
-	0x43, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x61, 0x76, 0x67, 0x18,
-	0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x12, 0x2f, 0x0a, 0x14, 0x65, 0x6e, 0x64,
-	0x74, 0x6f, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x61,
-	0x77, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x74, 0x6f, 0x65, 0x6e,
-	0x64, 0x45, 0x72, 0x72, 0x43, 0x6e, 0x74, 0x52, 0x61, 0x77, 0x12, 0x25, 0x0a, 0x0f, 0x63, 0x72,
-	0x63, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x24, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x72, 0x63, 0x45, 0x72, 0x72, 0x43, 0x6e, 0x74, 0x52, 0x61,
-	0x77, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x77, 0x65, 0x61, 0x72, 0x5f,
-	0x72, 0x61, 0x77, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x57, 0x65, 0x61, 0x72, 0x52, 0x61, 0x77, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x26, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x52, 0x61, 0x77, 0x12, 0x2c, 0x0a,
-	0x12, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
-	0x72, 0x61, 0x77, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
-	0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x61, 0x77, 0x12, 0x36, 0x0a, 0x17, 0x74,
-	0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x74, 0x68,
-	0x65, 0x72, 0x6d, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x74,
-	0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6e,
-	0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c,
-	0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6e, 0x74,
-	0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6e, 0x74, 0x18, 0x2a, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x70,
-	0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x6e, 0x74,
-	0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x6c, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x4c,
-	0x6f, 0x73, 0x73, 0x43, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6e, 0x64, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x2c, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x10, 0x6e, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
-	0x74, 0x74, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74,
-	0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x77, 0x61,
-	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65,
-	0x74, 0x61, 0x57, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x64, 0x62,
-	0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x72, 0x64, 0x62, 0x57, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6c,
-	0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x31, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a,
-	0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18,
-	0x32, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x61, 0x78, 0x53, 0x70,
-	0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e,
-	0x6b, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x5f, 0x6e, 0x65, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x65, 0x67, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x65, 0x67, 0x5f, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x65, 0x67,
-	0x57, 0x69, 0x64, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10,
-	0x03, 0x22, 0xbf, 0x04, 0x0a, 0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x15, 0x0a,
-	0x06, 0x66, 0x77, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66,
-	0x77, 0x52, 0x65, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x35, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x42, 0x69,
-	0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0b, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x73, 0x6d, 0x64, 0x5f, 0x64,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x73, 0x6d,
-	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x44, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08,
-	0x64, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x63, 0x74,
-	0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x63, 0x69, 0x5f, 0x64, 0x65, 0x76, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63, 0x69, 0x44,
-	0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f,
-	0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x63, 0x69, 0x5f, 0x63, 0x66, 0x67, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x63, 0x69, 0x43, 0x66, 0x67, 0x1a, 0x55, 0x0a, 0x09,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x50, 0x63, 0x69, 0x41,
-	0x64, 0x64, 0x72, 0x22, 0xda, 0x03, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53,
-	0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
-	0x62, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65,
-	0x42, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x57, 0x61,
-	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x72, 0x64, 0x62, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x64, 0x62, 0x57, 0x61, 0x6c, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x05, 0x63, 0x74, 0x72, 0x6c, 0x72,
-	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x74,
-	0x72, 0x6c, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x4a, 0x04,
-	0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
-	0x22, 0x0b, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a,
-	0x0a, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x0c, 0x0a,
-	0x0a, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x22, 0x9d, 0x01, 0x0a, 0x0b,
-	0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
-	0x1a, 0x49, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74,
-	0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0b,
+	0x03, 0x63, 0x74, 0x6c, 0x1a, 0x10, 0x63, 0x74, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x61, 0x0a, 0x0c, 0x42, 0x69, 0x6f, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x97, 0x10, 0x0a, 0x0d, 0x42, 0x69,
+	0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x61, 0x72,
+	0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x63, 0x72, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x74, 0x72, 0x6c, 0x5f, 0x62, 0x75,
+	0x73, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63,
+	0x74, 0x72, 0x6c, 0x42, 0x75, 0x73, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x73,
+	0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x53, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x45, 0x72, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x65, 0x72, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x4c, 0x6f, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x69, 0x6f, 0x5f, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62,
+	0x69, 0x6f, 0x52, 0x65, 0x61, 0x64, 0x45, 0x72, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x69,
+	0x6f, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x62, 0x69, 0x6f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x62, 0x69, 0x6f, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x70, 0x5f, 0x65, 0x72,
+	0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x69, 0x6f, 0x55, 0x6e, 0x6d,
+	0x61, 0x70, 0x45, 0x72, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x72, 0x65, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x53, 0x70, 0x61, 0x72, 0x65,
+	0x57, 0x61, 0x72, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x65, 0x76, 0x5f, 0x72, 0x65, 0x6c, 0x69,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x64, 0x65, 0x76, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f,
+	0x6e, 0x6c, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
+	0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x77, 0x61, 0x72,
+	0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x6d, 0x57, 0x61, 0x72, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x76, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x31, 0x0a,
+	0x15, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6e,
+	0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d,
+	0x12, 0x2f, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x52, 0x61,
+	0x77, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f,
+	0x63, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x65, 0x72, 0x61, 0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x4e, 0x6f, 0x72, 0x6d,
+	0x12, 0x2b, 0x0a, 0x12, 0x65, 0x72, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x63,
+	0x6e, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x72,
+	0x61, 0x73, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x43, 0x6e, 0x74, 0x52, 0x61, 0x77, 0x12, 0x33, 0x0a,
+	0x16, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x72, 0x6d, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x77,
+	0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74, 0x4e, 0x6f,
+	0x72, 0x6d, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43,
+	0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x69,
+	0x6e, 0x67, 0x43, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x61, 0x72,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x61, 0x76,
+	0x67, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x77, 0x65, 0x61, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x69, 0x6e, 0x67, 0x43, 0x6e, 0x74, 0x41, 0x76, 0x67, 0x12, 0x2f, 0x0a, 0x14, 0x65,
+	0x6e, 0x64, 0x74, 0x6f, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6e, 0x74, 0x5f,
+	0x72, 0x61, 0x77, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x6e, 0x64, 0x74, 0x6f,
+	0x65, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x43, 0x6e, 0x74, 0x52, 0x61, 0x77, 0x12, 0x25, 0x0a, 0x0f,
+	0x63, 0x72, 0x63, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x77, 0x18,
+	0x24, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x72, 0x63, 0x45, 0x72, 0x72, 0x43, 0x6e, 0x74,
+	0x52, 0x61, 0x77, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x77, 0x65, 0x61,
+	0x72, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x57, 0x65, 0x61, 0x72, 0x52, 0x61, 0x77, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x6f, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x73, 0x52, 0x61, 0x77, 0x12,
+	0x2c, 0x0a, 0x12, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x5f, 0x72, 0x61, 0x77, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x77, 0x6f, 0x72,
+	0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x61, 0x77, 0x12, 0x36, 0x0a,
+	0x17, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15,
+	0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c,
+	0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x63, 0x6e, 0x74, 0x18, 0x29, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x74, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6e, 0x74, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x11, 0x70, 0x6c, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x63,
+	0x6e, 0x74, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x6c, 0x6c, 0x4c, 0x6f, 0x63,
+	0x6b, 0x4c, 0x6f, 0x73, 0x73, 0x43, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x61, 0x6e, 0x64,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x2c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57,
+	0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x2d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x57, 0x72, 0x69,
+	0x74, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6d, 0x65, 0x74, 0x61, 0x57, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72,
+	0x64, 0x62, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x30, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x64, 0x62, 0x57, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x31, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x32, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4d, 0x61, 0x78,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c,
+	0x69, 0x6e, 0x6b, 0x4d, 0x61, 0x78, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x65, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4e, 0x65, 0x67, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6e, 0x65, 0x67, 0x5f, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x4e,
+	0x65, 0x67, 0x57, 0x69, 0x64, 0x74, 0x68, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x22, 0xd4, 0x05, 0x0a, 0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x15, 0x0a, 0x06, 0x66, 0x77, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x77, 0x52, 0x65, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x42, 0x69, 0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0b, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x73, 0x6d, 0x64,
+	0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0a,
+	0x73, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x65,
+	0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x44, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x09, 0x6c, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x4c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6c, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x63, 0x69, 0x5f, 0x64, 0x65,
+	0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63,
+	0x69, 0x44, 0x65, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x63, 0x69, 0x5f, 0x63, 0x66, 0x67,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x63, 0x69, 0x43, 0x66, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x74,
+	0x72, 0x65, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x1a, 0x6b, 0x0a,
+	0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x50, 0x63, 0x69,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65, 0x64, 0x22, 0xd7, 0x02, 0x0a, 0x0f, 0x4e,
+	0x76, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x72, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6e, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a,
+	0x0f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x61,
+	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41,
+	0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x77, 0x65, 0x61, 0x72, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x63, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x04, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65,
+	0x5f, 0x62, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x65, 0x74, 0x61, 0x57,
+	0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x64, 0x62, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x64, 0x62, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x64, 0x62, 0x5f, 0x77, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72, 0x64, 0x62, 0x57, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x05, 0x63, 0x74, 0x72, 0x6c,
+	0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x74, 0x72, 0x6c, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63,
+	0x74, 0x72, 0x6c, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12,
+	0x34, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10,
+	0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x0b, 0x0a, 0x09, 0x53, 0x6d, 0x64, 0x44, 0x65,
+	0x76, 0x52, 0x65, 0x71, 0x22, 0x4e, 0x0a, 0x0a, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x6d, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x22, 0xd6, 0x01, 0x0a, 0x0b, 0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
+	0x53, 0x6d, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x67, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x74, 0x67, 0x74, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x63, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x63, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0b,
 	0x53, 0x6d, 0x64, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x6f,
 	0x6d, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1d,
@@ -2375,7 +2582,7 @@ func file_ctl_smd_proto_rawDescGZIP() []byte {
 }
 
 var file_ctl_smd_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ctl_smd_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_ctl_smd_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_ctl_smd_proto_goTypes = []interface{}{
 	(NvmeDevState)(0),                // 0: ctl.NvmeDevState
 	(LedState)(0),                    // 1: ctl.LedState
@@ -2383,52 +2590,58 @@ var file_ctl_smd_proto_goTypes = []interface{}{
 	(*BioHealthReq)(nil),             // 3: ctl.BioHealthReq
 	(*BioHealthResp)(nil),            // 4: ctl.BioHealthResp
 	(*NvmeController)(nil),           // 5: ctl.NvmeController
-	(*SmdDevice)(nil),                // 6: ctl.SmdDevice
-	(*SmdDevReq)(nil),                // 7: ctl.SmdDevReq
-	(*SmdDevResp)(nil),               // 8: ctl.SmdDevResp
-	(*SmdPoolReq)(nil),               // 9: ctl.SmdPoolReq
-	(*SmdPoolResp)(nil),              // 10: ctl.SmdPoolResp
-	(*SmdQueryReq)(nil),              // 11: ctl.SmdQueryReq
-	(*SmdQueryResp)(nil),             // 12: ctl.SmdQueryResp
-	(*LedManageReq)(nil),             // 13: ctl.LedManageReq
-	(*DevReplaceReq)(nil),            // 14: ctl.DevReplaceReq
-	(*SetFaultyReq)(nil),             // 15: ctl.SetFaultyReq
-	(*DevManageResp)(nil),            // 16: ctl.DevManageResp
-	(*SmdManageReq)(nil),             // 17: ctl.SmdManageReq
-	(*SmdManageResp)(nil),            // 18: ctl.SmdManageResp
-	(*NvmeController_Namespace)(nil), // 19: ctl.NvmeController.Namespace
-	(*SmdPoolResp_Pool)(nil),         // 20: ctl.SmdPoolResp.Pool
-	(*SmdQueryResp_Pool)(nil),        // 21: ctl.SmdQueryResp.Pool
-	(*SmdQueryResp_RankResp)(nil),    // 22: ctl.SmdQueryResp.RankResp
-	(*SmdManageResp_Result)(nil),     // 23: ctl.SmdManageResp.Result
-	(*SmdManageResp_RankResp)(nil),   // 24: ctl.SmdManageResp.RankResp
+	(*NvmeHealthTrend)(nil),          // 6: ctl.NvmeHealthTrend
+	(*SmdDevice)(nil),                // 7: ctl.SmdDevice
+	(*SmdDevReq)(nil),                // 8: ctl.SmdDevReq
+	(*SmdDevResp)(nil),               // 9: ctl.SmdDevResp
+	(*SmdPoolReq)(nil),               // 10: ctl.SmdPoolReq
+	(*SmdPoolResp)(nil),              // 11: ctl.SmdPoolResp
+	(*SmdQueryReq)(nil),              // 12: ctl.SmdQueryReq
+	(*SmdQueryResp)(nil),             // 13: ctl.SmdQueryResp
+	(*LedManageReq)(nil),             // 14: ctl.LedManageReq
+	(*DevReplaceReq)(nil),            // 15: ctl.DevReplaceReq
+	(*SetFaultyReq)(nil),             // 16: ctl.SetFaultyReq
+	(*DevManageResp)(nil),            // 17: ctl.DevManageResp
+	(*SmdManageReq)(nil),             // 18: ctl.SmdManageReq
+	(*SmdManageResp)(nil),            // 19: ctl.SmdManageResp
+	(*NvmeController_Namespace)(nil), // 20: ctl.NvmeController.Namespace
+	(*SmdPoolResp_Pool)(nil),         // 21: ctl.SmdPoolResp.Pool
+	(*SmdQueryResp_Pool)(nil),        // 22: ctl.SmdQueryResp.Pool
+	(*SmdQueryResp_RankResp)(nil),    // 23: ctl.SmdQueryResp.RankResp
+	(*SmdManageResp_Result)(nil),     // 24: ctl.SmdManageResp.Result
+	(*SmdManageResp_RankResp)(nil),   // 25: ctl.SmdManageResp.RankResp
+	(*DeviceBinding)(nil),            // 26: ctl.DeviceBinding
+	(*PoolStorageUsage)(nil),         // 27: ctl.PoolStorageUsage
 }
 var file_ctl_smd_proto_depIdxs = []int32{
 	4,  // 0: ctl.NvmeController.health_stats:type_name -> ctl.BioHealthResp
-	19, // 1: ctl.NvmeController.namespaces:type_name -> ctl.NvmeController.Namespace
-	6,  // 2: ctl.NvmeController.smd_devices:type_name -> ctl.SmdDevice
+	20, // 1: ctl.NvmeController.namespaces:type_name -> ctl.NvmeController.Namespace
+	7,  // 2: ctl.NvmeController.smd_devices:type_name -> ctl.SmdDevice
 	0,  // 3: ctl.NvmeController.dev_state:type_name -> ctl.NvmeDevState
 	1,  // 4: ctl.NvmeController.led_state:type_name -> ctl.LedState
-	5,  // 5: ctl.SmdDevice.ctrlr:type_name -> ctl.NvmeController
-	6,  // 6: ctl.SmdDevResp.devices:type_name -> ctl.SmdDevice
-	20, // 7: ctl.SmdPoolResp.pools:type_name -> ctl.SmdPoolResp.Pool
-	22, // 8: ctl.SmdQueryResp.ranks:type_name -> ctl.SmdQueryResp.RankResp
-	2,  // 9: ctl.LedManageReq.led_action:type_name -> ctl.LedAction
-	1,  // 10: ctl.LedManageReq.led_state:type_name -> ctl.LedState
-	6,  // 11: ctl.DevManageResp.device:type_name -> ctl.SmdDevice
-	13, // 12: ctl.SmdManageReq.led:type_name -> ctl.LedManageReq
-	14, // 13: ctl.SmdManageReq.replace:type_name -> ctl.DevReplaceReq
-	15, // 14: ctl.SmdManageReq.faulty:type_name -> ctl.SetFaultyReq
-	24, // 15: ctl.SmdManageResp.ranks:type_name -> ctl.SmdManageResp.RankResp
-	6,  // 16: ctl.SmdQueryResp.RankResp.devices:type_name -> ctl.SmdDevice
-	21, // 17: ctl.SmdQueryResp.RankResp.pools:type_name -> ctl.SmdQueryResp.Pool
-	6,  // 18: ctl.SmdManageResp.Result.device:type_name -> ctl.SmdDevice
-	23, // 19: ctl.SmdManageResp.RankResp.results:type_name -> ctl.SmdManageResp.Result
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	26, // 5: ctl.NvmeController.binding:type_name -> ctl.DeviceBinding
+	6,  // 6: ctl.NvmeController.health_trend:type_name -> ctl.NvmeHealthTrend
+	5,  // 7: ctl.SmdDevice.ctrlr:type_name -> ctl.NvmeController
+	27, // 8: ctl.SmdDevice.pool_usage:type_name -> ctl.PoolStorageUsage
+	7,  // 9: ctl.SmdDevResp.devices:type_name -> ctl.SmdDevice
+	21, // 10: ctl.SmdPoolResp.pools:type_name -> ctl.SmdPoolResp.Pool
+	23, // 11: ctl.SmdQueryResp.ranks:type_name -> ctl.SmdQueryResp.RankResp
+	2,  // 12: ctl.LedManageReq.led_action:type_name -> ctl.LedAction
+	1,  // 13: ctl.LedManageReq.led_state:type_name -> ctl.LedState
+	7,  // 14: ctl.DevManageResp.device:type_name -> ctl.SmdDevice
+	14, // 15: ctl.SmdManageReq.led:type_name -> ctl.LedManageReq
+	15, // 16: ctl.SmdManageReq.replace:type_name -> ctl.DevReplaceReq
+	16, // 17: ctl.SmdManageReq.faulty:type_name -> ctl.SetFaultyReq
+	25, // 18: ctl.SmdManageResp.ranks:type_name -> ctl.SmdManageResp.RankResp
+	7,  // 19: ctl.SmdQueryResp.RankResp.devices:type_name -> ctl.SmdDevice
+	22, // 20: ctl.SmdQueryResp.RankResp.pools:type_name -> ctl.SmdQueryResp.Pool
+	7,  // 21: ctl.SmdManageResp.Result.device:type_name -> ctl.SmdDevice
+	24, // 22: ctl.SmdManageResp.RankResp.results:type_name -> ctl.SmdManageResp.Result
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_ctl_smd_proto_init() }
@@ -2436,6 +2649,7 @@ func file_ctl_smd_proto_init() {
 	if File_ctl_smd_proto != nil {
 		return
 	}
+	file_ctl_common_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ctl_smd_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BioHealthReq); i {
@@ -2474,7 +2688,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdDevice); i {
+			switch v := v.(*NvmeHealthTrend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2486,7 +2700,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdDevReq); i {
+			switch v := v.(*SmdDevice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,7 +2712,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdDevResp); i {
+			switch v := v.(*SmdDevReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,7 +2724,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolReq); i {
+			switch v := v.(*SmdDevResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,7 +2736,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolResp); i {
+			switch v := v.(*SmdPoolReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2534,7 +2748,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryReq); i {
+			switch v := v.(*SmdPoolResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2546,7 +2760,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp); i {
+			switch v := v.(*SmdQueryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2558,7 +2772,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LedManageReq); i {
+			switch v := v.(*SmdQueryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2570,7 +2784,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevReplaceReq); i {
+			switch v := v.(*LedManageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,7 +2796,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFaultyReq); i {
+			switch v := v.(*DevReplaceReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,7 +2808,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevManageResp); i {
+			switch v := v.(*SetFaultyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,7 +2820,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageReq); i {
+			switch v := v.(*DevManageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,7 +2832,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageResp); i {
+			switch v := v.(*SmdManageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,7 +2844,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NvmeController_Namespace); i {
+			switch v := v.(*SmdManageResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,7 +2856,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdPoolResp_Pool); i {
+			switch v := v.(*NvmeController_Namespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,7 +2868,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp_Pool); i {
+			switch v := v.(*SmdPoolResp_Pool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,7 +2880,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdQueryResp_RankResp); i {
+			switch v := v.(*SmdQueryResp_Pool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,7 +2892,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SmdManageResp_Result); i {
+			switch v := v.(*SmdQueryResp_RankResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,6 +2904,18 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 		file_ctl_smd_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmdManageResp_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_smd_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SmdManageResp_RankResp); i {
 			case 0:
 				return &v.state
@@ -2702,7 +2928,7 @@ func file_ctl_smd_proto_init() {
 			}
 		}
 	}
-	file_ctl_smd_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_ctl_smd_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*SmdManageReq_Led)(nil),
 		(*SmdManageReq_Replace)(nil),
 		(*SmdManageReq_Faulty)(nil),
@@ -2713,7 +2939,7 @@ func file_ctl_smd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_smd_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   22,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,13 +27,73 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Stages of a guided NVMe SSD replacement, in the order they are performed.
+type NvmeReplaceStage int32
+
+const (
+	NvmeReplaceStage_NVME_REPLACE_STARTED      NvmeReplaceStage = 0 // Replacement recorded
+	NvmeReplaceStage_NVME_REPLACE_FAULTY       NvmeReplaceStage = 1 // Old SSD set faulty so it is no longer used by the engine
+	NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE NvmeReplaceStage = 2 // Locate LED of old SSD blinking, waiting for new SSD
+	NvmeReplaceStage_NVME_REPLACE_BOUND        NvmeReplaceStage = 3 // New SSD bound to a user-space driver
+	NvmeReplaceStage_NVME_REPLACE_CONFIGURED   NvmeReplaceStage = 4 // Engine NVMe config regenerated to include new SSD
+	NvmeReplaceStage_NVME_REPLACE_DONE         NvmeReplaceStage = 5 // Old SSD replaced by new SSD, targets reintegrating
+)
+
+// Enum value maps for NvmeReplaceStage.
+var (
+	NvmeReplaceStage_name = map[int32]string{
+		0: "NVME_REPLACE_STARTED",
+		1: "NVME_REPLACE_FAULTY",
+		2: "NVME_REPLACE_AWAIT_DEVICE",
+		3: "NVME_REPLACE_BOUND",
+		4: "NVME_REPLACE_CONFIGURED",
+		5: "NVME_REPLACE_DONE",
+	}
+	NvmeReplaceStage_value = map[string]int32{
+		"NVME_REPLACE_STARTED":      0,
+		"NVME_REPLACE_FAULTY":       1,
+		"NVME_REPLACE_AWAIT_DEVICE": 2,
+		"NVME_REPLACE_BOUND":        3,
+		"NVME_REPLACE_CONFIGURED":   4,
+		"NVME_REPLACE_DONE":         5,
+	}
+)
+
+func (x NvmeReplaceStage) Enum() *NvmeReplaceStage {
+	p := new(NvmeReplaceStage)
+	*p = x
+	return p
+}
+
+func (x NvmeReplaceStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NvmeReplaceStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctl_storage_proto_enumTypes[0].Descriptor()
+}
+
+func (NvmeReplaceStage) Type() protoreflect.EnumType {
+	return &file_ctl_storage_proto_enumTypes[0]
+}
+
+func (x NvmeReplaceStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NvmeReplaceStage.Descriptor instead.
+func (NvmeReplaceStage) EnumDescriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{0}
+}
+
 type StorageScanReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nvme *ScanNvmeReq `protobuf:"bytes,1,opt,name=nvme,proto3" json:"nvme,omitempty"`
-	Scm  *ScanScmReq  `protobuf:"bytes,2,opt,name=scm,proto3" json:"scm,omitempty"`
+	Nvme     *ScanNvmeReq `protobuf:"bytes,1,opt,name=nvme,proto3" json:"nvme,omitempty"`
+	Scm      *ScanScmReq  `protobuf:"bytes,2,opt,name=scm,proto3" json:"scm,omitempty"`
+	Bindings bool         `protobuf:"varint,3,opt,name=bindings,proto3" json:"bindings,omitempty"` // Report device drivers and engine assignment, including unused NVMe
 }
 
 func (x *StorageScanReq) Reset() {
@@ -82,6 +142,13 @@ func (x *StorageScanReq) GetScm() *ScanScmReq {
 	return nil
 }
 
+func (x *StorageScanReq) GetBindings() bool {
+	if x != nil {
+		return x.Bindings
+	}
+	return false
+}
+
 type MemInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -681,6 +748,502 @@ func (x *NvmeAddDeviceResp) GetState() *ResponseState {
 	return nil
 }
 
+type NvmeNsCreateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PciAddr string `protobuf:"bytes,1,opt,name=pci_addr,json=pciAddr,proto3" json:"pci_addr,omitempty"` // PCI address of NVMe controller to create namespaces on
+	Size    uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`                     // Size in bytes of each namespace, zero to split unallocated capacity
+	Count   uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                   // Number of namespaces to create
+}
+
+func (x *NvmeNsCreateReq) Reset() {
+	*x = NvmeNsCreateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeNsCreateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeNsCreateReq) ProtoMessage() {}
+
+func (x *NvmeNsCreateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeNsCreateReq.ProtoReflect.Descriptor instead.
+func (*NvmeNsCreateReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NvmeNsCreateReq) GetPciAddr() string {
+	if x != nil {
+		return x.PciAddr
+	}
+	return ""
+}
+
+func (x *NvmeNsCreateReq) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *NvmeNsCreateReq) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NvmeNsCreateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State      *ResponseState              `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Namespaces []*NvmeController_Namespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"` // Namespaces created
+}
+
+func (x *NvmeNsCreateResp) Reset() {
+	*x = NvmeNsCreateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeNsCreateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeNsCreateResp) ProtoMessage() {}
+
+func (x *NvmeNsCreateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeNsCreateResp.ProtoReflect.Descriptor instead.
+func (*NvmeNsCreateResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NvmeNsCreateResp) GetState() *ResponseState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *NvmeNsCreateResp) GetNamespaces() []*NvmeController_Namespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type NvmeNsDeleteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PciAddr string `protobuf:"bytes,1,opt,name=pci_addr,json=pciAddr,proto3" json:"pci_addr,omitempty"` // PCI address of NVMe controller to delete namespace from
+	NsId    uint32 `protobuf:"varint,2,opt,name=ns_id,json=nsId,proto3" json:"ns_id,omitempty"`         // Identifier of namespace to delete
+}
+
+func (x *NvmeNsDeleteReq) Reset() {
+	*x = NvmeNsDeleteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeNsDeleteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeNsDeleteReq) ProtoMessage() {}
+
+func (x *NvmeNsDeleteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeNsDeleteReq.ProtoReflect.Descriptor instead.
+func (*NvmeNsDeleteReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NvmeNsDeleteReq) GetPciAddr() string {
+	if x != nil {
+		return x.PciAddr
+	}
+	return ""
+}
+
+func (x *NvmeNsDeleteReq) GetNsId() uint32 {
+	if x != nil {
+		return x.NsId
+	}
+	return 0
+}
+
+type NvmeNsDeleteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *ResponseState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *NvmeNsDeleteResp) Reset() {
+	*x = NvmeNsDeleteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeNsDeleteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeNsDeleteResp) ProtoMessage() {}
+
+func (x *NvmeNsDeleteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeNsDeleteResp.ProtoReflect.Descriptor instead.
+func (*NvmeNsDeleteResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NvmeNsDeleteResp) GetState() *ResponseState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type NvmeReplaceReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldUuid      string `protobuf:"bytes,1,opt,name=old_uuid,json=oldUuid,proto3" json:"old_uuid,omitempty"`                 // UUID of SSD to replace
+	NewPciAddr   string `protobuf:"bytes,2,opt,name=new_pci_addr,json=newPciAddr,proto3" json:"new_pci_addr,omitempty"`      // PCI address of new SSD if not in the same slot as old SSD
+	IdentifyMins uint32 `protobuf:"varint,3,opt,name=identify_mins,json=identifyMins,proto3" json:"identify_mins,omitempty"` // Minutes to blink locate LED of old SSD for
+	Abort        bool   `protobuf:"varint,4,opt,name=abort,proto3" json:"abort,omitempty"`                                   // Discard stored progress of replacement
+}
+
+func (x *NvmeReplaceReq) Reset() {
+	*x = NvmeReplaceReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeReplaceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeReplaceReq) ProtoMessage() {}
+
+func (x *NvmeReplaceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeReplaceReq.ProtoReflect.Descriptor instead.
+func (*NvmeReplaceReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *NvmeReplaceReq) GetOldUuid() string {
+	if x != nil {
+		return x.OldUuid
+	}
+	return ""
+}
+
+func (x *NvmeReplaceReq) GetNewPciAddr() string {
+	if x != nil {
+		return x.NewPciAddr
+	}
+	return ""
+}
+
+func (x *NvmeReplaceReq) GetIdentifyMins() uint32 {
+	if x != nil {
+		return x.IdentifyMins
+	}
+	return 0
+}
+
+func (x *NvmeReplaceReq) GetAbort() bool {
+	if x != nil {
+		return x.Abort
+	}
+	return false
+}
+
+type NvmeReplaceResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State      *ResponseState   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Stage      NvmeReplaceStage `protobuf:"varint,2,opt,name=stage,proto3,enum=ctl.NvmeReplaceStage" json:"stage,omitempty"` // Last stage completed
+	Rank       uint32           `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                             // Rank of engine using the SSD
+	OldUuid    string           `protobuf:"bytes,4,opt,name=old_uuid,json=oldUuid,proto3" json:"old_uuid,omitempty"`
+	OldPciAddr string           `protobuf:"bytes,5,opt,name=old_pci_addr,json=oldPciAddr,proto3" json:"old_pci_addr,omitempty"`
+	NewUuid    string           `protobuf:"bytes,6,opt,name=new_uuid,json=newUuid,proto3" json:"new_uuid,omitempty"`
+	NewPciAddr string           `protobuf:"bytes,7,opt,name=new_pci_addr,json=newPciAddr,proto3" json:"new_pci_addr,omitempty"`
+	Info       string           `protobuf:"bytes,8,opt,name=info,proto3" json:"info,omitempty"` // Action required to progress replacement
+}
+
+func (x *NvmeReplaceResp) Reset() {
+	*x = NvmeReplaceResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NvmeReplaceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NvmeReplaceResp) ProtoMessage() {}
+
+func (x *NvmeReplaceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NvmeReplaceResp.ProtoReflect.Descriptor instead.
+func (*NvmeReplaceResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NvmeReplaceResp) GetState() *ResponseState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *NvmeReplaceResp) GetStage() NvmeReplaceStage {
+	if x != nil {
+		return x.Stage
+	}
+	return NvmeReplaceStage_NVME_REPLACE_STARTED
+}
+
+func (x *NvmeReplaceResp) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *NvmeReplaceResp) GetOldUuid() string {
+	if x != nil {
+		return x.OldUuid
+	}
+	return ""
+}
+
+func (x *NvmeReplaceResp) GetOldPciAddr() string {
+	if x != nil {
+		return x.OldPciAddr
+	}
+	return ""
+}
+
+func (x *NvmeReplaceResp) GetNewUuid() string {
+	if x != nil {
+		return x.NewUuid
+	}
+	return ""
+}
+
+func (x *NvmeReplaceResp) GetNewPciAddr() string {
+	if x != nil {
+		return x.NewPciAddr
+	}
+	return ""
+}
+
+func (x *NvmeReplaceResp) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+type StorageRenderConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EngineIdx uint32 `protobuf:"varint,1,opt,name=engine_idx,json=engineIdx,proto3" json:"engine_idx,omitempty"` // Index of engine in server config file
+}
+
+func (x *StorageRenderConfigReq) Reset() {
+	*x = StorageRenderConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageRenderConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageRenderConfigReq) ProtoMessage() {}
+
+func (x *StorageRenderConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageRenderConfigReq.ProtoReflect.Descriptor instead.
+func (*StorageRenderConfigReq) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StorageRenderConfigReq) GetEngineIdx() uint32 {
+	if x != nil {
+		return x.EngineIdx
+	}
+	return 0
+}
+
+type StorageRenderConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State  *ResponseState `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Config string         `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"` // SPDK JSON config that would be written for the engine
+}
+
+func (x *StorageRenderConfigResp) Reset() {
+	*x = StorageRenderConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageRenderConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageRenderConfigResp) ProtoMessage() {}
+
+func (x *StorageRenderConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageRenderConfigResp.ProtoReflect.Descriptor instead.
+func (*StorageRenderConfigResp) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StorageRenderConfigResp) GetState() *ResponseState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *StorageRenderConfigResp) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
 var File_ctl_storage_proto protoreflect.FileDescriptor
 
 var file_ctl_storage_proto_rawDesc = []byte{
@@ -689,102 +1252,172 @@ var file_ctl_storage_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x76, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x15, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63,
 	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x63, 0x74, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x0e, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x6e,
-	0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x53, 0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52,
-	0x03, 0x73, 0x63, 0x6d, 0x22, 0x90, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x61, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65,
-	0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66,
-	0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70,
-	0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65,
-	0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75,
-	0x72, 0x70, 0x6c, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x6b, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x65, 0x6d,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x66,
-	0x72, 0x65, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65,
-	0x6d, 0x46, 0x72, 0x65, 0x65, 0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x75,
-	0x73, 0x65, 0x64, 0x5f, 0x6b, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65,
-	0x6d, 0x55, 0x73, 0x65, 0x64, 0x4b, 0x62, 0x22, 0xfb, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x4d,
-	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61,
-	0x67, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x25, 0x0a, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x65,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61,
-	0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67,
-	0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70, 0x6c,
-	0x75, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68, 0x75,
-	0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x12, 0x20, 0x0a, 0x0c,
-	0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x62, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x62, 0x12, 0x1e,
-	0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x4b, 0x62, 0x12, 0x28,
-	0x0a, 0x10, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x6b, 0x62, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4b, 0x62, 0x12, 0x2b, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x61,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x76, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63,
-	0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65,
-	0x12, 0x22, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x52,
-	0x03, 0x73, 0x63, 0x6d, 0x12, 0x31, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x53, 0x79, 0x73, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x79, 0x73,
-	0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x04,
-	0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c,
-	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04,
-	0x6e, 0x76, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63,
-	0x6d, 0x52, 0x65, 0x71, 0x52, 0x03, 0x73, 0x63, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22,
-	0x6f, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05,
-	0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73,
-	0x22, 0x2a, 0x0a, 0x0d, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3a, 0x0a, 0x0e,
-	0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x63, 0x74, 0x6c, 0x2f, 0x73,
+	0x6d, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x75, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x04, 0x6e, 0x76,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
+	0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52, 0x03,
+	0x73, 0x63, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x90, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x75,
+	0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
+	0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x46,
+	0x72, 0x65, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x73, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x62,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6b,
+	0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65,
+	0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6b,
+	0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x65, 0x64,
+	0x4b, 0x62, 0x22, 0xfb, 0x02, 0x0a, 0x0a, 0x53, 0x79, 0x73, 0x4d, 0x65, 0x6d, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x75,
+	0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x68,
+	0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x75,
+	0x72, 0x70, 0x6c, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x75, 0x67,
+	0x65, 0x70, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70, 0x6c, 0x75, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6b,
+	0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x4b, 0x62, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6b, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4b, 0x62, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6d,
+	0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x4b, 0x62, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x6d,
+	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6b, 0x62, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x4b, 0x62, 0x12, 0x2b, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4d, 0x65,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x76, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x73,
+	0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53,
+	0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x52, 0x03, 0x73, 0x63, 0x6d, 0x12,
+	0x31, 0x0a, 0x0c, 0x73, 0x79, 0x73, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x79, 0x73, 0x4d,
+	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x4d, 0x65, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6e, 0x76, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x03, 0x73, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x52,
+	0x03, 0x73, 0x63, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x6f, 0x0a, 0x11, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x2f, 0x0a, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x63, 0x72, 0x65, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x6d, 0x72, 0x65, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0d, 0x4e,
+	0x76, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x22, 0x3a, 0x0a, 0x0e, 0x4e, 0x76, 0x6d, 0x65, 0x52,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x7e, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x65, 0x72, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4e, 0x76, 0x6d, 0x65, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x56, 0x0a, 0x0f, 0x4e, 0x76, 0x6d, 0x65, 0x4e, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x4e, 0x76,
+	0x6d, 0x65, 0x4e, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28,
 	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x7e, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65,
-	0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
-	0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
-	0x69, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3d, 0x0a, 0x11, 0x4e, 0x76, 0x6d, 0x65,
-	0x41, 0x64, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0f, 0x4e, 0x76, 0x6d, 0x65, 0x4e,
+	0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x63,
+	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x63,
+	0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x6e, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6e, 0x73, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x10, 0x4e, 0x76,
+	0x6d, 0x65, 0x4e, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x4e, 0x76, 0x6d,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x6c, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x6c, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x63,
+	0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x77, 0x50, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x79, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x62,
+	0x6f, 0x72, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x0f, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x4e, 0x76, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x0c, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x50, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65,
+	0x77, 0x5f, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x77, 0x50, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x22, 0x37, 0x0a, 0x16, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x78, 0x22, 0x5b, 0x0a, 0x17, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0xb0, 0x01, 0x0a, 0x10, 0x4e, 0x76, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4e,
+	0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4e, 0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x59, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x4e, 0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x41,
+	0x57, 0x41, 0x49, 0x54, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x4e, 0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x42, 0x4f,
+	0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x56, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41,
+	0x43, 0x45, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x05, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -799,46 +1432,63 @@ func file_ctl_storage_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_proto_rawDescData
 }
 
-var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ctl_storage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctl_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_ctl_storage_proto_goTypes = []interface{}{
-	(*StorageScanReq)(nil),       // 0: ctl.StorageScanReq
-	(*MemInfo)(nil),              // 1: ctl.MemInfo
-	(*SysMemInfo)(nil),           // 2: ctl.SysMemInfo
-	(*StorageScanResp)(nil),      // 3: ctl.StorageScanResp
-	(*StorageFormatReq)(nil),     // 4: ctl.StorageFormatReq
-	(*StorageFormatResp)(nil),    // 5: ctl.StorageFormatResp
-	(*NvmeRebindReq)(nil),        // 6: ctl.NvmeRebindReq
-	(*NvmeRebindResp)(nil),       // 7: ctl.NvmeRebindResp
-	(*NvmeAddDeviceReq)(nil),     // 8: ctl.NvmeAddDeviceReq
-	(*NvmeAddDeviceResp)(nil),    // 9: ctl.NvmeAddDeviceResp
-	(*ScanNvmeReq)(nil),          // 10: ctl.ScanNvmeReq
-	(*ScanScmReq)(nil),           // 11: ctl.ScanScmReq
-	(*ScanNvmeResp)(nil),         // 12: ctl.ScanNvmeResp
-	(*ScanScmResp)(nil),          // 13: ctl.ScanScmResp
-	(*FormatNvmeReq)(nil),        // 14: ctl.FormatNvmeReq
-	(*FormatScmReq)(nil),         // 15: ctl.FormatScmReq
-	(*NvmeControllerResult)(nil), // 16: ctl.NvmeControllerResult
-	(*ScmMountResult)(nil),       // 17: ctl.ScmMountResult
-	(*ResponseState)(nil),        // 18: ctl.ResponseState
+	(NvmeReplaceStage)(0),            // 0: ctl.NvmeReplaceStage
+	(*StorageScanReq)(nil),           // 1: ctl.StorageScanReq
+	(*MemInfo)(nil),                  // 2: ctl.MemInfo
+	(*SysMemInfo)(nil),               // 3: ctl.SysMemInfo
+	(*StorageScanResp)(nil),          // 4: ctl.StorageScanResp
+	(*StorageFormatReq)(nil),         // 5: ctl.StorageFormatReq
+	(*StorageFormatResp)(nil),        // 6: ctl.StorageFormatResp
+	(*NvmeRebindReq)(nil),            // 7: ctl.NvmeRebindReq
+	(*NvmeRebindResp)(nil),           // 8: ctl.NvmeRebindResp
+	(*NvmeAddDeviceReq)(nil),         // 9: ctl.NvmeAddDeviceReq
+	(*NvmeAddDeviceResp)(nil),        // 10: ctl.NvmeAddDeviceResp
+	(*NvmeNsCreateReq)(nil),          // 11: ctl.NvmeNsCreateReq
+	(*NvmeNsCreateResp)(nil),         // 12: ctl.NvmeNsCreateResp
+	(*NvmeNsDeleteReq)(nil),          // 13: ctl.NvmeNsDeleteReq
+	(*NvmeNsDeleteResp)(nil),         // 14: ctl.NvmeNsDeleteResp
+	(*NvmeReplaceReq)(nil),           // 15: ctl.NvmeReplaceReq
+	(*NvmeReplaceResp)(nil),          // 16: ctl.NvmeReplaceResp
+	(*StorageRenderConfigReq)(nil),   // 17: ctl.StorageRenderConfigReq
+	(*StorageRenderConfigResp)(nil),  // 18: ctl.StorageRenderConfigResp
+	(*ScanNvmeReq)(nil),              // 19: ctl.ScanNvmeReq
+	(*ScanScmReq)(nil),               // 20: ctl.ScanScmReq
+	(*ScanNvmeResp)(nil),             // 21: ctl.ScanNvmeResp
+	(*ScanScmResp)(nil),              // 22: ctl.ScanScmResp
+	(*FormatNvmeReq)(nil),            // 23: ctl.FormatNvmeReq
+	(*FormatScmReq)(nil),             // 24: ctl.FormatScmReq
+	(*NvmeControllerResult)(nil),     // 25: ctl.NvmeControllerResult
+	(*ScmMountResult)(nil),           // 26: ctl.ScmMountResult
+	(*ResponseState)(nil),            // 27: ctl.ResponseState
+	(*NvmeController_Namespace)(nil), // 28: ctl.NvmeController.Namespace
 }
 var file_ctl_storage_proto_depIdxs = []int32{
-	10, // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
-	11, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
-	1,  // 2: ctl.SysMemInfo.numa_nodes:type_name -> ctl.MemInfo
-	12, // 3: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
-	13, // 4: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
-	2,  // 5: ctl.StorageScanResp.sys_mem_info:type_name -> ctl.SysMemInfo
-	14, // 6: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
-	15, // 7: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
-	16, // 8: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
-	17, // 9: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
-	18, // 10: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
-	18, // 11: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	19, // 0: ctl.StorageScanReq.nvme:type_name -> ctl.ScanNvmeReq
+	20, // 1: ctl.StorageScanReq.scm:type_name -> ctl.ScanScmReq
+	2,  // 2: ctl.SysMemInfo.numa_nodes:type_name -> ctl.MemInfo
+	21, // 3: ctl.StorageScanResp.nvme:type_name -> ctl.ScanNvmeResp
+	22, // 4: ctl.StorageScanResp.scm:type_name -> ctl.ScanScmResp
+	3,  // 5: ctl.StorageScanResp.sys_mem_info:type_name -> ctl.SysMemInfo
+	23, // 6: ctl.StorageFormatReq.nvme:type_name -> ctl.FormatNvmeReq
+	24, // 7: ctl.StorageFormatReq.scm:type_name -> ctl.FormatScmReq
+	25, // 8: ctl.StorageFormatResp.crets:type_name -> ctl.NvmeControllerResult
+	26, // 9: ctl.StorageFormatResp.mrets:type_name -> ctl.ScmMountResult
+	27, // 10: ctl.NvmeRebindResp.state:type_name -> ctl.ResponseState
+	27, // 11: ctl.NvmeAddDeviceResp.state:type_name -> ctl.ResponseState
+	27, // 12: ctl.NvmeNsCreateResp.state:type_name -> ctl.ResponseState
+	28, // 13: ctl.NvmeNsCreateResp.namespaces:type_name -> ctl.NvmeController.Namespace
+	27, // 14: ctl.NvmeNsDeleteResp.state:type_name -> ctl.ResponseState
+	27, // 15: ctl.NvmeReplaceResp.state:type_name -> ctl.ResponseState
+	0,  // 16: ctl.NvmeReplaceResp.stage:type_name -> ctl.NvmeReplaceStage
+	27, // 17: ctl.StorageRenderConfigResp.state:type_name -> ctl.ResponseState
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_proto_init() }
@@ -849,6 +1499,7 @@ func file_ctl_storage_proto_init() {
 	file_ctl_storage_nvme_proto_init()
 	file_ctl_storage_scm_proto_init()
 	file_ctl_common_proto_init()
+	file_ctl_smd_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_ctl_storage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageScanReq); i {
@@ -970,19 +1621,116 @@ func file_ctl_storage_proto_init() {
 				return nil
 			}
 		}
+		file_ctl_storage_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeNsCreateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeNsCreateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeNsDeleteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeNsDeleteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeReplaceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NvmeReplaceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageRenderConfigReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageRenderConfigResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_ctl_storage_proto_goTypes,
 		DependencyIndexes: file_ctl_storage_proto_depIdxs,
+		EnumInfos:         file_ctl_storage_proto_enumTypes,
 		MessageInfos:      file_ctl_storage_proto_msgTypes,
 	}.Build()
 	File_ctl_storage_proto = out.File
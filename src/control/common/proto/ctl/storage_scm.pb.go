@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -32,16 +33,21 @@ type ScmModule struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Channelid        uint32 `protobuf:"varint,1,opt,name=channelid,proto3" json:"channelid,omitempty"`              // The channel id where module is installed.
-	Channelposition  uint32 `protobuf:"varint,2,opt,name=channelposition,proto3" json:"channelposition,omitempty"`  // The channel position where module is installed.
-	Controllerid     uint32 `protobuf:"varint,3,opt,name=controllerid,proto3" json:"controllerid,omitempty"`        // The memory controller id attached to module.
-	Socketid         uint32 `protobuf:"varint,4,opt,name=socketid,proto3" json:"socketid,omitempty"`                // The socket id attached to module.
-	Physicalid       uint32 `protobuf:"varint,5,opt,name=physicalid,proto3" json:"physicalid,omitempty"`            // The physical id of the module.
-	Capacity         uint64 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`                // The capacity of the module.
-	Uid              string `protobuf:"bytes,7,opt,name=uid,proto3" json:"uid,omitempty"`                           // The uid of the module.
-	PartNumber       string `protobuf:"bytes,8,opt,name=partNumber,proto3" json:"partNumber,omitempty"`             // The part number of the module.
-	FirmwareRevision string `protobuf:"bytes,9,opt,name=firmwareRevision,proto3" json:"firmwareRevision,omitempty"` // Module's active firmware revision
-	HealthState      string `protobuf:"bytes,10,opt,name=healthState,proto3" json:"healthState,omitempty"`          // Module's health state.
+	Channelid             uint32 `protobuf:"varint,1,opt,name=channelid,proto3" json:"channelid,omitempty"`                          // The channel id where module is installed.
+	Channelposition       uint32 `protobuf:"varint,2,opt,name=channelposition,proto3" json:"channelposition,omitempty"`              // The channel position where module is installed.
+	Controllerid          uint32 `protobuf:"varint,3,opt,name=controllerid,proto3" json:"controllerid,omitempty"`                    // The memory controller id attached to module.
+	Socketid              uint32 `protobuf:"varint,4,opt,name=socketid,proto3" json:"socketid,omitempty"`                            // The socket id attached to module.
+	Physicalid            uint32 `protobuf:"varint,5,opt,name=physicalid,proto3" json:"physicalid,omitempty"`                        // The physical id of the module.
+	Capacity              uint64 `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`                            // The capacity of the module.
+	Uid                   string `protobuf:"bytes,7,opt,name=uid,proto3" json:"uid,omitempty"`                                       // The uid of the module.
+	PartNumber            string `protobuf:"bytes,8,opt,name=partNumber,proto3" json:"partNumber,omitempty"`                         // The part number of the module.
+	FirmwareRevision      string `protobuf:"bytes,9,opt,name=firmwareRevision,proto3" json:"firmwareRevision,omitempty"`             // Module's active firmware revision
+	HealthState           string `protobuf:"bytes,10,opt,name=healthState,proto3" json:"healthState,omitempty"`                      // Module's health state.
+	MediaTemperature      uint32 `protobuf:"varint,11,opt,name=mediaTemperature,proto3" json:"mediaTemperature,omitempty"`           // Media temperature in degrees Celsius.
+	ControllerTemperature uint32 `protobuf:"varint,12,opt,name=controllerTemperature,proto3" json:"controllerTemperature,omitempty"` // Controller temperature in degrees Celsius.
+	SparePercentage       uint32 `protobuf:"varint,13,opt,name=sparePercentage,proto3" json:"sparePercentage,omitempty"`             // Remaining spare capacity as a percentage.
+	LifespanRemaining     uint32 `protobuf:"varint,14,opt,name=lifespanRemaining,proto3" json:"lifespanRemaining,omitempty"`         // Remaining life as a percentage of factory expected life span.
+	SecurityState         string `protobuf:"bytes,15,opt,name=securityState,proto3" json:"securityState,omitempty"`                  // Module's passphrase security state.
 }
 
 func (x *ScmModule) Reset() {
@@ -146,7 +152,106 @@ func (x *ScmModule) GetHealthState() string {
 	return ""
 }
 
+func (x *ScmModule) GetMediaTemperature() uint32 {
+	if x != nil {
+		return x.MediaTemperature
+	}
+	return 0
+}
+
+func (x *ScmModule) GetControllerTemperature() uint32 {
+	if x != nil {
+		return x.ControllerTemperature
+	}
+	return 0
+}
+
+func (x *ScmModule) GetSparePercentage() uint32 {
+	if x != nil {
+		return x.SparePercentage
+	}
+	return 0
+}
+
+func (x *ScmModule) GetLifespanRemaining() uint32 {
+	if x != nil {
+		return x.LifespanRemaining
+	}
+	return 0
+}
+
+func (x *ScmModule) GetSecurityState() string {
+	if x != nil {
+		return x.SecurityState
+	}
+	return ""
+}
+
 // ScmNamespace represents SCM namespace as pmem device files created on a ScmRegion.
+// ScmOwnerLabel identifies the DAOS engine that formatted an SCM mount.
+type ScmOwnerLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	System    string `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	EngineIdx uint32 `protobuf:"varint,2,opt,name=engine_idx,json=engineIdx,proto3" json:"engine_idx,omitempty"`
+	Tier      uint32 `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+}
+
+func (x *ScmOwnerLabel) Reset() {
+	*x = ScmOwnerLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmOwnerLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmOwnerLabel) ProtoMessage() {}
+
+func (x *ScmOwnerLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmOwnerLabel.ProtoReflect.Descriptor instead.
+func (*ScmOwnerLabel) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ScmOwnerLabel) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *ScmOwnerLabel) GetEngineIdx() uint32 {
+	if x != nil {
+		return x.EngineIdx
+	}
+	return 0
+}
+
+func (x *ScmOwnerLabel) GetTier() uint32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
 type ScmNamespace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -156,14 +261,15 @@ type ScmNamespace struct {
 	Blockdev string              `protobuf:"bytes,2,opt,name=blockdev,proto3" json:"blockdev,omitempty"`
 	Dev      string              `protobuf:"bytes,3,opt,name=dev,proto3" json:"dev,omitempty"` // ndctl specific device identifier
 	NumaNode uint32              `protobuf:"varint,4,opt,name=numa_node,json=numaNode,proto3" json:"numa_node,omitempty"`
-	Size     uint64              `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`  // pmem block device capacity in bytes
-	Mount    *ScmNamespace_Mount `protobuf:"bytes,6,opt,name=mount,proto3" json:"mount,omitempty"` // mount OS info
+	Size     uint64              `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`      // pmem block device capacity in bytes
+	Mount    *ScmNamespace_Mount `protobuf:"bytes,6,opt,name=mount,proto3" json:"mount,omitempty"`     // mount OS info
+	Binding  *DeviceBinding      `protobuf:"bytes,7,opt,name=binding,proto3" json:"binding,omitempty"` // engine tier using namespace, unset if unused
 }
 
 func (x *ScmNamespace) Reset() {
 	*x = ScmNamespace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[1]
+		mi := &file_ctl_storage_scm_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -176,7 +282,7 @@ func (x *ScmNamespace) String() string {
 func (*ScmNamespace) ProtoMessage() {}
 
 func (x *ScmNamespace) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[1]
+	mi := &file_ctl_storage_scm_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -189,7 +295,7 @@ func (x *ScmNamespace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScmNamespace.ProtoReflect.Descriptor instead.
 func (*ScmNamespace) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{1}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ScmNamespace) GetUuid() string {
@@ -234,6 +340,13 @@ func (x *ScmNamespace) GetMount() *ScmNamespace_Mount {
 	return nil
 }
 
+func (x *ScmNamespace) GetBinding() *DeviceBinding {
+	if x != nil {
+		return x.Binding
+	}
+	return nil
+}
+
 // ScmModuleResult represents operation state for specific SCM/PM module.
 //
 // TODO: replace identifier with serial when returned in scan
@@ -249,7 +362,7 @@ type ScmModuleResult struct {
 func (x *ScmModuleResult) Reset() {
 	*x = ScmModuleResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[2]
+		mi := &file_ctl_storage_scm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -262,7 +375,7 @@ func (x *ScmModuleResult) String() string {
 func (*ScmModuleResult) ProtoMessage() {}
 
 func (x *ScmModuleResult) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[2]
+	mi := &file_ctl_storage_scm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +388,7 @@ func (x *ScmModuleResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScmModuleResult.ProtoReflect.Descriptor instead.
 func (*ScmModuleResult) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{2}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ScmModuleResult) GetPhysicalid() uint32 {
@@ -306,7 +419,7 @@ type ScmMountResult struct {
 func (x *ScmMountResult) Reset() {
 	*x = ScmMountResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[3]
+		mi := &file_ctl_storage_scm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -319,7 +432,7 @@ func (x *ScmMountResult) String() string {
 func (*ScmMountResult) ProtoMessage() {}
 
 func (x *ScmMountResult) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[3]
+	mi := &file_ctl_storage_scm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +445,7 @@ func (x *ScmMountResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScmMountResult.ProtoReflect.Descriptor instead.
 func (*ScmMountResult) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{3}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ScmMountResult) GetMntpoint() string {
@@ -367,7 +480,7 @@ type PrepareScmReq struct {
 func (x *PrepareScmReq) Reset() {
 	*x = PrepareScmReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[4]
+		mi := &file_ctl_storage_scm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -380,7 +493,7 @@ func (x *PrepareScmReq) String() string {
 func (*PrepareScmReq) ProtoMessage() {}
 
 func (x *PrepareScmReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[4]
+	mi := &file_ctl_storage_scm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +506,7 @@ func (x *PrepareScmReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareScmReq.ProtoReflect.Descriptor instead.
 func (*PrepareScmReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{4}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PrepareScmReq) GetReset_() bool {
@@ -416,7 +529,7 @@ type PrepareScmResp struct {
 func (x *PrepareScmResp) Reset() {
 	*x = PrepareScmResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[5]
+		mi := &file_ctl_storage_scm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +542,7 @@ func (x *PrepareScmResp) String() string {
 func (*PrepareScmResp) ProtoMessage() {}
 
 func (x *PrepareScmResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[5]
+	mi := &file_ctl_storage_scm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +555,7 @@ func (x *PrepareScmResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareScmResp.ProtoReflect.Descriptor instead.
 func (*PrepareScmResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{5}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PrepareScmResp) GetNamespaces() []*ScmNamespace {
@@ -477,7 +590,7 @@ type ScanScmReq struct {
 func (x *ScanScmReq) Reset() {
 	*x = ScanScmReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[6]
+		mi := &file_ctl_storage_scm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +603,7 @@ func (x *ScanScmReq) String() string {
 func (*ScanScmReq) ProtoMessage() {}
 
 func (x *ScanScmReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[6]
+	mi := &file_ctl_storage_scm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +616,7 @@ func (x *ScanScmReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanScmReq.ProtoReflect.Descriptor instead.
 func (*ScanScmReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{6}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ScanScmReq) GetUsage() bool {
@@ -513,20 +626,93 @@ func (x *ScanScmReq) GetUsage() bool {
 	return false
 }
 
+// ScmCapabilities indicates which PMem operations the discovery backend supports.
+type ScmCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backend  string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"` // ipmctl/ndctl or sysfs
+	Prepare  bool   `protobuf:"varint,2,opt,name=prepare,proto3" json:"prepare,omitempty"`
+	Firmware bool   `protobuf:"varint,3,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	Health   bool   `protobuf:"varint,4,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (x *ScmCapabilities) Reset() {
+	*x = ScmCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ctl_storage_scm_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScmCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScmCapabilities) ProtoMessage() {}
+
+func (x *ScmCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_ctl_storage_scm_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScmCapabilities.ProtoReflect.Descriptor instead.
+func (*ScmCapabilities) Descriptor() ([]byte, []int) {
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ScmCapabilities) GetBackend() string {
+	if x != nil {
+		return x.Backend
+	}
+	return ""
+}
+
+func (x *ScmCapabilities) GetPrepare() bool {
+	if x != nil {
+		return x.Prepare
+	}
+	return false
+}
+
+func (x *ScmCapabilities) GetFirmware() bool {
+	if x != nil {
+		return x.Firmware
+	}
+	return false
+}
+
+func (x *ScmCapabilities) GetHealth() bool {
+	if x != nil {
+		return x.Health
+	}
+	return false
+}
+
 type ScanScmResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Modules    []*ScmModule    `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
-	Namespaces []*ScmNamespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
-	State      *ResponseState  `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Modules      []*ScmModule     `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	Namespaces   []*ScmNamespace  `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	State        *ResponseState   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Capabilities *ScmCapabilities `protobuf:"bytes,4,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *ScanScmResp) Reset() {
 	*x = ScanScmResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[7]
+		mi := &file_ctl_storage_scm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +725,7 @@ func (x *ScanScmResp) String() string {
 func (*ScanScmResp) ProtoMessage() {}
 
 func (x *ScanScmResp) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[7]
+	mi := &file_ctl_storage_scm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +738,7 @@ func (x *ScanScmResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanScmResp.ProtoReflect.Descriptor instead.
 func (*ScanScmResp) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{7}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ScanScmResp) GetModules() []*ScmModule {
@@ -576,6 +762,13 @@ func (x *ScanScmResp) GetState() *ResponseState {
 	return nil
 }
 
+func (x *ScanScmResp) GetCapabilities() *ScmCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type FormatScmReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -585,7 +778,7 @@ type FormatScmReq struct {
 func (x *FormatScmReq) Reset() {
 	*x = FormatScmReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[8]
+		mi := &file_ctl_storage_scm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -598,7 +791,7 @@ func (x *FormatScmReq) String() string {
 func (*FormatScmReq) ProtoMessage() {}
 
 func (x *FormatScmReq) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[8]
+	mi := &file_ctl_storage_scm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +804,7 @@ func (x *FormatScmReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FormatScmReq.ProtoReflect.Descriptor instead.
 func (*FormatScmReq) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{8}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{10}
 }
 
 // Mount represents a mounted pmem block device.
@@ -620,19 +813,22 @@ type ScmNamespace_Mount struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path        string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	TotalBytes  uint64   `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
-	AvailBytes  uint64   `protobuf:"varint,3,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"` // Available RAW storage for data
-	DeviceList  []string `protobuf:"bytes,4,rep,name=device_list,json=deviceList,proto3" json:"device_list,omitempty"`
-	Class       string   `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
-	Rank        uint32   `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`                                  // DAOS I/O Engine using SCM devices
-	UsableBytes uint64   `protobuf:"varint,7,opt,name=usable_bytes,json=usableBytes,proto3" json:"usable_bytes,omitempty"` // Effective storage available for data
+	Path        string              `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	TotalBytes  uint64              `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	AvailBytes  uint64              `protobuf:"varint,3,opt,name=avail_bytes,json=availBytes,proto3" json:"avail_bytes,omitempty"` // Available RAW storage for data
+	DeviceList  []string            `protobuf:"bytes,4,rep,name=device_list,json=deviceList,proto3" json:"device_list,omitempty"`
+	Class       string              `protobuf:"bytes,5,opt,name=class,proto3" json:"class,omitempty"`
+	Rank        uint32              `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`                                  // DAOS I/O Engine using SCM devices
+	UsableBytes uint64              `protobuf:"varint,7,opt,name=usable_bytes,json=usableBytes,proto3" json:"usable_bytes,omitempty"` // Effective storage available for data
+	Owner       *ScmOwnerLabel      `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`                                 // DAOS owner recorded at format
+	Ownership   string              `protobuf:"bytes,9,opt,name=ownership,proto3" json:"ownership,omitempty"`                         // owned, unowned or foreign
+	PoolUsage   []*PoolStorageUsage `protobuf:"bytes,10,rep,name=pool_usage,json=poolUsage,proto3" json:"pool_usage,omitempty"`       // Capacity used by each pool
 }
 
 func (x *ScmNamespace_Mount) Reset() {
 	*x = ScmNamespace_Mount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ctl_storage_scm_proto_msgTypes[9]
+		mi := &file_ctl_storage_scm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +841,7 @@ func (x *ScmNamespace_Mount) String() string {
 func (*ScmNamespace_Mount) ProtoMessage() {}
 
 func (x *ScmNamespace_Mount) ProtoReflect() protoreflect.Message {
-	mi := &file_ctl_storage_scm_proto_msgTypes[9]
+	mi := &file_ctl_storage_scm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +854,7 @@ func (x *ScmNamespace_Mount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScmNamespace_Mount.ProtoReflect.Descriptor instead.
 func (*ScmNamespace_Mount) Descriptor() ([]byte, []int) {
-	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{1, 0}
+	return file_ctl_storage_scm_proto_rawDescGZIP(), []int{2, 0}
 }
 
 func (x *ScmNamespace_Mount) GetPath() string {
@@ -710,13 +906,34 @@ func (x *ScmNamespace_Mount) GetUsableBytes() uint64 {
 	return 0
 }
 
+func (x *ScmNamespace_Mount) GetOwner() *ScmOwnerLabel {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *ScmNamespace_Mount) GetOwnership() string {
+	if x != nil {
+		return x.Ownership
+	}
+	return ""
+}
+
+func (x *ScmNamespace_Mount) GetPoolUsage() []*PoolStorageUsage {
+	if x != nil {
+		return x.PoolUsage
+	}
+	return nil
+}
+
 var File_ctl_storage_scm_proto protoreflect.FileDescriptor
 
 var file_ctl_storage_scm_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x63, 0x74, 0x6c, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x63,
 	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x63, 0x74, 0x6c, 0x1a, 0x10, 0x63, 0x74,
-	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf,
-	0x02, 0x0a, 0x09, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf,
+	0x04, 0x0a, 0x09, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
 	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x68,
 	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
@@ -737,73 +954,115 @@ var file_ctl_storage_scm_proto_rawDesc = []byte{
 	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
 	0x0a, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x22, 0xfe, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65,
-	0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65,
-	0x76, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x65, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0xcb, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x22, 0x5b, 0x0a, 0x0f, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63,
-	0x61, 0x6c, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x78,
-	0x0a, 0x0e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05,
+	0x12, 0x2a, 0x0a, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x70, 0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x70, 0x61,
+	0x72, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x6c, 0x69, 0x66, 0x65, 0x73, 0x70, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x70, 0x61,
+	0x6e, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x5a, 0x0a, 0x0d, 0x53, 0x63, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x22, 0xaa, 0x04, 0x0a,
+	0x0c, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x64, 0x65, 0x76, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x65, 0x76, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x2d, 0x0a, 0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0xc9, 0x02,
+	0x0a, 0x05, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x53, 0x63, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x09,
+	0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5b, 0x0a, 0x0f, 0x53, 0x63, 0x6d,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x05,
 	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74,
 	0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x69, 0x64, 0x78, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x65, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22,
-	0x95, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x53, 0x63, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x72,
-	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x22, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x53,
-	0x63, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x0b,
-	0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x74, 0x6c, 0x2e,
-	0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x22, 0x0e, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63, 0x6d, 0x52,
-	0x65, 0x71, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x78, 0x0a, 0x0e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x69, 0x64, 0x78, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x69, 0x64, 0x78,
+	0x22, 0x25, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x53, 0x63, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x62, 0x6f, 0x6f,
+	0x74, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x72, 0x65, 0x62, 0x6f, 0x6f, 0x74, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
+	0x22, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x0f, 0x53, 0x63, 0x6d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69,
+	0x72, 0x6d, 0x77, 0x61, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xce,
+	0x01, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x28,
+	0x0a, 0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x07, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x74, 0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x0a, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x74, 0x6c,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x74,
+	0x6c, 0x2e, 0x53, 0x63, 0x6d, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22,
+	0x0e, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x63, 0x6d, 0x52, 0x65, 0x71, 0x42,
+	0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x72,
+	0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -818,34 +1077,42 @@ func file_ctl_storage_scm_proto_rawDescGZIP() []byte {
 	return file_ctl_storage_scm_proto_rawDescData
 }
 
-var file_ctl_storage_scm_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ctl_storage_scm_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ctl_storage_scm_proto_goTypes = []interface{}{
 	(*ScmModule)(nil),          // 0: ctl.ScmModule
-	(*ScmNamespace)(nil),       // 1: ctl.ScmNamespace
-	(*ScmModuleResult)(nil),    // 2: ctl.ScmModuleResult
-	(*ScmMountResult)(nil),     // 3: ctl.ScmMountResult
-	(*PrepareScmReq)(nil),      // 4: ctl.PrepareScmReq
-	(*PrepareScmResp)(nil),     // 5: ctl.PrepareScmResp
-	(*ScanScmReq)(nil),         // 6: ctl.ScanScmReq
-	(*ScanScmResp)(nil),        // 7: ctl.ScanScmResp
-	(*FormatScmReq)(nil),       // 8: ctl.FormatScmReq
-	(*ScmNamespace_Mount)(nil), // 9: ctl.ScmNamespace.Mount
-	(*ResponseState)(nil),      // 10: ctl.ResponseState
+	(*ScmOwnerLabel)(nil),      // 1: ctl.ScmOwnerLabel
+	(*ScmNamespace)(nil),       // 2: ctl.ScmNamespace
+	(*ScmModuleResult)(nil),    // 3: ctl.ScmModuleResult
+	(*ScmMountResult)(nil),     // 4: ctl.ScmMountResult
+	(*PrepareScmReq)(nil),      // 5: ctl.PrepareScmReq
+	(*PrepareScmResp)(nil),     // 6: ctl.PrepareScmResp
+	(*ScanScmReq)(nil),         // 7: ctl.ScanScmReq
+	(*ScmCapabilities)(nil),    // 8: ctl.ScmCapabilities
+	(*ScanScmResp)(nil),        // 9: ctl.ScanScmResp
+	(*FormatScmReq)(nil),       // 10: ctl.FormatScmReq
+	(*ScmNamespace_Mount)(nil), // 11: ctl.ScmNamespace.Mount
+	(*DeviceBinding)(nil),      // 12: ctl.DeviceBinding
+	(*ResponseState)(nil),      // 13: ctl.ResponseState
+	(*PoolStorageUsage)(nil),   // 14: ctl.PoolStorageUsage
 }
 var file_ctl_storage_scm_proto_depIdxs = []int32{
-	9,  // 0: ctl.ScmNamespace.mount:type_name -> ctl.ScmNamespace.Mount
-	10, // 1: ctl.ScmModuleResult.state:type_name -> ctl.ResponseState
-	10, // 2: ctl.ScmMountResult.state:type_name -> ctl.ResponseState
-	1,  // 3: ctl.PrepareScmResp.namespaces:type_name -> ctl.ScmNamespace
-	10, // 4: ctl.PrepareScmResp.state:type_name -> ctl.ResponseState
-	0,  // 5: ctl.ScanScmResp.modules:type_name -> ctl.ScmModule
-	1,  // 6: ctl.ScanScmResp.namespaces:type_name -> ctl.ScmNamespace
-	10, // 7: ctl.ScanScmResp.state:type_name -> ctl.ResponseState
-	8,  // [8:8] is the sub-list for method output_type
-	8,  // [8:8] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 0: ctl.ScmNamespace.mount:type_name -> ctl.ScmNamespace.Mount
+	12, // 1: ctl.ScmNamespace.binding:type_name -> ctl.DeviceBinding
+	13, // 2: ctl.ScmModuleResult.state:type_name -> ctl.ResponseState
+	13, // 3: ctl.ScmMountResult.state:type_name -> ctl.ResponseState
+	2,  // 4: ctl.PrepareScmResp.namespaces:type_name -> ctl.ScmNamespace
+	13, // 5: ctl.PrepareScmResp.state:type_name -> ctl.ResponseState
+	0,  // 6: ctl.ScanScmResp.modules:type_name -> ctl.ScmModule
+	2,  // 7: ctl.ScanScmResp.namespaces:type_name -> ctl.ScmNamespace
+	13, // 8: ctl.ScanScmResp.state:type_name -> ctl.ResponseState
+	8,  // 9: ctl.ScanScmResp.capabilities:type_name -> ctl.ScmCapabilities
+	1,  // 10: ctl.ScmNamespace.Mount.owner:type_name -> ctl.ScmOwnerLabel
+	14, // 11: ctl.ScmNamespace.Mount.pool_usage:type_name -> ctl.PoolStorageUsage
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_ctl_storage_scm_proto_init() }
@@ -868,7 +1135,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScmNamespace); i {
+			switch v := v.(*ScmOwnerLabel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -880,7 +1147,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScmModuleResult); i {
+			switch v := v.(*ScmNamespace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -892,7 +1159,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScmMountResult); i {
+			switch v := v.(*ScmModuleResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -904,7 +1171,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareScmReq); i {
+			switch v := v.(*ScmMountResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -916,7 +1183,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareScmResp); i {
+			switch v := v.(*PrepareScmReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -928,7 +1195,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScanScmReq); i {
+			switch v := v.(*PrepareScmResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -940,7 +1207,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ScanScmResp); i {
+			switch v := v.(*ScanScmReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -952,7 +1219,7 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FormatScmReq); i {
+			switch v := v.(*ScmCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -964,6 +1231,30 @@ func file_ctl_storage_scm_proto_init() {
 			}
 		}
 		file_ctl_storage_scm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanScmResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatScmReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ctl_storage_scm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ScmNamespace_Mount); i {
 			case 0:
 				return &v.state
@@ -982,7 +1273,7 @@ func file_ctl_storage_scm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ctl_storage_scm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -45,7 +45,7 @@ func ShouldDebug(msg proto.Message, ldrChk func() bool) bool {
 				*mgmtpb.ListPoolsReq, *mgmtpb.GetACLReq,
 				*mgmtpb.PoolQueryTargetReq, *mgmtpb.ListContReq,
 				*mgmtpb.SystemEraseReq, *mgmtpb.SystemGetPropReq,
-				*mgmtpb.SystemGetAttrReq:
+				*mgmtpb.SystemGetAttrReq, *mgmtpb.SystemEventsQueryReq:
 				return true
 			default:
 				return false
@@ -1,4 +1,3 @@
-//
 // (C) Copyright 2019-2024 Intel Corporation.
 // (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
@@ -141,6 +140,16 @@ func (r *PoolExtendReq) SetUUID(id uuid.UUID) {
 	r.Id = id.String()
 }
 
+// SetSvcRanks sets the request's Pool Service Ranks.
+func (r *PoolResizeReq) SetSvcRanks(rl []uint32) {
+	r.SvcRanks = rl
+}
+
+// SetUUID sets the request's ID to a UUID.
+func (r *PoolResizeReq) SetUUID(id uuid.UUID) {
+	r.Id = id.String()
+}
+
 // SetSvcRanks sets the request's Pool Service Ranks.
 func (r *PoolQueryReq) SetSvcRanks(rl []uint32) {
 	r.SvcRanks = rl
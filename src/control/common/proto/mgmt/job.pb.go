@@ -0,0 +1,726 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        v3.5.0
+// source: mgmt/job.proto
+
+package mgmt
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JobState int32
+
+const (
+	JobState_JOB_RUNNING   JobState = 0
+	JobState_JOB_SUCCEEDED JobState = 1
+	JobState_JOB_FAILED    JobState = 2
+	JobState_JOB_CANCELED  JobState = 3
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_RUNNING",
+		1: "JOB_SUCCEEDED",
+		2: "JOB_FAILED",
+		3: "JOB_CANCELED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_RUNNING":   0,
+		"JOB_SUCCEEDED": 1,
+		"JOB_FAILED":    2,
+		"JOB_CANCELED":  3,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mgmt_job_proto_enumTypes[0].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_mgmt_job_proto_enumTypes[0]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{0}
+}
+
+// JobSubmitReq supplies an operation to be run asynchronously by the MS leader.
+type JobSubmitReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	// Types that are assignable to Op:
+	//	*JobSubmitReq_PoolCreate
+	//	*JobSubmitReq_PoolDestroy
+	//	*JobSubmitReq_PoolReint
+	Op isJobSubmitReq_Op `protobuf_oneof:"op"`
+}
+
+func (x *JobSubmitReq) Reset() {
+	*x = JobSubmitReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSubmitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSubmitReq) ProtoMessage() {}
+
+func (x *JobSubmitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSubmitReq.ProtoReflect.Descriptor instead.
+func (*JobSubmitReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JobSubmitReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (m *JobSubmitReq) GetOp() isJobSubmitReq_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *JobSubmitReq) GetPoolCreate() *PoolCreateReq {
+	if x, ok := x.GetOp().(*JobSubmitReq_PoolCreate); ok {
+		return x.PoolCreate
+	}
+	return nil
+}
+
+func (x *JobSubmitReq) GetPoolDestroy() *PoolDestroyReq {
+	if x, ok := x.GetOp().(*JobSubmitReq_PoolDestroy); ok {
+		return x.PoolDestroy
+	}
+	return nil
+}
+
+func (x *JobSubmitReq) GetPoolReint() *PoolReintReq {
+	if x, ok := x.GetOp().(*JobSubmitReq_PoolReint); ok {
+		return x.PoolReint
+	}
+	return nil
+}
+
+type isJobSubmitReq_Op interface {
+	isJobSubmitReq_Op()
+}
+
+type JobSubmitReq_PoolCreate struct {
+	PoolCreate *PoolCreateReq `protobuf:"bytes,2,opt,name=pool_create,json=poolCreate,proto3,oneof"`
+}
+
+type JobSubmitReq_PoolDestroy struct {
+	PoolDestroy *PoolDestroyReq `protobuf:"bytes,3,opt,name=pool_destroy,json=poolDestroy,proto3,oneof"`
+}
+
+type JobSubmitReq_PoolReint struct {
+	PoolReint *PoolReintReq `protobuf:"bytes,4,opt,name=pool_reint,json=poolReint,proto3,oneof"`
+}
+
+func (*JobSubmitReq_PoolCreate) isJobSubmitReq_Op() {}
+
+func (*JobSubmitReq_PoolDestroy) isJobSubmitReq_Op() {}
+
+func (*JobSubmitReq_PoolReint) isJobSubmitReq_Op() {}
+
+// JobSubmitResp returns the identifier of a submitted job.
+type JobSubmitResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`          // job identifier
+}
+
+func (x *JobSubmitResp) Reset() {
+	*x = JobSubmitResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobSubmitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSubmitResp) ProtoMessage() {}
+
+func (x *JobSubmitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSubmitResp.ProtoReflect.Descriptor instead.
+func (*JobSubmitResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JobSubmitResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *JobSubmitResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Job represents the state of an asynchronous job.
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // job identifier
+	Op         string          `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`         // name of the operation run by the job
+	Target     string          `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"` // pool label or UUID the operation acts on
+	State      JobState        `protobuf:"varint,4,opt,name=state,proto3,enum=mgmt.JobState" json:"state,omitempty"`
+	Progress   uint32          `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"`                      // percentage of the operation completed
+	Error      string          `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                             // error message if the job failed
+	Created    string          `protobuf:"bytes,7,opt,name=created,proto3" json:"created,omitempty"`                         // RFC3339 time the job was submitted
+	Updated    string          `protobuf:"bytes,8,opt,name=updated,proto3" json:"updated,omitempty"`                         // RFC3339 time of the last job update
+	PoolCreate *PoolCreateResp `protobuf:"bytes,9,opt,name=pool_create,json=poolCreate,proto3" json:"pool_create,omitempty"` // result of a successful pool create job
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *Job) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_RUNNING
+}
+
+func (x *Job) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *Job) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *Job) GetPoolCreate() *PoolCreateResp {
+	if x != nil {
+		return x.PoolCreate
+	}
+	return nil
+}
+
+// JobListReq supplies the identifiers of the jobs to list, all jobs are listed if empty.
+type JobListReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Ids []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"` // job identifiers
+}
+
+func (x *JobListReq) Reset() {
+	*x = JobListReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobListReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobListReq) ProtoMessage() {}
+
+func (x *JobListReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobListReq.ProtoReflect.Descriptor instead.
+func (*JobListReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobListReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *JobListReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// JobListResp returns the requested jobs ordered by submission time.
+type JobListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"` // DAOS error code
+	Jobs   []*Job `protobuf:"bytes,2,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *JobListResp) Reset() {
+	*x = JobListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobListResp) ProtoMessage() {}
+
+func (x *JobListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobListResp.ProtoReflect.Descriptor instead.
+func (*JobListResp) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JobListResp) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *JobListResp) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// JobCancelReq supplies the identifier of a running job to cancel.
+type JobCancelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sys string `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"` // DAOS system identifier
+	Id  string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`   // job identifier
+}
+
+func (x *JobCancelReq) Reset() {
+	*x = JobCancelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mgmt_job_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobCancelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobCancelReq) ProtoMessage() {}
+
+func (x *JobCancelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_mgmt_job_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobCancelReq.ProtoReflect.Descriptor instead.
+func (*JobCancelReq) Descriptor() ([]byte, []int) {
+	return file_mgmt_job_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *JobCancelReq) GetSys() string {
+	if x != nil {
+		return x.Sys
+	}
+	return ""
+}
+
+func (x *JobCancelReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+var File_mgmt_job_proto protoreflect.FileDescriptor
+
+var file_mgmt_job_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x6a, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x04, 0x6d, 0x67, 0x6d, 0x74, 0x1a, 0x0f, 0x6d, 0x67, 0x6d, 0x74, 0x2f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e,
+	0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x48, 0x00,
+	0x52, 0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x12, 0x33, 0x0a,
+	0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x72, 0x65, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x69,
+	0x6e, 0x74, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22, 0x37, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x80, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x35, 0x0a,
+	0x0b, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x67, 0x6d, 0x74, 0x2e, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x22, 0x30, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x79, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x44, 0x0a, 0x0b, 0x4a, 0x6f, 0x62, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a,
+	0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x6d, 0x67,
+	0x6d, 0x74, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x30, 0x0a, 0x0c,
+	0x4a, 0x6f, 0x62, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x2a, 0x50,
+	0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x4a, 0x4f,
+	0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x4a, 0x4f, 0x42, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03,
+	0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
+	0x61, 0x6f, 0x73, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x64, 0x61, 0x6f, 0x73, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x67, 0x6d, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mgmt_job_proto_rawDescOnce sync.Once
+	file_mgmt_job_proto_rawDescData = file_mgmt_job_proto_rawDesc
+)
+
+func file_mgmt_job_proto_rawDescGZIP() []byte {
+	file_mgmt_job_proto_rawDescOnce.Do(func() {
+		file_mgmt_job_proto_rawDescData = protoimpl.X.CompressGZIP(file_mgmt_job_proto_rawDescData)
+	})
+	return file_mgmt_job_proto_rawDescData
+}
+
+var file_mgmt_job_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mgmt_job_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mgmt_job_proto_goTypes = []interface{}{
+	(JobState)(0),          // 0: mgmt.JobState
+	(*JobSubmitReq)(nil),   // 1: mgmt.JobSubmitReq
+	(*JobSubmitResp)(nil),  // 2: mgmt.JobSubmitResp
+	(*Job)(nil),            // 3: mgmt.Job
+	(*JobListReq)(nil),     // 4: mgmt.JobListReq
+	(*JobListResp)(nil),    // 5: mgmt.JobListResp
+	(*JobCancelReq)(nil),   // 6: mgmt.JobCancelReq
+	(*PoolCreateReq)(nil),  // 7: mgmt.PoolCreateReq
+	(*PoolDestroyReq)(nil), // 8: mgmt.PoolDestroyReq
+	(*PoolReintReq)(nil),   // 9: mgmt.PoolReintReq
+	(*PoolCreateResp)(nil), // 10: mgmt.PoolCreateResp
+}
+var file_mgmt_job_proto_depIdxs = []int32{
+	7,  // 0: mgmt.JobSubmitReq.pool_create:type_name -> mgmt.PoolCreateReq
+	8,  // 1: mgmt.JobSubmitReq.pool_destroy:type_name -> mgmt.PoolDestroyReq
+	9,  // 2: mgmt.JobSubmitReq.pool_reint:type_name -> mgmt.PoolReintReq
+	0,  // 3: mgmt.Job.state:type_name -> mgmt.JobState
+	10, // 4: mgmt.Job.pool_create:type_name -> mgmt.PoolCreateResp
+	3,  // 5: mgmt.JobListResp.jobs:type_name -> mgmt.Job
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_mgmt_job_proto_init() }
+func file_mgmt_job_proto_init() {
+	if File_mgmt_job_proto != nil {
+		return
+	}
+	file_mgmt_pool_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mgmt_job_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSubmitReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_job_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSubmitResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_job_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_job_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_job_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mgmt_job_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobCancelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mgmt_job_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*JobSubmitReq_PoolCreate)(nil),
+		(*JobSubmitReq_PoolDestroy)(nil),
+		(*JobSubmitReq_PoolReint)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mgmt_job_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mgmt_job_proto_goTypes,
+		DependencyIndexes: file_mgmt_job_proto_depIdxs,
+		EnumInfos:         file_mgmt_job_proto_enumTypes,
+		MessageInfos:      file_mgmt_job_proto_msgTypes,
+	}.Build()
+	File_mgmt_job_proto = out.File
+	file_mgmt_job_proto_rawDesc = nil
+	file_mgmt_job_proto_goTypes = nil
+	file_mgmt_job_proto_depIdxs = nil
+}
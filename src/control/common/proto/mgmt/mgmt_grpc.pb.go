@@ -8,7 +8,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.14.0
+// - protoc             v3.5.0
 // source: mgmt/mgmt.proto
 
 package mgmt
@@ -37,6 +37,10 @@ const (
 	MgmtSvc_PoolExclude_FullMethodName              = "/mgmt.MgmtSvc/PoolExclude"
 	MgmtSvc_PoolDrain_FullMethodName                = "/mgmt.MgmtSvc/PoolDrain"
 	MgmtSvc_PoolExtend_FullMethodName               = "/mgmt.MgmtSvc/PoolExtend"
+	MgmtSvc_PoolResize_FullMethodName               = "/mgmt.MgmtSvc/PoolResize"
+	MgmtSvc_PoolClone_FullMethodName                = "/mgmt.MgmtSvc/PoolClone"
+	MgmtSvc_PoolProfileSet_FullMethodName           = "/mgmt.MgmtSvc/PoolProfileSet"
+	MgmtSvc_PoolProfileGet_FullMethodName           = "/mgmt.MgmtSvc/PoolProfileGet"
 	MgmtSvc_PoolReintegrate_FullMethodName          = "/mgmt.MgmtSvc/PoolReintegrate"
 	MgmtSvc_PoolQuery_FullMethodName                = "/mgmt.MgmtSvc/PoolQuery"
 	MgmtSvc_PoolQueryTarget_FullMethodName          = "/mgmt.MgmtSvc/PoolQueryTarget"
@@ -75,6 +79,10 @@ const (
 	MgmtSvc_SystemGetAttr_FullMethodName            = "/mgmt.MgmtSvc/SystemGetAttr"
 	MgmtSvc_SystemSetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemSetProp"
 	MgmtSvc_SystemGetProp_FullMethodName            = "/mgmt.MgmtSvc/SystemGetProp"
+	MgmtSvc_SystemEventsQuery_FullMethodName        = "/mgmt.MgmtSvc/SystemEventsQuery"
+	MgmtSvc_JobSubmit_FullMethodName                = "/mgmt.MgmtSvc/JobSubmit"
+	MgmtSvc_JobList_FullMethodName                  = "/mgmt.MgmtSvc/JobList"
+	MgmtSvc_JobCancel_FullMethodName                = "/mgmt.MgmtSvc/JobCancel"
 	MgmtSvc_FaultInjectReport_FullMethodName        = "/mgmt.MgmtSvc/FaultInjectReport"
 	MgmtSvc_FaultInjectPoolFault_FullMethodName     = "/mgmt.MgmtSvc/FaultInjectPoolFault"
 	MgmtSvc_FaultInjectMgmtPoolFault_FullMethodName = "/mgmt.MgmtSvc/FaultInjectMgmtPoolFault"
@@ -110,6 +118,14 @@ type MgmtSvcClient interface {
 	PoolDrain(ctx context.Context, in *PoolDrainReq, opts ...grpc.CallOption) (*PoolDrainResp, error)
 	// Extend a pool.
 	PoolExtend(ctx context.Context, in *PoolExtendReq, opts ...grpc.CallOption) (*PoolExtendResp, error)
+	// Grow the per-rank storage allocation of a pool.
+	PoolResize(ctx context.Context, in *PoolResizeReq, opts ...grpc.CallOption) (*PoolResizeResp, error)
+	// Create a new pool with the same layout, properties and ACL as an existing pool.
+	PoolClone(ctx context.Context, in *PoolCloneReq, opts ...grpc.CallOption) (*PoolCloneResp, error)
+	// Store or delete a named pool profile.
+	PoolProfileSet(ctx context.Context, in *PoolProfileSetReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Fetch named pool profiles.
+	PoolProfileGet(ctx context.Context, in *PoolProfileGetReq, opts ...grpc.CallOption) (*PoolProfileGetResp, error)
 	// Reintegrate a pool target.
 	PoolReintegrate(ctx context.Context, in *PoolReintReq, opts ...grpc.CallOption) (*PoolReintResp, error)
 	// PoolQuery queries a DAOS pool.
@@ -186,6 +202,14 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// Query the RAS events retained by the MS.
+	SystemEventsQuery(ctx context.Context, in *SystemEventsQueryReq, opts ...grpc.CallOption) (*SystemEventsQueryResp, error)
+	// Submit a long-running operation to be run asynchronously.
+	JobSubmit(ctx context.Context, in *JobSubmitReq, opts ...grpc.CallOption) (*JobSubmitResp, error)
+	// List asynchronous jobs.
+	JobList(ctx context.Context, in *JobListReq, opts ...grpc.CallOption) (*JobListResp, error)
+	// Cancel a running asynchronous job.
+	JobCancel(ctx context.Context, in *JobCancelReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error)
@@ -292,6 +316,46 @@ func (c *mgmtSvcClient) PoolExtend(ctx context.Context, in *PoolExtendReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolResize(ctx context.Context, in *PoolResizeReq, opts ...grpc.CallOption) (*PoolResizeResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolResizeResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolResize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolClone(ctx context.Context, in *PoolCloneReq, opts ...grpc.CallOption) (*PoolCloneResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolCloneResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolClone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolProfileSet(ctx context.Context, in *PoolProfileSetReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolProfileSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolProfileGet(ctx context.Context, in *PoolProfileGetReq, opts ...grpc.CallOption) (*PoolProfileGetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PoolProfileGetResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_PoolProfileGet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolReintegrate(ctx context.Context, in *PoolReintReq, opts ...grpc.CallOption) (*PoolReintResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PoolReintResp)
@@ -672,6 +736,46 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemEventsQuery(ctx context.Context, in *SystemEventsQueryReq, opts ...grpc.CallOption) (*SystemEventsQueryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SystemEventsQueryResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_SystemEventsQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) JobSubmit(ctx context.Context, in *JobSubmitReq, opts ...grpc.CallOption) (*JobSubmitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobSubmitResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_JobSubmit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) JobList(ctx context.Context, in *JobListReq, opts ...grpc.CallOption) (*JobListResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobListResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_JobList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) JobCancel(ctx context.Context, in *JobCancelReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, MgmtSvc_JobCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) FaultInjectReport(ctx context.Context, in *chk.CheckReport, opts ...grpc.CallOption) (*DaosResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DaosResp)
@@ -732,6 +836,14 @@ type MgmtSvcServer interface {
 	PoolDrain(context.Context, *PoolDrainReq) (*PoolDrainResp, error)
 	// Extend a pool.
 	PoolExtend(context.Context, *PoolExtendReq) (*PoolExtendResp, error)
+	// Grow the per-rank storage allocation of a pool.
+	PoolResize(context.Context, *PoolResizeReq) (*PoolResizeResp, error)
+	// Create a new pool with the same layout, properties and ACL as an existing pool.
+	PoolClone(context.Context, *PoolCloneReq) (*PoolCloneResp, error)
+	// Store or delete a named pool profile.
+	PoolProfileSet(context.Context, *PoolProfileSetReq) (*DaosResp, error)
+	// Fetch named pool profiles.
+	PoolProfileGet(context.Context, *PoolProfileGetReq) (*PoolProfileGetResp, error)
 	// Reintegrate a pool target.
 	PoolReintegrate(context.Context, *PoolReintReq) (*PoolReintResp, error)
 	// PoolQuery queries a DAOS pool.
@@ -808,6 +920,14 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// Query the RAS events retained by the MS.
+	SystemEventsQuery(context.Context, *SystemEventsQueryReq) (*SystemEventsQueryResp, error)
+	// Submit a long-running operation to be run asynchronously.
+	JobSubmit(context.Context, *JobSubmitReq) (*JobSubmitResp, error)
+	// List asynchronous jobs.
+	JobList(context.Context, *JobListReq) (*JobListResp, error)
+	// Cancel a running asynchronous job.
+	JobCancel(context.Context, *JobCancelReq) (*DaosResp, error)
 	// Fault injection handlers are only implemented in non-release builds.
 	// FaultInjectReport injects a checker report.
 	FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error)
@@ -851,6 +971,18 @@ func (UnimplementedMgmtSvcServer) PoolDrain(context.Context, *PoolDrainReq) (*Po
 func (UnimplementedMgmtSvcServer) PoolExtend(context.Context, *PoolExtendReq) (*PoolExtendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolExtend not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolResize(context.Context, *PoolResizeReq) (*PoolResizeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolResize not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolClone(context.Context, *PoolCloneReq) (*PoolCloneResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolClone not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolProfileSet(context.Context, *PoolProfileSetReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProfileSet not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolProfileGet(context.Context, *PoolProfileGetReq) (*PoolProfileGetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProfileGet not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolReintegrate(context.Context, *PoolReintReq) (*PoolReintResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolReintegrate not implemented")
 }
@@ -965,6 +1097,18 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemEventsQuery(context.Context, *SystemEventsQueryReq) (*SystemEventsQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemEventsQuery not implemented")
+}
+func (UnimplementedMgmtSvcServer) JobSubmit(context.Context, *JobSubmitReq) (*JobSubmitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobSubmit not implemented")
+}
+func (UnimplementedMgmtSvcServer) JobList(context.Context, *JobListReq) (*JobListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobList not implemented")
+}
+func (UnimplementedMgmtSvcServer) JobCancel(context.Context, *JobCancelReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JobCancel not implemented")
+}
 func (UnimplementedMgmtSvcServer) FaultInjectReport(context.Context, *chk.CheckReport) (*DaosResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FaultInjectReport not implemented")
 }
@@ -1157,6 +1301,78 @@ func _MgmtSvc_PoolExtend_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolResize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolResizeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolResize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolResize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolResize(ctx, req.(*PoolResizeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolClone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolCloneReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolClone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolClone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolClone(ctx, req.(*PoolCloneReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolProfileSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProfileSetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProfileSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolProfileSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProfileSet(ctx, req.(*PoolProfileSetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolProfileGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProfileGetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProfileGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_PoolProfileGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProfileGet(ctx, req.(*PoolProfileGetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolReintegrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolReintReq)
 	if err := dec(in); err != nil {
@@ -1841,6 +2057,78 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemEventsQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemEventsQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemEventsQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_SystemEventsQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemEventsQuery(ctx, req.(*SystemEventsQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_JobSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSubmitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).JobSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_JobSubmit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).JobSubmit(ctx, req.(*JobSubmitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_JobList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).JobList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_JobList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).JobList(ctx, req.(*JobListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_JobCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobCancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).JobCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MgmtSvc_JobCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).JobCancel(ctx, req.(*JobCancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_FaultInjectReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(chk.CheckReport)
 	if err := dec(in); err != nil {
@@ -1938,6 +2226,22 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolExtend",
 			Handler:    _MgmtSvc_PoolExtend_Handler,
 		},
+		{
+			MethodName: "PoolResize",
+			Handler:    _MgmtSvc_PoolResize_Handler,
+		},
+		{
+			MethodName: "PoolClone",
+			Handler:    _MgmtSvc_PoolClone_Handler,
+		},
+		{
+			MethodName: "PoolProfileSet",
+			Handler:    _MgmtSvc_PoolProfileSet_Handler,
+		},
+		{
+			MethodName: "PoolProfileGet",
+			Handler:    _MgmtSvc_PoolProfileGet_Handler,
+		},
 		{
 			MethodName: "PoolReintegrate",
 			Handler:    _MgmtSvc_PoolReintegrate_Handler,
@@ -2090,6 +2394,22 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemEventsQuery",
+			Handler:    _MgmtSvc_SystemEventsQuery_Handler,
+		},
+		{
+			MethodName: "JobSubmit",
+			Handler:    _MgmtSvc_JobSubmit_Handler,
+		},
+		{
+			MethodName: "JobList",
+			Handler:    _MgmtSvc_JobList_Handler,
+		},
+		{
+			MethodName: "JobCancel",
+			Handler:    _MgmtSvc_JobCancel_Handler,
+		},
 		{
 			MethodName: "FaultInjectReport",
 			Handler:    _MgmtSvc_FaultInjectReport_Handler,
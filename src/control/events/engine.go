@@ -8,7 +8,11 @@
 package events
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
@@ -90,6 +94,21 @@ func NewEngineFormatRequiredEvent(hostname string, instanceIdx uint32, formatTyp
 	})
 }
 
+// NewEngineScmRemountedEvent creates an EngineScmRemounted event from given inputs.
+func NewEngineScmRemountedEvent(hostname string, instanceIdx uint32, mountPoint string, actions []string) *RASEvent {
+	return fill(&RASEvent{
+		Msg: fmt.Sprintf("DAOS engine %d SCM at %s was remounted: %s", instanceIdx,
+			mountPoint, strings.Join(actions, ", ")),
+		ID:       RASEngineScmRemounted,
+		Hostname: hostname,
+		Type:     RASTypeInfoOnly,
+		Severity: RASSeverityNotice,
+		ExtendedInfo: &EngineStateInfo{
+			InstanceIdx: instanceIdx,
+		},
+	})
+}
+
 // NewEngineJoinFailedEvent creates an EngineJoinFailed event from the given inputs.
 func NewEngineJoinFailedEvent(hostname string, instanceIdx uint32, rank uint32, incarnation uint64, reason string) *RASEvent {
 	return fill(&RASEvent{
@@ -103,3 +122,52 @@ func NewEngineJoinFailedEvent(hostname string, instanceIdx uint32, rank uint32,
 		ExtendedInfo: NewStrInfo(reason),
 	})
 }
+
+// EngineCrashInfo summarizes the crash report written after an engine exited unexpectedly.
+// It is carried as JSON in the string info of an EngineCrashReported event.
+type EngineCrashInfo struct {
+	InstanceIdx uint32 `json:"instance_idx"`
+	ExitStatus  string `json:"exit_status"`
+	Signal      string `json:"signal,omitempty"`
+	CoreDump    string `json:"core_dump,omitempty"`
+	ReportPath  string `json:"report_path"`
+}
+
+// NewEngineCrashReportedEvent creates an EngineCrashReported event from the given inputs.
+func NewEngineCrashReportedEvent(hostname string, rank uint32, incarnation uint64, exPid int, info *EngineCrashInfo) *RASEvent {
+	strInfo, err := json.Marshal(info)
+	if err != nil {
+		strInfo = []byte(err.Error())
+	}
+
+	return fill(&RASEvent{
+		Msg: fmt.Sprintf("DAOS engine %d crashed (%s), report written to %s",
+			info.InstanceIdx, info.ExitStatus, info.ReportPath),
+		ID:           RASEngineCrashReported,
+		Hostname:     hostname,
+		Rank:         rank,
+		Incarnation:  incarnation,
+		Type:         RASTypeInfoOnly,
+		Severity:     RASSeverityError,
+		ProcID:       exPid, // pid of crashed engine
+		ExtendedInfo: NewStrInfo(string(strInfo)),
+	})
+}
+
+// GetEngineCrashInfo returns the crash report summary carried by an EngineCrashReported event.
+func (evt *RASEvent) GetEngineCrashInfo() (*EngineCrashInfo, error) {
+	if evt.ID != RASEngineCrashReported {
+		return nil, errors.Errorf("unexpected event ID %s", evt.ID)
+	}
+	si := evt.GetStrInfo()
+	if si == nil {
+		return nil, errors.New("event has no crash report info")
+	}
+
+	info := new(EngineCrashInfo)
+	if err := json.Unmarshal([]byte(*si), info); err != nil {
+		return nil, errors.Wrap(err, "decode crash report info")
+	}
+
+	return info, nil
+}
@@ -127,3 +127,47 @@ func TestEvents_ConvertEngineFormatRequired(t *testing.T) {
 		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
 	}
 }
+
+func TestEvents_NewEngineCrashReportedEvent(t *testing.T) {
+	info := &EngineCrashInfo{
+		InstanceIdx: tInstanceIdx,
+		ExitStatus:  "signal: segmentation fault (core dumped)",
+		Signal:      "segmentation fault",
+		CoreDump:    "/var/crash/core.1234",
+		ReportPath:  "/var/log/daos/crash/engine1-20250102T150405Z.json",
+	}
+	evt := NewEngineCrashReportedEvent(tHost, tRank, tIncarnation, tPid, info)
+
+	test.AssertEqual(t, RASEngineCrashReported, evt.ID, "")
+	test.AssertEqual(t, RASTypeInfoOnly, evt.Type, "")
+	test.AssertEqual(t, RASSeverityError, evt.Severity, "")
+
+	test.AssertEqual(t, "DAOS engine 1 crashed (signal: segmentation fault (core dumped)), "+
+		"report written to /var/log/daos/crash/engine1-20250102T150405Z.json", evt.Msg, "")
+
+	test.AssertEqual(t, tHost, evt.Hostname, "")
+	test.AssertEqual(t, tRank, evt.Rank, "")
+	test.AssertEqual(t, tIncarnation, evt.Incarnation, "")
+	test.AssertEqual(t, tPid, evt.ProcID, "")
+
+	pbEvent, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	returnedEvent, err := NewFromProto(pbEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotInfo, err := returnedEvent.GetEngineCrashInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(info, gotInfo); diff != "" {
+		t.Fatalf("unexpected crash info (-want, +got):\n%s\n", diff)
+	}
+
+	if _, err := mockEvtDied(t).GetEngineCrashInfo(); err == nil {
+		t.Fatal("expected error for event of wrong type")
+	}
+}
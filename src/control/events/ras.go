@@ -13,6 +13,7 @@ package events
 import "C"
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/syslog"
@@ -58,6 +59,18 @@ const (
 	RASSystemFabricProvChanged RASID = C.RAS_SYSTEM_FABRIC_PROV_CHANGED // info
 	RASNVMeLinkSpeedChanged    RASID = C.RAS_DEVICE_LINK_SPEED_CHANGED  // warning|notice
 	RASNVMeLinkWidthChanged    RASID = C.RAS_DEVICE_LINK_WIDTH_CHANGED  // warning|notice
+	RASEngineScmRemounted      RASID = C.RAS_ENGINE_SCM_REMOUNTED       // notice
+	RASMgmtRequestAudited      RASID = C.RAS_MGMT_REQUEST_AUDITED       // notice
+	RASRevokedCertRejected     RASID = C.RAS_REVOKED_CERT_REJECTED      // warning
+	RASTelemetryAlertRaised    RASID = C.RAS_TELEMETRY_ALERT_RAISED     // warning|error
+	RASTelemetryAlertCleared   RASID = C.RAS_TELEMETRY_ALERT_CLEARED    // notice
+	RASDeviceFailurePredicted  RASID = C.RAS_DEVICE_FAILURE_PREDICTED   // warning|error
+	RASEngineCrashReported     RASID = C.RAS_ENGINE_CRASH_REPORTED      // error
+	RASDeviceSetFaulty         RASID = C.RAS_DEVICE_SET_FAULTY          // notice|error
+	RASDeviceSpareSubstituted  RASID = C.RAS_DEVICE_SPARE_SUBSTITUTED   // notice|error
+	RASDevicePlugged           RASID = C.RAS_DEVICE_PLUGGED             // notice
+	RASDeviceInserted          RASID = C.RAS_DEVICE_INSERTED            // notice
+	RASDeviceHotplugHandled    RASID = C.RAS_DEVICE_HOTPLUG_HANDLED     // notice|error
 )
 
 func (id RASID) String() string {
@@ -121,23 +134,25 @@ func (sev RASSeverityID) SyslogPriority() syslog.Priority {
 
 // RASEvent describes details of a specific RAS event.
 type RASEvent struct {
-	ID           RASID           `json:"id"`
-	Timestamp    string          `json:"timestamp"`
-	Type         RASTypeID       `json:"type"`
-	Severity     RASSeverityID   `json:"severity"`
-	Msg          string          `json:"msg"`
-	Hostname     string          `json:"hostname"`
-	Rank         uint32          `json:"rank"`
-	Incarnation  uint64          `json:"incarnation"`
-	HWID         string          `json:"hw_id"`
-	ProcID       int             `json:"proc_id"`
-	ThreadID     uint64          `json:"thread_id"`
-	JobID        string          `json:"job_id"`
-	PoolUUID     string          `json:"pool_uuid"`
-	ContUUID     string          `json:"cont_uuid"`
-	ObjID        string          `json:"obj_id"`
-	CtlOp        string          `json:"ctl_op"`
-	ExtendedInfo RASExtendedInfo `json:"extended_info"`
+	ID            RASID           `json:"id"`
+	Timestamp     string          `json:"timestamp"`
+	Type          RASTypeID       `json:"type"`
+	Severity      RASSeverityID   `json:"severity"`
+	Msg           string          `json:"msg"`
+	Hostname      string          `json:"hostname"`
+	Rank          uint32          `json:"rank"`
+	Incarnation   uint64          `json:"incarnation"`
+	HWID          string          `json:"hw_id"`
+	ProcID        int             `json:"proc_id"`
+	ThreadID      uint64          `json:"thread_id"`
+	JobID         string          `json:"job_id"`
+	PoolUUID      string          `json:"pool_uuid"`
+	ContUUID      string          `json:"cont_uuid"`
+	ObjID         string          `json:"obj_id"`
+	CtlOp         string          `json:"ctl_op"`
+	CorrelationID string          `json:"correlation_id"`
+	Count         uint32          `json:"count"`
+	ExtendedInfo  RASExtendedInfo `json:"extended_info"`
 
 	forwarded   atm.Bool
 	forwardable atm.Bool
@@ -178,6 +193,52 @@ func (evt *RASEvent) WithRank(rid uint32) *RASEvent {
 	return evt
 }
 
+// WithCorrelationID sets the identifier of the management operation that raised the event.
+func (evt *RASEvent) WithCorrelationID(id string) *RASEvent {
+	evt.CorrelationID = id
+	return evt
+}
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a copy of the context carrying the identifier of the management
+// operation being handled, so that it can be attached to any events raised by the operation.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the identifier of the management operation carried by the
+// context, or an empty string if none is set.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// correlatedPublisher sets the correlation ID of the management operation on each event before
+// passing it to the underlying publisher.
+type correlatedPublisher struct {
+	id  string
+	pub Publisher
+}
+
+func (cp *correlatedPublisher) Publish(evt *RASEvent) {
+	if evt != nil && evt.CorrelationID == "" {
+		evt.CorrelationID = cp.id
+	}
+	cp.pub.Publish(evt)
+}
+
+// CorrelatedPublisher returns a Publisher that links the events it publishes to the management
+// operation carried by the context. The supplied publisher is returned if the context doesn't
+// carry a correlation ID.
+func CorrelatedPublisher(ctx context.Context, pub Publisher) Publisher {
+	id := CorrelationIDFromContext(ctx)
+	if id == "" {
+		return pub
+	}
+	return &correlatedPublisher{id: id, pub: pub}
+}
+
 // fill accepts a pointer to a RASEvent and fills in any
 // missing fields before returning the event.
 func fill(evt *RASEvent) *RASEvent {
@@ -273,22 +334,24 @@ func (evt *RASEvent) ToProto() (*sharedpb.RASEvent, error) {
 // FromProto initializes a native event from a provided protobuf event.
 func (evt *RASEvent) FromProto(pbEvt *sharedpb.RASEvent) (err error) {
 	*evt = RASEvent{
-		ID:          RASID(pbEvt.Id),
-		Timestamp:   pbEvt.Timestamp,
-		Type:        RASTypeID(pbEvt.Type),
-		Severity:    RASSeverityID(pbEvt.Severity),
-		Msg:         pbEvt.Msg,
-		Hostname:    pbEvt.Hostname,
-		Rank:        pbEvt.Rank,
-		Incarnation: pbEvt.Incarnation,
-		HWID:        pbEvt.HwId,
-		ProcID:      int(pbEvt.ProcId),
-		ThreadID:    pbEvt.ThreadId,
-		JobID:       pbEvt.JobId,
-		PoolUUID:    pbEvt.PoolUuid,
-		ContUUID:    pbEvt.ContUuid,
-		ObjID:       pbEvt.ObjId,
-		CtlOp:       pbEvt.CtlOp,
+		ID:            RASID(pbEvt.Id),
+		Timestamp:     pbEvt.Timestamp,
+		Type:          RASTypeID(pbEvt.Type),
+		Severity:      RASSeverityID(pbEvt.Severity),
+		Msg:           pbEvt.Msg,
+		Hostname:      pbEvt.Hostname,
+		Rank:          pbEvt.Rank,
+		Incarnation:   pbEvt.Incarnation,
+		HWID:          pbEvt.HwId,
+		ProcID:        int(pbEvt.ProcId),
+		ThreadID:      pbEvt.ThreadId,
+		JobID:         pbEvt.JobId,
+		PoolUUID:      pbEvt.PoolUuid,
+		ContUUID:      pbEvt.ContUuid,
+		ObjID:         pbEvt.ObjId,
+		CtlOp:         pbEvt.CtlOp,
+		CorrelationID: pbEvt.CorrelationId,
+		Count:         pbEvt.Count,
 	}
 
 	evt.forwarded.SetFalse()
@@ -363,6 +426,12 @@ func (evt *RASEvent) PrintRAS() string {
 	if evt.CtlOp != "" {
 		fmt.Fprintf(&b, " ctlop: [%s]", evt.CtlOp)
 	}
+	if evt.CorrelationID != "" {
+		fmt.Fprintf(&b, " correlation: [%s]", evt.CorrelationID)
+	}
+	if evt.Count > 0 {
+		fmt.Fprintf(&b, " count: [%d]", evt.Count)
+	}
 
 	// log data blob if event info is non-specific
 	if ei := evt.GetStrInfo(); ei != nil && *ei != "" {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package events
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -123,3 +125,61 @@ func TestEvents_HandleClusterEvent(t *testing.T) {
 		})
 	}
 }
+
+type publisherFunc func(*RASEvent)
+
+func (f publisherFunc) Publish(evt *RASEvent) {
+	f(evt)
+}
+
+func TestEvents_CorrelatedPublisher(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ctx   context.Context
+		evtID string
+		expID string
+	}{
+		"no correlation ID": {
+			ctx: test.Context(t),
+		},
+		"correlation ID": {
+			ctx:   WithCorrelationID(test.Context(t), "op-1"),
+			expID: "op-1",
+		},
+		"event already correlated": {
+			ctx:   WithCorrelationID(test.Context(t), "op-1"),
+			evtID: "op-0",
+			expID: "op-0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var published *RASEvent
+			pub := CorrelatedPublisher(tc.ctx, publisherFunc(func(evt *RASEvent) {
+				published = evt
+			}))
+
+			pub.Publish(mockEvtDied(t).WithCorrelationID(tc.evtID))
+
+			test.AssertEqual(t, tc.expID, published.CorrelationID, "unexpected correlation ID")
+		})
+	}
+}
+
+func TestEvents_RASEvent_ProtoRoundTrip(t *testing.T) {
+	evt := mockEvtDied(t).WithCorrelationID("op-1")
+	evt.Count = 3
+
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "op-1", pbEvt.CorrelationId, "unexpected proto correlation ID")
+	test.AssertEqual(t, uint32(3), pbEvt.Count, "unexpected proto count")
+
+	got, err := NewFromProto(pbEvt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(evt, got, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,185 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// DefaultRateLimitBurst is the number of occurrences of an event reported in each rate-limit
+// window if no burst is configured.
+const DefaultRateLimitBurst = 1
+
+// RateLimitConfig configures the suppression of repeated RAS events. Occurrences of an event
+// beyond the burst within each window are counted rather than reported individually.
+type RateLimitConfig struct {
+	Window time.Duration `yaml:"window"`
+	Burst  int           `yaml:"burst,omitempty"`
+}
+
+// Validate checks the window and burst.
+func (rlc *RateLimitConfig) Validate() error {
+	if rlc.Window <= 0 {
+		return errors.New("window must be greater than zero")
+	}
+	if rlc.Burst < 0 {
+		return errors.New("burst must not be negative")
+	}
+	return nil
+}
+
+// GetBurst returns the number of occurrences reported in each window, or the default if none
+// is configured.
+func (rlc *RateLimitConfig) GetBurst() int {
+	if rlc.Burst == 0 {
+		return DefaultRateLimitBurst
+	}
+	return rlc.Burst
+}
+
+// rateLimitKey identifies repeated occurrences of an event, i.e. events with the same ID and
+// message that have been raised by the same component.
+func rateLimitKey(evt *RASEvent) string {
+	return fmt.Sprintf("%d/%s/%d/%s/%s/%s/%s/%s", evt.ID, evt.Hostname, evt.Rank, evt.HWID,
+		evt.PoolUUID, evt.ContUUID, evt.ObjID, evt.Msg)
+}
+
+// rateLimitEntry tracks the occurrences of an event within the current window.
+type rateLimitEntry struct {
+	start      time.Time
+	reported   int
+	suppressed uint32
+	last       *RASEvent
+}
+
+// summary returns the last suppressed occurrence of the event with its count set to the number
+// of occurrences suppressed, or nil if none were suppressed.
+func (rle *rateLimitEntry) summary() *RASEvent {
+	if rle.suppressed == 0 {
+		return nil
+	}
+	rle.last.Count = rle.suppressed
+	return rle.last
+}
+
+// RateLimiter implements the Handler interface and passes events on to a set of handlers,
+// suppressing repeated occurrences of an event beyond the configured burst within each window.
+// Once a window in which occurrences were suppressed has elapsed, the last of them is passed on
+// with its count set to the number suppressed, so that e.g. a flapping device is reported at
+// most burst+1 times per window. Forwarded events are passed on unchanged as they have already
+// been rate-limited at their source.
+type RateLimiter struct {
+	sync.Mutex
+	log      logging.Logger
+	window   time.Duration
+	burst    int
+	handlers []Handler
+	entries  map[string]*rateLimitEntry
+}
+
+// NewRateLimiter returns a RateLimiter passing events on to the supplied handlers.
+func NewRateLimiter(log logging.Logger, cfg *RateLimitConfig, handlers ...Handler) *RateLimiter {
+	return &RateLimiter{
+		log:      log,
+		window:   cfg.Window,
+		burst:    cfg.GetBurst(),
+		handlers: handlers,
+		entries:  make(map[string]*rateLimitEntry),
+	}
+}
+
+func (rl *RateLimiter) dispatch(ctx context.Context, evts ...*RASEvent) {
+	for _, evt := range evts {
+		for _, hdlr := range rl.handlers {
+			hdlr.OnEvent(ctx, evt)
+		}
+	}
+}
+
+// admit records an occurrence of the event and returns the events to be passed on: the summary
+// of the previous window if it has elapsed, followed by the event if it is within the burst.
+func (rl *RateLimiter) admit(evt *RASEvent, now time.Time) []*RASEvent {
+	rl.Lock()
+	defer rl.Unlock()
+
+	var admitted []*RASEvent
+	key := rateLimitKey(evt)
+	entry, found := rl.entries[key]
+	if found && now.Sub(entry.start) >= rl.window {
+		if sum := entry.summary(); sum != nil {
+			admitted = append(admitted, sum)
+		}
+		found = false
+	}
+	if !found {
+		entry = &rateLimitEntry{start: now}
+		rl.entries[key] = entry
+	}
+
+	if entry.reported < rl.burst {
+		entry.reported++
+		return append(admitted, evt)
+	}
+
+	if entry.suppressed == 0 {
+		rl.log.Debugf("rate-limiting %s events for %s", evt.ID, rl.window)
+	}
+	entry.suppressed++
+	entry.last = evt
+	return admitted
+}
+
+// expire removes the entries whose window has elapsed and returns their summaries.
+func (rl *RateLimiter) expire(now time.Time) []*RASEvent {
+	rl.Lock()
+	defer rl.Unlock()
+
+	var summaries []*RASEvent
+	for key, entry := range rl.entries {
+		if now.Sub(entry.start) < rl.window {
+			continue
+		}
+		if sum := entry.summary(); sum != nil {
+			summaries = append(summaries, sum)
+		}
+		delete(rl.entries, key)
+	}
+
+	return summaries
+}
+
+// OnEvent implements the Handler interface.
+func (rl *RateLimiter) OnEvent(ctx context.Context, evt *RASEvent) {
+	if evt == nil || evt.IsForwarded() {
+		rl.dispatch(ctx, evt)
+		return
+	}
+
+	rl.dispatch(ctx, rl.admit(evt, time.Now())...)
+}
+
+// Run periodically passes on the summaries of elapsed windows until the context is canceled.
+func (rl *RateLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(rl.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			rl.dispatch(ctx, rl.expire(now)...)
+		}
+	}
+}
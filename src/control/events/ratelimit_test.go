@@ -0,0 +1,109 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEvents_RateLimitConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg      *RateLimitConfig
+		expBurst int
+		expErr   error
+	}{
+		"missing window": {
+			cfg:    &RateLimitConfig{},
+			expErr: errors.New("window must be greater than zero"),
+		},
+		"negative burst": {
+			cfg:    &RateLimitConfig{Window: time.Minute, Burst: -1},
+			expErr: errors.New("burst must not be negative"),
+		},
+		"default burst": {
+			cfg:      &RateLimitConfig{Window: time.Minute},
+			expBurst: DefaultRateLimitBurst,
+		},
+		"burst": {
+			cfg:      &RateLimitConfig{Window: time.Minute, Burst: 5},
+			expBurst: 5,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			test.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expBurst, tc.cfg.GetBurst(), "unexpected burst")
+		})
+	}
+}
+
+func TestEvents_RateLimiter(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tly := newTally(0)
+	rl := NewRateLimiter(log, &RateLimitConfig{Window: time.Minute, Burst: 2}, tly)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		rl.dispatch(test.Context(t), rl.admit(mockEvtDied(t), start.Add(time.Duration(i)*time.Second))...)
+	}
+	// Events from other components are limited independently.
+	rl.dispatch(test.Context(t), rl.admit(mockEvtDied(t).WithRank(2), start)...)
+	// Forwarded events have already been limited at their source.
+	for i := 0; i < 3; i++ {
+		rl.OnEvent(test.Context(t), mockEvtDied(t).WithForwarded(true))
+	}
+
+	test.AssertEqual(t, 6, len(tly.getRx()), "unexpected number of events within window")
+
+	// Nothing is reported before the window has elapsed.
+	test.AssertEqual(t, 0, len(rl.expire(start.Add(30*time.Second))), "unexpected summaries")
+
+	summaries := rl.expire(start.Add(time.Minute))
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 summary, got %d", len(summaries))
+	}
+	test.AssertEqual(t, RASEngineDied, summaries[0].ID, "unexpected summary event")
+	test.AssertEqual(t, uint32(3), summaries[0].Count, "unexpected summary count")
+	test.AssertEqual(t, 0, len(rl.entries), "expected elapsed entries to be removed")
+
+	// A new window starts with the next occurrence.
+	admitted := rl.admit(mockEvtDied(t), start.Add(2*time.Minute))
+	test.AssertEqual(t, 1, len(admitted), "unexpected events admitted in new window")
+	test.AssertEqual(t, uint32(0), admitted[0].Count, "unexpected count on admitted event")
+}
+
+func TestEvents_RateLimiter_SummaryOnNextOccurrence(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	rl := NewRateLimiter(log, &RateLimitConfig{Window: time.Minute})
+
+	start := time.Now()
+	test.AssertEqual(t, 1, len(rl.admit(mockEvtDied(t), start)), "first occurrence not admitted")
+	test.AssertEqual(t, 0, len(rl.admit(mockEvtDied(t), start.Add(time.Second))),
+		"repeated occurrence admitted")
+
+	// An occurrence after the window has elapsed reports the suppressed occurrences of the
+	// previous window if they haven't already been expired.
+	admitted := rl.admit(mockEvtDied(t), start.Add(time.Minute))
+	if len(admitted) != 2 {
+		t.Fatalf("expected summary and event, got %d events", len(admitted))
+	}
+	test.AssertEqual(t, uint32(1), admitted[0].Count, "unexpected summary count")
+	test.AssertEqual(t, uint32(0), admitted[1].Count, "unexpected event count")
+}
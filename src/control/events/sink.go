@@ -0,0 +1,449 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// Types of sink that events may be delivered to.
+const (
+	SinkTypeSyslog  = "syslog"
+	SinkTypeWebhook = "webhook"
+	SinkTypeKafka   = "kafka"
+)
+
+const (
+	// sinkSendTimeout bounds the time spent delivering a single event to a sink.
+	sinkSendTimeout = 10 * time.Second
+	// kafkaContentType is the content type of records produced through a Kafka REST Proxy.
+	kafkaContentType = "application/vnd.kafka.json.v2+json"
+	syslogTag        = "daos_server"
+)
+
+var syslogFacilities = map[string]syslog.Priority{
+	"kern":   syslog.LOG_KERN,
+	"user":   syslog.LOG_USER,
+	"daemon": syslog.LOG_DAEMON,
+	"local0": syslog.LOG_LOCAL0,
+	"local1": syslog.LOG_LOCAL1,
+	"local2": syslog.LOG_LOCAL2,
+	"local3": syslog.LOG_LOCAL3,
+	"local4": syslog.LOG_LOCAL4,
+	"local5": syslog.LOG_LOCAL5,
+	"local6": syslog.LOG_LOCAL6,
+	"local7": syslog.LOG_LOCAL7,
+}
+
+// ParseRASSeverity returns the severity with the given name, e.g. "warning".
+func ParseRASSeverity(name string) (RASSeverityID, error) {
+	for _, sev := range []RASSeverityID{RASSeverityError, RASSeverityWarning, RASSeverityNotice} {
+		if strings.EqualFold(name, sev.String()) {
+			return sev, nil
+		}
+	}
+	return RASSeverityUnknown, errors.Errorf("unknown RAS event severity %q", name)
+}
+
+// ParseRASID returns the ID of the event with the given name, e.g. "engine_died".
+func ParseRASID(name string) (RASID, error) {
+	unknown := RASID(^uint32(0)).String()
+	for id := RASUnknownEvent + 1; id.String() != unknown; id++ {
+		if id.String() == name {
+			return id, nil
+		}
+	}
+	return RASUnknownEvent, errors.Errorf("unknown RAS event %q", name)
+}
+
+// severityRank orders severities from most to least severe, treating events of unknown
+// severity as notices.
+func severityRank(sev RASSeverityID) int {
+	switch sev {
+	case RASSeverityError:
+		return 0
+	case RASSeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// IsAtLeast returns true if the severity is at least as severe as the supplied minimum.
+func (sev RASSeverityID) IsAtLeast(min RASSeverityID) bool {
+	return severityRank(sev) <= severityRank(min)
+}
+
+// SinkConfig configures the delivery of RAS events to an external system. Events may be
+// limited to those at or above a minimum severity and to a list of event names.
+type SinkConfig struct {
+	Type        string            `yaml:"type"`
+	Address     string            `yaml:"address,omitempty"`
+	Facility    string            `yaml:"facility,omitempty"`
+	URL         string            `yaml:"url,omitempty"`
+	Topic       string            `yaml:"topic,omitempty"`
+	Headers     map[string]string `yaml:"headers,omitempty"`
+	MinSeverity string            `yaml:"min_severity,omitempty"`
+	Events      []string          `yaml:"events,omitempty"`
+}
+
+func (sc *SinkConfig) String() string {
+	switch sc.Type {
+	case SinkTypeSyslog:
+		if sc.Address == "" {
+			return "syslog"
+		}
+		return "syslog " + sc.Address
+	case SinkTypeKafka:
+		return fmt.Sprintf("kafka %s topic %s", sc.URL, sc.Topic)
+	default:
+		return sc.Type + " " + sc.URL
+	}
+}
+
+func validateSinkURL(sinkURL string) error {
+	u, err := url.Parse(sinkURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("url %q is not an http(s) URL", sinkURL)
+	}
+	return nil
+}
+
+// Validate checks that the parameters required by the sink type are set and that the filters
+// refer to known severities and events.
+func (sc *SinkConfig) Validate() error {
+	switch sc.Type {
+	case SinkTypeSyslog:
+		if sc.URL != "" || sc.Topic != "" || len(sc.Headers) > 0 {
+			return errors.New("url, topic and headers are not supported by syslog sinks")
+		}
+		if _, err := parseSyslogAddress(sc.Address); err != nil {
+			return err
+		}
+		if _, found := syslogFacilities[sc.Facility]; sc.Facility != "" && !found {
+			return errors.Errorf("unknown syslog facility %q", sc.Facility)
+		}
+	case SinkTypeWebhook, SinkTypeKafka:
+		if sc.Address != "" || sc.Facility != "" {
+			return errors.Errorf("address and facility are not supported by %s sinks", sc.Type)
+		}
+		if err := validateSinkURL(sc.URL); err != nil {
+			return err
+		}
+		if sc.Type == SinkTypeKafka && sc.Topic == "" {
+			return errors.New("topic must be set for kafka sinks")
+		}
+		if sc.Type == SinkTypeWebhook && sc.Topic != "" {
+			return errors.New("topic is not supported by webhook sinks")
+		}
+	case "":
+		return errors.New("sink type must be set")
+	default:
+		return errors.Errorf("unknown sink type %q", sc.Type)
+	}
+
+	if _, err := sc.filter(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// sinkFilter selects the events delivered to a sink.
+type sinkFilter struct {
+	minSeverity RASSeverityID
+	ids         map[RASID]struct{}
+}
+
+func (sc *SinkConfig) filter() (*sinkFilter, error) {
+	sf := &sinkFilter{minSeverity: RASSeverityNotice}
+	if sc.MinSeverity != "" {
+		sev, err := ParseRASSeverity(sc.MinSeverity)
+		if err != nil {
+			return nil, err
+		}
+		sf.minSeverity = sev
+	}
+
+	if len(sc.Events) > 0 {
+		sf.ids = make(map[RASID]struct{})
+		for _, name := range sc.Events {
+			id, err := ParseRASID(name)
+			if err != nil {
+				return nil, err
+			}
+			sf.ids[id] = struct{}{}
+		}
+	}
+
+	return sf, nil
+}
+
+func (sf *sinkFilter) matches(evt *RASEvent) bool {
+	if !evt.Severity.IsAtLeast(sf.minSeverity) {
+		return false
+	}
+	if sf.ids == nil {
+		return true
+	}
+	_, found := sf.ids[evt.ID]
+	return found
+}
+
+// Sink defines an interface to be implemented by external systems that events are delivered to.
+type Sink interface {
+	// Send delivers the event to the external system.
+	Send(context.Context, *RASEvent) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+// SinkHandler implements the Handler interface and delivers the events that match its filter to
+// a Sink. Events forwarded from other hosts are not delivered, as they are delivered by the host
+// that raised them.
+type SinkHandler struct {
+	log    logging.Logger
+	name   string
+	sink   Sink
+	filter *sinkFilter
+}
+
+// OnEvent implements the Handler interface.
+func (sh *SinkHandler) OnEvent(ctx context.Context, evt *RASEvent) {
+	switch {
+	case evt == nil:
+		sh.log.Debug("skip event sink, nil event")
+		return
+	case evt.IsForwarded():
+		return // event has already been delivered by its source
+	case !sh.filter.matches(evt):
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sinkSendTimeout)
+	defer cancel()
+
+	if err := sh.sink.Send(ctx, evt); err != nil {
+		sh.log.Errorf("failed to deliver %s event to %s: %s", evt.ID, sh.name, err)
+	}
+}
+
+// Close closes the underlying sink.
+func (sh *SinkHandler) Close() error {
+	return sh.sink.Close()
+}
+
+// NewSinkHandler returns a SinkHandler delivering events to the sink described by the supplied
+// configuration.
+func NewSinkHandler(log logging.Logger, cfg *SinkConfig) (*SinkHandler, error) {
+	if cfg == nil {
+		return nil, errors.New("nil sink config")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s sink", cfg.Type)
+	}
+	filter, err := cfg.filter()
+	if err != nil {
+		return nil, err
+	}
+
+	var sink Sink
+	switch cfg.Type {
+	case SinkTypeSyslog:
+		sink, err = newSyslogSink(cfg.Address, cfg.Facility)
+	case SinkTypeWebhook:
+		sink = newWebhookSink(cfg.URL, cfg.Headers)
+	case SinkTypeKafka:
+		sink = newKafkaSink(cfg.URL, cfg.Topic, cfg.Headers)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &SinkHandler{
+		log:    log,
+		name:   cfg.String(),
+		sink:   sink,
+		filter: filter,
+	}, nil
+}
+
+// sinkEventJSON returns the JSON representation of an event delivered to a sink. Unlike the
+// representation used between DAOS components, the ID, type and severity are given by name.
+func sinkEventJSON(evt *RASEvent) ([]byte, error) {
+	type toJSON RASEvent
+	return json.Marshal(&struct {
+		ID       string `json:"id"`
+		Type     string `json:"type"`
+		Severity string `json:"severity"`
+		*toJSON
+	}{
+		ID:       evt.ID.String(),
+		Type:     evt.Type.String(),
+		Severity: evt.Severity.String(),
+		toJSON:   (*toJSON)(evt),
+	})
+}
+
+type syslogAddress struct {
+	network string
+	addr    string
+}
+
+// parseSyslogAddress parses an address of the form [udp|tcp://]host:port. An empty address
+// selects the local syslog daemon.
+func parseSyslogAddress(address string) (*syslogAddress, error) {
+	if address == "" {
+		return &syslogAddress{}, nil
+	}
+
+	sa := &syslogAddress{network: "udp", addr: address}
+	if network, addr, found := strings.Cut(address, "://"); found {
+		sa.network = network
+		sa.addr = addr
+	}
+	if sa.network != "udp" && sa.network != "tcp" {
+		return nil, errors.Errorf("unsupported syslog network %q", sa.network)
+	}
+	if _, _, err := common.SplitPort(sa.addr, 514); err != nil {
+		return nil, errors.Wrapf(err, "invalid syslog address %q", address)
+	}
+
+	return sa, nil
+}
+
+// syslogSink writes events to a local or remote syslog daemon at the priority derived from the
+// event severity.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+func newSyslogSink(address, facility string) (*syslogSink, error) {
+	sa, err := parseSyslogAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	priority := syslog.LOG_DAEMON
+	if facility != "" {
+		priority = syslogFacilities[facility]
+	}
+
+	writer, err := syslog.Dial(sa.network, sa.addr, priority|syslog.LOG_NOTICE, syslogTag)
+	if err != nil {
+		return nil, errors.Wrap(err, "connect to syslog")
+	}
+
+	return &syslogSink{writer: writer}, nil
+}
+
+// Send implements the Sink interface.
+func (ss *syslogSink) Send(_ context.Context, evt *RASEvent) error {
+	msg := evt.PrintRAS()
+	switch evt.Severity {
+	case RASSeverityError:
+		return ss.writer.Err(msg)
+	case RASSeverityWarning:
+		return ss.writer.Warning(msg)
+	default:
+		return ss.writer.Notice(msg)
+	}
+}
+
+// Close implements the Sink interface.
+func (ss *syslogSink) Close() error {
+	return ss.writer.Close()
+}
+
+// httpSink posts events to an HTTP endpoint.
+type httpSink struct {
+	url         string
+	contentType string
+	headers     map[string]string
+	body        func(*RASEvent) ([]byte, error)
+	client      *http.Client
+}
+
+// newWebhookSink returns a sink that posts each event as a JSON object to the given URL.
+func newWebhookSink(url string, headers map[string]string) *httpSink {
+	return &httpSink{
+		url:         url,
+		contentType: "application/json",
+		headers:     headers,
+		body:        sinkEventJSON,
+		client:      &http.Client{},
+	}
+}
+
+// newKafkaSink returns a sink that produces each event as a record of the given topic through a
+// Kafka REST Proxy. Records are keyed by the hostname of the event so that the events of a host
+// are kept in order.
+func newKafkaSink(proxyURL, topic string, headers map[string]string) *httpSink {
+	return &httpSink{
+		url:         strings.TrimSuffix(proxyURL, "/") + "/topics/" + url.PathEscape(topic),
+		contentType: kafkaContentType,
+		headers:     headers,
+		body: func(evt *RASEvent) ([]byte, error) {
+			value, err := sinkEventJSON(evt)
+			if err != nil {
+				return nil, err
+			}
+			return json.Marshal(map[string]interface{}{
+				"records": []map[string]interface{}{
+					{"key": evt.Hostname, "value": json.RawMessage(value)},
+				},
+			})
+		},
+		client: &http.Client{},
+	}
+}
+
+// Send implements the Sink interface.
+func (hs *httpSink) Send(ctx context.Context, evt *RASEvent) error {
+	body, err := hs.body(evt)
+	if err != nil {
+		return errors.Wrap(err, "marshal event")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hs.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", hs.contentType)
+	for key, val := range hs.headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := hs.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("%s returned %s", hs.url, resp.Status)
+	}
+	return nil
+}
+
+// Close implements the Sink interface.
+func (hs *httpSink) Close() error {
+	hs.client.CloseIdleConnections()
+	return nil
+}
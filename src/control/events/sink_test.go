@@ -0,0 +1,295 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestEvents_ParseRASID(t *testing.T) {
+	for name, tc := range map[string]struct {
+		name   string
+		expID  RASID
+		expErr error
+	}{
+		"first event": {
+			name:  "engine_format_required",
+			expID: RASEngineFormatRequired,
+		},
+		"event": {
+			name:  "engine_died",
+			expID: RASEngineDied,
+		},
+		"last event": {
+			name:  "telemetry_alert_cleared",
+			expID: RASTelemetryAlertCleared,
+		},
+		"unknown event": {
+			name:   "engine_exploded",
+			expErr: errors.New("unknown RAS event"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			id, err := ParseRASID(tc.name)
+			test.CmpErr(t, tc.expErr, err)
+			test.AssertEqual(t, tc.expID, id, "unexpected event ID")
+		})
+	}
+}
+
+func TestEvents_SinkConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *SinkConfig
+		expErr error
+	}{
+		"local syslog": {
+			cfg: &SinkConfig{Type: SinkTypeSyslog},
+		},
+		"remote syslog": {
+			cfg: &SinkConfig{
+				Type:     SinkTypeSyslog,
+				Address:  "tcp://loghost:514",
+				Facility: "local0",
+			},
+		},
+		"syslog bad network": {
+			cfg:    &SinkConfig{Type: SinkTypeSyslog, Address: "unix:///dev/log"},
+			expErr: errors.New("unsupported syslog network"),
+		},
+		"syslog bad facility": {
+			cfg:    &SinkConfig{Type: SinkTypeSyslog, Facility: "local9"},
+			expErr: errors.New("unknown syslog facility"),
+		},
+		"syslog with url": {
+			cfg:    &SinkConfig{Type: SinkTypeSyslog, URL: "http://localhost"},
+			expErr: errors.New("not supported by syslog"),
+		},
+		"webhook": {
+			cfg: &SinkConfig{Type: SinkTypeWebhook, URL: "https://ops.example.com/events"},
+		},
+		"webhook bad url": {
+			cfg:    &SinkConfig{Type: SinkTypeWebhook, URL: "ops.example.com"},
+			expErr: errors.New("not an http(s) URL"),
+		},
+		"kafka": {
+			cfg: &SinkConfig{Type: SinkTypeKafka, URL: "http://kafka:8082", Topic: "daos"},
+		},
+		"kafka missing topic": {
+			cfg:    &SinkConfig{Type: SinkTypeKafka, URL: "http://kafka:8082"},
+			expErr: errors.New("topic must be set"),
+		},
+		"unknown type": {
+			cfg:    &SinkConfig{Type: "pager"},
+			expErr: errors.New("unknown sink type"),
+		},
+		"bad severity": {
+			cfg:    &SinkConfig{Type: SinkTypeSyslog, MinSeverity: "critical"},
+			expErr: errors.New("unknown RAS event severity"),
+		},
+		"bad event": {
+			cfg:    &SinkConfig{Type: SinkTypeSyslog, Events: []string{"engine_exploded"}},
+			expErr: errors.New("unknown RAS event"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestEvents_sinkFilter(t *testing.T) {
+	died := mockEvtDied(t)       // error
+	fmtReq := mockEvtFmtReq(t)   // notice
+	generic := mockEvtGeneric(t) // error
+	unknown := mockEvtGeneric(t) // unknown severity
+	unknown.Severity = RASSeverityUnknown
+
+	for name, tc := range map[string]struct {
+		cfg      *SinkConfig
+		expMatch []bool
+	}{
+		"no filter": {
+			cfg:      &SinkConfig{},
+			expMatch: []bool{true, true, true, true},
+		},
+		"min severity": {
+			cfg:      &SinkConfig{MinSeverity: "warning"},
+			expMatch: []bool{true, false, true, false},
+		},
+		"event names": {
+			cfg:      &SinkConfig{Events: []string{"engine_died", "engine_format_required"}},
+			expMatch: []bool{true, true, false, false},
+		},
+		"event names and severity": {
+			cfg: &SinkConfig{
+				MinSeverity: "error",
+				Events:      []string{"engine_died", "engine_format_required"},
+			},
+			expMatch: []bool{true, false, false, false},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sf, err := tc.cfg.filter()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var gotMatch []bool
+			for _, evt := range []*RASEvent{died, fmtReq, generic, unknown} {
+				gotMatch = append(gotMatch, sf.matches(evt))
+			}
+			if diff := cmp.Diff(tc.expMatch, gotMatch); diff != "" {
+				t.Fatalf("unexpected matches (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockHTTPSink struct {
+	sync.Mutex
+	paths        []string
+	contentTypes []string
+	headers      []string
+	bodies       [][]byte
+}
+
+func (ms *mockHTTPSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ms.Lock()
+	defer ms.Unlock()
+	ms.paths = append(ms.paths, r.URL.Path)
+	ms.contentTypes = append(ms.contentTypes, r.Header.Get("Content-Type"))
+	ms.headers = append(ms.headers, r.Header.Get("X-Api-Key"))
+	ms.bodies = append(ms.bodies, body)
+}
+
+func TestEvents_SinkHandler_Webhook(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mock := new(mockHTTPSink)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	sh, err := NewSinkHandler(log, &SinkConfig{
+		Type:        SinkTypeWebhook,
+		URL:         srv.URL + "/events",
+		Headers:     map[string]string{"X-Api-Key": "secret"},
+		MinSeverity: "error",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sh.Close()
+
+	sh.OnEvent(test.Context(t), mockEvtFmtReq(t))                   // filtered by severity
+	sh.OnEvent(test.Context(t), mockEvtDied(t).WithForwarded(true)) // delivered by source
+	sh.OnEvent(test.Context(t), mockEvtDied(t))
+
+	mock.Lock()
+	defer mock.Unlock()
+
+	if len(mock.bodies) != 1 {
+		t.Fatalf("expected 1 event to be delivered, got %d", len(mock.bodies))
+	}
+	test.AssertEqual(t, "/events", mock.paths[0], "unexpected path")
+	test.AssertEqual(t, "application/json", mock.contentTypes[0], "unexpected content type")
+	test.AssertEqual(t, "secret", mock.headers[0], "unexpected header")
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(mock.bodies[0], &got); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "engine_died", got["id"], "unexpected event ID")
+	test.AssertEqual(t, "ERROR", got["severity"], "unexpected event severity")
+	test.AssertEqual(t, "STATE_CHANGE", got["type"], "unexpected event type")
+	test.AssertEqual(t, mockEvtDied(t).Msg, got["msg"], "unexpected event message")
+}
+
+func TestEvents_SinkHandler_Kafka(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mock := new(mockHTTPSink)
+	srv := httptest.NewServer(mock)
+	defer srv.Close()
+
+	sh, err := NewSinkHandler(log, &SinkConfig{
+		Type:  SinkTypeKafka,
+		URL:   srv.URL + "/",
+		Topic: "daos-ras",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sh.Close()
+
+	evt := mockEvtDied(t)
+	sh.OnEvent(test.Context(t), evt)
+
+	mock.Lock()
+	defer mock.Unlock()
+
+	if len(mock.bodies) != 1 {
+		t.Fatalf("expected 1 record to be produced, got %d", len(mock.bodies))
+	}
+	test.AssertEqual(t, "/topics/daos-ras", mock.paths[0], "unexpected path")
+	test.AssertEqual(t, kafkaContentType, mock.contentTypes[0], "unexpected content type")
+
+	var got struct {
+		Records []struct {
+			Key   string                 `json:"key"`
+			Value map[string]interface{} `json:"value"`
+		} `json:"records"`
+	}
+	if err := json.Unmarshal(mock.bodies[0], &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(got.Records))
+	}
+	test.AssertEqual(t, evt.Hostname, got.Records[0].Key, "unexpected record key")
+	test.AssertEqual(t, "engine_died", got.Records[0].Value["id"], "unexpected event ID")
+}
+
+func TestEvents_SinkHandler_Error(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sh, err := NewSinkHandler(log, &SinkConfig{Type: SinkTypeWebhook, URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sh.Close()
+
+	// Delivery failures are logged rather than returned to the publisher.
+	sh.OnEvent(test.Context(t), mockEvtDied(t))
+	test.AssertTrue(t, strings.Contains(buf.String(), "failed to deliver engine_died event"),
+		"expected delivery failure to be logged")
+}
@@ -86,6 +86,10 @@ const (
 	ScmRamdiskLowMem
 	ScmRamdiskBadSize
 	ScmConfigTierMissing
+	ScmForeignOwner
+	ScmFsckFailed
+	ScmUnlockMissingPassphrase
+	ScmUnlockNotPossible
 )
 
 // Bdev fault codes
@@ -110,6 +114,14 @@ const (
 	BdevConfigControlMetadataNoRoles
 	BdevConfigRolesNoControlMetadata
 	BdevConfigRolesWalDataNoMeta
+	BdevConfigTierTransportMismatch
+	BdevFormatNoSpace
+	BdevConfigNoHugepagesWithRealNVMe
+	BdevZonedNamespace
+	BdevConfigModified
+	BdevConfigInputsChanged
+	BdevIommuGroupNotViable
+	BdevFeatureNotInSpdk
 )
 
 // DAOS system fault codes
@@ -118,6 +130,7 @@ const (
 	SystemBadFaultDomainDepth
 	SystemPoolLocked
 	SystemJoinReplaceRankNotFound
+	SystemPoolProfileNotFound
 )
 
 // client fault codes
@@ -134,6 +147,8 @@ const (
 	ClientFormatRunningSystem
 	ClientRpcTimeout
 	ClientConfigVMDImbalance
+	ClientMethodUnsupported
+	ClientIncompatibleAPIVersion
 )
 
 // server fault codes
@@ -161,6 +176,13 @@ const (
 	ServerBadFaultDomainLabels
 	ServerJoinReplaceEnabledPoolRank
 	ServerRankAdminExcluded
+	ServerPoolResizeShrink
+	ServerPoolResizeTier
+	ServerJobNotFound
+	ServerAuditLogDisabled
+	ServerCertsNotInUse
+	ServerIncompatibleAPIVersion
+	ServerEraseEnabledPoolRanks
 )
 
 // server config fault codes
@@ -203,6 +225,27 @@ const (
 	ServerConfigEnableHotplugDeprecated
 	ServerConfigBdevExcludeClash
 	ServerConfigHugepagesDisabledWithNrSet
+	ServerConfigRamdiskMemMarginOutOfRange
+	ServerConfigScmImbalanceOutOfRange
+	ServerConfigScmNumaMismatch
+	ServerConfigScmCapacityImbalance
+	ServerConfigBadScmUnlock
+	ServerConfigUnsupportedVersion
+	ServerConfigReloadImmutable
+	ServerConfigBadTelemetryCollect
+	ServerConfigBadTelemetryOTLP
+	ServerConfigBadTelemetryHistory
+	ServerConfigBadTelemetryAlerts
+	ServerConfigBadEventSink
+	ServerConfigBadEventRateLimit
+	ServerConfigBadNvmeHealthHistory
+	ServerConfigBadNvmeFailurePolicy
+	ServerConfigBadHTTPGateway
+	ServerConfigBadHealthProbe
+	ServerConfigBadCrashReports
+	ServerConfigBadEngineShutdown
+	ServerConfigBdevSparesNoHotplug
+	ServerConfigBadNvmeHotplugPolicy
 )
 
 // SPDK library bindings codes
@@ -220,6 +263,7 @@ const (
 	SecurityMissingCertFile
 	SecurityUnreadableCertFile
 	SecurityInvalidCert
+	SecurityInvalidCRL
 )
 
 const (
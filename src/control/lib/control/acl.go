@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,9 +12,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/user"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // AccessControlList is a structure for the access control list.
@@ -109,6 +113,342 @@ func ParseACL(reader io.Reader) (*AccessControlList, error) {
 	return &AccessControlList{Entries: aceList}, nil
 }
 
+// ReadValidACLFile reads in a file representing an ACL in the same way as
+// ReadACLFile, additionally allowing comments after entries and checking that
+// the entries are valid.
+func ReadValidACLFile(aclFile string) (*AccessControlList, error) {
+	file, err := os.Open(aclFile)
+	if err != nil {
+		return nil, errors.WithMessage(err, "opening ACL file")
+	}
+	defer file.Close()
+
+	acl, err := ParseValidACL(file)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "ACL file '%s'", aclFile)
+	}
+	if acl.Empty() {
+		return nil, errors.New(fmt.Sprintf("ACL file '%s' contains no entries", aclFile))
+	}
+
+	return acl, nil
+}
+
+// stripACLComment removes a comment following an entry on a line of an ACL
+// file. A '#' only starts a comment if it follows whitespace, as principal
+// names may contain '#'.
+func stripACLComment(line string) string {
+	if isACLFileComment(line) {
+		return ""
+	}
+
+	for i := 1; i < len(line); i++ {
+		if line[i] == '#' && (line[i-1] == ' ' || line[i-1] == '\t') {
+			return strings.TrimSpace(line[:i])
+		}
+	}
+
+	return line
+}
+
+// ParseValidACL reads the content from io.Reader in the same way as ParseACL,
+// additionally allowing comments after entries. Each entry is checked with
+// ValidateACE, and the ACL is checked for duplicate principals and for
+// exceeding the maximum ACL size. All problems found are reported along with
+// the line on which they occur.
+func ParseValidACL(reader io.Reader) (*AccessControlList, error) {
+	acl := &AccessControlList{Entries: make([]string, 0)}
+	var problems []string
+	seen := make(map[string]int)
+
+	scanner := bufio.NewScanner(reader)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := stripACLComment(strings.TrimSpace(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if err := ValidateACE(line); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: %s", lineNum, err))
+			continue
+		}
+
+		principal := ACEPrincipal(line)
+		if first, found := seen[principal]; found {
+			problems = append(problems, fmt.Sprintf("line %d: duplicate entry for %s (first on line %d)",
+				lineNum, principal, first))
+			continue
+		}
+		seen[principal] = lineNum
+
+		acl.Entries = append(acl.Entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithMessage(err, "reading ACL file")
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.Errorf("invalid ACL:\n  %s", strings.Join(problems, "\n  "))
+	}
+	if err := CheckACLSize(acl); err != nil {
+		return nil, err
+	}
+
+	return acl, nil
+}
+
+// Components of an ACE string.
+const (
+	aceAccessTypes = "AUL"
+	aceFlags       = "GSFP"
+	acePerms       = "rwcdtTaAo"
+
+	acePrincipalOwner      = "OWNER@"
+	acePrincipalOwnerGroup = "GROUP@"
+	acePrincipalEveryone   = "EVERYONE@"
+
+	// aceHeaderLen is the length of an encoded ACE without its principal.
+	aceHeaderLen = 32
+)
+
+func isSpecialPrincipal(identity string) bool {
+	switch identity {
+	case acePrincipalOwner, acePrincipalOwnerGroup, acePrincipalEveryone:
+		return true
+	}
+	return false
+}
+
+func checkACEField(field, name, valid string) error {
+	for _, c := range field {
+		if !strings.ContainsRune(valid, c) {
+			return errors.Errorf("invalid %s '%c' (valid: %s)", name, c, valid)
+		}
+	}
+	return nil
+}
+
+// ValidateACE checks that the Access Control Entry in short string format,
+// <access types>:<flags>:<principal>:<permissions>, would be accepted by the
+// DAOS server.
+func ValidateACE(ace string) error {
+	if len(ace) > daos.ACLMaxACEStrLen {
+		return errors.Errorf("entry is longer than %d characters", daos.ACLMaxACEStrLen)
+	}
+
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return errors.Errorf("invalid entry %q (format: <access types>:<flags>:<principal>:<permissions>)", ace)
+	}
+	types, flags, identity, perms := fields[0], fields[1], fields[2], fields[3]
+
+	if types == "" {
+		return errors.Errorf("entry %q has no access type", ace)
+	}
+	if err := checkACEField(types, "access type", aceAccessTypes); err != nil {
+		return errors.WithMessagef(err, "entry %q", ace)
+	}
+	if err := checkACEField(flags, "flag", aceFlags); err != nil {
+		return errors.WithMessagef(err, "entry %q", ace)
+	}
+	if err := checkACEField(perms, "permission", acePerms); err != nil {
+		return errors.WithMessagef(err, "entry %q", ace)
+	}
+	if !daos.ACLPrincipalIsValid(identity) {
+		return errors.Errorf("entry %q has invalid principal %q (format: name@[domain])", ace, identity)
+	}
+
+	hasGroupFlag := strings.ContainsRune(flags, 'G')
+	switch identity {
+	case acePrincipalOwner, acePrincipalEveryone:
+		if hasGroupFlag {
+			return errors.Errorf("entry %q: the G flag is not valid for %s", ace, identity)
+		}
+	case acePrincipalOwnerGroup:
+		if !hasGroupFlag {
+			return errors.Errorf("entry %q: the G flag is required for %s", ace, identity)
+		}
+	}
+
+	isAlert := strings.ContainsAny(types, "UL")
+	hasAlertFlags := strings.ContainsAny(flags, "SF")
+	if isAlert && !hasAlertFlags {
+		return errors.Errorf("entry %q: audit and alarm entries require the S or F flag", ace)
+	}
+	if !isAlert && hasAlertFlags {
+		return errors.Errorf("entry %q: the S and F flags are only valid for audit and alarm entries", ace)
+	}
+
+	return nil
+}
+
+// ACEPrincipal returns the principal that a valid Access Control Entry
+// applies to, in the format accepted by the delete-acl commands.
+func ACEPrincipal(ace string) string {
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return ""
+	}
+
+	identity := fields[2]
+	switch {
+	case isSpecialPrincipal(identity):
+		return identity
+	case strings.ContainsRune(fields[1], 'G'):
+		return "g:" + identity
+	default:
+		return "u:" + identity
+	}
+}
+
+// CheckACLSize checks that the encoded entries of the ACL do not exceed the
+// maximum size of an ACL.
+func CheckACLSize(acl *AccessControlList) error {
+	size := 0
+	for _, ace := range acl.Entries {
+		size += aceHeaderLen
+		if identity := strings.Split(ace, ":")[2]; !isSpecialPrincipal(identity) {
+			// Principals are null-terminated and 64-bit aligned.
+			size += (len(identity) + 1 + 7) &^ 7
+		}
+	}
+
+	if size > daos.ACLMaxACELen {
+		return errors.Errorf("ACL with %d entries is too large (%d bytes, max %d)",
+			len(acl.Entries), size, daos.ACLMaxACELen)
+	}
+
+	return nil
+}
+
+type principalLookupFn func(name string) error
+
+func lookupLocalUser(name string) error {
+	_, err := user.Lookup(name)
+	return err
+}
+
+func lookupLocalGroup(name string) error {
+	_, err := user.LookupGroup(name)
+	return err
+}
+
+// CheckACLPrincipals checks that the users and groups named in the entries of
+// the ACL can be resolved by the directory configured on this host, e.g. a
+// directory service used via NSS. The domain of each principal is ignored.
+func CheckACLPrincipals(acl *AccessControlList) error {
+	return checkACLPrincipals(acl, lookupLocalUser, lookupLocalGroup)
+}
+
+func checkACLPrincipals(acl *AccessControlList, lookupUser, lookupGroup principalLookupFn) error {
+	if acl == nil {
+		return nil
+	}
+
+	var unknown []string
+	for _, ace := range acl.Entries {
+		principal := ACEPrincipal(ace)
+		if principal == "" || isSpecialPrincipal(principal) {
+			continue
+		}
+
+		name := principal[2:strings.IndexRune(principal, '@')]
+		lookup := lookupUser
+		if strings.HasPrefix(principal, "g:") {
+			lookup = lookupGroup
+		}
+		if err := lookup(name); err != nil {
+			unknown = append(unknown, principal)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return errors.Errorf("unknown principals in ACL: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// ACLChange describes the change to the entry of a principal in an ACL. Old is
+// empty for added entries and New is empty for removed entries.
+type ACLChange struct {
+	Principal string `json:"principal"`
+	Old       string `json:"old,omitempty"`
+	New       string `json:"new,omitempty"`
+}
+
+// MergeACL returns the ACL resulting from updating the entries of the current
+// ACL with those of the update, as done by the update-acl commands. Entries for
+// principals already in the ACL are replaced and others are added.
+func MergeACL(cur, update *AccessControlList) *AccessControlList {
+	merged := &AccessControlList{}
+	if cur != nil {
+		merged.Owner = cur.Owner
+		merged.OwnerGroup = cur.OwnerGroup
+		merged.Entries = append(merged.Entries, cur.Entries...)
+	}
+	if update == nil {
+		return merged
+	}
+
+	for _, ace := range update.Entries {
+		principal := ACEPrincipal(ace)
+		replaced := false
+		for i, curACE := range merged.Entries {
+			if ACEPrincipal(curACE) == principal {
+				merged.Entries[i] = ace
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged.Entries = append(merged.Entries, ace)
+		}
+	}
+
+	return merged
+}
+
+// DiffACL returns the changes required to turn the old ACL into the new ACL.
+// Changed and added entries are listed in the order of the new ACL, followed
+// by removed entries in the order of the old ACL.
+func DiffACL(old, new *AccessControlList) []*ACLChange {
+	oldEntries := make(map[string]string)
+	if old != nil {
+		for _, ace := range old.Entries {
+			oldEntries[ACEPrincipal(ace)] = ace
+		}
+	}
+
+	changes := []*ACLChange{}
+	newPrincipals := make(map[string]bool)
+	if new != nil {
+		for _, ace := range new.Entries {
+			principal := ACEPrincipal(ace)
+			newPrincipals[principal] = true
+			if oldEntries[principal] != ace {
+				changes = append(changes, &ACLChange{
+					Principal: principal,
+					Old:       oldEntries[principal],
+					New:       ace,
+				})
+			}
+		}
+	}
+	if old != nil {
+		for _, ace := range old.Entries {
+			if principal := ACEPrincipal(ace); !newPrincipals[principal] {
+				changes = append(changes, &ACLChange{
+					Principal: principal,
+					Old:       ace,
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
 // FormatACL converts the AccessControlList to a human-readable string.
 func FormatACL(acl *AccessControlList, verbose bool) string {
 	var builder strings.Builder
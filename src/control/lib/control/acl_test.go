@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -16,6 +17,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // mockReader is a mock used to represent a successful read of some text
@@ -251,6 +253,342 @@ func TestControl_ParseACL_MultiValidACEWithComment(t *testing.T) {
 	}
 }
 
+func TestControl_ValidateACE(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ace    string
+		expErr error
+	}{
+		"owner": {
+			ace: "A::OWNER@:rwdtTaAo",
+		},
+		"owner group": {
+			ace: "A:G:GROUP@:rw",
+		},
+		"named user": {
+			ace: "A::user1@:r",
+		},
+		"named group with domain": {
+			ace: "A:G:readers@example.com:r",
+		},
+		"audit entry": {
+			ace: "U:S:EVERYONE@:w",
+		},
+		"multiple types": {
+			ace: "AL:F:user1@:rw",
+		},
+		"no permissions": {
+			ace: "A::EVERYONE@:",
+		},
+		"too few fields": {
+			ace:    "A::OWNER@",
+			expErr: errors.New("format: <access types>:<flags>:<principal>:<permissions>"),
+		},
+		"too many fields": {
+			ace:    "A::OWNER@:rw:r",
+			expErr: errors.New("format: <access types>:<flags>:<principal>:<permissions>"),
+		},
+		"no access type": {
+			ace:    ":G:GROUP@:rw",
+			expErr: errors.New("has no access type"),
+		},
+		"bad access type": {
+			ace:    "X::OWNER@:rw",
+			expErr: errors.New("invalid access type 'X'"),
+		},
+		"lowercase flag": {
+			ace:    "A:g:readers@:r",
+			expErr: errors.New("invalid flag 'g'"),
+		},
+		"bad permission": {
+			ace:    "A::OWNER@:rx",
+			expErr: errors.New("invalid permission 'x'"),
+		},
+		"bad principal": {
+			ace:    "A::user1:rw",
+			expErr: errors.New("invalid principal \"user1\""),
+		},
+		"group flag for owner": {
+			ace:    "A:G:OWNER@:rw",
+			expErr: errors.New("the G flag is not valid for OWNER@"),
+		},
+		"group flag for everyone": {
+			ace:    "A:G:EVERYONE@:rw",
+			expErr: errors.New("the G flag is not valid for EVERYONE@"),
+		},
+		"no group flag for owner group": {
+			ace:    "A::GROUP@:rw",
+			expErr: errors.New("the G flag is required for GROUP@"),
+		},
+		"audit entry without success or failure flag": {
+			ace:    "U::OWNER@:rw",
+			expErr: errors.New("require the S or F flag"),
+		},
+		"allow entry with success flag": {
+			ace:    "A:S:OWNER@:rw",
+			expErr: errors.New("only valid for audit and alarm entries"),
+		},
+		"too long": {
+			ace:    "A::" + strings.Repeat("a", daos.ACLMaxACEStrLen) + "@:rw",
+			expErr: errors.New("entry is longer than"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, ValidateACE(tc.ace))
+		})
+	}
+}
+
+func TestControl_ACEPrincipal(t *testing.T) {
+	for ace, expPrincipal := range map[string]string{
+		"A::OWNER@:rw":       "OWNER@",
+		"A:G:GROUP@:rw":      "GROUP@",
+		"A::EVERYONE@:r":     "EVERYONE@",
+		"A::user1@:r":        "u:user1@",
+		"A:G:readers@:r":     "g:readers@",
+		"U:GS:readers@dom:r": "g:readers@dom",
+		"garbage":            "",
+	} {
+		t.Run(ace, func(t *testing.T) {
+			if diff := cmp.Diff(expPrincipal, ACEPrincipal(ace)); diff != "" {
+				t.Fatalf("unexpected principal (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ParseValidACL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		input  string
+		expACL *AccessControlList
+		expErr error
+	}{
+		"empty": {
+			expACL: &AccessControlList{Entries: []string{}},
+		},
+		"comments": {
+			input: strings.Join([]string{
+				"# Pool ACL",
+				"A::OWNER@:rw    # owner",
+				"",
+				"\tA:G:GROUP@:r\t# owner group",
+				"A::user#1@:r",
+				"  # trailing comment",
+			}, "\n"),
+			expACL: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::user#1@:r"},
+			},
+		},
+		"same name for user and group": {
+			input: "A::admin@:rw\nA:G:admin@:r\n",
+			expACL: &AccessControlList{
+				Entries: []string{"A::admin@:rw", "A:G:admin@:r"},
+			},
+		},
+		"invalid entries": {
+			input: strings.Join([]string{
+				"# Pool ACL",
+				"A::OWNER@:rx",
+				"A:G:GROUP@:r",
+				"A::GROUP@:r",
+			}, "\n"),
+			expErr: errors.New("invalid ACL:\n  line 2: entry \"A::OWNER@:rx\": invalid permission 'x' (valid: rwcdtTaAo)\n  line 4: "),
+		},
+		"duplicate principal": {
+			input: strings.Join([]string{
+				"A::user1@:r",
+				"A:G:user1@:r",
+				"A::user1@:rw # again",
+			}, "\n"),
+			expErr: errors.New("line 3: duplicate entry for u:user1@ (first on line 1)"),
+		},
+		"too large": {
+			input: func() string {
+				var entries []string
+				for i := 0; i < 2048; i++ {
+					entries = append(entries, fmt.Sprintf("A::user%d@:r", i))
+				}
+				return strings.Join(entries, "\n")
+			}(),
+			expErr: errors.New("ACL with 2048 entries is too large"),
+		},
+		"read error": {
+			expErr: errors.New("reading ACL file: mock read"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var reader io.Reader = &mockReader{text: tc.input}
+			if name == "read error" {
+				reader = &mockErrorReader{errorMsg: "mock read"}
+			}
+
+			acl, err := ParseValidACL(reader)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expACL, acl); diff != "" {
+				t.Fatalf("unexpected ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ReadValidACLFile(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content string
+		expACL  *AccessControlList
+		expErr  error
+	}{
+		"valid": {
+			content: "# comment\nA::OWNER@:rw # owner\n",
+			expACL:  &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+		},
+		"only comments": {
+			content: "# comment\n",
+			expErr:  errors.New("contains no entries"),
+		},
+		"invalid": {
+			content: "A::OWNER@:rw\nA::OWNER@:r\n",
+			expErr:  errors.New("line 2: duplicate entry for OWNER@"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := test.CreateTestFile(t, tmpDir, tc.content)
+
+			acl, err := ReadValidACLFile(path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expACL, acl); diff != "" {
+				t.Fatalf("unexpected ACL (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_checkACLPrincipals(t *testing.T) {
+	mockLookup := func(known ...string) principalLookupFn {
+		return func(name string) error {
+			for _, k := range known {
+				if name == k {
+					return nil
+				}
+			}
+			return errors.New("unknown")
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		acl    *AccessControlList
+		expErr error
+	}{
+		"nil": {},
+		"special principals only": {
+			acl: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::EVERYONE@:r"},
+			},
+		},
+		"known principals": {
+			acl: &AccessControlList{
+				Entries: []string{"A::user1@:rw", "A:G:group1@example.com:r"},
+			},
+		},
+		"unknown principals": {
+			acl: &AccessControlList{
+				Entries: []string{"A::user1@:rw", "A::group1@:r", "A:G:user1@:r", "A:G:group1@:r"},
+			},
+			expErr: errors.New("unknown principals in ACL: u:group1@, g:user1@"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := checkACLPrincipals(tc.acl, mockLookup("user1"), mockLookup("group1"))
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestControl_MergeACL(t *testing.T) {
+	cur := &AccessControlList{
+		Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::user1@:r"},
+		Owner:   "owner@",
+	}
+
+	for name, tc := range map[string]struct {
+		cur    *AccessControlList
+		update *AccessControlList
+		expACL *AccessControlList
+	}{
+		"nil": {
+			expACL: &AccessControlList{},
+		},
+		"no current ACL": {
+			update: &AccessControlList{Entries: []string{"A::user1@:rw"}},
+			expACL: &AccessControlList{Entries: []string{"A::user1@:rw"}},
+		},
+		"replace and add": {
+			cur: cur,
+			update: &AccessControlList{
+				Entries: []string{"A:G:user1@:r", "A::user1@:rw"},
+			},
+			expACL: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::user1@:rw", "A:G:user1@:r"},
+				Owner:   "owner@",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expACL, MergeACL(tc.cur, tc.update)); diff != "" {
+				t.Fatalf("unexpected ACL (-want, +got):\n%s\n", diff)
+			}
+			if len(cur.Entries) != 3 || cur.Entries[2] != "A::user1@:r" {
+				t.Fatal("current ACL was modified")
+			}
+		})
+	}
+}
+
+func TestControl_DiffACL(t *testing.T) {
+	for name, tc := range map[string]struct {
+		old        *AccessControlList
+		new        *AccessControlList
+		expChanges []*ACLChange
+	}{
+		"nil": {
+			expChanges: []*ACLChange{},
+		},
+		"no changes": {
+			old:        &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			new:        &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			expChanges: []*ACLChange{},
+		},
+		"changes": {
+			old: &AccessControlList{
+				Entries: []string{"A::OWNER@:rw", "A:G:GROUP@:r", "A::user1@:r"},
+			},
+			new: &AccessControlList{
+				Entries: []string{"A::user2@:r", "A::OWNER@:rw", "A::user1@:rw"},
+			},
+			expChanges: []*ACLChange{
+				{Principal: "u:user2@", New: "A::user2@:r"},
+				{Principal: "u:user1@", Old: "A::user1@:r", New: "A::user1@:rw"},
+				{Principal: "GROUP@", Old: "A:G:GROUP@:r"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expChanges, DiffACL(tc.old, tc.new)); diff != "" {
+				t.Fatalf("unexpected changes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_FormatACL(t *testing.T) {
 	for name, tc := range map[string]struct {
 		acl     *AccessControlList
@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+type (
+	// AuditEntry is a record of a privileged management request handled by a server.
+	AuditEntry struct {
+		Host        string          `json:"host"`
+		Time        time.Time       `json:"time"`
+		Method      string          `json:"method"`
+		Client      string          `json:"client"`
+		Component   string          `json:"component,omitempty"`
+		User        string          `json:"user,omitempty"`         // verified client identity
+		ClaimedUser string          `json:"claimed_user,omitempty"` // reported by client, not verified
+		Args        json.RawMessage `json:"args,omitempty"`
+		Error       string          `json:"error,omitempty"`
+		DurationUs  uint64          `json:"duration_us"`
+	}
+
+	// AuditQueryReq contains the parameters for an audit log query request.
+	AuditQueryReq struct {
+		unaryRequest
+		MaxEntries uint32    // most recent entries to return, all if zero
+		Since      time.Time // only return entries recorded at or after this time
+		Method     string    // only return entries for methods containing this string
+	}
+
+	// AuditQueryResp contains the entries from the audit logs of the queried hosts,
+	// oldest first.
+	AuditQueryResp struct {
+		HostErrorsResp
+		Entries []*AuditEntry `json:"entries"`
+	}
+)
+
+func auditEntryFromPB(host string, pbEntry *ctlpb.AuditEntry) (*AuditEntry, error) {
+	entryTime, err := time.Parse(time.RFC3339Nano, pbEntry.GetTime())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid audit entry time from %s", host)
+	}
+
+	entry := &AuditEntry{
+		Host:        host,
+		Time:        entryTime,
+		Method:      pbEntry.GetMethod(),
+		Client:      pbEntry.GetClient(),
+		Component:   pbEntry.GetComponent(),
+		User:        pbEntry.GetUser(),
+		ClaimedUser: pbEntry.GetClaimedUser(),
+		Error:       pbEntry.GetError(),
+		DurationUs:  pbEntry.GetDurationUs(),
+	}
+	if args := pbEntry.GetArgs(); json.Valid([]byte(args)) {
+		entry.Args = json.RawMessage(args)
+	}
+
+	return entry, nil
+}
+
+// AuditQuery requests the most recent entries from the audit logs of privileged management
+// requests handled by each host in the hostlist. Entries from all hosts are merged in the
+// order they were recorded and, if a maximum is requested, only the most recent are returned.
+func AuditQuery(ctx context.Context, rpcClient UnaryInvoker, req *AuditQueryReq) (*AuditQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.AuditQueryReq{
+		MaxEntries: req.MaxEntries,
+		Method:     req.Method,
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = req.Since.Format(time.RFC3339)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).AuditQuery(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS audit query request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke audit query RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &AuditQueryResp{
+		Entries: []*AuditEntry{},
+	}
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hr.Message.(*ctlpb.AuditQueryResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hr.Message)
+		}
+		for _, pbEntry := range pbResp.GetEntries() {
+			entry, err := auditEntryFromPB(hr.Addr, pbEntry)
+			if err != nil {
+				return nil, err
+			}
+			resp.Entries = append(resp.Entries, entry)
+		}
+	}
+
+	sort.SliceStable(resp.Entries, func(i, j int) bool {
+		return resp.Entries[i].Time.Before(resp.Entries[j].Time)
+	})
+	if req.MaxEntries > 0 && len(resp.Entries) > int(req.MaxEntries) {
+		resp.Entries = resp.Entries[len(resp.Entries)-int(req.MaxEntries):]
+	}
+
+	rpcClient.Debugf("DAOS audit query response: %+v", resp)
+	return resp, nil
+}
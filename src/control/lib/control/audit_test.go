@@ -0,0 +1,199 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_AuditQuery(t *testing.T) {
+	t0 := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	pbEntry := func(offset time.Duration, method string) *ctlpb.AuditEntry {
+		return &ctlpb.AuditEntry{
+			Time:        t0.Add(offset).Format(time.RFC3339Nano),
+			Method:      method,
+			Client:      "10.0.0.1:4242",
+			Component:   "admin",
+			User:        "root",
+			ClaimedUser: "alice",
+			Args:        `{"uuid":"foo"}`,
+			DurationUs:  10,
+		}
+	}
+	entry := func(host string, offset time.Duration, method string) *AuditEntry {
+		return &AuditEntry{
+			Host:        host,
+			Time:        t0.Add(offset),
+			Method:      method,
+			Client:      "10.0.0.1:4242",
+			Component:   "admin",
+			User:        "root",
+			ClaimedUser: "alice",
+			Args:        json.RawMessage(`{"uuid":"foo"}`),
+			DurationUs:  10,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req         *AuditQueryReq
+		mic         *MockInvokerConfig
+		expResponse *AuditQueryResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invoker error": {
+			req: &AuditQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("fatal"),
+			},
+			expErr: errors.New("fatal"),
+		},
+		"nil message": {
+			req: &AuditQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"invalid entry time": {
+			req: &AuditQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.AuditQueryResp{
+								Entries: []*ctlpb.AuditEntry{
+									{Time: "yesterday"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expErr: errors.New("invalid audit entry time"),
+		},
+		"empty response": {
+			req: &AuditQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{},
+			},
+			expResponse: &AuditQueryResp{
+				Entries: []*AuditEntry{},
+			},
+		},
+		"multiple hosts; merged in time order; one fails": {
+			req: &AuditQueryReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.AuditQueryResp{
+								Entries: []*ctlpb.AuditEntry{
+									pbEntry(0, "/mgmt.MgmtSvc/PoolCreate"),
+									pbEntry(2*time.Second, "/mgmt.MgmtSvc/PoolDestroy"),
+								},
+							},
+						},
+						{
+							Addr:  "host2",
+							Error: FaultConnectionRefused("host2"),
+						},
+						{
+							Addr: "host3",
+							Message: &ctlpb.AuditQueryResp{
+								Entries: []*ctlpb.AuditEntry{
+									pbEntry(time.Second, "/ctl.CtlSvc/StorageFormat"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expResponse: &AuditQueryResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host2",
+					Error: FaultConnectionRefused("host2").Error(),
+				}),
+				Entries: []*AuditEntry{
+					entry("host1", 0, "/mgmt.MgmtSvc/PoolCreate"),
+					entry("host3", time.Second, "/ctl.CtlSvc/StorageFormat"),
+					entry("host1", 2*time.Second, "/mgmt.MgmtSvc/PoolDestroy"),
+				},
+			},
+		},
+		"multiple hosts; most recent entries returned": {
+			req: &AuditQueryReq{MaxEntries: 2},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.AuditQueryResp{
+								Entries: []*ctlpb.AuditEntry{
+									pbEntry(0, "/mgmt.MgmtSvc/PoolCreate"),
+									pbEntry(2*time.Second, "/mgmt.MgmtSvc/PoolDestroy"),
+								},
+							},
+						},
+						{
+							Addr: "host2",
+							Message: &ctlpb.AuditQueryResp{
+								Entries: []*ctlpb.AuditEntry{
+									pbEntry(time.Second, "/ctl.CtlSvc/StorageFormat"),
+									pbEntry(3*time.Second, "/ctl.CtlSvc/StorageScan"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expResponse: &AuditQueryResp{
+				Entries: []*AuditEntry{
+					entry("host1", 2*time.Second, "/mgmt.MgmtSvc/PoolDestroy"),
+					entry("host2", 3*time.Second, "/ctl.CtlSvc/StorageScan"),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := AuditQuery(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
@@ -48,6 +49,9 @@ const (
 	errInvalNrCores      = "invalid number of cores-per-numa, want at least 2 got %d"
 	errInsufNrProvGroups = "none of the provider-ifaces sets match the numa node sets " +
 		"that meet storage requirements"
+	errInsufNrTgts   = "generated config has %d targets per engine, fewer than the requested minimum %d"
+	errInsufSysCores = "cannot reserve %d cores for system usage with %d cores per numa node"
+	errUnbalancedSCM = "scm is not balanced across engines: %s"
 )
 
 var errNoNuma = errors.New("zero numa nodes reported on hosts")
@@ -70,13 +74,23 @@ type (
 		// Generate config with a tmpfs RAM-disk SCM.
 		UseTmpfsSCM bool `json:"UseTmpfsSCM"`
 		// Location to persist control-plane metadata, will generate MD-on-SSD config.
-		ExtMetadataPath string         `json:"ExtMetadataPath"`
-		Log             logging.Logger `json:"-"`
+		ExtMetadataPath string `json:"ExtMetadataPath"`
+		// Minimum number of targets per engine, generation fails if not met.
+		MinTargets int `json:"MinTargets"`
+		// Number of cores per engine to reserve for system usage, default used if zero.
+		SysCores int `json:"SysCores"`
+		// NVMe SSD PCI addresses that should not be used in the generated config.
+		ExcludedPCIAddrs []string `json:"-"`
+		// Require one equally sized PMem namespace per engine.
+		BalancedSCM bool           `json:"BalancedSCM"`
+		Log         logging.Logger `json:"-"`
 	}
 
 	// ConfGenerateResp contains the generated server config.
 	ConfGenerateResp struct {
 		config.Server
+		// Reasons for the choices made when generating the config.
+		Rationale []string `json:"rationale,omitempty"`
 	}
 
 	// ConfGenerateRemoteReq adds connectivity related fields to base request.
@@ -102,9 +116,10 @@ type (
 func (cgr *ConfGenerateReq) UnmarshalJSON(data []byte) error {
 	type Alias ConfGenerateReq
 	aux := &struct {
-		MgmtSvcReplicas string
-		FabricPorts     string
-		NetClass        string
+		MgmtSvcReplicas  string
+		FabricPorts      string
+		NetClass         string
+		ExcludedPCIAddrs string
 		*Alias
 	}{
 		Alias: (*Alias)(cgr),
@@ -126,6 +141,11 @@ func (cgr *ConfGenerateReq) UnmarshalJSON(data []byte) error {
 		}
 		cgr.FabricPorts = append(cgr.FabricPorts, n)
 	}
+	for _, s := range strings.Split(aux.ExcludedPCIAddrs, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			cgr.ExcludedPCIAddrs = append(cgr.ExcludedPCIAddrs, s)
+		}
+	}
 
 	switch aux.NetClass {
 	case "ethernet":
@@ -161,9 +181,24 @@ func DefaultEngineCfg(idx int) *engine.Config {
 		WithLogFile(fmt.Sprintf("%s.%d.log", defaultEngineLogFile, idx))
 }
 
+// sysCores returns the number of cores per engine to reserve for system usage.
+func (cgr *ConfGenerateReq) sysCores() int {
+	if cgr.SysCores == 0 {
+		return coresRsvdPerEngine
+	}
+	return cgr.SysCores
+}
+
 // ConfGenerate derives an optimal server config file from details of network, storage and CPU
 // hardware by evaluating affinity matches for NUMA node combinations.
 func ConfGenerate(req ConfGenerateReq, newEngineCfg newEngineCfgFn, hf *HostFabric, hs *HostStorage) (*ConfGenerateResp, error) {
+	if req.MinTargets < 0 {
+		return nil, errors.Errorf("invalid minimum number of targets %d", req.MinTargets)
+	}
+	if req.SysCores < 0 {
+		return nil, errors.Errorf("invalid number of system cores %d", req.SysCores)
+	}
+
 	// process host fabric scan results to retrieve network details
 	nd, err := getNetworkDetails(req, hf)
 	if err != nil {
@@ -182,6 +217,12 @@ func ConfGenerate(req ConfGenerateReq, newEngineCfg newEngineCfgFn, hf *HostFabr
 		return nil, err
 	}
 
+	if req.BalancedSCM {
+		if err := checkSCMBalance(nodeSet, sd); err != nil {
+			return nil, err
+		}
+	}
+
 	// populate engine configs with storage and network devices
 	ecs, err := genEngineConfigs(req, newEngineCfg, nodeSet, nd, sd)
 	if err != nil {
@@ -189,10 +230,14 @@ func ConfGenerate(req ConfGenerateReq, newEngineCfg newEngineCfgFn, hf *HostFabr
 	}
 
 	// calculate service and helper thread counts
-	tc, err := getThreadCounts(req.Log, nodeSet, nd.NumaCoreCount, sd.NumaSSDs)
+	tc, err := getThreadCounts(req.Log, nodeSet, nd.NumaCoreCount, req.sysCores(),
+		sd.NumaSSDs)
 	if err != nil {
 		return nil, err
 	}
+	if tc.nrTgts < req.MinTargets {
+		return nil, errors.Errorf(errInsufNrTgts, tc.nrTgts, req.MinTargets)
+	}
 
 	// populate server config using engine configs
 	sc, err := genServerConfig(req, ecs, tc)
@@ -200,7 +245,10 @@ func ConfGenerate(req ConfGenerateReq, newEngineCfg newEngineCfgFn, hf *HostFabr
 		return nil, err
 	}
 
-	resp := ConfGenerateResp{Server: *sc}
+	resp := ConfGenerateResp{
+		Server:    *sc,
+		Rationale: genRationale(req, nd, tc, sc),
+	}
 	return &resp, nil
 }
 
@@ -506,6 +554,43 @@ type storageDetails struct {
 	NumaSSDs   numaSSDsMap
 	SysMemInfo *common.SysMemInfo
 	scmCls     storage.Class
+	scmSizes   map[string]uint64
+}
+
+// filterExcludedSSDs returns the SSDs whose PCI address, or VMD domain address for VMD backing
+// devices, is not in the excluded list.
+func filterExcludedSSDs(ssds storage.NvmeControllers, excluded []string) (storage.NvmeControllers, error) {
+	if len(excluded) == 0 {
+		return ssds, nil
+	}
+
+	exclSet, err := hardware.NewPCIAddressSet(excluded...)
+	if err != nil {
+		return nil, errors.Wrap(err, "excluded pci addresses")
+	}
+
+	var out storage.NvmeControllers
+	for _, ssd := range ssds {
+		addr, err := hardware.NewPCIAddress(ssd.PciAddr)
+		if err != nil {
+			return nil, err
+		}
+		if exclSet.Contains(addr) {
+			continue
+		}
+		if addr.IsVMDBackingAddress() {
+			vmdAddr, err := addr.BackingToVMDAddress()
+			if err != nil {
+				return nil, err
+			}
+			if exclSet.Contains(vmdAddr) {
+				continue
+			}
+		}
+		out = append(out, ssd)
+	}
+
+	return out, nil
 }
 
 // getStorageDetails retrieves mappings of NUMA node to PMem and NVMe SSD devices.  Returns storage
@@ -530,7 +615,16 @@ func getStorageDetails(req ConfGenerateReq, numaCount int, hs *HostStorage) (*st
 		return nil, errors.New("requires nonzero HugepageSizeKiB")
 	}
 
-	if err := sd.NumaSSDs.fromNVMe(hs.NvmeDevices); err != nil {
+	ssds, err := filterExcludedSSDs(hs.NvmeDevices, req.ExcludedPCIAddrs)
+	if err != nil {
+		return nil, err
+	}
+	if len(ssds) != len(hs.NvmeDevices) {
+		req.Log.Debugf("excluded %d ssds matching %v", len(hs.NvmeDevices)-len(ssds),
+			req.ExcludedPCIAddrs)
+	}
+
+	if err := sd.NumaSSDs.fromNVMe(ssds); err != nil {
 		return nil, errors.Wrap(err, "mapping ssd addresses to numa node")
 	}
 
@@ -555,10 +649,43 @@ func getStorageDetails(req ConfGenerateReq, numaCount int, hs *HostStorage) (*st
 	if err := sd.NumaSCMs.fromSCM(hs.ScmNamespaces); err != nil {
 		return nil, errors.Wrap(err, "mapping scm block device names to numa node")
 	}
+	sd.scmSizes = make(map[string]uint64)
+	for _, ns := range hs.ScmNamespaces {
+		sd.scmSizes[fmt.Sprintf("%s/%s", scmBdevDir, ns.BlockDevice)] = ns.Size
+	}
 
 	return &sd, nil
 }
 
+// checkSCMBalance verifies that each NUMA node in the set has a single PMem namespace and that
+// all of the namespaces are the same size. RAM-disk SCM is sized equally per engine so is always
+// balanced.
+func checkSCMBalance(nodeSet []int, sd *storageDetails) error {
+	if sd.scmCls != storage.ClassDcpm {
+		return nil
+	}
+
+	var firstDev string
+	for _, numaID := range nodeSet {
+		devs := sd.NumaSCMs[numaID]
+		if len(devs) != 1 {
+			return errors.Errorf(errUnbalancedSCM, fmt.Sprintf("numa %d has %d pmem "+
+				"namespaces, want 1", numaID, len(devs)))
+		}
+		if firstDev == "" {
+			firstDev = devs[0]
+			continue
+		}
+		if sd.scmSizes[devs[0]] != sd.scmSizes[firstDev] {
+			return errors.Errorf(errUnbalancedSCM, fmt.Sprintf("%s is %s but %s is %s",
+				firstDev, humanize.IBytes(sd.scmSizes[firstDev]), devs[0],
+				humanize.IBytes(sd.scmSizes[devs[0]])))
+		}
+	}
+
+	return nil
+}
+
 // Filters PMem and SSD groups to include only the NUMA IDs that have sufficient number of devices
 // with appropriate affinity. Returns error if not enough satisfied NUMA ID groupings for required
 // engine count.
@@ -1125,15 +1252,18 @@ type threadCounts struct {
 // xs_streams_per_engine = ROUNDDOWN(#targets_per_engine / 4; 0)
 //
 // Here, 0.8 = 4/5 = #targets / (#targets + #xs_streams).
-func getThreadCounts(log logging.Logger, nodeSet []int, coresPerEngine int, numaSSDs numaSSDsMap) (*threadCounts, error) {
+func getThreadCounts(log logging.Logger, nodeSet []int, coresPerEngine, sysCores int, numaSSDs numaSSDsMap) (*threadCounts, error) {
 	if len(nodeSet) == 0 {
 		return nil, errors.New("empty nodeSet")
 	}
 	if coresPerEngine < 2 {
 		return nil, errors.Errorf(errInvalNrCores, coresPerEngine)
 	}
+	if sysCores >= coresPerEngine {
+		return nil, errors.Errorf(errInsufSysCores, sysCores, coresPerEngine)
+	}
 	// reserve cores for system usage
-	coresPerEngine -= coresRsvdPerEngine
+	coresPerEngine -= sysCores
 
 	// number of ssds will be the same for each engine
 	ssds, exists := numaSSDs[nodeSet[0]]
@@ -1183,9 +1313,9 @@ func getThreadCounts(log logging.Logger, nodeSet []int, coresPerEngine int, numa
 		nrHlprs: tgtsPerEngine / 4,
 	}
 
-	log.Debugf("per-engine %d targets assigned with %d ssds (based on %d cores of which 2 are "+
-		"reserved for system usage) and %d helper xstreams", tc.nrTgts, ssdsPerEngine,
-		coresPerEngine+coresRsvdPerEngine, tc.nrHlprs)
+	log.Debugf("per-engine %d targets assigned with %d ssds (based on %d cores of which %d "+
+		"are reserved for system usage) and %d helper xstreams", tc.nrTgts, ssdsPerEngine,
+		coresPerEngine+sysCores, sysCores, tc.nrHlprs)
 
 	return &tc, nil
 }
@@ -1262,3 +1392,58 @@ func genServerConfig(req ConfGenerateReq, ecs []*engine.Config, tc *threadCounts
 
 	return cfg, nil
 }
+
+// genRationale describes the choices made when generating a server config so that they can be
+// reviewed alongside the generated config.
+func genRationale(req ConfGenerateReq, nd *networkDetails, tc *threadCounts, cfg *config.Server) []string {
+	var lines []string
+
+	if req.NrEngines == 0 {
+		lines = append(lines, fmt.Sprintf("%d engines, one per numa node with matching "+
+			"storage and fabric devices", len(cfg.Engines)))
+	} else {
+		lines = append(lines, fmt.Sprintf("%d engines as requested", len(cfg.Engines)))
+	}
+
+	for idx, ec := range cfg.Engines {
+		line := fmt.Sprintf("engine %d:", idx)
+		if ec.PinnedNumaNode != nil {
+			line += fmt.Sprintf(" numa %d,", *ec.PinnedNumaNode)
+			if iface, exists := nd.NumaIfaces[int(*ec.PinnedNumaNode)]; exists {
+				line += fmt.Sprintf(" fabric %s (%s, priority %d),", iface.Device,
+					iface.Provider, iface.Priority)
+			}
+		}
+		for _, tier := range ec.Storage.Tiers.ScmConfigs() {
+			if tier.Class == storage.ClassRam {
+				line += " scm ram-disk,"
+			} else {
+				line += fmt.Sprintf(" scm %s,", strings.Join(tier.Scm.DeviceList, " "))
+			}
+		}
+		line += fmt.Sprintf(" %d ssds", ec.Storage.Tiers.NVMeBdevs().Len())
+		lines = append(lines, line)
+	}
+
+	if len(req.ExcludedPCIAddrs) > 0 {
+		lines = append(lines, fmt.Sprintf("ssds %s excluded as requested",
+			strings.Join(req.ExcludedPCIAddrs, ",")))
+	}
+	if !req.SCMOnly {
+		lines = append(lines, "ssd count per engine limited to the lowest count across engines "+
+			"to keep engines balanced")
+	}
+	if req.BalancedSCM {
+		lines = append(lines, "scm balanced across engines as requested")
+	}
+
+	lines = append(lines, fmt.Sprintf("%d targets and %d helper threads per engine from %d "+
+		"cores per numa node with %d reserved for system usage", tc.nrTgts, tc.nrHlprs,
+		nd.NumaCoreCount, req.sysCores()))
+	if req.MinTargets > 0 {
+		lines = append(lines, fmt.Sprintf("minimum of %d targets per engine met",
+			req.MinTargets))
+	}
+
+	return lines
+}
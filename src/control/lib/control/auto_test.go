@@ -696,6 +696,118 @@ func TestControl_AutoConfig_getStorageDetails(t *testing.T) {
 	}
 }
 
+func TestControl_AutoConfig_filterExcludedSSDs(t *testing.T) {
+	vmdBacking := storage.MockNvmeController(5)
+	vmdBacking.PciAddr = "5d0505:01:00.0"
+
+	for name, tc := range map[string]struct {
+		ssds     storage.NvmeControllers
+		excluded []string
+		expAddrs []string
+		expErr   error
+	}{
+		"nothing excluded": {
+			ssds: storage.NvmeControllers{
+				storage.MockNvmeController(1),
+				storage.MockNvmeController(2),
+			},
+			expAddrs: test.MockPCIAddrs(1, 2),
+		},
+		"bad excluded address": {
+			ssds:     storage.NvmeControllers{storage.MockNvmeController(1)},
+			excluded: []string{"foo"},
+			expErr:   errors.New("excluded pci addresses"),
+		},
+		"one excluded": {
+			ssds: storage.NvmeControllers{
+				storage.MockNvmeController(1),
+				storage.MockNvmeController(2),
+				storage.MockNvmeController(3),
+			},
+			excluded: []string{test.MockPCIAddr(2)},
+			expAddrs: test.MockPCIAddrs(1, 3),
+		},
+		"vmd domain excluded": {
+			ssds: storage.NvmeControllers{
+				storage.MockNvmeController(1),
+				vmdBacking,
+			},
+			excluded: []string{"0000:5d:05.5"},
+			expAddrs: test.MockPCIAddrs(1),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSSDs, gotErr := filterExcludedSSDs(tc.ssds, tc.excluded)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotAddrs []string
+			for _, ssd := range gotSSDs {
+				gotAddrs = append(gotAddrs, ssd.PciAddr)
+			}
+			if diff := cmp.Diff(tc.expAddrs, gotAddrs); diff != "" {
+				t.Fatalf("unexpected ssds (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_AutoConfig_checkSCMBalance(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nodeSet []int
+		sd      *storageDetails
+		expErr  error
+	}{
+		"ram scm": {
+			nodeSet: []int{0, 1},
+			sd: &storageDetails{
+				NumaSCMs: numaSCMsMap{0: {""}, 1: {""}},
+				scmCls:   storage.ClassRam,
+			},
+		},
+		"balanced pmem": {
+			nodeSet: []int{0, 1},
+			sd: &storageDetails{
+				NumaSCMs: numaSCMsMap{0: {"/dev/pmem0"}, 1: {"/dev/pmem1"}},
+				scmCls:   storage.ClassDcpm,
+				scmSizes: map[string]uint64{
+					"/dev/pmem0": humanize.TByte,
+					"/dev/pmem1": humanize.TByte,
+				},
+			},
+		},
+		"multiple namespaces on a numa node": {
+			nodeSet: []int{0, 1},
+			sd: &storageDetails{
+				NumaSCMs: numaSCMsMap{
+					0: {"/dev/pmem0"},
+					1: {"/dev/pmem1", "/dev/pmem1.1"},
+				},
+				scmCls: storage.ClassDcpm,
+			},
+			expErr: errors.New("numa 1 has 2 pmem namespaces"),
+		},
+		"namespace sizes differ": {
+			nodeSet: []int{0, 1},
+			sd: &storageDetails{
+				NumaSCMs: numaSCMsMap{0: {"/dev/pmem0"}, 1: {"/dev/pmem1"}},
+				scmCls:   storage.ClassDcpm,
+				scmSizes: map[string]uint64{
+					"/dev/pmem0": humanize.TByte,
+					"/dev/pmem1": 2 * humanize.TByte,
+				},
+			},
+			expErr: errors.New("/dev/pmem0 is 931 GiB but /dev/pmem1 is 1.8 TiB"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, checkSCMBalance(tc.nodeSet, tc.sd))
+		})
+	}
+}
+
 func TestControl_AutoConfig_filterDevicesByAffinity(t *testing.T) {
 	singlePMemMap := numaSCMsMap{0: []string{"/dev/pmem0"}}
 
@@ -1505,6 +1617,7 @@ func TestControl_AutoConfig_getThreadCounts(t *testing.T) {
 	for name, tc := range map[string]struct {
 		nodeSet       []int // set of NUMA nodes
 		numaCoreCount int   // physical( cores per NUMA node
+		sysCores      int   // cores reserved for system usage, default if unset
 		numaSSDs      numaSSDsMap
 		expNrTgts     int
 		expNrHlprs    int
@@ -1589,6 +1702,23 @@ func TestControl_AutoConfig_getThreadCounts(t *testing.T) {
 			expNrTgts:  16,
 			expNrHlprs: 4,
 		},
+		"26 cores 2 ssd; 6 system cores": {
+			nodeSet:       []int{1},
+			numaCoreCount: 26,
+			sysCores:      6,
+			numaSSDs: numaSSDsMap{1: hardware.MustNewPCIAddressSet(
+				test.MockPCIAddrs(0, 1)...)},
+			expNrTgts:  16,
+			expNrHlprs: 4,
+		},
+		"all cores reserved for system": {
+			nodeSet:       []int{1},
+			numaCoreCount: 8,
+			sysCores:      8,
+			numaSSDs: numaSSDsMap{1: hardware.MustNewPCIAddressSet(
+				test.MockPCIAddrs(0, 1)...)},
+			expErr: errors.Errorf(errInsufSysCores, 8, 8),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1596,8 +1726,9 @@ func TestControl_AutoConfig_getThreadCounts(t *testing.T) {
 
 			// TODO DAOS-11859: Test calculation based on MD-on-SSD (bdev tiers)
 
+			req := ConfGenerateReq{SysCores: tc.sysCores}
 			gotCounts, gotErr := getThreadCounts(log, tc.nodeSet, tc.numaCoreCount,
-				tc.numaSSDs)
+				req.sysCores(), tc.numaSSDs)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
@@ -0,0 +1,104 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+type (
+	// CertInfo describes the certificate loaded by a server.
+	CertInfo struct {
+		CommonName string    `json:"common_name"`
+		Serial     string    `json:"serial"`
+		NotBefore  time.Time `json:"not_before"`
+		NotAfter   time.Time `json:"not_after"`
+	}
+
+	// ReloadCertsReq contains the inputs for the reload certificates request.
+	ReloadCertsReq struct {
+		unaryRequest
+	}
+
+	// ReloadCertsResp contains the certificates loaded by each host after a reload
+	// certificates request.
+	ReloadCertsResp struct {
+		HostErrorsResp
+		HostCerts map[string]*CertInfo `json:"host_certs"`
+	}
+)
+
+func certInfoFromPB(host string, pbResp *ctlpb.ReloadCertsResp) (*CertInfo, error) {
+	notBefore, err := time.Parse(time.RFC3339, pbResp.GetNotBefore())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid certificate start time from %s", host)
+	}
+	notAfter, err := time.Parse(time.RFC3339, pbResp.GetNotAfter())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid certificate expiry time from %s", host)
+	}
+
+	return &CertInfo{
+		CommonName: pbResp.GetCommonName(),
+		Serial:     pbResp.GetSerial(),
+		NotBefore:  notBefore,
+		NotAfter:   notAfter,
+	}, nil
+}
+
+// ReloadCerts will send RPC to hostlist to request that each daos_server reloads its
+// certificates and certificate revocation list from disk. Connections made after a
+// successful reload use the new certificates.
+func ReloadCerts(ctx context.Context, rpcClient UnaryInvoker, req *ReloadCertsReq) (*ReloadCertsResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.ReloadCertsReq{}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).ReloadCerts(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS reload certificates request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke reload certificates RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &ReloadCertsResp{
+		HostCerts: make(map[string]*CertInfo),
+	}
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hr.Message.(*ctlpb.ReloadCertsResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hr.Message)
+		}
+		info, err := certInfoFromPB(hr.Addr, pbResp)
+		if err != nil {
+			return nil, err
+		}
+		resp.HostCerts[hr.Addr] = info
+	}
+
+	rpcClient.Debugf("DAOS reload certificates response: %+v", resp)
+	return resp, nil
+}
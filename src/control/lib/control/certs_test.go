@@ -0,0 +1,133 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_ReloadCerts(t *testing.T) {
+	notBefore := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	notAfter := notBefore.Add(365 * 24 * time.Hour)
+	pbResp := &ctlpb.ReloadCertsResp{
+		CommonName: "server",
+		Serial:     "1f",
+		NotBefore:  notBefore.Format(time.RFC3339),
+		NotAfter:   notAfter.Format(time.RFC3339),
+	}
+	certInfo := &CertInfo{
+		CommonName: "server",
+		Serial:     "1f",
+		NotBefore:  notBefore,
+		NotAfter:   notAfter,
+	}
+
+	for name, tc := range map[string]struct {
+		req         *ReloadCertsReq
+		mic         *MockInvokerConfig
+		expResponse *ReloadCertsResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invoker error": {
+			req: &ReloadCertsReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("fatal"),
+			},
+			expErr: errors.New("fatal"),
+		},
+		"nil message": {
+			req: &ReloadCertsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"invalid expiry time": {
+			req: &ReloadCertsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.ReloadCertsResp{
+								NotBefore: notBefore.Format(time.RFC3339),
+								NotAfter:  "tomorrow",
+							},
+						},
+					},
+				},
+			},
+			expErr: errors.New("invalid certificate expiry time"),
+		},
+		"multiple hosts; one fails": {
+			req: &ReloadCertsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:    "host1",
+							Message: pbResp,
+						},
+						{
+							Addr:  "host2",
+							Error: errors.New("certificate has been revoked"),
+						},
+						{
+							Addr:    "host3",
+							Message: pbResp,
+						},
+					},
+				},
+			},
+			expResponse: &ReloadCertsResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host2",
+					Error: "certificate has been revoked",
+				}),
+				HostCerts: map[string]*CertInfo{
+					"host1": certInfo,
+					"host3": certInfo,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := ReloadCerts(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
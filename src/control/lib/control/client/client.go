@@ -0,0 +1,197 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+// Version is the version of the interface provided by this package.
+const Version = "1.0.0"
+
+type (
+	// Logger is the interface used by the client to log debug messages.
+	Logger interface {
+		Debug(string)
+		Debugf(string, ...interface{})
+	}
+
+	// Config contains the parameters used to connect to the DAOS servers. Fields that
+	// are not set take the default values used by dmg.
+	Config struct {
+		// SystemName is the name of the DAOS system.
+		SystemName string
+		// HostList contains the addresses of the servers to connect to. Requests for
+		// the management service only need to include one of its replicas.
+		HostList []string
+		// ControlPort is the port used for hosts in HostList that don't include one.
+		ControlPort int
+		// Insecure disables certificate-based transport security, and must match the
+		// configuration of the servers.
+		Insecure bool
+		// CACertPath, CertPath and KeyPath are the paths of the CA certificate and of
+		// the administrative client certificate and key.
+		CACertPath string
+		CertPath   string
+		KeyPath    string
+		// TokenFile is the path of a file containing a bearer token that is sent in
+		// addition to the client certificate, for servers that use token authentication.
+		TokenFile string
+	}
+
+	// Option configures a Client created with New.
+	Option func(*clientOptions) error
+
+	clientOptions struct {
+		cfg     *control.Config
+		log     Logger
+		invoker control.UnaryInvoker
+	}
+
+	// Client manages a DAOS system. It is safe for concurrent use.
+	Client struct {
+		invoker control.UnaryInvoker
+		closer  func() error
+
+		// Pools manages the pools of the system.
+		Pools *PoolService
+		// System manages the engines that are members of the system.
+		System *SystemService
+		// Storage reports the storage of the servers.
+		Storage *StorageService
+	}
+)
+
+func (cfg *Config) toControlConfig() *control.Config {
+	ctlCfg := control.DefaultConfig()
+	if cfg.SystemName != "" {
+		ctlCfg.SystemName = cfg.SystemName
+	}
+	if len(cfg.HostList) > 0 {
+		ctlCfg.HostList = cfg.HostList
+	}
+	if cfg.ControlPort != 0 {
+		ctlCfg.ControlPort = cfg.ControlPort
+	}
+
+	tc := security.DefaultClientTransportConfig()
+	tc.AllowInsecure = cfg.Insecure
+	if cfg.CACertPath != "" {
+		tc.CARootPath = cfg.CACertPath
+	}
+	if cfg.CertPath != "" {
+		tc.CertificatePath = cfg.CertPath
+	}
+	if cfg.KeyPath != "" {
+		tc.PrivateKeyPath = cfg.KeyPath
+	}
+	tc.TokenFile = cfg.TokenFile
+	ctlCfg.TransportConfig = tc
+
+	return ctlCfg
+}
+
+// WithConfig sets the parameters used to connect to the DAOS servers.
+func WithConfig(cfg *Config) Option {
+	return func(opts *clientOptions) error {
+		if cfg == nil {
+			return errors.New("nil config")
+		}
+		opts.cfg = cfg.toControlConfig()
+		return nil
+	}
+}
+
+// WithConfigFile loads the parameters used to connect to the DAOS servers from a dmg
+// configuration file.
+func WithConfigFile(path string) Option {
+	return func(opts *clientOptions) error {
+		if path == "" {
+			return errors.New("empty config file path")
+		}
+		cfg, err := control.LoadConfig(path)
+		if err != nil {
+			return err
+		}
+		opts.cfg = cfg
+		return nil
+	}
+}
+
+// WithLogger sets the logger for the debug messages of the client.
+func WithLogger(log Logger) Option {
+	return func(opts *clientOptions) error {
+		opts.log = log
+		return nil
+	}
+}
+
+// withInvoker sets the invoker used to make requests in place of a connection to the
+// servers.
+func withInvoker(invoker control.UnaryInvoker) Option {
+	return func(opts *clientOptions) error {
+		opts.invoker = invoker
+		return nil
+	}
+}
+
+// New returns a Client configured by the supplied options. If neither WithConfig nor
+// WithConfigFile is given, the configuration is loaded from the dmg configuration file in
+// the home directory of the user or the system configuration directory, or defaults to a
+// single server on the local host if neither exists.
+func New(options ...Option) (*Client, error) {
+	opts := &clientOptions{}
+	for _, opt := range options {
+		if err := opt(opts); err != nil {
+			return nil, wrapErr("client create", err)
+		}
+	}
+
+	c := &Client{
+		invoker: opts.invoker,
+		closer:  func() error { return nil },
+	}
+	if c.invoker == nil {
+		if opts.cfg == nil {
+			cfg, err := control.LoadConfig("")
+			switch {
+			case err == control.ErrNoConfigFile:
+				cfg = control.DefaultConfig()
+			case err != nil:
+				return nil, wrapErr("client create", err)
+			}
+			opts.cfg = cfg
+		}
+
+		ctlOpts := []control.ClientOption{
+			control.WithConfig(opts.cfg),
+			control.WithClientComponent(build.ComponentAdmin),
+			control.WithClientConnCache(),
+		}
+		if opts.log != nil {
+			ctlOpts = append(ctlOpts, control.WithClientLogger(opts.log))
+		}
+		ctlClient := control.NewClient(ctlOpts...)
+		c.invoker = ctlClient
+		c.closer = ctlClient.Close
+	}
+
+	c.Pools = &PoolService{c: c}
+	c.System = &SystemService{c: c}
+	c.Storage = &StorageService{c: c}
+
+	return c, nil
+}
+
+// Close releases the connections held by the client.
+func (c *Client) Close() error {
+	return c.closer()
+}
@@ -0,0 +1,108 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestClient_Config_toControlConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *Config
+		expCfg func() *control.Config
+	}{
+		"defaults": {
+			cfg:    &Config{},
+			expCfg: control.DefaultConfig,
+		},
+		"custom": {
+			cfg: &Config{
+				SystemName:  "daos_test",
+				HostList:    []string{"host1", "host2:10005"},
+				ControlPort: 10004,
+				CACertPath:  "/etc/daos/ca.crt",
+				CertPath:    "/etc/daos/admin.crt",
+				KeyPath:     "/etc/daos/admin.key",
+				TokenFile:   "/etc/daos/token",
+			},
+			expCfg: func() *control.Config {
+				cfg := control.DefaultConfig()
+				cfg.SystemName = "daos_test"
+				cfg.HostList = []string{"host1", "host2:10005"}
+				cfg.ControlPort = 10004
+				cfg.TransportConfig.AllowInsecure = false
+				cfg.TransportConfig.CARootPath = "/etc/daos/ca.crt"
+				cfg.TransportConfig.CertificatePath = "/etc/daos/admin.crt"
+				cfg.TransportConfig.PrivateKeyPath = "/etc/daos/admin.key"
+				cfg.TransportConfig.TokenFile = "/etc/daos/token"
+				return cfg
+			},
+		},
+		"insecure": {
+			cfg: &Config{Insecure: true},
+			expCfg: func() *control.Config {
+				cfg := control.DefaultConfig()
+				cfg.TransportConfig.AllowInsecure = true
+				return cfg
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCfg := tc.cfg.toControlConfig()
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(security.CertificateConfig{}),
+			}
+			if diff := cmp.Diff(tc.expCfg(), gotCfg, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClient_New(t *testing.T) {
+	for name, tc := range map[string]struct {
+		options []Option
+		expErr  error
+	}{
+		"nil config": {
+			options: []Option{WithConfig(nil)},
+			expErr:  errors.New("nil config"),
+		},
+		"empty config file path": {
+			options: []Option{WithConfigFile("")},
+			expErr:  errors.New("empty config file path"),
+		},
+		"missing config file": {
+			options: []Option{WithConfigFile("/does/not/exist.yml")},
+			expErr:  errors.New("no such file"),
+		},
+		"config": {
+			options: []Option{WithConfig(&Config{HostList: []string{"host1"}})},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, gotErr := New(tc.options...)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			defer c.Close()
+
+			test.AssertTrue(t, c.Pools != nil && c.System != nil && c.Storage != nil,
+				"expected services to be set")
+		})
+	}
+}
@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+/*
+Package client provides a stable Go interface for managing a DAOS system, intended for
+applications such as operators and Kubernetes controllers that would otherwise run dmg.
+
+A Client is created with New and groups the supported operations into services:
+
+	c, err := client.New(client.WithConfigFile("/etc/daos/daos_control.yml"))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	pools, err := c.Pools.List(ctx)
+	if errors.Is(err, client.ErrUnavailable) {
+		// retry later
+	}
+
+Every operation takes a context.Context that controls its deadline and cancellation.
+Errors returned by operations are of type *Error and may be tested against the error kinds
+declared by this package, e.g. ErrNotFound, with errors.Is.
+
+# Compatibility
+
+The exported identifiers of this package follow semantic versioning, independently of the
+DAOS release version, and the version is reported by Version. Within a major version,
+existing identifiers are not removed or changed in an incompatible way; new services,
+operations, options and result fields may be added. Callers should therefore use keyed
+fields in struct literals. The types of this package do not expose the types of other
+packages in this repository, which remain subject to change between DAOS releases.
+
+Operations that depend on a control API feature that is not supported by all servers fail
+with ErrUnsupported.
+*/
+package client
@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// Kinds of error returned by client operations, to be tested for with errors.Is.
+var (
+	// ErrNotFound indicates that the pool, rank or other entity does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists indicates that the entity to be created already exists.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrPermissionDenied indicates that the client is not permitted to make the request.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrUnavailable indicates that the servers or management service could not be reached
+	// or are not ready. The operation may succeed if retried later.
+	ErrUnavailable = errors.New("unavailable")
+	// ErrUnsupported indicates that the servers do not support the operation, e.g. because
+	// they run an older version than the client.
+	ErrUnsupported = errors.New("unsupported")
+	// ErrInvalidArgument indicates that the request was rejected as invalid.
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrBusy indicates that the entity is in use, e.g. a pool with open connections.
+	ErrBusy = errors.New("busy")
+)
+
+// Error describes the failure of a client operation.
+type Error struct {
+	// Op is the operation that failed, e.g. "pool create".
+	Op string
+	// Kind is one of the error kinds declared by this package, or nil if the failure
+	// doesn't correspond to any of them.
+	Kind error
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Op + ": " + e.Err.Error()
+}
+
+// Unwrap returns the kind and underlying error so that both may be tested for with
+// errors.Is and errors.As.
+func (e *Error) Unwrap() []error {
+	if e.Kind == nil {
+		return []error{e.Err}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// wrapErr returns an *Error for the failure of the given operation, or nil if err is nil.
+func wrapErr(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Op: op, Kind: errKind(err), Err: err}
+}
+
+var errKinds = []error{
+	ErrNotFound, ErrAlreadyExists, ErrPermissionDenied, ErrUnavailable, ErrUnsupported,
+	ErrInvalidArgument, ErrBusy,
+}
+
+func errKind(err error) error {
+	for _, kind := range errKinds {
+		if errors.Is(err, kind) {
+			return kind
+		}
+	}
+	cause := errors.Cause(err)
+
+	switch {
+	case system.IsPoolNotFound(err), system.IsMemberNotFound(err):
+		return ErrNotFound
+	case control.IsConnErr(err), control.IsMSConnectionFailure(err),
+		system.IsUnavailable(err), system.IsNotReady(err):
+		return ErrUnavailable
+	case control.IsMethodUnsupported(err):
+		return ErrUnsupported
+	}
+
+	if f, ok := cause.(*fault.Fault); ok {
+		switch f.Code {
+		case code.ClientIncompatibleAPIVersion, code.ServerIncompatibleAPIVersion,
+			code.ServerIncompatibleComponents, code.ServerNoCompatibilityInsecure:
+			return ErrUnsupported
+		case code.ClientRpcTimeout, code.ServerDataPlaneNotStarted:
+			return ErrUnavailable
+		case code.ServerPoolDuplicateLabel:
+			return ErrAlreadyExists
+		}
+		return nil
+	}
+
+	if ds, ok := cause.(daos.Status); ok {
+		switch ds {
+		case daos.Nonexistent:
+			return ErrNotFound
+		case daos.Exists:
+			return ErrAlreadyExists
+		case daos.NoPermission:
+			return ErrPermissionDenied
+		case daos.Unreachable, daos.TimedOut, daos.TryAgain:
+			return ErrUnavailable
+		case daos.NotImpl:
+			return ErrUnsupported
+		case daos.InvalidInput:
+			return ErrInvalidArgument
+		case daos.Busy:
+			return ErrBusy
+		}
+		return nil
+	}
+
+	if st, ok := status.FromError(cause); ok {
+		switch st.Code() {
+		case codes.NotFound:
+			return ErrNotFound
+		case codes.AlreadyExists:
+			return ErrAlreadyExists
+		case codes.PermissionDenied, codes.Unauthenticated:
+			return ErrPermissionDenied
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return ErrUnavailable
+		case codes.Unimplemented:
+			return ErrUnsupported
+		case codes.InvalidArgument:
+			return ErrInvalidArgument
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestClient_wrapErr(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err     error
+		expKind error
+	}{
+		"nil error": {},
+		"unclassified error": {
+			err: errors.New("whoops"),
+		},
+		"wrapped kind": {
+			err:     errors.Wrap(ErrInvalidArgument, "empty pool ID"),
+			expKind: ErrInvalidArgument,
+		},
+		"pool not found": {
+			err:     errors.Wrap(system.ErrPoolLabelNotFound("foo"), "query"),
+			expKind: ErrNotFound,
+		},
+		"connection refused": {
+			err:     control.FaultConnectionRefused("host1"),
+			expKind: ErrUnavailable,
+		},
+		"method unsupported": {
+			err:     control.FaultMethodUnsupported("host1", "/mgmt.MgmtSvc/PoolClone"),
+			expKind: ErrUnsupported,
+		},
+		"incompatible API version": {
+			err:     control.FaultIncompatibleAPIVersion("host1", 0),
+			expKind: ErrUnsupported,
+		},
+		"daos status": {
+			err:     errors.Wrap(daos.Busy, "pool destroy failed"),
+			expKind: ErrBusy,
+		},
+		"grpc status": {
+			err:     status.Error(codes.PermissionDenied, "denied"),
+			expKind: ErrPermissionDenied,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := wrapErr("test op", tc.err)
+			if tc.err == nil {
+				if gotErr != nil {
+					t.Fatalf("expected nil error, got %v", gotErr)
+				}
+				return
+			}
+
+			var cErr *Error
+			if !errors.As(gotErr, &cErr) {
+				t.Fatalf("expected *Error, got %T", gotErr)
+			}
+			test.AssertEqual(t, "test op", cErr.Op, "unexpected operation")
+			test.AssertEqual(t, "test op: "+tc.err.Error(), gotErr.Error(), "unexpected message")
+			test.AssertTrue(t, errors.Is(gotErr, tc.err), "expected underlying error to match")
+			if tc.expKind == nil {
+				test.AssertTrue(t, cErr.Kind == nil, "unexpected error kind")
+				return
+			}
+			test.AssertTrue(t, errors.Is(gotErr, tc.expKind), "expected error kind to match")
+		})
+	}
+}
@@ -0,0 +1,213 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// defaultTierRatio is the fraction of the size of a pool allocated to each storage tier, as
+// for pools created by dmg.
+var defaultTierRatio = []float64{0.06, 0.94}
+
+type (
+	// PoolService manages the pools of a DAOS system.
+	PoolService struct {
+		c *Client
+	}
+
+	// Pool describes a DAOS pool. Usage and target information is only available for
+	// pools in the "Ready" state.
+	Pool struct {
+		UUID            string      `json:"uuid"`
+		Label           string      `json:"label,omitempty"`
+		State           string      `json:"state"`
+		ServiceLeader   uint32      `json:"svc_ldr"`
+		ServiceReplicas []uint32    `json:"svc_reps,omitempty"`
+		TotalTargets    uint32      `json:"total_targets"`
+		ActiveTargets   uint32      `json:"active_targets"`
+		DisabledTargets uint32      `json:"disabled_targets"`
+		RebuildState    string      `json:"rebuild_state,omitempty"`
+		Tiers           []*PoolTier `json:"tiers,omitempty"`
+	}
+
+	// PoolTier describes the space usage of a pool storage tier.
+	PoolTier struct {
+		MediaType  string `json:"media_type"`
+		TotalBytes uint64 `json:"total_bytes"`
+		FreeBytes  uint64 `json:"free_bytes"`
+	}
+
+	// PoolCreateRequest contains the parameters of a new pool.
+	PoolCreateRequest struct {
+		// Label is the unique label of the pool.
+		Label string
+		// TotalBytes is the total size of the pool across all of its ranks, divided
+		// between the storage tiers in the default ratio.
+		TotalBytes uint64
+		// NumRanks is the number of ranks to create the pool on. All ranks are used if
+		// neither NumRanks nor Ranks is set.
+		NumRanks uint32
+		// Ranks is the list of ranks to create the pool on.
+		Ranks []uint32
+		// NumServiceReplicas is the number of pool service replicas. The default number
+		// is used if not set.
+		NumServiceReplicas uint32
+		// User and Group are the owner of the pool, e.g. "user@". The owner is the
+		// effective user and group of the caller if not set.
+		User  string
+		Group string
+	}
+
+	// PoolDestroyOptions contains the options of a pool destroy request.
+	PoolDestroyOptions struct {
+		// Force destroys the pool even if it has open connections.
+		Force bool
+		// Recursive destroys the pool even if it contains containers.
+		Recursive bool
+	}
+)
+
+func poolFromInfo(pi *daos.PoolInfo) *Pool {
+	pool := &Pool{
+		UUID:            pi.UUID.String(),
+		Label:           pi.Label,
+		State:           pi.State.String(),
+		ServiceLeader:   pi.ServiceLeader,
+		ServiceReplicas: ranklist.RanksToUint32(pi.ServiceReplicas),
+		TotalTargets:    pi.TotalTargets,
+		ActiveTargets:   pi.ActiveTargets,
+		DisabledTargets: pi.DisabledTargets,
+	}
+	if pi.Rebuild != nil {
+		pool.RebuildState = pi.Rebuild.State.String()
+	}
+	for _, ts := range pi.TierStats {
+		pool.Tiers = append(pool.Tiers, &PoolTier{
+			MediaType:  ts.MediaType.String(),
+			TotalBytes: ts.Total,
+			FreeBytes:  ts.Free,
+		})
+	}
+
+	return pool
+}
+
+// List returns the pools of the system in label order.
+func (svc *PoolService) List(ctx context.Context) ([]*Pool, error) {
+	resp, err := control.ListPools(ctx, svc.c.invoker, &control.ListPoolsReq{})
+	if err != nil {
+		return nil, wrapErr("pool list", err)
+	}
+
+	pools := make([]*Pool, 0, len(resp.Pools))
+	for _, pi := range resp.Pools {
+		pools = append(pools, poolFromInfo(pi))
+	}
+
+	return pools, nil
+}
+
+// Get returns the pool with the given label or UUID.
+func (svc *PoolService) Get(ctx context.Context, id string) (*Pool, error) {
+	if id == "" {
+		return nil, wrapErr("pool query", errors.Wrap(ErrInvalidArgument, "empty pool ID"))
+	}
+
+	resp, err := control.PoolQuery(ctx, svc.c.invoker, &control.PoolQueryReq{
+		ID:        id,
+		QueryMask: daos.DefaultPoolQueryMask,
+	})
+	if err == nil && resp.Status != 0 {
+		err = daos.Status(resp.Status)
+	}
+	if err != nil {
+		return nil, wrapErr("pool query", err)
+	}
+
+	return poolFromInfo(&resp.PoolInfo), nil
+}
+
+// Create creates a pool and returns it once it is ready.
+func (svc *PoolService) Create(ctx context.Context, req *PoolCreateRequest) (*Pool, error) {
+	const op = "pool create"
+
+	if req == nil {
+		return nil, wrapErr(op, errors.Wrap(ErrInvalidArgument, "nil request"))
+	}
+	if req.Label == "" {
+		return nil, wrapErr(op, errors.Wrap(ErrInvalidArgument, "empty pool label"))
+	}
+	if req.TotalBytes == 0 {
+		return nil, wrapErr(op, errors.Wrap(ErrInvalidArgument, "pool size not set"))
+	}
+	if req.NumRanks > 0 && len(req.Ranks) > 0 {
+		return nil, wrapErr(op, errors.Wrap(ErrInvalidArgument,
+			"number of ranks and list of ranks may not both be set"))
+	}
+
+	labelProp, err := daos.PoolProperties().GetProperty("label")
+	if err != nil {
+		return nil, wrapErr(op, err)
+	}
+	if err := labelProp.SetValue(req.Label); err != nil {
+		return nil, wrapErr(op, errors.Wrap(ErrInvalidArgument, err.Error()))
+	}
+
+	createReq := &control.PoolCreateReq{
+		User:       req.User,
+		UserGroup:  req.Group,
+		NumSvcReps: req.NumServiceReplicas,
+		Properties: []*daos.PoolProperty{labelProp},
+		TotalBytes: req.TotalBytes,
+		TierRatio:  defaultTierRatio,
+		NumRanks:   req.NumRanks,
+		Ranks:      ranklist.RanksFromUint32(req.Ranks),
+	}
+
+	resp, err := control.PoolCreate(ctx, svc.c.invoker, createReq)
+	if err != nil {
+		return nil, wrapErr(op, err)
+	}
+
+	poolUUID, err := uuid.Parse(resp.UUID)
+	if err != nil {
+		return nil, wrapErr(op, errors.Wrapf(err, "invalid pool UUID %q", resp.UUID))
+	}
+	pool := poolFromInfo(&daos.PoolInfo{
+		UUID:            poolUUID,
+		Label:           req.Label,
+		State:           daos.PoolServiceStateReady,
+		ServiceLeader:   resp.Leader,
+		ServiceReplicas: ranklist.RanksFromUint32(resp.SvcReps),
+	})
+
+	return pool, nil
+}
+
+// Destroy destroys the pool with the given label or UUID.
+func (svc *PoolService) Destroy(ctx context.Context, id string, opts *PoolDestroyOptions) error {
+	if id == "" {
+		return wrapErr("pool destroy", errors.Wrap(ErrInvalidArgument, "empty pool ID"))
+	}
+	if opts == nil {
+		opts = &PoolDestroyOptions{}
+	}
+
+	return wrapErr("pool destroy", control.PoolDestroy(ctx, svc.c.invoker, &control.PoolDestroyReq{
+		ID:        id,
+		Force:     opts.Force,
+		Recursive: opts.Recursive,
+	}))
+}
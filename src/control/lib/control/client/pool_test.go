@@ -0,0 +1,308 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func mockClient(t *testing.T, log logging.Logger, mic *control.MockInvokerConfig) *Client {
+	t.Helper()
+
+	c, err := New(withInvoker(control.NewMockInvoker(log, mic)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestClient_PoolService_List(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic      *control.MockInvokerConfig
+		expPools []*Pool
+		expErr   error
+	}{
+		"management service unavailable": {
+			mic: &control.MockInvokerConfig{
+				UnaryError: control.FaultConnectionRefused("host1"),
+			},
+			expErr: ErrUnavailable,
+		},
+		"no pools": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{}),
+			},
+			expPools: []*Pool{},
+		},
+		"one pool": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponseSet: []*control.UnaryResponse{
+					control.MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+						Pools: []*mgmtpb.ListPoolsResp_Pool{
+							{
+								Uuid:    test.MockUUID(1),
+								Label:   "pool1",
+								SvcReps: []uint32{0, 1, 2},
+								State:   daos.PoolServiceStateReady.String(),
+							},
+						},
+					}),
+					control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+						Uuid:          test.MockUUID(1),
+						Label:         "pool1",
+						State:         mgmtpb.PoolServiceState_Ready,
+						SvcReps:       []uint32{0, 1, 2},
+						TotalTargets:  16,
+						ActiveTargets: 16,
+						Rebuild: &mgmtpb.PoolRebuildStatus{
+							State: mgmtpb.PoolRebuildStatus_IDLE,
+						},
+						TierStats: []*mgmtpb.StorageUsageStats{
+							{Total: 1000, Free: 500, MediaType: mgmtpb.StorageMediaType_SCM},
+							{Total: 9000, Free: 4000, MediaType: mgmtpb.StorageMediaType_NVME},
+						},
+					}),
+				},
+			},
+			expPools: []*Pool{
+				{
+					UUID:            test.MockUUID(1),
+					Label:           "pool1",
+					State:           "Ready",
+					ServiceReplicas: []uint32{0, 1, 2},
+					TotalTargets:    16,
+					ActiveTargets:   16,
+					RebuildState:    "idle",
+					Tiers: []*PoolTier{
+						{MediaType: "scm", TotalBytes: 1000, FreeBytes: 500},
+						{MediaType: "nvme", TotalBytes: 9000, FreeBytes: 4000},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotPools, gotErr := c.Pools.List(test.Context(t))
+			if tc.expErr != nil {
+				test.AssertTrue(t, errors.Is(gotErr, tc.expErr), "unexpected error: "+gotErr.Error())
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			if diff := cmp.Diff(tc.expPools, gotPools); diff != "" {
+				t.Fatalf("unexpected pools (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClient_PoolService_Get(t *testing.T) {
+	for name, tc := range map[string]struct {
+		id      string
+		mic     *control.MockInvokerConfig
+		expPool *Pool
+		expErr  error
+	}{
+		"empty ID": {
+			expErr: ErrInvalidArgument,
+		},
+		"pool not found": {
+			id: "pool1",
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", daos.Nonexistent, nil),
+			},
+			expErr: ErrNotFound,
+		},
+		"query status": {
+			id: "pool1",
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Status: int32(daos.NoPermission),
+				}),
+			},
+			expErr: ErrPermissionDenied,
+		},
+		"success": {
+			id: "pool1",
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid:          test.MockUUID(1),
+					Label:         "pool1",
+					State:         mgmtpb.PoolServiceState_Ready,
+					TotalTargets:  8,
+					ActiveTargets: 8,
+				}),
+			},
+			expPool: &Pool{
+				UUID:          test.MockUUID(1),
+				Label:         "pool1",
+				State:         "Ready",
+				TotalTargets:  8,
+				ActiveTargets: 8,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotPool, gotErr := c.Pools.Get(test.Context(t), tc.id)
+			if tc.expErr != nil {
+				test.AssertTrue(t, errors.Is(gotErr, tc.expErr), "unexpected error")
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			if diff := cmp.Diff(tc.expPool, gotPool); diff != "" {
+				t.Fatalf("unexpected pool (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClient_PoolService_Create(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolCreateRequest
+		mic     *control.MockInvokerConfig
+		expPool *Pool
+		expErr  error
+	}{
+		"nil request": {
+			expErr: ErrInvalidArgument,
+		},
+		"no label": {
+			req:    &PoolCreateRequest{TotalBytes: 1 << 30},
+			expErr: ErrInvalidArgument,
+		},
+		"invalid label": {
+			req:    &PoolCreateRequest{Label: "bad label!", TotalBytes: 1 << 30},
+			expErr: ErrInvalidArgument,
+		},
+		"no size": {
+			req:    &PoolCreateRequest{Label: "pool1"},
+			expErr: ErrInvalidArgument,
+		},
+		"number and list of ranks": {
+			req: &PoolCreateRequest{
+				Label:      "pool1",
+				TotalBytes: 1 << 30,
+				NumRanks:   2,
+				Ranks:      []uint32{0, 1},
+			},
+			expErr: ErrInvalidArgument,
+		},
+		"duplicate label": {
+			req: &PoolCreateRequest{Label: "pool1", TotalBytes: 1 << 30},
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", daos.Exists, nil),
+			},
+			expErr: ErrAlreadyExists,
+		},
+		"success": {
+			req: &PoolCreateRequest{Label: "pool1", TotalBytes: 1 << 30},
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolCreateResp{
+					SvcLdr:   1,
+					SvcReps:  []uint32{0, 1, 2},
+					TgtRanks: []uint32{0, 1, 2},
+				}),
+			},
+			expPool: &Pool{
+				Label:           "pool1",
+				State:           "Ready",
+				ServiceLeader:   1,
+				ServiceReplicas: []uint32{0, 1, 2},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotPool, gotErr := c.Pools.Create(test.Context(t), tc.req)
+			if tc.expErr != nil {
+				test.AssertTrue(t, errors.Is(gotErr, tc.expErr), "unexpected error")
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			// The pool UUID is generated by the client for each request.
+			if _, err := uuid.Parse(gotPool.UUID); err != nil {
+				t.Fatalf("invalid pool UUID %q: %s", gotPool.UUID, err)
+			}
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(Pool{}, "UUID"),
+			}
+			if diff := cmp.Diff(tc.expPool, gotPool, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected pool (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClient_PoolService_Destroy(t *testing.T) {
+	for name, tc := range map[string]struct {
+		id     string
+		mic    *control.MockInvokerConfig
+		expErr error
+	}{
+		"empty ID": {
+			expErr: ErrInvalidArgument,
+		},
+		"pool busy": {
+			id: "pool1",
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", daos.Busy, nil),
+			},
+			expErr: ErrBusy,
+		},
+		"success": {
+			id: "pool1",
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.PoolDestroyResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotErr := c.Pools.Destroy(test.Context(t), tc.id, nil)
+			if tc.expErr != nil {
+				test.AssertTrue(t, errors.Is(gotErr, tc.expErr), "unexpected error")
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+		})
+	}
+}
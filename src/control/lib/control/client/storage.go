@@ -0,0 +1,93 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"context"
+	"sort"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+type (
+	// StorageService reports the storage of the servers of a DAOS system.
+	StorageService struct {
+		c *Client
+	}
+
+	// HostStorage describes the SCM and NVMe storage of a server. Free space is only
+	// reported if usage was requested.
+	HostStorage struct {
+		Host           string `json:"host"`
+		ScmNamespaces  int    `json:"scm_namespaces"`
+		ScmTotalBytes  uint64 `json:"scm_total_bytes"`
+		ScmFreeBytes   uint64 `json:"scm_free_bytes"`
+		NvmeDevices    int    `json:"nvme_devices"`
+		NvmeTotalBytes uint64 `json:"nvme_total_bytes"`
+		NvmeFreeBytes  uint64 `json:"nvme_free_bytes"`
+		RebootRequired bool   `json:"reboot_required"`
+	}
+
+	// StorageScanOptions contains the options of a storage scan request.
+	StorageScanOptions struct {
+		// Hosts is the list of servers to scan. The servers in the client configuration
+		// are scanned if not set.
+		Hosts []string
+		// Usage requests the space used by DAOS on each device, which is only available
+		// from servers with running engines.
+		Usage bool
+	}
+)
+
+func hostStorage(host string, hs *control.HostStorage, usage bool) *HostStorage {
+	out := &HostStorage{
+		Host:           host,
+		ScmNamespaces:  len(hs.ScmNamespaces),
+		NvmeDevices:    len(hs.NvmeDevices),
+		RebootRequired: hs.RebootRequired,
+	}
+	if !usage {
+		out.ScmTotalBytes = hs.ScmNamespaces.Capacity()
+		out.NvmeTotalBytes = hs.NvmeDevices.Capacity()
+		return out
+	}
+	out.ScmTotalBytes = hs.ScmNamespaces.Total()
+	out.ScmFreeBytes = hs.ScmNamespaces.Free()
+	out.NvmeTotalBytes = hs.NvmeDevices.Total()
+	out.NvmeFreeBytes = hs.NvmeDevices.Free()
+
+	return out
+}
+
+// Scan returns the storage of each server, in host order. The storage of the servers that
+// responded is returned even if some of them failed, in which case an error is also
+// returned.
+func (svc *StorageService) Scan(ctx context.Context, opts *StorageScanOptions) ([]*HostStorage, error) {
+	if opts == nil {
+		opts = &StorageScanOptions{}
+	}
+
+	req := &control.StorageScanReq{
+		Usage: opts.Usage,
+	}
+	req.SetHostList(opts.Hosts)
+
+	resp, err := control.StorageScan(ctx, svc.c.invoker, req)
+	if err != nil {
+		return nil, wrapErr("storage scan", err)
+	}
+
+	var hosts []*HostStorage
+	for _, hss := range resp.HostStorage {
+		for _, host := range hss.HostSet.Slice() {
+			hosts = append(hosts, hostStorage(host, hss.HostStorage, opts.Usage))
+		}
+	}
+	sort.Slice(hosts, func(i, j int) bool { return hosts[i].Host < hosts[j].Host })
+
+	return hosts, wrapErr("storage scan", resp.Errors())
+}
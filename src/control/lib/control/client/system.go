@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemService manages the engines that are members of a DAOS system.
+	SystemService struct {
+		c *Client
+	}
+
+	// Member describes an engine that is a member of the system.
+	Member struct {
+		Rank        uint32    `json:"rank"`
+		UUID        string    `json:"uuid"`
+		Addr        string    `json:"addr"`
+		FabricURI   string    `json:"fabric_uri"`
+		State       string    `json:"state"`
+		Info        string    `json:"info,omitempty"`
+		FaultDomain string    `json:"fault_domain"`
+		LastUpdate  time.Time `json:"last_update"`
+	}
+
+	// MemberResult describes the outcome of an action on a member of the system.
+	MemberResult struct {
+		Rank    uint32 `json:"rank"`
+		Addr    string `json:"addr"`
+		Action  string `json:"action"`
+		Errored bool   `json:"errored"`
+		Msg     string `json:"msg,omitempty"`
+		State   string `json:"state"`
+	}
+
+	// SystemStartOptions contains the options of a system start request.
+	SystemStartOptions struct {
+		// Ranks is the list of ranks to start. All ranks are started if not set.
+		Ranks []uint32
+	}
+
+	// SystemStopOptions contains the options of a system stop request.
+	SystemStopOptions struct {
+		// Ranks is the list of ranks to stop. All ranks are stopped if not set.
+		Ranks []uint32
+		// Force stops the ranks without first preparing them for shutdown.
+		Force bool
+	}
+)
+
+func memberFromSystem(sm *system.Member) *Member {
+	m := &Member{
+		Rank:        uint32(sm.Rank),
+		UUID:        sm.UUID.String(),
+		FabricURI:   sm.PrimaryFabricURI,
+		State:       sm.State.String(),
+		Info:        sm.Info,
+		FaultDomain: sm.FaultDomain.String(),
+		LastUpdate:  sm.LastUpdate,
+	}
+	if sm.Addr != nil {
+		m.Addr = sm.Addr.String()
+	}
+
+	return m
+}
+
+func memberResults(in system.MemberResults) []*MemberResult {
+	out := make([]*MemberResult, 0, len(in))
+	for _, mr := range in {
+		out = append(out, &MemberResult{
+			Rank:    uint32(mr.Rank),
+			Addr:    mr.Addr,
+			Action:  mr.Action,
+			Errored: mr.Errored,
+			Msg:     mr.Msg,
+			State:   mr.State.String(),
+		})
+	}
+
+	return out
+}
+
+// Members returns the engines that are members of the system, in rank order.
+func (svc *SystemService) Members(ctx context.Context) ([]*Member, error) {
+	resp, err := control.SystemQuery(ctx, svc.c.invoker, &control.SystemQueryReq{})
+	if err != nil {
+		return nil, wrapErr("system query", err)
+	}
+
+	members := make([]*Member, 0, len(resp.Members))
+	for _, sm := range resp.Members {
+		members = append(members, memberFromSystem(sm))
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Rank < members[j].Rank })
+
+	return members, nil
+}
+
+// Start starts the engines of the system. The result of each start attempt is returned
+// even if some of them failed, in which case an error is also returned.
+func (svc *SystemService) Start(ctx context.Context, opts *SystemStartOptions) ([]*MemberResult, error) {
+	if opts == nil {
+		opts = &SystemStartOptions{}
+	}
+
+	req := new(control.SystemStartReq)
+	req.Ranks.Replace(ranklist.RankSetFromRanks(ranklist.RanksFromUint32(opts.Ranks)))
+
+	resp, err := control.SystemStart(ctx, svc.c.invoker, req)
+	if err != nil {
+		return nil, wrapErr("system start", err)
+	}
+
+	return memberResults(resp.Results), wrapErr("system start", resp.Errors())
+}
+
+// Stop stops the engines of the system. The result of each stop attempt is returned even
+// if some of them failed, in which case an error is also returned.
+func (svc *SystemService) Stop(ctx context.Context, opts *SystemStopOptions) ([]*MemberResult, error) {
+	if opts == nil {
+		opts = &SystemStopOptions{}
+	}
+
+	req := &control.SystemStopReq{
+		Force: opts.Force,
+	}
+	req.Ranks.Replace(ranklist.RankSetFromRanks(ranklist.RanksFromUint32(opts.Ranks)))
+
+	resp, err := control.SystemStop(ctx, svc.c.invoker, req)
+	if err != nil {
+		return nil, wrapErr("system stop", err)
+	}
+
+	return memberResults(resp.Results), wrapErr("system stop", resp.Errors())
+}
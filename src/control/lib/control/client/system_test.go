@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package client
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestClient_SystemService_Members(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic        *control.MockInvokerConfig
+		expMembers []*Member
+		expErr     error
+	}{
+		"management service unavailable": {
+			mic: &control.MockInvokerConfig{
+				UnaryError: control.FaultConnectionRefused("host1"),
+			},
+			expErr: ErrUnavailable,
+		},
+		"members in rank order": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{
+					Members: []*mgmtpb.SystemMember{
+						{
+							Rank:        1,
+							Uuid:        test.MockUUID(1),
+							State:       system.MemberStateJoined.String(),
+							Addr:        "10.0.0.2:10001",
+							FaultDomain: "/host2",
+						},
+						{
+							Rank:        0,
+							Uuid:        test.MockUUID(0),
+							State:       system.MemberStateStopped.String(),
+							Addr:        "10.0.0.1:10001",
+							FaultDomain: "/host1",
+						},
+					},
+				}),
+			},
+			expMembers: []*Member{
+				{
+					Rank:        0,
+					UUID:        test.MockUUID(0),
+					Addr:        "10.0.0.1:10001",
+					State:       "Stopped",
+					FaultDomain: "/host1",
+				},
+				{
+					Rank:        1,
+					UUID:        test.MockUUID(1),
+					Addr:        "10.0.0.2:10001",
+					State:       "Joined",
+					FaultDomain: "/host2",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotMembers, gotErr := c.System.Members(test.Context(t))
+			if tc.expErr != nil {
+				test.AssertTrue(t, errors.Is(gotErr, tc.expErr), "unexpected error")
+				return
+			}
+			if gotErr != nil {
+				t.Fatal(gotErr)
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(Member{}, "LastUpdate"),
+			}
+			if diff := cmp.Diff(tc.expMembers, gotMembers, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected members (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestClient_SystemService_Stop(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic        *control.MockInvokerConfig
+		expResults []*MemberResult
+		expErr     error
+	}{
+		"all ranks stopped": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{
+					Results: []*sharedpb.RankResult{
+						{Rank: 0, Action: "stop", State: system.MemberStateStopped.String()},
+						{Rank: 1, Action: "stop", State: system.MemberStateStopped.String()},
+					},
+				}),
+			},
+			expResults: []*MemberResult{
+				{Rank: 0, Action: "stop", State: "Stopped"},
+				{Rank: 1, Action: "stop", State: "Stopped"},
+			},
+		},
+		"rank failed to stop": {
+			mic: &control.MockInvokerConfig{
+				UnaryResponse: control.MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{
+					Results: []*sharedpb.RankResult{
+						{Rank: 0, Action: "stop", State: system.MemberStateStopped.String()},
+						{
+							Rank: 1, Action: "stop", Errored: true, Msg: "timed out",
+							State: system.MemberStateErrored.String(),
+						},
+					},
+				}),
+			},
+			expResults: []*MemberResult{
+				{Rank: 0, Action: "stop", State: "Stopped"},
+				{Rank: 1, Action: "stop", Errored: true, Msg: "timed out", State: "Errored"},
+			},
+			expErr: errors.New("system stop: failed rank 1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			c := mockClient(t, log, tc.mic)
+			gotResults, gotErr := c.System.Stop(test.Context(t), &SystemStopOptions{
+				Ranks: []uint32{0, 1},
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expResults, gotResults); diff != "" {
+				t.Fatalf("unexpected results (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
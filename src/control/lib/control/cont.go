@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,12 +9,16 @@ package control
 
 import (
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 )
 
 // ContSetOwnerReq contains the parameters for the set owner request
@@ -62,3 +67,110 @@ func ContSetOwner(ctx context.Context, rpcClient UnaryInvoker, req *ContSetOwner
 
 	return errors.Wrap(ur.getMSError(), "container set-owner failed")
 }
+
+type (
+	// ContListReq contains the parameters for a container list request.
+	ContListReq struct {
+		msRequest
+		unaryRequest
+		PoolID string // UUID or label of the pool to list containers in
+	}
+
+	// ContainerInfo describes a container as reported by the management service.
+	ContainerInfo struct {
+		UUID         string `json:"uuid"`
+		Label        string `json:"label"`
+		OwnerUser    string `json:"owner_user"`
+		OwnerGroup   string `json:"owner_group"`
+		NumSnapshots uint32 `json:"num_snapshots"`
+	}
+
+	// ContListResp contains the containers in a pool sorted by label and UUID.
+	ContListResp struct {
+		Containers []*ContainerInfo `json:"containers"`
+	}
+)
+
+// ContList fetches the containers in a pool through the management service, so no client
+// library or pool connect permission is needed.
+func ContList(ctx context.Context, rpcClient UnaryInvoker, req *ContListReq) (*ContListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.PoolID == "" {
+		return nil, errors.New("no pool label or UUID specified")
+	}
+
+	pbReq := &mgmtpb.ListContReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.PoolID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).ListContainers(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("List DAOS containers request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.ListContResp)
+	if !ok {
+		return nil, errors.New("unable to extract ListContResp from MS response")
+	}
+	if pbResp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(pbResp.Status), "container list failed")
+	}
+
+	resp := new(ContListResp)
+	if err := convert.Types(pbResp, resp); err != nil {
+		return nil, errors.Wrap(err, "container list failed")
+	}
+	sort.Slice(resp.Containers, func(i, j int) bool {
+		ci, cj := resp.Containers[i], resp.Containers[j]
+		if ci.Label != cj.Label {
+			return ci.Label < cj.Label
+		}
+		return ci.UUID < cj.UUID
+	})
+
+	return resp, nil
+}
+
+// ContQueryReq contains the parameters for a container query request.
+type ContQueryReq struct {
+	msRequest
+	unaryRequest
+	PoolID string // UUID or label of the pool for the container
+	ContID string // UUID or label of the container
+}
+
+// ContQuery fetches the details of a single container through the management service.
+func ContQuery(ctx context.Context, rpcClient UnaryInvoker, req *ContQueryReq) (*ContainerInfo, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.ContID == "" {
+		return nil, errors.New("no container label or UUID specified")
+	}
+
+	listReq := &ContListReq{PoolID: req.PoolID}
+	listReq.SetSystem(req.Sys)
+	resp, err := ContList(ctx, rpcClient, listReq)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cont := range resp.Containers {
+		if cont.UUID == req.ContID || cont.Label == req.ContID {
+			return cont, nil
+		}
+	}
+
+	return nil, errors.Wrapf(daos.Nonexistent, "container %s in pool %s", req.ContID, req.PoolID)
+}
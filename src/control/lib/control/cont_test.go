@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,11 +10,13 @@ package control
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -132,3 +135,161 @@ func TestControl_ContSetOwner(t *testing.T) {
 		})
 	}
 }
+
+func mockListContResp() *mgmtpb.ListContResp {
+	return &mgmtpb.ListContResp{
+		Containers: []*mgmtpb.ListContResp_Cont{
+			{
+				Uuid:         test.MockUUID(2),
+				Label:        "scratch",
+				OwnerUser:    "bob@",
+				OwnerGroup:   "builders@",
+				NumSnapshots: 2,
+			},
+			{
+				Uuid:       test.MockUUID(1),
+				Label:      "results",
+				OwnerUser:  "alice@",
+				OwnerGroup: "builders@",
+			},
+		},
+	}
+}
+
+func TestControl_ContList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *ContListReq
+		expResp *ContListResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.ContListReq"),
+		},
+		"no pool ID": {
+			req:    &ContListReq{},
+			expErr: errors.New("pool label or UUID"),
+		},
+		"remote failure": {
+			req: &ContListReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"DAOS failure": {
+			req: &ContListReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListContResp{
+					Status: int32(daos.NoPermission),
+				}),
+			},
+			expErr: daos.NoPermission,
+		},
+		"no containers": {
+			req: &ContListReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.ListContResp{}),
+			},
+			expResp: &ContListResp{},
+		},
+		"success": {
+			req: &ContListReq{PoolID: "pool1"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, mockListContResp()),
+			},
+			expResp: &ContListResp{
+				Containers: []*ContainerInfo{
+					{
+						UUID:       test.MockUUID(1),
+						Label:      "results",
+						OwnerUser:  "alice@",
+						OwnerGroup: "builders@",
+					},
+					{
+						UUID:         test.MockUUID(2),
+						Label:        "scratch",
+						OwnerUser:    "bob@",
+						OwnerGroup:   "builders@",
+						NumSnapshots: 2,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotResp, gotErr := ContList(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ContQuery(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *ContQueryReq
+		expResp *ContainerInfo
+		expErr  error
+	}{
+		"no container ID": {
+			req:    &ContQueryReq{PoolID: "pool1"},
+			expErr: errors.New("container label or UUID"),
+		},
+		"unknown container": {
+			req:    &ContQueryReq{PoolID: "pool1", ContID: "missing"},
+			expErr: daos.Nonexistent,
+		},
+		"by label": {
+			req: &ContQueryReq{PoolID: "pool1", ContID: "results"},
+			expResp: &ContainerInfo{
+				UUID:       test.MockUUID(1),
+				Label:      "results",
+				OwnerUser:  "alice@",
+				OwnerGroup: "builders@",
+			},
+		},
+		"by UUID": {
+			req: &ContQueryReq{PoolID: "pool1", ContID: test.MockUUID(2)},
+			expResp: &ContainerInfo{
+				UUID:         test.MockUUID(2),
+				Label:        "scratch",
+				OwnerUser:    "bob@",
+				OwnerGroup:   "builders@",
+				NumSnapshots: 2,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, mockListContResp()),
+			})
+
+			gotResp, gotErr := ContQuery(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
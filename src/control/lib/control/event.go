@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +11,7 @@ import (
 	"context"
 	"log"
 	"log/syslog"
+	"sync"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -72,16 +74,34 @@ func eventNotify(ctx context.Context, rpcClient UnaryInvoker, seq uint64, evt *e
 type EventForwarder struct {
 	seq        <-chan uint64
 	client     UnaryInvoker
+	mu         sync.RWMutex
 	msReplicas []string
 }
 
+// SetReplicas updates the MS replicas that events are forwarded to.
+func (ef *EventForwarder) SetReplicas(replicas []string) {
+	ef.mu.Lock()
+	defer ef.mu.Unlock()
+
+	ef.msReplicas = replicas
+}
+
+func (ef *EventForwarder) replicas() []string {
+	ef.mu.RLock()
+	defer ef.mu.RUnlock()
+
+	return ef.msReplicas
+}
+
 // OnEvent implements the events.Handler interface.
 func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
+	msReplicas := ef.replicas()
+
 	switch {
 	case evt == nil:
 		ef.client.Debug("skip event forwarding, nil event")
 		return
-	case len(ef.msReplicas) == 0:
+	case len(msReplicas) == 0:
 		ef.client.Debug("skip event forwarding, missing MS replicas")
 		return
 	case !evt.ShouldForward():
@@ -89,7 +109,7 @@ func (ef *EventForwarder) OnEvent(ctx context.Context, evt *events.RASEvent) {
 		return
 	}
 
-	if err := eventNotify(ctx, ef.client, <-ef.seq, evt, ef.msReplicas); err != nil {
+	if err := eventNotify(ctx, ef.client, <-ef.seq, evt, msReplicas); err != nil {
 		ef.client.Debugf("failed to forward event to MS: %s", err)
 	}
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,7 +11,10 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 )
@@ -132,6 +136,41 @@ func FaultRpcTimeout(req deadliner) *fault.Fault {
 	)
 }
 
+// FaultMethodUnsupported indicates that the server does not implement a control API method,
+// e.g. because it is running an older version than the client.
+func FaultMethodUnsupported(srvAddr, method string) *fault.Fault {
+	desc := fmt.Sprintf("the server at %s does not support the %s request", srvAddr, method)
+	if feature, ok := build.MethodFeature(method); ok {
+		desc = fmt.Sprintf("the server at %s does not support the %s feature required by the %s request",
+			srvAddr, feature, method)
+	}
+	return clientFault(
+		code.ClientMethodUnsupported,
+		desc,
+		"upgrade the server or run 'dmg server capabilities' to see the features supported by each server",
+	)
+}
+
+// FaultIncompatibleAPIVersion indicates that the control API version of the server is older
+// than the oldest version supported by the client.
+func FaultIncompatibleAPIVersion(srvAddr string, version uint32) *fault.Fault {
+	return clientFault(
+		code.ClientIncompatibleAPIVersion,
+		fmt.Sprintf("the server at %s implements control API version %d which is not supported (minimum %d)",
+			srvAddr, version, build.MinControlAPIVersion),
+		"upgrade the server to a version that is compatible with the client",
+	)
+}
+
+// IsMethodUnsupported indicates whether the error is the result of a request that the server
+// does not implement.
+func IsMethodUnsupported(err error) bool {
+	if f, ok := errors.Cause(err).(*fault.Fault); ok {
+		return f.Code == code.ClientMethodUnsupported
+	}
+	return status.Code(errors.Cause(err)) == codes.Unimplemented
+}
+
 func clientFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "client",
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -208,7 +209,10 @@ type (
 		Devices      []string // Specific devices to update
 		ModelID      string   // Update only devices of specific model
 		FirmwareRev  string   // Update only devices with a specific current firmware
-
+		Slot         uint32   // NVMe firmware slot to update, 0 for the device to choose
+		Stage        bool     // Activate NVMe firmware on next controller reset
+		ScmActivate  bool     // Verify and activate SCM firmware once staged on all hosts
+		RollbackPath string   // SCM firmware to re-stage if the update fails on any host
 	}
 
 	// HostSCMUpdateMap maps a host name to a slice of SCM update results.
@@ -236,6 +240,8 @@ type (
 		HostErrorsResp
 		HostSCMResult  HostSCMUpdateMap
 		HostNVMeResult HostNVMeUpdateMap
+		ScmPhase       storage.ScmFirmwarePhase // last SCM update phase attempted
+		ScmRolledBack  bool                     // rollback firmware was re-staged
 	}
 )
 
@@ -343,27 +349,67 @@ func (m HostNVMeUpdateMap) Keys() []string {
 	return keys
 }
 
-// FirmwareUpdate concurrently updates device firmware for a given device type
-// for all hosts supplied in the request's hostlist, or all configured hosts
-// if not explicitly specified. The function blocks until all results
-// (successful or otherwise) are received, and returns a single response
-// structure containing results for all host firmware update operations.
-func FirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUpdateReq) (*FirmwareUpdateResp, error) {
-	if req.FirmwarePath == "" {
-		return nil, errors.New("firmware file path missing")
+// ScmUpdateFailed returns true if any host or SCM module reported an error.
+func (ur *FirmwareUpdateResp) ScmUpdateFailed() bool {
+	if len(ur.HostErrors) > 0 {
+		return true
 	}
-	pbType, err := req.Type.toCtlPBType()
-	if err != nil {
-		return nil, err
+
+	for _, results := range ur.HostSCMResult {
+		for _, res := range results {
+			if res.Error != nil {
+				return true
+			}
+		}
 	}
+
+	return false
+}
+
+// mergeScmResults folds host and SCM module errors from a subsequent update phase into the
+// response so that each module reports the first error it encountered.
+func (ur *FirmwareUpdateResp) mergeScmResults(label string, other *FirmwareUpdateResp) error {
+	for _, hes := range other.HostErrors {
+		for _, addr := range hes.HostSet.Slice() {
+			if err := ur.addHostError(addr, errors.Wrap(hes.HostError, label)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for addr, results := range other.HostSCMResult {
+		if ur.HostSCMResult == nil {
+			ur.HostSCMResult = make(HostSCMUpdateMap)
+		}
+
+	nextResult:
+		for _, res := range results {
+			var resErr error
+			if res.Error != nil {
+				resErr = errors.Wrap(res.Error, label)
+			}
+
+			for _, cur := range ur.HostSCMResult[addr] {
+				if cur.Module.UID == res.Module.UID {
+					if cur.Error == nil {
+						cur.Error = resErr
+					}
+					continue nextResult
+				}
+			}
+			ur.HostSCMResult[addr] = append(ur.HostSCMResult[addr], &SCMUpdateResult{
+				Module: res.Module,
+				Error:  resErr,
+			})
+		}
+	}
+
+	return nil
+}
+
+func invokeFirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUpdateReq, pbReq *ctlpb.FirmwareUpdateReq) (*FirmwareUpdateResp, error) {
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
-		return ctlpb.NewCtlSvcClient(conn).FirmwareUpdate(ctx, &ctlpb.FirmwareUpdateReq{
-			FirmwarePath: req.FirmwarePath,
-			Type:         pbType,
-			DeviceIDs:    req.Devices,
-			ModelID:      req.ModelID,
-			FirmwareRev:  req.FirmwareRev,
-		})
+		return ctlpb.NewCtlSvcClient(conn).FirmwareUpdate(ctx, pbReq)
 	})
 
 	unaryResp, err := rpcClient.InvokeUnaryRPC(ctx, req)
@@ -387,3 +433,100 @@ func FirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUp
 
 	return resp, nil
 }
+
+// scmFirmwareStagedUpdate stages SCM firmware on all hosts in the request's hostlist and, only
+// once every module has staged successfully, verifies and then activates it. If any phase fails
+// and a rollback image has been supplied, the rollback image is re-staged on all hosts so that
+// the new firmware is not activated on next reboot.
+func scmFirmwareStagedUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUpdateReq) (*FirmwareUpdateResp, error) {
+	newPBReq := func(path string, phase storage.ScmFirmwarePhase) *ctlpb.FirmwareUpdateReq {
+		return &ctlpb.FirmwareUpdateReq{
+			FirmwarePath: path,
+			Type:         ctlpb.FirmwareUpdateReq_SCM,
+			DeviceIDs:    req.Devices,
+			ModelID:      req.ModelID,
+			FirmwareRev:  req.FirmwareRev,
+			ScmPhase:     ctlpb.FirmwareUpdateReq_ScmPhase(phase),
+		}
+	}
+
+	resp, err := invokeFirmwareUpdate(ctx, rpcClient, req,
+		newPBReq(req.FirmwarePath, storage.ScmFirmwarePhaseStage))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ScmActivate {
+		for _, phase := range []storage.ScmFirmwarePhase{
+			storage.ScmFirmwarePhaseVerify, storage.ScmFirmwarePhaseActivate,
+		} {
+			if resp.ScmUpdateFailed() {
+				break
+			}
+
+			phaseResp, err := invokeFirmwareUpdate(ctx, rpcClient, req,
+				newPBReq(req.FirmwarePath, phase))
+			if err != nil {
+				return nil, err
+			}
+			resp.ScmPhase = phase
+			if err := resp.mergeScmResults(phase.String(), phaseResp); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !resp.ScmUpdateFailed() || req.RollbackPath == "" {
+		return resp, nil
+	}
+
+	rbResp, err := invokeFirmwareUpdate(ctx, rpcClient, req,
+		newPBReq(req.RollbackPath, storage.ScmFirmwarePhaseStage))
+	if err != nil {
+		return nil, errors.Wrap(err, "rollback")
+	}
+	resp.ScmRolledBack = !rbResp.ScmUpdateFailed()
+	if err := resp.mergeScmResults("rollback", rbResp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FirmwareUpdate concurrently updates device firmware for a given device type
+// for all hosts supplied in the request's hostlist, or all configured hosts
+// if not explicitly specified. The function blocks until all results
+// (successful or otherwise) are received, and returns a single response
+// structure containing results for all host firmware update operations.
+//
+// For SCM, requesting activation or rollback performs a staged update across all hosts, see
+// scmFirmwareStagedUpdate.
+func FirmwareUpdate(ctx context.Context, rpcClient UnaryInvoker, req *FirmwareUpdateReq) (*FirmwareUpdateResp, error) {
+	if req.FirmwarePath == "" {
+		return nil, errors.New("firmware file path missing")
+	}
+	pbType, err := req.Type.toCtlPBType()
+	if err != nil {
+		return nil, err
+	}
+	if req.Type != DeviceTypeNVMe && (req.Slot != 0 || req.Stage) {
+		return nil, errors.New("firmware slot and stage options only apply to NVMe devices")
+	}
+	if req.Type != DeviceTypeSCM && (req.ScmActivate || req.RollbackPath != "") {
+		return nil, errors.New("firmware activate and rollback options only apply to SCM devices")
+	}
+
+	if req.Type == DeviceTypeSCM && (req.ScmActivate || req.RollbackPath != "") {
+		return scmFirmwareStagedUpdate(ctx, rpcClient, req)
+	}
+
+	return invokeFirmwareUpdate(ctx, rpcClient, req, &ctlpb.FirmwareUpdateReq{
+		FirmwarePath: req.FirmwarePath,
+		Type:         pbType,
+		DeviceIDs:    req.Devices,
+		ModelID:      req.ModelID,
+		FirmwareRev:  req.FirmwareRev,
+		Slot:         req.Slot,
+		Stage:        req.Stage,
+	})
+}
@@ -317,6 +317,10 @@ func TestControl_FirmwareUpdate(t *testing.T) {
 	pbSCMResults, expSCMResults := getTestSCMUpdateResults(t)
 	pbNVMeResults, expNVMeResults := getTestNVMeUpdateResults(t)
 
+	pbSCMGood, expSCMGood := getTestSCMUpdateResults(t)
+	pbSCMGood[1].Error = ""
+	expSCMGood[1].Error = nil
+
 	for name, tc := range map[string]struct {
 		mic     *MockInvokerConfig
 		req     *FirmwareUpdateReq
@@ -336,6 +340,14 @@ func TestControl_FirmwareUpdate(t *testing.T) {
 			},
 			expErr: errors.New("firmware file path missing"),
 		},
+		"slot set for SCM": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				Slot:         2,
+			},
+			expErr: errors.New("only apply to NVMe"),
+		},
 		"local failure": {
 			req: &FirmwareUpdateReq{
 				Type:         DeviceTypeSCM,
@@ -381,6 +393,126 @@ func TestControl_FirmwareUpdate(t *testing.T) {
 				},
 			},
 		},
+		"activate set for NVMe": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeNVMe,
+				FirmwarePath: "/my/path",
+				ScmActivate:  true,
+			},
+			expErr: errors.New("only apply to SCM"),
+		},
+		"SCM staged update; activated": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				ScmActivate:  true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+				},
+			},
+			expResp: &FirmwareUpdateResp{
+				HostSCMResult: map[string][]*SCMUpdateResult{
+					"host1": expSCMGood,
+				},
+				ScmPhase: storage.ScmFirmwarePhaseActivate,
+			},
+		},
+		"SCM staged update; stage failed; no activation": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				ScmActivate:  true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMResults,
+					}),
+				},
+			},
+			expResp: &FirmwareUpdateResp{
+				HostSCMResult: map[string][]*SCMUpdateResult{
+					"host1": expSCMResults,
+				},
+				ScmPhase: storage.ScmFirmwarePhaseStage,
+			},
+		},
+		"SCM staged update; verify failed; rolled back": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				ScmActivate:  true,
+				RollbackPath: "/old/path",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMResults,
+					}),
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+				},
+			},
+			expResp: &FirmwareUpdateResp{
+				HostSCMResult: map[string][]*SCMUpdateResult{
+					"host1": {
+						expSCMGood[0],
+						{
+							Module: expSCMGood[1].Module,
+							Error:  errors.New("verify: something went wrong"),
+						},
+					},
+				},
+				ScmPhase:      storage.ScmFirmwarePhaseVerify,
+				ScmRolledBack: true,
+			},
+		},
+		"SCM staged update; host failed activation": {
+			req: &FirmwareUpdateReq{
+				Type:         DeviceTypeSCM,
+				FirmwarePath: "/my/path",
+				ScmActivate:  true,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+					MockMSResponse("host1", nil, &ctlpb.FirmwareUpdateResp{
+						ScmResults: pbSCMGood,
+					}),
+					MockMSResponse("host1", errors.New("remote failed"), nil),
+				},
+			},
+			expResp: &FirmwareUpdateResp{
+				HostErrorsResp: HostErrorsResp{
+					HostErrors: HostErrorsMap{
+						"activate: remote failed": &HostErrorSet{
+							HostSet:   createTestHostSet(t, "host1"),
+							HostError: errors.New("activate: remote failed"),
+						},
+					},
+				},
+				HostSCMResult: map[string][]*SCMUpdateResult{
+					"host1": expSCMGood,
+				},
+				ScmPhase: storage.ScmFirmwarePhaseActivate,
+			},
+		},
 		"NVMe success": {
 			req: &FirmwareUpdateReq{
 				Type:         DeviceTypeNVMe,
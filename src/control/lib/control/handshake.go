@@ -0,0 +1,112 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+type (
+	// ServerCapabilities describes the control API supported by a server. Servers that
+	// predate control API versioning are reported with an API version of zero and no
+	// features.
+	ServerCapabilities struct {
+		Version       string   `json:"version"`
+		APIVersion    uint32   `json:"api_version"`
+		MinAPIVersion uint32   `json:"min_api_version"`
+		Features      []string `json:"features"`
+	}
+
+	// HandshakeReq contains the inputs for the handshake request.
+	HandshakeReq struct {
+		unaryRequest
+	}
+
+	// HandshakeResp contains the capabilities reported by each host in response to a
+	// handshake request.
+	HandshakeResp struct {
+		HostErrorsResp
+		HostCapabilities map[string]*ServerCapabilities `json:"host_capabilities"`
+	}
+)
+
+// IsLegacy indicates whether the server predates control API versioning.
+func (sc *ServerCapabilities) IsLegacy() bool {
+	return sc.APIVersion == 0
+}
+
+// HasFeature indicates whether the server supports the given control API feature.
+func (sc *ServerCapabilities) HasFeature(feature build.Feature) bool {
+	for _, f := range sc.Features {
+		if f == feature.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// Handshake will send RPC to hostlist to request the version, supported control API versions
+// and optional features of each daos_server. Servers that don't implement the handshake are
+// reported as legacy servers rather than as errors.
+func Handshake(ctx context.Context, rpcClient UnaryInvoker, req *HandshakeReq) (*HandshakeResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.HandshakeReq{
+		Component:  rpcClient.GetComponent().String(),
+		Version:    build.DaosVersion,
+		ApiVersion: build.ControlAPIVersion,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).Handshake(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS handshake request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke handshake RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &HandshakeResp{
+		HostCapabilities: make(map[string]*ServerCapabilities),
+	}
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if IsMethodUnsupported(hr.Error) {
+				resp.HostCapabilities[hr.Addr] = &ServerCapabilities{}
+				continue
+			}
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hr.Message.(*ctlpb.HandshakeResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hr.Message)
+		}
+		resp.HostCapabilities[hr.Addr] = &ServerCapabilities{
+			Version:       pbResp.GetVersion(),
+			APIVersion:    pbResp.GetApiVersion(),
+			MinAPIVersion: pbResp.GetMinApiVersion(),
+			Features:      pbResp.GetFeatures(),
+		}
+	}
+
+	rpcClient.Debugf("DAOS handshake response: %+v", resp)
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_Handshake(t *testing.T) {
+	pbResp := &ctlpb.HandshakeResp{
+		Version:       "2.8.0",
+		ApiVersion:    1,
+		MinApiVersion: 1,
+		Features:      []string{"jobs", "reload-certs"},
+	}
+	caps := &ServerCapabilities{
+		Version:       "2.8.0",
+		APIVersion:    1,
+		MinAPIVersion: 1,
+		Features:      []string{"jobs", "reload-certs"},
+	}
+
+	for name, tc := range map[string]struct {
+		req         *HandshakeReq
+		mic         *MockInvokerConfig
+		expResponse *HandshakeResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invoker error": {
+			req: &HandshakeReq{},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("fatal"),
+			},
+			expErr: errors.New("fatal"),
+		},
+		"nil message": {
+			req: &HandshakeReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"mixed versions; one fails": {
+			req: &HandshakeReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:    "host1",
+							Message: pbResp,
+						},
+						{
+							Addr:  "host2",
+							Error: FaultMethodUnsupported("host2", "/ctl.CtlSvc/Handshake"),
+						},
+						{
+							Addr:  "host3",
+							Error: status.Error(codes.Unimplemented, "unknown method Handshake"),
+						},
+						{
+							Addr:  "host4",
+							Error: errors.New("connection refused"),
+						},
+					},
+				},
+			},
+			expResponse: &HandshakeResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host4",
+					Error: "connection refused",
+				}),
+				HostCapabilities: map[string]*ServerCapabilities{
+					"host1": caps,
+					"host2": {},
+					"host3": {},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := Handshake(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_ServerCapabilities(t *testing.T) {
+	legacy := &ServerCapabilities{}
+	test.AssertTrue(t, legacy.IsLegacy(), "expected legacy server")
+	test.AssertFalse(t, legacy.HasFeature(build.FeatureJobs), "unexpected feature")
+
+	current := &ServerCapabilities{
+		APIVersion: build.ControlAPIVersion,
+		Features:   []string{build.FeatureJobs.String()},
+	}
+	test.AssertFalse(t, current.IsLegacy(), "unexpected legacy server")
+	test.AssertTrue(t, current.HasFeature(build.FeatureJobs), "expected feature")
+	test.AssertFalse(t, current.HasFeature(build.FeaturePoolClone), "unexpected feature")
+}
+
+func TestControl_FaultMethodUnsupported(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method  string
+		expDesc string
+	}{
+		"no feature": {
+			method:  "/ctl.CtlSvc/Handshake",
+			expDesc: "the server at host1 does not support the /ctl.CtlSvc/Handshake request",
+		},
+		"feature": {
+			method: "/mgmt.MgmtSvc/PoolClone",
+			expDesc: "the server at host1 does not support the pool-clone feature required " +
+				"by the /mgmt.MgmtSvc/PoolClone request",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := FaultMethodUnsupported("host1", tc.method)
+
+			test.AssertEqual(t, tc.expDesc, f.Description, "unexpected description")
+			test.AssertTrue(t, IsMethodUnsupported(f), "expected unsupported method error")
+		})
+	}
+}
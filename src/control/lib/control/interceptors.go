@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,17 +9,48 @@ package control
 
 import (
 	"context"
+	"os/user"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/security"
 )
 
+// UserHeader defines the header name used to convey the name of the user running
+// the client. The name is reported by the client and is informational only, e.g.
+// it is recorded in the server audit log.
+const UserHeader = "x-daos-user"
+
+// CorrelationHeader defines the header name used to convey the ID of the management
+// operation that a request is part of. The same ID is sent with each request made by a
+// single invocation, including those fanned out to multiple servers and retries, and is
+// attached to the RAS events raised while handling them.
+const CorrelationHeader = "x-daos-correlation-id"
+
+// withCorrelationID returns a context whose outgoing headers carry a correlation ID. The ID
+// of the operation being handled by the caller is reused if set, otherwise a new one is
+// generated.
+func withCorrelationID(ctx context.Context) context.Context {
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(CorrelationHeader)) > 0 {
+		return ctx
+	}
+
+	id := events.CorrelationIDFromContext(ctx)
+	if id == "" {
+		id = uuid.New().String()
+	}
+	return metadata.AppendToOutgoingContext(ctx, CorrelationHeader, id)
+}
+
 // connErrToFault attempts to resolve a network connection
 // error to a more informative Fault with resolution.
 func connErrToFault(st *status.Status, target string) error {
@@ -69,12 +101,37 @@ func unaryErrorInterceptor() grpc.UnaryClientInterceptor {
 			if err.Error() != st.Err().Error() {
 				return err
 			}
+			if st.Code() == codes.Unimplemented {
+				return FaultMethodUnsupported(cc.Target(), method)
+			}
 			return connErrToFault(st, cc.Target())
 		}
 		return nil
 	}
 }
 
+// unaryAPIVersionInterceptor checks the control API version reported in the response headers
+// of the server. Servers that predate control API versioning don't report a version and are
+// only subject to the component version checks made by the server.
+func unaryAPIVersionInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var header metadata.MD
+		if err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...); err != nil {
+			return err
+		}
+
+		version, err := build.APIVersionFromMD(header)
+		if err != nil {
+			return errors.Wrapf(err, "response from %s", cc.Target())
+		}
+		if err := build.CheckAPIVersion(version); err != nil {
+			return FaultIncompatibleAPIVersion(cc.Target(), version)
+		}
+
+		return nil
+	}
+}
+
 // unaryVersionedComponentInterceptor appends the component name and version to the
 // outgoing request headers.
 func unaryVersionedComponentInterceptor(comp build.Component) grpc.UnaryClientInterceptor {
@@ -99,3 +156,22 @@ func unaryVersionedComponentInterceptor(comp build.Component) grpc.UnaryClientIn
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+// unaryUserInterceptor appends the name of the user running the client to the
+// outgoing request headers.
+//
+// NB: This interceptor must follow unaryVersionedComponentInterceptor in the
+// chain, as the component and version are only set if no headers exist.
+func unaryUserInterceptor() grpc.UnaryClientInterceptor {
+	var userName string
+	if cu, err := user.Current(); err == nil {
+		userName = cu.Username
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if userName != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, UserHeader, userName)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
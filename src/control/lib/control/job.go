@@ -0,0 +1,309 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// DefaultJobPollInterval is the interval between job state queries made by JobWait.
+const DefaultJobPollInterval = 2 * time.Second
+
+// JobState indicates the state of an asynchronous job run by the management service.
+type JobState int32
+
+// JobState values.
+const (
+	JobStateRunning   = JobState(mgmtpb.JobState_JOB_RUNNING)
+	JobStateSucceeded = JobState(mgmtpb.JobState_JOB_SUCCEEDED)
+	JobStateFailed    = JobState(mgmtpb.JobState_JOB_FAILED)
+	JobStateCanceled  = JobState(mgmtpb.JobState_JOB_CANCELED)
+)
+
+func (js JobState) String() string {
+	name, found := mgmtpb.JobState_name[int32(js)]
+	if !found {
+		return fmt.Sprintf("unknown(%d)", js)
+	}
+	return strings.ToLower(strings.TrimPrefix(name, "JOB_"))
+}
+
+// MarshalJSON outputs the job state as a string.
+func (js JobState) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + js.String() + `"`), nil
+}
+
+// UnmarshalJSON accepts either a numeric or a string job state.
+func (js *JobState) UnmarshalJSON(data []byte) error {
+	if val, err := strconv.ParseInt(string(data), 10, 32); err == nil {
+		*js = JobState(val)
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	val, found := mgmtpb.JobState_value["JOB_"+strings.ToUpper(str)]
+	if !found {
+		return errors.Errorf("invalid job state %q", str)
+	}
+	*js = JobState(val)
+
+	return nil
+}
+
+// Job describes an asynchronous operation run by the management service.
+type Job struct {
+	ID         string          `json:"id"`
+	Op         string          `json:"op"`
+	Target     string          `json:"target"` // Pool the operation acts on.
+	State      JobState        `json:"state"`
+	Progress   uint32          `json:"progress"` // Percentage of the operation completed.
+	Error      string          `json:"error,omitempty"`
+	Created    time.Time       `json:"created"`
+	Updated    time.Time       `json:"updated"`
+	PoolCreate *PoolCreateResp `json:"pool_create,omitempty"` // Result of a pool create job.
+}
+
+// Finished returns true if the job is no longer running.
+func (j *Job) Finished() bool {
+	return j.State != JobStateRunning
+}
+
+// JobSubmitReq contains an operation to be run asynchronously by the management service. The
+// request is not retried so that an operation is not submitted more than once.
+type JobSubmitReq struct {
+	unaryRequest
+	msRequest
+	mgmtpb.JobSubmitReq
+}
+
+func jobSubmit(ctx context.Context, rpcClient UnaryInvoker, req *JobSubmitReq) (string, error) {
+	req.JobSubmitReq.Sys = req.getSystem(rpcClient)
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).JobSubmit(ctx, &req.JobSubmitReq)
+	})
+
+	rpcClient.Debugf("DAOS JobSubmit request: %s", pbUtil.Debug(&req.JobSubmitReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	resp := new(mgmtpb.JobSubmitResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return "", errors.Wrap(err, "job submit failed")
+	}
+
+	return resp.Id, nil
+}
+
+// PoolCreateJob submits a pool create operation to be run asynchronously by the management
+// service and returns the identifier of the job.
+func PoolCreateJob(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq) (string, error) {
+	pbReq, _, err := poolCreateGenPBReq(ctx, rpcClient, req)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate PoolCreate request")
+	}
+
+	jobReq := new(JobSubmitReq)
+	jobReq.Op = &mgmtpb.JobSubmitReq_PoolCreate{PoolCreate: pbReq}
+
+	return jobSubmit(ctx, rpcClient, jobReq)
+}
+
+// PoolDestroyJob submits a pool destroy operation to be run asynchronously by the management
+// service and returns the identifier of the job.
+func PoolDestroyJob(ctx context.Context, rpcClient UnaryInvoker, req *PoolDestroyReq) (string, error) {
+	if req == nil {
+		return "", errors.Errorf("nil %T", req)
+	}
+
+	jobReq := new(JobSubmitReq)
+	jobReq.Op = &mgmtpb.JobSubmitReq_PoolDestroy{
+		PoolDestroy: &mgmtpb.PoolDestroyReq{
+			Id:        req.ID,
+			Recursive: req.Recursive,
+			Force:     req.Force,
+		},
+	}
+
+	return jobSubmit(ctx, rpcClient, jobReq)
+}
+
+// PoolReintegrateJob submits a reintegrate operation for each rank in the request to be run
+// asynchronously by the management service. Each job completes when the rebuild started by the
+// reintegration has finished. Returns the job identifiers in the order of the request ranks.
+func PoolReintegrateJob(ctx context.Context, rpcClient UnaryInvoker, req *PoolRanksReq) ([]string, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("empty pool id")
+	}
+	if len(req.Ranks) == 0 {
+		return nil, errors.New("no ranks in request")
+	}
+
+	ids := make([]string, 0, len(req.Ranks))
+	for _, rank := range req.Ranks {
+		jobReq := new(JobSubmitReq)
+		jobReq.Op = &mgmtpb.JobSubmitReq_PoolReint{
+			PoolReint: &mgmtpb.PoolReintReq{
+				Id:        req.ID,
+				Rank:      rank.Uint32(),
+				TargetIdx: req.TargetIdx,
+			},
+		}
+
+		id, err := jobSubmit(ctx, rpcClient, jobReq)
+		if err != nil {
+			return ids, errors.Wrapf(err, "rank %d", rank)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+type (
+	// JobListReq contains the identifiers of the jobs to list, all jobs are listed if none
+	// are supplied.
+	JobListReq struct {
+		unaryRequest
+		msRequest
+		IDs []string
+	}
+
+	// JobListResp contains the requested jobs ordered by submission time.
+	JobListResp struct {
+		Jobs []*Job `json:"jobs"`
+	}
+)
+
+// JobList fetches the state of asynchronous jobs from the management service leader.
+func JobList(ctx context.Context, rpcClient UnaryInvoker, req *JobListReq) (*JobListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	pbReq := &mgmtpb.JobListReq{
+		Sys: req.getSystem(rpcClient),
+		Ids: req.IDs,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).JobList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS JobList request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(JobListResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "job list failed")
+	}
+	for _, job := range resp.Jobs {
+		if job.PoolCreate != nil && job.PoolCreate.UUID == "" {
+			job.PoolCreate.UUID = job.Target
+		}
+	}
+
+	return resp, nil
+}
+
+// JobCancelReq contains the identifier of the job to cancel.
+type JobCancelReq struct {
+	unaryRequest
+	msRequest
+	ID string
+}
+
+// JobCancel stops a running job. Any part of the operation that has already completed is not
+// undone.
+func JobCancel(ctx context.Context, rpcClient UnaryInvoker, req *JobCancelReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T", req)
+	}
+	if req.ID == "" {
+		return errors.New("empty job id")
+	}
+
+	pbReq := &mgmtpb.JobCancelReq{
+		Sys: req.getSystem(rpcClient),
+		Id:  req.ID,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).JobCancel(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS JobCancel request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "job cancel failed")
+}
+
+// JobWaitReq contains the parameters for waiting on a job to finish.
+type JobWaitReq struct {
+	ID           string
+	PollInterval time.Duration // DefaultJobPollInterval if unset.
+	OnUpdate     func(*Job)    // Called with the job state after each poll, may be nil.
+}
+
+// JobWait polls the management service until the job has finished and returns its final state.
+func JobWait(ctx context.Context, rpcClient UnaryInvoker, req *JobWaitReq) (*Job, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+
+	interval := req.PollInterval
+	if interval == 0 {
+		interval = DefaultJobPollInterval
+	}
+
+	for {
+		resp, err := JobList(ctx, rpcClient, &JobListReq{IDs: []string{req.ID}})
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.Jobs) != 1 {
+			return nil, errors.Errorf("unexpected number of jobs in response: %d", len(resp.Jobs))
+		}
+
+		job := resp.Jobs[0]
+		if req.OnUpdate != nil {
+			req.OnUpdate(job)
+		}
+		if job.Finished() {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
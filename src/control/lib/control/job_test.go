@@ -0,0 +1,251 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_JobState_JSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in       string
+		expState JobState
+		expErr   error
+	}{
+		"numeric": {
+			in:       "2",
+			expState: JobStateFailed,
+		},
+		"string": {
+			in:       `"canceled"`,
+			expState: JobStateCanceled,
+		},
+		"unknown string": {
+			in:     `"paused"`,
+			expErr: errors.New("invalid job state"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotState JobState
+			gotErr := json.Unmarshal([]byte(tc.in), &gotState)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expState, gotState, "unexpected state")
+
+			out, err := json.Marshal(gotState)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, `"`+tc.expState.String()+`"`, string(out), "unexpected JSON")
+		})
+	}
+}
+
+func TestControl_PoolReintegrateJob(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolRanksReq
+		expIDs []string
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolRanksReq"),
+		},
+		"no ranks": {
+			req:    &PoolRanksReq{ID: test.MockUUID()},
+			expErr: errors.New("no ranks"),
+		},
+		"second submission fails": {
+			req: &PoolRanksReq{ID: test.MockUUID(), Ranks: []ranklist.Rank{1, 2}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.JobSubmitResp{Id: "job-1"}),
+					MockMSResponse("host1", errors.New("remote failed"), nil),
+				},
+			},
+			expIDs: []string{"job-1"},
+			expErr: errors.New("rank 2: job submit failed: remote failed"),
+		},
+		"success": {
+			req: &PoolRanksReq{ID: test.MockUUID(), Ranks: []ranklist.Rank{1, 2}},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.JobSubmitResp{Id: "job-1"}),
+					MockMSResponse("host1", nil, &mgmtpb.JobSubmitResp{Id: "job-2"}),
+				},
+			},
+			expIDs: []string{"job-1", "job-2"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotIDs, gotErr := PoolReintegrateJob(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if diff := cmp.Diff(tc.expIDs, gotIDs); diff != "" {
+				t.Fatalf("unexpected job ids (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_JobList(t *testing.T) {
+	created := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updated := created.Add(time.Minute)
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *JobListReq
+		expResp *JobListResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.JobListReq"),
+		},
+		"remote failure": {
+			req: &JobListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &JobListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.JobListResp{
+					Jobs: []*mgmtpb.Job{
+						{
+							Id:       "job-1",
+							Op:       "pool create",
+							Target:   test.MockUUID(1),
+							State:    mgmtpb.JobState_JOB_SUCCEEDED,
+							Progress: 100,
+							Created:  created.Format(time.RFC3339),
+							Updated:  updated.Format(time.RFC3339),
+							PoolCreate: &mgmtpb.PoolCreateResp{
+								SvcLdr:  1,
+								SvcReps: []uint32{0, 1, 2},
+							},
+						},
+						{
+							Id:       "job-2",
+							Op:       "pool reintegrate",
+							Target:   test.MockUUID(2),
+							State:    mgmtpb.JobState_JOB_RUNNING,
+							Progress: 50,
+							Created:  created.Format(time.RFC3339),
+							Updated:  updated.Format(time.RFC3339),
+						},
+					},
+				}),
+			},
+			expResp: &JobListResp{
+				Jobs: []*Job{
+					{
+						ID:       "job-1",
+						Op:       "pool create",
+						Target:   test.MockUUID(1),
+						State:    JobStateSucceeded,
+						Progress: 100,
+						Created:  created,
+						Updated:  updated,
+						PoolCreate: &PoolCreateResp{
+							UUID:    test.MockUUID(1),
+							Leader:  1,
+							SvcReps: []uint32{0, 1, 2},
+						},
+					},
+					{
+						ID:       "job-2",
+						Op:       "pool reintegrate",
+						Target:   test.MockUUID(2),
+						State:    JobStateRunning,
+						Progress: 50,
+						Created:  created,
+						Updated:  updated,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotResp, gotErr := JobList(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_JobWait(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	now := time.Now().Format(time.RFC3339)
+	mockJob := func(state mgmtpb.JobState, progress uint32) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.JobListResp{
+			Jobs: []*mgmtpb.Job{
+				{Id: "job-1", State: state, Progress: progress, Created: now, Updated: now},
+			},
+		})
+	}
+	mi := NewMockInvoker(log, &MockInvokerConfig{
+		UnaryResponseSet: []*UnaryResponse{
+			mockJob(mgmtpb.JobState_JOB_RUNNING, 10),
+			mockJob(mgmtpb.JobState_JOB_RUNNING, 50),
+			mockJob(mgmtpb.JobState_JOB_FAILED, 50),
+		},
+	})
+
+	var gotProgress []uint32
+	job, err := JobWait(test.Context(t), mi, &JobWaitReq{
+		ID:           "job-1",
+		PollInterval: time.Millisecond,
+		OnUpdate: func(j *Job) {
+			gotProgress = append(gotProgress, j.Progress)
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	test.AssertEqual(t, JobStateFailed, job.State, "unexpected final state")
+	if diff := cmp.Diff([]uint32{10, 50, 50}, gotProgress); diff != "" {
+		t.Fatalf("unexpected progress updates (-want, +got):\n%s\n", diff)
+	}
+}
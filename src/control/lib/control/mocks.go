@@ -457,6 +457,37 @@ func MockServerScanResp(t *testing.T, variant string) *ctlpb.StorageScanResp {
 		if err := convert.Types(ncs, &ssr.Nvme.Ctrlrs); err != nil {
 			t.Fatal(err)
 		}
+	case "withForeignScm":
+		snss := nss(true, 0, 1)
+		snss[0].Mount.Ownership = storage.ScmOwnershipOwned
+		snss[1].Mount.Owner = &storage.ScmOwnerLabel{System: "other"}
+		snss[1].Mount.Ownership = storage.ScmOwnershipForeign
+		if err := convert.Types(snss, &ssr.Scm.Namespaces); err != nil {
+			t.Fatal(err)
+		}
+		ncs := ctrlrsWithUsage(0 /* rank */, 0 /* roles */, 1, 2, 3, 4, 5, 6, 7, 8)
+		if err := convert.Types(ncs, &ssr.Nvme.Ctrlrs); err != nil {
+			t.Fatal(err)
+		}
+	case "withPoolUsage":
+		snss := nss(true, 0, 1)
+		snss[0].Mount.PoolUsage = []*storage.PoolStorageUsage{
+			{UUID: test.MockUUID(1), UsedBytes: 250 * humanize.GByte},
+		}
+		if err := convert.Types(snss, &ssr.Scm.Namespaces); err != nil {
+			t.Fatal(err)
+		}
+		ncs := ctrlrsWithUsage(0 /* rank */, 0 /* roles */, 1, 2, 3, 4, 5, 6, 7, 8)
+		ncs[0].SmdDevices[0].PoolUsage = []*storage.PoolStorageUsage{
+			{UUID: test.MockUUID(1), UsedBytes: 100 * humanize.GByte},
+			{UUID: test.MockUUID(2), UsedBytes: 250 * humanize.GByte},
+		}
+		ncs[1].SmdDevices[0].PoolUsage = []*storage.PoolStorageUsage{
+			{UUID: test.MockUUID(1), UsedBytes: 500 * humanize.GByte},
+		}
+		if err := convert.Types(ncs, &ssr.Nvme.Ctrlrs); err != nil {
+			t.Fatal(err)
+		}
 	case "withSpaceUsageRolesAll":
 		snss := nss(true, 0, 1)
 		if err := convert.Types(snss, &ssr.Scm.Namespaces); err != nil {
@@ -227,6 +227,22 @@ type (
 		Ranks      []ranklist.Rank      `json:"ranks"`       // Manual-sizing param
 		TierBytes  []uint64             `json:"tier_bytes"`  // Per-rank values
 		MemRatio   float32              `json:"mem_ratio"`   // mem_file_size:meta_blob_size
+		// Per-rank-sizing param, resolved into TierBytes from a storage query.
+		PerRankTiers []PoolTierSize `json:"-"`
+	}
+
+	// PoolTierSize describes the per-rank size of a pool storage tier as either an
+	// absolute number of bytes or a fraction of the space available on each rank.
+	PoolTierSize struct {
+		Bytes     uint64  `json:"bytes,omitempty"`
+		AvailFrac float64 `json:"avail_frac,omitempty"`
+	}
+
+	// PoolCreateLayout describes the per-rank storage allocation computed from a storage
+	// query when a pool is created with sizes relative to the available space.
+	PoolCreateLayout struct {
+		AvailBytes []uint64 `json:"avail_bytes"` // Per-rank space available in each tier.
+		TierBytes  []uint64 `json:"tier_bytes"`  // Per-rank space requested in each tier.
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -238,29 +254,84 @@ type (
 		TierBytes     []uint64 `json:"tier_bytes"`       // Per-rank storage tier sizes.
 		MemFileBytes  uint64   `json:"mem_file_bytes"`   // Per-rank. MD-on-SSD mode only.
 		MdOnSsdActive bool     `json:"md_on_ssd_active"` // MD-on-SSD mode.
+		// Layout computed by the control API, nil if sizes were given explicitly.
+		Layout *PoolCreateLayout `json:"layout,omitempty"`
 	}
 )
 
 type maxPoolSizeGetter func(*PoolCreateReq) (uint64, uint64, error)
 
-func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req *PoolCreateReq) error {
+// resolvePerRankTiers converts per-rank tier sizes into absolute byte values based on the space
+// available on each rank and checks that the requested sizes fit.
+func resolvePerRankTiers(tiers []PoolTierSize, avail []uint64) ([]uint64, error) {
+	if len(tiers) != len(avail) {
+		return nil, errors.Errorf("expected %d per-rank tier sizes, got %d", len(avail),
+			len(tiers))
+	}
+
+	tierBytes := make([]uint64, len(tiers))
+	for i, ts := range tiers {
+		switch {
+		case ts.AvailFrac < 0 || ts.AvailFrac > 1:
+			return nil, errors.Errorf("tier %d: invalid fraction of available space %.2f",
+				i, ts.AvailFrac)
+		case ts.AvailFrac > 0:
+			tierBytes[i] = uint64(float64(avail[i]) * ts.AvailFrac)
+		default:
+			tierBytes[i] = ts.Bytes
+		}
+		if tierBytes[i] > avail[i] {
+			return nil, errors.Errorf("tier %d: requested %s per rank but only %s is "+
+				"available", i, humanize.Bytes(tierBytes[i]), humanize.Bytes(avail[i]))
+		}
+	}
+	if tierBytes[0] == 0 {
+		return nil, errPoolCreateFirstTierZeroBytes
+	}
+
+	return tierBytes, nil
+}
+
+func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req *PoolCreateReq) (*PoolCreateLayout, error) {
 	hasTotBytes := req.TotalBytes > 0
 	hasTierBytes := len(req.TierBytes) == 2
 	hasNoTierBytes := len(req.TierBytes) == 0
 	hasTierRatio := len(req.TierRatio) == 2
 	hasNoTierRatio := len(req.TierRatio) == 0
+	hasPerRankTiers := len(req.PerRankTiers) > 0
 
 	switch {
+	case hasPerRankTiers && hasNoTierBytes && hasNoTierRatio && !hasTotBytes:
+		// Per-rank tier sizes given as bytes or fractions of available space, resolve
+		// them against a fresh storage query (per-rank-size).
+		scmBytes, nvmeBytes, err := getMaxPoolSz(req)
+		if err != nil {
+			return nil, err
+		}
+		avail := []uint64{scmBytes, nvmeBytes}
+		tierBytes, err := resolvePerRankTiers(req.PerRankTiers, avail)
+		if err != nil {
+			return nil, err
+		}
+		req.PerRankTiers = nil
+		req.TierBytes = tierBytes
+		log.Debugf("per-rank-size pool create mode: %+v", req)
+
+		return &PoolCreateLayout{AvailBytes: avail, TierBytes: tierBytes}, nil
+
+	case hasPerRankTiers:
+		return nil, errors.Errorf("unexpected parameters in pool create request: %+v", req)
+
 	case hasTierBytes && hasNoTierRatio && !hasTotBytes:
 		if req.TierBytes[0] == 0 {
-			return errPoolCreateFirstTierZeroBytes
+			return nil, errPoolCreateFirstTierZeroBytes
 		}
 		// Storage sizes have been written to TierBytes in request (manual-size).
 		log.Debugf("manual-size pool create mode: %+v", req)
 
 	case hasNoTierBytes && hasTierRatio && hasTotBytes:
 		if req.TierRatio[0] == 0 {
-			return errPoolCreateFirstTierRatioZero
+			return nil, errPoolCreateFirstTierRatioZero
 		}
 		// Storage tier ratios and total pool size given, distribution of space across
 		// ranks to be calculated on the server side (auto-total-size).
@@ -268,38 +339,43 @@ func poolCreateReqChkSizes(log debugLogger, getMaxPoolSz maxPoolSizeGetter, req
 
 	case hasNoTierBytes && hasTierRatio && !hasTotBytes:
 		if req.TierRatio[0] == 0 {
-			return errPoolCreateFirstTierRatioZero
+			return nil, errPoolCreateFirstTierRatioZero
 		}
 		availRatio := req.TierRatio[0]
 		if req.TierRatio[1] != availRatio {
-			return errors.New("different tier ratios with no total size is not supported")
+			return nil, errors.New("different tier ratios with no total size is not supported")
 		}
 		req.TierRatio = nil
 		// Storage tier ratios specified without a total size, use specified fraction of
 		// available space (auto-percentage-size).
 		scmBytes, nvmeBytes, err := getMaxPoolSz(req)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		req.TierBytes = []uint64{
 			uint64(float64(scmBytes) * availRatio),
 			uint64(float64(nvmeBytes) * availRatio),
 		}
 		if req.TierBytes[0] == 0 {
-			return errors.Errorf("Not enough SCM storage available with ratio %d%%: "+
+			return nil, errors.Errorf("Not enough SCM storage available with ratio %d%%: "+
 				"SCM storage capacity or ratio should be increased",
 				int(availRatio*100))
 		}
 		log.Debugf("auto-percentage-size pool create mode: %+v", req)
 
+		return &PoolCreateLayout{
+			AvailBytes: []uint64{scmBytes, nvmeBytes},
+			TierBytes:  req.TierBytes,
+		}, nil
+
 	default:
-		return errors.Errorf("unexpected parameters in pool create request: %+v", req)
+		return nil, errors.Errorf("unexpected parameters in pool create request: %+v", req)
 	}
 
-	return nil
+	return nil, nil
 }
 
-func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCreateReq) (out *mgmtpb.PoolCreateReq, err error) {
+func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCreateReq) (out *mgmtpb.PoolCreateReq, layout *PoolCreateLayout, err error) {
 	// ensure pool ownership is set up correctly
 	in.User, in.UserGroup, err = formatNameGroup(in.User, in.UserGroup)
 	if err != nil {
@@ -310,7 +386,7 @@ func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCre
 		return getMaxPoolSize(ctx, rpcClient, createReq)
 	}
 
-	if err = poolCreateReqChkSizes(rpcClient, getMaxPoolSz, in); err != nil {
+	if layout, err = poolCreateReqChkSizes(rpcClient, getMaxPoolSz, in); err != nil {
 		return
 	}
 
@@ -329,7 +405,7 @@ func poolCreateGenPBReq(ctx context.Context, rpcClient UnaryInvoker, in *PoolCre
 // Default values for missing request parameters (e.g. owner/group) are generated when
 // appropriate.
 func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq) (*PoolCreateResp, error) {
-	pbReq, err := poolCreateGenPBReq(ctx, rpcClient, req)
+	pbReq, layout, err := poolCreateGenPBReq(ctx, rpcClient, req)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate PoolCreate request")
 	}
@@ -355,6 +431,7 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 	if pcr.UUID == "" {
 		pcr.UUID = pbReq.Uuid
 	}
+	pcr.Layout = layout
 
 	return pcr, nil
 }
@@ -451,17 +528,28 @@ func PoolEvict(ctx context.Context, rpcClient UnaryInvoker, req *PoolEvictReq) e
 }
 
 type (
-	// PoolQueryReq contains the parameters for a pool query request.
+	// PoolQueryReq contains the parameters for a pool query request. If HistoryPeriod is set,
+	// the usage samples captured by the MS within that period are returned with the response.
 	PoolQueryReq struct {
 		poolRequest
-		ID        string
-		QueryMask daos.PoolQueryMask
+		ID            string
+		QueryMask     daos.PoolQueryMask
+		HistoryPeriod time.Duration
+	}
+
+	// PoolUsageSample contains pool space usage and rebuild status captured by the MS.
+	PoolUsageSample struct {
+		Timestamp       time.Time                 `json:"timestamp"`
+		TierStats       []*daos.StorageUsageStats `json:"tier_stats"`
+		Rebuild         *daos.PoolRebuildStatus   `json:"rebuild"`
+		DisabledTargets uint32                    `json:"disabled_targets"`
 	}
 
 	// PoolQueryResp contains the pool query response.
 	PoolQueryResp struct {
 		Status int32 `json:"status"`
 		daos.PoolInfo
+		History []*PoolUsageSample `json:"history,omitempty"`
 	}
 
 	// PoolQueryTargetReq contains parameters for a pool query target request
@@ -490,10 +578,12 @@ func (pqr *PoolQueryResp) MarshalJSON() ([]byte, error) {
 	type Alias daos.PoolInfo
 	return json.Marshal(&struct {
 		*Alias
-		Status int32 `json:"status"`
+		Status  int32              `json:"status"`
+		History []*PoolUsageSample `json:"history,omitempty"`
 	}{
-		Alias:  (*Alias)(&pqr.PoolInfo),
-		Status: pqr.Status,
+		Alias:   (*Alias)(&pqr.PoolInfo),
+		Status:  pqr.Status,
+		History: pqr.History,
 	})
 }
 
@@ -508,9 +598,10 @@ func PoolQuery(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryReq) (
 // that will be filled out with the query details.
 func poolQueryInt(ctx context.Context, rpcClient UnaryInvoker, req *PoolQueryReq, resp *PoolQueryResp) (*PoolQueryResp, error) {
 	pbReq := &mgmtpb.PoolQueryReq{
-		Sys:       req.getSystem(rpcClient),
-		Id:        req.ID,
-		QueryMask: uint64(req.QueryMask),
+		Sys:         req.getSystem(rpcClient),
+		Id:          req.ID,
+		QueryMask:   uint64(req.QueryMask),
+		HistorySecs: uint64(req.HistoryPeriod.Seconds()),
 	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).PoolQuery(ctx, pbReq)
@@ -951,6 +1042,100 @@ func PoolExtend(ctx context.Context, rpcClient UnaryInvoker, req *PoolExtendReq)
 	return errors.Wrap(ur.getMSError(), "pool extend failed")
 }
 
+// PoolResizeReq contains the parameters for a pool resize request.
+type PoolResizeReq struct {
+	poolRequest
+	ID        string
+	TierBytes []uint64 // New per-rank tier sizes, zero leaves a tier unchanged.
+}
+
+// PoolResizeResp contains the per-rank tier sizes of a pool after a resize.
+type PoolResizeResp struct {
+	TierBytes []uint64 `json:"tier_bytes"`
+}
+
+// PoolResize grows the per-rank storage allocation of an existing pool. Tier sizes may not be
+// reduced and the engines must have enough free space to satisfy the increase.
+func PoolResize(ctx context.Context, rpcClient UnaryInvoker, req *PoolResizeReq) (*PoolResizeResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if len(req.TierBytes) == 0 {
+		return nil, errors.New("no tier sizes in pool resize request")
+	}
+
+	pbReq := &mgmtpb.PoolResizeReq{
+		Sys:       req.getSystem(rpcClient),
+		Id:        req.ID,
+		TierBytes: req.TierBytes,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolResize(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Resize DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolResizeResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool resize failed")
+	}
+
+	return resp, nil
+}
+
+// PoolCloneReq contains the parameters for a pool clone request.
+type PoolCloneReq struct {
+	poolRequest
+	ID    string
+	Label string // Label of the new pool.
+}
+
+// PoolCloneResp contains the identity and layout of a new pool created by a clone request.
+type PoolCloneResp struct {
+	UUID      string   `json:"uuid"`
+	Leader    uint32   `json:"svc_ldr"`
+	SvcReps   []uint32 `json:"svc_reps"`
+	TgtRanks  []uint32 `json:"tgt_ranks"`
+	TierBytes []uint64 `json:"tier_bytes"` // Per-rank storage tier sizes.
+}
+
+// PoolClone creates a new pool with the same ranks, per-rank storage allocation, properties and
+// ACL as an existing pool. Containers of the existing pool are not copied.
+func PoolClone(ctx context.Context, rpcClient UnaryInvoker, req *PoolCloneReq) (*PoolCloneResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T", req)
+	}
+	if !daos.LabelIsValid(req.Label) {
+		return nil, errors.Errorf("invalid label %q for new pool", req.Label)
+	}
+
+	pbReq := &mgmtpb.PoolCloneReq{
+		Sys:   req.getSystem(rpcClient),
+		Id:    req.ID,
+		Label: req.Label,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolClone(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Clone DAOS pool request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolCloneResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool clone failed")
+	}
+
+	return resp, nil
+}
+
 // Implements poolRankOpSig.
 func poolReintegrateRank(ctx context.Context, rpcClient UnaryInvoker, req *PoolRanksReq, rank ranklist.Rank) (*PoolRankResult, error) {
 	pbReq := new(mgmtpb.PoolReintReq)
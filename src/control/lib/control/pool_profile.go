@@ -0,0 +1,258 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+// PoolProfile is a named set of pool create parameters that is stored by the management service
+// so that pools created from it are configured consistently.
+type PoolProfile struct {
+	Name       string             `json:"name"`
+	Properties map[string]string  `json:"properties,omitempty"` // Property values keyed by name.
+	ACL        *AccessControlList `json:"acl,omitempty"`
+	TierRatio  []float64          `json:"tier_ratio,omitempty"` // Tier fractions for total-size creates.
+	NumSvcReps uint32             `json:"nsvc,omitempty"`
+}
+
+// poolProperties returns the profile's pool properties sorted by name.
+func (pp *PoolProfile) poolProperties() ([]*daos.PoolProperty, error) {
+	allProps := daos.PoolProperties()
+	props := make([]*daos.PoolProperty, 0, len(pp.Properties))
+	for name, val := range pp.Properties {
+		if name == "label" {
+			return nil, errors.New("label may not be set in a pool profile")
+		}
+		prop, err := allProps.GetProperty(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := prop.SetValue(val); err != nil {
+			return nil, errors.Wrapf(err, "property %q", name)
+		}
+		props = append(props, prop)
+	}
+	sort.Slice(props, func(i, j int) bool { return props[i].Name < props[j].Name })
+
+	return props, nil
+}
+
+// Validate checks that the profile name and parameters are valid.
+func (pp *PoolProfile) Validate() error {
+	if pp == nil {
+		return errors.Errorf("nil %T", pp)
+	}
+	if !daos.LabelIsValid(pp.Name) {
+		return errors.Errorf("invalid pool profile name %q", pp.Name)
+	}
+
+	if _, err := pp.poolProperties(); err != nil {
+		return err
+	}
+
+	if len(pp.TierRatio) > 0 {
+		if len(pp.TierRatio) != 2 {
+			return errors.Errorf("expected 2 tier ratios in pool profile, got %d",
+				len(pp.TierRatio))
+		}
+		var total float64
+		for _, ratio := range pp.TierRatio {
+			if ratio < 0 || ratio > 1 {
+				return errors.Errorf("invalid tier ratio %.2f in pool profile", ratio)
+			}
+			total += ratio
+		}
+		if math.Abs(total-1) > 0.001 {
+			return errors.New("pool profile tier ratios must add up to 100%")
+		}
+	}
+
+	return nil
+}
+
+// Apply sets the properties, ACL and number of service replicas of a pool create request from
+// the profile. Parameters that have already been set in the request take precedence.
+func (pp *PoolProfile) Apply(req *PoolCreateReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T", req)
+	}
+
+	props, err := pp.poolProperties()
+	if err != nil {
+		return errors.Wrapf(err, "pool profile %q", pp.Name)
+	}
+	for _, prop := range props {
+		found := false
+		for _, reqProp := range req.Properties {
+			if reqProp.Number == prop.Number {
+				found = true
+				break
+			}
+		}
+		if !found {
+			req.Properties = append(req.Properties, prop)
+		}
+	}
+
+	if req.ACL == nil && pp.ACL != nil {
+		req.ACL = &AccessControlList{
+			Entries:    append([]string{}, pp.ACL.Entries...),
+			Owner:      pp.ACL.Owner,
+			OwnerGroup: pp.ACL.OwnerGroup,
+		}
+	}
+	if req.NumSvcReps == 0 {
+		req.NumSvcReps = pp.NumSvcReps
+	}
+
+	return nil
+}
+
+// PoolProfileSetReq contains the pool profile to be stored by the management service.
+type PoolProfileSetReq struct {
+	unaryRequest
+	msRequest
+	Profile *PoolProfile
+}
+
+// PoolProfileSet stores a pool profile, replacing any existing profile with the same name.
+func PoolProfileSet(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileSetReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if err := req.Profile.Validate(); err != nil {
+		return err
+	}
+
+	encoded, err := json.Marshal(req.Profile)
+	if err != nil {
+		return errors.Wrap(err, "encoding pool profile")
+	}
+
+	pbReq := &mgmtpb.PoolProfileSetReq{
+		Sys:     req.getSystem(rpcClient),
+		Name:    req.Profile.Name,
+		Profile: string(encoded),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileSet(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolProfileSet request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+// PoolProfileDeleteReq contains the name of the pool profile to be deleted.
+type PoolProfileDeleteReq struct {
+	unaryRequest
+	msRequest
+	Name string
+}
+
+// PoolProfileDelete deletes a stored pool profile.
+func PoolProfileDelete(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileDeleteReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Name == "" {
+		return errors.New("pool profile name cannot be empty")
+	}
+
+	// An empty profile in the request deletes the stored profile.
+	pbReq := &mgmtpb.PoolProfileSetReq{
+		Sys:  req.getSystem(rpcClient),
+		Name: req.Name,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileSet(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolProfileSet request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return ur.getMSError()
+}
+
+type (
+	// PoolProfileGetReq contains the names of the pool profiles to fetch, all profiles are
+	// fetched if no names are supplied.
+	PoolProfileGetReq struct {
+		unaryRequest
+		msRequest
+		Names []string
+	}
+
+	// PoolProfileGetResp contains the requested pool profiles sorted by name.
+	PoolProfileGetResp struct {
+		Profiles []*PoolProfile `json:"profiles"`
+	}
+)
+
+// PoolProfileGet fetches stored pool profiles.
+func PoolProfileGet(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileGetReq) (*PoolProfileGetResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.PoolProfileGetReq{
+		Sys:   req.getSystem(rpcClient),
+		Names: req.Names,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileGet(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS PoolProfileGet request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolProfileGetResp)
+	if !ok {
+		return nil, errors.New("unable to extract PoolProfileGetResp from MS response")
+	}
+
+	resp := new(PoolProfileGetResp)
+	for name, encoded := range pbResp.GetProfiles() {
+		profile := new(PoolProfile)
+		if err := json.Unmarshal([]byte(encoded), profile); err != nil {
+			return nil, errors.Wrapf(err, "decoding pool profile %q", name)
+		}
+		profile.Name = name
+		resp.Profiles = append(resp.Profiles, profile)
+	}
+	sort.Slice(resp.Profiles, func(i, j int) bool {
+		return resp.Profiles[i].Name < resp.Profiles[j].Name
+	})
+
+	return resp, nil
+}
@@ -0,0 +1,268 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_PoolProfile_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		profile *PoolProfile
+		expErr  error
+	}{
+		"nil profile": {
+			expErr: errors.New("nil *control.PoolProfile"),
+		},
+		"bad name": {
+			profile: &PoolProfile{Name: ""},
+			expErr:  errors.New("invalid pool profile name"),
+		},
+		"unknown property": {
+			profile: &PoolProfile{
+				Name:       "prod",
+				Properties: map[string]string{"bogus": "1"},
+			},
+			expErr: errors.New("bogus"),
+		},
+		"bad property value": {
+			profile: &PoolProfile{
+				Name:       "prod",
+				Properties: map[string]string{"reclaim": "sometimes"},
+			},
+			expErr: errors.New("reclaim"),
+		},
+		"label property": {
+			profile: &PoolProfile{
+				Name:       "prod",
+				Properties: map[string]string{"label": "foo"},
+			},
+			expErr: errors.New("label may not be set"),
+		},
+		"wrong number of tier ratios": {
+			profile: &PoolProfile{
+				Name:      "prod",
+				TierRatio: []float64{1},
+			},
+			expErr: errors.New("expected 2 tier ratios"),
+		},
+		"tier ratios don't add up": {
+			profile: &PoolProfile{
+				Name:      "prod",
+				TierRatio: []float64{0.1, 0.5},
+			},
+			expErr: errors.New("add up to 100%"),
+		},
+		"valid": {
+			profile: &PoolProfile{
+				Name:       "prod-ec",
+				Properties: map[string]string{"reclaim": "lazy", "rd_fac": "2"},
+				TierRatio:  []float64{0.1, 0.9},
+				NumSvcReps: 5,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.profile.Validate())
+		})
+	}
+}
+
+func TestControl_PoolProfile_Apply(t *testing.T) {
+	profile := &PoolProfile{
+		Name:       "prod",
+		Properties: map[string]string{"reclaim": "lazy", "rd_fac": "2"},
+		ACL: &AccessControlList{
+			Entries: []string{"A::OWNER@:rw"},
+		},
+		NumSvcReps: 5,
+	}
+
+	mustProp := func(name, val string) *daos.PoolProperty {
+		prop, err := daos.PoolProperties().GetProperty(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := prop.SetValue(val); err != nil {
+			t.Fatal(err)
+		}
+		return prop
+	}
+
+	for name, tc := range map[string]struct {
+		req      *PoolCreateReq
+		expProps []string
+		expACL   *AccessControlList
+		expNSvc  uint32
+	}{
+		"empty request": {
+			req:      &PoolCreateReq{},
+			expProps: []string{"rd_fac:2", "reclaim:lazy"},
+			expACL:   &AccessControlList{Entries: []string{"A::OWNER@:rw"}},
+			expNSvc:  5,
+		},
+		"request values take precedence": {
+			req: &PoolCreateReq{
+				Properties: []*daos.PoolProperty{
+					mustProp("label", "foo"),
+					mustProp("reclaim", "disabled"),
+				},
+				ACL:        &AccessControlList{Entries: []string{"A::EVERYONE@:r"}},
+				NumSvcReps: 3,
+			},
+			expProps: []string{"label:foo", "reclaim:disabled", "rd_fac:2"},
+			expACL:   &AccessControlList{Entries: []string{"A::EVERYONE@:r"}},
+			expNSvc:  3,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := profile.Apply(tc.req); err != nil {
+				t.Fatal(err)
+			}
+
+			var gotProps []string
+			for _, prop := range tc.req.Properties {
+				gotProps = append(gotProps, prop.String())
+			}
+			if diff := cmp.Diff(tc.expProps, gotProps); diff != "" {
+				t.Fatalf("unexpected properties (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expACL, tc.req.ACL); diff != "" {
+				t.Fatalf("unexpected ACL (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expNSvc, tc.req.NumSvcReps, "unexpected nsvc")
+		})
+	}
+}
+
+func TestControl_PoolProfileSet(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic    *MockInvokerConfig
+		req    *PoolProfileSetReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolProfileSetReq"),
+		},
+		"invalid profile": {
+			req:    &PoolProfileSetReq{Profile: &PoolProfile{}},
+			expErr: errors.New("invalid pool profile name"),
+		},
+		"remote failure": {
+			req: &PoolProfileSetReq{Profile: &PoolProfile{Name: "prod"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolProfileSetReq{Profile: &PoolProfile{Name: "prod"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.DaosResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotErr := PoolProfileSet(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolProfileGet(t *testing.T) {
+	encode := func(pp *PoolProfile) string {
+		data, err := json.Marshal(pp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolProfileGetReq
+		expResp *PoolProfileGetResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolProfileGetReq"),
+		},
+		"remote failure": {
+			req: &PoolProfileGetReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"bad encoding": {
+			req: &PoolProfileGetReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolProfileGetResp{
+					Profiles: map[string]string{"prod": "{"},
+				}),
+			},
+			expErr: errors.New("decoding pool profile \"prod\""),
+		},
+		"success": {
+			req: &PoolProfileGetReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolProfileGetResp{
+					Profiles: map[string]string{
+						"test": encode(&PoolProfile{Name: "test"}),
+						"prod": encode(&PoolProfile{
+							Name:      "prod",
+							TierRatio: []float64{0.1, 0.9},
+						}),
+					},
+				}),
+			},
+			expResp: &PoolProfileGetResp{
+				Profiles: []*PoolProfile{
+					{Name: "prod", TierRatio: []float64{0.1, 0.9}},
+					{Name: "test"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			gotResp, gotErr := PoolProfileGet(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -776,6 +777,166 @@ func TestControl_PoolReintegrate(t *testing.T) {
 	}
 }
 
+func TestControl_PoolResize(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolResizeReq
+		expResp *PoolResizeResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolResizeReq"),
+		},
+		"no tier sizes": {
+			req: &PoolResizeReq{
+				ID: test.MockUUID(),
+			},
+			expErr: errors.New("no tier sizes"),
+		},
+		"local failure": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, humanize.TByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, humanize.TByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", daos.NoSpace, nil),
+			},
+			expErr: daos.NoSpace,
+		},
+		"success": {
+			req: &PoolResizeReq{
+				ID:        test.MockUUID(),
+				TierBytes: []uint64{0, humanize.TByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolResizeResp{
+					TierBytes: []uint64{humanize.GByte, humanize.TByte},
+				}),
+			},
+			expResp: &PoolResizeResp{
+				TierBytes: []uint64{humanize.GByte, humanize.TByte},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolResize(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolClone(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolCloneReq
+		expResp *PoolCloneResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolCloneReq"),
+		},
+		"invalid label": {
+			req: &PoolCloneReq{
+				ID: test.MockUUID(),
+			},
+			expErr: errors.New("invalid label"),
+		},
+		"local failure": {
+			req: &PoolCloneReq{
+				ID:    test.MockUUID(),
+				Label: "clone",
+			},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("local failed"),
+			},
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req: &PoolCloneReq{
+				ID:    test.MockUUID(),
+				Label: "clone",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", daos.NoSpace, nil),
+			},
+			expErr: daos.NoSpace,
+		},
+		"success": {
+			req: &PoolCloneReq{
+				ID:    test.MockUUID(),
+				Label: "clone",
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolCloneResp{
+					Uuid:      test.MockUUID(2),
+					SvcLdr:    1,
+					SvcReps:   []uint32{0, 1, 2},
+					TgtRanks:  []uint32{0, 1, 2},
+					TierBytes: []uint64{humanize.GByte, humanize.TByte},
+				}),
+			},
+			expResp: &PoolCloneResp{
+				UUID:      test.MockUUID(2),
+				Leader:    1,
+				SvcReps:   []uint32{0, 1, 2},
+				TgtRanks:  []uint32{0, 1, 2},
+				TierBytes: []uint64{humanize.GByte, humanize.TByte},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, mic)
+
+			gotResp, gotErr := PoolClone(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_PoolEvict(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mic    *MockInvokerConfig
@@ -894,6 +1055,7 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 		getMaxErr        error
 		expNrGetMaxCalls int
 		expReq           *PoolCreateReq
+		expLayout        *PoolCreateLayout
 		expErr           error
 	}{
 		"empty request": {
@@ -930,6 +1092,10 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			expReq: &PoolCreateReq{
 				TierBytes: []uint64{80 * humanize.GiByte, 0},
 			},
+			expLayout: &PoolCreateLayout{
+				AvailBytes: []uint64{100 * humanize.GiByte, 0},
+				TierBytes:  []uint64{80 * humanize.GiByte, 0},
+			},
 		},
 		"auto-percentage-size": {
 			req: PoolCreateReq{
@@ -941,6 +1107,79 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			expReq: &PoolCreateReq{
 				TierBytes: []uint64{80 * humanize.GiByte, 160 * humanize.GiByte},
 			},
+			expLayout: &PoolCreateLayout{
+				AvailBytes: []uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				TierBytes:  []uint64{80 * humanize.GiByte, 160 * humanize.GiByte},
+			},
+		},
+		"per-rank-size; bytes and fraction": {
+			req: PoolCreateReq{
+				PerRankTiers: []PoolTierSize{
+					{Bytes: 10 * humanize.GiByte},
+					{AvailFrac: 0.5},
+				},
+			},
+			getMaxScm:        100 * humanize.GiByte,
+			getMaxNvme:       200 * humanize.GiByte,
+			expNrGetMaxCalls: 1,
+			expReq: &PoolCreateReq{
+				TierBytes: []uint64{10 * humanize.GiByte, 100 * humanize.GiByte},
+			},
+			expLayout: &PoolCreateLayout{
+				AvailBytes: []uint64{100 * humanize.GiByte, 200 * humanize.GiByte},
+				TierBytes:  []uint64{10 * humanize.GiByte, 100 * humanize.GiByte},
+			},
+		},
+		"per-rank-size; exceeds available": {
+			req: PoolCreateReq{
+				PerRankTiers: []PoolTierSize{
+					{Bytes: 10 * humanize.GiByte},
+					{Bytes: 300 * humanize.GiByte},
+				},
+			},
+			getMaxScm:  100 * humanize.GiByte,
+			getMaxNvme: 200 * humanize.GiByte,
+			expErr:     errors.New("tier 1: requested 322 GB per rank but only 215 GB"),
+		},
+		"per-rank-size; zero first tier": {
+			req: PoolCreateReq{
+				PerRankTiers: []PoolTierSize{
+					{},
+					{AvailFrac: 1},
+				},
+			},
+			getMaxScm:  100 * humanize.GiByte,
+			getMaxNvme: 200 * humanize.GiByte,
+			expErr:     errPoolCreateFirstTierZeroBytes,
+		},
+		"per-rank-size; query fails": {
+			req: PoolCreateReq{
+				PerRankTiers: []PoolTierSize{
+					{AvailFrac: 0.5},
+					{AvailFrac: 0.5},
+				},
+			},
+			getMaxErr: errors.New("system not ready"),
+			expErr:    errors.New("system not ready"),
+		},
+		"per-rank-size; missing tier": {
+			req: PoolCreateReq{
+				PerRankTiers: []PoolTierSize{
+					{AvailFrac: 0.5},
+				},
+			},
+			getMaxScm: 100 * humanize.GiByte,
+			expErr:    errors.New("expected 2 per-rank tier sizes"),
+		},
+		"per-rank-size and tier bytes": {
+			req: PoolCreateReq{
+				TierBytes: tierBytes,
+				PerRankTiers: []PoolTierSize{
+					{AvailFrac: 0.5},
+					{AvailFrac: 0.5},
+				},
+			},
+			expErr: errors.New("unexpected param"),
 		},
 		"manual-size": {
 			req: PoolCreateReq{
@@ -968,7 +1207,7 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 				return tc.getMaxScm, tc.getMaxNvme, tc.getMaxErr
 			}
 
-			gotErr := poolCreateReqChkSizes(log, getMaxPoolSz, &tc.req)
+			gotLayout, gotErr := poolCreateReqChkSizes(log, getMaxPoolSz, &tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
@@ -985,6 +1224,9 @@ func TestControl_poolCreateReqChkSizes(t *testing.T) {
 			if diff := cmp.Diff(*tc.expReq, tc.req, cmpOpt); diff != "" {
 				t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.expLayout, gotLayout); diff != "" {
+				t.Fatalf("Unexpected layout (-want, +got):\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -1773,6 +2015,61 @@ func TestControl_PoolQuery(t *testing.T) {
 				},
 			},
 		},
+		"query succeeds with history": {
+			req: &PoolQueryReq{
+				ID:            test.MockUUID(),
+				HistoryPeriod: 24 * time.Hour,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil,
+					&mgmtpb.PoolQueryResp{
+						Uuid:         poolUUID.String(),
+						TotalTargets: 42,
+						History: []*mgmtpb.PoolUsageSample{
+							{
+								Timestamp: "2025-06-01T12:00:00Z",
+								TierStats: []*mgmtpb.StorageUsageStats{
+									{
+										Total:     123456,
+										Free:      1000,
+										MediaType: mgmtpb.StorageMediaType(daos.StorageMediaTypeNvme),
+									},
+								},
+								Rebuild: &mgmtpb.PoolRebuildStatus{
+									State:   mgmtpb.PoolRebuildStatus_BUSY,
+									Objects: 1,
+								},
+								DisabledTargets: 8,
+							},
+						},
+					},
+				),
+			},
+			expResp: &PoolQueryResp{
+				PoolInfo: daos.PoolInfo{
+					UUID:         poolUUID,
+					TotalTargets: 42,
+					State:        daos.PoolServiceStateReady,
+				},
+				History: []*PoolUsageSample{
+					{
+						Timestamp: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+						TierStats: []*daos.StorageUsageStats{
+							{
+								Total:     123456,
+								Free:      1000,
+								MediaType: daos.StorageMediaTypeNvme,
+							},
+						},
+						Rebuild: &daos.PoolRebuildStatus{
+							State:   daos.PoolRebuildStateBusy,
+							Objects: 1,
+						},
+						DisabledTargets: 8,
+					},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -127,6 +128,8 @@ type (
 		config    *Config
 		log       debugLogger
 		component build.Component
+		connMu    sync.Mutex
+		conns     map[string]*grpc.ClientConn
 	}
 
 	// ClientOption defines the signature for functional Client options.
@@ -147,6 +150,15 @@ func WithClientLogger(log debugLogger) ClientOption {
 	}
 }
 
+// WithClientConnCache enables the reuse of connections to hosts between
+// requests, for long-lived clients that issue many requests. Cached
+// connections are released by Close.
+func WithClientConnCache() ClientOption {
+	return func(c *Client) {
+		c.conns = make(map[string]*grpc.ClientConn)
+	}
+}
+
 // WithConfig sets the client's configuration.
 func WithConfig(cfg *Config) ClientOption {
 	return func(c *Client) {
@@ -187,11 +199,53 @@ func (c *Client) GetComponent() build.Component {
 }
 
 // SetConfig sets the client configuration for an
-// existing Client.
+// existing Client. Cached connections are closed if the
+// new configuration changes how connections are secured.
 func (c *Client) SetConfig(cfg *Config) {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if !sameTransport(c.config, cfg) {
+		c.closeConns()
+	}
 	c.config = cfg
 }
 
+// Close releases any cached connections.
+func (c *Client) Close() error {
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	return c.closeConns()
+}
+
+func (c *Client) closeConns() error {
+	var lastErr error
+	for addr, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			lastErr = err
+		}
+		delete(c.conns, addr)
+	}
+	return lastErr
+}
+
+func sameTransport(a, b *Config) bool {
+	var aTC, bTC security.TransportConfig
+	if a != nil && a.TransportConfig != nil {
+		aTC = *a.TransportConfig
+	}
+	if b != nil && b.TransportConfig != nil {
+		bTC = *b.TransportConfig
+	}
+
+	return aTC.AllowInsecure == bTC.AllowInsecure &&
+		aTC.ServerName == bTC.ServerName &&
+		aTC.CARootPath == bTC.CARootPath &&
+		aTC.CertificatePath == bTC.CertificatePath &&
+		aTC.PrivateKeyPath == bTC.PrivateKeyPath
+}
+
 // GetConfig retrieves the system name from the client configuration and
 // implements the sysGetter interface.
 func (c *Client) GetSystem() string {
@@ -213,7 +267,9 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 		streamErrorInterceptor(),
 		grpc.WithChainUnaryInterceptor(
 			unaryErrorInterceptor(),
+			unaryAPIVersionInterceptor(),
 			unaryVersionedComponentInterceptor(c.GetComponent()),
+			unaryUserInterceptor(),
 		),
 		grpc.FailOnNonTempDialError(true),
 	}
@@ -224,9 +280,51 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 	}
 	opts = append(opts, creds)
 
+	if tc := c.config.TransportConfig; tc != nil && !tc.AllowInsecure && tc.TokenFile != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&security.TokenFileCredentials{
+			Path: tc.TokenFile,
+		}))
+	}
+
 	return opts, nil
 }
 
+// dial returns a connection to the host along with a function to release it
+// once the request is complete. If the connection cache is enabled, existing
+// connections are reused and remain open on release.
+func (c *Client) dial(ctx context.Context, hostAddr string) (*grpc.ClientConn, func(), error) {
+	if c.conns == nil {
+		opts, err := c.dialOptions()
+		if err != nil {
+			return nil, nil, err
+		}
+		conn, err := grpc.DialContext(ctx, hostAddr, opts...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, func() { conn.Close() }, nil
+	}
+
+	c.connMu.Lock()
+	defer c.connMu.Unlock()
+
+	if conn, found := c.conns[hostAddr]; found {
+		return conn, func() {}, nil
+	}
+
+	opts, err := c.dialOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.DialContext(ctx, hostAddr, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.conns[hostAddr] = conn
+
+	return conn, func() {}, nil
+}
+
 // setDeadlineIfUnset sets a deadline on the context unless there is already
 // one set. If the request does not define a specific deadline, then the
 // default timeout is used.
@@ -270,6 +368,7 @@ func wrapReqTimeout(req UnaryRequest, err error) error {
 // provides access to a stream of HostResponse items as they are received, and
 // is closed when no more responses are expected.
 func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (HostResponseChan, error) {
+	parent = withCorrelationID(parent)
 	hosts, err := getRequestHosts(c.config, req)
 	if err != nil {
 		return nil, err
@@ -290,14 +389,10 @@ func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (
 			wg.Add(1)
 			go func(hostAddr string) {
 				var msg proto.Message
-				opts, err := c.dialOptions()
+				conn, release, err := c.dial(ctx, hostAddr)
 				if err == nil {
-					var conn *grpc.ClientConn
-					conn, err = grpc.DialContext(ctx, hostAddr, opts...)
-					if err == nil {
-						msg, err = req.getRPC()(ctx, conn)
-						conn.Close()
-					}
+					msg, err = req.getRPC()(ctx, conn)
+					release()
 				}
 
 				select {
@@ -484,7 +579,7 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 			}
 
 			// If the RPC handler is unimplemented, then we shouldn't retry.
-			if status.Code(errors.Cause(err)) == codes.Unimplemented {
+			if IsMethodUnsupported(err) {
 				return nil, err
 			}
 
@@ -528,5 +623,5 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	return invokeUnaryRPC(withCorrelationID(ctx), c.log, c, req, c.config.HostList)
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -21,10 +22,12 @@ import (
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -237,6 +240,109 @@ func TestControl_InvokeUnaryRPCAsync(t *testing.T) {
 	}
 }
 
+func TestControl_withCorrelationID(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ctx      context.Context
+		expID    string
+		expNewID bool
+	}{
+		"new operation": {
+			ctx:      test.Context(t),
+			expNewID: true,
+		},
+		"operation being handled": {
+			ctx:   events.WithCorrelationID(test.Context(t), "op-1"),
+			expID: "op-1",
+		},
+		"already set": {
+			ctx: metadata.AppendToOutgoingContext(
+				events.WithCorrelationID(test.Context(t), "op-1"), CorrelationHeader, "op-0"),
+			expID: "op-0",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := withCorrelationID(tc.ctx)
+			// Applying it again, e.g. on retry, must not change the ID.
+			ctx = withCorrelationID(ctx)
+
+			md, _ := metadata.FromOutgoingContext(ctx)
+			ids := md.Get(CorrelationHeader)
+			if len(ids) != 1 {
+				t.Fatalf("expected 1 correlation ID, got %v", ids)
+			}
+			if tc.expNewID {
+				if ids[0] == "" {
+					t.Fatal("expected a new correlation ID")
+				}
+				return
+			}
+			test.AssertEqual(t, tc.expID, ids[0], "unexpected correlation ID")
+		})
+	}
+}
+
+func TestControl_Client_ConnCache(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	clientCfg := DefaultConfig()
+	clientCfg.TransportConfig.AllowInsecure = true
+
+	var conns []*grpc.ClientConn
+	req := &testRequest{
+		HostList: []string{"127.0.0.1:1"},
+		rpcFn: func(_ context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+			conns = append(conns, conn)
+			return defaultMessage, nil
+		},
+	}
+
+	client := NewClient(
+		WithClientLogger(log),
+		WithConfig(clientCfg),
+		WithClientConnCache(),
+	)
+	for i := 0; i < 2; i++ {
+		respChan, err := client.InvokeUnaryRPCAsync(test.Context(t), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range respChan {
+		}
+	}
+
+	if len(conns) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(conns))
+	}
+	if conns[0] != conns[1] {
+		t.Fatal("expected connection to be reused")
+	}
+
+	// A new config with the same transport settings keeps the connection.
+	sameCfg := DefaultConfig()
+	sameCfg.TransportConfig.AllowInsecure = true
+	client.SetConfig(sameCfg)
+	test.AssertEqual(t, 1, len(client.conns), "unexpected cached connections")
+
+	// A change to the transport settings closes the connection.
+	client.SetConfig(DefaultConfig())
+	test.AssertEqual(t, 0, len(client.conns), "unexpected cached connections")
+
+	client.SetConfig(sameCfg)
+	respChan, err := client.InvokeUnaryRPCAsync(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range respChan {
+	}
+	test.AssertEqual(t, 1, len(client.conns), "unexpected cached connections")
+
+	if err := client.Close(); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 0, len(client.conns), "unexpected cached connections")
+}
+
 func TestControl_InvokeUnaryRPC(t *testing.T) {
 	// make the rand deterministic for testing
 	msCandidateRandSource = newSafeRandSource(1)
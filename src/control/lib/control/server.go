@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -155,3 +156,114 @@ func SetEngineLogMasks(ctx context.Context, rpcClient UnaryInvoker, req *SetEngi
 	rpcClient.Debugf("DAOS set engine log masks response: %+v", resp)
 	return resp, nil
 }
+
+// ServerReloadConfigReq contains the inputs for the server reload config request.
+type ServerReloadConfigReq struct {
+	unaryRequest
+}
+
+// ServerReloadConfigResp contains the results of a server reload config request.
+type ServerReloadConfigResp struct {
+	HostErrorsResp
+	HostChanges map[string][]string `json:"host_changes"`
+}
+
+// ServerReloadConfig will send RPC to hostlist to request that each daos_server re-reads its
+// config file and applies any changes to parameters that can be updated without a restart.
+func ServerReloadConfig(ctx context.Context, rpcClient UnaryInvoker, req *ServerReloadConfigReq) (*ServerReloadConfigResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.ReloadConfigReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).ReloadConfig(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS server reload config request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke server reload config RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &ServerReloadConfigResp{
+		HostChanges: make(map[string][]string),
+	}
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hr.Message.(*ctlpb.ReloadConfigResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hr.Message)
+		}
+		resp.HostChanges[hr.Addr] = pbResp.GetChanges()
+	}
+
+	rpcClient.Debugf("DAOS server reload config response: %+v", resp)
+	return resp, nil
+}
+
+// ServerSetEngineStandbyReq contains the inputs for the set engine standby request.
+type ServerSetEngineStandbyReq struct {
+	unaryRequest
+	EngineIdx uint32
+	Standby   bool
+}
+
+// ServerSetEngineStandbyResp contains the results of a set engine standby request.
+type ServerSetEngineStandbyResp struct {
+	HostErrorsResp
+	HostStandby map[string]bool `json:"host_standby"`
+}
+
+// ServerSetEngineStandby will send RPC to hostlist to request that the engine with the given
+// index is placed in or taken out of standby mode. Engines in standby are not started by the
+// server until standby is cleared.
+func ServerSetEngineStandby(ctx context.Context, rpcClient UnaryInvoker, req *ServerSetEngineStandbyReq) (*ServerSetEngineStandbyResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	pbReq := &ctlpb.SetEngineStandbyReq{
+		Sys:       req.getSystem(rpcClient),
+		EngineIdx: req.EngineIdx,
+		Standby:   req.Standby,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).SetEngineStandby(ctx, pbReq)
+	})
+	rpcClient.Debugf("DAOS server set engine standby request: %+v", pbReq)
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		rpcClient.Debugf("failed to invoke server set engine standby RPC: %s", err)
+		return nil, err
+	}
+
+	resp := &ServerSetEngineStandbyResp{
+		HostStandby: make(map[string]bool),
+	}
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			if err := resp.addHostError(hr.Addr, hr.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hr.Message.(*ctlpb.SetEngineStandbyResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hr.Message)
+		}
+		resp.HostStandby[hr.Addr] = pbResp.GetStandby()
+	}
+
+	rpcClient.Debugf("DAOS server set engine standby response: %+v", resp)
+	return resp, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -22,6 +23,10 @@ import (
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
+// DefaultLedIdentifyTimeout is the number of minutes an identify LED blinks for when no timeout
+// is specified.
+const DefaultLedIdentifyTimeout = 2
+
 // SmdManageOpcode defines an SmdManage operation.
 type SmdManageOpcode uint8
 
@@ -316,12 +321,16 @@ func packPBSmdManageReq(req *SmdManageReq, pbReq *ctlpb.SmdManageReq) error {
 			},
 		}
 	case LedBlinkOp:
+		timeout := req.IdentifyTimeout
+		if timeout == 0 {
+			timeout = DefaultLedIdentifyTimeout
+		}
 		pbReq.Op = &ctlpb.SmdManageReq_Led{
 			Led: &ctlpb.LedManageReq{
 				Ids:             req.IDs,
 				LedState:        ctlpb.LedState_QUICK_BLINK,
 				LedAction:       ctlpb.LedAction_SET,
-				LedDurationMins: req.IdentifyTimeout,
+				LedDurationMins: timeout,
 			},
 		}
 	case LedResetOp:
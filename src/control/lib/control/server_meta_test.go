@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -537,9 +538,27 @@ func TestControl_packPBSmdManageReq(t *testing.T) {
 			expPBReq: &ctlpb.SmdManageReq{
 				Op: &ctlpb.SmdManageReq_Led{
 					Led: &ctlpb.LedManageReq{
-						Ids:       fmt.Sprintf(test.MockUUID(1), test.MockPCIAddr(1)),
-						LedState:  ctlpb.LedState_QUICK_BLINK,
-						LedAction: ctlpb.LedAction_SET,
+						Ids:             fmt.Sprintf(test.MockUUID(1), test.MockPCIAddr(1)),
+						LedState:        ctlpb.LedState_QUICK_BLINK,
+						LedAction:       ctlpb.LedAction_SET,
+						LedDurationMins: DefaultLedIdentifyTimeout,
+					},
+				},
+			},
+		},
+		"led-manage; identify with timeout": {
+			req: &SmdManageReq{
+				Operation:       LedBlinkOp,
+				IDs:             test.MockPCIAddr(1),
+				IdentifyTimeout: 60,
+			},
+			expPBReq: &ctlpb.SmdManageReq{
+				Op: &ctlpb.SmdManageReq_Led{
+					Led: &ctlpb.LedManageReq{
+						Ids:             test.MockPCIAddr(1),
+						LedState:        ctlpb.LedState_QUICK_BLINK,
+						LedAction:       ctlpb.LedAction_SET,
+						LedDurationMins: 60,
 					},
 				},
 			},
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -436,3 +437,167 @@ func Test_SetEngineLogMasks(t *testing.T) {
 		})
 	}
 }
+
+func Test_ServerReloadConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		expResponse *ServerReloadConfigResp
+		expErr      error
+	}{
+		"empty response": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{},
+			},
+			expResponse: &ServerReloadConfigResp{
+				HostChanges: map[string][]string{},
+			},
+		},
+		"nil message": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"invoker error": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("fatal"),
+			},
+			expErr: errors.New("fatal"),
+		},
+		"multiple hosts; one fails": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+							Message: &ctlpb.ReloadConfigResp{
+								Changes: []string{
+									"control_log_mask: INFO -> DEBUG",
+								},
+							},
+						},
+						{
+							Addr:  "host2",
+							Error: errors.New("immutable"),
+						},
+						{
+							Addr:    "host3",
+							Message: &ctlpb.ReloadConfigResp{},
+						},
+					},
+				},
+			},
+			expResponse: &ServerReloadConfigResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host2",
+					Error: "immutable",
+				}),
+				HostChanges: map[string][]string{
+					"host1": {"control_log_mask: INFO -> DEBUG"},
+					"host3": nil,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := ServerReloadConfig(ctx, mi, &ServerReloadConfigReq{})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func Test_ServerSetEngineStandby(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req         *ServerSetEngineStandbyReq
+		mic         *MockInvokerConfig
+		expResponse *ServerSetEngineStandbyResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"nil message": {
+			req: &ServerSetEngineStandbyReq{Standby: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr: "host1",
+						},
+					},
+				},
+			},
+			expErr: errors.New("unpack"),
+		},
+		"invoker error": {
+			req: &ServerSetEngineStandbyReq{Standby: true},
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("fatal"),
+			},
+			expErr: errors.New("fatal"),
+		},
+		"multiple hosts; one fails": {
+			req: &ServerSetEngineStandbyReq{EngineIdx: 1, Standby: true},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{
+							Addr:    "host1",
+							Message: &ctlpb.SetEngineStandbyResp{Standby: true},
+						},
+						{
+							Addr:  "host2",
+							Error: errors.New("instance 1 is running"),
+						},
+					},
+				},
+			},
+			expResponse: &ServerSetEngineStandbyResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{
+					Hosts: "host2",
+					Error: "instance 1 is running",
+				}),
+				HostStandby: map[string]bool{
+					"host1": true,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := ServerSetEngineStandby(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
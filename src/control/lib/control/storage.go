@@ -24,6 +24,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/server/storage"
 	"github.com/daos-stack/daos/src/control/system"
 )
@@ -55,6 +56,10 @@ type HostStorage struct {
 	// host's metadata table, if available.
 	SmdInfo *SmdInfo `json:"smd_info"`
 
+	// ScmCapabilities indicates which PMem operations are supported on the host, nil if
+	// not reported.
+	ScmCapabilities *storage.ScmCapabilities `json:"scm_capabilities,omitempty"`
+
 	// RebootRequired indicates that a host reboot is necessary in order
 	// to achieve some goal (SCM prep, etc.)
 	RebootRequired bool `json:"reboot_required"`
@@ -171,6 +176,75 @@ func (hsm HostStorageMap) IsMdOnSsdEnabled() bool {
 	return false
 }
 
+// StorageDevice types reported in a flattened host storage device list.
+const (
+	StorageDeviceTypeNvme = "nvme"
+	StorageDeviceTypeScm  = "scm"
+)
+
+// StorageDevice describes a single NVMe controller or SCM namespace found in a storage scan.
+type StorageDevice struct {
+	Type     string                 `json:"type"`
+	Address  string                 `json:"address"`
+	NumaNode uint32                 `json:"numa_node"`
+	Driver   string                 `json:"driver,omitempty"`
+	Model    string                 `json:"model,omitempty"`
+	Serial   string                 `json:"serial,omitempty"`
+	FwRev    string                 `json:"fw_rev,omitempty"`
+	Capacity uint64                 `json:"capacity"`
+	Binding  *storage.DeviceBinding `json:"binding,omitempty"`
+}
+
+// Devices returns a flattened list of the NVMe controllers and SCM namespaces in the host storage
+// configuration.
+func (hs *HostStorage) Devices() []*StorageDevice {
+	if hs == nil {
+		return nil
+	}
+
+	devs := make([]*StorageDevice, 0, len(hs.ScmNamespaces)+len(hs.NvmeDevices))
+	for _, ns := range hs.ScmNamespaces {
+		devs = append(devs, &StorageDevice{
+			Type:     StorageDeviceTypeScm,
+			Address:  ns.BlockDevice,
+			NumaNode: ns.NumaNode,
+			Capacity: ns.Size,
+			Binding:  ns.Binding,
+		})
+	}
+	for _, nc := range hs.NvmeDevices {
+		dev := &StorageDevice{
+			Type:     StorageDeviceTypeNvme,
+			Address:  nc.PciAddr,
+			Driver:   nc.Driver,
+			Model:    nc.Model,
+			Serial:   nc.Serial,
+			FwRev:    nc.FwRev,
+			Capacity: nc.Capacity(),
+			Binding:  nc.Binding,
+		}
+		if nc.SocketID > 0 {
+			dev.NumaNode = uint32(nc.SocketID)
+		}
+		devs = append(devs, dev)
+	}
+
+	return devs
+}
+
+// UnusedDevices returns the devices in the host storage configuration that are not assigned to
+// any engine.
+func (hs *HostStorage) UnusedDevices() []*StorageDevice {
+	var unused []*StorageDevice
+	for _, dev := range hs.Devices() {
+		if dev.Binding == nil {
+			unused = append(unused, dev)
+		}
+	}
+
+	return unused
+}
+
 type (
 	// StorageScanReq contains the parameters for a storage scan request.
 	StorageScanReq struct {
@@ -179,6 +253,9 @@ type (
 		NvmeHealth bool    `json:"nvme_health"`
 		NvmeBasic  bool    `json:"nvme_basic"`
 		MemRatio   float32 `json:"mem_ratio"`
+		// Bindings requests the driver and engine assignment of each device, including
+		// NVMe devices that are not assigned to any engine.
+		Bindings bool `json:"bindings"`
 	}
 
 	// StorageScanResp contains the response from a storage scan request.
@@ -230,6 +307,12 @@ func (ssp *StorageScanResp) addHostResponse(hr *HostResponse) error {
 		if err := convert.Types(scmResp.GetNamespaces(), &hs.ScmNamespaces); err != nil {
 			return err
 		}
+		if scmResp.GetCapabilities() != nil {
+			hs.ScmCapabilities = new(storage.ScmCapabilities)
+			if err := convert.Types(scmResp.GetCapabilities(), hs.ScmCapabilities); err != nil {
+				return err
+			}
+		}
 	default:
 		pbErrMsg := scmState.GetError()
 		if pbErrMsg == "" {
@@ -281,6 +364,7 @@ func StorageScan(ctx context.Context, rpcClient UnaryInvoker, req *StorageScanRe
 				// Only request link stats if health explicitly requested.
 				LinkStats: req.NvmeHealth,
 			},
+			Bindings: req.Bindings,
 		})
 	})
 
@@ -582,3 +666,319 @@ func StorageNvmeAddDevice(ctx context.Context, rpcClient UnaryInvoker, req *Nvme
 
 	return resp, nil
 }
+
+// NvmeReplaceStage indicates the progress of a guided NVMe SSD replacement.
+type NvmeReplaceStage int32
+
+// NvmeReplaceStage values in the order they are reached.
+const (
+	NvmeReplaceStarted NvmeReplaceStage = iota
+	NvmeReplaceFaulty
+	NvmeReplaceAwaitDevice
+	NvmeReplaceBound
+	NvmeReplaceConfigured
+	NvmeReplaceDone
+)
+
+func (nrs NvmeReplaceStage) String() string {
+	if str, exists := map[NvmeReplaceStage]string{
+		NvmeReplaceStarted:     "started",
+		NvmeReplaceFaulty:      "faulty",
+		NvmeReplaceAwaitDevice: "awaiting-device",
+		NvmeReplaceBound:       "bound",
+		NvmeReplaceConfigured:  "configured",
+		NvmeReplaceDone:        "done",
+	}[nrs]; exists {
+		return str
+	}
+	return fmt.Sprintf("unknown (%d)", nrs)
+}
+
+// MarshalJSON outputs the stage as a string.
+func (nrs NvmeReplaceStage) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + nrs.String() + `"`), nil
+}
+
+type (
+	// NvmeReplaceReq contains the parameters for a guided storage replace request. If
+	// NewPCIAddr is not set, the new SSD is expected in the slot of the old SSD.
+	NvmeReplaceReq struct {
+		unaryRequest
+		OldUUID      string `json:"old_uuid"`
+		NewPCIAddr   string `json:"new_pci_addr"`
+		IdentifyMins uint32 `json:"identify_mins"`
+		Abort        bool   `json:"abort"`
+	}
+
+	// NvmeReplaceResp contains the progress of a guided storage replace request. Info holds
+	// any action required before the replacement can progress.
+	NvmeReplaceResp struct {
+		HostErrorsResp
+		Host       string           `json:"host"`
+		Stage      NvmeReplaceStage `json:"stage"`
+		Rank       ranklist.Rank    `json:"rank"`
+		OldUUID    string           `json:"old_uuid"`
+		OldPCIAddr string           `json:"old_pci_addr"`
+		NewUUID    string           `json:"new_uuid"`
+		NewPCIAddr string           `json:"new_pci_addr"`
+		Info       string           `json:"info"`
+	}
+)
+
+// StorageNvmeReplace replaces a faulty NVMe SSD on a single server, resuming a replacement
+// previously started for the same SSD. The SSD is set faulty and its locate LED blinked, then once
+// the new SSD has been inserted it is bound, added to the engine config and used in place of the
+// old SSD.
+func StorageNvmeReplace(ctx context.Context, rpcClient UnaryInvoker, req *NvmeReplaceReq) (*NvmeReplaceResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if err := checkUUID(req.OldUUID); err != nil {
+		return nil, errors.Wrap(err, "bad device UUID")
+	}
+	if req.NewPCIAddr != "" {
+		if _, err := hardware.NewPCIAddress(req.NewPCIAddr); err != nil {
+			return nil, errors.Wrap(err, "invalid pci address in request")
+		}
+	}
+	if len(req.getHostList()) != 1 {
+		return nil, errors.New("request expects a single host in hostlist")
+	}
+
+	pbReq := &ctlpb.NvmeReplaceReq{
+		OldUuid:      req.OldUUID,
+		NewPciAddr:   req.NewPCIAddr,
+		IdentifyMins: req.IdentifyMins,
+		Abort:        req.Abort,
+	}
+	if pbReq.IdentifyMins == 0 {
+		pbReq.IdentifyMins = DefaultLedIdentifyTimeout
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageNvmeReplace(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(NvmeReplaceResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.NvmeReplaceResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		resp.Host = hostResp.Addr
+		resp.Stage = NvmeReplaceStage(pbResp.Stage)
+		resp.Rank = ranklist.Rank(pbResp.Rank)
+		resp.OldUUID = pbResp.OldUuid
+		resp.OldPCIAddr = pbResp.OldPciAddr
+		resp.NewUUID = pbResp.NewUuid
+		resp.NewPCIAddr = pbResp.NewPciAddr
+		resp.Info = pbResp.Info
+
+		if err := ctlStateToErr(pbResp.GetState()); err != nil {
+			if err := resp.addHostError(hostResp.Addr, err); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+type (
+	// NvmeNsCreateReq contains the parameters for a storage nvme-ns-create request.
+	//
+	// If Size is zero, the unallocated capacity of the SSD is split evenly between the Count
+	// namespaces created.
+	NvmeNsCreateReq struct {
+		unaryRequest
+		PCIAddr string `json:"pci_addr"`
+		Size    uint64 `json:"size"`
+		Count   uint32 `json:"count"`
+	}
+
+	// NvmeNsCreateResp contains the response from a storage nvme-ns-create request.
+	NvmeNsCreateResp struct {
+		HostErrorsResp
+		Namespaces []*storage.NvmeNamespace `json:"namespaces"`
+	}
+)
+
+// StorageNvmeNsCreate creates namespaces on an NVMe SSD on a single server.
+func StorageNvmeNsCreate(ctx context.Context, rpcClient UnaryInvoker, req *NvmeNsCreateReq) (*NvmeNsCreateResp, error) {
+	// validate address in request
+	if _, err := hardware.NewPCIAddress(req.PCIAddr); err != nil {
+		return nil, errors.Wrap(err, "invalid pci address in request")
+	}
+	if req.Count == 0 {
+		return nil, errors.New("number of namespaces to create must be nonzero")
+	}
+
+	pbReq := new(ctlpb.NvmeNsCreateReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageNvmeNsCreate(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(NvmeNsCreateResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.NvmeNsCreateResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		if err := ctlStateToErr(pbResp.GetState()); err != nil {
+			if err := resp.addHostError(hostResp.Addr, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := convert.Types(pbResp.GetNamespaces(), &resp.Namespaces); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+type (
+	// NvmeNsDeleteReq contains the parameters for a storage nvme-ns-delete request.
+	NvmeNsDeleteReq struct {
+		unaryRequest
+		PCIAddr string `json:"pci_addr"`
+		NsID    uint32 `json:"ns_id"`
+	}
+
+	// NvmeNsDeleteResp contains the response from a storage nvme-ns-delete request.
+	NvmeNsDeleteResp struct {
+		HostErrorsResp
+	}
+)
+
+// StorageNvmeNsDelete deletes a namespace on an NVMe SSD on a single server.
+func StorageNvmeNsDelete(ctx context.Context, rpcClient UnaryInvoker, req *NvmeNsDeleteReq) (*NvmeNsDeleteResp, error) {
+	// validate address in request
+	if _, err := hardware.NewPCIAddress(req.PCIAddr); err != nil {
+		return nil, errors.Wrap(err, "invalid pci address in request")
+	}
+	if req.NsID == 0 {
+		return nil, errors.New("namespace id must be nonzero")
+	}
+
+	pbReq := new(ctlpb.NvmeNsDeleteReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageNvmeNsDelete(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(NvmeNsDeleteResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.NvmeNsDeleteResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		if err := ctlStateToErr(pbResp.GetState()); err != nil {
+			if err := resp.addHostError(hostResp.Addr, err); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+type (
+	// StorageRenderConfigReq contains the parameters for a storage render-config request.
+	StorageRenderConfigReq struct {
+		unaryRequest
+		EngineIdx uint32 `json:"engine_idx"`
+	}
+
+	// StorageRenderConfigResp contains the response from a storage render-config request.
+	StorageRenderConfigResp struct {
+		HostErrorsResp
+		Config string `json:"config"`
+	}
+)
+
+// StorageRenderConfig returns the SPDK JSON config that would be written for an engine on a single
+// server. Nothing is written on the server.
+func StorageRenderConfig(ctx context.Context, rpcClient UnaryInvoker, req *StorageRenderConfigReq) (*StorageRenderConfigResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := new(ctlpb.StorageRenderConfigReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, err
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).StorageRenderConfig(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(StorageRenderConfigResp)
+	for _, hostResp := range ur.Responses {
+		if hostResp.Error != nil {
+			if err := resp.addHostError(hostResp.Addr, hostResp.Error); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		pbResp, ok := hostResp.Message.(*ctlpb.StorageRenderConfigResp)
+		if !ok {
+			return nil, errors.Errorf("unable to unpack message: %+v", hostResp.Message)
+		}
+		if err := ctlStateToErr(pbResp.GetState()); err != nil {
+			if err := resp.addHostError(hostResp.Addr, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		resp.Config = pbResp.GetConfig()
+	}
+
+	return resp, nil
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -167,6 +168,59 @@ func TestControl_StorageMap(t *testing.T) {
 	}
 }
 
+func TestControl_HostStorage_UnusedDevices(t *testing.T) {
+	usedNvme := storage.MockNvmeController(1)
+	usedNvme.Driver = "vfio-pci"
+	usedNvme.Binding = &storage.DeviceBinding{EngineIdx: 0, TierIdx: 1, Class: storage.ClassNvme}
+	unusedNvme := storage.MockNvmeController(2)
+	unusedNvme.Driver = "nvme"
+	usedScm := storage.MockScmNamespace(0)
+	usedScm.Binding = &storage.DeviceBinding{EngineIdx: 0, TierIdx: 0, Class: storage.ClassDcpm}
+	unusedScm := storage.MockScmNamespace(1)
+
+	for name, tc := range map[string]struct {
+		hs        *HostStorage
+		expUnused []*StorageDevice
+	}{
+		"nil host storage": {},
+		"all devices used": {
+			hs: &HostStorage{
+				NvmeDevices:   storage.NvmeControllers{usedNvme},
+				ScmNamespaces: storage.ScmNamespaces{usedScm},
+			},
+		},
+		"unused devices": {
+			hs: &HostStorage{
+				NvmeDevices:   storage.NvmeControllers{usedNvme, unusedNvme},
+				ScmNamespaces: storage.ScmNamespaces{usedScm, unusedScm},
+			},
+			expUnused: []*StorageDevice{
+				{
+					Type:     StorageDeviceTypeScm,
+					Address:  "pmem1",
+					NumaNode: 1,
+					Capacity: unusedScm.Size,
+				},
+				{
+					Type:     StorageDeviceTypeNvme,
+					Address:  unusedNvme.PciAddr,
+					Driver:   "nvme",
+					Model:    unusedNvme.Model,
+					Serial:   unusedNvme.Serial,
+					FwRev:    unusedNvme.FwRev,
+					Capacity: unusedNvme.Capacity(),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expUnused, tc.hs.UnusedDevices()); diff != "" {
+				t.Fatalf("unexpected unused devices (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_StorageScan(t *testing.T) {
 	var (
 		standard       = MockServerScanResp(t, "standard")
@@ -1032,3 +1086,410 @@ func TestControl_StorageNvmeAddDevice(t *testing.T) {
 		})
 	}
 }
+
+func TestControl_StorageNvmeNsCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		pciAddr     string
+		count       uint32
+		expResponse *NvmeNsCreateResp
+		expErr      error
+	}{
+		"invalid pci address": {
+			pciAddr: "ZZZZ:MM:NN.O",
+			count:   1,
+			expErr:  errors.New("invalid pci address"),
+		},
+		"zero count": {
+			pciAddr: test.MockPCIAddr(),
+			expErr:  errors.New("must be nonzero"),
+		},
+		"invoke fails": {
+			mic: &MockInvokerConfig{
+				UnaryError: errors.New("failed"),
+			},
+			pciAddr: test.MockPCIAddr(),
+			count:   1,
+			expErr:  errors.New("failed"),
+		},
+		"server error": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr:  "host1",
+								Error: errors.New("failed"),
+							},
+						},
+					},
+				},
+			},
+			pciAddr: test.MockPCIAddr(),
+			count:   1,
+			expResponse: &NvmeNsCreateResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "failed"}),
+			},
+		},
+		"create failed": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.NvmeNsCreateResp{
+									State: &ctlpb.ResponseState{
+										Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+										Error:  "no space",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pciAddr: test.MockPCIAddr(),
+			count:   1,
+			expResponse: &NvmeNsCreateResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "no space"}),
+			},
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.NvmeNsCreateResp{
+									Namespaces: []*ctlpb.NvmeController_Namespace{
+										{Id: 1, Size: humanize.TByte},
+										{Id: 2, Size: humanize.TByte},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pciAddr: test.MockPCIAddr(),
+			count:   2,
+			expResponse: &NvmeNsCreateResp{
+				Namespaces: []*storage.NvmeNamespace{
+					{ID: 1, Size: humanize.TByte},
+					{ID: 2, Size: humanize.TByte},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := StorageNvmeNsCreate(ctx, mi, &NvmeNsCreateReq{
+				PCIAddr: tc.pciAddr,
+				Count:   tc.count,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_StorageNvmeNsDelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		pciAddr     string
+		nsID        uint32
+		expResponse *NvmeNsDeleteResp
+		expErr      error
+	}{
+		"invalid pci address": {
+			pciAddr: "ZZZZ:MM:NN.O",
+			nsID:    1,
+			expErr:  errors.New("invalid pci address"),
+		},
+		"zero namespace id": {
+			pciAddr: test.MockPCIAddr(),
+			expErr:  errors.New("must be nonzero"),
+		},
+		"delete failed": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.NvmeNsDeleteResp{
+									State: &ctlpb.ResponseState{
+										Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+										Error:  "not found",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			pciAddr: test.MockPCIAddr(),
+			nsID:    1,
+			expResponse: &NvmeNsDeleteResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "not found"}),
+			},
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr:    "host1",
+								Message: &ctlpb.NvmeNsDeleteResp{},
+							},
+						},
+					},
+				},
+			},
+			pciAddr:     test.MockPCIAddr(),
+			nsID:        1,
+			expResponse: &NvmeNsDeleteResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := StorageNvmeNsDelete(ctx, mi, &NvmeNsDeleteReq{
+				PCIAddr: tc.pciAddr,
+				NsID:    tc.nsID,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_StorageRenderConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		req         *StorageRenderConfigReq
+		expResponse *StorageRenderConfigResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"render failed": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.StorageRenderConfigResp{
+									State: &ctlpb.ResponseState{
+										Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+										Error:  "scan failed",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			req: &StorageRenderConfigReq{},
+			expResponse: &StorageRenderConfigResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "scan failed"}),
+			},
+		},
+		"success": {
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					{
+						Responses: []*HostResponse{
+							{
+								Addr: "host1",
+								Message: &ctlpb.StorageRenderConfigResp{
+									Config: `{"subsystems": []}`,
+								},
+							},
+						},
+					},
+				},
+			},
+			req: &StorageRenderConfigReq{EngineIdx: 1},
+			expResponse: &StorageRenderConfigResp{
+				Config: `{"subsystems": []}`,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			mi := NewMockInvoker(log, tc.mic)
+
+			gotResponse, gotErr := StorageRenderConfig(ctx, mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_StorageNvmeReplace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mic         *MockInvokerConfig
+		req         *NvmeReplaceReq
+		hosts       []string
+		expResponse *NvmeReplaceResp
+		expErr      error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"invalid uuid": {
+			req:    &NvmeReplaceReq{OldUUID: "foo"},
+			hosts:  []string{"host1"},
+			expErr: errors.New("bad device UUID"),
+		},
+		"invalid pci address": {
+			req: &NvmeReplaceReq{
+				OldUUID:    test.MockUUID(1),
+				NewPCIAddr: "ZZZZ:MM:NN.O",
+			},
+			hosts:  []string{"host1"},
+			expErr: errors.New("invalid pci address"),
+		},
+		"multiple hosts": {
+			req:    &NvmeReplaceReq{OldUUID: test.MockUUID(1)},
+			hosts:  []string{"host1", "host2"},
+			expErr: errors.New("single host"),
+		},
+		"server error": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("failed"), nil),
+			},
+			req:   &NvmeReplaceReq{OldUUID: test.MockUUID(1)},
+			hosts: []string{"host1"},
+			expResponse: &NvmeReplaceResp{
+				HostErrorsResp: MockHostErrorsResp(t, &MockHostError{"host1", "failed"}),
+			},
+		},
+		"stage failed": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.NvmeReplaceResp{
+					State: &ctlpb.ResponseState{
+						Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+						Error:  "set-faulty failed",
+					},
+					OldUuid: test.MockUUID(1),
+				}),
+			},
+			req:   &NvmeReplaceReq{OldUUID: test.MockUUID(1)},
+			hosts: []string{"host1"},
+			expResponse: &NvmeReplaceResp{
+				HostErrorsResp: MockHostErrorsResp(t,
+					&MockHostError{"host1", "set-faulty failed"}),
+				Host:    "host1",
+				Stage:   NvmeReplaceStarted,
+				OldUUID: test.MockUUID(1),
+			},
+		},
+		"awaiting device": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.NvmeReplaceResp{
+					Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE,
+					Rank:       1,
+					OldUuid:    test.MockUUID(1),
+					OldPciAddr: test.MockPCIAddr(1),
+					NewPciAddr: test.MockPCIAddr(2),
+					Info:       "insert new SSD",
+				}),
+			},
+			req: &NvmeReplaceReq{
+				OldUUID:    test.MockUUID(1),
+				NewPCIAddr: test.MockPCIAddr(2),
+			},
+			hosts: []string{"host1"},
+			expResponse: &NvmeReplaceResp{
+				Host:       "host1",
+				Stage:      NvmeReplaceAwaitDevice,
+				Rank:       1,
+				OldUUID:    test.MockUUID(1),
+				OldPCIAddr: test.MockPCIAddr(1),
+				NewPCIAddr: test.MockPCIAddr(2),
+				Info:       "insert new SSD",
+			},
+		},
+		"done": {
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &ctlpb.NvmeReplaceResp{
+					Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE,
+					OldUuid:    test.MockUUID(1),
+					OldPciAddr: test.MockPCIAddr(1),
+					NewUuid:    test.MockUUID(2),
+					NewPciAddr: test.MockPCIAddr(1),
+				}),
+			},
+			req: &NvmeReplaceReq{
+				OldUUID:      test.MockUUID(1),
+				IdentifyMins: 10,
+			},
+			hosts: []string{"host1"},
+			expResponse: &NvmeReplaceResp{
+				Host:       "host1",
+				Stage:      NvmeReplaceDone,
+				OldUUID:    test.MockUUID(1),
+				OldPCIAddr: test.MockPCIAddr(1),
+				NewUUID:    test.MockUUID(2),
+				NewPCIAddr: test.MockPCIAddr(1),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, tc.mic)
+			if tc.req != nil {
+				tc.req.SetHostList(tc.hosts)
+			}
+
+			gotResponse, gotErr := StorageNvmeReplace(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, gotResponse, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
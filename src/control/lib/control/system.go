@@ -25,6 +25,7 @@ import (
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -40,6 +41,9 @@ const (
 	// SystemJoinRetryTimeout defines the amount of time a retry attempt can take. It
 	// should be set low in order to ensure that individual join attempts retry quickly.
 	SystemJoinRetryTimeout = 10 * time.Second
+	// DefaultSystemDrainTimeout defines the amount of time a system stop with drain will wait
+	// for in-flight I/O to complete on each rank before stopping it.
+	DefaultSystemDrainTimeout = 2 * time.Minute
 )
 
 var (
@@ -447,13 +451,16 @@ type SystemStopReq struct {
 	sysRequest
 	Force               bool
 	Full                bool
-	IgnoreAdminExcluded bool // Ignore any ranks in the rank/host list in the AdminExcluded state
+	IgnoreAdminExcluded bool          // Ignore any ranks in the rank/host list in the AdminExcluded state
+	Drain               bool          // Drain ranks of pool connections and in-flight I/O before stop
+	DrainTimeout        time.Duration // Time to wait for in-flight I/O to complete when draining
 }
 
 // SystemStopResp contains the request response.
 type SystemStopResp struct {
-	sysResponse `json:"-"`
-	Results     system.MemberResults
+	sysResponse  `json:"-"`
+	Results      system.MemberResults
+	DrainResults system.MemberResults `json:"drain_results,omitempty"`
 }
 
 // UnmarshalJSON unpacks JSON message into SystemStopResp struct.
@@ -504,14 +511,27 @@ func SystemStop(ctx context.Context, rpcClient UnaryInvoker, req *SystemStopReq)
 	if req.Full && req.Ranks.String() != "" {
 		return nil, errors.New("full and ranks options may not be mixed")
 	}
+	if req.Force && req.Drain {
+		return nil, errors.New("force and drain options may not be mixed")
+	}
 
 	pbReq := &mgmtpb.SystemStopReq{
 		Hosts:               req.Hosts.String(),
 		Ranks:               req.Ranks.String(),
 		Sys:                 req.getSystem(rpcClient),
-		Force:               !req.Full, // Force used unless full graceful shutdown requested.
+		Force:               req.Force,
 		IgnoreAdminExcluded: req.IgnoreAdminExcluded,
 	}
+	if req.Drain {
+		drainTimeout := req.DrainTimeout
+		if drainTimeout == 0 {
+			drainTimeout = DefaultSystemDrainTimeout
+		}
+		pbReq.Drain = true
+		pbReq.DrainTimeout = uint32(drainTimeout.Seconds())
+		// Allow for the time spent waiting on ranks to drain.
+		req.SetTimeout(defaultRequestTimeout + drainTimeout)
+	}
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemStop(ctx, pbReq)
 	})
@@ -667,35 +687,67 @@ func SystemDrain(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainRe
 	return resp, convertMSResponse(ur, resp)
 }
 
-// SystemEraseReq contains the inputs for a system erase request.
+// SystemEraseReq contains the inputs for a system erase request. If ranks or hosts are set, only
+// the selected ranks are erased.
 type SystemEraseReq struct {
 	msRequest
 	unaryRequest
 	retryableRequest
+	sysRequest
+}
+
+// IsSelective returns true if the request erases a subset of the system.
+func (req *SystemEraseReq) IsSelective() bool {
+	return req.Ranks.Count() > 0 || req.Hosts.Count() > 0
 }
 
 // SystemEraseResp contains the results of a system erase request.
 type SystemEraseResp struct {
 	HostErrorsResp
-	Results system.MemberResults
+	sysResponse `json:"-"`
+	Results     system.MemberResults
+}
+
+// UnmarshalJSON unpacks JSON message into SystemEraseResp struct.
+func (resp *SystemEraseResp) UnmarshalJSON(data []byte) error {
+	type Alias SystemEraseResp
+	aux := &struct{ *Alias }{Alias: (*Alias)(resp)}
+
+	// Use type alias to avoid recursive decode issues.
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if err := unmarshalSysRespJsonFields(data, &resp.sysResponse); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// Errors returns error if any of the results indicate a failure.
+// Errors returns error if any of the results indicate a failure or if any of the requested ranks
+// or hosts are missing from the system.
 func (resp *SystemEraseResp) Errors() error {
-	if resp == nil || resp.Results == nil {
+	if resp == nil {
 		return nil
 	}
-	return resp.Results.Errors()
+	var errResults error
+	if resp.Results != nil {
+		errResults = resp.Results.Errors()
+	}
+	return concatErrs(resp.getAbsentHostsRanksErrors(), errResults)
 }
 
 // checkSystemErase queries system to interrogate membership before deciding
 // whether a system erase is appropriate.
-func checkSystemErase(ctx context.Context, rpcClient UnaryInvoker) error {
-	resp, err := SystemQuery(ctx, rpcClient, &SystemQueryReq{FailOnUnavailable: true})
+func checkSystemErase(ctx context.Context, rpcClient UnaryInvoker, req *SystemEraseReq) error {
+	queryReq := &SystemQueryReq{FailOnUnavailable: true}
+	queryReq.Ranks.Replace(&req.Ranks)
+	queryReq.Hosts.Replace(&req.Hosts)
+	resp, err := SystemQuery(ctx, rpcClient, queryReq)
 	if err != nil {
 		// If the AP hasn't been started, it will respond as if it
 		// is not a replica.
-		if system.IsNotReplica(err) || system.IsUnavailable(err) {
+		if !req.IsSelective() && (system.IsNotReplica(err) || system.IsUnavailable(err)) {
 			return nil
 		}
 		return errors.Wrap(err, "System-Query command failed")
@@ -724,18 +776,23 @@ func checkSystemErase(ctx context.Context, rpcClient UnaryInvoker) error {
 	return nil
 }
 
-// SystemErase initiates a wipe of system metadata prior to reformatting storage.
+// SystemErase initiates a wipe of system metadata prior to reformatting storage. A selective
+// erase removes the superblocks and SCM contents of the selected ranks, leaving the rest of the
+// system and the MS database intact.
 func SystemErase(ctx context.Context, rpcClient UnaryInvoker, req *SystemEraseReq) (*SystemEraseResp, error) {
 	if req == nil {
 		return nil, errors.Errorf("nil %T request", req)
 	}
 
-	if err := checkSystemErase(ctx, rpcClient); err != nil {
+	if err := checkSystemErase(ctx, rpcClient, req); err != nil {
 		return nil, err
 	}
 
-	pbReq := new(mgmtpb.SystemEraseReq)
-	pbReq.Sys = req.getSystem(rpcClient)
+	pbReq := &mgmtpb.SystemEraseReq{
+		Sys:   req.getSystem(rpcClient),
+		Ranks: req.Ranks.String(),
+		Hosts: req.Hosts.String(),
+	}
 
 	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
 		return mgmtpb.NewMgmtSvcClient(conn).SystemErase(ctx, pbReq)
@@ -846,6 +903,8 @@ type RanksReq struct {
 	Ranks        string `json:"ranks"`
 	Force        bool   `json:"force"`
 	CheckMode    bool   `json:"check_mode"`
+	DrainTimeout uint32 `json:"drain_timeout"` // seconds
+	WipeScm      bool   `json:"wipe_scm"`
 }
 
 func (r *RanksReq) reportResponse(resp *HostResponse) {
@@ -937,6 +996,26 @@ func PrepShutdownRanks(ctx context.Context, rpcClient UnaryInvoker, req *RanksRe
 	return invokeRPCFanout(ctx, rpcClient, req)
 }
 
+// DrainRanks concurrently drains ranks of pool connections and in-flight I/O across all hosts
+// supplied in the request's hostlist.
+//
+// This is called from SystemStop in server/mgmt_system.go with a populated host list in the
+// request parameter and blocks until all results (successful or otherwise) are received after
+// invoking fan-out. Returns a single response structure containing results generated with
+// request responses from each selected rank.
+func DrainRanks(ctx context.Context, rpcClient UnaryInvoker, req *RanksReq) (*RanksResp, error) {
+	pbReq := new(ctlpb.RanksReq)
+	if err := convert.Types(req, pbReq); err != nil {
+		return nil, errors.Wrapf(err, "convert request type %T->%T", req, pbReq)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return ctlpb.NewCtlSvcClient(conn).DrainRanks(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system drain-ranks request: %s", pbUtil.Debug(pbReq))
+	return invokeRPCFanout(ctx, rpcClient, req)
+}
+
 // StopRanks concurrently performs stop ranks across all hosts supplied in the
 // request's hostlist.
 //
@@ -1135,6 +1214,150 @@ func SystemGetAttr(ctx context.Context, rpcClient UnaryInvoker, req *SystemGetAt
 	return resp, convertMSResponse(ur, resp)
 }
 
+type (
+	// SystemEventsQueryReq contains the inputs for the system events query request.
+	SystemEventsQueryReq struct {
+		unaryRequest
+		msRequest
+		MinSeverity string
+		Ranks       *ranklist.RankSet
+		Since       time.Time
+		AfterSeq    uint64
+		MaxEvents   uint32
+	}
+
+	// SystemEvent is a RAS event retained by the MS.
+	SystemEvent struct {
+		Seq   uint64           `json:"seq"`
+		Event *events.RASEvent `json:"event"`
+	}
+
+	// SystemEventsQueryResp contains the request response.
+	SystemEventsQueryResp struct {
+		Events  []*SystemEvent `json:"events"`
+		LastSeq uint64         `json:"last_seq"`
+	}
+)
+
+// SystemEventsQuery returns the most recent RAS events retained by the MS that match the
+// request filters, oldest first.
+func SystemEventsQuery(ctx context.Context, rpcClient UnaryInvoker, req *SystemEventsQueryReq) (*SystemEventsQueryResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemEventsQueryReq{
+		Sys:         req.getSystem(rpcClient),
+		MinSeverity: req.MinSeverity,
+		Ranks:       req.Ranks.String(),
+		AfterSeq:    req.AfterSeq,
+		MaxEvents:   req.MaxEvents,
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = req.Since.Format(time.RFC3339)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemEventsQuery(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS SystemEventsQuery request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		if IsConnErr(err) {
+			return nil, errors.Wrap(errMSConnectionFailure, err.Error())
+		}
+		return nil, err
+	}
+	pbResp, ok := msResp.(*mgmtpb.SystemEventsQueryResp)
+	if !ok {
+		return nil, errors.New("unable to extract SystemEventsQueryResp from MS response")
+	}
+
+	resp := &SystemEventsQueryResp{LastSeq: pbResp.LastSeq}
+	for _, pbEvt := range pbResp.Events {
+		evt, err := events.NewFromProto(pbEvt.Event)
+		if err != nil {
+			return nil, errors.Wrapf(err, "convert event %d", pbEvt.Seq)
+		}
+		resp.Events = append(resp.Events, &SystemEvent{Seq: pbEvt.Seq, Event: evt})
+	}
+
+	return resp, nil
+}
+
+type (
+	// SystemCrashReportsReq contains the inputs for the system crash reports request.
+	SystemCrashReportsReq struct {
+		unaryRequest
+		msRequest
+		Ranks      *ranklist.RankSet
+		MaxReports int
+	}
+
+	// SystemCrashReport describes an engine crash report written by a server and referenced
+	// by a RAS event retained by the MS.
+	SystemCrashReport struct {
+		Timestamp   string        `json:"timestamp"`
+		Hostname    string        `json:"hostname"`
+		Rank        ranklist.Rank `json:"rank"`
+		Incarnation uint64        `json:"incarnation"`
+		PID         int           `json:"pid"`
+		events.EngineCrashInfo
+	}
+
+	// SystemCrashReportsResp contains the request response.
+	SystemCrashReportsResp struct {
+		Reports []*SystemCrashReport `json:"crash_reports"`
+	}
+)
+
+// SystemCrashReports returns the most recent engine crash reports in the system, oldest first.
+// The reports are found from the engine_crash_reported RAS events retained by the MS, so crash
+// reports written after those events were discarded are not returned.
+func SystemCrashReports(ctx context.Context, rpcClient UnaryInvoker, req *SystemCrashReportsReq) (*SystemCrashReportsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	evtReq := &SystemEventsQueryReq{Ranks: req.Ranks}
+	evtReq.request = req.request
+	evtResp, err := SystemEventsQuery(ctx, rpcClient, evtReq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemCrashReportsResp)
+	for _, se := range evtResp.Events {
+		if se.Event.ID != events.RASEngineCrashReported {
+			continue
+		}
+		info, err := se.Event.GetEngineCrashInfo()
+		if err != nil {
+			rpcClient.Debugf("skipping crash report event %d: %s", se.Seq, err)
+			continue
+		}
+		resp.Reports = append(resp.Reports, &SystemCrashReport{
+			Timestamp:       se.Event.Timestamp,
+			Hostname:        se.Event.Hostname,
+			Rank:            ranklist.Rank(se.Event.Rank),
+			Incarnation:     se.Event.Incarnation,
+			PID:             se.Event.ProcID,
+			EngineCrashInfo: *info,
+		})
+	}
+
+	if req.MaxReports > 0 && len(resp.Reports) > req.MaxReports {
+		resp.Reports = resp.Reports[len(resp.Reports)-req.MaxReports:]
+	}
+
+	return resp, nil
+}
+
 // SystemSetPropReq contains the inputs for the system set-prop request.
 type SystemSetPropReq struct {
 	unaryRequest
@@ -0,0 +1,407 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	// DefaultRollingRestartPollInterval is the interval between system and pool queries made
+	// while waiting for a restarted batch of ranks to settle.
+	DefaultRollingRestartPollInterval = 5 * time.Second
+	// DefaultRollingRestartSettleTimeout is the maximum time to wait for a restarted batch of
+	// ranks to rejoin the system and for pool rebuilds to complete.
+	DefaultRollingRestartSettleTimeout = 30 * time.Minute
+)
+
+// RollingRestartStage indicates the progress of a batch in a rolling restart.
+type RollingRestartStage string
+
+// RollingRestartStage values.
+const (
+	RollingRestartStopping      RollingRestartStage = "stopping"
+	RollingRestartStarting      RollingRestartStage = "starting"
+	RollingRestartJoining       RollingRestartStage = "waiting for ranks to join"
+	RollingRestartReintegrating RollingRestartStage = "reintegrating"
+	RollingRestartSettling      RollingRestartStage = "waiting for pools to settle"
+	RollingRestartDone          RollingRestartStage = "done"
+	RollingRestartFailed        RollingRestartStage = "failed"
+)
+
+type (
+	// SystemRollingRestartReq contains the parameters for a rolling restart of system ranks.
+	SystemRollingRestartReq struct {
+		sysRequest
+		BatchSize       uint                       // Fault domains restarted together, 1 if unset.
+		AbortOnDegraded bool                       // Stop if any pool is degraded after a batch settles.
+		SettleTimeout   time.Duration              // DefaultRollingRestartSettleTimeout if unset.
+		PollInterval    time.Duration              // DefaultRollingRestartPollInterval if unset.
+		OnUpdate        func(*RollingRestartBatch) `json:"-"` // Called on each stage change, may be nil.
+	}
+
+	// RollingRestartBatch describes a set of fault domains whose ranks were restarted together.
+	RollingRestartBatch struct {
+		FaultDomains  []string            `json:"fault_domains"`
+		Ranks         *ranklist.RankSet   `json:"ranks"`
+		Stage         RollingRestartStage `json:"stage"`
+		DegradedPools []string            `json:"degraded_pools,omitempty"`
+		Error         string              `json:"error,omitempty"`
+	}
+
+	// SystemRollingRestartResp contains the outcome of each attempted batch, in order, and the
+	// ranks that were not restarted because the rolling restart was aborted.
+	SystemRollingRestartResp struct {
+		Batches      []*RollingRestartBatch `json:"batches"`
+		NotRestarted *ranklist.RankSet      `json:"not_restarted"`
+	}
+)
+
+// Errors returns the error that caused the rolling restart to be aborted, if any.
+func (resp *SystemRollingRestartResp) Errors() error {
+	for _, b := range resp.Batches {
+		if b.Error != "" {
+			return errors.Errorf("restart of ranks %s failed: %s", b.Ranks, b.Error)
+		}
+	}
+
+	return nil
+}
+
+// getRollingRestartBatches groups the joined ranks selected by the request by fault domain and
+// returns them in batches of the requested number of fault domains.
+func getRollingRestartBatches(members system.Members, batchSize uint) ([]*RollingRestartBatch, error) {
+	domainRanks := make(map[string]*ranklist.RankSet)
+	notJoined := ranklist.NewRankSet()
+	for _, m := range members {
+		switch m.State {
+		case system.MemberStateJoined:
+		case system.MemberStateAdminExcluded:
+			continue
+		default:
+			notJoined.Add(m.Rank)
+			continue
+		}
+
+		fd := m.FaultDomain.String()
+		if _, exists := domainRanks[fd]; !exists {
+			domainRanks[fd] = ranklist.NewRankSet()
+		}
+		domainRanks[fd].Add(m.Rank)
+	}
+	if notJoined.Count() > 0 {
+		return nil, errors.Errorf("ranks %s are not joined", notJoined)
+	}
+	if len(domainRanks) == 0 {
+		return nil, errors.New("no joined ranks found to restart")
+	}
+
+	domains := make([]string, 0, len(domainRanks))
+	for fd := range domainRanks {
+		domains = append(domains, fd)
+	}
+	sort.Strings(domains)
+
+	var batches []*RollingRestartBatch
+	for len(domains) > 0 {
+		n := int(batchSize)
+		if n > len(domains) {
+			n = len(domains)
+		}
+
+		batch := &RollingRestartBatch{
+			FaultDomains: domains[:n],
+			Ranks:        ranklist.NewRankSet(),
+		}
+		for _, fd := range batch.FaultDomains {
+			batch.Ranks.Merge(domainRanks[fd])
+		}
+		batches = append(batches, batch)
+		domains = domains[n:]
+	}
+
+	return batches, nil
+}
+
+// rollingRestart holds the state shared by the stages of a rolling restart.
+type rollingRestart struct {
+	rpcClient UnaryInvoker
+	req       *SystemRollingRestartReq
+	interval  time.Duration
+	timeout   time.Duration
+}
+
+func (rr *rollingRestart) update(batch *RollingRestartBatch, stage RollingRestartStage) {
+	batch.Stage = stage
+	if rr.req.OnUpdate != nil {
+		rr.req.OnUpdate(batch)
+	}
+}
+
+// poll calls the check function at the configured interval until it returns true, an error or
+// the deadline passes.
+func (rr *rollingRestart) poll(ctx context.Context, deadline time.Time, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		if !time.Now().Before(deadline) {
+			return errors.New("timed out")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rr.interval):
+		}
+	}
+}
+
+// waitJoined waits for all ranks in the batch to rejoin the system.
+func (rr *rollingRestart) waitJoined(ctx context.Context, deadline time.Time, ranks *ranklist.RankSet) error {
+	pending := ranks
+	err := rr.poll(ctx, deadline, func() (bool, error) {
+		req := &SystemQueryReq{}
+		req.Ranks.Replace(ranks)
+		resp, err := SystemQuery(ctx, rr.rpcClient, req)
+		if err != nil {
+			return false, err
+		}
+
+		pending = ranklist.NewRankSet()
+		for _, m := range resp.Members {
+			if m.State != system.MemberStateJoined {
+				pending.Add(m.Rank)
+			}
+		}
+		return pending.Count() == 0, nil
+	})
+
+	return errors.Wrapf(err, "waiting for ranks %s to join", pending)
+}
+
+// listPools queries all pools in the system, returning an error if any of the queries fail.
+func (rr *rollingRestart) listPools(ctx context.Context) ([]*daos.PoolInfo, error) {
+	req := &ListPoolsReq{}
+	resp, err := ListPools(ctx, rr.rpcClient, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := resp.Errors(); err != nil {
+		return nil, err
+	}
+
+	return resp.Pools, nil
+}
+
+// reintegrate reintegrates the batch's ranks into any pools that they were excluded from while
+// stopped.
+func (rr *rollingRestart) reintegrate(ctx context.Context, ranks *ranklist.RankSet) error {
+	pools, err := rr.listPools(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pools {
+		if p.DisabledRanks == nil {
+			continue
+		}
+
+		var disabled []ranklist.Rank
+		for _, r := range p.DisabledRanks.Ranks() {
+			if ranks.Contains(r) {
+				disabled = append(disabled, r)
+			}
+		}
+		if len(disabled) == 0 {
+			continue
+		}
+
+		req := &PoolRanksReq{ID: p.UUID.String(), Ranks: disabled}
+		resp, err := PoolReintegrate(ctx, rr.rpcClient, req)
+		if err != nil {
+			return err
+		}
+		if err := resp.Errors(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitSettled waits for rebuilds to complete on all pools and returns the names of any pools
+// that remain degraded.
+func (rr *rollingRestart) waitSettled(ctx context.Context, deadline time.Time) ([]string, error) {
+	var degraded, rebuilding []string
+	var queryErr error
+	err := rr.poll(ctx, deadline, func() (bool, error) {
+		var pools []*daos.PoolInfo
+		pools, queryErr = rr.listPools(ctx)
+		if queryErr != nil {
+			// Pool services may be unavailable while their replicas restart.
+			rebuilding = nil
+			return false, nil
+		}
+
+		degraded, rebuilding = nil, nil
+		for _, p := range pools {
+			if p.State == daos.PoolServiceStateTargetsExcluded || p.DisabledTargets > 0 {
+				degraded = append(degraded, p.Name())
+			}
+			if p.Rebuild != nil && p.Rebuild.State == daos.PoolRebuildStateBusy {
+				rebuilding = append(rebuilding, p.Name())
+			}
+		}
+		return len(rebuilding) == 0, nil
+	})
+	if err != nil {
+		switch {
+		case len(rebuilding) > 0:
+			return nil, errors.Wrapf(err, "waiting for rebuild of pools %s",
+				strings.Join(rebuilding, ","))
+		case queryErr != nil:
+			return nil, errors.Wrapf(err, "waiting for pools to settle (%s)", queryErr)
+		}
+		return nil, errors.Wrap(err, "waiting for pools to settle")
+	}
+
+	return degraded, nil
+}
+
+// restartBatch stops and starts the batch's ranks then waits for them and the pools they host
+// to recover.
+func (rr *rollingRestart) restartBatch(ctx context.Context, batch *RollingRestartBatch) error {
+	rr.update(batch, RollingRestartStopping)
+	stopReq := &SystemStopReq{}
+	stopReq.Ranks.Replace(batch.Ranks)
+	stopResp, err := SystemStop(ctx, rr.rpcClient, stopReq)
+	if err == nil {
+		err = stopResp.Errors()
+	}
+	if err != nil {
+		return errors.Wrap(err, "stop")
+	}
+
+	rr.update(batch, RollingRestartStarting)
+	startReq := &SystemStartReq{}
+	startReq.Ranks.Replace(batch.Ranks)
+	startResp, err := SystemStart(ctx, rr.rpcClient, startReq)
+	if err == nil {
+		err = startResp.Errors()
+	}
+	if err != nil {
+		return errors.Wrap(err, "start")
+	}
+
+	deadline := time.Now().Add(rr.timeout)
+
+	rr.update(batch, RollingRestartJoining)
+	if err := rr.waitJoined(ctx, deadline, batch.Ranks); err != nil {
+		return err
+	}
+
+	rr.update(batch, RollingRestartReintegrating)
+	if err := rr.reintegrate(ctx, batch.Ranks); err != nil {
+		return errors.Wrap(err, "reintegrate")
+	}
+
+	rr.update(batch, RollingRestartSettling)
+	degraded, err := rr.waitSettled(ctx, deadline)
+	if err != nil {
+		return err
+	}
+	batch.DegradedPools = degraded
+	if len(degraded) > 0 && rr.req.AbortOnDegraded {
+		return errors.Errorf("pools %s degraded", strings.Join(degraded, ","))
+	}
+
+	return nil
+}
+
+// SystemRollingRestart restarts the selected ranks, or all ranks in the system, one batch of
+// fault domains at a time. After each batch is restarted, the ranks are reintegrated into any
+// pools they were excluded from and pool rebuilds are allowed to complete before the next batch
+// is started. The rolling restart is aborted if a batch fails to restart or settle, or if pools
+// are left degraded and AbortOnDegraded is set.
+//
+// An error is returned if the rolling restart could not be started, otherwise the response
+// contains the outcome of each attempted batch.
+func SystemRollingRestart(ctx context.Context, rpcClient UnaryInvoker, req *SystemRollingRestartReq) (*SystemRollingRestartResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	rr := &rollingRestart{
+		rpcClient: rpcClient,
+		req:       req,
+		interval:  req.PollInterval,
+		timeout:   req.SettleTimeout,
+	}
+	if rr.interval == 0 {
+		rr.interval = DefaultRollingRestartPollInterval
+	}
+	if rr.timeout == 0 {
+		rr.timeout = DefaultRollingRestartSettleTimeout
+	}
+	batchSize := req.BatchSize
+	if batchSize == 0 {
+		batchSize = 1
+	}
+
+	queryReq := &SystemQueryReq{}
+	queryReq.Ranks.Replace(&req.Ranks)
+	queryReq.Hosts.Replace(&req.Hosts)
+	queryResp, err := SystemQuery(ctx, rpcClient, queryReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := queryResp.Errors(); err != nil {
+		return nil, err
+	}
+
+	batches, err := getRollingRestartBatches(queryResp.Members, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allow any rebuild in progress to complete before restarting the first batch.
+	degraded, err := rr.waitSettled(ctx, time.Now().Add(rr.timeout))
+	if err != nil {
+		return nil, err
+	}
+	if len(degraded) > 0 && req.AbortOnDegraded {
+		return nil, errors.Errorf("pools %s degraded before restart",
+			strings.Join(degraded, ","))
+	}
+
+	resp := &SystemRollingRestartResp{NotRestarted: ranklist.NewRankSet()}
+	for i, batch := range batches {
+		resp.Batches = append(resp.Batches, batch)
+
+		if err := rr.restartBatch(ctx, batch); err != nil {
+			batch.Error = err.Error()
+			rr.update(batch, RollingRestartFailed)
+			for _, remaining := range batches[i+1:] {
+				resp.NotRestarted.Merge(remaining.Ranks)
+			}
+			break
+		}
+		rr.update(batch, RollingRestartDone)
+	}
+
+	return resp, nil
+}
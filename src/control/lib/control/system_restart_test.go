@@ -0,0 +1,327 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_SystemRollingRestart(t *testing.T) {
+	member := func(rank uint32, state system.MemberState, fd string) *mgmtpb.SystemMember {
+		return &mgmtpb.SystemMember{
+			Rank:        rank,
+			Uuid:        test.MockUUID(int32(rank)),
+			State:       state.String(),
+			Addr:        "10.0.0.1:10001",
+			FaultDomain: fd,
+		}
+	}
+	query := func(members ...*mgmtpb.SystemMember) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.SystemQueryResp{Members: members})
+	}
+	noPools := MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{})
+	listPool := func(state daos.PoolServiceState) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+			Pools: []*mgmtpb.ListPoolsResp_Pool{
+				{Uuid: test.MockUUID(1), Label: "pool1", State: state.String()},
+			},
+		})
+	}
+	queryPool := func(rebuild mgmtpb.PoolRebuildStatus_State, disabledRanks string) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:          test.MockUUID(1),
+			Label:         "pool1",
+			TotalTargets:  8,
+			ActiveTargets: 8,
+			Rebuild:       &mgmtpb.PoolRebuildStatus{State: rebuild},
+			DisabledRanks: disabledRanks,
+		})
+	}
+	stop := MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{})
+	start := MockMSResponse("host1", nil, &mgmtpb.SystemStartResp{})
+
+	node0 := "/rack0/node0"
+	node1 := "/rack0/node1"
+	twoNodes := query(
+		member(0, system.MemberStateJoined, node0),
+		member(1, system.MemberStateJoined, node0),
+		member(2, system.MemberStateJoined, node1),
+		member(3, system.MemberStateAdminExcluded, node1),
+	)
+
+	for name, tc := range map[string]struct {
+		req       *SystemRollingRestartReq
+		responses []*UnaryResponse
+		expResp   *SystemRollingRestartResp
+		expStages []string
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"ranks not joined": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateStopped, node0),
+				),
+			},
+			expErr: errors.New("ranks 1 are not joined"),
+		},
+		"no ranks to restart": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				query(member(3, system.MemberStateAdminExcluded, node1)),
+			},
+			expErr: errors.New("no joined ranks"),
+		},
+		"pool degraded before restart": {
+			req: &SystemRollingRestartReq{AbortOnDegraded: true},
+			responses: []*UnaryResponse{
+				twoNodes,
+				listPool(daos.PoolServiceStateTargetsExcluded),
+			},
+			expErr: errors.New("pools pool1 degraded before restart"),
+		},
+		"pool rebuild times out before restart": {
+			req: &SystemRollingRestartReq{SettleTimeout: time.Nanosecond},
+			responses: []*UnaryResponse{
+				twoNodes,
+				listPool(daos.PoolServiceStateReady),
+				queryPool(mgmtpb.PoolRebuildStatus_BUSY, ""),
+			},
+			expErr: errors.New("waiting for rebuild of pools pool1: timed out"),
+		},
+		"one fault domain per batch": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				twoNodes,
+				noPools,
+				stop, start,
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateJoined, node0),
+				),
+				noPools, noPools,
+				stop, start,
+				query(member(2, system.MemberStateStopped, node1)),
+				query(member(2, system.MemberStateJoined, node1)),
+				noPools, noPools,
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains: []string{node0},
+						Ranks:        ranklist.MustCreateRankSet("0-1"),
+						Stage:        RollingRestartDone,
+					},
+					{
+						FaultDomains: []string{node1},
+						Ranks:        ranklist.MustCreateRankSet("2"),
+						Stage:        RollingRestartDone,
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet(""),
+			},
+			expStages: []string{
+				"0-1: stopping",
+				"0-1: starting",
+				"0-1: waiting for ranks to join",
+				"0-1: reintegrating",
+				"0-1: waiting for pools to settle",
+				"0-1: done",
+				"2: stopping",
+				"2: starting",
+				"2: waiting for ranks to join",
+				"2: reintegrating",
+				"2: waiting for pools to settle",
+				"2: done",
+			},
+		},
+		"two fault domains per batch": {
+			req: &SystemRollingRestartReq{BatchSize: 2},
+			responses: []*UnaryResponse{
+				twoNodes,
+				noPools,
+				stop, start,
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateJoined, node0),
+					member(2, system.MemberStateJoined, node1),
+				),
+				noPools, noPools,
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains: []string{node0, node1},
+						Ranks:        ranklist.MustCreateRankSet("0-2"),
+						Stage:        RollingRestartDone,
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet(""),
+			},
+		},
+		"reintegrate excluded ranks and wait for rebuild": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateJoined, node0),
+				),
+				listPool(daos.PoolServiceStateReady),
+				queryPool(mgmtpb.PoolRebuildStatus_IDLE, ""),
+				stop, start,
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateJoined, node0),
+				),
+				listPool(daos.PoolServiceStateReady),
+				queryPool(mgmtpb.PoolRebuildStatus_IDLE, "1"),
+				MockMSResponse("host1", nil, &mgmtpb.PoolReintResp{}),
+				listPool(daos.PoolServiceStateReady),
+				queryPool(mgmtpb.PoolRebuildStatus_BUSY, ""),
+				listPool(daos.PoolServiceStateReady),
+				queryPool(mgmtpb.PoolRebuildStatus_DONE, ""),
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains: []string{node0},
+						Ranks:        ranklist.MustCreateRankSet("0-1"),
+						Stage:        RollingRestartDone,
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet(""),
+			},
+		},
+		"stop fails": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				twoNodes,
+				noPools,
+				MockMSResponse("host1", nil, &mgmtpb.SystemStopResp{
+					Results: []*sharedpb.RankResult{
+						{
+							Rank: 0, Errored: true, Msg: "fail", Action: "stop",
+							State: system.MemberStateErrored.String(),
+						},
+						{
+							Rank: 1, Action: "stop",
+							State: system.MemberStateStopped.String(),
+						},
+					},
+				}),
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains: []string{node0},
+						Ranks:        ranklist.MustCreateRankSet("0-1"),
+						Stage:        RollingRestartFailed,
+						Error:        "stop: check results for failed rank 0",
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet("2"),
+			},
+		},
+		"pool degraded after batch": {
+			req: &SystemRollingRestartReq{},
+			responses: []*UnaryResponse{
+				query(member(2, system.MemberStateJoined, node1)),
+				noPools,
+				stop, start,
+				query(member(2, system.MemberStateJoined, node1)),
+				noPools,
+				listPool(daos.PoolServiceStateTargetsExcluded),
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains:  []string{node1},
+						Ranks:         ranklist.MustCreateRankSet("2"),
+						Stage:         RollingRestartDone,
+						DegradedPools: []string{"pool1"},
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet(""),
+			},
+		},
+		"pool degraded after batch; abort": {
+			req: &SystemRollingRestartReq{AbortOnDegraded: true},
+			responses: []*UnaryResponse{
+				twoNodes,
+				noPools,
+				stop, start,
+				query(
+					member(0, system.MemberStateJoined, node0),
+					member(1, system.MemberStateJoined, node0),
+				),
+				noPools,
+				listPool(daos.PoolServiceStateTargetsExcluded),
+			},
+			expResp: &SystemRollingRestartResp{
+				Batches: []*RollingRestartBatch{
+					{
+						FaultDomains:  []string{node0},
+						Ranks:         ranklist.MustCreateRankSet("0-1"),
+						Stage:         RollingRestartFailed,
+						DegradedPools: []string{"pool1"},
+						Error:         "pools pool1 degraded",
+					},
+				},
+				NotRestarted: ranklist.MustCreateRankSet("2"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryResponseSet: tc.responses,
+			})
+
+			var gotStages []string
+			if tc.req != nil {
+				tc.req.PollInterval = time.Millisecond
+				tc.req.OnUpdate = func(b *RollingRestartBatch) {
+					gotStages = append(gotStages, fmt.Sprintf("%s: %s", b.Ranks, b.Stage))
+				}
+			}
+
+			gotResp, gotErr := SystemRollingRestart(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if tc.expStages != nil {
+				if diff := cmp.Diff(tc.expStages, gotStages); diff != "" {
+					t.Fatalf("unexpected stages (-want, +got):\n%s\n", diff)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ package control
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -16,9 +17,11 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/common"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -886,6 +889,41 @@ func TestControl_SystemStop(t *testing.T) {
 			},
 			expErr: errors.New("may not be mixed"),
 		},
+		"request force and drain options": {
+			req: &SystemStopReq{
+				Force: true,
+				Drain: true,
+			},
+			expErr: errors.New("may not be mixed"),
+		},
+		"drain results": {
+			req: &SystemStopReq{Drain: true},
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemStopResp{
+				Results: []*sharedpb.RankResult{
+					{
+						Rank:  0,
+						State: system.MemberStateStopped.String(),
+					},
+				},
+				DrainResults: []*sharedpb.RankResult{
+					{
+						Rank: 0, Msg: "timed out with 2 RPCs in flight",
+						State: system.MemberStateStopping.String(),
+					},
+				},
+			}),
+			expResp: &SystemStopResp{
+				Results: system.MemberResults{
+					system.NewMemberResult(0, nil, system.MemberStateStopped),
+				},
+				DrainResults: system.MemberResults{
+					{
+						Rank: 0, Msg: "timed out with 2 RPCs in flight",
+						State: system.MemberStateStopping,
+					},
+				},
+			},
+		},
 		"request full and host set options": {
 			req:    withFull(testReqHS),
 			expErr: errors.New("may not be mixed"),
@@ -1312,6 +1350,7 @@ func TestControl_SystemCleanup(t *testing.T) {
 
 func TestControl_System_checkSystemErase(t *testing.T) {
 	for name, tc := range map[string]struct {
+		ranks        string
 		uErr, expErr error
 		members      []*mgmtpb.SystemMember
 	}{
@@ -1325,7 +1364,19 @@ func TestControl_System_checkSystemErase(t *testing.T) {
 		"raft unavailable": {
 			uErr: system.ErrRaftUnavail,
 		},
+		"raft unavailable; selective erase": {
+			ranks:  "1",
+			uErr:   system.ErrRaftUnavail,
+			expErr: system.ErrRaftUnavail,
+		},
 		"empty membership": {},
+		"selected rank not stopped": {
+			ranks: "1",
+			members: []*mgmtpb.SystemMember{
+				{Rank: 1, State: system.MemberStateJoined.String()},
+			},
+			expErr: errors.New("system erase requires the following 1 rank to be stopped: 1"),
+		},
 		"rank not stopped": {
 			members: []*mgmtpb.SystemMember{
 				{Rank: 0, State: system.MemberStateStopped.String()},
@@ -1356,7 +1407,10 @@ func TestControl_System_checkSystemErase(t *testing.T) {
 					&mgmtpb.SystemQueryResp{Members: tc.members}),
 			})
 
-			err := checkSystemErase(test.Context(t), mi)
+			req := new(SystemEraseReq)
+			req.Ranks.Replace(ranklist.MustCreateRankSet(tc.ranks))
+
+			err := checkSystemErase(test.Context(t), mi, req)
 			test.CmpErr(t, tc.expErr, err)
 		})
 	}
@@ -1381,12 +1435,16 @@ func TestControl_SystemErase(t *testing.T) {
 		return mr
 	}
 
+	selectiveReq := new(SystemEraseReq)
+	selectiveReq.Ranks.Replace(ranklist.MustCreateRankSet("1-2,9"))
+
 	for name, tc := range map[string]struct {
-		req     *SystemEraseReq
-		uErr    error
-		uResp   *UnaryResponse
-		expResp *SystemEraseResp
-		expErr  error
+		req        *SystemEraseReq
+		uErr       error
+		uResp      *UnaryResponse
+		expResp    *SystemEraseResp
+		expErr     error
+		expRespErr error
 	}{
 		"nil req": {
 			req:    nil,
@@ -1540,6 +1598,41 @@ func TestControl_SystemErase(t *testing.T) {
 				},
 			},
 		},
+		"selective erase with absent rank": {
+			req: selectiveReq,
+			uResp: MockMSResponse("10.0.0.1:10001", nil, &mgmtpb.SystemEraseResp{
+				Results: []*sharedpb.RankResult{
+					{
+						Rank: member1.Rank.Uint32(), Action: "erase",
+						State: system.MemberStateAwaitFormat.String(),
+						Addr:  member1.Addr.String(),
+						Msg:   "superblock removed",
+					},
+					{
+						Rank: member2.Rank.Uint32(), Action: "erase",
+						State: system.MemberStateAwaitFormat.String(),
+						Addr:  member2.Addr.String(),
+						Msg:   "superblock removed",
+					},
+				},
+				Absentranks: "9",
+			}),
+			expResp: &SystemEraseResp{
+				Results: system.MemberResults{
+					func() *system.MemberResult {
+						mr := mockMemberResult(member1, "erase", nil, system.MemberStateAwaitFormat)
+						mr.Msg = "superblock removed"
+						return mr
+					}(),
+					func() *system.MemberResult {
+						mr := mockMemberResult(member2, "erase", nil, system.MemberStateAwaitFormat)
+						mr.Msg = "superblock removed"
+						return mr
+					}(),
+				},
+			},
+			expRespErr: errors.New("non-existent ranks 9"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1556,9 +1649,12 @@ func TestControl_SystemErase(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(tc.expResp, gotResp, defResCmpOpts()...); diff != "" {
+			cmpOpts := append(defResCmpOpts(), cmpopts.IgnoreUnexported(SystemEraseResp{}))
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
 			}
+
+			test.CmpErr(t, tc.expRespErr, gotResp.Errors())
 		})
 	}
 }
@@ -1888,6 +1984,195 @@ func TestControl_SystemGetAttr(t *testing.T) {
 	}
 }
 
+func TestControl_SystemEventsQuery(t *testing.T) {
+	evt := events.NewEngineDiedEvent("foo", 0, 1, 2, common.NormalExit, 1234)
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemEventsQueryReq
+		mic     *MockInvokerConfig
+		expResp *SystemEventsQueryResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: new(SystemEventsQueryReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"success": {
+			req: &SystemEventsQueryReq{
+				MinSeverity: "error",
+				Ranks:       ranklist.MustCreateRankSet("1"),
+				MaxEvents:   10,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsQueryResp{
+						Events: []*mgmtpb.SystemEvent{
+							{Seq: 7, Event: pbEvt},
+						},
+						LastSeq: 9,
+					}),
+				},
+			},
+			expResp: &SystemEventsQueryResp{
+				Events: []*SystemEvent{
+					{Seq: 7, Event: evt},
+				},
+				LastSeq: 9,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemEventsQuery(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreUnexported(events.RASEvent{}),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemCrashReports(t *testing.T) {
+	mockCrashEvt := func(t *testing.T, rank uint32, idx uint32) (*events.RASEvent, *sharedpb.RASEvent) {
+		t.Helper()
+		evt := events.NewEngineCrashReportedEvent("foo", rank, 2, 1234, &events.EngineCrashInfo{
+			InstanceIdx: idx,
+			ExitStatus:  "signal: aborted (core dumped)",
+			Signal:      "aborted",
+			CoreDump:    "/var/crash/core.1234",
+			ReportPath:  fmt.Sprintf("/var/log/daos/crash/engine%d.json", idx),
+		})
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return evt, pbEvt
+	}
+	expReport := func(evt *events.RASEvent) *SystemCrashReport {
+		info, err := evt.GetEngineCrashInfo()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &SystemCrashReport{
+			Timestamp:       evt.Timestamp,
+			Hostname:        evt.Hostname,
+			Rank:            ranklist.Rank(evt.Rank),
+			Incarnation:     evt.Incarnation,
+			PID:             evt.ProcID,
+			EngineCrashInfo: *info,
+		}
+	}
+
+	crash0, pbCrash0 := mockCrashEvt(t, 1, 0)
+	crash1, pbCrash1 := mockCrashEvt(t, 2, 1)
+	pbDied, err := events.NewEngineDiedEvent("foo", 0, 1, 2, common.NormalExit, 1234).ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventsResp := MockMSResponse("", nil, &mgmtpb.SystemEventsQueryResp{
+		Events: []*mgmtpb.SystemEvent{
+			{Seq: 1, Event: pbCrash0},
+			{Seq: 2, Event: pbDied},
+			{Seq: 3, Event: pbCrash1},
+		},
+		LastSeq: 3,
+	})
+
+	for name, tc := range map[string]struct {
+		req     *SystemCrashReportsReq
+		mic     *MockInvokerConfig
+		expResp *SystemCrashReportsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: new(SystemCrashReportsReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no crash reports": {
+			req: new(SystemCrashReportsReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsQueryResp{
+						Events: []*mgmtpb.SystemEvent{
+							{Seq: 1, Event: pbDied},
+						},
+						LastSeq: 1,
+					}),
+				},
+			},
+			expResp: &SystemCrashReportsResp{},
+		},
+		"success": {
+			req: new(SystemCrashReportsReq),
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{eventsResp},
+			},
+			expResp: &SystemCrashReportsResp{
+				Reports: []*SystemCrashReport{
+					expReport(crash0), expReport(crash1),
+				},
+			},
+		},
+		"most recent only": {
+			req: &SystemCrashReportsReq{MaxReports: 1},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{eventsResp},
+			},
+			expResp: &SystemCrashReportsResp{
+				Reports: []*SystemCrashReport{
+					expReport(crash1),
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemCrashReports(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_SystemRebuildManage(t *testing.T) {
 	for name, tc := range map[string]struct {
 		req        *SystemRebuildManageReq
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,11 +8,14 @@
 package control
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/pkg/errors"
@@ -40,6 +44,21 @@ func getMetricsURL(host string, port uint32) *url.URL {
 	}
 }
 
+func getMetricsHistoryURL(host string, port uint32, since time.Duration, metricNames []string) *url.URL {
+	query := url.Values{}
+	query.Set("since", since.String())
+	if len(metricNames) > 0 {
+		query.Set("metrics", strings.Join(metricNames, ","))
+	}
+
+	return &url.URL{
+		Scheme:   "http",
+		Host:     fmt.Sprintf("%s:%d", host, port),
+		Path:     "metrics/history",
+		RawQuery: query.Encode(),
+	}
+}
+
 // scrapeMetrics fetches the metrics published by the DAOS server in the
 // Prometheus-compatible endpoint.
 func scrapeMetrics(ctx context.Context, req httpGetter) (pbMetricMap, error) {
@@ -203,6 +222,119 @@ func newMetricsQueryResp(scraped pbMetricMap, metricNames []string) (*MetricsQue
 	return resp, nil
 }
 
+type (
+	// MetricsHistoryReq is used to query the telemetry values retained by a DAOS node.
+	MetricsHistoryReq struct {
+		httpReq
+		Host        string        // host to query for telemetry data
+		Port        uint32        // port to use for collecting telemetry data
+		MetricNames []string      // if empty, collects all metrics
+		Since       time.Duration // collect values sampled within this duration
+	}
+
+	// MetricsSample contains the telemetry values sampled at a point in time.
+	MetricsSample struct {
+		Timestamp  time.Time         `json:"timestamp"`
+		MetricSets []*daos.MetricSet `json:"metric_sets"`
+	}
+
+	// MetricsHistoryResp contains the retained telemetry values, oldest first.
+	MetricsHistoryResp struct {
+		Samples []*MetricsSample `json:"samples"`
+	}
+)
+
+// fetchMetricsHistory fetches the retained metrics from the DAOS server, grouped by the
+// timestamp of the sample they were taken from.
+func fetchMetricsHistory(ctx context.Context, req httpGetter) (map[int64]pbMetricMap, error) {
+	body, err := httpGetBodyRetry(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make(map[int64]pbMetricMap)
+	dec := expfmt.NewDecoder(bytes.NewReader(body), expfmt.FmtProtoDelim)
+	for {
+		mf := new(pclient.MetricFamily)
+		if err := dec.Decode(mf); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if len(mf.Metric) == 0 {
+			continue
+		}
+
+		ts := mf.Metric[0].GetTimestampMs()
+		if _, found := samples[ts]; !found {
+			samples[ts] = make(pbMetricMap)
+		}
+		samples[ts][mf.GetName()] = mf
+	}
+
+	return samples, nil
+}
+
+// MetricsHistory fetches the requested metrics values retained by the DAOS node.
+func MetricsHistory(ctx context.Context, req *MetricsHistoryReq) (*MetricsHistoryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if req.Host == "" {
+		return nil, errors.New("host must be specified")
+	}
+
+	if req.Port == 0 {
+		return nil, errors.New("port must be specified")
+	}
+
+	if req.Since <= 0 {
+		return nil, errors.New("since must be a positive duration")
+	}
+
+	req.url = getMetricsHistoryURL(req.Host, req.Port, req.Since, req.MetricNames)
+
+	samples, err := fetchMetricsHistory(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to query metrics history (is telemetry_history enabled on the host?)")
+	}
+
+	timestamps := make([]int64, 0, len(samples))
+	for ts := range samples {
+		timestamps = append(timestamps, ts)
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+	resp := new(MetricsHistoryResp)
+	seen := make(map[string]bool)
+	for _, ts := range timestamps {
+		scraped := samples[ts]
+		for name := range scraped {
+			seen[name] = true
+		}
+
+		// metrics may not be present in every sample, e.g. after an engine restart
+		qr, err := newMetricsQueryResp(scraped, scraped.Keys())
+		if err != nil {
+			return nil, err
+		}
+		resp.Samples = append(resp.Samples, &MetricsSample{
+			Timestamp:  time.UnixMilli(ts),
+			MetricSets: qr.MetricSets,
+		})
+	}
+
+	for _, name := range req.MetricNames {
+		if len(resp.Samples) > 0 && !seen[name] {
+			return nil, errors.Errorf("metric %q not found in history on host", name)
+		}
+	}
+
+	return resp, nil
+}
+
 func getMetricFromPrometheus(pMetric *pclient.Metric, metricType pclient.MetricType) (daos.Metric, error) {
 	labels := metricsLabelsToMap(pMetric)
 	switch metricType {
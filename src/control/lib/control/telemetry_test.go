@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package control
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -583,6 +585,152 @@ func TestControl_MetricsQuery(t *testing.T) {
 	}
 }
 
+func newTestHistoryGauge(name string, ts int64, value float64) *pclient.MetricFamily {
+	fam := newTestMetricFamily(name, name+" help", pclient.MetricType_GAUGE)
+	metric := newTestPBGauge(value)
+	metric.TimestampMs = proto.Int64(ts)
+	fam.Metric = append(fam.Metric, metric)
+	return fam
+}
+
+func mockHistoryFnSuccess(t *testing.T, expQuery string, metricFam ...*pclient.MetricFamily) func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error) {
+	t.Helper()
+
+	return func(_ context.Context, u *url.URL, _ httpGetFn, _ time.Duration) ([]byte, error) {
+		test.AssertEqual(t, "/metrics/history", u.Path, "unexpected URL path")
+		test.AssertEqual(t, expQuery, u.RawQuery, "unexpected URL query")
+
+		var b bytes.Buffer
+		enc := expfmt.NewEncoder(&b, expfmt.FmtProtoDelim)
+		for _, mf := range metricFam {
+			if err := enc.Encode(mf); err != nil {
+				t.Fatalf("external metric encoding failed: %s", err.Error())
+			}
+		}
+		return b.Bytes(), nil
+	}
+}
+
+func TestControl_MetricsHistory(t *testing.T) {
+	ts1 := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	ts2 := ts1.Add(10 * time.Second)
+	history := []*pclient.MetricFamily{
+		newTestHistoryGauge("my_gauge", ts1.UnixMilli(), 1),
+		newTestHistoryGauge("my_other_gauge", ts1.UnixMilli(), 2),
+		newTestHistoryGauge("my_gauge", ts2.UnixMilli(), 3),
+	}
+	expSet := func(name string, value float64) *daos.MetricSet {
+		return &daos.MetricSet{
+			Name:        name,
+			Description: name + " help",
+			Type:        daos.MetricTypeGauge,
+			Metrics: []daos.Metric{
+				newSimpleMetric(map[string]string{}, value),
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		historyFn func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error)
+		req       *MetricsHistoryReq
+		expResp   *MetricsHistoryResp
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil"),
+		},
+		"no host": {
+			req:    &MetricsHistoryReq{Port: 2525, Since: time.Minute},
+			expErr: errors.New("host must be specified"),
+		},
+		"no port": {
+			req:    &MetricsHistoryReq{Host: "host1", Since: time.Minute},
+			expErr: errors.New("port must be specified"),
+		},
+		"no since": {
+			req:    &MetricsHistoryReq{Host: "host1", Port: 7777},
+			expErr: errors.New("since must be a positive duration"),
+		},
+		"fetch failed": {
+			req: &MetricsHistoryReq{Host: "host1", Port: 7777, Since: time.Minute},
+			historyFn: func(context.Context, *url.URL, httpGetFn, time.Duration) ([]byte, error) {
+				return nil, errors.New("HTTP response error: 404 Not Found")
+			},
+			expErr: errors.New("telemetry_history enabled"),
+		},
+		"no samples": {
+			req:       &MetricsHistoryReq{Host: "host1", Port: 7777, Since: time.Minute},
+			historyFn: mockHistoryFnSuccess(t, "since=1m0s"),
+			expResp:   &MetricsHistoryResp{},
+		},
+		"all metrics": {
+			req:       &MetricsHistoryReq{Host: "host1", Port: 7777, Since: 10 * time.Minute},
+			historyFn: mockHistoryFnSuccess(t, "since=10m0s", history...),
+			expResp: &MetricsHistoryResp{
+				Samples: []*MetricsSample{
+					{
+						Timestamp: time.UnixMilli(ts1.UnixMilli()),
+						MetricSets: []*daos.MetricSet{
+							expSet("my_gauge", 1),
+							expSet("my_other_gauge", 2),
+						},
+					},
+					{
+						Timestamp: time.UnixMilli(ts2.UnixMilli()),
+						MetricSets: []*daos.MetricSet{
+							expSet("my_gauge", 3),
+						},
+					},
+				},
+			},
+		},
+		"selected metrics": {
+			req: &MetricsHistoryReq{
+				Host:        "host1",
+				Port:        7777,
+				Since:       time.Hour,
+				MetricNames: []string{"my_gauge"},
+			},
+			historyFn: mockHistoryFnSuccess(t, "metrics=my_gauge&since=1h0m0s", history[0], history[2]),
+			expResp: &MetricsHistoryResp{
+				Samples: []*MetricsSample{
+					{
+						Timestamp:  time.UnixMilli(ts1.UnixMilli()),
+						MetricSets: []*daos.MetricSet{expSet("my_gauge", 1)},
+					},
+					{
+						Timestamp:  time.UnixMilli(ts2.UnixMilli()),
+						MetricSets: []*daos.MetricSet{expSet("my_gauge", 3)},
+					},
+				},
+			},
+		},
+		"invalid metric name": {
+			req: &MetricsHistoryReq{
+				Host:        "host1",
+				Port:        7777,
+				Since:       time.Hour,
+				MetricNames: []string{"my_gauge", "fake"},
+			},
+			historyFn: mockHistoryFnSuccess(t, "metrics=my_gauge%2Cfake&since=1h0m0s", history[0]),
+			expErr:    errors.New("metric \"fake\" not found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.req != nil {
+				tc.req.getBodyFn = tc.historyFn
+			}
+
+			resp, err := MetricsHistory(test.Context(t), tc.req)
+
+			test.CmpErr(t, tc.expErr, err)
+			if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_Metric_JSON(t *testing.T) {
 	testLabelMap := map[string]string{
 		"label1": "val1",
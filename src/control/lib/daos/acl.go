@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,6 +19,10 @@ import "C"
 const (
 	// ACLPrincipalMaxLen is the maximum length of a principal string.
 	ACLPrincipalMaxLen = C.DAOS_ACL_MAX_PRINCIPAL_LEN
+	// ACLMaxACEStrLen is the maximum length of an ACE string.
+	ACLMaxACEStrLen = C.DAOS_ACL_MAX_ACE_STR_LEN
+	// ACLMaxACELen is the maximum encoded length of the entries of an ACL.
+	ACLMaxACELen = C.DAOS_ACL_MAX_ACE_LEN
 )
 
 // ACLPrincipalIsValid returns true if the principal is valid.
@@ -55,7 +55,8 @@ func (m securityAgentMethod) ID() int32 {
 
 func (m securityAgentMethod) String() string {
 	if s, ok := map[securityAgentMethod]string{
-		MethodRequestCredentials: "request agent credentials",
+		MethodRequestCredentials:          "request agent credentials",
+		MethodRequestDelegatedCredentials: "request delegated agent credentials",
 	}[m]; ok {
 		return s
 	}
@@ -66,6 +67,8 @@ func (m securityAgentMethod) String() string {
 const (
 	// MethodRequestCredentials is a ModuleSecurityAgent method
 	MethodRequestCredentials securityAgentMethod = C.DRPC_METHOD_SEC_AGENT_REQUEST_CREDS
+	// MethodRequestDelegatedCredentials is a ModuleSecurityAgent method
+	MethodRequestDelegatedCredentials securityAgentMethod = C.DRPC_METHOD_SEC_AGENT_REQUEST_DELEGATED_CREDS
 )
 
 type MgmtMethod int32
@@ -82,6 +85,7 @@ func (m MgmtMethod) String() string {
 	if s, ok := map[MgmtMethod]string{
 		MethodPrepShutdown:         "PrepShutdown",
 		MethodPingRank:             "PingRank",
+		MethodDrainRank:            "DrainRank",
 		MethodSetRank:              "SetRank",
 		MethodSetLogMasks:          "SetLogMasks",
 		MethodGetAttachInfo:        "GetAttachInfo",
@@ -125,6 +129,7 @@ func (m MgmtMethod) String() string {
 		MethodPoolRebuildStop:      "PoolRebuildStop",
 		MethodGroupStatusGet:       "GroupStatusGet",
 		MethodPoolSelfHealEval:     "PoolSelfHealEval",
+		MethodPoolResize:           "PoolResize",
 	}[m]; ok {
 		return s
 	}
@@ -137,6 +142,8 @@ const (
 	MethodPrepShutdown MgmtMethod = C.DRPC_METHOD_MGMT_PREP_SHUTDOWN
 	// MethodPingRank is a ModuleMgmt method
 	MethodPingRank MgmtMethod = C.DRPC_METHOD_MGMT_PING_RANK
+	// MethodDrainRank is a ModuleMgmt method to refuse new pool connections ahead of shutdown
+	MethodDrainRank MgmtMethod = C.DRPC_METHOD_MGMT_DRAIN_RANK
 	// MethodSetRank is a ModuleMgmt method
 	MethodSetRank MgmtMethod = C.DRPC_METHOD_MGMT_SET_RANK
 	// MethodSetLogMasks is a ModuleMgmt method
@@ -223,6 +230,8 @@ const (
 	MethodGroupStatusGet MgmtMethod = C.DRPC_METHOD_MGMT_GROUP_STATUS_GET
 	// MethodPoolSelfHealEval defines a method for evaluating self_heal property on a pool
 	MethodPoolSelfHealEval MgmtMethod = C.DRPC_METHOD_MGMT_POOL_SELF_HEAL_EVAL
+	// MethodPoolResize defines a method for growing the per-rank storage of a pool
+	MethodPoolResize MgmtMethod = C.DRPC_METHOD_MGMT_POOL_RESIZE
 )
 
 type SrvMethod int32
@@ -0,0 +1,197 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package health provides HTTP endpoints for the liveness and readiness probes of
+// container orchestrators such as Kubernetes.
+package health
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// LivenessPath is the path of the liveness endpoint, which reports whether the
+	// process is functional and should not be restarted.
+	LivenessPath = "/healthz"
+	// ReadinessPath is the path of the readiness endpoint, which reports whether the
+	// process is able to serve requests.
+	ReadinessPath = "/readyz"
+
+	// DefaultCheckTimeout is the time allowed for each check if none is configured.
+	DefaultCheckTimeout = 5 * time.Second
+
+	readHeaderTimeout = 5 * time.Second
+)
+
+type (
+	// CheckFn returns an error if the checked component is not healthy.
+	CheckFn func(context.Context) error
+
+	// Check is a named health check.
+	Check struct {
+		Name string
+		Fn   CheckFn
+	}
+
+	// Config defines the checks run by the probe endpoints. The readiness endpoint also
+	// runs the liveness checks, as a process that is not live cannot be ready.
+	Config struct {
+		Liveness  []*Check
+		Readiness []*Check
+		Timeout   time.Duration
+	}
+
+	checkResult struct {
+		name string
+		err  error
+	}
+)
+
+func (cfg *Config) timeout() time.Duration {
+	if cfg.Timeout <= 0 {
+		return DefaultCheckTimeout
+	}
+	return cfg.Timeout
+}
+
+// runChecks runs the checks concurrently and returns their results in order.
+func runChecks(ctx context.Context, timeout time.Duration, checks []*Check) []*checkResult {
+	results := make([]*checkResult, len(checks))
+
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check *Check) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			results[i] = &checkResult{name: check.Name, err: check.Fn(checkCtx)}
+		}(i, check)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// checkHandler returns a handler that responds with 200 OK if all of the checks pass, or
+// 503 Service Unavailable if any of them fail. The body lists the outcome of each check in
+// the format of the verbose Kubernetes probe endpoints.
+func checkHandler(log logging.Logger, name string, timeout time.Duration, checks []*Check) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var body strings.Builder
+		failed := false
+		for _, res := range runChecks(r.Context(), timeout, checks) {
+			if res.err != nil {
+				failed = true
+				log.Debugf("%s check %q failed: %s", name, res.name, res.err)
+				fmt.Fprintf(&body, "[-]%s failed: %s\n", res.name, res.err)
+				continue
+			}
+			fmt.Fprintf(&body, "[+]%s ok\n", res.name)
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if failed {
+			fmt.Fprintf(&body, "%s check failed\n", name)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			fmt.Fprintf(&body, "%s check passed\n", name)
+		}
+		if _, err := w.Write([]byte(body.String())); err != nil {
+			log.Debugf("failed to write %s response: %s", name, err)
+		}
+	}
+}
+
+// NewHandler returns a handler that serves the liveness and readiness endpoints.
+func NewHandler(log logging.Logger, cfg *Config) http.Handler {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	readiness := make([]*Check, 0, len(cfg.Liveness)+len(cfg.Readiness))
+	readiness = append(readiness, cfg.Liveness...)
+	readiness = append(readiness, cfg.Readiness...)
+
+	mux := http.NewServeMux()
+	mux.Handle(LivenessPath, checkHandler(log, "healthz", cfg.timeout(), cfg.Liveness))
+	mux.Handle(ReadinessPath, checkHandler(log, "readyz", cfg.timeout(), readiness))
+
+	return mux
+}
+
+// Serve serves the probe endpoints on the listener until the returned cleanup function
+// is called.
+func Serve(log logging.Logger, lis net.Listener, cfg *Config) func() {
+	srv := &http.Server{
+		Handler:           NewHandler(log, cfg),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		log.Infof("serving health probes on %s", lis.Addr())
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Errorf("health probe server stopped: %s", err)
+		}
+	}()
+
+	return func() {
+		log.Debug("shutting down health probe server")
+
+		timedCtx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(timedCtx); err != nil {
+			log.Noticef("health probe server didn't shut down within timeout: %s", err)
+		}
+	}
+}
+
+// Start listens on the given port on all interfaces and serves the probe endpoints until
+// the returned cleanup function is called.
+func Start(log logging.Logger, port int, cfg *Config) (func(), error) {
+	if port <= 0 {
+		return nil, errors.Errorf("invalid health probe port %d", port)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
+	if err != nil {
+		return nil, errors.Wrap(err, "health probe listener")
+	}
+
+	return Serve(log, lis, cfg), nil
+}
+
+// DrpcSocketCheck returns a check that fails if the dRPC server listening on the socket at
+// the given path does not accept connections.
+func DrpcSocketCheck(sockPath string) CheckFn {
+	return func(ctx context.Context) error {
+		client := drpc.NewClientConnection(sockPath)
+		if err := client.Connect(ctx); err != nil {
+			return err
+		}
+		return client.Close()
+	}
+}
@@ -0,0 +1,179 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package health
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func passCheck(name string) *Check {
+	return &Check{
+		Name: name,
+		Fn:   func(context.Context) error { return nil },
+	}
+}
+
+func failCheck(name, msg string) *Check {
+	return &Check{
+		Name: name,
+		Fn:   func(context.Context) error { return errors.New(msg) },
+	}
+}
+
+func TestHealth_NewHandler(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg       *Config
+		method    string
+		path      string
+		expStatus int
+		expBody   string
+	}{
+		"nil config": {
+			path:      LivenessPath,
+			expStatus: http.StatusOK,
+			expBody:   "healthz check passed\n",
+		},
+		"liveness passed": {
+			cfg: &Config{
+				Liveness:  []*Check{passCheck("harness"), passCheck("drpc")},
+				Readiness: []*Check{failCheck("engine-0", "not joined")},
+			},
+			path:      LivenessPath,
+			expStatus: http.StatusOK,
+			expBody:   "[+]harness ok\n[+]drpc ok\nhealthz check passed\n",
+		},
+		"liveness failed": {
+			cfg: &Config{
+				Liveness: []*Check{passCheck("harness"), failCheck("drpc", "refused")},
+			},
+			path:      LivenessPath,
+			expStatus: http.StatusServiceUnavailable,
+			expBody:   "[+]harness ok\n[-]drpc failed: refused\nhealthz check failed\n",
+		},
+		"readiness includes liveness": {
+			cfg: &Config{
+				Liveness:  []*Check{failCheck("drpc", "refused")},
+				Readiness: []*Check{passCheck("engine-0")},
+			},
+			path:      ReadinessPath,
+			expStatus: http.StatusServiceUnavailable,
+			expBody:   "[-]drpc failed: refused\n[+]engine-0 ok\nreadyz check failed\n",
+		},
+		"readiness passed": {
+			cfg: &Config{
+				Liveness:  []*Check{passCheck("drpc")},
+				Readiness: []*Check{passCheck("engine-0"), passCheck("engine-1")},
+			},
+			path:      ReadinessPath,
+			expStatus: http.StatusOK,
+			expBody:   "[+]drpc ok\n[+]engine-0 ok\n[+]engine-1 ok\nreadyz check passed\n",
+		},
+		"check timed out": {
+			cfg: &Config{
+				Readiness: []*Check{
+					{
+						Name: "slow",
+						Fn: func(ctx context.Context) error {
+							<-ctx.Done()
+							return ctx.Err()
+						},
+					},
+				},
+				Timeout: time.Millisecond,
+			},
+			path:      ReadinessPath,
+			expStatus: http.StatusServiceUnavailable,
+			expBody:   "[-]slow failed: context deadline exceeded\nreadyz check failed\n",
+		},
+		"method not allowed": {
+			method:    http.MethodPost,
+			path:      LivenessPath,
+			expStatus: http.StatusMethodNotAllowed,
+			expBody:   "method not allowed\n",
+		},
+		"unknown path": {
+			path:      "/metrics",
+			expStatus: http.StatusNotFound,
+			expBody:   "404 page not found\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodGet
+			}
+
+			rec := httptest.NewRecorder()
+			NewHandler(log, tc.cfg).ServeHTTP(rec, httptest.NewRequest(method, tc.path, nil))
+
+			test.AssertEqual(t, tc.expStatus, rec.Code, "unexpected status")
+			test.AssertEqual(t, tc.expBody, rec.Body.String(), "unexpected body")
+		})
+	}
+}
+
+func TestHealth_Serve(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup := Serve(log, lis, &Config{Liveness: []*Check{passCheck("test")}})
+	defer cleanup()
+
+	resp, err := http.Get("http://" + lis.Addr().String() + LivenessPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, http.StatusOK, resp.StatusCode, "unexpected status")
+	test.AssertEqual(t, "[+]test ok\nhealthz check passed\n", string(body), "unexpected body")
+}
+
+func TestHealth_DrpcSocketCheck(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	sockPath := filepath.Join(tmpDir, "test.sock")
+	check := DrpcSocketCheck(sockPath)
+
+	if err := check(test.Context(t)); err == nil {
+		t.Fatal("expected error with no listener")
+	}
+
+	lis, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: sockPath, Net: "unixpacket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	if err := check(test.Context(t)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
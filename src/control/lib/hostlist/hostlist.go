@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -32,6 +33,7 @@ const (
 	outerRangeSeparators = "\t, "
 	innerRangeSeparator  = ","
 	rangeOperator        = "-"
+	exclusionOperator    = "!"
 )
 
 type (
@@ -248,9 +250,31 @@ func MustCreate(stringHosts string) *HostList {
 }
 
 // Create creates a new HostList from the supplied string representation.
+// Hosts following an exclusion operator are removed from the list, e.g.
+// "node[1-100]!node[17,42]".
 func Create(stringHosts string) (*HostList, error) {
-	return parseBracketedHostList(stringHosts, outerRangeSeparators,
+	exprs := strings.Split(stringHosts, exclusionOperator)
+
+	hl, err := parseBracketedHostList(exprs[0], outerRangeSeparators,
 		rangeOperator, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, expr := range exprs[1:] {
+		if strings.TrimSpace(expr) == "" {
+			return nil, fmt.Errorf("no hosts to exclude after %q in %q",
+				exclusionOperator, stringHosts)
+		}
+		if hl.IsEmpty() {
+			continue
+		}
+		if _, err := hl.Delete(expr); err != nil {
+			return nil, err
+		}
+	}
+
+	return hl, nil
 }
 
 // String returns a ranged string representation of the HostList.
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -169,6 +170,37 @@ func TestHostList_Create(t *testing.T) {
 			startList: "1.2.3.4:10001,1.2.3.5:-10001",
 			expErr:    errors.New("invalid hostname"),
 		},
+		"exclusion": {
+			startList:    "node[1-100]!node[17,42]",
+			expRawOut:    "node[1-16,18-41,43-100]",
+			expUniqOut:   "node[1-16,18-41,43-100]",
+			expUniqCount: 98,
+		},
+		"multiple exclusions": {
+			startList:    "node[1-10]!node2!node[5-6]",
+			expRawOut:    "node[1,3-4,7-10]",
+			expUniqOut:   "node[1,3-4,7-10]",
+			expUniqCount: 7,
+		},
+		"exclusion of hosts not in list": {
+			startList:    "node[1-3]!node[7-8],other1",
+			expRawOut:    "node[1-3]",
+			expUniqOut:   "node[1-3]",
+			expUniqCount: 3,
+		},
+		"exclusion of all hosts": {
+			startList:  "node[1-3]!node[1-3]",
+			expRawOut:  "",
+			expUniqOut: "",
+		},
+		"missing exclusion": {
+			startList: "node[1-3]!",
+			expErr:    errors.New("no hosts to exclude"),
+		},
+		"bad exclusion range": {
+			startList: "node[1-3]!node[5-4]",
+			expErr:    errors.New("invalid range"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			hl, gotErr := hostlist.Create(tc.startList)
@@ -17,16 +17,34 @@ struct ret_t *
 nvme_discover(void);
 
 /**
- * Wipe NVMe controller namespace LBA-0.
+ * Attach to NVMe controllers in preparation for wiping their namespaces.
  *
- * Removes any data container structures e.g. blobstore.
+ * Only the PCI address is populated in each of the returned controllers.
+ * Must be followed by a call to nvme_wipe_detach() if successful.
+ *
+ * \return a pointer to a return struct (ret_t).
+ */
+struct ret_t *
+nvme_wipe_attach(void);
+
+/**
+ * Wipe LBA-0 of each namespace on an attached NVMe controller.
+ *
+ * Removes any data container structures e.g. blobstore. May be called
+ * concurrently for different controllers.
  *
  * \param ctrlr_pci_addr PCI address of NVMe controller.
  *
  * \return a pointer to a return struct (ret_t).
  */
 struct ret_t *
-nvme_wipe_namespaces(void);
+nvme_wipe_ctrlr(char *ctrlr_pci_addr);
+
+/**
+ * Detach from NVMe controllers attached by nvme_wipe_attach().
+ */
+void
+nvme_wipe_detach(void);
 
 /**
  * Format NVMe controller namespace.
@@ -44,11 +62,41 @@ nvme_format(char *ctrlr_pci_addr);
  * \param ctrlr_pci_addr PCI address of NVMe controller.
  * \param path Local filepath where firmware image is stored.
  * \param slot Identifier of software slot/register to upload to.
+ * \param stage Replace the image in the slot without activating it, the
+ *              image is then activated on the next controller reset.
+ *
+ * \return a pointer to a return struct (ret_t).
+ */
+struct ret_t *
+nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot, bool stage);
+
+/**
+ * Create namespaces on an NVMe controller and attach them to the controller.
+ *
+ * Namespaces use the LBA format of the first active namespace on the
+ * controller, or LBA format 0 if there are no active namespaces.
+ *
+ * \param ctrlr_pci_addr PCI address of NVMe controller.
+ * \param size Size in bytes of each namespace, if zero the unallocated
+ *             capacity of the controller is split between the namespaces.
+ * \param count Number of namespaces to create.
+ *
+ * \return a pointer to a return struct (ret_t), the created namespaces are
+ *         listed under the single returned controller.
+ */
+struct ret_t *
+nvme_ns_create(char *ctrlr_pci_addr, uint64_t size, unsigned int count);
+
+/**
+ * Detach a namespace from an NVMe controller and delete it.
+ *
+ * \param ctrlr_pci_addr PCI address of NVMe controller.
+ * \param nsid Identifier of namespace to delete.
  *
  * \return a pointer to a return struct (ret_t).
  */
 struct ret_t *
-nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot);
+nvme_ns_delete(char *ctrlr_pci_addr, unsigned int nsid);
 
 /**
  * Initialize SPDK environment.
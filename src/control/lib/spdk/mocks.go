@@ -56,6 +56,9 @@ type MockNvmeCfg struct {
 	FormatRes      []*FormatResult
 	FormatErr      error
 	UpdateErr      error
+	CreateNsRes    []*storage.NvmeNamespace
+	CreateNsErr    error
+	DeleteNsErr    error
 	CleanErr       error
 	CleanRes       []string
 }
@@ -92,12 +95,33 @@ func (n MockNvmeImpl) Format(log logging.Logger) ([]*FormatResult, error) {
 }
 
 // Update calls C.nvme_fwupdate to update controller firmware image.
-func (n MockNvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+func (n MockNvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32, stage bool) error {
 	if n.Cfg.UpdateErr != nil {
 		return n.Cfg.UpdateErr
 	}
-	log.Debugf("mock update fw on nvme ssd: %q, image path %q, slot %d",
-		ctrlrPciAddr, path, slot)
+	log.Debugf("mock update fw on nvme ssd: %q, image path %q, slot %d, stage %t",
+		ctrlrPciAddr, path, slot, stage)
+
+	return nil
+}
+
+// CreateNamespaces calls C.nvme_ns_create to create namespaces on a controller.
+func (n MockNvmeImpl) CreateNamespaces(log logging.Logger, ctrlrPciAddr string, size uint64, count uint32) ([]*storage.NvmeNamespace, error) {
+	if n.Cfg.CreateNsErr != nil {
+		return nil, n.Cfg.CreateNsErr
+	}
+	log.Debugf("mock create %d namespaces of size %d on nvme ssd: %q", count, size,
+		ctrlrPciAddr)
+
+	return n.Cfg.CreateNsRes, nil
+}
+
+// DeleteNamespace calls C.nvme_ns_delete to delete a namespace on a controller.
+func (n MockNvmeImpl) DeleteNamespace(log logging.Logger, ctrlrPciAddr string, nsID uint32) error {
+	if n.Cfg.DeleteNsErr != nil {
+		return n.Cfg.DeleteNsErr
+	}
+	log.Debugf("mock delete namespace %d on nvme ssd: %q", nsID, ctrlrPciAddr)
 
 	return nil
 }
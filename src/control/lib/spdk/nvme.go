@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -27,6 +28,52 @@ type FormatResult struct {
 	Err          error
 }
 
+// maxFormatWorkers bounds the number of NVMe controllers that are formatted concurrently.
+const maxFormatWorkers = 8
+
+// ctrlrFormatFn formats the namespaces of the NVMe controller at the given PCI address.
+type ctrlrFormatFn func(ctrlrPciAddr string) ([]*FormatResult, error)
+
+// formatCtrlrs calls the supplied format function for each controller address using at most
+// nrWorkers concurrent workers. A failure to format one controller does not prevent the others
+// from being formatted, the error is instead recorded in a result for the failed controller.
+// Results are returned in the order of the supplied addresses.
+func formatCtrlrs(log logging.Logger, addrs []string, nrWorkers int, format ctrlrFormatFn) []*FormatResult {
+	if nrWorkers < 1 {
+		nrWorkers = 1
+	}
+
+	ctrlrResults := make([][]*FormatResult, len(addrs))
+	workers := make(chan struct{}, nrWorkers)
+	var wg sync.WaitGroup
+
+	for i, addr := range addrs {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, addr string) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+
+			results, err := format(addr)
+			if err != nil {
+				log.Errorf("format of nvme ssd %s failed: %s", addr, err)
+				results = []*FormatResult{{CtrlrPCIAddr: addr, Err: err}}
+			}
+			ctrlrResults[i] = results
+		}(i, addr)
+	}
+	wg.Wait()
+
+	var results []*FormatResult
+	for _, cr := range ctrlrResults {
+		results = append(results, cr...)
+	}
+
+	return results
+}
+
 // LockfileAddrCheckFn is a function supplied to the Clean API call which can be used to decide
 // whether to remove a lockfile for device or not based on its PCI address. This is necessary so
 // that logic outside of this package can be used to determine which addresses to process.
@@ -38,8 +85,14 @@ type Nvme interface {
 	Discover(logging.Logger) (storage.NvmeControllers, error)
 	// Format NVMe controller namespaces
 	Format(logging.Logger) ([]*FormatResult, error)
-	// Update updates the firmware on a specific PCI address and slot
-	Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error
+	// Update updates the firmware on a specific PCI address and slot, optionally staging it
+	// for activation on the next controller reset
+	Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32, stage bool) error
+	// CreateNamespaces creates and attaches namespaces on a specific PCI address, a zero size
+	// splits the unallocated capacity of the controller between the namespaces
+	CreateNamespaces(log logging.Logger, ctrlrPciAddr string, size uint64, count uint32) ([]*storage.NvmeNamespace, error)
+	// DeleteNamespace detaches and deletes a namespace on a specific PCI address
+	DeleteNamespace(log logging.Logger, ctrlrPciAddr string, nsID uint32) error
 	// Clean removes lockfiles associated with NVMe controllers. Decisions regarding which
 	// lockfiles to remove made using supplied address check function.
 	Clean(logging.Logger, LockfileAddrCheckFn) ([]string, error)
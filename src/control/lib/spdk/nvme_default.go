@@ -80,28 +80,41 @@ func (n *NvmeImpl) Discover(log logging.Logger) (storage.NvmeControllers, error)
 
 // Format devices available through SPDK, destructive operation!
 //
-// Attempt wipe of each controller namespace's LBA-0.
+// Attempt wipe of each controller namespace's LBA-0, controllers are wiped concurrently by a
+// bounded number of workers and a failure on one controller does not prevent others from being
+// wiped.
 // Afterwards remove lockfile for each formatted device.
 func (n *NvmeImpl) Format(log logging.Logger) ([]*FormatResult, error) {
 	if n == nil {
 		return nil, errors.New("nil NvmeImpl")
 	}
 
-	results, errCollect := collectFormatResults(C.nvme_wipe_namespaces(),
-		"NVMe Format(): C.nvme_wipe_namespaces()")
+	addrs, err := collectCtrlrAddrs(C.nvme_wipe_attach(),
+		"NVMe Format(): C.nvme_wipe_attach()")
+	if err != nil {
+		return nil, err
+	}
+
+	results := formatCtrlrs(log, addrs, maxFormatWorkers, func(addr string) ([]*FormatResult, error) {
+		csPci := C.CString(addr)
+		defer C.free(unsafe.Pointer(csPci))
+
+		return collectFormatResults(C.nvme_wipe_ctrlr(csPci),
+			"NVMe Format(): C.nvme_wipe_ctrlr()")
+	})
+	C.nvme_wipe_detach()
 
 	pciAddrs := resultPCIAddresses(results)
 	log.Debugf("formatted nvme ssds: %v", pciAddrs)
 
-	errRemLocks := cleanKnownLockfiles(log, n, pciAddrs...)
-
-	return results, wrapCleanError(errCollect, errRemLocks)
+	return results, cleanKnownLockfiles(log, n, pciAddrs...)
 }
 
-// Update updates the firmware image via SPDK in a given slot on the device.
+// Update updates the firmware image via SPDK in a given slot on the device. If stage is set, the
+// image is committed without being activated until the next controller reset.
 //
 // Afterwards remove lockfile for the updated device.
-func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32, stage bool) error {
 	if n == nil {
 		return errors.New("nil NvmeImpl")
 	}
@@ -112,7 +125,7 @@ func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string,
 	csPci := C.CString(ctrlrPciAddr)
 	defer C.free(unsafe.Pointer(csPci))
 
-	_, errCollect := collectCtrlrs(C.nvme_fwupdate(csPci, csPath, C.uint(slot)),
+	_, errCollect := collectCtrlrs(C.nvme_fwupdate(csPci, csPath, C.uint(slot), C.bool(stage)),
 		"NVMe Update(): C.nvme_fwupdate")
 
 	errRemLocks := cleanKnownLockfiles(log, n, ctrlrPciAddr)
@@ -120,6 +133,46 @@ func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string,
 	return wrapCleanError(errCollect, errRemLocks)
 }
 
+// CreateNamespaces creates namespaces of the given size on the device and attaches them to the
+// controller. If size is zero, the unallocated capacity of the device is split evenly between the
+// namespaces.
+//
+// Afterwards remove lockfile for the updated device.
+func (n *NvmeImpl) CreateNamespaces(log logging.Logger, ctrlrPciAddr string, size uint64, count uint32) ([]*storage.NvmeNamespace, error) {
+	if n == nil {
+		return nil, errors.New("nil NvmeImpl")
+	}
+
+	csPci := C.CString(ctrlrPciAddr)
+	defer C.free(unsafe.Pointer(csPci))
+
+	nss, errCollect := collectNamespaces(C.nvme_ns_create(csPci, C.uint64_t(size), C.uint(count)),
+		"NVMe CreateNamespaces(): C.nvme_ns_create")
+
+	errRemLocks := cleanKnownLockfiles(log, n, ctrlrPciAddr)
+
+	return nss, wrapCleanError(errCollect, errRemLocks)
+}
+
+// DeleteNamespace detaches the namespace with the given ID from the controller and deletes it.
+//
+// Afterwards remove lockfile for the updated device.
+func (n *NvmeImpl) DeleteNamespace(log logging.Logger, ctrlrPciAddr string, nsID uint32) error {
+	if n == nil {
+		return errors.New("nil NvmeImpl")
+	}
+
+	csPci := C.CString(ctrlrPciAddr)
+	defer C.free(unsafe.Pointer(csPci))
+
+	_, errCollect := collectNamespaces(C.nvme_ns_delete(csPci, C.uint(nsID)),
+		"NVMe DeleteNamespace(): C.nvme_ns_delete")
+
+	errRemLocks := cleanKnownLockfiles(log, n, ctrlrPciAddr)
+
+	return wrapCleanError(errCollect, errRemLocks)
+}
+
 // c2GoController is a private translation function.
 func c2GoController(ctrlr *C.struct_nvme_ctrlr_t) *storage.NvmeController {
 	return &storage.NvmeController{
@@ -173,8 +226,9 @@ func c2GoDeviceHealth(hs *C.struct_nvme_stats) *storage.NvmeHealth {
 // c2GoNamespace is a private translation function.
 func c2GoNamespace(ns *C.struct_nvme_ns_t) *storage.NvmeNamespace {
 	return &storage.NvmeNamespace{
-		ID:   uint32(ns.id),
-		Size: uint64(ns.size),
+		ID:    uint32(ns.id),
+		Size:  uint64(ns.size),
+		Zoned: bool(ns.zoned),
 	}
 }
 
@@ -262,6 +316,40 @@ func collectCtrlrs(retPtr *C.struct_ret_t, msgFail string) (storage.NvmeControll
 	return ctrlrs, nil
 }
 
+// collectCtrlrAddrs parses return struct to collect PCI addresses of controllers.
+func collectCtrlrAddrs(retPtr *C.struct_ret_t, msgFail string) ([]string, error) {
+	defer clean(retPtr)
+
+	if err := checkRet(retPtr, msgFail); err != nil {
+		return nil, err
+	}
+
+	var addrs []string
+	for ctrlrPtr := retPtr.ctrlrs; ctrlrPtr != nil; ctrlrPtr = ctrlrPtr.next {
+		addrs = append(addrs, C.GoString(ctrlrPtr.pci_addr))
+	}
+
+	return addrs, nil
+}
+
+// collectNamespaces parses return struct to collect namespaces listed under controllers.
+func collectNamespaces(retPtr *C.struct_ret_t, msgFail string) ([]*storage.NvmeNamespace, error) {
+	defer clean(retPtr)
+
+	if err := checkRet(retPtr, msgFail); err != nil {
+		return nil, err
+	}
+
+	var nss []*storage.NvmeNamespace
+	for ctrlrPtr := retPtr.ctrlrs; ctrlrPtr != nil; ctrlrPtr = ctrlrPtr.next {
+		for nsPtr := ctrlrPtr.nss; nsPtr != nil; nsPtr = nsPtr.next {
+			nss = append(nss, c2GoNamespace(nsPtr))
+		}
+	}
+
+	return nss, nil
+}
+
 // collectFormatResults parses return struct to collect slice of
 // nvme.FormatResult.
 func collectFormatResults(retPtr *C.struct_ret_t, msgFail string) ([]*FormatResult, error) {
@@ -25,7 +25,17 @@ func (n *NvmeImpl) Format(log logging.Logger) ([]*FormatResult, error) {
 }
 
 // Update updates the firmware image via SPDK in a given slot on the device.
-func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32) error {
+func (n *NvmeImpl) Update(log logging.Logger, ctrlrPciAddr string, path string, slot int32, stage bool) error {
+	return nil
+}
+
+// CreateNamespaces creates namespaces on the device.
+func (n *NvmeImpl) CreateNamespaces(log logging.Logger, ctrlrPciAddr string, size uint64, count uint32) ([]*storage.NvmeNamespace, error) {
+	return []*storage.NvmeNamespace{}, nil
+}
+
+// DeleteNamespace deletes a namespace on the device.
+func (n *NvmeImpl) DeleteNamespace(log logging.Logger, ctrlrPciAddr string, nsID uint32) error {
 	return nil
 }
 
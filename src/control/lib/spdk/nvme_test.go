@@ -10,7 +10,9 @@ package spdk
 import (
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -267,3 +269,82 @@ func TestSpdk_WrapCleanError(t *testing.T) {
 		})
 	}
 }
+
+func TestSpdk_formatCtrlrs(t *testing.T) {
+	addrs := []string{"0000:01:00.0", "0000:02:00.0", "0000:03:00.0", "0000:04:00.0"}
+
+	for name, tc := range map[string]struct {
+		nrWorkers  int
+		failAddrs  map[string]bool
+		expResults []*FormatResult
+	}{
+		"single worker": {
+			nrWorkers: 1,
+			expResults: []*FormatResult{
+				{CtrlrPCIAddr: addrs[0], NsID: 1},
+				{CtrlrPCIAddr: addrs[1], NsID: 1},
+				{CtrlrPCIAddr: addrs[2], NsID: 1},
+				{CtrlrPCIAddr: addrs[3], NsID: 1},
+			},
+		},
+		"fewer workers than controllers; partial failure": {
+			nrWorkers: 2,
+			failAddrs: map[string]bool{addrs[1]: true},
+			expResults: []*FormatResult{
+				{CtrlrPCIAddr: addrs[0], NsID: 1},
+				{CtrlrPCIAddr: addrs[1], Err: sampleErr1},
+				{CtrlrPCIAddr: addrs[2], NsID: 1},
+				{CtrlrPCIAddr: addrs[3], NsID: 1},
+			},
+		},
+		"more workers than controllers; all failed": {
+			nrWorkers: 8,
+			failAddrs: map[string]bool{
+				addrs[0]: true, addrs[1]: true, addrs[2]: true, addrs[3]: true,
+			},
+			expResults: []*FormatResult{
+				{CtrlrPCIAddr: addrs[0], Err: sampleErr1},
+				{CtrlrPCIAddr: addrs[1], Err: sampleErr1},
+				{CtrlrPCIAddr: addrs[2], Err: sampleErr1},
+				{CtrlrPCIAddr: addrs[3], Err: sampleErr1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var mu sync.Mutex
+			var active, maxActive int
+
+			gotResults := formatCtrlrs(log, addrs, tc.nrWorkers,
+				func(addr string) ([]*FormatResult, error) {
+					mu.Lock()
+					active++
+					if active > maxActive {
+						maxActive = active
+					}
+					mu.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					mu.Lock()
+					active--
+					mu.Unlock()
+
+					if tc.failAddrs[addr] {
+						return nil, sampleErr1
+					}
+					return []*FormatResult{{CtrlrPCIAddr: addr, NsID: 1}}, nil
+				})
+
+			if diff := cmp.Diff(tc.expResults, gotResults, cmp.Comparer(test.CmpErrBool)); diff != "" {
+				t.Fatalf("unexpected results (-want, +got):\n%s\n", diff)
+			}
+			if maxActive > tc.nrWorkers {
+				t.Fatalf("expected at most %d concurrent formats, got %d", tc.nrWorkers,
+					maxActive)
+			}
+		})
+	}
+}
@@ -221,43 +221,13 @@ wipe_ctrlr(struct ctrlr_entry *centry)
 	return res;
 }
 
-static struct wipe_res_t *
-wipe_ctrlrs(void)
-{
-	struct ctrlr_entry	*centry = g_controllers;
-	struct wipe_res_t	*start = NULL, *end = NULL;
-
-	while (centry != NULL) {
-		struct wipe_res_t *results = wipe_ctrlr(centry);
-		struct wipe_res_t *tmp = results;
-
-		if (results == NULL) {
-			continue;
-		}
-
-		if (start == NULL) {
-			start = results;
-		} else if (end != NULL) {
-			end->next = results;
-		}
-
-		/* update ptr to last in list */
-		while (tmp != NULL) {
-			end = tmp;
-			tmp = tmp->next;
-		}
-
-		centry = centry->next;
-	}
-
-	return start;
-}
-
 struct ret_t *
-nvme_wipe_namespaces(void)
+nvme_wipe_attach(void)
 {
-	struct ret_t	*ret;
-	int		 rc;
+	struct ctrlr_entry	*centry;
+	struct nvme_ctrlr_t	*ctrlr_tmp;
+	struct ret_t		*ret;
+	int			 rc;
 
 	ret = init_ret();
 
@@ -272,27 +242,76 @@ nvme_wipe_namespaces(void)
 	if (rc < 0) {
 		snprintf(ret->info, sizeof(ret->info), "spdk_nvme_probe()\n");
 		ret->rc = rc;
-		goto out;
+		goto fail;
 	}
 
 	if (g_controllers == NULL) {
 		snprintf(ret->info, sizeof(ret->info), "no controllers found\n");
 		ret->rc = -ENOENT;
-		goto out;
+		goto fail;
 	}
 
-	ret->wipe_results = wipe_ctrlrs();
+	/** return address of each attached controller so they can be wiped individually */
+	for (centry = g_controllers; centry != NULL; centry = centry->next) {
+		ctrlr_tmp = calloc(1, sizeof(struct nvme_ctrlr_t));
+		if (ctrlr_tmp == NULL) {
+			ret->rc = -ENOMEM;
+			goto fail;
+		}
+		ctrlr_tmp->next = ret->ctrlrs;
+		ret->ctrlrs = ctrlr_tmp;
+
+		ctrlr_tmp->pci_addr = calloc(1, SPDK_NVMF_TRADDR_MAX_LEN + 1);
+		if (ctrlr_tmp->pci_addr == NULL) {
+			ret->rc = -ENOMEM;
+			goto fail;
+		}
+
+		rc = spdk_pci_addr_fmt(ctrlr_tmp->pci_addr, SPDK_NVMF_TRADDR_MAX_LEN,
+				       &centry->pci_addr);
+		if (rc != 0) {
+			ret->rc = -NVMEC_ERR_PCI_ADDR_FMT;
+			goto fail;
+		}
+	}
+
+	return ret;
+fail:
+	clean_ret(ret);
+	cleanup(true);
+	return ret;
+}
+
+struct ret_t *
+nvme_wipe_ctrlr(char *ctrlr_pci_addr)
+{
+	struct ctrlr_entry	*centry = NULL;
+	struct ret_t		*ret;
+
+	ret = init_ret();
+
+	ret->rc = get_controller(&centry, ctrlr_pci_addr);
+	if (ret->rc != 0) {
+		snprintf(ret->info, sizeof(ret->info), "controller %s not attached\n",
+			 ctrlr_pci_addr);
+		return ret;
+	}
+
+	ret->wipe_results = wipe_ctrlr(centry);
 	if (ret->wipe_results == NULL) {
 		snprintf(ret->info, sizeof(ret->info), "no namespaces on controller\n");
 		ret->rc = -ENOENT;
-		goto out;
 	}
 
-out:
-	cleanup(true);
 	return ret;
 }
 
+void
+nvme_wipe_detach(void)
+{
+	cleanup(true);
+}
+
 struct ret_t *
 nvme_format(char *ctrlr_pci_addr)
 {
@@ -353,7 +372,7 @@ nvme_format(char *ctrlr_pci_addr)
 }
 
 struct ret_t *
-nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
+nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot, bool stage)
 {
 	int					rc = 1;
 	int					fd = -1;
@@ -411,7 +430,10 @@ nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
 	}
 	close(fd);
 
-	commit_action = SPDK_NVME_FW_COMMIT_REPLACE_AND_ENABLE_IMG;
+	if (stage)
+		commit_action = SPDK_NVME_FW_COMMIT_REPLACE_IMG;
+	else
+		commit_action = SPDK_NVME_FW_COMMIT_REPLACE_AND_ENABLE_IMG;
 	rc = spdk_nvme_ctrlr_update_firmware(ctrlr_entry->ctrlr, fw_image, size,
 					     slot, commit_action, &status);
 	if (rc == -ENXIO && status.sct == SPDK_NVME_SCT_COMMAND_SPECIFIC &&
@@ -429,6 +451,203 @@ nvme_fwupdate(char *ctrlr_pci_addr, char *path, unsigned int slot)
 	return ret;
 }
 
+/** probe and attach controllers then find the one that is to be managed */
+static int
+ns_mgmt_attach(struct ret_t *ret, char *ctrlr_pci_addr, struct ctrlr_entry **centry)
+{
+	const struct spdk_nvme_ctrlr_data	*cdata;
+	int					 rc;
+
+	rc = spdk_nvme_probe(NULL, NULL, probe_cb, attach_cb, NULL);
+	if (rc < 0) {
+		snprintf(ret->info, sizeof(ret->info), "spdk_nvme_probe()\n");
+		return rc;
+	}
+
+	rc = get_controller(centry, ctrlr_pci_addr);
+	if (rc != 0) {
+		snprintf(ret->info, sizeof(ret->info), "controller %s not found\n",
+			 ctrlr_pci_addr);
+		return rc;
+	}
+
+	cdata = spdk_nvme_ctrlr_get_data((*centry)->ctrlr);
+	if (!cdata->oacs.ns_manage) {
+		snprintf(ret->info, sizeof(ret->info),
+			 "controller does not support namespace management\n");
+		return -NVMEC_ERR_NOT_SUPPORTED;
+	}
+
+	return 0;
+}
+
+/** add details of a namespace to the single controller in the return struct */
+static int
+add_ns_result(struct ret_t *ret, char *ctrlr_pci_addr, uint32_t nsid, uint64_t size)
+{
+	struct nvme_ns_t *ns_tmp;
+
+	if (ret->ctrlrs == NULL) {
+		ret->ctrlrs = calloc(1, sizeof(struct nvme_ctrlr_t));
+		if (ret->ctrlrs == NULL)
+			return -ENOMEM;
+		ret->ctrlrs->pci_addr = strndup(ctrlr_pci_addr, SPDK_NVMF_TRADDR_MAX_LEN);
+		if (ret->ctrlrs->pci_addr == NULL)
+			return -ENOMEM;
+	}
+
+	ns_tmp = calloc(1, sizeof(struct nvme_ns_t));
+	if (ns_tmp == NULL)
+		return -ENOMEM;
+	ns_tmp->id = nsid;
+	ns_tmp->size = size;
+	ns_tmp->next = ret->ctrlrs->nss;
+	ret->ctrlrs->nss = ns_tmp;
+
+	return 0;
+}
+
+struct ret_t *
+nvme_ns_create(char *ctrlr_pci_addr, uint64_t size, unsigned int count)
+{
+	const struct spdk_nvme_ctrlr_data	*cdata;
+	const struct spdk_nvme_ns_data		*nsdata;
+	struct spdk_nvme_ns_data		 ndata = {};
+	struct spdk_nvme_ctrlr_list		 ctrlr_list = {};
+	struct ctrlr_entry			*centry;
+	struct ret_t				*ret;
+	uint64_t				 unalloc, nr_lbas;
+	uint32_t				 sector_size = 512;
+	uint32_t				 nsid;
+	uint8_t					 lbaf = 0;
+	unsigned int				 i;
+	int					 rc;
+
+	ret = init_ret();
+
+	if (count == 0) {
+		snprintf(ret->info, sizeof(ret->info), "zero namespaces requested\n");
+		ret->rc = -EINVAL;
+		return ret;
+	}
+
+	rc = ns_mgmt_attach(ret, ctrlr_pci_addr, &centry);
+	if (rc != 0)
+		goto out;
+
+	/** new namespaces share the LBA format of any existing namespace */
+	if (centry->nss != NULL) {
+		nsdata = spdk_nvme_ns_get_data(centry->nss->ns);
+		lbaf = nsdata->flbas.format;
+		sector_size = spdk_nvme_ns_get_sector_size(centry->nss->ns);
+	}
+
+	cdata = spdk_nvme_ctrlr_get_data(centry->ctrlr);
+	unalloc = cdata->unvmcap[0];
+	if (size == 0)
+		size = unalloc / count;
+	if (size > unalloc / count) {
+		snprintf(ret->info, sizeof(ret->info),
+			 "%u namespaces of %" PRIu64 " bytes exceed unallocated capacity of %"
+			 PRIu64 " bytes\n", count, size, unalloc);
+		rc = -ENOSPC;
+		goto out;
+	}
+
+	nr_lbas = size / sector_size;
+	if (nr_lbas == 0) {
+		snprintf(ret->info, sizeof(ret->info),
+			 "namespace size smaller than sector size %u\n", sector_size);
+		rc = -NVMEC_ERR_BAD_LBA;
+		goto out;
+	}
+
+	ndata.nsze		= nr_lbas;
+	ndata.ncap		= nr_lbas;
+	ndata.flbas.format	= lbaf;
+
+	ctrlr_list.ctrlr_count		= 1;
+	ctrlr_list.ctrlr_list[0]	= cdata->cntlid;
+
+	for (i = 0; i < count; i++) {
+		nsid = spdk_nvme_ctrlr_create_ns(centry->ctrlr, &ndata);
+		if (nsid == 0) {
+			snprintf(ret->info, sizeof(ret->info),
+				 "spdk_nvme_ctrlr_create_ns() failed\n");
+			rc = -NVMEC_ERR_NOT_SUPPORTED;
+			break;
+		}
+
+		rc = spdk_nvme_ctrlr_attach_ns(centry->ctrlr, nsid, &ctrlr_list);
+		if (rc != 0) {
+			snprintf(ret->info, sizeof(ret->info),
+				 "spdk_nvme_ctrlr_attach_ns() failed for ns %u\n", nsid);
+			spdk_nvme_ctrlr_delete_ns(centry->ctrlr, nsid);
+			break;
+		}
+
+		rc = add_ns_result(ret, ctrlr_pci_addr, nsid, nr_lbas * sector_size);
+		if (rc != 0)
+			break;
+	}
+
+	/* print address of device updated for verification purposes */
+	printf("Created %u namespaces on NVMe Controller at %s\n", i, ctrlr_pci_addr);
+out:
+	ret->rc = rc;
+	cleanup(true);
+	return ret;
+}
+
+struct ret_t *
+nvme_ns_delete(char *ctrlr_pci_addr, unsigned int nsid)
+{
+	const struct spdk_nvme_ctrlr_data	*cdata;
+	struct spdk_nvme_ctrlr_list		 ctrlr_list = {};
+	struct ctrlr_entry			*centry;
+	struct ret_t				*ret;
+	int					 rc;
+
+	ret = init_ret();
+
+	rc = ns_mgmt_attach(ret, ctrlr_pci_addr, &centry);
+	if (rc != 0)
+		goto out;
+
+	if (nsid == 0 || nsid > spdk_nvme_ctrlr_get_num_ns(centry->ctrlr)) {
+		snprintf(ret->info, sizeof(ret->info), "namespace with id %u not found\n",
+			 nsid);
+		rc = -NVMEC_ERR_NS_NOT_FOUND;
+		goto out;
+	}
+
+	if (spdk_nvme_ctrlr_is_active_ns(centry->ctrlr, nsid)) {
+		cdata = spdk_nvme_ctrlr_get_data(centry->ctrlr);
+		ctrlr_list.ctrlr_count		= 1;
+		ctrlr_list.ctrlr_list[0]	= cdata->cntlid;
+
+		rc = spdk_nvme_ctrlr_detach_ns(centry->ctrlr, nsid, &ctrlr_list);
+		if (rc != 0) {
+			snprintf(ret->info, sizeof(ret->info),
+				 "spdk_nvme_ctrlr_detach_ns() failed\n");
+			goto out;
+		}
+	}
+
+	rc = spdk_nvme_ctrlr_delete_ns(centry->ctrlr, nsid);
+	if (rc != 0) {
+		snprintf(ret->info, sizeof(ret->info), "spdk_nvme_ctrlr_delete_ns() failed\n");
+		goto out;
+	}
+
+	/* print address of device updated for verification purposes */
+	printf("Deleted namespace %u on NVMe Controller at %s\n", nsid, ctrlr_pci_addr);
+out:
+	ret->rc = rc;
+	cleanup(true);
+	return ret;
+}
+
 static int
 is_addr_in_allowlist(char *pci_addr, const struct spdk_pci_addr *allowlist,
 		     int num_allowlist_devices)
@@ -300,6 +300,7 @@ collect_namespaces(struct ns_entry *ns_entry, struct nvme_ctrlr_t *ctrlr)
 
 		ns_tmp->id = spdk_nvme_ns_get_id(ns_entry->ns);
 		ns_tmp->size = spdk_nvme_ns_get_size(ns_entry->ns);
+		ns_tmp->zoned = spdk_nvme_ns_get_csi(ns_entry->ns) == SPDK_NVME_CSI_ZNS;
 		ns_tmp->next = ctrlr->nss;
 		ctrlr->nss = ns_tmp;
 
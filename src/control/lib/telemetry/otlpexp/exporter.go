@@ -0,0 +1,224 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+// Package otlpexp pushes DAOS telemetry to an OpenTelemetry collector using the OTLP gRPC
+// protocol, for sites that do not scrape the Prometheus endpoint.
+package otlpexp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/daos-stack/daos/src/control/build"
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	metricsExportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+	traceExportMethod   = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+
+	// DefaultPushInterval is the default interval between pushes to the collector.
+	DefaultPushInterval = time.Minute
+
+	pushTimeout = 10 * time.Second
+)
+
+var instrumentationScope = &otlppb.InstrumentationScope{
+	Name:    "daos",
+	Version: build.DaosVersion,
+}
+
+type (
+	// ExporterConfig defines the configuration for the OTLP exporter.
+	ExporterConfig struct {
+		Endpoint string            // collector address (host:port)
+		Interval time.Duration     // interval between pushes
+		Insecure bool              // disable TLS
+		CACert   string            // CA certificate used to verify the collector
+		Headers  map[string]string // gRPC metadata sent with each push, e.g. for authentication
+		Resource map[string]string // attributes describing the source of the telemetry
+		Gatherer prometheus.Gatherer
+		Spans    *SpanRecorder // spans to push, if non-nil
+	}
+
+	exporter struct {
+		log       logging.Logger
+		cfg       *ExporterConfig
+		conn      *grpc.ClientConn
+		resource  *otlppb.Resource
+		startTime time.Time
+	}
+)
+
+func dialOptions(cfg *ExporterConfig) ([]grpc.DialOption, error) {
+	if cfg.Insecure {
+		return []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CACert != "" {
+		pem, err := os.ReadFile(cfg.CACert)
+		if err != nil {
+			return nil, errors.Wrap(err, "reading CA certificate")
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in %q", cfg.CACert)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg))}, nil
+}
+
+func (e *exporter) withHeaders(ctx context.Context) context.Context {
+	if len(e.cfg.Headers) == 0 {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, metadata.New(e.cfg.Headers))
+}
+
+func (e *exporter) pushMetrics(ctx context.Context) error {
+	families, err := e.cfg.Gatherer.Gather()
+	if err != nil {
+		// Gather returns the metrics it was able to collect along with the error.
+		e.log.Errorf("otlp: failed to gather some metrics: %s", err)
+	}
+	if len(families) == 0 {
+		return nil
+	}
+
+	req := metricsRequest(e.resource, families, e.startTime, time.Now())
+	resp := new(otlppb.ExportMetricsServiceResponse)
+	if err := e.conn.Invoke(e.withHeaders(ctx), metricsExportMethod, req, resp); err != nil {
+		return errors.Wrap(err, "exporting metrics")
+	}
+	if ps := resp.GetPartialSuccess(); ps.GetRejectedDataPoints() > 0 {
+		e.log.Noticef("otlp: collector rejected %d data points: %s", ps.GetRejectedDataPoints(),
+			ps.GetErrorMessage())
+	}
+
+	return nil
+}
+
+func (e *exporter) pushSpans(ctx context.Context) error {
+	if e.cfg.Spans == nil {
+		return nil
+	}
+
+	spans, dropped := e.cfg.Spans.Drain()
+	if dropped > 0 {
+		e.log.Noticef("otlp: dropped %d spans since last push", dropped)
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	req := spansRequest(e.resource, spans)
+	resp := new(otlppb.ExportTraceServiceResponse)
+	if err := e.conn.Invoke(e.withHeaders(ctx), traceExportMethod, req, resp); err != nil {
+		return errors.Wrapf(err, "exporting %d spans", len(spans))
+	}
+	if ps := resp.GetPartialSuccess(); ps.GetRejectedSpans() > 0 {
+		e.log.Noticef("otlp: collector rejected %d spans: %s", ps.GetRejectedSpans(),
+			ps.GetErrorMessage())
+	}
+
+	return nil
+}
+
+// push sends the current metrics and any recorded spans to the collector.
+func (e *exporter) push(parent context.Context) {
+	ctx, cancel := context.WithTimeout(parent, pushTimeout)
+	defer cancel()
+
+	if err := e.pushMetrics(ctx); err != nil {
+		e.log.Errorf("otlp: %s", err)
+	}
+	if err := e.pushSpans(ctx); err != nil {
+		e.log.Errorf("otlp: %s", err)
+	}
+}
+
+func (e *exporter) pushLoop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(e.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.push(ctx)
+		}
+	}
+}
+
+// StartExporter starts pushing telemetry to the collector at the configured interval. The
+// returned function stops the exporter after a final push.
+func StartExporter(ctx context.Context, log logging.Logger, cfg *ExporterConfig) (func(), error) {
+	if cfg == nil {
+		return nil, errors.New("invalid exporter config: nil config")
+	}
+	if cfg.Endpoint == "" {
+		return nil, errors.New("invalid exporter config: no endpoint")
+	}
+	if cfg.Gatherer == nil {
+		return nil, errors.New("invalid exporter config: nil gatherer")
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultPushInterval
+	}
+
+	opts, err := dialOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(ctx, cfg.Endpoint, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to %s", cfg.Endpoint)
+	}
+
+	e := &exporter{
+		log:  log,
+		cfg:  cfg,
+		conn: conn,
+		resource: &otlppb.Resource{
+			Attributes: mapAttrs(cfg.Resource),
+		},
+		startTime: time.Now(),
+	}
+
+	loopCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go e.pushLoop(loopCtx, done)
+	log.Infof("Pushing telemetry to %s every %s", cfg.Endpoint, cfg.Interval)
+
+	return func() {
+		log.Debug("Shutting down OTLP exporter")
+
+		cancel()
+		<-done
+		// Push whatever was recorded since the last interval before closing the connection.
+		e.push(context.Background())
+		if err := conn.Close(); err != nil {
+			log.Noticef("otlp: closing connection: %s", err)
+		}
+	}, nil
+}
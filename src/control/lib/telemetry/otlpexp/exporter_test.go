@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package otlpexp
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// mockCollector is a minimal OTLP collector that records the requests it receives.
+type mockCollector struct {
+	sync.Mutex
+	metrics []*otlppb.ExportMetricsServiceRequest
+	traces  []*otlppb.ExportTraceServiceRequest
+	headers []metadata.MD
+}
+
+func (mc *mockCollector) recordHeaders(ctx context.Context) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	mc.headers = append(mc.headers, md)
+}
+
+func (mc *mockCollector) exportMetrics(ctx context.Context, req *otlppb.ExportMetricsServiceRequest) (*otlppb.ExportMetricsServiceResponse, error) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	mc.recordHeaders(ctx)
+	mc.metrics = append(mc.metrics, req)
+	return new(otlppb.ExportMetricsServiceResponse), nil
+}
+
+func (mc *mockCollector) exportTraces(ctx context.Context, req *otlppb.ExportTraceServiceRequest) (*otlppb.ExportTraceServiceResponse, error) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	mc.recordHeaders(ctx)
+	mc.traces = append(mc.traces, req)
+	return new(otlppb.ExportTraceServiceResponse), nil
+}
+
+func metricsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(otlppb.ExportMetricsServiceRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(*mockCollector).exportMetrics(ctx, req)
+}
+
+func tracesHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(otlppb.ExportTraceServiceRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(*mockCollector).exportTraces(ctx, req)
+}
+
+func startMockCollector(t *testing.T) (*mockCollector, string) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mc := new(mockCollector)
+	srv := grpc.NewServer()
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "opentelemetry.proto.collector.metrics.v1.MetricsService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Export",
+				Handler:    metricsHandler,
+			},
+		},
+	}, mc)
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "opentelemetry.proto.collector.trace.v1.TraceService",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Export",
+				Handler:    tracesHandler,
+			},
+		},
+	}, mc)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	return mc, lis.Addr().String()
+}
+
+func TestOTLPExp_StartExporter(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *ExporterConfig
+		expErr error
+	}{
+		"nil cfg": {
+			expErr: errors.New("nil config"),
+		},
+		"no endpoint": {
+			cfg: &ExporterConfig{
+				Gatherer: prometheus.NewRegistry(),
+			},
+			expErr: errors.New("no endpoint"),
+		},
+		"no gatherer": {
+			cfg: &ExporterConfig{
+				Endpoint: "localhost:4317",
+			},
+			expErr: errors.New("nil gatherer"),
+		},
+		"missing CA cert": {
+			cfg: &ExporterConfig{
+				Endpoint: "localhost:4317",
+				CACert:   "/does/not/exist",
+				Gatherer: prometheus.NewRegistry(),
+			},
+			expErr: errors.New("reading CA certificate"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			stop, err := StartExporter(test.Context(t), log, tc.cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if err == nil {
+				stop()
+			}
+		})
+	}
+}
+
+func TestOTLPExp_Exporter_Push(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mc, addr := startMockCollector(t)
+
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "engine_test_ops",
+		Help: "test ops",
+	})
+	reg.MustRegister(counter)
+	counter.Add(3)
+
+	spans := NewSpanRecorder(0)
+	spans.Record("PoolCreate", time.Now(), time.Now(), nil, nil)
+
+	stop, err := StartExporter(test.Context(t), log, &ExporterConfig{
+		Endpoint: addr,
+		Interval: time.Hour,
+		Insecure: true,
+		Headers:  map[string]string{"x-api-key": "secret"},
+		Resource: map[string]string{"service.name": "daos_server"},
+		Gatherer: reg,
+		Spans:    spans,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Stopping the exporter performs a final push.
+	stop()
+
+	mc.Lock()
+	defer mc.Unlock()
+
+	if len(mc.metrics) != 1 {
+		t.Fatalf("expected 1 metrics export, got %d", len(mc.metrics))
+	}
+	rm := mc.metrics[0].GetResourceMetrics()[0]
+	if rm.GetResource().GetAttributes()[0].GetValue().GetStringValue() != "daos_server" {
+		t.Fatalf("unexpected resource %+v", rm.GetResource())
+	}
+	metric := rm.GetScopeMetrics()[0].GetMetrics()[0]
+	if metric.GetName() != "engine_test_ops" {
+		t.Fatalf("unexpected metric name %q", metric.GetName())
+	}
+	if val := metric.GetSum().GetDataPoints()[0].GetAsDouble(); val != 3 {
+		t.Fatalf("unexpected metric value %f", val)
+	}
+
+	if len(mc.traces) != 1 {
+		t.Fatalf("expected 1 trace export, got %d", len(mc.traces))
+	}
+	span := mc.traces[0].GetResourceSpans()[0].GetScopeSpans()[0].GetSpans()[0]
+	if span.GetName() != "PoolCreate" {
+		t.Fatalf("unexpected span name %q", span.GetName())
+	}
+
+	for _, md := range mc.headers {
+		if got := md.Get("x-api-key"); len(got) != 1 || got[0] != "secret" {
+			t.Fatalf("expected header to be sent, got %v", md)
+		}
+	}
+}
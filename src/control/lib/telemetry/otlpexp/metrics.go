@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package otlpexp
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+)
+
+func stringAttr(key, value string) *otlppb.KeyValue {
+	return &otlppb.KeyValue{
+		Key: key,
+		Value: &otlppb.AnyValue{
+			Value: &otlppb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
+
+// mapAttrs converts a map of strings into OTLP attributes, sorted by key.
+func mapAttrs(in map[string]string) []*otlppb.KeyValue {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]*otlppb.KeyValue, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, stringAttr(key, in[key]))
+	}
+	return attrs
+}
+
+func labelAttrs(labels []*dto.LabelPair) []*otlppb.KeyValue {
+	attrs := make([]*otlppb.KeyValue, 0, len(labels))
+	for _, lp := range labels {
+		attrs = append(attrs, stringAttr(lp.GetName(), lp.GetValue()))
+	}
+	return attrs
+}
+
+func unixNano(t time.Time) uint64 {
+	return uint64(t.UnixNano())
+}
+
+func numberPoint(m *dto.Metric, value float64, start, now time.Time) *otlppb.NumberDataPoint {
+	return &otlppb.NumberDataPoint{
+		Attributes:        labelAttrs(m.GetLabel()),
+		StartTimeUnixNano: unixNano(start),
+		TimeUnixNano:      unixNano(now),
+		Value:             &otlppb.NumberDataPoint_AsDouble{AsDouble: value},
+	}
+}
+
+// histogramPoint converts a Prometheus histogram, whose buckets hold cumulative counts, into an
+// OTLP data point holding the count of each bucket, including the implicit +Inf bucket.
+func histogramPoint(m *dto.Metric, start, now time.Time) *otlppb.HistogramDataPoint {
+	h := m.GetHistogram()
+	sum := h.GetSampleSum()
+	dp := &otlppb.HistogramDataPoint{
+		Attributes:        labelAttrs(m.GetLabel()),
+		StartTimeUnixNano: unixNano(start),
+		TimeUnixNano:      unixNano(now),
+		Count:             h.GetSampleCount(),
+		Sum:               &sum,
+	}
+
+	var prev uint64
+	for _, b := range h.GetBucket() {
+		if math.IsInf(b.GetUpperBound(), 1) {
+			break
+		}
+		dp.ExplicitBounds = append(dp.ExplicitBounds, b.GetUpperBound())
+		dp.BucketCounts = append(dp.BucketCounts, b.GetCumulativeCount()-prev)
+		prev = b.GetCumulativeCount()
+	}
+	dp.BucketCounts = append(dp.BucketCounts, h.GetSampleCount()-prev)
+
+	return dp
+}
+
+func summaryPoint(m *dto.Metric, start, now time.Time) *otlppb.SummaryDataPoint {
+	s := m.GetSummary()
+	dp := &otlppb.SummaryDataPoint{
+		Attributes:        labelAttrs(m.GetLabel()),
+		StartTimeUnixNano: unixNano(start),
+		TimeUnixNano:      unixNano(now),
+		Count:             s.GetSampleCount(),
+		Sum:               s.GetSampleSum(),
+	}
+	for _, q := range s.GetQuantile() {
+		dp.QuantileValues = append(dp.QuantileValues, &otlppb.SummaryDataPoint_ValueAtQuantile{
+			Quantile: q.GetQuantile(),
+			Value:    q.GetValue(),
+		})
+	}
+
+	return dp
+}
+
+// convertFamily converts a gathered Prometheus metric family into an OTLP metric. Counters and
+// histograms are reported as cumulative since the start time.
+func convertFamily(mf *dto.MetricFamily, start, now time.Time) *otlppb.Metric {
+	out := &otlppb.Metric{
+		Name:        mf.GetName(),
+		Description: mf.GetHelp(),
+	}
+
+	switch mf.GetType() {
+	case dto.MetricType_COUNTER:
+		sum := &otlppb.Sum{
+			AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}
+		for _, m := range mf.GetMetric() {
+			sum.DataPoints = append(sum.DataPoints, numberPoint(m, m.GetCounter().GetValue(), start, now))
+		}
+		out.Data = &otlppb.Metric_Sum{Sum: sum}
+	case dto.MetricType_GAUGE:
+		gauge := new(otlppb.Gauge)
+		for _, m := range mf.GetMetric() {
+			gauge.DataPoints = append(gauge.DataPoints, numberPoint(m, m.GetGauge().GetValue(), start, now))
+		}
+		out.Data = &otlppb.Metric_Gauge{Gauge: gauge}
+	case dto.MetricType_UNTYPED:
+		gauge := new(otlppb.Gauge)
+		for _, m := range mf.GetMetric() {
+			gauge.DataPoints = append(gauge.DataPoints, numberPoint(m, m.GetUntyped().GetValue(), start, now))
+		}
+		out.Data = &otlppb.Metric_Gauge{Gauge: gauge}
+	case dto.MetricType_HISTOGRAM:
+		hist := &otlppb.Histogram{
+			AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}
+		for _, m := range mf.GetMetric() {
+			hist.DataPoints = append(hist.DataPoints, histogramPoint(m, start, now))
+		}
+		out.Data = &otlppb.Metric_Histogram{Histogram: hist}
+	case dto.MetricType_SUMMARY:
+		summary := new(otlppb.Summary)
+		for _, m := range mf.GetMetric() {
+			summary.DataPoints = append(summary.DataPoints, summaryPoint(m, start, now))
+		}
+		out.Data = &otlppb.Metric_Summary{Summary: summary}
+	default:
+		return nil
+	}
+
+	return out
+}
+
+// metricsRequest builds an OTLP export request from gathered Prometheus metric families.
+func metricsRequest(resource *otlppb.Resource, families []*dto.MetricFamily, start, now time.Time) *otlppb.ExportMetricsServiceRequest {
+	scope := &otlppb.ScopeMetrics{
+		Scope: instrumentationScope,
+	}
+	for _, mf := range families {
+		if m := convertFamily(mf, start, now); m != nil {
+			scope.Metrics = append(scope.Metrics, m)
+		}
+	}
+
+	return &otlppb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*otlppb.ResourceMetrics{
+			{
+				Resource:     resource,
+				ScopeMetrics: []*otlppb.ScopeMetrics{scope},
+			},
+		},
+	}
+}
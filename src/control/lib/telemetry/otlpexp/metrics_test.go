@@ -0,0 +1,190 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package otlpexp
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+)
+
+func TestOTLPExp_convertFamily(t *testing.T) {
+	start := time.Unix(100, 0)
+	now := time.Unix(160, 0)
+	labels := []*dto.LabelPair{
+		{Name: proto.String("rank"), Value: proto.String("1")},
+	}
+	expAttrs := []*otlppb.KeyValue{stringAttr("rank", "1")}
+	sum := 42.5
+
+	for name, tc := range map[string]struct {
+		family *dto.MetricFamily
+		expOut *otlppb.Metric
+	}{
+		"counter": {
+			family: &dto.MetricFamily{
+				Name: proto.String("engine_ops"),
+				Help: proto.String("ops"),
+				Type: dto.MetricType_COUNTER.Enum(),
+				Metric: []*dto.Metric{
+					{Label: labels, Counter: &dto.Counter{Value: proto.Float64(7)}},
+				},
+			},
+			expOut: &otlppb.Metric{
+				Name:        "engine_ops",
+				Description: "ops",
+				Data: &otlppb.Metric_Sum{Sum: &otlppb.Sum{
+					AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+					IsMonotonic:            true,
+					DataPoints: []*otlppb.NumberDataPoint{
+						{
+							Attributes:        expAttrs,
+							StartTimeUnixNano: unixNano(start),
+							TimeUnixNano:      unixNano(now),
+							Value:             &otlppb.NumberDataPoint_AsDouble{AsDouble: 7},
+						},
+					},
+				}},
+			},
+		},
+		"gauge": {
+			family: &dto.MetricFamily{
+				Name: proto.String("engine_free"),
+				Type: dto.MetricType_GAUGE.Enum(),
+				Metric: []*dto.Metric{
+					{Label: labels, Gauge: &dto.Gauge{Value: proto.Float64(3)}},
+				},
+			},
+			expOut: &otlppb.Metric{
+				Name: "engine_free",
+				Data: &otlppb.Metric_Gauge{Gauge: &otlppb.Gauge{
+					DataPoints: []*otlppb.NumberDataPoint{
+						{
+							Attributes:        expAttrs,
+							StartTimeUnixNano: unixNano(start),
+							TimeUnixNano:      unixNano(now),
+							Value:             &otlppb.NumberDataPoint_AsDouble{AsDouble: 3},
+						},
+					},
+				}},
+			},
+		},
+		"histogram": {
+			family: &dto.MetricFamily{
+				Name: proto.String("engine_lat"),
+				Type: dto.MetricType_HISTOGRAM.Enum(),
+				Metric: []*dto.Metric{
+					{
+						Label: labels,
+						Histogram: &dto.Histogram{
+							SampleCount: proto.Uint64(10),
+							SampleSum:   proto.Float64(sum),
+							Bucket: []*dto.Bucket{
+								{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(2)},
+								{UpperBound: proto.Float64(5), CumulativeCount: proto.Uint64(6)},
+								{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(10)},
+							},
+						},
+					},
+				},
+			},
+			expOut: &otlppb.Metric{
+				Name: "engine_lat",
+				Data: &otlppb.Metric_Histogram{Histogram: &otlppb.Histogram{
+					AggregationTemporality: otlppb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+					DataPoints: []*otlppb.HistogramDataPoint{
+						{
+							Attributes:        expAttrs,
+							StartTimeUnixNano: unixNano(start),
+							TimeUnixNano:      unixNano(now),
+							Count:             10,
+							Sum:               &sum,
+							ExplicitBounds:    []float64{1, 5},
+							BucketCounts:      []uint64{2, 4, 4},
+						},
+					},
+				}},
+			},
+		},
+		"summary": {
+			family: &dto.MetricFamily{
+				Name: proto.String("engine_sum"),
+				Type: dto.MetricType_SUMMARY.Enum(),
+				Metric: []*dto.Metric{
+					{
+						Summary: &dto.Summary{
+							SampleCount: proto.Uint64(4),
+							SampleSum:   proto.Float64(8),
+							Quantile: []*dto.Quantile{
+								{Quantile: proto.Float64(0.5), Value: proto.Float64(2)},
+							},
+						},
+					},
+				},
+			},
+			expOut: &otlppb.Metric{
+				Name: "engine_sum",
+				Data: &otlppb.Metric_Summary{Summary: &otlppb.Summary{
+					DataPoints: []*otlppb.SummaryDataPoint{
+						{
+							Attributes:        []*otlppb.KeyValue{},
+							StartTimeUnixNano: unixNano(start),
+							TimeUnixNano:      unixNano(now),
+							Count:             4,
+							Sum:               8,
+							QuantileValues: []*otlppb.SummaryDataPoint_ValueAtQuantile{
+								{Quantile: 0.5, Value: 2},
+							},
+						},
+					},
+				}},
+			},
+		},
+		"unsupported type": {
+			family: &dto.MetricFamily{
+				Name: proto.String("engine_unknown"),
+				Type: dto.MetricType(99).Enum(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := convertFamily(tc.family, start, now)
+
+			if tc.expOut == nil {
+				if got != nil {
+					t.Fatalf("expected nil metric, got %+v", got)
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.expOut, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected metric (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestOTLPExp_mapAttrs(t *testing.T) {
+	got := mapAttrs(map[string]string{
+		"service.name": "daos_server",
+		"host.name":    "node1",
+	})
+	expAttrs := []*otlppb.KeyValue{
+		stringAttr("host.name", "node1"),
+		stringAttr("service.name", "daos_server"),
+	}
+
+	if diff := cmp.Diff(expAttrs, got, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+	}
+}
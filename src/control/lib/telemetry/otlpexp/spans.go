@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package otlpexp
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+)
+
+// DefaultMaxSpans is the default number of spans buffered between pushes.
+const DefaultMaxSpans = 4096
+
+// SpanRecorder buffers the spans of completed operations until they are pushed to the
+// collector. Each span is the root of its own trace.
+type SpanRecorder struct {
+	mu       sync.Mutex
+	maxSpans int
+	spans    []*otlppb.Span
+	dropped  uint64
+}
+
+// NewSpanRecorder returns a SpanRecorder that buffers at most maxSpans spans. Spans recorded
+// while the buffer is full are dropped.
+func NewSpanRecorder(maxSpans int) *SpanRecorder {
+	if maxSpans <= 0 {
+		maxSpans = DefaultMaxSpans
+	}
+
+	return &SpanRecorder{
+		maxSpans: maxSpans,
+	}
+}
+
+func randomID(size int) []byte {
+	id := make([]byte, size)
+	_, _ = rand.Read(id)
+	return id
+}
+
+// Record adds a span for an operation handled by this process. The span status is set to error
+// if opErr is non-nil.
+func (sr *SpanRecorder) Record(name string, start, end time.Time, attrs map[string]string, opErr error) {
+	if sr == nil {
+		return
+	}
+
+	span := &otlppb.Span{
+		TraceId:           randomID(16),
+		SpanId:            randomID(8),
+		Name:              name,
+		Kind:              otlppb.SpanKind_SPAN_KIND_SERVER,
+		StartTimeUnixNano: unixNano(start),
+		EndTimeUnixNano:   unixNano(end),
+		Attributes:        mapAttrs(attrs),
+		Status: &otlppb.Status{
+			Code: otlppb.StatusCode_STATUS_CODE_OK,
+		},
+	}
+	if opErr != nil {
+		span.Status = &otlppb.Status{
+			Code:    otlppb.StatusCode_STATUS_CODE_ERROR,
+			Message: opErr.Error(),
+		}
+	}
+
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	if len(sr.spans) >= sr.maxSpans {
+		sr.dropped++
+		return
+	}
+	sr.spans = append(sr.spans, span)
+}
+
+// Drain removes and returns the buffered spans along with the number of spans dropped since the
+// last call.
+func (sr *SpanRecorder) Drain() ([]*otlppb.Span, uint64) {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	spans, dropped := sr.spans, sr.dropped
+	sr.spans = nil
+	sr.dropped = 0
+
+	return spans, dropped
+}
+
+// spansRequest builds an OTLP export request from recorded spans.
+func spansRequest(resource *otlppb.Resource, spans []*otlppb.Span) *otlppb.ExportTraceServiceRequest {
+	return &otlppb.ExportTraceServiceRequest{
+		ResourceSpans: []*otlppb.ResourceSpans{
+			{
+				Resource: resource,
+				ScopeSpans: []*otlppb.ScopeSpans{
+					{
+						Scope: instrumentationScope,
+						Spans: spans,
+					},
+				},
+			},
+		},
+	}
+}
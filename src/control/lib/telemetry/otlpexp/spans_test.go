@@ -0,0 +1,71 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package otlpexp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
+)
+
+func TestOTLPExp_SpanRecorder(t *testing.T) {
+	start := time.Now()
+	end := start.Add(time.Second)
+
+	sr := NewSpanRecorder(2)
+	sr.Record("PoolCreate", start, end, map[string]string{"rpc.method": "PoolCreate"}, nil)
+	sr.Record("PoolDestroy", start, end, nil, errors.New("busy"))
+	sr.Record("PoolQuery", start, end, nil, nil)
+
+	spans, dropped := sr.Drain()
+	if dropped != 1 {
+		t.Fatalf("expected 1 dropped span, got %d", dropped)
+	}
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	ok := spans[0]
+	if ok.GetName() != "PoolCreate" {
+		t.Fatalf("unexpected span name %q", ok.GetName())
+	}
+	if len(ok.GetTraceId()) != 16 || len(ok.GetSpanId()) != 8 {
+		t.Fatalf("unexpected id lengths: trace %d, span %d", len(ok.GetTraceId()), len(ok.GetSpanId()))
+	}
+	if ok.GetStatus().GetCode() != otlppb.StatusCode_STATUS_CODE_OK {
+		t.Fatalf("expected OK status, got %s", ok.GetStatus().GetCode())
+	}
+	if ok.GetEndTimeUnixNano()-ok.GetStartTimeUnixNano() != uint64(time.Second) {
+		t.Fatal("unexpected span duration")
+	}
+	if len(ok.GetAttributes()) != 1 || ok.GetAttributes()[0].GetKey() != "rpc.method" {
+		t.Fatalf("unexpected attributes %+v", ok.GetAttributes())
+	}
+
+	failed := spans[1]
+	if failed.GetStatus().GetCode() != otlppb.StatusCode_STATUS_CODE_ERROR {
+		t.Fatalf("expected ERROR status, got %s", failed.GetStatus().GetCode())
+	}
+	if failed.GetStatus().GetMessage() != "busy" {
+		t.Fatalf("unexpected status message %q", failed.GetStatus().GetMessage())
+	}
+
+	spans, dropped = sr.Drain()
+	if len(spans) != 0 || dropped != 0 {
+		t.Fatalf("expected empty recorder after drain, got %d spans, %d dropped", len(spans), dropped)
+	}
+}
+
+func TestOTLPExp_SpanRecorder_Nil(t *testing.T) {
+	var sr *SpanRecorder
+
+	// Recording on a nil recorder is a no-op so that callers need not check if tracing is enabled.
+	sr.Record("PoolCreate", time.Now(), time.Now(), nil, nil)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -24,16 +25,39 @@ type (
 	CollectorOpts struct {
 		Ignores        []string
 		RetainDuration time.Duration
+		Sets           []string // metric sets to collect, all if empty
 	}
 
 	metricsCollector struct {
 		log            logging.Logger
 		summary        *prometheus.SummaryVec
 		ignoredMetrics []*regexp.Regexp
+		sets           map[string]struct{}
 		collectFn      func(ch chan *sourceMetric)
 	}
 )
 
+func (c *metricsCollector) setCollected(sets []string) {
+	if len(sets) == 0 {
+		return
+	}
+
+	c.sets = make(map[string]struct{})
+	for _, set := range sets {
+		c.sets[set] = struct{}{}
+	}
+}
+
+// isCollected returns true if the metric is not part of a set or if its set is enabled.
+func (c *metricsCollector) isCollected(sm *sourceMetric) bool {
+	if c.sets == nil || sm.set == "" {
+		return true
+	}
+
+	_, found := c.sets[sm.set]
+	return found
+}
+
 func (c *metricsCollector) isIgnored(name string) bool {
 	for _, re := range c.ignoredMetrics {
 		// TODO: We may want to look into removing the use of regexp here
@@ -67,7 +91,7 @@ func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
 	}()
 
 	for sm := range sourceMetrics {
-		if c.isIgnored(sm.baseName) {
+		if c.isIgnored(sm.baseName) || !c.isCollected(sm) {
 			continue
 		}
 
@@ -104,7 +128,14 @@ func (c *metricsCollector) Collect(ch chan<- prometheus.Metric) {
 				}
 			}
 		case telemetry.MetricTypeCounter:
-			err = sm.cvm.set(sm.baseName, sm.metric.FloatValue(), sm.labels)
+			if err = sm.cvm.set(sm.baseName, sm.metric.FloatValue(), sm.labels); err != nil {
+				break
+			}
+			for _, dm := range sm.derived {
+				if err = sm.cvm.set(dm.name, sm.metric.FloatValue()*dm.scale, sm.labels); err != nil {
+					break
+				}
+			}
 		default:
 			c.log.Errorf("[%s]: metric type %d not supported", sm.baseName, sm.metric.Type())
 		}
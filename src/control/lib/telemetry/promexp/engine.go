@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -24,6 +25,46 @@ import (
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
+const (
+	// MetricSetEngine identifies the engine metrics that are not specific to a storage device.
+	MetricSetEngine = "engine"
+	// MetricSetDevice identifies the per-device engine metrics.
+	MetricSetDevice = "device"
+
+	// nvmeDataUnitSize is the size of the data units counted in NVMe SSD health stats,
+	// which are reported in thousands of 512-byte units.
+	nvmeDataUnitSize = 512 * 1000
+)
+
+// deviceIOMetrics maps the NVMe SSD command counters to the per-device I/O counters derived
+// from them, so that bandwidth and IOPS can be calculated with rate().
+var deviceIOMetrics = map[string]struct {
+	name  string
+	help  string
+	scale float64
+}{
+	"engine_nvme_commands_data_units_read": {
+		name:  "engine_nvme_read_bytes",
+		help:  "Number of bytes read from the device",
+		scale: nvmeDataUnitSize,
+	},
+	"engine_nvme_commands_data_units_written": {
+		name:  "engine_nvme_write_bytes",
+		help:  "Number of bytes written to the device",
+		scale: nvmeDataUnitSize,
+	},
+	"engine_nvme_commands_host_read_cmds": {
+		name:  "engine_nvme_read_ops",
+		help:  "Number of read commands completed by the device",
+		scale: 1,
+	},
+	"engine_nvme_commands_host_write_cmds": {
+		name:  "engine_nvme_write_ops",
+		help:  "Number of write commands completed by the device",
+		scale: 1,
+	},
+}
+
 type (
 	// EngineCollector collects metrics from DAOS Engine sources.
 	EngineCollector struct {
@@ -102,6 +143,7 @@ func NewEngineCollector(log logging.Logger, opts *CollectorOpts, sources ...*Eng
 		}
 		c.ignoredMetrics = append(c.ignoredMetrics, re)
 	}
+	c.setCollected(opts.Sets)
 
 	return c, nil
 }
@@ -220,7 +262,16 @@ func newRankMetric(log logging.Logger, rank uint32, m telemetry.Metric) *sourceM
 	baseName := "engine_" + name
 	labels["rank"] = fmt.Sprintf("%d", rank)
 
-	return newSourceMetric(log, m, baseName, labels)
+	sm := newSourceMetric(log, m, baseName, labels)
+	sm.set = MetricSetEngine
+	if _, found := labels["device"]; found {
+		sm.set = MetricSetDevice
+		if dm, found := deviceIOMetrics[baseName]; found && m.Type() == telemetry.MetricTypeCounter {
+			sm.addDerived(dm.name, dm.help, dm.scale)
+		}
+	}
+
+	return sm
 }
 
 // AddSource adds an EngineSource to the Collector.
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -260,6 +261,21 @@ func TestPromExp_NewEngineCollector(t *testing.T) {
 				sources: testSrc,
 			},
 		},
+		"opts with sets": {
+			sources: testSrc,
+			opts:    &CollectorOpts{Sets: []string{MetricSetDevice}},
+			expResult: &EngineCollector{
+				metricsCollector: metricsCollector{
+					summary: &prometheus.SummaryVec{
+						MetricVec: &prometheus.MetricVec{},
+					},
+					sets: map[string]struct{}{
+						MetricSetDevice: {},
+					},
+				},
+				sources: testSrc,
+			},
+		},
 		"bad regexp in ignores": {
 			sources: testSrc,
 			opts:    &CollectorOpts{Ignores: []string{"one", "(two////********["}},
@@ -511,6 +527,119 @@ func TestPromExp_Collector_Collect(t *testing.T) {
 	}
 }
 
+func TestPromExp_Collector_CollectSets(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testIdx := uint32(telemetry.NextTestID(telemetry.PromexpIDBase))
+	testRank := uint32(123)
+	telemetry.InitTestMetricsProducer(t, int(testIdx), 4096)
+	defer telemetry.CleanupTestMetricsProducer(t)
+
+	telemetry.AddTestMetric(t, &telemetry.TestMetric{
+		Type: telemetry.MetricTypeCounter,
+		Name: "simple/counter1",
+		Cur:  25,
+	})
+	telemetry.AddTestMetric(t, &telemetry.TestMetric{
+		Type: telemetry.MetricTypeCounter,
+		Name: "nvme/0000:81:00.0/commands/data_units_read",
+		Cur:  2,
+	})
+	telemetry.AddTestMetric(t, &telemetry.TestMetric{
+		Type: telemetry.MetricTypeGauge,
+		Name: "nvme/0000:81:00.0/temp/current",
+		Cur:  300,
+	})
+
+	engSrc, cleanup, err := NewEngineSource(test.Context(t), testIdx, testRank)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		sets           []string
+		expMetricNames []string
+		expReadBytes   float64
+	}{
+		"all sets": {
+			expMetricNames: []string{
+				"engine_simple_counter1",
+				"engine_nvme_commands_data_units_read",
+				"engine_nvme_read_bytes",
+				"engine_nvme_temp_current",
+			},
+			expReadBytes: 2 * nvmeDataUnitSize,
+		},
+		"engine set": {
+			sets: []string{MetricSetEngine},
+			expMetricNames: []string{
+				"engine_simple_counter1",
+			},
+		},
+		"device set": {
+			sets: []string{MetricSetDevice},
+			expMetricNames: []string{
+				"engine_nvme_commands_data_units_read",
+				"engine_nvme_read_bytes",
+				"engine_nvme_temp_current",
+			},
+			expReadBytes: 2 * nvmeDataUnitSize,
+		},
+		"no engine sets": {
+			sets:           []string{"pool"},
+			expMetricNames: []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			collector, err := NewEngineCollector(log, &CollectorOpts{Sets: tc.sets}, engSrc)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resultChan := make(chan prometheus.Metric)
+			go collector.Collect(resultChan)
+
+			gotMetrics := []prometheus.Metric{}
+			done := false
+			for !done {
+				select {
+				case <-time.After(500 * time.Millisecond):
+					done = true
+				case m := <-resultChan:
+					gotMetrics = append(gotMetrics, m)
+				}
+			}
+
+			fqNameRe := regexp.MustCompile(`fqName: "(\w*)"`)
+			gotMetricNames := make([]string, len(gotMetrics))
+			var gotReadBytes float64
+			for i, m := range gotMetrics {
+				gotMetricNames[i] = fqNameRe.FindStringSubmatch(m.Desc().String())[1]
+				if gotMetricNames[i] != "engine_nvme_read_bytes" {
+					continue
+				}
+
+				pb := new(dto.Metric)
+				if err := m.Write(pb); err != nil {
+					t.Fatal(err)
+				}
+				gotReadBytes = pb.GetCounter().GetValue()
+			}
+			cmpOpts := cmp.Options{
+				cmpopts.SortSlices(func(a, b string) bool {
+					return a < b
+				}),
+			}
+			if diff := cmp.Diff(tc.expMetricNames, gotMetricNames, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected set of metrics (-want,+got: %s)", diff)
+			}
+			test.AssertEqual(t, tc.expReadBytes, gotReadBytes, "unexpected read bytes")
+		})
+	}
+}
+
 func TestPromExp_extractEngineLabels(t *testing.T) {
 	for name, tc := range map[string]struct {
 		input     string
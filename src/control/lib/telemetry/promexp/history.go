@@ -0,0 +1,164 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+//go:build linux && (amd64 || arm64)
+// +build linux
+// +build amd64 arm64
+
+package promexp
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// HistoryPath is the path of the endpoint serving retained metrics.
+	HistoryPath = "/metrics/history"
+	// HistorySinceParam is the query parameter limiting the retained metrics served to those
+	// sampled within the given duration.
+	HistorySinceParam = "since"
+	// HistoryMetricsParam is the query parameter holding a comma-separated list of metric
+	// names to serve. All metrics are served if it is not set.
+	HistoryMetricsParam = "metrics"
+	// HistoryFormat is the format of the retained metrics served by the endpoint. Each
+	// metric carries the timestamp of the sample it was taken from.
+	HistoryFormat = expfmt.FmtProtoDelim
+)
+
+type historySample struct {
+	time     time.Time
+	families []*dto.MetricFamily
+}
+
+// History is a ring buffer of metric samples taken at a fixed interval, allowing metrics to be
+// queried for a period before the query was made.
+type History struct {
+	log      logging.Logger
+	interval time.Duration
+	mu       sync.RWMutex
+	samples  []historySample
+	next     int
+	count    int
+}
+
+// NewHistory creates a History that retains samples taken every interval for the given
+// retention period.
+func NewHistory(log logging.Logger, retention, interval time.Duration) (*History, error) {
+	if retention <= 0 {
+		return nil, errors.New("retention must be positive")
+	}
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+
+	size := int(retention / interval)
+	if retention%interval != 0 {
+		size++
+	}
+
+	return &History{
+		log:      log,
+		interval: interval,
+		samples:  make([]historySample, size),
+	}, nil
+}
+
+// add stores the families as the sample taken at the given time, replacing the oldest sample
+// if the buffer is full.
+func (h *History) add(now time.Time, families []*dto.MetricFamily) {
+	ts := now.UnixMilli()
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			m.TimestampMs = &ts
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples[h.next] = historySample{time: now, families: families}
+	h.next = (h.next + 1) % len(h.samples)
+	if h.count < len(h.samples) {
+		h.count++
+	}
+}
+
+// Since returns the metric families sampled at or after the given time, oldest first. If names
+// are supplied, only the families with those names are returned.
+func (h *History) Since(since time.Time, names ...string) []*dto.MetricFamily {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var out []*dto.MetricFamily
+	first := (h.next - h.count + len(h.samples)) % len(h.samples)
+	for i := 0; i < h.count; i++ {
+		sample := h.samples[(first+i)%len(h.samples)]
+		if sample.time.Before(since) {
+			continue
+		}
+		for _, mf := range sample.families {
+			if len(names) > 0 && !common.Includes(names, mf.GetName()) {
+				continue
+			}
+			out = append(out, mf)
+		}
+	}
+
+	return out
+}
+
+// Run samples the metrics from the gatherer at the configured interval until the context is
+// canceled.
+func (h *History) Run(ctx context.Context, gatherer prometheus.Gatherer) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			families, err := gatherer.Gather()
+			if err != nil {
+				h.log.Debugf("telemetry history: failed to gather some metrics: %s", err)
+			}
+			h.add(now, families)
+		}
+	}
+}
+
+// ServeHTTP serves the retained metrics.
+func (h *History) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	since := time.Time{}
+	if val := r.URL.Query().Get(HistorySinceParam); val != "" {
+		dur, err := time.ParseDuration(val)
+		if err != nil || dur < 0 {
+			http.Error(w, "invalid "+HistorySinceParam+" duration: "+val, http.StatusBadRequest)
+			return
+		}
+		since = time.Now().Add(-dur)
+	}
+	names := common.TokenizeCommaSeparatedString(r.URL.Query().Get(HistoryMetricsParam))
+
+	w.Header().Set("Content-Type", string(HistoryFormat))
+	enc := expfmt.NewEncoder(w, HistoryFormat)
+	for _, mf := range h.Since(since, names...) {
+		if err := enc.Encode(mf); err != nil {
+			h.log.Errorf("telemetry history: failed to encode %s: %s", mf.GetName(), err)
+			return
+		}
+	}
+}
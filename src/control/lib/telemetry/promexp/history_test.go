@@ -0,0 +1,174 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package promexp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func newTestHistoryFamily(name string, value float64) *dto.MetricFamily {
+	return &dto.MetricFamily{
+		Name: proto.String(name),
+		Type: dto.MetricType_GAUGE.Enum(),
+		Metric: []*dto.Metric{
+			{Gauge: &dto.Gauge{Value: proto.Float64(value)}},
+		},
+	}
+}
+
+func historyValues(families []*dto.MetricFamily) []float64 {
+	var values []float64
+	for _, mf := range families {
+		values = append(values, mf.GetMetric()[0].GetGauge().GetValue())
+	}
+	return values
+}
+
+func TestPromExp_NewHistory(t *testing.T) {
+	for name, tc := range map[string]struct {
+		retention time.Duration
+		interval  time.Duration
+		expSize   int
+		expErr    error
+	}{
+		"zero retention": {
+			interval: time.Second,
+			expErr:   errors.New("retention"),
+		},
+		"zero interval": {
+			retention: time.Minute,
+			expErr:    errors.New("interval"),
+		},
+		"even multiple": {
+			retention: time.Minute,
+			interval:  10 * time.Second,
+			expSize:   6,
+		},
+		"rounded up": {
+			retention: time.Minute,
+			interval:  7 * time.Second,
+			expSize:   9,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			h, err := NewHistory(log, tc.retention, tc.interval)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expSize, len(h.samples), "unexpected buffer size")
+		})
+	}
+}
+
+func TestPromExp_History_Since(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	h, err := NewHistory(log, 3*time.Second, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Unix(1000, 0)
+	for i := 0; i < 5; i++ {
+		h.add(start.Add(time.Duration(i)*time.Second), []*dto.MetricFamily{
+			newTestHistoryFamily("engine_a", float64(i)),
+			newTestHistoryFamily("engine_b", float64(i*10)),
+		})
+	}
+
+	// Only the last three samples are retained, oldest first.
+	test.AssertEqual(t, []float64{2, 20, 3, 30, 4, 40}, historyValues(h.Since(time.Time{})),
+		"unexpected retained values")
+	test.AssertEqual(t, []float64{3, 4}, historyValues(h.Since(start.Add(3*time.Second), "engine_a")),
+		"unexpected values since")
+
+	ts := h.Since(start.Add(4 * time.Second))[0].GetMetric()[0].GetTimestampMs()
+	test.AssertEqual(t, start.Add(4*time.Second).UnixMilli(), ts, "unexpected sample timestamp")
+}
+
+func TestPromExp_History_ServeHTTP(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	h, err := NewHistory(log, time.Hour, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	h.add(now.Add(-30*time.Minute), []*dto.MetricFamily{newTestHistoryFamily("engine_a", 1)})
+	h.add(now.Add(-time.Minute), []*dto.MetricFamily{
+		newTestHistoryFamily("engine_a", 2),
+		newTestHistoryFamily("engine_b", 3),
+	})
+
+	for name, tc := range map[string]struct {
+		query     string
+		expStatus int
+		expValues []float64
+	}{
+		"all": {
+			expStatus: http.StatusOK,
+			expValues: []float64{1, 2, 3},
+		},
+		"since": {
+			query:     "?since=10m",
+			expStatus: http.StatusOK,
+			expValues: []float64{2, 3},
+		},
+		"since and metrics": {
+			query:     "?since=10m&metrics=engine_b",
+			expStatus: http.StatusOK,
+			expValues: []float64{3},
+		},
+		"bad since": {
+			query:     "?since=bogus",
+			expStatus: http.StatusBadRequest,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, HistoryPath+tc.query, nil))
+
+			test.AssertEqual(t, tc.expStatus, rec.Code, "unexpected status")
+			if tc.expStatus != http.StatusOK {
+				return
+			}
+
+			var got []*dto.MetricFamily
+			dec := expfmt.NewDecoder(rec.Body, HistoryFormat)
+			for {
+				mf := new(dto.MetricFamily)
+				if err := dec.Decode(mf); err != nil {
+					if err == io.EOF {
+						break
+					}
+					t.Fatal(err)
+				}
+				got = append(got, mf)
+			}
+			test.AssertEqual(t, tc.expValues, historyValues(got), "unexpected values")
+		})
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -32,6 +33,7 @@ type (
 		Port     int
 		Title    string
 		Register RegMonFn
+		History  *History // if set, retained metrics are served on HistoryPath
 	}
 )
 
@@ -66,6 +68,15 @@ func StartExporter(ctx context.Context, log logging.Logger, cfg *ExporterConfig)
 	http.Handle("/metrics", promhttp.HandlerFor(
 		prometheus.DefaultGatherer, promhttp.HandlerOpts{},
 	))
+	histCtx, stopHistory := context.WithCancel(context.Background())
+	if cfg.History != nil {
+		http.Handle(HistoryPath, cfg.History)
+		go cfg.History.Run(histCtx, prometheus.DefaultGatherer)
+	} else {
+		http.HandleFunc(HistoryPath, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "telemetry history is not enabled", http.StatusNotFound)
+		})
+	}
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		num, err := w.Write([]byte(fmt.Sprintf(`<html>
 				<head><title>%s</title></head>
@@ -88,6 +99,7 @@ func StartExporter(ctx context.Context, log logging.Logger, cfg *ExporterConfig)
 
 	return func() {
 		log.Debug("Shutting down Prometheus web exporter")
+		stopHistory()
 
 		// When this cleanup function is called, the original context
 		// will probably have already been canceled.
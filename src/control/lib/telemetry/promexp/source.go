@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -83,16 +84,31 @@ func defaultCollectorOpts() *CollectorOpts {
 	return &CollectorOpts{}
 }
 
+// derivedMetric defines a counter whose value is calculated by scaling the value of a
+// telemetry.Metric counter.
+type derivedMetric struct {
+	name  string
+	scale float64
+}
+
 // sourceMetric defines a wrapper for the wrapped telemetry.Metric instance.
 type sourceMetric struct {
 	metric   telemetry.Metric
 	baseName string
 	labels   labelMap
+	set      string
+	derived  []*derivedMetric
 	gvm      gvMap
 	cvm      cvMap
 	hvm      hvMap
 }
 
+// addDerived adds a counter whose value is the value of the wrapped counter multiplied by scale.
+func (bm *sourceMetric) addDerived(name, help string, scale float64) {
+	bm.cvm.add(name, help, bm.labels)
+	bm.derived = append(bm.derived, &derivedMetric{name: name, scale: scale})
+}
+
 // collect sends the metrics vectors in the sourceMetric struct to the provided channel.
 func (bm *sourceMetric) collect(ch chan<- prometheus.Metric) {
 	for _, gv := range bm.gvm {
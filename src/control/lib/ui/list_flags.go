@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,6 +8,7 @@
 package ui
 
 import (
+	"regexp"
 	"sort"
 	"strings"
 
@@ -52,23 +54,127 @@ func (f *RankSetFlag) MarshalJSON() ([]byte, error) {
 
 // HostSetFlag is a go-flags compatible flag type for
 // handling inputs that can be converted to a hostlist.HostSet.
+//
+// A value enclosed in slashes, e.g. "/^node0[1-4]$/", is a regular expression
+// which is resolved to a set of hosts by Select. Hosts to leave out of the
+// selection may follow the pattern, e.g. "/^node/!node[17,42]".
 type HostSetFlag struct {
 	hostlist.HostSet
+	pattern *regexp.Regexp
+	exclude *hostlist.HostSet
 }
 
+const (
+	hostPatternDelim  = "/"
+	hostExclusionOper = "!"
+)
+
 // Empty returns true if the flag was not set.
 func (f *HostSetFlag) Empty() bool {
-	return f.Count() == 0
+	return f.Count() == 0 && f.pattern == nil
+}
+
+// HasPattern returns true if the flag holds a host pattern that has yet to be
+// resolved by Select.
+func (f *HostSetFlag) HasPattern() bool {
+	return f.pattern != nil
+}
+
+func (f *HostSetFlag) String() string {
+	if f.pattern == nil {
+		return f.HostSet.String()
+	}
+
+	str := hostPatternDelim + f.pattern.String() + hostPatternDelim
+	if f.exclude != nil {
+		str += hostExclusionOper + f.exclude.String()
+	}
+	return str
+}
+
+func (f *HostSetFlag) unmarshalPattern(fv string) error {
+	end := strings.LastIndex(fv, hostPatternDelim)
+	if end == 0 {
+		return errors.Errorf("host pattern %q is missing closing %q", fv, hostPatternDelim)
+	}
+
+	pattern, err := regexp.Compile(fv[1:end])
+	if err != nil {
+		return errors.Wrapf(err, "invalid host pattern %q", fv)
+	}
+
+	var exclude *hostlist.HostSet
+	if rest := fv[end+1:]; rest != "" {
+		if !strings.HasPrefix(rest, hostExclusionOper) {
+			return errors.Errorf("unexpected %q after host pattern", rest)
+		}
+		exclude, err = hostlist.CreateSet(rest[1:])
+		if err != nil {
+			return err
+		}
+		if exclude.Count() == 0 {
+			return errors.Errorf("no hosts to exclude in %q", fv)
+		}
+	}
+
+	f.Replace(hostlist.MustCreateSet(""))
+	f.pattern = pattern
+	f.exclude = exclude
+
+	return nil
 }
 
 // UnmarshalFlag implements the go-flags.Unmarshaler
 // interface.
 func (f *HostSetFlag) UnmarshalFlag(fv string) error {
+	if strings.HasPrefix(fv, hostPatternDelim) {
+		return f.unmarshalPattern(fv)
+	}
+
 	rs, err := hostlist.CreateSet(fv)
 	if err != nil {
 		return err
 	}
 	f.Replace(rs)
+	f.pattern = nil
+	f.exclude = nil
+
+	return nil
+}
+
+// Select resolves the flag's host pattern to the set of hosts that it matches.
+// Candidates map the host names that are matched against the pattern and
+// exclusions to the addresses that are added to the set when selected.
+func (f *HostSetFlag) Select(candidates map[string]string) error {
+	if f.pattern == nil {
+		return errors.New("no host pattern to select with")
+	}
+
+	selected := hostlist.MustCreateSet("")
+	for name, addr := range candidates {
+		if !f.pattern.MatchString(name) {
+			continue
+		}
+		if f.exclude != nil {
+			excluded, err := f.exclude.Within(name)
+			if err != nil {
+				return errors.Wrapf(err, "checking exclusion of %q", name)
+			}
+			if excluded {
+				continue
+			}
+		}
+		if _, err := selected.Insert(addr); err != nil {
+			return err
+		}
+	}
+	if selected.Count() == 0 {
+		return errors.Errorf("no hosts selected by %s", f)
+	}
+
+	f.Replace(selected)
+	f.pattern = nil
+	f.exclude = nil
 
 	return nil
 }
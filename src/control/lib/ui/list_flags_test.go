@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -105,6 +106,41 @@ func TestUI_HostSetFlag(t *testing.T) {
 			}(),
 			expString: "host-[1-128]",
 		},
+		"list with exclusion": {
+			arg: "host-[1-128]!host-[17,42]",
+			expFlag: func() *ui.HostSetFlag {
+				flag := &ui.HostSetFlag{}
+				flag.Replace(hostlist.MustCreateSet("host-[1-16,18-41,43-128]"))
+				return flag
+			}(),
+			expString: "host-[1-16,18-41,43-128]",
+		},
+		"pattern": {
+			arg:       "/^host-0[1-4]$/",
+			expFlag:   &ui.HostSetFlag{},
+			expString: "/^host-0[1-4]$/",
+		},
+		"pattern with exclusion": {
+			arg:       "/^host-/!host-[17,42]",
+			expFlag:   &ui.HostSetFlag{},
+			expString: "/^host-/!host-[17,42]",
+		},
+		"unclosed pattern": {
+			arg:    "/^host-",
+			expErr: errors.New("missing closing"),
+		},
+		"bad pattern": {
+			arg:    "/host-[/",
+			expErr: errors.New("invalid host pattern"),
+		},
+		"garbage after pattern": {
+			arg:    "/^host-/host-1",
+			expErr: errors.New("unexpected"),
+		},
+		"empty pattern exclusion": {
+			arg:    "/^host-/!",
+			expErr: errors.New("no hosts to exclude"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			f := ui.HostSetFlag{}
@@ -131,6 +167,58 @@ func TestUI_HostSetFlag(t *testing.T) {
 	}
 }
 
+func TestUI_HostSetFlag_Select(t *testing.T) {
+	candidates := map[string]string{
+		"host-1": "10.0.0.1:10001",
+		"host-2": "10.0.0.2:10001",
+		"host-3": "10.0.0.3:10001",
+		"other":  "10.0.0.4:10001",
+	}
+
+	for name, tc := range map[string]struct {
+		arg       string
+		expString string
+		expErr    error
+	}{
+		"no pattern": {
+			arg:    "host-1",
+			expErr: errors.New("no host pattern"),
+		},
+		"pattern": {
+			arg:       "/^host-/",
+			expString: "10.0.0.[1-3]:10001",
+		},
+		"pattern with exclusion": {
+			arg:       "/^host-/!host-[1,3]",
+			expString: "10.0.0.2:10001",
+		},
+		"nothing selected": {
+			arg:    "/^node/",
+			expErr: errors.New("no hosts selected by /^node/"),
+		},
+		"everything excluded": {
+			arg:    "/^host-/!host-[1-3]",
+			expErr: errors.New("no hosts selected"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := ui.HostSetFlag{}
+			if err := f.UnmarshalFlag(tc.arg); err != nil {
+				t.Fatal(err)
+			}
+
+			gotErr := f.Select(candidates)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertFalse(t, f.HasPattern(), "pattern not resolved")
+			test.AssertEqual(t, tc.expString, f.String(), "unexpected String()")
+		})
+	}
+}
+
 func TestUI_MemberStateSetFlag(t *testing.T) {
 	for name, tc := range map[string]struct {
 		arg     string
@@ -37,6 +37,8 @@ type (
 		MountErr        error
 		UnmountErr      error
 		MkfsErr         error
+		FsckRepaired    bool
+		FsckErr         error
 		ChmodErr        error
 		ChownErr        error
 		GetfsStr        string
@@ -150,6 +152,10 @@ func (msp *MockSysProvider) Mkfs(_ MkfsReq) error {
 	return msp.cfg.MkfsErr
 }
 
+func (msp *MockSysProvider) Fsck(_ FsckReq) (bool, error) {
+	return msp.cfg.FsckRepaired, msp.cfg.FsckErr
+}
+
 func (msp *MockSysProvider) Chmod(string, os.FileMode) error {
 	return msp.cfg.ChmodErr
 }
@@ -243,6 +243,41 @@ func (s LinuxProvider) checkDevice(device string) error {
 }
 
 // MkfsReq defines the input parameters for a Mkfs call.
+// FsckReq defines the parameters for a filesystem check.
+type FsckReq struct {
+	Device     string
+	Filesystem string
+}
+
+// Fsck attempts to check and automatically repair the filesystem of the supplied type on the
+// supplied device, which must not be mounted. Returns true if errors were found and corrected.
+func (s LinuxProvider) Fsck(req FsckReq) (bool, error) {
+	cmdPath, err := exec.LookPath(fmt.Sprintf("fsck.%s", req.Filesystem))
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to find fsck.%s", req.Filesystem)
+	}
+
+	if err := s.checkDevice(req.Device); err != nil {
+		return false, err
+	}
+
+	// Preen mode repairs problems that can be safely fixed without human intervention.
+	out, err := exec.Command(cmdPath, "-p", req.Device).Output()
+	if err == nil {
+		return false, nil
+	}
+
+	// Exit codes 1 and 2 indicate that errors were corrected.
+	if ee, ok := err.(*exec.ExitError); ok && (ee.ExitCode() == 1 || ee.ExitCode() == 2) {
+		return true, nil
+	}
+
+	return false, &RunCmdError{
+		Wrapped: err,
+		Stdout:  string(out),
+	}
+}
+
 type MkfsReq struct {
 	Device     string
 	Filesystem string
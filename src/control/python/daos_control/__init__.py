@@ -0,0 +1,24 @@
+"""
+  (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+  SPDX-License-Identifier: BSD-2-Clause-Patent
+
+Python client for the DAOS management API.
+
+The generated protobuf and gRPC stubs for the management service are available under
+daos_control.proto, and daos_control.client provides a thin convenience layer over them for
+the most common system and pool operations:
+
+    from daos_control import ControlClient
+
+    with ControlClient(["server1", "server2"], ca_cert="/etc/daos/certs/daosCA.crt",
+                       cert="/etc/daos/certs/admin.crt",
+                       key="/etc/daos/certs/admin.key") as client:
+        for member in client.system_query():
+            print(member.rank, member.state)
+"""
+
+from daos_control.client import (DEFAULT_CONTROL_PORT, DEFAULT_SYSTEM_NAME, ControlClient,
+                                 ControlError)
+
+__all__ = ["ControlClient", "ControlError", "DEFAULT_CONTROL_PORT", "DEFAULT_SYSTEM_NAME"]
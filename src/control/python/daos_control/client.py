@@ -0,0 +1,285 @@
+"""
+  (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+  SPDX-License-Identifier: BSD-2-Clause-Patent
+"""
+
+import grp
+import os
+import pwd
+import re
+import uuid
+
+import grpc
+
+from daos_control.proto import mgmt_pb2_grpc, pool_pb2, system_pb2
+
+try:
+    from daos_control._version import DAOS_VERSION
+except ImportError:
+    DAOS_VERSION = None
+
+DEFAULT_CONTROL_PORT = 10001
+DEFAULT_SYSTEM_NAME = "daos_server"
+DEFAULT_TIMEOUT = 60
+
+# Values that identify this client to the control plane, see src/control/build.
+CONTROL_API_VERSION = 1
+COMPONENT_HEADER = "x-daos-component"
+VERSION_HEADER = "x-daos-version"
+API_VERSION_HEADER = "x-daos-api-version"
+ADMIN_COMPONENT = "admin"
+
+# Common name of the server certificates.
+SERVER_NAME = "server"
+
+# Fraction of the pool size allocated to each storage tier, as for pools created by dmg.
+DEFAULT_TIER_RATIO = (0.06, 0.94)
+
+# DAOS_PROP_PO_LABEL, see daos_prop.h.
+POOL_PROP_LABEL = 1
+
+# All pool query options except for the lists of enabled and dead engines, see daos_pool.h.
+DEFAULT_POOL_QUERY_MASK = 0xFFFFFFFFFFFFFFFF & ~((1 << 2) | (1 << 4))
+
+# Requests for the management service must be sent to its leader. Other servers reject them
+# with one of these errors, and the request is retried on the next server.
+_NOT_LEADER_RE = re.compile(r"not (the|a) DAOS Management Service (leader|replica)")
+
+
+class ControlError(Exception):
+    """Error returned by a management API request.
+
+    Attributes:
+        code (grpc.StatusCode): gRPC status of the request, or None if the request
+            succeeded but the operation failed.
+        status (int): DAOS error code of a failed operation, or 0.
+    """
+
+    def __init__(self, message, code=None, status=0):
+        super().__init__(message)
+        self.code = code
+        self.status = status
+
+
+class ControlClient():
+    """Client for the management service of a DAOS system.
+
+    Requests are sent to the servers in the order of the host list until one of them succeeds,
+    so the list only needs to include the management service replicas.
+    """
+
+    # pylint: disable=too-many-arguments
+    def __init__(self, hosts=None, port=DEFAULT_CONTROL_PORT, system=DEFAULT_SYSTEM_NAME,
+                 ca_cert=None, cert=None, key=None, insecure=False, timeout=DEFAULT_TIMEOUT,
+                 daos_version=None):
+        """Initialize a ControlClient object.
+
+        Args:
+            hosts (list, optional): addresses of the servers, with or without a port. Defaults
+                to the local host.
+            port (int, optional): port of the servers without one in hosts.
+            system (str, optional): name of the DAOS system.
+            ca_cert (str, optional): path of the CA certificate.
+            cert (str, optional): path of the administrative client certificate.
+            key (str, optional): path of the administrative client key.
+            insecure (bool, optional): disable transport security. Must match the
+                configuration of the servers.
+            timeout (int, optional): timeout of each request, in seconds.
+            daos_version (str, optional): DAOS version reported to the servers. Defaults to
+                the version this package was installed with.
+
+        Raises:
+            ValueError: if the configuration is incomplete.
+        """
+        if not hosts:
+            hosts = ["localhost"]
+        self._hosts = [host if ":" in host else f"{host}:{port}" for host in hosts]
+        self._system = system
+        self._timeout = timeout
+
+        daos_version = daos_version or DAOS_VERSION
+        if not daos_version:
+            raise ValueError("DAOS version is not known and must be supplied")
+        self._metadata = (
+            (COMPONENT_HEADER, ADMIN_COMPONENT),
+            (VERSION_HEADER, daos_version),
+            (API_VERSION_HEADER, str(CONTROL_API_VERSION)),
+        )
+
+        self._credentials = None
+        if not insecure:
+            if not (ca_cert and cert and key):
+                raise ValueError("ca_cert, cert and key are required unless insecure is set")
+            with open(ca_cert, "rb") as ca_file, open(cert, "rb") as cert_file, \
+                    open(key, "rb") as key_file:
+                self._credentials = grpc.ssl_channel_credentials(
+                    root_certificates=ca_file.read(),
+                    private_key=key_file.read(),
+                    certificate_chain=cert_file.read())
+
+        self._channels = {}
+        self._leader = 0
+
+    def __enter__(self):
+        return self
+
+    def __exit__(self, *args):
+        self.close()
+
+    def close(self):
+        """Close the connections to the servers."""
+        for channel in self._channels.values():
+            channel.close()
+        self._channels = {}
+
+    def _channel(self, host):
+        """Get a cached channel to a server."""
+        if host not in self._channels:
+            if self._credentials is None:
+                self._channels[host] = grpc.insecure_channel(host)
+            else:
+                options = (("grpc.ssl_target_name_override", SERVER_NAME),)
+                self._channels[host] = grpc.secure_channel(host, self._credentials, options)
+        return self._channels[host]
+
+    def _invoke(self, method, request):
+        """Send a request to the management service leader.
+
+        Args:
+            method (str): name of the MgmtSvc method.
+            request (message): request message.
+
+        Raises:
+            ControlError: if no server was able to handle the request.
+
+        Returns:
+            message: the response message.
+        """
+        errors = []
+        for idx in range(len(self._hosts)):
+            host_idx = (self._leader + idx) % len(self._hosts)
+            host = self._hosts[host_idx]
+            stub = mgmt_pb2_grpc.MgmtSvcStub(self._channel(host))
+            try:
+                response = getattr(stub, method)(
+                    request, metadata=self._metadata, timeout=self._timeout)
+            except grpc.RpcError as error:
+                # pylint: disable=no-member
+                if error.code() == grpc.StatusCode.UNAVAILABLE or \
+                        _NOT_LEADER_RE.search(error.details() or ""):
+                    errors.append(f"{host}: {error.details()}")
+                    continue
+                raise ControlError(f"{method}: {error.details()}", code=error.code()) from error
+            self._leader = host_idx
+            return response
+
+        raise ControlError(f"{method}: no management service leader found ({'; '.join(errors)})",
+                           code=grpc.StatusCode.UNAVAILABLE)
+
+    @staticmethod
+    def _check_status(method, response):
+        """Raise an error if the response contains a DAOS error code."""
+        if response.status != 0:
+            raise ControlError(f"{method}: DER_{response.status}", status=response.status)
+
+    def system_query(self, ranks="", hosts=""):
+        """Query the members of the system.
+
+        Args:
+            ranks (str, optional): rank set to query, e.g. "0-3". Defaults to all ranks.
+            hosts (str, optional): host set to query. Defaults to all hosts.
+
+        Returns:
+            list: SystemMember messages, in rank order.
+        """
+        request = system_pb2.SystemQueryReq(sys=self._system, ranks=ranks, hosts=hosts)
+        response = self._invoke("SystemQuery", request)
+        return sorted(response.members, key=lambda member: member.rank)
+
+    def pool_list(self):
+        """List the pools of the system.
+
+        Returns:
+            list: ListPoolsResp.Pool messages.
+        """
+        response = self._invoke("ListPools", pool_pb2.ListPoolsReq(sys=self._system))
+        self._check_status("ListPools", response)
+        return list(response.pools)
+
+    def pool_query(self, pool_id):
+        """Query a pool.
+
+        Args:
+            pool_id (str): label or UUID of the pool.
+
+        Returns:
+            PoolQueryResp: the pool information.
+        """
+        request = pool_pb2.PoolQueryReq(
+            sys=self._system, id=pool_id, query_mask=DEFAULT_POOL_QUERY_MASK)
+        response = self._invoke("PoolQuery", request)
+        self._check_status("PoolQuery", response)
+        return response
+
+    # pylint: disable=too-many-arguments
+    def pool_create(self, label, total_bytes, num_ranks=0, ranks=None, num_svc_reps=0,
+                    user=None, group=None):
+        """Create a pool.
+
+        Args:
+            label (str): label of the pool.
+            total_bytes (int): total size of the pool across all of its ranks, divided between
+                the storage tiers in the same ratio as dmg.
+            num_ranks (int, optional): number of ranks to create the pool on. Defaults to all
+                ranks.
+            ranks (list, optional): ranks to create the pool on.
+            num_svc_reps (int, optional): number of pool service replicas.
+            user (str, optional): owner of the pool. Defaults to the effective user.
+            group (str, optional): owner group of the pool. Defaults to the effective group.
+
+        Returns:
+            tuple: the UUID of the new pool and the PoolCreateResp message.
+
+        Raises:
+            ValueError: if the parameters are invalid.
+        """
+        if not label:
+            raise ValueError("pool label is required")
+        if total_bytes <= 0:
+            raise ValueError("pool size must be greater than zero")
+        if num_ranks and ranks:
+            raise ValueError("num_ranks and ranks may not both be set")
+        if user is None:
+            user = pwd.getpwuid(os.geteuid()).pw_name
+        if group is None:
+            group = grp.getgrgid(os.getegid()).gr_name
+
+        pool_uuid = str(uuid.uuid4())
+        request = pool_pb2.PoolCreateReq(
+            uuid=pool_uuid,
+            sys=self._system,
+            user=user if user.endswith("@") else f"{user}@",
+            user_group=group if group.endswith("@") else f"{group}@",
+            properties=[pool_pb2.PoolProperty(number=POOL_PROP_LABEL, strval=label)],
+            num_svc_reps=num_svc_reps,
+            total_bytes=total_bytes,
+            tier_ratio=DEFAULT_TIER_RATIO,
+            num_ranks=num_ranks,
+            ranks=ranks or [])
+        response = self._invoke("PoolCreate", request)
+        self._check_status("PoolCreate", response)
+        return pool_uuid, response
+
+    def pool_destroy(self, pool_id, force=False, recursive=False):
+        """Destroy a pool.
+
+        Args:
+            pool_id (str): label or UUID of the pool.
+            force (bool, optional): destroy the pool even if it has open connections.
+            recursive (bool, optional): destroy the pool even if it contains containers.
+        """
+        request = pool_pb2.PoolDestroyReq(
+            sys=self._system, id=pool_id, force=force, recursive=recursive)
+        response = self._invoke("PoolDestroy", request)
+        self._check_status("PoolDestroy", response)
@@ -0,0 +1,23 @@
+"""
+  (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+  SPDX-License-Identifier: BSD-2-Clause-Patent
+
+Generated protobuf and gRPC stubs for the DAOS management API.
+
+The stubs are generated from the definitions in src/proto with "make -C src/proto proto-py".
+The generated modules import each other by their proto package path (e.g. "mgmt.pool_pb2"),
+so this directory is added to the module search path before they are loaded.
+"""
+
+import os
+import sys
+
+_STUB_DIR = os.path.dirname(os.path.abspath(__file__))
+if _STUB_DIR not in sys.path:
+    sys.path.append(_STUB_DIR)
+
+# pylint: disable=wrong-import-position,import-error
+from mgmt import mgmt_pb2_grpc, pool_pb2, system_pb2  # noqa: E402
+
+__all__ = ["mgmt_pb2_grpc", "pool_pb2", "system_pb2"]
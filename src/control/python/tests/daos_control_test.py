@@ -0,0 +1,107 @@
+"""
+  (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+
+  SPDX-License-Identifier: BSD-2-Clause-Patent
+"""
+import unittest
+from concurrent import futures
+
+import grpc
+import pytest
+from daos_control import ControlClient, ControlError
+from daos_control.client import (ADMIN_COMPONENT, API_VERSION_HEADER, COMPONENT_HEADER,
+                                 VERSION_HEADER)
+from daos_control.proto import mgmt_pb2_grpc, pool_pb2, system_pb2
+
+
+# pylint: disable=invalid-name,unused-argument
+class MockMgmtSvc(mgmt_pb2_grpc.MgmtSvcServicer):
+    """Management service that answers from canned responses."""
+
+    def __init__(self):
+        self.metadata = {}
+        self.pool_status = 0
+
+    def SystemQuery(self, request, context):
+        self.metadata = dict(context.invocation_metadata())
+        return system_pb2.SystemQueryResp(members=[
+            system_pb2.SystemMember(rank=1, addr="10.0.0.2:10001", state="joined"),
+            system_pb2.SystemMember(rank=0, addr="10.0.0.1:10001", state="joined"),
+        ])
+
+    def ListPools(self, request, context):
+        return pool_pb2.ListPoolsResp(pools=[pool_pb2.ListPoolsResp.Pool(label="pool1")])
+
+    def PoolDestroy(self, request, context):
+        return pool_pb2.PoolDestroyResp(status=self.pool_status)
+
+
+class StubsTestCase(unittest.TestCase):
+    """Test the generated protobuf and gRPC stubs."""
+
+    @pytest.mark.ut
+    def test_message_round_trip(self):
+        req = pool_pb2.PoolCreateReq(uuid="7a4e5c8b-1c5e-4a4e-9b1e-3f7d0c5a6b21", sys="daos",
+                                     total_bytes=1 << 30, tier_ratio=[0.06, 0.94],
+                                     ranks=[0, 1])
+        out = pool_pb2.PoolCreateReq.FromString(req.SerializeToString())
+        self.assertEqual(out, req)
+        self.assertEqual(list(out.ranks), [0, 1])
+
+    @pytest.mark.ut
+    def test_stub_methods(self):
+        stub = mgmt_pb2_grpc.MgmtSvcStub(grpc.insecure_channel("localhost:1"))
+        for method in ("SystemQuery", "PoolCreate", "PoolDestroy", "PoolQuery", "ListPools"):
+            self.assertTrue(callable(getattr(stub, method)), method)
+
+
+class ControlClientTestCase(unittest.TestCase):
+    """Test the ControlClient against an in-process management service."""
+
+    def setUp(self):
+        self.svc = MockMgmtSvc()
+        self.server = grpc.server(futures.ThreadPoolExecutor(max_workers=1))
+        mgmt_pb2_grpc.add_MgmtSvcServicer_to_server(self.svc, self.server)
+        port = self.server.add_insecure_port("localhost:0")
+        self.server.start()
+        self.client = ControlClient([f"localhost:{port}"], insecure=True, timeout=10,
+                                    daos_version="2.7.0")
+
+    def tearDown(self):
+        self.client.close()
+        self.server.stop(None)
+
+    @pytest.mark.ut
+    def test_missing_version(self):
+        with pytest.raises(ValueError):
+            ControlClient(insecure=True, daos_version="")
+
+    @pytest.mark.ut
+    def test_missing_certs(self):
+        with pytest.raises(ValueError):
+            ControlClient(daos_version="2.7.0")
+
+    @pytest.mark.ut
+    def test_system_query(self):
+        members = self.client.system_query()
+        self.assertEqual([member.rank for member in members], [0, 1])
+        self.assertEqual(self.svc.metadata[COMPONENT_HEADER], ADMIN_COMPONENT)
+        self.assertEqual(self.svc.metadata[VERSION_HEADER], "2.7.0")
+        self.assertIn(API_VERSION_HEADER, self.svc.metadata)
+
+    @pytest.mark.ut
+    def test_pool_list(self):
+        self.assertEqual([pool.label for pool in self.client.pool_list()], ["pool1"])
+
+    @pytest.mark.ut
+    def test_pool_destroy_failed(self):
+        self.svc.pool_status = -1005
+        with pytest.raises(ControlError) as err:
+            self.client.pool_destroy("pool1")
+        self.assertEqual(err.value.status, -1005)
+
+    @pytest.mark.ut
+    def test_unimplemented(self):
+        with pytest.raises(ControlError) as err:
+            self.client.pool_query("pool1")
+        self.assertEqual(err.value.code, grpc.StatusCode.UNIMPLEMENTED)
@@ -0,0 +1,15 @@
+#!/bin/bash
+## Run the unit tests of the daos_control Python package against the stubs in the source tree
+set -eu
+
+CURRENT_DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+
+for module in pytest grpc google.protobuf; do
+  if ! python3 -c "import ${module}" > /dev/null 2>&1; then
+    echo "${module} not found, skipping daos_control unit testing"
+    exit 0
+  fi
+done
+
+export PYTHONPATH="${CURRENT_DIR}/..${PYTHONPATH:+:${PYTHONPATH}}"
+python3 -m pytest -m ut -vv "${CURRENT_DIR}"
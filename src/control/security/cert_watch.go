@@ -0,0 +1,114 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// certFileModTimes returns the modification times of the given files. Files
+// that cannot be accessed are recorded with a zero time.
+func certFileModTimes(files []string) map[string]time.Time {
+	modTimes := make(map[string]time.Time, len(files))
+	for _, file := range files {
+		var modTime time.Time
+		if fi, err := os.Stat(file); err == nil {
+			modTime = fi.ModTime()
+		}
+		modTimes[file] = modTime
+	}
+
+	return modTimes
+}
+
+func certFilesChanged(prev, cur map[string]time.Time) bool {
+	for file, modTime := range cur {
+		if !prev[file].Equal(modTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WatchCertFiles checks the certificate files of the TransportConfig at the
+// configured interval and reloads the certificate data when any of them have
+// changed. If the reload fails, the previously loaded data remains in use and
+// the reload is retried on the next change. WatchCertFiles blocks until the
+// context is canceled and returns immediately if certificates are not in use
+// or no interval is set.
+func WatchCertFiles(ctx context.Context, log logging.Logger, tc *TransportConfig) {
+	if tc == nil || tc.AllowInsecure || tc.CertWatchInterval <= 0 {
+		return
+	}
+
+	files := tc.CertFiles()
+	modTimes := certFileModTimes(files)
+	log.Debugf("watching certificate files %v every %s", files, tc.CertWatchInterval)
+
+	ticker := time.NewTicker(tc.CertWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		curModTimes := certFileModTimes(files)
+		if !certFilesChanged(modTimes, curModTimes) {
+			continue
+		}
+		modTimes = curModTimes
+
+		if err := tc.ReloadCertData(); err != nil {
+			log.Errorf("failed to reload certificates, continuing with previous certificates: %s", err)
+			continue
+		}
+		cert := tc.Certificate()
+		log.Noticef("reloaded certificate %q (serial %x), valid until %s",
+			cert.Subject.CommonName, cert.SerialNumber, cert.NotAfter.Format(time.RFC3339))
+	}
+}
+
+// WatchCRL reloads the certificate revocation list of the TransportConfig at the
+// configured refresh interval, which allows a list retrieved from an HTTP
+// distribution point to be kept up to date. If the reload fails, the previously
+// loaded list remains in use. WatchCRL blocks until the context is canceled and
+// returns immediately if no list or refresh interval is set.
+func WatchCRL(ctx context.Context, log logging.Logger, tc *TransportConfig) {
+	if tc == nil || tc.AllowInsecure || tc.CRLPath == "" || tc.CRLRefreshInterval <= 0 {
+		return
+	}
+
+	log.Debugf("refreshing certificate revocation list %s every %s", tc.CRLPath,
+		tc.CRLRefreshInterval)
+
+	ticker := time.NewTicker(tc.CRLRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := tc.RefreshCRL(); err != nil {
+			log.Errorf("failed to refresh certificate revocation list, continuing with previous list: %s", err)
+			continue
+		}
+		_, _, crl := tc.certData()
+		log.Debugf("refreshed certificate revocation list %s (%d revoked certificates)",
+			tc.CRLPath, len(crl.RevokedCertificateEntries))
+	}
+}
@@ -0,0 +1,194 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestSecurity_WatchCertFiles_Disabled(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	for name, tc := range map[string]*TransportConfig{
+		"nil":         nil,
+		"insecure":    {AllowInsecure: true, CertWatchInterval: time.Second, CRLRefreshInterval: time.Second},
+		"no interval": {CertificateConfig: CertificateConfig{CRLPath: "ca.crl"}},
+		"no CRL":      {CRLRefreshInterval: time.Second},
+	} {
+		t.Run(name, func(t *testing.T) {
+			done := make(chan struct{})
+			go func() {
+				WatchCertFiles(test.Context(t), log, tc)
+				WatchCRL(test.Context(t), log, tc)
+				close(done)
+			}()
+
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Fatal("watcher did not return")
+			}
+		})
+	}
+}
+
+func TestSecurity_WatchCertFiles(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	_, certPath, keyPath := pki.issue(t, "server", "server", 2)
+
+	cfg := &TransportConfig{
+		CertWatchInterval: 10 * time.Millisecond,
+		CertificateConfig: CertificateConfig{
+			CARootPath:      pki.caPath,
+			CertificatePath: certPath,
+			PrivateKeyPath:  keyPath,
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	done := make(chan struct{})
+	go func() {
+		WatchCertFiles(ctx, log, cfg)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	waitForLog := func(t *testing.T, msg string) {
+		t.Helper()
+
+		deadline := time.Now().Add(10 * time.Second)
+		for !strings.Contains(buf.String(), msg) {
+			if time.Now().After(deadline) {
+				t.Fatalf("%q not logged", msg)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	waitForSerial := func(t *testing.T, serial int64) {
+		t.Helper()
+
+		deadline := time.Now().Add(10 * time.Second)
+		for time.Now().Before(deadline) {
+			if cfg.Certificate().SerialNumber.Int64() == serial {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("certificate with serial %d not loaded", serial)
+	}
+
+	// Ensure that the modification times of the rewritten files differ.
+	touch := func(t *testing.T, paths ...string) {
+		t.Helper()
+
+		mtime := time.Now().Add(time.Minute)
+		for _, path := range paths {
+			if err := os.Chtimes(path, mtime, mtime); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	waitForLog(t, "watching certificate files")
+
+	// An invalid certificate is not loaded and the previous certificate remains in use.
+	badPath := filepath.Join(testDir, "bad.crt")
+	writeTestFile(t, badPath, []byte("garbage"), MaxCertPerm)
+	if err := os.Rename(badPath, certPath); err != nil {
+		t.Fatal(err)
+	}
+	touch(t, certPath)
+
+	waitForLog(t, "failed to reload certificates")
+	test.AssertEqual(t, int64(2), cfg.Certificate().SerialNumber.Int64(),
+		"previous certificate not retained")
+
+	// A valid replacement is loaded on the next change.
+	pki.issue(t, "server", "server", 3)
+	touch(t, certPath, keyPath)
+	waitForSerial(t, 3)
+}
+
+func TestSecurity_WatchCRL(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	_, certPath, keyPath := pki.issue(t, "server", "server", 2)
+	revoked, _, _ := pki.issue(t, "agent", "agent", 3)
+	crlPath := filepath.Join(testDir, "ca.crl")
+	pki.writeCRL(t, crlPath, 1)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(testDir)))
+	defer srv.Close()
+
+	cfg := &TransportConfig{
+		CRLRefreshInterval: 10 * time.Millisecond,
+		CertificateConfig: CertificateConfig{
+			CARootPath:      pki.caPath,
+			CertificatePath: certPath,
+			PrivateKeyPath:  keyPath,
+			CRLPath:         srv.URL + "/ca.crl",
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.CheckRevoked(revoked, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(test.Context(t))
+	done := make(chan struct{})
+	go func() {
+		WatchCRL(ctx, log, cfg)
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// The certificate is rejected once the refreshed list revoking it is loaded.
+	pki.writeCRL(t, crlPath, 2, 3)
+	deadline := time.Now().Add(10 * time.Second)
+	for cfg.CheckRevoked(revoked, "test") == nil {
+		if time.Now().After(deadline) {
+			t.Fatal("refreshed CRL not loaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
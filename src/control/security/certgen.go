@@ -0,0 +1,394 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultCertValidity is the validity period of generated certificates.
+	DefaultCertValidity = 1095 * 24 * time.Hour
+
+	// RSA keys are required by the cipher suite used for control plane connections.
+	certGenKeyBits   = 3072
+	certGenOrg       = "DAOS"
+	certGenCAName    = "DAOS CA"
+	certGenCADirName = "daosCA"
+)
+
+// CertAuthority is a certificate authority that issues certificates to DAOS components.
+type CertAuthority struct {
+	Cert *x509.Certificate
+	key  *rsa.PrivateKey
+}
+
+// CertRequest describes a certificate to be issued by a CertAuthority. Hosts are added to
+// the certificate as SubjectAlternativeNames, as IP addresses or DNS names.
+type CertRequest struct {
+	CommonName         string
+	OrganizationalUnit string
+	Hosts              []string
+	ExtKeyUsage        []x509.ExtKeyUsage
+	Validity           time.Duration
+}
+
+func genSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "generating serial number")
+	}
+
+	return serial, nil
+}
+
+func genCertKey() (*rsa.PrivateKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certGenKeyBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating key")
+	}
+
+	return key, nil
+}
+
+// NewCertAuthority generates a self-signed CA certificate and key, valid for the given period.
+func NewCertAuthority(validity time.Duration) (*CertAuthority, error) {
+	if validity <= 0 {
+		return nil, errors.New("certificate validity period must be positive")
+	}
+
+	key, err := genCertKey()
+	if err != nil {
+		return nil, err
+	}
+	serial, err := genSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{certGenOrg},
+			CommonName:   certGenCAName,
+		},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            1,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating CA certificate")
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertAuthority{Cert: cert, key: key}, nil
+}
+
+// LoadCertAuthority loads an existing CA certificate and its key.
+func LoadCertAuthority(certPath, keyPath string) (*CertAuthority, error) {
+	cert, err := LoadCertificate(certPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading CA certificate %q", certPath)
+	}
+	if !cert.IsCA {
+		return nil, errors.Errorf("%q is not a CA certificate", certPath)
+	}
+
+	key, err := LoadPrivateKey(keyPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading CA key %q", keyPath)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok || !rsaKey.PublicKey.Equal(cert.PublicKey) {
+		return nil, errors.Errorf("%q is not the key for CA certificate %q", keyPath, certPath)
+	}
+
+	return &CertAuthority{Cert: cert, key: rsaKey}, nil
+}
+
+// Issue generates a key and a certificate for the request, signed by the CA.
+func (ca *CertAuthority) Issue(req *CertRequest) (*x509.Certificate, *rsa.PrivateKey, error) {
+	if req == nil {
+		return nil, nil, errors.New("nil request")
+	}
+	if req.CommonName == "" {
+		return nil, nil, errors.New("certificate common name must be set")
+	}
+	if req.Validity <= 0 {
+		return nil, nil, errors.New("certificate validity period must be positive")
+	}
+
+	key, err := genCertKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := genSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{certGenOrg},
+			CommonName:   req.CommonName,
+		},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(req.Validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           req.ExtKeyUsage,
+		BasicConstraintsValid: true,
+	}
+	if req.OrganizationalUnit != "" {
+		tmpl.Subject.OrganizationalUnit = []string{req.OrganizationalUnit}
+	}
+	if tmpl.NotAfter.After(ca.Cert.NotAfter) {
+		tmpl.NotAfter = ca.Cert.NotAfter
+	}
+	for _, host := range req.Hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+			continue
+		}
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.Cert, &key.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "creating %s certificate", req.CommonName)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cert, key, nil
+}
+
+// EncodeCertPEM returns the PEM encoding of the certificate.
+func EncodeCertPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// EncodeKeyPEM returns the PKCS #1 PEM encoding of the key.
+func EncodeKeyPEM(key *rsa.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+}
+
+// CertGenConfig contains the parameters for generating the certificates of a DAOS system.
+type CertGenConfig struct {
+	Dir         string        // directory in which the daosCA directory is created
+	Validity    time.Duration // validity period of the generated certificates
+	ServerHosts []string      // hosts to generate per-host server certificates for
+	CACertPath  string        // existing CA certificate to sign with instead of a new CA
+	CAKeyPath   string        // key of the existing CA certificate
+}
+
+// CertKeyFiles contains the paths of a certificate and its key.
+type CertKeyFiles struct {
+	Cert string
+	Key  string
+}
+
+// GeneratedCerts contains the paths of the files written by GenerateCerts.
+type GeneratedCerts struct {
+	CADir       string
+	CACert      string
+	CAKey       string // empty if an existing CA was used
+	Server      CertKeyFiles
+	Agent       CertKeyFiles
+	Admin       CertKeyFiles
+	HostServers map[string]CertKeyFiles
+	ClientsDir  string
+}
+
+func writeCertFile(path string, data []byte, perm os.FileMode) error {
+	if err := os.WriteFile(path, data, perm); err != nil {
+		return errors.Wrapf(err, "writing %q", path)
+	}
+	// Set the permissions explicitly as they may have been restricted by the umask.
+	return errors.Wrapf(os.Chmod(path, perm), "setting permissions of %q", path)
+}
+
+func writeCertDir(path string, perm os.FileMode) error {
+	if err := os.MkdirAll(path, perm); err != nil {
+		return errors.Wrapf(err, "creating %q", path)
+	}
+	return errors.Wrapf(os.Chmod(path, perm), "setting permissions of %q", path)
+}
+
+func issueToFiles(ca *CertAuthority, req *CertRequest, dir, name string) (CertKeyFiles, error) {
+	files := CertKeyFiles{
+		Cert: filepath.Join(dir, name+".crt"),
+		Key:  filepath.Join(dir, name+".key"),
+	}
+
+	cert, key, err := ca.Issue(req)
+	if err != nil {
+		return files, err
+	}
+	if err := writeCertFile(files.Key, EncodeKeyPEM(key), MaxUserOnlyKeyPerm); err != nil {
+		return files, err
+	}
+	if err := writeCertFile(files.Cert, EncodeCertPEM(cert), 0644); err != nil {
+		return files, err
+	}
+
+	return files, nil
+}
+
+// GenerateCerts writes a CA and the certificates of each DAOS component to a new daosCA
+// directory, using the same layout as the gen_certificates.sh script:
+//
+//	daosCA/private/daosCA.key   CA key, if a new CA is generated
+//	daosCA/certs/daosCA.crt     CA certificate
+//	daosCA/certs/server.*       server certificate and key
+//	daosCA/certs/agent.*        agent certificate and key
+//	daosCA/certs/admin.*        admin certificate and key
+//	daosCA/certs/clients/       client certificates to install on servers
+//	daosCA/certs/hosts/<host>/  per-host server certificates and keys
+//
+// Per-host server certificates contain the host as a SubjectAlternativeName. Agents share a
+// single certificate as servers look up the certificate of a client by its common name.
+func GenerateCerts(cfg *CertGenConfig) (*GeneratedCerts, error) {
+	if cfg == nil {
+		return nil, errors.New("nil config")
+	}
+	if (cfg.CACertPath == "") != (cfg.CAKeyPath == "") {
+		return nil, errors.New("both the CA certificate and key must be set to use an existing CA")
+	}
+	for _, host := range cfg.ServerHosts {
+		if host == "" || strings.ContainsAny(host, `/\`) || host == "." || host == ".." {
+			return nil, errors.Errorf("invalid host name %q", host)
+		}
+	}
+
+	caDir := filepath.Join(cfg.Dir, certGenCADirName)
+	if _, err := os.Stat(caDir); err == nil {
+		return nil, errors.Errorf("%q already exists", caDir)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var ca *CertAuthority
+	var err error
+	if cfg.CACertPath != "" {
+		ca, err = LoadCertAuthority(cfg.CACertPath, cfg.CAKeyPath)
+	} else {
+		ca, err = NewCertAuthority(cfg.Validity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	certsDir := filepath.Join(caDir, "certs")
+	gen := &GeneratedCerts{
+		CADir:       caDir,
+		CACert:      filepath.Join(certsDir, "daosCA.crt"),
+		HostServers: make(map[string]CertKeyFiles),
+		ClientsDir:  filepath.Join(certsDir, "clients"),
+	}
+
+	if err := writeCertDir(caDir, 0700); err != nil {
+		return nil, err
+	}
+	if err := writeCertDir(certsDir, 0755); err != nil {
+		return nil, err
+	}
+	if err := writeCertDir(gen.ClientsDir, 0755); err != nil {
+		return nil, err
+	}
+	if cfg.CACertPath == "" {
+		privateDir := filepath.Join(caDir, "private")
+		if err := writeCertDir(privateDir, 0700); err != nil {
+			return nil, err
+		}
+		gen.CAKey = filepath.Join(privateDir, "daosCA.key")
+		if err := writeCertFile(gen.CAKey, EncodeKeyPEM(ca.key), MaxUserOnlyKeyPerm); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeCertFile(gen.CACert, EncodeCertPEM(ca.Cert), 0644); err != nil {
+		return nil, err
+	}
+
+	serverUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	clientUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+
+	if gen.Server, err = issueToFiles(ca, &CertRequest{
+		CommonName:  ServerCommonName,
+		ExtKeyUsage: serverUsage,
+		Validity:    cfg.Validity,
+	}, certsDir, "server"); err != nil {
+		return nil, err
+	}
+	if gen.Agent, err = issueToFiles(ca, &CertRequest{
+		CommonName:  "agent",
+		ExtKeyUsage: clientUsage,
+		Validity:    cfg.Validity,
+	}, certsDir, "agent"); err != nil {
+		return nil, err
+	}
+	if gen.Admin, err = issueToFiles(ca, &CertRequest{
+		CommonName:  "admin",
+		ExtKeyUsage: clientUsage,
+		Validity:    cfg.Validity,
+	}, certsDir, "admin"); err != nil {
+		return nil, err
+	}
+
+	for _, files := range []CertKeyFiles{gen.Agent, gen.Admin} {
+		data, err := os.ReadFile(files.Cert)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeCertFile(filepath.Join(gen.ClientsDir, filepath.Base(files.Cert)), data, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, host := range cfg.ServerHosts {
+		hostDir := filepath.Join(certsDir, "hosts", host)
+		if err := writeCertDir(hostDir, 0755); err != nil {
+			return nil, err
+		}
+		if gen.HostServers[host], err = issueToFiles(ca, &CertRequest{
+			CommonName:  ServerCommonName,
+			Hosts:       []string{host},
+			ExtKeyUsage: serverUsage,
+			Validity:    cfg.Validity,
+		}, hostDir, "server"); err != nil {
+			return nil, err
+		}
+	}
+
+	return gen, nil
+}
@@ -0,0 +1,266 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSecurity_CertAuthority_Issue(t *testing.T) {
+	ca, err := NewCertAuthority(time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, certGenCAName, ca.Cert.Subject.CommonName, "")
+	test.AssertTrue(t, ca.Cert.IsCA, "CA certificate is not a CA")
+
+	for name, tc := range map[string]struct {
+		req      *CertRequest
+		expDNS   []string
+		expIPs   []net.IP
+		expUntil time.Time
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no common name": {
+			req:    &CertRequest{Validity: time.Minute},
+			expErr: errors.New("common name"),
+		},
+		"no validity": {
+			req:    &CertRequest{CommonName: "server"},
+			expErr: errors.New("validity"),
+		},
+		"with hosts": {
+			req: &CertRequest{
+				CommonName:  "server",
+				Hosts:       []string{"host1", "10.0.0.1", "host1.example.com"},
+				ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+				Validity:    time.Minute,
+			},
+			expDNS: []string{"host1", "host1.example.com"},
+			expIPs: []net.IP{net.ParseIP("10.0.0.1")},
+		},
+		"limited to CA validity": {
+			req: &CertRequest{
+				CommonName:         "monitor",
+				OrganizationalUnit: "monitoring",
+				ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+				Validity:           24 * time.Hour,
+			},
+			expUntil: ca.Cert.NotAfter,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cert, key, err := ca.Issue(tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertTrue(t, key.PublicKey.Equal(cert.PublicKey), "key does not match certificate")
+			test.AssertEqual(t, tc.req.CommonName, cert.Subject.CommonName, "")
+			if tc.req.OrganizationalUnit != "" {
+				test.AssertEqual(t, []string{tc.req.OrganizationalUnit},
+					cert.Subject.OrganizationalUnit, "")
+			}
+			if diff := cmp.Diff(tc.expDNS, cert.DNSNames); diff != "" {
+				t.Fatalf("unexpected DNS names (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, len(tc.expIPs), len(cert.IPAddresses), "")
+			for i, ip := range tc.expIPs {
+				test.AssertTrue(t, ip.Equal(cert.IPAddresses[i]), "unexpected IP address")
+			}
+			if !tc.expUntil.IsZero() {
+				test.AssertEqual(t, tc.expUntil, cert.NotAfter, "")
+			}
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca.Cert)
+			if _, err := cert.Verify(x509.VerifyOptions{
+				Roots:     roots,
+				KeyUsages: tc.req.ExtKeyUsage,
+			}); err != nil {
+				t.Fatalf("issued certificate not verified by CA: %s", err)
+			}
+		})
+	}
+}
+
+func checkFilePerm(t *testing.T, path string, expPerm os.FileMode) {
+	t.Helper()
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, expPerm, fi.Mode().Perm(), path)
+}
+
+func TestSecurity_GenerateCerts(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		cfg    *CertGenConfig
+		expErr error
+	}{
+		"nil config": {
+			expErr: errors.New("nil config"),
+		},
+		"CA key without certificate": {
+			cfg: &CertGenConfig{
+				Dir:       testDir,
+				Validity:  time.Hour,
+				CAKeyPath: "ca.key",
+			},
+			expErr: errors.New("both the CA certificate and key"),
+		},
+		"invalid host": {
+			cfg: &CertGenConfig{
+				Dir:         testDir,
+				Validity:    time.Hour,
+				ServerHosts: []string{"../host1"},
+			},
+			expErr: errors.New("invalid host name"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := GenerateCerts(tc.cfg)
+			test.CmpErr(t, tc.expErr, err)
+		})
+	}
+
+	gen, err := GenerateCerts(&CertGenConfig{
+		Dir:         testDir,
+		Validity:    time.Hour,
+		ServerHosts: []string{"host1", "10.0.0.2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caDir := filepath.Join(testDir, "daosCA")
+	expGen := &GeneratedCerts{
+		CADir:  caDir,
+		CACert: filepath.Join(caDir, "certs", "daosCA.crt"),
+		CAKey:  filepath.Join(caDir, "private", "daosCA.key"),
+		Server: CertKeyFiles{
+			Cert: filepath.Join(caDir, "certs", "server.crt"),
+			Key:  filepath.Join(caDir, "certs", "server.key"),
+		},
+		Agent: CertKeyFiles{
+			Cert: filepath.Join(caDir, "certs", "agent.crt"),
+			Key:  filepath.Join(caDir, "certs", "agent.key"),
+		},
+		Admin: CertKeyFiles{
+			Cert: filepath.Join(caDir, "certs", "admin.crt"),
+			Key:  filepath.Join(caDir, "certs", "admin.key"),
+		},
+		HostServers: map[string]CertKeyFiles{
+			"host1": {
+				Cert: filepath.Join(caDir, "certs", "hosts", "host1", "server.crt"),
+				Key:  filepath.Join(caDir, "certs", "hosts", "host1", "server.key"),
+			},
+			"10.0.0.2": {
+				Cert: filepath.Join(caDir, "certs", "hosts", "10.0.0.2", "server.crt"),
+				Key:  filepath.Join(caDir, "certs", "hosts", "10.0.0.2", "server.key"),
+			},
+		},
+		ClientsDir: filepath.Join(caDir, "certs", "clients"),
+	}
+	if diff := cmp.Diff(expGen, gen); diff != "" {
+		t.Fatalf("unexpected generated files (-want, +got):\n%s\n", diff)
+	}
+
+	checkFilePerm(t, caDir, 0700)
+	checkFilePerm(t, filepath.Join(caDir, "private"), 0700)
+	checkFilePerm(t, gen.CAKey, MaxUserOnlyKeyPerm)
+	checkFilePerm(t, gen.CACert, 0644)
+	checkFilePerm(t, gen.ClientsDir, 0755)
+	for _, files := range []CertKeyFiles{gen.Server, gen.Agent, gen.Admin, gen.HostServers["host1"]} {
+		checkFilePerm(t, files.Cert, 0644)
+		checkFilePerm(t, files.Key, MaxUserOnlyKeyPerm)
+	}
+	checkFilePerm(t, filepath.Join(gen.ClientsDir, "agent.crt"), 0644)
+	checkFilePerm(t, filepath.Join(gen.ClientsDir, "admin.crt"), 0644)
+
+	// The generated certificates are usable by each component.
+	for _, files := range []CertKeyFiles{gen.Server, gen.Agent, gen.Admin, gen.HostServers["host1"]} {
+		cfg := &TransportConfig{
+			CertificateConfig: CertificateConfig{
+				CARootPath:      gen.CACert,
+				CertificatePath: files.Cert,
+				PrivateKeyPath:  files.Key,
+				maxKeyPerms:     MaxUserOnlyKeyPerm,
+			},
+		}
+		if err := cfg.PreLoadCertData(); err != nil {
+			t.Fatalf("%s: %s", files.Cert, err)
+		}
+	}
+	hostCert, err := LoadCertificate(gen.HostServers["host1"].Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ServerCommonName, hostCert.Subject.CommonName, "")
+	if err := hostCert.VerifyHostname("host1"); err != nil {
+		t.Fatal(err)
+	}
+	ipCert, err := LoadCertificate(gen.HostServers["10.0.0.2"].Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ipCert.VerifyHostname("10.0.0.2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// An existing directory is not overwritten.
+	_, err = GenerateCerts(&CertGenConfig{Dir: testDir, Validity: time.Hour})
+	test.CmpErr(t, errors.New("already exists"), err)
+
+	// An existing CA may be used to sign new certificates.
+	otherDir := filepath.Join(testDir, "other")
+	reuseGen, err := GenerateCerts(&CertGenConfig{
+		Dir:        otherDir,
+		Validity:   time.Hour,
+		CACertPath: gen.CACert,
+		CAKeyPath:  gen.CAKey,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "", reuseGen.CAKey, "CA key should not be copied")
+	origCA, err := LoadCertificate(gen.CACert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reuseCA, err := LoadCertificate(reuseGen.CACert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, origCA.Equal(reuseCA), "CA certificate not reused")
+
+	_, err = GenerateCerts(&CertGenConfig{
+		Dir:        filepath.Join(testDir, "mismatch"),
+		Validity:   time.Hour,
+		CACertPath: gen.CACert,
+		CAKeyPath:  gen.Server.Key,
+	})
+	test.CmpErr(t, errors.New("is not the key for CA certificate"), err)
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,12 +12,16 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -91,18 +96,135 @@ func (cm ClientUserMap) Lookup(uid uint32) *MappedClientUser {
 	return cm[defaultMapKey]
 }
 
+// maxDelegationMapPerm is the maximum permissions of a delegation map file, as
+// anyone able to modify it could obtain credentials for any user.
+const maxDelegationMapPerm os.FileMode = 0644
+
+// DelegationMap is a map of delegating client users, such as a container
+// runtime, to the client users that they may request credentials for. The
+// delegating users may be identified by user name or uid.
+type DelegationMap map[string]ClientUserMap
+
+// Lookup attempts to resolve the supplied uid to a client user mapped to the
+// delegator. If the delegator is not in the map, or neither the uid nor a
+// default is mapped for the delegator, nil is returned.
+func (dm DelegationMap) Lookup(delegator string, uid uint32) *MappedClientUser {
+	users, found := dm[delegator]
+	if !found {
+		return nil
+	}
+	return users.Lookup(uid)
+}
+
+// readDelegationMapFile reads a delegation map file after checking that it is
+// a regular file owned by root or the agent user, and that it is not writable
+// by anyone else. The checks are made on the opened file so that it cannot be
+// replaced between checking and reading it.
+func readDelegationMapFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !fi.Mode().IsRegular() {
+		return nil, errors.Errorf("%s is not a regular file", path)
+	}
+	if err := checkMaxPermissions(path, fi.Mode(), maxDelegationMapPerm); err != nil {
+		return nil, err
+	}
+
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil, errors.Errorf("unable to determine the owner of %s", path)
+	}
+	if st.Uid != 0 && int(st.Uid) != os.Geteuid() {
+		return nil, errors.Errorf("%s must be owned by root or the agent user (uid %d), not uid %d",
+			path, os.Geteuid(), st.Uid)
+	}
+
+	return io.ReadAll(f)
+}
+
+// LoadDelegationMap reads a delegation map from a YAML file, which must be
+// owned by root or the agent user.
+func LoadDelegationMap(path string) (DelegationMap, error) {
+	data, err := readDelegationMapFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "loading delegation map")
+	}
+
+	dm := make(DelegationMap)
+	if err := yaml.UnmarshalStrict(data, &dm); err != nil {
+		return nil, errors.Wrapf(err, "parsing delegation map %q", path)
+	}
+
+	for delegator, users := range dm {
+		for _, mu := range users {
+			if mu == nil || mu.User == "" || mu.Group == "" {
+				return nil, errors.Errorf("delegation map %q: users mapped to %q require a user and group",
+					path, delegator)
+			}
+		}
+	}
+
+	return dm, nil
+}
+
 // CredentialConfig contains configuration details for managing user
-// credentials.
+// credentials. If CacheGroupCheck is set, the group memberships of users with
+// cached credentials are checked at that interval, and their cached credentials
+// are invalidated when the memberships change. If DelegationMapFile is set, the
+// delegating client users defined in the file may request credentials on behalf
+// of the users mapped to them.
 type CredentialConfig struct {
-	CacheExpiration time.Duration `yaml:"cache_expiration,omitempty"`
-	ClientUserMap   ClientUserMap `yaml:"client_user_map,omitempty"`
+	CacheExpiration   time.Duration `yaml:"cache_expiration,omitempty"`
+	CacheGroupCheck   time.Duration `yaml:"cache_group_check,omitempty"`
+	ClientUserMap     ClientUserMap `yaml:"client_user_map,omitempty"`
+	DelegationMapFile string        `yaml:"delegation_map_file,omitempty"`
+}
+
+// Validate checks that the credential configuration is valid.
+func (cc *CredentialConfig) Validate() error {
+	if cc == nil {
+		return nil
+	}
+
+	if cc.CacheExpiration < 0 {
+		return errors.New("cache_expiration must not be negative")
+	}
+	if cc.CacheGroupCheck < 0 {
+		return errors.New("cache_group_check must not be negative")
+	}
+	if cc.CacheGroupCheck > 0 && cc.CacheExpiration == 0 {
+		return errors.New("cache_group_check requires cache_expiration")
+	}
+
+	return nil
 }
 
 // TransportConfig contains all the information on whether or not to use
-// certificates and their location if their use is specified.
+// certificates and their location if their use is specified. ClientRoles is
+// only used by the server to determine the access granted to administrative
+// clients. If CertWatchInterval is set, long-running processes check the
+// certificate files at that interval and reload them when they change. If
+// CRLRefreshInterval is set, the certificate revocation list is reloaded at
+// that interval. TokenAuth is only used by the server to accept administrative
+// clients authenticated with bearer tokens on a separate port, and TokenFile is
+// only used by administrative clients to authenticate with a bearer token
+// instead of a certificate.
 type TransportConfig struct {
-	AllowInsecure     bool `yaml:"allow_insecure"`
-	CertificateConfig `yaml:",inline"`
+	AllowInsecure      bool             `yaml:"allow_insecure"`
+	ClientRoles        ClientRoles      `yaml:"client_roles,omitempty"`
+	CertWatchInterval  time.Duration    `yaml:"cert_watch_interval,omitempty"`
+	CRLRefreshInterval time.Duration    `yaml:"crl_refresh_interval,omitempty"`
+	TokenAuth          *TokenAuthConfig `yaml:"token_auth,omitempty"`
+	TokenFile          string           `yaml:"token_file,omitempty"`
+	CertificateConfig  `yaml:",inline"`
 }
 
 func (tc *TransportConfig) String() string {
@@ -111,19 +233,28 @@ func (tc *TransportConfig) String() string {
 
 // CertificateConfig contains the specific certificate information for the daos
 // component. ServerName is only needed if the config is being used as a
-// transport credential for a gRPC tls client.
+// transport credential for a gRPC tls client. If CRLPath is set, peer
+// certificates that have been revoked are rejected. CRLPath may be a file
+// path or an HTTP URL of a CRL distribution point.
 type CertificateConfig struct {
-	ServerName      string           `yaml:"-"`
-	ClientCertDir   string           `yaml:"client_cert_dir,omitempty"`
-	CARootPath      string           `yaml:"ca_cert"`
-	CertificatePath string           `yaml:"cert"`
-	PrivateKeyPath  string           `yaml:"key"`
-	tlsKeypair      *tls.Certificate `yaml:"-"`
-	caPool          *x509.CertPool   `yaml:"-"`
-	maxKeyPerms     fs.FileMode      `yaml:"-"`
-	verifyTime      time.Time        `yaml:"-"` // for testing
+	ServerName      string               `yaml:"-"`
+	ClientCertDir   string               `yaml:"client_cert_dir,omitempty"`
+	CARootPath      string               `yaml:"ca_cert"`
+	CertificatePath string               `yaml:"cert"`
+	PrivateKeyPath  string               `yaml:"key"`
+	CRLPath         string               `yaml:"crl,omitempty"`
+	tlsKeypair      *tls.Certificate     `yaml:"-"`
+	caPool          *x509.CertPool       `yaml:"-"`
+	crl             *x509.RevocationList `yaml:"-"`
+	revokedHandler  RevokedCertHandler   `yaml:"-"`
+	maxKeyPerms     fs.FileMode          `yaml:"-"`
+	verifyTime      time.Time            `yaml:"-"` // for testing
 }
 
+// certDataLock serializes access to the certificate data loaded into each
+// TransportConfig, which may be reloaded while connections are being made.
+var certDataLock sync.RWMutex
+
 // DefaultAgentTransportConfig provides a default transport config disabling
 // certificate usage and specifying certificates located under /etc/daos/certs.
 func DefaultAgentTransportConfig() *TransportConfig {
@@ -187,12 +318,30 @@ func (tc *TransportConfig) PreLoadCertData() error {
 	if tc == nil {
 		return errors.New("nil TransportConfig")
 	}
-	if tc.tlsKeypair != nil && tc.caPool != nil || tc.AllowInsecure {
+	if tc.AllowInsecure {
+		return nil
+	}
+	if keypair, caPool, _ := tc.certData(); keypair != nil && caPool != nil {
 		// In this case the data is already preloaded.
 		// In order to reload data use ReloadCertData
 		return nil
 	}
 
+	return tc.ReloadCertData()
+}
+
+// ReloadCertData reloads and stores the certificate data in the case when
+// certificate data has changed since initial loading. The previously loaded
+// data is retained if the new data is invalid, and connections made after a
+// successful reload use the new data.
+func (tc *TransportConfig) ReloadCertData() error {
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if tc.AllowInsecure {
+		return nil
+	}
+
 	if tc.ClientCertDir != "" {
 		if _, err := os.ReadDir(tc.ClientCertDir); errors.Is(err, fs.ErrPermission) {
 			return FaultUnreadableCertFile(tc.ClientCertDir)
@@ -206,16 +355,13 @@ func (tc *TransportConfig) PreLoadCertData() error {
 		return err
 	}
 
-	tc.tlsKeypair = certificate
-	tc.caPool = certPool
-
 	// Pre-parse the Leaf Certificate
-	tc.tlsKeypair.Leaf, err = x509.ParseCertificate(tc.tlsKeypair.Certificate[0])
+	certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0])
 	if err != nil {
 		return err
 	}
 
-	if _, err = tc.tlsKeypair.Leaf.Verify(x509.VerifyOptions{
+	if _, err = certificate.Leaf.Verify(x509.VerifyOptions{
 		CurrentTime: tc.CertificateConfig.verifyTime, // for testing - by default this is 0, which is treated as current time
 		Roots:       certPool,
 		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
@@ -225,15 +371,87 @@ func (tc *TransportConfig) PreLoadCertData() error {
 		return err
 	}
 
+	var crl *x509.RevocationList
+	if tc.CRLPath != "" {
+		if crl, err = loadCRL(tc.CRLPath, tc.CARootPath); err != nil {
+			return err
+		}
+		if err := checkRevoked(certificate.Leaf, crl); err != nil {
+			return FaultInvalidCertFile(tc.CertificatePath, err)
+		}
+	}
+
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+	tc.tlsKeypair = certificate
+	tc.caPool = certPool
+	tc.crl = crl
+
 	return nil
 }
 
-// ReloadCertData reloads and stores the certificate data in the case when
-// certificate data has changed since initial loading.
-func (tc *TransportConfig) ReloadCertData() error {
-	tc.tlsKeypair = nil
-	tc.caPool = nil
-	return tc.PreLoadCertData()
+// LoadCAData loads only the CA certificate and certificate revocation list, for
+// clients that verify the server but authenticate with a bearer token instead of
+// a certificate.
+func (tc *TransportConfig) LoadCAData() error {
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if tc.AllowInsecure {
+		return nil
+	}
+
+	certPool, err := loadCAPool(tc.CARootPath)
+	if err != nil {
+		return err
+	}
+
+	var crl *x509.RevocationList
+	if tc.CRLPath != "" {
+		if crl, err = loadCRL(tc.CRLPath, tc.CARootPath); err != nil {
+			return err
+		}
+	}
+
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+	tc.caPool = certPool
+	tc.crl = crl
+
+	return nil
+}
+
+// certData returns the currently loaded certificate data.
+func (tc *TransportConfig) certData() (*tls.Certificate, *x509.CertPool, *x509.RevocationList) {
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return tc.tlsKeypair, tc.caPool, tc.crl
+}
+
+// Certificate returns the currently loaded certificate, or nil if certificate
+// data has not been loaded.
+func (tc *TransportConfig) Certificate() *x509.Certificate {
+	if tc == nil {
+		return nil
+	}
+	keypair, _, _ := tc.certData()
+	if keypair == nil {
+		return nil
+	}
+
+	return keypair.Leaf
+}
+
+// CertFiles returns the paths of the files that certificate data is loaded from.
+// A certificate revocation list retrieved over HTTP is not included.
+func (tc *TransportConfig) CertFiles() []string {
+	files := []string{tc.CARootPath, tc.CertificatePath, tc.PrivateKeyPath}
+	if tc.CRLPath != "" && !isCRLURL(tc.CRLPath) {
+		files = append(files, tc.CRLPath)
+	}
+
+	return files
 }
 
 // PrivateKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -242,13 +460,11 @@ func (tc *TransportConfig) PrivateKey() (crypto.PrivateKey, error) {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := tc.PreLoadCertData(); err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.PrivateKey, nil
+	keypair, _, _ := tc.certData()
+	return keypair.PrivateKey, nil
 }
 
 // PublicKey returns the private key stored in the certificates loaded into the TransportConfig
@@ -257,11 +473,9 @@ func (tc *TransportConfig) PublicKey() (crypto.PublicKey, error) {
 		return nil, nil
 	}
 	// If we don't have our keys loaded attempt to load them.
-	if tc.tlsKeypair == nil || tc.caPool == nil {
-		err := tc.ReloadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := tc.PreLoadCertData(); err != nil {
+		return nil, err
 	}
-	return tc.tlsKeypair.Leaf.PublicKey, nil
+	keypair, _, _ := tc.certData()
+	return keypair.Leaf.PublicKey, nil
 }
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -201,8 +202,7 @@ func TestPreLoadCertData(t *testing.T) {
 				SetupTCFilePerms(t, serverTC)
 				return serverTC
 			},
-			expLoaded: true,
-			expErr:    FaultInvalidCertFile(ServerTC().CertificatePath, nil),
+			expErr: FaultInvalidCertFile(ServerTC().CertificatePath, nil),
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -508,3 +508,147 @@ default:
 		})
 	}
 }
+
+func TestSecurity_LoadDelegationMap(t *testing.T) {
+	alice := &MappedClientUser{
+		User:   "alice",
+		Group:  "users",
+		Groups: []string{"project1"},
+	}
+	nobody := &MappedClientUser{
+		User:  "nobody",
+		Group: "nobody",
+	}
+
+	for name, tc := range map[string]struct {
+		content string
+		perms   os.FileMode
+		owner   int
+		expMap  DelegationMap
+		expErr  error
+	}{
+		"not owned by root or agent user": {
+			content: "containerd:\n  1000:\n    user: alice\n    group: users\n",
+			owner:   os.Geteuid() + 1,
+			expErr:  errors.New("must be owned by root or the agent user"),
+		},
+		"bad perms": {
+			content: "containerd:\n  1000:\n    user: alice\n    group: users\n",
+			perms:   0666,
+			expErr:  errors.New("permissions"),
+		},
+		"invalid yaml": {
+			content: "containerd: [1000]\n",
+			expErr:  errors.New("parsing delegation map"),
+		},
+		"unknown field": {
+			content: "containerd:\n  1000:\n    user: alice\n    group: users\n    shell: /bin/sh\n",
+			expErr:  errors.New("parsing delegation map"),
+		},
+		"missing group": {
+			content: "containerd:\n  1000:\n    user: alice\n",
+			expErr:  errors.New(`users mapped to "containerd" require a user and group`),
+		},
+		"success": {
+			content: `
+containerd:
+  1000:
+    user: alice
+    group: users
+    groups: [project1]
+  default:
+    user: nobody
+    group: nobody
+"995":
+  1000:
+    user: alice
+    group: users
+    groups: [project1]
+`,
+			expMap: DelegationMap{
+				"containerd": ClientUserMap{
+					1000:          alice,
+					defaultMapKey: nobody,
+				},
+				"995": ClientUserMap{
+					1000: alice,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := filepath.Join(dir, "delegation.yml")
+			perms := tc.perms
+			if perms == 0 {
+				perms = 0644
+			}
+			if err := os.WriteFile(path, []byte(tc.content), perms); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(path, perms); err != nil {
+				t.Fatal(err)
+			}
+			if tc.owner != 0 {
+				if os.Geteuid() != 0 {
+					t.Skip("changing the file owner requires root")
+				}
+				if err := os.Chown(path, tc.owner, -1); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			dm, err := LoadDelegationMap(path)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.expMap, dm); diff != "" {
+				t.Fatalf("unexpected DelegationMap (-want, +got)\n %s", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_DelegationMap_Lookup(t *testing.T) {
+	alice := &MappedClientUser{User: "alice", Group: "users"}
+	nobody := &MappedClientUser{User: "nobody", Group: "nobody"}
+
+	dm := DelegationMap{
+		"containerd": ClientUserMap{1000: alice},
+		"995":        ClientUserMap{1000: alice, defaultMapKey: nobody},
+	}
+
+	for name, tc := range map[string]struct {
+		delegator string
+		uid       uint32
+		expUser   *MappedClientUser
+	}{
+		"unknown delegator": {
+			delegator: "runc",
+			uid:       1000,
+		},
+		"unmapped uid": {
+			delegator: "containerd",
+			uid:       1001,
+		},
+		"mapped uid": {
+			delegator: "containerd",
+			uid:       1000,
+			expUser:   alice,
+		},
+		"default": {
+			delegator: "995",
+			uid:       1001,
+			expUser:   nobody,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expUser, dm.Lookup(tc.delegator, tc.uid)); diff != "" {
+				t.Fatalf("unexpected user (-want, +got)\n %s", diff)
+			}
+		})
+	}
+}
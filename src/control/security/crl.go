@@ -0,0 +1,176 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	crlPEMType      = "X509 CRL"
+	crlFetchTimeout = 30 * time.Second
+	maxCRLSize      = 64 << 20
+)
+
+// isCRLURL returns true if the certificate revocation list is retrieved from
+// an HTTP distribution point rather than a file.
+func isCRLURL(crlPath string) bool {
+	return strings.HasPrefix(crlPath, "http://") || strings.HasPrefix(crlPath, "https://")
+}
+
+// fetchCRL retrieves a certificate revocation list from an HTTP distribution point.
+func fetchCRL(url string) ([]byte, error) {
+	client := &http.Client{Timeout: crlFetchTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch CRL")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("could not fetch CRL from %s: %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCRLSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not fetch CRL from %s", url)
+	}
+	if len(data) > maxCRLSize {
+		return nil, FaultInvalidCRLFile(url, errors.Errorf("larger than %d bytes", maxCRLSize))
+	}
+
+	return data, nil
+}
+
+func readCRL(crlPath string) ([]byte, error) {
+	if isCRLURL(crlPath) {
+		return fetchCRL(crlPath)
+	}
+
+	crlData, err := LoadPEMData(crlPath, MaxCertPerm)
+	if err != nil {
+		switch {
+		case os.IsNotExist(err):
+			return nil, FaultMissingCertFile(crlPath)
+		case os.IsPermission(err):
+			return nil, FaultUnreadableCertFile(crlPath)
+		default:
+			return nil, errors.Wrap(err, "could not load CRL")
+		}
+	}
+
+	return crlData, nil
+}
+
+// loadCRL loads the certificate revocation list from the given file path or
+// HTTP URL, in PEM or DER format, and verifies that it was issued by one of the
+// CA certificates in the PEM file at caRootPath.
+func loadCRL(crlPath, caRootPath string) (*x509.RevocationList, error) {
+	crlData, err := readCRL(crlPath)
+	if err != nil {
+		return nil, err
+	}
+	if block, _ := pem.Decode(crlData); block != nil {
+		if block.Type != crlPEMType {
+			return nil, FaultInvalidCRLFile(crlPath,
+				errors.Errorf("unexpected PEM block type %q", block.Type))
+		}
+		crlData = block.Bytes
+	}
+
+	crl, err := x509.ParseRevocationList(crlData)
+	if err != nil {
+		return nil, FaultInvalidCRLFile(crlPath, err)
+	}
+
+	caPEM, err := LoadPEMData(caRootPath, MaxCertPerm)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load caRoot")
+	}
+	for block, rest := pem.Decode(caPEM); block != nil; block, rest = pem.Decode(rest) {
+		caCert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if crl.CheckSignatureFrom(caCert) == nil {
+			return crl, nil
+		}
+	}
+
+	return nil, FaultInvalidCRLFile(crlPath, errors.New("not signed by a trusted CA"))
+}
+
+// checkRevoked returns an error if the certificate has been revoked by the
+// issuer of the certificate revocation list.
+func checkRevoked(cert *x509.Certificate, crl *x509.RevocationList) error {
+	if crl == nil || cert.Issuer.String() != crl.Issuer.String() {
+		return nil
+	}
+
+	for _, entry := range crl.RevokedCertificateEntries {
+		if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return errors.Errorf("certificate %q (serial %s) has been revoked",
+				cert.Subject.CommonName, cert.SerialNumber)
+		}
+	}
+
+	return nil
+}
+
+// RevokedCertHandler is called when a peer presents a certificate that has
+// been revoked. The peer describes where the certificate was presented.
+type RevokedCertHandler func(cert *x509.Certificate, peer string)
+
+// SetRevokedCertHandler sets the function to be called when a peer certificate
+// is rejected because it has been revoked.
+func (tc *TransportConfig) SetRevokedCertHandler(fn RevokedCertHandler) {
+	tc.revokedHandler = fn
+}
+
+// CheckRevoked returns an error if the certificate presented by the peer has
+// been revoked by the currently loaded certificate revocation list.
+func (tc *TransportConfig) CheckRevoked(cert *x509.Certificate, peer string) error {
+	_, _, crl := tc.certData()
+	if err := checkRevoked(cert, crl); err != nil {
+		if tc.revokedHandler != nil {
+			tc.revokedHandler(cert, peer)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RefreshCRL reloads the certificate revocation list without reloading the
+// certificates. The previously loaded list is retained if the new one is invalid.
+func (tc *TransportConfig) RefreshCRL() error {
+	if tc == nil {
+		return errors.New("nil TransportConfig")
+	}
+	if tc.AllowInsecure || tc.CRLPath == "" {
+		return nil
+	}
+
+	crl, err := loadCRL(tc.CRLPath, tc.CARootPath)
+	if err != nil {
+		return err
+	}
+
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+	tc.crl = crl
+
+	return nil
+}
@@ -0,0 +1,428 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+)
+
+// testPKI generates a CA and certificates issued by it in a test directory.
+type testPKI struct {
+	dir    string
+	caPath string
+	caCert *x509.Certificate
+	caKey  *rsa.PrivateKey
+}
+
+func genTestKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// writeTestFile replaces the file at path so that read-only files can be rewritten.
+func writeTestFile(t *testing.T, path string, data []byte, perm os.FileMode) {
+	t.Helper()
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, perm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newTestPKI(t *testing.T, dir, caName string) *testPKI {
+	t.Helper()
+
+	key := genTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1000),
+		Subject:               pkix.Name{Organization: []string{"DAOS"}, CommonName: caName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pki := &testPKI{
+		dir:    dir,
+		caPath: filepath.Join(dir, caName+".crt"),
+		caCert: cert,
+		caKey:  key,
+	}
+	writeTestFile(t, pki.caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		MaxCertPerm)
+
+	return pki
+}
+
+// issue creates a certificate with the given CommonName and serial number and
+// writes it and its key to <name>.crt and <name>.key in the PKI directory.
+func (pki *testPKI) issue(t *testing.T, name, cn string, serial int64) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key := genTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{Organization: []string{"DAOS"}, CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(12 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, pki.caCert, &key.PublicKey, pki.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPath := filepath.Join(pki.dir, name+".crt")
+	keyPath := filepath.Join(pki.dir, name+".key")
+	writeTestFile(t, certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		MaxCertPerm)
+	writeTestFile(t, keyPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), MaxUserOnlyKeyPerm)
+
+	return cert, certPath, keyPath
+}
+
+// genCRL creates a certificate revocation list signed by the CA revoking the
+// certificates with the given serial numbers.
+func (pki *testPKI) genCRL(t *testing.T, number int64, serials ...int64) []byte {
+	t.Helper()
+
+	tmpl := &x509.RevocationList{
+		Number:     big.NewInt(number),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+	for _, serial := range serials {
+		tmpl.RevokedCertificateEntries = append(tmpl.RevokedCertificateEntries,
+			x509.RevocationListEntry{
+				SerialNumber:   big.NewInt(serial),
+				RevocationTime: time.Now().Add(-time.Minute),
+			})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, tmpl, pki.caCert, pki.caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return der
+}
+
+// writeCRL writes a PEM encoded certificate revocation list to path.
+func (pki *testPKI) writeCRL(t *testing.T, path string, number int64, serials ...int64) {
+	t.Helper()
+
+	writeTestFile(t, path, pem.EncodeToMemory(&pem.Block{
+		Type:  crlPEMType,
+		Bytes: pki.genCRL(t, number, serials...),
+	}), MaxCertPerm)
+}
+
+func TestSecurity_loadCRL(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	otherPKI := newTestPKI(t, testDir, "other")
+
+	pemPath := filepath.Join(testDir, "pem.crl")
+	pki.writeCRL(t, pemPath, 1, 2, 3)
+	derPath := filepath.Join(testDir, "der.crl")
+	writeTestFile(t, derPath, pki.genCRL(t, 1, 2), MaxCertPerm)
+	otherPath := filepath.Join(testDir, "other.crl")
+	otherPKI.writeCRL(t, otherPath, 1, 2)
+	garbagePath := filepath.Join(testDir, "garbage.crl")
+	writeTestFile(t, garbagePath, []byte("garbage"), MaxCertPerm)
+	certPath := filepath.Join(testDir, "cert.crl")
+	writeTestFile(t, certPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: pki.caCert.Raw,
+	}), MaxCertPerm)
+	srv := httptest.NewServer(http.FileServer(http.Dir(testDir)))
+	defer srv.Close()
+	badPermsPath := filepath.Join(testDir, "badperms.crl")
+	pki.writeCRL(t, badPermsPath, 1, 2)
+	if err := os.Chmod(badPermsPath, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		crlPath    string
+		expRevoked int
+		expErr     error
+	}{
+		"pem": {
+			crlPath:    pemPath,
+			expRevoked: 2,
+		},
+		"der": {
+			crlPath:    derPath,
+			expRevoked: 1,
+		},
+		"missing": {
+			crlPath: filepath.Join(testDir, "missing.crl"),
+			expErr:  FaultMissingCertFile(filepath.Join(testDir, "missing.crl")),
+		},
+		"bad permissions": {
+			crlPath: badPermsPath,
+			expErr:  errors.New("insecure permissions"),
+		},
+		"not a CRL": {
+			crlPath: garbagePath,
+			expErr:  FaultInvalidCRLFile(garbagePath, errors.New("")),
+		},
+		"wrong PEM type": {
+			crlPath: certPath,
+			expErr:  FaultInvalidCRLFile(certPath, errors.New("")),
+		},
+		"untrusted issuer": {
+			crlPath: otherPath,
+			expErr:  FaultInvalidCRLFile(otherPath, errors.New("")),
+		},
+		"url": {
+			crlPath:    srv.URL + "/pem.crl",
+			expRevoked: 2,
+		},
+		"url not found": {
+			crlPath: srv.URL + "/missing.crl",
+			expErr:  errors.New("404 Not Found"),
+		},
+		"url not a CRL": {
+			crlPath: srv.URL + "/garbage.crl",
+			expErr:  FaultInvalidCRLFile(srv.URL+"/garbage.crl", errors.New("")),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			crl, err := loadCRL(tc.crlPath, pki.caPath)
+			if f, ok := tc.expErr.(*fault.Fault); ok {
+				if !fault.IsFaultCode(err, f.Code) {
+					t.Fatalf("expected fault code %d, got %v", f.Code, err)
+				}
+				return
+			}
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expRevoked, len(crl.RevokedCertificateEntries), "")
+		})
+	}
+}
+
+func TestSecurity_checkRevoked(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	otherPKI := newTestPKI(t, testDir, "other")
+	revoked, _, _ := pki.issue(t, "revoked", "admin", 2)
+	valid, _, _ := pki.issue(t, "valid", "admin", 3)
+	otherRevoked, _, _ := otherPKI.issue(t, "other", "admin", 2)
+
+	crl, err := x509.ParseRevocationList(pki.genCRL(t, 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		cert   *x509.Certificate
+		crl    *x509.RevocationList
+		expErr error
+	}{
+		"no CRL": {
+			cert: revoked,
+		},
+		"revoked": {
+			cert:   revoked,
+			crl:    crl,
+			expErr: errors.New("has been revoked"),
+		},
+		"not revoked": {
+			cert: valid,
+			crl:  crl,
+		},
+		"same serial from other issuer": {
+			cert: otherRevoked,
+			crl:  crl,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, checkRevoked(tc.cert, tc.crl))
+		})
+	}
+}
+
+func TestSecurity_ReloadCertData_CRL(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	_, certPath, keyPath := pki.issue(t, "server", "server", 2)
+	clientCert, _, _ := pki.issue(t, "admin", "admin", 3)
+	crlPath := filepath.Join(testDir, "ca.crl")
+	pki.writeCRL(t, crlPath, 1)
+
+	cfg := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			CARootPath:      pki.caPath,
+			CertificatePath: certPath,
+			PrivateKeyPath:  keyPath,
+			CRLPath:         crlPath,
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	cs := tls.ConnectionState{PeerCertificates: []*x509.Certificate{clientCert}}
+	if err := verifyPeer(cfg, "test", cs, x509.ExtKeyUsageClientAuth); err != nil {
+		t.Fatalf("expected client certificate to be accepted, got %s", err)
+	}
+
+	// Peers with revoked certificates are rejected once the reloaded CRL is in use.
+	pki.writeCRL(t, crlPath, 2, 3)
+	if err := cfg.ReloadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, errors.New("has been revoked"),
+		verifyPeer(cfg, "test", cs, x509.ExtKeyUsageClientAuth))
+
+	// The previously loaded data is retained if the certificate itself is revoked.
+	pki.writeCRL(t, crlPath, 3, 2, 3)
+	err := cfg.ReloadCertData()
+	if !fault.IsFaultCode(err, code.SecurityInvalidCert) {
+		t.Fatalf("expected invalid cert fault, got %v", err)
+	}
+	_, _, crl := cfg.certData()
+	test.AssertEqual(t, int64(2), crl.Number.Int64(), "previous CRL not retained")
+}
+
+func TestSecurity_TransportConfig_CheckRevoked(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	_, certPath, keyPath := pki.issue(t, "server", "server", 2)
+	revoked, _, _ := pki.issue(t, "revoked", "agent", 3)
+	valid, _, _ := pki.issue(t, "valid", "agent", 4)
+	crlPath := filepath.Join(testDir, "ca.crl")
+	pki.writeCRL(t, crlPath, 1, 3)
+
+	cfg := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			CARootPath:      pki.caPath,
+			CertificatePath: certPath,
+			PrivateKeyPath:  keyPath,
+			CRLPath:         crlPath,
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	var rejected []string
+	cfg.SetRevokedCertHandler(func(cert *x509.Certificate, peer string) {
+		rejected = append(rejected, cert.SerialNumber.String()+" "+peer)
+	})
+
+	if err := cfg.CheckRevoked(valid, "peer1"); err != nil {
+		t.Fatal(err)
+	}
+	test.CmpErr(t, errors.New("has been revoked"), cfg.CheckRevoked(revoked, "peer2"))
+
+	cs := tls.ConnectionState{PeerCertificates: []*x509.Certificate{revoked}}
+	test.CmpErr(t, errors.New("has been revoked"),
+		verifyPeer(cfg, "peer3", cs, x509.ExtKeyUsageClientAuth))
+
+	test.AssertEqual(t, []string{"3 peer2", "3 peer3"}, rejected, "")
+}
+
+func TestSecurity_TransportConfig_RefreshCRL(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	pki := newTestPKI(t, testDir, "ca")
+	_, certPath, keyPath := pki.issue(t, "server", "server", 2)
+	crlPath := filepath.Join(testDir, "ca.crl")
+	pki.writeCRL(t, crlPath, 1)
+
+	srv := httptest.NewServer(http.FileServer(http.Dir(testDir)))
+	defer srv.Close()
+
+	cfg := &TransportConfig{
+		CertificateConfig: CertificateConfig{
+			CARootPath:      pki.caPath,
+			CertificatePath: certPath,
+			PrivateKeyPath:  keyPath,
+			CRLPath:         srv.URL + "/ca.crl",
+			maxKeyPerms:     MaxUserOnlyKeyPerm,
+		},
+	}
+	if err := cfg.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, []string{pki.caPath, certPath, keyPath}, cfg.CertFiles(),
+		"CRL URL should not be watched as a file")
+
+	pki.writeCRL(t, crlPath, 2, 3)
+	if err := cfg.RefreshCRL(); err != nil {
+		t.Fatal(err)
+	}
+	_, _, crl := cfg.certData()
+	test.AssertEqual(t, int64(2), crl.Number.Int64(), "CRL not refreshed")
+
+	// The previously loaded list is retained if the new one is invalid.
+	writeTestFile(t, crlPath, []byte("garbage"), MaxCertPerm)
+	if err := cfg.RefreshCRL(); !fault.IsFaultCode(err, code.SecurityInvalidCRL) {
+		t.Fatalf("expected invalid CRL fault, got %v", err)
+	}
+	_, _, crl = cfg.certData()
+	test.AssertEqual(t, int64(2), crl.Number.Int64(), "previous CRL not retained")
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -65,6 +66,19 @@ func FaultInvalidCert(err error) *fault.Fault {
 	return f
 }
 
+// FaultInvalidCRLFile indicates that a certificate revocation list loaded from a file was invalid.
+func FaultInvalidCRLFile(filePath string, err error) *fault.Fault {
+	f := securityFault(
+		code.SecurityInvalidCRL,
+		fmt.Sprintf("certificate revocation list at path %q is invalid", filePath),
+		"verify the certificate revocation list is in PEM or DER format and is signed by the DAOS CA",
+	)
+	if err != nil {
+		f.Reason = err.Error()
+	}
+	return f
+}
+
 func securityFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "security",
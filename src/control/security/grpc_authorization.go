@@ -33,14 +33,24 @@ var methodAuthorizations = map[string][]Component{
 	"/ctl.CtlSvc/StorageFormat":              {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeRebind":          {ComponentAdmin},
 	"/ctl.CtlSvc/StorageNvmeAddDevice":       {ComponentAdmin},
+	"/ctl.CtlSvc/StorageNvmeReplace":         {ComponentAdmin},
+	"/ctl.CtlSvc/StorageNvmeNsCreate":        {ComponentAdmin},
+	"/ctl.CtlSvc/StorageNvmeNsDelete":        {ComponentAdmin},
+	"/ctl.CtlSvc/StorageRenderConfig":        {ComponentAdmin},
 	"/ctl.CtlSvc/NetworkScan":                {ComponentAdmin},
 	"/ctl.CtlSvc/CollectLog":                 {ComponentAdmin},
+	"/ctl.CtlSvc/AuditQuery":                 {ComponentAdmin},
+	"/ctl.CtlSvc/ReloadCerts":                {ComponentAdmin},
+	"/ctl.CtlSvc/Handshake":                  {ComponentAdmin, ComponentAgent, ComponentServer},
 	"/ctl.CtlSvc/FirmwareQuery":              {ComponentAdmin},
 	"/ctl.CtlSvc/FirmwareUpdate":             {ComponentAdmin},
 	"/ctl.CtlSvc/SmdQuery":                   {ComponentAdmin},
 	"/ctl.CtlSvc/SmdManage":                  {ComponentAdmin},
 	"/ctl.CtlSvc/SetEngineLogMasks":          {ComponentAdmin},
+	"/ctl.CtlSvc/ReloadConfig":               {ComponentAdmin},
+	"/ctl.CtlSvc/SetEngineStandby":           {ComponentAdmin},
 	"/ctl.CtlSvc/PrepShutdownRanks":          {ComponentServer},
+	"/ctl.CtlSvc/DrainRanks":                 {ComponentServer},
 	"/ctl.CtlSvc/StopRanks":                  {ComponentServer},
 	"/ctl.CtlSvc/ResetFormatRanks":           {ComponentServer},
 	"/ctl.CtlSvc/StartRanks":                 {ComponentServer},
@@ -70,6 +80,10 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/PoolReintegrate":          {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolEvict":                {ComponentAdmin, ComponentAgent},
 	"/mgmt.MgmtSvc/PoolExtend":               {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolResize":               {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolClone":                {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolProfileSet":           {ComponentAdmin},
+	"/mgmt.MgmtSvc/PoolProfileGet":           {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolUpgrade":              {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolRebuildStart":         {ComponentAdmin},
 	"/mgmt.MgmtSvc/PoolRebuildStop":          {ComponentAdmin},
@@ -94,6 +108,10 @@ var methodAuthorizations = map[string][]Component{
 	"/mgmt.MgmtSvc/SystemGetAttr":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemSetProp":            {ComponentAdmin},
 	"/mgmt.MgmtSvc/SystemGetProp":            {ComponentAdmin},
+	"/mgmt.MgmtSvc/SystemEventsQuery":        {ComponentAdmin},
+	"/mgmt.MgmtSvc/JobSubmit":                {ComponentAdmin},
+	"/mgmt.MgmtSvc/JobList":                  {ComponentAdmin},
+	"/mgmt.MgmtSvc/JobCancel":                {ComponentAdmin},
 	"/RaftTransport/AppendEntries":           {ComponentServer},
 	"/RaftTransport/AppendEntriesPipeline":   {ComponentServer},
 	"/RaftTransport/RequestVote":             {ComponentServer},
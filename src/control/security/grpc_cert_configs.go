@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -28,28 +29,58 @@ import (
 // On the client side we still ensure the CommonName for the server is correct and
 // validate the certificate chain.
 
+var errNoCertData = errors.New("certificate data not loaded")
+
+// verifyPeer verifies the certificate chain presented by the peer against the
+// currently loaded CA pool and rejects certificates that have been revoked.
+func verifyPeer(cfg *TransportConfig, peer string, cs tls.ConnectionState, keyUsages ...x509.ExtKeyUsage) error {
+	_, caPool, _ := cfg.certData()
+	opts := x509.VerifyOptions{
+		Roots:         caPool,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     keyUsages,
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+		return err
+	}
+	for _, cert := range cs.PeerCertificates {
+		if err := cfg.CheckRevoked(cert, peer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// The server configuration is generated for each handshake so that certificate
+// data reloaded after the server has started is used for new connections.
 func serverTLSConfig(cfg *TransportConfig) *tls.Config {
 	return &tls.Config{
-		ClientAuth:               tls.RequireAndVerifyClientCert,
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:                cfg.caPool,
-		MinVersion:               tls.VersionTLS12,
-		MaxVersion:               tls.VersionTLS12,
-		PreferServerCipherSuites: true,
-		CipherSuites: []uint16{
-			tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
-		},
-		VerifyConnection: func(cs tls.ConnectionState) error {
-			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
-				Intermediates: x509.NewCertPool(),
-				KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
-			}
-			for _, cert := range cs.PeerCertificates[1:] {
-				opts.Intermediates.AddCert(cert)
+		MinVersion: tls.VersionTLS12,
+		MaxVersion: tls.VersionTLS12,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			keypair, caPool, _ := cfg.certData()
+			if keypair == nil {
+				return nil, errNoCertData
 			}
-			_, err := cs.PeerCertificates[0].Verify(opts)
-			return err
+			return &tls.Config{
+				ClientAuth:               tls.RequireAndVerifyClientCert,
+				Certificates:             []tls.Certificate{*keypair},
+				ClientCAs:                caPool,
+				NextProtos:               []string{"h2"},
+				MinVersion:               tls.VersionTLS12,
+				MaxVersion:               tls.VersionTLS12,
+				PreferServerCipherSuites: true,
+				CipherSuites: []uint16{
+					tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+				},
+				VerifyConnection: func(cs tls.ConnectionState) error {
+					return verifyPeer(cfg, "gRPC client "+hello.Conn.RemoteAddr().String(),
+						cs, x509.ExtKeyUsageClientAuth)
+				},
+			}, nil
 		},
 	}
 }
@@ -58,8 +89,15 @@ const ServerCommonName = "server"
 
 func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 	return &tls.Config{
-		Certificates:             []tls.Certificate{*cfg.tlsKeypair},
-		RootCAs:                  cfg.caPool,
+		// The client certificate is looked up for each handshake so that
+		// reloaded certificate data is used for new connections.
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			keypair, _, _ := cfg.certData()
+			if keypair == nil {
+				return nil, errNoCertData
+			}
+			return keypair, nil
+		},
 		MinVersion:               tls.VersionTLS12,
 		MaxVersion:               tls.VersionTLS12,
 		PreferServerCipherSuites: true,
@@ -79,15 +117,7 @@ func clientTLSConfig(cfg *TransportConfig) *tls.Config {
 		// of the received certificate is "server" to ensure we are
 		// communicating with a DAOS server.
 		VerifyConnection: func(cs tls.ConnectionState) error {
-			opts := x509.VerifyOptions{
-				Roots:         cfg.caPool,
-				Intermediates: x509.NewCertPool(),
-			}
-			for _, cert := range cs.PeerCertificates[1:] {
-				opts.Intermediates.AddCert(cert)
-			}
-			_, err := cs.PeerCertificates[0].Verify(opts)
-			if err != nil {
+			if err := verifyPeer(cfg, "gRPC server", cs); err != nil {
 				return err
 			}
 			if cs.PeerCertificates[0].Subject.CommonName != ServerCommonName {
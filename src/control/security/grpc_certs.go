@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2021 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -18,11 +19,8 @@ func GetServerTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(serverTLSConfig(cfg))
@@ -34,11 +32,8 @@ func GetClientTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		return nil, errors.New("nil TransportConfig")
 	}
 
-	if cfg.tlsKeypair == nil || cfg.caPool == nil {
-		err := cfg.PreLoadCertData()
-		if err != nil {
-			return nil, err
-		}
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
 	}
 
 	creds := credentials.NewTLS(clientTLSConfig(cfg))
@@ -75,6 +70,13 @@ func DialOptionForTransportConfig(cfg *TransportConfig) (grpc.DialOption, error)
 		return nil, errors.New("No ServerName set in TransportConfig")
 	}
 
+	if cfg.TokenFile != "" {
+		if err := cfg.LoadCAData(); err != nil {
+			return nil, err
+		}
+		return grpc.WithTransportCredentials(credentials.NewTLS(tokenClientTLSConfig(cfg))), nil
+	}
+
 	creds, err := GetClientTransportCredentials(cfg)
 	if err != nil {
 		return nil, err
@@ -82,3 +84,21 @@ func DialOptionForTransportConfig(cfg *TransportConfig) (grpc.DialOption, error)
 
 	return grpc.WithTransportCredentials(creds), nil
 }
+
+// ServerOptionForTokenAuth returns the credentials of a listener for clients
+// that authenticate with bearer tokens instead of certificates.
+func ServerOptionForTokenAuth(cfg *TransportConfig) (grpc.ServerOption, error) {
+	if cfg == nil {
+		return nil, errors.New("nil TransportConfig")
+	}
+
+	if cfg.AllowInsecure {
+		return nil, errors.New("token authentication requires certificates")
+	}
+
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
+	}
+
+	return grpc.Creds(credentials.NewTLS(tokenServerTLSConfig(cfg))), nil
+}
@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/pkg/errors"
+)
+
+// httpServerTLSConfig returns the configuration of a HTTP listener. Clients may
+// present a certificate, which is verified in the same way as for gRPC clients,
+// or authenticate with a bearer token instead. HTTP/2 is not offered as the
+// cipher suite in use is not permitted by the HTTP/2 specification.
+func httpServerTLSConfig(cfg *TransportConfig) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		MaxVersion: tls.VersionTLS12,
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			keypair, caPool, _ := cfg.certData()
+			if keypair == nil {
+				return nil, errNoCertData
+			}
+			return &tls.Config{
+				ClientAuth:               tls.VerifyClientCertIfGiven,
+				Certificates:             []tls.Certificate{*keypair},
+				ClientCAs:                caPool,
+				NextProtos:               []string{"http/1.1"},
+				MinVersion:               tls.VersionTLS12,
+				MaxVersion:               tls.VersionTLS12,
+				PreferServerCipherSuites: true,
+				CipherSuites: []uint16{
+					tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+				},
+				VerifyConnection: func(cs tls.ConnectionState) error {
+					if len(cs.PeerCertificates) == 0 {
+						return nil
+					}
+					return verifyPeer(cfg, "HTTP client "+hello.Conn.RemoteAddr().String(),
+						cs, x509.ExtKeyUsageClientAuth)
+				},
+			}, nil
+		},
+	}
+}
+
+// ServerTLSConfigForHTTP returns the TLS configuration of a HTTP listener that
+// uses the certificates of the transport config, or nil if the transport is
+// insecure.
+func ServerTLSConfigForHTTP(cfg *TransportConfig) (*tls.Config, error) {
+	if cfg == nil {
+		return nil, errors.New("nil TransportConfig")
+	}
+
+	if cfg.AllowInsecure {
+		return nil, nil
+	}
+
+	if err := cfg.PreLoadCertData(); err != nil {
+		return nil, err
+	}
+
+	return httpServerTLSConfig(cfg), nil
+}
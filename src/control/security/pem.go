@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -53,21 +54,36 @@ func isInvalidCert(err error) bool {
 	return isCertErr
 }
 
-func loadCertWithCustomCA(caRootPath, certPath, keyPath string, maxKeyPerm os.FileMode) (*tls.Certificate, *x509.CertPool, error) {
+// loadCAPool loads the CA certificate at the given path into a certificate pool.
+func loadCAPool(caRootPath string) (*x509.CertPool, error) {
 	caPEM, err := LoadPEMData(caRootPath, MaxCertPerm)
 	if err != nil {
 		switch {
 		case os.IsNotExist(err):
-			return nil, nil, FaultMissingCertFile(caRootPath)
+			return nil, FaultMissingCertFile(caRootPath)
 		case os.IsPermission(err):
-			return nil, nil, FaultUnreadableCertFile(caRootPath)
+			return nil, FaultUnreadableCertFile(caRootPath)
 		case isInvalidCert(err):
-			return nil, nil, FaultInvalidCertFile(caRootPath, err)
+			return nil, FaultInvalidCertFile(caRootPath, err)
 		default:
-			return nil, nil, errors.Wrapf(err, "could not load caRoot")
+			return nil, errors.Wrapf(err, "could not load caRoot")
 		}
 	}
 
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPEM) {
+		return nil, errors.New("unable to append caRoot to cert pool")
+	}
+
+	return certPool, nil
+}
+
+func loadCertWithCustomCA(caRootPath, certPath, keyPath string, maxKeyPerm os.FileMode) (*tls.Certificate, *x509.CertPool, error) {
+	certPool, err := loadCAPool(caRootPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	certPEM, err := LoadPEMData(certPath, MaxCertPerm)
 	if err != nil {
 		switch {
@@ -101,13 +117,6 @@ func loadCertWithCustomCA(caRootPath, certPath, keyPath string, maxKeyPerm os.Fi
 		return nil, nil, errors.Wrapf(err, "could not create X509KeyPair")
 	}
 
-	certPool := x509.NewCertPool()
-
-	added := certPool.AppendCertsFromPEM(caPEM)
-	if !added {
-		return nil, nil, errors.Wrapf(err, "unable to append caRoot to cert pool")
-	}
-
 	return &certificate, certPool, nil
 }
 
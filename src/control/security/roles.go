@@ -0,0 +1,190 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Role represents the level of access granted to an administrative client.
+// Each role includes the access granted to the roles below it.
+type Role int
+
+const (
+	RoleUndefined Role = iota
+	RoleReadOnly
+	RoleOperator
+	RoleAdmin
+)
+
+func (r Role) String() string {
+	return [...]string{"undefined", "read-only", "operator", "admin"}[r]
+}
+
+// RoleFromString returns the role with the given name.
+func RoleFromString(name string) (Role, error) {
+	for _, r := range []Role{RoleReadOnly, RoleOperator, RoleAdmin} {
+		if strings.EqualFold(name, r.String()) {
+			return r, nil
+		}
+	}
+
+	return RoleUndefined, errors.Errorf("unknown role %q (valid roles: %s, %s, %s)", name,
+		RoleReadOnly, RoleOperator, RoleAdmin)
+}
+
+func (r *Role) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err != nil {
+		return err
+	}
+
+	role, err := RoleFromString(name)
+	if err != nil {
+		return err
+	}
+	*r = role
+
+	return nil
+}
+
+func (r Role) MarshalYAML() (interface{}, error) {
+	return r.String(), nil
+}
+
+// methodRoles is the map for checking the minimum role an administrative client requires to
+// make the specific method call. Methods that are not listed require the admin role.
+var methodRoles = map[string]Role{
+	"/ctl.CtlSvc/StorageScan":            RoleReadOnly,
+	"/ctl.CtlSvc/NetworkScan":            RoleReadOnly,
+	"/ctl.CtlSvc/FirmwareQuery":          RoleReadOnly,
+	"/ctl.CtlSvc/SmdQuery":               RoleReadOnly,
+	"/ctl.CtlSvc/Handshake":              RoleReadOnly,
+	"/ctl.CtlSvc/CollectLog":             RoleOperator,
+	"/ctl.CtlSvc/AuditQuery":             RoleOperator,
+	"/ctl.CtlSvc/SmdManage":              RoleOperator,
+	"/ctl.CtlSvc/SetEngineLogMasks":      RoleOperator,
+	"/ctl.CtlSvc/ReloadConfig":           RoleOperator,
+	"/ctl.CtlSvc/SetEngineStandby":       RoleOperator,
+	"/mgmt.MgmtSvc/LeaderQuery":          RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemQuery":          RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemStart":          RoleOperator,
+	"/mgmt.MgmtSvc/SystemStop":           RoleOperator,
+	"/mgmt.MgmtSvc/SystemExclude":        RoleOperator,
+	"/mgmt.MgmtSvc/SystemDrain":          RoleOperator,
+	"/mgmt.MgmtSvc/SystemRebuildManage":  RoleOperator,
+	"/mgmt.MgmtSvc/SystemSelfHealEval":   RoleOperator,
+	"/mgmt.MgmtSvc/PoolQuery":            RoleReadOnly,
+	"/mgmt.MgmtSvc/PoolQueryTarget":      RoleReadOnly,
+	"/mgmt.MgmtSvc/PoolGetProp":          RoleReadOnly,
+	"/mgmt.MgmtSvc/PoolGetACL":           RoleReadOnly,
+	"/mgmt.MgmtSvc/PoolExclude":          RoleOperator,
+	"/mgmt.MgmtSvc/PoolDrain":            RoleOperator,
+	"/mgmt.MgmtSvc/PoolReintegrate":      RoleOperator,
+	"/mgmt.MgmtSvc/PoolEvict":            RoleOperator,
+	"/mgmt.MgmtSvc/PoolProfileGet":       RoleReadOnly,
+	"/mgmt.MgmtSvc/PoolRebuildStart":     RoleOperator,
+	"/mgmt.MgmtSvc/PoolRebuildStop":      RoleOperator,
+	"/mgmt.MgmtSvc/PoolSelfHealEval":     RoleOperator,
+	"/mgmt.MgmtSvc/ListPools":            RoleReadOnly,
+	"/mgmt.MgmtSvc/ListContainers":       RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemCheckQuery":     RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemCheckGetPolicy": RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemGetAttr":        RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemGetProp":        RoleReadOnly,
+	"/mgmt.MgmtSvc/SystemEventsQuery":    RoleReadOnly,
+	"/mgmt.MgmtSvc/JobList":              RoleReadOnly,
+	"/mgmt.MgmtSvc/JobCancel":            RoleOperator,
+}
+
+// HasAccess checks if the role permits an administrative client to call the method given in
+// FullMethod.
+func (r Role) HasAccess(FullMethod string) bool {
+	required, found := methodRoles[FullMethod]
+	if !found {
+		required = RoleAdmin
+	}
+
+	return r >= required
+}
+
+// ClientRole maps administrative client certificates with matching subject fields to a role.
+// If both the common name and organizational unit are set, both must match.
+type ClientRole struct {
+	CommonName         string `yaml:"cn,omitempty"`
+	OrganizationalUnit string `yaml:"ou,omitempty"`
+	Role               Role   `yaml:"role"`
+}
+
+func (cr *ClientRole) matches(cert *x509.Certificate) bool {
+	if cr.CommonName != "" && cr.CommonName != cert.Subject.CommonName {
+		return false
+	}
+	if cr.OrganizationalUnit == "" {
+		return true
+	}
+	for _, ou := range cert.Subject.OrganizationalUnit {
+		if ou == cr.OrganizationalUnit {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClientRoles is an ordered list of mappings from client certificates to roles. The first
+// matching entry determines the role of a client.
+type ClientRoles []*ClientRole
+
+// Validate checks that each mapping matches on at least one subject field, assigns a role and
+// cannot match the certificates of other DAOS components.
+func (crs ClientRoles) Validate() error {
+	for i, cr := range crs {
+		if cr == nil {
+			return errors.Errorf("client_roles[%d]: empty entry", i)
+		}
+		if cr.CommonName == "" && cr.OrganizationalUnit == "" {
+			return errors.Errorf("client_roles[%d]: cn or ou must be set", i)
+		}
+		if cr.Role == RoleUndefined {
+			return errors.Errorf("client_roles[%d]: role must be set", i)
+		}
+		switch CommonNameToComponent(cr.CommonName) {
+		case ComponentAgent, ComponentServer:
+			return errors.Errorf("client_roles[%d]: cn %q is reserved for %s certificates",
+				i, cr.CommonName, cr.CommonName)
+		}
+	}
+
+	return nil
+}
+
+// Resolve returns the component and role of the client presenting the given certificate.
+// Clients matching a mapping are administrative clients with the mapped role. Otherwise the
+// component is determined by the CommonName, and admin certificates are granted the admin role.
+// The certificates of agents and servers are never mapped to roles.
+func (crs ClientRoles) Resolve(cert *x509.Certificate) (Component, Role) {
+	comp := CommonNameToComponent(cert.Subject.CommonName)
+	switch comp {
+	case ComponentAgent, ComponentServer:
+		return comp, RoleUndefined
+	}
+
+	for _, cr := range crs {
+		if cr.matches(cert) {
+			return ComponentAdmin, cr.Role
+		}
+	}
+
+	if comp == ComponentAdmin {
+		return comp, RoleAdmin
+	}
+	return comp, RoleUndefined
+}
@@ -0,0 +1,256 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSecurity_RoleFromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      string
+		expRole Role
+		expErr  error
+	}{
+		"read-only": {
+			in:      "read-only",
+			expRole: RoleReadOnly,
+		},
+		"operator": {
+			in:      "operator",
+			expRole: RoleOperator,
+		},
+		"admin uppercase": {
+			in:      "ADMIN",
+			expRole: RoleAdmin,
+		},
+		"undefined": {
+			in:     "undefined",
+			expErr: errors.New("unknown role"),
+		},
+		"empty": {
+			expErr: errors.New("unknown role"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotRole, gotErr := RoleFromString(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expRole, gotRole, "")
+		})
+	}
+}
+
+func TestSecurity_ClientRoles_YAML(t *testing.T) {
+	in := `
+- ou: monitoring
+  role: read-only
+- cn: ops
+  ou: storage
+  role: operator
+`
+	var roles ClientRoles
+	if err := yaml.UnmarshalStrict([]byte(in), &roles); err != nil {
+		t.Fatal(err)
+	}
+
+	expRoles := ClientRoles{
+		{OrganizationalUnit: "monitoring", Role: RoleReadOnly},
+		{CommonName: "ops", OrganizationalUnit: "storage", Role: RoleOperator},
+	}
+	if diff := cmp.Diff(expRoles, roles); diff != "" {
+		t.Fatalf("unexpected roles (-want, +got):\n%s\n", diff)
+	}
+
+	out, err := yaml.Marshal(roles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roundTrip ClientRoles
+	if err := yaml.UnmarshalStrict(out, &roundTrip); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expRoles, roundTrip); diff != "" {
+		t.Fatalf("unexpected roles after round trip (-want, +got):\n%s\n", diff)
+	}
+
+	err = yaml.UnmarshalStrict([]byte("- cn: ops\n  role: superuser\n"), &roles)
+	test.CmpErr(t, errors.New("unknown role"), err)
+}
+
+func TestSecurity_RoleHasAccess(t *testing.T) {
+	for method, expRoles := range map[string][]Role{
+		"/mgmt.MgmtSvc/SystemQuery":  {RoleReadOnly, RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/PoolQuery":    {RoleReadOnly, RoleOperator, RoleAdmin},
+		"/ctl.CtlSvc/StorageScan":    {RoleReadOnly, RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/PoolDrain":    {RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/SystemStop":   {RoleOperator, RoleAdmin},
+		"/mgmt.MgmtSvc/PoolCreate":   {RoleAdmin},
+		"/mgmt.MgmtSvc/PoolDestroy":  {RoleAdmin},
+		"/ctl.CtlSvc/StorageFormat":  {RoleAdmin},
+		"/mgmt.MgmtSvc/SystemErase":  {RoleAdmin},
+		"/mgmt.MgmtSvc/UnknownThing": {RoleAdmin},
+	} {
+		t.Run(method, func(t *testing.T) {
+			for _, role := range []Role{RoleUndefined, RoleReadOnly, RoleOperator, RoleAdmin} {
+				exp := false
+				for _, r := range expRoles {
+					if r == role {
+						exp = true
+					}
+				}
+				test.AssertEqual(t, exp, role.HasAccess(method), role.String())
+			}
+		})
+	}
+}
+
+func TestSecurity_MethodRolesAreAdminMethods(t *testing.T) {
+	for method, role := range methodRoles {
+		if !ComponentAdmin.HasAccess(method) {
+			t.Errorf("method %q with role %s is not authorized for %s", method, role,
+				ComponentAdmin)
+		}
+		if role == RoleUndefined || role == RoleAdmin {
+			t.Errorf("method %q has redundant role %s", method, role)
+		}
+	}
+}
+
+func TestSecurity_ClientRoles_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		roles  ClientRoles
+		expErr error
+	}{
+		"empty": {},
+		"valid": {
+			roles: ClientRoles{
+				{OrganizationalUnit: "monitoring", Role: RoleReadOnly},
+				{CommonName: "admin", Role: RoleOperator},
+			},
+		},
+		"nil entry": {
+			roles:  ClientRoles{nil},
+			expErr: errors.New("empty entry"),
+		},
+		"no subject fields": {
+			roles:  ClientRoles{{Role: RoleReadOnly}},
+			expErr: errors.New("cn or ou must be set"),
+		},
+		"no role": {
+			roles:  ClientRoles{{CommonName: "monitor"}},
+			expErr: errors.New("role must be set"),
+		},
+		"server cn": {
+			roles:  ClientRoles{{CommonName: "server", Role: RoleAdmin}},
+			expErr: errors.New("reserved for server"),
+		},
+		"agent cn": {
+			roles:  ClientRoles{{CommonName: "agent", Role: RoleReadOnly}},
+			expErr: errors.New("reserved for agent"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.roles.Validate())
+		})
+	}
+}
+
+func TestSecurity_ClientRoles_Resolve(t *testing.T) {
+	mockCert := func(cn string, ous ...string) *x509.Certificate {
+		return &x509.Certificate{
+			Subject: pkix.Name{
+				CommonName:         cn,
+				OrganizationalUnit: ous,
+			},
+		}
+	}
+	roles := ClientRoles{
+		{OrganizationalUnit: "monitoring", Role: RoleReadOnly},
+		{CommonName: "ops", Role: RoleOperator},
+		{CommonName: "admin", OrganizationalUnit: "limited", Role: RoleOperator},
+	}
+
+	for name, tc := range map[string]struct {
+		roles   ClientRoles
+		cert    *x509.Certificate
+		expComp Component
+		expRole Role
+	}{
+		"no mappings; admin": {
+			cert:    mockCert("admin"),
+			expComp: ComponentAdmin,
+			expRole: RoleAdmin,
+		},
+		"no mappings; unknown": {
+			cert:    mockCert("monitor", "monitoring"),
+			expComp: ComponentUndefined,
+		},
+		"unmapped admin": {
+			roles:   roles,
+			cert:    mockCert("admin", "storage"),
+			expComp: ComponentAdmin,
+			expRole: RoleAdmin,
+		},
+		"unmapped unknown": {
+			roles:   roles,
+			cert:    mockCert("monitor", "storage"),
+			expComp: ComponentUndefined,
+		},
+		"ou match": {
+			roles:   roles,
+			cert:    mockCert("monitor", "storage", "monitoring"),
+			expComp: ComponentAdmin,
+			expRole: RoleReadOnly,
+		},
+		"cn match": {
+			roles:   roles,
+			cert:    mockCert("ops"),
+			expComp: ComponentAdmin,
+			expRole: RoleOperator,
+		},
+		"cn and ou match": {
+			roles:   roles,
+			cert:    mockCert("admin", "limited"),
+			expComp: ComponentAdmin,
+			expRole: RoleOperator,
+		},
+		"first match wins": {
+			roles:   roles,
+			cert:    mockCert("ops", "monitoring"),
+			expComp: ComponentAdmin,
+			expRole: RoleReadOnly,
+		},
+		"agent never mapped": {
+			roles:   roles,
+			cert:    mockCert("agent", "monitoring"),
+			expComp: ComponentAgent,
+		},
+		"server never mapped": {
+			roles:   roles,
+			cert:    mockCert("server", "monitoring"),
+			expComp: ComponentServer,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotComp, gotRole := tc.roles.Resolve(tc.cert)
+			test.AssertEqual(t, tc.expComp, gotComp, "unexpected component")
+			test.AssertEqual(t, tc.expRole, gotRole, "unexpected role")
+		})
+	}
+}
@@ -0,0 +1,691 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// TokenAuthHeader is the metadata key used to send bearer tokens.
+	TokenAuthHeader = "authorization"
+	// DefaultTokenAuthPort is the default port on which bearer tokens are accepted.
+	DefaultTokenAuthPort = 10002
+
+	defaultRoleClaim  = "groups"
+	tokenTimeLeeway   = time.Minute
+	jwksFetchTimeout  = 30 * time.Second
+	jwksMaxAge        = time.Hour
+	jwksMinRefresh    = time.Minute
+	maxJWKSSize       = 1 << 20
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+)
+
+// TokenAuthConfig configures a listener on which administrative clients are
+// authenticated with JSON Web Tokens issued by an OpenID Connect identity
+// provider instead of client certificates. The role granted to a client is the
+// highest role mapped from the values of RoleClaim in its token. JWKSURI may be
+// an https URL or the path of a local file. If it is not set, the signing keys
+// are discovered from the OpenID Connect configuration of the Issuer, which
+// must then be an https URL.
+type TokenAuthConfig struct {
+	Port      int             `yaml:"port"`
+	Issuer    string          `yaml:"issuer"`
+	Audience  string          `yaml:"audience"`
+	JWKSURI   string          `yaml:"jwks_uri,omitempty"`
+	RoleClaim string          `yaml:"role_claim,omitempty"`
+	Roles     map[string]Role `yaml:"roles"`
+}
+
+func (tac *TokenAuthConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	if tac == nil {
+		return errors.New("attempt to unmarshal nil TokenAuthConfig")
+	}
+
+	type TokenAuthConfigDefault TokenAuthConfig
+	tmp := TokenAuthConfigDefault{
+		Port: DefaultTokenAuthPort,
+	}
+
+	if err := unmarshal(&tmp); err != nil {
+		return err
+	}
+	*tac = TokenAuthConfig(tmp)
+
+	return nil
+}
+
+// Validate checks that the token authentication parameters are usable.
+func (tac *TokenAuthConfig) Validate() error {
+	if tac == nil {
+		return nil
+	}
+
+	switch {
+	case tac.Port <= 0:
+		return errors.New("token_auth: port must be set")
+	case tac.Issuer == "":
+		return errors.New("token_auth: issuer must be set")
+	case tac.Audience == "":
+		return errors.New("token_auth: audience must be set")
+	case len(tac.Roles) == 0:
+		return errors.New("token_auth: roles must be set")
+	}
+	for value, role := range tac.Roles {
+		if role == RoleUndefined {
+			return errors.Errorf("token_auth: role for %q must be set", value)
+		}
+	}
+
+	if tac.JWKSURI != "" {
+		if err := checkTokenAuthResource(tac.JWKSURI); err != nil {
+			return errors.Wrap(err, "token_auth: jwks_uri")
+		}
+	} else if !strings.HasPrefix(tac.Issuer, "https://") {
+		return errors.New("token_auth: issuer must be an https URL if jwks_uri is not set")
+	}
+
+	return nil
+}
+
+func (tac *TokenAuthConfig) roleClaim() string {
+	if tac.RoleClaim == "" {
+		return defaultRoleClaim
+	}
+	return tac.RoleClaim
+}
+
+// TokenIdentity is the identity of a client established from a verified token.
+type TokenIdentity struct {
+	Subject string
+	Role    Role
+}
+
+type (
+	jwtHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	// jwtAudience accepts the aud claim as either a string or a list of strings.
+	jwtAudience []string
+
+	jwtClaims struct {
+		Issuer    string      `json:"iss"`
+		Subject   string      `json:"sub"`
+		Audience  jwtAudience `json:"aud"`
+		ExpiresAt *int64      `json:"exp"`
+		NotBefore *int64      `json:"nbf"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+)
+
+func (aud *jwtAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = jwtAudience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return errors.New("aud must be a string or list of strings")
+	}
+	*aud = list
+
+	return nil
+}
+
+func decodeSegment(seg string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(seg, "="))
+}
+
+func decodeBigInt(val string) (*big.Int, error) {
+	data, err := decodeSegment(val)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func (jwk *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid RSA modulus")
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil || !e.IsInt64() {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %q", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC x coordinate")
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid EC y coordinate")
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %q", jwk.Kty)
+	}
+}
+
+// parseJWKS returns the signature verification keys in a JSON Web Key Set
+// indexed by key ID. Keys of unsupported types are ignored.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set jsonWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.Wrap(err, "invalid JWKS")
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no usable signature keys")
+	}
+
+	return keys, nil
+}
+
+// checkTokenAuthResource returns an error if the signing keys or OpenID Connect
+// configuration can not be securely retrieved from the URI. Only https URLs and
+// local files are accepted, as keys retrieved over an unauthenticated connection
+// could be replaced to forge tokens.
+func checkTokenAuthResource(uri string) error {
+	if strings.HasPrefix(uri, "https://") || !strings.Contains(uri, "://") {
+		return nil
+	}
+
+	return errors.Errorf("%q is neither an https URL nor a local file", uri)
+}
+
+func newTokenAuthClient() *http.Client {
+	return &http.Client{
+		Timeout: jwksFetchTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if req.URL.Scheme != "https" {
+				return errors.Errorf("redirect to %s is not https", req.URL)
+			}
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return nil
+		},
+	}
+}
+
+func readTokenAuthResource(client *http.Client, uri string) ([]byte, error) {
+	if err := checkTokenAuthResource(uri); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(uri, "https://") {
+		return os.ReadFile(uri)
+	}
+
+	resp, err := client.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("fetching %s: %s", uri, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "fetching %s", uri)
+	}
+	if len(data) > maxJWKSSize {
+		return nil, errors.Errorf("fetching %s: larger than %d bytes", uri, maxJWKSSize)
+	}
+
+	return data, nil
+}
+
+// TokenVerifier verifies bearer tokens issued by the configured identity
+// provider. The signature verification keys are retrieved from the JWKS URI,
+// or discovered from the OpenID Connect configuration of the issuer if it is
+// not set, and are refreshed periodically and when a token is signed with an
+// unknown key.
+type TokenVerifier struct {
+	cfg    *TokenAuthConfig
+	now    func() time.Time
+	client *http.Client
+
+	keysLock  sync.Mutex
+	keys      map[string]crypto.PublicKey
+	keysTime  time.Time
+	fetchLock sync.Mutex
+	fetchTime time.Time
+	fetchErr  error
+	fetchKeys func() (map[string]crypto.PublicKey, error)
+}
+
+// NewTokenVerifier returns a verifier for tokens matching the configuration.
+func NewTokenVerifier(cfg *TokenAuthConfig) (*TokenVerifier, error) {
+	if cfg == nil {
+		return nil, errors.New("nil TokenAuthConfig")
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	tv := &TokenVerifier{
+		cfg:    cfg,
+		now:    time.Now,
+		client: newTokenAuthClient(),
+	}
+	tv.fetchKeys = tv.fetchJWKS
+
+	return tv, nil
+}
+
+func (tv *TokenVerifier) jwksURI() (string, error) {
+	if tv.cfg.JWKSURI != "" {
+		return tv.cfg.JWKSURI, nil
+	}
+
+	data, err := readTokenAuthResource(tv.client,
+		strings.TrimSuffix(tv.cfg.Issuer, "/")+oidcDiscoveryPath)
+	if err != nil {
+		return "", errors.Wrap(err, "discovering OpenID Connect configuration")
+	}
+	var discovery struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := json.Unmarshal(data, &discovery); err != nil {
+		return "", errors.Wrap(err, "invalid OpenID Connect configuration")
+	}
+	if discovery.JWKSURI == "" {
+		return "", errors.New("OpenID Connect configuration has no jwks_uri")
+	}
+
+	return discovery.JWKSURI, nil
+}
+
+func (tv *TokenVerifier) fetchJWKS() (map[string]crypto.PublicKey, error) {
+	uri, err := tv.jwksURI()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readTokenAuthResource(tv.client, uri)
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieving JWKS")
+	}
+
+	return parseJWKS(data)
+}
+
+// lookupKey returns the cached key with the given ID. A token without a key ID
+// may be signed with the only cached key. Must be called with keysLock held.
+func (tv *TokenVerifier) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(tv.keys) == 1 {
+		for _, key := range tv.keys {
+			return key, true
+		}
+	}
+	key, found := tv.keys[kid]
+	return key, found
+}
+
+// cachedKey returns the cached key with the given ID if it is not stale, or the
+// result of the last refresh if the keys were fetched too recently to be
+// fetched again. done is false if the keys should be refreshed.
+func (tv *TokenVerifier) cachedKey(kid string, now time.Time) (key crypto.PublicKey, done bool, err error) {
+	tv.keysLock.Lock()
+	defer tv.keysLock.Unlock()
+
+	key, found := tv.lookupKey(kid)
+	if found && now.Sub(tv.keysTime) <= jwksMaxAge {
+		return key, true, nil
+	}
+	if !tv.fetchTime.IsZero() && now.Sub(tv.fetchTime) < jwksMinRefresh {
+		if found {
+			return key, true, nil
+		}
+		if tv.fetchErr != nil {
+			return nil, true, tv.fetchErr
+		}
+		return nil, true, errors.Errorf("unknown signing key %q", kid)
+	}
+
+	return nil, false, nil
+}
+
+// key returns the key with the given ID, refreshing the keys if they are stale
+// or the ID is unknown. Keys are fetched at most once per jwksMinRefresh, and
+// previously fetched keys continue to be used if a refresh fails. The cached
+// keys remain available to other callers while a refresh is in progress.
+func (tv *TokenVerifier) key(kid string) (crypto.PublicKey, error) {
+	if key, done, err := tv.cachedKey(kid, tv.now()); done {
+		return key, err
+	}
+
+	tv.fetchLock.Lock()
+	defer tv.fetchLock.Unlock()
+
+	// The keys may have been refreshed while waiting for another refresh.
+	now := tv.now()
+	if key, done, err := tv.cachedKey(kid, now); done {
+		return key, err
+	}
+
+	keys, err := tv.fetchKeys()
+
+	tv.keysLock.Lock()
+	defer tv.keysLock.Unlock()
+
+	tv.fetchTime = now
+	tv.fetchErr = err
+	if err != nil {
+		if key, found := tv.lookupKey(kid); found {
+			return key, nil
+		}
+		return nil, err
+	}
+	tv.keys = keys
+	tv.keysTime = now
+
+	key, found := tv.lookupKey(kid)
+	if !found {
+		return nil, errors.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func verifyTokenSignature(alg string, key crypto.PublicKey, signed, sig []byte) error {
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return errors.Errorf("unsupported algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch alg[:2] {
+	case "RS", "PS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return errors.Errorf("key type does not match algorithm %q", alg)
+		}
+		if alg[0] == 'R' {
+			return rsa.VerifyPKCS1v15(rsaKey, hash, digest, sig)
+		}
+		return rsa.VerifyPSS(rsaKey, hash, digest, sig, nil)
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return errors.Errorf("key type does not match algorithm %q", alg)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+// roleFromClaims returns the highest role mapped from the values of the role claim.
+func (tv *TokenVerifier) roleFromClaims(claims map[string]interface{}) Role {
+	var values []string
+	switch val := claims[tv.cfg.roleClaim()].(type) {
+	case string:
+		values = append(values, val)
+	case []interface{}:
+		for _, v := range val {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	role := RoleUndefined
+	for _, val := range values {
+		if mapped := tv.cfg.Roles[val]; mapped > role {
+			role = mapped
+		}
+	}
+
+	return role
+}
+
+// Verify checks the signature, issuer, audience and validity period of the
+// token and returns the identity of the client it was issued to.
+func (tv *TokenVerifier) Verify(token string) (*TokenIdentity, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	headerData, err := decodeSegment(parts[0])
+	if err == nil {
+		err = json.Unmarshal(headerData, &header)
+	}
+	if err != nil {
+		return nil, errors.New("malformed token header")
+	}
+	if len(header.Alg) != 5 {
+		return nil, errors.Errorf("unsupported algorithm %q", header.Alg)
+	}
+
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, errors.New("malformed token signature")
+	}
+	key, err := tv.key(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyTokenSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, errors.Wrap(err, "token signature verification failed")
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, errors.New("malformed token payload")
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Wrap(err, "malformed token claims")
+	}
+	var allClaims map[string]interface{}
+	if err := json.Unmarshal(payload, &allClaims); err != nil {
+		return nil, errors.Wrap(err, "malformed token claims")
+	}
+
+	now := tv.now()
+	switch {
+	case claims.Issuer != tv.cfg.Issuer:
+		return nil, errors.Errorf("token issuer %q is not trusted", claims.Issuer)
+	case claims.ExpiresAt == nil:
+		return nil, errors.New("token has no expiry time")
+	case now.After(time.Unix(*claims.ExpiresAt, 0).Add(tokenTimeLeeway)):
+		return nil, errors.New("token has expired")
+	case claims.NotBefore != nil && now.Add(tokenTimeLeeway).Before(time.Unix(*claims.NotBefore, 0)):
+		return nil, errors.New("token is not yet valid")
+	}
+	audOK := false
+	for _, aud := range claims.Audience {
+		if aud == tv.cfg.Audience {
+			audOK = true
+			break
+		}
+	}
+	if !audOK {
+		return nil, errors.Errorf("token audience does not include %q", tv.cfg.Audience)
+	}
+
+	role := tv.roleFromClaims(allClaims)
+	if role == RoleUndefined {
+		return nil, errors.Errorf("token for %q is not granted a role", claims.Subject)
+	}
+
+	return &TokenIdentity{
+		Subject: claims.Subject,
+		Role:    role,
+	}, nil
+}
+
+// BearerToken returns the token from the value of an authorization header.
+func BearerToken(header string) (string, error) {
+	const prefix = "bearer "
+	if len(header) <= len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return "", errors.New("authorization header is not a bearer token")
+	}
+
+	return strings.TrimSpace(header[len(prefix):]), nil
+}
+
+// TokenFileCredentials sends the bearer token in a file with each request. The
+// file is read for each request so that refreshed tokens are used.
+type TokenFileCredentials struct {
+	Path string
+}
+
+// GetRequestMetadata implements the credentials.PerRPCCredentials interface.
+func (tfc *TokenFileCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	data, err := LoadPEMData(tfc.Path, MaxGroupKeyPerm|0200)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading token file")
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return nil, errors.Errorf("token file %s is empty", tfc.Path)
+	}
+
+	return map[string]string{TokenAuthHeader: "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity implements the credentials.PerRPCCredentials interface.
+func (tfc *TokenFileCredentials) RequireTransportSecurity() bool {
+	return true
+}
+
+// tokenServerTLSConfig returns the configuration of a listener for clients that
+// authenticate with bearer tokens, which do not present certificates.
+func tokenServerTLSConfig(cfg *TransportConfig) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		MaxVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			keypair, _, _ := cfg.certData()
+			if keypair == nil {
+				return nil, errNoCertData
+			}
+			return &tls.Config{
+				ClientAuth:               tls.NoClientCert,
+				Certificates:             []tls.Certificate{*keypair},
+				NextProtos:               []string{"h2"},
+				MinVersion:               tls.VersionTLS12,
+				MaxVersion:               tls.VersionTLS12,
+				PreferServerCipherSuites: true,
+				CipherSuites: []uint16{
+					tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+				},
+			}, nil
+		},
+	}
+}
+
+// tokenClientTLSConfig returns the configuration of a client that authenticates
+// with a bearer token. The server certificate is verified in the same way as
+// for clients that present certificates.
+func tokenClientTLSConfig(cfg *TransportConfig) *tls.Config {
+	return &tls.Config{
+		MinVersion:               tls.VersionTLS12,
+		MaxVersion:               tls.VersionTLS12,
+		PreferServerCipherSuites: true,
+		CipherSuites: []uint16{
+			tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
+		},
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if err := verifyPeer(cfg, "gRPC server", cs); err != nil {
+				return err
+			}
+			if cs.PeerCertificates[0].Subject.CommonName != ServerCommonName {
+				return errors.New("Server certificate does not identify as Server")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,776 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package security
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+const (
+	testTokenIssuer   = "https://idp.example.com"
+	testTokenAudience = "daos"
+)
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// signTestToken returns a JWT with the given header and claims signed with the key.
+func signTestToken(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := b64(header) + "." + b64(payload)
+
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	default:
+		hash = crypto.SHA512
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if alg[0] == 'P' {
+			sig, err = rsa.SignPSS(rand.Reader, k, hash, digest, nil)
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, k, hash, digest)
+		}
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest)
+		size := (k.Curve.Params().BitSize + 7) / 8
+		sig = make([]byte, 2*size)
+		if err == nil {
+			r.FillBytes(sig[:size])
+			s.FillBytes(sig[size:])
+		}
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed + "." + b64(sig)
+}
+
+// testJWKS returns a JSON Web Key Set containing the public keys.
+func testJWKS(t *testing.T, keys map[string]crypto.Signer) []byte {
+	t.Helper()
+
+	var set jsonWebKeySet
+	for kid, key := range keys {
+		switch pub := key.Public().(type) {
+		case *rsa.PublicKey:
+			set.Keys = append(set.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   b64(pub.N.Bytes()),
+				E:   b64(big.NewInt(int64(pub.E)).Bytes()),
+			})
+		case *ecdsa.PublicKey:
+			set.Keys = append(set.Keys, jsonWebKey{
+				Kty: "EC",
+				Kid: kid,
+				Crv: pub.Curve.Params().Name,
+				X:   b64(pub.X.Bytes()),
+				Y:   b64(pub.Y.Bytes()),
+			})
+		}
+	}
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func testTokenClaims(now time.Time, groups ...interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":    testTokenIssuer,
+		"sub":    "user1",
+		"aud":    testTokenAudience,
+		"exp":    now.Add(time.Hour).Unix(),
+		"iat":    now.Unix(),
+		"groups": groups,
+	}
+}
+
+func testTokenAuthConfig(jwksURI string) *TokenAuthConfig {
+	return &TokenAuthConfig{
+		Port:     DefaultTokenAuthPort,
+		Issuer:   testTokenIssuer,
+		Audience: testTokenAudience,
+		JWKSURI:  jwksURI,
+		Roles: map[string]Role{
+			"monitors":  RoleReadOnly,
+			"operators": RoleOperator,
+			"admins":    RoleAdmin,
+		},
+	}
+}
+
+func TestSecurity_TokenAuthConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *TokenAuthConfig
+		expErr error
+	}{
+		"nil config": {},
+		"valid": {
+			cfg: testTokenAuthConfig(""),
+		},
+		"no port": {
+			cfg: &TokenAuthConfig{
+				Issuer:   testTokenIssuer,
+				Audience: testTokenAudience,
+				Roles:    map[string]Role{"admins": RoleAdmin},
+			},
+			expErr: errors.New("port must be set"),
+		},
+		"no issuer": {
+			cfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Audience: testTokenAudience,
+				Roles:    map[string]Role{"admins": RoleAdmin},
+			},
+			expErr: errors.New("issuer must be set"),
+		},
+		"no audience": {
+			cfg: &TokenAuthConfig{
+				Port:   DefaultTokenAuthPort,
+				Issuer: testTokenIssuer,
+				Roles:  map[string]Role{"admins": RoleAdmin},
+			},
+			expErr: errors.New("audience must be set"),
+		},
+		"no roles": {
+			cfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Issuer:   testTokenIssuer,
+				Audience: testTokenAudience,
+			},
+			expErr: errors.New("roles must be set"),
+		},
+		"undefined role": {
+			cfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Issuer:   testTokenIssuer,
+				Audience: testTokenAudience,
+				Roles:    map[string]Role{"admins": RoleUndefined},
+			},
+			expErr: errors.New(`role for "admins" must be set`),
+		},
+		"jwks uri is a local file": {
+			cfg: testTokenAuthConfig("/etc/daos/jwks.json"),
+		},
+		"jwks uri is an https url": {
+			cfg: testTokenAuthConfig("https://idp.example.com/keys"),
+		},
+		"jwks uri is an http url": {
+			cfg:    testTokenAuthConfig("http://idp.example.com/keys"),
+			expErr: errors.New("neither an https URL nor a local file"),
+		},
+		"http issuer without jwks uri": {
+			cfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Issuer:   "http://idp.example.com",
+				Audience: testTokenAudience,
+				Roles:    map[string]Role{"admins": RoleAdmin},
+			},
+			expErr: errors.New("issuer must be an https URL"),
+		},
+		"http issuer with jwks file": {
+			cfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Issuer:   "http://idp.example.com",
+				Audience: testTokenAudience,
+				JWKSURI:  "/etc/daos/jwks.json",
+				Roles:    map[string]Role{"admins": RoleAdmin},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestSecurity_TokenAuthConfig_UnmarshalYAML(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expCfg *TokenAuthConfig
+		expErr error
+	}{
+		"default port": {
+			in: `
+issuer: https://idp.example.com
+audience: daos
+roles:
+  admins: admin
+  monitors: read-only
+`,
+			expCfg: &TokenAuthConfig{
+				Port:     DefaultTokenAuthPort,
+				Issuer:   testTokenIssuer,
+				Audience: testTokenAudience,
+				Roles: map[string]Role{
+					"admins":   RoleAdmin,
+					"monitors": RoleReadOnly,
+				},
+			},
+		},
+		"custom port and claim": {
+			in: `
+port: 10443
+issuer: https://idp.example.com
+audience: daos
+jwks_uri: /etc/daos/jwks.json
+role_claim: roles
+roles:
+  operators: operator
+`,
+			expCfg: &TokenAuthConfig{
+				Port:      10443,
+				Issuer:    testTokenIssuer,
+				Audience:  testTokenAudience,
+				JWKSURI:   "/etc/daos/jwks.json",
+				RoleClaim: "roles",
+				Roles:     map[string]Role{"operators": RoleOperator},
+			},
+		},
+		"unknown role": {
+			in: `
+issuer: https://idp.example.com
+audience: daos
+roles:
+  admins: superuser
+`,
+			expErr: errors.New("unknown role"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var cfg TokenAuthConfig
+			err := yaml.UnmarshalStrict([]byte(tc.in), &cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, &cfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_TokenVerifier_Verify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	jwksPath := filepath.Join(testDir, "jwks.json")
+	jwks := testJWKS(t, map[string]crypto.Signer{"rsa": rsaKey, "ec": ecKey})
+	if err := os.WriteFile(jwksPath, jwks, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	withClaim := func(key string, val interface{}) map[string]interface{} {
+		claims := testTokenClaims(now, "operators")
+		if val == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = val
+		}
+		return claims
+	}
+
+	for name, tc := range map[string]struct {
+		token  func(t *testing.T) string
+		expID  *TokenIdentity
+		expErr error
+	}{
+		"RS256": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, testTokenClaims(now, "operators"))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleOperator},
+		},
+		"PS384": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "PS384", "rsa", rsaKey, testTokenClaims(now, "monitors"))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleReadOnly},
+		},
+		"ES256": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "ES256", "ec", ecKey, testTokenClaims(now, "admins"))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleAdmin},
+		},
+		"highest role granted": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					testTokenClaims(now, "users", "admins", "monitors"))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleAdmin},
+		},
+		"string role claim": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, withClaim("groups", "monitors"))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleReadOnly},
+		},
+		"audience list": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					withClaim("aud", []string{"other", testTokenAudience}))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleOperator},
+		},
+		"expired within leeway": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					withClaim("exp", now.Add(-30*time.Second).Unix()))
+			},
+			expID: &TokenIdentity{Subject: "user1", Role: RoleOperator},
+		},
+		"no mapped role": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, testTokenClaims(now, "users"))
+			},
+			expErr: errors.New(`token for "user1" is not granted a role`),
+		},
+		"no role claim": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, withClaim("groups", nil))
+			},
+			expErr: errors.New("not granted a role"),
+		},
+		"expired": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					withClaim("exp", now.Add(-time.Hour).Unix()))
+			},
+			expErr: errors.New("token has expired"),
+		},
+		"no expiry": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, withClaim("exp", nil))
+			},
+			expErr: errors.New("no expiry time"),
+		},
+		"not yet valid": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					withClaim("nbf", now.Add(time.Hour).Unix()))
+			},
+			expErr: errors.New("not yet valid"),
+		},
+		"untrusted issuer": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey,
+					withClaim("iss", "https://evil.example.com"))
+			},
+			expErr: errors.New("is not trusted"),
+		},
+		"wrong audience": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", rsaKey, withClaim("aud", "other"))
+			},
+			expErr: errors.New(`audience does not include "daos"`),
+		},
+		"signed by unknown key": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "other", otherKey, testTokenClaims(now, "admins"))
+			},
+			expErr: errors.New(`unknown signing key "other"`),
+		},
+		"signed by other key with known ID": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "rsa", otherKey, testTokenClaims(now, "admins"))
+			},
+			expErr: errors.New("signature verification failed"),
+		},
+		"key type does not match algorithm": {
+			token: func(t *testing.T) string {
+				return signTestToken(t, "RS256", "ec", rsaKey, testTokenClaims(now, "admins"))
+			},
+			expErr: errors.New("key type does not match"),
+		},
+		"tampered claims": {
+			token: func(t *testing.T) string {
+				parts := strings.Split(signTestToken(t, "RS256", "rsa", rsaKey,
+					testTokenClaims(now, "monitors")), ".")
+				claims, err := json.Marshal(testTokenClaims(now, "admins"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return parts[0] + "." + b64(claims) + "." + parts[2]
+			},
+			expErr: errors.New("signature verification failed"),
+		},
+		"unsigned": {
+			token: func(t *testing.T) string {
+				header, _ := json.Marshal(map[string]string{"alg": "none"})
+				claims, _ := json.Marshal(testTokenClaims(now, "admins"))
+				return b64(header) + "." + b64(claims) + "."
+			},
+			expErr: errors.New(`unsupported algorithm "none"`),
+		},
+		"HMAC": {
+			token: func(t *testing.T) string {
+				header, _ := json.Marshal(map[string]string{"alg": "HS256", "kid": "rsa"})
+				claims, _ := json.Marshal(testTokenClaims(now, "admins"))
+				return b64(header) + "." + b64(claims) + "." + b64([]byte("sig"))
+			},
+			expErr: errors.New(`unsupported algorithm "HS256"`),
+		},
+		"malformed": {
+			token: func(t *testing.T) string {
+				return "not-a-token"
+			},
+			expErr: errors.New("malformed token"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tv, err := NewTokenVerifier(testTokenAuthConfig(jwksPath))
+			if err != nil {
+				t.Fatal(err)
+			}
+			tv.now = func() time.Time { return now }
+
+			gotID, gotErr := tv.Verify(tc.token(t))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expID, gotID); diff != "" {
+				t.Fatalf("unexpected identity (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSecurity_TokenVerifier_Discovery(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks := testJWKS(t, map[string]crypto.Signer{"key1": key})
+
+	var srv *httptest.Server
+	var jwksURI string
+	srv = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case oidcDiscoveryPath:
+			fmt.Fprintf(w, `{"issuer": %q, "jwks_uri": %q}`, srv.URL, jwksURI)
+		case "/keys":
+			w.Write(jwks)
+		case "/redirect":
+			http.Redirect(w, r, "http://"+r.Host+"/keys", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	newVerifier := func(cfg *TokenAuthConfig) *TokenVerifier {
+		t.Helper()
+		tv, err := NewTokenVerifier(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tv.client = srv.Client()
+		tv.client.CheckRedirect = newTokenAuthClient().CheckRedirect
+		return tv
+	}
+
+	cfg := testTokenAuthConfig("")
+	cfg.Issuer = srv.URL
+	jwksURI = srv.URL + "/keys"
+	tv := newVerifier(cfg)
+
+	claims := testTokenClaims(time.Now(), "admins")
+	claims["iss"] = srv.URL
+	id, err := tv.Verify(signTestToken(t, "RS256", "key1", key, claims))
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, RoleAdmin, id.Role, "")
+
+	adminToken := signTestToken(t, "RS256", "key1", key, testTokenClaims(time.Now(), "admins"))
+
+	tv = newVerifier(testTokenAuthConfig(srv.URL + "/missing"))
+	_, err = tv.Verify(adminToken)
+	test.CmpErr(t, errors.New("404 Not Found"), err)
+
+	// Keys are not retrieved from an insecure location advertised by discovery.
+	jwksURI = strings.Replace(srv.URL, "https://", "http://", 1) + "/keys"
+	tv = newVerifier(cfg)
+	_, err = tv.Verify(signTestToken(t, "RS256", "key1", key, claims))
+	test.CmpErr(t, errors.New("neither an https URL nor a local file"), err)
+
+	// Nor are redirects to insecure locations followed.
+	tv = newVerifier(testTokenAuthConfig(srv.URL + "/redirect"))
+	_, err = tv.Verify(adminToken)
+	test.CmpErr(t, errors.New("is not https"), err)
+}
+
+func TestSecurity_TokenVerifier_KeyRefresh(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key2, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tv, err := NewTokenVerifier(testTokenAuthConfig("unused"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	tv.now = func() time.Time { return now }
+
+	var fetches int
+	var fetchErr error
+	keys := map[string]crypto.Signer{"key1": key1}
+	tv.fetchKeys = func() (map[string]crypto.PublicKey, error) {
+		fetches++
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		return parseJWKS(testJWKS(t, keys))
+	}
+
+	verify := func(kid string, key crypto.Signer) error {
+		_, err := tv.Verify(signTestToken(t, "RS256", kid, key, testTokenClaims(now, "admins")))
+		return err
+	}
+
+	if err := verify("key1", key1); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, fetches, "keys not fetched on first use")
+
+	// Keys are not refetched for a known key ID.
+	if err := verify("key1", key1); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, fetches, "keys refetched for known key")
+
+	// A rotated key is not looked up more often than the minimum refresh interval.
+	keys["key2"] = key2
+	test.CmpErr(t, errors.New(`unknown signing key "key2"`), verify("key2", key2))
+	test.AssertEqual(t, 1, fetches, "keys refetched before minimum interval")
+
+	now = now.Add(jwksMinRefresh + time.Second)
+	if err := verify("key2", key2); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 2, fetches, "keys not refetched for unknown key")
+
+	// Stale keys are refreshed, and the previous keys are used if this fails.
+	now = now.Add(jwksMaxAge + time.Second)
+	fetchErr = errors.New("connection refused")
+	if err := verify("key1", key1); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 3, fetches, "stale keys not refreshed")
+
+	// Failed refreshes are also rate limited.
+	if err := verify("key1", key1); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 3, fetches, "keys refetched before minimum interval")
+}
+
+func TestSecurity_TokenVerifier_KeyRefreshInProgress(t *testing.T) {
+	key1, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tv, err := NewTokenVerifier(testTokenAuthConfig("unused"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	tv.now = func() time.Time { return now }
+
+	jwks := testJWKS(t, map[string]crypto.Signer{"key1": key1})
+	fetchStarted := make(chan struct{})
+	releaseFetch := make(chan struct{})
+	var fetches int
+	tv.fetchKeys = func() (map[string]crypto.PublicKey, error) {
+		fetches++
+		if fetches > 1 {
+			close(fetchStarted)
+			<-releaseFetch
+		}
+		return parseJWKS(jwks)
+	}
+
+	verify := func(kid string) error {
+		_, err := tv.Verify(signTestToken(t, "RS256", kid, key1, testTokenClaims(now, "admins")))
+		return err
+	}
+
+	if err := verify("key1"); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(jwksMinRefresh + time.Second)
+
+	// A refresh for an unknown key does not block verification with cached keys.
+	refreshErr := make(chan error)
+	go func() {
+		refreshErr <- verify("key2")
+	}()
+	<-fetchStarted
+	if err := verify("key1"); err != nil {
+		t.Fatal(err)
+	}
+	close(releaseFetch)
+	test.CmpErr(t, errors.New(`unknown signing key "key2"`), <-refreshErr)
+	test.AssertEqual(t, 2, fetches, "unexpected number of fetches")
+}
+
+func TestSecurity_BearerToken(t *testing.T) {
+	for name, tc := range map[string]struct {
+		header   string
+		expToken string
+		expErr   error
+	}{
+		"empty": {
+			expErr: errors.New("not a bearer token"),
+		},
+		"basic auth": {
+			header: "Basic dXNlcjpwYXNz",
+			expErr: errors.New("not a bearer token"),
+		},
+		"bearer": {
+			header:   "Bearer abc.def.ghi",
+			expToken: "abc.def.ghi",
+		},
+		"lower case": {
+			header:   "bearer abc.def.ghi ",
+			expToken: "abc.def.ghi",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotToken, gotErr := BearerToken(tc.header)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expToken, gotToken, "")
+		})
+	}
+}
+
+func TestSecurity_TokenFileCredentials(t *testing.T) {
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	for name, tc := range map[string]struct {
+		content string
+		perms   os.FileMode
+		noFile  bool
+		expMD   map[string]string
+		expErr  error
+	}{
+		"no file": {
+			noFile: true,
+			expErr: errors.New("no such file"),
+		},
+		"insecure permissions": {
+			content: "abc.def.ghi",
+			perms:   0644,
+			expErr:  errors.New("insecure permissions"),
+		},
+		"empty": {
+			content: "\n",
+			perms:   0600,
+			expErr:  errors.New("is empty"),
+		},
+		"token": {
+			content: "abc.def.ghi\n",
+			perms:   0600,
+			expMD:   map[string]string{TokenAuthHeader: "Bearer abc.def.ghi"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(testDir, strings.ReplaceAll(name, " ", "_"))
+			if !tc.noFile {
+				if err := os.WriteFile(path, []byte(tc.content), tc.perms); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			creds := &TokenFileCredentials{Path: path}
+			test.AssertTrue(t, creds.RequireTransportSecurity(), "token sent without TLS")
+
+			gotMD, gotErr := creds.GetRequestMetadata(context.Background())
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expMD, gotMD); diff != "" {
+				t.Fatalf("unexpected metadata (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,293 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daos-stack/daos/src/control/common/proto"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+const (
+	auditQueryMethod  = "/ctl.CtlSvc/AuditQuery"
+	eventsQueryMethod = "/mgmt.MgmtSvc/SystemEventsQuery"
+	// auditMaxLineSize bounds the size of an entry read back from the audit log.
+	auditMaxLineSize = 1 << 20
+)
+
+// auditEntry is a record of a management request handled by the server.
+type auditEntry struct {
+	Time          time.Time       `json:"time"`
+	Method        string          `json:"method"`
+	Client        string          `json:"client"`
+	Component     string          `json:"component,omitempty"`
+	User          string          `json:"user,omitempty"`
+	ClaimedUser   string          `json:"claimed_user,omitempty"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	Args          json.RawMessage `json:"args,omitempty"`
+	Error         string          `json:"error,omitempty"`
+	DurationUs    uint64          `json:"duration_us"`
+}
+
+func (ae *auditEntry) toProto() *ctlpb.AuditEntry {
+	return &ctlpb.AuditEntry{
+		Time:        ae.Time.Format(time.RFC3339Nano),
+		Method:      ae.Method,
+		Client:      ae.Client,
+		Component:   ae.Component,
+		User:        ae.User,
+		ClaimedUser: ae.ClaimedUser,
+		Args:        string(ae.Args),
+		Error:       ae.Error,
+		DurationUs:  ae.DurationUs,
+	}
+}
+
+// auditLog records the privileged management requests handled by the server
+// as JSON entries, one per line, in a local file. Entries may optionally be
+// published as RAS events.
+type auditLog struct {
+	sync.Mutex
+	log     logging.Logger
+	path    string
+	file    *os.File
+	publish func(*events.RASEvent)
+}
+
+// newAuditLog opens the audit log at the given path for appending. If publish
+// is set, it is called with a RAS event for each recorded entry.
+func newAuditLog(log logging.Logger, path string, publish func(*events.RASEvent)) (*auditLog, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "open audit log")
+	}
+
+	return &auditLog{
+		log:     log,
+		path:    path,
+		file:    f,
+		publish: publish,
+	}, nil
+}
+
+// Close closes the audit log file.
+func (al *auditLog) Close() error {
+	al.Lock()
+	defer al.Unlock()
+
+	return al.file.Close()
+}
+
+func newAuditEvent(entry *auditEntry, data []byte) *events.RASEvent {
+	result := "succeeded"
+	if entry.Error != "" {
+		result = "failed: " + entry.Error
+	}
+	msg := fmt.Sprintf("%s from %s %s", entry.Method, entry.Client, result)
+
+	evt := events.NewGenericEvent(events.RASMgmtRequestAudited, events.RASSeverityNotice, msg,
+		string(data))
+	evt.CtlOp = entry.Method
+	evt.CorrelationID = entry.CorrelationID
+	return evt
+}
+
+// record appends the entry to the audit log.
+func (al *auditLog) record(entry *auditEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		al.log.Errorf("failed to marshal audit entry for %s: %s", entry.Method, err)
+		return
+	}
+
+	al.Lock()
+	_, err = al.file.Write(append(data, '\n'))
+	al.Unlock()
+	if err != nil {
+		al.log.Errorf("failed to write audit entry for %s: %s", entry.Method, err)
+	}
+
+	if al.publish != nil {
+		al.publish(newAuditEvent(entry, data))
+	}
+}
+
+// query returns the most recent entries in the audit log that match the
+// request, oldest first.
+func (al *auditLog) query(req *ctlpb.AuditQueryReq) ([]*auditEntry, error) {
+	var since time.Time
+	if req.Since != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, req.Since); err != nil {
+			return nil, errors.Wrapf(err, "invalid time %q", req.Since)
+		}
+	}
+
+	f, err := os.Open(al.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open audit log")
+	}
+	defer f.Close()
+
+	var entries []*auditEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), auditMaxLineSize)
+	for scanner.Scan() {
+		entry := new(auditEntry)
+		if err := json.Unmarshal(scanner.Bytes(), entry); err != nil {
+			al.log.Debugf("skipping invalid audit entry: %s", err)
+			continue
+		}
+		if entry.Time.Before(since) || !strings.Contains(entry.Method, req.Method) {
+			continue
+		}
+
+		entries = append(entries, entry)
+		if req.MaxEntries > 0 && len(entries) > int(req.MaxEntries) {
+			entries = entries[1:]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read audit log")
+	}
+
+	return entries, nil
+}
+
+// shouldAudit returns true if requests made to the method are recorded in the
+// audit log, i.e. the method may be called by the administrative tool. Queries
+// of the audit log and of retained events are not recorded as they are polled
+// and would otherwise report themselves.
+func shouldAudit(method string) bool {
+	switch method {
+	case auditQueryMethod, eventsQueryMethod:
+		return false
+	}
+	return security.ComponentAdmin.HasAccess(method)
+}
+
+// unwrapStatusErr unwraps the error if it's a gRPC status error.
+func unwrapStatusErr(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return proto.UnwrapError(st)
+	}
+	return err
+}
+
+// verifiedUserFromContext returns the identity of the client as established by the server, the
+// subject of a verified bearer token or the common name of a verified client certificate. An
+// empty string is returned if the client is not authenticated, e.g. in insecure mode.
+func verifiedUserFromContext(ctx context.Context) string {
+	if id := tokenIdentityFromContext(ctx); id != nil {
+		return id.Subject
+	}
+	if cert, err := certFromContext(ctx); err == nil {
+		return cert.Subject.CommonName
+	}
+	return ""
+}
+
+func newAuditEntry(ctx context.Context, roles security.ClientRoles, method string, req interface{}, reqErr error, start time.Time) *auditEntry {
+	entry := &auditEntry{
+		Time:          start,
+		Method:        method,
+		CorrelationID: events.CorrelationIDFromContext(ctx),
+		DurationUs:    uint64(time.Since(start).Microseconds()),
+	}
+
+	if clientPeer, ok := peer.FromContext(ctx); ok && clientPeer.Addr != nil {
+		entry.Client = clientPeer.Addr.String()
+	}
+	if comp, err := componentFromContext(ctx, roles); err == nil {
+		entry.Component = comp.String()
+	}
+	entry.User = verifiedUserFromContext(ctx)
+	// The user name sent by the client can't be verified and is recorded separately.
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if users := md.Get(control.UserHeader); len(users) > 0 {
+			entry.ClaimedUser = users[0]
+		}
+	}
+
+	if m, ok := req.(protoreflect.ProtoMessage); ok {
+		if args, err := protojson.Marshal(m); err == nil {
+			entry.Args = args
+		}
+	}
+
+	if reqErr != nil {
+		entry.Error = unwrapStatusErr(reqErr).Error()
+	}
+
+	return entry
+}
+
+// unaryAuditInterceptor generates a grpc.UnaryServerInterceptor that records
+// privileged management requests in the audit log. Requests rejected because
+// this server is not the management service leader are not recorded, as they
+// are retried on the leader.
+func unaryAuditInterceptor(al *auditLog, roles security.ClientRoles) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !shouldAudit(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := handler(ctx, req)
+		if err != nil && isSentinelErr(unwrapStatusErr(err)) {
+			return res, err
+		}
+		al.record(newAuditEntry(ctx, roles, info.FullMethod, req, err, start))
+
+		return res, err
+	}
+}
+
+// AuditQuery returns recent entries from the audit log of management requests
+// handled by this server.
+func (cs *ControlService) AuditQuery(ctx context.Context, req *ctlpb.AuditQueryReq) (*ctlpb.AuditQueryResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if cs.audit == nil {
+		return nil, FaultAuditLogDisabled
+	}
+
+	entries, err := cs.audit.query(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ctlpb.AuditQueryResp{
+		Entries: make([]*ctlpb.AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, entry.toProto())
+	}
+
+	return resp, nil
+}
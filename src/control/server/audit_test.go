@@ -0,0 +1,284 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_auditLog_query(t *testing.T) {
+	t0 := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	entries := []*auditEntry{
+		{Time: t0, Method: "/mgmt.MgmtSvc/PoolCreate", Client: "c1"},
+		{Time: t0.Add(time.Minute), Method: "/ctl.CtlSvc/StorageFormat", Client: "c2"},
+		{Time: t0.Add(2 * time.Minute), Method: "/mgmt.MgmtSvc/PoolDestroy", Client: "c1",
+			Error: "busy"},
+		{Time: t0.Add(3 * time.Minute), Method: "/mgmt.MgmtSvc/PoolCreate", Client: "c3"},
+	}
+
+	for name, tc := range map[string]struct {
+		req        *ctlpb.AuditQueryReq
+		expEntries []*auditEntry
+		expErr     error
+	}{
+		"all entries": {
+			req:        &ctlpb.AuditQueryReq{},
+			expEntries: entries,
+		},
+		"most recent entries": {
+			req:        &ctlpb.AuditQueryReq{MaxEntries: 2},
+			expEntries: entries[2:],
+		},
+		"since": {
+			req:        &ctlpb.AuditQueryReq{Since: t0.Add(time.Minute).Format(time.RFC3339)},
+			expEntries: entries[1:],
+		},
+		"method": {
+			req:        &ctlpb.AuditQueryReq{Method: "PoolCreate"},
+			expEntries: []*auditEntry{entries[0], entries[3]},
+		},
+		"method and max": {
+			req:        &ctlpb.AuditQueryReq{Method: "MgmtSvc", MaxEntries: 1},
+			expEntries: entries[3:],
+		},
+		"invalid since": {
+			req:    &ctlpb.AuditQueryReq{Since: "yesterday"},
+			expErr: errors.New("invalid time"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			al, err := newAuditLog(log, filepath.Join(testDir, "audit.log"), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer al.Close()
+
+			for _, entry := range entries {
+				al.record(entry)
+			}
+			// Corrupt entries are skipped when reading back.
+			if _, err := al.file.WriteString("not json\n"); err != nil {
+				t.Fatal(err)
+			}
+
+			gotEntries, gotErr := al.query(tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEntries, gotEntries); diff != "" {
+				t.Fatalf("unexpected entries (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_shouldAudit(t *testing.T) {
+	for method, exp := range map[string]bool{
+		"/mgmt.MgmtSvc/PoolCreate":        true,
+		"/ctl.CtlSvc/StorageFormat":       true,
+		"/ctl.CtlSvc/AuditQuery":          false,
+		"/mgmt.MgmtSvc/SystemEventsQuery": false,
+		"/mgmt.MgmtSvc/Join":              false,
+		"/mgmt.MgmtSvc/GetAttachInfo":     false,
+	} {
+		t.Run(method, func(t *testing.T) {
+			test.AssertEqual(t, exp, shouldAudit(method), "")
+		})
+	}
+}
+
+func TestServer_unaryAuditInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method     string
+		token      bool
+		certCN     string
+		handlerErr error
+		expRecord  bool
+		expUser    string
+		expError   string
+	}{
+		"not audited": {
+			method: "/mgmt.MgmtSvc/Join",
+		},
+		"success; unauthenticated client": {
+			method:    "/mgmt.MgmtSvc/PoolCreate",
+			expRecord: true,
+		},
+		"failure": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			handlerErr: errors.New("no space"),
+			expRecord:  true,
+			expError:   "no space",
+		},
+		"certificate common name recorded as user": {
+			method:    "/mgmt.MgmtSvc/PoolCreate",
+			certCN:    "admin",
+			expRecord: true,
+			expUser:   "admin",
+		},
+		"token subject recorded as user": {
+			method:    "/mgmt.MgmtSvc/PoolCreate",
+			token:     true,
+			expRecord: true,
+			expUser:   "user1",
+		},
+		"not leader": {
+			method:     "/mgmt.MgmtSvc/PoolCreate",
+			handlerErr: &system.ErrNotLeader{LeaderHint: "host1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			var published []*events.RASEvent
+			al, err := newAuditLog(log, filepath.Join(testDir, "audit.log"),
+				func(evt *events.RASEvent) {
+					published = append(published, evt)
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer al.Close()
+
+			clientPeer := &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4242},
+			}
+			if tc.certCN != "" {
+				clientPeer.AuthInfo = credentials.TLSInfo{
+					State: tls.ConnectionState{
+						VerifiedChains: [][]*x509.Certificate{
+							{{Subject: pkix.Name{CommonName: tc.certCN}}},
+						},
+					},
+				}
+			}
+			ctx := peer.NewContext(test.Context(t), clientPeer)
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(control.UserHeader, "alice"))
+			if tc.token {
+				ctx = newTestTokenCtx(ctx, security.RoleAdmin)
+			}
+			req := &mgmtpb.PoolCreateReq{Sys: "daos_server"}
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+
+			_, gotErr := unaryAuditInterceptor(al, nil)(ctx, req,
+				&grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			if gotErr != tc.handlerErr {
+				t.Fatalf("expected handler error %v, got %v", tc.handlerErr, gotErr)
+			}
+
+			data, err := os.ReadFile(al.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tc.expRecord {
+				test.AssertEqual(t, 0, len(data), "unexpected audit entry")
+				test.AssertEqual(t, 0, len(published), "unexpected audit event")
+				return
+			}
+
+			var entry auditEntry
+			if err := json.Unmarshal(data, &entry); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.method, entry.Method, "")
+			test.AssertEqual(t, "10.0.0.1:4242", entry.Client, "")
+			test.AssertEqual(t, tc.expUser, entry.User, "")
+			test.AssertEqual(t, "alice", entry.ClaimedUser, "")
+			test.AssertEqual(t, tc.expError, entry.Error, "")
+			var args map[string]string
+			if err := json.Unmarshal(entry.Args, &args); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, "daos_server", args["sys"], "")
+
+			test.AssertEqual(t, 1, len(published), "expected audit event")
+			test.AssertEqual(t, events.RASMgmtRequestAudited, published[0].ID, "")
+			test.AssertEqual(t, tc.method, published[0].CtlOp, "")
+		})
+	}
+}
+
+func TestServer_CtlSvc_AuditQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	cs := &ControlService{}
+	_, err := cs.AuditQuery(test.Context(t), &ctlpb.AuditQueryReq{})
+	test.CmpErr(t, FaultAuditLogDisabled, err)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	cs.audit, err = newAuditLog(log, filepath.Join(testDir, "audit.log"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cs.audit.Close()
+
+	t0 := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	cs.audit.record(&auditEntry{Time: t0, Method: "/mgmt.MgmtSvc/PoolCreate", Client: "c1",
+		Args: json.RawMessage(`{"sys":"daos_server"}`), DurationUs: 20})
+
+	resp, err := cs.AuditQuery(test.Context(t), &ctlpb.AuditQueryReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expResp := &ctlpb.AuditQueryResp{
+		Entries: []*ctlpb.AuditEntry{
+			{
+				Time:       t0.Format(time.RFC3339Nano),
+				Method:     "/mgmt.MgmtSvc/PoolCreate",
+				Client:     "c1",
+				Args:       `{"sys":"daos_server"}`,
+				DurationUs: 20,
+			},
+		},
+	}
+	if diff := cmp.Diff(expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+const engineDefaultsKey = "engine_defaults"
+
+// mergeEngineEnvVars returns the default env_vars that are not set by the engine followed by the
+// engine's own env_vars so that per-engine values take precedence.
+func mergeEngineEnvVars(defaults, engineVars interface{}) (interface{}, error) {
+	defList, ok := defaults.([]interface{})
+	if !ok && defaults != nil {
+		return nil, errors.New("env_vars must be a list")
+	}
+	engList, ok := engineVars.([]interface{})
+	if !ok && engineVars != nil {
+		return nil, errors.New("env_vars must be a list")
+	}
+
+	envKey := func(item interface{}) string {
+		return strings.SplitN(fmt.Sprint(item), "=", 2)[0]
+	}
+
+	overridden := make(map[string]bool)
+	for _, item := range engList {
+		overridden[envKey(item)] = true
+	}
+
+	merged := make([]interface{}, 0, len(defList)+len(engList))
+	for _, item := range defList {
+		if !overridden[envKey(item)] {
+			merged = append(merged, item)
+		}
+	}
+
+	return append(merged, engList...), nil
+}
+
+// applyEngineDefaults expands the engine_defaults section of the config into each engine.
+// Parameters set on an engine override the defaults with the exception of env_vars, which are
+// merged by variable name. The engine_defaults section is removed from the returned config.
+func applyEngineDefaults(in []byte) ([]byte, error) {
+	var ms yaml.MapSlice
+	if err := yaml.Unmarshal(in, &ms); err != nil {
+		return nil, errors.Wrap(err, "parsing config")
+	}
+
+	ms, defVal, found := mapRemove(ms, engineDefaultsKey)
+	if !found {
+		return in, nil
+	}
+	if defVal == nil {
+		return yaml.Marshal(ms)
+	}
+	defaults, ok := defVal.(yaml.MapSlice)
+	if !ok {
+		return nil, errors.Errorf("%s must be a map of engine parameters", engineDefaultsKey)
+	}
+
+	// Check that the defaults only contain engine parameters, even if no engines are defined.
+	defBytes, err := yaml.Marshal(defaults)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.UnmarshalStrict(defBytes, new(engine.Config)); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", engineDefaultsKey)
+	}
+
+	idx := mapIndex(ms, "engines")
+	if idx < 0 || ms[idx].Value == nil {
+		return yaml.Marshal(ms)
+	}
+	engines, ok := ms[idx].Value.([]interface{})
+	if !ok {
+		return nil, errors.New("engines must be a list")
+	}
+
+	for i, e := range engines {
+		eng, ok := e.(yaml.MapSlice)
+		if !ok && e != nil {
+			return nil, errors.Errorf("engine %d: invalid config", i)
+		}
+
+		for _, item := range defaults {
+			key, _ := item.Key.(string)
+			engIdx := mapIndex(eng, key)
+			switch {
+			case engIdx < 0:
+				eng = append(eng, item)
+			case key == "env_vars":
+				merged, err := mergeEngineEnvVars(item.Value, eng[engIdx].Value)
+				if err != nil {
+					return nil, errors.Wrapf(err, "engine %d", i)
+				}
+				eng[engIdx].Value = merged
+			}
+		}
+		engines[i] = eng
+	}
+
+	return yaml.Marshal(ms)
+}
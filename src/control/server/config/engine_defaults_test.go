@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServerConfig_applyEngineDefaults(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expOut string
+		expErr error
+	}{
+		"no defaults": {
+			in: `
+name: daos_server
+engines:
+- targets: 8
+`,
+		},
+		"empty defaults": {
+			in: `
+engine_defaults:
+engines:
+- targets: 8
+`,
+			expOut: `
+engines:
+- targets: 8
+`,
+		},
+		"defaults not a map": {
+			in: `
+engine_defaults: [targets]
+`,
+			expErr: errors.New("engine_defaults must be a map"),
+		},
+		"unknown parameter in defaults": {
+			in: `
+engine_defaults:
+  target: 8
+engines: []
+`,
+			expErr: errors.New("invalid engine_defaults"),
+		},
+		"defaults inherited and overridden": {
+			in: `
+engine_defaults:
+  targets: 16
+  nr_xs_helpers: 4
+  log_mask: ERR
+  env_vars:
+  - CRT_TIMEOUT=30
+  - D_LOG_FILE_APPEND_PID=1
+  storage:
+  - class: ram
+    scm_mount: /mnt/daos
+engines:
+- fabric_iface: ib0
+  log_mask: INFO
+- fabric_iface: ib1
+  env_vars:
+  - CRT_TIMEOUT=60
+  storage:
+  - class: dcpm
+    scm_mount: /mnt/daos1
+    scm_list: [/dev/pmem1]
+`,
+			expOut: `
+engines:
+- fabric_iface: ib0
+  log_mask: INFO
+  targets: 16
+  nr_xs_helpers: 4
+  env_vars:
+  - CRT_TIMEOUT=30
+  - D_LOG_FILE_APPEND_PID=1
+  storage:
+  - class: ram
+    scm_mount: /mnt/daos
+- fabric_iface: ib1
+  env_vars:
+  - D_LOG_FILE_APPEND_PID=1
+  - CRT_TIMEOUT=60
+  storage:
+  - class: dcpm
+    scm_mount: /mnt/daos1
+    scm_list:
+    - /dev/pmem1
+  targets: 16
+  nr_xs_helpers: 4
+  log_mask: ERR
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := applyEngineDefaults([]byte(strings.TrimPrefix(tc.in, "\n")))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			expOut := strings.TrimPrefix(tc.expOut, "\n")
+			if tc.expOut == "" {
+				expOut = strings.TrimPrefix(tc.in, "\n")
+			}
+			if diff := cmp.Diff(expOut, string(out)); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
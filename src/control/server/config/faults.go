@@ -122,6 +122,11 @@ var (
 		"'bdev_exclude' list includes address used in engine config bdev_list",
 		"make sure addresses excluded are not included in engine storage configs then restart daos_server",
 	)
+	FaultConfigBdevSparesNoHotplug = serverConfigFault(
+		code.ServerConfigBdevSparesNoHotplug,
+		"'bdev_spares' set in engine storage config but hotplug is disabled",
+		"remove 'disable_hotplug: true' from server config or remove 'bdev_spares' from engine storage configs then restart daos_server",
+	)
 )
 
 func FaultConfigFaultDomainInvalid(err error) *fault.Fault {
@@ -250,6 +255,214 @@ func FaultConfigNrHugepagesOutOfRange(req, max int) *fault.Fault {
 	)
 }
 
+// FaultConfigRamdiskMemMarginOutOfRange creates a fault for the scenario where the configured
+// ram-disk memory margin percentage is outside of the allowed range.
+func FaultConfigRamdiskMemMarginOutOfRange(req, max int) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigRamdiskMemMarginOutOfRange,
+		fmt.Sprintf("ram_disk_mem_margin specified (%d%%) is out of range (0 - %d%%)", req,
+			max),
+		fmt.Sprintf("specify a ram_disk_mem_margin value between 0 and %d", max),
+	)
+}
+
+// FaultConfigScmImbalanceOutOfRange creates a fault for the scenario where the configured PMem
+// capacity imbalance threshold percentage is outside of the allowed range.
+func FaultConfigScmImbalanceOutOfRange(req int) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigScmImbalanceOutOfRange,
+		fmt.Sprintf("scm_imbalance_threshold specified (%d%%) is out of range (0 - 100%%)", req),
+		"specify a scm_imbalance_threshold value between 0 and 100",
+	)
+}
+
+// FaultConfigBadScmUnlock creates a fault for the scenario where the PMem unlock passphrase source
+// is misconfigured.
+func FaultConfigBadScmUnlock(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadScmUnlock,
+		fmt.Sprintf("invalid scm_unlock config: %s", reason),
+		"specify either key_file or both kmip_helper and kmip_key_id in scm_unlock",
+	)
+}
+
+// FaultConfigUnsupportedVersion creates a fault for the scenario where the config file declares a
+// schema version that is newer than this version of daos_server understands.
+func FaultConfigUnsupportedVersion(version, maxVersion int) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigUnsupportedVersion,
+		fmt.Sprintf("config file version %d is not supported (maximum %d)", version,
+			maxVersion),
+		"update daos_server or use a config file written for this version and restart",
+	)
+}
+
+// FaultConfigReloadImmutable creates a fault for the scenario where a reloaded config file changes
+// parameters that can only be applied by restarting daos_server.
+func FaultConfigReloadImmutable(diff string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigReloadImmutable,
+		fmt.Sprintf("config file changes can not be applied without a restart:\n%s", diff),
+		"revert the listed changes and reload or restart daos_server to apply them",
+	)
+}
+
+// FaultConfigBadTelemetryCollect creates a fault for the scenario where an unknown telemetry
+// collection set is specified in the config file.
+func FaultConfigBadTelemetryCollect(set string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadTelemetryCollect,
+		fmt.Sprintf("unknown telemetry collection set %q in configuration", set),
+		fmt.Sprintf("specify sets from %v in configuration ('telemetry_collect' parameter) and restart the control server",
+			TelemetryCollectSets),
+	)
+}
+
+// FaultConfigBadTelemetryOTLP creates a fault for the scenario where the OTLP telemetry exporter
+// is misconfigured.
+func FaultConfigBadTelemetryOTLP(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadTelemetryOTLP,
+		fmt.Sprintf("invalid telemetry_otlp config: %s", reason),
+		"fix the telemetry_otlp section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadTelemetryHistory creates a fault for the scenario where the retention of
+// telemetry history is misconfigured.
+func FaultConfigBadTelemetryHistory(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadTelemetryHistory,
+		fmt.Sprintf("invalid telemetry_history config: %s", reason),
+		"fix the telemetry_history section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadNvmeHealthHistory creates a fault for the scenario where the polling of NVMe
+// device health is misconfigured.
+func FaultConfigBadNvmeHealthHistory(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadNvmeHealthHistory,
+		fmt.Sprintf("invalid nvme_health_history config: %s", reason),
+		"fix the nvme_health_history section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadNvmeFailurePolicy creates a fault for the scenario where the policy acting on
+// NVMe device failure scores is misconfigured.
+func FaultConfigBadNvmeFailurePolicy(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadNvmeFailurePolicy,
+		fmt.Sprintf("invalid nvme_failure_policy config: %s", reason),
+		"fix the nvme_failure_policy section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadHTTPGateway creates a fault for the scenario where the HTTP management gateway
+// is misconfigured.
+func FaultConfigBadHTTPGateway(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadHTTPGateway,
+		fmt.Sprintf("invalid http_gateway config: %s", reason),
+		"fix the http_gateway section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadHealthProbe creates a fault for the scenario where the health probe endpoint is
+// misconfigured.
+func FaultConfigBadHealthProbe(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadHealthProbe,
+		fmt.Sprintf("invalid health_probe config: %s", reason),
+		"fix the health_probe section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadCrashReports creates a fault for the scenario where the engine crash report
+// parameters are misconfigured.
+func FaultConfigBadCrashReports(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadCrashReports,
+		fmt.Sprintf("invalid crash_reports config: %s", reason),
+		"fix the crash_reports section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadEngineShutdown creates a fault for the scenario where the graceful engine
+// shutdown timeouts are misconfigured.
+func FaultConfigBadEngineShutdown(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadEngineShutdown,
+		fmt.Sprintf("invalid engine_shutdown config: %s", reason),
+		"fix the engine_shutdown section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadNvmeHotplugPolicy creates a fault for the scenario where the policy handling
+// inserted NVMe SSDs is misconfigured.
+func FaultConfigBadNvmeHotplugPolicy(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadNvmeHotplugPolicy,
+		fmt.Sprintf("invalid nvme_hotplug_policy config: %s", reason),
+		"fix the nvme_hotplug_policy section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadEventSink creates a fault for the scenario where a RAS event sink is
+// misconfigured.
+func FaultConfigBadEventSink(idx int, reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadEventSink,
+		fmt.Sprintf("invalid event_sinks entry %d: %s", idx, reason),
+		"fix the event_sinks section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadEventRateLimit creates a fault for the scenario where the RAS event rate limit
+// is misconfigured.
+func FaultConfigBadEventRateLimit(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadEventRateLimit,
+		fmt.Sprintf("invalid event_rate_limit config: %s", reason),
+		"fix the event_rate_limit section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigBadTelemetryAlerts creates a fault for the scenario where the telemetry alert rules
+// are misconfigured.
+func FaultConfigBadTelemetryAlerts(reason string) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigBadTelemetryAlerts,
+		fmt.Sprintf("invalid telemetry_alerts config: %s", reason),
+		"fix the telemetry_alerts section of the configuration and restart the control server",
+	)
+}
+
+// FaultConfigScmNumaMismatch creates a fault for the scenario where a PMem namespace assigned to
+// an engine is attached to a different NUMA node than the one the engine is pinned to.
+func FaultConfigScmNumaMismatch(idx int, dev string, devNode uint32, engineNode uint) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigScmNumaMismatch,
+		fmt.Sprintf("scm_list entry %s in engine %d is on NUMA node %d but the engine is "+
+			"pinned to NUMA node %d", dev, idx, devNode, engineNode),
+		fmt.Sprintf("assign PMem namespaces on NUMA node %d to engine %d or change the "+
+			"engine's pinned_numa_node and restart", engineNode, idx),
+	)
+}
+
+// FaultConfigScmCapacityImbalance creates a fault for the scenario where total PMem capacity
+// differs between engines by more than the allowed threshold.
+func FaultConfigScmCapacityImbalance(minIdx int, minSize uint64, maxIdx int, maxSize uint64, threshold int) *fault.Fault {
+	return serverConfigFault(
+		code.ServerConfigScmCapacityImbalance,
+		fmt.Sprintf("PMem capacity is not balanced across engines, engine %d has %s but "+
+			"engine %d has %s (more than %d%% difference)", minIdx,
+			humanize.IBytes(minSize), maxIdx, humanize.IBytes(maxSize), threshold),
+		"assign PMem namespaces of similar total capacity to each engine or raise "+
+			"scm_imbalance_threshold and restart",
+	)
+}
+
 // FaultConfigRamdiskOverMaxMem indicates that the tmpfs size requested in config is larger than
 // maximum allowed.
 func FaultConfigRamdiskOverMaxMem(confSize, ramSize, memRamdiskMin uint64) *fault.Fault {
@@ -0,0 +1,246 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	// ConfigVersion is the current version of the server config file schema.
+	ConfigVersion = 2
+
+	// configVersionLegacy is the version of config files that predate tiered storage and
+	// specify engine storage with flat scm_* and bdev_* parameters.
+	configVersionLegacy = 1
+)
+
+var (
+	// legacyScmKeys are flat engine parameters that are moved into the SCM storage tier.
+	legacyScmKeys = []string{"scm_mount", "scm_list", "scm_size"}
+	// legacyBdevKeys are flat engine parameters that are moved into the NVMe storage tier.
+	legacyBdevKeys = []string{"bdev_list", "bdev_number", "bdev_size", "bdev_busid_range"}
+	// legacyTopKeys are top-level parameters only found in legacy config files.
+	legacyTopKeys = []string{"servers", "enable_vmd"}
+)
+
+type configMigrationFn func(yaml.MapSlice) (yaml.MapSlice, []string, error)
+
+// configMigrations upgrade a config from the keyed version to the next version.
+var configMigrations = map[int]configMigrationFn{
+	configVersionLegacy: migrateFlatStorage,
+}
+
+func mapIndex(ms yaml.MapSlice, key string) int {
+	for i, item := range ms {
+		if k, ok := item.Key.(string); ok && k == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func mapRemove(ms yaml.MapSlice, key string) (yaml.MapSlice, interface{}, bool) {
+	idx := mapIndex(ms, key)
+	if idx < 0 {
+		return ms, nil, false
+	}
+	val := ms[idx].Value
+	return append(ms[:idx], ms[idx+1:]...), val, true
+}
+
+func isLegacyEngine(engine interface{}) bool {
+	ms, ok := engine.(yaml.MapSlice)
+	if !ok {
+		return false
+	}
+	for _, key := range append(append([]string{"scm_class", "bdev_class"}, legacyScmKeys...),
+		legacyBdevKeys...) {
+		if mapIndex(ms, key) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// detectConfigVersion returns the schema version of the config. Files without an explicit
+// version are assumed to be current unless they contain parameters from a legacy layout.
+func detectConfigVersion(ms yaml.MapSlice) (int, error) {
+	if idx := mapIndex(ms, "version"); idx >= 0 {
+		version, ok := ms[idx].Value.(int)
+		if !ok || version < configVersionLegacy {
+			return 0, errors.Errorf("invalid config version %v", ms[idx].Value)
+		}
+		return version, nil
+	}
+
+	for _, key := range legacyTopKeys {
+		if mapIndex(ms, key) >= 0 {
+			return configVersionLegacy, nil
+		}
+	}
+	if idx := mapIndex(ms, "engines"); idx >= 0 {
+		engines, _ := ms[idx].Value.([]interface{})
+		for _, engine := range engines {
+			if isLegacyEngine(engine) {
+				return configVersionLegacy, nil
+			}
+		}
+	}
+
+	return ConfigVersion, nil
+}
+
+// MigrateConfig upgrades the supplied server config file contents to the current schema version.
+// The migrated YAML is returned along with a description of each change made. If the config is
+// already current, the input is returned unmodified and the list of changes is empty.
+func MigrateConfig(in []byte) ([]byte, []string, error) {
+	var ms yaml.MapSlice
+	if err := yaml.Unmarshal(in, &ms); err != nil {
+		return nil, nil, errors.Wrap(err, "parsing config")
+	}
+
+	version, err := detectConfigVersion(ms)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version > ConfigVersion {
+		return nil, nil, FaultConfigUnsupportedVersion(version, ConfigVersion)
+	}
+	if version == ConfigVersion {
+		return in, nil, nil
+	}
+
+	var changes []string
+	for v := version; v < ConfigVersion; v++ {
+		migrate, found := configMigrations[v]
+		if !found {
+			return nil, nil, errors.Errorf("no migration from config version %d", v)
+		}
+
+		var vChanges []string
+		ms, vChanges, err = migrate(ms)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "migrating config from version %d", v)
+		}
+		changes = append(changes, vChanges...)
+	}
+
+	ms, _, _ = mapRemove(ms, "version")
+	ms = append(yaml.MapSlice{{Key: "version", Value: ConfigVersion}}, ms...)
+	changes = append(changes, fmt.Sprintf("updated config version from %d to %d", version,
+		ConfigVersion))
+
+	out, err := yaml.Marshal(ms)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "generating migrated config")
+	}
+
+	return out, changes, nil
+}
+
+// migrateFlatStorage upgrades a version 1 config by renaming the servers section to engines,
+// replacing enable_vmd with disable_vmd and moving flat scm_* and bdev_* engine parameters into
+// storage tiers.
+func migrateFlatStorage(ms yaml.MapSlice) (yaml.MapSlice, []string, error) {
+	var changes []string
+
+	if idx := mapIndex(ms, "servers"); idx >= 0 {
+		if mapIndex(ms, "engines") >= 0 {
+			return nil, nil, errors.New("servers and engines are mutually exclusive")
+		}
+		ms[idx].Key = "engines"
+		changes = append(changes, "renamed servers to engines")
+	}
+
+	if idx := mapIndex(ms, "enable_vmd"); idx >= 0 {
+		enable, ok := ms[idx].Value.(bool)
+		if !ok {
+			return nil, nil, errors.Errorf("invalid enable_vmd value %v", ms[idx].Value)
+		}
+		if mapIndex(ms, "disable_vmd") >= 0 {
+			return nil, nil, errors.New("enable_vmd and disable_vmd are mutually exclusive")
+		}
+		ms[idx] = yaml.MapItem{Key: "disable_vmd", Value: !enable}
+		changes = append(changes, fmt.Sprintf("replaced enable_vmd: %t with disable_vmd: %t",
+			enable, !enable))
+	}
+
+	idx := mapIndex(ms, "engines")
+	if idx < 0 || ms[idx].Value == nil {
+		return ms, changes, nil
+	}
+	engines, ok := ms[idx].Value.([]interface{})
+	if !ok {
+		return nil, nil, errors.New("engines must be a list")
+	}
+
+	for i, e := range engines {
+		engine, ok := e.(yaml.MapSlice)
+		if !ok {
+			return nil, nil, errors.Errorf("engine %d: invalid config", i)
+		}
+
+		migrated, moved, err := migrateEngineStorage(engine)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "engine %d", i)
+		}
+		if moved {
+			engines[i] = migrated
+			changes = append(changes, fmt.Sprintf("engine %d: moved scm_* and bdev_* "+
+				"parameters into storage tiers", i))
+		}
+	}
+
+	return ms, changes, nil
+}
+
+// migrateEngineStorage moves flat storage parameters of an engine into an SCM tier and an
+// optional NVMe tier. The second return value indicates whether any parameters were moved.
+func migrateEngineStorage(engine yaml.MapSlice) (yaml.MapSlice, bool, error) {
+	extract := func(tier yaml.MapSlice, classKey string, keys []string) yaml.MapSlice {
+		var val interface{}
+		var found bool
+		if engine, val, found = mapRemove(engine, classKey); found {
+			tier = append(tier, yaml.MapItem{Key: "class", Value: val})
+		}
+		for _, key := range keys {
+			if engine, val, found = mapRemove(engine, key); found {
+				tier = append(tier, yaml.MapItem{Key: key, Value: val})
+			}
+		}
+		return tier
+	}
+
+	scmTier := extract(nil, "scm_class", legacyScmKeys)
+	bdevTier := extract(nil, "bdev_class", legacyBdevKeys)
+	if len(scmTier) == 0 && len(bdevTier) == 0 {
+		return engine, false, nil
+	}
+
+	if mapIndex(engine, "storage") >= 0 {
+		return nil, false, errors.New("flat scm_* and bdev_* parameters cannot be combined " +
+			"with storage tiers")
+	}
+	if mapIndex(scmTier, "class") < 0 {
+		return nil, false, errors.New("scm_class must be set when migrating storage " +
+			"parameters")
+	}
+
+	tiers := []interface{}{scmTier}
+	if len(bdevTier) > 0 {
+		if mapIndex(bdevTier, "class") < 0 {
+			bdevTier = append(yaml.MapSlice{{Key: "class", Value: "nvme"}}, bdevTier...)
+		}
+		tiers = append(tiers, bdevTier)
+	}
+
+	return append(engine, yaml.MapItem{Key: "storage", Value: tiers}), true, nil
+}
@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServerConfig_MigrateConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in         string
+		expOut     string
+		expChanges []string
+		expErr     error
+	}{
+		"current layout; no version": {
+			in: `
+name: daos_server
+engines:
+- targets: 8
+  storage:
+  - class: ram
+    scm_mount: /mnt/daos
+`,
+		},
+		"current version": {
+			in: `
+version: 2
+name: daos_server
+`,
+		},
+		"future version": {
+			in:     "version: 3\n",
+			expErr: FaultConfigUnsupportedVersion(3, ConfigVersion),
+		},
+		"invalid version": {
+			in:     "version: two\n",
+			expErr: errors.New("invalid config version"),
+		},
+		"legacy layout": {
+			in: `
+name: daos_server
+enable_vmd: true
+servers:
+- targets: 8
+  scm_mount: /mnt/daos
+  scm_class: dcpm
+  scm_list: [/dev/pmem0]
+  bdev_list: ["0000:81:00.0"]
+  fabric_iface: ib0
+`,
+			expOut: `version: 2
+name: daos_server
+disable_vmd: false
+engines:
+- targets: 8
+  fabric_iface: ib0
+  storage:
+  - class: dcpm
+    scm_mount: /mnt/daos
+    scm_list:
+    - /dev/pmem0
+  - class: nvme
+    bdev_list:
+    - 0000:81:00.0
+`,
+			expChanges: []string{
+				"renamed servers to engines",
+				"replaced enable_vmd: true with disable_vmd: false",
+				"engine 0: moved scm_* and bdev_* parameters into storage tiers",
+				"updated config version from 1 to 2",
+			},
+		},
+		"explicit legacy version": {
+			in: `
+version: 1
+engines:
+- scm_class: ram
+  scm_mount: /mnt/daos
+  scm_size: 16
+  bdev_class: file
+  bdev_list: [/tmp/daos-bdev]
+  bdev_size: 4
+`,
+			expOut: `version: 2
+engines:
+- storage:
+  - class: ram
+    scm_mount: /mnt/daos
+    scm_size: 16
+  - class: file
+    bdev_list:
+    - /tmp/daos-bdev
+    bdev_size: 4
+`,
+			expChanges: []string{
+				"engine 0: moved scm_* and bdev_* parameters into storage tiers",
+				"updated config version from 1 to 2",
+			},
+		},
+		"legacy layout; missing scm_class": {
+			in: `
+servers:
+- scm_mount: /mnt/daos
+`,
+			expErr: errors.New("engine 0: scm_class must be set"),
+		},
+		"flat parameters mixed with storage tiers": {
+			in: `
+engines:
+- scm_class: ram
+  scm_mount: /mnt/daos
+  storage:
+  - class: nvme
+    bdev_list: ["0000:81:00.0"]
+`,
+			expErr: errors.New("cannot be combined with storage tiers"),
+		},
+		"servers and engines": {
+			in: `
+servers: []
+engines: []
+`,
+			expErr: errors.New("servers and engines are mutually exclusive"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, changes, err := MigrateConfig([]byte(strings.TrimPrefix(tc.in, "\n")))
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			expOut := tc.expOut
+			if expOut == "" {
+				expOut = strings.TrimPrefix(tc.in, "\n")
+			}
+			if diff := cmp.Diff(expOut, string(out)); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expChanges, changes); diff != "" {
+				t.Fatalf("unexpected changes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
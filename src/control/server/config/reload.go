@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// ReloadChanges holds the new values of parameters that differ between a running config and a
+// reloaded one and that can be applied without restarting daos_server. Nil or empty fields are
+// unchanged.
+type ReloadChanges struct {
+	ControlLogMask  *common.ControlLogLevel
+	EngineLogMasks  map[int]string
+	TelemetryPort   *int
+	MgmtSvcReplicas []string
+	Descriptions    []string
+}
+
+// IsEmpty returns true if there are no changes to apply.
+func (rc *ReloadChanges) IsEmpty() bool {
+	return rc == nil || len(rc.Descriptions) == 0
+}
+
+// diffLines returns the lines removed from a and added in b, prefixed with "-" and "+".
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var diff []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			diff = append(diff, "- "+a[i])
+			i++
+		default:
+			diff = append(diff, "+ "+b[j])
+			j++
+		}
+	}
+
+	return diff
+}
+
+func yamlLines(cfg *Server) ([]string, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n"), nil
+}
+
+// ReloadChanges compares the config with one re-read from the config file. Changes to control
+// log mask, engine log masks, telemetry port and the MS replica list are returned so that they
+// can be applied to the running server. A fault listing the differences is returned if any
+// other parameter has changed.
+func (cfg *Server) ReloadChanges(newCfg *Server) (*ReloadChanges, error) {
+	if newCfg == nil {
+		return nil, errors.New("nil config")
+	}
+
+	// Compare the configs with reloadable parameters set to their current values.
+	cmpCfg := *newCfg
+	cmpCfg.ControlLogMask = cfg.ControlLogMask
+	cmpCfg.TelemetryPort = cfg.TelemetryPort
+	cmpCfg.MgmtSvcReplicas = cfg.MgmtSvcReplicas
+	cmpCfg.Engines = make([]*engine.Config, len(newCfg.Engines))
+	for i, ec := range newCfg.Engines {
+		cmpEngine := *ec
+		if i < len(cfg.Engines) {
+			cmpEngine.LogMask = cfg.Engines[i].LogMask
+		}
+		cmpCfg.Engines[i] = &cmpEngine
+	}
+
+	curLines, err := yamlLines(cfg)
+	if err != nil {
+		return nil, err
+	}
+	newLines, err := yamlLines(&cmpCfg)
+	if err != nil {
+		return nil, err
+	}
+	if diff := diffLines(curLines, newLines); len(diff) > 0 {
+		return nil, FaultConfigReloadImmutable(strings.Join(diff, "\n"))
+	}
+
+	rc := new(ReloadChanges)
+	if newCfg.ControlLogMask != cfg.ControlLogMask {
+		rc.ControlLogMask = &newCfg.ControlLogMask
+		rc.Descriptions = append(rc.Descriptions, fmt.Sprintf("control_log_mask: %s -> %s",
+			cfg.ControlLogMask, newCfg.ControlLogMask))
+	}
+
+	for i, ec := range newCfg.Engines {
+		if ec.LogMask == cfg.Engines[i].LogMask {
+			continue
+		}
+		if err := engine.ValidateLogMasks(ec.LogMask); err != nil {
+			return nil, errors.Wrapf(err, "engine %d", i)
+		}
+		if rc.EngineLogMasks == nil {
+			rc.EngineLogMasks = make(map[int]string)
+		}
+		rc.EngineLogMasks[i] = ec.LogMask
+		rc.Descriptions = append(rc.Descriptions, fmt.Sprintf("engine %d log_mask: %q -> %q",
+			i, cfg.Engines[i].LogMask, ec.LogMask))
+	}
+
+	if newCfg.TelemetryPort != cfg.TelemetryPort {
+		if newCfg.TelemetryPort < 0 {
+			return nil, errors.Errorf("invalid telemetry_port %d", newCfg.TelemetryPort)
+		}
+		rc.TelemetryPort = &newCfg.TelemetryPort
+		rc.Descriptions = append(rc.Descriptions, fmt.Sprintf("telemetry_port: %d -> %d",
+			cfg.TelemetryPort, newCfg.TelemetryPort))
+	}
+
+	if strings.Join(newCfg.MgmtSvcReplicas, ",") != strings.Join(cfg.MgmtSvcReplicas, ",") {
+		if len(newCfg.MgmtSvcReplicas) == 0 {
+			return nil, FaultConfigBadMgmtSvcReplicas
+		}
+		rc.MgmtSvcReplicas = newCfg.MgmtSvcReplicas
+		rc.Descriptions = append(rc.Descriptions, fmt.Sprintf("mgmt_svc_replicas: %v -> %v",
+			cfg.MgmtSvcReplicas, newCfg.MgmtSvcReplicas))
+	}
+
+	return rc, nil
+}
@@ -0,0 +1,130 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServerConfig_diffLines(t *testing.T) {
+	for name, tc := range map[string]struct {
+		a       []string
+		b       []string
+		expDiff []string
+	}{
+		"identical": {
+			a: []string{"a", "b", "c"},
+			b: []string{"a", "b", "c"},
+		},
+		"line changed": {
+			a:       []string{"a", "b", "c"},
+			b:       []string{"a", "x", "c"},
+			expDiff: []string{"- b", "+ x"},
+		},
+		"lines added and removed": {
+			a:       []string{"a", "b", "c"},
+			b:       []string{"b", "c", "d"},
+			expDiff: []string{"- a", "+ d"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expDiff, diffLines(tc.a, tc.b)); diff != "" {
+				t.Fatalf("unexpected diff (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServerConfig_ReloadChanges(t *testing.T) {
+	baseCfg := func() *Server {
+		return DefaultServer().
+			WithControlLogMask(common.ControlLogLevelInfo).
+			WithTelemetryPort(9191).
+			WithMgmtSvcReplicas("host1", "host2", "host3").
+			WithEngines(
+				engine.MockConfig().WithLogMask("ERR"),
+				engine.MockConfig().WithLogMask("ERR"),
+			)
+	}
+	telemPort := 9192
+	debugLvl := common.ControlLogLevelDebug
+
+	for name, tc := range map[string]struct {
+		newCfg     *Server
+		expChanges *ReloadChanges
+		expErr     error
+	}{
+		"nil config": {
+			expErr: errors.New("nil config"),
+		},
+		"no changes": {
+			newCfg:     baseCfg(),
+			expChanges: new(ReloadChanges),
+		},
+		"reloadable changes": {
+			newCfg: func() *Server {
+				cfg := baseCfg().
+					WithControlLogMask(debugLvl).
+					WithTelemetryPort(telemPort).
+					WithMgmtSvcReplicas("host1", "host2", "host4")
+				cfg.Engines[1].LogMask = "DEBUG,mgmt=ERR"
+				return cfg
+			}(),
+			expChanges: &ReloadChanges{
+				ControlLogMask:  &debugLvl,
+				EngineLogMasks:  map[int]string{1: "DEBUG,mgmt=ERR"},
+				TelemetryPort:   &telemPort,
+				MgmtSvcReplicas: []string{"host1", "host2", "host4"},
+				Descriptions: []string{
+					"control_log_mask: INFO -> DEBUG",
+					`engine 1 log_mask: "ERR" -> "DEBUG,mgmt=ERR"`,
+					"telemetry_port: 9191 -> 9192",
+					"mgmt_svc_replicas: [host1 host2 host3] -> [host1 host2 host4]",
+				},
+			},
+		},
+		"invalid engine log mask": {
+			newCfg: func() *Server {
+				cfg := baseCfg()
+				cfg.Engines[0].LogMask = "DEBUGX"
+				return cfg
+			}(),
+			expErr: errors.New("engine 0"),
+		},
+		"empty replica list": {
+			newCfg: baseCfg().WithMgmtSvcReplicas(),
+			expErr: FaultConfigBadMgmtSvcReplicas,
+		},
+		"immutable change": {
+			newCfg: baseCfg().WithSystemName("other").WithTelemetryPort(telemPort),
+			expErr: FaultConfigReloadImmutable("- name: daos_server\n+ name: other"),
+		},
+		"engine removed": {
+			newCfg: baseCfg().WithEngines(engine.MockConfig().WithLogMask("ERR")),
+			expErr: errors.New("can not be applied without a restart"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotChanges, gotErr := baseCfg().ReloadChanges(tc.newCfg)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expChanges, gotChanges); diff != "" {
+				t.Fatalf("unexpected changes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
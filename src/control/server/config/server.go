@@ -11,10 +11,14 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -22,6 +26,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -40,7 +45,97 @@ const (
 	// SPDK memory requirements when performing a NVMe device scan.
 	ScanMinHugepageCount = 128
 
+	// DefaultScmImbalance is the maximum percentage by which total PMem capacity may differ
+	// between engines when scm_imbalance_threshold is not set.
+	DefaultScmImbalance = 10
+
+	// DefaultTelemetryOTLPInterval is the interval between pushes of telemetry to an
+	// OpenTelemetry collector when none is configured.
+	DefaultTelemetryOTLPInterval = time.Minute
+
+	// DefaultTelemetryHistoryInterval is the interval between samples of the retained
+	// telemetry history when none is configured.
+	DefaultTelemetryHistoryInterval = 10 * time.Second
+	// MaxTelemetryHistoryRetention bounds the memory used to retain telemetry history.
+	MaxTelemetryHistoryRetention = 24 * time.Hour
+
+	// DefaultNvmeHealthHistoryInterval is the interval between polls of NVMe device health
+	// when none is configured.
+	DefaultNvmeHealthHistoryInterval = 10 * time.Minute
+	// MinNvmeHealthHistoryInterval bounds the rate at which NVMe device health is polled.
+	MinNvmeHealthHistoryInterval = time.Minute
+	// MaxNvmeHealthHistoryRetention bounds the memory used to retain NVMe health history.
+	MaxNvmeHealthHistoryRetention = 90 * 24 * time.Hour
+
+	// DefaultNvmeFailureWarnScore is the failure score at which a NVMe device failure is
+	// predicted when none is configured.
+	DefaultNvmeFailureWarnScore = 50
+	// DefaultNvmeFailureFaultyScore is the failure score at which a NVMe device is set faulty
+	// when none is configured.
+	DefaultNvmeFailureFaultyScore = 80
+
+	// DefaultTelemetryAlertInterval is the interval between evaluations of the telemetry
+	// alert rules when none is configured.
+	DefaultTelemetryAlertInterval = 30 * time.Second
+
+	// DefaultHTTPGatewayPort is the port of the HTTP management gateway when none is
+	// configured.
+	DefaultHTTPGatewayPort = 10003
+
+	// DefaultHealthProbePort is the port of the health probe endpoints when none is
+	// configured.
+	DefaultHealthProbePort = 10004
+
+	// DefaultCrashReportDir is the directory in which engine crash reports are written when
+	// none is configured.
+	DefaultCrashReportDir = "/var/log/daos/crash"
+	// DefaultCrashReportLogLines is the number of engine log lines included in a crash
+	// report when none is configured.
+	DefaultCrashReportLogLines = 100
+	// DefaultCrashReportMax is the number of most recent crash reports kept when none is
+	// configured.
+	DefaultCrashReportMax = 20
+
+	// DefaultShutdownNotifyTimeout is how long an engine is given to acknowledge a shutdown
+	// notification when none is configured.
+	DefaultShutdownNotifyTimeout = 10 * time.Second
+	// DefaultShutdownFlushTimeout is how long an engine is given to flush metadata and exit
+	// after SIGINT when none is configured.
+	DefaultShutdownFlushTimeout = 60 * time.Second
+	// DefaultShutdownKillTimeout is how long an engine is given to exit after SIGKILL when
+	// none is configured.
+	DefaultShutdownKillTimeout = 10 * time.Second
+
+	// DefaultNvmeHotplugPollInterval is the interval between scans for inserted and removed
+	// NVMe SSDs when none is configured.
+	DefaultNvmeHotplugPollInterval = 5 * time.Second
+
 	msgAPsMSReps = "access_points is deprecated; please use mgmt_svc_replicas instead"
+
+	// TelemetryCollectEngine exports the engine telemetry that is not specific to a device.
+	TelemetryCollectEngine = "engine"
+	// TelemetryCollectPool exports pool space usage and rebuild state (MS leader only).
+	TelemetryCollectPool = "pool"
+	// TelemetryCollectTarget exports the state of each pool target (MS leader only).
+	TelemetryCollectTarget = "target"
+	// TelemetryCollectDevice exports per-device health and I/O telemetry.
+	TelemetryCollectDevice = "device"
+	// TelemetryCollectControl exports the control plane's Go runtime, gRPC and dRPC metrics.
+	TelemetryCollectControl = "control"
+)
+
+var (
+	// TelemetryCollectSets lists the telemetry collection sets that may be configured.
+	TelemetryCollectSets = []string{
+		TelemetryCollectEngine, TelemetryCollectPool, TelemetryCollectTarget, TelemetryCollectDevice,
+		TelemetryCollectControl,
+	}
+	// DefaultTelemetryCollect lists the telemetry collection sets exported when none are
+	// configured. Per-target state is excluded as it requires a query of every pool target.
+	DefaultTelemetryCollect = []string{
+		TelemetryCollectEngine, TelemetryCollectPool, TelemetryCollectDevice,
+		TelemetryCollectControl,
+	}
 )
 
 // SupportConfig is defined here to avoid a import cycle
@@ -48,6 +143,535 @@ type SupportConfig struct {
 	FileTransferExec string `yaml:"file_transfer_exec,omitempty"`
 }
 
+// ScmUnlockConfig specifies where to obtain the passphrase used to unlock secured PMem modules
+// when daos_server starts. The passphrase is read from a key file in ipmctl source format or
+// fetched from a KMIP server by a helper executable that prints it on stdout.
+type ScmUnlockConfig struct {
+	KeyFile    string `yaml:"key_file,omitempty"`
+	KmipHelper string `yaml:"kmip_helper,omitempty"`
+	KmipKeyID  string `yaml:"kmip_key_id,omitempty"`
+}
+
+// Validate checks that exactly one passphrase source is configured.
+func (suc *ScmUnlockConfig) Validate() error {
+	switch {
+	case suc.KeyFile != "" && suc.KmipHelper != "":
+		return FaultConfigBadScmUnlock("key_file and kmip_helper are mutually exclusive")
+	case suc.KeyFile == "" && suc.KmipHelper == "":
+		return FaultConfigBadScmUnlock("one of key_file or kmip_helper must be set")
+	case suc.KmipHelper != "" && suc.KmipKeyID == "":
+		return FaultConfigBadScmUnlock("kmip_key_id must be set with kmip_helper")
+	}
+
+	return nil
+}
+
+// PassphraseFile returns the path of a file containing the passphrase in ipmctl source format
+// together with a function to remove any temporary file created. Passphrases fetched through
+// the KMIP helper are written to a temporary file readable only by the owner.
+func (suc *ScmUnlockConfig) PassphraseFile() (string, func(), error) {
+	noop := func() {}
+	if suc.KeyFile != "" {
+		return suc.KeyFile, noop, nil
+	}
+
+	out, err := exec.Command(suc.KmipHelper, suc.KmipKeyID).Output()
+	if err != nil {
+		return "", noop, errors.Wrapf(err, "fetch passphrase with %s", suc.KmipHelper)
+	}
+	passphrase := strings.TrimSpace(string(out))
+	if passphrase == "" {
+		return "", noop, errors.Errorf("%s returned an empty passphrase", suc.KmipHelper)
+	}
+
+	f, err := os.CreateTemp("", "daos_pmem_key")
+	if err != nil {
+		return "", noop, errors.Wrap(err, "create passphrase file")
+	}
+	cleanup := func() { _ = os.Remove(f.Name()) }
+
+	_, err = fmt.Fprintf(f, "#ascii\npassphrase=%s\n", passphrase)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		cleanup()
+		return "", noop, errors.Wrap(err, "write passphrase file")
+	}
+
+	return f.Name(), cleanup, nil
+}
+
+// TelemetryOTLPConfig specifies an OpenTelemetry collector that telemetry is pushed to over OTLP
+// gRPC, as an alternative to scraping the Prometheus endpoint.
+type TelemetryOTLPConfig struct {
+	Endpoint string            `yaml:"endpoint"`
+	Interval time.Duration     `yaml:"interval,omitempty"`
+	Insecure bool              `yaml:"insecure,omitempty"`
+	CACert   string            `yaml:"ca_cert,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty"`
+	Traces   bool              `yaml:"traces,omitempty"`
+}
+
+// Validate checks that the collector endpoint is set and that the other parameters are sane.
+func (toc *TelemetryOTLPConfig) Validate() error {
+	switch {
+	case toc.Endpoint == "":
+		return FaultConfigBadTelemetryOTLP("endpoint must be set")
+	case toc.Interval < 0:
+		return FaultConfigBadTelemetryOTLP("interval must not be negative")
+	case toc.Insecure && toc.CACert != "":
+		return FaultConfigBadTelemetryOTLP("insecure and ca_cert are mutually exclusive")
+	}
+
+	return nil
+}
+
+// GetInterval returns the interval between pushes, or the default if none is configured.
+func (toc *TelemetryOTLPConfig) GetInterval() time.Duration {
+	if toc.Interval == 0 {
+		return DefaultTelemetryOTLPInterval
+	}
+	return toc.Interval
+}
+
+// TelemetryHistoryConfig specifies how long metrics sampled on the server are retained so that
+// they can be queried after the event.
+type TelemetryHistoryConfig struct {
+	Retention time.Duration `yaml:"retention"`
+	Interval  time.Duration `yaml:"interval,omitempty"`
+}
+
+// Validate checks that the retention period and sample interval are sane.
+func (thc *TelemetryHistoryConfig) Validate() error {
+	switch {
+	case thc.Retention <= 0:
+		return FaultConfigBadTelemetryHistory("retention must be set")
+	case thc.Retention > MaxTelemetryHistoryRetention:
+		return FaultConfigBadTelemetryHistory(
+			fmt.Sprintf("retention must not exceed %s", MaxTelemetryHistoryRetention))
+	case thc.Interval < 0:
+		return FaultConfigBadTelemetryHistory("interval must not be negative")
+	case thc.GetInterval() > thc.Retention:
+		return FaultConfigBadTelemetryHistory("interval must not exceed retention")
+	}
+
+	return nil
+}
+
+// GetInterval returns the interval between samples, or the default if none is configured.
+func (thc *TelemetryHistoryConfig) GetInterval() time.Duration {
+	if thc.Interval == 0 {
+		return DefaultTelemetryHistoryInterval
+	}
+	return thc.Interval
+}
+
+// NvmeHealthHistoryConfig specifies how often the health of the NVMe devices used by the engines
+// is polled and how long the samples are retained to track device wear.
+type NvmeHealthHistoryConfig struct {
+	Retention time.Duration `yaml:"retention"`
+	Interval  time.Duration `yaml:"interval,omitempty"`
+}
+
+// Validate checks that the retention period and poll interval are sane.
+func (nhc *NvmeHealthHistoryConfig) Validate() error {
+	switch {
+	case nhc.Retention <= 0:
+		return FaultConfigBadNvmeHealthHistory("retention must be set")
+	case nhc.Retention > MaxNvmeHealthHistoryRetention:
+		return FaultConfigBadNvmeHealthHistory(
+			fmt.Sprintf("retention must not exceed %s", MaxNvmeHealthHistoryRetention))
+	case nhc.Interval < 0:
+		return FaultConfigBadNvmeHealthHistory("interval must not be negative")
+	case nhc.GetInterval() < MinNvmeHealthHistoryInterval:
+		return FaultConfigBadNvmeHealthHistory(
+			fmt.Sprintf("interval must be at least %s", MinNvmeHealthHistoryInterval))
+	case nhc.GetInterval() > nhc.Retention:
+		return FaultConfigBadNvmeHealthHistory("interval must not exceed retention")
+	}
+
+	return nil
+}
+
+// GetInterval returns the interval between polls, or the default if none is configured.
+func (nhc *NvmeHealthHistoryConfig) GetInterval() time.Duration {
+	if nhc.Interval == 0 {
+		return DefaultNvmeHealthHistoryInterval
+	}
+	return nhc.Interval
+}
+
+// NvmeFailurePolicyConfig specifies how the failure scores of the NVMe devices used by the
+// engines, calculated from the retained health history, are acted upon. A device failure is
+// predicted when its score reaches WarnScore. If AutoFaulty is set, a device is set faulty when
+// its score reaches FaultyScore so that its data is rebuilt before it fails.
+type NvmeFailurePolicyConfig struct {
+	WarnScore   uint32 `yaml:"warn_score,omitempty"`
+	FaultyScore uint32 `yaml:"faulty_score,omitempty"`
+	AutoFaulty  bool   `yaml:"auto_faulty,omitempty"`
+}
+
+// Validate checks that the scores are sane.
+func (nfc *NvmeFailurePolicyConfig) Validate() error {
+	switch {
+	case nfc.GetWarnScore() > storage.MaxNvmeFailureScore:
+		return FaultConfigBadNvmeFailurePolicy(
+			fmt.Sprintf("warn_score must not exceed %d", storage.MaxNvmeFailureScore))
+	case nfc.GetFaultyScore() > storage.MaxNvmeFailureScore:
+		return FaultConfigBadNvmeFailurePolicy(
+			fmt.Sprintf("faulty_score must not exceed %d", storage.MaxNvmeFailureScore))
+	case nfc.GetWarnScore() > nfc.GetFaultyScore():
+		return FaultConfigBadNvmeFailurePolicy("warn_score must not exceed faulty_score")
+	}
+
+	return nil
+}
+
+// GetWarnScore returns the score at which a device failure is predicted, or the default if
+// none is configured.
+func (nfc *NvmeFailurePolicyConfig) GetWarnScore() uint32 {
+	if nfc.WarnScore == 0 {
+		return DefaultNvmeFailureWarnScore
+	}
+	return nfc.WarnScore
+}
+
+// GetFaultyScore returns the score at which a device is set faulty, or the default if none is
+// configured.
+func (nfc *NvmeFailurePolicyConfig) GetFaultyScore() uint32 {
+	if nfc.FaultyScore == 0 {
+		return DefaultNvmeFailureFaultyScore
+	}
+	return nfc.FaultyScore
+}
+
+// NVMe hotplug policy actions.
+const (
+	NvmeHotplugActionNotify  = "notify"
+	NvmeHotplugActionAdd     = "add"
+	NvmeHotplugActionReplace = "replace"
+)
+
+// NvmeHotplugPolicyConfig specifies how NVMe SSDs inserted into the host are handled. With the
+// notify action an event is raised and nothing else is done. With the add and replace actions an
+// inserted SSD is also bound to a user-space driver so that an engine attaches it through
+// hotplug, the add action then adds the SSD to the bdev tier with index AddTier (the first bdev
+// tier if unset) and the replace action substitutes it for an evicted SSD of the engine.
+type NvmeHotplugPolicyConfig struct {
+	Action       string        `yaml:"action,omitempty"`
+	AddTier      int           `yaml:"add_tier,omitempty"`
+	PollInterval time.Duration `yaml:"poll_interval,omitempty"`
+}
+
+// Validate checks that the action is known and that the remaining parameters are sane.
+func (nhc *NvmeHotplugPolicyConfig) Validate() error {
+	switch nhc.GetAction() {
+	case NvmeHotplugActionNotify, NvmeHotplugActionAdd, NvmeHotplugActionReplace:
+	default:
+		return FaultConfigBadNvmeHotplugPolicy(fmt.Sprintf("unknown action %q, use one "+
+			"of %s, %s or %s", nhc.Action, NvmeHotplugActionNotify,
+			NvmeHotplugActionAdd, NvmeHotplugActionReplace))
+	}
+
+	switch {
+	case nhc.AddTier < 0:
+		return FaultConfigBadNvmeHotplugPolicy("add_tier must not be negative")
+	case nhc.AddTier != 0 && nhc.GetAction() != NvmeHotplugActionAdd:
+		return FaultConfigBadNvmeHotplugPolicy(fmt.Sprintf("add_tier may only be set "+
+			"with the %s action", NvmeHotplugActionAdd))
+	case nhc.PollInterval < 0:
+		return FaultConfigBadNvmeHotplugPolicy("poll_interval must not be negative")
+	}
+
+	return nil
+}
+
+// GetAction returns the action taken on inserted SSDs, or notify if none is configured.
+func (nhc *NvmeHotplugPolicyConfig) GetAction() string {
+	if nhc.Action == "" {
+		return NvmeHotplugActionNotify
+	}
+	return nhc.Action
+}
+
+// GetPollInterval returns the interval between scans for inserted SSDs, or the default if none
+// is configured.
+func (nhc *NvmeHotplugPolicyConfig) GetPollInterval() time.Duration {
+	if nhc.PollInterval == 0 {
+		return DefaultNvmeHotplugPollInterval
+	}
+	return nhc.PollInterval
+}
+
+// FindAddTier returns the tier that inserted SSDs are added to with the add action, this is the
+// tier with index AddTier or the first bdev tier if AddTier is unset. Nil is returned if the tier
+// doesn't exist or isn't a nvme class tier.
+func (nhc *NvmeHotplugPolicyConfig) FindAddTier(tcs storage.TierConfigs) *storage.TierConfig {
+	for _, tc := range tcs.BdevConfigs() {
+		if nhc.AddTier != 0 && tc.Tier != nhc.AddTier {
+			continue
+		}
+		if tc.Class != storage.ClassNvme {
+			return nil
+		}
+		return tc
+	}
+
+	return nil
+}
+
+// HTTPGatewayConfig specifies a listener on which read-only management requests are accepted as
+// HTTP GET requests with JSON responses, so that clients such as dashboards can integrate without
+// a gRPC client. The listener uses the certificates of the transport config.
+type HTTPGatewayConfig struct {
+	Port int `yaml:"port,omitempty"`
+}
+
+// Validate checks that the port is sane.
+func (hgc *HTTPGatewayConfig) Validate() error {
+	if hgc.Port < 0 {
+		return FaultConfigBadHTTPGateway("port must not be negative")
+	}
+
+	return nil
+}
+
+// GetPort returns the port of the gateway, or the default if none is configured.
+func (hgc *HTTPGatewayConfig) GetPort() int {
+	if hgc.Port == 0 {
+		return DefaultHTTPGatewayPort
+	}
+	return hgc.Port
+}
+
+// HealthProbeConfig specifies a listener on which the liveness (/healthz) and readiness
+// (/readyz) endpoints used by container orchestrator probes are served over plain HTTP.
+type HealthProbeConfig struct {
+	Port int `yaml:"port,omitempty"`
+}
+
+// Validate checks that the port is sane.
+func (hpc *HealthProbeConfig) Validate() error {
+	if hpc.Port < 0 {
+		return FaultConfigBadHealthProbe("port must not be negative")
+	}
+
+	return nil
+}
+
+// GetPort returns the port of the probe endpoints, or the default if none is configured.
+func (hpc *HealthProbeConfig) GetPort() int {
+	if hpc.Port == 0 {
+		return DefaultHealthProbePort
+	}
+	return hpc.Port
+}
+
+// CrashReportConfig specifies where the crash reports written after an engine exits
+// unexpectedly are kept and what they contain.
+type CrashReportConfig struct {
+	Dir        string `yaml:"dir,omitempty"`
+	LogLines   int    `yaml:"log_lines,omitempty"`
+	MaxReports int    `yaml:"max_reports,omitempty"`
+}
+
+// Validate checks that the crash report parameters are sane.
+func (crc *CrashReportConfig) Validate() error {
+	switch {
+	case crc.Dir != "" && !filepath.IsAbs(crc.Dir):
+		return FaultConfigBadCrashReports(fmt.Sprintf("dir %q is not an absolute path", crc.Dir))
+	case crc.LogLines < 0:
+		return FaultConfigBadCrashReports("log_lines must not be negative")
+	case crc.MaxReports < 0:
+		return FaultConfigBadCrashReports("max_reports must not be negative")
+	}
+
+	return nil
+}
+
+// GetDir returns the crash report directory, or the default if none is configured.
+func (crc *CrashReportConfig) GetDir() string {
+	if crc.Dir == "" {
+		return DefaultCrashReportDir
+	}
+	return crc.Dir
+}
+
+// GetLogLines returns the number of engine log lines to include in a crash report, or the
+// default if none is configured.
+func (crc *CrashReportConfig) GetLogLines() int {
+	if crc.LogLines == 0 {
+		return DefaultCrashReportLogLines
+	}
+	return crc.LogLines
+}
+
+// GetMaxReports returns the number of most recent crash reports to keep, or the default if
+// none is configured.
+func (crc *CrashReportConfig) GetMaxReports() int {
+	if crc.MaxReports == 0 {
+		return DefaultCrashReportMax
+	}
+	return crc.MaxReports
+}
+
+// EngineShutdownConfig specifies the per-phase timeouts of a graceful engine shutdown. The
+// engine is first notified of the shutdown over dRPC, then sent SIGINT and given time to flush
+// metadata and exit before being sent SIGKILL.
+type EngineShutdownConfig struct {
+	NotifyTimeout time.Duration `yaml:"notify_timeout,omitempty"`
+	FlushTimeout  time.Duration `yaml:"flush_timeout,omitempty"`
+	KillTimeout   time.Duration `yaml:"kill_timeout,omitempty"`
+}
+
+// Validate checks that the shutdown timeouts are sane.
+func (esc *EngineShutdownConfig) Validate() error {
+	switch {
+	case esc.NotifyTimeout < 0:
+		return FaultConfigBadEngineShutdown("notify_timeout must not be negative")
+	case esc.FlushTimeout < 0:
+		return FaultConfigBadEngineShutdown("flush_timeout must not be negative")
+	case esc.KillTimeout < 0:
+		return FaultConfigBadEngineShutdown("kill_timeout must not be negative")
+	}
+
+	return nil
+}
+
+// GetNotifyTimeout returns how long to wait for the shutdown notification to be acknowledged.
+// A nil config returns the default.
+func (esc *EngineShutdownConfig) GetNotifyTimeout() time.Duration {
+	if esc == nil || esc.NotifyTimeout == 0 {
+		return DefaultShutdownNotifyTimeout
+	}
+	return esc.NotifyTimeout
+}
+
+// GetFlushTimeout returns how long to wait for the engine to exit after SIGINT. A nil config
+// returns the default.
+func (esc *EngineShutdownConfig) GetFlushTimeout() time.Duration {
+	if esc == nil || esc.FlushTimeout == 0 {
+		return DefaultShutdownFlushTimeout
+	}
+	return esc.FlushTimeout
+}
+
+// GetKillTimeout returns how long to wait for the engine to exit after SIGKILL. A nil config
+// returns the default.
+func (esc *EngineShutdownConfig) GetKillTimeout() time.Duration {
+	if esc == nil || esc.KillTimeout == 0 {
+		return DefaultShutdownKillTimeout
+	}
+	return esc.KillTimeout
+}
+
+// Comparison operators supported by telemetry alert rules.
+const (
+	AlertOpGreater      = ">"
+	AlertOpGreaterEqual = ">="
+	AlertOpLess         = "<"
+	AlertOpLessEqual    = "<="
+	AlertOpEqual        = "=="
+	AlertOpNotEqual     = "!="
+)
+
+// Severities of the events raised by telemetry alert rules.
+const (
+	AlertSeverityWarning = "warning"
+	AlertSeverityError   = "error"
+)
+
+// TelemetryAlertRule raises an alert for each series of a metric whose value crosses a
+// threshold. If Divisor is set, the value compared is the metric divided by the series of the
+// divisor metric with the same labels, e.g. free space as a fraction of total space.
+type TelemetryAlertRule struct {
+	Name      string            `yaml:"name"`
+	Metric    string            `yaml:"metric"`
+	Divisor   string            `yaml:"divisor,omitempty"`
+	Labels    map[string]string `yaml:"labels,omitempty"`
+	Op        string            `yaml:"op"`
+	Threshold float64           `yaml:"threshold"`
+	Severity  string            `yaml:"severity,omitempty"`
+}
+
+// Validate checks that the rule is complete and uses a supported operator and severity.
+func (tar *TelemetryAlertRule) Validate() error {
+	switch {
+	case tar.Name == "":
+		return FaultConfigBadTelemetryAlerts("rule name must be set")
+	case tar.Metric == "":
+		return FaultConfigBadTelemetryAlerts(fmt.Sprintf("rule %q: metric must be set", tar.Name))
+	}
+
+	switch tar.Op {
+	case AlertOpGreater, AlertOpGreaterEqual, AlertOpLess, AlertOpLessEqual, AlertOpEqual,
+		AlertOpNotEqual:
+	default:
+		return FaultConfigBadTelemetryAlerts(
+			fmt.Sprintf("rule %q: unsupported op %q", tar.Name, tar.Op))
+	}
+
+	switch tar.Severity {
+	case "", AlertSeverityWarning, AlertSeverityError:
+	default:
+		return FaultConfigBadTelemetryAlerts(
+			fmt.Sprintf("rule %q: unsupported severity %q", tar.Name, tar.Severity))
+	}
+
+	return nil
+}
+
+// TelemetryAlertsConfig specifies the alert rules evaluated against the server's telemetry.
+// Alerts are raised as RAS events and optionally posted to a webhook.
+type TelemetryAlertsConfig struct {
+	Interval time.Duration         `yaml:"interval,omitempty"`
+	Webhook  string                `yaml:"webhook,omitempty"`
+	Rules    []*TelemetryAlertRule `yaml:"rules"`
+}
+
+// Validate checks the alert rules and webhook URL.
+func (tac *TelemetryAlertsConfig) Validate() error {
+	if tac.Interval < 0 {
+		return FaultConfigBadTelemetryAlerts("interval must not be negative")
+	}
+	if len(tac.Rules) == 0 {
+		return FaultConfigBadTelemetryAlerts("no rules defined")
+	}
+	if tac.Webhook != "" {
+		u, err := url.Parse(tac.Webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return FaultConfigBadTelemetryAlerts(
+				fmt.Sprintf("webhook %q is not an http(s) URL", tac.Webhook))
+		}
+	}
+
+	names := make(map[string]struct{})
+	for _, rule := range tac.Rules {
+		if rule == nil {
+			return FaultConfigBadTelemetryAlerts("empty rule")
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+		if _, found := names[rule.Name]; found {
+			return FaultConfigBadTelemetryAlerts(
+				fmt.Sprintf("duplicate rule name %q", rule.Name))
+		}
+		names[rule.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetInterval returns the interval between evaluations, or the default if none is configured.
+func (tac *TelemetryAlertsConfig) GetInterval() time.Duration {
+	if tac.Interval == 0 {
+		return DefaultTelemetryAlertInterval
+	}
+	return tac.Interval
+}
+
 type deprecatedParams struct {
 	AccessPoints  []string `yaml:"access_points,omitempty"`  // deprecated in 2.8
 	EnableHotplug *bool    `yaml:"enable_hotplug,omitempty"` // deprecated in 2.8
@@ -64,8 +688,10 @@ type Server struct {
 	DisableVFIO        bool                      `yaml:"disable_vfio"`
 	DisableVMD         *bool                     `yaml:"disable_vmd"`
 	DisableHotplug     *bool                     `yaml:"disable_hotplug"`
-	NrHugepages        int                       `yaml:"nr_hugepages"`        // total for all engines
-	SystemRamReserved  int                       `yaml:"system_ram_reserved"` // total for all engines
+	NrHugepages        int                       `yaml:"nr_hugepages"`                      // total for all engines
+	SystemRamReserved  int                       `yaml:"system_ram_reserved"`               // total for all engines
+	RamdiskMemMargin   int                       `yaml:"ram_disk_mem_margin,omitempty"`     // percent of total
+	ScmImbalance       int                       `yaml:"scm_imbalance_threshold,omitempty"` // percent
 	DisableHugepages   bool                      `yaml:"disable_hugepages"`
 	AllowNumaImbalance bool                      `yaml:"allow_numa_imbalance"`
 	ControlLogMask     common.ControlLogLevel    `yaml:"control_log_mask"`
@@ -73,11 +699,28 @@ type Server struct {
 	ControlLogJSON     bool                      `yaml:"control_log_json,omitempty"`
 	HelperLogFile      string                    `yaml:"helper_log_file,omitempty"`
 	FWHelperLogFile    string                    `yaml:"firmware_helper_log_file,omitempty"`
+	AuditLogFile       string                    `yaml:"audit_log_file,omitempty"`
+	AuditRASEvents     bool                      `yaml:"audit_ras_events,omitempty"`
 	FaultPath          string                    `yaml:"fault_path,omitempty"`
 	TelemetryPort      int                       `yaml:"telemetry_port,omitempty"`
+	TelemetryCollect   []string                  `yaml:"telemetry_collect,omitempty"`
+	TelemetryOTLP      *TelemetryOTLPConfig      `yaml:"telemetry_otlp,omitempty"`
+	TelemetryHistory   *TelemetryHistoryConfig   `yaml:"telemetry_history,omitempty"`
+	TelemetryAlerts    *TelemetryAlertsConfig    `yaml:"telemetry_alerts,omitempty"`
+	NvmeHealthHistory  *NvmeHealthHistoryConfig  `yaml:"nvme_health_history,omitempty"`
+	NvmeFailurePolicy  *NvmeFailurePolicyConfig  `yaml:"nvme_failure_policy,omitempty"`
+	NvmeHotplugPolicy  *NvmeHotplugPolicyConfig  `yaml:"nvme_hotplug_policy,omitempty"`
+	EventSinks         []*events.SinkConfig      `yaml:"event_sinks,omitempty"`
+	EventRateLimit     *events.RateLimitConfig   `yaml:"event_rate_limit,omitempty"`
+	HTTPGateway        *HTTPGatewayConfig        `yaml:"http_gateway,omitempty"`
+	HealthProbe        *HealthProbeConfig        `yaml:"health_probe,omitempty"`
+	CrashReports       *CrashReportConfig        `yaml:"crash_reports,omitempty"`
+	EngineShutdown     *EngineShutdownConfig     `yaml:"engine_shutdown,omitempty"`
 	CoreDumpFilter     uint8                     `yaml:"core_dump_filter,omitempty"`
 	ClientEnvVars      []string                  `yaml:"client_env_vars,omitempty"`
 	SupportConfig      SupportConfig             `yaml:"support_config,omitempty"`
+	ScmUnlock          *ScmUnlockConfig          `yaml:"scm_unlock,omitempty"`
+	Version            int                       `yaml:"version,omitempty"`
 
 	// duplicated in engine.Config
 	SystemName string              `yaml:"name"`
@@ -96,7 +739,8 @@ type Server struct {
 	Path string `yaml:"-"` // path to config file
 
 	// Behavior flags
-	AutoFormat bool `yaml:"-"`
+	AutoFormat           bool `yaml:"-"`
+	ForceBdevConfigRegen bool `yaml:"-"`
 
 	deprecatedParams `yaml:",inline"`
 }
@@ -306,6 +950,26 @@ func (cfg *Server) WithSystemRamReserved(nr int) *Server {
 	return cfg
 }
 
+// WithRamdiskMemMargin sets the percentage of total memory to withhold from RAM-disks as a safety
+// margin when calculating RAM-disk size.
+func (cfg *Server) WithRamdiskMemMargin(pct int) *Server {
+	cfg.RamdiskMemMargin = pct
+	return cfg
+}
+
+// WithScmImbalance sets the maximum percentage by which total PMem capacity may differ between
+// engines.
+func (cfg *Server) WithScmImbalance(pct int) *Server {
+	cfg.ScmImbalance = pct
+	return cfg
+}
+
+// WithScmUnlock sets the passphrase source used to unlock secured PMem modules on start-up.
+func (cfg *Server) WithScmUnlock(suc *ScmUnlockConfig) *Server {
+	cfg.ScmUnlock = suc
+	return cfg
+}
+
 // WithControlLogMask sets the daos_server log level.
 func (cfg *Server) WithControlLogMask(lvl common.ControlLogLevel) *Server {
 	cfg.ControlLogMask = lvl
@@ -336,16 +1000,116 @@ func (cfg *Server) WithFirmwareHelperLogFile(filePath string) *Server {
 	return cfg
 }
 
+// WithAuditLogFile sets the path to the audit log of management requests.
+func (cfg *Server) WithAuditLogFile(filePath string) *Server {
+	cfg.AuditLogFile = filePath
+	return cfg
+}
+
+// WithAuditRASEvents enables publishing a RAS event for each audited request.
+func (cfg *Server) WithAuditRASEvents(enabled bool) *Server {
+	cfg.AuditRASEvents = enabled
+	return cfg
+}
+
 // WithTelemetryPort sets the port for the telemetry exporter.
 func (cfg *Server) WithTelemetryPort(port int) *Server {
 	cfg.TelemetryPort = port
 	return cfg
 }
 
+// WithEventSinks sets the external systems that RAS events are delivered to.
+func (cfg *Server) WithEventSinks(sinks ...*events.SinkConfig) *Server {
+	cfg.EventSinks = sinks
+	return cfg
+}
+
+// WithEventRateLimit sets the limit on repeated RAS events reported by the server.
+func (cfg *Server) WithEventRateLimit(rlc *events.RateLimitConfig) *Server {
+	cfg.EventRateLimit = rlc
+	return cfg
+}
+
+// WithTelemetryAlerts sets the alert rules evaluated against the server's telemetry.
+func (cfg *Server) WithTelemetryAlerts(tac *TelemetryAlertsConfig) *Server {
+	cfg.TelemetryAlerts = tac
+	return cfg
+}
+
+// WithTelemetryHistory sets the retention of telemetry history on the server.
+func (cfg *Server) WithTelemetryHistory(thc *TelemetryHistoryConfig) *Server {
+	cfg.TelemetryHistory = thc
+	return cfg
+}
+
+// WithNvmeHealthHistory sets the polling and retention of NVMe device health on the server.
+func (cfg *Server) WithNvmeHealthHistory(nhc *NvmeHealthHistoryConfig) *Server {
+	cfg.NvmeHealthHistory = nhc
+	return cfg
+}
+
+// WithNvmeFailurePolicy sets the policy acting on the failure scores of NVMe devices.
+func (cfg *Server) WithNvmeFailurePolicy(nfc *NvmeFailurePolicyConfig) *Server {
+	cfg.NvmeFailurePolicy = nfc
+	return cfg
+}
+
+// WithNvmeHotplugPolicy sets the policy handling NVMe SSDs inserted into the host.
+func (cfg *Server) WithNvmeHotplugPolicy(nhc *NvmeHotplugPolicyConfig) *Server {
+	cfg.NvmeHotplugPolicy = nhc
+	return cfg
+}
+
+// WithHTTPGateway sets the HTTP management gateway of the server.
+func (cfg *Server) WithHTTPGateway(hgc *HTTPGatewayConfig) *Server {
+	cfg.HTTPGateway = hgc
+	return cfg
+}
+
+// WithHealthProbe sets the health probe endpoints of the server.
+func (cfg *Server) WithHealthProbe(hpc *HealthProbeConfig) *Server {
+	cfg.HealthProbe = hpc
+	return cfg
+}
+
+// WithCrashReports sets the engine crash report parameters of the server.
+func (cfg *Server) WithCrashReports(crc *CrashReportConfig) *Server {
+	cfg.CrashReports = crc
+	return cfg
+}
+
+// WithEngineShutdown sets the graceful engine shutdown timeouts of the server.
+func (cfg *Server) WithEngineShutdown(esc *EngineShutdownConfig) *Server {
+	cfg.EngineShutdown = esc
+	return cfg
+}
+
+// WithTelemetryOTLP sets the OpenTelemetry collector that telemetry is pushed to.
+func (cfg *Server) WithTelemetryOTLP(toc *TelemetryOTLPConfig) *Server {
+	cfg.TelemetryOTLP = toc
+	return cfg
+}
+
+// WithTelemetryCollect sets the telemetry collection sets exported.
+func (cfg *Server) WithTelemetryCollect(sets ...string) *Server {
+	cfg.TelemetryCollect = sets
+	return cfg
+}
+
+// GetTelemetryCollect returns the telemetry collection sets to export, or the defaults if none
+// are configured.
+func (cfg *Server) GetTelemetryCollect() []string {
+	if len(cfg.TelemetryCollect) == 0 {
+		return DefaultTelemetryCollect
+	}
+	return cfg.TelemetryCollect
+}
+
 // DefaultServer creates a new instance of configuration struct
 // populated with defaults.
 func DefaultServer() *Server {
 	return &Server{
+		Version:           ConfigVersion,
 		SystemName:        build.DefaultSystemName,
 		SocketDir:         defaultRuntimeDir,
 		ControlPort:       build.DefaultControlPort,
@@ -370,6 +1134,23 @@ func (cfg *Server) Load(log logging.Logger) error {
 		return errors.WithMessage(err, "reading file")
 	}
 
+	bytes, changes, err := MigrateConfig(bytes)
+	if err != nil {
+		return errors.WithMessagef(err, "migration of %q failed", cfg.Path)
+	}
+	if len(changes) > 0 {
+		for _, change := range changes {
+			log.Noticef("config %q: %s", cfg.Path, change)
+		}
+		log.Noticef("config %q uses a legacy layout and was migrated in memory, run "+
+			"'daos_server config migrate' to print the updated config", cfg.Path)
+	}
+
+	bytes, err = applyEngineDefaults(bytes)
+	if err != nil {
+		return errors.WithMessagef(err, "applying engine defaults in %q", cfg.Path)
+	}
+
 	if err = yaml.UnmarshalStrict(bytes, cfg); err != nil {
 		return errors.WithMessagef(err, "parse of %q failed; config contains invalid "+
 			"parameters and may be out of date, see server config examples",
@@ -507,6 +1288,12 @@ func (cfg *Server) getTgtCounts(log logging.Logger) (cfgTargetCount, sysXSCount
 		msg := fmt.Sprintf("engine %d fabric numa %d, storage numa %d", idx,
 			ec.Fabric.NumaNodeIndex, ec.Storage.NumaNodeIndex)
 
+		// Engines running SPDK without hugepages don't contribute to the requirement.
+		if ec.Storage.SpdkEnvOpts.NoHugepages {
+			log.Debugf("%s (spdk without hugepages)", msg)
+			continue
+		}
+
 		// Calculate overall target count if bdevs exist in config.
 		if ec.Storage.Tiers.HaveBdevs() {
 			cfgTargetCount += ec.TargetCount
@@ -664,23 +1451,7 @@ func (cfg *Server) calcRamdiskSize(log logging.Logger, hpSizeKiB, memKiB int) (u
 		return 0, errors.New("no engines in config")
 	}
 
-	return storage.CalcRamdiskSize(log, memTotal, memHuge, memSys, cfg.Engines[0].TargetCount,
-		len(cfg.Engines))
-}
-
-// calcMemForRamdiskSize calculates minimum memory needed for a given RAM-disk size.
-func (cfg *Server) calcMemForRamdiskSize(log logging.Logger, hpSizeKiB int, ramdiskSize uint64) (uint64, error) {
-	// Calculate assigned hugepage memory in bytes.
-	memHuge := uint64(cfg.NrHugepages * hpSizeKiB * humanize.KiByte)
-
-	// Calculate reserved system memory in bytes.
-	memSys := uint64(cfg.SystemRamReserved * humanize.GiByte)
-
-	if len(cfg.Engines) == 0 {
-		return 0, errors.New("no engines in config")
-	}
-
-	return storage.CalcMemForRamdiskSize(log, ramdiskSize, memHuge, memSys,
+	return storage.CalcRamdiskSize(log, memTotal, memHuge, memSys, cfg.RamdiskMemMargin,
 		cfg.Engines[0].TargetCount, len(cfg.Engines))
 }
 
@@ -700,6 +1471,10 @@ func (cfg *Server) SetRamdiskSize(log logging.Logger, smi *common.SysMemInfo) er
 
 	maxRamdiskSize, err := cfg.calcRamdiskSize(log, smi.HugepageSizeKiB, smi.MemTotalKiB)
 	if err != nil {
+		if fault.IsFault(err) {
+			// Total RAM is insufficient to meet minimum size.
+			return err
+		}
 		return errors.Wrapf(err, "calculate ramdisk size")
 	}
 
@@ -708,20 +1483,6 @@ func (cfg *Server) SetRamdiskSize(log logging.Logger, smi *common.SysMemInfo) er
 	msg := fmt.Sprintf("calculated max ram-disk size (%s) using MemTotal (%s)",
 		humanize.IBytes(maxRamdiskSize), humanize.IBytes(memTotBytes))
 
-	if maxRamdiskSize < storage.MinRamdiskMem {
-		// Total RAM is insufficient to meet minimum size.
-		log.Errorf("%s: insufficient total memory", msg)
-
-		minMem, err := cfg.calcMemForRamdiskSize(log, smi.HugepageSizeKiB,
-			storage.MinRamdiskMem)
-		if err != nil {
-			log.Error(err.Error())
-		}
-
-		return storage.FaultRamdiskLowMem("Total", storage.MinRamdiskMem, minMem,
-			memTotBytes)
-	}
-
 	for idx, ec := range cfg.Engines {
 		scs := ec.Storage.Tiers.ScmConfigs()
 		if len(scs) != 1 {
@@ -821,6 +1582,147 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigSysRsvdZero
 	}
 
+	if cfg.RamdiskMemMargin < 0 || cfg.RamdiskMemMargin > storage.MaxRamdiskMemMargin {
+		return FaultConfigRamdiskMemMarginOutOfRange(cfg.RamdiskMemMargin,
+			storage.MaxRamdiskMemMargin)
+	}
+
+	if cfg.ScmImbalance < 0 || cfg.ScmImbalance > 100 {
+		return FaultConfigScmImbalanceOutOfRange(cfg.ScmImbalance)
+	}
+
+	if cfg.ScmUnlock != nil {
+		if err := cfg.ScmUnlock.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TelemetryOTLP != nil {
+		if err := cfg.TelemetryOTLP.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TelemetryHistory != nil {
+		if err := cfg.TelemetryHistory.Validate(); err != nil {
+			return err
+		}
+		if cfg.TelemetryPort == 0 {
+			return FaultConfigBadTelemetryHistory("telemetry_port must be set")
+		}
+	}
+
+	if cfg.TelemetryAlerts != nil {
+		if err := cfg.TelemetryAlerts.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.NvmeHealthHistory != nil {
+		if err := cfg.NvmeHealthHistory.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.NvmeFailurePolicy != nil {
+		if err := cfg.NvmeFailurePolicy.Validate(); err != nil {
+			return err
+		}
+		if cfg.NvmeHealthHistory == nil {
+			return FaultConfigBadNvmeFailurePolicy("nvme_health_history must be set")
+		}
+	}
+
+	if err := cfg.validateNvmeHotplugPolicy(); err != nil {
+		return err
+	}
+
+	for idx, sink := range cfg.EventSinks {
+		if sink == nil {
+			return FaultConfigBadEventSink(idx, "empty sink")
+		}
+		if err := sink.Validate(); err != nil {
+			return FaultConfigBadEventSink(idx, err.Error())
+		}
+	}
+
+	if cfg.EventRateLimit != nil {
+		if err := cfg.EventRateLimit.Validate(); err != nil {
+			return FaultConfigBadEventRateLimit(err.Error())
+		}
+	}
+
+	if cfg.HTTPGateway != nil {
+		if err := cfg.HTTPGateway.Validate(); err != nil {
+			return err
+		}
+		port := cfg.HTTPGateway.GetPort()
+		switch {
+		case port == cfg.ControlPort:
+			return FaultConfigBadHTTPGateway(
+				fmt.Sprintf("port %d is already used by the control plane", port))
+		case port == cfg.TelemetryPort:
+			return FaultConfigBadHTTPGateway(
+				fmt.Sprintf("port %d is already used by the telemetry endpoint", port))
+		case cfg.TransportConfig != nil && cfg.TransportConfig.TokenAuth != nil &&
+			port == cfg.TransportConfig.TokenAuth.Port:
+			return FaultConfigBadHTTPGateway(
+				fmt.Sprintf("port %d is already used by token_auth", port))
+		}
+	}
+
+	if cfg.CrashReports != nil {
+		if err := cfg.CrashReports.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.EngineShutdown != nil {
+		if err := cfg.EngineShutdown.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HealthProbe != nil {
+		if err := cfg.HealthProbe.Validate(); err != nil {
+			return err
+		}
+		port := cfg.HealthProbe.GetPort()
+		switch {
+		case port == cfg.ControlPort:
+			return FaultConfigBadHealthProbe(
+				fmt.Sprintf("port %d is already used by the control plane", port))
+		case port == cfg.TelemetryPort:
+			return FaultConfigBadHealthProbe(
+				fmt.Sprintf("port %d is already used by the telemetry endpoint", port))
+		case cfg.HTTPGateway != nil && port == cfg.HTTPGateway.GetPort():
+			return FaultConfigBadHealthProbe(
+				fmt.Sprintf("port %d is already used by http_gateway", port))
+		case cfg.TransportConfig != nil && cfg.TransportConfig.TokenAuth != nil &&
+			port == cfg.TransportConfig.TokenAuth.Port:
+			return FaultConfigBadHealthProbe(
+				fmt.Sprintf("port %d is already used by token_auth", port))
+		}
+	}
+
+	if cfg.TransportConfig != nil {
+		if err := cfg.TransportConfig.ClientRoles.Validate(); err != nil {
+			return err
+		}
+		if ta := cfg.TransportConfig.TokenAuth; ta != nil {
+			if cfg.TransportConfig.AllowInsecure {
+				return errors.New("token_auth requires allow_insecure to be false")
+			}
+			if err := ta.Validate(); err != nil {
+				return err
+			}
+			if ta.Port == cfg.ControlPort {
+				return errors.Errorf("token_auth: port %d is already used by the control plane",
+					ta.Port)
+			}
+		}
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
@@ -849,6 +1751,12 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigBadTelemetryPort
 	}
 
+	for _, set := range cfg.TelemetryCollect {
+		if !common.Includes(TelemetryCollectSets, set) {
+			return FaultConfigBadTelemetryCollect(set)
+		}
+	}
+
 	for idx, ec := range cfg.Engines {
 		ec.Storage.ControlMetadata = cfg.Metadata
 		ec.Storage.EngineIdx = uint(idx)
@@ -857,6 +1765,9 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		if err := ec.Validate(); err != nil {
 			return errors.Wrapf(err, "I/O Engine %d failed config validation", idx)
 		}
+		for _, warning := range ec.EnvVarWarnings() {
+			log.Noticef("engine %d: %s", idx, warning)
+		}
 	}
 
 	if len(cfg.Engines) > 1 {
@@ -870,13 +1781,51 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 	}
 
 	// Verify bdev_exclude doesn't clash with any configured bdev.
-	pciAddrs := cfg.GetBdevConfigs().NVMeBdevs().Devices()
+	pciAddrs := cfg.GetBdevConfigs().NVMeBdevsWithSpares().Devices()
 	for _, a := range pciAddrs {
 		if common.Includes(cfg.BdevExclude, a) {
 			return FaultConfigBdevExcludeClash
 		}
 	}
 
+	// Spare SSDs are attached via hotplug when they are substituted for faulty devices.
+	if cfg.GetBdevConfigs().NVMeSpares().Len() > 0 && *cfg.DisableHotplug {
+		return FaultConfigBdevSparesNoHotplug
+	}
+
+	return nil
+}
+
+// validateNvmeHotplugPolicy checks the NVMe hotplug policy against the rest of the config.
+// Inserted SSDs can only be attached by an engine when hotplug is enabled and the add action
+// requires a nvme class bdev tier to add them to on each engine.
+func (cfg *Server) validateNvmeHotplugPolicy() error {
+	nhc := cfg.NvmeHotplugPolicy
+	if nhc == nil {
+		return nil
+	}
+	if err := nhc.Validate(); err != nil {
+		return err
+	}
+	if nhc.GetAction() == NvmeHotplugActionNotify {
+		return nil
+	}
+
+	if cfg.DisableHotplug != nil && *cfg.DisableHotplug {
+		return FaultConfigBadNvmeHotplugPolicy(fmt.Sprintf("%s action requires hotplug, "+
+			"remove disable_hotplug", nhc.GetAction()))
+	}
+	if nhc.GetAction() != NvmeHotplugActionAdd {
+		return nil
+	}
+
+	for idx, ec := range cfg.Engines {
+		if ec == nil || nhc.FindAddTier(ec.Storage.Tiers) == nil {
+			return FaultConfigBadNvmeHotplugPolicy(fmt.Sprintf("engine %d has no nvme "+
+				"class bdev tier to add inserted SSDs to", idx))
+		}
+	}
+
 	return nil
 }
 
@@ -947,7 +1896,8 @@ func (cfg *Server) validateMultiEngineConfig(log logging.Logger) error {
 
 		bdevs := engine.Storage.GetBdevs()
 		bdevCount := bdevs.Len()
-		for _, dev := range bdevs.Devices() {
+		devs := append(bdevs.Devices(), engine.Storage.Tiers.NVMeSpares().Devices()...)
+		for _, dev := range devs {
 			if seenIn, exists := seenBdevSet[dev]; exists {
 				log.Debugf("bdev_list entry %s in %d overlaps %d", dev, idx, seenIn)
 				return FaultConfigOverlappingBdevDeviceList(idx, seenIn)
@@ -1072,6 +2022,69 @@ func (cfg *Server) SetEngineAffinities(log logging.Logger, affSources ...EngineA
 	return nil
 }
 
+// ValidateScmPlacement verifies that PMem namespaces assigned to each engine are attached to the
+// engine's NUMA node and that total PMem capacity is balanced across engines within the
+// configured threshold. Namespaces not present in the supplied set are skipped.
+func (cfg *Server) ValidateScmPlacement(log logging.Logger, namespaces storage.ScmNamespaces) error {
+	nsMap := make(map[string]*storage.ScmNamespace)
+	for _, ns := range namespaces {
+		nsMap[ns.BlockDevice] = ns
+	}
+
+	threshold := cfg.ScmImbalance
+	if threshold == 0 {
+		threshold = DefaultScmImbalance
+	}
+
+	sizes := make(map[int]uint64)
+	minIdx, maxIdx := -1, -1
+	for idx, ec := range cfg.Engines {
+		for _, sc := range ec.Storage.Tiers.ScmConfigs() {
+			if sc.Class != storage.ClassDcpm {
+				continue
+			}
+
+			for _, dev := range sc.Scm.DeviceList {
+				ns, found := nsMap[filepath.Base(dev)]
+				if !found {
+					log.Debugf("engine %d: scm_list entry %s not found in scan", idx, dev)
+					continue
+				}
+
+				if ec.PinnedNumaNode != nil && uint(ns.NumaNode) != *ec.PinnedNumaNode {
+					return FaultConfigScmNumaMismatch(idx, dev, ns.NumaNode,
+						*ec.PinnedNumaNode)
+				}
+				sizes[idx] += ns.Size
+			}
+		}
+
+		if _, exists := sizes[idx]; !exists {
+			continue
+		}
+		if minIdx == -1 || sizes[idx] < sizes[minIdx] {
+			minIdx = idx
+		}
+		if maxIdx == -1 || sizes[idx] > sizes[maxIdx] {
+			maxIdx = idx
+		}
+	}
+
+	if len(sizes) < 2 || sizes[maxIdx] == 0 {
+		return nil
+	}
+
+	diffPct := (sizes[maxIdx] - sizes[minIdx]) * 100 / sizes[maxIdx]
+	log.Debugf("PMem capacity differs by %d%% between engines %d (%s) and %d (%s)", diffPct,
+		minIdx, humanize.IBytes(sizes[minIdx]), maxIdx, humanize.IBytes(sizes[maxIdx]))
+	if diffPct > uint64(threshold) {
+		return FaultConfigScmCapacityImbalance(minIdx, sizes[minIdx], maxIdx, sizes[maxIdx],
+			threshold)
+	}
+
+	return nil
+}
+
 // GetBdevConfigs retrieves all engine bdev storage tier configs from a server configuration.
 func (cfg *Server) GetBdevConfigs() (bdevCfgs storage.TierConfigs) {
 	if cfg == nil {
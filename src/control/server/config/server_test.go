@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -26,6 +27,7 @@ import (
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/engine"
@@ -252,7 +254,81 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithControlLogFile("/var/log/daos/daos_server.log").
 		WithHelperLogFile("/var/log/daos/daos_server_helper.log").
 		WithFirmwareHelperLogFile("/var/log/daos/daos_firmware_helper.log").
+		WithAuditLogFile("/var/log/daos/daos_server_audit.log").
+		WithAuditRASEvents(true).
+		WithEventSinks(
+			&events.SinkConfig{
+				Type:        events.SinkTypeSyslog,
+				Address:     "tcp://loghost.example.com:514",
+				Facility:    "local0",
+				MinSeverity: "warning",
+			},
+			&events.SinkConfig{
+				Type:    events.SinkTypeWebhook,
+				URL:     "https://ops.example.com/daos/events",
+				Headers: map[string]string{"Authorization": "Bearer secret"},
+				Events:  []string{"engine_died", "device_set_faulty", "pool_rebuild_failed"},
+			},
+			&events.SinkConfig{
+				Type:  events.SinkTypeKafka,
+				URL:   "http://kafka-rest.example.com:8082",
+				Topic: "daos-ras",
+			},
+		).
+		WithEventRateLimit(&events.RateLimitConfig{Window: time.Minute, Burst: 3}).
 		WithTelemetryPort(9191).
+		WithTelemetryCollect("engine", "pool", "target", "device", "control").
+		WithTelemetryOTLP(&TelemetryOTLPConfig{
+			Endpoint: "collector.example.com:4317",
+			Interval: 30 * time.Second,
+			CACert:   "/etc/daos/certs/otlp_ca.crt",
+			Headers:  map[string]string{"x-api-key": "secret"},
+			Traces:   true,
+		}).
+		// interval is dropped by uncommentServerConfig as a duplicate key.
+		WithTelemetryHistory(&TelemetryHistoryConfig{Retention: time.Hour}).
+		WithTelemetryAlerts(&TelemetryAlertsConfig{
+			Interval: 30 * time.Second,
+			Webhook:  "https://alerts.example.com/daos",
+			Rules: []*TelemetryAlertRule{
+				{
+					Name:      "nvme_hot",
+					Metric:    "engine_nvme_temp_current",
+					Op:        ">",
+					Threshold: 343,
+					Severity:  "error",
+				},
+				{
+					Name:      "scm_low",
+					Metric:    "system_pool_space_free_bytes",
+					Divisor:   "system_pool_space_total_bytes",
+					Labels:    map[string]string{"tier": "scm"},
+					Op:        "<",
+					Threshold: 0.05,
+				},
+			},
+		}).
+		// interval is dropped by uncommentServerConfig as a duplicate key.
+		WithNvmeHealthHistory(&NvmeHealthHistoryConfig{Retention: 720 * time.Hour}).
+		WithNvmeFailurePolicy(&NvmeFailurePolicyConfig{
+			WarnScore:   40,
+			FaultyScore: 90,
+			AutoFaulty:  true,
+		}).
+		WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{
+			Action:       NvmeHotplugActionReplace,
+			PollInterval: 10 * time.Second,
+		}).
+		WithCrashReports(&CrashReportConfig{
+			Dir:        "/var/log/daos/crash",
+			LogLines:   100,
+			MaxReports: 20,
+		}).
+		WithEngineShutdown(&EngineShutdownConfig{
+			NotifyTimeout: 10 * time.Second,
+			FlushTimeout:  60 * time.Second,
+			KillTimeout:   10 * time.Second,
+		}).
 		WithSystemName("daos_server").
 		WithSocketDir("./.daos/daos_server").
 		WithFabricProvider("ofi+verbs;ofi_rxm").
@@ -264,6 +340,9 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithFabricAuthKey("foo:bar").
 		WithHyperthreads(true). // hyper-threads disabled by default
 		WithSystemRamReserved(5).
+		WithRamdiskMemMargin(10).
+		WithScmImbalance(20).
+		WithScmUnlock(&ScmUnlockConfig{KeyFile: "/etc/daos/pmem_passphrase"}).
 		WithAllowNumaImbalance(true)
 
 	// add engines explicitly to test functionality applied in WithEngines()
@@ -325,6 +404,26 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithStorageEnableHotplug(false).
 			WithStorageAutoFaultyCriteria(false, 0, 0),
 	}
+	constructed.TransportConfig.ClientRoles = security.ClientRoles{
+		{
+			CommonName:         "monitor",
+			OrganizationalUnit: "monitoring",
+			Role:               security.RoleReadOnly,
+		},
+	}
+	constructed.TransportConfig.CRLPath = "/etc/daos/certs/daosCA.crl"
+	constructed.TransportConfig.CertWatchInterval = time.Minute
+	constructed.TransportConfig.CRLRefreshInterval = time.Hour
+	constructed.TransportConfig.TokenAuth = &security.TokenAuthConfig{
+		Port:      security.DefaultTokenAuthPort,
+		Issuer:    "https://idp.example.com/realms/hpc",
+		Audience:  "daos",
+		RoleClaim: "groups",
+		Roles: map[string]security.Role{
+			"hpc-admins":    security.RoleAdmin,
+			"hpc-operators": security.RoleOperator,
+		},
+	}
 	constructed.Path = testFile // just to avoid failing the cmp
 
 	for i := range constructed.Engines {
@@ -630,6 +729,430 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigBadTelemetryPort,
 		},
+		"good telemetry collection sets": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryCollect(TelemetryCollectPool, TelemetryCollectTarget)
+			},
+		},
+		"bad telemetry collection set": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryCollect(TelemetryCollectEngine, "bogus")
+			},
+			expErr: FaultConfigBadTelemetryCollect("bogus"),
+		},
+		"good telemetry otlp config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLP(&TelemetryOTLPConfig{
+					Endpoint: "localhost:4317",
+					Insecure: true,
+				})
+			},
+		},
+		"telemetry otlp missing endpoint": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLP(&TelemetryOTLPConfig{})
+			},
+			expErr: FaultConfigBadTelemetryOTLP("endpoint must be set"),
+		},
+		"telemetry otlp negative interval": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLP(&TelemetryOTLPConfig{
+					Endpoint: "localhost:4317",
+					Interval: -time.Second,
+				})
+			},
+			expErr: FaultConfigBadTelemetryOTLP("interval must not be negative"),
+		},
+		"telemetry otlp insecure with ca_cert": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryOTLP(&TelemetryOTLPConfig{
+					Endpoint: "localhost:4317",
+					Insecure: true,
+					CACert:   "/etc/daos/certs/otlp_ca.crt",
+				})
+			},
+			expErr: FaultConfigBadTelemetryOTLP("insecure and ca_cert are mutually exclusive"),
+		},
+		"good telemetry history config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(9191).
+					WithTelemetryHistory(&TelemetryHistoryConfig{Retention: 10 * time.Minute})
+			},
+		},
+		"telemetry history missing retention": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(9191).
+					WithTelemetryHistory(&TelemetryHistoryConfig{})
+			},
+			expErr: FaultConfigBadTelemetryHistory("retention must be set"),
+		},
+		"telemetry history retention too long": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(9191).
+					WithTelemetryHistory(&TelemetryHistoryConfig{Retention: 48 * time.Hour})
+			},
+			expErr: FaultConfigBadTelemetryHistory("retention must not exceed 24h0m0s"),
+		},
+		"telemetry history interval exceeds retention": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(9191).
+					WithTelemetryHistory(&TelemetryHistoryConfig{
+						Retention: time.Minute,
+						Interval:  time.Hour,
+					})
+			},
+			expErr: FaultConfigBadTelemetryHistory("interval must not exceed retention"),
+		},
+		"telemetry history without telemetry port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(0).
+					WithTelemetryHistory(&TelemetryHistoryConfig{Retention: time.Minute})
+			},
+			expErr: FaultConfigBadTelemetryHistory("telemetry_port must be set"),
+		},
+		"good nvme health history config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{
+					Retention: 24 * time.Hour,
+					Interval:  5 * time.Minute,
+				})
+			},
+		},
+		"nvme health history missing retention": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{})
+			},
+			expErr: FaultConfigBadNvmeHealthHistory("retention must be set"),
+		},
+		"nvme health history retention too long": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{
+					Retention: 100 * 24 * time.Hour,
+				})
+			},
+			expErr: FaultConfigBadNvmeHealthHistory("retention must not exceed 2160h0m0s"),
+		},
+		"nvme health history interval too short": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{
+					Retention: time.Hour,
+					Interval:  time.Second,
+				})
+			},
+			expErr: FaultConfigBadNvmeHealthHistory("interval must be at least 1m0s"),
+		},
+		"nvme health history interval exceeds retention": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{
+					Retention: 5 * time.Minute,
+				})
+			},
+			expErr: FaultConfigBadNvmeHealthHistory("interval must not exceed retention"),
+		},
+		"good nvme failure policy config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{Retention: time.Hour}).
+					WithNvmeFailurePolicy(&NvmeFailurePolicyConfig{AutoFaulty: true})
+			},
+		},
+		"nvme failure policy without health history": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeFailurePolicy(&NvmeFailurePolicyConfig{})
+			},
+			expErr: FaultConfigBadNvmeFailurePolicy("nvme_health_history must be set"),
+		},
+		"nvme failure policy score too high": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{Retention: time.Hour}).
+					WithNvmeFailurePolicy(&NvmeFailurePolicyConfig{FaultyScore: 101})
+			},
+			expErr: FaultConfigBadNvmeFailurePolicy("faulty_score must not exceed 100"),
+		},
+		"nvme failure policy warn score exceeds faulty score": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHealthHistory(&NvmeHealthHistoryConfig{Retention: time.Hour}).
+					WithNvmeFailurePolicy(&NvmeFailurePolicyConfig{WarnScore: 90})
+			},
+			expErr: FaultConfigBadNvmeFailurePolicy("warn_score must not exceed faulty_score"),
+		},
+		"good nvme hotplug policy config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{
+					Action:  NvmeHotplugActionAdd,
+					AddTier: 1,
+				}).
+					WithEngines(
+						defaultEngineCfg().
+							WithStorage(
+								storage.NewTierConfig().
+									WithScmMountPoint("/mnt/daos/1").
+									WithStorageClass("ram").
+									WithScmDisableHugepages(),
+								storage.NewTierConfig().
+									WithStorageClass("nvme").
+									WithBdevDeviceList("0000:81:00.0"),
+							),
+					)
+			},
+		},
+		"nvme hotplug policy unknown action": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{Action: "eject"})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy(`unknown action "eject", use one of ` +
+				"notify, add or replace"),
+		},
+		"nvme hotplug policy add tier without add action": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{AddTier: 1})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy("add_tier may only be set with the " +
+				"add action"),
+		},
+		"nvme hotplug policy negative poll interval": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{PollInterval: -1})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy("poll_interval must not be negative"),
+		},
+		"nvme hotplug policy replace with hotplug disabled": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithDisableHotplug(true).
+					WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{
+						Action: NvmeHotplugActionReplace,
+					})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy("replace action requires hotplug, " +
+				"remove disable_hotplug"),
+		},
+		"nvme hotplug policy notify with hotplug disabled": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithDisableHotplug(true).
+					WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{})
+			},
+		},
+		"nvme hotplug policy add tier not nvme class": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{
+					Action: NvmeHotplugActionAdd,
+				})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy("engine 1 has no nvme class bdev " +
+				"tier to add inserted SSDs to"),
+		},
+		"nvme hotplug policy add tier missing": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithNvmeHotplugPolicy(&NvmeHotplugPolicyConfig{
+					Action:  NvmeHotplugActionAdd,
+					AddTier: 5,
+				})
+			},
+			expErr: FaultConfigBadNvmeHotplugPolicy("engine 0 has no nvme class bdev " +
+				"tier to add inserted SSDs to"),
+		},
+		"good http gateway config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHTTPGateway(&HTTPGatewayConfig{})
+			},
+		},
+		"http gateway negative port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHTTPGateway(&HTTPGatewayConfig{Port: -1})
+			},
+			expErr: FaultConfigBadHTTPGateway("port must not be negative"),
+		},
+		"http gateway port conflicts with control port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHTTPGateway(&HTTPGatewayConfig{Port: c.ControlPort})
+			},
+			expErr: FaultConfigBadHTTPGateway("port 10001 is already used by the control plane"),
+		},
+		"http gateway port conflicts with telemetry port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryPort(DefaultHTTPGatewayPort).
+					WithHTTPGateway(&HTTPGatewayConfig{})
+			},
+			expErr: FaultConfigBadHTTPGateway("port 10003 is already used by the telemetry endpoint"),
+		},
+		"good crash reports config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithCrashReports(&CrashReportConfig{})
+			},
+		},
+		"crash reports relative dir": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithCrashReports(&CrashReportConfig{Dir: "crash"})
+			},
+			expErr: FaultConfigBadCrashReports(`dir "crash" is not an absolute path`),
+		},
+		"crash reports negative log lines": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithCrashReports(&CrashReportConfig{LogLines: -1})
+			},
+			expErr: FaultConfigBadCrashReports("log_lines must not be negative"),
+		},
+		"crash reports negative max reports": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithCrashReports(&CrashReportConfig{MaxReports: -1})
+			},
+			expErr: FaultConfigBadCrashReports("max_reports must not be negative"),
+		},
+		"good engine shutdown config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEngineShutdown(&EngineShutdownConfig{
+					FlushTimeout: 2 * time.Minute,
+				})
+			},
+		},
+		"engine shutdown negative notify timeout": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEngineShutdown(&EngineShutdownConfig{NotifyTimeout: -1})
+			},
+			expErr: FaultConfigBadEngineShutdown("notify_timeout must not be negative"),
+		},
+		"engine shutdown negative flush timeout": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEngineShutdown(&EngineShutdownConfig{FlushTimeout: -1})
+			},
+			expErr: FaultConfigBadEngineShutdown("flush_timeout must not be negative"),
+		},
+		"engine shutdown negative kill timeout": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEngineShutdown(&EngineShutdownConfig{KillTimeout: -1})
+			},
+			expErr: FaultConfigBadEngineShutdown("kill_timeout must not be negative"),
+		},
+		"good health probe config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHealthProbe(&HealthProbeConfig{})
+			},
+		},
+		"health probe negative port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHealthProbe(&HealthProbeConfig{Port: -1})
+			},
+			expErr: FaultConfigBadHealthProbe("port must not be negative"),
+		},
+		"health probe port conflicts with http gateway port": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithHTTPGateway(&HTTPGatewayConfig{}).
+					WithHealthProbe(&HealthProbeConfig{Port: DefaultHTTPGatewayPort})
+			},
+			expErr: FaultConfigBadHealthProbe("port 10003 is already used by http_gateway"),
+		},
+		"good telemetry alerts config": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Webhook: "http://localhost:8080/alerts",
+					Rules: []*TelemetryAlertRule{
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: ">", Threshold: 343},
+					},
+				})
+			},
+		},
+		"telemetry alerts without rules": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{})
+			},
+			expErr: FaultConfigBadTelemetryAlerts("no rules defined"),
+		},
+		"telemetry alerts bad webhook": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Webhook: "localhost:8080",
+					Rules: []*TelemetryAlertRule{
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: ">"},
+					},
+				})
+			},
+			expErr: FaultConfigBadTelemetryAlerts(`webhook "localhost:8080" is not an http(s) URL`),
+		},
+		"telemetry alert rule missing metric": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Rules: []*TelemetryAlertRule{{Name: "hot", Op: ">"}},
+				})
+			},
+			expErr: FaultConfigBadTelemetryAlerts(`rule "hot": metric must be set`),
+		},
+		"telemetry alert rule bad op": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Rules: []*TelemetryAlertRule{
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: "=>"},
+					},
+				})
+			},
+			expErr: FaultConfigBadTelemetryAlerts(`rule "hot": unsupported op "=>"`),
+		},
+		"telemetry alert rule bad severity": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Rules: []*TelemetryAlertRule{
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: ">", Severity: "critical"},
+					},
+				})
+			},
+			expErr: FaultConfigBadTelemetryAlerts(`rule "hot": unsupported severity "critical"`),
+		},
+		"telemetry alert duplicate rule names": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithTelemetryAlerts(&TelemetryAlertsConfig{
+					Rules: []*TelemetryAlertRule{
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: ">"},
+						{Name: "hot", Metric: "engine_nvme_temp_current", Op: ">="},
+					},
+				})
+			},
+			expErr: FaultConfigBadTelemetryAlerts(`duplicate rule name "hot"`),
+		},
+		"good event sinks": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(
+					&events.SinkConfig{Type: events.SinkTypeSyslog},
+					&events.SinkConfig{
+						Type:        events.SinkTypeWebhook,
+						URL:         "http://localhost:8080/events",
+						MinSeverity: "error",
+					},
+				)
+			},
+		},
+		"event sink missing type": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(&events.SinkConfig{URL: "http://localhost:8080"})
+			},
+			expErr: FaultConfigBadEventSink(0, "sink type must be set"),
+		},
+		"kafka event sink missing topic": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(
+					&events.SinkConfig{Type: events.SinkTypeSyslog},
+					&events.SinkConfig{Type: events.SinkTypeKafka, URL: "http://localhost:8082"},
+				)
+			},
+			expErr: FaultConfigBadEventSink(1, "topic must be set for kafka sinks"),
+		},
+		"event sink unknown event": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventSinks(&events.SinkConfig{
+					Type:   events.SinkTypeSyslog,
+					Events: []string{"engine_exploded"},
+				})
+			},
+			expErr: FaultConfigBadEventSink(0, `unknown RAS event "engine_exploded"`),
+		},
+		"good event rate limit": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventRateLimit(&events.RateLimitConfig{Window: time.Minute})
+			},
+		},
+		"event rate limit missing window": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEventRateLimit(&events.RateLimitConfig{Burst: 3})
+			},
+			expErr: FaultConfigBadEventRateLimit("window must be greater than zero"),
+		},
 		"different number of bdevs": {
 			extraConfig: func(c *Server) *Server {
 				// add multiple bdevs for engine 0 to create mismatch
@@ -681,6 +1204,95 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigSysRsvdZero,
 		},
+		"ram-disk mem margin out of range": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithRamdiskMemMargin(storage.MaxRamdiskMemMargin + 1)
+			},
+			expErr: FaultConfigRamdiskMemMarginOutOfRange(storage.MaxRamdiskMemMargin+1,
+				storage.MaxRamdiskMemMargin),
+		},
+		"scm imbalance threshold out of range": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithScmImbalance(101)
+			},
+			expErr: FaultConfigScmImbalanceOutOfRange(101),
+		},
+		"scm unlock without passphrase source": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithScmUnlock(&ScmUnlockConfig{})
+			},
+			expErr: FaultConfigBadScmUnlock("one of key_file or kmip_helper must be set"),
+		},
+		"scm unlock with key file and kmip helper": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithScmUnlock(&ScmUnlockConfig{
+					KeyFile:    "/etc/daos/pmem_passphrase",
+					KmipHelper: "/usr/bin/kmip",
+					KmipKeyID:  "pmem",
+				})
+			},
+			expErr: FaultConfigBadScmUnlock("key_file and kmip_helper are mutually exclusive"),
+		},
+		"scm unlock kmip helper without key id": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithScmUnlock(&ScmUnlockConfig{KmipHelper: "/usr/bin/kmip"})
+			},
+			expErr: FaultConfigBadScmUnlock("kmip_key_id must be set with kmip_helper"),
+		},
+		"client role without role": {
+			extraConfig: func(c *Server) *Server {
+				c.TransportConfig.ClientRoles = security.ClientRoles{
+					{OrganizationalUnit: "monitoring"},
+				}
+				return c
+			},
+			expErr: errors.New("role must be set"),
+		},
+		"token auth without issuer": {
+			extraConfig: func(c *Server) *Server {
+				c.TransportConfig.TokenAuth = &security.TokenAuthConfig{
+					Port:     security.DefaultTokenAuthPort,
+					Audience: "daos",
+					Roles:    map[string]security.Role{"admins": security.RoleAdmin},
+				}
+				return c
+			},
+			expErr: errors.New("issuer must be set"),
+		},
+		"token auth on control port": {
+			extraConfig: func(c *Server) *Server {
+				c.TransportConfig.TokenAuth = &security.TokenAuthConfig{
+					Port:     c.ControlPort,
+					Issuer:   "https://idp.example.com",
+					Audience: "daos",
+					Roles:    map[string]security.Role{"admins": security.RoleAdmin},
+				}
+				return c
+			},
+			expErr: errors.New("already used by the control plane"),
+		},
+		"token auth with insecure transport": {
+			extraConfig: func(c *Server) *Server {
+				c.TransportConfig.AllowInsecure = true
+				c.TransportConfig.TokenAuth = &security.TokenAuthConfig{
+					Port:     security.DefaultTokenAuthPort,
+					Issuer:   "https://idp.example.com",
+					Audience: "daos",
+					Roles:    map[string]security.Role{"admins": security.RoleAdmin},
+				}
+				return c
+			},
+			expErr: errors.New("requires allow_insecure to be false"),
+		},
+		"client role matching server certificates": {
+			extraConfig: func(c *Server) *Server {
+				c.TransportConfig.ClientRoles = security.ClientRoles{
+					{CommonName: "server", Role: security.RoleReadOnly},
+				}
+				return c
+			},
+			expErr: errors.New("reserved for server"),
+		},
 		"control metadata multi-engine": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithControlMetadata(storage.ControlMetadata{
@@ -966,6 +1578,25 @@ func TestServerConfig_Validation(t *testing.T) {
 			},
 			expErr: FaultConfigBdevExcludeClash,
 		},
+		"bdev_spares with hotplug disabled": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithDisableHotplug(true).
+					WithEngines(
+						defaultEngineCfg().
+							WithStorage(
+								storage.NewTierConfig().
+									WithScmMountPoint("/mnt/daos/1").
+									WithStorageClass("ram").
+									WithScmDisableHugepages(),
+								storage.NewTierConfig().
+									WithStorageClass("nvme").
+									WithBdevDeviceList("0000:81:00.0").
+									WithBdevSpares("0000:82:00.0"),
+							),
+					)
+			},
+			expErr: FaultConfigBdevSparesNoHotplug,
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -1262,6 +1893,27 @@ func TestServerConfig_SetNrHugepages(t *testing.T) {
 			},
 			expCfgNrHugepages: ScanMinHugepageCount + 1,
 		},
+		"spdk without hugepages; emulated bdevs configured": {
+			extraConfig: func(c *Server) *Server {
+				return c.WithEngines(defaultEngineCfg().
+					WithStorage(
+						storage.NewTierConfig().
+							WithStorageClass("ram").
+							WithScmMountPoint("/foo"),
+						storage.NewTierConfig().
+							WithStorageClass("file").
+							WithBdevDeviceList("/tmp/daos-bdev").
+							WithBdevFileSize(16),
+					).
+					WithStorageSpdkEnvOptions(storage.SpdkEnvOptions{
+						NoHugepages: true,
+						MemSizeMiB:  4096,
+					}),
+				)
+			},
+			// No engine targets contribute to the hugepage requirement.
+			expCfgNrHugepages: ScanMinHugepageCount,
+		},
 		"md-on-ssd enabled with explicit role assignment; zero total system hugepages": {
 			extraConfig: func(c *Server) *Server {
 				return c.WithEngines(
@@ -1358,9 +2010,24 @@ func TestServerConfig_SetRamdiskSize(t *testing.T) {
 			extraConfig: func(c *Server) *Server {
 				return c.WithNrHugepages(16896)
 			},
-			// error indicates min RAM needed = 40 + 4 gib per engine
-			expErr: storage.FaultRamdiskLowMem("Total", storage.MinRamdiskMem,
-				humanize.GiByte*50, humanize.GiByte*46),
+			// error indicates min RAM needed = 42 + 4 gib per engine
+			expErr: errors.New("want 50 GiB RAM but only have 46 GiB"),
+		},
+		"low mem; margin set": {
+			// 60 total - (33 huge + 5 sys rsv + 4 engine rsv + 18 margin) = 0 for tmpfs
+			memTotBytes: humanize.GiByte * 60,
+			extraConfig: func(c *Server) *Server {
+				return c.WithNrHugepages(16896).WithRamdiskMemMargin(30)
+			},
+			expErr: errors.New("want 68 GiB RAM but only have 60 GiB"),
+		},
+		"auto-calculated value set; margin set": {
+			// 60 total - (33 huge + 5 sys rsv + 4 engine rsv + 6 margin) = 12 for tmpfs
+			memTotBytes: humanize.GiByte * 60,
+			extraConfig: func(c *Server) *Server {
+				return c.WithNrHugepages(16896).WithRamdiskMemMargin(10)
+			},
+			expRamdiskSize: 6,
 		},
 		"custom value set": {
 			memTotBytes: humanize.GiByte * 60,
@@ -1491,8 +2158,8 @@ func TestServerConfig_SetRamdiskSize(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
 			defer test.ShowBufferOnFailure(t, buf)
 
-			// Apply test case changes to basic config
-			cfg := tc.extraConfig(baseCfg(t, log, testFile))
+			// Apply test case changes to basic config, ignoring margin from example file.
+			cfg := tc.extraConfig(baseCfg(t, log, testFile).WithRamdiskMemMargin(0))
 
 			val := tc.memTotBytes / humanize.KiByte
 			if val > math.MaxInt {
@@ -1944,6 +2611,22 @@ func TestServerConfig_validateMultiEngineConfig(t *testing.T) {
 				),
 			expErr: FaultConfigOverlappingBdevDeviceList(1, 0),
 		},
+		"bdev_spares overlaps bdev_list of other engine": {
+			configA: configA().
+				AppendStorage(
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddr(1)),
+				),
+			configB: configB().
+				AppendStorage(
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddr(2)).
+						WithBdevSpares(test.MockPCIAddr(1)),
+				),
+			expErr: FaultConfigOverlappingBdevDeviceList(1, 0),
+		},
 		"duplicates in bdev_list": {
 			configA: configA().
 				AppendStorage(
@@ -2343,3 +3026,205 @@ func TestConfig_HasPMem(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_ValidateScmPlacement(t *testing.T) {
+	pmemEngine := func(numa uint, devs ...string) *engine.Config {
+		return engine.MockConfig().
+			WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmMountPoint(fmt.Sprintf("/mnt/daos%d", numa)).
+					WithScmDeviceList(devs...),
+			).
+			WithPinnedNumaNode(numa)
+	}
+	mockNs := func(idx int32, numa uint32, size uint64) *storage.ScmNamespace {
+		ns := storage.MockScmNamespace(idx)
+		ns.NumaNode = numa
+		ns.Size = size
+		return ns
+	}
+
+	for name, tc := range map[string]struct {
+		cfg        *Server
+		namespaces storage.ScmNamespaces
+		expErr     error
+	}{
+		"no engines": {
+			cfg: DefaultServer(),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, humanize.TByte),
+			},
+		},
+		"ram class": {
+			cfg: DefaultServer().WithEngines(
+				engine.MockConfig().
+					WithStorage(
+						storage.NewTierConfig().
+							WithStorageClass(storage.ClassRam.String()).
+							WithScmMountPoint("/mnt/daos0"),
+					).
+					WithPinnedNumaNode(1),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, humanize.TByte),
+			},
+		},
+		"balanced": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, humanize.TByte),
+				mockNs(1, 1, humanize.TByte),
+			},
+		},
+		"namespace missing from scan": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, humanize.TByte),
+			},
+		},
+		"numa mismatch": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 1, humanize.TByte),
+				mockNs(1, 0, humanize.TByte),
+			},
+			expErr: FaultConfigScmNumaMismatch(0, "/dev/pmem0", 1, 0),
+		},
+		"imbalance within default threshold": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, 100*humanize.GiByte),
+				mockNs(1, 1, 95*humanize.GiByte),
+			},
+		},
+		"imbalance exceeds default threshold": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, 100*humanize.GiByte),
+				mockNs(1, 1, 80*humanize.GiByte),
+			},
+			expErr: FaultConfigScmCapacityImbalance(1, 80*humanize.GiByte, 0,
+				100*humanize.GiByte, DefaultScmImbalance),
+		},
+		"imbalance within configured threshold": {
+			cfg: DefaultServer().
+				WithScmImbalance(25).
+				WithEngines(
+					pmemEngine(0, "/dev/pmem0"),
+					pmemEngine(1, "/dev/pmem1"),
+				),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, 100*humanize.GiByte),
+				mockNs(1, 1, 80*humanize.GiByte),
+			},
+		},
+		"multiple namespaces per engine": {
+			cfg: DefaultServer().WithEngines(
+				pmemEngine(0, "/dev/pmem0", "/dev/pmem2"),
+				pmemEngine(1, "/dev/pmem1"),
+			),
+			namespaces: storage.ScmNamespaces{
+				mockNs(0, 0, 100*humanize.GiByte),
+				mockNs(1, 1, 100*humanize.GiByte),
+				mockNs(2, 0, 100*humanize.GiByte),
+			},
+			expErr: FaultConfigScmCapacityImbalance(1, 100*humanize.GiByte, 0,
+				200*humanize.GiByte, DefaultScmImbalance),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			gotErr := tc.cfg.ValidateScmPlacement(log, tc.namespaces)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestConfig_ScmUnlockPassphraseFile(t *testing.T) {
+	testDir := t.TempDir()
+
+	writeHelper := func(t *testing.T, name, script string) string {
+		t.Helper()
+		path := filepath.Join(testDir, name)
+		if err := os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\n"), 0700); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	for name, tc := range map[string]struct {
+		cfg        *ScmUnlockConfig
+		expPath    string
+		expContent string
+		expErr     error
+	}{
+		"key file": {
+			cfg:     &ScmUnlockConfig{KeyFile: "/etc/daos/pmem_passphrase"},
+			expPath: "/etc/daos/pmem_passphrase",
+		},
+		"kmip helper": {
+			cfg: &ScmUnlockConfig{
+				KmipHelper: writeHelper(t, "good", `echo "secret-$1"`),
+				KmipKeyID:  "pmem",
+			},
+			expContent: "#ascii\npassphrase=secret-pmem\n",
+		},
+		"kmip helper fails": {
+			cfg: &ScmUnlockConfig{
+				KmipHelper: writeHelper(t, "fail", "exit 1"),
+				KmipKeyID:  "pmem",
+			},
+			expErr: errors.New("fetch passphrase"),
+		},
+		"kmip helper returns empty passphrase": {
+			cfg: &ScmUnlockConfig{
+				KmipHelper: writeHelper(t, "empty", "echo"),
+				KmipKeyID:  "pmem",
+			},
+			expErr: errors.New("empty passphrase"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path, cleanup, err := tc.cfg.PassphraseFile()
+			defer cleanup()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if tc.expPath != "" {
+				test.AssertEqual(t, tc.expPath, path, "unexpected passphrase file")
+				return
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expContent, string(content), "unexpected file content")
+
+			cleanup()
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Fatalf("expected passphrase file to be removed, got %v", err)
+			}
+		})
+	}
+}
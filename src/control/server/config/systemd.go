@@ -0,0 +1,155 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// SystemdDropInPath is the location at which the generated drop-in is expected to be installed.
+const SystemdDropInPath = "/etc/systemd/system/daos_server.service.d/50-daos-engines.conf"
+
+// systemdDeviceUnit returns the name of the systemd device unit for a network interface, escaped
+// as per systemd-escape(1).
+func systemdDeviceUnit(iface string) string {
+	var bld strings.Builder
+	for i, r := range iface {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_',
+			r == ':', r == '.' && i > 0:
+			bld.WriteRune(r)
+		default:
+			fmt.Fprintf(&bld, "\\x%02x", r)
+		}
+	}
+
+	return fmt.Sprintf("sys-subsystem-net-devices-%s.device", bld.String())
+}
+
+// systemdCPUList formats CPU core IDs as a list of ranges, e.g. "0-3,8-11".
+func systemdCPUList(cores []uint) string {
+	sorted := make([]uint, len(cores))
+	copy(sorted, cores)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var ranges []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			ranges = append(ranges, fmt.Sprint(sorted[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+
+	return strings.Join(ranges, ",")
+}
+
+func systemdLimit(rl engine.Rlimit) string {
+	if rl == engine.RlimitUnlimited {
+		return "infinity"
+	}
+	return rl.String()
+}
+
+// SystemdDropIn renders a systemd drop-in for the daos_server service that matches the engines
+// in the config. Limits are raised to the largest value requested by any engine and the service
+// is ordered after the engine fabric interfaces and, if configured, hugepage setup. If core
+// allocations are supplied, the service is confined to the allocated CPU cores.
+func (cfg *Server) SystemdDropIn(allocs []*engine.CoreAllocation) (string, error) {
+	if len(cfg.Engines) == 0 {
+		return "", errors.New("no engines configured")
+	}
+
+	var after []string
+	seen := make(map[string]bool)
+	addAfter := func(unit string) {
+		if !seen[unit] {
+			seen[unit] = true
+			after = append(after, unit)
+		}
+	}
+	if cfg.NrHugepages > 0 && !cfg.DisableHugepages {
+		addAfter("dev-hugepages.mount")
+	}
+
+	var limits [3]*engine.Rlimit
+	var limitNotes []string
+	for idx, ec := range cfg.Engines {
+		ifaces, err := ec.Fabric.GetInterfaces()
+		if err != nil {
+			return "", errors.Wrapf(err, "engine %d", idx)
+		}
+		for _, iface := range ifaces {
+			addAfter(systemdDeviceUnit(iface))
+		}
+
+		if ec.Rlimits == nil {
+			continue
+		}
+		for i, rl := range []*engine.Rlimit{ec.Rlimits.MemLock, ec.Rlimits.NoFile,
+			ec.Rlimits.Core} {
+			if rl != nil && (limits[i] == nil || *rl > *limits[i]) {
+				limits[i] = rl
+			}
+		}
+		limitNotes = append(limitNotes, fmt.Sprintf("# engine %d rlimits: memlock=%s "+
+			"nofile=%s core=%s", idx, rlimitOrUnset(ec.Rlimits.MemLock),
+			rlimitOrUnset(ec.Rlimits.NoFile), rlimitOrUnset(ec.Rlimits.Core)))
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "# Generated by \"daos_server config gen-systemd\"")
+	if cfg.Path != "" {
+		fmt.Fprintf(&bld, " from %s", cfg.Path)
+	}
+	fmt.Fprintf(&bld, ".\n# Install as %s and run \"systemctl daemon-reload\".\n",
+		SystemdDropInPath)
+
+	bld.WriteString("\n[Unit]\n")
+	for _, unit := range after {
+		fmt.Fprintf(&bld, "Wants=%s\nAfter=%s\n", unit, unit)
+	}
+
+	bld.WriteString("\n[Service]\n")
+	for _, note := range limitNotes {
+		bld.WriteString(note + "\n")
+	}
+	for i, key := range []string{"LimitMEMLOCK", "LimitNOFILE", "LimitCORE"} {
+		if limits[i] != nil {
+			fmt.Fprintf(&bld, "%s=%s\n", key, systemdLimit(*limits[i]))
+		}
+	}
+
+	if len(allocs) > 0 {
+		var cores []uint
+		for _, alloc := range allocs {
+			fmt.Fprintf(&bld, "# %s\n", alloc)
+			cores = append(cores, alloc.Cores()...)
+		}
+		fmt.Fprintf(&bld, "CPUAffinity=%s\n", systemdCPUList(cores))
+	}
+
+	return bld.String(), nil
+}
+
+func rlimitOrUnset(rl *engine.Rlimit) string {
+	if rl == nil {
+		return "-"
+	}
+	return rl.String()
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServerConfig_SystemdDropIn(t *testing.T) {
+	unlimited := engine.RlimitUnlimited
+	nofileLow := engine.Rlimit(4096)
+	nofileHigh := engine.Rlimit(65536)
+	numa0 := uint(0)
+
+	const header = `# Generated by "daos_server config gen-systemd" from /etc/daos/daos_server.yml.
+# Install as /etc/systemd/system/daos_server.service.d/50-daos-engines.conf and run "systemctl daemon-reload".
+`
+
+	for name, tc := range map[string]struct {
+		cfg    *Server
+		allocs []*engine.CoreAllocation
+		expOut string
+		expErr error
+	}{
+		"no engines": {
+			cfg:    DefaultServer(),
+			expErr: errors.New("no engines configured"),
+		},
+		"no fabric interface": {
+			cfg:    DefaultServer().WithEngines(engine.MockConfig()),
+			expErr: errors.New("engine 0: fabric_iface not set"),
+		},
+		"single engine; no limits": {
+			cfg: DefaultServer().WithEngines(
+				engine.MockConfig().WithFabricInterface("ib0"),
+			),
+			expOut: header + `
+[Unit]
+Wants=sys-subsystem-net-devices-ib0.device
+After=sys-subsystem-net-devices-ib0.device
+
+[Service]
+`,
+		},
+		"multiple engines; limits; hugepages; affinity": {
+			cfg: DefaultServer().WithNrHugepages(8192).WithEngines(
+				engine.MockConfig().WithFabricInterface("ib0").
+					WithRlimits(&engine.RlimitConfig{
+						MemLock: &unlimited,
+						NoFile:  &nofileLow,
+					}),
+				engine.MockConfig().WithFabricInterface("eth-1").
+					WithRlimits(&engine.RlimitConfig{
+						NoFile: &nofileHigh,
+					}),
+			),
+			allocs: []*engine.CoreAllocation{
+				{
+					EngineIdx:   0,
+					NumaNode:    &numa0,
+					SysCores:    []uint{0},
+					TargetCores: []uint{1, 2},
+				},
+				{
+					EngineIdx:   1,
+					SysCores:    []uint{8},
+					TargetCores: []uint{9, 10},
+					HelperCores: []uint{12},
+				},
+			},
+			expOut: header + `
+[Unit]
+Wants=dev-hugepages.mount
+After=dev-hugepages.mount
+Wants=sys-subsystem-net-devices-ib0.device
+After=sys-subsystem-net-devices-ib0.device
+Wants=sys-subsystem-net-devices-eth\x2d1.device
+After=sys-subsystem-net-devices-eth\x2d1.device
+
+[Service]
+# engine 0 rlimits: memlock=unlimited nofile=4096 core=-
+# engine 1 rlimits: memlock=- nofile=65536 core=-
+LimitMEMLOCK=infinity
+LimitNOFILE=65536
+# engine 0 (numa 0): sys [0], targets [1 2]
+# engine 1: sys [8], targets [9 10], helpers [12]
+CPUAffinity=0-2,8-10,12
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tc.cfg.Path = "/etc/daos/daos_server.yml"
+
+			gotOut, gotErr := tc.cfg.SystemdDropIn(tc.allocs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expOut, gotOut); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+const (
+	crashReportPrefix  = "engine"
+	crashReportSuffix  = ".json"
+	crashReportTimeFmt = "20060102T150405Z"
+	engineBinName      = "daos_engine"
+)
+
+// crashReport is the content of the report file written after an engine exits unexpectedly.
+type crashReport struct {
+	Time        string        `json:"time"`
+	Hostname    string        `json:"hostname"`
+	InstanceIdx uint32        `json:"instance_idx"`
+	Rank        ranklist.Rank `json:"rank"`
+	Incarnation uint64        `json:"incarnation"`
+	PID         int           `json:"pid"`
+	ExitStatus  string        `json:"exit_status"`
+	Signal      string        `json:"signal,omitempty"`
+	CoreDumped  bool          `json:"core_dumped"`
+	CoreDump    string        `json:"core_dump,omitempty"`
+	LogFile     string        `json:"log_file,omitempty"`
+	LogLines    []string      `json:"log_lines,omitempty"`
+}
+
+// crashReporter writes a crash report when an engine exits unexpectedly and publishes an event
+// referencing it.
+type crashReporter struct {
+	log      logging.Logger
+	cfg      *config.CrashReportConfig
+	hostname string
+	publish  func(*events.RASEvent)
+	procRoot string
+	getwd    func() (string, error)
+	now      func() time.Time
+}
+
+func newCrashReporter(log logging.Logger, cfg *config.CrashReportConfig, hostname string, publish func(*events.RASEvent)) *crashReporter {
+	return &crashReporter{
+		log:      log,
+		cfg:      cfg,
+		hostname: hostname,
+		publish:  publish,
+		procRoot: "/proc",
+		getwd:    os.Getwd,
+		now:      time.Now,
+	}
+}
+
+// exitSignal returns the signal that terminated the engine and whether a core dump was
+// written, if the exit was caused by a signal.
+func exitSignal(exitErr error) (string, bool) {
+	var ee *exec.ExitError
+	if !errors.As(exitErr, &ee) {
+		return "", false
+	}
+	ws, ok := ee.Sys().(syscall.WaitStatus)
+	if !ok || !ws.Signaled() {
+		return "", false
+	}
+
+	return ws.Signal().String(), ws.CoreDump()
+}
+
+// coreDumpLocation returns where the kernel will have written the core dump of the engine
+// process with the given PID, based on the configured core pattern. Only the PID, executable
+// and hostname specifiers are expanded.
+func (cr *crashReporter) coreDumpLocation(pid int) (string, error) {
+	pattern, err := os.ReadFile(filepath.Join(cr.procRoot, "sys/kernel/core_pattern"))
+	if err != nil {
+		return "", errors.Wrap(err, "read core pattern")
+	}
+	loc := strings.TrimSpace(string(pattern))
+
+	if strings.HasPrefix(loc, "|") {
+		handler := strings.Fields(strings.TrimPrefix(loc, "|"))
+		if len(handler) == 0 {
+			return "", errors.New("empty core pattern handler")
+		}
+		return fmt.Sprintf("piped to %s", handler[0]), nil
+	}
+
+	hasPID := strings.Contains(loc, "%p") || strings.Contains(loc, "%P")
+	loc = strings.NewReplacer(
+		"%%", "%",
+		"%p", strconv.Itoa(pid),
+		"%P", strconv.Itoa(pid),
+		"%e", engineBinName,
+		"%h", cr.hostname,
+	).Replace(loc)
+
+	if !hasPID {
+		usesPID, err := os.ReadFile(filepath.Join(cr.procRoot, "sys/kernel/core_uses_pid"))
+		if err == nil && strings.TrimSpace(string(usesPID)) == "1" {
+			loc += "." + strconv.Itoa(pid)
+		}
+	}
+
+	// Relative paths are relative to the working directory of the engine, which is inherited
+	// from daos_server.
+	if !filepath.IsAbs(loc) {
+		wd, err := cr.getwd()
+		if err != nil {
+			return "", errors.Wrap(err, "get working directory")
+		}
+		loc = filepath.Join(wd, loc)
+	}
+
+	return loc, nil
+}
+
+// tailLines returns up to the last n lines of the file at the given path, reading backwards
+// from the end of the file so that large engine logs are not read in full.
+func tailLines(path string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	const chunkSize = 64 * 1024
+	var buf []byte
+	offset := fi.Size()
+	for offset > 0 && bytes.Count(buf, []byte("\n")) <= n {
+		readSize := int64(chunkSize)
+		if offset < readSize {
+			readSize = offset
+		}
+		offset -= readSize
+
+		chunk := make([]byte, readSize)
+		if _, err := f.ReadAt(chunk, offset); err != nil && err != io.EOF {
+			return nil, err
+		}
+		buf = append(chunk, buf...)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(buf), "\n"), "\n")
+	if offset > 0 {
+		// The first line may have been read in part.
+		lines = lines[1:]
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	if len(lines) == 1 && lines[0] == "" {
+		return nil, nil
+	}
+
+	return lines, nil
+}
+
+// write writes the report to a new file in the crash report directory and removes the oldest
+// reports beyond the configured maximum. The path of the new report is returned.
+func (cr *crashReporter) write(report *crashReport, ts time.Time) (string, error) {
+	dir := cr.cfg.GetDir()
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return "", errors.Wrap(err, "create crash report directory")
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", errors.Wrap(err, "marshal crash report")
+	}
+
+	name := fmt.Sprintf("%s%d-%s-%d%s", crashReportPrefix, report.InstanceIdx,
+		ts.UTC().Format(crashReportTimeFmt), report.PID, crashReportSuffix)
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0640); err != nil {
+		return "", errors.Wrap(err, "write crash report")
+	}
+
+	if err := cr.prune(dir); err != nil {
+		cr.log.Errorf("pruning crash reports: %s", err)
+	}
+
+	return path, nil
+}
+
+// prune removes the oldest crash reports in the directory beyond the configured maximum.
+func (cr *crashReporter) prune(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	type reportFile struct {
+		path    string
+		modTime time.Time
+	}
+	var reports []reportFile
+	for _, ent := range entries {
+		name := ent.Name()
+		if ent.IsDir() || !strings.HasPrefix(name, crashReportPrefix) ||
+			!strings.HasSuffix(name, crashReportSuffix) {
+			continue
+		}
+		fi, err := ent.Info()
+		if err != nil {
+			continue
+		}
+		reports = append(reports, reportFile{filepath.Join(dir, name), fi.ModTime()})
+	}
+
+	excess := len(reports) - cr.cfg.GetMaxReports()
+	if excess <= 0 {
+		return nil
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].modTime.Equal(reports[j].modTime) {
+			return reports[i].path < reports[j].path
+		}
+		return reports[i].modTime.Before(reports[j].modTime)
+	})
+	for _, rf := range reports[:excess] {
+		if err := os.Remove(rf.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// report collects the details of an engine exit into a crash report, writes it and publishes
+// an event referencing it.
+func (cr *crashReporter) report(engineCfg *engine.Config, engineIdx uint32, rank ranklist.Rank, incarnation uint64, exitErr error, exPid int) error {
+	ts := cr.now()
+	report := &crashReport{
+		Time:        common.FormatTime(ts),
+		Hostname:    cr.hostname,
+		InstanceIdx: engineIdx,
+		Rank:        rank,
+		Incarnation: incarnation,
+		PID:         exPid,
+		ExitStatus:  exitErr.Error(),
+		LogFile:     engineCfg.LogFile,
+	}
+
+	report.Signal, report.CoreDumped = exitSignal(exitErr)
+	if report.CoreDumped {
+		loc, err := cr.coreDumpLocation(exPid)
+		if err != nil {
+			cr.log.Errorf("instance %d: unable to find core dump: %s", engineIdx, err)
+		}
+		report.CoreDump = loc
+	}
+
+	if report.LogFile != "" {
+		lines, err := tailLines(report.LogFile, cr.cfg.GetLogLines())
+		if err != nil {
+			cr.log.Errorf("instance %d: unable to read log file: %s", engineIdx, err)
+		}
+		report.LogLines = lines
+	}
+
+	path, err := cr.write(report, ts)
+	if err != nil {
+		return errors.Wrapf(err, "instance %d", engineIdx)
+	}
+	cr.log.Noticef("instance %d: crash report written to %s", engineIdx, path)
+
+	evt := events.NewEngineCrashReportedEvent(cr.hostname, rank.Uint32(), incarnation, exPid,
+		&events.EngineCrashInfo{
+			InstanceIdx: engineIdx,
+			ExitStatus:  report.ExitStatus,
+			Signal:      report.Signal,
+			CoreDump:    report.CoreDump,
+			ReportPath:  path,
+		})
+
+	// set forwardable if there is a rank so that the report is listed by the MS
+	cr.publish(evt.WithForwardable(!rank.Equals(ranklist.NilRank)))
+
+	return nil
+}
+
+// createCrashReportFunc returns onInstanceExitFn which will write a crash report for the
+// engine if it exited unexpectedly.
+func createCrashReportFunc(cr *crashReporter, ei *EngineInstance) onInstanceExitFn {
+	return func(_ context.Context, engineIdx uint32, rank ranklist.Rank, incarnation uint64, exitErr error, exPid int) error {
+		// Ignore exits requested by the harness and engines that failed to start.
+		if exitErr == nil || exPid == 0 || ei.stopRequested.Load() {
+			return nil
+		}
+
+		return cr.report(ei.runner.GetConfig(), engineIdx, rank, incarnation, exitErr, exPid)
+	}
+}
@@ -0,0 +1,351 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestServer_exitSignal(t *testing.T) {
+	killErr := exec.Command("sh", "-c", "kill -KILL $$").Run()
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+
+	for name, tc := range map[string]struct {
+		exitErr       error
+		expSignal     string
+		expCoreDumped bool
+	}{
+		"not an exit error": {
+			exitErr: errors.New("failed"),
+		},
+		"nonzero exit": {
+			exitErr: errors.Wrap(exitErr, "daos_engine exited"),
+		},
+		"killed": {
+			exitErr:   errors.Wrap(killErr, "daos_engine exited"),
+			expSignal: "killed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSignal, gotCoreDumped := exitSignal(tc.exitErr)
+
+			test.AssertEqual(t, tc.expSignal, gotSignal, "unexpected signal")
+			test.AssertEqual(t, tc.expCoreDumped, gotCoreDumped, "unexpected core dumped")
+		})
+	}
+}
+
+func TestServer_crashReporter_coreDumpLocation(t *testing.T) {
+	for name, tc := range map[string]struct {
+		corePattern string
+		coreUsesPID string
+		expLoc      string
+		expErr      error
+	}{
+		"no core pattern": {
+			expErr: errors.New("read core pattern"),
+		},
+		"piped to handler": {
+			corePattern: "|/usr/lib/systemd/systemd-coredump %P %u %g %s %t %c %h",
+			expLoc:      "piped to /usr/lib/systemd/systemd-coredump",
+		},
+		"absolute pattern": {
+			corePattern: "/var/crash/core.%e.%p.%h",
+			expLoc:      "/var/crash/core.daos_engine.1234.host1",
+		},
+		"relative pattern": {
+			corePattern: "core",
+			expLoc:      "/home/daos/core",
+		},
+		"relative pattern; uses pid": {
+			corePattern: "core",
+			coreUsesPID: "1",
+			expLoc:      "/home/daos/core.1234",
+		},
+		"unexpanded specifiers": {
+			corePattern: "/var/crash/core.%t.%%p",
+			expLoc:      "/var/crash/core.%t.%p",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			procRoot := t.TempDir()
+			kernelDir := filepath.Join(procRoot, "sys", "kernel")
+			if err := os.MkdirAll(kernelDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if tc.corePattern != "" {
+				writeTestFile(t, filepath.Join(kernelDir, "core_pattern"),
+					tc.corePattern+"\n")
+			}
+			if tc.coreUsesPID != "" {
+				writeTestFile(t, filepath.Join(kernelDir, "core_uses_pid"),
+					tc.coreUsesPID+"\n")
+			}
+
+			cr := newCrashReporter(log, &config.CrashReportConfig{}, "host1", nil)
+			cr.procRoot = procRoot
+			cr.getwd = func() (string, error) { return "/home/daos", nil }
+
+			gotLoc, gotErr := cr.coreDumpLocation(1234)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expLoc, gotLoc, "unexpected core dump location")
+		})
+	}
+}
+
+func TestServer_tailLines(t *testing.T) {
+	var longLog strings.Builder
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&longLog, "log line %d with some padding to fill the buffer\n", i)
+	}
+
+	for name, tc := range map[string]struct {
+		content  string
+		n        int
+		expLines []string
+	}{
+		"empty file": {
+			n: 5,
+		},
+		"zero lines": {
+			content: "a\nb\n",
+		},
+		"fewer lines than requested": {
+			content:  "a\nb\n",
+			n:        5,
+			expLines: []string{"a", "b"},
+		},
+		"last lines": {
+			content:  "a\nb\nc\nd\n",
+			n:        2,
+			expLines: []string{"c", "d"},
+		},
+		"no trailing newline": {
+			content:  "a\nb\nc",
+			n:        2,
+			expLines: []string{"b", "c"},
+		},
+		"spans read chunks": {
+			content: longLog.String(),
+			n:       3,
+			expLines: []string{
+				"log line 9997 with some padding to fill the buffer",
+				"log line 9998 with some padding to fill the buffer",
+				"log line 9999 with some padding to fill the buffer",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "engine.log")
+			writeTestFile(t, path, tc.content)
+
+			gotLines, err := tailLines(path, tc.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expLines, gotLines); diff != "" {
+				t.Fatalf("unexpected lines (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_createCrashReportFunc(t *testing.T) {
+	killErr := errors.Wrap(exec.Command("sh", "-c", "kill -KILL $$").Run(),
+		"/usr/bin/daos_engine exited")
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		exitErr       error
+		exPid         int
+		rank          ranklist.Rank
+		stopRequested bool
+		oldReports    int
+		expReport     *crashReport
+		expForward    bool
+		expReports    int
+	}{
+		"stop requested": {
+			exitErr:       killErr,
+			exPid:         1234,
+			rank:          1,
+			stopRequested: true,
+		},
+		"failed to start": {
+			exitErr: errors.New("format failed"),
+			rank:    1,
+		},
+		"engine killed": {
+			exitErr: killErr,
+			exPid:   1234,
+			rank:    1,
+			expReport: &crashReport{
+				Time:        "2025-01-02T15:04:05.000+00:00",
+				Hostname:    "host1",
+				InstanceIdx: 1,
+				Rank:        1,
+				Incarnation: 2,
+				PID:         1234,
+				ExitStatus:  "/usr/bin/daos_engine exited: signal: killed",
+				Signal:      "killed",
+				LogLines:    []string{"line 2", "line 3"},
+			},
+			expForward: true,
+			expReports: 1,
+		},
+		"no rank": {
+			exitErr: killErr,
+			exPid:   1234,
+			rank:    ranklist.NilRank,
+			expReport: &crashReport{
+				Time:        "2025-01-02T15:04:05.000+00:00",
+				Hostname:    "host1",
+				InstanceIdx: 1,
+				Rank:        ranklist.NilRank,
+				Incarnation: 2,
+				PID:         1234,
+				ExitStatus:  "/usr/bin/daos_engine exited: signal: killed",
+				Signal:      "killed",
+				LogLines:    []string{"line 2", "line 3"},
+			},
+			expReports: 1,
+		},
+		"old reports pruned": {
+			exitErr:    killErr,
+			exPid:      1234,
+			rank:       1,
+			oldReports: 3,
+			expReport: &crashReport{
+				Time:        "2025-01-02T15:04:05.000+00:00",
+				Hostname:    "host1",
+				InstanceIdx: 1,
+				Rank:        1,
+				Incarnation: 2,
+				PID:         1234,
+				ExitStatus:  "/usr/bin/daos_engine exited: signal: killed",
+				Signal:      "killed",
+				LogLines:    []string{"line 2", "line 3"},
+			},
+			expForward: true,
+			expReports: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir := t.TempDir()
+			reportDir := filepath.Join(testDir, "crash")
+			logFile := filepath.Join(testDir, "engine.log")
+			writeTestFile(t, logFile, "line 1\nline 2\nline 3\n")
+
+			if tc.oldReports > 0 {
+				if err := os.MkdirAll(reportDir, 0750); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i := 0; i < tc.oldReports; i++ {
+				old := filepath.Join(reportDir, fmt.Sprintf("engine0-old%d.json", i))
+				writeTestFile(t, old, "{}")
+				mtime := now.Add(-time.Duration(tc.oldReports-i) * time.Hour)
+				if err := os.Chtimes(old, mtime, mtime); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var published []*events.RASEvent
+			cr := newCrashReporter(log, &config.CrashReportConfig{
+				Dir:        reportDir,
+				LogLines:   2,
+				MaxReports: 2,
+			}, "host1", func(evt *events.RASEvent) {
+				published = append(published, evt)
+			})
+			cr.now = func() time.Time { return now }
+
+			runner := engine.NewTestRunner(nil, engine.MockConfig().WithLogFile(logFile))
+			ei := NewEngineInstance(log, nil, nil, runner, nil)
+			ei.stopRequested.Store(tc.stopRequested)
+
+			fn := createCrashReportFunc(cr, ei)
+			if err := fn(test.Context(t), 1, tc.rank, 2, tc.exitErr, tc.exPid); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.expReport == nil {
+				test.AssertEqual(t, 0, len(published), "unexpected events published")
+				if _, err := os.Stat(reportDir); !os.IsNotExist(err) {
+					t.Fatal("expected no crash report directory")
+				}
+				return
+			}
+
+			test.AssertEqual(t, 1, len(published), "unexpected number of events published")
+			evt := published[0]
+			test.AssertEqual(t, events.RASEngineCrashReported, evt.ID, "unexpected event ID")
+			test.AssertEqual(t, tc.expForward, evt.ShouldForward(), "unexpected forwarding state")
+
+			info, err := evt.GetEngineCrashInfo()
+			if err != nil {
+				t.Fatal(err)
+			}
+			expPath := filepath.Join(reportDir, "engine1-20250102T150405Z-1234.json")
+			test.AssertEqual(t, expPath, info.ReportPath, "unexpected report path")
+			test.AssertEqual(t, tc.expReport.Signal, info.Signal, "unexpected signal")
+
+			data, err := os.ReadFile(expPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotReport := new(crashReport)
+			if err := json.Unmarshal(data, gotReport); err != nil {
+				t.Fatal(err)
+			}
+			tc.expReport.LogFile = logFile
+			if diff := cmp.Diff(tc.expReport, gotReport); diff != "" {
+				t.Fatalf("unexpected report (-want, +got):\n%s\n", diff)
+			}
+
+			entries, err := os.ReadDir(reportDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expReports, len(entries), "unexpected number of reports kept")
+		})
+	}
+}
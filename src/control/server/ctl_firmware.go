@@ -136,6 +136,7 @@ func (svc *ControlService) updateSCM(pbReq *ctlpb.FirmwareUpdateReq, pbResp *ctl
 		FirmwareRev:  pbReq.FirmwareRev,
 		ModelID:      pbReq.ModelID,
 		DeviceUIDs:   pbReq.DeviceIDs,
+		Phase:        storage.ScmFirmwarePhase(pbReq.ScmPhase),
 	})
 	if err != nil {
 		return err
@@ -159,6 +160,8 @@ func (svc *ControlService) updateNVMe(pbReq *ctlpb.FirmwareUpdateReq, pbResp *ct
 		FirmwareRev:  pbReq.FirmwareRev,
 		ModelID:      pbReq.ModelID,
 		DeviceAddrs:  pbReq.DeviceIDs,
+		Slot:         int32(pbReq.Slot),
+		Stage:        pbReq.Stage,
 	})
 	if err != nil {
 		return err
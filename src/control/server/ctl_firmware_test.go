@@ -775,6 +775,36 @@ func TestCtlSvc_FirmwareUpdate(t *testing.T) {
 				},
 			},
 		},
+		"NVMe - invalid slot": {
+			req: ctlpb.FirmwareUpdateReq{
+				Type:         ctlpb.FirmwareUpdateReq_NVMe,
+				FirmwarePath: "/some/path",
+				Slot:         8,
+			},
+			bmbc: &bdev.MockBackendConfig{
+				ScanRes: &storage.BdevScanResponse{Controllers: mockNVMe},
+			},
+			expErr: errors.New("invalid firmware slot 8"),
+		},
+		"NVMe - staged to slot": {
+			req: ctlpb.FirmwareUpdateReq{
+				Type:         ctlpb.FirmwareUpdateReq_NVMe,
+				FirmwarePath: "/some/path",
+				DeviceIDs:    []string{"0000:01:00.0"},
+				Slot:         2,
+				Stage:        true,
+			},
+			bmbc: &bdev.MockBackendConfig{
+				ScanRes: &storage.BdevScanResponse{Controllers: mockNVMe},
+			},
+			expResp: &ctlpb.FirmwareUpdateResp{
+				NvmeResults: []*ctlpb.NvmeFirmwareUpdateResp{
+					{
+						PciAddr: mockNVMe[1].PciAddr,
+					},
+				},
+			},
+		},
 		"NVMe - specific devices": {
 			req: ctlpb.FirmwareUpdateReq{
 				Type:         ctlpb.FirmwareUpdateReq_NVMe,
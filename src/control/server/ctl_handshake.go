@@ -0,0 +1,58 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+)
+
+// serverFeatures returns the control API features supported by the server, including those
+// that are only available when enabled in the server config file.
+func (svc *ControlService) serverFeatures() []string {
+	var features []string
+	for _, feature := range build.ServerFeatures() {
+		features = append(features, feature.String())
+	}
+
+	if svc.srvCfg == nil {
+		return features
+	}
+	if tc := svc.srvCfg.TransportConfig; tc != nil && tc.TokenAuth != nil {
+		features = append(features, build.FeatureTokenAuth.String())
+	}
+	if svc.srvCfg.HTTPGateway != nil {
+		features = append(features, build.FeatureHTTPGateway.String())
+	}
+
+	return features
+}
+
+// Handshake reports the version of the server, the range of control API versions that it
+// interoperates with and the optional control API features that it supports.
+func (svc *ControlService) Handshake(ctx context.Context, req *ctlpb.HandshakeReq) (*ctlpb.HandshakeResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	if err := build.CheckAPIVersion(req.GetApiVersion()); err != nil {
+		return nil, FaultIncompatibleAPIVersion(req.GetApiVersion())
+	}
+	svc.log.Debugf("handshake from %s %s (control API version %d)", req.GetComponent(),
+		req.GetVersion(), req.GetApiVersion())
+
+	return &ctlpb.HandshakeResp{
+		Version:       build.DaosVersion,
+		ApiVersion:    build.ControlAPIVersion,
+		MinApiVersion: build.MinControlAPIVersion,
+		Features:      svc.serverFeatures(),
+	}, nil
+}
@@ -0,0 +1,101 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_CtlSvc_Handshake(t *testing.T) {
+	baseFeatures := func(extra ...build.Feature) []string {
+		var features []string
+		for _, f := range append(build.ServerFeatures(), extra...) {
+			features = append(features, f.String())
+		}
+		return features
+	}
+
+	for name, tc := range map[string]struct {
+		req     *ctlpb.HandshakeReq
+		cfg     *config.Server
+		expResp *ctlpb.HandshakeResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"legacy client": {
+			req: &ctlpb.HandshakeReq{},
+			expResp: &ctlpb.HandshakeResp{
+				Version:       build.DaosVersion,
+				ApiVersion:    build.ControlAPIVersion,
+				MinApiVersion: build.MinControlAPIVersion,
+				Features:      baseFeatures(),
+			},
+		},
+		"current client": {
+			req: &ctlpb.HandshakeReq{
+				Component:  build.ComponentAdmin.String(),
+				Version:    build.DaosVersion,
+				ApiVersion: build.ControlAPIVersion,
+			},
+			expResp: &ctlpb.HandshakeResp{
+				Version:       build.DaosVersion,
+				ApiVersion:    build.ControlAPIVersion,
+				MinApiVersion: build.MinControlAPIVersion,
+				Features:      baseFeatures(),
+			},
+		},
+		"optional features enabled": {
+			req: &ctlpb.HandshakeReq{
+				ApiVersion: build.ControlAPIVersion,
+			},
+			cfg: config.DefaultServer().
+				WithTransportConfig(&security.TransportConfig{
+					TokenAuth: &security.TokenAuthConfig{},
+				}).
+				WithHTTPGateway(&config.HTTPGatewayConfig{}),
+			expResp: &ctlpb.HandshakeResp{
+				Version:       build.DaosVersion,
+				ApiVersion:    build.ControlAPIVersion,
+				MinApiVersion: build.MinControlAPIVersion,
+				Features:      baseFeatures(build.FeatureTokenAuth, build.FeatureHTTPGateway),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.cfg == nil {
+				tc.cfg = config.DefaultServer()
+			}
+			cs := mockControlService(t, log, tc.cfg, nil, nil, nil)
+
+			gotResp, gotErr := cs.Handshake(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
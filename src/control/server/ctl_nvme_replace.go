@@ -0,0 +1,527 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+)
+
+const nvmeReplaceStateFile = "nvme_replace.json"
+
+// Drivers that an SSD is bound to when it is available for use by SPDK.
+var userspaceNvmeDrivers = common.NewStringSet("vfio-pci", "uio_pci_generic")
+
+// nvmeReplaceState records the progress of a guided NVMe SSD replacement so that it can be resumed
+// by a later request, including after a restart of the server.
+type nvmeReplaceState struct {
+	OldUUID    string                 `json:"old_uuid"`
+	OldPciAddr string                 `json:"old_pci_addr"`
+	OldSerial  string                 `json:"old_serial,omitempty"`
+	NewPciAddr string                 `json:"new_pci_addr,omitempty"`
+	NewUUID    string                 `json:"new_uuid,omitempty"`
+	CtrlrName  string                 `json:"ctrlr_name,omitempty"`
+	Rank       uint32                 `json:"rank"`
+	Stage      ctlpb.NvmeReplaceStage `json:"stage"`
+}
+
+func (st *nvmeReplaceState) toResp(info string) *ctlpb.NvmeReplaceResp {
+	return &ctlpb.NvmeReplaceResp{
+		Stage:      st.Stage,
+		Rank:       st.Rank,
+		OldUuid:    st.OldUUID,
+		OldPciAddr: st.OldPciAddr,
+		NewUuid:    st.NewUUID,
+		NewPciAddr: st.NewPciAddr,
+		Info:       info,
+	}
+}
+
+// nvmeReplaceStatePath returns the path of the file storing replacement progress, this is in the
+// control metadata directory if configured so that it survives a reboot.
+func (svc *ControlService) nvmeReplaceStatePath() string {
+	dir := svc.srvCfg.SocketDir
+	if svc.srvCfg.Metadata.HasPath() {
+		dir = svc.srvCfg.Metadata.Directory()
+	}
+
+	return filepath.Join(dir, nvmeReplaceStateFile)
+}
+
+func readNvmeReplaceStates(path string) (map[string]*nvmeReplaceState, error) {
+	states := make(map[string]*nvmeReplaceState)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return states, nil
+		}
+		return nil, errors.Wrap(err, "reading nvme replace state")
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, errors.Wrapf(err, "parsing nvme replace state in %s", path)
+	}
+
+	return states, nil
+}
+
+func writeNvmeReplaceStates(path string, states map[string]*nvmeReplaceState) error {
+	if len(states) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "removing nvme replace state")
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(common.WriteFileAtomic(path, data, 0600), "writing %s", path)
+}
+
+// findSmdDevice returns the first SSD known to the engine of the given rank that matches, or to any
+// engine if the rank is nil.
+func (svc *ControlService) findSmdDevice(ctx context.Context, rank ranklist.Rank, match func(*ctlpb.SmdDevice) bool) (Engine, *ctlpb.SmdDevice, error) {
+	req := &ctlpb.SmdQueryReq{Rank: rank.Uint32()}
+	resp := new(ctlpb.SmdQueryResp)
+	if err := svc.querySmdDevices(ctx, req, resp); err != nil {
+		return nil, nil, err
+	}
+
+	for _, rr := range resp.Ranks {
+		for _, dev := range rr.Devices {
+			if dev == nil || dev.Ctrlr == nil || !match(dev) {
+				continue
+			}
+			engines, err := svc.harness.FilterInstancesByRankSet(fmt.Sprintf("%d", rr.Rank))
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(engines) == 0 {
+				return nil, nil, errors.Errorf("failed to retrieve instance for rank %d",
+					rr.Rank)
+			}
+			return engines[0], dev, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// findNewDevice returns the SSD that the engine has detected in place of the one being replaced.
+func (svc *ControlService) findNewDevice(ctx context.Context, st *nvmeReplaceState) (Engine, *ctlpb.SmdDevice, error) {
+	return svc.findSmdDevice(ctx, ranklist.Rank(st.Rank), func(dev *ctlpb.SmdDevice) bool {
+		return dev.Uuid != st.OldUUID && dev.Ctrlr.PciAddr == st.NewPciAddr &&
+			dev.Ctrlr.DevState == ctlpb.NvmeDevState_NEW
+	})
+}
+
+func (svc *ControlService) rankEngine(rank uint32) (Engine, error) {
+	engines, err := svc.harness.FilterInstancesByRankSet(fmt.Sprintf("%d", rank))
+	if err != nil {
+		return nil, err
+	}
+	if len(engines) == 0 {
+		return nil, errors.Errorf("rank %d not found on this server", rank)
+	}
+
+	return engines[0], nil
+}
+
+// replaceSetFaulty marks the SSD being replaced as faulty so that the engine evicts it and stops
+// using it. Nothing is done if the SSD has already been evicted.
+func (svc *ControlService) replaceSetFaulty(ctx context.Context, st *nvmeReplaceState) error {
+	engine, dev, err := svc.findSmdDevice(ctx, ranklist.NilRank, func(dev *ctlpb.SmdDevice) bool {
+		return dev.Uuid == st.OldUUID
+	})
+	if err != nil {
+		return err
+	}
+	if dev == nil {
+		return errors.Errorf("device %s not found", st.OldUUID)
+	}
+
+	rank, err := engine.GetRank()
+	if err != nil {
+		return errors.Wrap(err, "retrieving engine rank")
+	}
+	st.Rank = rank.Uint32()
+	st.OldPciAddr = dev.Ctrlr.PciAddr
+	st.OldSerial = dev.Ctrlr.Serial
+	if st.NewPciAddr == "" {
+		st.NewPciAddr = st.OldPciAddr
+	}
+
+	if dev.Ctrlr.DevState == ctlpb.NvmeDevState_EVICTED {
+		svc.log.Debugf("device %s already evicted", st.OldUUID)
+		return nil
+	}
+
+	res, err := sendManageReq(ctx, engine, daos.MethodSetFaultyState,
+		&ctlpb.SetFaultyReq{Uuid: st.OldUUID})
+	if err != nil {
+		return errors.Wrap(err, "set-faulty")
+	}
+
+	return errors.Wrap(checkDaosStatus(res.Status), "set-faulty")
+}
+
+// replaceIdentify blinks the locate LED of the SSD being replaced. Failure is not fatal as the SSD
+// may not have a manageable LED.
+func (svc *ControlService) replaceIdentify(ctx context.Context, st *nvmeReplaceState, mins uint32) {
+	req := &ctlpb.SmdManageReq{
+		Op: &ctlpb.SmdManageReq_Led{
+			Led: &ctlpb.LedManageReq{
+				LedAction:       ctlpb.LedAction_SET,
+				LedState:        ctlpb.LedState_QUICK_BLINK,
+				LedDurationMins: mins,
+			},
+		},
+	}
+
+	rankResps, err := svc.multiDevSmdManage(ctx, req, st.OldPciAddr)
+	if err != nil {
+		svc.log.Noticef("locate led of %s not set: %s", st.OldPciAddr, err)
+		return
+	}
+	for _, rr := range rankResps {
+		for _, res := range rr.Results {
+			if res.Status != 0 || res.GetDevice().GetCtrlr().GetLedState() == ctlpb.LedState_NA {
+				svc.log.Noticef("locate led of %s not set (status %d)", st.OldPciAddr,
+					res.Status)
+			}
+		}
+	}
+}
+
+// replaceBindNew waits for the new SSD to appear and binds it to a user-space driver. A non-empty
+// string is returned if the new SSD is not yet available.
+func (svc *ControlService) replaceBindNew(ctx context.Context, st *nvmeReplaceState) (string, error) {
+	_, dev, err := svc.findNewDevice(ctx, st)
+	if err != nil {
+		return "", err
+	}
+	if dev != nil {
+		svc.log.Debugf("new device %s already in use by engine", st.NewPciAddr)
+		return "", nil
+	}
+
+	if svc.scanSysfsBdevs == nil {
+		return "", errors.New("sysfs nvme scan unavailable")
+	}
+	ctrlrs, err := svc.scanSysfsBdevs()
+	if err != nil {
+		return "", errors.Wrap(err, "scanning nvme devices in sysfs")
+	}
+
+	var found *storage.NvmeController
+	for _, c := range ctrlrs {
+		if c.PciAddr == st.NewPciAddr {
+			found = c
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Sprintf("insert new SSD at %s", st.NewPciAddr), nil
+	}
+
+	// A newly inserted SSD is claimed by the kernel driver, so one bound to a user-space
+	// driver in the old SSD's slot is the old SSD.
+	oldPresent := (st.OldSerial != "" && found.Serial == st.OldSerial) ||
+		(st.NewPciAddr == st.OldPciAddr && userspaceNvmeDrivers.Has(found.Driver))
+	if oldPresent {
+		return fmt.Sprintf("remove SSD %s and insert new SSD at %s", st.OldUUID,
+			st.NewPciAddr), nil
+	}
+	if userspaceNvmeDrivers.Has(found.Driver) {
+		return "", nil
+	}
+
+	resp, err := svc.StorageNvmeRebind(ctx, &ctlpb.NvmeRebindReq{PciAddr: st.NewPciAddr})
+	if err != nil {
+		return "", err
+	}
+	if resp.GetState().GetError() != "" {
+		return "", errors.New(resp.GetState().GetError())
+	}
+
+	return "", nil
+}
+
+// replaceConfigure regenerates the engine's NVMe config with the new SSD in place of the old one
+// when the new SSD is at a different address and attaches the new SSD to the running engine. A
+// non-empty string is returned if the engine has to be restarted to use the new SSD.
+func (svc *ControlService) replaceConfigure(ctx context.Context, st *nvmeReplaceState) (string, error) {
+	if st.NewPciAddr == st.OldPciAddr {
+		return "", nil
+	}
+
+	engine, err := svc.rankEngine(st.Rank)
+	if err != nil {
+		return "", err
+	}
+	engineStorage := engine.GetStorage()
+	tiers := engineStorage.GetBdevConfigs()
+
+	if tier := findBdevTier(st.OldPciAddr, tiers); tier != nil {
+		// Record the name of the old SSD's controller before it is renamed in the config.
+		if st.CtrlrName == "" {
+			req, err := engineStorage.NvmeConfigRequest(ctx, svc.log, nil)
+			if err != nil {
+				return "", err
+			}
+			if st.CtrlrName, err = bdev.ControllerName(req, st.OldPciAddr); err != nil {
+				return "", err
+			}
+		}
+
+		svc.log.Debugf("bdev list to be updated: %+v", tier.Bdev.DeviceList)
+		if err := tier.Bdev.DeviceList.Replace(st.OldPciAddr, st.NewPciAddr); err != nil {
+			return "", errors.Wrapf(err, "updating bdev list for tier %d", tier.Tier)
+		}
+		svc.log.Debugf("updated bdev list: %+v", tier.Bdev.DeviceList)
+	} else if findBdevTier(st.NewPciAddr, tiers) == nil {
+		return "", errors.Errorf("device %s not in bdev config of engine %d", st.OldPciAddr,
+			engine.Index())
+	}
+
+	if err := engineStorage.WriteNvmeConfig(ctx, svc.log, nil); err != nil {
+		return "", errors.Wrapf(err, "write nvme config for engine %d", engine.Index())
+	}
+
+	return svc.replaceAttach(ctx, engine, st)
+}
+
+// replaceAttach attaches the new SSD to the running engine through the engine's SPDK JSON-RPC
+// server, reusing the name of the old SSD's controller which is detached first. A non-empty string
+// is returned if the engine has to be restarted to pick up the new SSD from its NVMe config.
+func (svc *ControlService) replaceAttach(ctx context.Context, engine Engine, st *nvmeReplaceState) (string, error) {
+	_, dev, err := svc.findNewDevice(ctx, st)
+	if err != nil {
+		return "", err
+	}
+	if dev != nil {
+		svc.log.Debugf("new device %s already in use by engine", st.NewPciAddr)
+		return "", nil
+	}
+
+	restartInfo := fmt.Sprintf("restart rank %d to use new SSD at %s then repeat the request",
+		st.Rank, st.NewPciAddr)
+
+	// The SPDK JSON-RPC server is disabled by default and may not be enabled in release
+	// builds, the engine reads the new SSD from its NVMe config when restarted.
+	engineStorage := engine.GetStorage()
+	if !engineStorage.SpdkRpcSrvEnabled() {
+		svc.log.Noticef("spdk json-rpc server not enabled for engine %d, new device %s "+
+			"can't be attached at runtime", engine.Index(), st.NewPciAddr)
+		return restartInfo, nil
+	}
+	if st.CtrlrName == "" {
+		return "", errors.Errorf("controller name of device %s not recorded", st.OldPciAddr)
+	}
+
+	// The old SSD's controller has normally already been removed on hot-remove.
+	if err := engineStorage.DetachBdevController(st.CtrlrName); err != nil {
+		svc.log.Debugf("detach controller %s: %s", st.CtrlrName, err)
+	}
+
+	// The new SSD is already in the engine's NVMe config so a restart is the only other way to
+	// bring it into use, the replacement stays at the current stage until the engine detects it.
+	resp, err := engineStorage.AttachBdevController(st.CtrlrName, st.NewPciAddr)
+	if err != nil {
+		svc.log.Errorf("attach new device %s to engine %d: %s", st.NewPciAddr,
+			engine.Index(), err)
+		return fmt.Sprintf("attaching new SSD failed (%s), %s", err, restartInfo), nil
+	}
+	svc.log.Debugf("attached new device %s as controller %s with bdevs %v", st.NewPciAddr,
+		st.CtrlrName, resp.Bdevs)
+
+	return "", nil
+}
+
+// replaceDevice replaces the old SSD with the new one in the engine, which reintegrates the targets
+// using the SSD. A non-empty string is returned if the engine has not yet detected the new SSD.
+func (svc *ControlService) replaceDevice(ctx context.Context, st *nvmeReplaceState) (string, error) {
+	engine, dev, err := svc.findNewDevice(ctx, st)
+	if err != nil {
+		return "", err
+	}
+	if dev == nil {
+		return fmt.Sprintf("waiting for rank %d to detect new SSD at %s", st.Rank,
+			st.NewPciAddr), nil
+	}
+	st.NewUUID = dev.Uuid
+
+	res, err := replaceDevRetryBusy(ctx, svc.log, engine, &ctlpb.DevReplaceReq{
+		OldDevUuid: st.OldUUID,
+		NewDevUuid: st.NewUUID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "dev-replace")
+	}
+	if err := checkDaosStatus(res.Status); err != nil {
+		return "", errors.Wrap(err, "dev-replace")
+	}
+
+	if svc.slotLeds != nil {
+		svc.slotLeds.manage(&ctlpb.LedManageReq{LedAction: ctlpb.LedAction_RESET},
+			st.OldPciAddr)
+	}
+
+	return "", nil
+}
+
+// advanceNvmeReplace performs the remaining stages of a replacement, saving progress after each.
+// A non-empty string is returned if a stage can't be completed until some action has been taken.
+func (svc *ControlService) advanceNvmeReplace(ctx context.Context, req *ctlpb.NvmeReplaceReq, st *nvmeReplaceState, save func() error) (string, error) {
+	for st.Stage != ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE {
+		var info string
+		var err error
+
+		switch st.Stage {
+		case ctlpb.NvmeReplaceStage_NVME_REPLACE_STARTED:
+			err = svc.replaceSetFaulty(ctx, st)
+		case ctlpb.NvmeReplaceStage_NVME_REPLACE_FAULTY:
+			svc.replaceIdentify(ctx, st, req.IdentifyMins)
+		case ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE:
+			info, err = svc.replaceBindNew(ctx, st)
+		case ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND:
+			info, err = svc.replaceConfigure(ctx, st)
+		case ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED:
+			info, err = svc.replaceDevice(ctx, st)
+		default:
+			return "", errors.Errorf("unknown replace stage %d", st.Stage)
+		}
+		if err != nil || info != "" {
+			if saveErr := save(); saveErr != nil {
+				svc.log.Error(saveErr.Error())
+			}
+			return info, err
+		}
+
+		st.Stage++
+		svc.log.Debugf("replacement of device %s reached stage %s", st.OldUUID, st.Stage)
+		if err := save(); err != nil {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+// StorageNvmeReplace replaces a faulty SSD in a guided sequence of stages. The device is set
+// faulty, its locate LED is blinked while waiting for the new SSD which is then bound to a
+// user-space driver and added to the engine's NVMe config before the engine is told to replace
+// the old device and reintegrate its targets.
+//
+// Progress is stored on the server and a repeated request resumes from the last completed stage.
+func (svc *ControlService) StorageNvmeReplace(ctx context.Context, req *ctlpb.NvmeReplaceReq) (*ctlpb.NvmeReplaceResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+	if svc.srvCfg == nil {
+		return nil, errNoSrvCfg
+	}
+	if _, err := uuid.Parse(req.OldUuid); err != nil {
+		return nil, errors.Errorf("invalid device uuid %q", req.OldUuid)
+	}
+	if req.NewPciAddr != "" {
+		addr, err := hardware.NewPCIAddress(req.NewPciAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "new device pci address")
+		}
+		req.NewPciAddr = addr.String()
+	}
+	if !svc.harness.isStarted() {
+		return nil, FaultHarnessNotStarted
+	}
+	if len(svc.harness.readyRanks()) == 0 {
+		return nil, FaultDataPlaneNotStarted
+	}
+
+	svc.nvmeReplaceMu.Lock()
+	defer svc.nvmeReplaceMu.Unlock()
+
+	path := svc.nvmeReplaceStatePath()
+	states, err := readNvmeReplaceStates(path)
+	if err != nil {
+		return nil, err
+	}
+	save := func() error {
+		return writeNvmeReplaceStates(path, states)
+	}
+
+	st, inProgress := states[req.OldUuid]
+	if req.Abort {
+		if !inProgress {
+			return nil, errors.Errorf("no replacement of device %s in progress", req.OldUuid)
+		}
+		delete(states, req.OldUuid)
+		if err := save(); err != nil {
+			return nil, err
+		}
+		if svc.slotLeds != nil {
+			svc.slotLeds.manage(&ctlpb.LedManageReq{LedAction: ctlpb.LedAction_RESET},
+				st.OldPciAddr)
+		}
+		return st.toResp("replacement aborted"), nil
+	}
+
+	if !inProgress {
+		st = &nvmeReplaceState{OldUUID: req.OldUuid}
+		states[req.OldUuid] = st
+	}
+	if req.NewPciAddr != "" && req.NewPciAddr != st.NewPciAddr {
+		if st.Stage >= ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND {
+			return nil, errors.Errorf("new device %s already bound, abort the "+
+				"replacement to use a different device", st.NewPciAddr)
+		}
+		st.NewPciAddr = req.NewPciAddr
+	}
+
+	info, err := svc.advanceNvmeReplace(ctx, req, st, save)
+	if err != nil {
+		err = errors.Wrapf(err, "replace device %s after stage %s", req.OldUuid, st.Stage)
+		svc.log.Error(err.Error())
+
+		resp := st.toResp("")
+		resp.State = &ctlpb.ResponseState{
+			Error:  err.Error(),
+			Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+		}
+		return resp, nil
+	}
+
+	if st.Stage == ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE {
+		delete(states, req.OldUuid)
+		if err := save(); err != nil {
+			return nil, err
+		}
+		if st.NewPciAddr != st.OldPciAddr {
+			info = fmt.Sprintf("update bdev_list in server config file, replacing %s "+
+				"with %s", st.OldPciAddr, st.NewPciAddr)
+		}
+	}
+
+	return st.toResp(info), nil
+}
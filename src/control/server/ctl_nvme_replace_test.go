@@ -0,0 +1,495 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+	"github.com/daos-stack/daos/src/control/server/storage/scm"
+)
+
+func TestServer_CtlSvc_StorageNvmeReplace(t *testing.T) {
+	dev := func(idx int32, state ctlpb.NvmeDevState, serial string) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid: test.MockUUID(idx),
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  test.MockPCIAddr(1),
+				Serial:   serial,
+				DevState: state,
+			},
+		}
+	}
+	oldDev := dev(1, ctlpb.NvmeDevState_NORMAL, "old")
+	evictedDev := dev(1, ctlpb.NvmeDevState_EVICTED, "old")
+	unpluggedDev := dev(1, ctlpb.NvmeDevState_UNPLUGGED, "old")
+	newDev := dev(2, ctlpb.NvmeDevState_NEW, "new")
+
+	smdResp := func(devs ...*ctlpb.SmdDevice) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.SmdDevResp{Devices: devs},
+		}
+	}
+	manageResp := func(status daos.Status) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.DevManageResp{Status: status.Int32()},
+		}
+	}
+	ledResp := &mockDrpcResponse{
+		Message: &ctlpb.DevManageResp{
+			Device: &ctlpb.SmdDevice{
+				Ctrlr: &ctlpb.NvmeController{
+					PciAddr:  test.MockPCIAddr(1),
+					LedState: ctlpb.LedState_QUICK_BLINK,
+				},
+			},
+		},
+	}
+	state := func(stage ctlpb.NvmeReplaceStage) *nvmeReplaceState {
+		return &nvmeReplaceState{
+			OldUUID:    test.MockUUID(1),
+			OldPciAddr: test.MockPCIAddr(1),
+			OldSerial:  "old",
+			NewPciAddr: test.MockPCIAddr(1),
+			Stage:      stage,
+		}
+	}
+	hostname, _ := os.Hostname()
+	ctrlrName := fmt.Sprintf("Nvme_%s_0_1_0", hostname)
+	movedState := func(stage ctlpb.NvmeReplaceStage, name string) *nvmeReplaceState {
+		st := state(stage)
+		st.NewPciAddr = test.MockPCIAddr(2)
+		st.CtrlrName = name
+		return st
+	}
+	movedDev := &ctlpb.SmdDevice{
+		Uuid: test.MockUUID(2),
+		Ctrlr: &ctlpb.NvmeController{
+			PciAddr:  test.MockPCIAddr(2),
+			Serial:   "new",
+			DevState: ctlpb.NvmeDevState_NEW,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		req         *ctlpb.NvmeReplaceReq
+		states      map[string]*nvmeReplaceState
+		drpcResps   []*mockDrpcResponse
+		sysfsCtrlrs storage.NvmeControllers
+		rpcSrv      bool
+		bmbc        *bdev.MockBackendConfig
+		expResp     *ctlpb.NvmeReplaceResp
+		expErr      error
+		expStates   map[string]*nvmeReplaceState
+		expAttach   []storage.BdevAttachRequest
+		expDetach   []storage.BdevDetachRequest
+	}{
+		"nil request": {
+			expErr: errNilReq,
+		},
+		"invalid uuid": {
+			req:    &ctlpb.NvmeReplaceReq{OldUuid: "foo"},
+			expErr: errors.New("invalid device uuid"),
+		},
+		"invalid new pci address": {
+			req: &ctlpb.NvmeReplaceReq{
+				OldUuid:    test.MockUUID(1),
+				NewPciAddr: "foo",
+			},
+			expErr: errors.New("new device pci address"),
+		},
+		"abort; not in progress": {
+			req: &ctlpb.NvmeReplaceReq{
+				OldUuid: test.MockUUID(1),
+				Abort:   true,
+			},
+			expErr: errors.New("no replacement of device"),
+		},
+		"abort": {
+			req: &ctlpb.NvmeReplaceReq{
+				OldUuid: test.MockUUID(1),
+				Abort:   true,
+			},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(1),
+				Info:       "replacement aborted",
+			},
+		},
+		"new address after new device bound": {
+			req: &ctlpb.NvmeReplaceReq{
+				OldUuid:    test.MockUUID(1),
+				NewPciAddr: test.MockPCIAddr(2),
+			},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND),
+			},
+			expErr: errors.New("already bound"),
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND),
+			},
+		},
+		"device not found": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(newDev),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error: "replace device " + test.MockUUID(1) +
+						" after stage NVME_REPLACE_STARTED: device " +
+						test.MockUUID(1) + " not found",
+				},
+				OldUuid: test.MockUUID(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): {OldUUID: test.MockUUID(1)},
+			},
+		},
+		"set-faulty fails": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(oldDev),
+				manageResp(daos.IOError),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error: "replace device " + test.MockUUID(1) +
+						" after stage NVME_REPLACE_STARTED: set-faulty: " +
+						daos.IOError.Error(),
+				},
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_STARTED),
+			},
+		},
+		"old device still present": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(evictedDev),
+				smdResp(evictedDev),
+				ledResp,
+				smdResp(evictedDev),
+			},
+			sysfsCtrlrs: storage.NvmeControllers{
+				{PciAddr: test.MockPCIAddr(1), Driver: "vfio-pci"},
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(1),
+				Info: "remove SSD " + test.MockUUID(1) + " and insert new SSD at " +
+					test.MockPCIAddr(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE),
+			},
+		},
+		"resume; new device not inserted": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(1),
+				Info:       "insert new SSD at " + test.MockPCIAddr(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE),
+			},
+		},
+		"resume; new device rebound; not yet detected by engine": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_AWAIT_DEVICE),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev),
+				smdResp(unpluggedDev),
+			},
+			sysfsCtrlrs: storage.NvmeControllers{
+				{PciAddr: test.MockPCIAddr(1), Driver: "nvme", Serial: "new"},
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(1),
+				Info: "waiting for rank 0 to detect new SSD at " +
+					test.MockPCIAddr(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED),
+			},
+		},
+		"complete replacement in same slot": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(oldDev),
+				manageResp(daos.Success),
+				smdResp(evictedDev),
+				ledResp,
+				smdResp(evictedDev, newDev),
+				smdResp(evictedDev, newDev),
+				manageResp(daos.Success),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewUuid:    test.MockUUID(2),
+				NewPciAddr: test.MockPCIAddr(1),
+			},
+		},
+		"dev-replace fails": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): state(ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev, newDev),
+				manageResp(daos.IOError),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error: "replace device " + test.MockUUID(1) +
+						" after stage NVME_REPLACE_CONFIGURED: dev-replace: " +
+						daos.IOError.Error(),
+				},
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewUuid:    test.MockUUID(2),
+				NewPciAddr: test.MockPCIAddr(1),
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): func() *nvmeReplaceState {
+					st := state(ctlpb.NvmeReplaceStage_NVME_REPLACE_CONFIGURED)
+					st.NewUUID = test.MockUUID(2)
+					return st
+				}(),
+			},
+		},
+		"new address; rpc server disabled; restart required": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND, ""),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(2),
+				Info: "restart rank 0 to use new SSD at " + test.MockPCIAddr(2) +
+					" then repeat the request",
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND,
+					ctrlrName),
+			},
+		},
+		"new address; attached to running engine": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND, ""),
+			},
+			rpcSrv: true,
+			bmbc: &bdev.MockBackendConfig{
+				DetachErr: errors.New("no such controller"),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev),
+				smdResp(unpluggedDev, movedDev),
+				manageResp(daos.Success),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewUuid:    test.MockUUID(2),
+				NewPciAddr: test.MockPCIAddr(2),
+				Info: "update bdev_list in server config file, replacing " +
+					test.MockPCIAddr(1) + " with " + test.MockPCIAddr(2),
+			},
+			expDetach: []storage.BdevDetachRequest{
+				{SockAddr: storage.DefaultSpdkRpcSockAddr, DeviceName: ctrlrName},
+			},
+			expAttach: []storage.BdevAttachRequest{
+				{
+					SockAddr:   storage.DefaultSpdkRpcSockAddr,
+					DeviceName: ctrlrName,
+					PciAddr:    test.MockPCIAddr(2),
+				},
+			},
+		},
+		"new address; attach fails; restart required": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND, ""),
+			},
+			rpcSrv: true,
+			bmbc: &bdev.MockBackendConfig{
+				AttachErr: errors.New("bad attach"),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewPciAddr: test.MockPCIAddr(2),
+				Info: "attaching new SSD failed (bad attach), restart rank 0 to use " +
+					"new SSD at " + test.MockPCIAddr(2) + " then repeat the request",
+			},
+			expStates: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND,
+					ctrlrName),
+			},
+			expDetach: []storage.BdevDetachRequest{
+				{SockAddr: storage.DefaultSpdkRpcSockAddr, DeviceName: ctrlrName},
+			},
+			expAttach: []storage.BdevAttachRequest{
+				{
+					SockAddr:   storage.DefaultSpdkRpcSockAddr,
+					DeviceName: ctrlrName,
+					PciAddr:    test.MockPCIAddr(2),
+				},
+			},
+		},
+		"resume after restart; new device detected": {
+			req: &ctlpb.NvmeReplaceReq{OldUuid: test.MockUUID(1)},
+			states: map[string]*nvmeReplaceState{
+				test.MockUUID(1): movedState(ctlpb.NvmeReplaceStage_NVME_REPLACE_BOUND,
+					ctrlrName),
+			},
+			drpcResps: []*mockDrpcResponse{
+				smdResp(unpluggedDev, movedDev),
+				smdResp(unpluggedDev, movedDev),
+				manageResp(daos.Success),
+			},
+			expResp: &ctlpb.NvmeReplaceResp{
+				Stage:      ctlpb.NvmeReplaceStage_NVME_REPLACE_DONE,
+				OldUuid:    test.MockUUID(1),
+				OldPciAddr: test.MockPCIAddr(1),
+				NewUuid:    test.MockUUID(2),
+				NewPciAddr: test.MockPCIAddr(2),
+				Info: "update bdev_list in server config file, replacing " +
+					test.MockPCIAddr(1) + " with " + test.MockPCIAddr(2),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().
+				WithEngines(engine.MockConfig().
+					WithTargetCount(1).
+					WithStorage(
+						storage.NewTierConfig().
+							WithStorageClass("ram").
+							WithScmRamdiskSize(16).
+							WithScmMountPoint("/mnt/daos"),
+						storage.NewTierConfig().
+							WithTier(1).
+							WithStorageClass("nvme").
+							WithBdevDeviceList(test.MockPCIAddr(1)),
+					).
+					WithStorageSpdkRpcSrvProps(tc.rpcSrv, ""))
+			cfg.SocketDir = t.TempDir()
+			bmb := bdev.NewMockBackend(tc.bmbc)
+			svc := newMockControlServiceFromBackends(t, log, cfg, bmb,
+				scm.NewMockBackend(nil), nil)
+			svc.harness.started.SetTrue()
+
+			for _, e := range svc.harness.instances {
+				ei := e.(*EngineInstance)
+				dcc := new(mockDrpcClientConfig)
+				for _, mock := range tc.drpcResps {
+					dcc.setSendMsgResponseList(t, mock)
+				}
+				mdc := newMockDrpcClient(dcc)
+				ei.getDrpcClientFn = func(s string) drpc.DomainSocketClient {
+					return mdc
+				}
+				ei.ready.SetTrue()
+			}
+			svc.scanSysfsBdevs = func() (storage.NvmeControllers, error) {
+				return tc.sysfsCtrlrs, nil
+			}
+
+			statePath := filepath.Join(cfg.SocketDir, nvmeReplaceStateFile)
+			if tc.states != nil {
+				if err := writeNvmeReplaceStates(statePath, tc.states); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotResp, gotErr := svc.StorageNvmeReplace(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == nil {
+				if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+					t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+				}
+			}
+
+			gotStates, err := readNvmeReplaceStates(statePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.expStates == nil {
+				tc.expStates = map[string]*nvmeReplaceState{}
+			}
+			if diff := cmp.Diff(tc.expStates, gotStates); diff != "" {
+				t.Fatalf("unexpected stored state (-want, +got)\n%s\n", diff)
+			}
+
+			if diff := cmp.Diff(tc.expAttach, bmb.AttachCalls); diff != "" {
+				t.Fatalf("unexpected attach calls (-want, +got)\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expDetach, bmb.DetachCalls); diff != "" {
+				t.Fatalf("unexpected detach calls (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
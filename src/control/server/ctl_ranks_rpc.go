@@ -9,9 +9,14 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
@@ -93,7 +98,11 @@ func (svc *ControlService) PrepShutdownRanks(ctx context.Context, req *ctlpb.Ran
 			rank, err := ei.GetRank()
 			svc.log.Debugf("skip prep-shutdown as rank %d is dead", rank)
 			// If rank is already dead, return successful result.
-			ch <- system.NewMemberResult(rank, err, system.MemberStateStopped)
+			res := system.NewMemberResult(rank, err, system.MemberStateStopped)
+			if err == nil {
+				res.Msg = "notify skipped (engine not ready)"
+			}
+			ch <- res
 			continue
 		}
 
@@ -101,7 +110,7 @@ func (svc *ControlService) PrepShutdownRanks(ctx context.Context, req *ctlpb.Ran
 			select {
 			case <-ctx.Done():
 				ch <- nil
-			case ch <- e.tryDrpc(ctx, daos.MethodPrepShutdown):
+			case ch <- svc.notifyShutdown(ctx, e):
 			}
 		}(ctx, ei)
 	}
@@ -127,6 +136,107 @@ func (svc *ControlService) PrepShutdownRanks(ctx context.Context, req *ctlpb.Ran
 	return resp, nil
 }
 
+// waitEngineIdle polls the number of RPCs being processed by the engine until there are none or
+// the timeout expires, returning the last number seen.
+func (svc *ControlService) waitEngineIdle(ctx context.Context, ei Engine, timeout time.Duration) (uint64, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		active, err := svc.getActiveRPCs(ctx, ei)
+		if err != nil {
+			return 0, err
+		}
+		if active == 0 || !time.Now().Before(deadline) {
+			return active, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(instanceUpdateDelay):
+		}
+	}
+}
+
+// drainEngine stops the engine from accepting new pool connections and then waits for its
+// in-flight I/O to complete, recording the outcome in the result message.
+func (svc *ControlService) drainEngine(ctx context.Context, ei Engine, timeout time.Duration) *system.MemberResult {
+	result := ei.tryDrpc(ctx, daos.MethodDrainRank)
+	if result == nil || result.Errored {
+		return result
+	}
+
+	active, err := svc.waitEngineIdle(ctx, ei, timeout)
+	switch {
+	case err != nil:
+		result.Msg = fmt.Sprintf("unable to check in-flight I/O: %s", err)
+	case active > 0:
+		result.Msg = fmt.Sprintf("timed out with %d RPCs in flight", active)
+	default:
+		result.Msg = "drained"
+	}
+
+	return result
+}
+
+// DrainRanks implements the method defined for the Management Service.
+//
+// Drain data-plane instance(s) managed by control-plane ahead of a controlled shutdown,
+// identified by unique rank(s). Each instance refuses new pool connections and is given up to
+// the requested timeout for in-flight I/O to complete. Instances that are still busy when the
+// timeout expires are reported as such rather than as failures.
+func (svc *ControlService) DrainRanks(ctx context.Context, req *ctlpb.RanksReq) (*ctlpb.RanksResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if len(req.GetRanks()) == 0 {
+		return nil, errors.New("no ranks specified in request")
+	}
+
+	instances, err := svc.harness.FilterInstancesByRankSet(req.GetRanks())
+	if err != nil {
+		return nil, errors.Wrap(err, "filtering instances by rank set")
+	}
+	timeout := time.Duration(req.GetDrainTimeout()) * time.Second
+
+	ch := make(chan *system.MemberResult, len(instances))
+	for _, ei := range instances {
+		if !ei.IsReady() {
+			rank, err := ei.GetRank()
+			svc.log.Debugf("skip drain as rank %d is dead", rank)
+			ch <- system.NewMemberResult(rank, err, system.MemberStateStopped)
+			continue
+		}
+
+		go func(ctx context.Context, e Engine) {
+			select {
+			case <-ctx.Done():
+				ch <- nil
+			case ch <- svc.drainEngine(ctx, e, timeout):
+			}
+		}(ctx, ei)
+	}
+
+	results := make(system.MemberResults, 0, len(instances))
+	for len(results) < len(instances) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case result := <-ch:
+			if result == nil {
+				return nil, errors.New("drain returned nil result")
+			}
+			results = append(results, result)
+		}
+	}
+
+	resp := &ctlpb.RanksResp{}
+	if err := convert.Types(results, &resp.Results); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // memberStateResults returns system member results reflecting whether the state
 // of the given member is equivalent to the supplied desired state value.
 func (svc *ControlService) memberStateResults(instances []Engine, tgtState system.MemberState, okMsg, failMsg string) (system.MemberResults, error) {
@@ -153,12 +263,62 @@ func (svc *ControlService) memberStateResults(instances []Engine, tgtState syste
 	return results, nil
 }
 
+// notifyShutdown notifies an engine of a controlled shutdown over dRPC, waiting up to the
+// configured notify timeout for the engine to acknowledge. An engine that fails to acknowledge in
+// time is reported as stopping so that the shutdown can escalate to signals.
+func (svc *ControlService) notifyShutdown(ctx context.Context, ei Engine) *system.MemberResult {
+	timeout := svc.srvCfg.EngineShutdown.GetNotifyTimeout()
+	notifyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	res := ei.tryDrpc(notifyCtx, daos.MethodPrepShutdown)
+	switch {
+	case res == nil || ctx.Err() != nil:
+		return res
+	case notifyCtx.Err() == context.DeadlineExceeded:
+		svc.log.Noticef("instance %d did not acknowledge shutdown notification in %s",
+			ei.Index(), timeout)
+		res = system.NewMemberResult(res.Rank, nil, system.MemberStateStopping)
+		res.Msg = fmt.Sprintf("notify timed out after %s", timeout)
+	case !res.Errored:
+		res.Msg = "notified"
+	}
+
+	return res
+}
+
+// waitForStop waits up to the given timeout for all instances to exit. An error is only
+// returned if the parent context is done before the timeout expires.
+func waitForStop(ctx context.Context, instances []Engine, timeout time.Duration) error {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// ignore poll result as state is checked by the caller after the timeout
+	_ = pollInstanceState(waitCtx, instances, func(e Engine) bool { return !e.IsStarted() })
+
+	return ctx.Err()
+}
+
+func signalName(sig os.Signal) string {
+	switch sig {
+	case syscall.SIGINT:
+		return "SIGINT"
+	case syscall.SIGKILL:
+		return "SIGKILL"
+	default:
+		return sig.String()
+	}
+}
+
 // StopRanks implements the method defined for the Management Service.
 //
 // Stop data-plane instance(s) managed by control-plane identified by unique
-// rank(s). After attempting to stop instances through harness (when either all
+// rank(s). Unless forced, instances are sent SIGINT and given the configured
+// flush timeout to exit before any that are still running are sent SIGKILL.
+// After attempting to stop instances through harness (when either all
 // instances are stopped or timeout has occurred), populate response results
-// based on local instance state.
+// based on local instance state and annotate them with how far the shutdown
+// of each instance progressed.
 func (svc *ControlService) StopRanks(ctx context.Context, req *ctlpb.RanksReq) (*ctlpb.RanksResp, error) {
 	if req == nil {
 		return nil, errors.New("nil request")
@@ -181,26 +341,83 @@ func (svc *ControlService) StopRanks(ctx context.Context, req *ctlpb.RanksReq) (
 	svc.events.DisableEventIDs(events.RASEngineDied)
 	defer svc.events.EnableEventIDs(events.RASEngineDied)
 
-	for _, ei := range instances {
-		if !ei.IsStarted() {
-			continue
+	// Stop is called on engines that are not running so that any pending automatic
+	// restart is cancelled; the signal is not sent to an engine that is not running.
+	progress := make([][]string, len(instances))
+	running := make([]bool, len(instances))
+	for i, ei := range instances {
+		running[i] = ei.IsStarted()
+		if !running[i] {
+			progress[i] = append(progress[i], "not running")
 		}
 		if err := ei.Stop(signal); err != nil {
 			return nil, errors.Wrapf(err, "sending %s", signal)
 		}
 	}
 
-	// ignore poll results as we gather state immediately after
-	pollFn := func(e Engine) bool { return !e.IsStarted() }
-	if err := pollInstanceState(ctx, instances, pollFn); err != nil {
+	timeouts := svc.srvCfg.EngineShutdown
+	if !req.Force {
+		if err := waitForStop(ctx, instances, timeouts.GetFlushTimeout()); err != nil {
+			return nil, errors.Wrap(err, "waiting for engines to stop")
+		}
+
+		// escalate to SIGKILL for engines that failed to flush and exit in time
+		for i, ei := range instances {
+			if !running[i] {
+				continue
+			}
+			if !ei.IsStarted() {
+				progress[i] = append(progress[i], fmt.Sprintf("exited after %s",
+					signalName(signal)))
+				running[i] = false
+				continue
+			}
+			svc.log.Noticef("instance %d still running %s after %s, sending %s", ei.Index(),
+				timeouts.GetFlushTimeout(), signal, syscall.SIGKILL)
+			progress[i] = append(progress[i], fmt.Sprintf("flush timed out after %s",
+				timeouts.GetFlushTimeout()))
+			if err := ei.Stop(syscall.SIGKILL); err != nil {
+				return nil, errors.Wrapf(err, "sending %s", syscall.SIGKILL)
+			}
+		}
+	}
+
+	if err := waitForStop(ctx, instances, timeouts.GetKillTimeout()); err != nil {
 		return nil, errors.Wrap(err, "waiting for engines to stop")
 	}
 
-	results, err := svc.memberStateResults(instances, system.MemberStateStopped, "system stop",
-		"system stop: rank failed to stop")
-	if err != nil {
-		return nil, err
+	results := make(system.MemberResults, 0, len(instances))
+	for i, ei := range instances {
+		if running[i] {
+			if ei.IsStarted() {
+				progress[i] = append(progress[i], fmt.Sprintf("still running %s after %s",
+					timeouts.GetKillTimeout(), signalName(syscall.SIGKILL)))
+			} else {
+				progress[i] = append(progress[i], fmt.Sprintf("exited after %s",
+					signalName(syscall.SIGKILL)))
+			}
+		}
+		msg := strings.Join(progress[i], ", ")
+		svc.log.Debugf("instance %d stop: %s", ei.Index(), msg)
+
+		rank, err := ei.GetRank()
+		if err != nil {
+			svc.log.Debugf("skip MemberResult, Instance %d GetRank(): %s", ei.Index(), err)
+			continue
+		}
+
+		if ei.LocalState() != system.MemberStateStopped {
+			results = append(results, system.NewMemberResult(rank,
+				errors.Errorf("system stop: rank failed to stop: %s", msg),
+				system.MemberStateErrored))
+			continue
+		}
+
+		res := system.NewMemberResult(rank, nil, system.MemberStateStopped)
+		res.Msg = msg
+		results = append(results, res)
 	}
+
 	resp := &ctlpb.RanksResp{}
 	if err := convert.Types(results, &resp.Results); err != nil {
 		return nil, err
@@ -232,6 +449,7 @@ func (svc *ControlService) ResetFormatRanks(ctx context.Context, req *ctlpb.Rank
 	}
 
 	savedRanks := make(map[uint32]ranklist.Rank) // instance idx to system rank
+	reports := make(map[uint32]string)           // instance idx to what was destroyed
 	for _, ei := range instances {
 		rank, err := ei.GetRank()
 		if err != nil {
@@ -245,6 +463,14 @@ func (svc *ControlService) ResetFormatRanks(ctx context.Context, req *ctlpb.Rank
 		if err := ei.RemoveSuperblock(); err != nil {
 			return nil, err
 		}
+		reports[ei.Index()] = "superblock removed"
+		if req.GetWipeScm() {
+			report, err := wipeScm(ei)
+			if err != nil {
+				return nil, err
+			}
+			reports[ei.Index()] += ", " + report
+		}
 		ei.requestStart(ctx)
 	}
 
@@ -263,7 +489,11 @@ func (svc *ControlService) ResetFormatRanks(ctx context.Context, req *ctlpb.Rank
 			err = errors.Errorf("want %s, got %s", system.MemberStateAwaitFormat, state)
 		}
 
-		results = append(results, system.NewMemberResult(savedRanks[ei.Index()], err, state))
+		result := system.NewMemberResult(savedRanks[ei.Index()], err, state)
+		if err == nil && req.GetWipeScm() {
+			result.Msg = reports[ei.Index()]
+		}
+		results = append(results, result)
 	}
 
 	resp := &ctlpb.RanksResp{}
@@ -274,6 +504,39 @@ func (svc *ControlService) ResetFormatRanks(ctx context.Context, req *ctlpb.Rank
 	return resp, nil
 }
 
+// wipeScm removes the contents of the engine's SCM mount and returns a description of what was
+// removed. Nothing is removed if SCM is not mounted so that the underlying directory is left
+// alone.
+func wipeScm(ei Engine) (string, error) {
+	scmCfg, err := ei.GetStorage().GetScmConfig()
+	if err != nil {
+		return "", err
+	}
+	mntPoint := scmCfg.Scm.MountPoint
+
+	mounted, err := ei.GetStorage().ScmIsMounted()
+	if err != nil {
+		return "", errors.Wrapf(err, "checking if %s is mounted", mntPoint)
+	}
+	if !mounted {
+		return fmt.Sprintf("SCM at %s not mounted, not wiped", mntPoint), nil
+	}
+
+	entries, err := os.ReadDir(mntPoint)
+	if err != nil {
+		return "", errors.Wrap(err, "wiping SCM")
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(mntPoint, entry.Name())); err != nil {
+			return "", errors.Wrap(err, "wiping SCM")
+		}
+	}
+	ei.Debugf("instance %d: removed %d entries from %s", ei.Index(), len(entries), mntPoint)
+
+	return fmt.Sprintf("SCM at %s wiped (%s removed)", mntPoint,
+		english.Plural(len(entries), "entry", "entries")), nil
+}
+
 // StartRanks implements the method defined for the Management Service.
 //
 // Start data-plane instance(s) managed by control-plane identified by unique
@@ -292,9 +555,25 @@ func (svc *ControlService) StartRanks(ctx context.Context, req *ctlpb.RanksReq)
 	if err != nil {
 		return nil, err
 	}
+	toStart := make([]Engine, 0, len(instances))
+	var standbyResults system.MemberResults
 	for _, ei := range instances {
 		ei.SetCheckerMode(req.CheckMode)
 
+		// Instances in standby are not started and so are not waited on.
+		if ei.IsStandby() && !ei.IsStarted() {
+			rank, err := ei.GetRank()
+			if err != nil {
+				svc.log.Debugf("skip MemberResult, Instance %d GetRank(): %s", ei.Index(), err)
+				continue
+			}
+			standbyResults = append(standbyResults, system.NewMemberResult(rank,
+				errors.Errorf("system start: engine %d is in standby mode", ei.Index()),
+				system.MemberStateStopped))
+			continue
+		}
+		toStart = append(toStart, ei)
+
 		if ei.IsStarted() {
 			continue
 		}
@@ -303,17 +582,18 @@ func (svc *ControlService) StartRanks(ctx context.Context, req *ctlpb.RanksReq)
 
 	// ignore poll results as we gather state immediately after
 	pollFn := func(e Engine) bool { return e.IsReady() }
-	if err := pollInstanceState(ctx, instances, pollFn); err != nil {
+	if err := pollInstanceState(ctx, toStart, pollFn); err != nil {
 		return nil, errors.Wrap(err, "waiting for engines to be ready to receive drpcs")
 	}
 
 	// instances will update state to "Started" through join or
 	// bootstrap in membership, here just make sure instances are "Ready"
-	results, err := svc.memberStateResults(instances, system.MemberStateReady, "system start",
+	results, err := svc.memberStateResults(toStart, system.MemberStateReady, "system start",
 		"system start: rank failed to start")
 	if err != nil {
 		return nil, err
 	}
+	results = append(results, standbyResults...)
 	resp := &ctlpb.RanksResp{}
 	if err := convert.Types(results, &resp.Results); err != nil {
 		return nil, err
@@ -360,6 +640,32 @@ func updateSetLogMasksReq(cfg *engine.Config, req *ctlpb.SetLogMasksReq) error {
 	return nil
 }
 
+// setEngineLogMasks calls into a single engine over dRPC to set log masks. Failure to set the masks
+// is reported in the returned message, an error is only returned if the engine response is invalid.
+func (svc *ControlService) setEngineLogMasks(ctx context.Context, idx int, ei Engine, eReq *ctlpb.SetLogMasksReq) (string, error) {
+	if err := updateSetLogMasksReq(svc.srvCfg.Engines[idx], eReq); err != nil {
+		return err.Error(), nil
+	}
+	svc.log.Debugf("setting engine %d log masks %q, streams %q and subsystems %q",
+		ei.Index(), eReq.Masks, eReq.Streams, eReq.Subsystems)
+
+	dresp, err := ei.CallDrpc(ctx, daos.MethodSetLogMasks, eReq)
+	if err != nil {
+		return err.Error(), nil
+	}
+
+	engineResp := new(ctlpb.SetLogMasksResp)
+	if err = proto.Unmarshal(dresp.Body, engineResp); err != nil {
+		return "", err
+	}
+
+	if engineResp.Status != 0 {
+		return daos.Status(engineResp.Status).Error(), nil
+	}
+
+	return "", nil
+}
+
 // SetEngineLogMasks calls into each engine over dRPC to set loglevel at runtime.
 func (svc *ControlService) SetEngineLogMasks(ctx context.Context, req *ctlpb.SetLogMasksReq) (*ctlpb.SetLogMasksResp, error) {
 	if req == nil {
@@ -378,28 +684,56 @@ func (svc *ControlService) SetEngineLogMasks(ctx context.Context, req *ctlpb.Set
 				idx, ei.Index())
 		}
 
-		if err := updateSetLogMasksReq(svc.srvCfg.Engines[idx], &eReq); err != nil {
-			resp.Errors[idx] = err.Error()
-			continue
-		}
-		svc.log.Debugf("setting engine %d log masks %q, streams %q and subsystems %q",
-			ei.Index(), eReq.Masks, eReq.Streams, eReq.Subsystems)
-
-		dresp, err := ei.CallDrpc(ctx, daos.MethodSetLogMasks, &eReq)
+		msg, err := svc.setEngineLogMasks(ctx, idx, ei, &eReq)
 		if err != nil {
-			resp.Errors[idx] = err.Error()
-			continue
-		}
-
-		engineResp := new(ctlpb.SetLogMasksResp)
-		if err = proto.Unmarshal(dresp.Body, engineResp); err != nil {
 			return nil, err
 		}
-
-		if engineResp.Status != 0 {
-			resp.Errors[idx] = daos.Status(engineResp.Status).Error()
-		}
+		resp.Errors[idx] = msg
 	}
 
 	return resp, nil
 }
+
+// ReloadConfig re-reads the server config file and applies changes to parameters that can be
+// updated without restarting engines.
+func (svc *ControlService) ReloadConfig(ctx context.Context, req *ctlpb.ReloadConfigReq) (*ctlpb.ReloadConfigResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if svc.reloadConfig == nil {
+		return nil, errors.New("config reload not supported")
+	}
+
+	changes, err := svc.reloadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctlpb.ReloadConfigResp{Changes: changes}, nil
+}
+
+// SetEngineStandby places an engine in or takes it out of standby mode. Engines in standby are
+// not started by the harness but their storage is still checked when a start is requested.
+func (svc *ControlService) SetEngineStandby(ctx context.Context, req *ctlpb.SetEngineStandbyReq) (*ctlpb.SetEngineStandbyResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+
+	instances := svc.harness.Instances()
+	if int(req.EngineIdx) >= len(instances) {
+		return nil, errors.Errorf("engine index %d out of range (%d engines)", req.EngineIdx,
+			len(instances))
+	}
+	ei := instances[req.EngineIdx]
+
+	if err := ei.SetStandby(req.Standby); err != nil {
+		return nil, err
+	}
+	if req.Standby {
+		svc.log.Noticef("engine %d placed in standby mode", req.EngineIdx)
+	} else {
+		svc.log.Noticef("engine %d taken out of standby mode", req.EngineIdx)
+	}
+
+	return &ctlpb.SetEngineStandbyResp{Standby: ei.IsStandby()}, nil
+}
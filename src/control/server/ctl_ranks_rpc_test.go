@@ -9,7 +9,10 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"testing"
@@ -29,6 +32,7 @@ import (
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	sysprov "github.com/daos-stack/daos/src/control/provider/system"
 	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -85,6 +89,7 @@ func TestServer_CtlSvc_PrepShutdownRanks(t *testing.T) {
 		junkResp         bool
 		drpcResps        []proto.Message
 		responseDelay    time.Duration
+		notifyTimeout    time.Duration
 		ctxTimeout       time.Duration
 		ctxCancel        time.Duration
 		expResults       []*sharedpb.RankResult
@@ -155,6 +160,19 @@ func TestServer_CtlSvc_PrepShutdownRanks(t *testing.T) {
 			},
 			expErr: context.Canceled,
 		},
+		"notify timeout": { // dRPC req-resp duration > notify timeout
+			req:           &ctlpb.RanksReq{Ranks: "0-3"},
+			responseDelay: 40 * time.Millisecond,
+			notifyTimeout: 10 * time.Millisecond,
+			drpcResps: []proto.Message{
+				&mgmtpb.DaosResp{Status: 0},
+				&mgmtpb.DaosResp{Status: 0},
+			},
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: stateString(system.MemberStateStopping)},
+				{Rank: 2, State: stateString(system.MemberStateStopping)},
+			},
+		},
 		"unsuccessful call": {
 			req: &ctlpb.RanksReq{Ranks: "0-3"},
 			drpcResps: []proto.Message{
@@ -185,7 +203,7 @@ func TestServer_CtlSvc_PrepShutdownRanks(t *testing.T) {
 			cfg := config.DefaultServer().WithEngines(
 				engine.MockConfig().WithTargetCount(1),
 				engine.MockConfig().WithTargetCount(1),
-			)
+			).WithEngineShutdown(&config.EngineShutdownConfig{NotifyTimeout: tc.notifyTimeout})
 			svc := mockControlService(t, log, cfg, nil, nil, nil)
 			for i, e := range svc.harness.instances {
 				srv := e.(*EngineInstance)
@@ -248,12 +266,157 @@ func TestServer_CtlSvc_PrepShutdownRanks(t *testing.T) {
 	}
 }
 
+func TestServer_CtlSvc_DrainRanks(t *testing.T) {
+	msStopping := stateString(system.MemberStateStopping)
+
+	for name, tc := range map[string]struct {
+		instancesStopped bool
+		req              *ctlpb.RanksReq
+		drpcResps        []proto.Message
+		activeRPCs       []uint64 // sequence of values returned for each engine
+		activeRPCsErr    error
+		expResults       []*sharedpb.RankResult
+		expErr           error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no ranks specified": {
+			req:    &ctlpb.RanksReq{},
+			expErr: errors.New("no ranks specified in request"),
+		},
+		"instances stopped already": {
+			req:              &ctlpb.RanksReq{Ranks: "0-3"},
+			instancesStopped: true,
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: msStopped},
+				{Rank: 2, State: msStopped},
+			},
+		},
+		"unsuccessful call": {
+			req: &ctlpb.RanksReq{Ranks: "0-3", DrainTimeout: 10},
+			drpcResps: []proto.Message{
+				&mgmtpb.DaosResp{Status: -1},
+				&mgmtpb.DaosResp{Status: -1},
+			},
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: msErrored, Errored: true},
+				{Rank: 2, State: msErrored, Errored: true},
+			},
+		},
+		"drained": {
+			req: &ctlpb.RanksReq{Ranks: "0-3", DrainTimeout: 10},
+			drpcResps: []proto.Message{
+				&mgmtpb.DaosResp{Status: 0},
+				&mgmtpb.DaosResp{Status: 0},
+			},
+			activeRPCs: []uint64{2, 0},
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: msStopping, Msg: "drained"},
+				{Rank: 2, State: msStopping, Msg: "drained"},
+			},
+		},
+		"drain timed out": {
+			req: &ctlpb.RanksReq{Ranks: "0-3"},
+			drpcResps: []proto.Message{
+				&mgmtpb.DaosResp{Status: 0},
+				&mgmtpb.DaosResp{Status: 0},
+			},
+			activeRPCs: []uint64{3},
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: msStopping, Msg: "timed out with 3 RPCs in flight"},
+				{Rank: 2, State: msStopping, Msg: "timed out with 3 RPCs in flight"},
+			},
+		},
+		"telemetry unavailable": {
+			req: &ctlpb.RanksReq{Ranks: "0-3", DrainTimeout: 10},
+			drpcResps: []proto.Message{
+				&mgmtpb.DaosResp{Status: 0},
+				&mgmtpb.DaosResp{Status: 0},
+			},
+			activeRPCsErr: errors.New("no telemetry"),
+			expResults: []*sharedpb.RankResult{
+				{
+					Rank: 1, State: msStopping,
+					Msg: "unable to check in-flight I/O: no telemetry",
+				},
+				{
+					Rank: 2, State: msStopping,
+					Msg: "unable to check in-flight I/O: no telemetry",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(
+				engine.MockConfig().WithTargetCount(1),
+				engine.MockConfig().WithTargetCount(1),
+			)
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+			for i, e := range svc.harness.instances {
+				srv := e.(*EngineInstance)
+
+				trc := &engine.TestRunnerConfig{}
+				if !tc.instancesStopped {
+					trc.Running.SetTrue()
+					srv.ready.SetTrue()
+				}
+				srv.runner = engine.NewTestRunner(trc, engine.MockConfig())
+				srv.setIndex(uint32(i))
+
+				srv._superblock.Rank = new(ranklist.Rank)
+				*srv._superblock.Rank = ranklist.Rank(i + 1)
+
+				cfg := new(mockDrpcClientConfig)
+				if len(tc.drpcResps) > i {
+					rb, _ := proto.Marshal(tc.drpcResps[i])
+					cfg.setSendMsgResponse(drpc.Status_SUCCESS, rb, nil)
+				}
+				srv.getDrpcClientFn = func(s string) drpc.DomainSocketClient {
+					return newMockDrpcClient(cfg)
+				}
+			}
+
+			var mu sync.Mutex
+			calls := make(map[uint32]int)
+			svc.getActiveRPCs = func(_ context.Context, e Engine) (uint64, error) {
+				if tc.activeRPCsErr != nil {
+					return 0, tc.activeRPCsErr
+				}
+				mu.Lock()
+				defer mu.Unlock()
+
+				idx := calls[e.Index()]
+				calls[e.Index()]++
+				if idx >= len(tc.activeRPCs) {
+					idx = len(tc.activeRPCs) - 1
+				}
+				return tc.activeRPCs[idx], nil
+			}
+
+			gotResp, gotErr := svc.DrainRanks(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			// order of results nondeterministic as drains run async
+			checkUnorderedRankResults(t, tc.expResults, gotResp.Results)
+		})
+	}
+}
+
 func TestServer_CtlSvc_StopRanks(t *testing.T) {
 	for name, tc := range map[string]struct {
 		missingSB         bool
 		engineCount       int
 		instancesStopped  bool
 		instancesDontStop bool
+		stopOnlyOnKill    bool
+		shutdown          *config.EngineShutdownConfig
 		req               *ctlpb.RanksReq
 		timeout           time.Duration
 		signal            os.Signal
@@ -282,16 +445,53 @@ func TestServer_CtlSvc_StopRanks(t *testing.T) {
 			req:            &ctlpb.RanksReq{Ranks: "0-3"},
 			expSignalsSent: map[uint32]os.Signal{0: syscall.SIGINT, 1: syscall.SIGINT},
 			expResults: []*sharedpb.RankResult{
-				{Rank: 1, State: msStopped},
-				{Rank: 2, State: msStopped},
+				{Rank: 1, State: msStopped, Msg: "exited after SIGINT"},
+				{Rank: 2, State: msStopped, Msg: "exited after SIGINT"},
 			},
 		},
 		"instances successfully stopped with force": {
 			req:            &ctlpb.RanksReq{Ranks: "0-3", Force: true},
 			expSignalsSent: map[uint32]os.Signal{0: syscall.SIGKILL, 1: syscall.SIGKILL},
 			expResults: []*sharedpb.RankResult{
-				{Rank: 1, State: msStopped},
-				{Rank: 2, State: msStopped},
+				{Rank: 1, State: msStopped, Msg: "exited after SIGKILL"},
+				{Rank: 2, State: msStopped, Msg: "exited after SIGKILL"},
+			},
+		},
+		"instances killed after flush timeout": {
+			req:            &ctlpb.RanksReq{Ranks: "0-3"},
+			shutdown:       &config.EngineShutdownConfig{FlushTimeout: time.Millisecond},
+			stopOnlyOnKill: true,
+			expSignalsSent: map[uint32]os.Signal{0: syscall.SIGKILL, 1: syscall.SIGKILL},
+			expResults: []*sharedpb.RankResult{
+				{
+					Rank: 1, State: msStopped,
+					Msg: "flush timed out after 1ms, exited after SIGKILL",
+				},
+				{
+					Rank: 2, State: msStopped,
+					Msg: "flush timed out after 1ms, exited after SIGKILL",
+				},
+			},
+		},
+		"instances fail to stop after kill": {
+			req: &ctlpb.RanksReq{Ranks: "0-3"},
+			shutdown: &config.EngineShutdownConfig{
+				FlushTimeout: time.Millisecond,
+				KillTimeout:  time.Millisecond,
+			},
+			instancesDontStop: true,
+			expSignalsSent:    map[uint32]os.Signal{0: syscall.SIGKILL, 1: syscall.SIGKILL},
+			expResults: []*sharedpb.RankResult{
+				{
+					Rank: 1, State: msErrored, Errored: true,
+					Msg: "system stop: rank failed to stop: flush timed out after " +
+						"1ms, still running 1ms after SIGKILL",
+				},
+				{
+					Rank: 2, State: msErrored, Errored: true,
+					Msg: "system stop: rank failed to stop: flush timed out after " +
+						"1ms, still running 1ms after SIGKILL",
+				},
 			},
 		},
 		"instances not stopped in time": {
@@ -305,15 +505,15 @@ func TestServer_CtlSvc_StopRanks(t *testing.T) {
 			req:              &ctlpb.RanksReq{Ranks: "0-3"},
 			instancesStopped: true,
 			expResults: []*sharedpb.RankResult{
-				{Rank: 1, State: msStopped},
-				{Rank: 2, State: msStopped},
+				{Rank: 1, State: msStopped, Msg: "not running"},
+				{Rank: 2, State: msStopped, Msg: "not running"},
 			},
 		},
 		"single instance already stopped": {
 			req:              &ctlpb.RanksReq{Ranks: "1"},
 			instancesStopped: true,
 			expResults: []*sharedpb.RankResult{
-				{Rank: 1, State: msStopped},
+				{Rank: 1, State: msStopped, Msg: "not running"},
 			},
 		},
 	} {
@@ -330,7 +530,7 @@ func TestServer_CtlSvc_StopRanks(t *testing.T) {
 			cfg := config.DefaultServer().WithEngines(
 				engine.MockConfig().WithTargetCount(1),
 				engine.MockConfig().WithTargetCount(1),
-			)
+			).WithEngineShutdown(tc.shutdown)
 			svc := mockControlService(t, log, cfg, nil, nil, nil)
 
 			ctx, outerCancel := context.WithCancel(test.Context(t))
@@ -363,7 +563,7 @@ func TestServer_CtlSvc_StopRanks(t *testing.T) {
 				}
 				trc.SignalCb = func(idx uint32, sig os.Signal) {
 					signalsSent.Store(idx, sig)
-					if tc.instancesDontStop {
+					if tc.instancesDontStop || (tc.stopOnlyOnKill && sig != syscall.SIGKILL) {
 						return
 					}
 					// simulate process exit which will call onInstanceExit handlers
@@ -396,7 +596,7 @@ func TestServer_CtlSvc_StopRanks(t *testing.T) {
 			}
 			test.AssertEqual(t, 0, len(subscriber.getRx()), "number of events published")
 
-			if diff := cmp.Diff(tc.expResults, gotResp.Results, defRankCmpOpts...); diff != "" {
+			if diff := cmp.Diff(tc.expResults, gotResp.Results, test.DefaultCmpOpts()...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
 
@@ -426,6 +626,7 @@ func TestServer_CtlSvc_ResetFormatRanks(t *testing.T) {
 		engineCount      int
 		instancesStarted bool
 		startFails       bool
+		scmMounted       bool
 		req              *ctlpb.RanksReq
 		timeout          time.Duration
 		expResults       []*sharedpb.RankResult
@@ -456,6 +657,33 @@ func TestServer_CtlSvc_ResetFormatRanks(t *testing.T) {
 				{Rank: 2, State: msWaitFormat},
 			},
 		},
+		"scm wiped": {
+			req:        &ctlpb.RanksReq{Ranks: "0-3", WipeScm: true},
+			scmMounted: true,
+			expResults: []*sharedpb.RankResult{
+				{
+					Rank: 1, State: msWaitFormat,
+					Msg: "superblock removed, SCM at %s wiped (2 entries removed)",
+				},
+				{
+					Rank: 2, State: msWaitFormat,
+					Msg: "superblock removed, SCM at %s wiped (2 entries removed)",
+				},
+			},
+		},
+		"scm not mounted": {
+			req: &ctlpb.RanksReq{Ranks: "0-3", WipeScm: true},
+			expResults: []*sharedpb.RankResult{
+				{
+					Rank: 1, State: msWaitFormat,
+					Msg: "superblock removed, SCM at %s not mounted, not wiped",
+				},
+				{
+					Rank: 2, State: msWaitFormat,
+					Msg: "superblock removed, SCM at %s not mounted, not wiped",
+				},
+			},
+		},
 		"instances stay stopped": {
 			req:        &ctlpb.RanksReq{Ranks: "0-3"},
 			startFails: true,
@@ -494,8 +722,11 @@ func TestServer_CtlSvc_ResetFormatRanks(t *testing.T) {
 							WithStorageClass("ram"),
 					),
 			)
-			svc := mockControlService(t, log, cfg, nil, nil, nil)
+			svc := mockControlService(t, log, cfg, nil, nil, &sysprov.MockSysConfig{
+				IsMountedBool: tc.scmMounted,
+			})
 
+			scmDirs := make([]string, len(svc.harness.instances))
 			for i, e := range svc.harness.instances {
 				ei := e.(*EngineInstance)
 				if tc.missingSB {
@@ -508,6 +739,16 @@ func TestServer_CtlSvc_ResetFormatRanks(t *testing.T) {
 				testDir, cleanup := test.CreateTestDir(t)
 				defer cleanup()
 				engineCfg.Storage.Tiers[0].Scm.MountPoint = testDir
+				scmDirs[i] = testDir
+				if err := os.Mkdir(filepath.Join(testDir, "pool"), 0700); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(testDir, "pool", "vos-0"), nil, 0600); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(testDir, "daos_nvme.conf"), nil, 0600); err != nil {
+					t.Fatal(err)
+				}
 
 				trc := &engine.TestRunnerConfig{}
 				if tc.instancesStarted {
@@ -554,9 +795,33 @@ func TestServer_CtlSvc_ResetFormatRanks(t *testing.T) {
 				return
 			}
 
+			for _, res := range tc.expResults {
+				if strings.Contains(res.Msg, "%s") {
+					res.Msg = fmt.Sprintf(res.Msg, scmDirs[res.Rank-1])
+				}
+			}
 			if diff := cmp.Diff(tc.expResults, gotResp.Results, defRankCmpOpts...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+			for i, res := range gotResp.Results {
+				test.AssertEqual(t, tc.expResults[i].Msg, res.Msg, "unexpected result message")
+			}
+
+			for _, dir := range scmDirs {
+				if dir == "" {
+					continue
+				}
+				entries, err := os.ReadDir(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if tc.scmMounted && len(entries) != 0 {
+					t.Fatalf("expected %s to be wiped, found %d entries", dir, len(entries))
+				}
+				if !tc.scmMounted && len(entries) != 2 {
+					t.Fatalf("expected %s to be left as is, found %d entries", dir, len(entries))
+				}
+			}
 		})
 	}
 }
@@ -567,6 +832,7 @@ func TestServer_CtlSvc_StartRanks(t *testing.T) {
 		engineCount      int
 		instancesStopped bool
 		startFails       bool
+		standby          bool
 		req              *ctlpb.RanksReq
 		timeout          time.Duration
 		expResults       []*sharedpb.RankResult
@@ -604,6 +870,15 @@ func TestServer_CtlSvc_StartRanks(t *testing.T) {
 				{Rank: 2, State: msReady},
 			},
 		},
+		"second instance in standby": {
+			req:              &ctlpb.RanksReq{Ranks: "0-3"},
+			instancesStopped: true,
+			standby:          true,
+			expResults: []*sharedpb.RankResult{
+				{Rank: 1, State: msReady},
+				{Rank: 2, State: msErrored, Errored: true},
+			},
+		},
 		"instances not started in time": {
 			req:              &ctlpb.RanksReq{Ranks: "0-3"},
 			timeout:          time.Second,
@@ -649,6 +924,7 @@ func TestServer_CtlSvc_StartRanks(t *testing.T) {
 				}
 				srv.runner = engine.NewTestRunner(trc, engine.MockConfig())
 				srv.setIndex(uint32(i))
+				srv.standby.Store(tc.standby && i == 1)
 
 				srv._superblock.Rank = new(ranklist.Rank)
 				*srv._superblock.Rank = ranklist.Rank(i + 1)
@@ -931,3 +1207,122 @@ func TestServer_CtlSvc_SetEngineLogMasks(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_CtlSvc_ReloadConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req          *ctlpb.ReloadConfigReq
+		reloadConfig func(context.Context) ([]string, error)
+		expResp      *ctlpb.ReloadConfigResp
+		expErr       error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"reload not supported": {
+			req:    new(ctlpb.ReloadConfigReq),
+			expErr: errors.New("not supported"),
+		},
+		"reload fails": {
+			req: new(ctlpb.ReloadConfigReq),
+			reloadConfig: func(context.Context) ([]string, error) {
+				return nil, errors.New("immutable")
+			},
+			expErr: errors.New("immutable"),
+		},
+		"changes applied": {
+			req: new(ctlpb.ReloadConfigReq),
+			reloadConfig: func(context.Context) ([]string, error) {
+				return []string{"telemetry_port: 9191 -> 9192"}, nil
+			},
+			expResp: &ctlpb.ReloadConfigResp{
+				Changes: []string{"telemetry_port: 9191 -> 9192"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := mockControlService(t, log, config.DefaultServer(), nil, nil, nil)
+			svc.reloadConfig = tc.reloadConfig
+
+			gotResp, gotErr := svc.ReloadConfig(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_SetEngineStandby(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *ctlpb.SetEngineStandbyReq
+		running    bool
+		standby    bool
+		expResp    *ctlpb.SetEngineStandbyResp
+		expStandby bool
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"engine index out of range": {
+			req:    &ctlpb.SetEngineStandbyReq{EngineIdx: 2, Standby: true},
+			expErr: errors.New("out of range"),
+		},
+		"enter standby": {
+			req:        &ctlpb.SetEngineStandbyReq{EngineIdx: 1, Standby: true},
+			expResp:    &ctlpb.SetEngineStandbyResp{Standby: true},
+			expStandby: true,
+		},
+		"enter standby; engine running": {
+			req:     &ctlpb.SetEngineStandbyReq{EngineIdx: 1, Standby: true},
+			running: true,
+			expErr:  errors.New("instance 1 is running"),
+		},
+		"leave standby": {
+			req:     &ctlpb.SetEngineStandbyReq{EngineIdx: 1},
+			standby: true,
+			expResp: &ctlpb.SetEngineStandbyResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(
+				engine.MockConfig().WithTargetCount(1),
+				engine.MockConfig().WithTargetCount(1),
+			)
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+			for i, e := range svc.harness.instances {
+				ei := e.(*EngineInstance)
+				trc := &engine.TestRunnerConfig{}
+				trc.Running.Store(tc.running)
+				ei.runner = engine.NewTestRunner(trc, engine.MockConfig())
+				ei.setIndex(uint32(i))
+				ei.standby.Store(tc.standby && i == 1)
+			}
+
+			gotResp, gotErr := svc.SetEngineStandby(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertEqual(t, tc.expStandby, svc.harness.instances[1].IsStandby(),
+				"unexpected standby state")
+			test.AssertFalse(t, svc.harness.instances[0].IsStandby(),
+				"unexpected standby state on other engine")
+		})
+	}
+}
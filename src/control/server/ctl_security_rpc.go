@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func newRevokedCertEvent(cert *x509.Certificate, peer string) *events.RASEvent {
+	msg := fmt.Sprintf("revoked certificate %q (serial %x) rejected: %s",
+		cert.Subject.CommonName, cert.SerialNumber, peer)
+
+	return events.NewGenericEvent(events.RASRevokedCertRejected, events.RASSeverityWarning, msg, "")
+}
+
+// createPublishRevokedCertFunc returns a function which will publish an event using the
+// provided publish function to indicate that a revoked peer certificate was rejected.
+func createPublishRevokedCertFunc(publish func(*events.RASEvent)) security.RevokedCertHandler {
+	return func(cert *x509.Certificate, peer string) {
+		publish(newRevokedCertEvent(cert, peer))
+	}
+}
+
+// ReloadCerts reloads the server certificate, key, CA certificate and certificate revocation
+// list from the paths in the server config file. New connections are made with the reloaded
+// certificates and the previously loaded certificates remain in use if the reload fails.
+func (svc *ControlService) ReloadCerts(ctx context.Context, req *ctlpb.ReloadCertsReq) (*ctlpb.ReloadCertsResp, error) {
+	if req == nil {
+		return nil, errors.New("nil request")
+	}
+	if svc.srvCfg == nil || svc.srvCfg.TransportConfig == nil {
+		return nil, errors.New("no transport config")
+	}
+
+	tc := svc.srvCfg.TransportConfig
+	if tc.AllowInsecure {
+		return nil, FaultCertsNotInUse
+	}
+
+	if err := tc.ReloadCertData(); err != nil {
+		return nil, errors.Wrap(err, "reloading certificates")
+	}
+	cert := tc.Certificate()
+	if cert == nil {
+		return nil, errors.New("no certificate loaded")
+	}
+	svc.log.Noticef("reloaded certificate %q (serial %x), valid until %s",
+		cert.Subject.CommonName, cert.SerialNumber, cert.NotAfter.Format(time.RFC3339))
+
+	return &ctlpb.ReloadCertsResp{
+		CommonName: cert.Subject.CommonName,
+		Serial:     fmt.Sprintf("%x", cert.SerialNumber),
+		NotBefore:  cert.NotBefore.Format(time.RFC3339),
+		NotAfter:   cert.NotAfter.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_CtlSvc_ReloadCerts(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *ctlpb.ReloadCertsReq
+		tc     *security.TransportConfig
+		expErr error
+	}{
+		"nil request": {
+			tc:     insecureTransportConfig(),
+			expErr: errors.New("nil request"),
+		},
+		"no transport config": {
+			req:    &ctlpb.ReloadCertsReq{},
+			expErr: errors.New("no transport config"),
+		},
+		"insecure": {
+			req:    &ctlpb.ReloadCertsReq{},
+			tc:     insecureTransportConfig(),
+			expErr: FaultCertsNotInUse,
+		},
+		"missing certificates": {
+			req: &ctlpb.ReloadCertsReq{},
+			tc: &security.TransportConfig{
+				CertificateConfig: security.CertificateConfig{
+					CARootPath:      "/does/not/exist/daosCA.crt",
+					CertificatePath: "/does/not/exist/server.crt",
+					PrivateKeyPath:  "/does/not/exist/server.key",
+				},
+			},
+			expErr: errors.New("was not found"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithTransportConfig(tc.tc)
+			cs := mockControlService(t, log, cfg, nil, nil, nil)
+
+			_, gotErr := cs.ReloadCerts(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_createPublishRevokedCertFunc(t *testing.T) {
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(31),
+		Subject:      pkix.Name{CommonName: "agent"},
+	}
+
+	var published []*events.RASEvent
+	createPublishRevokedCertFunc(func(evt *events.RASEvent) {
+		published = append(published, evt)
+	})(cert, "gRPC client 10.0.0.1:12345")
+
+	if len(published) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(published))
+	}
+	evt := published[0]
+	test.AssertEqual(t, events.RASRevokedCertRejected, evt.ID, "")
+	test.AssertEqual(t, events.RASSeverityWarning, evt.Severity, "")
+	test.AssertEqual(t, `revoked certificate "agent" (serial 1f) rejected: gRPC client 10.0.0.1:12345`,
+		evt.Msg, "")
+}
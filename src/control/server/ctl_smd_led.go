@@ -0,0 +1,191 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// Values of the attention indicator file in a PCIe hotplug slot's sysfs directory.
+const (
+	slotAttentionOff   = "0"
+	slotAttentionOn    = "1"
+	slotAttentionBlink = "2"
+)
+
+var errNoSlotAttention = errors.New("no hotplug slot with attention indicator")
+
+// slotLedManager drives the attention indicator of the PCIe hotplug slot holding an NVMe SSD. The
+// engine can only manage LEDs of SSDs behind a VMD controller so this provides a fallback for
+// directly attached SSDs.
+type slotLedManager struct {
+	sync.Mutex
+	log     logging.Logger
+	sysRoot string
+	timers  map[string]*time.Timer
+}
+
+func newSlotLedManager(log logging.Logger) *slotLedManager {
+	return &slotLedManager{
+		log:     log,
+		sysRoot: "/sys",
+		timers:  make(map[string]*time.Timer),
+	}
+}
+
+// attentionPath returns the path of the attention indicator of the slot holding the device.
+// Slot address files contain the domain, bus and device numbers but not the function.
+func (m *slotLedManager) attentionPath(pciAddr string) (string, error) {
+	addr, err := hardware.NewPCIAddress(pciAddr)
+	if err != nil {
+		return "", err
+	}
+	slotAddr := fmt.Sprintf("%04x:%02x:%02x", addr.Domain, addr.Bus, addr.Device)
+
+	addrPaths, err := filepath.Glob(filepath.Join(m.sysRoot, "bus", "pci", "slots", "*",
+		"address"))
+	if err != nil {
+		return "", err
+	}
+	for _, addrPath := range addrPaths {
+		buf, err := os.ReadFile(addrPath)
+		if err != nil || strings.TrimSpace(string(buf)) != slotAddr {
+			continue
+		}
+		attnPath := filepath.Join(filepath.Dir(addrPath), "attention")
+		if _, err := os.Stat(attnPath); err != nil {
+			break
+		}
+		return attnPath, nil
+	}
+
+	return "", errNoSlotAttention
+}
+
+func readSlotAttention(path string) (ctlpb.LedState, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return ctlpb.LedState_NA, err
+	}
+
+	switch strings.TrimSpace(string(buf)) {
+	case slotAttentionOff:
+		return ctlpb.LedState_OFF, nil
+	case slotAttentionOn:
+		return ctlpb.LedState_ON, nil
+	case slotAttentionBlink:
+		return ctlpb.LedState_QUICK_BLINK, nil
+	default:
+		return ctlpb.LedState_NA, nil
+	}
+}
+
+func writeSlotAttention(path string, state ctlpb.LedState) error {
+	var val string
+	switch state {
+	case ctlpb.LedState_OFF:
+		val = slotAttentionOff
+	case ctlpb.LedState_ON:
+		val = slotAttentionOn
+	case ctlpb.LedState_QUICK_BLINK:
+		val = slotAttentionBlink
+	default:
+		return errors.Errorf("led state %s not supported by hotplug slot", state)
+	}
+
+	return errors.Wrapf(os.WriteFile(path, []byte(val), 0644), "write %s", path)
+}
+
+func (m *slotLedManager) cancelTimer(pciAddr string) {
+	if timer, exists := m.timers[pciAddr]; exists {
+		timer.Stop()
+		delete(m.timers, pciAddr)
+	}
+}
+
+// expire turns off the identify blink on a device once its duration has elapsed, unless the LED
+// has been changed in the meantime.
+func (m *slotLedManager) expire(pciAddr, path string, timer *time.Timer) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.timers[pciAddr] != timer {
+		return
+	}
+	delete(m.timers, pciAddr)
+
+	if err := writeSlotAttention(path, ctlpb.LedState_OFF); err != nil {
+		m.log.Errorf("identify on %s expired but led could not be reset: %s", pciAddr, err)
+		return
+	}
+	m.log.Debugf("identify on %s expired, led reset", pciAddr)
+}
+
+// manage performs the LED action on the slot holding the device and returns the result. If the
+// slot has no attention indicator the result is returned with LED state NA, as the engine does
+// for devices that it can't manage.
+func (m *slotLedManager) manage(req *ctlpb.LedManageReq, pciAddr string) *ctlpb.SmdManageResp_Result {
+	m.Lock()
+	defer m.Unlock()
+
+	res := &ctlpb.SmdManageResp_Result{
+		Device: &ctlpb.SmdDevice{
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  pciAddr,
+				LedState: ctlpb.LedState_NA,
+			},
+		},
+	}
+
+	path, err := m.attentionPath(pciAddr)
+	if err != nil {
+		m.log.Debugf("led on %s can't be managed: %s", pciAddr, err)
+		return res
+	}
+
+	switch req.LedAction {
+	case ctlpb.LedAction_SET:
+		m.cancelTimer(pciAddr)
+		err = writeSlotAttention(path, req.LedState)
+		if err == nil && req.LedState == ctlpb.LedState_QUICK_BLINK && req.LedDurationMins != 0 {
+			dur := time.Duration(req.LedDurationMins) * time.Minute
+			var timer *time.Timer
+			timer = time.AfterFunc(dur, func() { m.expire(pciAddr, path, timer) })
+			m.timers[pciAddr] = timer
+		}
+	case ctlpb.LedAction_RESET:
+		m.cancelTimer(pciAddr)
+		err = writeSlotAttention(path, ctlpb.LedState_OFF)
+	}
+	if err != nil {
+		m.log.Errorf("led %s on %s failed: %s", req.LedAction, pciAddr, err)
+		res.Status = daos.IOError.Int32()
+		return res
+	}
+
+	state, err := readSlotAttention(path)
+	if err != nil {
+		m.log.Errorf("reading led state on %s failed: %s", pciAddr, err)
+		res.Status = daos.IOError.Int32()
+		return res
+	}
+	res.Device.Ctrlr.LedState = state
+
+	return res
+}
@@ -0,0 +1,214 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// mockSlotLedManager returns a manager using a fake sysfs tree with a single hotplug slot holding
+// the device at the given PCI address.
+func mockSlotLedManager(t *testing.T, log logging.Logger, pciAddr, attention string) *slotLedManager {
+	t.Helper()
+
+	sysRoot := t.TempDir()
+	slotDir := filepath.Join(sysRoot, "bus", "pci", "slots", "1")
+	if err := os.MkdirAll(slotDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	// Slot address omits the function number.
+	slotAddr := pciAddr[:strings.LastIndex(pciAddr, ".")]
+	if err := os.WriteFile(filepath.Join(slotDir, "address"), []byte(slotAddr+"\n"),
+		0644); err != nil {
+		t.Fatal(err)
+	}
+	if attention != "" {
+		if err := os.WriteFile(filepath.Join(slotDir, "attention"), []byte(attention+"\n"),
+			0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	m := newSlotLedManager(log)
+	m.sysRoot = sysRoot
+
+	return m
+}
+
+func TestServer_slotLedManager_manage(t *testing.T) {
+	pciAddr := test.MockPCIAddr(1)
+
+	for name, tc := range map[string]struct {
+		pciAddr      string
+		attention    string
+		timerRunning bool
+		req          *ctlpb.LedManageReq
+		expState     ctlpb.LedState
+		expStatus    int32
+		expAttention string
+		expTimer     bool
+	}{
+		"no attention indicator": {
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_SET,
+				LedState:  ctlpb.LedState_QUICK_BLINK,
+			},
+			expState: ctlpb.LedState_NA,
+		},
+		"device not in a slot": {
+			pciAddr:   test.MockPCIAddr(2),
+			attention: slotAttentionOff,
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_SET,
+				LedState:  ctlpb.LedState_QUICK_BLINK,
+			},
+			expState:     ctlpb.LedState_NA,
+			expAttention: slotAttentionOff,
+		},
+		"get": {
+			attention: slotAttentionOn,
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_GET,
+			},
+			expState:     ctlpb.LedState_ON,
+			expAttention: slotAttentionOn,
+		},
+		"identify; no timeout": {
+			attention: slotAttentionOff,
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_SET,
+				LedState:  ctlpb.LedState_QUICK_BLINK,
+			},
+			expState:     ctlpb.LedState_QUICK_BLINK,
+			expAttention: slotAttentionBlink,
+		},
+		"identify; with timeout": {
+			attention: slotAttentionOff,
+			req: &ctlpb.LedManageReq{
+				LedAction:       ctlpb.LedAction_SET,
+				LedState:        ctlpb.LedState_QUICK_BLINK,
+				LedDurationMins: 2,
+			},
+			expState:     ctlpb.LedState_QUICK_BLINK,
+			expAttention: slotAttentionBlink,
+			expTimer:     true,
+		},
+		"unsupported state": {
+			attention: slotAttentionOff,
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_SET,
+				LedState:  ctlpb.LedState_SLOW_BLINK,
+			},
+			expState:     ctlpb.LedState_NA,
+			expStatus:    daos.IOError.Int32(),
+			expAttention: slotAttentionOff,
+		},
+		"reset cancels timeout": {
+			attention:    slotAttentionBlink,
+			timerRunning: true,
+			req: &ctlpb.LedManageReq{
+				LedAction: ctlpb.LedAction_RESET,
+			},
+			expState:     ctlpb.LedState_OFF,
+			expAttention: slotAttentionOff,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			m := mockSlotLedManager(t, log, pciAddr, tc.attention)
+			if tc.timerRunning {
+				m.timers[pciAddr] = time.NewTimer(time.Hour)
+			}
+			defer func() {
+				for _, timer := range m.timers {
+					timer.Stop()
+				}
+			}()
+
+			devAddr := tc.pciAddr
+			if devAddr == "" {
+				devAddr = pciAddr
+			}
+
+			res := m.manage(tc.req, devAddr)
+
+			expRes := &ctlpb.SmdManageResp_Result{
+				Status: tc.expStatus,
+				Device: &ctlpb.SmdDevice{
+					Ctrlr: &ctlpb.NvmeController{
+						PciAddr:  devAddr,
+						LedState: tc.expState,
+					},
+				},
+			}
+			if diff := cmp.Diff(expRes, res, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected result (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expAttention != "" {
+				buf, err := os.ReadFile(filepath.Join(m.sysRoot, "bus", "pci", "slots", "1",
+					"attention"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, tc.expAttention, strings.TrimSpace(string(buf)),
+					"unexpected attention value")
+			}
+
+			_, gotTimer := m.timers[pciAddr]
+			test.AssertEqual(t, tc.expTimer, gotTimer, "unexpected timer state")
+		})
+	}
+}
+
+func TestServer_slotLedManager_expire(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	pciAddr := test.MockPCIAddr(1)
+	m := mockSlotLedManager(t, log, pciAddr, slotAttentionBlink)
+	path, err := m.attentionPath(pciAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A stale timer should not reset the LED.
+	current := time.NewTimer(time.Hour)
+	defer current.Stop()
+	m.timers[pciAddr] = current
+	m.expire(pciAddr, path, time.NewTimer(time.Hour))
+
+	state, err := readSlotAttention(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ctlpb.LedState_QUICK_BLINK, state, "stale timer reset led")
+
+	m.expire(pciAddr, path, current)
+
+	state, err = readSlotAttention(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, ctlpb.LedState_OFF, state, "led not reset on expiry")
+	if _, exists := m.timers[pciAddr]; exists {
+		t.Fatal("timer not removed on expiry")
+	}
+}
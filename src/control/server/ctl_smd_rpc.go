@@ -67,6 +67,11 @@ func (svc *ControlService) querySmdDevices(ctx context.Context, req *ctlpb.SmdQu
 		if err != nil {
 			return err
 		}
+		if req.IncludeBioHealth {
+			if err := addNvmeHealthTrends(svc.nvmeHealth, rResp); err != nil {
+				return errors.Wrapf(err, "rank %d", engineRank)
+			}
+		}
 		resp.Ranks = append(resp.Ranks, rResp)
 	}
 
@@ -92,22 +97,12 @@ func (svc *ControlService) querySmdPools(ctx context.Context, req *ctlpb.SmdQuer
 		rResp := new(ctlpb.SmdQueryResp_RankResp)
 		rResp.Rank = engineRank.Uint32()
 
-		dresp, err := ei.CallDrpc(ctx, daos.MethodSmdPools, new(ctlpb.SmdPoolReq))
+		rankPoolResp, err := listSmdPools(ctx, ei, new(ctlpb.SmdPoolReq))
 		if err != nil {
-			return err
-		}
-
-		rankDevResp := new(ctlpb.SmdPoolResp)
-		if err = proto.Unmarshal(dresp.Body, rankDevResp); err != nil {
-			return errors.Wrap(err, "unmarshal SmdListPools response")
-		}
-
-		if rankDevResp.Status != 0 {
-			return errors.Wrapf(daos.Status(rankDevResp.Status),
-				"rank %d ListPools failed", engineRank)
+			return errors.Wrapf(err, "rank %d", engineRank)
 		}
 
-		if err := convert.Types(rankDevResp.Pools, &rResp.Pools); err != nil {
+		if err := convert.Types(rankPoolResp.Pools, &rResp.Pools); err != nil {
 			return errors.Wrap(err, "failed to convert pool list")
 		}
 		resp.Ranks = append(resp.Ranks, rResp)
@@ -174,7 +169,7 @@ func extractReqIDs(log logging.Logger, ids string, addrs idMap, uuids idMap) err
 	tokens := strings.Split(ids, ",")
 
 	for _, token := range tokens {
-		if addr, e := hardware.NewPCIAddress(token); e == nil && addr.IsVMDBackingAddress() {
+		if addr, e := hardware.NewPCIAddress(token); e == nil {
 			addrs[addr.String()] = true
 			continue
 		}
@@ -184,8 +179,7 @@ func extractReqIDs(log logging.Logger, ids string, addrs idMap, uuids idMap) err
 			continue
 		}
 
-		return errors.Errorf("req id entry %q is neither a valid vmd backing device pci "+
-			"address or uuid", token)
+		return errors.Errorf("req id entry %q is neither a valid pci address or uuid", token)
 	}
 
 	return nil
@@ -483,6 +477,12 @@ func (svc *ControlService) multiDevSmdManage(ctx context.Context, req *ctlpb.Smd
 			if err != nil {
 				return nil, errors.Wrap(err, msg)
 			}
+			// The engine reports state NA for SSDs not behind VMD, try the hotplug
+			// slot's attention indicator instead.
+			if svc.slotLeds != nil && devRes.Status == 0 &&
+				devRes.GetDevice().GetCtrlr().GetLedState() == ctlpb.LedState_NA {
+				devRes = svc.slotLeds.manage(dReq, dev.trAddr)
+			}
 			addManageRespIDOnFail(svc.log, devRes, &dev)
 			svc.log.Tracef("%s: req %+v, resp %+v", msg, dReq, devRes)
 			devResults = append(devResults, devRes)
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -855,9 +856,57 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 		drpcResps      map[int][]*mockDrpcResponse
 		harnessStopped bool
 		ioStopped      bool
+		slotAttention  string
 		expResp        *ctlpb.SmdManageResp
 		expErr         error
 	}{
+		"led-manage; not behind vmd; hotplug slot attention indicator used": {
+			req: &ctlpb.SmdManageReq{
+				Op: &ctlpb.SmdManageReq_Led{
+					Led: &ctlpb.LedManageReq{
+						Ids:       test.MockPCIAddr(1),
+						LedAction: ctlpb.LedAction_SET,
+						LedState:  ctlpb.LedState_QUICK_BLINK,
+					},
+				},
+			},
+			drpcResps: map[int][]*mockDrpcResponse{
+				0: {
+					{
+						Message: &ctlpb.SmdDevResp{
+							Devices: []*ctlpb.SmdDevice{pbNormDev(1)},
+						},
+					},
+					{
+						Message: &ctlpb.DevManageResp{
+							Device: &ctlpb.SmdDevice{
+								Ctrlr: &ctlpb.NvmeController{
+									PciAddr:  test.MockPCIAddr(1),
+									LedState: ctlpb.LedState_NA,
+								},
+							},
+						},
+					},
+				},
+			},
+			slotAttention: slotAttentionOff,
+			expResp: &ctlpb.SmdManageResp{
+				Ranks: []*ctlpb.SmdManageResp_RankResp{
+					{
+						Results: []*ctlpb.SmdManageResp_Result{
+							{
+								Device: &ctlpb.SmdDevice{
+									Ctrlr: &ctlpb.NvmeController{
+										PciAddr:  test.MockPCIAddr(1),
+										LedState: ctlpb.LedState_QUICK_BLINK,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"harness not started": {
 			req:            &ctlpb.SmdManageReq{},
 			harnessStopped: true,
@@ -919,7 +968,7 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 			},
 			expErr: errors.New("neither a valid"),
 		},
-		"led-manage; pci address not of a vmd backing device": {
+		"led-manage; valid pci address of directly attached device": {
 			req: &ctlpb.SmdManageReq{
 				Op: &ctlpb.SmdManageReq_Led{
 					Led: &ctlpb.LedManageReq{
@@ -927,7 +976,7 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 					},
 				},
 			},
-			expErr: errors.New("neither a valid"),
+			expErr: errors.New("no response"),
 		},
 		"led-manage; valid pci address of vmd backing device": {
 			req: &ctlpb.SmdManageReq{
@@ -1635,6 +1684,10 @@ func TestServer_CtlSvc_SmdManage(t *testing.T) {
 				}
 				ei.ready.SetTrue()
 			}
+			if tc.slotAttention != "" {
+				svc.slotLeds = mockSlotLedManager(t, log, test.MockPCIAddr(1),
+					tc.slotAttention)
+			}
 			if tc.harnessStopped {
 				svc.harness.started.SetFalse()
 			}
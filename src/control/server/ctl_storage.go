@@ -19,6 +19,7 @@ import (
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
 )
 
 // StorageControlService encapsulates the storage part of the control service
@@ -27,6 +28,7 @@ type StorageControlService struct {
 	storage         *storage.Provider
 	instanceStorage map[uint32]*storage.Config
 	getSysMemInfo   common.GetSysMemInfoFn
+	scanSysfsBdevs  func() (storage.NvmeControllers, error)
 }
 
 // ScmPrepare preps locally attached modules.
@@ -39,6 +41,11 @@ func (scs *StorageControlService) ScmScan(req storage.ScmScanRequest) (*storage.
 	return scs.storage.ScanScm(req)
 }
 
+// ScmUnlock unlocks locally attached passphrase-protected modules.
+func (scs *StorageControlService) ScmUnlock(req storage.ScmUnlockRequest) (*storage.ScmUnlockResponse, error) {
+	return scs.storage.UnlockScm(req)
+}
+
 // NvmePrepare preps locally attached SSDs.
 func (scs *StorageControlService) NvmePrepare(req storage.BdevPrepareRequest) (*storage.BdevPrepareResponse, error) {
 	return scs.storage.PrepareBdevs(req)
@@ -73,6 +80,9 @@ func NewStorageControlService(log logging.Logger, ecs []*engine.Config) *Storage
 		instanceStorage: instanceStorage,
 		storage:         storage.DefaultProvider(log, 0, topCfg),
 		getSysMemInfo:   common.GetSysMemInfo,
+		scanSysfsBdevs: func() (storage.NvmeControllers, error) {
+			return bdev.ScanSysfs(log)
+		},
 	}
 }
 
@@ -138,6 +148,16 @@ func (cs *ControlService) getScmUsage(ssr *storage.ScmScanResponse) (*storage.Sc
 			ns.Mount = mount
 		}
 
+		if ns.Mount != nil {
+			owner, ownership, err := engine.GetStorage().ScmOwnership(cs.srvCfg.SystemName)
+			if err != nil {
+				cs.log.Errorf("engine %d: failed to check scm ownership: %s",
+					engine.Index(), err)
+			}
+			ns.Mount.Owner = owner
+			ns.Mount.Ownership = ownership
+		}
+
 		if ns.Mount == nil {
 			cs.log.Debugf("engine %d: getScmUsage(): nil ns.Mount, skipping rank fetch",
 				engine.Index())
@@ -12,7 +12,9 @@ import (
 	"fmt"
 	"math"
 	"os/user"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -28,6 +30,7 @@ import (
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/engine"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
 )
 
 const (
@@ -92,14 +95,40 @@ func ctrlrToPciStr(nc *ctlpb.NvmeController) (string, error) {
 	return pciAddr.String(), nil
 }
 
+func tierHasBdev(tc *storage.TierConfig, pciAddr string) bool {
+	if !tc.IsBdev() {
+		return false
+	}
+	for _, name := range tc.Bdev.DeviceList.Devices() {
+		if pciAddr == name {
+			return true
+		}
+	}
+
+	return tc.Class == storage.ClassNvmeCache && tc.Bdev.Cache.Device == pciAddr
+}
+
 func findBdevTier(pciAddr string, tcs storage.TierConfigs) *storage.TierConfig {
 	for _, tc := range tcs {
-		if !tc.IsBdev() {
-			continue
+		if tierHasBdev(tc, pciAddr) {
+			return tc
 		}
-		for _, name := range tc.Bdev.DeviceList.Devices() {
-			if pciAddr == name {
-				return tc
+	}
+
+	return nil
+}
+
+// findDeviceBinding returns the engine storage tier selected by the match function, nil if no
+// engine tier matches.
+func findDeviceBinding(ecs []*engine.Config, match func(*storage.TierConfig) bool) *ctlpb.DeviceBinding {
+	for ei, ec := range ecs {
+		for ti, tc := range ec.Storage.Tiers {
+			if match(tc) {
+				return &ctlpb.DeviceBinding{
+					EngineIdx: uint32(ei),
+					TierIdx:   uint32(ti),
+					Class:     tc.Class.String(),
+				}
 			}
 		}
 	}
@@ -107,6 +136,79 @@ func findBdevTier(pciAddr string, tcs storage.TierConfigs) *storage.TierConfig {
 	return nil
 }
 
+// addUnusedBdevs appends NVMe controllers found in sysfs but missing from the scan results, these
+// are devices that are not assigned to an engine. The bound driver is also filled in for
+// controllers already in the results.
+func (cs *ControlService) addUnusedBdevs(resp *ctlpb.ScanNvmeResp) error {
+	if cs.scanSysfsBdevs == nil {
+		return errors.New("sysfs nvme scan unavailable")
+	}
+	found, err := cs.scanSysfsBdevs()
+	if err != nil {
+		return errors.Wrap(err, "scanning nvme devices in sysfs")
+	}
+
+	scanned := make(map[string]*ctlpb.NvmeController)
+	for _, c := range resp.Ctrlrs {
+		scanned[c.PciAddr] = c
+	}
+	for _, fc := range found {
+		if c, exists := scanned[fc.PciAddr]; exists {
+			if c.Driver == "" {
+				c.Driver = fc.Driver
+			}
+			continue
+		}
+		c := new(ctlpb.NvmeController)
+		if err := (*proto.NvmeController)(c).FromNative(fc); err != nil {
+			return err
+		}
+		resp.Ctrlrs = append(resp.Ctrlrs, c)
+	}
+
+	return nil
+}
+
+// bindStorageDevices sets the engine storage tier that each scanned NVMe controller and SCM
+// namespace is assigned to in the server config.
+func (cs *ControlService) bindStorageDevices(resp *ctlpb.StorageScanResp) {
+	ecs := cs.srvCfg.Engines
+
+	for _, c := range resp.GetNvme().GetCtrlrs() {
+		pciAddr, err := ctrlrToPciStr(c)
+		if err != nil {
+			continue // Emulated devices have no PCI address.
+		}
+		c.Binding = findDeviceBinding(ecs, func(tc *storage.TierConfig) bool {
+			return tierHasBdev(tc, pciAddr)
+		})
+	}
+
+	for _, ns := range resp.GetScm().GetNamespaces() {
+		devPath := "/dev/" + ns.Blockdev
+		ns.Binding = findDeviceBinding(ecs, func(tc *storage.TierConfig) bool {
+			return tc.Class == storage.ClassDcpm && common.Includes(tc.Scm.DeviceList, devPath)
+		})
+	}
+}
+
+// filterBdevNamespaces returns only the scanned namespace selected in the device list if one has
+// been specified for the controller, so that reported capacity reflects what the engine uses.
+func filterBdevNamespaces(nss []*ctlpb.NvmeController_Namespace, pciAddr string, bdl *storage.BdevDeviceList) []*ctlpb.NvmeController_Namespace {
+	if !bdl.HasNamespace(pciAddr) {
+		return nss
+	}
+
+	nsID := bdl.Namespace(pciAddr)
+	for _, ns := range nss {
+		if ns.Id == nsID {
+			return []*ctlpb.NvmeController_Namespace{ns}
+		}
+	}
+
+	return nil
+}
+
 // Convert bdev scan results to protobuf response.
 func bdevScanToProtoResp(scan scanBdevsFn, bdevCfgs storage.TierConfigs) (*ctlpb.ScanNvmeResp, error) {
 	req := storage.BdevScanRequest{DeviceList: bdevCfgs.Bdevs()}
@@ -143,6 +245,8 @@ func bdevScanToProtoResp(scan scanBdevsFn, bdevCfgs storage.TierConfigs) (*ctlpb
 				Rank:     uint32(ranklist.NilRank),
 			})
 			c.DevState = ctlpb.NvmeDevState_NORMAL
+			c.Namespaces = filterBdevNamespaces(c.Namespaces, pciAddrStr,
+				bc.Bdev.DeviceList)
 		}
 	}
 
@@ -329,6 +433,13 @@ func newScanScmResp(inResp *storage.ScmScanResponse, inErr error) (*ctlpb.ScanSc
 		return outResp, nil
 	}
 
+	if inResp.Capabilities != nil {
+		outResp.Capabilities = new(ctlpb.ScmCapabilities)
+		if err := convert.Types(inResp.Capabilities, outResp.Capabilities); err != nil {
+			return nil, err
+		}
+	}
+
 	if len(inResp.Namespaces) == 0 {
 		outResp.Modules = make(proto.ScmModules, 0, len(inResp.Modules))
 		if err := (*proto.ScmModules)(&outResp.Modules).FromNative(inResp.Modules); err != nil {
@@ -750,6 +861,107 @@ func (cs *ControlService) adjustScmSize(resp *ctlpb.ScanScmResp) {
 	}
 }
 
+// poolUsage returns the capacity used by each of the supplied pools given the number of the
+// pool's targets using a device and the size each target allocates on it, ordered by pool UUID.
+func poolUsage(pools []*ctlpb.SmdPoolResp_Pool, tgtCount func(*ctlpb.SmdPoolResp_Pool) int, tgtSize func(*ctlpb.SmdPoolResp_Pool) uint64) []*ctlpb.PoolStorageUsage {
+	var usage []*ctlpb.PoolStorageUsage
+	for _, pool := range pools {
+		used := uint64(tgtCount(pool)) * tgtSize(pool)
+		if used == 0 {
+			continue
+		}
+		usage = append(usage, &ctlpb.PoolStorageUsage{
+			Uuid:      pool.GetUuid(),
+			UsedBytes: used,
+		})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].Uuid < usage[j].Uuid
+	})
+
+	return usage
+}
+
+// scmPoolUsage returns the capacity of an engine's SCM mount used by each pool. The VOS file of
+// each of a pool's targets is stored on the mount.
+func scmPoolUsage(pools []*ctlpb.SmdPoolResp_Pool) []*ctlpb.PoolStorageUsage {
+	return poolUsage(pools,
+		func(pool *ctlpb.SmdPoolResp_Pool) int {
+			return len(pool.GetTgtIds())
+		},
+		func(pool *ctlpb.SmdPoolResp_Pool) uint64 {
+			return pool.GetScmSize()
+		})
+}
+
+// nvmePoolUsage returns the capacity of a SMD device used by each pool. The data blob of each of
+// a pool's targets is stored on the device assigned to the target.
+func nvmePoolUsage(dev *ctlpb.SmdDevice, pools []*ctlpb.SmdPoolResp_Pool) []*ctlpb.PoolStorageUsage {
+	devTgts := make(map[int32]bool)
+	for _, id := range dev.GetTgtIds() {
+		devTgts[id] = true
+	}
+
+	return poolUsage(pools,
+		func(pool *ctlpb.SmdPoolResp_Pool) (n int) {
+			for _, id := range pool.GetTgtIds() {
+				if devTgts[id] {
+					n++
+				}
+			}
+			return
+		},
+		func(pool *ctlpb.SmdPoolResp_Pool) uint64 {
+			return pool.GetBlobSize()
+		})
+}
+
+// addPoolUsage sets the capacity of each SCM mount and SMD device used by each pool, as recorded
+// in the per-server metadata (SMD) of the engine using the storage. Pool usage is best effort and
+// is omitted for engines that are not ready or fail to return their pools.
+func (cs *ControlService) addPoolUsage(ctx context.Context, req *ctlpb.StorageScanReq, resp *ctlpb.StorageScanResp) {
+	for _, ei := range cs.harness.Instances() {
+		if !ei.IsReady() {
+			continue
+		}
+
+		rank, err := ei.GetRank()
+		if err != nil {
+			cs.log.Debugf("engine %d: pool usage: %s", ei.Index(), err)
+			continue
+		}
+		poolResp, err := scanSmdPools(ctx, ei, new(ctlpb.SmdPoolReq))
+		if err != nil {
+			cs.log.Errorf("engine %d: pool usage: %s", ei.Index(), err)
+			continue
+		}
+		pools := poolResp.GetPools()
+
+		if req.GetScm().GetUsage() {
+			scmCfg, err := ei.GetStorage().GetScmConfig()
+			if err != nil {
+				cs.log.Debugf("engine %d: pool usage: %s", ei.Index(), err)
+			} else {
+				for _, ns := range resp.GetScm().GetNamespaces() {
+					if mnt := ns.GetMount(); mnt.GetPath() == scmCfg.Scm.MountPoint {
+						mnt.PoolUsage = scmPoolUsage(pools)
+					}
+				}
+			}
+		}
+
+		if req.GetNvme().GetMeta() {
+			for _, ctrlr := range resp.GetNvme().GetCtrlrs() {
+				for _, dev := range ctrlr.GetSmdDevices() {
+					if dev.GetRank() == rank.Uint32() {
+						dev.PoolUsage = nvmePoolUsage(dev, pools)
+					}
+				}
+			}
+		}
+	}
+}
+
 // StorageScan discovers non-volatile storage hardware on node.
 func (cs *ControlService) StorageScan(ctx context.Context, req *ctlpb.StorageScanReq) (*ctlpb.StorageScanResp, error) {
 	if req == nil {
@@ -776,9 +988,23 @@ func (cs *ControlService) StorageScan(ctx context.Context, req *ctlpb.StorageSca
 		if err != nil {
 			return nil, err
 		}
+		if req.Bindings {
+			if err := cs.addUnusedBdevs(respNvme); err != nil {
+				return nil, err
+			}
+			respNvme = bdevScanTrimResults(req.Nvme, respNvme)
+		}
 		resp.Nvme = respNvme
 	}
 
+	if req.Bindings {
+		cs.bindStorageDevices(resp)
+	}
+
+	if req.GetScm().GetUsage() || req.GetNvme().GetMeta() {
+		cs.addPoolUsage(ctx, req, resp)
+	}
+
 	smi, err := cs.getSysMemInfo()
 	if err != nil {
 		return nil, err
@@ -837,6 +1063,7 @@ func checkTmpfsMem(log logging.Logger, scmCfgs map[int]*storage.TierConfig, getS
 
 type formatScmReq struct {
 	log           logging.Logger
+	system        string
 	reformat      bool
 	replace       bool
 	instances     []Engine
@@ -904,6 +1131,17 @@ func formatScm(ctx context.Context, req formatScmReq, resp *ctlpb.StorageFormatR
 			continue
 		}
 
+		// Refuse to reuse SCM formatted by a different system or engine without reformat.
+		if err := ei.GetStorage().CheckScmOwnership(req.system); err != nil {
+			resp.Mrets = append(resp.Mrets, &ctlpb.ScmMountResult{
+				Instanceidx: uint32(idx),
+				Mntpoint:    scmCfgs[idx].Scm.MountPoint,
+				State:       newResponseState(err, ctlpb.ResponseStatus_CTL_ERR_SCM, ""),
+			})
+			errored[idx] = err.Error()
+			continue
+		}
+
 		resp.Mrets = append(resp.Mrets, &ctlpb.ScmMountResult{
 			Instanceidx: uint32(idx),
 			Mntpoint:    scmCfgs[idx].Scm.MountPoint,
@@ -1056,6 +1294,7 @@ func (cs *ControlService) StorageFormat(ctx context.Context, req *ctlpb.StorageF
 
 	fsr := formatScmReq{
 		log:           cs.log,
+		system:        cs.srvCfg.SystemName,
 		reformat:      req.Reformat,
 		replace:       req.Replace,
 		instances:     instances,
@@ -1219,3 +1458,137 @@ func (cs *ControlService) StorageNvmeAddDevice(ctx context.Context, req *ctlpb.N
 
 	return resp, nil
 }
+
+// checkNvmeCtrlrNotInUse returns an error if the NVMe controller is in the bdev configuration of a
+// running engine as namespaces must not be changed underneath an engine.
+func (cs *ControlService) checkNvmeCtrlrNotInUse(pciAddr string) error {
+	for _, ei := range cs.harness.Instances() {
+		if !ei.IsStarted() {
+			continue
+		}
+		for _, tier := range ei.GetStorage().GetBdevConfigs() {
+			devs := tier.Bdev.DeviceList.Devices()
+			if tier.Class == storage.ClassNvmeCache {
+				devs = append(devs, tier.Bdev.Cache.Device)
+			}
+			for _, dev := range devs {
+				if strings.EqualFold(dev, pciAddr) {
+					return errors.Errorf("nvme controller %s in use by running engine %d",
+						pciAddr, ei.Index())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// StorageNvmeNsCreate creates namespaces on an SSD so that its capacity can be divided between
+// DAOS engines.
+func (cs *ControlService) StorageNvmeNsCreate(ctx context.Context, req *ctlpb.NvmeNsCreateReq) (*ctlpb.NvmeNsCreateResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+	if err := cs.checkNvmeCtrlrNotInUse(req.PciAddr); err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.NvmeNsCreateResp)
+	nss, err := cs.storage.CreateBdevNamespaces(req.PciAddr, req.Size, req.Count)
+	if err != nil {
+		err = errors.Wrap(err, "nvme namespace create")
+		cs.log.Error(err.Error())
+
+		resp.State = &ctlpb.ResponseState{
+			Error:  err.Error(),
+			Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+		}
+
+		return resp, nil // report create call result in response
+	}
+
+	for _, ns := range nss {
+		resp.Namespaces = append(resp.Namespaces, &ctlpb.NvmeController_Namespace{
+			Id:           ns.ID,
+			Size:         ns.Size,
+			CtrlrPciAddr: req.PciAddr,
+			Zoned:        ns.Zoned,
+		})
+	}
+
+	return resp, nil
+}
+
+// StorageNvmeNsDelete deletes a namespace on an SSD.
+func (cs *ControlService) StorageNvmeNsDelete(ctx context.Context, req *ctlpb.NvmeNsDeleteReq) (*ctlpb.NvmeNsDeleteResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+	if err := cs.checkNvmeCtrlrNotInUse(req.PciAddr); err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.NvmeNsDeleteResp)
+	if err := cs.storage.DeleteBdevNamespace(req.PciAddr, req.NsId); err != nil {
+		err = errors.Wrap(err, "nvme namespace delete")
+		cs.log.Error(err.Error())
+
+		resp.State = &ctlpb.ResponseState{
+			Error:  err.Error(),
+			Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+		}
+	}
+
+	return resp, nil
+}
+
+// StorageRenderConfig renders the SPDK JSON config that would be written for an engine and returns
+// it without writing anything to disk. Engine NVMe storage is scanned so that VMD endpoint addresses
+// can be replaced with those of the backing devices.
+func (cs *ControlService) StorageRenderConfig(ctx context.Context, req *ctlpb.StorageRenderConfigReq) (*ctlpb.StorageRenderConfigResp, error) {
+	if req == nil {
+		return nil, errNilReq
+	}
+
+	engines := cs.harness.Instances()
+	engineIndex := req.GetEngineIdx()
+	if len(engines) <= int(engineIndex) {
+		return nil, errors.Errorf("engine with index %d not found", engineIndex)
+	}
+	ei := engines[engineIndex]
+	if len(ei.GetStorage().GetBdevConfigs()) == 0 {
+		return nil, errors.Errorf("no bdev storage tiers in config for engine %d", engineIndex)
+	}
+
+	resp := new(ctlpb.StorageRenderConfigResp)
+	buf, err := cs.renderEngineConfig(ctx, ei)
+	if err != nil {
+		err = errors.Wrapf(err, "render nvme config for engine %d", engineIndex)
+		cs.log.Error(err.Error())
+
+		// report render call result in response
+		resp.State = &ctlpb.ResponseState{
+			Error:  err.Error(),
+			Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+		}
+
+		return resp, nil
+	}
+	resp.Config = string(buf)
+
+	return resp, nil
+}
+
+func (cs *ControlService) renderEngineConfig(ctx context.Context, ei Engine) ([]byte, error) {
+	ctrlrs, err := getEngineBdevCtrlrs(ctx, ei)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := ei.GetStorage().NvmeConfigRequest(ctx, cs.log, ctrlrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return bdev.RenderJsonConfig(cs.log, req)
+}
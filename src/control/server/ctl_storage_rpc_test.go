@@ -203,6 +203,57 @@ func TestServer_bdevScan(t *testing.T) {
 				},
 			},
 		},
+		"scan local; bdevs in config; namespace selected in cfg": {
+			req: &ctlpb.ScanNvmeReq{Health: true},
+			engTierCfgs: []storage.TierConfigs{
+				{
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddr(1)+":ns=2",
+							test.MockPCIAddr(2)),
+				},
+			},
+			provRes: &storage.BdevScanResponse{
+				Controllers: storage.NvmeControllers{
+					func() *storage.NvmeController {
+						c := storage.MockNvmeController(1)
+						c.Namespaces = append(c.Namespaces,
+							storage.MockNvmeNamespace(2))
+						return c
+					}(),
+					storage.MockNvmeController(2),
+				},
+			},
+			engStopped: []bool{true},
+			expResp: &ctlpb.ScanNvmeResp{
+				Ctrlrs: proto.NvmeControllers{
+					func() *ctlpb.NvmeController {
+						c := proto.MockNvmeController(1)
+						c.Namespaces = []*ctlpb.NvmeController_Namespace{
+							{Id: 2, Size: 3 * humanize.TByte},
+						}
+						c.SmdDevices = []*ctlpb.SmdDevice{
+							{Rank: uint32(ranklist.NilRank)},
+						}
+						return c
+					}(),
+					func() *ctlpb.NvmeController {
+						c := proto.MockNvmeController(2)
+						c.SmdDevices = []*ctlpb.SmdDevice{
+							{Rank: uint32(ranklist.NilRank)},
+						}
+						return c
+					}(),
+				},
+				State: new(ctlpb.ResponseState),
+			},
+			expBackendScanCalls: []storage.BdevScanRequest{
+				{
+					DeviceList: storage.MustNewBdevDeviceList(
+						test.MockPCIAddr(1), test.MockPCIAddr(2)),
+				},
+			},
+		},
 		"scan local; bdevs in config; devlist passed to backend; roles from cfg": {
 			req: &ctlpb.ScanNvmeReq{Health: true},
 			engTierCfgs: []storage.TierConfigs{
@@ -1251,6 +1302,19 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 	ctrlrPBBasic.SmdDevices = nil
 	ctrlrPBBasic.FwRev = ""
 	ctrlrPBBasic.Model = ""
+	mockCtrlrWithSmd := func(poolUsage []*ctlpb.PoolStorageUsage) *ctlpb.NvmeController {
+		c := proto.MockNvmeController()
+		c.HealthStats = nil
+		c.SmdDevices = []*ctlpb.SmdDevice{
+			{
+				Uuid:      test.MockUUID(0),
+				TgtIds:    []int32{0, 1},
+				Rank:      0,
+				PoolUsage: poolUsage,
+			},
+		}
+		return c
+	}
 
 	for name, tc := range map[string]struct {
 		req             *ctlpb.StorageScanReq
@@ -1262,6 +1326,10 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 		disableHPs      bool
 		noSrvCfg        bool
 		nilReq          bool
+		sysfsCtrlrs     storage.NvmeControllers
+		sysfsErr        error
+		smdPools        []*ctlpb.SmdPoolResp_Pool
+		smdPoolsErr     error
 		expResp         *ctlpb.StorageScanResp
 		expErr          error
 	}{
@@ -1273,6 +1341,99 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 			noSrvCfg: true,
 			expErr:   errNoSrvCfg,
 		},
+		"bindings; unused nvme devices added": {
+			req: &ctlpb.StorageScanReq{
+				Scm:      new(ctlpb.ScanScmReq),
+				Nvme:     new(ctlpb.ScanNvmeReq),
+				Bindings: true,
+			},
+			bdevScanRes: &ctlpb.ScanNvmeResp{
+				Ctrlrs: proto.NvmeControllers{
+					func() *ctlpb.NvmeController {
+						c := proto.MockNvmeController()
+						c.HealthStats = nil
+						c.SmdDevices = nil
+						return c
+					}(),
+				},
+				State: new(ctlpb.ResponseState),
+			},
+			sysfsCtrlrs: storage.NvmeControllers{
+				{PciAddr: ctrlr.PciAddr, Driver: "vfio-pci"},
+				{
+					PciAddr:  test.MockPCIAddr(3),
+					SocketID: 1,
+					Driver:   "nvme",
+					Serial:   "PHLN0003",
+				},
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes:    storage.ScmModules{storage.MockScmModule()},
+				GetNamespacesRes: storage.ScmNamespaces{storage.MockScmNamespace()},
+			},
+			tierCfgs: storage.TierConfigs{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmMountPoint("/mnt/daos0").
+					WithScmDeviceList("/dev/pmem0"),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(ctrlr.PciAddr),
+			},
+			expResp: &ctlpb.StorageScanResp{
+				Nvme: &ctlpb.ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{
+						func() *ctlpb.NvmeController {
+							c := proto.MockNvmeController()
+							c.HealthStats = nil
+							c.SmdDevices = nil
+							c.Driver = "vfio-pci"
+							c.Binding = &ctlpb.DeviceBinding{
+								EngineIdx: 0,
+								TierIdx:   1,
+								Class:     storage.ClassNvme.String(),
+							}
+							return c
+						}(),
+						{
+							PciAddr:  test.MockPCIAddr(3),
+							SocketId: 1,
+							Driver:   "nvme",
+							Serial:   "PHLN0003",
+						},
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				Scm: &ctlpb.ScanScmResp{
+					Namespaces: proto.ScmNamespaces{
+						func() *ctlpb.ScmNamespace {
+							ns := proto.MockScmNamespace()
+							ns.Binding = &ctlpb.DeviceBinding{
+								EngineIdx: 0,
+								TierIdx:   0,
+								Class:     storage.ClassDcpm.String(),
+							}
+							return ns
+						}(),
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				SysMemInfo: control.MockPBSysMemInfo(),
+			},
+		},
+		"bindings; sysfs scan fails": {
+			req: &ctlpb.StorageScanReq{
+				Scm:      new(ctlpb.ScanScmReq),
+				Nvme:     new(ctlpb.ScanNvmeReq),
+				Bindings: true,
+			},
+			bdevScanRes: &ctlpb.ScanNvmeResp{
+				State: new(ctlpb.ResponseState),
+			},
+			smbc:     &scm.MockBackendConfig{},
+			sysfsErr: errors.New("sysfs failed"),
+			expErr:   errors.New("sysfs failed"),
+		},
 		"successful scan; scm namespaces": {
 			bdevScanRes: &ctlpb.ScanNvmeResp{
 				Ctrlrs: proto.NvmeControllers{
@@ -1727,6 +1888,133 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 				SysMemInfo: control.MockPBSysMemInfo(),
 			},
 		},
+		"dcpm class scm in cfg; usage requested; pool usage": {
+			tierCfgs: storage.TierConfigs{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmMountPoint("/mnt/daos0").
+					WithScmDeviceList("/dev/pmem0"),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(ctrlr.PciAddr, test.MockPCIAddr(2)),
+			},
+			bdevScanRes: &ctlpb.ScanNvmeResp{
+				Ctrlrs: proto.NvmeControllers{
+					mockCtrlrWithSmd(nil),
+				},
+				State: new(ctlpb.ResponseState),
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes:    storage.ScmModules{storage.MockScmModule()},
+				GetNamespacesRes: storage.ScmNamespaces{storage.MockScmNamespace(0)},
+			},
+			smdPools: []*ctlpb.SmdPoolResp_Pool{
+				{
+					Uuid:     test.MockUUID(2),
+					TgtIds:   []int32{1},
+					ScmSize:  humanize.GiByte,
+					BlobSize: 4 * humanize.GiByte,
+				},
+				{
+					Uuid:     test.MockUUID(1),
+					TgtIds:   []int32{0, 1, 2, 3},
+					ScmSize:  humanize.GiByte,
+					BlobSize: 2 * humanize.GiByte,
+				},
+				{
+					Uuid:     test.MockUUID(3),
+					TgtIds:   []int32{2, 3},
+					ScmSize:  humanize.GiByte,
+					BlobSize: 2 * humanize.GiByte,
+				},
+			},
+			req: &ctlpb.StorageScanReq{
+				Scm: &ctlpb.ScanScmReq{
+					Usage: true,
+				},
+				Nvme: &ctlpb.ScanNvmeReq{
+					Meta: true,
+				},
+			},
+			expResp: &ctlpb.StorageScanResp{
+				Nvme: &ctlpb.ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{
+						mockCtrlrWithSmd([]*ctlpb.PoolStorageUsage{
+							{Uuid: test.MockUUID(1), UsedBytes: 4 * humanize.GiByte},
+							{Uuid: test.MockUUID(2), UsedBytes: 4 * humanize.GiByte},
+						}),
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				Scm: &ctlpb.ScanScmResp{
+					Namespaces: proto.ScmNamespaces{
+						func() *ctlpb.ScmNamespace {
+							ns := proto.MockScmNamespace(0)
+							ns.Mount = proto.MockScmMountPoint(0)
+							ns.Mount.AvailBytes = 0
+							ns.Mount.TotalBytes = 0
+							ns.Mount.PoolUsage = []*ctlpb.PoolStorageUsage{
+								{Uuid: test.MockUUID(1), UsedBytes: 4 * humanize.GiByte},
+								{Uuid: test.MockUUID(2), UsedBytes: humanize.GiByte},
+								{Uuid: test.MockUUID(3), UsedBytes: 2 * humanize.GiByte},
+							}
+							return ns
+						}(),
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				SysMemInfo: control.MockPBSysMemInfo(),
+			},
+		},
+		"dcpm class scm in cfg; usage requested; list pools fails": {
+			tierCfgs: storage.TierConfigs{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmMountPoint("/mnt/daos0").
+					WithScmDeviceList("/dev/pmem0"),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(ctrlr.PciAddr, test.MockPCIAddr(2)),
+			},
+			bdevScanRes: &ctlpb.ScanNvmeResp{
+				Ctrlrs: proto.NvmeControllers{
+					mockCtrlrWithSmd(nil),
+				},
+				State: new(ctlpb.ResponseState),
+			},
+			smbc: &scm.MockBackendConfig{
+				GetModulesRes:    storage.ScmModules{storage.MockScmModule()},
+				GetNamespacesRes: storage.ScmNamespaces{storage.MockScmNamespace(0)},
+			},
+			smdPoolsErr: errors.New("list pools failed"),
+			req: &ctlpb.StorageScanReq{
+				Scm: &ctlpb.ScanScmReq{
+					Usage: true,
+				},
+				Nvme: &ctlpb.ScanNvmeReq{
+					Meta: true,
+				},
+			},
+			expResp: &ctlpb.StorageScanResp{
+				Nvme: &ctlpb.ScanNvmeResp{
+					Ctrlrs: proto.NvmeControllers{mockCtrlrWithSmd(nil)},
+					State:  new(ctlpb.ResponseState),
+				},
+				Scm: &ctlpb.ScanScmResp{
+					Namespaces: proto.ScmNamespaces{
+						func() *ctlpb.ScmNamespace {
+							ns := proto.MockScmNamespace(0)
+							ns.Mount = proto.MockScmMountPoint(0)
+							ns.Mount.AvailBytes = 0
+							ns.Mount.TotalBytes = 0
+							return ns
+						}(),
+					},
+					State: new(ctlpb.ResponseState),
+				},
+				SysMemInfo: control.MockPBSysMemInfo(),
+			},
+		},
 		"dcpm class scm in cfg; usage requested; engine not started": {
 			tierCfgs: storage.TierConfigs{
 				storage.NewTierConfig().
@@ -1801,6 +2089,12 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 			defer func() {
 				scanBdevs = bdevScan
 			}()
+			scanSmdPools = func(_ context.Context, _ Engine, _ *ctlpb.SmdPoolReq) (*ctlpb.SmdPoolResp, error) {
+				return &ctlpb.SmdPoolResp{Pools: tc.smdPools}, tc.smdPoolsErr
+			}
+			defer func() {
+				scanSmdPools = listSmdPools
+			}()
 
 			if tc.req == nil && !tc.nilReq {
 				tc.req = &ctlpb.StorageScanReq{
@@ -1811,6 +2105,9 @@ func TestServer_CtlSvc_StorageScan(t *testing.T) {
 			if tc.noSrvCfg {
 				cs.srvCfg = nil
 			}
+			cs.scanSysfsBdevs = func() (storage.NvmeControllers, error) {
+				return tc.sysfsCtrlrs, tc.sysfsErr
+			}
 
 			resp, err := cs.StorageScan(test.Context(t), tc.req)
 			test.CmpErr(t, tc.expErr, err)
@@ -3232,6 +3529,331 @@ func TestServer_CtlSvc_StorageNvmeAddDevice(t *testing.T) {
 	}
 }
 
+func TestServer_CtlSvc_StorageNvmeNsCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req          *ctlpb.NvmeNsCreateReq
+		bmbc         *bdev.MockBackendConfig
+		engineDevs   []string
+		notStarted   bool
+		expErr       error
+		expResp      *ctlpb.NvmeNsCreateResp
+		expCreateReq *storage.BdevNamespaceCreateRequest
+	}{
+		"nil request": {
+			expErr: errNilReq,
+		},
+		"controller in use by running engine": {
+			req: &ctlpb.NvmeNsCreateReq{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			expErr:     errors.New("in use by running engine 0"),
+		},
+		"controller in config of stopped engine": {
+			req: &ctlpb.NvmeNsCreateReq{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			notStarted: true,
+			expCreateReq: &storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			expResp: &ctlpb.NvmeNsCreateResp{},
+		},
+		"zero count": {
+			req: &ctlpb.NvmeNsCreateReq{
+				PciAddr: test.MockPCIAddr(1),
+			},
+			engineDevs: []string{test.MockPCIAddr(2)},
+			expResp: &ctlpb.NvmeNsCreateResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error:  "nvme namespace create: number of namespaces to create must be nonzero",
+				},
+			},
+		},
+		"failure": {
+			req: &ctlpb.NvmeNsCreateReq{
+				PciAddr: test.MockPCIAddr(1),
+				Size:    humanize.TByte,
+				Count:   1,
+			},
+			bmbc: &bdev.MockBackendConfig{
+				CreateNsErr: errors.New("failure"),
+			},
+			engineDevs: []string{test.MockPCIAddr(2)},
+			expCreateReq: &storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Size:    humanize.TByte,
+				Count:   1,
+			},
+			expResp: &ctlpb.NvmeNsCreateResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error:  "nvme namespace create: failure",
+				},
+			},
+		},
+		"success": {
+			req: &ctlpb.NvmeNsCreateReq{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			bmbc: &bdev.MockBackendConfig{
+				CreateNsRes: &storage.BdevNamespaceCreateResponse{
+					Namespaces: []*storage.NvmeNamespace{
+						{ID: 1, Size: humanize.TByte},
+						{ID: 2, Size: humanize.TByte},
+					},
+				},
+			},
+			engineDevs: []string{test.MockPCIAddr(2)},
+			expCreateReq: &storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			expResp: &ctlpb.NvmeNsCreateResp{
+				Namespaces: []*ctlpb.NvmeController_Namespace{
+					{Id: 1, Size: humanize.TByte, CtrlrPciAddr: test.MockPCIAddr(1)},
+					{Id: 2, Size: humanize.TByte, CtrlrPciAddr: test.MockPCIAddr(1)},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ec := engine.MockConfig().WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(tc.engineDevs...),
+			)
+			serverCfg := config.DefaultServer().WithEngines(ec)
+			mbb := bdev.NewMockBackend(tc.bmbc)
+			cs := newMockControlServiceFromBackends(t, log, serverCfg, mbb,
+				scm.NewMockBackend(nil), nil, tc.notStarted)
+
+			resp, err := cs.StorageNvmeNsCreate(test.Context(t), tc.req)
+
+			mbb.RLock()
+			if tc.expCreateReq == nil {
+				if len(mbb.CreateNsCalls) != 0 {
+					t.Fatal("unexpected number of create namespaces calls")
+				}
+			} else {
+				if len(mbb.CreateNsCalls) != 1 {
+					t.Fatal("unexpected number of create namespaces calls")
+				}
+				if diff := cmp.Diff(*tc.expCreateReq, mbb.CreateNsCalls[0]); diff != "" {
+					t.Fatalf("unexpected create namespaces call (-want, +got):\n%s\n", diff)
+				}
+			}
+			mbb.RUnlock()
+
+			test.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_StorageNvmeNsDelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req          *ctlpb.NvmeNsDeleteReq
+		bmbc         *bdev.MockBackendConfig
+		engineDevs   []string
+		expErr       error
+		expResp      *ctlpb.NvmeNsDeleteResp
+		expDeleteReq *storage.BdevNamespaceDeleteRequest
+	}{
+		"nil request": {
+			expErr: errNilReq,
+		},
+		"controller in use by running engine": {
+			req: &ctlpb.NvmeNsDeleteReq{
+				PciAddr: test.MockPCIAddr(1),
+				NsId:    2,
+			},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			expErr:     errors.New("in use by running engine 0"),
+		},
+		"failure": {
+			req: &ctlpb.NvmeNsDeleteReq{
+				PciAddr: test.MockPCIAddr(1),
+				NsId:    2,
+			},
+			bmbc: &bdev.MockBackendConfig{
+				DeleteNsErr: errors.New("failure"),
+			},
+			engineDevs: []string{test.MockPCIAddr(2)},
+			expDeleteReq: &storage.BdevNamespaceDeleteRequest{
+				PciAddr: test.MockPCIAddr(1),
+				NsID:    2,
+			},
+			expResp: &ctlpb.NvmeNsDeleteResp{
+				State: &ctlpb.ResponseState{
+					Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+					Error:  "nvme namespace delete: failure",
+				},
+			},
+		},
+		"success": {
+			req: &ctlpb.NvmeNsDeleteReq{
+				PciAddr: test.MockPCIAddr(1),
+				NsId:    2,
+			},
+			engineDevs: []string{test.MockPCIAddr(2)},
+			expDeleteReq: &storage.BdevNamespaceDeleteRequest{
+				PciAddr: test.MockPCIAddr(1),
+				NsID:    2,
+			},
+			expResp: &ctlpb.NvmeNsDeleteResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ec := engine.MockConfig().WithStorage(
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()),
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(tc.engineDevs...),
+			)
+			serverCfg := config.DefaultServer().WithEngines(ec)
+			mbb := bdev.NewMockBackend(tc.bmbc)
+			cs := newMockControlServiceFromBackends(t, log, serverCfg, mbb,
+				scm.NewMockBackend(nil), nil)
+
+			resp, err := cs.StorageNvmeNsDelete(test.Context(t), tc.req)
+
+			mbb.RLock()
+			if tc.expDeleteReq == nil {
+				if len(mbb.DeleteNsCalls) != 0 {
+					t.Fatal("unexpected number of delete namespace calls")
+				}
+			} else {
+				if len(mbb.DeleteNsCalls) != 1 {
+					t.Fatal("unexpected number of delete namespace calls")
+				}
+				if diff := cmp.Diff(*tc.expDeleteReq, mbb.DeleteNsCalls[0]); diff != "" {
+					t.Fatalf("unexpected delete namespace call (-want, +got):\n%s\n", diff)
+				}
+			}
+			mbb.RUnlock()
+
+			test.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_CtlSvc_StorageRenderConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        *ctlpb.StorageRenderConfigReq
+		engineDevs []string
+		scanResp   *ctlpb.ScanNvmeResp
+		scanErr    error
+		expErr     error
+		expState   *ctlpb.ResponseState
+		expConfig  []string
+	}{
+		"nil request": {
+			expErr: errNilReq,
+		},
+		"engine index out of range": {
+			req:        &ctlpb.StorageRenderConfigReq{EngineIdx: 1},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			expErr:     errors.New("engine with index 1 not found"),
+		},
+		"no bdev tiers": {
+			req:    &ctlpb.StorageRenderConfigReq{},
+			expErr: errors.New("no bdev storage tiers in config for engine 0"),
+		},
+		"scan fails": {
+			req:        &ctlpb.StorageRenderConfigReq{},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			scanErr:    errors.New("scan failed"),
+			expState: &ctlpb.ResponseState{
+				Status: ctlpb.ResponseStatus_CTL_ERR_NVME,
+				Error:  "render nvme config for engine 0: scan failed",
+			},
+		},
+		"success": {
+			req:        &ctlpb.StorageRenderConfigReq{},
+			engineDevs: []string{test.MockPCIAddr(1)},
+			scanResp: &ctlpb.ScanNvmeResp{
+				Ctrlrs: proto.NvmeControllers{proto.MockNvmeController(1)},
+				State:  new(ctlpb.ResponseState),
+			},
+			expConfig: []string{
+				`"method": "bdev_nvme_attach_controller"`,
+				fmt.Sprintf(`"traddr": "%s"`, test.MockPCIAddr(1)),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tiers := storage.TierConfigs{
+				storage.NewTierConfig().
+					WithStorageClass(storage.ClassDcpm.String()).
+					WithScmDeviceList("/dev/pmem0").
+					WithScmMountPoint("/mnt/daos0"),
+			}
+			if len(tc.engineDevs) > 0 {
+				tiers = append(tiers, storage.NewTierConfig().
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(tc.engineDevs...))
+			}
+			ec := engine.MockConfig().WithStorage(tiers...)
+			serverCfg := config.DefaultServer().WithEngines(ec)
+			cs := newMockControlServiceFromBackends(t, log, serverCfg, nil, nil, nil)
+
+			scanEngineBdevs = func(_ context.Context, _ Engine, _ *ctlpb.ScanNvmeReq) (*ctlpb.ScanNvmeResp, error) {
+				return tc.scanResp, tc.scanErr
+			}
+			defer func() {
+				scanEngineBdevs = bdevScanEngine
+			}()
+
+			resp, err := cs.StorageRenderConfig(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expState, resp.State, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response state (-want, +got):\n%s\n", diff)
+			}
+			for _, exp := range tc.expConfig {
+				if !strings.Contains(resp.Config, exp) {
+					t.Fatalf("expected %q in rendered config:\n%s", exp, resp.Config)
+				}
+			}
+		})
+	}
+}
+
 func TestServer_CtlSvc_adjustNvmeSize(t *testing.T) {
 	const (
 		clusterSize     uint64 = 32 * humanize.MiByte
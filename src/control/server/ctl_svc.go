@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2018-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,11 +8,15 @@
 package server
 
 import (
+	"context"
+	"sync"
+
 	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 // ControlService implements the control plane control service, satisfying
@@ -23,6 +28,13 @@ type ControlService struct {
 	srvCfg  *config.Server
 	events  *events.PubSub
 	fabric  *hardware.FabricScanner
+
+	reloadConfig  func(context.Context) ([]string, error)
+	getActiveRPCs func(context.Context, Engine) (uint64, error)
+	slotLeds      *slotLedManager
+	nvmeReplaceMu sync.Mutex
+	audit         *auditLog
+	nvmeHealth    *storage.NvmeHealthHistory // nil unless NVMe health polling is enabled
 }
 
 // NewControlService returns ControlService to be used as gRPC control service
@@ -42,5 +54,7 @@ func NewControlService(log logging.Logger, h *EngineHarness,
 		srvCfg:                cfg,
 		events:                e,
 		fabric:                f,
+		getActiveRPCs:         getEngineActiveRPCs,
+		slotLeds:              newSlotLedManager(log),
 	}
 }
@@ -10,6 +10,7 @@ package engine
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,13 @@ const (
 	minABTThreadStackSizeUCX  = 32768
 )
 
+// FabricInterfaceConfig describes one of the network interfaces used by a multi-homed engine.
+// Interfaces with a lower priority value are preferred.
+type FabricInterfaceConfig struct {
+	Name     string `yaml:"iface"`
+	Priority uint   `yaml:"priority,omitempty"`
+}
+
 // FabricConfig encapsulates networking fabric configuration.
 type FabricConfig struct {
 	Provider        string `yaml:"provider,omitempty" cmdEnv:"D_PROVIDER"`
@@ -47,6 +55,8 @@ type FabricConfig struct {
 	NumSecondaryEndpoints []int  `yaml:"secondary_provider_endpoints,omitempty" cmdLongFlag:"--nr_sec_ctx,nonzero" cmdShortFlag:"-S,nonzero"`
 	DisableSRX            bool   `yaml:"disable_srx,omitempty" cmdEnv:"FI_OFI_RXM_USE_SRX,invertBool,intBool"`
 	AuthKey               string `yaml:"fabric_auth_key,omitempty" cmdEnv:"D_PROVIDER_AUTH_KEY"`
+	// Interfaces configures multiple prioritized interfaces for a single provider.
+	Interfaces []*FabricInterfaceConfig `yaml:"fabric_ifaces,omitempty"`
 }
 
 // GetPrimaryProvider parses the primary provider from the Provider string.
@@ -105,12 +115,38 @@ func (fc *FabricConfig) GetPrimaryInterface() (string, error) {
 	return interfaces[0], nil
 }
 
-// GetInterfaces parses the Interface string into one or more interfaces.
+// IsMultiHomed returns true if the engine uses multiple interfaces with a single provider.
+func (fc *FabricConfig) IsMultiHomed() bool {
+	return fc != nil && len(fc.Interfaces) > 0
+}
+
+// multiHomedInterfaces returns the names of the configured fabric_ifaces in priority order.
+func (fc *FabricConfig) multiHomedInterfaces() []string {
+	ifaces := make([]*FabricInterfaceConfig, len(fc.Interfaces))
+	copy(ifaces, fc.Interfaces)
+	sort.SliceStable(ifaces, func(i, j int) bool {
+		return ifaces[i].Priority < ifaces[j].Priority
+	})
+
+	names := make([]string, 0, len(ifaces))
+	for _, iface := range ifaces {
+		names = append(names, strings.TrimSpace(iface.Name))
+	}
+
+	return names
+}
+
+// GetInterfaces parses the Interface string into one or more interfaces. For multi-homed engines
+// the fabric_ifaces are returned in priority order.
 func (fc *FabricConfig) GetInterfaces() ([]string, error) {
 	if fc == nil {
 		return nil, errors.New("FabricConfig is nil")
 	}
 
+	if fc.IsMultiHomed() {
+		return fc.multiHomedInterfaces(), nil
+	}
+
 	interfaces := splitMultiProviderStr(fc.Interface)
 	if len(interfaces) == 0 {
 		return nil, errors.New("fabric_iface not set")
@@ -141,8 +177,9 @@ func (fc *FabricConfig) Update(other FabricConfig) {
 	if fc.Provider == "" {
 		fc.Provider = other.Provider
 	}
-	if fc.Interface == "" {
+	if fc.Interface == "" && !fc.IsMultiHomed() {
 		fc.Interface = other.Interface
+		fc.Interfaces = other.Interfaces
 	}
 	if fc.InterfacePort == 0 {
 		fc.InterfacePort = other.InterfacePort
@@ -172,6 +209,34 @@ func (fc *FabricConfig) setNumSecondaryEndpoints(other []int) {
 	fc.NumSecondaryEndpoints = other
 }
 
+// validateMultiHomed checks the fabric_ifaces of a multi-homed engine and renders them in priority
+// order into the fabric_iface value passed to the engine.
+func (fc *FabricConfig) validateMultiHomed(numProv int) error {
+	if numProv != 1 {
+		return errors.New("fabric_ifaces can only be used with a single provider")
+	}
+
+	names := fc.multiHomedInterfaces()
+	seen := make(map[string]struct{})
+	for _, name := range names {
+		if name == "" {
+			return errors.New("fabric_ifaces entry missing iface")
+		}
+		if _, dupe := seen[name]; dupe {
+			return errors.Errorf("fabric_ifaces entry %q specified more than once", name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	rendered := strings.Join(names, MultiProviderSeparator)
+	if fc.Interface != "" && fc.Interface != rendered {
+		return errors.New("fabric_iface and fabric_ifaces are mutually exclusive")
+	}
+	fc.Interface = rendered
+
+	return nil
+}
+
 // Validate ensures that the configuration meets minimum standards.
 func (fc *FabricConfig) Validate() error {
 	numProv := fc.GetNumProviders()
@@ -179,6 +244,12 @@ func (fc *FabricConfig) Validate() error {
 		return errors.New("provider not set")
 	}
 
+	if fc.IsMultiHomed() {
+		if err := fc.validateMultiHomed(numProv); err != nil {
+			return err
+		}
+	}
+
 	interfaces, err := fc.GetInterfaces()
 	if err != nil {
 		return err
@@ -195,7 +266,7 @@ func (fc *FabricConfig) Validate() error {
 		}
 	}
 
-	if len(interfaces) != numProv { // TODO SRS-31: check num ports when multiprovider fully enabled: || len(ports) != numProv {
+	if !fc.IsMultiHomed() && len(interfaces) != numProv { // TODO SRS-31: check num ports when multiprovider fully enabled: || len(ports) != numProv {
 		return errors.Errorf("provider, fabric_iface and fabric_iface_port must include the same number of items delimited by %q", MultiProviderSeparator)
 	}
 
@@ -257,6 +328,9 @@ type Config struct {
 	Fabric            FabricConfig   `yaml:",inline"`
 	EnvVars           []string       `yaml:"env_vars,omitempty"`
 	EnvPassThrough    []string       `yaml:"env_pass_through,omitempty"`
+	Rlimits           *RlimitConfig  `yaml:"rlimits,omitempty"`
+	RestartPolicy     *RestartPolicy `yaml:"restart_policy,omitempty"`
+	Standby           bool           `yaml:"standby,omitempty"`
 	PinnedNumaNode    *uint          `yaml:"pinned_numa_node,omitempty" cmdLongFlag:"--pinned_numa_node" cmdShortFlag:"-p"`
 	Index             uint32         `yaml:"-" cmdLongFlag:"--instance_idx" cmdShortFlag:"-I"`
 	MemSize           int            `yaml:"-" cmdLongFlag:"--mem_size" cmdShortFlag:"-r"`
@@ -347,6 +421,18 @@ func (c *Config) Validate() error {
 	if err := ValidateLogSubsystems(subsystems); err != nil {
 		return errors.Wrap(err, "validate engine log subsystems")
 	}
+
+	if err := c.validateEnvVars(); err != nil {
+		return errors.Wrap(err, "validate engine environment")
+	}
+
+	if err := c.Rlimits.Validate(); err != nil {
+		return errors.Wrap(err, "validate engine resource limits")
+	}
+
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return errors.Wrap(err, "validate engine restart policy")
+	}
 	return nil
 }
 
@@ -535,7 +621,12 @@ func (c *Config) CmdLineEnv() ([]string, error) {
 		env = common.MergeKeyValues(env, sEnv)
 	}
 
-	return common.MergeKeyValues(c.EnvVars, env), nil
+	envVars, err := c.expandEnvVars()
+	if err != nil {
+		return nil, err
+	}
+
+	return common.MergeKeyValues(envVars, env), nil
 }
 
 // HasEnvVar returns true if the configuration contains
@@ -579,6 +670,24 @@ func (c *Config) WithEnvPassThrough(allowList ...string) *Config {
 	return c
 }
 
+// WithRlimits sets the resource limits to be applied to the engine process.
+func (c *Config) WithRlimits(rc *RlimitConfig) *Config {
+	c.Rlimits = rc
+	return c
+}
+
+// WithRestartPolicy sets the policy for restarting the engine after it exits unexpectedly.
+func (c *Config) WithRestartPolicy(rp *RestartPolicy) *Config {
+	c.RestartPolicy = rp
+	return c
+}
+
+// WithStandby sets whether the engine should be left in standby rather than started.
+func (c *Config) WithStandby(standby bool) *Config {
+	c.Standby = standby
+	return c
+}
+
 // WithSystemName sets the system name to which the instance belongs.
 func (c *Config) WithSystemName(name string) *Config {
 	c.SystemName = name
@@ -624,6 +733,34 @@ func (c *Config) WithStorageEnableHotplug(enable bool) *Config {
 	return c
 }
 
+// WithStorageHotplugPollPeriod sets the SPDK hotplug poll period in microseconds.
+func (c *Config) WithStorageHotplugPollPeriod(usec uint64) *Config {
+	c.Storage.HotplugPollUsec = usec
+	return c
+}
+
+// WithStorageBdevOptions sets the SPDK bdev_io and data buffer pool sizes.
+func (c *Config) WithStorageBdevOptions(opts storage.BdevOptions) *Config {
+	c.Storage.BdevOptions = opts
+	return c
+}
+
+// WithStorageSpdkLog sets SPDK log levels and flags.
+func (c *Config) WithStorageSpdkLog(level, printLevel string, flags ...string) *Config {
+	c.Storage.SpdkLogProps = storage.SpdkLog{
+		Level:      level,
+		PrintLevel: printLevel,
+		Flags:      flags,
+	}
+	return c
+}
+
+// WithStorageSpdkEnvOptions sets options that run the SPDK environment without hugepages.
+func (c *Config) WithStorageSpdkEnvOptions(opts storage.SpdkEnvOptions) *Config {
+	c.Storage.SpdkEnvOpts = opts
+	return c
+}
+
 // WithStorageNumaNodeIndex sets the NUMA node index to be used by this instance.
 func (c *Config) WithStorageNumaNodeIndex(nodeIndex uint) *Config {
 	c.Storage.NumaNodeIndex = nodeIndex
@@ -666,6 +803,12 @@ func (c *Config) WithFabricInterface(iface string) *Config {
 	return c
 }
 
+// WithFabricInterfaces sets the prioritized network interfaces of a multi-homed instance.
+func (c *Config) WithFabricInterfaces(ifaces ...*FabricInterfaceConfig) *Config {
+	c.Fabric.Interfaces = ifaces
+	return c
+}
+
 // WithFabricInterfacePort sets the numeric interface port to be used by this instance.
 func (c *Config) WithFabricInterfacePort(ifacePort int) *Config {
 	c.Fabric.InterfacePort = ifacePort
@@ -782,6 +925,15 @@ func (c *Config) WithStorageSpdkRpcSrvProps(enable bool, sockAddr string) *Confi
 	return c
 }
 
+// WithStorageSpdkRpcSockPerms specifies the ownership and permissions of the SPDK JSON-RPC server
+// socket in the I/O Engine.
+func (c *Config) WithStorageSpdkRpcSockPerms(owner, group string, mode uint32) *Config {
+	c.Storage.SpdkRpcSrvProps.SockOwner = owner
+	c.Storage.SpdkRpcSrvProps.SockGroup = group
+	c.Storage.SpdkRpcSrvProps.SockMode = mode
+	return c
+}
+
 // WithStorageAutoFaultyCriteria specifies NVMe auto-faulty settings in the I/O Engine.
 func (c *Config) WithStorageAutoFaultyCriteria(enable bool, maxIoErrs, maxCsumErrs uint32) *Config {
 	c.Storage.AutoFaultyProps.Enable = enable
@@ -407,7 +407,7 @@ func TestConfig_BdevValidation(t *testing.T) {
 				),
 			expCls: storage.ClassFile,
 		},
-		"mix of emulated and non-emulated device classes": {
+		"mix of emulated and non-emulated device classes; no roles": {
 			cfg: baseValidConfig().
 				AppendStorage(
 					storage.NewTierConfig().
@@ -418,7 +418,7 @@ func TestConfig_BdevValidation(t *testing.T) {
 						WithBdevFileSize(10).
 						WithBdevDeviceList("bdev1", "bdev2"),
 				),
-			expErr: storage.FaultBdevConfigTierTypeMismatch,
+			expErr: storage.FaultBdevConfigMultiTiersWithoutRoles,
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -554,8 +554,9 @@ func multiProviderString(comp ...string) string {
 
 func TestConfig_FabricValidation(t *testing.T) {
 	for name, tc := range map[string]struct {
-		cfg    FabricConfig
-		expErr error
+		cfg      FabricConfig
+		expIface string
+		expErr   error
 	}{
 		"missing provider": {
 			cfg: FabricConfig{
@@ -651,10 +652,69 @@ func TestConfig_FabricValidation(t *testing.T) {
 			},
 			expErr: errors.New("must have one value for each"),
 		},
+		"multi-homed ok": {
+			cfg: FabricConfig{
+				Provider:      "foo",
+				InterfacePort: 42,
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net1", Priority: 1},
+					{Name: "net0"},
+				},
+			},
+			expIface: multiProviderString("net0", "net1"),
+		},
+		"multi-homed; multiple providers": {
+			cfg: FabricConfig{
+				Provider:      multiProviderString("foo", "bar"),
+				InterfacePort: 42,
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net0"},
+					{Name: "net1"},
+				},
+			},
+			expErr: errors.New("single provider"),
+		},
+		"multi-homed; duplicate interface": {
+			cfg: FabricConfig{
+				Provider:      "foo",
+				InterfacePort: 42,
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net0"},
+					{Name: "net0", Priority: 1},
+				},
+			},
+			expErr: errors.New("more than once"),
+		},
+		"multi-homed; missing name": {
+			cfg: FabricConfig{
+				Provider:      "foo",
+				InterfacePort: 42,
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net0"},
+					{Priority: 1},
+				},
+			},
+			expErr: errors.New("missing iface"),
+		},
+		"multi-homed; fabric_iface also set": {
+			cfg: FabricConfig{
+				Provider:      "foo",
+				Interface:     "net2",
+				InterfacePort: 42,
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net0"},
+					{Name: "net1"},
+				},
+			},
+			expErr: errors.New("mutually exclusive"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			gotErr := tc.cfg.Validate()
 			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expIface != "" {
+				test.AssertEqual(t, tc.expIface, tc.cfg.Interface, "unexpected fabric_iface")
+			}
 		})
 	}
 }
@@ -887,6 +947,16 @@ func TestFabricConfig_GetInterfaces(t *testing.T) {
 			},
 			expInterfaces: []string{"net1", "net2", "net3"},
 		},
+		"multi-homed": {
+			cfg: &FabricConfig{
+				Interfaces: []*FabricInterfaceConfig{
+					{Name: "net2", Priority: 2},
+					{Name: "net0"},
+					{Name: "net1", Priority: 2},
+				},
+			},
+			expInterfaces: []string{"net0", "net2", "net1"},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			interfaces, err := tc.cfg.GetInterfaces()
@@ -1076,6 +1146,17 @@ func TestFabricConfig_Update(t *testing.T) {
 				DisableSRX:    true,
 			},
 		},
+		"multi-homed not overwritten": {
+			fc: &FabricConfig{
+				Interfaces: []*FabricInterfaceConfig{{Name: "net0"}},
+			},
+			new: FabricConfig{
+				Interface: "iface",
+			},
+			expResult: &FabricConfig{
+				Interfaces: []*FabricInterfaceConfig{{Name: "net0"}},
+			},
+		},
 		"default secondary ctx": {
 			fc: &FabricConfig{},
 			new: FabricConfig{
@@ -0,0 +1,207 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+)
+
+// sysCoresPerEngine is the number of cores shared by an engine's system xstreams.
+const sysCoresPerEngine = 1
+
+// CoreRequirements describes the number of CPU cores needed to run an engine's execution streams
+// and where on the host they should be taken from.
+type CoreRequirements struct {
+	SysCores     int
+	ReactorCores int
+	Targets      int
+	Helpers      int
+	NumaNode     *uint
+	FirstCore    *int
+}
+
+// Total returns the number of cores required.
+func (cr *CoreRequirements) Total() int {
+	if cr == nil {
+		return 0
+	}
+	return cr.SysCores + cr.ReactorCores + cr.Targets + cr.Helpers
+}
+
+// CoreRequirements returns the CPU core requirements of the engine. Engines running in MD-on-SSD
+// mode drive SPDK metadata I/O from an extra sys-xstream which requires a dedicated reactor core.
+func (c *Config) CoreRequirements() *CoreRequirements {
+	cr := &CoreRequirements{
+		SysCores:  sysCoresPerEngine,
+		Targets:   c.TargetCount,
+		Helpers:   c.HelperStreamCount,
+		NumaNode:  c.PinnedNumaNode,
+		FirstCore: c.ServiceThreadCore,
+	}
+	if c.Storage.Tiers.HasBdevRoleMeta() {
+		cr.ReactorCores = 1
+	}
+
+	return cr
+}
+
+// CoreAllocation lists the host CPU cores assigned to an engine's execution streams.
+type CoreAllocation struct {
+	EngineIdx    int
+	NumaNode     *uint
+	SysCores     []uint
+	ReactorCores []uint
+	TargetCores  []uint
+	HelperCores  []uint
+}
+
+// Cores returns all cores assigned to the engine in execution stream order.
+func (ca *CoreAllocation) Cores() []uint {
+	if ca == nil {
+		return nil
+	}
+
+	var cores []uint
+	for _, set := range [][]uint{ca.SysCores, ca.ReactorCores, ca.TargetCores, ca.HelperCores} {
+		cores = append(cores, set...)
+	}
+
+	return cores
+}
+
+func (ca *CoreAllocation) String() string {
+	if ca == nil {
+		return "<nil>"
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "engine %d", ca.EngineIdx)
+	if ca.NumaNode != nil {
+		fmt.Fprintf(&bld, " (numa %d)", *ca.NumaNode)
+	}
+	fmt.Fprintf(&bld, ": sys %v", ca.SysCores)
+	if len(ca.ReactorCores) > 0 {
+		fmt.Fprintf(&bld, ", reactor %v", ca.ReactorCores)
+	}
+	fmt.Fprintf(&bld, ", targets %v", ca.TargetCores)
+	if len(ca.HelperCores) > 0 {
+		fmt.Fprintf(&bld, ", helpers %v", ca.HelperCores)
+	}
+
+	return bld.String()
+}
+
+func sortedCoreIDs(cores []hardware.CPUCore) []uint {
+	ids := make([]uint, 0, len(cores))
+	for _, core := range cores {
+		ids = append(ids, core.ID)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// selectCores returns the pool of host cores available to an engine with the given requirements.
+// In legacy mode, cores are taken in logical index order starting at first_core, otherwise they
+// are taken from the start of the pinned NUMA node.
+func selectCores(topo *hardware.Topology, idx int, cr *CoreRequirements) ([]uint, error) {
+	if cr.NumaNode == nil && cr.FirstCore != nil {
+		var all []hardware.CPUCore
+		for _, node := range topo.NUMANodes {
+			all = append(all, node.Cores...)
+		}
+		pool := sortedCoreIDs(all)
+
+		for i, id := range pool {
+			if id == uint(*cr.FirstCore) {
+				return pool[i:], nil
+			}
+		}
+		return nil, errors.Errorf("engine %d: first_core %d not found in host topology "+
+			"(%d cores)", idx, *cr.FirstCore, len(pool))
+	}
+
+	numaID := uint(0)
+	if cr.NumaNode != nil {
+		numaID = *cr.NumaNode
+	}
+	node, exists := topo.NUMANodes[numaID]
+	if !exists {
+		return nil, errors.Errorf("engine %d: NUMA node %d not found in host topology", idx,
+			numaID)
+	}
+
+	return sortedCoreIDs(node.Cores), nil
+}
+
+// PlanCores computes the CPU cores that each engine will bind its execution streams to on the
+// given host topology. An error is returned if any engine requires more cores than are available
+// to it or if a core would be shared by more than one engine.
+func PlanCores(topo *hardware.Topology, cfgs ...*Config) ([]*CoreAllocation, error) {
+	if topo == nil || topo.NumCoresPerNUMA() == 0 {
+		return nil, errors.New("no CPU cores found in host topology")
+	}
+
+	allocs := make([]*CoreAllocation, 0, len(cfgs))
+	owners := make(map[uint]int)
+	for idx, cfg := range cfgs {
+		cr := cfg.CoreRequirements()
+		if cr.Targets <= 0 {
+			return nil, errors.Errorf("engine %d: target count must be nonzero", idx)
+		}
+		if cr.Helpers < 0 {
+			return nil, errors.Errorf("engine %d: helper stream count must not be negative",
+				idx)
+		}
+
+		pool, err := selectCores(topo, idx, cr)
+		if err != nil {
+			return nil, err
+		}
+		if len(pool) < cr.Total() {
+			return nil, errors.Errorf("engine %d: %d cores required (%d sys, %d reactor, "+
+				"%d targets, %d helpers) but only %d available", idx, cr.Total(),
+				cr.SysCores, cr.ReactorCores, cr.Targets, cr.Helpers, len(pool))
+		}
+
+		take := func(n int) []uint {
+			if n == 0 {
+				return nil
+			}
+			set := pool[:n]
+			pool = pool[n:]
+			return set
+		}
+
+		alloc := &CoreAllocation{
+			EngineIdx:    idx,
+			NumaNode:     cr.NumaNode,
+			SysCores:     take(cr.SysCores),
+			ReactorCores: take(cr.ReactorCores),
+			TargetCores:  take(cr.Targets),
+			HelperCores:  take(cr.Helpers),
+		}
+
+		for _, core := range alloc.Cores() {
+			if owner, taken := owners[core]; taken {
+				return nil, errors.Errorf("engines %d and %d oversubscribe core %d",
+					owner, idx, core)
+			}
+			owners[core] = idx
+		}
+
+		allocs = append(allocs, alloc)
+	}
+
+	return allocs, nil
+}
@@ -0,0 +1,172 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestEngine_PlanCores(t *testing.T) {
+	numa0 := uint(0)
+	numa1 := uint(1)
+	mockTopo := &hardware.Topology{
+		NUMANodes: hardware.NodeMap{
+			0: hardware.MockNUMANode(0, 8),
+			1: hardware.MockNUMANode(1, 8, 8),
+		},
+	}
+	mdOnSSDTiers := []*storage.TierConfig{
+		storage.NewTierConfig().
+			WithStorageClass(storage.ClassRam.String()).
+			WithScmMountPoint("/mnt/daos"),
+		storage.NewTierConfig().
+			WithStorageClass(storage.ClassNvme.String()).
+			WithBdevDeviceList("0000:81:00.0").
+			WithBdevDeviceRoles(storage.BdevRoleAll),
+	}
+
+	for name, tc := range map[string]struct {
+		topo      *hardware.Topology
+		cfgs      []*Config
+		expAllocs []*CoreAllocation
+		expErr    error
+	}{
+		"nil topology": {
+			cfgs:   []*Config{NewConfig().WithTargetCount(4).WithPinnedNumaNode(0)},
+			expErr: errors.New("no CPU cores"),
+		},
+		"zero targets": {
+			topo:   mockTopo,
+			cfgs:   []*Config{NewConfig().WithPinnedNumaNode(0)},
+			expErr: errors.New("target count must be nonzero"),
+		},
+		"engine per numa node": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithPinnedNumaNode(0),
+				NewConfig().WithTargetCount(4).WithHelperStreamCount(0).
+					WithPinnedNumaNode(1),
+			},
+			expAllocs: []*CoreAllocation{
+				{
+					EngineIdx:   0,
+					NumaNode:    &numa0,
+					SysCores:    []uint{0},
+					TargetCores: []uint{1, 2, 3, 4},
+					HelperCores: []uint{5, 6},
+				},
+				{
+					EngineIdx:   1,
+					NumaNode:    &numa1,
+					SysCores:    []uint{8},
+					TargetCores: []uint{9, 10, 11, 12},
+				},
+			},
+		},
+		"md-on-ssd reactor core": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithHelperStreamCount(1).
+					WithPinnedNumaNode(1).WithStorage(mdOnSSDTiers...),
+			},
+			expAllocs: []*CoreAllocation{
+				{
+					EngineIdx:    0,
+					NumaNode:     &numa1,
+					SysCores:     []uint{8},
+					ReactorCores: []uint{9},
+					TargetCores:  []uint{10, 11, 12, 13},
+					HelperCores:  []uint{14},
+				},
+			},
+		},
+		"numa node missing": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithPinnedNumaNode(2),
+			},
+			expErr: errors.New("NUMA node 2 not found"),
+		},
+		"too few cores on numa node": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(6).WithPinnedNumaNode(0),
+			},
+			expErr: errors.New("9 cores required (1 sys, 0 reactor, 6 targets, 2 helpers) but only 8 available"),
+		},
+		"engines share numa node": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(2).WithPinnedNumaNode(0),
+				NewConfig().WithTargetCount(2).WithPinnedNumaNode(0),
+			},
+			expErr: errors.New("engines 0 and 1 oversubscribe core 0"),
+		},
+		"legacy first_core": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithHelperStreamCount(0).
+					WithServiceThreadCore(6),
+				NewConfig().WithTargetCount(4).WithHelperStreamCount(0).
+					WithServiceThreadCore(11),
+			},
+			expAllocs: []*CoreAllocation{
+				{
+					EngineIdx:   0,
+					SysCores:    []uint{6},
+					TargetCores: []uint{7, 8, 9, 10},
+				},
+				{
+					EngineIdx:   1,
+					SysCores:    []uint{11},
+					TargetCores: []uint{12, 13, 14, 15},
+				},
+			},
+		},
+		"legacy first_core; overlap": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithServiceThreadCore(0),
+				NewConfig().WithTargetCount(4).WithServiceThreadCore(4),
+			},
+			expErr: errors.New("engines 0 and 1 oversubscribe core 4"),
+		},
+		"legacy first_core; out of range": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(4).WithServiceThreadCore(16),
+			},
+			expErr: errors.New("first_core 16 not found"),
+		},
+		"legacy first_core; too few cores": {
+			topo: mockTopo,
+			cfgs: []*Config{
+				NewConfig().WithTargetCount(8).WithServiceThreadCore(10),
+			},
+			expErr: errors.New("but only 6 available"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotAllocs, gotErr := PlanCores(tc.topo, tc.cfgs...)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAllocs, gotAllocs); diff != "" {
+				t.Fatalf("unexpected allocations (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
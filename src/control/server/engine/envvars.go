@@ -0,0 +1,297 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+// EnvVarKind identifies the type of value accepted by an environment variable.
+type EnvVarKind int
+
+const (
+	// EnvVarString accepts any value.
+	EnvVarString EnvVarKind = iota
+	// EnvVarInt accepts a non-negative decimal integer.
+	EnvVarInt
+	// EnvVarBool accepts 0/1, true/false or yes/no.
+	EnvVarBool
+)
+
+func (k EnvVarKind) String() string {
+	switch k {
+	case EnvVarInt:
+		return "integer"
+	case EnvVarBool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+// EnvVarSpec describes an environment variable that is understood by the engine.
+type EnvVarSpec struct {
+	Name     string
+	Kind     EnvVarKind
+	Min      int64
+	Max      int64 // zero means unbounded
+	validate func(string) error
+}
+
+// Validate checks that the supplied value is acceptable for the variable.
+func (s *EnvVarSpec) Validate(value string) error {
+	switch s.Kind {
+	case EnvVarInt:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || i < s.Min || (s.Max != 0 && i > s.Max) {
+			if s.Max != 0 {
+				return errors.Errorf("env_var %s has invalid value %q (expected %s in "+
+					"range %d-%d)", s.Name, value, s.Kind, s.Min, s.Max)
+			}
+			return errors.Errorf("env_var %s has invalid value %q (expected %s >= %d)",
+				s.Name, value, s.Kind, s.Min)
+		}
+	case EnvVarBool:
+		switch strings.ToLower(value) {
+		case "0", "1", "true", "false", "yes", "no":
+		default:
+			return errors.Errorf("env_var %s has invalid value %q (expected %s)", s.Name,
+				value, s.Kind)
+		}
+	}
+
+	if s.validate != nil {
+		if err := s.validate(value); err != nil {
+			return errors.Wrapf(err, "env_var %s", s.Name)
+		}
+	}
+
+	return nil
+}
+
+// thirdPartyEnvPrefixes are prefixes of variables consumed by libraries linked into the engine
+// that are passed through without validation.
+var thirdPartyEnvPrefixes = []string{"FI_", "UCX_", "NA_", "HG_"}
+
+var knownEnvVars = func(specs ...*EnvVarSpec) map[string]*EnvVarSpec {
+	registry := make(map[string]*EnvVarSpec)
+	for _, spec := range specs {
+		registry[spec.Name] = spec
+	}
+	return registry
+}(
+	// Argobots
+	&EnvVarSpec{Name: "ABT_ENV_MAX_NUM_XSTREAMS", Kind: EnvVarInt, Min: 1},
+	&EnvVarSpec{Name: "ABT_MAX_NUM_XSTREAMS", Kind: EnvVarInt, Min: 1},
+	&EnvVarSpec{Name: "ABT_STACK_OVERFLOW_CHECK", Kind: EnvVarString},
+	&EnvVarSpec{Name: "ABT_THREAD_STACKSIZE", Kind: EnvVarInt},
+
+	// CaRT
+	&EnvVarSpec{Name: "CRT_CREDIT_EP_CTX", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "CRT_CTX_SHARE_ADDR", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "CRT_DISABLE_MEM_PIN", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "CRT_TIMEOUT", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "D_DOMAIN", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_INTERFACE", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_MRECV_BUF", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "D_PORT", Kind: EnvVarInt, Max: 65535},
+	&EnvVarSpec{Name: "D_PORT_AUTO_ADJUST", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "D_POST_INCR", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "D_POST_INIT", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "D_PROVIDER", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_PROVIDER_AUTH_KEY", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_QUOTA_RPCS", Kind: EnvVarInt},
+
+	// Logging
+	&EnvVarSpec{Name: envLogMasks, Kind: EnvVarString, validate: ValidateLogMasks},
+	&EnvVarSpec{Name: envLogDbgStreams, Kind: EnvVarString, validate: ValidateLogStreams},
+	&EnvVarSpec{Name: envLogSubsystems, Kind: EnvVarString, validate: ValidateLogSubsystems},
+	&EnvVarSpec{Name: "D_LOG_FILE", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_LOG_FILE_APPEND_PID", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "D_LOG_FILE_APPEND_RANK", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "D_LOG_SIZE", Kind: EnvVarString},
+	&EnvVarSpec{Name: "D_LOG_STDERR_IN_LOG", Kind: EnvVarBool},
+
+	// DAOS engine
+	&EnvVarSpec{Name: "DAOS_DTX_AGG_THD_AGE", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "DAOS_DTX_AGG_THD_CNT", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "DAOS_MD_CAP", Kind: EnvVarInt},
+	&EnvVarSpec{Name: "DAOS_SCHED_WATCHDOG_ALL", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "DAOS_STRICT_SHUTDOWN", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "DAOS_TARGET_OVERSUBSCRIBE", Kind: EnvVarBool},
+
+	// PMDK
+	&EnvVarSpec{Name: "PMEMOBJ_CONF", Kind: EnvVarString},
+
+	// Fabric libraries
+	&EnvVarSpec{Name: "FI_OFI_RXM_USE_SRX", Kind: EnvVarBool},
+	&EnvVarSpec{Name: "FI_UNIVERSE_SIZE", Kind: EnvVarInt},
+)
+
+// LookupEnvVar returns the registered specification for the named environment variable.
+func LookupEnvVar(name string) (*EnvVarSpec, bool) {
+	spec, found := knownEnvVars[name]
+	return spec, found
+}
+
+func isThirdPartyEnvVar(name string) bool {
+	for _, prefix := range thirdPartyEnvPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// envTemplateData provides the values that may be referenced from env_vars templates,
+// e.g. D_LOG_FILE=/tmp/daos_engine.{{.EngineIndex}}.log.
+type envTemplateData struct {
+	EngineIndex       uint32
+	TargetCount       int
+	HelperStreamCount int
+	NumaNode          uint
+	SystemName        string
+	Provider          string
+	Interface         string
+}
+
+func (c *Config) envTemplateData() *envTemplateData {
+	data := &envTemplateData{
+		EngineIndex:       c.Index,
+		TargetCount:       c.TargetCount,
+		HelperStreamCount: c.HelperStreamCount,
+		SystemName:        c.SystemName,
+		Provider:          c.Fabric.Provider,
+		Interface:         c.Fabric.Interface,
+	}
+	if c.PinnedNumaNode != nil {
+		data.NumaNode = *c.PinnedNumaNode
+	}
+
+	return data
+}
+
+// expandEnvVars returns env_vars with any templated values rendered using engine config values.
+func (c *Config) expandEnvVars() ([]string, error) {
+	if len(c.EnvVars) == 0 {
+		return c.EnvVars, nil
+	}
+
+	var data *envTemplateData
+	out := make([]string, 0, len(c.EnvVars))
+	for _, pair := range c.EnvVars {
+		if !strings.Contains(pair, "{{") {
+			out = append(out, pair)
+			continue
+		}
+
+		tmpl, err := template.New("env_var").Option("missingkey=error").Parse(pair)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing env_var %q", pair)
+		}
+		if data == nil {
+			data = c.envTemplateData()
+		}
+
+		var bld strings.Builder
+		if err := tmpl.Execute(&bld, data); err != nil {
+			return nil, errors.Wrapf(err, "expanding env_var %q", pair)
+		}
+		out = append(out, bld.String())
+	}
+
+	return out, nil
+}
+
+// validateEnvVars ensures that env_vars entries are well formed, that templates expand and that
+// values of registered variables are valid.
+func (c *Config) validateEnvVars() error {
+	envVars, err := c.expandEnvVars()
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range envVars {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return errors.Errorf("env_var %q is not in KEY=VALUE format", pair)
+		}
+
+		if spec, found := LookupEnvVar(kv[0]); found {
+			if err := spec.Validate(kv[1]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, name := range c.EnvPassThrough {
+		if name == "" || strings.Contains(name, "=") {
+			return errors.Errorf("env_pass_through entry %q is not a variable name", name)
+		}
+	}
+
+	return nil
+}
+
+// EnvVarWarnings returns advisory messages about env_vars and env_pass_through entries that are
+// not recognised, are set more than once or are overridden by other engine config parameters.
+func (c *Config) EnvVarWarnings() []string {
+	var warnings []string
+
+	cfgEnv, err := parseCmdTags(c, envTag, joinEnvVars, nil)
+	if err != nil {
+		return nil
+	}
+	for _, sc := range c.Storage.Tiers {
+		sEnv, err := parseCmdTags(sc, envTag, joinEnvVars, nil)
+		if err != nil {
+			return nil
+		}
+		cfgEnv = common.MergeKeyValues(cfgEnv, sEnv)
+	}
+
+	seen := make(map[string]string)
+	for _, pair := range c.EnvVars {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		name, value := kv[0], kv[1]
+
+		if prev, dupe := seen[name]; dupe && prev != value {
+			warnings = append(warnings, fmt.Sprintf("env_var %s is set more than once "+
+				"with different values (%q, %q)", name, prev, value))
+		}
+		seen[name] = value
+
+		if cfgVal, err := common.FindKeyValue(cfgEnv, name); err == nil && cfgVal != value {
+			warnings = append(warnings, fmt.Sprintf("env_var %s=%s is overridden by "+
+				"engine config value %q", name, value, cfgVal))
+		}
+
+		if _, found := LookupEnvVar(name); !found && !isThirdPartyEnvVar(name) {
+			warnings = append(warnings, fmt.Sprintf("env_var %s is not a known engine "+
+				"variable", name))
+		}
+	}
+
+	for _, name := range c.EnvPassThrough {
+		if _, set := seen[name]; set {
+			warnings = append(warnings, fmt.Sprintf("env_pass_through %s is ignored as "+
+				"it is also set in env_vars", name))
+		}
+	}
+
+	return warnings
+}
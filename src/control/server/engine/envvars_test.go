@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEngine_EnvVarSpec_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		envVar string
+		value  string
+		expErr error
+	}{
+		"integer": {
+			envVar: "CRT_TIMEOUT",
+			value:  "30",
+		},
+		"integer; not a number": {
+			envVar: "CRT_TIMEOUT",
+			value:  "thirty",
+			expErr: errors.New("env_var CRT_TIMEOUT has invalid value \"thirty\" (expected integer >= 0)"),
+		},
+		"integer; below minimum": {
+			envVar: "ABT_MAX_NUM_XSTREAMS",
+			value:  "0",
+			expErr: errors.New("expected integer >= 1"),
+		},
+		"integer; above maximum": {
+			envVar: "D_PORT",
+			value:  "70000",
+			expErr: errors.New("expected integer in range 0-65535"),
+		},
+		"boolean": {
+			envVar: "DAOS_TARGET_OVERSUBSCRIBE",
+			value:  "True",
+		},
+		"boolean; invalid": {
+			envVar: "FI_OFI_RXM_USE_SRX",
+			value:  "on",
+			expErr: errors.New("(expected boolean)"),
+		},
+		"log mask": {
+			envVar: "D_LOG_MASK",
+			value:  "DEBUG,MGMT=ERR",
+		},
+		"log mask; invalid": {
+			envVar: "D_LOG_MASK",
+			value:  "LOUD",
+			expErr: errors.New("env_var D_LOG_MASK"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			spec, found := LookupEnvVar(tc.envVar)
+			if !found {
+				t.Fatalf("%s not registered", tc.envVar)
+			}
+
+			test.CmpErr(t, tc.expErr, spec.Validate(tc.value))
+		})
+	}
+}
+
+func TestConfig_EnvVarTemplates(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg       *Config
+		expEnvVar string
+		expValue  string
+		expErr    error
+	}{
+		"no template": {
+			cfg:       MockConfig().WithEnvVars("D_LOG_FILE=/tmp/engine.log"),
+			expEnvVar: "D_LOG_FILE",
+			expValue:  "/tmp/engine.log",
+		},
+		"engine index": {
+			cfg: MockConfig().WithIndex(1).
+				WithEnvVars("D_LOG_FILE=/tmp/engine.{{.EngineIndex}}.log"),
+			expEnvVar: "D_LOG_FILE",
+			expValue:  "/tmp/engine.1.log",
+		},
+		"numa node and targets": {
+			cfg: MockConfig().WithPinnedNumaNode(1).WithTargetCount(8).
+				WithEnvVars("FOO={{.NumaNode}}-{{.TargetCount}}"),
+			expEnvVar: "FOO",
+			expValue:  "1-8",
+		},
+		"bad syntax": {
+			cfg:    MockConfig().WithEnvVars("FOO={{.EngineIndex"),
+			expErr: errors.New("parsing env_var"),
+		},
+		"unknown field": {
+			cfg:    MockConfig().WithEnvVars("FOO={{.Rank}}"),
+			expErr: errors.New("expanding env_var"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			env, err := tc.cfg.CmdLineEnv()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, pair := range env {
+				if pair == tc.expEnvVar+"="+tc.expValue {
+					return
+				}
+			}
+			t.Fatalf("%s=%s not found in %v", tc.expEnvVar, tc.expValue, env)
+		})
+	}
+}
+
+func TestConfig_validateEnvVars(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *Config
+		expErr error
+	}{
+		"no env vars": {
+			cfg: MockConfig(),
+		},
+		"valid env vars": {
+			cfg: MockConfig().WithEnvVars("CRT_TIMEOUT=30", "FOO=bar",
+				"D_LOG_FILE=/tmp/engine.{{.EngineIndex}}.log"),
+		},
+		"malformed pass-through entry": {
+			cfg:    MockConfig().WithEnvPassThrough("FOO=bar"),
+			expErr: errors.New("not a variable name"),
+		},
+		"invalid value": {
+			cfg:    MockConfig().WithEnvVars("DAOS_MD_CAP=lots"),
+			expErr: errors.New("DAOS_MD_CAP"),
+		},
+		"invalid templated value": {
+			cfg:    MockConfig().WithEnvVars("D_PORT={{.Interface}}"),
+			expErr: errors.New("D_PORT"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.validateEnvVars())
+		})
+	}
+}
+
+func TestConfig_EnvVarWarnings(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg         *Config
+		expWarnings []string
+	}{
+		"no env vars": {
+			cfg: MockConfig(),
+		},
+		"known and third-party env vars": {
+			cfg: MockConfig().WithEnvVars("CRT_TIMEOUT=30", "FI_UNIVERSE_SIZE=2048",
+				"UCX_TLS=rc"),
+		},
+		"unknown env var": {
+			cfg: MockConfig().WithEnvVars("D_LOG_MASKS=DEBUG"),
+			expWarnings: []string{
+				"env_var D_LOG_MASKS is not a known engine variable",
+			},
+		},
+		"conflicting duplicates": {
+			cfg: &Config{
+				EnvVars: []string{"CRT_TIMEOUT=30", "CRT_TIMEOUT=60"},
+			},
+			expWarnings: []string{
+				"env_var CRT_TIMEOUT is set more than once with different values (\"30\", \"60\")",
+			},
+		},
+		"overridden by config": {
+			cfg: MockConfig().WithLogMask("ERR").WithEnvVars("D_LOG_MASK=DEBUG"),
+			expWarnings: []string{
+				"env_var D_LOG_MASK=DEBUG is overridden by engine config value \"ERR\"",
+			},
+		},
+		"same value as config": {
+			cfg: MockConfig().WithLogMask("ERR").WithEnvVars("D_LOG_MASK=ERR"),
+		},
+		"pass-through also set": {
+			cfg: MockConfig().WithEnvVars("CRT_TIMEOUT=30").
+				WithEnvPassThrough("CRT_TIMEOUT"),
+			expWarnings: []string{
+				"env_pass_through CRT_TIMEOUT is ignored as it is also set in env_vars",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expWarnings, tc.cfg.EnvVarWarnings()); diff != "" {
+				t.Fatalf("unexpected warnings (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
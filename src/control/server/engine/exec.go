@@ -80,7 +80,7 @@ func (r *Runner) run(parent context.Context, args, env []string, exitCh RunnerEx
 	r.log.Debugf("%s:%d env: %s", engineBin, r.Config.Index, cmd.Env)
 	r.log.Infof("Starting I/O Engine instance %d: %s", r.Config.Index, binPath)
 
-	if err := cmd.Start(); err != nil {
+	if err := r.Config.withRlimits(cmd.Start); err != nil {
 		return errors.Wrapf(common.GetExitStatus(err),
 			"%s (instance %d) failed to start", binPath, r.Config.Index)
 	}
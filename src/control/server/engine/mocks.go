@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -74,6 +75,10 @@ func (tr *TestRunner) Start(ctx context.Context) (RunnerExitChan, error) {
 }
 
 func (tr *TestRunner) Signal(sig os.Signal) {
+	// Mirror Runner, which doesn't signal an engine that is not running.
+	if !tr.IsRunning() {
+		return
+	}
 	if tr.runnerCfg.SignalCb != nil {
 		tr.runnerCfg.SignalCb(tr.serverCfg.Index, sig)
 	}
@@ -0,0 +1,119 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RestartAction is the action taken when an engine exceeds the restart limit of its policy.
+type RestartAction string
+
+const (
+	// RestartActionStop leaves the engine stopped until it is started by an administrator.
+	RestartActionStop RestartAction = "stop"
+	// RestartActionStandby places the engine in standby mode so that it is not started
+	// until standby is cleared.
+	RestartActionStandby RestartAction = "standby"
+)
+
+const (
+	// DefaultRestartWindow is the period over which engine restarts are counted.
+	DefaultRestartWindow = 10 * time.Minute
+	// DefaultRestartBackoff is the delay before the first automatic restart.
+	DefaultRestartBackoff = 5 * time.Second
+	// DefaultRestartBackoffMax is the longest delay between automatic restarts.
+	DefaultRestartBackoffMax = 5 * time.Minute
+)
+
+// RestartPolicy defines how an engine is restarted after it exits unexpectedly. The delay
+// before each restart doubles from BackoffInitial up to BackoffMax, and once MaxRestarts
+// restarts have occurred within Window the engine is considered to be in a crash loop and
+// the OnGiveUp action is taken instead.
+type RestartPolicy struct {
+	MaxRestarts    int           `yaml:"max_restarts"`
+	Window         time.Duration `yaml:"window,omitempty"`
+	BackoffInitial time.Duration `yaml:"backoff_initial,omitempty"`
+	BackoffMax     time.Duration `yaml:"backoff_max,omitempty"`
+	OnGiveUp       RestartAction `yaml:"on_give_up,omitempty"`
+}
+
+// Validate checks the policy for invalid values.
+func (rp *RestartPolicy) Validate() error {
+	if rp == nil {
+		return nil
+	}
+
+	if rp.MaxRestarts <= 0 {
+		return errors.New("restart_policy: max_restarts must be greater than zero")
+	}
+	if rp.Window < 0 || rp.BackoffInitial < 0 || rp.BackoffMax < 0 {
+		return errors.New("restart_policy: durations must not be negative")
+	}
+	if rp.BackoffMax > 0 && rp.GetBackoffInitial() > rp.BackoffMax {
+		return errors.New("restart_policy: backoff_initial must not exceed backoff_max")
+	}
+
+	switch rp.OnGiveUp {
+	case "", RestartActionStop, RestartActionStandby:
+	default:
+		return errors.Errorf("restart_policy: unknown on_give_up action %q (expected %q or %q)",
+			rp.OnGiveUp, RestartActionStop, RestartActionStandby)
+	}
+
+	return nil
+}
+
+// GetWindow returns the period over which restarts are counted.
+func (rp *RestartPolicy) GetWindow() time.Duration {
+	if rp.Window == 0 {
+		return DefaultRestartWindow
+	}
+	return rp.Window
+}
+
+// GetBackoffInitial returns the delay before the first restart.
+func (rp *RestartPolicy) GetBackoffInitial() time.Duration {
+	if rp.BackoffInitial == 0 {
+		return DefaultRestartBackoff
+	}
+	return rp.BackoffInitial
+}
+
+// GetBackoffMax returns the longest delay between restarts.
+func (rp *RestartPolicy) GetBackoffMax() time.Duration {
+	if rp.BackoffMax == 0 {
+		return DefaultRestartBackoffMax
+	}
+	return rp.BackoffMax
+}
+
+// GetOnGiveUp returns the action taken when the restart limit is exceeded.
+func (rp *RestartPolicy) GetOnGiveUp() RestartAction {
+	if rp.OnGiveUp == "" {
+		return RestartActionStop
+	}
+	return rp.OnGiveUp
+}
+
+// Backoff returns the delay before a restart, given the number of restarts that have already
+// occurred within the window.
+func (rp *RestartPolicy) Backoff(prevRestarts int) time.Duration {
+	delay := rp.GetBackoffInitial()
+	for i := 0; i < prevRestarts; i++ {
+		if delay >= rp.GetBackoffMax()/2 {
+			return rp.GetBackoffMax()
+		}
+		delay *= 2
+	}
+	if delay > rp.GetBackoffMax() {
+		return rp.GetBackoffMax()
+	}
+	return delay
+}
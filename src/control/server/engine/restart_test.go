@@ -0,0 +1,122 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestEngine_RestartPolicy_Unmarshal(t *testing.T) {
+	in := `
+max_restarts: 3
+window: 30m
+backoff_initial: 10s
+backoff_max: 2m
+on_give_up: standby
+`
+	var rp RestartPolicy
+	if err := yaml.Unmarshal([]byte(in), &rp); err != nil {
+		t.Fatal(err)
+	}
+
+	exp := RestartPolicy{
+		MaxRestarts:    3,
+		Window:         30 * time.Minute,
+		BackoffInitial: 10 * time.Second,
+		BackoffMax:     2 * time.Minute,
+		OnGiveUp:       RestartActionStandby,
+	}
+	if diff := cmp.Diff(exp, rp); diff != "" {
+		t.Fatalf("unexpected policy (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestEngine_RestartPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rp     *RestartPolicy
+		expErr error
+	}{
+		"nil policy": {},
+		"defaults": {
+			rp: &RestartPolicy{MaxRestarts: 5},
+		},
+		"zero max restarts": {
+			rp:     &RestartPolicy{},
+			expErr: errors.New("max_restarts must be greater than zero"),
+		},
+		"negative window": {
+			rp:     &RestartPolicy{MaxRestarts: 1, Window: -time.Second},
+			expErr: errors.New("must not be negative"),
+		},
+		"initial exceeds max": {
+			rp: &RestartPolicy{
+				MaxRestarts:    1,
+				BackoffInitial: time.Minute,
+				BackoffMax:     time.Second,
+			},
+			expErr: errors.New("backoff_initial must not exceed backoff_max"),
+		},
+		"default initial exceeds max": {
+			rp:     &RestartPolicy{MaxRestarts: 1, BackoffMax: time.Second},
+			expErr: errors.New("backoff_initial must not exceed backoff_max"),
+		},
+		"unknown action": {
+			rp:     &RestartPolicy{MaxRestarts: 1, OnGiveUp: "reboot"},
+			expErr: errors.New("unknown on_give_up action"),
+		},
+		"standby action": {
+			rp: &RestartPolicy{MaxRestarts: 1, OnGiveUp: RestartActionStandby},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.rp.Validate())
+		})
+	}
+}
+
+func TestEngine_RestartPolicy_Backoff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rp       *RestartPolicy
+		restarts int
+		expDelay time.Duration
+	}{
+		"default initial": {
+			rp:       &RestartPolicy{MaxRestarts: 1},
+			expDelay: DefaultRestartBackoff,
+		},
+		"doubles": {
+			rp:       &RestartPolicy{MaxRestarts: 5, BackoffInitial: time.Second},
+			restarts: 3,
+			expDelay: 8 * time.Second,
+		},
+		"capped at max": {
+			rp: &RestartPolicy{
+				MaxRestarts:    5,
+				BackoffInitial: time.Second,
+				BackoffMax:     5 * time.Second,
+			},
+			restarts: 3,
+			expDelay: 5 * time.Second,
+		},
+		"large count does not overflow": {
+			rp:       &RestartPolicy{MaxRestarts: 1000},
+			restarts: 999,
+			expDelay: DefaultRestartBackoffMax,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expDelay, tc.rp.Backoff(tc.restarts), "unexpected delay")
+		})
+	}
+}
@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// RlimitUnlimited is the value of a resource limit that imposes no restriction (RLIM_INFINITY).
+const RlimitUnlimited Rlimit = ^Rlimit(0)
+
+// The syscall package variants are used to adjust limits so that the Go runtime does not reset
+// the open file limit of child processes to its value at start-up.
+var (
+	getRlimit = syscall.Getrlimit
+	setRlimit = syscall.Setrlimit
+
+	// rlimitMu serializes engine launches that temporarily adjust the limits of the server
+	// process so that they are inherited by the engine.
+	rlimitMu sync.Mutex
+)
+
+// Rlimit is a resource limit value which may be specified in the config as "unlimited", a plain
+// integer or, for size based limits, a human readable size (e.g. 64GiB).
+type Rlimit uint64
+
+func (rl Rlimit) String() string {
+	if rl == RlimitUnlimited {
+		return "unlimited"
+	}
+	return strconv.FormatUint(uint64(rl), 10)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (rl Rlimit) MarshalYAML() (interface{}, error) {
+	if rl == RlimitUnlimited {
+		return rl.String(), nil
+	}
+	return uint64(rl), nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (rl *Rlimit) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+
+	switch strings.ToLower(strings.TrimSpace(str)) {
+	case "unlimited", "infinity":
+		*rl = RlimitUnlimited
+		return nil
+	}
+
+	val, err := humanize.ParseBytes(str)
+	if err != nil {
+		return errors.Errorf("invalid resource limit %q", str)
+	}
+	*rl = Rlimit(val)
+
+	return nil
+}
+
+// RlimitConfig specifies resource limits to be applied to an engine process. Limits that are not
+// set are inherited from daos_server.
+type RlimitConfig struct {
+	MemLock *Rlimit `yaml:"memlock,omitempty"`
+	NoFile  *Rlimit `yaml:"nofile,omitempty"`
+	Core    *Rlimit `yaml:"core,omitempty"`
+}
+
+type rlimitSetting struct {
+	name     string
+	resource int
+	value    Rlimit
+}
+
+// settings returns the configured limits in a stable order.
+func (rc *RlimitConfig) settings() []rlimitSetting {
+	if rc == nil {
+		return nil
+	}
+
+	var settings []rlimitSetting
+	for _, s := range []struct {
+		name     string
+		resource int
+		value    *Rlimit
+	}{
+		{"memlock", unix.RLIMIT_MEMLOCK, rc.MemLock},
+		{"nofile", unix.RLIMIT_NOFILE, rc.NoFile},
+		{"core", unix.RLIMIT_CORE, rc.Core},
+	} {
+		if s.value != nil {
+			settings = append(settings, rlimitSetting{s.name, s.resource, *s.value})
+		}
+	}
+
+	return settings
+}
+
+// Validate checks the configured limits for values that can never be applied.
+func (rc *RlimitConfig) Validate() error {
+	if rc == nil || rc.NoFile == nil {
+		return nil
+	}
+
+	switch *rc.NoFile {
+	case 0:
+		return errors.New("rlimits: nofile must be nonzero")
+	case RlimitUnlimited:
+		return errors.New("rlimits: nofile cannot be unlimited")
+	}
+
+	return nil
+}
+
+// RlimitReport describes a configured engine resource limit alongside the limits of the
+// daos_server process.
+type RlimitReport struct {
+	Resource  string
+	Requested Rlimit
+	Soft      Rlimit
+	Hard      Rlimit
+}
+
+// ExceedsHard indicates whether the requested limit is above the current hard limit and can
+// therefore only be applied by a privileged process.
+func (rr *RlimitReport) ExceedsHard() bool {
+	return rr.Requested > rr.Hard
+}
+
+func (rr *RlimitReport) String() string {
+	return fmt.Sprintf("%s: requested %s (current soft %s, hard %s)", rr.Resource,
+		rr.Requested, rr.Soft, rr.Hard)
+}
+
+// CheckRlimits compares the engine's configured resource limits with those of the current
+// process. An error is returned if a limit exceeds the current hard limit and the process is not
+// privileged to raise it.
+func (c *Config) CheckRlimits(privileged bool) ([]*RlimitReport, error) {
+	var reports []*RlimitReport
+	for _, s := range c.Rlimits.settings() {
+		var cur syscall.Rlimit
+		if err := getRlimit(s.resource, &cur); err != nil {
+			return nil, errors.Wrapf(err, "reading %s limit", s.name)
+		}
+
+		rr := &RlimitReport{
+			Resource:  s.name,
+			Requested: s.value,
+			Soft:      Rlimit(cur.Cur),
+			Hard:      Rlimit(cur.Max),
+		}
+		if rr.ExceedsHard() && !privileged {
+			return nil, errors.Errorf("engine %d: rlimits %s %s exceeds hard limit %s",
+				c.Index, s.name, rr.Requested, rr.Hard)
+		}
+		reports = append(reports, rr)
+	}
+
+	return reports, nil
+}
+
+// withRlimits runs the supplied function with the process resource limits temporarily set to
+// the engine's configured values so that they are inherited by a child started within it. The
+// original limits are restored before returning.
+func (c *Config) withRlimits(fn func() error) error {
+	settings := c.Rlimits.settings()
+	if len(settings) == 0 {
+		return fn()
+	}
+
+	rlimitMu.Lock()
+	defer rlimitMu.Unlock()
+
+	saved := make(map[int]syscall.Rlimit)
+	defer func() {
+		for resource, orig := range saved {
+			orig := orig
+			_ = setRlimit(resource, &orig)
+		}
+	}()
+
+	for _, s := range settings {
+		var orig syscall.Rlimit
+		if err := getRlimit(s.resource, &orig); err != nil {
+			return errors.Wrapf(err, "reading %s limit", s.name)
+		}
+
+		// Only raise the hard limit when needed as lowering it cannot be undone without
+		// privileges.
+		next := syscall.Rlimit{Cur: uint64(s.value), Max: orig.Max}
+		if next.Cur > next.Max {
+			next.Max = next.Cur
+		}
+		if err := setRlimit(s.resource, &next); err != nil {
+			return errors.Wrapf(err, "engine %d: setting %s limit to %s", c.Index, s.name,
+				s.value)
+		}
+		saved[s.resource] = orig
+	}
+
+	return fn()
+}
@@ -0,0 +1,243 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func mockRlimits(t *testing.T, limits map[int]syscall.Rlimit, setErr error) map[int]syscall.Rlimit {
+	t.Helper()
+
+	set := make(map[int]syscall.Rlimit)
+	getRlimit = func(resource int, rlim *syscall.Rlimit) error {
+		cur, found := limits[resource]
+		if !found {
+			return errors.Errorf("unexpected resource %d", resource)
+		}
+		*rlim = cur
+		return nil
+	}
+	setRlimit = func(resource int, rlim *syscall.Rlimit) error {
+		if setErr != nil {
+			return setErr
+		}
+		set[resource] = *rlim
+		limits[resource] = *rlim
+		return nil
+	}
+	t.Cleanup(func() {
+		getRlimit = syscall.Getrlimit
+		setRlimit = syscall.Setrlimit
+	})
+
+	return set
+}
+
+func TestEngine_RlimitConfig_Unmarshal(t *testing.T) {
+	unlimited := RlimitUnlimited
+	memlock := Rlimit(64 << 30)
+	nofile := Rlimit(65536)
+
+	for name, tc := range map[string]struct {
+		in     string
+		expCfg *RlimitConfig
+		expErr error
+	}{
+		"empty": {
+			in:     "{}",
+			expCfg: &RlimitConfig{},
+		},
+		"all set": {
+			in: `
+memlock: 64GiB
+nofile: 65536
+core: unlimited
+`,
+			expCfg: &RlimitConfig{
+				MemLock: &memlock,
+				NoFile:  &nofile,
+				Core:    &unlimited,
+			},
+		},
+		"infinity": {
+			in: "memlock: infinity",
+			expCfg: &RlimitConfig{
+				MemLock: &unlimited,
+			},
+		},
+		"invalid value": {
+			in:     "nofile: lots",
+			expErr: errors.New("invalid resource limit \"lots\""),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCfg := new(RlimitConfig)
+			err := yaml.UnmarshalStrict([]byte(tc.in), gotCfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, gotCfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEngine_RlimitConfig_Validate(t *testing.T) {
+	zero := Rlimit(0)
+	unlimited := RlimitUnlimited
+	nofile := Rlimit(1024)
+
+	for name, tc := range map[string]struct {
+		cfg    *RlimitConfig
+		expErr error
+	}{
+		"nil config": {},
+		"valid": {
+			cfg: &RlimitConfig{MemLock: &unlimited, NoFile: &nofile, Core: &zero},
+		},
+		"zero nofile": {
+			cfg:    &RlimitConfig{NoFile: &zero},
+			expErr: errors.New("nofile must be nonzero"),
+		},
+		"unlimited nofile": {
+			cfg:    &RlimitConfig{NoFile: &unlimited},
+			expErr: errors.New("nofile cannot be unlimited"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
+
+func TestEngine_Config_CheckRlimits(t *testing.T) {
+	memlock := Rlimit(64 << 30)
+	nofile := Rlimit(4096)
+
+	for name, tc := range map[string]struct {
+		cfg        *RlimitConfig
+		privileged bool
+		expReports []*RlimitReport
+		expErr     error
+	}{
+		"no limits": {},
+		"within hard limits": {
+			cfg: &RlimitConfig{NoFile: &nofile},
+			expReports: []*RlimitReport{
+				{Resource: "nofile", Requested: 4096, Soft: 1024, Hard: 524288},
+			},
+		},
+		"exceeds hard limit": {
+			cfg:    &RlimitConfig{MemLock: &memlock, NoFile: &nofile},
+			expErr: errors.New("engine 1: rlimits memlock 68719476736 exceeds hard limit 65536"),
+		},
+		"exceeds hard limit; privileged": {
+			cfg:        &RlimitConfig{MemLock: &memlock},
+			privileged: true,
+			expReports: []*RlimitReport{
+				{Resource: "memlock", Requested: memlock, Soft: 65536, Hard: 65536},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRlimits(t, map[int]syscall.Rlimit{
+				unix.RLIMIT_MEMLOCK: {Cur: 65536, Max: 65536},
+				unix.RLIMIT_NOFILE:  {Cur: 1024, Max: 524288},
+			}, nil)
+
+			cfg := MockConfig().WithIndex(1).WithRlimits(tc.cfg)
+			gotReports, gotErr := cfg.CheckRlimits(tc.privileged)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReports, gotReports); diff != "" {
+				t.Fatalf("unexpected reports (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEngine_Config_withRlimits(t *testing.T) {
+	unlimited := RlimitUnlimited
+	nofile := Rlimit(4096)
+	orig := map[int]syscall.Rlimit{
+		unix.RLIMIT_MEMLOCK: {Cur: 65536, Max: 65536},
+		unix.RLIMIT_NOFILE:  {Cur: 1024, Max: 524288},
+	}
+
+	for name, tc := range map[string]struct {
+		cfg       *RlimitConfig
+		setErr    error
+		expInFn   map[int]syscall.Rlimit
+		expCalled bool
+		expErr    error
+	}{
+		"no limits": {
+			expInFn:   orig,
+			expCalled: true,
+		},
+		"limits applied during call": {
+			cfg: &RlimitConfig{MemLock: &unlimited, NoFile: &nofile},
+			expInFn: map[int]syscall.Rlimit{
+				unix.RLIMIT_MEMLOCK: {Cur: uint64(unlimited), Max: uint64(unlimited)},
+				unix.RLIMIT_NOFILE:  {Cur: 4096, Max: 524288},
+			},
+			expCalled: true,
+		},
+		"set fails": {
+			cfg:    &RlimitConfig{NoFile: &nofile},
+			setErr: errors.New("operation not permitted"),
+			expErr: errors.New("setting nofile limit to 4096"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			limits := make(map[int]syscall.Rlimit)
+			for k, v := range orig {
+				limits[k] = v
+			}
+			mockRlimits(t, limits, tc.setErr)
+
+			var inFn map[int]syscall.Rlimit
+			called := false
+			cfg := MockConfig().WithRlimits(tc.cfg)
+			gotErr := cfg.withRlimits(func() error {
+				called = true
+				inFn = make(map[int]syscall.Rlimit)
+				for k, v := range limits {
+					inFn[k] = v
+				}
+				return nil
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expCalled, called, "unexpected call state")
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expInFn, inFn); diff != "" {
+				t.Fatalf("unexpected limits during call (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(orig, limits); diff != "" {
+				t.Fatalf("limits not restored (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
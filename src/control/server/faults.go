@@ -187,6 +187,18 @@ func FaultJoinReplaceEnabledPoolRank(rank ranklist.Rank, poolIDs ...string) *fau
 	)
 }
 
+// FaultEraseEnabledPoolRanks indicates that ranks selected for erasure are still enabled in pools.
+func FaultEraseEnabledPoolRanks(ranks *ranklist.RankSet, poolIDs ...string) *fault.Fault {
+	return serverFault(
+		code.ServerEraseEnabledPoolRanks,
+		fmt.Sprintf("%s %s %s enabled on %s %s and cannot be erased until excluded on all pools",
+			english.PluralWord(ranks.Count(), "rank", "ranks"), ranks,
+			english.PluralWord(ranks.Count(), "is", "are"),
+			english.PluralWord(len(poolIDs), "pool", "pools"), strings.Join(poolIDs, ",")),
+		"run dmg system exclude with the same ranks or hosts to exclude them from all system pools then attempt dmg system erase again",
+	)
+}
+
 // FaultRankAdminExcluded indicates that the given rank list is administratively excluded.
 func FaultRankAdminExcluded(ranks ranklist.RankList) *fault.Fault {
 	return serverFault(
@@ -197,6 +209,62 @@ func FaultRankAdminExcluded(ranks ranklist.RankList) *fault.Fault {
 	)
 }
 
+// FaultPoolResizeShrink indicates that a pool resize request would reduce the per-rank size of
+// a storage tier.
+func FaultPoolResizeShrink(tierIdx int, cur, req uint64) *fault.Fault {
+	return serverFault(
+		code.ServerPoolResizeShrink,
+		fmt.Sprintf("pool resize would shrink storage tier %d from %s to %s per rank", tierIdx,
+			humanize.IBytes(cur), humanize.IBytes(req)),
+		"retry the request with per-rank sizes that are no smaller than the current sizes",
+	)
+}
+
+// FaultPoolResizeTier indicates that a pool resize request would grow a storage tier that the
+// engines are unable to grow.
+func FaultPoolResizeTier(tierIdx int, reason string) *fault.Fault {
+	return serverFault(
+		code.ServerPoolResizeTier,
+		fmt.Sprintf("pool resize cannot grow storage tier %d: %s", tierIdx, reason),
+		"retry the request changing only the per-rank size of the NVMe tier",
+	)
+}
+
+// FaultJobNotFound indicates that a job was not found on the MS leader.
+func FaultJobNotFound(id string) *fault.Fault {
+	return serverFault(
+		code.ServerJobNotFound,
+		fmt.Sprintf("job %s not found", id),
+		"run 'dmg job list' to see the jobs held by the current management service leader",
+	)
+}
+
+// FaultAuditLogDisabled indicates that audit logging has not been enabled on the server.
+var FaultAuditLogDisabled = serverFault(
+	code.ServerAuditLogDisabled,
+	"audit logging is not enabled on this server",
+	"set audit_log_file in the server config file and restart the server to record management requests",
+)
+
+// FaultCertsNotInUse indicates that certificates cannot be reloaded because the server is
+// running in insecure mode.
+var FaultCertsNotInUse = serverFault(
+	code.ServerCertsNotInUse,
+	"certificates are not in use on this server",
+	"set allow_insecure to false in the server config file transport_config section and restart the server to enable certificates",
+)
+
+// FaultIncompatibleAPIVersion indicates that the control API version of the client is older
+// than the oldest version supported by the server.
+func FaultIncompatibleAPIVersion(other uint32) *fault.Fault {
+	return serverFault(
+		code.ServerIncompatibleAPIVersion,
+		fmt.Sprintf("client control API version %d is not supported (minimum %d)", other,
+			build.MinControlAPIVersion),
+		"upgrade the client to a version that is compatible with the server",
+	)
+}
+
 func serverFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "server",
@@ -52,11 +52,14 @@ type Engine interface {
 
 	// This is a more reasonable surface that will be easier to maintain and test.
 	CallDrpc(context.Context, drpc.Method, proto.Message) (*drpc.Response, error)
+	DrpcCallsInFlight() int64
 	GetRank() (ranklist.Rank, error)
 	GetTargetCount() int
 	Index() uint32
 	IsStarted() bool
 	IsReady() bool
+	IsStandby() bool
+	SetStandby(bool) error
 	LocalState() system.MemberState
 	RemoveSuperblock() error
 	Run(context.Context)
@@ -0,0 +1,70 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/health"
+)
+
+// harnessLiveCheck fails if the engine harness is not running.
+func harnessLiveCheck(h *EngineHarness) health.CheckFn {
+	return func(_ context.Context) error {
+		if !h.isStarted() {
+			return errors.New("engine harness is not running")
+		}
+		return nil
+	}
+}
+
+// engineReadyCheck fails unless the engine is started, has a superblock with a rank, has
+// joined the system and responds to dRPC.
+func engineReadyCheck(ei Engine) health.CheckFn {
+	return func(ctx context.Context) error {
+		if !ei.IsStarted() {
+			return errors.New("engine is not started")
+		}
+		if _, err := ei.GetRank(); err != nil {
+			return errors.Wrap(err, "engine has no rank")
+		}
+		if !ei.IsReady() {
+			return errors.New("engine has not joined the system")
+		}
+
+		res := ei.tryDrpc(ctx, daos.MethodPingRank)
+		switch {
+		case res == nil:
+			return errors.New("no dRPC response")
+		case res.Errored:
+			return errors.Errorf("dRPC ping failed: %s", res.Msg)
+		}
+		return nil
+	}
+}
+
+// healthConfig returns the checks served on the health probe endpoints.
+func (srv *server) healthConfig() *health.Config {
+	cfg := &health.Config{
+		Liveness: []*health.Check{
+			{Name: "harness", Fn: harnessLiveCheck(srv.harness)},
+			{Name: "drpc", Fn: health.DrpcSocketCheck(getDrpcServerSocketPath(srv.cfg.SocketDir))},
+		},
+	}
+	for _, ei := range srv.harness.Instances() {
+		cfg.Readiness = append(cfg.Readiness, &health.Check{
+			Name: fmt.Sprintf("engine-%d", ei.Index()),
+			Fn:   engineReadyCheck(ei),
+		})
+	}
+
+	return cfg
+}
@@ -0,0 +1,92 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_harnessLiveCheck(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	h := NewEngineHarness(log)
+	check := harnessLiveCheck(h)
+
+	test.CmpErr(t, errors.New("not running"), check(test.Context(t)))
+
+	h.started.SetTrue()
+	test.CmpErr(t, nil, check(test.Context(t)))
+}
+
+func TestServer_engineReadyCheck(t *testing.T) {
+	for name, tc := range map[string]struct {
+		miCfg  *MockInstanceConfig
+		expErr error
+	}{
+		"not started": {
+			miCfg:  &MockInstanceConfig{},
+			expErr: errors.New("not started"),
+		},
+		"no rank": {
+			miCfg: &MockInstanceConfig{
+				Started:    atm.NewBool(true),
+				GetRankErr: errors.New("nil superblock"),
+			},
+			expErr: errors.New("engine has no rank: nil superblock"),
+		},
+		"not joined": {
+			miCfg: &MockInstanceConfig{
+				Started:     atm.NewBool(true),
+				GetRankResp: ranklist.Rank(1),
+			},
+			expErr: errors.New("not joined"),
+		},
+		"no drpc response": {
+			miCfg: &MockInstanceConfig{
+				Started:     atm.NewBool(true),
+				Ready:       atm.NewBool(true),
+				GetRankResp: ranklist.Rank(1),
+			},
+			expErr: errors.New("no dRPC response"),
+		},
+		"drpc ping failed": {
+			miCfg: &MockInstanceConfig{
+				Started:     atm.NewBool(true),
+				Ready:       atm.NewBool(true),
+				GetRankResp: ranklist.Rank(1),
+				TryDrpcResult: &system.MemberResult{
+					Errored: true,
+					Msg:     "timed out",
+				},
+			},
+			expErr: errors.New("dRPC ping failed: timed out"),
+		},
+		"ready": {
+			miCfg: &MockInstanceConfig{
+				Started:       atm.NewBool(true),
+				Ready:         atm.NewBool(true),
+				GetRankResp:   ranklist.Rank(1),
+				TryDrpcResult: &system.MemberResult{Rank: 1},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			check := engineReadyCheck(NewMockInstance(tc.miCfg))
+
+			test.CmpErr(t, tc.expErr, check(test.Context(t)))
+		})
+	}
+}
@@ -0,0 +1,363 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// Paths served by the HTTP management gateway.
+const (
+	httpGatewaySystemPath  = "/v1/system"
+	httpGatewayPoolsPath   = "/v1/pools"
+	httpGatewayHealthPath  = "/v1/storage/health"
+	httpGatewayPoolsPrefix = httpGatewayPoolsPath + "/"
+)
+
+// httpGatewayReadTimeout bounds the time taken by a client to send the headers
+// of a request.
+const httpGatewayReadTimeout = 10 * time.Second
+
+var httpGatewayMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// httpError is an error that is returned to the client of the HTTP management
+// gateway with the given status code.
+type httpError struct {
+	code int
+	msg  string
+}
+
+func (he *httpError) Error() string {
+	return he.msg
+}
+
+func errHTTP(code int, format string, args ...interface{}) error {
+	return &httpError{code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+// httpGatewayCall is a management request built from a HTTP request.
+type httpGatewayCall struct {
+	method string // full gRPC method name, used for access checks and auditing
+	req    proto.Message
+	invoke grpc.UnaryHandler
+}
+
+// httpGateway serves read-only management requests as HTTP GET requests with
+// JSON responses. Each request is mapped onto the equivalent gRPC method and
+// passes through the same interceptors as a gRPC request, so that clients are
+// subject to the same access checks and requests are audited in the same way.
+type httpGateway struct {
+	log          logging.Logger
+	port         int
+	sysName      string
+	verifier     *security.TokenVerifier // nil unless token authentication is configured
+	interceptors []grpc.UnaryServerInterceptor
+	ctlSvc       ctlpb.CtlSvcServer
+	mgmtSvc      mgmtpb.MgmtSvcServer
+}
+
+func newHTTPGateway(log logging.Logger, port int, sysName string, cfg *security.TransportConfig, ctlSvc ctlpb.CtlSvcServer, mgmtSvc mgmtpb.MgmtSvcServer, audit *auditLog, metrics *controlMetrics) (*httpGateway, error) {
+	if cfg == nil {
+		return nil, errors.New("nil TransportConfig")
+	}
+
+	gw := &httpGateway{
+		log:          log,
+		port:         port,
+		sysName:      sysName,
+		interceptors: []grpc.UnaryServerInterceptor{unaryCorrelationInterceptor},
+		ctlSvc:       ctlSvc,
+		mgmtSvc:      mgmtSvc,
+	}
+
+	if cfg.TokenAuth != nil {
+		verifier, err := security.NewTokenVerifier(cfg.TokenAuth)
+		if err != nil {
+			return nil, err
+		}
+		gw.verifier = verifier
+	}
+	if metrics != nil {
+		gw.interceptors = append(gw.interceptors, unaryMetricsInterceptor(metrics))
+	}
+	if audit != nil {
+		// record errors from the subsequent checks, e.g. access denied
+		gw.interceptors = append(gw.interceptors, unaryAuditInterceptor(audit, cfg.ClientRoles))
+	}
+	gw.interceptors = append(gw.interceptors, unaryStatusInterceptor)
+
+	accessInt, err := unaryInterceptorForTransportConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if accessInt != nil {
+		gw.interceptors = append(gw.interceptors, accessInt)
+	}
+
+	return gw, nil
+}
+
+// newCall maps the HTTP request onto a management request.
+func (gw *httpGateway) newCall(r *http.Request) (*httpGatewayCall, error) {
+	query := r.URL.Query()
+
+	switch path := strings.TrimSuffix(r.URL.Path, "/"); {
+	case path == httpGatewaySystemPath:
+		req := &mgmtpb.SystemQueryReq{
+			Sys:   gw.sysName,
+			Ranks: query.Get("ranks"),
+			Hosts: query.Get("hosts"),
+		}
+		if _, err := ranklist.CreateRankSet(req.Ranks); err != nil {
+			return nil, errHTTP(http.StatusBadRequest, "invalid ranks: %s", err)
+		}
+		if _, err := hostlist.CreateSet(req.Hosts); err != nil {
+			return nil, errHTTP(http.StatusBadRequest, "invalid hosts: %s", err)
+		}
+		return &httpGatewayCall{
+			method: mgmtpb.MgmtSvc_SystemQuery_FullMethodName,
+			req:    req,
+			invoke: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return gw.mgmtSvc.SystemQuery(ctx, req)
+			},
+		}, nil
+	case path == httpGatewayPoolsPath:
+		req := &mgmtpb.ListPoolsReq{Sys: gw.sysName}
+		return &httpGatewayCall{
+			method: mgmtpb.MgmtSvc_ListPools_FullMethodName,
+			req:    req,
+			invoke: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return gw.mgmtSvc.ListPools(ctx, req)
+			},
+		}, nil
+	case strings.HasPrefix(path, httpGatewayPoolsPrefix):
+		id := strings.TrimPrefix(path, httpGatewayPoolsPrefix)
+		if id == "" || strings.Contains(id, "/") {
+			break
+		}
+		req := &mgmtpb.PoolQueryReq{Sys: gw.sysName, Id: id}
+		return &httpGatewayCall{
+			method: mgmtpb.MgmtSvc_PoolQuery_FullMethodName,
+			req:    req,
+			invoke: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return gw.mgmtSvc.PoolQuery(ctx, req)
+			},
+		}, nil
+	case path == httpGatewayHealthPath:
+		req := &ctlpb.SmdQueryReq{
+			OmitPools:        true,
+			IncludeBioHealth: true,
+			Rank:             uint32(ranklist.NilRank),
+		}
+		return &httpGatewayCall{
+			method: ctlpb.CtlSvc_SmdQuery_FullMethodName,
+			req:    req,
+			invoke: func(ctx context.Context, _ interface{}) (interface{}, error) {
+				return gw.ctlSvc.SmdQuery(ctx, req)
+			},
+		}, nil
+	}
+
+	return nil, errHTTP(http.StatusNotFound, "unknown path %q", r.URL.Path)
+}
+
+// httpRequestContext returns a context carrying the peer and bearer token of
+// the HTTP request in the same form as they are supplied for gRPC requests.
+// Client-reported identity headers are not forwarded, the user recorded for
+// the request is derived from the verified token or certificate.
+func httpRequestContext(r *http.Request) context.Context {
+	clientPeer := new(peer.Peer)
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		clientPeer.Addr = addr
+	}
+	if r.TLS != nil {
+		clientPeer.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+
+	md := metadata.MD{}
+	if token := r.Header.Get(security.TokenAuthHeader); token != "" {
+		md.Set(security.TokenAuthHeader, token)
+	}
+
+	return metadata.NewIncomingContext(peer.NewContext(r.Context(), clientPeer), md)
+}
+
+func (gw *httpGateway) handle(ctx context.Context, call *httpGatewayCall) (interface{}, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: call.method}
+
+	handler := call.invoke
+	for i := len(gw.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := gw.interceptors[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	return handler(ctx, call.req)
+}
+
+func (gw *httpGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		gw.writeError(w, r, errHTTP(http.StatusMethodNotAllowed, "method %s not allowed", r.Method))
+		return
+	}
+
+	call, err := gw.newCall(r)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+
+	ctx := httpRequestContext(r)
+	if r.Header.Get(security.TokenAuthHeader) != "" {
+		if gw.verifier == nil {
+			gw.writeError(w, r, errHTTP(http.StatusUnauthorized,
+				"bearer token authentication is not configured"))
+			return
+		}
+		if ctx, err = authenticateToken(ctx, gw.verifier); err != nil {
+			gw.writeError(w, r, err)
+			return
+		}
+	}
+
+	resp, err := gw.handle(ctx, call)
+	if err != nil {
+		gw.writeError(w, r, err)
+		return
+	}
+
+	data, err := httpGatewayMarshaler.Marshal(resp.(proto.Message))
+	if err != nil {
+		gw.writeError(w, r, errors.Wrap(err, "marshal response"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		gw.log.Debugf("http gateway: %s: write response: %s", r.URL.Path, err)
+	}
+}
+
+// redirectAddr returns the address of the control plane that a request
+// rejected by this server should be redirected to, if known. Requests that must
+// be handled by the MS leader are redirected to the leader if this server knows
+// it, otherwise to one of the replicas.
+func redirectAddr(err error) string {
+	var replicas []string
+	switch cause := errors.Cause(err).(type) {
+	case *system.ErrNotLeader:
+		if cause.LeaderHint != "" {
+			return cause.LeaderHint
+		}
+		replicas = cause.Replicas
+	case *system.ErrNotReplica:
+		replicas = cause.Replicas
+	}
+	if len(replicas) == 0 {
+		return ""
+	}
+
+	return replicas[0]
+}
+
+// httpStatusCode returns the HTTP status code reported to the client for the
+// given error.
+func httpStatusCode(err error) int {
+	var he *httpError
+	if errors.As(err, &he) {
+		return he.code
+	}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	}
+
+	err = unwrapStatusErr(err)
+	switch {
+	case system.IsPoolNotFound(err), errors.Is(err, daos.Nonexistent):
+		return http.StatusNotFound
+	case isSentinelErr(err),
+		fault.IsFaultCode(err, code.ServerHarnessNotStarted),
+		fault.IsFaultCode(err, code.ServerDataPlaneNotStarted):
+		return http.StatusServiceUnavailable
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (gw *httpGateway) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if addr := redirectAddr(err); addr != "" {
+		host, _, splitErr := net.SplitHostPort(addr)
+		if splitErr != nil {
+			host = addr
+		}
+		scheme := "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+		target := url.URL{
+			Scheme:   scheme,
+			Host:     net.JoinHostPort(host, strconv.Itoa(gw.port)),
+			Path:     r.URL.Path,
+			RawQuery: r.URL.RawQuery,
+		}
+		http.Redirect(w, r, target.String(), http.StatusTemporaryRedirect)
+		return
+	}
+
+	statusCode := httpStatusCode(err)
+	msg := unwrapStatusErr(err).Error()
+	gw.log.Debugf("http gateway: %s: %d: %s", r.URL.Path, statusCode, msg)
+
+	data, mErr := json.Marshal(struct {
+		Error string `json:"error"`
+	}{msg})
+	if mErr != nil {
+		http.Error(w, msg, statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(data); err != nil {
+		gw.log.Debugf("http gateway: %s: write response: %s", r.URL.Path, err)
+	}
+}
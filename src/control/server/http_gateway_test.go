@@ -0,0 +1,360 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type mockGatewayCtlSvc struct {
+	ctlpb.UnimplementedCtlSvcServer
+	gotReq proto.Message
+	resp   *ctlpb.SmdQueryResp
+	err    error
+}
+
+func (svc *mockGatewayCtlSvc) SmdQuery(_ context.Context, req *ctlpb.SmdQueryReq) (*ctlpb.SmdQueryResp, error) {
+	svc.gotReq = req
+	return svc.resp, svc.err
+}
+
+type mockGatewayMgmtSvc struct {
+	mgmtpb.UnimplementedMgmtSvcServer
+	gotReq   proto.Message
+	sysResp  *mgmtpb.SystemQueryResp
+	listResp *mgmtpb.ListPoolsResp
+	poolResp *mgmtpb.PoolQueryResp
+	err      error
+}
+
+func (svc *mockGatewayMgmtSvc) SystemQuery(_ context.Context, req *mgmtpb.SystemQueryReq) (*mgmtpb.SystemQueryResp, error) {
+	svc.gotReq = req
+	return svc.sysResp, svc.err
+}
+
+func (svc *mockGatewayMgmtSvc) ListPools(_ context.Context, req *mgmtpb.ListPoolsReq) (*mgmtpb.ListPoolsResp, error) {
+	svc.gotReq = req
+	return svc.listResp, svc.err
+}
+
+func (svc *mockGatewayMgmtSvc) PoolQuery(_ context.Context, req *mgmtpb.PoolQueryReq) (*mgmtpb.PoolQueryResp, error) {
+	svc.gotReq = req
+	return svc.poolResp, svc.err
+}
+
+func newTestTLSState(commonName string, orgUnits ...string) *tls.ConnectionState {
+	return &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{
+			{
+				{
+					Subject: pkix.Name{
+						CommonName:         commonName,
+						OrganizationalUnit: orgUnits,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestServer_httpGateway(t *testing.T) {
+	roles := security.ClientRoles{
+		{OrganizationalUnit: "monitoring", Role: security.RoleReadOnly},
+	}
+	sysResp := &mgmtpb.SystemQueryResp{
+		Members: []*mgmtpb.SystemMember{
+			{Rank: 1, State: "joined", Addr: "10.0.0.1:10001"},
+		},
+	}
+	poolResp := &mgmtpb.PoolQueryResp{
+		Uuid:  test.MockUUID(1),
+		Label: "pool1",
+	}
+
+	for name, tc := range map[string]struct {
+		method      string
+		path        string
+		tlsState    *tls.ConnectionState
+		token       string
+		secure      bool
+		ctlSvc      *mockGatewayCtlSvc
+		mgmtSvc     *mockGatewayMgmtSvc
+		expCode     int
+		expReq      proto.Message
+		expResp     proto.Message
+		expErr      string
+		expLocation string
+	}{
+		"system query": {
+			path:    "/v1/system?ranks=0-1",
+			mgmtSvc: &mockGatewayMgmtSvc{sysResp: sysResp},
+			expCode: http.StatusOK,
+			expReq:  &mgmtpb.SystemQueryReq{Sys: build.DefaultSystemName, Ranks: "0-1"},
+			expResp: sysResp,
+		},
+		"system query; bad ranks": {
+			path:    "/v1/system?ranks=foo",
+			expCode: http.StatusBadRequest,
+			expErr:  "invalid ranks",
+		},
+		"list pools": {
+			path: "/v1/pools/",
+			mgmtSvc: &mockGatewayMgmtSvc{
+				listResp: &mgmtpb.ListPoolsResp{
+					Pools: []*mgmtpb.ListPoolsResp_Pool{
+						{Uuid: test.MockUUID(1), Label: "pool1"},
+					},
+				},
+			},
+			expCode: http.StatusOK,
+			expReq:  &mgmtpb.ListPoolsReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.ListPoolsResp{
+				Pools: []*mgmtpb.ListPoolsResp_Pool{
+					{Uuid: test.MockUUID(1), Label: "pool1"},
+				},
+			},
+		},
+		"pool query": {
+			path:    "/v1/pools/pool1",
+			mgmtSvc: &mockGatewayMgmtSvc{poolResp: poolResp},
+			expCode: http.StatusOK,
+			expReq:  &mgmtpb.PoolQueryReq{Sys: build.DefaultSystemName, Id: "pool1"},
+			expResp: poolResp,
+		},
+		"pool query; not found": {
+			path: "/v1/pools/pool1",
+			mgmtSvc: &mockGatewayMgmtSvc{
+				err: system.ErrPoolLabelNotFound("pool1"),
+			},
+			expCode: http.StatusNotFound,
+			expErr:  "unable to find pool service",
+		},
+		"pool query; bad status": {
+			path: "/v1/pools/pool1",
+			mgmtSvc: &mockGatewayMgmtSvc{
+				poolResp: &mgmtpb.PoolQueryResp{Status: int32(daos.Nonexistent)},
+			},
+			expCode: http.StatusNotFound,
+			expErr:  daos.Nonexistent.Error(),
+		},
+		"storage health": {
+			path: "/v1/storage/health",
+			ctlSvc: &mockGatewayCtlSvc{
+				resp: &ctlpb.SmdQueryResp{
+					Ranks: []*ctlpb.SmdQueryResp_RankResp{{Rank: 1}},
+				},
+			},
+			expCode: http.StatusOK,
+			expReq: &ctlpb.SmdQueryReq{
+				OmitPools:        true,
+				IncludeBioHealth: true,
+				Rank:             uint32(ranklist.NilRank),
+			},
+			expResp: &ctlpb.SmdQueryResp{
+				Ranks: []*ctlpb.SmdQueryResp_RankResp{{Rank: 1}},
+			},
+		},
+		"storage health; harness not started": {
+			path:    "/v1/storage/health",
+			ctlSvc:  &mockGatewayCtlSvc{err: FaultHarnessNotStarted},
+			expCode: http.StatusServiceUnavailable,
+			expErr:  "harness not started",
+		},
+		"unknown path": {
+			path:    "/v1/pools/pool1/containers",
+			expCode: http.StatusNotFound,
+			expErr:  "unknown path",
+		},
+		"post not allowed": {
+			method:  http.MethodPost,
+			path:    "/v1/pools",
+			expCode: http.StatusMethodNotAllowed,
+			expErr:  "method POST not allowed",
+		},
+		"not leader; redirected to leader": {
+			path: "/v1/system?hosts=foo[1-2]",
+			mgmtSvc: &mockGatewayMgmtSvc{
+				err: &system.ErrNotLeader{
+					LeaderHint: "host2:10001",
+					Replicas:   []string{"host1:10001", "host2:10001"},
+				},
+			},
+			expCode:     http.StatusTemporaryRedirect,
+			expLocation: "http://host2:10003/v1/system?hosts=foo[1-2]",
+		},
+		"not replica; redirected to replica": {
+			path:     "/v1/pools",
+			tlsState: newTestTLSState("admin"),
+			secure:   true,
+			mgmtSvc: &mockGatewayMgmtSvc{
+				err: &system.ErrNotReplica{Replicas: []string{"host1:10001"}},
+			},
+			expCode:     http.StatusTemporaryRedirect,
+			expLocation: "https://host1:10003/v1/pools",
+		},
+		"raft unavailable": {
+			path:    "/v1/system",
+			mgmtSvc: &mockGatewayMgmtSvc{err: system.ErrRaftUnavail},
+			expCode: http.StatusServiceUnavailable,
+			expErr:  system.ErrRaftUnavail.Error(),
+		},
+		"internal error": {
+			path:    "/v1/pools",
+			mgmtSvc: &mockGatewayMgmtSvc{err: errors.New("whoops")},
+			expCode: http.StatusInternalServerError,
+			expErr:  "whoops",
+		},
+		"secure; no certificate": {
+			path:    "/v1/pools",
+			secure:  true,
+			expCode: http.StatusUnauthorized,
+			expErr:  "unable to obtain TLS info",
+		},
+		"secure; read-only role allowed": {
+			path:     "/v1/pools",
+			tlsState: newTestTLSState("monitor", "monitoring"),
+			secure:   true,
+			mgmtSvc: &mockGatewayMgmtSvc{
+				listResp: &mgmtpb.ListPoolsResp{},
+			},
+			expCode: http.StatusOK,
+			expReq:  &mgmtpb.ListPoolsReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.ListPoolsResp{},
+		},
+		"secure; agent denied": {
+			path:     "/v1/pools",
+			tlsState: newTestTLSState("agent"),
+			secure:   true,
+			expCode:  http.StatusForbidden,
+			expErr:   "agent does not have permission",
+		},
+		"token without token auth": {
+			path:     "/v1/pools",
+			tlsState: &tls.ConnectionState{},
+			token:    "Bearer abc",
+			secure:   true,
+			expCode:  http.StatusUnauthorized,
+			expErr:   "bearer token authentication is not configured",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.ctlSvc == nil {
+				tc.ctlSvc = &mockGatewayCtlSvc{}
+			}
+			if tc.mgmtSvc == nil {
+				tc.mgmtSvc = &mockGatewayMgmtSvc{}
+			}
+			cfg := &security.TransportConfig{
+				AllowInsecure: !tc.secure,
+				ClientRoles:   roles,
+			}
+			gw, err := newHTTPGateway(log, 10003, build.DefaultSystemName, cfg, tc.ctlSvc,
+				tc.mgmtSvc, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.method == "" {
+				tc.method = http.MethodGet
+			}
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.TLS = tc.tlsState
+			if tc.token != "" {
+				req.Header.Set("Authorization", tc.token)
+			}
+			rec := httptest.NewRecorder()
+
+			gw.ServeHTTP(rec, req)
+
+			test.AssertEqual(t, tc.expCode, rec.Code, "unexpected status code")
+			test.AssertEqual(t, tc.expLocation, rec.Header().Get("Location"),
+				"unexpected redirect location")
+
+			var gotReq proto.Message
+			switch {
+			case tc.mgmtSvc.gotReq != nil:
+				gotReq = tc.mgmtSvc.gotReq
+			case tc.ctlSvc.gotReq != nil:
+				gotReq = tc.ctlSvc.gotReq
+			}
+			if diff := cmp.Diff(tc.expReq, gotReq, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected request (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expErr != "" {
+				var body struct {
+					Error string `json:"error"`
+				}
+				if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(body.Error, tc.expErr) {
+					t.Fatalf("expected error to contain %q, got %q", tc.expErr, body.Error)
+				}
+				return
+			}
+			if tc.expResp == nil {
+				return
+			}
+
+			test.AssertEqual(t, "application/json", rec.Header().Get("Content-Type"),
+				"unexpected content type")
+			gotResp := tc.expResp.ProtoReflect().New().Interface()
+			if err := protojson.Unmarshal(rec.Body.Bytes(), gotResp); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_httpRequestContext(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/v1/system", nil)
+	req.Header.Set(security.TokenAuthHeader, "Bearer abc")
+	req.Header.Set(control.UserHeader, "alice")
+	req.Header.Set(control.CorrelationHeader, "1234")
+
+	md, ok := metadata.FromIncomingContext(httpRequestContext(req))
+	if !ok {
+		t.Fatal("expected incoming metadata")
+	}
+
+	expMD := metadata.Pairs(security.TokenAuthHeader, "Bearer abc")
+	if diff := cmp.Diff(expMD, md); diff != "" {
+		t.Fatalf("unexpected metadata (-want, +got):\n%s\n", diff)
+	}
+}
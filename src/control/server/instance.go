@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -57,7 +58,10 @@ type EngineInstance struct {
 	waitDrpc        atm.Bool
 	drpcReady       chan *srvpb.NotifyReadyReq
 	ready           atm.Bool
+	standby         atm.Bool
 	startRequested  chan bool
+	stopRequested   atm.Bool
+	restarts        restartTracker
 	fsRoot          string
 	hostFaultDomain *system.FaultDomain
 	joinSystem      systemJoinFn
@@ -67,6 +71,7 @@ type EngineInstance struct {
 	onReady         []onReadyFn
 	onInstanceExit  []onInstanceExitFn
 	getDrpcClientFn func(string) drpc.DomainSocketClient
+	drpcInFlight    atomic.Int64
 
 	sync.RWMutex
 	// these must be protected by a mutex in order to
@@ -111,6 +116,24 @@ func (ei *EngineInstance) IsStarted() bool {
 	return ei.runner.IsRunning()
 }
 
+// IsStandby indicates whether the EngineInstance has been placed in standby
+// mode, in which case requests to start the engine are ignored.
+func (ei *EngineInstance) IsStandby() bool {
+	return ei.standby.Load()
+}
+
+// SetStandby places the EngineInstance in or takes it out of standby mode.
+// A running engine must be stopped before it can be placed in standby.
+func (ei *EngineInstance) SetStandby(standby bool) error {
+	if standby && ei.IsStarted() {
+		return errors.Errorf("instance %d is running, stop its rank before entering standby",
+			ei.Index())
+	}
+	ei.standby.Store(standby)
+
+	return nil
+}
+
 // IsReady indicates whether the EngineInstance is in a ready state.
 //
 // If true indicates that the instance is fully setup, distinct from
@@ -82,6 +82,9 @@ func (ei *EngineInstance) callDrpc(ctx context.Context, method drpc.Method, body
 		rankMsg = fmt.Sprintf(" (rank %s)", sb.Rank)
 	}
 
+	ei.drpcInFlight.Add(1)
+	defer ei.drpcInFlight.Add(-1)
+
 	startedAt := time.Now()
 	defer func() {
 		ei.log.Debugf("dRPC to index %d%s: %s/%dB/%s", ei.Index(), rankMsg, method, proto.Size(body), time.Since(startedAt))
@@ -105,6 +108,11 @@ func (ei *EngineInstance) CallDrpc(ctx context.Context, method drpc.Method, body
 	return ei.callDrpc(ctx, method, body)
 }
 
+// DrpcCallsInFlight returns the number of dRPC calls to this instance that have not completed.
+func (ei *EngineInstance) DrpcCallsInFlight() int64 {
+	return ei.drpcInFlight.Load()
+}
+
 // drespToMemberResult converts drpc.Response to system.MemberResult.
 //
 // MemberResult is populated with rank, state and error dependent on processing
@@ -152,7 +160,7 @@ func (ei *EngineInstance) tryDrpc(ctx context.Context, method drpc.Method) *syst
 	// system member state that should be set on dRPC success
 	targetState := system.MemberStateUnknown
 	switch method {
-	case daos.MethodPrepShutdown:
+	case daos.MethodPrepShutdown, daos.MethodDrainRank:
 		targetState = system.MemberStateStopping
 	case daos.MethodPingRank:
 		targetState = system.MemberStateReady
@@ -217,3 +225,21 @@ func listSmdDevices(ctx context.Context, engine Engine, req *ctlpb.SmdDevReq) (*
 
 	return resp, nil
 }
+
+func listSmdPools(ctx context.Context, engine Engine, req *ctlpb.SmdPoolReq) (*ctlpb.SmdPoolResp, error) {
+	dresp, err := engine.CallDrpc(ctx, daos.MethodSmdPools, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(ctlpb.SmdPoolResp)
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal SmdListPools response")
+	}
+
+	if resp.Status != 0 {
+		return nil, errors.Wrap(daos.Status(resp.Status), "ListSmdPools failed")
+	}
+
+	return resp, nil
+}
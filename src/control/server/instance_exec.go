@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -124,8 +125,10 @@ func (ei *EngineInstance) finishStartup(ctx context.Context, ready *srvpb.Notify
 }
 
 // createPublishInstanceExitFunc returns onInstanceExitFn which will publish an exit
-// event using the provided publish function.
-func createPublishInstanceExitFunc(publish func(*events.RASEvent), hostname string) onInstanceExitFn {
+// event using the provided publish function. If restartStatus is non-nil, the outcome of
+// the engine's restart policy is appended to the event message so that it is visible in
+// the system member state.
+func createPublishInstanceExitFunc(publish func(*events.RASEvent), hostname string, restartStatus func() string) onInstanceExitFn {
 	return func(_ context.Context, engineIdx uint32, rank ranklist.Rank, incarnation uint64, exitErr error, exPid int) error {
 		if exitErr == nil {
 			return errors.New("expected non-nil exit error")
@@ -133,6 +136,11 @@ func createPublishInstanceExitFunc(publish func(*events.RASEvent), hostname stri
 
 		evt := events.NewEngineDiedEvent(hostname, engineIdx, rank.Uint32(), incarnation,
 			common.ExitStatus(exitErr.Error()), exPid)
+		if restartStatus != nil {
+			if status := restartStatus(); status != "" {
+				evt.Msg += "; " + status
+			}
+		}
 
 		// set forwardable if there is a rank for the MS to operate on
 		publish(evt.WithForwardable(!rank.Equals(ranklist.NilRank)))
@@ -212,11 +220,14 @@ func (ei *EngineInstance) requestStart(ctx context.Context) {
 }
 
 // Run starts the control loop for an EngineInstance. Engine starts are triggered by
-// calling requestStart() on the instance.
+// calling requestStart() on the instance. If the engine exits unexpectedly and a restart
+// policy is configured, the engine is restarted after a backoff delay until the policy
+// restart limit is reached.
 func (ei *EngineInstance) Run(ctx context.Context) {
 	// Start the instance control loop.
 	go func() {
 		var runnerExitCh engine.RunnerExitChan
+		var restartTimer <-chan time.Time
 		var err error
 		var restartRequested bool
 		for {
@@ -228,23 +239,59 @@ func (ei *EngineInstance) Run(ctx context.Context) {
 					return
 				}
 
+				// An explicit start request supersedes any pending automatic
+				// restart and clears the restart history.
+				restartTimer = nil
+				ei.stopRequested.Store(false)
+				ei.restarts.reset()
+
 				if runnerExitCh != nil {
 					restartRequested = true
 					continue
 				}
 
+				if ei.IsStandby() {
+					ei.log.Noticef("instance %d is in standby mode, not starting",
+						ei.Index())
+					ei.checkStandbyStorage()
+					continue
+				}
+
+				runnerExitCh, err = ei.startRunner(ctx)
+				if err != nil {
+					ei.log.Errorf("runner exited without starting process: %s", err)
+					ei.restarts.onExit(nil, time.Now())
+					ei.handleExit(ctx, 0, err)
+					continue
+				}
+			case <-restartTimer:
+				restartTimer = nil
+				if runnerExitCh != nil || ei.stopRequested.Load() || ei.IsStandby() {
+					ei.log.Debugf("instance %d: automatic restart cancelled", ei.Index())
+					continue
+				}
+
+				ei.log.Noticef("instance %d: restarting after unexpected exit", ei.Index())
 				runnerExitCh, err = ei.startRunner(ctx)
 				if err != nil {
 					ei.log.Errorf("runner exited without starting process: %s", err)
+					ei.restarts.onExit(nil, time.Now())
 					ei.handleExit(ctx, 0, err)
 					continue
 				}
 			case runnerExit := <-runnerExitCh:
+				decision := ei.checkRestart(restartRequested)
 				ei.handleExit(ctx, runnerExit.PID, runnerExit.Error)
 				runnerExitCh = nil // next runner will reset this
-				if restartRequested {
+				switch {
+				case restartRequested:
 					go ei.requestStart(ctx)
 					restartRequested = false
+				case decision.restart:
+					ei.log.Noticef("instance %d: %s", ei.Index(), decision.status)
+					restartTimer = time.After(decision.delay)
+				case decision.giveUp:
+					ei.giveUpRestarts(decision)
 				}
 			}
 		}
@@ -254,8 +301,10 @@ func (ei *EngineInstance) Run(ctx context.Context) {
 	ei.requestStart(ctx)
 }
 
-// Stop sends signal to stop EngineInstance runner (nonblocking).
+// Stop sends signal to stop EngineInstance runner (nonblocking). Any pending automatic
+// restart of the engine is cancelled.
 func (ei *EngineInstance) Stop(signal os.Signal) error {
+	ei.stopRequested.Store(true)
 	ei.runner.Signal(signal)
 	return nil
 }
@@ -39,6 +39,7 @@ func TestIOEngineInstance_exit(t *testing.T) {
 		instanceIdx      uint32
 		exitErr          error
 		expShouldForward bool
+		restartPolicy    *engine.RestartPolicy
 		expEvtMsg        string
 		expExPid         int
 	}{
@@ -59,6 +60,11 @@ func TestIOEngineInstance_exit(t *testing.T) {
 			expEvtMsg: fmt.Sprintf(exitMsg, 0),
 			expExPid:  1234,
 		},
+		"with restart policy": {
+			restartPolicy: &engine.RestartPolicy{MaxRestarts: 3},
+			expEvtMsg: fmt.Sprintf(exitMsg, 0) +
+				"; restart 1 of 3 in 10m0s scheduled in 5s",
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -66,7 +72,8 @@ func TestIOEngineInstance_exit(t *testing.T) {
 
 			rxEvts = []*events.RASEvent{}
 
-			runner := engine.NewTestRunner(tc.trc, &engine.Config{})
+			runner := engine.NewTestRunner(tc.trc,
+				engine.MockConfig().WithRestartPolicy(tc.restartPolicy))
 
 			engine := NewEngineInstance(log, nil, nil, runner, nil)
 			engine.setIndex(tc.instanceIdx)
@@ -81,8 +88,10 @@ func TestIOEngineInstance_exit(t *testing.T) {
 			}
 
 			hn, _ := os.Hostname()
-			engine.OnInstanceExit(createPublishInstanceExitFunc(fakePublish, hn))
+			engine.OnInstanceExit(createPublishInstanceExitFunc(fakePublish, hn,
+				engine.restarts.getStatus))
 
+			engine.checkRestart(false)
 			engine.handleExit(test.Context(t), tc.expExPid, exitErr)
 
 			test.AssertEqual(t, 1, len(rxEvts),
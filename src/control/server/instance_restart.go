@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// restartDecision describes what the harness should do after an engine exit.
+type restartDecision struct {
+	restart bool
+	delay   time.Duration
+	giveUp  bool
+	status  string
+}
+
+// restartTracker records automatic restarts of an engine so that a crash loop can be
+// detected and the restart policy applied.
+type restartTracker struct {
+	sync.RWMutex
+	restarts []time.Time
+	status   string
+}
+
+// onExit decides whether an engine that has just exited should be restarted under the given
+// policy. A nil policy disables automatic restarts.
+func (rt *restartTracker) onExit(rp *engine.RestartPolicy, now time.Time) restartDecision {
+	rt.Lock()
+	defer rt.Unlock()
+
+	if rp == nil {
+		rt.status = ""
+		return restartDecision{}
+	}
+
+	// Only restarts within the policy window count towards the limit.
+	cutoff := now.Add(-rp.GetWindow())
+	recent := rt.restarts[:0]
+	for _, ts := range rt.restarts {
+		if ts.After(cutoff) {
+			recent = append(recent, ts)
+		}
+	}
+	rt.restarts = recent
+
+	if len(rt.restarts) >= rp.MaxRestarts {
+		action := "engine stopped"
+		if rp.GetOnGiveUp() == engine.RestartActionStandby {
+			action = "engine placed in standby"
+		}
+		rt.status = fmt.Sprintf("restart limit of %d in %s reached, %s", rp.MaxRestarts,
+			rp.GetWindow(), action)
+		return restartDecision{giveUp: true, status: rt.status}
+	}
+
+	delay := rp.Backoff(len(rt.restarts))
+	rt.restarts = append(rt.restarts, now)
+	rt.status = fmt.Sprintf("restart %d of %d in %s scheduled in %s", len(rt.restarts),
+		rp.MaxRestarts, rp.GetWindow(), delay)
+
+	return restartDecision{restart: true, delay: delay, status: rt.status}
+}
+
+// reset clears the restart history, e.g. after an engine is started by an administrator.
+func (rt *restartTracker) reset() {
+	rt.Lock()
+	defer rt.Unlock()
+
+	rt.restarts = nil
+	rt.status = ""
+}
+
+// getStatus returns a description of the outcome of the most recent restart decision.
+func (rt *restartTracker) getStatus() string {
+	rt.RLock()
+	defer rt.RUnlock()
+
+	return rt.status
+}
+
+// checkRestart applies the engine's restart policy after an exit. Exits that were requested
+// by the harness are never eligible for an automatic restart.
+func (ei *EngineInstance) checkRestart(requested bool) restartDecision {
+	var rp *engine.RestartPolicy
+	if !requested && !ei.stopRequested.Load() {
+		rp = ei.runner.GetConfig().RestartPolicy
+	}
+
+	return ei.restarts.onExit(rp, time.Now())
+}
+
+// giveUpRestarts takes the policy action for an engine that has reached its restart limit.
+func (ei *EngineInstance) giveUpRestarts(decision restartDecision) {
+	ei.log.Errorf("instance %d: %s", ei.Index(), decision.status)
+
+	rp := ei.runner.GetConfig().RestartPolicy
+	if rp.GetOnGiveUp() != engine.RestartActionStandby {
+		return
+	}
+	if err := ei.SetStandby(true); err != nil {
+		ei.log.Errorf("instance %d: entering standby: %s", ei.Index(), err)
+	}
+}
@@ -0,0 +1,100 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServer_restartTracker_onExit(t *testing.T) {
+	start := time.Now()
+
+	for name, tc := range map[string]struct {
+		policy      *engine.RestartPolicy
+		exits       []time.Duration // offsets from start of previous exits
+		exitAt      time.Duration
+		expDecision restartDecision
+	}{
+		"no policy": {
+			exits: []time.Duration{0},
+		},
+		"first restart": {
+			policy: &engine.RestartPolicy{MaxRestarts: 3},
+			expDecision: restartDecision{
+				restart: true,
+				delay:   5 * time.Second,
+				status:  "restart 1 of 3 in 10m0s scheduled in 5s",
+			},
+		},
+		"backoff increases": {
+			policy: &engine.RestartPolicy{MaxRestarts: 3},
+			exits:  []time.Duration{0, time.Minute},
+			exitAt: 2 * time.Minute,
+			expDecision: restartDecision{
+				restart: true,
+				delay:   20 * time.Second,
+				status:  "restart 3 of 3 in 10m0s scheduled in 20s",
+			},
+		},
+		"limit reached": {
+			policy: &engine.RestartPolicy{MaxRestarts: 2},
+			exits:  []time.Duration{0, time.Minute},
+			exitAt: 2 * time.Minute,
+			expDecision: restartDecision{
+				giveUp: true,
+				status: "restart limit of 2 in 10m0s reached, engine stopped",
+			},
+		},
+		"limit reached; standby": {
+			policy: &engine.RestartPolicy{
+				MaxRestarts: 1,
+				OnGiveUp:    engine.RestartActionStandby,
+			},
+			exits:  []time.Duration{0},
+			exitAt: time.Minute,
+			expDecision: restartDecision{
+				giveUp: true,
+				status: "restart limit of 1 in 10m0s reached, engine placed in standby",
+			},
+		},
+		"old restarts outside window": {
+			policy: &engine.RestartPolicy{MaxRestarts: 2, Window: time.Hour},
+			exits:  []time.Duration{0, time.Minute},
+			exitAt: 2 * time.Hour,
+			expDecision: restartDecision{
+				restart: true,
+				delay:   5 * time.Second,
+				status:  "restart 1 of 2 in 1h0m0s scheduled in 5s",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var rt restartTracker
+			for _, offset := range tc.exits {
+				rt.onExit(tc.policy, start.Add(offset))
+			}
+
+			gotDecision := rt.onExit(tc.policy, start.Add(tc.exitAt))
+			if diff := cmp.Diff(tc.expDecision, gotDecision, cmp.AllowUnexported(restartDecision{})); diff != "" {
+				t.Fatalf("unexpected decision (-want, +got):\n%s\n", diff)
+			}
+			if gotDecision.status != rt.getStatus() {
+				t.Fatalf("expected status %q, got %q", gotDecision.status, rt.getStatus())
+			}
+
+			rt.reset()
+			if rt.getStatus() != "" || len(rt.restarts) != 0 {
+				t.Fatal("expected tracker to be cleared after reset")
+			}
+		})
+	}
+}
@@ -12,11 +12,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -108,6 +110,45 @@ func (ei *EngineInstance) checkScmNeedFormat() (bool, error) {
 	return needsScmFormat, nil
 }
 
+// writeScmOwnerLabel records the instance as the owner of freshly formatted SCM. Failure is not
+// fatal as SCM without a label is adopted on next start.
+func (ei *EngineInstance) writeScmOwnerLabel() {
+	if err := ei.storage.WriteScmOwnerLabel(ei.systemName()); err != nil {
+		ei.log.Errorf("instance %d: %s", ei.Index(), err)
+	}
+}
+
+// restoreScm remounts formatted PMem that was found unmounted and publishes an event describing
+// the actions taken so that unexpected loss of mounts is visible to administrators.
+func (ei *EngineInstance) restoreScm() error {
+	if ei.storage.ControlMetadataPathConfigured() {
+		return nil
+	}
+
+	actions, err := ei.storage.RestoreScm()
+	if err != nil {
+		// Mounting unchecked filesystems is unsafe so abort, otherwise fall through to the
+		// regular format checks.
+		if fault.IsFaultCode(err, code.ScmFsckFailed) {
+			return errors.Wrapf(err, "instance %d: restore scm mount", ei.Index())
+		}
+		ei.log.Errorf("instance %d: failed to restore scm mount: %s", ei.Index(), err)
+		return nil
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+
+	cfg, err := ei.storage.GetScmConfig()
+	if err != nil {
+		return err
+	}
+	ei.log.Noticef("instance %d: scm remounted: %s", ei.Index(), strings.Join(actions, ", "))
+	ei.Publish(events.NewEngineScmRemountedEvent("", ei.Index(), cfg.Scm.MountPoint, actions))
+
+	return nil
+}
+
 // awaitStorageReady blocks until instance has storage available and ready to be used.
 func (ei *EngineInstance) awaitStorageReady(ctx context.Context) error {
 	idx := ei.Index()
@@ -127,6 +168,10 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context) error {
 	}
 	ei.log.Debugf("%s: needsMetaFormat: %t", msgIdx, needsMetaFormat)
 
+	if err := ei.restoreScm(); err != nil {
+		return err
+	}
+
 	needsScmFormat, err := ei.checkScmNeedFormat()
 	if err != nil {
 		return err
@@ -138,6 +183,7 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context) error {
 		if err := ei.storage.FormatScm(true); err != nil {
 			return errors.Wrapf(err, "%s: format ramdisk", msgIdx)
 		}
+		ei.writeScmOwnerLabel()
 		needsScmFormat = false
 	}
 
@@ -150,6 +196,10 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context) error {
 		if !needsSuperblock {
 			ei.log.Debugf("%s: superblock not needed", msgIdx)
 
+			if err := ei.storage.CheckScmOwnership(ei.systemName()); err != nil {
+				return errors.Wrapf(err, "%s: check scm ownership", msgIdx)
+			}
+
 			if ei.storage.HasBlockDevices() {
 				ei.log.Debugf("%s: checking bdev config", msgIdx)
 
@@ -198,6 +248,28 @@ func (ei *EngineInstance) awaitStorageReady(ctx context.Context) error {
 	return ctx.Err()
 }
 
+// checkStandbyStorage validates the storage of an instance in standby mode without formatting
+// or starting anything so that problems are reported before the instance is brought back into
+// service.
+func (ei *EngineInstance) checkStandbyStorage() {
+	msgIdx := fmt.Sprintf("instance %d", ei.Index())
+
+	needsScmFormat, err := ei.checkScmNeedFormat()
+	switch {
+	case err != nil:
+		ei.log.Errorf("%s (standby): storage check failed: %s", msgIdx, err)
+	case needsScmFormat:
+		ei.log.Noticef("%s (standby): SCM format required before instance can start",
+			msgIdx)
+	default:
+		if err := ei.storage.CheckScmOwnership(ei.systemName()); err != nil {
+			ei.log.Errorf("%s (standby): storage check failed: %s", msgIdx, err)
+			return
+		}
+		ei.log.Infof("%s (standby): storage check passed", msgIdx)
+	}
+}
+
 func (ei *EngineInstance) logScmStorage() error {
 	mp, err := ei.storage.GetScmUsage()
 	if err != nil {
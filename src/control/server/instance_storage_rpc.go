@@ -31,6 +31,7 @@ import (
 var (
 	// Function pointers to enable mocking.
 	scanSmd       = listSmdDevices
+	scanSmdPools  = listSmdPools
 	scanHealth    = getBioHealth
 	linkStatsProv = pciutils.NewPCIeLinkStatsProvider()
 
@@ -87,6 +88,7 @@ func (ei *EngineInstance) scmFormat(force bool) (*ctlpb.ScmMountResult, error) {
 	if err != nil {
 		return nil, err
 	}
+	ei.writeScmOwnerLabel()
 
 	return ei.newMntRet(cfg.Scm.MountPoint, nil), nil
 }
@@ -129,6 +131,13 @@ func formatEngineBdevs(ei *EngineInstance, ctrlrs storage.NvmeControllers) (resu
 			res.RoleBits = uint32(tr.DeviceRoles.OptionBits)
 			results = append(results, res)
 		}
+		for _, ex := range tr.Excluded {
+			res := ei.newCret(ex.PciAddr, nil)
+			res.State.Info = fmt.Sprintf("excluded from tier %d, format skipped: %s",
+				ex.Tier, ex.Reason)
+			res.RoleBits = uint32(tr.DeviceRoles.OptionBits)
+			results = append(results, res)
+		}
 	}
 
 	return
@@ -384,6 +384,8 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 		readErr         error
 		metaNeedsFmt    bool
 		metaNeedsFmtErr error
+		scmFsck         bool
+		fsckErr         error
 		expNoWait       bool
 		expFmtType      string
 		expErr          error
@@ -410,6 +412,19 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 			isMountedErr: os.ErrNotExist,
 			expErr:       storage.FaultDeviceWithFsNoMountpoint(dev, mnt),
 		},
+		"formatted scm unmounted; fsck fails": {
+			sbSet:   true,
+			fsStr:   "ext4",
+			scmFsck: true,
+			fsckErr: errors.New("bad superblock"),
+			expErr:  scm.FaultFsckFailed(dev, errors.New("bad superblock")),
+		},
+		"formatted scm unmounted; fsck and remount succeed": {
+			sbSet:     true,
+			fsStr:     "ext4",
+			scmFsck:   true,
+			expNoWait: true,
+		},
 		"mount check fails": {
 			sbSet:        true,
 			fsStr:        "ext4",
@@ -491,6 +506,17 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 			if tc.storageCfg == nil {
 				tc.storageCfg = &dcpmCfg.Storage
 			}
+			if tc.scmFsck {
+				scmCfg := *tc.storageCfg
+				scmCfg.Tiers = storage.TierConfigs{
+					storage.NewTierConfig().
+						WithStorageClass(storage.ClassDcpm.String()).
+						WithScmMountPoint(mnt).
+						WithScmDeviceList(dev).
+						WithScmFsck(true),
+				}
+				tc.storageCfg = &scmCfg
+			}
 
 			msc := system.MockSysConfig{
 				IsMountedBool: tc.isMounted,
@@ -499,6 +525,7 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 				UnmountErr:    tc.unmountErr,
 				GetfsStr:      tc.fsStr,
 				GetfsErr:      tc.fsErr,
+				FsckErr:       tc.fsckErr,
 			}
 			if tc.readErr != nil {
 				storagePath := mnt
@@ -520,7 +547,8 @@ func TestIOEngineInstance_awaitStorageReady(t *testing.T) {
 				system.NewMockSysProvider(log, &msc),
 				scm.NewMockProvider(log, &smbc, &msc),
 				nil, mmp)
-			engine := NewEngineInstance(log, mp, nil, runner, nil)
+			engine := NewEngineInstance(log, mp, nil, runner,
+				events.NewPubSub(test.Context(t), log))
 
 			engine.setIndex(tc.engineIndex)
 
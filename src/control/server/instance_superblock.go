@@ -83,6 +83,14 @@ func (ei *EngineInstance) getSuperblock() *Superblock {
 	return &sbCopy
 }
 
+// systemName returns the name of the system the instance belongs to.
+func (ei *EngineInstance) systemName() string {
+	if name := ei.runner.GetConfig().SystemName; name != "" {
+		return name
+	}
+	return defaultGroupName
+}
+
 func (ei *EngineInstance) hasSuperblock() bool {
 	return ei.getSuperblock() != nil
 }
@@ -135,16 +143,10 @@ func (ei *EngineInstance) createSuperblock() error {
 		return errors.Wrap(err, "Failed to generate instance UUID")
 	}
 
-	cfg := ei.runner.GetConfig()
-	systemName := cfg.SystemName
-	if systemName == "" {
-		systemName = defaultGroupName
-	}
-
 	superblock := &Superblock{
 		Version: superblockVersion,
 		UUID:    u.String(),
-		System:  systemName,
+		System:  ei.systemName(),
 	}
 
 	if ei.hostFaultDomain != nil {
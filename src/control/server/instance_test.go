@@ -267,12 +267,15 @@ type (
 	MockInstanceConfig struct {
 		CallDrpcResp        *drpc.Response
 		CallDrpcErr         error
+		DrpcCallsInFlight   int64
 		GetRankResp         ranklist.Rank
 		GetRankErr          error
 		TargetCount         int
 		Index               uint32
 		Started             atm.Bool
 		Ready               atm.Bool
+		Standby             atm.Bool
+		SetStandbyErr       error
 		CheckerMode         atm.Bool
 		LocalState          system.MemberState
 		RemoveSuperblockErr error
@@ -281,6 +284,7 @@ type (
 		ScmTierConfig       *storage.TierConfig
 		ScanBdevTiersResult []storage.BdevTierScanResult
 		LastHealthStats     map[string]*ctlpb.BioHealthResp
+		TryDrpcResult       *system.MemberResult
 	}
 
 	MockInstance struct {
@@ -310,6 +314,10 @@ func (mi *MockInstance) CallDrpc(_ context.Context, _ drpc.Method, _ proto.Messa
 	return mi.cfg.CallDrpcResp, mi.cfg.CallDrpcErr
 }
 
+func (mi *MockInstance) DrpcCallsInFlight() int64 {
+	return mi.cfg.DrpcCallsInFlight
+}
+
 func (mi *MockInstance) GetRank() (ranklist.Rank, error) {
 	return mi.cfg.GetRankResp, mi.cfg.GetRankErr
 }
@@ -330,6 +338,18 @@ func (mi *MockInstance) IsReady() bool {
 	return mi.cfg.Ready.Load()
 }
 
+func (mi *MockInstance) IsStandby() bool {
+	return mi.cfg.Standby.Load()
+}
+
+func (mi *MockInstance) SetStandby(standby bool) error {
+	if mi.cfg.SetStandbyErr != nil {
+		return mi.cfg.SetStandbyErr
+	}
+	mi.cfg.Standby.Store(standby)
+	return nil
+}
+
 func (mi *MockInstance) LocalState() system.MemberState {
 	return mi.cfg.LocalState
 }
@@ -363,7 +383,7 @@ func (mi *MockInstance) newCret(_ string, _ error) *ctlpb.NvmeControllerResult {
 }
 
 func (mi *MockInstance) tryDrpc(_ context.Context, _ drpc.Method) *system.MemberResult {
-	return nil
+	return mi.cfg.TryDrpcResult
 }
 
 func (mi *MockInstance) requestStart(_ context.Context) {}
@@ -8,28 +8,35 @@
 package server
 
 import (
+	"crypto/x509"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/otlpexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/system"
 )
 
-func componentFromContext(ctx context.Context) (comp *security.Component, err error) {
+func certFromContext(ctx context.Context) (*x509.Certificate, error) {
 	clientPeer, ok := peer.FromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "no peer information found")
@@ -46,14 +53,101 @@ func componentFromContext(ctx context.Context) (comp *security.Component, err er
 		return nil, status.Error(codes.Unauthenticated, "unable to verify client certificates")
 	}
 
-	peerCert := certs[0][0]
-	component := security.CommonNameToComponent(peerCert.Subject.CommonName)
+	return certs[0][0], nil
+}
+
+// tokenIdentityKey is the context key of the identity of a client that has
+// been authenticated with a bearer token.
+type tokenIdentityKey struct{}
+
+func tokenIdentityFromContext(ctx context.Context) *security.TokenIdentity {
+	id, _ := ctx.Value(tokenIdentityKey{}).(*security.TokenIdentity)
+	return id
+}
+
+// authenticateToken verifies the bearer token sent by the client and returns a
+// context carrying the identity it establishes.
+func authenticateToken(ctx context.Context, verifier *security.TokenVerifier) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	headers := md.Get(security.TokenAuthHeader)
+	if len(headers) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "no bearer token provided")
+	}
+
+	token, err := security.BearerToken(headers[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	id, err := verifier.Verify(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", err)
+	}
+
+	return context.WithValue(ctx, tokenIdentityKey{}, id), nil
+}
+
+func unaryTokenAuthInterceptor(verifier *security.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authCtx, err := authenticateToken(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(authCtx, req)
+	}
+}
+
+// tokenAuthServerStream overrides the context of a stream with one carrying
+// the identity of the authenticated client.
+type tokenAuthServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *tokenAuthServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func streamTokenAuthInterceptor(verifier *security.TokenVerifier) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authCtx, err := authenticateToken(ss.Context(), verifier)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &tokenAuthServerStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+// clientFromContext returns the component and role of the client based on
+// its certificate and the configured client roles. Clients authenticated with
+// a bearer token are administrative clients with the role granted by the token.
+func clientFromContext(ctx context.Context, roles security.ClientRoles) (security.Component, security.Role, error) {
+	if id := tokenIdentityFromContext(ctx); id != nil {
+		return security.ComponentAdmin, id.Role, nil
+	}
+
+	peerCert, err := certFromContext(ctx)
+	if err != nil {
+		return security.ComponentUndefined, security.RoleUndefined, err
+	}
+
+	comp, role := roles.Resolve(peerCert)
+	return comp, role, nil
+}
+
+func componentFromContext(ctx context.Context, roles security.ClientRoles) (comp *security.Component, err error) {
+	component, _, err := clientFromContext(ctx, roles)
+	if err != nil {
+		return nil, err
+	}
 
 	return &component, nil
 }
 
-func checkAccess(ctx context.Context, FullMethod string) error {
-	component, err := componentFromContext(ctx)
+func checkAccess(ctx context.Context, FullMethod string, roles security.ClientRoles) error {
+	component, role, err := clientFromContext(ctx, roles)
 	if err != nil {
 		return err
 	}
@@ -63,23 +157,32 @@ func checkAccess(ctx context.Context, FullMethod string) error {
 		return status.Error(codes.PermissionDenied, errMsg)
 	}
 
+	if component == security.ComponentAdmin && !role.HasAccess(FullMethod) {
+		errMsg := fmt.Sprintf("%s role does not have permission to call %s", role, FullMethod)
+		return status.Error(codes.PermissionDenied, errMsg)
+	}
+
 	return nil
 }
 
-func unaryAccessInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	if err := checkAccess(ctx, info.FullMethod); err != nil {
-		return nil, errors.Wrapf(err, "access denied for %T", req)
-	}
+func unaryAccessInterceptor(roles security.ClientRoles) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkAccess(ctx, info.FullMethod, roles); err != nil {
+			return nil, errors.Wrapf(err, "access denied for %T", req)
+		}
 
-	return handler(ctx, req)
+		return handler(ctx, req)
+	}
 }
 
-func streamAccessInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
-	if err := checkAccess(ss.Context(), info.FullMethod); err != nil {
-		return err
-	}
+func streamAccessInterceptor(roles security.ClientRoles) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkAccess(ss.Context(), info.FullMethod, roles); err != nil {
+			return err
+		}
 
-	return handler(srv, ss)
+		return handler(srv, ss)
+	}
 }
 
 func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.UnaryServerInterceptor, error) {
@@ -91,7 +194,7 @@ func unaryInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.Una
 		return nil, nil
 	}
 
-	return unaryAccessInterceptor, nil
+	return unaryAccessInterceptor(cfg.ClientRoles), nil
 }
 
 func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.StreamServerInterceptor, error) {
@@ -103,7 +206,7 @@ func streamInterceptorForTransportConfig(cfg *security.TransportConfig) (grpc.St
 		return nil, nil
 	}
 
-	return streamAccessInterceptor, nil
+	return streamAccessInterceptor(cfg.ClientRoles), nil
 }
 
 var selfServerComponent = func() *build.VersionedComponent {
@@ -117,7 +220,7 @@ var selfServerComponent = func() *build.VersionedComponent {
 	return self
 }()
 
-func checkVersion(ctx context.Context, log logging.Logger, self *build.VersionedComponent, req interface{}) error {
+func checkVersion(ctx context.Context, log logging.Logger, self *build.VersionedComponent, roles security.ClientRoles, req interface{}) error {
 	// If we can't determine our own version, then there's no
 	// checking to be done.
 	if self.Version.IsZero() {
@@ -130,7 +233,7 @@ func checkVersion(ctx context.Context, log logging.Logger, self *build.Versioned
 	// will fail if certificates are disabled.
 	otherComponent := build.ComponentServer
 	otherVersion := build.MustNewVersion("0.0.0")
-	secComponent, err := componentFromContext(ctx)
+	secComponent, err := componentFromContext(ctx, roles)
 	if err == nil {
 		otherComponent = build.Component(secComponent.String())
 	}
@@ -197,9 +300,9 @@ func checkVersion(ctx context.Context, log logging.Logger, self *build.Versioned
 	return nil
 }
 
-func unaryVersionInterceptor(log logging.Logger) grpc.UnaryServerInterceptor {
+func unaryVersionInterceptor(log logging.Logger, roles security.ClientRoles) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		if err := checkVersion(ctx, log, selfServerComponent, req); err != nil {
+		if err := checkVersion(ctx, log, selfServerComponent, roles, req); err != nil {
 			return nil, errors.Wrapf(err, "version check failed for %T", req)
 		}
 
@@ -207,6 +310,29 @@ func unaryVersionInterceptor(log logging.Logger) grpc.UnaryServerInterceptor {
 	}
 }
 
+// unaryAPIVersionInterceptor rejects requests from clients that implement a control API
+// version older than the server supports, and reports the version implemented by the server
+// in the response headers so that clients can perform the same check.
+func unaryAPIVersionInterceptor(log logging.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		header := metadata.Pairs(build.DaosAPIVersionHeader, strconv.Itoa(build.ControlAPIVersion))
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			log.Debugf("failed to set %s response header: %s", build.DaosAPIVersionHeader, err)
+		}
+
+		version, err := build.APIVersionFromContext(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "API version check failed for %T", req)
+		}
+		if err := build.CheckAPIVersion(version); err != nil {
+			log.Errorf("%s: %s", info.FullMethod, err)
+			return nil, FaultIncompatibleAPIVersion(version)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
 func unaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	res, err := handler(ctx, req)
 	return res, proto.AnnotateError(err)
@@ -294,3 +420,60 @@ func unaryLoggingInterceptor(log logging.Logger, ldrChk func() bool) grpc.UnaryS
 		return res, err
 	}
 }
+
+// maxCorrelationIDLen bounds the length of a correlation ID supplied by a client.
+const maxCorrelationIDLen = 128
+
+// unaryCorrelationInterceptor attaches the ID of the management operation that the request is
+// part of to the request context, so that it is set on any RAS events raised while handling the
+// request. A new ID is generated if the client didn't supply a valid one.
+func unaryCorrelationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(control.CorrelationHeader); len(ids) > 0 && len(ids[0]) <= maxCorrelationIDLen {
+			id = ids[0]
+		}
+	}
+	if id == "" {
+		id = uuid.New().String()
+	}
+
+	return handler(events.WithCorrelationID(ctx, id), req)
+}
+
+// unaryMetricsInterceptor records the result and duration of each request in the control plane
+// metrics.
+func unaryMetricsInterceptor(metrics *controlMetrics) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+		res, err := handler(ctx, req)
+		metrics.observeRequest(info.FullMethod, status.Code(err), time.Since(startTime))
+
+		return res, err
+	}
+}
+
+// unaryTraceInterceptor records a span for each request in the supplied recorder so that
+// management operations can be traced by an OTLP collector.
+func unaryTraceInterceptor(spans *otlpexp.SpanRecorder) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		startTime := time.Now()
+		res, err := handler(ctx, req)
+		endTime := time.Now()
+
+		opErr := err
+		if st, ok := status.FromError(err); ok && err != nil {
+			opErr = proto.UnwrapError(st)
+		}
+
+		name := strings.TrimPrefix(info.FullMethod, "/")
+		service, method, _ := strings.Cut(name, "/")
+		spans.Record(name, startTime, endTime, map[string]string{
+			"rpc.system":  "grpc",
+			"rpc.service": service,
+			"rpc.method":  method,
+		}, opErr)
+
+		return res, err
+	}
+}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2020-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,19 +12,29 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	otlppb "github.com/daos-stack/daos/src/control/common/proto/otlp"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/otlpexp"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 )
 
 type testStatus struct {
@@ -76,7 +87,7 @@ func TestServer_unaryStatusInterceptor(t *testing.T) {
 
 // newTestAuthCtx returns a context with a fake peer.PeerInfo
 // set up to validate component access/versioning.
-func newTestAuthCtx(parent context.Context, commonName string) context.Context {
+func newTestAuthCtx(parent context.Context, commonName string, orgUnits ...string) context.Context {
 	ctxPeer := &peer.Peer{
 		Addr: common.LocalhostCtrlAddr(),
 		AuthInfo: credentials.TLSInfo{
@@ -85,7 +96,8 @@ func newTestAuthCtx(parent context.Context, commonName string) context.Context {
 					{
 						{
 							Subject: pkix.Name{
-								CommonName: commonName,
+								CommonName:         commonName,
+								OrganizationalUnit: orgUnits,
 							},
 						},
 					},
@@ -97,6 +109,159 @@ func newTestAuthCtx(parent context.Context, commonName string) context.Context {
 	return peer.NewContext(parent, ctxPeer)
 }
 
+// newTestTokenCtx returns a context for a client authenticated with a bearer
+// token that grants the given role.
+func newTestTokenCtx(parent context.Context, role security.Role) context.Context {
+	return context.WithValue(parent, tokenIdentityKey{}, &security.TokenIdentity{
+		Subject: "user1",
+		Role:    role,
+	})
+}
+
+func TestServer_checkAccess(t *testing.T) {
+	roles := security.ClientRoles{
+		{OrganizationalUnit: "monitoring", Role: security.RoleReadOnly},
+		{CommonName: "ops", Role: security.RoleOperator},
+	}
+
+	for name, tc := range map[string]struct {
+		ctx    context.Context
+		roles  security.ClientRoles
+		method string
+		expErr error
+	}{
+		"no peer": {
+			ctx:    test.Context(t),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: errors.New("no peer information"),
+		},
+		"admin allowed": {
+			ctx:    newTestAuthCtx(test.Context(t), "admin"),
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+		},
+		"agent denied admin method": {
+			ctx:    newTestAuthCtx(test.Context(t), "agent"),
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+			expErr: errors.New("agent does not have permission"),
+		},
+		"unmapped unknown certificate denied": {
+			ctx:    newTestAuthCtx(test.Context(t), "monitor", "monitoring"),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+			expErr: errors.New("undefined does not have permission"),
+		},
+		"read-only role query allowed": {
+			ctx:    newTestAuthCtx(test.Context(t), "monitor", "monitoring"),
+			roles:  roles,
+			method: "/mgmt.MgmtSvc/SystemQuery",
+		},
+		"read-only role pool destroy denied": {
+			ctx:    newTestAuthCtx(test.Context(t), "monitor", "monitoring"),
+			roles:  roles,
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+			expErr: errors.New("read-only role does not have permission"),
+		},
+		"operator role drain allowed": {
+			ctx:    newTestAuthCtx(test.Context(t), "ops"),
+			roles:  roles,
+			method: "/mgmt.MgmtSvc/PoolDrain",
+		},
+		"operator role format denied": {
+			ctx:    newTestAuthCtx(test.Context(t), "ops"),
+			roles:  roles,
+			method: "/ctl.CtlSvc/StorageFormat",
+			expErr: errors.New("operator role does not have permission"),
+		},
+		"mapped role does not grant server methods": {
+			ctx:    newTestAuthCtx(test.Context(t), "ops"),
+			roles:  roles,
+			method: "/mgmt.MgmtSvc/Join",
+			expErr: errors.New("admin does not have permission"),
+		},
+		"server unaffected by roles": {
+			ctx:    newTestAuthCtx(test.Context(t), "server", "monitoring"),
+			roles:  roles,
+			method: "/mgmt.MgmtSvc/Join",
+		},
+		"token read-only role query allowed": {
+			ctx:    newTestTokenCtx(test.Context(t), security.RoleReadOnly),
+			method: "/mgmt.MgmtSvc/SystemQuery",
+		},
+		"token read-only role pool destroy denied": {
+			ctx:    newTestTokenCtx(test.Context(t), security.RoleReadOnly),
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+			expErr: errors.New("read-only role does not have permission"),
+		},
+		"token admin role does not grant server methods": {
+			ctx:    newTestTokenCtx(test.Context(t), security.RoleAdmin),
+			method: "/mgmt.MgmtSvc/Join",
+			expErr: errors.New("admin does not have permission"),
+		},
+		"token identity overrides certificate": {
+			ctx: newTestTokenCtx(newTestAuthCtx(test.Context(t), "admin"),
+				security.RoleReadOnly),
+			method: "/mgmt.MgmtSvc/PoolDestroy",
+			expErr: errors.New("read-only role does not have permission"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, checkAccess(tc.ctx, tc.method, tc.roles))
+		})
+	}
+}
+
+func TestServer_unaryTokenAuthInterceptor(t *testing.T) {
+	verifier, err := security.NewTokenVerifier(&security.TokenAuthConfig{
+		Port:     security.DefaultTokenAuthPort,
+		Issuer:   "https://idp.example.com",
+		Audience: "daos",
+		JWKSURI:  "/nonexistent/jwks.json",
+		Roles:    map[string]security.Role{"admins": security.RoleAdmin},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		md     metadata.MD
+		expErr error
+	}{
+		"no metadata": {
+			expErr: errors.New("no bearer token provided"),
+		},
+		"no authorization header": {
+			md:     metadata.Pairs("other", "value"),
+			expErr: errors.New("no bearer token provided"),
+		},
+		"not a bearer token": {
+			md:     metadata.Pairs(security.TokenAuthHeader, "Basic dXNlcjpwYXNz"),
+			expErr: errors.New("not a bearer token"),
+		},
+		"malformed token": {
+			md:     metadata.Pairs(security.TokenAuthHeader, "Bearer abc"),
+			expErr: errors.New("invalid bearer token: malformed token"),
+		},
+		"keys unavailable": {
+			md:     metadata.Pairs(security.TokenAuthHeader, "Bearer eyJhbGciOiJSUzI1NiJ9.e30.c2ln"),
+			expErr: errors.New("invalid bearer token"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := test.Context(t)
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				t.Fatal("handler called for unauthenticated request")
+				return nil, nil
+			}
+			_, gotErr := unaryTokenAuthInterceptor(verifier)(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, codes.Unauthenticated, status.Code(gotErr), "")
+		})
+	}
+}
+
 type checkVerReq struct {
 	Sys string
 }
@@ -110,6 +275,7 @@ func TestServer_checkVersion(t *testing.T) {
 		selfVersion  string
 		otherVersion string
 		ctx          context.Context
+		roles        security.ClientRoles
 		nonSysMsg    bool
 		expErr       error
 	}{
@@ -223,6 +389,28 @@ func TestServer_checkVersion(t *testing.T) {
 			nonSysMsg: true,
 			expErr:    errors.New("invalid component"),
 		},
+		"certificate mapped to role matches admin header": {
+			selfVersion: "2.4.0",
+			ctx: newTestAuthCtx(
+				metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+					build.DaosComponentHeader, build.ComponentAdmin.String(),
+					build.DaosVersionHeader, "2.4.0"),
+				), "monitor", "monitoring"),
+			roles: security.ClientRoles{
+				{OrganizationalUnit: "monitoring", Role: security.RoleReadOnly},
+			},
+			nonSysMsg: true,
+		},
+		"unmapped certificate does not match admin header": {
+			selfVersion: "2.4.0",
+			ctx: newTestAuthCtx(
+				metadata.NewIncomingContext(test.Context(t), metadata.Pairs(
+					build.DaosComponentHeader, build.ComponentAdmin.String(),
+					build.DaosVersionHeader, "2.4.0"),
+				), "monitor", "monitoring"),
+			nonSysMsg: true,
+			expErr:    errors.New("component mismatch"),
+		},
 		"header/certificate component mismatch": {
 			selfVersion: "2.4.0",
 			ctx: newTestAuthCtx(
@@ -260,8 +448,196 @@ func TestServer_checkVersion(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
 			test.ShowBufferOnFailure(t, buf)
 
-			gotErr := checkVersion(ctx, log, selfComp, req)
+			gotErr := checkVersion(ctx, log, selfComp, tc.roles, req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestServer_unaryTraceInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		handlerErr error
+		expCode    otlppb.StatusCode
+		expMessage string
+	}{
+		"success": {
+			expCode: otlppb.StatusCode_STATUS_CODE_OK,
+		},
+		"handler error": {
+			handlerErr: errors.New("whoops"),
+			expCode:    otlppb.StatusCode_STATUS_CODE_ERROR,
+			expMessage: "whoops",
+		},
+		"DAOS status error": {
+			handlerErr: daos.Nonexistent,
+			expCode:    otlppb.StatusCode_STATUS_CODE_ERROR,
+			expMessage: daos.Nonexistent.Error(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			spans := otlpexp.NewSpanRecorder(0)
+			info := &grpc.UnaryServerInfo{FullMethod: "/mgmt.MgmtSvc/PoolCreate"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tc.handlerErr
+			}
+
+			_, gotErr := unaryTraceInterceptor(spans)(test.Context(t), nil, info, handler)
+			test.CmpErr(t, tc.handlerErr, gotErr)
+
+			got, _ := spans.Drain()
+			if len(got) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(got))
+			}
+			test.AssertEqual(t, "mgmt.MgmtSvc/PoolCreate", got[0].GetName(), "unexpected span name")
+			test.AssertEqual(t, tc.expCode, got[0].GetStatus().GetCode(), "unexpected status code")
+			test.AssertEqual(t, tc.expMessage, got[0].GetStatus().GetMessage(), "unexpected status message")
+
+			attrs := make(map[string]string)
+			for _, kv := range got[0].GetAttributes() {
+				attrs[kv.GetKey()] = kv.GetValue().GetStringValue()
+			}
+			if diff := cmp.Diff(map[string]string{
+				"rpc.system":  "grpc",
+				"rpc.service": "mgmt.MgmtSvc",
+				"rpc.method":  "PoolCreate",
+			}, attrs); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_unaryMetricsInterceptor(t *testing.T) {
+	metrics := newControlMetrics()
+	reg := prometheus.NewRegistry()
+	if err := regControlCollectors(reg, metrics, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/mgmt.MgmtSvc/PoolCreate"}
+	for _, handlerErr := range []error{
+		nil,
+		nil,
+		status.Error(codes.PermissionDenied, "denied"),
+	} {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, handlerErr
+		}
+		_, gotErr := unaryMetricsInterceptor(metrics)(test.Context(t), nil, info, handler)
+		test.CmpErr(t, handlerErr, gotErr)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := make(map[string]float64)
+	var observed uint64
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "control_grpc_requests_total":
+			for _, m := range mf.GetMetric() {
+				labels := make(map[string]string)
+				for _, lp := range m.GetLabel() {
+					labels[lp.GetName()] = lp.GetValue()
+				}
+				test.AssertEqual(t, "mgmt.MgmtSvc/PoolCreate", labels["method"], "unexpected method label")
+				requests[labels["code"]] = m.GetCounter().GetValue()
+			}
+		case "control_grpc_request_duration_seconds":
+			observed = mf.GetMetric()[0].GetHistogram().GetSampleCount()
+		}
+	}
+
+	if diff := cmp.Diff(map[string]float64{"OK": 2, "PermissionDenied": 1}, requests); diff != "" {
+		t.Fatalf("unexpected request counts (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, uint64(3), observed, "unexpected number of observed durations")
+}
+
+func TestServer_unaryCorrelationInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		headers  metadata.MD
+		expID    string
+		expNewID bool
+	}{
+		"no headers": {
+			expNewID: true,
+		},
+		"no correlation ID": {
+			headers:  metadata.Pairs(control.UserHeader, "alice"),
+			expNewID: true,
+		},
+		"correlation ID": {
+			headers: metadata.Pairs(control.CorrelationHeader, "op-1"),
+			expID:   "op-1",
+		},
+		"correlation ID too long": {
+			headers:  metadata.Pairs(control.CorrelationHeader, strings.Repeat("x", maxCorrelationIDLen+1)),
+			expNewID: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ctx := test.Context(t)
+			if tc.headers != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.headers)
+			}
+
+			var gotID string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				gotID = events.CorrelationIDFromContext(ctx)
+				return nil, nil
+			}
+			if _, err := unaryCorrelationInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.expNewID {
+				if gotID == "" || len(gotID) > maxCorrelationIDLen {
+					t.Fatalf("expected a new correlation ID, got %q", gotID)
+				}
+				return
+			}
+			test.AssertEqual(t, tc.expID, gotID, "unexpected correlation ID")
+		})
+	}
+}
+
+func TestServer_unaryAPIVersionInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		headers metadata.MD
+		expErr  error
+	}{
+		"legacy client": {},
+		"current client": {
+			headers: metadata.Pairs(build.DaosAPIVersionHeader, "1"),
+		},
+		"newer client": {
+			headers: metadata.Pairs(build.DaosAPIVersionHeader, "2"),
+		},
+		"invalid version": {
+			headers: metadata.Pairs(build.DaosAPIVersionHeader, "latest"),
+			expErr:  errors.New("invalid x-daos-api-version header"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			if tc.headers != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.headers)
+			}
+
+			var called bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/ctl.CtlSvc/Handshake"}
+			_, gotErr := unaryAPIVersionInterceptor(log)(ctx, nil, info, handler)
 			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expErr == nil, called, "unexpected handler invocation")
 		})
 	}
 }
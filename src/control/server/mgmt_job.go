@@ -0,0 +1,322 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+const (
+	jobPollInterval  = 5 * time.Second
+	maxFinishedJobs  = 256
+	jobRebuildPolls  = 3 // polls to wait for a rebuild to start before assuming none is needed
+	jobReintProgress = 50
+)
+
+type (
+	// jobRunFn performs the operation of a job, reporting progress as a percentage.
+	jobRunFn func(ctx context.Context, progress func(uint32)) (proto.Message, error)
+
+	mgmtJob struct {
+		pb       *mgmtpb.Job
+		cancel   context.CancelFunc
+		canceled bool
+	}
+
+	// jobManager tracks the asynchronous jobs run by the MS leader. Jobs are kept in memory
+	// only, so they are not visible after an MS leadership change.
+	jobManager struct {
+		sync.RWMutex
+		parent       context.Context
+		pollInterval time.Duration
+		jobs         []*mgmtJob // ordered by submission time
+	}
+)
+
+func newJobManager() *jobManager {
+	return &jobManager{
+		parent:       context.Background(),
+		pollInterval: jobPollInterval,
+	}
+}
+
+// setParent sets the context that jobs submitted from now on are run under.
+func (jm *jobManager) setParent(ctx context.Context) {
+	jm.Lock()
+	defer jm.Unlock()
+
+	jm.parent = ctx
+}
+
+// pruneFinished drops the oldest finished jobs so that no more than maxFinishedJobs are held.
+// The caller must hold the lock.
+func (jm *jobManager) pruneFinished() {
+	var finished int
+	for _, job := range jm.jobs {
+		if job.pb.State != mgmtpb.JobState_JOB_RUNNING {
+			finished++
+		}
+	}
+
+	kept := jm.jobs[:0]
+	for _, job := range jm.jobs {
+		if finished > maxFinishedJobs && job.pb.State != mgmtpb.JobState_JOB_RUNNING {
+			finished--
+			continue
+		}
+		kept = append(kept, job)
+	}
+	jm.jobs = kept
+}
+
+// submit starts a job running the supplied function and returns the job identifier.
+func (jm *jobManager) submit(op, target string, run jobRunFn) string {
+	jm.Lock()
+	defer jm.Unlock()
+
+	now := time.Now().Format(time.RFC3339)
+	ctx, cancel := context.WithCancel(jm.parent)
+	job := &mgmtJob{
+		pb: &mgmtpb.Job{
+			Id:      uuid.New().String(),
+			Op:      op,
+			Target:  target,
+			State:   mgmtpb.JobState_JOB_RUNNING,
+			Created: now,
+			Updated: now,
+		},
+		cancel: cancel,
+	}
+	jm.pruneFinished()
+	jm.jobs = append(jm.jobs, job)
+
+	go func() {
+		defer cancel()
+
+		result, err := run(ctx, func(pct uint32) {
+			jm.update(job, func(pb *mgmtpb.Job) {
+				pb.Progress = pct
+			})
+		})
+		jm.update(job, func(pb *mgmtpb.Job) {
+			switch {
+			case job.canceled:
+				pb.State = mgmtpb.JobState_JOB_CANCELED
+			case err != nil:
+				pb.State = mgmtpb.JobState_JOB_FAILED
+				pb.Error = err.Error()
+			default:
+				pb.State = mgmtpb.JobState_JOB_SUCCEEDED
+				pb.Progress = 100
+				if resp, ok := result.(*mgmtpb.PoolCreateResp); ok {
+					pb.PoolCreate = resp
+				}
+			}
+		})
+	}()
+
+	return job.pb.Id
+}
+
+func (jm *jobManager) update(job *mgmtJob, fn func(*mgmtpb.Job)) {
+	jm.Lock()
+	defer jm.Unlock()
+
+	if job.pb.State != mgmtpb.JobState_JOB_RUNNING {
+		return
+	}
+	fn(job.pb)
+	job.pb.Updated = time.Now().Format(time.RFC3339)
+}
+
+// find returns the job with the supplied identifier. The caller must hold the lock.
+func (jm *jobManager) find(id string) (*mgmtJob, error) {
+	for _, job := range jm.jobs {
+		if job.pb.Id == id {
+			return job, nil
+		}
+	}
+
+	return nil, FaultJobNotFound(id)
+}
+
+// list returns copies of the jobs with the supplied identifiers, or of all jobs if none are
+// supplied.
+func (jm *jobManager) list(ids ...string) ([]*mgmtpb.Job, error) {
+	jm.RLock()
+	defer jm.RUnlock()
+
+	var out []*mgmtpb.Job
+	if len(ids) == 0 {
+		for _, job := range jm.jobs {
+			out = append(out, proto.Clone(job.pb).(*mgmtpb.Job))
+		}
+		return out, nil
+	}
+
+	for _, id := range ids {
+		job, err := jm.find(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, proto.Clone(job.pb).(*mgmtpb.Job))
+	}
+
+	return out, nil
+}
+
+// cancel stops a running job. Any part of the operation already completed is not undone.
+func (jm *jobManager) cancel(id string) error {
+	jm.Lock()
+	defer jm.Unlock()
+
+	job, err := jm.find(id)
+	if err != nil {
+		return err
+	}
+	if job.pb.State != mgmtpb.JobState_JOB_RUNNING {
+		return errors.Errorf("job %s is not running (state: %s)", id, job.pb.State)
+	}
+
+	job.canceled = true
+	job.cancel()
+
+	return nil
+}
+
+// checkDaosStatus returns the DAOS status of a response as an error if it indicates failure.
+func checkDaosStatus(status int32) error {
+	if status != 0 {
+		return daos.Status(status)
+	}
+	return nil
+}
+
+// JobSubmit starts a long-running operation on the MS leader and returns the identifier of the
+// job tracking it without waiting for the operation to complete.
+func (svc *mgmtSvc) JobSubmit(ctx context.Context, req *mgmtpb.JobSubmitReq) (*mgmtpb.JobSubmitResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	var id string
+	switch op := req.GetOp().(type) {
+	case *mgmtpb.JobSubmitReq_PoolCreate:
+		opReq := op.PoolCreate
+		opReq.Sys = req.Sys
+		id = svc.jobs.submit("pool create", opReq.Uuid, func(ctx context.Context, _ func(uint32)) (proto.Message, error) {
+			resp, err := svc.PoolCreate(ctx, opReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp, checkDaosStatus(resp.Status)
+		})
+	case *mgmtpb.JobSubmitReq_PoolDestroy:
+		opReq := op.PoolDestroy
+		opReq.Sys = req.Sys
+		id = svc.jobs.submit("pool destroy", opReq.Id, func(ctx context.Context, _ func(uint32)) (proto.Message, error) {
+			resp, err := svc.PoolDestroy(ctx, opReq)
+			if err != nil {
+				return nil, err
+			}
+			return resp, checkDaosStatus(resp.Status)
+		})
+	case *mgmtpb.JobSubmitReq_PoolReint:
+		opReq := op.PoolReint
+		opReq.Sys = req.Sys
+		id = svc.jobs.submit("pool reintegrate", opReq.Id, func(ctx context.Context, progress func(uint32)) (proto.Message, error) {
+			return nil, svc.runPoolReintJob(ctx, opReq, progress)
+		})
+	default:
+		return nil, errors.Errorf("unsupported job operation %T", op)
+	}
+
+	svc.log.Debugf("submitted job %s", id)
+	return &mgmtpb.JobSubmitResp{Id: id}, nil
+}
+
+// runPoolReintJob reintegrates pool targets and then waits for the resulting rebuild to
+// complete.
+func (svc *mgmtSvc) runPoolReintJob(ctx context.Context, req *mgmtpb.PoolReintReq, progress func(uint32)) error {
+	resp, err := svc.PoolReintegrate(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkDaosStatus(resp.Status); err != nil {
+		return err
+	}
+	progress(jobReintProgress)
+
+	qReq := &mgmtpb.PoolQueryReq{
+		Sys:       req.Sys,
+		Id:        req.Id,
+		QueryMask: uint64(daos.HealthOnlyPoolQueryMask),
+	}
+	var rebuildSeen bool
+	for polls := 1; ; polls++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(svc.jobs.pollInterval):
+		}
+
+		qResp, err := svc.PoolQuery(ctx, qReq)
+		if err != nil {
+			return errors.Wrap(err, "querying rebuild status")
+		}
+		if err := checkDaosStatus(qResp.Status); err != nil {
+			return errors.Wrap(err, "querying rebuild status")
+		}
+		if err := checkDaosStatus(qResp.GetRebuild().GetStatus()); err != nil {
+			return errors.Wrap(err, "rebuild failed")
+		}
+
+		if qResp.GetRebuild().GetState() == mgmtpb.PoolRebuildStatus_BUSY {
+			rebuildSeen = true
+			continue
+		}
+		if rebuildSeen || polls >= jobRebuildPolls {
+			return nil
+		}
+	}
+}
+
+// JobList returns the state of jobs held by the MS leader.
+func (svc *mgmtSvc) JobList(ctx context.Context, req *mgmtpb.JobListReq) (*mgmtpb.JobListResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	jobs, err := svc.jobs.list(req.GetIds()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.JobListResp{Jobs: jobs}, nil
+}
+
+// JobCancel stops a running job held by the MS leader.
+func (svc *mgmtSvc) JobCancel(ctx context.Context, req *mgmtpb.JobCancelReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if err := svc.jobs.cancel(req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.DaosResp{}, nil
+}
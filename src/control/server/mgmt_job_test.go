@@ -0,0 +1,231 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func waitForJob(t *testing.T, jm *jobManager, id string) *mgmtpb.Job {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		jobs, err := jm.list(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if jobs[0].State != mgmtpb.JobState_JOB_RUNNING {
+			return jobs[0]
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("job %s did not finish", id)
+	return nil
+}
+
+func TestServer_jobManager(t *testing.T) {
+	for name, tc := range map[string]struct {
+		run         jobRunFn
+		cancel      bool
+		expState    mgmtpb.JobState
+		expProgress uint32
+		expError    string
+		expResult   *mgmtpb.PoolCreateResp
+	}{
+		"success": {
+			run: func(_ context.Context, progress func(uint32)) (proto.Message, error) {
+				progress(10)
+				return &mgmtpb.PoolCreateResp{SvcLdr: 1}, nil
+			},
+			expState:    mgmtpb.JobState_JOB_SUCCEEDED,
+			expProgress: 100,
+			expResult:   &mgmtpb.PoolCreateResp{SvcLdr: 1},
+		},
+		"failure": {
+			run: func(_ context.Context, progress func(uint32)) (proto.Message, error) {
+				progress(30)
+				return nil, errors.New("whoops")
+			},
+			expState:    mgmtpb.JobState_JOB_FAILED,
+			expProgress: 30,
+			expError:    "whoops",
+		},
+		"canceled": {
+			run: func(ctx context.Context, _ func(uint32)) (proto.Message, error) {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			},
+			cancel:   true,
+			expState: mgmtpb.JobState_JOB_CANCELED,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			jm := newJobManager()
+			jm.setParent(test.Context(t))
+
+			id := jm.submit("pool create", test.MockUUID(), tc.run)
+			if tc.cancel {
+				if err := jm.cancel(id); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			job := waitForJob(t, jm, id)
+			test.AssertEqual(t, id, job.Id, "unexpected job id")
+			test.AssertEqual(t, "pool create", job.Op, "unexpected job op")
+			test.AssertEqual(t, test.MockUUID(), job.Target, "unexpected job target")
+			test.AssertEqual(t, tc.expState, job.State, "unexpected job state")
+			test.AssertEqual(t, tc.expProgress, job.Progress, "unexpected job progress")
+			test.AssertEqual(t, tc.expError, job.Error, "unexpected job error")
+			test.AssertEqual(t, tc.expResult.GetSvcLdr(), job.PoolCreate.GetSvcLdr(),
+				"unexpected job result")
+
+			if err := jm.cancel(id); err == nil {
+				t.Fatal("expected error when canceling finished job")
+			}
+		})
+	}
+}
+
+func TestServer_jobManager_pruneFinished(t *testing.T) {
+	jm := newJobManager()
+
+	done := func(context.Context, func(uint32)) (proto.Message, error) {
+		return nil, nil
+	}
+	first := jm.submit("pool destroy", test.MockUUID(1), done)
+	waitForJob(t, jm, first)
+
+	blocked := make(chan struct{})
+	running := jm.submit("pool destroy", test.MockUUID(2), func(context.Context, func(uint32)) (proto.Message, error) {
+		<-blocked
+		return nil, nil
+	})
+	defer close(blocked)
+
+	for i := 0; i < maxFinishedJobs; i++ {
+		waitForJob(t, jm, jm.submit("pool destroy", test.MockUUID(3), done))
+	}
+	// Pruning happens on submission, so one more job is needed to drop the first one.
+	jm.submit("pool destroy", test.MockUUID(3), done)
+
+	if _, err := jm.list(first); err == nil {
+		t.Fatal("expected oldest finished job to be pruned")
+	}
+	if _, err := jm.list(running); err != nil {
+		t.Fatalf("expected running job to be kept: %s", err)
+	}
+}
+
+func TestServer_MgmtSvc_JobList(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	id := svc.jobs.submit("pool destroy", test.MockUUID(), func(context.Context, func(uint32)) (proto.Message, error) {
+		return nil, nil
+	})
+	waitForJob(t, svc.jobs, id)
+
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.JobListReq
+		expIDs []string
+		expErr error
+	}{
+		"wrong system": {
+			req:    &mgmtpb.JobListReq{Sys: "bad"},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"all jobs": {
+			req:    &mgmtpb.JobListReq{},
+			expIDs: []string{id},
+		},
+		"selected job": {
+			req:    &mgmtpb.JobListReq{Ids: []string{id}},
+			expIDs: []string{id},
+		},
+		"unknown job": {
+			req:    &mgmtpb.JobListReq{Ids: []string{"missing"}},
+			expErr: FaultJobNotFound("missing"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := svc.JobList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			var gotIDs []string
+			for _, job := range resp.Jobs {
+				gotIDs = append(gotIDs, job.Id)
+			}
+			test.AssertEqual(t, tc.expIDs, gotIDs, "unexpected job ids")
+		})
+	}
+}
+
+func TestServer_MgmtSvc_JobCancel(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	svc.jobs.setParent(test.Context(t))
+	id := svc.jobs.submit("pool reintegrate", test.MockUUID(), func(ctx context.Context, _ func(uint32)) (proto.Message, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	if _, err := svc.JobCancel(test.Context(t), &mgmtpb.JobCancelReq{Id: "missing"}); err == nil {
+		t.Fatal("expected error when canceling unknown job")
+	}
+
+	if _, err := svc.JobCancel(test.Context(t), &mgmtpb.JobCancelReq{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	job := waitForJob(t, svc.jobs, id)
+	test.AssertEqual(t, mgmtpb.JobState_JOB_CANCELED, job.State, "unexpected job state")
+}
+
+func TestServer_MgmtSvc_JobSubmit(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	svc.jobs.setParent(test.Context(t))
+
+	if _, err := svc.JobSubmit(test.Context(t), &mgmtpb.JobSubmitReq{}); err == nil {
+		t.Fatal("expected error for request without an operation")
+	}
+
+	resp, err := svc.JobSubmit(test.Context(t), &mgmtpb.JobSubmitReq{
+		Op: &mgmtpb.JobSubmitReq_PoolDestroy{
+			PoolDestroy: &mgmtpb.PoolDestroyReq{Id: test.MockUUID(9)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The pool doesn't exist so the job is accepted but fails.
+	job := waitForJob(t, svc.jobs, resp.Id)
+	test.AssertEqual(t, mgmtpb.JobState_JOB_FAILED, job.State, "unexpected job state")
+	test.AssertEqual(t, "pool destroy", job.Op, "unexpected job op")
+	test.AssertEqual(t, test.MockUUID(9), job.Target, "unexpected job target")
+}
@@ -8,6 +8,7 @@
 package server
 
 import (
+	"encoding/json"
 	"math/rand"
 	"sort"
 	"time"
@@ -904,6 +905,205 @@ func (svc *mgmtSvc) PoolExtend(ctx context.Context, req *mgmtpb.PoolExtendReq) (
 	return resp, nil
 }
 
+// resizeTierBytes returns the per-rank tier sizes resulting from a resize request. A zero size
+// leaves the tier unchanged and sizes may not be reduced. Engines can only grow the NVMe (data)
+// tier of a pool that already has one, so growing the SCM (metadata) tier is rejected.
+func resizeTierBytes(cur, req []uint64) ([]uint64, error) {
+	if len(req) == 0 || len(req) > len(cur) {
+		return nil, errors.Errorf("expected between 1 and %d tier sizes in resize request, "+
+			"got %d", len(cur), len(req))
+	}
+
+	out := make([]uint64, len(cur))
+	copy(out, cur)
+	grown := false
+	for i, reqBytes := range req {
+		switch {
+		case reqBytes == 0 || reqBytes == cur[i]:
+			continue
+		case reqBytes < cur[i]:
+			return nil, FaultPoolResizeShrink(i, cur[i], reqBytes)
+		case i == 0:
+			return nil, FaultPoolResizeTier(i, "the SCM tier of an existing pool cannot grow")
+		case cur[i] == 0:
+			return nil, FaultPoolResizeTier(i, "the pool has no storage on this tier")
+		}
+		out[i] = reqBytes
+		grown = true
+	}
+	if !grown {
+		return nil, errors.New("resize request does not increase the size of any tier")
+	}
+
+	return out, nil
+}
+
+// PoolResize forwards a request to the I/O Engine to grow the per-rank storage allocation of a
+// pool and records the new allocation in the pool service entry.
+func (svc *mgmtSvc) PoolResize(ctx context.Context, req *mgmtpb.PoolResizeReq) (*mgmtpb.PoolResizeResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	ps, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx = lock.InContext(ctx)
+
+	req.TierBytes, err = resizeTierBytes(ps.Storage.PerRankTierStorage, req.GetTierBytes())
+	if err != nil {
+		return nil, err
+	}
+	req.MemRatio = ps.Storage.MemRatio
+
+	svc.log.Debugf("MgmtSvc.PoolResize forwarding modified req:%+v\n", req)
+
+	dResp, err := svc.makePoolServiceCall(ctx, daos.MethodPoolResize, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolResizeResp{}
+	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+		return nil, err
+	}
+	if resp.GetStatus() != 0 {
+		return resp, nil
+	}
+	if len(resp.TierBytes) == 0 {
+		resp.TierBytes = req.TierBytes
+	}
+
+	// Persist the new allocation so that later extend and reintegrate requests use it.
+	ps.Storage.PerRankTierStorage = resp.TierBytes
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, errors.Wrap(err, "pool resized but failed to update pool service entry")
+	}
+
+	return resp, nil
+}
+
+// poolCloneSkipProps are pool properties that are not copied to a clone because they identify
+// the source pool or describe its current state rather than its configuration.
+var poolCloneSkipProps = map[uint32]bool{
+	daos.PoolPropertyLabel:         true,
+	daos.PoolPropertyGlobalVersion: true,
+	daos.PoolPropertyUpgradeStatus: true,
+	daos.PoolPropertySvcList:       true,
+}
+
+// poolCloneProps fetches the properties of the source pool that are to be set on its clone.
+func (svc *mgmtSvc) poolCloneProps(ctx context.Context, req *mgmtpb.PoolCloneReq, srcUUID string) ([]*mgmtpb.PoolProperty, int32, error) {
+	propReq := &mgmtpb.PoolGetPropReq{Sys: req.GetSys(), Id: srcUUID}
+	allProps := daos.PoolProperties()
+	for _, key := range allProps.Keys() {
+		prop := allProps[key].GetProperty(key)
+		if poolCloneSkipProps[prop.Number] {
+			continue
+		}
+		propReq.Properties = append(propReq.Properties, &mgmtpb.PoolProperty{Number: prop.Number})
+	}
+
+	propResp, err := svc.PoolGetProp(ctx, propReq)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "fetching source pool properties")
+	}
+	if propResp.GetStatus() != 0 {
+		return nil, propResp.GetStatus(), nil
+	}
+
+	props := make([]*mgmtpb.PoolProperty, 0, len(propResp.GetProperties())+1)
+	for _, prop := range propResp.GetProperties() {
+		if prop.GetValue() == nil || poolCloneSkipProps[prop.GetNumber()] {
+			continue
+		}
+		props = append(props, prop)
+	}
+
+	return append(props, &mgmtpb.PoolProperty{
+		Number: daos.PoolPropertyLabel,
+		Value:  &mgmtpb.PoolProperty_Strval{Strval: req.GetLabel()},
+	}), 0, nil
+}
+
+// PoolClone creates a new pool with the same ranks, per-rank storage allocation, properties
+// and ACL as an existing pool. If requested, the container metadata of the source pool is then
+// copied to the new pool, which is destroyed again if the copy fails.
+func (svc *mgmtSvc) PoolClone(ctx context.Context, req *mgmtpb.PoolCloneReq) (*mgmtpb.PoolCloneResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.GetLabel() == "" {
+		return nil, FaultPoolNoLabel
+	}
+
+	srcPS, err := svc.getPoolService(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if srcPS.State != system.PoolServiceStateReady {
+		return nil, errors.Errorf("pool %s is not ready (state: %s)", srcPS.PoolUUID,
+			srcPS.State)
+	}
+	srcUUID := srcPS.PoolUUID.String()
+
+	resp := new(mgmtpb.PoolCloneResp)
+
+	props, status, err := svc.poolCloneProps(ctx, req, srcUUID)
+	if err != nil || status != 0 {
+		resp.Status = status
+		return resp, err
+	}
+
+	aclResp, err := svc.PoolGetACL(ctx, &mgmtpb.GetACLReq{Sys: req.GetSys(), Id: srcUUID})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching source pool ACL")
+	}
+	if aclResp.GetStatus() != 0 {
+		resp.Status = aclResp.GetStatus()
+		return resp, nil
+	}
+
+	createReq := &mgmtpb.PoolCreateReq{
+		Uuid:       uuid.New().String(),
+		Sys:        req.GetSys(),
+		User:       aclResp.GetAcl().GetOwnerUser(),
+		UserGroup:  aclResp.GetAcl().GetOwnerGroup(),
+		Acl:        aclResp.GetAcl().GetEntries(),
+		Properties: props,
+		Ranks:      ranklist.RanksToUint32(srcPS.Storage.CreationRanks()),
+		TierBytes:  srcPS.Storage.PerRankTierStorage,
+		MemRatio:   srcPS.Storage.MemRatio,
+	}
+
+	svc.log.Debugf("MgmtSvc.PoolClone creating pool %s from %s", createReq.Uuid, srcUUID)
+
+	createResp, err := svc.PoolCreate(ctx, createReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating pool clone")
+	}
+	if createResp.GetStatus() != 0 {
+		resp.Status = createResp.GetStatus()
+		return resp, nil
+	}
+
+	resp.Uuid = createReq.Uuid
+	resp.SvcLdr = createResp.GetSvcLdr()
+	resp.SvcReps = createResp.GetSvcReps()
+	resp.TgtRanks = createResp.GetTgtRanks()
+	resp.TierBytes = createResp.GetTierBytes()
+
+	return resp, nil
+}
+
 // Return error if any requested rank is not in a valid state. Uses available rank filter under the
 // hood so will only against ranks with joined/ready state.
 func (svc *mgmtSvc) checkRanksExist(rl ...uint32) error {
@@ -951,7 +1151,12 @@ func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReintRe
 
 // PoolQuery forwards a pool query request to the I/O Engine.
 func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*mgmtpb.PoolQueryResp, error) {
-	if err := svc.checkReplicaRequest(req); err != nil {
+	// Pool usage history is only held by the MS leader.
+	checkReq := svc.checkReplicaRequest
+	if req.GetHistorySecs() > 0 {
+		checkReq = svc.checkLeaderRequest
+	}
+	if err := checkReq(req); err != nil {
 		return nil, err
 	}
 
@@ -972,6 +1177,34 @@ func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *mgmtpb.PoolQueryReq) (*m
 	// Preserve compatibility with pre-2.6 callers.
 	resp.Leader = resp.SvcLdr
 
+	if req.HistorySecs > 0 && resp.Status == 0 {
+		resp.History = svc.poolHistory.get(resp.Uuid, time.Now(),
+			time.Duration(req.HistorySecs)*time.Second)
+	}
+
+	return resp, nil
+}
+
+// queryPoolService queries the pool service on behalf of the control plane and returns an error
+// if the query fails or returns a non-zero status.
+func (svc *mgmtSvc) queryPoolService(ctx context.Context, id string, mask daos.PoolQueryMask) (*mgmtpb.PoolQueryResp, error) {
+	req := &mgmtpb.PoolQueryReq{
+		Id:        id,
+		QueryMask: uint64(mask),
+	}
+	dResp, err := svc.makePoolServiceCall(ctx, daos.MethodPoolQuery, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolQueryResp)
+	if err := svc.unmarshalPB(dResp.Body, resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != 0 {
+		return nil, daos.Status(resp.Status)
+	}
+
 	return resp, nil
 }
 
@@ -1270,6 +1503,46 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 	return resp, nil
 }
 
+// PoolProfileSet stores a named pool profile in the system database or deletes it if the
+// profile in the request is empty. The profile encoding is opaque to the management service
+// beyond being checked as valid JSON.
+func (svc *mgmtSvc) PoolProfileSet(ctx context.Context, req *mgmtpb.PoolProfileSetReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.GetProfile() == "" {
+		if err := system.DelPoolProfile(svc.sysdb, req.GetName()); err != nil {
+			return nil, err
+		}
+		return &mgmtpb.DaosResp{}, nil
+	}
+
+	if !json.Valid([]byte(req.GetProfile())) {
+		return nil, errors.Errorf("invalid encoding of pool profile %q", req.GetName())
+	}
+	if err := system.SetPoolProfile(svc.sysdb, req.GetName(), req.GetProfile()); err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.DaosResp{}, nil
+}
+
+// PoolProfileGet returns the named pool profiles from the system database, or all stored pool
+// profiles if no names are supplied.
+func (svc *mgmtSvc) PoolProfileGet(ctx context.Context, req *mgmtpb.PoolProfileGetReq) (*mgmtpb.PoolProfileGetResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	profiles, err := system.GetPoolProfiles(svc.sysdb, req.GetNames())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.PoolProfileGetResp{Profiles: profiles}, nil
+}
+
 // PoolRebuildStart forwards a pool interactive rebuild start request to the I/O Engine.
 func (svc *mgmtSvc) PoolRebuildStart(ctx context.Context, req *mgmtpb.PoolRebuildStartReq) (*mgmtpb.DaosResp, error) {
 	return svc.poolServiceSimple(ctx, req, daos.MethodPoolRebuildStart)
@@ -0,0 +1,144 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	poolHistoryInterval  = 10 * time.Minute
+	poolHistoryRetention = 7 * 24 * time.Hour
+)
+
+type (
+	poolUsageSample struct {
+		time   time.Time
+		sample *mgmtpb.PoolUsageSample
+	}
+
+	// poolHistory holds the pool usage samples captured by the MS leader. Samples are kept in
+	// memory only, so the history starts again after an MS leadership change.
+	poolHistory struct {
+		sync.RWMutex
+		retention time.Duration
+		samples   map[string][]*poolUsageSample // keyed by pool UUID
+	}
+)
+
+func newPoolHistory(retention time.Duration) *poolHistory {
+	return &poolHistory{
+		retention: retention,
+		samples:   make(map[string][]*poolUsageSample),
+	}
+}
+
+// add records a sample for the pool and drops the pool's samples that are older than the
+// retention period.
+func (ph *poolHistory) add(poolUUID string, now time.Time, resp *mgmtpb.PoolQueryResp) {
+	ph.Lock()
+	defer ph.Unlock()
+
+	cutoff := now.Add(-ph.retention)
+	samples := ph.samples[poolUUID]
+	for len(samples) > 0 && samples[0].time.Before(cutoff) {
+		samples = samples[1:]
+	}
+
+	ph.samples[poolUUID] = append(samples, &poolUsageSample{
+		time: now,
+		sample: &mgmtpb.PoolUsageSample{
+			Timestamp:       now.Format(time.RFC3339),
+			TierStats:       resp.TierStats,
+			Rebuild:         resp.Rebuild,
+			DisabledTargets: resp.DisabledTargets,
+		},
+	})
+}
+
+// prune drops the samples of pools that are not in the supplied set.
+func (ph *poolHistory) prune(poolUUIDs map[string]struct{}) {
+	ph.Lock()
+	defer ph.Unlock()
+
+	for poolUUID := range ph.samples {
+		if _, found := poolUUIDs[poolUUID]; !found {
+			delete(ph.samples, poolUUID)
+		}
+	}
+}
+
+// get returns the samples captured for the pool within the period before now, oldest first.
+func (ph *poolHistory) get(poolUUID string, now time.Time, period time.Duration) []*mgmtpb.PoolUsageSample {
+	if ph == nil {
+		return nil
+	}
+
+	ph.RLock()
+	defer ph.RUnlock()
+
+	cutoff := now.Add(-period)
+	var out []*mgmtpb.PoolUsageSample
+	for _, s := range ph.samples[poolUUID] {
+		if s.time.Before(cutoff) {
+			continue
+		}
+		out = append(out, s.sample)
+	}
+
+	return out
+}
+
+// samplePoolHistory records the space usage and rebuild status of each ready pool.
+func (svc *mgmtSvc) samplePoolHistory(ctx context.Context) {
+	psList, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		svc.log.Errorf("pool history: failed to fetch pool service list: %s", err)
+		return
+	}
+
+	poolUUIDs := make(map[string]struct{})
+	for _, ps := range psList {
+		poolUUIDs[ps.PoolUUID.String()] = struct{}{}
+		if ps.State != system.PoolServiceStateReady {
+			continue
+		}
+
+		resp, err := svc.queryPoolService(ctx, ps.PoolUUID.String(), daos.DefaultPoolQueryMask)
+		if err != nil {
+			svc.log.Debugf("pool history: query of pool %s failed: %s", ps.PoolUUID, err)
+			continue
+		}
+
+		svc.poolHistory.add(ps.PoolUUID.String(), time.Now(), resp)
+	}
+
+	svc.poolHistory.prune(poolUUIDs)
+}
+
+// poolHistoryLoop periodically samples pool usage while this replica is the MS leader.
+func (svc *mgmtSvc) poolHistoryLoop(parent context.Context) {
+	ticker := time.NewTicker(poolHistoryInterval)
+	defer ticker.Stop()
+
+	svc.log.Debug("starting poolHistoryLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped poolHistoryLoop")
+			return
+		case <-ticker.C:
+			svc.samplePoolHistory(parent)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestServer_poolHistory(t *testing.T) {
+	now := time.Now()
+	ts := func(d time.Duration) string {
+		return now.Add(d).Format(time.RFC3339)
+	}
+
+	ph := newPoolHistory(24 * time.Hour)
+	ph.add(test.MockUUID(1), now.Add(-30*time.Hour), &mgmtpb.PoolQueryResp{DisabledTargets: 1})
+	ph.add(test.MockUUID(1), now.Add(-3*time.Hour), &mgmtpb.PoolQueryResp{DisabledTargets: 2})
+	ph.add(test.MockUUID(2), now.Add(-2*time.Hour), &mgmtpb.PoolQueryResp{DisabledTargets: 3})
+	ph.add(test.MockUUID(1), now, &mgmtpb.PoolQueryResp{DisabledTargets: 4})
+
+	for name, tc := range map[string]struct {
+		poolUUID   string
+		period     time.Duration
+		expSamples []*mgmtpb.PoolUsageSample
+	}{
+		"unknown pool": {
+			poolUUID: test.MockUUID(3),
+			period:   time.Hour,
+		},
+		"samples older than retention dropped": {
+			poolUUID: test.MockUUID(1),
+			period:   48 * time.Hour,
+			expSamples: []*mgmtpb.PoolUsageSample{
+				{Timestamp: ts(-3 * time.Hour), DisabledTargets: 2},
+				{Timestamp: ts(0), DisabledTargets: 4},
+			},
+		},
+		"samples within period": {
+			poolUUID: test.MockUUID(1),
+			period:   time.Hour,
+			expSamples: []*mgmtpb.PoolUsageSample{
+				{Timestamp: ts(0), DisabledTargets: 4},
+			},
+		},
+		"other pool": {
+			poolUUID: test.MockUUID(2),
+			period:   24 * time.Hour,
+			expSamples: []*mgmtpb.PoolUsageSample{
+				{Timestamp: ts(-2 * time.Hour), DisabledTargets: 3},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSamples := ph.get(tc.poolUUID, now, tc.period)
+			if diff := cmp.Diff(tc.expSamples, gotSamples, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected samples (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	ph.prune(map[string]struct{}{test.MockUUID(2): {}})
+	if got := ph.get(test.MockUUID(1), now, 48*time.Hour); len(got) != 0 {
+		t.Fatalf("expected samples of pruned pool to be dropped, got %d", len(got))
+	}
+	if got := ph.get(test.MockUUID(2), now, 48*time.Hour); len(got) != 1 {
+		t.Fatalf("expected 1 sample for retained pool, got %d", len(got))
+	}
+}
+
+func TestServer_MgmtSvc_samplePoolHistory(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	addTestPools(t, svc.sysdb, mockUUID)
+	svc.poolHistory.add(test.MockUUID(9), time.Now(), &mgmtpb.PoolQueryResp{})
+
+	setupMockDrpcClient(svc, &mgmtpb.PoolQueryResp{
+		Uuid: mockUUID,
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{Total: 100, Free: 40},
+		},
+		Rebuild: &mgmtpb.PoolRebuildStatus{
+			State:   mgmtpb.PoolRebuildStatus_BUSY,
+			Objects: 10,
+		},
+	}, nil)
+
+	svc.samplePoolHistory(test.Context(t))
+
+	if got := svc.poolHistory.get(test.MockUUID(9), time.Now(), time.Hour); len(got) != 0 {
+		t.Fatalf("expected history of removed pool to be pruned, got %d samples", len(got))
+	}
+
+	got := svc.poolHistory.get(mockUUID, time.Now(), time.Hour)
+	if len(got) != 1 {
+		t.Fatalf("expected 1 sample, got %d", len(got))
+	}
+	expSample := &mgmtpb.PoolUsageSample{
+		Timestamp: got[0].Timestamp,
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{Total: 100, Free: 40},
+		},
+		Rebuild: &mgmtpb.PoolRebuildStatus{
+			State:   mgmtpb.PoolRebuildStatus_BUSY,
+			Objects: 10,
+		},
+	}
+	if diff := cmp.Diff(expSample, got[0], test.DefaultCmpOpts()...); diff != "" {
+		t.Fatalf("unexpected sample (-want, +got):\n%s\n", diff)
+	}
+}
@@ -1468,6 +1468,437 @@ func TestServer_MgmtSvc_PoolExtend(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_PoolResize(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	notAP := newTestMgmtSvc(t, log)
+	curTierBytes := []uint64{humanize.GiByte, 10 * humanize.GiByte}
+
+	for name, tc := range map[string]struct {
+		nilReq       bool
+		getMockDrpc  func(error) *mockDrpcClient
+		mgmtSvc      *mgmtSvc
+		psTierBytes  []uint64
+		reqIn        *mgmtpb.PoolResizeReq
+		drpcResp     *mgmtpb.PoolResizeResp
+		expDrpcReq   *mgmtpb.PoolResizeReq
+		expResp      *mgmtpb.PoolResizeResp
+		expTierBytes []uint64
+		expErr       error
+	}{
+		"nil request": {
+			nilReq: true,
+			expErr: errors.New("nil request"),
+		},
+		"not MS replica": {
+			mgmtSvc: notAP,
+			expErr:  errNotReplica,
+		},
+		"missing uuid": {
+			reqIn:  &mgmtpb.PoolResizeReq{TierBytes: []uint64{2 * humanize.GiByte}},
+			expErr: errors.New("empty pool id"),
+		},
+		"no tier sizes": {
+			reqIn:  &mgmtpb.PoolResizeReq{Id: mockUUID},
+			expErr: errors.New("expected between 1 and 2 tier sizes"),
+		},
+		"too many tier sizes": {
+			reqIn:  &mgmtpb.PoolResizeReq{Id: mockUUID, TierBytes: []uint64{1, 2, 3}},
+			expErr: errors.New("expected between 1 and 2 tier sizes"),
+		},
+		"shrink": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{0, 5 * humanize.GiByte},
+			},
+			expErr: FaultPoolResizeShrink(1, 10*humanize.GiByte, 5*humanize.GiByte),
+		},
+		"grow scm": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{2 * humanize.GiByte, 20 * humanize.GiByte},
+			},
+			expErr: FaultPoolResizeTier(0, "the SCM tier of an existing pool cannot grow"),
+		},
+		"grow nvme on scm-only pool": {
+			psTierBytes: []uint64{humanize.GiByte, 0},
+			expErr:      FaultPoolResizeTier(1, "the pool has no storage on this tier"),
+		},
+		"no change": {
+			reqIn: &mgmtpb.PoolResizeReq{
+				Id:        mockUUID,
+				TierBytes: []uint64{humanize.GiByte},
+			},
+			expErr: errors.New("does not increase"),
+		},
+		"dRPC send fails": {
+			expErr: errors.New("send failure"),
+		},
+		"garbage resp": {
+			getMockDrpc: func(err error) *mockDrpcClient {
+				// dRPC call returns junk in the message body
+				badBytes := makeBadBytes(42)
+
+				return getMockDrpcClientBytes(badBytes, err)
+			},
+			expErr: errors.New("unmarshal"),
+		},
+		"engine returns error status": {
+			drpcResp: &mgmtpb.PoolResizeResp{
+				Status: int32(daos.NoSpace),
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				Status: int32(daos.NoSpace),
+			},
+			expTierBytes: curTierBytes,
+		},
+		"successfully resized": {
+			drpcResp: &mgmtpb.PoolResizeResp{},
+			// Expect that unset tier sizes are filled from the ps entry.
+			expDrpcReq: &mgmtpb.PoolResizeReq{
+				Sys:       build.DefaultSystemName,
+				Id:        mockUUID,
+				SvcRanks:  mockSvcRanks,
+				TierBytes: []uint64{humanize.GiByte, 20 * humanize.GiByte},
+				MemRatio:  mockMemRatio,
+			},
+			expResp: &mgmtpb.PoolResizeResp{
+				TierBytes: []uint64{humanize.GiByte, 20 * humanize.GiByte},
+			},
+			expTierBytes: []uint64{humanize.GiByte, 20 * humanize.GiByte},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.reqIn == nil && !tc.nilReq {
+				tc.reqIn = &mgmtpb.PoolResizeReq{
+					Id:        mockUUID,
+					TierBytes: []uint64{0, 20 * humanize.GiByte},
+				}
+			}
+			if tc.mgmtSvc == nil {
+				tc.mgmtSvc = newTestMgmtSvc(t, log)
+			}
+			if tc.psTierBytes == nil {
+				tc.psTierBytes = curTierBytes
+			}
+			addTestPoolService(t, tc.mgmtSvc.sysdb, &system.PoolService{
+				PoolUUID: uuid.MustParse(mockUUID),
+				State:    system.PoolServiceStateReady,
+				Replicas: []ranklist.Rank{0},
+				Storage: &system.PoolServiceStorage{
+					PerRankTierStorage: tc.psTierBytes,
+					MemRatio:           mockMemRatio,
+				},
+			})
+
+			if tc.getMockDrpc == nil {
+				tc.getMockDrpc = func(err error) *mockDrpcClient {
+					return getMockDrpcClient(tc.drpcResp, err)
+				}
+			}
+			mdc := tc.getMockDrpc(tc.expErr)
+			setupSvcDrpcClient(tc.mgmtSvc, 0, mdc)
+
+			if tc.reqIn != nil && tc.reqIn.Sys == "" {
+				tc.reqIn.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := tc.mgmtSvc.PoolResize(test.Context(t), tc.reqIn)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := test.DefaultCmpOpts()
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			ps, err := tc.mgmtSvc.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expTierBytes, ps.Storage.PerRankTierStorage); diff != "" {
+				t.Fatalf("unexpected pool service tier sizes (-want, +got)\n%s\n", diff)
+			}
+
+			if tc.expDrpcReq == nil {
+				return
+			}
+			lastReq := new(mgmtpb.PoolResizeReq)
+			if err := proto.Unmarshal(getLastMockCall(mdc).Body, lastReq); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expDrpcReq, lastReq, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected final dRPC request (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolClone(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	notAP := newTestMgmtSvc(t, log)
+	srcTierBytes := []uint64{100 * humanize.GiByte, 10 * humanize.TByte}
+
+	propResp := &mgmtpb.PoolGetPropResp{
+		Properties: []*mgmtpb.PoolProperty{
+			{
+				Number: daos.PoolPropertyLabel,
+				Value:  &mgmtpb.PoolProperty_Strval{Strval: "src"},
+			},
+			{
+				Number: daos.PoolPropertyGlobalVersion,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: 3},
+			},
+			{
+				Number: daos.PoolPropertySpaceReclaim,
+				Value:  &mgmtpb.PoolProperty_Numval{Numval: daos.PoolSpaceReclaimTime},
+			},
+		},
+	}
+	aclResp := &mgmtpb.ACLResp{
+		Acl: &mgmtpb.AccessControlList{
+			Entries:    []string{"A::OWNER@:rw"},
+			OwnerUser:  "bob@",
+			OwnerGroup: "builders@",
+		},
+	}
+	createResp := &mgmtpb.PoolCreateResp{
+		SvcReps:   []uint32{0},
+		TgtRanks:  []uint32{0, 1},
+		TierBytes: srcTierBytes,
+	}
+
+	for name, tc := range map[string]struct {
+		mgmtSvc      *mgmtSvc
+		req          *mgmtpb.PoolCloneReq
+		srcState     system.PoolServiceState
+		drpcResps    []*mockDrpcResponse
+		expResp      *mgmtpb.PoolCloneResp
+		expCreateReq *mgmtpb.PoolCreateReq
+		expErr       error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not MS replica": {
+			mgmtSvc: notAP,
+			req:     &mgmtpb.PoolCloneReq{Id: mockUUID, Label: "clone"},
+			expErr:  errNotReplica,
+		},
+		"missing label": {
+			req:    &mgmtpb.PoolCloneReq{Id: mockUUID},
+			expErr: FaultPoolNoLabel,
+		},
+		"unknown source pool": {
+			req:    &mgmtpb.PoolCloneReq{Id: test.MockUUID(9), Label: "clone"},
+			expErr: errors.New("unable to find pool service"),
+		},
+		"source pool not ready": {
+			req:      &mgmtpb.PoolCloneReq{Id: mockUUID, Label: "clone"},
+			srcState: system.PoolServiceStateDestroying,
+			expErr:   errors.New("is not ready"),
+		},
+		"get properties fails": {
+			req: &mgmtpb.PoolCloneReq{Id: mockUUID, Label: "clone"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolGetPropResp{Status: int32(daos.NoPermission)}},
+			},
+			expResp: &mgmtpb.PoolCloneResp{Status: int32(daos.NoPermission)},
+		},
+		"get ACL fails": {
+			req: &mgmtpb.PoolCloneReq{Id: mockUUID, Label: "clone"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: propResp},
+				{Message: &mgmtpb.ACLResp{Status: int32(daos.NoPermission)}},
+			},
+			expResp: &mgmtpb.PoolCloneResp{Status: int32(daos.NoPermission)},
+		},
+		"successful clone": {
+			req: &mgmtpb.PoolCloneReq{Id: mockUUID, Label: "clone"},
+			drpcResps: []*mockDrpcResponse{
+				{Message: propResp},
+				{Message: aclResp},
+				{Message: createResp},
+			},
+			expResp: &mgmtpb.PoolCloneResp{
+				SvcReps:   []uint32{0},
+				TgtRanks:  []uint32{0, 1},
+				TierBytes: srcTierBytes,
+			},
+			expCreateReq: &mgmtpb.PoolCreateReq{
+				Sys:       build.DefaultSystemName,
+				User:      "bob@",
+				UserGroup: "builders@",
+				Acl:       []string{"A::OWNER@:rw"},
+				Ranks:     []uint32{0, 1},
+				TierBytes: srcTierBytes,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf.Reset()
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.mgmtSvc == nil {
+				engineCfg := engine.MockConfig().
+					WithTargetCount(16).
+					WithStorage(storage.NewTierConfig().
+						WithStorageClass("nvme").
+						WithBdevDeviceList("foo", "bar"))
+				mp := storage.NewProvider(log, 0, &engineCfg.Storage,
+					nil, nil, nil, nil)
+				tc.mgmtSvc = newTestMgmtSvcWithProvider(t, log, mp)
+			}
+			for i := 0; i < 2; i++ {
+				mm := system.MockMember(t, uint32(i), system.MemberStateJoined)
+				if _, err := tc.mgmtSvc.membership.Add(mm); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			srcPS := system.NewPoolService(uuid.MustParse(mockUUID), srcTierBytes, 0,
+				[]ranklist.Rank{0, 1})
+			srcPS.PoolLabel = "src"
+			srcPS.Replicas = []ranklist.Rank{0}
+			srcPS.State = system.PoolServiceStateReady
+			if tc.srcState != 0 {
+				srcPS.State = tc.srcState
+			}
+			addTestPoolService(t, tc.mgmtSvc.sysdb, srcPS)
+
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(tc.mgmtSvc, 0, mdc)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			ctx, cancel := context.WithTimeout(test.Context(t), 260*time.Millisecond)
+			defer cancel()
+			gotResp, gotErr := tc.mgmtSvc.PoolClone(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := append(test.DefaultCmpOpts(),
+				protocmp.IgnoreFields(&mgmtpb.PoolCloneResp{}, "uuid"))
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			if tc.expCreateReq == nil {
+				return
+			}
+			var gotCreateReq *mgmtpb.PoolCreateReq
+			for _, call := range mdc.calls.get() {
+				if call.Method != daos.MethodPoolCreate.ID() {
+					continue
+				}
+				gotCreateReq = new(mgmtpb.PoolCreateReq)
+				if err := proto.Unmarshal(call.Body, gotCreateReq); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if gotCreateReq == nil {
+				t.Fatal("pool create dRPC not called")
+			}
+
+			// Only the label and the source pool's configurable properties are copied.
+			props := make(map[uint32]*mgmtpb.PoolProperty)
+			for _, prop := range gotCreateReq.Properties {
+				props[prop.Number] = prop
+			}
+			if props[daos.PoolPropertyLabel].GetStrval() != "clone" {
+				t.Fatalf("unexpected clone label prop %v", props[daos.PoolPropertyLabel])
+			}
+			if props[daos.PoolPropertySpaceReclaim].GetNumval() != daos.PoolSpaceReclaimTime {
+				t.Fatalf("reclaim prop not copied to clone")
+			}
+			if _, found := props[daos.PoolPropertyGlobalVersion]; found {
+				t.Fatalf("global version prop copied to clone")
+			}
+			if gotCreateReq.Uuid == mockUUID {
+				t.Fatal("clone reuses source pool uuid")
+			}
+
+			cmpOpts = append(test.DefaultCmpOpts(),
+				protocmp.IgnoreFields(&mgmtpb.PoolCreateReq{}, "uuid", "properties",
+					"fault_domains", "mem_ratio"))
+			if diff := cmp.Diff(tc.expCreateReq, gotCreateReq, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected create request (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolProfiles(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ctx := test.Context(t)
+	svc := newTestMgmtSvc(t, log)
+	sys := build.DefaultSystemName
+
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.PoolProfileSetReq
+		expErr error
+	}{
+		"no name": {
+			req:    &mgmtpb.PoolProfileSetReq{Sys: sys, Profile: "{}"},
+			expErr: errors.New("empty pool profile name"),
+		},
+		"bad encoding": {
+			req:    &mgmtpb.PoolProfileSetReq{Sys: sys, Name: "prod", Profile: "{"},
+			expErr: errors.New("invalid encoding"),
+		},
+		"delete unknown": {
+			req:    &mgmtpb.PoolProfileSetReq{Sys: sys, Name: "missing"},
+			expErr: system.FaultPoolProfileNotFound("missing"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := svc.PoolProfileSet(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+
+	for _, req := range []*mgmtpb.PoolProfileSetReq{
+		{Sys: sys, Name: "prod", Profile: `{"tier_ratio":[0.1,0.9]}`},
+		{Sys: sys, Name: "test", Profile: `{}`},
+	} {
+		if _, err := svc.PoolProfileSet(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := svc.PoolProfileGet(ctx, &mgmtpb.PoolProfileGetReq{Sys: sys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{
+		"prod": `{"tier_ratio":[0.1,0.9]}`,
+		"test": `{}`,
+	}, resp.Profiles); diff != "" {
+		t.Fatalf("unexpected profiles (-want, +got)\n%s\n", diff)
+	}
+
+	if _, err := svc.PoolProfileSet(ctx, &mgmtpb.PoolProfileSetReq{Sys: sys, Name: "test"}); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = svc.PoolProfileGet(ctx, &mgmtpb.PoolProfileGetReq{Sys: sys, Names: []string{"test"}})
+	test.CmpErr(t, system.FaultPoolProfileNotFound("test"), err)
+}
+
 func TestServer_MgmtSvc_PoolReintegrate(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	missingSB := newTestMgmtSvc(t, log)
@@ -2331,6 +2762,8 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 	missingSB := newTestMgmtSvc(t, log)
 	missingSB.harness.instances[0].(*EngineInstance)._superblock = nil
 
+	histNow := time.Now()
+
 	allRanksDown := newTestMgmtSvc(t, log)
 	downRanksPool := test.MockUUID(9)
 	addTestPools(t, allRanksDown.sysdb, downRanksPool)
@@ -2417,6 +2850,33 @@ func TestServer_MgmtSvc_PoolQuery(t *testing.T) {
 				Leader: 42,
 			},
 		},
+		"successful query with history": {
+			req: &mgmtpb.PoolQueryReq{
+				Id:          mockUUID,
+				HistorySecs: uint64((24 * time.Hour).Seconds()),
+			},
+			setupMockDrpc: func(svc *mgmtSvc, err error) {
+				svc.poolHistory.add(mockUUID, histNow.Add(-48*time.Hour),
+					&mgmtpb.PoolQueryResp{DisabledTargets: 1})
+				svc.poolHistory.add(mockUUID, histNow.Add(-time.Hour),
+					&mgmtpb.PoolQueryResp{DisabledTargets: 2})
+				resp := &mgmtpb.PoolQueryResp{
+					State: mgmtpb.PoolServiceState_Ready,
+					Uuid:  mockUUID,
+				}
+				setupMockDrpcClient(svc, resp, nil)
+			},
+			expResp: &mgmtpb.PoolQueryResp{
+				State: mgmtpb.PoolServiceState_Ready,
+				Uuid:  mockUUID,
+				History: []*mgmtpb.PoolUsageSample{
+					{
+						Timestamp:       histNow.Add(-time.Hour).Format(time.RFC3339),
+						DisabledTargets: 2,
+					},
+				},
+			},
+		},
 		"successful query; mdonssd enabled": {
 			req: &mgmtpb.PoolQueryReq{
 				Id: mockUUID,
@@ -83,6 +83,8 @@ type mgmtSvc struct {
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	poolHistory       *poolHistory
+	jobs              *jobManager
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -99,6 +101,8 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		batchReqs:         make(batchReqChan),
 		serialReqs:        make(batchReqChan),
 		groupUpdateReqs:   make(chan bool),
+		poolHistory:       newPoolHistory(poolHistoryRetention),
+		jobs:              newJobManager(),
 	}
 }
 
@@ -176,6 +180,8 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
 	go svc.leaderTaskLoop(ctx)
+	go svc.poolHistoryLoop(ctx)
+	svc.jobs.setParent(ctx)
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -277,7 +283,9 @@ func (svc *mgmtSvc) processBatchJoins(ctx context.Context, bprChan batchProcessR
 			continue
 		}
 
-		resp, err := svc.join(ctx, msg, replyAddr)
+		// Link any events raised while joining to the join request.
+		joinCtx := events.WithCorrelationID(ctx, events.CorrelationIDFromContext(req.ctx))
+		resp, err := svc.join(joinCtx, msg, replyAddr)
 		req.sendResponse(ctx, resp, err)
 		if err == nil {
 			updateNeeded = true
@@ -217,7 +217,8 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 		return nil, err
 	}
 
-	if err := svc.checkReqFabricProvider(req, peerAddr, svc.events); err != nil {
+	publisher := events.CorrelatedPublisher(ctx, svc.events)
+	if err := svc.checkReqFabricProvider(req, peerAddr, publisher); err != nil {
 		return nil, err
 	}
 
@@ -249,7 +250,7 @@ func (svc *mgmtSvc) join(ctx context.Context, req *mgmtpb.JoinReq, peerAddr *net
 	joinResponse, err := svc.membership.Join(joinReq)
 	if err != nil {
 		if system.IsJoinFailure(err) {
-			publishJoinFailedEvent(req, peerAddr, svc.events, err.Error())
+			publishJoinFailedEvent(req, peerAddr, publisher, err.Error())
 		}
 		return nil, errors.Wrap(err, "failed to join system")
 	}
@@ -611,11 +612,13 @@ type (
 	systemRanksFunc func(context.Context, control.UnaryInvoker, *control.RanksReq) (*control.RanksResp, error)
 
 	fanoutRequest struct {
-		Method     systemRanksFunc
-		Ranks      *ranklist.RankSet
-		Force      bool
-		FullSystem bool
-		CheckMode  bool
+		Method       systemRanksFunc
+		Ranks        *ranklist.RankSet
+		Force        bool
+		FullSystem   bool
+		CheckMode    bool
+		DrainTimeout time.Duration
+		WipeScm      bool
 	}
 
 	fanoutResponse struct {
@@ -729,9 +732,11 @@ func (svc *mgmtSvc) rpcFanout(ctx context.Context, req *fanoutRequest, resp *fan
 	}
 
 	ranksReq := &control.RanksReq{
-		Ranks:     req.Ranks.String(),
-		Force:     req.Force,
-		CheckMode: req.CheckMode,
+		Ranks:        req.Ranks.String(),
+		Force:        req.Force,
+		CheckMode:    req.CheckMode,
+		DrainTimeout: uint32(req.DrainTimeout.Seconds()),
+		WipeScm:      req.WipeScm,
 	}
 
 	funcName := func(i interface{}) string {
@@ -907,13 +912,13 @@ func (svc *mgmtSvc) getFanout(req systemReq) (*fanoutRequest, *fanoutResponse, e
 		force = forceReq.GetForce()
 	}
 	return &fanoutRequest{
-			Ranks:      hitRanks,
-			Force:      force,
-			FullSystem: len(ranklist.CheckRankMembership(hitRanks.Ranks(), allRanks)) == 0,
-		}, &fanoutResponse{
-			AbsentRanks: missRanks,
-			AbsentHosts: missHosts,
-		}, nil
+		Ranks:      hitRanks,
+		Force:      force,
+		FullSystem: len(ranklist.CheckRankMembership(hitRanks.Ranks(), allRanks)) == 0,
+	}, &fanoutResponse{
+		AbsentRanks: missRanks,
+		AbsentHosts: missHosts,
+	}, nil
 }
 
 func (svc *mgmtSvc) getFanoutNoAdminExcluded(req systemReq, ignoreAdminExcluded bool) (*fanoutRequest, *fanoutResponse, error) {
@@ -976,9 +981,35 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	if err != nil {
 		return nil, err
 	}
+	publisher := events.CorrelatedPublisher(ctx, svc.events)
+
+	// Optional drain phase: Stop the ranks accepting new pool connections and give in-flight
+	// I/O a chance to complete. A failed or timed-out drain is reported but doesn't prevent
+	// the ranks from being stopped.
+	var drainResults []*sharedpb.RankResult
+	if req.Drain {
+		fReq.Method = control.DrainRanks
+		fReq.DrainTimeout = time.Duration(req.DrainTimeout) * time.Second
+		drainResp, _, err := svc.rpcFanout(ctx, fReq, nil, false)
+		if err != nil {
+			return nil, err
+		}
+		if drainResp.Results.Errors() != nil {
+			publisher.Publish(newSystemStopFailedEvent("drain",
+				drainResp.Results.Errors().Error()))
+		}
+		if err := convert.Types(drainResp.Results, &drainResults); err != nil {
+			return nil, err
+		}
+		for _, r := range drainResults {
+			r.Action = "drain"
+		}
+	}
 
 	// First phase: Prepare the ranks for shutdown, but only if the request is for an unforced
-	// full system stop.
+	// full system stop. The outcome of the shutdown notification is reported along with the
+	// progress of the stop for each rank.
+	notified := make(map[ranklist.Rank]string)
 	if !fReq.Force {
 		fReq.Method = control.PrepShutdownRanks
 		fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
@@ -987,22 +1018,37 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 		}
 		if fResp.Results.Errors() != nil {
 			// return early if not forced and prep shutdown fails
-			return processStopResp("prep shutdown", fResp, svc.events)
+			resp, err := processStopResp("prep shutdown", fResp, publisher)
+			if err != nil {
+				return nil, err
+			}
+			resp.DrainResults = drainResults
+			return resp, nil
+		}
+		for _, r := range fResp.Results {
+			notified[r.Rank] = r.Msg
 		}
 	}
 
 	// Second phase: Stop the ranks. If the request is forced, we will
-	// kill the ranks immediately without a graceful shutdown.
+	// kill the ranks immediately without a graceful shutdown, otherwise each
+	// harness escalates to SIGKILL if its engines fail to exit in time.
 	fReq.Method = control.StopRanks
 	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
 	if err != nil {
 		return nil, err
 	}
+	for _, r := range fResp.Results {
+		if msg := notified[r.Rank]; msg != "" && !r.Errored {
+			r.Msg = strings.TrimSuffix(msg+", "+r.Msg, ", ")
+		}
+	}
 
-	resp, err := processStopResp("stop", fResp, svc.events)
+	resp, err := processStopResp("stop", fResp, publisher)
 	if err != nil {
 		return nil, err
 	}
+	resp.DrainResults = drainResults
 
 	return resp, nil
 }
@@ -1095,7 +1141,7 @@ func (svc *mgmtSvc) SystemStart(ctx context.Context, req *mgmtpb.SystemStartReq)
 		return nil, err
 	}
 
-	resp, err := processStartResp(fResp, svc.events)
+	resp, err := processStartResp(fResp, events.CorrelatedPublisher(ctx, svc.events))
 	if err != nil {
 		return nil, err
 	}
@@ -1630,6 +1676,10 @@ func (svc *mgmtSvc) eraseAndRestart(pause bool) error {
 
 // SystemErase implements the gRPC handler for erasing system metadata.
 func (svc *mgmtSvc) SystemErase(ctx context.Context, pbReq *mgmtpb.SystemEraseReq) (*mgmtpb.SystemEraseResp, error) {
+	if pbReq.GetRanks() != "" || pbReq.GetHosts() != "" {
+		return svc.systemEraseRanks(ctx, pbReq)
+	}
+
 	// At a minimum, ensure that this only runs on MS replicas.
 	if err := svc.checkReplicaRequest(pbReq); err != nil {
 		return nil, err
@@ -1705,6 +1755,100 @@ func (svc *mgmtSvc) SystemErase(ctx context.Context, pbReq *mgmtpb.SystemEraseRe
 	return pbResp, errors.Wrap(svc.eraseAndRestart(true), "erasing and restarting leader")
 }
 
+// eraseReplaceHint tells the user how an erased rank rejoins the system.
+const eraseReplaceHint = "rejoin with dmg storage format --replace"
+
+// systemEraseRanks removes the superblocks and SCM contents of the selected ranks so that their
+// engines can be reformatted, e.g. after a server has been replaced. Unlike a full system erase,
+// the MS database and the other ranks are left intact and the erased ranks remain members of the
+// system, awaiting format and excluded from the group map, until they rejoin in replace mode. The
+// ranks must already have been excluded from all pools as their data is destroyed.
+func (svc *mgmtSvc) systemEraseRanks(ctx context.Context, pbReq *mgmtpb.SystemEraseReq) (*mgmtpb.SystemEraseResp, error) {
+	if err := svc.checkLeaderRequest(pbReq); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("Received SystemErase RPC for ranks %q hosts %q", pbReq.GetRanks(),
+		pbReq.GetHosts())
+
+	fanReq, fanResp, err := svc.getFanout(pbReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// The client checks that the ranks are stopped but they may have been started since.
+	aliveRanks := ranklist.MustCreateRankSet("")
+	for _, rank := range fanReq.Ranks.Ranks() {
+		member, err := svc.membership.Get(rank)
+		if err != nil {
+			return nil, err
+		}
+		if member.State&system.AvailableMemberFilter != 0 {
+			aliveRanks.Add(rank)
+		}
+	}
+	if aliveRanks.Count() > 0 {
+		return nil, errors.Errorf("system erase requires the following %s to be stopped: %s",
+			english.Plural(aliveRanks.Count(), "rank", "ranks"), aliveRanks)
+	}
+
+	// Pool maps would otherwise keep referring to targets whose data no longer exists.
+	poolIDs, poolRanks, err := svc.getPoolRanksEnabled(ctx, fanReq.Ranks)
+	if err != nil {
+		return nil, err
+	}
+	if len(poolIDs) != 0 {
+		enabledRanks := ranklist.MustCreateRankSet("")
+		for _, rs := range poolRanks {
+			enabledRanks.Merge(rs)
+		}
+		return nil, FaultEraseEnabledPoolRanks(enabledRanks, poolIDs...)
+	}
+
+	fanReq.Method = control.ResetFormatRanks
+	fanReq.WipeScm = true
+	fanResp, _, err = svc.rpcFanout(ctx, fanReq, fanResp, false)
+	if err != nil {
+		return nil, err
+	}
+	// Erased ranks await format and are dropped from the group map. Ranks that were excluded
+	// with dmg system exclude keep their state on update, so move them explicitly as replace-mode
+	// joins are refused for administratively excluded ranks.
+	for _, result := range fanResp.Results {
+		if result.Errored || !svc.membership.IsRankAdminExcluded(result.Rank) {
+			continue
+		}
+		m, err := svc.sysdb.FindMemberByRank(result.Rank)
+		if err != nil {
+			return nil, err
+		}
+		m.State = system.MemberStateAwaitFormat
+		if err := svc.sysdb.UpdateMember(m); err != nil {
+			return nil, err
+		}
+	}
+	svc.reqGroupUpdate(ctx, false)
+
+	pbResp := &mgmtpb.SystemEraseResp{
+		Absentranks: fanResp.AbsentRanks.String(),
+		Absenthosts: fanResp.AbsentHosts.String(),
+	}
+	if err := convert.Types(fanResp.Results, &pbResp.Results); err != nil {
+		return nil, err
+	}
+	for _, result := range pbResp.Results {
+		result.Action = "erase"
+		if !result.Errored {
+			svc.log.Noticef("rank %d erased: %s", result.Rank, result.Msg)
+			if result.Msg != "" {
+				result.Msg += ", "
+			}
+			result.Msg += eraseReplaceHint
+		}
+	}
+
+	return pbResp, nil
+}
+
 // SystemCleanup implements the method defined for the Management Service.
 //
 // Signal to the data plane to find all resources associated with a given machine
@@ -1791,6 +1935,89 @@ func (svc *mgmtSvc) SystemGetAttr(ctx context.Context, req *mgmtpb.SystemGetAttr
 	return
 }
 
+// eventQueryFilter selects retained events matching a SystemEventsQuery request.
+type eventQueryFilter struct {
+	minSeverity events.RASSeverityID
+	ranks       *ranklist.RankSet
+	since       time.Time
+}
+
+func newEventQueryFilter(req *mgmtpb.SystemEventsQueryReq) (*eventQueryFilter, error) {
+	ef := &eventQueryFilter{minSeverity: events.RASSeverityNotice}
+
+	if req.MinSeverity != "" {
+		sev, err := events.ParseRASSeverity(req.MinSeverity)
+		if err != nil {
+			return nil, err
+		}
+		ef.minSeverity = sev
+	}
+	if req.Ranks != "" {
+		rs, err := ranklist.CreateRankSet(req.Ranks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid ranks %q", req.Ranks)
+		}
+		ef.ranks = rs
+	}
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time %q", req.Since)
+		}
+		ef.since = since
+	}
+
+	return ef, nil
+}
+
+func (ef *eventQueryFilter) matches(evt *sharedpb.RASEvent) bool {
+	if !events.RASSeverityID(evt.Severity).IsAtLeast(ef.minSeverity) {
+		return false
+	}
+	if ef.ranks != nil && !ef.ranks.Contains(ranklist.Rank(evt.Rank)) {
+		return false
+	}
+	if !ef.since.IsZero() {
+		ts, err := common.ParseTime(evt.Timestamp)
+		if err != nil || ts.Before(ef.since) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SystemEventsQuery returns the most recent RAS events retained by the MS that match the
+// request filters, oldest first.
+func (svc *mgmtSvc) SystemEventsQuery(ctx context.Context, req *mgmtpb.SystemEventsQueryReq) (*mgmtpb.SystemEventsQueryResp, error) {
+	if err := svc.checkReplicaRequest(req); err != nil {
+		return nil, err
+	}
+
+	filter, err := newEventQueryFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	records, lastSeq, err := svc.sysdb.GetEvents(req.AfterSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemEventsQueryResp{LastSeq: lastSeq}
+	for _, rec := range records {
+		if !filter.matches(rec.Event) {
+			continue
+		}
+		resp.Events = append(resp.Events, &mgmtpb.SystemEvent{Seq: rec.Seq, Event: rec.Event})
+	}
+	if req.MaxEvents > 0 && len(resp.Events) > int(req.MaxEvents) {
+		resp.Events = resp.Events[len(resp.Events)-int(req.MaxEvents):]
+	}
+
+	return resp, nil
+}
+
 func sp2pp(sp *daos.SystemProperty) (*daos.PoolProperty, bool) {
 	if pp, ok := sp.Value.(interface{ PoolProperty() *daos.PoolProperty }); ok {
 		return pp.PoolProperty(), true
@@ -46,7 +46,7 @@ import (
 
 func act2state(a string) string {
 	switch a {
-	case "prep shutdown":
+	case "prep shutdown", "drain":
 		return stateString(system.MemberStateStopping)
 	case "stop":
 		return stateString(system.MemberStateStopped)
@@ -396,6 +396,92 @@ func TestServer_MgmtSvc_ClusterEvent(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_SystemEventsQuery(t *testing.T) {
+	oldEvt := events.NewGenericEvent(events.RASEngineJoinFailed, events.RASSeverityWarning,
+		"old warning", "").WithRank(2)
+	oldEvt.Timestamp = common.FormatTime(time.Now().Add(-2 * time.Hour))
+	retained := []*events.RASEvent{
+		oldEvt,
+		mockEvtEngineDied(t).WithRank(1),
+		events.NewGenericEvent(events.RASSystemFabricProvChanged, events.RASSeverityNotice,
+			"notice", "").WithRank(uint32(ranklist.NilRank)),
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemEventsQueryReq
+		expSeqs []uint64
+		expErr  error
+	}{
+		"all events": {
+			req:     &mgmtpb.SystemEventsQueryReq{},
+			expSeqs: []uint64{1, 2, 3},
+		},
+		"minimum severity": {
+			req:     &mgmtpb.SystemEventsQueryReq{MinSeverity: "warning"},
+			expSeqs: []uint64{1, 2},
+		},
+		"ranks": {
+			req:     &mgmtpb.SystemEventsQueryReq{Ranks: "[1,3]"},
+			expSeqs: []uint64{2},
+		},
+		"since": {
+			req: &mgmtpb.SystemEventsQueryReq{
+				Since: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+			expSeqs: []uint64{2, 3},
+		},
+		"after sequence number": {
+			req:     &mgmtpb.SystemEventsQueryReq{AfterSeq: 2},
+			expSeqs: []uint64{3},
+		},
+		"most recent": {
+			req:     &mgmtpb.SystemEventsQueryReq{MaxEvents: 2},
+			expSeqs: []uint64{2, 3},
+		},
+		"invalid severity": {
+			req:    &mgmtpb.SystemEventsQueryReq{MinSeverity: "critical"},
+			expErr: errors.New("unknown RAS event severity"),
+		},
+		"invalid ranks": {
+			req:    &mgmtpb.SystemEventsQueryReq{Ranks: "one"},
+			expErr: errors.New("invalid ranks"),
+		},
+		"invalid since": {
+			req:    &mgmtpb.SystemEventsQueryReq{Since: "yesterday"},
+			expErr: errors.New("invalid time"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, evt := range retained {
+				if err := svc.sysdb.AddEvent(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tc.req.Sys = build.DefaultSystemName
+			gotResp, gotErr := svc.SystemEventsQuery(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, uint64(len(retained)), gotResp.LastSeq,
+				"unexpected last sequence number")
+			gotSeqs := []uint64{}
+			for _, evt := range gotResp.Events {
+				gotSeqs = append(gotSeqs, evt.Seq)
+			}
+			if diff := cmp.Diff(tc.expSeqs, gotSeqs); diff != "" {
+				t.Fatalf("unexpected events (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_getPeerListenAddr(t *testing.T) {
 	defaultAddr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:10001")
 	if err != nil {
@@ -1799,6 +1885,18 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	rankResStopSuccess := []*sharedpb.RankResult{
 		mockRankSuccess("stop", 0, 1), mockRankSuccess("stop", 1, 1), mockRankSuccess("stop", 3, 2),
 	}
+	hrds := []*control.HostResponse{
+		hr(1, mockRankSuccess("drain", 0), mockRankSuccess("drain", 1)),
+		hr(2, mockRankSuccess("drain", 3)),
+	}
+	hrdf := []*control.HostResponse{
+		hr(1, mockRankFail("drain", 0), mockRankSuccess("drain", 1)),
+		hr(2, mockRankFail("drain", 3)),
+	}
+	withMsg := func(rr *sharedpb.RankResult, msg string) *sharedpb.RankResult {
+		rr.Msg = msg
+		return rr
+	}
 	expEventsStopFail := func(msgErr string) []string {
 		e := newSystemStopFailedEvent(msgErr, "failed ranks 0,3")
 		e.Timestamp = ""
@@ -1806,17 +1904,19 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 	}
 
 	for name, tc := range map[string]struct {
-		req            *mgmtpb.SystemStopReq
-		members        system.Members
-		mResps         [][]*control.HostResponse
-		expMembers     func() system.Members
-		expResults     []*sharedpb.RankResult
-		expAbsentRanks string
-		expAbsentHosts string
-		expAPIErr      error
-		expDispatched  []string
-		expInvokeCount int
-		expFanoutRanks *ranklist.RankSet
+		req             *mgmtpb.SystemStopReq
+		members         system.Members
+		mResps          [][]*control.HostResponse
+		expMembers      func() system.Members
+		expResults      []*sharedpb.RankResult
+		expDrainResults []*sharedpb.RankResult
+		expAbsentRanks  string
+		expAbsentHosts  string
+		expAPIErr       error
+		expDispatched   []string
+		expInvokeCount  int
+		expFanoutRanks  *ranklist.RankSet
+		expDrainTimeout uint32
 	}{
 		"nil req": {
 			req:       (*mgmtpb.SystemStopReq)(nil),
@@ -1895,6 +1995,38 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			expInvokeCount: 2, // prep should be called
 			expFanoutRanks: ranklist.MustCreateRankSet("0-1,3"),
 		},
+		"full system stop; shutdown progress": {
+			req: &mgmtpb.SystemStopReq{},
+			mResps: [][]*control.HostResponse{
+				{
+					hr(1, withMsg(mockRankSuccess("prep shutdown", 0), "notified"),
+						withMsg(mockRankSuccess("prep shutdown", 1),
+							"notify timed out after 10s")),
+					hr(2, mockRankSuccess("prep shutdown", 3)),
+				},
+				{
+					hr(1, withMsg(mockRankSuccess("stop", 0), "exited after SIGINT"),
+						withMsg(mockRankSuccess("stop", 1),
+							"flush timed out after 1m0s, exited after SIGKILL")),
+					hr(2, withMsg(mockRankSuccess("stop", 3), "exited after SIGINT")),
+				},
+			},
+			expResults: []*sharedpb.RankResult{
+				withMsg(mockRankSuccess("stop", 0, 1), "notified, exited after SIGINT"),
+				withMsg(mockRankSuccess("stop", 1, 1), "notify timed out after 10s, "+
+					"flush timed out after 1m0s, exited after SIGKILL"),
+				withMsg(mockRankSuccess("stop", 3, 2), "exited after SIGINT"),
+			},
+			expMembers: func() system.Members {
+				return system.Members{
+					mockMember(t, 0, 1, "stopped"),
+					mockMember(t, 1, 1, "stopped"),
+					mockMember(t, 3, 2, "stopped"),
+				}
+			},
+			expInvokeCount: 2, // prep should be called
+			expFanoutRanks: ranklist.MustCreateRankSet("0-1,3"),
+		},
 		"full system stop; partial ranks in req": {
 			req: &mgmtpb.SystemStopReq{Ranks: "0,1"},
 			members: system.Members{
@@ -1923,6 +2055,64 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			expInvokeCount: 2, // prep should be called
 			expFanoutRanks: ranklist.MustCreateRankSet("0-1"),
 		},
+		"full system stop; drain": {
+			req:        &mgmtpb.SystemStopReq{Drain: true, DrainTimeout: 30},
+			mResps:     [][]*control.HostResponse{hrds, hrps, hrss},
+			expResults: rankResStopSuccess,
+			expDrainResults: []*sharedpb.RankResult{
+				mockRankSuccess("drain", 0, 1), mockRankSuccess("drain", 1, 1),
+				mockRankSuccess("drain", 3, 2),
+			},
+			expMembers: func() system.Members {
+				return system.Members{
+					mockMember(t, 0, 1, "stopped"),
+					mockMember(t, 1, 1, "stopped"),
+					mockMember(t, 3, 2, "stopped"),
+				}
+			},
+			expInvokeCount:  3, // drain and prep should be called
+			expFanoutRanks:  ranklist.MustCreateRankSet("0-1,3"),
+			expDrainTimeout: 30,
+		},
+		"full system stop; drain fail": {
+			req:        &mgmtpb.SystemStopReq{Drain: true, DrainTimeout: 30},
+			mResps:     [][]*control.HostResponse{hrdf, hrps, hrss},
+			expResults: rankResStopSuccess,
+			expDrainResults: []*sharedpb.RankResult{
+				mockRankFail("drain", 0, 1), mockRankSuccess("drain", 1, 1),
+				mockRankFail("drain", 3, 2),
+			},
+			expMembers: func() system.Members {
+				return system.Members{
+					mockMember(t, 0, 1, "stopped"),
+					mockMember(t, 1, 1, "stopped"),
+					mockMember(t, 3, 2, "stopped"),
+				}
+			},
+			expDispatched:   expEventsStopFail("drain"),
+			expInvokeCount:  3, // stop should proceed despite drain failure
+			expFanoutRanks:  ranklist.MustCreateRankSet("0-1,3"),
+			expDrainTimeout: 30,
+		},
+		"full system stop (forced); drain": {
+			req:        &mgmtpb.SystemStopReq{Force: true, Drain: true, DrainTimeout: 30},
+			mResps:     [][]*control.HostResponse{hrds, hrss},
+			expResults: rankResStopSuccess,
+			expDrainResults: []*sharedpb.RankResult{
+				mockRankSuccess("drain", 0, 1), mockRankSuccess("drain", 1, 1),
+				mockRankSuccess("drain", 3, 2),
+			},
+			expMembers: func() system.Members {
+				return system.Members{
+					mockMember(t, 0, 1, "stopped"),
+					mockMember(t, 1, 1, "stopped"),
+					mockMember(t, 3, 2, "stopped"),
+				}
+			},
+			expInvokeCount:  2, // prep should not be called
+			expFanoutRanks:  ranklist.MustCreateRankSet("0-1,3"),
+			expDrainTimeout: 30,
+		},
 		"full system stop (forced)": {
 			req:        &mgmtpb.SystemStopReq{Force: true},
 			mResps:     hostRespStopSuccess,
@@ -2092,6 +2282,7 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 			}
 
 			checkRankResults(t, tc.expResults, gotResp.Results)
+			checkRankResults(t, tc.expDrainResults, gotResp.DrainResults)
 			checkMembers(t, tc.expMembers(), svc.membership)
 			test.AssertEqual(t, tc.expAbsentHosts, gotResp.Absenthosts, "absent hosts")
 			test.AssertEqual(t, tc.expAbsentRanks, gotResp.Absentranks, "absent ranks")
@@ -2101,6 +2292,7 @@ func TestServer_MgmtSvc_SystemStop(t *testing.T) {
 				test.AssertEqual(t, tc.expInvokeCount, len(mockInvoker.SentReqs), "fanoutRequests sent")
 				ranksReqSent := mockInvoker.SentReqs[0].(*control.RanksReq)
 				test.AssertEqual(t, tc.expFanoutRanks.String(), ranksReqSent.Ranks, "")
+				test.AssertEqual(t, tc.expDrainTimeout, ranksReqSent.DrainTimeout, "drain timeout")
 			}
 
 			<-ctx.Done()
@@ -2942,11 +3134,20 @@ func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 			Message: &mgmtpb.SystemEraseResp{Results: rrs},
 		}
 	}
+	erased := func(rr *sharedpb.RankResult) *sharedpb.RankResult {
+		rr.Action = "erase"
+		if !rr.Errored {
+			rr.Msg = eraseReplaceHint
+		}
+		return rr
+	}
 
 	for name, tc := range map[string]struct {
 		nilReq         bool
 		ranks          string
 		hosts          string
+		pools          []string
+		drpcResps      []*mockDrpcResponse // Sequential list of dRPC responses.
 		members        system.Members
 		mResps         []*control.HostResponse
 		expMembers     system.Members
@@ -3025,6 +3226,117 @@ func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 				mockMember(t, 3, 2, "awaitformat"),
 			},
 		},
+		"selective erase": {
+			ranks: "2-3",
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			mResps: []*control.HostResponse{
+				hr(2, mockRankFail("reset format", 2), mockRankSuccess("reset format", 3)),
+			},
+			expResults: []*sharedpb.RankResult{
+				erased(mockRankFail("reset format", 2, 2)),
+				erased(mockRankSuccess("reset format", 3, 2)),
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "awaitformat"),
+			},
+		},
+		"selective erase; absent rank": {
+			ranks: "3,7",
+			members: system.Members{
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			mResps: []*control.HostResponse{
+				hr(2, mockRankSuccess("reset format", 3)),
+			},
+			expResults: []*sharedpb.RankResult{
+				erased(mockRankSuccess("reset format", 3, 2)),
+			},
+			expMembers: system.Members{
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "awaitformat"),
+			},
+			expAbsentRanks: "7",
+		},
+		"selective erase; rank running": {
+			ranks: "1-2",
+			members: system.Members{
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+			},
+			expErrMsg: "system erase requires the following 1 rank to be stopped: 1",
+		},
+		"selective erase; ranks enabled in pool": {
+			ranks: "2-3",
+			pools: []string{test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{EnabledRanks: "0-2"}},
+			},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			expErrMsg: FaultEraseEnabledPoolRanks(ranklist.MustCreateRankSet("2"),
+				test.MockUUID(1)).Error(),
+		},
+		"selective erase; admin excluded ranks": {
+			ranks: "2-3",
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+				mockMember(t, 3, 2, "adminexcluded"),
+			},
+			mResps: []*control.HostResponse{
+				hr(2, mockRankFail("reset format", 2), mockRankSuccess("reset format", 3)),
+			},
+			expResults: []*sharedpb.RankResult{
+				erased(mockRankFail("reset format", 2, 2)),
+				erased(mockRankSuccess("reset format", 3, 2)),
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "adminexcluded"),
+				mockMember(t, 3, 2, "awaitformat"),
+			},
+		},
+		"selective erase; ranks excluded from pool": {
+			ranks: "2-3",
+			pools: []string{test.MockUUID(1)},
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{EnabledRanks: "0-1"}},
+			},
+			members: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "stopped"),
+				mockMember(t, 3, 2, "stopped"),
+			},
+			mResps: []*control.HostResponse{
+				hr(2, mockRankSuccess("reset format", 2), mockRankSuccess("reset format", 3)),
+			},
+			expResults: []*sharedpb.RankResult{
+				erased(mockRankSuccess("reset format", 2, 2)),
+				erased(mockRankSuccess("reset format", 3, 2)),
+			},
+			expMembers: system.Members{
+				mockMember(t, 0, 1, "joined"),
+				mockMember(t, 1, 1, "joined"),
+				mockMember(t, 2, 2, "awaitformat"),
+				mockMember(t, 3, 2, "awaitformat"),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -3032,8 +3344,23 @@ func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 
 			svc := mgmtSystemTestSetup(t, log, tc.members, tc.mResps)
 
+			cfg := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				cfg.setSendMsgResponseList(t, mock)
+			}
+			setupSvcDrpcClient(svc, 0, newMockDrpcClient(cfg))
+			for _, uuidStr := range tc.pools {
+				addTestPoolService(t, svc.sysdb, &system.PoolService{
+					PoolUUID: uuid.MustParse(uuidStr),
+					State:    system.PoolServiceStateReady,
+					Replicas: []ranklist.Rank{0},
+				})
+			}
+
 			req := &mgmtpb.SystemEraseReq{
-				Sys: build.DefaultSystemName,
+				Sys:   build.DefaultSystemName,
+				Ranks: tc.ranks,
+				Hosts: tc.hosts,
 			}
 			if tc.nilReq {
 				req = nil
@@ -3047,6 +3374,8 @@ func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 
 			checkRankResults(t, tc.expResults, gotResp.Results)
 			checkMembers(t, tc.expMembers, svc.membership)
+			test.AssertEqual(t, tc.expAbsentRanks, gotResp.Absentranks, "absent ranks")
+			test.AssertEqual(t, tc.expAbsentHosts, gotResp.Absenthosts, "absent hosts")
 		})
 	}
 }
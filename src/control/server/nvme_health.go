@@ -0,0 +1,150 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// nvmeHealthPoller periodically samples the health of the NVMe devices used by the engines so
+// that the wear of each device can be tracked over time.
+type nvmeHealthPoller struct {
+	log     logging.Logger
+	engines []Engine
+	hist    *storage.NvmeHealthHistory
+	policy  *nvmeFailurePolicy // nil unless a failure policy is configured
+}
+
+// poll samples the health of each controller that is able to supply health stats, once per
+// controller regardless of the number of SMD devices on it. Failures are logged and otherwise
+// ignored so that a single device doesn't prevent the others from being sampled.
+func (p *nvmeHealthPoller) poll(ctx context.Context) {
+	for _, ei := range p.engines {
+		if !ei.IsReady() {
+			continue
+		}
+
+		smdResp, err := scanSmd(ctx, ei, new(ctlpb.SmdDevReq))
+		if err != nil {
+			p.log.Debugf("engine %d: nvme health poll: scan smd: %s", ei.Index(), err)
+			continue
+		}
+
+		polled := make(map[string]struct{})
+		for _, dev := range smdResp.GetDevices() {
+			ctrlr := dev.GetCtrlr()
+			if ctrlr == nil || !ctrlr.CanSupplyHealthStats() {
+				continue
+			}
+			if _, seen := polled[ctrlr.PciAddr]; seen {
+				continue
+			}
+			polled[ctrlr.PciAddr] = struct{}{}
+
+			bh, err := scanHealth(ctx, ei, &ctlpb.BioHealthReq{DevUuid: dev.Uuid})
+			if err != nil {
+				p.log.Debugf("engine %d: nvme health poll: %s: %s", ei.Index(),
+					ctrlr.PciAddr, err)
+				continue
+			}
+			if err := recordNvmeHealth(p.hist, ctrlr.PciAddr, bh); err != nil {
+				p.log.Debugf("engine %d: nvme health poll: %s: %s", ei.Index(),
+					ctrlr.PciAddr, err)
+				continue
+			}
+			p.policy.check(ctx, ei, dev, p.hist.Trend(ctrlr.PciAddr))
+		}
+	}
+}
+
+func (p *nvmeHealthPoller) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	p.poll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.poll(ctx)
+		}
+	}
+}
+
+// startNvmeHealthPoller starts polling the health of the NVMe devices used by the engines into
+// the supplied history, applying the failure policy to each device if one is supplied. The
+// returned function stops the poller.
+func startNvmeHealthPoller(ctx context.Context, log logging.Logger, interval time.Duration, engines []Engine, hist *storage.NvmeHealthHistory, policy *nvmeFailurePolicy) func() {
+	p := &nvmeHealthPoller{
+		log:     log,
+		engines: engines,
+		hist:    hist,
+		policy:  policy,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.run(ctx, interval)
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
+
+func recordNvmeHealth(hist *storage.NvmeHealthHistory, pciAddr string, bh *ctlpb.BioHealthResp) error {
+	health := new(storage.NvmeHealth)
+	if err := convert.Types(bh, health); err != nil {
+		return errors.Wrap(err, "convert health stats")
+	}
+	hist.Add(pciAddr, health)
+
+	return nil
+}
+
+// addNvmeHealthTrends records the health stats returned for each device in the history and
+// then sets the wear trend of the device's controller from the retained samples.
+func addNvmeHealthTrends(hist *storage.NvmeHealthHistory, rResp *ctlpb.SmdQueryResp_RankResp) error {
+	if hist == nil || rResp == nil {
+		return nil
+	}
+
+	for _, dev := range rResp.Devices {
+		ctrlr := dev.GetCtrlr()
+		if ctrlr == nil || ctrlr.HealthStats == nil {
+			continue
+		}
+		if err := recordNvmeHealth(hist, ctrlr.PciAddr, ctrlr.HealthStats); err != nil {
+			return err
+		}
+
+		trend := hist.Trend(ctrlr.PciAddr)
+		if trend == nil {
+			continue
+		}
+		ctrlr.HealthTrend = new(ctlpb.NvmeHealthTrend)
+		if err := convert.Types(trend, ctrlr.HealthTrend); err != nil {
+			return errors.Wrap(err, "convert health trend")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// nvmeFailurePolicy acts on the failure scores of NVMe devices calculated from their health
+// history, predicting device failures and optionally setting devices faulty before they fail.
+type nvmeFailurePolicy struct {
+	log       logging.Logger
+	cfg       *config.NvmeFailurePolicyConfig
+	publish   func(*events.RASEvent)
+	predicted map[string]struct{} // controllers for which a failure has been predicted
+}
+
+func newNvmeFailurePolicy(log logging.Logger, cfg *config.NvmeFailurePolicyConfig, publish func(*events.RASEvent)) *nvmeFailurePolicy {
+	return &nvmeFailurePolicy{
+		log:       log,
+		cfg:       cfg,
+		publish:   publish,
+		predicted: make(map[string]struct{}),
+	}
+}
+
+func setNvmeDeviceFaulty(ctx context.Context, engine Engine, devUUID string) error {
+	res, err := sendManageReq(ctx, engine, daos.MethodSetFaultyState,
+		&ctlpb.SetFaultyReq{Uuid: devUUID})
+	if err != nil {
+		return err
+	}
+	if res.Status != 0 {
+		return daos.Status(res.Status)
+	}
+
+	return nil
+}
+
+// check acts on the failure score in the trend of an in-use device. A failure is predicted once
+// when the score reaches the warn score, and again if the device is then set faulty. Devices
+// whose score drops below the warn score may have their failure predicted again later.
+//
+// Setting a device faulty causes the engine to exclude the targets using the device from their
+// pools, rebuilding the data they hold from redundant copies elsewhere in the system.
+func (p *nvmeFailurePolicy) check(ctx context.Context, engine Engine, dev *ctlpb.SmdDevice, trend *storage.NvmeHealthTrend) {
+	ctrlr := dev.GetCtrlr()
+	if p == nil || trend == nil || ctrlr == nil || ctrlr.DevState != ctlpb.NvmeDevState_NORMAL {
+		return
+	}
+
+	if trend.FailureScore < p.cfg.GetWarnScore() {
+		delete(p.predicted, ctrlr.PciAddr)
+		return
+	}
+
+	setFaulty := p.cfg.AutoFaulty && trend.FailureScore >= p.cfg.GetFaultyScore()
+	if _, seen := p.predicted[ctrlr.PciAddr]; seen && !setFaulty {
+		return
+	}
+	p.predicted[ctrlr.PciAddr] = struct{}{}
+
+	sev := events.RASSeverityWarning
+	msg := fmt.Sprintf("NVMe device %s (%s) predicted to fail, score %d: %s", dev.Uuid,
+		ctrlr.PciAddr, trend.FailureScore, strings.Join(trend.FailureReasons, ", "))
+	if setFaulty {
+		sev = events.RASSeverityError
+		if err := setNvmeDeviceFaulty(ctx, engine, dev.Uuid); err != nil {
+			err = errors.Wrapf(err, "set device %s faulty", dev.Uuid)
+			p.log.Error(err.Error())
+			msg += fmt.Sprintf("; failed to set faulty: %s", err)
+		} else {
+			msg += "; device set faulty to rebuild its data"
+		}
+	}
+
+	evt := events.NewGenericEvent(events.RASDeviceFailurePredicted, sev, msg, "")
+	if rank, err := engine.GetRank(); err == nil {
+		evt.Rank = rank.Uint32()
+	}
+	p.log.Debugf("nvme failure policy: %s", msg)
+	p.publish(evt)
+}
@@ -0,0 +1,181 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_nvmeFailurePolicy_check(t *testing.T) {
+	devUUID := test.MockUUID(1)
+	pciAddr := test.MockPCIAddr(1)
+	reasons := []string{"2 new media errors", "critical warning: temperature"}
+	predictedMsg := "NVMe device " + devUUID + " (" + pciAddr + ") predicted to fail, " +
+		"score %d: 2 new media errors, critical warning: temperature"
+
+	for name, tc := range map[string]struct {
+		cfg          *config.NvmeFailurePolicyConfig
+		devState     ctlpb.NvmeDevState
+		predicted    bool
+		noTrend      bool
+		score        uint32
+		faultyStatus daos.Status
+		drpcErr      error
+		expSev       events.RASSeverityID
+		expMsg       string
+		expPredicted bool
+	}{
+		"no trend": {
+			cfg:     &config.NvmeFailurePolicyConfig{},
+			noTrend: true,
+		},
+		"below warn score": {
+			cfg:   &config.NvmeFailurePolicyConfig{},
+			score: 49,
+		},
+		"below warn score; re-armed": {
+			cfg:       &config.NvmeFailurePolicyConfig{},
+			predicted: true,
+			score:     10,
+		},
+		"device not in use": {
+			cfg:      &config.NvmeFailurePolicyConfig{},
+			devState: ctlpb.NvmeDevState_EVICTED,
+			score:    100,
+		},
+		"failure predicted": {
+			cfg:          &config.NvmeFailurePolicyConfig{},
+			score:        90,
+			expSev:       events.RASSeverityWarning,
+			expMsg:       predictedMsg,
+			expPredicted: true,
+		},
+		"failure already predicted": {
+			cfg:          &config.NvmeFailurePolicyConfig{},
+			predicted:    true,
+			score:        90,
+			expPredicted: true,
+		},
+		"custom warn score": {
+			cfg:          &config.NvmeFailurePolicyConfig{WarnScore: 20},
+			score:        25,
+			expSev:       events.RASSeverityWarning,
+			expMsg:       predictedMsg,
+			expPredicted: true,
+		},
+		"below faulty score": {
+			cfg:          &config.NvmeFailurePolicyConfig{AutoFaulty: true},
+			score:        79,
+			expSev:       events.RASSeverityWarning,
+			expMsg:       predictedMsg,
+			expPredicted: true,
+		},
+		"set faulty": {
+			cfg:          &config.NvmeFailurePolicyConfig{AutoFaulty: true},
+			predicted:    true,
+			score:        80,
+			expSev:       events.RASSeverityError,
+			expMsg:       predictedMsg + "; device set faulty to rebuild its data",
+			expPredicted: true,
+		},
+		"set faulty fails": {
+			cfg:          &config.NvmeFailurePolicyConfig{AutoFaulty: true},
+			score:        100,
+			faultyStatus: daos.Busy,
+			expSev:       events.RASSeverityError,
+			expMsg: predictedMsg + "; failed to set faulty: set device " + devUUID +
+				" faulty: " + daos.Busy.Error(),
+			expPredicted: true,
+		},
+		"set faulty drpc fails": {
+			cfg:     &config.NvmeFailurePolicyConfig{AutoFaulty: true},
+			score:   100,
+			drpcErr: errors.New("drpc failed"),
+			expSev:  events.RASSeverityError,
+			expMsg: predictedMsg + "; failed to set faulty: set device " + devUUID +
+				" faulty: call drpc: drpc failed",
+			expPredicted: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			body, err := proto.Marshal(&ctlpb.DevManageResp{Status: int32(tc.faultyStatus)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ei := NewMockInstance(&MockInstanceConfig{
+				Ready:        atm.NewBool(true),
+				GetRankResp:  2,
+				CallDrpcResp: &drpc.Response{Body: body},
+				CallDrpcErr:  tc.drpcErr,
+			})
+
+			var published []*events.RASEvent
+			p := newNvmeFailurePolicy(log, tc.cfg, func(evt *events.RASEvent) {
+				published = append(published, evt)
+			})
+			if tc.predicted {
+				p.predicted[pciAddr] = struct{}{}
+			}
+
+			if tc.devState == ctlpb.NvmeDevState_UNKNOWN {
+				tc.devState = ctlpb.NvmeDevState_NORMAL
+			}
+			dev := &ctlpb.SmdDevice{
+				Uuid: devUUID,
+				Ctrlr: &ctlpb.NvmeController{
+					PciAddr:  pciAddr,
+					DevState: tc.devState,
+				},
+			}
+			var trend *storage.NvmeHealthTrend
+			if !tc.noTrend {
+				trend = &storage.NvmeHealthTrend{
+					FailureScore:   tc.score,
+					FailureReasons: reasons,
+				}
+			}
+
+			p.check(test.Context(t), ei, dev, trend)
+
+			_, gotPredicted := p.predicted[pciAddr]
+			test.AssertEqual(t, tc.expPredicted, gotPredicted, "unexpected predicted state")
+
+			if tc.expMsg == "" {
+				if len(published) != 0 {
+					t.Fatalf("unexpected events published: %+v", published)
+				}
+				return
+			}
+			if len(published) != 1 {
+				t.Fatalf("expected one event, got %d", len(published))
+			}
+			evt := published[0]
+			test.AssertEqual(t, events.RASDeviceFailurePredicted, evt.ID, "unexpected event ID")
+			test.AssertEqual(t, tc.expSev, evt.Severity, "unexpected event severity")
+			test.AssertEqual(t, uint32(2), evt.Rank, "unexpected event rank")
+			test.AssertEqual(t, fmt.Sprintf(tc.expMsg, tc.score), evt.Msg,
+				"unexpected event message")
+		})
+	}
+}
@@ -0,0 +1,205 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_nvmeHealthPoller_poll(t *testing.T) {
+	mockDev := func(uuid, pciAddr string, state ctlpb.NvmeDevState) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid: uuid,
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  pciAddr,
+				DevState: state,
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		notReady      bool
+		smdResp       *ctlpb.SmdDevResp
+		smdErr        error
+		healthErr     error
+		expHealthReqs []string
+		expSampled    []string
+	}{
+		"engine not ready": {
+			notReady: true,
+			smdResp: &ctlpb.SmdDevResp{
+				Devices: []*ctlpb.SmdDevice{
+					mockDev(test.MockUUID(1), test.MockPCIAddr(1), ctlpb.NvmeDevState_NORMAL),
+				},
+			},
+		},
+		"scan smd fails": {
+			smdErr: errors.New("scan failed"),
+		},
+		"health query fails": {
+			smdResp: &ctlpb.SmdDevResp{
+				Devices: []*ctlpb.SmdDevice{
+					mockDev(test.MockUUID(1), test.MockPCIAddr(1), ctlpb.NvmeDevState_NORMAL),
+				},
+			},
+			healthErr:     errors.New("health failed"),
+			expHealthReqs: []string{test.MockUUID(1)},
+		},
+		"one sample per controller": {
+			smdResp: &ctlpb.SmdDevResp{
+				Devices: []*ctlpb.SmdDevice{
+					mockDev(test.MockUUID(1), test.MockPCIAddr(1), ctlpb.NvmeDevState_NORMAL),
+					mockDev(test.MockUUID(2), test.MockPCIAddr(1), ctlpb.NvmeDevState_NORMAL),
+					mockDev(test.MockUUID(3), test.MockPCIAddr(2), ctlpb.NvmeDevState_EVICTED),
+					mockDev(test.MockUUID(4), test.MockPCIAddr(3), ctlpb.NvmeDevState_UNPLUGGED),
+					{Uuid: test.MockUUID(5)},
+				},
+			},
+			expHealthReqs: []string{test.MockUUID(1), test.MockUUID(3)},
+			expSampled:    []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			scanSmd = func(_ context.Context, _ Engine, _ *ctlpb.SmdDevReq) (*ctlpb.SmdDevResp, error) {
+				return tc.smdResp, tc.smdErr
+			}
+			defer func() {
+				scanSmd = listSmdDevices
+			}()
+			var gotHealthReqs []string
+			scanHealth = func(_ context.Context, _ Engine, req *ctlpb.BioHealthReq) (*ctlpb.BioHealthResp, error) {
+				gotHealthReqs = append(gotHealthReqs, req.DevUuid)
+				return &ctlpb.BioHealthResp{Timestamp: 100, DevUuid: req.DevUuid}, tc.healthErr
+			}
+			defer func() {
+				scanHealth = getBioHealth
+			}()
+
+			ei := NewMockInstance(&MockInstanceConfig{
+				Ready: atm.NewBool(!tc.notReady),
+			})
+			hist := storage.NewNvmeHealthHistory(time.Hour)
+			p := &nvmeHealthPoller{
+				log:     log,
+				engines: []Engine{ei},
+				hist:    hist,
+			}
+
+			p.poll(test.Context(t))
+
+			if diff := cmp.Diff(tc.expHealthReqs, gotHealthReqs); diff != "" {
+				t.Fatalf("unexpected health requests (-want, +got):\n%s\n", diff)
+			}
+			for _, addr := range tc.expSampled {
+				if len(hist.Samples(addr)) != 1 {
+					t.Fatalf("expected a sample for %s", addr)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_addNvmeHealthTrends(t *testing.T) {
+	mockResp := func(ts, nand, host uint64) *ctlpb.SmdQueryResp_RankResp {
+		return &ctlpb.SmdQueryResp_RankResp{
+			Devices: []*ctlpb.SmdDevice{
+				{
+					Uuid: test.MockUUID(1),
+					Ctrlr: &ctlpb.NvmeController{
+						PciAddr: test.MockPCIAddr(1),
+						HealthStats: &ctlpb.BioHealthResp{
+							Timestamp:           ts,
+							WearLevelingCntNorm: 90,
+							NandBytesWritten:    nand,
+							HostBytesWritten:    host,
+						},
+					},
+				},
+				{
+					Uuid:  test.MockUUID(2),
+					Ctrlr: &ctlpb.NvmeController{PciAddr: test.MockPCIAddr(2)},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		hist     *storage.NvmeHealthHistory
+		prior    []*storage.NvmeHealth
+		resp     *ctlpb.SmdQueryResp_RankResp
+		expTrend *ctlpb.NvmeHealthTrend
+	}{
+		"polling disabled": {
+			resp: mockResp(100, 3000, 1000),
+		},
+		"no prior samples": {
+			hist: storage.NewNvmeHealthHistory(time.Hour),
+			resp: mockResp(100, 3000, 1000),
+			expTrend: &ctlpb.NvmeHealthTrend{
+				NrSamples:      1,
+				FirstTimestamp: 100,
+				LastTimestamp:  100,
+				WriteAmp:       3,
+				WearUsed:       10,
+			},
+		},
+		"trend over prior samples": {
+			hist: storage.NewNvmeHealthHistory(time.Hour),
+			prior: []*storage.NvmeHealth{
+				{
+					Timestamp:           100,
+					WearLevelingCntNorm: 90,
+					NandBytesWritten:    1000,
+					HostBytesWritten:    500,
+				},
+			},
+			resp: mockResp(200, 3000, 1500),
+			expTrend: &ctlpb.NvmeHealthTrend{
+				NrSamples:      2,
+				FirstTimestamp: 100,
+				LastTimestamp:  200,
+				HostWriteRate:  10,
+				WriteAmp:       2,
+				WearUsed:       10,
+				RemainingSecs:  1350,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, h := range tc.prior {
+				tc.hist.Add(test.MockPCIAddr(1), h)
+			}
+
+			if err := addNvmeHealthTrends(tc.hist, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expTrend, tc.resp.Devices[0].Ctrlr.HealthTrend,
+				protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected trend (-want, +got):\n%s\n", diff)
+			}
+			if tc.resp.Devices[1].Ctrlr.HealthTrend != nil {
+				t.Fatal("unexpected trend for device without health stats")
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// nvmeHotplugPolicy applies the configured policy to NVMe SSDs inserted into the host, which are
+// detected by periodically scanning sysfs. With the add and replace actions an inserted SSD is
+// bound to a user-space driver and the action is completed when the engine that attaches the SSD
+// through hotplug reports it with a device_plugged event.
+type nvmeHotplugPolicy struct {
+	log     logging.Logger
+	cfg     *config.NvmeHotplugPolicyConfig
+	svc     *ControlService
+	publish func(*events.RASEvent)
+	present map[string]struct{} // controllers found by the last scan, nil before the first
+}
+
+func newNvmeHotplugPolicy(log logging.Logger, cfg *config.NvmeHotplugPolicyConfig, svc *ControlService, publish func(*events.RASEvent)) *nvmeHotplugPolicy {
+	return &nvmeHotplugPolicy{
+		log:     log,
+		cfg:     cfg,
+		svc:     svc,
+		publish: publish,
+	}
+}
+
+func (p *nvmeHotplugPolicy) notify(rank ranklist.Rank, sev events.RASSeverityID, msg string) {
+	evt := events.NewGenericEvent(events.RASDeviceHotplugHandled, sev, msg, "")
+	evt.Rank = rank.Uint32()
+	p.publish(evt)
+}
+
+// scan compares the NVMe controllers found in sysfs with those found by the previous scan. The
+// first scan only records the controllers present when polling starts. Removed controllers are
+// forgotten so that an SSD inserted again in the same slot is handled as a new insertion, the
+// removal of an SSD in use is reported by the engine.
+func (p *nvmeHotplugPolicy) scan(ctx context.Context) {
+	ctrlrs, err := p.svc.scanSysfsBdevs()
+	if err != nil {
+		p.log.Debugf("nvme hotplug scan: %s", err)
+		return
+	}
+
+	prev := p.present
+	p.present = make(map[string]struct{})
+	for _, c := range ctrlrs {
+		p.present[c.PciAddr] = struct{}{}
+	}
+	if prev == nil {
+		return
+	}
+
+	for addr := range prev {
+		if _, found := p.present[addr]; !found {
+			p.log.Debugf("nvme hotplug scan: SSD at %s removed", addr)
+		}
+	}
+	for _, c := range ctrlrs {
+		if _, found := prev[c.PciAddr]; !found {
+			p.inserted(ctx, c)
+		}
+	}
+}
+
+// inserted raises an event for an inserted SSD and, unless the action is notify, binds the SSD
+// to a user-space driver so that it can be attached by an engine.
+func (p *nvmeHotplugPolicy) inserted(ctx context.Context, c *storage.NvmeController) {
+	msg := fmt.Sprintf("NVMe SSD inserted at %s (model %s, serial %s)", c.PciAddr, c.Model,
+		c.Serial)
+	p.log.Notice(msg)
+	p.publish(events.NewGenericEvent(events.RASDeviceInserted, events.RASSeverityNotice, msg,
+		""))
+
+	switch {
+	case p.cfg.GetAction() == config.NvmeHotplugActionNotify:
+		return
+	case common.Includes(p.svc.srvCfg.BdevExclude, c.PciAddr):
+		p.log.Debugf("nvme hotplug: %s listed in bdev_exclude, not bound", c.PciAddr)
+		return
+	case userspaceNvmeDrivers.Has(c.Driver):
+		return
+	}
+
+	resp, err := p.svc.StorageNvmeRebind(ctx, &ctlpb.NvmeRebindReq{PciAddr: c.PciAddr})
+	if err == nil && resp.GetState().GetError() != "" {
+		err = errors.New(resp.GetState().GetError())
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to bind inserted NVMe SSD at %s: %s", c.PciAddr, err)
+		p.log.Error(msg)
+		p.notify(ranklist.NilRank, events.RASSeverityError, msg)
+	}
+}
+
+// OnEvent implements the events.Handler interface, completing the add or replace action when an
+// engine on this host reports that it has attached a hot-plugged SSD.
+func (p *nvmeHotplugPolicy) OnEvent(ctx context.Context, evt *events.RASEvent) {
+	if evt == nil || evt.ID != events.RASDevicePlugged || evt.IsForwarded() {
+		return
+	}
+	if p.cfg.GetAction() == config.NvmeHotplugActionNotify {
+		return
+	}
+
+	// Don't act on SSDs while a guided replacement is being advanced.
+	p.svc.nvmeReplaceMu.Lock()
+	defer p.svc.nvmeReplaceMu.Unlock()
+
+	for _, engine := range p.svc.harness.Instances() {
+		rank, err := engine.GetRank()
+		if err != nil || rank.Uint32() != evt.Rank || !engine.IsReady() {
+			continue
+		}
+		if err := p.plugged(ctx, engine, rank); err != nil {
+			p.log.Errorf("nvme hotplug: rank %d: %s", rank, err)
+		}
+	}
+}
+
+// plugged applies the action to each NEW SSD of the engine, SSDs listed in bdev_spares are left
+// for substitution when a device is set faulty.
+func (p *nvmeHotplugPolicy) plugged(ctx context.Context, engine Engine, rank ranklist.Rank) error {
+	rResp, err := smdQueryEngine(ctx, engine, new(ctlpb.SmdQueryReq))
+	if err != nil {
+		return err
+	}
+	guided, err := readNvmeReplaceStates(p.svc.nvmeReplaceStatePath())
+	if err != nil {
+		return err
+	}
+	tiers := engine.GetStorage().GetBdevConfigs()
+	spares := tiers.NVMeSpares().Devices()
+
+	// SSDs that still have targets assigned but are no longer usable can be replaced.
+	var replaceable []*ctlpb.SmdDevice
+	for _, dev := range rResp.Devices {
+		state := dev.GetCtrlr().GetDevState()
+		if state != ctlpb.NvmeDevState_EVICTED && state != ctlpb.NvmeDevState_UNPLUGGED {
+			continue
+		}
+		if _, inProgress := guided[dev.Uuid]; inProgress || len(dev.TgtIds) == 0 {
+			continue
+		}
+		if findBdevTier(dev.Ctrlr.PciAddr, tiers) != nil {
+			replaceable = append(replaceable, dev)
+		}
+	}
+
+	for _, dev := range rResp.Devices {
+		ctrlr := dev.GetCtrlr()
+		if ctrlr.GetDevState() != ctlpb.NvmeDevState_NEW ||
+			common.Includes(spares, ctrlr.PciAddr) {
+			continue
+		}
+
+		switch p.cfg.GetAction() {
+		case config.NvmeHotplugActionAdd:
+			p.add(ctx, engine, rank, tiers, dev)
+		case config.NvmeHotplugActionReplace:
+			replaceable = p.replace(ctx, engine, rank, tiers, dev, replaceable)
+		}
+	}
+
+	return nil
+}
+
+// add adds the SSD to the engine's bdev tier selected by the policy and regenerates the engine's
+// NVMe config so that the SSD is used when the engine is restarted.
+func (p *nvmeHotplugPolicy) add(ctx context.Context, engine Engine, rank ranklist.Rank, tiers storage.TierConfigs, dev *ctlpb.SmdDevice) {
+	addr := dev.Ctrlr.PciAddr
+	if findBdevTier(addr, tiers) != nil {
+		p.log.Debugf("nvme hotplug: %s already in bdev config of rank %d", addr, rank)
+		return
+	}
+
+	err := errors.New("no nvme class bdev tier to add SSD to")
+	tier := p.cfg.FindAddTier(tiers)
+	if tier != nil {
+		err = tier.Bdev.DeviceList.AddStrings(addr)
+	}
+	if err == nil {
+		err = errors.Wrapf(engine.GetStorage().WriteNvmeConfig(ctx, p.log, nil),
+			"write nvme config for engine %d", engine.Index())
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to add NVMe SSD %s (%s): %s", dev.Uuid, addr, err)
+		p.log.Error(msg)
+		p.notify(rank, events.RASSeverityError, msg)
+		return
+	}
+
+	msg := fmt.Sprintf("NVMe SSD %s (%s) added to bdev tier %d, it will be used when the "+
+		"engine restarts, add it to bdev_list in server config file", dev.Uuid, addr,
+		tier.Tier)
+	p.log.Notice(msg)
+	p.notify(rank, events.RASSeverityNotice, msg)
+}
+
+// replace substitutes the SSD for a replaceable SSD of the engine, preferring one in the same
+// slot, and returns the SSDs that remain replaceable.
+func (p *nvmeHotplugPolicy) replace(ctx context.Context, engine Engine, rank ranklist.Rank, tiers storage.TierConfigs, dev *ctlpb.SmdDevice, replaceable []*ctlpb.SmdDevice) []*ctlpb.SmdDevice {
+	addr := dev.Ctrlr.PciAddr
+	if len(replaceable) == 0 {
+		msg := fmt.Sprintf("no evicted SSD for NVMe SSD %s (%s) to replace, left unused",
+			dev.Uuid, addr)
+		p.log.Notice(msg)
+		p.notify(rank, events.RASSeverityNotice, msg)
+		return replaceable
+	}
+
+	idx := 0
+	for i, old := range replaceable {
+		if old.Ctrlr.PciAddr == addr {
+			idx = i
+			break
+		}
+	}
+	old := replaceable[idx]
+	replaceable = append(replaceable[:idx], replaceable[idx+1:]...)
+
+	if err := p.replaceDevice(ctx, engine, tiers, old, dev); err != nil {
+		msg := fmt.Sprintf("failed to replace evicted SSD %s (%s) with NVMe SSD %s (%s): %s",
+			old.Uuid, old.Ctrlr.PciAddr, dev.Uuid, addr, err)
+		p.log.Error(msg)
+		p.notify(rank, events.RASSeverityError, msg)
+		return replaceable
+	}
+
+	msg := fmt.Sprintf("NVMe SSD %s (%s) replaced evicted SSD %s (%s)", dev.Uuid, addr,
+		old.Uuid, old.Ctrlr.PciAddr)
+	if addr != old.Ctrlr.PciAddr {
+		msg += fmt.Sprintf(", update bdev_list in server config file, replacing %s with %s",
+			old.Ctrlr.PciAddr, addr)
+	}
+	p.log.Notice(msg)
+	p.notify(rank, events.RASSeverityNotice, msg)
+
+	return replaceable
+}
+
+// replaceDevice tells the engine to replace the old SSD with the new one, which reintegrates the
+// targets of the old SSD, and regenerates the engine's NVMe config if the new SSD is at a
+// different address.
+func (p *nvmeHotplugPolicy) replaceDevice(ctx context.Context, engine Engine, tiers storage.TierConfigs, old, dev *ctlpb.SmdDevice) error {
+	res, err := replaceDevRetryBusy(ctx, p.log, engine, &ctlpb.DevReplaceReq{
+		OldDevUuid: old.Uuid,
+		NewDevUuid: dev.Uuid,
+	})
+	if err != nil {
+		return errors.Wrap(err, "dev-replace")
+	}
+	if err := checkDaosStatus(res.Status); err != nil {
+		return errors.Wrap(err, "dev-replace")
+	}
+
+	if p.svc.slotLeds != nil {
+		p.svc.slotLeds.manage(&ctlpb.LedManageReq{LedAction: ctlpb.LedAction_RESET},
+			old.Ctrlr.PciAddr)
+	}
+
+	if old.Ctrlr.PciAddr == dev.Ctrlr.PciAddr {
+		return nil
+	}
+	tier := findBdevTier(old.Ctrlr.PciAddr, tiers)
+	if err := tier.Bdev.DeviceList.Replace(old.Ctrlr.PciAddr, dev.Ctrlr.PciAddr); err != nil {
+		return errors.Wrapf(err, "updating bdev list for tier %d", tier.Tier)
+	}
+
+	return errors.Wrapf(engine.GetStorage().WriteNvmeConfig(ctx, p.log, nil),
+		"write nvme config for engine %d", engine.Index())
+}
+
+func (p *nvmeHotplugPolicy) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	p.scan(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.scan(ctx)
+		}
+	}
+}
+
+// start starts scanning for inserted SSDs at the configured interval. The returned function
+// stops the scanning.
+func (p *nvmeHotplugPolicy) start(ctx context.Context) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		p.run(ctx, p.cfg.GetPollInterval())
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}
+}
@@ -0,0 +1,364 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+	"github.com/daos-stack/daos/src/control/server/storage/scm"
+)
+
+func TestServer_nvmeHotplugPolicy_scan(t *testing.T) {
+	ctrlr := func(idx int32, driver string) *storage.NvmeController {
+		c := storage.MockNvmeController(idx)
+		c.Driver = driver
+		return c
+	}
+	insertedMsg := func(idx int32) string {
+		c := storage.MockNvmeController(idx)
+		return "NVMe SSD inserted at " + c.PciAddr + " (model " + c.Model + ", serial " +
+			c.Serial + ")"
+	}
+
+	for name, tc := range map[string]struct {
+		action    string
+		exclude   []string
+		prepErr   error
+		scans     []storage.NvmeControllers
+		expEvents []string
+		expPreps  []string
+	}{
+		"first scan sets baseline": {
+			action: config.NvmeHotplugActionAdd,
+			scans: []storage.NvmeControllers{
+				{ctrlr(1, "nvme")},
+			},
+		},
+		"notify only": {
+			scans: []storage.NvmeControllers{
+				{ctrlr(1, "nvme")},
+				{ctrlr(1, "nvme"), ctrlr(2, "nvme")},
+			},
+			expEvents: []string{insertedMsg(2)},
+		},
+		"inserted ssd bound": {
+			action: config.NvmeHotplugActionReplace,
+			scans: []storage.NvmeControllers{
+				{ctrlr(1, "vfio-pci")},
+				{ctrlr(1, "vfio-pci"), ctrlr(2, "nvme")},
+				{ctrlr(1, "vfio-pci"), ctrlr(2, "vfio-pci")},
+			},
+			expEvents: []string{insertedMsg(2)},
+			expPreps:  []string{test.MockPCIAddr(2)},
+		},
+		"inserted ssd already bound": {
+			action: config.NvmeHotplugActionAdd,
+			scans: []storage.NvmeControllers{
+				{},
+				{ctrlr(2, "vfio-pci")},
+			},
+			expEvents: []string{insertedMsg(2)},
+		},
+		"inserted ssd excluded": {
+			action:  config.NvmeHotplugActionAdd,
+			exclude: []string{test.MockPCIAddr(2)},
+			scans: []storage.NvmeControllers{
+				{},
+				{ctrlr(2, "nvme")},
+			},
+			expEvents: []string{insertedMsg(2)},
+		},
+		"ssd removed and inserted again": {
+			action: config.NvmeHotplugActionAdd,
+			scans: []storage.NvmeControllers{
+				{ctrlr(1, "vfio-pci")},
+				{},
+				{ctrlr(1, "nvme")},
+			},
+			expEvents: []string{insertedMsg(1)},
+			expPreps:  []string{test.MockPCIAddr(1)},
+		},
+		"bind fails": {
+			action:  config.NvmeHotplugActionAdd,
+			prepErr: errors.New("failure"),
+			scans: []storage.NvmeControllers{
+				{},
+				{ctrlr(2, "nvme")},
+			},
+			expEvents: []string{
+				insertedMsg(2),
+				"failed to bind inserted NVMe SSD at " + test.MockPCIAddr(2) +
+					": nvme rebind: failure",
+			},
+			expPreps: []string{test.MockPCIAddr(2)},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mbb := bdev.NewMockBackend(&bdev.MockBackendConfig{PrepareErr: tc.prepErr})
+			scs := NewMockStorageControlService(log, nil, nil,
+				scm.NewMockProvider(log, nil, nil), bdev.NewProvider(log, mbb), nil)
+			cs := &ControlService{StorageControlService: *scs}
+			cs.srvCfg = config.DefaultServer().WithBdevExclude(tc.exclude...)
+
+			var published []string
+			p := newNvmeHotplugPolicy(log, &config.NvmeHotplugPolicyConfig{Action: tc.action},
+				cs, func(evt *events.RASEvent) {
+					published = append(published, evt.Msg)
+				})
+
+			for _, ctrlrs := range tc.scans {
+				cs.scanSysfsBdevs = func() (storage.NvmeControllers, error) {
+					return ctrlrs, nil
+				}
+				p.scan(test.Context(t))
+			}
+
+			if diff := cmp.Diff(tc.expEvents, published); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+
+			var preps []string
+			mbb.RLock()
+			for _, call := range mbb.PrepareCalls {
+				preps = append(preps, call.PCIAllowList)
+			}
+			mbb.RUnlock()
+			if diff := cmp.Diff(tc.expPreps, preps); diff != "" {
+				t.Fatalf("unexpected prepare calls (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_nvmeHotplugPolicy_OnEvent(t *testing.T) {
+	dev := func(idx int32, state ctlpb.NvmeDevState, tgts ...int32) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid:   test.MockUUID(idx),
+			TgtIds: tgts,
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  test.MockPCIAddr(idx),
+				DevState: state,
+			},
+		}
+	}
+	smdResp := func(devs ...*ctlpb.SmdDevice) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.SmdDevResp{Devices: devs},
+		}
+	}
+	manageResp := func(status daos.Status) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.DevManageResp{Status: status.Int32()},
+		}
+	}
+	pluggedEvt := func(rank uint32) *events.RASEvent {
+		evt := events.NewGenericEvent(events.RASDevicePlugged, events.RASSeverityNotice,
+			"Detected hot plugged device: Nvme_0000:03:00.0", "")
+		evt.Rank = rank
+		return evt
+	}
+	replacedMsg := func(newIdx, oldIdx int32) string {
+		return "NVMe SSD " + test.MockUUID(newIdx) + " (" + test.MockPCIAddr(newIdx) +
+			") replaced evicted SSD " + test.MockUUID(oldIdx) + " (" +
+			test.MockPCIAddr(oldIdx) + ")"
+	}
+
+	for name, tc := range map[string]struct {
+		action     string
+		evt        *events.RASEvent
+		drpcResps  []*mockDrpcResponse
+		expCalls   []int32
+		expSev     events.RASSeverityID
+		expMsg     string
+		expDevices []string
+	}{
+		"other event": {
+			action: config.NvmeHotplugActionReplace,
+			evt: events.NewGenericEvent(events.RASDeviceFailurePredicted,
+				events.RASSeverityWarning, "", ""),
+		},
+		"forwarded event": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(0).WithForwarded(true),
+		},
+		"notify action": {
+			evt: pluggedEvt(0),
+		},
+		"rank not on this host": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(1),
+		},
+		"spare ignored": {
+			action: config.NvmeHotplugActionAdd,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_NORMAL, 0),
+					dev(4, ctlpb.NvmeDevState_NEW)),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID()},
+		},
+		"ssd added": {
+			action: config.NvmeHotplugActionAdd,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_NORMAL, 0),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID()},
+			expSev:   events.RASSeverityNotice,
+			expMsg: "NVMe SSD " + test.MockUUID(3) + " (" + test.MockPCIAddr(3) +
+				") added to bdev tier 1, it will be used when the engine restarts, " +
+				"add it to bdev_list in server config file",
+			expDevices: test.MockPCIAddrs(1, 2, 3),
+		},
+		"nothing to replace": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_NORMAL, 0),
+					dev(2, ctlpb.NvmeDevState_EVICTED),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID()},
+			expSev:   events.RASSeverityNotice,
+			expMsg: "no evicted SSD for NVMe SSD " + test.MockUUID(3) + " (" +
+				test.MockPCIAddr(3) + ") to replace, left unused",
+		},
+		"ssd replaced in same slot": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED, 0),
+					dev(2, ctlpb.NvmeDevState_UNPLUGGED, 1),
+					&ctlpb.SmdDevice{
+						Uuid: test.MockUUID(5),
+						Ctrlr: &ctlpb.NvmeController{
+							PciAddr:  test.MockPCIAddr(2),
+							DevState: ctlpb.NvmeDevState_NEW,
+						},
+					}),
+				manageResp(daos.Success),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:   events.RASSeverityNotice,
+			expMsg: "NVMe SSD " + test.MockUUID(5) + " (" + test.MockPCIAddr(2) +
+				") replaced evicted SSD " + test.MockUUID(2) + " (" +
+				test.MockPCIAddr(2) + ")",
+		},
+		"ssd replaced in other slot": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED, 0),
+					dev(2, ctlpb.NvmeDevState_NORMAL, 1),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+				manageResp(daos.Success),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:   events.RASSeverityNotice,
+			expMsg: replacedMsg(3, 1) + ", update bdev_list in server config file, " +
+				"replacing " + test.MockPCIAddr(1) + " with " + test.MockPCIAddr(3),
+			expDevices: test.MockPCIAddrs(3, 2),
+		},
+		"dev-replace fails": {
+			action: config.NvmeHotplugActionReplace,
+			evt:    pluggedEvt(0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED, 0),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+				manageResp(daos.IOError),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:   events.RASSeverityError,
+			expMsg: "failed to replace evicted SSD " + test.MockUUID(1) + " (" +
+				test.MockPCIAddr(1) + ") with NVMe SSD " + test.MockUUID(3) + " (" +
+				test.MockPCIAddr(3) + "): dev-replace: " + daos.IOError.Error(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			nvmeTier := storage.NewTierConfig().
+				WithStorageClass(storage.ClassNvme.String()).
+				WithBdevDeviceList(test.MockPCIAddrs(1, 2)...).
+				WithBdevSpares(test.MockPCIAddrs(4)...)
+			cfg := config.DefaultServer().
+				WithEngines(engine.MockConfig().
+					WithTargetCount(2).
+					WithStorage(
+						storage.NewTierConfig().
+							WithStorageClass(storage.ClassRam.String()).
+							WithScmMountPoint("/mnt/daos"),
+						nvmeTier,
+					))
+			cfg.SocketDir = t.TempDir()
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+			dcc := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				dcc.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(dcc)
+			for _, e := range svc.harness.instances {
+				e.(*EngineInstance).getDrpcClientFn = func(string) drpc.DomainSocketClient {
+					return mdc
+				}
+			}
+
+			var published []*events.RASEvent
+			p := newNvmeHotplugPolicy(log, &config.NvmeHotplugPolicyConfig{Action: tc.action},
+				svc, func(evt *events.RASEvent) {
+					published = append(published, evt)
+				})
+
+			p.OnEvent(test.Context(t), tc.evt)
+
+			if diff := cmp.Diff(tc.expCalls, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expMsg == "" {
+				if len(published) != 0 {
+					t.Fatalf("unexpected events published: %+v", published)
+				}
+			} else {
+				if len(published) != 1 {
+					t.Fatalf("expected one event, got %d", len(published))
+				}
+				evt := published[0]
+				test.AssertEqual(t, events.RASDeviceHotplugHandled, evt.ID,
+					"unexpected event ID")
+				test.AssertEqual(t, tc.expSev, evt.Severity, "unexpected event severity")
+				test.AssertEqual(t, tc.expMsg, evt.Msg, "unexpected event message")
+			}
+
+			if tc.expDevices == nil {
+				tc.expDevices = test.MockPCIAddrs(1, 2)
+			}
+			test.AssertEqual(t, strings.Join(tc.expDevices, ","),
+				nvmeTier.Bdev.DeviceList.String(), "unexpected bdev_list")
+		})
+	}
+}
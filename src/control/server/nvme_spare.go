@@ -0,0 +1,158 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// nvmeSpareSubstitutor substitutes spare SSDs listed in the bdev_spares of an engine's storage
+// tier for devices in the tier that have been set faulty. Spares are bound to a user-space driver
+// and attached by the engine through hotplug so they are known to the engine as NEW devices but
+// hold no data until substituted.
+type nvmeSpareSubstitutor struct {
+	sync.Mutex
+	log     logging.Logger
+	harness *EngineHarness
+	publish func(*events.RASEvent)
+}
+
+func newNvmeSpareSubstitutor(log logging.Logger, harness *EngineHarness, publish func(*events.RASEvent)) *nvmeSpareSubstitutor {
+	return &nvmeSpareSubstitutor{
+		log:     log,
+		harness: harness,
+		publish: publish,
+	}
+}
+
+// OnEvent implements the events.Handler interface, substituting spares when an engine on this
+// host reports that a device has been set faulty.
+func (nss *nvmeSpareSubstitutor) OnEvent(ctx context.Context, evt *events.RASEvent) {
+	if evt == nil || evt.ID != events.RASDeviceSetFaulty || evt.IsForwarded() {
+		return
+	}
+	if evt.Severity == events.RASSeverityError {
+		return // device failed to be set faulty
+	}
+
+	nss.Lock()
+	defer nss.Unlock()
+
+	for _, engine := range nss.harness.Instances() {
+		rank, err := engine.GetRank()
+		if err != nil || rank.Uint32() != evt.Rank || !engine.IsReady() {
+			continue
+		}
+		nss.substituteEvicted(ctx, engine, rank)
+	}
+}
+
+func (nss *nvmeSpareSubstitutor) notify(rank ranklist.Rank, sev events.RASSeverityID, msg string) {
+	evt := events.NewGenericEvent(events.RASDeviceSpareSubstituted, sev, msg, "")
+	evt.Rank = rank.Uint32()
+	nss.publish(evt)
+}
+
+// substituteEvicted substitutes a spare for each evicted device of the engine that is assigned
+// to a tier with spares.
+func (nss *nvmeSpareSubstitutor) substituteEvicted(ctx context.Context, engine Engine, rank ranklist.Rank) {
+	tiers := engine.GetStorage().GetBdevConfigs()
+	if tiers.NVMeSpares().Len() == 0 {
+		return
+	}
+
+	rResp, err := smdQueryEngine(ctx, engine, new(ctlpb.SmdQueryReq))
+	if err != nil {
+		nss.log.Errorf("spare substitution: %s", err)
+		return
+	}
+
+	newDevs := make(map[string]*ctlpb.SmdDevice)
+	for _, dev := range rResp.Devices {
+		if dev.GetCtrlr().GetDevState() == ctlpb.NvmeDevState_NEW {
+			newDevs[dev.Ctrlr.PciAddr] = dev
+		}
+	}
+
+	for _, dev := range rResp.Devices {
+		ctrlr := dev.GetCtrlr()
+		if ctrlr.GetDevState() != ctlpb.NvmeDevState_EVICTED {
+			continue
+		}
+		tier := findBdevTier(ctrlr.PciAddr, tiers)
+		if tier == nil || tier.Bdev.Spares.Len() == 0 {
+			continue
+		}
+
+		var spare *ctlpb.SmdDevice
+		for _, addr := range tier.Bdev.Spares.Devices() {
+			if spare = newDevs[addr]; spare != nil {
+				break
+			}
+		}
+		if spare == nil {
+			msg := fmt.Sprintf("no spare available for faulty NVMe device %s (%s)",
+				dev.Uuid, ctrlr.PciAddr)
+			nss.log.Error(msg)
+			nss.notify(rank, events.RASSeverityError, msg)
+			continue
+		}
+		delete(newDevs, spare.Ctrlr.PciAddr)
+
+		if err := nss.substitute(ctx, engine, tier, dev, spare); err != nil {
+			msg := fmt.Sprintf("failed to substitute spare NVMe device %s (%s) for "+
+				"faulty device %s (%s): %s", spare.Uuid, spare.Ctrlr.PciAddr, dev.Uuid,
+				ctrlr.PciAddr, err)
+			nss.log.Error(msg)
+			nss.notify(rank, events.RASSeverityError, msg)
+			continue
+		}
+
+		msg := fmt.Sprintf("spare NVMe device %s (%s) substituted for faulty device %s "+
+			"(%s), update bdev_list and bdev_spares in server config file", spare.Uuid,
+			spare.Ctrlr.PciAddr, dev.Uuid, ctrlr.PciAddr)
+		nss.log.Notice(msg)
+		nss.notify(rank, events.RASSeverityNotice, msg)
+	}
+}
+
+// substitute tells the engine to replace the faulty device with the spare, which reintegrates the
+// targets using the device, then moves the spare into the tier's bdev_list and regenerates the
+// engine's NVMe config. The bdev config is only updated once the engine has accepted the spare so
+// that a failed substitution is retried when a device is next set faulty.
+func (nss *nvmeSpareSubstitutor) substitute(ctx context.Context, engine Engine, tier *storage.TierConfig, dev, spare *ctlpb.SmdDevice) error {
+	res, err := replaceDevRetryBusy(ctx, nss.log, engine, &ctlpb.DevReplaceReq{
+		OldDevUuid: dev.Uuid,
+		NewDevUuid: spare.Uuid,
+	})
+	if err != nil {
+		return errors.Wrap(err, "dev-replace")
+	}
+	if err := checkDaosStatus(res.Status); err != nil {
+		return errors.Wrap(err, "dev-replace")
+	}
+
+	if err := tier.Bdev.DeviceList.Replace(dev.Ctrlr.PciAddr, spare.Ctrlr.PciAddr); err != nil {
+		return errors.Wrapf(err, "updating bdev list for tier %d", tier.Tier)
+	}
+	if err := tier.Bdev.Spares.Remove(spare.Ctrlr.PciAddr); err != nil {
+		return errors.Wrapf(err, "updating bdev spares for tier %d", tier.Tier)
+	}
+
+	return errors.Wrapf(engine.GetStorage().WriteNvmeConfig(ctx, nss.log, nil),
+		"write nvme config for engine %d", engine.Index())
+}
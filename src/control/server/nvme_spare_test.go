@@ -0,0 +1,215 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+func TestServer_nvmeSpareSubstitutor_OnEvent(t *testing.T) {
+	dev := func(idx int32, state ctlpb.NvmeDevState) *ctlpb.SmdDevice {
+		return &ctlpb.SmdDevice{
+			Uuid: test.MockUUID(idx),
+			Ctrlr: &ctlpb.NvmeController{
+				PciAddr:  test.MockPCIAddr(idx),
+				DevState: state,
+			},
+		}
+	}
+	smdResp := func(devs ...*ctlpb.SmdDevice) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.SmdDevResp{Devices: devs},
+		}
+	}
+	manageResp := func(status daos.Status) *mockDrpcResponse {
+		return &mockDrpcResponse{
+			Message: &ctlpb.DevManageResp{Status: status.Int32()},
+		}
+	}
+	faultyEvt := func(sev events.RASSeverityID, rank uint32) *events.RASEvent {
+		evt := events.NewGenericEvent(events.RASDeviceSetFaulty, sev,
+			"Device: "+test.MockUUID(1)+" set faulty", "")
+		evt.Rank = rank
+		return evt
+	}
+	substitutedMsg := func(spare int32) string {
+		return "spare NVMe device " + test.MockUUID(spare) + " (" + test.MockPCIAddr(spare) +
+			") substituted for faulty device " + test.MockUUID(1) + " (" +
+			test.MockPCIAddr(1) + "), update bdev_list and bdev_spares in server config file"
+	}
+
+	for name, tc := range map[string]struct {
+		evt        *events.RASEvent
+		noSpares   bool
+		drpcResps  []*mockDrpcResponse
+		expCalls   []int32
+		expSev     events.RASSeverityID
+		expMsg     string
+		expDevices []string
+		expSpares  []string
+	}{
+		"other event": {
+			evt: events.NewGenericEvent(events.RASDeviceFailurePredicted,
+				events.RASSeverityWarning, "", ""),
+		},
+		"forwarded event": {
+			evt: faultyEvt(events.RASSeverityNotice, 0).WithForwarded(true),
+		},
+		"set faulty failed": {
+			evt: faultyEvt(events.RASSeverityError, 0),
+		},
+		"rank not on this host": {
+			evt: faultyEvt(events.RASSeverityNotice, 1),
+		},
+		"no spares configured": {
+			evt:      faultyEvt(events.RASSeverityNotice, 0),
+			noSpares: true,
+		},
+		"no evicted devices": {
+			evt: faultyEvt(events.RASSeverityNotice, 0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_NORMAL),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID()},
+		},
+		"no spare available": {
+			evt: faultyEvt(events.RASSeverityNotice, 0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED)),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID()},
+			expSev:   events.RASSeverityError,
+			expMsg: "no spare available for faulty NVMe device " + test.MockUUID(1) +
+				" (" + test.MockPCIAddr(1) + ")",
+		},
+		"dev-replace fails": {
+			evt: faultyEvt(events.RASSeverityNotice, 0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED),
+					dev(3, ctlpb.NvmeDevState_NEW)),
+				manageResp(daos.IOError),
+			},
+			expCalls: []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:   events.RASSeverityError,
+			expMsg: "failed to substitute spare NVMe device " + test.MockUUID(3) + " (" +
+				test.MockPCIAddr(3) + ") for faulty device " + test.MockUUID(1) +
+				" (" + test.MockPCIAddr(1) + "): dev-replace: " + daos.IOError.Error(),
+		},
+		"spare substituted": {
+			evt: faultyEvt(events.RASSeverityNotice, 0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED),
+					dev(2, ctlpb.NvmeDevState_NORMAL),
+					dev(3, ctlpb.NvmeDevState_NEW),
+					dev(4, ctlpb.NvmeDevState_NEW)),
+				manageResp(daos.Success),
+			},
+			expCalls:   []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:     events.RASSeverityNotice,
+			expMsg:     substitutedMsg(3),
+			expDevices: test.MockPCIAddrs(2, 3),
+			expSpares:  test.MockPCIAddrs(4),
+		},
+		"first spare not attached": {
+			evt: faultyEvt(events.RASSeverityNotice, 0),
+			drpcResps: []*mockDrpcResponse{
+				smdResp(dev(1, ctlpb.NvmeDevState_EVICTED),
+					dev(4, ctlpb.NvmeDevState_NEW)),
+				manageResp(daos.Success),
+			},
+			expCalls:   []int32{daos.MethodSmdDevs.ID(), daos.MethodReplaceStorage.ID()},
+			expSev:     events.RASSeverityNotice,
+			expMsg:     substitutedMsg(4),
+			expDevices: test.MockPCIAddrs(2, 4),
+			expSpares:  test.MockPCIAddrs(3),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			nvmeTier := storage.NewTierConfig().
+				WithStorageClass(storage.ClassNvme.String()).
+				WithBdevDeviceList(test.MockPCIAddrs(1, 2)...)
+			if !tc.noSpares {
+				nvmeTier.WithBdevSpares(test.MockPCIAddrs(3, 4)...)
+			}
+			cfg := config.DefaultServer().
+				WithEngines(engine.MockConfig().
+					WithTargetCount(1).
+					WithStorage(
+						storage.NewTierConfig().
+							WithStorageClass(storage.ClassRam.String()).
+							WithScmMountPoint("/mnt/daos"),
+						nvmeTier,
+					))
+			svc := mockControlService(t, log, cfg, nil, nil, nil)
+
+			dcc := new(mockDrpcClientConfig)
+			for _, mock := range tc.drpcResps {
+				dcc.setSendMsgResponseList(t, mock)
+			}
+			mdc := newMockDrpcClient(dcc)
+			for _, e := range svc.harness.instances {
+				e.(*EngineInstance).getDrpcClientFn = func(string) drpc.DomainSocketClient {
+					return mdc
+				}
+			}
+
+			var published []*events.RASEvent
+			nss := newNvmeSpareSubstitutor(log, svc.harness, func(evt *events.RASEvent) {
+				published = append(published, evt)
+			})
+
+			nss.OnEvent(test.Context(t), tc.evt)
+
+			if diff := cmp.Diff(tc.expCalls, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got):\n%s\n", diff)
+			}
+
+			if tc.expMsg == "" {
+				if len(published) != 0 {
+					t.Fatalf("unexpected events published: %+v", published)
+				}
+			} else {
+				if len(published) != 1 {
+					t.Fatalf("expected one event, got %d", len(published))
+				}
+				evt := published[0]
+				test.AssertEqual(t, events.RASDeviceSpareSubstituted, evt.ID,
+					"unexpected event ID")
+				test.AssertEqual(t, tc.expSev, evt.Severity, "unexpected event severity")
+				test.AssertEqual(t, tc.expMsg, evt.Msg, "unexpected event message")
+			}
+
+			if tc.expDevices == nil {
+				tc.expDevices = test.MockPCIAddrs(1, 2)
+			}
+			if tc.expSpares == nil && !tc.noSpares {
+				tc.expSpares = test.MockPCIAddrs(3, 4)
+			}
+			test.AssertEqual(t, strings.Join(tc.expDevices, ","),
+				nvmeTier.Bdev.DeviceList.String(), "unexpected bdev_list")
+			test.AssertEqual(t, strings.Join(tc.expSpares, ","),
+				nvmeTier.Bdev.Spares.String(), "unexpected bdev_spares")
+		})
+	}
+}
@@ -60,6 +60,10 @@ func (m *SecurityModule) processValidateCredentials(body []byte) ([]byte, error)
 			m.log.Errorf("loading certificate %s failed: %v", certPath, err)
 			return m.validateRespWithStatus(daos.NoCert)
 		}
+		if err := m.config.CheckRevoked(cert, "dRPC credential from "+cred.Origin); err != nil {
+			m.log.Errorf("certificate %s rejected: %v", certPath, err)
+			return m.validateRespWithStatus(daos.BadCert)
+		}
 		key = cert.PublicKey
 	}
 
@@ -12,6 +12,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"math/big"
 	"os"
@@ -363,3 +364,119 @@ func TestSrvSecurityModule_ValidateCred_Secure_BadVerifier(t *testing.T) {
 		Status: int32(daos.NoPermission),
 	})
 }
+
+func writeTestPEM(t *testing.T, path, blockType string, data []byte, perm os.FileMode) {
+	t.Helper()
+
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: data}), perm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// revokedClientTransportConfig generates a CA whose certificate is also used as the server
+// certificate, and a "test" client certificate that has been revoked by the CA. It returns
+// a transport config with the certificate data loaded and the key of the client certificate.
+func revokedClientTransportConfig(t *testing.T, dir string) (*security.TransportConfig, crypto.PrivateKey) {
+	t.Helper()
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "server"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caCert, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTmpl, caCert, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	crlDER, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Minute),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{
+			{SerialNumber: big.NewInt(2), RevocationTime: time.Now().Add(-time.Minute)},
+		},
+	}, caCert, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clientDir := filepath.Join(dir, "clients")
+	if err := os.Mkdir(clientDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeTestPEM(t, filepath.Join(dir, "ca.crt"), "CERTIFICATE", caDER, 0644)
+	writeTestPEM(t, filepath.Join(dir, "ca.key"), "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(caKey), 0400)
+	writeTestPEM(t, filepath.Join(dir, "ca.crl"), "X509 CRL", crlDER, 0644)
+	writeTestPEM(t, filepath.Join(clientDir, "test.crt"), "CERTIFICATE", clientDER, 0644)
+
+	tc := security.DefaultServerTransportConfig()
+	tc.CARootPath = filepath.Join(dir, "ca.crt")
+	tc.CertificatePath = filepath.Join(dir, "ca.crt")
+	tc.PrivateKeyPath = filepath.Join(dir, "ca.key")
+	tc.CRLPath = filepath.Join(dir, "ca.crl")
+	tc.ClientCertDir = clientDir
+	if err := tc.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+
+	return tc, clientKey
+}
+
+func TestSrvSecurityModule_ValidateCred_Secure_Revoked(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	tmpDir, tmpCleanup := test.CreateTestDir(t)
+	defer tmpCleanup()
+
+	tc, key := revokedClientTransportConfig(t, tmpDir)
+	var rejected []string
+	tc.SetRevokedCertHandler(func(cert *x509.Certificate, peer string) {
+		rejected = append(rejected, peer)
+	})
+
+	mod := NewSecurityModule(log, tc)
+	token := getValidToken(t)
+
+	reqBytes := getMarshaledValidateCredReq(t, token, getVerifierForToken(t, token, key))
+
+	resp, err := callValidateCreds(t, mod, reqBytes)
+
+	if err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	expectValidateResp(t, resp, &auth.ValidateCredResp{
+		Status: int32(daos.BadCert),
+	})
+	test.AssertEqual(t, []string{"dRPC credential from test"}, rejected, "")
+}
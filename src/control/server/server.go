@@ -9,7 +9,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"os/user"
@@ -31,6 +33,8 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/network"
 	"github.com/daos-stack/daos/src/control/lib/hardware/defaults/topology"
+	"github.com/daos-stack/daos/src/control/lib/health"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/otlpexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/config"
@@ -82,7 +86,7 @@ func processConfig(log logging.Logger, cfg *config.Server, fis *hardware.FabricI
 		return err
 	}
 
-	for _, ec := range cfg.Engines {
+	for idx, ec := range cfg.Engines {
 		fabricIFs, err := ec.Fabric.GetInterfaces()
 		if err != nil {
 			return err
@@ -94,6 +98,10 @@ func processConfig(log logging.Logger, cfg *config.Server, fis *hardware.FabricI
 			}
 		}
 
+		if err := checkMultiHomedFabric(idx, ec, fis); err != nil {
+			return err
+		}
+
 		if err := updateFabricEnvars(log, ec, fis); err != nil {
 			return errors.Wrap(err, "update engine fabric envars")
 		}
@@ -151,19 +159,50 @@ type server struct {
 	netDevClass []hardware.NetDevClass
 	listener    net.Listener
 
+	// tokenListener accepts administrative clients that authenticate with
+	// bearer tokens, if token authentication is configured.
+	tokenListener net.Listener
+
+	// httpListener accepts requests to the HTTP management gateway, if it is
+	// configured.
+	httpListener net.Listener
+
+	// healthListener accepts requests from the liveness and readiness probes
+	// of container orchestrators, if they are configured.
+	healthListener net.Listener
+
 	harness      *EngineHarness
 	membership   *system.Membership
 	sysdb        *raft.Database
 	pubSub       *events.PubSub
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
+	evtSinks     []*events.SinkHandler
+	evtLimiter   *events.RateLimiter
+	nvmeSpares   *nvmeSpareSubstitutor
+	nvmeHotplug  *nvmeHotplugPolicy
 	ctlSvc       *ControlService
 	mgmtSvc      *mgmtSvc
 	grpcServer   *grpc.Server
 
+	tokenGrpcServer *grpc.Server
+	httpServer      *http.Server
+
 	cbLock           sync.Mutex
 	onEnginesStarted []func(context.Context) error
 	onShutdown       []func()
+
+	reloadLock sync.Mutex
+	loadedCfg  *config.Server // config as read from file, updated on reload
+
+	telemLock       sync.Mutex
+	stopTelemetry   func()
+	stopOTLP        func()
+	stopAlerts      func()
+	stopNvmeHealth  func()
+	stopNvmeHotplug func()
+	otlpSpans       *otlpexp.SpanRecorder // spans of management requests, if tracing is enabled
+	ctlMetrics      *controlMetrics
 }
 
 func newServer(log logging.Logger, cfg *config.Server, faultDomain *system.FaultDomain) (*server, error) {
@@ -249,11 +288,69 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 	srv.OnShutdown(srv.pubSub.Close)
 	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.cfg.MgmtSvcReplicas)
 	srv.evtLogger = control.NewEventLogger(srv.log)
+	for _, sinkCfg := range srv.cfg.EventSinks {
+		sink, err := events.NewSinkHandler(srv.log, sinkCfg)
+		if err != nil {
+			return errors.Wrapf(err, "event sink %s", sinkCfg)
+		}
+		srv.evtSinks = append(srv.evtSinks, sink)
+		srv.log.Debugf("delivering RAS events to %s", sinkCfg)
+	}
+	srv.OnShutdown(func() {
+		for _, sink := range srv.evtSinks {
+			if err := sink.Close(); err != nil {
+				srv.log.Errorf("failed to close event sink: %s", err)
+			}
+		}
+	})
+	if srv.cfg.EventRateLimit != nil {
+		notifiers := []events.Handler{srv.evtLogger}
+		for _, sink := range srv.evtSinks {
+			notifiers = append(notifiers, sink)
+		}
+		srv.evtLimiter = events.NewRateLimiter(srv.log, srv.cfg.EventRateLimit, notifiers...)
+		go srv.evtLimiter.Run(ctx)
+	}
+
+	srv.nvmeSpares = newNvmeSpareSubstitutor(srv.log, srv.harness, srv.pubSub.Publish)
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		network.DefaultFabricScanner(srv.log))
+	if srv.cfg.NvmeHotplugPolicy != nil {
+		srv.nvmeHotplug = newNvmeHotplugPolicy(srv.log, srv.cfg.NvmeHotplugPolicy, srv.ctlSvc,
+			srv.pubSub.Publish)
+	}
 	srv.mgmtSvc = newMgmtSvc(srv.harness, srv.membership, srv.sysdb, rpcClient, srv.pubSub)
 
+	if srv.cfg.TransportConfig != nil {
+		srv.cfg.TransportConfig.SetRevokedCertHandler(
+			createPublishRevokedCertFunc(srv.pubSub.Publish))
+	}
+
+	if srv.cfg.AuditLogFile != "" {
+		var publish func(*events.RASEvent)
+		if srv.cfg.AuditRASEvents {
+			publish = srv.pubSub.Publish
+		}
+		audit, err := newAuditLog(srv.log, srv.cfg.AuditLogFile, publish)
+		if err != nil {
+			return err
+		}
+		srv.OnShutdown(func() {
+			if err := audit.Close(); err != nil {
+				srv.log.Errorf("failed to close audit log: %s", err)
+			}
+		})
+		srv.ctlSvc.audit = audit
+		srv.log.Debugf("recording management requests in audit log %s", srv.cfg.AuditLogFile)
+	}
+
+	srv.ctlMetrics = newControlMetrics()
+	if srv.cfg.TelemetryOTLP != nil && srv.cfg.TelemetryOTLP.Traces {
+		srv.otlpSpans = otlpexp.NewSpanRecorder(otlpexp.DefaultMaxSpans)
+		srv.log.Debug("recording management request spans for OTLP export")
+	}
+
 	if err := srv.mgmtSvc.systemProps.UpdateCompPropVal(daos.SystemPropertyDaosSystem, func() string {
 		return srv.cfg.SystemName
 	}); err != nil {
@@ -322,6 +419,33 @@ func (srv *server) initNetwork() error {
 	srv.ctlAddr = ctlAddr
 	srv.listener = listener
 
+	if tc := srv.cfg.TransportConfig; tc != nil && tc.TokenAuth != nil {
+		tokenAddr := &net.TCPAddr{IP: ctlAddr.IP, Port: tc.TokenAuth.Port}
+		tokenListener, err := createListener(tokenAddr, net.Listen)
+		if err != nil {
+			return errors.Wrap(err, "token authentication listener")
+		}
+		srv.tokenListener = tokenListener
+	}
+
+	if srv.cfg.HTTPGateway != nil {
+		httpAddr := &net.TCPAddr{IP: ctlAddr.IP, Port: srv.cfg.HTTPGateway.GetPort()}
+		httpListener, err := createListener(httpAddr, net.Listen)
+		if err != nil {
+			return errors.Wrap(err, "http gateway listener")
+		}
+		srv.httpListener = httpListener
+	}
+
+	if srv.cfg.HealthProbe != nil {
+		healthAddr := &net.TCPAddr{IP: ctlAddr.IP, Port: srv.cfg.HealthProbe.GetPort()}
+		healthListener, err := createListener(healthAddr, net.Listen)
+		if err != nil {
+			return errors.Wrap(err, "health probe listener")
+		}
+		srv.healthListener = healthListener
+	}
+
 	return nil
 }
 
@@ -336,10 +460,15 @@ func (srv *server) createEngine(ctx context.Context, idx int, cfg *engine.Config
 	}
 
 	sp := storage.DefaultProvider(srv.log, idx, &cfg.Storage).
-		WithVMDEnabled(srv.ctlSvc.storage.IsVMDEnabled())
+		WithVMDEnabled(srv.ctlSvc.storage.IsVMDEnabled()).
+		WithForceBdevConfigRegen(srv.cfg.ForceBdevConfigRegen)
 
 	engine := NewEngineInstance(srv.log, sp, joinFn, engine.NewRunner(srv.log, cfg), srv.pubSub).
 		WithHostFaultDomain(srv.harness.faultDomain)
+	if cfg.Standby {
+		srv.log.Noticef("engine %d is configured in standby mode and will not be started", idx)
+		engine.standby.SetTrue()
+	}
 
 	if idx == 0 {
 		configureFirstEngine(ctx, engine, srv.sysdb, joinFn)
@@ -353,12 +482,35 @@ func (srv *server) createEngine(ctx context.Context, idx int, cfg *engine.Config
 func (srv *server) addEngines(ctx context.Context, smi *common.SysMemInfo) error {
 	var allStarted sync.WaitGroup
 	registerTelemetryCallbacks(ctx, srv)
+	srv.OnShutdown(func() {
+		_ = srv.restartTelemetry(ctx, 0)
+	})
+	registerOTLPCallbacks(srv)
+	registerAlertCallbacks(srv)
+	registerNvmeHealthCallbacks(srv)
+	registerNvmeHotplugCallbacks(srv)
 
 	iommuEnabled, err := topology.DefaultIOMMUDetector(srv.log).IsIOMMUEnabled()
 	if err != nil {
 		return err
 	}
 
+	if err := checkEngineCores(ctx, srv.log, srv.cfg, topology.DefaultProvider(srv.log)); err != nil {
+		return err
+	}
+
+	if err := checkEngineRlimits(srv.log, srv.cfg, os.Geteuid() == 0); err != nil {
+		return err
+	}
+
+	if err := unlockScm(srv); err != nil {
+		return err
+	}
+
+	if err := checkScmPlacement(srv); err != nil {
+		return err
+	}
+
 	// Allocate hugepages and rebind NVMe devices to userspace drivers.
 	if err := prepBdevStorage(srv, iommuEnabled, smi); err != nil {
 		return err
@@ -374,13 +526,21 @@ func (srv *server) addEngines(ctx context.Context, smi *common.SysMemInfo) error
 			return errors.Wrap(err, "creating engine instances")
 		}
 
-		registerEngineEventCallbacks(srv, engine, &allStarted)
+		// Engines in standby are not waited on so that callbacks run when the
+		// remaining engines have started.
+		if engine.IsStandby() {
+			registerEngineEventCallbacks(srv, engine, nil)
+		} else {
+			registerEngineEventCallbacks(srv, engine, &allStarted)
+		}
 
 		if err := srv.harness.AddInstance(engine); err != nil {
 			return err
 		}
-		// increment count of engines waiting to start
-		allStarted.Add(1)
+		if !engine.IsStandby() {
+			// increment count of engines waiting to start
+			allStarted.Add(1)
+		}
 	}
 
 	go func() {
@@ -403,7 +563,8 @@ func (srv *server) addEngines(ctx context.Context, smi *common.SysMemInfo) error
 
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader)
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader, srv.ctlSvc.audit,
+		srv.otlpSpans, srv.ctlMetrics)
 	if err != nil {
 		return err
 	}
@@ -444,6 +605,62 @@ func (srv *server) setupGrpc() error {
 		return err
 	}
 
+	if err := srv.setupTokenGrpc(); err != nil {
+		return err
+	}
+
+	return srv.setupHTTPGateway()
+}
+
+// setupTokenGrpc creates the grpc server for administrative clients that
+// authenticate with bearer tokens. Only the control and management services
+// are registered, as other servers always authenticate with certificates.
+func (srv *server) setupTokenGrpc() error {
+	if srv.tokenListener == nil {
+		return nil
+	}
+
+	srvOpts, err := getTokenGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader, srv.ctlSvc.audit,
+		srv.otlpSpans, srv.ctlMetrics)
+	if err != nil {
+		return err
+	}
+
+	srv.tokenGrpcServer = grpc.NewServer(srvOpts...)
+	ctlpb.RegisterCtlSvcServer(srv.tokenGrpcServer, srv.ctlSvc)
+	mgmtpb.RegisterMgmtSvcServer(srv.tokenGrpcServer, srv.mgmtSvc)
+
+	return nil
+}
+
+// setupHTTPGateway creates the HTTP server for read-only management requests
+// from clients such as dashboards that do not use gRPC.
+func (srv *server) setupHTTPGateway() error {
+	if srv.httpListener == nil {
+		return nil
+	}
+
+	gw, err := newHTTPGateway(srv.log, srv.cfg.HTTPGateway.GetPort(), srv.cfg.SystemName,
+		srv.cfg.TransportConfig, srv.ctlSvc, srv.mgmtSvc, srv.ctlSvc.audit, srv.ctlMetrics)
+	if err != nil {
+		return errors.Wrap(err, "http gateway")
+	}
+
+	tlsCfg, err := security.ServerTLSConfigForHTTP(srv.cfg.TransportConfig)
+	if err != nil {
+		return errors.Wrap(err, "http gateway")
+	}
+	if tlsCfg != nil {
+		srv.httpListener = tls.NewListener(srv.httpListener, tlsCfg)
+	}
+
+	srv.httpServer = &http.Server{
+		Handler:           gw,
+		ReadHeaderTimeout: httpGatewayReadTimeout,
+		// HTTP/2 is not offered, see security.ServerTLSConfigForHTTP.
+		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
+	}
+
 	return nil
 }
 
@@ -502,6 +719,28 @@ func (srv *server) start(ctx context.Context) error {
 	}()
 	defer srv.grpcServer.Stop()
 
+	if srv.tokenGrpcServer != nil {
+		go func() {
+			_ = srv.tokenGrpcServer.Serve(srv.tokenListener)
+		}()
+		defer srv.tokenGrpcServer.Stop()
+		srv.log.Infof("accepting bearer token authentication on port %d",
+			srv.cfg.TransportConfig.TokenAuth.Port)
+	}
+
+	if srv.httpServer != nil {
+		go func() {
+			_ = srv.httpServer.Serve(srv.httpListener)
+		}()
+		defer srv.httpServer.Close()
+		srv.log.Infof("serving HTTP management gateway on port %d",
+			srv.cfg.HTTPGateway.GetPort())
+	}
+
+	if srv.healthListener != nil {
+		defer health.Serve(srv.log, srv.healthListener, srv.healthConfig())()
+	}
+
 	// noop on release builds
 	control.StartPProf(srv.log)
 
@@ -590,6 +829,13 @@ func Start(log logging.Logger, cfg *config.Server) error {
 		return err
 	}
 
+	// Take a copy of the config before it is updated with runtime values so that it can be
+	// compared with the config file on reload.
+	loadedCfg, err := snapshotConfig(cfg)
+	if err != nil {
+		return err
+	}
+
 	if err := waitFabricReady(ctx, log, cfg); err != nil {
 		return err
 	}
@@ -621,6 +867,7 @@ func Start(log logging.Logger, cfg *config.Server) error {
 		return err
 	}
 	defer srv.shutdown()
+	srv.loadedCfg = loadedCfg
 
 	if err := srv.setCoreDumpFilter(); err != nil {
 		return err
@@ -633,6 +880,10 @@ func Start(log logging.Logger, cfg *config.Server) error {
 	if err := srv.createServices(ctx); err != nil {
 		return err
 	}
+	srv.ctlSvc.reloadConfig = func(_ context.Context) ([]string, error) {
+		// Use the root context as the reload may restart long-running services.
+		return srv.reloadConfig(ctx)
+	}
 
 	if err := srv.initNetwork(); err != nil {
 		return err
@@ -645,15 +896,25 @@ func Start(log logging.Logger, cfg *config.Server) error {
 	if err := srv.setupGrpc(); err != nil {
 		return err
 	}
+	go security.WatchCertFiles(ctx, srv.log, srv.cfg.TransportConfig)
+	go security.WatchCRL(ctx, srv.log, srv.cfg.TransportConfig)
 
 	srv.registerEvents()
 
 	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
-		sig := <-sigChan
-		srv.log.Debugf("Caught signal: %s", sig)
-		shutdown()
+		for sig := range sigChan {
+			srv.log.Debugf("Caught signal: %s", sig)
+			if sig == syscall.SIGHUP {
+				if _, err := srv.reloadConfig(ctx); err != nil {
+					srv.log.Errorf("config reload failed: %s", err)
+				}
+				continue
+			}
+			shutdown()
+			return
+		}
 	}()
 
 	return srv.start(ctx)
@@ -22,13 +22,16 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	yaml "gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/common"
+	ctlpb "github.com/daos-stack/daos/src/control/common/proto/ctl"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/otlpexp"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/pbin"
 	"github.com/daos-stack/daos/src/control/security"
@@ -46,6 +49,10 @@ const (
 
 	// maxLineChars is the maximum number of chars per line in a formatted byte string.
 	maxLineChars = 32
+
+	// defaultHugepageSizeMiB is passed to engines running SPDK without hugepages when the
+	// hugepage size cannot be determined from meminfo.
+	defaultHugepageSizeMiB = 2
 )
 
 // netListenerFn is a type alias for the net.Listener function signature.
@@ -194,6 +201,13 @@ func updateFabricEnvars(log logging.Logger, cfg *engine.Config, fis *hardware.Fa
 			return err
 		}
 
+		// All interfaces of a multi-homed engine share the single provider.
+		if cfg.Fabric.IsMultiHomed() {
+			for range interfaces[1:] {
+				providers = append(providers, providers[0])
+			}
+		}
+
 		if len(providers) != len(interfaces) {
 			return errors.New("number of providers not equal to number of interfaces")
 		}
@@ -217,6 +231,39 @@ func updateFabricEnvars(log logging.Logger, cfg *engine.Config, fis *hardware.Fa
 	return nil
 }
 
+// checkMultiHomedFabric verifies that each interface of a multi-homed engine supports the engine's
+// provider and is local to the engine's NUMA node.
+func checkMultiHomedFabric(idx int, cfg *engine.Config, fis *hardware.FabricInterfaceSet) error {
+	if !cfg.Fabric.IsMultiHomed() {
+		return nil
+	}
+
+	provider, err := cfg.Fabric.GetPrimaryProvider()
+	if err != nil {
+		return err
+	}
+
+	interfaces, err := cfg.Fabric.GetInterfaces()
+	if err != nil {
+		return err
+	}
+
+	for _, iface := range interfaces {
+		fi, err := fis.GetInterfaceOnNetDevice(iface, provider)
+		if err != nil {
+			return errors.Wrapf(err, "engine %d fabric_ifaces", idx)
+		}
+
+		if cfg.PinnedNumaNode != nil && fi.NUMANode != *cfg.PinnedNumaNode {
+			return errors.Errorf("engine %d fabric_ifaces: interface %q is on NUMA node %d, "+
+				"engine is pinned to NUMA node %d", idx, iface, fi.NUMANode,
+				*cfg.PinnedNumaNode)
+		}
+	}
+
+	return nil
+}
+
 func getFabricNetDevClass(cfg *config.Server, fis *hardware.FabricInterfaceSet) ([]hardware.NetDevClass, error) {
 	netDevClass := []hardware.NetDevClass{}
 	for index, engine := range cfg.Engines {
@@ -359,6 +406,18 @@ func SetHugeNodes(log logging.Logger, srvCfg *config.Server, smi *common.SysMemI
 	return nil
 }
 
+// spdkNoHugepages returns true if all engines in the server config have been set to run SPDK
+// without hugepages.
+func spdkNoHugepages(cfg *config.Server) bool {
+	for _, ec := range cfg.Engines {
+		if !ec.Storage.SpdkEnvOpts.NoHugepages {
+			return false
+		}
+	}
+
+	return len(cfg.Engines) > 0
+}
+
 // Prepare bdev storage. Assumes validation has already been performed on server config. Hugepages
 // are required for both emulated (AIO devices) and real NVMe bdevs. VFIO and IOMMU are not
 // mandatory requirements for emulated NVMe.
@@ -372,6 +431,10 @@ func prepBdevStorage(srv *server, iommuEnabled bool, smi *common.SysMemInfo) err
 		srv.log.Debugf("skip nvme prepare as disable_hugepages is set true in config")
 		return nil
 	}
+	if spdkNoHugepages(srv.cfg) {
+		srv.log.Debugf("skip nvme prepare as spdk_no_hugepages is set true on all engines")
+		return nil
+	}
 
 	bdevCfgs := srv.cfg.GetBdevConfigs()
 
@@ -386,7 +449,7 @@ func prepBdevStorage(srv *server, iommuEnabled bool, smi *common.SysMemInfo) err
 	}
 
 	// Clean leftover SPDK hugepages and lockfiles for configured NVMe SSDs before prepare.
-	pciAddrs := bdevCfgs.NVMeBdevs().Devices()
+	pciAddrs := bdevCfgs.NVMeBdevsWithSpares().Devices()
 	if err := cleanSpdkResources(srv, pciAddrs); err != nil {
 		srv.log.Error(errors.Wrap(err, "prepBdevStorage").Error())
 	}
@@ -412,8 +475,8 @@ func prepBdevStorage(srv *server, iommuEnabled bool, smi *common.SysMemInfo) err
 		srv.log.Info("VMD not enabled because VFIO disabled in config")
 	case enableVMD && !iommuEnabled:
 		srv.log.Info("VMD not enabled because IOMMU disabled on platform")
-	case enableVMD && bdevCfgs.HaveEmulatedNVMe():
-		srv.log.Info("VMD not enabled because emulated NVMe devices found in config")
+	case enableVMD && bdevCfgs.HaveEmulatedNVMe() && !bdevCfgs.HaveRealNVMe():
+		srv.log.Info("VMD not enabled because only emulated NVMe devices found in config")
 	default:
 		// If no case above matches, set enable VMD flag in request otherwise leave false.
 		prepReq.EnableVMD = enableVMD
@@ -464,19 +527,40 @@ func setEngineMemSize(srv *server, ei *EngineInstance, smi *common.SysMemInfo) {
 		ei.RUnlock()
 		return
 	}
+	envOpts := ec.Storage.SpdkEnvOpts
 	ei.RUnlock()
 
+	// Calculate mem_size per I/O engine (in MB) based on number of pages required per engine.
+	pageSizeMiB := smi.HugepageSizeKiB / humanize.KiByte // kib to mib
+
+	// Engines running SPDK without hugepages allocate DMA buffers from the process heap so use
+	// the configured mem-size and skip hugepage availability checks.
+	if envOpts.NoHugepages {
+		if pageSizeMiB == 0 {
+			pageSizeMiB = defaultHugepageSizeMiB
+		}
+		srv.log.Debugf("Per-engine MemSize:%dMB (spdk without hugepages)", envOpts.MemSizeMiB)
+		ei.setMemSize(int(envOpts.MemSizeMiB))
+		ei.setHugepageSz(pageSizeMiB)
+		return
+	}
+
+	nrHugepageEngines := 0
+	for _, ec := range srv.cfg.Engines {
+		if !ec.Storage.SpdkEnvOpts.NoHugepages {
+			nrHugepageEngines++
+		}
+	}
+
 	// Mem-size for each engine to be calculated based on server config total hugepage
 	// requirements. Mem-size should be the same for each engine to avoid performance imbalance
 	// and will act as memory cap to stop DMA buffer growing beyond mem-size.
-	nrPagesRequired := srv.cfg.NrHugepages / len(srv.cfg.Engines)
+	nrPagesRequired := srv.cfg.NrHugepages / nrHugepageEngines
 
 	// Global (rather than per-NUMA) meminfo stats used to verify sufficient free hugepages as
 	// engines should be started even if hugemem has to be used across NUMA boundaries.
 	nrPagesFree := smi.HugepagesFree
 
-	// Calculate mem_size per I/O engine (in MB) based on number of pages required per engine.
-	pageSizeMiB := smi.HugepageSizeKiB / humanize.KiByte // kib to mib
 	memSizeReqMiB := nrPagesRequired * pageSizeMiB
 	memSizeFreeMiB := nrPagesFree * pageSizeMiB
 
@@ -552,6 +636,98 @@ func checkMemForRamdisk(log logging.Logger, memRamdisks, memAvail uint64) error
 	return nil
 }
 
+// unlockScm unlocks passphrase-protected PMem modules using the passphrase source specified in
+// the server config so that secured modules can be used without manual intervention.
+func unlockScm(srv *server) error {
+	if srv.cfg.ScmUnlock == nil || !srv.cfg.HasPMem() {
+		return nil
+	}
+
+	keyFile, cleanup, err := srv.cfg.ScmUnlock.PassphraseFile()
+	if err != nil {
+		return errors.Wrap(err, "scm unlock")
+	}
+	defer cleanup()
+
+	resp, err := srv.ctlSvc.ScmUnlock(storage.ScmUnlockRequest{
+		PassphraseFile: keyFile,
+	})
+	if err != nil {
+		return errors.Wrap(err, "scm unlock")
+	}
+
+	for _, module := range resp.Modules {
+		srv.log.Noticef("unlocked PMem module %s (socket %d)", module.UID, module.SocketID)
+	}
+
+	return nil
+}
+
+// checkScmPlacement scans PMem namespaces and verifies that their assignment to engines is
+// consistent with engine NUMA affinity and balanced in capacity.
+func checkScmPlacement(srv *server) error {
+	if !srv.cfg.HasPMem() {
+		return nil
+	}
+
+	resp, err := srv.ctlSvc.ScmScan(storage.ScmScanRequest{})
+	if err != nil {
+		// Scan failures are reported when the engine attempts to mount its SCM.
+		srv.log.Noticef("skipping PMem placement validation: %s", err)
+		return nil
+	}
+
+	if err := srv.cfg.ValidateScmPlacement(srv.log, resp.Namespaces); err != nil {
+		return errors.Wrapf(err, "%s: validation failed", srv.cfg.Path)
+	}
+
+	return nil
+}
+
+// checkEngineCores plans CPU core assignments for all configured engines against the host
+// topology so that oversubscription is detected before any engine is launched.
+func checkEngineCores(ctx context.Context, log logging.Logger, cfg *config.Server, topoProv hardware.TopologyProvider) error {
+	if len(cfg.Engines) == 0 {
+		return nil
+	}
+
+	topo, err := topoProv.GetTopology(ctx)
+	if err != nil {
+		log.Noticef("skipping engine core allocation validation: %s", err)
+		return nil
+	}
+
+	allocs, err := engine.PlanCores(topo, cfg.Engines...)
+	if err != nil {
+		return errors.Wrapf(err, "%s: engine core allocation", cfg.Path)
+	}
+	for _, alloc := range allocs {
+		log.Debugf("core allocation: %s", alloc)
+	}
+
+	return nil
+}
+
+// checkEngineRlimits reports the resource limits configured for each engine and verifies that they
+// can be applied by the current process before any engine is launched.
+func checkEngineRlimits(log logging.Logger, cfg *config.Server, privileged bool) error {
+	for _, ec := range cfg.Engines {
+		reports, err := ec.CheckRlimits(privileged)
+		if err != nil {
+			return errors.Wrapf(err, "%s: engine resource limits", cfg.Path)
+		}
+		for _, rr := range reports {
+			if rr.ExceedsHard() {
+				log.Noticef("engine %d rlimits %s will raise hard limit", ec.Index, rr)
+				continue
+			}
+			log.Debugf("engine %d rlimits %s", ec.Index, rr)
+		}
+	}
+
+	return nil
+}
+
 func checkEngineTmpfsMem(srv *server, ei *EngineInstance, smi *common.SysMemInfo) error {
 	sc, err := ei.storage.GetScmConfig()
 	if err != nil {
@@ -606,11 +782,18 @@ func createPublishFormatRequiredFunc(publish func(*events.RASEvent), hostname st
 
 func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarted *sync.WaitGroup) {
 	// Register callback to publish engine process exit events.
-	engine.OnInstanceExit(createPublishInstanceExitFunc(srv.pubSub.Publish, srv.hostname))
+	engine.OnInstanceExit(createPublishInstanceExitFunc(srv.pubSub.Publish, srv.hostname,
+		engine.restarts.getStatus))
+
+	// Register callback to write a crash report when the engine exits unexpectedly.
+	if srv.cfg.CrashReports != nil {
+		cr := newCrashReporter(srv.log, srv.cfg.CrashReports, srv.hostname, srv.pubSub.Publish)
+		engine.OnInstanceExit(createCrashReportFunc(cr, engine))
+	}
 
 	engine.OnInstanceExit(func(_ context.Context, _ uint32, _ ranklist.Rank, _ uint64, _ error, _ int) error {
 		storageCfg := engine.runner.GetConfig().Storage
-		pciAddrs := storageCfg.Tiers.NVMeBdevs().Devices()
+		pciAddrs := storageCfg.Tiers.NVMeBdevsWithSpares().Devices()
 
 		if err := cleanSpdkResources(srv, pciAddrs); err != nil {
 			srv.log.Error(
@@ -632,17 +815,38 @@ func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarte
 		// Indicate that engine has been started, only do this the first time that the
 		// engine starts as shared memory persists between engine restarts.
 		onceReady.Do(func() {
-			allStarted.Done()
+			if allStarted != nil {
+				allStarted.Done()
+			}
 		})
 		return nil
 	})
 
+	// Register callback to inject I/O errors into the error bdevs of a started engine.
+	engine.OnReady(func(_ context.Context) error {
+		if !engine.runner.GetConfig().Storage.Tiers.HaveErrorBdevs() {
+			return nil
+		}
+
+		// Failure to inject errors should not prevent the engine from being used.
+		resp, err := engine.storage.InjectBdevErrors()
+		if err != nil {
+			srv.log.Errorf("engine instance %d: inject bdev errors: %s", engine.Index(),
+				err)
+			return nil
+		}
+		srv.log.Noticef("engine instance %d: injected errors into bdevs %v", engine.Index(),
+			resp.Bdevs)
+
+		return nil
+	})
+
 	// Register callback to update engine cfg mem_size after format.
 	engine.OnStorageReady(func(_ context.Context) error {
 		srv.log.Debugf("engine %d: storage ready", engine.Index())
 
 		storageCfg := engine.runner.GetConfig().Storage
-		pciAddrs := storageCfg.Tiers.NVMeBdevs().Devices()
+		pciAddrs := storageCfg.Tiers.NVMeBdevsWithSpares().Devices()
 
 		if err := cleanSpdkResources(srv, pciAddrs); err != nil {
 			srv.log.Error(
@@ -716,23 +920,309 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 
 	srv.OnEnginesStarted(func(ctxIn context.Context) error {
 		srv.log.Debug("starting Prometheus exporter")
-		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances())
+		return srv.restartTelemetry(ctxIn, telemPort)
+	})
+}
+
+// registerOTLPCallbacks starts pushing telemetry to the configured OTLP collector when all
+// engines have been started.
+func registerOTLPCallbacks(srv *server) {
+	if srv.cfg.TelemetryOTLP == nil {
+		return
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		srv.log.Debug("starting OTLP exporter")
+		stop, err := startOTLPExporter(ctxIn, srv.log, srv.cfg, srv.hostname,
+			srv.harness.Instances(), srv.mgmtSvc, srv.ctlMetrics, srv.otlpSpans)
 		if err != nil {
 			return err
 		}
-		srv.OnShutdown(cleanup)
+
+		srv.telemLock.Lock()
+		srv.stopOTLP = stop
+		srv.telemLock.Unlock()
+		return nil
+	})
+	srv.OnShutdown(func() {
+		srv.telemLock.Lock()
+		defer srv.telemLock.Unlock()
+
+		if srv.stopOTLP != nil {
+			srv.stopOTLP()
+			srv.stopOTLP = nil
+		}
+	})
+}
+
+// registerAlertCallbacks starts evaluating the configured telemetry alert rules when all engines
+// have been started.
+func registerAlertCallbacks(srv *server) {
+	if srv.cfg.TelemetryAlerts == nil {
+		return
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		srv.log.Debug("starting telemetry alert evaluation")
+		stop, err := startTelemetryAlerts(ctxIn, srv.log, srv.cfg, srv.hostname,
+			srv.harness.Instances(), srv.mgmtSvc, srv.ctlMetrics, srv.pubSub.Publish)
+		if err != nil {
+			return err
+		}
+
+		srv.telemLock.Lock()
+		srv.stopAlerts = stop
+		srv.telemLock.Unlock()
+		return nil
+	})
+	srv.OnShutdown(func() {
+		srv.telemLock.Lock()
+		defer srv.telemLock.Unlock()
+
+		if srv.stopAlerts != nil {
+			srv.stopAlerts()
+			srv.stopAlerts = nil
+		}
+	})
+}
+
+// registerNvmeHealthCallbacks starts polling the health of the NVMe devices used by the engines
+// when all engines have been started, retaining the samples so that device wear can be tracked
+// and applying the failure policy if one is configured.
+func registerNvmeHealthCallbacks(srv *server) {
+	if srv.cfg.NvmeHealthHistory == nil {
+		return
+	}
+	srv.ctlSvc.nvmeHealth = storage.NewNvmeHealthHistory(srv.cfg.NvmeHealthHistory.Retention)
+
+	var policy *nvmeFailurePolicy
+	if srv.cfg.NvmeFailurePolicy != nil {
+		policy = newNvmeFailurePolicy(srv.log, srv.cfg.NvmeFailurePolicy, srv.pubSub.Publish)
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		srv.log.Debug("starting nvme health polling")
+		stop := startNvmeHealthPoller(ctxIn, srv.log, srv.cfg.NvmeHealthHistory.GetInterval(),
+			srv.harness.Instances(), srv.ctlSvc.nvmeHealth, policy)
+
+		srv.telemLock.Lock()
+		if srv.stopNvmeHealth != nil {
+			srv.stopNvmeHealth()
+		}
+		srv.stopNvmeHealth = stop
+		srv.telemLock.Unlock()
+		return nil
+	})
+	srv.OnShutdown(func() {
+		srv.telemLock.Lock()
+		defer srv.telemLock.Unlock()
+
+		if srv.stopNvmeHealth != nil {
+			srv.stopNvmeHealth()
+			srv.stopNvmeHealth = nil
+		}
+	})
+}
+
+// registerNvmeHotplugCallbacks starts scanning for inserted NVMe SSDs once the engines have been
+// started, if an NVMe hotplug policy is configured, and stops it on shutdown.
+func registerNvmeHotplugCallbacks(srv *server) {
+	if srv.nvmeHotplug == nil {
+		return
+	}
+
+	srv.OnEnginesStarted(func(ctxIn context.Context) error {
+		srv.log.Debug("starting nvme hotplug scanning")
+		stop := srv.nvmeHotplug.start(ctxIn)
+
+		srv.telemLock.Lock()
+		if srv.stopNvmeHotplug != nil {
+			srv.stopNvmeHotplug()
+		}
+		srv.stopNvmeHotplug = stop
+		srv.telemLock.Unlock()
 		return nil
 	})
+	srv.OnShutdown(func() {
+		srv.telemLock.Lock()
+		defer srv.telemLock.Unlock()
+
+		if srv.stopNvmeHotplug != nil {
+			srv.stopNvmeHotplug()
+			srv.stopNvmeHotplug = nil
+		}
+	})
+}
+
+// restartTelemetry stops any running Prometheus exporter and starts a new one on the given port.
+// A port of zero leaves the exporter stopped.
+func (srv *server) restartTelemetry(ctx context.Context, port int) error {
+	srv.telemLock.Lock()
+	defer srv.telemLock.Unlock()
+
+	if srv.stopTelemetry != nil {
+		srv.stopTelemetry()
+		srv.stopTelemetry = nil
+	}
+	if port == 0 {
+		return nil
+	}
+
+	cleanup, err := startPrometheusExporter(ctx, srv.log, port, srv.cfg.GetTelemetryCollect(),
+		srv.cfg.TelemetryHistory, srv.harness.Instances(), srv.mgmtSvc, srv.ctlMetrics)
+	if err != nil {
+		return err
+	}
+	srv.stopTelemetry = cleanup
+
+	return nil
+}
+
+// snapshotConfig returns a copy of the server config containing only parameters that are read
+// from the config file.
+func snapshotConfig(cfg *config.Server) (*config.Server, error) {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal config")
+	}
+
+	snapshot := new(config.Server)
+	if err := yaml.Unmarshal(data, snapshot); err != nil {
+		return nil, errors.Wrap(err, "unmarshal config")
+	}
+	snapshot.Path = cfg.Path
+
+	return snapshot, nil
+}
+
+// reloadConfig re-reads the server config file and applies changes to hot-reloadable parameters
+// to the running server. An error is returned without applying any changes if parameters that
+// require a restart have been modified.
+func (srv *server) reloadConfig(ctx context.Context) ([]string, error) {
+	srv.reloadLock.Lock()
+	defer srv.reloadLock.Unlock()
+
+	if srv.loadedCfg == nil {
+		return nil, errors.New("config reload not available")
+	}
+
+	newCfg := config.DefaultServer()
+	if err := newCfg.SetPath(srv.loadedCfg.Path); err != nil {
+		return nil, err
+	}
+	if err := newCfg.Load(srv.log); err != nil {
+		return nil, errors.Wrap(err, "reload config")
+	}
+
+	changes, err := srv.loadedCfg.ReloadChanges(newCfg)
+	if err != nil {
+		return nil, err
+	}
+	if changes.IsEmpty() {
+		srv.log.Noticef("config %q reloaded, no changes to apply", newCfg.Path)
+		return nil, nil
+	}
+
+	if err := srv.applyConfigChanges(ctx, changes); err != nil {
+		return nil, err
+	}
+	for _, desc := range changes.Descriptions {
+		srv.log.Noticef("config %q reloaded, applied %s", newCfg.Path, desc)
+	}
+
+	snapshot, err := snapshotConfig(newCfg)
+	if err != nil {
+		return nil, err
+	}
+	srv.loadedCfg = snapshot
+
+	return changes.Descriptions, nil
+}
+
+// applyConfigChanges updates the running server with reloaded config parameters.
+func (srv *server) applyConfigChanges(ctx context.Context, changes *config.ReloadChanges) error {
+	if changes.ControlLogMask != nil {
+		srv.cfg.ControlLogMask = *changes.ControlLogMask
+		if ll, ok := srv.log.(interface{ SetLevel(logging.LogLevel) }); ok {
+			ll.SetLevel(logging.LogLevel(*changes.ControlLogMask))
+		}
+	}
+
+	if len(changes.EngineLogMasks) > 0 {
+		instances := srv.harness.Instances()
+		for idx, mask := range changes.EngineLogMasks {
+			srv.cfg.Engines[idx].LogMask = mask
+			if idx >= len(instances) || !instances[idx].IsStarted() {
+				continue // applied when the engine is next started
+			}
+
+			req := &ctlpb.SetLogMasksReq{
+				ResetMasks:      true,
+				ResetStreams:    true,
+				ResetSubsystems: true,
+			}
+			msg, err := srv.ctlSvc.setEngineLogMasks(ctx, idx, instances[idx], req)
+			if err != nil {
+				return err
+			}
+			if msg != "" {
+				return errors.Errorf("engine %d: set log masks: %s", idx, msg)
+			}
+		}
+	}
+
+	if changes.TelemetryPort != nil {
+		srv.cfg.TelemetryPort = *changes.TelemetryPort
+		if err := srv.restartTelemetry(ctx, *changes.TelemetryPort); err != nil {
+			return errors.Wrap(err, "restart telemetry exporter")
+		}
+	}
+
+	if changes.MgmtSvcReplicas != nil {
+		srv.cfg.MgmtSvcReplicas = changes.MgmtSvcReplicas
+		srv.evtForwarder.SetReplicas(changes.MgmtSvcReplicas)
+		srv.log.Notice("updated MS replica list used for event forwarding and joins, " +
+			"the MS replica set is unchanged")
+	}
+
+	return nil
+}
+
+// subscribeEventNotifiers logs events raised on this host and delivers them to the configured
+// event sinks, through the rate limiter if one is configured.
+func subscribeEventNotifiers(srv *server) {
+	if srv.evtLimiter != nil {
+		srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLimiter)
+		return
+	}
+
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	for _, sink := range srv.evtSinks {
+		srv.pubSub.Subscribe(events.RASTypeAny, sink)
+	}
+}
+
+// subscribeLocalHandlers subscribes handlers acting on events raised on this host, which are
+// required whether or not this host is the MS leader.
+func subscribeLocalHandlers(srv *server) {
+	if srv.nvmeSpares != nil {
+		srv.pubSub.Subscribe(events.RASTypeInfoOnly, srv.nvmeSpares)
+	}
+	if srv.nvmeHotplug != nil {
+		srv.pubSub.Subscribe(events.RASTypeInfoOnly, srv.nvmeHotplug)
+	}
 }
 
 // registerFollowerSubscriptions stops handling received forwarded (in addition
-// to local) events and starts forwarding events to the new MS leader.
+// to local) events and starts forwarding events to the new MS leader, which
+// retains them for querying.
 // Log events on the host that they were raised (and first published) on.
 // This is the initial behavior before leadership has been determined.
 func registerFollowerSubscriptions(srv *server) {
 	srv.pubSub.Reset()
-	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
-	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.evtForwarder)
+	subscribeEventNotifiers(srv)
+	subscribeLocalHandlers(srv)
+	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtForwarder)
 }
 
 func isSelfHealExcludeSet(svc *mgmtSvc) bool {
@@ -796,9 +1286,16 @@ func handleRankDead(ctx context.Context, srv *server, evt *events.RASEvent) {
 // handling received forwarded (and local) events.
 func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Reset()
-	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	subscribeEventNotifiers(srv)
+	subscribeLocalHandlers(srv)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
+	srv.pubSub.Subscribe(events.RASTypeAny,
+		events.HandlerFunc(func(_ context.Context, evt *events.RASEvent) {
+			if err := srv.sysdb.AddEvent(evt); err != nil {
+				srv.log.Debugf("failed to retain event: %s", err)
+			}
+		}))
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
 		events.HandlerFunc(func(ctx context.Context, evt *events.RASEvent) {
 			switch evt.ID {
@@ -814,20 +1311,70 @@ func registerLeaderSubscriptions(srv *server) {
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool) ([]grpc.ServerOption, error) {
-	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
-		unaryErrorInterceptor,
-		unaryStatusInterceptor,
-		unaryVersionInterceptor(log),
+// Requests are recorded in the audit log and span recorder if supplied.
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool, audit *auditLog, spans *otlpexp.SpanRecorder, metrics *controlMetrics) ([]grpc.ServerOption, error) {
+	tcOpt, err := security.ServerOptionForTransportConfig(cfgTransport)
+	if err != nil {
+		return nil, err
 	}
-	streamInterceptors := []grpc.StreamServerInterceptor{
-		streamErrorInterceptor,
+
+	return getInterceptorOpts(log, cfgTransport, ldrChk, audit, spans, metrics, tcOpt, nil)
+}
+
+// getTokenGrpcOpts generates the gRPC options of the server listening for administrative clients
+// that authenticate with bearer tokens. Tokens are verified before any other checks so that the
+// identity established by the token is used for access checks and audit log entries.
+func getTokenGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool, audit *auditLog, spans *otlpexp.SpanRecorder, metrics *controlMetrics) ([]grpc.ServerOption, error) {
+	if cfgTransport == nil {
+		return nil, errors.New("nil TransportConfig")
 	}
-	tcOpt, err := security.ServerOptionForTransportConfig(cfgTransport)
+
+	verifier, err := security.NewTokenVerifier(cfgTransport.TokenAuth)
 	if err != nil {
 		return nil, err
 	}
+
+	tcOpt, err := security.ServerOptionForTokenAuth(cfgTransport)
+	if err != nil {
+		return nil, err
+	}
+
+	return getInterceptorOpts(log, cfgTransport, ldrChk, audit, spans, metrics, tcOpt, verifier)
+}
+
+func getInterceptorOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool, audit *auditLog, spans *otlpexp.SpanRecorder, metrics *controlMetrics, tcOpt grpc.ServerOption, verifier *security.TokenVerifier) ([]grpc.ServerOption, error) {
+	var roles security.ClientRoles
+	if cfgTransport != nil {
+		roles = cfgTransport.ClientRoles
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
+		unaryCorrelationInterceptor,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{}
+	if spans != nil {
+		// record the full duration and result of the request, including failed checks
+		unaryInterceptors = append(unaryInterceptors, unaryTraceInterceptor(spans))
+	}
+	if metrics != nil {
+		unaryInterceptors = append(unaryInterceptors, unaryMetricsInterceptor(metrics))
+	}
+	if verifier != nil {
+		unaryInterceptors = append(unaryInterceptors, unaryTokenAuthInterceptor(verifier))
+		streamInterceptors = append(streamInterceptors, streamTokenAuthInterceptor(verifier))
+	}
+	if audit != nil {
+		// record errors from the subsequent checks, e.g. access denied
+		unaryInterceptors = append(unaryInterceptors, unaryAuditInterceptor(audit, roles))
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		unaryErrorInterceptor,
+		unaryStatusInterceptor,
+		unaryVersionInterceptor(log, roles),
+		unaryAPIVersionInterceptor(log),
+	)
+	streamInterceptors = append(streamInterceptors, streamErrorInterceptor)
 	srvOpts := []grpc.ServerOption{tcOpt}
 
 	uintOpt, err := unaryInterceptorForTransportConfig(cfgTransport)
@@ -379,6 +379,19 @@ func TestServer_prepBdevStorage_setEngineMemSize(t *testing.T) {
 			expMemSize:      16384,
 			expHugepageSize: 2,
 		},
+		"non-nvme bdevs; spdk without hugepages": {
+			srvCfgExtra: func(sc *config.Server) *config.Server {
+				return sc.WithEngines(pmemFakeNvmeEngine(0).
+					WithStorageSpdkEnvOptions(storage.SpdkEnvOptions{
+						NoHugepages: true,
+						MemSizeMiB:  4096,
+					}))
+			},
+			hugepagesFree:   128,
+			hugepagesTotal:  128,
+			expMemSize:      4096,
+			expHugepageSize: 2,
+		},
 		"iommu disabled": {
 			iommuDisabled: true,
 			srvCfgExtra: func(sc *config.Server) *config.Server {
@@ -1410,6 +1423,59 @@ func TestServer_checkEngineTmpfsMem(t *testing.T) {
 	}
 }
 
+func TestServer_checkEngineCores(t *testing.T) {
+	mockTopo := &hardware.Topology{
+		NUMANodes: hardware.NodeMap{
+			0: hardware.MockNUMANode(0, 8),
+			1: hardware.MockNUMANode(1, 8, 8),
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		engines []*engine.Config
+		topoRes *hardware.Topology
+		topoErr error
+		expErr  error
+	}{
+		"no engines": {},
+		"topology unavailable; skip check": {
+			engines: []*engine.Config{
+				engine.NewConfig().WithTargetCount(4).WithPinnedNumaNode(0),
+			},
+			topoErr: errors.New("no hwloc"),
+		},
+		"engines fit": {
+			engines: []*engine.Config{
+				engine.NewConfig().WithTargetCount(4).WithPinnedNumaNode(0),
+				engine.NewConfig().WithTargetCount(4).WithPinnedNumaNode(1),
+			},
+			topoRes: mockTopo,
+		},
+		"engines oversubscribe": {
+			engines: []*engine.Config{
+				engine.NewConfig().WithTargetCount(4).WithPinnedNumaNode(1),
+				engine.NewConfig().WithTargetCount(4).WithPinnedNumaNode(1),
+			},
+			topoRes: mockTopo,
+			expErr:  errors.New("oversubscribe core 8"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEngines(tc.engines...)
+			prov := &hardware.MockTopologyProvider{
+				GetTopoReturn: tc.topoRes,
+				GetTopoErr:    tc.topoErr,
+			}
+
+			gotErr := checkEngineCores(test.Context(t), log, cfg, prov)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
 func testFabricProviderSet(prov ...string) *hardware.FabricProviderSet {
 	providers := []*hardware.FabricProvider{}
 	for _, p := range prov {
@@ -1523,6 +1589,106 @@ func TestServer_getNetDevClass(t *testing.T) {
 	}
 }
 
+func TestServer_checkMultiHomedFabric(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    *engine.Config
+		expErr error
+	}{
+		"single interface; skip check": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterface("eth0"),
+		},
+		"multi-homed; same numa": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterfaces(
+					&engine.FabricInterfaceConfig{Name: "eth1"},
+					&engine.FabricInterfaceConfig{Name: "ib1", Priority: 1},
+				).
+				WithPinnedNumaNode(1),
+		},
+		"multi-homed; numa mismatch": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterfaces(
+					&engine.FabricInterfaceConfig{Name: "eth0"},
+					&engine.FabricInterfaceConfig{Name: "ib1", Priority: 1},
+				).
+				WithPinnedNumaNode(0),
+			expErr: errors.New("interface \"ib1\" is on NUMA node 1"),
+		},
+		"multi-homed; provider not supported": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+verbs;ofi_rxm").
+				WithFabricInterfaces(
+					&engine.FabricInterfaceConfig{Name: "ib1"},
+					&engine.FabricInterfaceConfig{Name: "eth1", Priority: 1},
+				).
+				WithPinnedNumaNode(1),
+			expErr: errors.New("not supported on network device"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, checkMultiHomedFabric(0, tc.cfg, mockFabIfSet))
+		})
+	}
+}
+
+func TestServer_updateFabricEnvars(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg       *engine.Config
+		expDomain string
+		expErr    error
+	}{
+		"single interface": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterface("eth0"),
+			expDomain: "eth0",
+		},
+		"multi-homed": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterfaces(
+					&engine.FabricInterfaceConfig{Name: "ib1", Priority: 2},
+					&engine.FabricInterfaceConfig{Name: "eth1", Priority: 1},
+				),
+			expDomain: "eth1,ib1",
+		},
+		"domain already set": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterface("eth0").
+				WithEnvVars("D_DOMAIN=mlx5_0"),
+			expDomain: "mlx5_0",
+		},
+		"unknown interface": {
+			cfg: engine.MockConfig().
+				WithFabricProvider("ofi+tcp").
+				WithFabricInterface("eth9"),
+			expErr: errors.New("unable to determine device domain for eth9"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			gotErr := updateFabricEnvars(log, tc.cfg, mockFabIfSet)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotDomain, err := tc.cfg.GetEnvVar("D_DOMAIN")
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expDomain, gotDomain, "unexpected D_DOMAIN")
+		})
+	}
+}
+
 type mockReplicaAddrSrc struct {
 	replicaAddrResult *net.TCPAddr
 	replicaAddrErr    error
@@ -55,14 +55,31 @@ const (
 	ConfBdevNvmeSetOptions       = "bdev_nvme_set_options"
 	ConfBdevNvmeSetHotplug       = "bdev_nvme_set_hotplug"
 	ConfBdevAioCreate            = "bdev_aio_create"
+	ConfBdevNullCreate           = C.NVME_CONF_NULL_CREATE
+	ConfBdevMallocCreate         = C.NVME_CONF_MALLOC_CREATE
+	ConfBdevRaidCreate           = "bdev_raid_create"
+	ConfBdevDelayCreate          = "bdev_delay_create"
+	ConfBdevErrorCreate          = "bdev_error_create"
+	ConfBdevErrorInjectError     = "bdev_error_inject_error"
+	ConfIdxdScanAccelEngine      = "idxd_scan_accel_engine"
+	ConfAccelCryptoKeyCreate     = "accel_crypto_key_create"
+	ConfBdevCryptoCreate         = "bdev_crypto_create"
+	ConfBdevOcfCreate            = "bdev_ocf_create"
 	ConfBdevNvmeAttachController = C.NVME_CONF_ATTACH_CONTROLLER
 	ConfVmdEnable                = C.NVME_CONF_ENABLE_VMD
 	ConfSetHotplugBusidRange     = C.NVME_CONF_SET_HOTPLUG_RANGE
 	ConfSetAccelProps            = C.NVME_CONF_SET_ACCEL_PROPS
 	ConfSetSpdkRpcServer         = C.NVME_CONF_SET_SPDK_RPC_SERVER
 	ConfSetAutoFaultyProps       = C.NVME_CONF_SET_AUTO_FAULTY
+	ConfSetSpdkEnvOpts           = C.NVME_CONF_SET_SPDK_ENV_OPTS
+	ConfSetSpdkLog               = C.NVME_CONF_SET_SPDK_LOG
+	ConfSetNsSelect              = C.NVME_CONF_SET_NS_SELECT
 )
 
+// DefaultSpdkRpcSockAddr is the path of the socket the engine SPDK JSON-RPC server listens on if
+// none is configured.
+const DefaultSpdkRpcSockAddr = "/var/tmp/spdk.sock"
+
 // Acceleration related constants for engine setting and optional capabilities.
 const (
 	AccelEngineNone  = C.NVME_ACCEL_NONE
@@ -231,6 +248,28 @@ type NvmeHealth struct {
 	LinkNegWidth            uint32  `json:"link_neg_width"`
 }
 
+// CriticalWarnings returns descriptions of the critical warnings set in the health stats.
+func (nh *NvmeHealth) CriticalWarnings() (warnings []string) {
+	if nh == nil {
+		return
+	}
+	for _, w := range []struct {
+		set  bool
+		desc string
+	}{
+		{nh.TempWarn, "temperature"},
+		{nh.AvailSpareWarn, "available spare"},
+		{nh.ReliabilityWarn, "device reliability"},
+		{nh.ReadOnlyWarn, "read only"},
+		{nh.VolatileWarn, "volatile memory backup"},
+	} {
+		if w.set {
+			warnings = append(warnings, w.desc)
+		}
+	}
+	return
+}
+
 // TempK returns controller temperature in degrees Kelvin.
 func (nch *NvmeHealth) TempK() uint32 {
 	return uint32(nch.Temperature)
@@ -246,31 +285,47 @@ func (nch *NvmeHealth) TempF() float32 {
 	return (nch.TempC() * (9.0 / 5.0)) + 32.0
 }
 
+// NvmeHealthTrend summarizes the wear of a NVMe device over the retained history of its health
+// statistics. RemainingSecs is zero if the remaining endurance cannot be predicted.
+type NvmeHealthTrend struct {
+	NrSamples      uint32   `json:"nr_samples"`
+	FirstTimestamp uint64   `json:"first_timestamp"`
+	LastTimestamp  uint64   `json:"last_timestamp"`
+	HostWriteRate  float64  `json:"host_write_rate"`
+	WriteAmp       float64  `json:"write_amp"`
+	WearUsed       uint32   `json:"wear_used"`
+	RemainingSecs  uint64   `json:"remaining_secs"`
+	FailureScore   uint32   `json:"failure_score"`
+	FailureReasons []string `json:"failure_reasons"`
+}
+
 // NvmeNamespace represents an individual NVMe namespace on a device and
 // mirrors C.struct_ns_t.
 type NvmeNamespace struct {
-	ID   uint32 `json:"id"`
-	Size uint64 `json:"size"`
+	ID    uint32 `json:"id"`
+	Size  uint64 `json:"size"`
+	Zoned bool   `json:"zoned"`
 }
 
 // SmdDevice contains DAOS storage device information, including
 // health details if requested.
 type SmdDevice struct {
-	UUID             string         `json:"uuid"`
-	TargetIDs        []int32        `hash:"set" json:"tgt_ids"`
-	Rank             ranklist.Rank  `json:"rank"`
-	TotalBytes       uint64         `json:"total_bytes"`
-	AvailBytes       uint64         `json:"avail_bytes"`
-	UsableBytes      uint64         `json:"usable_bytes"`
-	ClusterSize      uint64         `json:"cluster_size"`
-	MetaSize         uint64         `json:"meta_size"`
-	MetaWalSize      uint64         `json:"meta_wal_size"`
-	RdbSize          uint64         `json:"rdb_size"`
-	RdbWalSize       uint64         `json:"rdb_wal_size"`
-	Roles            BdevRoles      `json:"roles"`
-	HasSysXS         bool           `json:"has_sys_xs"`
-	Ctrlr            NvmeController `json:"ctrlr"`
-	CtrlrNamespaceID uint32         `json:"ctrlr_namespace_id"`
+	UUID             string              `json:"uuid"`
+	TargetIDs        []int32             `hash:"set" json:"tgt_ids"`
+	Rank             ranklist.Rank       `json:"rank"`
+	TotalBytes       uint64              `json:"total_bytes"`
+	AvailBytes       uint64              `json:"avail_bytes"`
+	UsableBytes      uint64              `json:"usable_bytes"`
+	ClusterSize      uint64              `json:"cluster_size"`
+	MetaSize         uint64              `json:"meta_size"`
+	MetaWalSize      uint64              `json:"meta_wal_size"`
+	RdbSize          uint64              `json:"rdb_size"`
+	RdbWalSize       uint64              `json:"rdb_wal_size"`
+	Roles            BdevRoles           `json:"roles"`
+	HasSysXS         bool                `json:"has_sys_xs"`
+	Ctrlr            NvmeController      `json:"ctrlr"`
+	CtrlrNamespaceID uint32              `json:"ctrlr_namespace_id"`
+	PoolUsage        []*PoolStorageUsage `json:"pool_usage,omitempty"`
 }
 
 func (sd *SmdDevice) String() string {
@@ -350,10 +405,13 @@ type NvmeController struct {
 	PciType     string           `json:"pci_type"`
 	SocketID    int32            `json:"socket_id"`
 	HealthStats *NvmeHealth      `json:"health_stats"`
+	HealthTrend *NvmeHealthTrend `json:"health_trend,omitempty"`
 	Namespaces  []*NvmeNamespace `hash:"set" json:"namespaces"`
 	SmdDevices  []*SmdDevice     `hash:"set" json:"smd_devices"`
 	NvmeState   NvmeDevState     `json:"dev_state"`
 	LedState    LedState         `json:"led_state"`
+	Driver      string           `json:"driver"`
+	Binding     *DeviceBinding   `json:"binding,omitempty"`
 }
 
 // UpdateSmd adds or updates SMD device entry for an NVMe Controller.
@@ -382,6 +440,20 @@ func (nc *NvmeController) Capacity() (tb uint64) {
 	return
 }
 
+// HasZonedNamespace returns true if any of the controller's namespaces use the zoned namespace
+// (ZNS) command set.
+func (nc *NvmeController) HasZonedNamespace() bool {
+	if nc == nil {
+		return false
+	}
+	for _, n := range nc.Namespaces {
+		if n.Zoned {
+			return true
+		}
+	}
+	return false
+}
+
 // Total returns the cumulative total bytes of all blobstore clusters.
 func (nc NvmeController) Total() (tb uint64) {
 	for _, d := range nc.SmdDevices {
@@ -544,6 +616,11 @@ type (
 		ReadConfig(BdevReadConfigRequest) (*BdevReadConfigResponse, error)
 		QueryFirmware(NVMeFirmwareQueryRequest) (*NVMeFirmwareQueryResponse, error)
 		UpdateFirmware(NVMeFirmwareUpdateRequest) (*NVMeFirmwareUpdateResponse, error)
+		AttachController(BdevAttachRequest) (*BdevAttachResponse, error)
+		DetachController(BdevDetachRequest) (*BdevDetachResponse, error)
+		InjectErrors(BdevInjectErrorsRequest) (*BdevInjectErrorsResponse, error)
+		CreateNamespaces(BdevNamespaceCreateRequest) (*BdevNamespaceCreateResponse, error)
+		DeleteNamespace(BdevNamespaceDeleteRequest) (*BdevNamespaceDeleteResponse, error)
 	}
 
 	// BdevPrepareRequest defines the parameters for a Prepare operation.
@@ -584,11 +661,27 @@ type (
 
 	// BdevTierProperties contains basic configuration properties of a bdev tier.
 	BdevTierProperties struct {
-		Class          Class
-		DeviceList     *BdevDeviceList
-		DeviceFileSize uint64 // size in bytes for NVMe device emulation
-		Tier           int
-		DeviceRoles    BdevRoles // NVMe SSD role assignments
+		Class            Class
+		DeviceList       *BdevDeviceList
+		DeviceFileSize   uint64 // size in bytes for NVMe device emulation
+		DeviceFileSparse bool   // create emulation files without allocating blocks
+		DeviceZoned      bool   // permit zoned namespaces (ZNS) on NVMe devices
+		Tier             int
+		DeviceRoles      BdevRoles       // NVMe SSD role assignments
+		Transport        BdevTransport   // NVMe-oF transport for remote controllers
+		Raid             BdevRaid        // RAID bdev composed from tier devices
+		Delay            BdevDelay       // I/O latency added by delay bdevs
+		ErrorInject      BdevErrorInject // I/O errors injected by error bdevs
+		BlockSize        BdevBlockSize   // block sizes of AIO bdevs
+		Crypto           BdevCrypto      // encryption of tier bdevs
+		Cache            BdevCache       // OCF caching of tier bdevs
+	}
+
+	// BdevExclusion describes an NVMe device removed from a bdev tier and why.
+	BdevExclusion struct {
+		Tier    int
+		PciAddr string
+		Reason  string
 	}
 
 	// BdevFormatRequest defines the parameters for a Format operation.
@@ -610,14 +703,20 @@ type (
 		OwnerGID          int
 		TierProps         []BdevTierProperties
 		HotplugEnabled    bool
+		HotplugPollUsec   uint64
 		HotplugBusidBegin uint8
 		HotplugBusidEnd   uint8
 		Hostname          string
 		AccelProps        AccelProps
 		SpdkRpcSrvProps   SpdkRpcServer
 		AutoFaultyProps   BdevAutoFaulty
+		SpdkLogProps      SpdkLog
+		NvmeOptions       BdevNvmeOptions
+		BdevOptions       BdevOptions
+		SpdkEnvOpts       SpdkEnvOptions
 		VMDEnabled        bool
 		ScannedBdevs      NvmeControllers // VMD needs address mapping for backing devices.
+		ExcludedBdevs     []BdevExclusion
 	}
 
 	// BdevWriteConfigResponse contains the result of a WriteConfig operation.
@@ -626,11 +725,81 @@ type (
 	// BdevReadConfigRequest defines the parameters for a ReadConfig operation.
 	BdevReadConfigRequest struct {
 		pbin.ForwardableRequest
-		ConfigPath string
+		ConfigPath     string
+		ExpectedConfig *BdevWriteConfigRequest // compare file against generated config if set
 	}
 
 	// BdevReadConfigResponse contains the result of a ReadConfig operation.
-	BdevReadConfigResponse struct{}
+	BdevReadConfigResponse struct {
+		Differences     []string // config file methods that differ from the expected config
+		ChecksumMissing bool     // no checksum was recorded when the config file was written
+		ConfigModified  bool     // config file content changed since it was generated
+		InputsChanged   bool     // expected config inputs differ from those of the config file
+	}
+
+	// BdevAttachRequest defines the parameters for attaching an NVMe controller to a running
+	// engine through its SPDK JSON-RPC server.
+	BdevAttachRequest struct {
+		pbin.ForwardableRequest
+		SockAddr   string // engine SPDK JSON-RPC server socket
+		DeviceName string // controller name, prefix of the names of bdevs created
+		PciAddr    string
+	}
+
+	// BdevAttachResponse contains the result of an AttachController operation.
+	BdevAttachResponse struct {
+		Bdevs []string // names of bdevs created for the controller's namespaces
+	}
+
+	// BdevDetachRequest defines the parameters for detaching an NVMe controller from a running
+	// engine through its SPDK JSON-RPC server.
+	BdevDetachRequest struct {
+		pbin.ForwardableRequest
+		SockAddr   string // engine SPDK JSON-RPC server socket
+		DeviceName string // name given to the controller when attached
+	}
+
+	// BdevDetachResponse contains the result of a DetachController operation.
+	BdevDetachResponse struct{}
+
+	// BdevInjectErrorsRequest defines the parameters for injecting the I/O errors recorded in
+	// an engine's SPDK config file into the error bdevs of the running engine through its SPDK
+	// JSON-RPC server.
+	BdevInjectErrorsRequest struct {
+		pbin.ForwardableRequest
+		SockAddr   string // engine SPDK JSON-RPC server socket
+		ConfigPath string // engine SPDK config file
+	}
+
+	// BdevInjectErrorsResponse contains the result of an InjectErrors operation.
+	BdevInjectErrorsResponse struct {
+		Bdevs []string // names of error bdevs that errors were injected into
+	}
+
+	// BdevNamespaceCreateRequest defines the parameters for creating namespaces on an NVMe
+	// controller.
+	BdevNamespaceCreateRequest struct {
+		pbin.ForwardableRequest
+		PciAddr string
+		Size    uint64 // bytes per namespace, zero to split unallocated capacity
+		Count   uint32 // number of namespaces to create
+	}
+
+	// BdevNamespaceCreateResponse contains the result of a CreateNamespaces operation.
+	BdevNamespaceCreateResponse struct {
+		Namespaces []*NvmeNamespace
+	}
+
+	// BdevNamespaceDeleteRequest defines the parameters for deleting a namespace on an NVMe
+	// controller.
+	BdevNamespaceDeleteRequest struct {
+		pbin.ForwardableRequest
+		PciAddr string
+		NsID    uint32
+	}
+
+	// BdevNamespaceDeleteResponse contains the result of a DeleteNamespace operation.
+	BdevNamespaceDeleteResponse struct{}
 
 	// BdevDeviceFormatRequest designs the parameters for a device-specific format.
 	BdevDeviceFormatRequest struct {
@@ -678,6 +847,8 @@ type (
 		FirmwarePath string   // location of the firmware binary
 		ModelID      string   // filter devices by model ID
 		FirmwareRev  string   // filter devices by current FW revision
+		Slot         int32    // firmware slot to update, 0 for the controller to choose
+		Stage        bool     // defer activation of the image until next controller reset
 	}
 
 	// NVMeDeviceFirmwareUpdateResult represents the result of a firmware update for
@@ -727,6 +898,31 @@ func getNumaNodeBusidRange(ctx context.Context, getTopology topologyGetter, numa
 	return lowAddr.Bus, highAddr.Bus, nil
 }
 
+// getDeviceListBusidRange returns the lowest and highest PCI bus-IDs of the addresses in the given
+// device list. False is returned if the list contains no PCI addresses.
+func getDeviceListBusidRange(devs *BdevDeviceList) (uint8, uint8, bool) {
+	if devs == nil {
+		return 0, 0, false
+	}
+
+	addrs := devs.PCIAddressSet.Addresses()
+	if len(addrs) == 0 {
+		return 0, 0, false
+	}
+
+	begin, end := addrs[0].Bus, addrs[0].Bus
+	for _, addr := range addrs[1:] {
+		if addr.Bus < begin {
+			begin = addr.Bus
+		}
+		if addr.Bus > end {
+			end = addr.Bus
+		}
+	}
+
+	return begin, end, true
+}
+
 // filterBdevScanResponse removes controllers that are not in the input list from the scan response.
 // As the response contains controller references which may be shared elsewhere, copy them to avoid
 // accessing the same references in multiple code paths and return a new BdevScanResponse objecst.
@@ -851,6 +1047,61 @@ func (f *BdevAdminForwarder) ReadConfig(req BdevReadConfigRequest) (*BdevReadCon
 	return res, nil
 }
 
+func (f *BdevAdminForwarder) AttachController(req BdevAttachRequest) (*BdevAttachResponse, error) {
+	req.Forwarded = true
+
+	res := new(BdevAttachResponse)
+	if err := f.SendReq("BdevAttachController", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *BdevAdminForwarder) DetachController(req BdevDetachRequest) (*BdevDetachResponse, error) {
+	req.Forwarded = true
+
+	res := new(BdevDetachResponse)
+	if err := f.SendReq("BdevDetachController", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *BdevAdminForwarder) InjectErrors(req BdevInjectErrorsRequest) (*BdevInjectErrorsResponse, error) {
+	req.Forwarded = true
+
+	res := new(BdevInjectErrorsResponse)
+	if err := f.SendReq("BdevInjectErrors", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *BdevAdminForwarder) CreateNamespaces(req BdevNamespaceCreateRequest) (*BdevNamespaceCreateResponse, error) {
+	req.Forwarded = true
+
+	res := new(BdevNamespaceCreateResponse)
+	if err := f.SendReq("BdevCreateNamespaces", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (f *BdevAdminForwarder) DeleteNamespace(req BdevNamespaceDeleteRequest) (*BdevNamespaceDeleteResponse, error) {
+	req.Forwarded = true
+
+	res := new(BdevNamespaceDeleteResponse)
+	if err := f.SendReq("BdevDeleteNamespace", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 const (
 	// NVMeFirmwareQueryMethod is the name of the method used to forward the request to
 	// update NVMe device firmware.
@@ -9,6 +9,7 @@
 package bdev
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"os/exec"
@@ -40,7 +41,7 @@ type (
 	spdkBackend struct {
 		log     logging.Logger
 		binding *spdkWrapper
-		script  *spdkSetupScript
+		binder  pciDeviceBinder
 	}
 
 	statFn      func(string) (os.FileInfo, error)
@@ -103,19 +104,19 @@ func (w *spdkWrapper) init(log logging.Logger, spdkOpts *spdk.EnvOptions) (resto
 	return restoreOutput, nil
 }
 
-func newBackend(log logging.Logger, sr *spdkSetupScript) *spdkBackend {
+func newBackend(log logging.Logger, binder pciDeviceBinder) *spdkBackend {
 	return &spdkBackend{
 		log: log,
 		binding: &spdkWrapper{
 			Env:  spdk.NewEnvImpl(),
 			Nvme: spdk.NewNvmeImpl(),
 		},
-		script: sr,
+		binder: binder,
 	}
 }
 
 func defaultBackend(log logging.Logger) *spdkBackend {
-	return newBackend(log, defaultScriptRunner(log))
+	return newBackend(log, defaultSysfsBinder(log))
 }
 
 // Returns true if PID file matching input string is found in /proc/ directory indicating related
@@ -320,16 +321,16 @@ func (sb *spdkBackend) prepare(req storage.BdevPrepareRequest, vmdDetect vmdDete
 		//
 		// Applies block (not allow) list if VMD is configured so specific NVMe devices can
 		// be reserved for other use (bdev_exclude).
-		if err := sb.script.Unbind(&req); err != nil {
+		if err := sb.binder.Unbind(&req); err != nil {
 			return resp, errors.Wrap(err, "un-binding devices")
 		}
 	} else {
-		if err := sb.script.Reset(&req); err != nil {
+		if err := sb.binder.Reset(&req); err != nil {
 			return resp, errors.Wrap(err, "resetting device bindings")
 		}
 	}
 
-	return resp, errors.Wrap(sb.script.Prepare(&req), "binding devices to userspace drivers")
+	return resp, errors.Wrap(sb.binder.Prepare(&req), "binding devices to userspace drivers")
 }
 
 // reset receives function pointers for external interfaces.
@@ -342,26 +343,26 @@ func (sb *spdkBackend) reset(req storage.BdevPrepareRequest, vmdDetect vmdDetect
 	}
 	resp.VMDPrepared = req.EnableVMD
 
-	return resp, errors.Wrap(sb.script.Reset(&req), "unbinding nvme devices from userspace drivers")
+	return resp, errors.Wrap(sb.binder.Reset(&req), "unbinding nvme devices from userspace drivers")
 }
 
 // Reset will perform a lookup on the requested target user to validate existence
 // then reset non-VMD NVMe devices for use by the OS/kernel.
 // If EnableVmd is true in request then attempt to use VMD NVMe devices.
-// Backend call executes the SPDK setup.sh script to rebind PCI devices as selected by
+// Backend call rebinds PCI devices through sysfs as selected by
 // devs specified in bdev_list and bdev_exclude provided in the server config file.
 func (sb *spdkBackend) Reset(req storage.BdevPrepareRequest) (*storage.BdevPrepareResponse, error) {
-	sb.log.Debugf("spdk backend reset (script call): %+v", req)
+	sb.log.Debugf("spdk backend reset (binder call): %+v", req)
 	return sb.reset(req, DetectVMD)
 }
 
 // Prepare will perform a lookup on the requested target user to validate existence
 // then prepare non-VMD NVMe devices for use with SPDK.
 // If EnableVmd is true in request then attempt to use VMD NVMe devices.
-// Backend call executes the SPDK setup.sh script to rebind PCI devices as selected by
+// Backend call rebinds PCI devices through sysfs as selected by
 // devs specified in bdev_list and bdev_exclude provided in the server config file.
 func (sb *spdkBackend) Prepare(req storage.BdevPrepareRequest) (*storage.BdevPrepareResponse, error) {
-	sb.log.Debugf("spdk backend prepare (script call): %+v", req)
+	sb.log.Debugf("spdk backend prepare (binder call): %+v", req)
 	return sb.prepare(req, DetectVMD, cleanHugepages)
 }
 
@@ -560,6 +561,9 @@ func (sb *spdkBackend) formatAioFile(req *storage.BdevFormatRequest) (*storage.B
 }
 
 // TODO DAOS-6039: implement kdev fs format
+//
+// NVMe-oF targets are formatted on the remote side so are handled in the same way, as are null and
+// malloc bdevs which are created empty in memory by the engine.
 func (sb *spdkBackend) formatKdev(req *storage.BdevFormatRequest) (*storage.BdevFormatResponse, error) {
 	resp := &storage.BdevFormatResponse{
 		DeviceResponses: make(storage.BdevDeviceFormatResponses),
@@ -567,7 +571,8 @@ func (sb *spdkBackend) formatKdev(req *storage.BdevFormatRequest) (*storage.Bdev
 
 	for _, device := range req.Properties.DeviceList.Devices() {
 		resp.DeviceResponses[device] = new(storage.BdevDeviceFormatResponse)
-		sb.log.Debugf("%s format for non-NVMe bdev skipped on %s", req.Properties.Class, device)
+		sb.log.Debugf("%s format for non-local-NVMe bdev skipped on %s",
+			req.Properties.Class, device)
 	}
 
 	return resp, nil
@@ -581,6 +586,16 @@ func (sb *spdkBackend) formatNvme(req *storage.BdevFormatRequest) (*storage.Bdev
 		return &storage.BdevFormatResponse{}, nil
 	}
 
+	if req.Properties.Class == storage.ClassNvmeCache {
+		// Stale cache metadata must not be loaded so format the cache device as well.
+		devs, err := hardware.NewPCIAddressSet(append(needDevs.Strings(),
+			req.Properties.Cache.Device)...)
+		if err != nil {
+			return nil, errors.Wrap(err, "bdev cache device")
+		}
+		needDevs = devs
+	}
+
 	if req.VMDEnabled {
 		sb.log.Debug("vmd support enabled during nvme format")
 		dl, err := substituteVMDAddresses(sb.log, needDevs, req.ScannedBdevs)
@@ -621,38 +636,89 @@ func (sb *spdkBackend) Format(req storage.BdevFormatRequest) (resp *storage.Bdev
 	switch req.Properties.Class {
 	case storage.ClassFile:
 		return sb.formatAioFile(&req)
-	case storage.ClassKdev:
+	case storage.ClassKdev, storage.ClassNvmeFabrics, storage.ClassNull, storage.ClassMalloc:
 		return sb.formatKdev(&req)
-	case storage.ClassNvme:
+	case storage.ClassNvme, storage.ClassNvmeRaid, storage.ClassDelay, storage.ClassError,
+		storage.ClassNvmeCache:
 		return sb.formatNvme(&req)
 	default:
 		return nil, FaultFormatUnknownClass(req.Properties.Class.String())
 	}
 }
 
-func (sb *spdkBackend) writeNvmeConfig(req storage.BdevWriteConfigRequest, confWriter writeConfFn) error {
-	sb.log.Debugf("spdk backend write config (system calls): %+v", req)
+// substituteTierVMDAddresses replaces addresses in bdev tier's DeviceLists with those of the
+// backing devices if VMD is in use.
+func substituteTierVMDAddresses(log logging.Logger, req *storage.BdevWriteConfigRequest) error {
+	if !req.VMDEnabled {
+		return nil
+	}
 
-	// Substitute addresses in bdev tier's DeviceLists if VMD is in use.
-	if req.VMDEnabled {
-		sb.log.Debug("vmd support enabled during nvme config write")
-		tps := make([]storage.BdevTierProperties, 0, len(req.TierProps))
-		copy(req.TierProps, tps)
-		for _, props := range req.TierProps {
-			if props.Class != storage.ClassNvme {
-				continue
-			}
+	log.Debug("vmd support enabled during nvme config write")
+	tps := make([]storage.BdevTierProperties, 0, len(req.TierProps))
+	for _, props := range req.TierProps {
+		if !props.Class.IsLocalNVMe() {
+			tps = append(tps, props)
+			continue
+		}
 
-			bdevs := &props.DeviceList.PCIAddressSet
+		bdevs := &props.DeviceList.PCIAddressSet
 
-			dl, err := substituteVMDAddresses(sb.log, bdevs, req.ScannedBdevs)
-			if err != nil {
-				return errors.Wrapf(err, "storage tier %d", props.Tier)
+		dl, err := substituteVMDAddresses(log, bdevs, req.ScannedBdevs)
+		if err != nil {
+			return errors.Wrapf(err, "storage tier %d", props.Tier)
+		}
+		props.DeviceList = &storage.BdevDeviceList{PCIAddressSet: *dl}
+		tps = append(tps, props)
+	}
+	req.TierProps = tps
+
+	return nil
+}
+
+// checkTierZonedNamespaces verifies that scanned NVMe controllers assigned to bdev tiers only have
+// zoned namespaces (ZNS) if they have been explicitly enabled on the tier. SPDK exposes zoned
+// namespaces as zoned bdevs that the engine would otherwise fail to use at start-up.
+func (sb *spdkBackend) checkTierZonedNamespaces(req *storage.BdevWriteConfigRequest) error {
+	ctrlrs := make(map[string]*storage.NvmeController)
+	for _, c := range req.ScannedBdevs {
+		ctrlrs[c.PciAddr] = c
+	}
+
+	for _, props := range req.TierProps {
+		if props.Class != storage.ClassNvme || props.DeviceList == nil {
+			continue
+		}
+
+		for _, addr := range props.DeviceList.Devices() {
+			c, exists := ctrlrs[addr]
+			if !exists || !c.HasZonedNamespace() {
+				continue
+			}
+			for _, ns := range c.Namespaces {
+				if !ns.Zoned {
+					continue
+				}
+				if !props.DeviceZoned {
+					return FaultZonedNamespace(addr, ns.ID)
+				}
+				sb.log.Noticef("zoned namespace %d on NVMe controller %s enabled in "+
+					"storage tier %d", ns.ID, addr, props.Tier)
 			}
-			props.DeviceList = &storage.BdevDeviceList{PCIAddressSet: *dl}
-			tps = append(tps, props)
 		}
-		req.TierProps = tps
+	}
+
+	return nil
+}
+
+func (sb *spdkBackend) writeNvmeConfig(req storage.BdevWriteConfigRequest, confWriter writeConfFn) error {
+	sb.log.Debugf("spdk backend write config (system calls): %+v", req)
+
+	if err := substituteTierVMDAddresses(sb.log, &req); err != nil {
+		return err
+	}
+
+	if err := sb.checkTierZonedNamespaces(&req); err != nil {
+		return err
 	}
 
 	return errors.Wrap(confWriter(sb.log, &req), "write spdk nvme config")
@@ -663,44 +729,190 @@ func (sb *spdkBackend) WriteConfig(req storage.BdevWriteConfigRequest) (*storage
 	return &storage.BdevWriteConfigResponse{}, sb.writeNvmeConfig(req, writeJsonConfig)
 }
 
-// ReadConfig reads the SPDK configuration file.
-// NB: Currently returns an empty response struct if the file is read
-// and parsed successfully, but may be extended to return the
-// parsed configuration.
+// AttachController attaches an NVMe controller to a running engine through the engine's SPDK
+// JSON-RPC server.
+func (sb *spdkBackend) AttachController(req storage.BdevAttachRequest) (*storage.BdevAttachResponse, error) {
+	sb.log.Debugf("spdk backend attach controller (json-rpc): %+v", req)
+
+	bdevs, err := newSpdkRpcClient(req.SockAddr).attachController(req.DeviceName, req.PciAddr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "attach controller %s", req.PciAddr)
+	}
+
+	return &storage.BdevAttachResponse{Bdevs: bdevs}, nil
+}
+
+// DetachController detaches an NVMe controller from a running engine through the engine's SPDK
+// JSON-RPC server.
+func (sb *spdkBackend) DetachController(req storage.BdevDetachRequest) (*storage.BdevDetachResponse, error) {
+	sb.log.Debugf("spdk backend detach controller (json-rpc): %+v", req)
+
+	if err := newSpdkRpcClient(req.SockAddr).detachController(req.DeviceName); err != nil {
+		return nil, errors.Wrapf(err, "detach controller %s", req.DeviceName)
+	}
+
+	return &storage.BdevDetachResponse{}, nil
+}
+
+// InjectErrors injects the I/O errors recorded in the DAOS data section of an engine's SPDK config
+// file into the error bdevs of the running engine through the engine's SPDK JSON-RPC server.
+// Errors can only be injected once the error bdevs have been created by SPDK on engine start.
+func (sb *spdkBackend) InjectErrors(req storage.BdevInjectErrorsRequest) (*storage.BdevInjectErrorsResponse, error) {
+	sb.log.Debugf("spdk backend inject errors (json-rpc): %+v", req)
+
+	f, err := os.Open(req.ConfigPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open SPDK config at %q", req.ConfigPath)
+	}
+	defer f.Close()
+
+	cfg, err := readSpdkConfig(f)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &storage.BdevInjectErrorsResponse{}
+	if cfg.DaosData == nil {
+		return resp, nil
+	}
+
+	client := newSpdkRpcClient(req.SockAddr)
+	for _, dc := range cfg.DaosData.Configs {
+		params, ok := dc.Params.(*ErrorInjectParams)
+		if !ok {
+			continue
+		}
+		if err := client.injectError(params); err != nil {
+			return nil, errors.Wrapf(err, "inject errors into bdev %s", params.DeviceName)
+		}
+		resp.Bdevs = append(resp.Bdevs, params.DeviceName)
+	}
+
+	return resp, nil
+}
+
+// ReadConfig reads and parses the SPDK configuration file and verifies it against the checksum
+// recorded when it was generated. If an expected config is provided in the request, the parsed
+// file is compared against the config that would be generated from it and any differences are
+// returned in the response.
 func (sb *spdkBackend) ReadConfig(req storage.BdevReadConfigRequest) (*storage.BdevReadConfigResponse, error) {
 	if req.ConfigPath == "" {
 		return nil, errors.New("empty SPDK config path")
 	}
 
-	r, err := os.Open(req.ConfigPath)
+	content, err := os.ReadFile(req.ConfigPath)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open SPDK config at %q", req.ConfigPath)
 	}
-	defer r.Close()
 
-	_, err = readSpdkConfig(r)
+	gotCfg, err := readSpdkConfig(bytes.NewReader(content))
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: Reconstruct the WriteConfig request params? At the moment, all we care about is
-	// that we can read and parse the config file, but in the future it might be useful to
-	// be able to inspect its contents.
 	resp := &storage.BdevReadConfigResponse{}
+	sum, err := readConfigChecksum(req.ConfigPath)
+	switch {
+	case os.IsNotExist(err):
+		sb.log.Debugf("no checksum recorded for SPDK config at %q", req.ConfigPath)
+		resp.ChecksumMissing = true
+	case err != nil:
+		return nil, err
+	default:
+		resp.ConfigModified = sum.Config != sha256Hex(content)
+	}
+
+	if req.ExpectedConfig == nil {
+		return resp, nil
+	}
+
+	expReq := *req.ExpectedConfig
+	if err := substituteTierVMDAddresses(sb.log, &expReq); err != nil {
+		return nil, errors.Wrap(err, "generate expected spdk config")
+	}
+	if sum != nil {
+		inputs, err := configInputsChecksum(&expReq)
+		if err != nil {
+			return nil, err
+		}
+		resp.InputsChanged = sum.Inputs != inputs
+	}
+	expCfg, err := newSpdkConfig(sb.log, &expReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "generate expected spdk config")
+	}
+
+	resp.Differences, err = diffSpdkConfigs(expCfg, gotCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "compare spdk configs")
+	}
+
 	return resp, nil
 }
 
 // UpdateFirmware uses the SPDK bindings to update an NVMe controller's firmware.
-func (sb *spdkBackend) UpdateFirmware(pciAddr string, path string, slot int32) error {
+func (sb *spdkBackend) UpdateFirmware(pciAddr string, path string, slot int32, stage bool) error {
 	sb.log.Debug("spdk backend update firmware")
 
 	if pciAddr == "" {
 		return FaultBadPCIAddr("")
 	}
 
-	if err := sb.binding.Update(sb.log, pciAddr, path, slot); err != nil {
+	if err := sb.binding.Update(sb.log, pciAddr, path, slot, stage); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// initNamespaceMgmt initializes the SPDK environment so that only the NVMe controller whose
+// namespaces are to be managed is accessible.
+func (sb *spdkBackend) initNamespaceMgmt(pciAddr string) (restoreFn, error) {
+	devs, err := hardware.NewPCIAddressSet(pciAddr)
+	if err != nil || devs.Len() != 1 {
+		return nil, FaultBadPCIAddr(pciAddr)
+	}
+
+	// The controller should not be in use by another process, remove any stale lockfile
+	// otherwise SPDK will refuse access.
+	sb.cleanLockfilesQuiet(devs)
+
+	return sb.binding.init(sb.log, &spdk.EnvOptions{
+		PCIAllowList: devs,
+	})
+}
+
+// CreateNamespaces uses the SPDK bindings to create namespaces on an NVMe controller.
+func (sb *spdkBackend) CreateNamespaces(req storage.BdevNamespaceCreateRequest) (*storage.BdevNamespaceCreateResponse, error) {
+	sb.log.Debugf("spdk backend create namespaces (bindings call): %+v", req)
+
+	restoreAfterInit, err := sb.initNamespaceMgmt(req.PciAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer restoreAfterInit()
+
+	nss, err := sb.binding.CreateNamespaces(sb.log, req.PciAddr, req.Size, req.Count)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create namespaces on %s", req.PciAddr)
+	}
+
+	return &storage.BdevNamespaceCreateResponse{Namespaces: nss}, nil
+}
+
+// DeleteNamespace uses the SPDK bindings to delete a namespace on an NVMe controller.
+func (sb *spdkBackend) DeleteNamespace(req storage.BdevNamespaceDeleteRequest) (*storage.BdevNamespaceDeleteResponse, error) {
+	sb.log.Debugf("spdk backend delete namespace (bindings call): %+v", req)
+
+	restoreAfterInit, err := sb.initNamespaceMgmt(req.PciAddr)
+	if err != nil {
+		return nil, err
+	}
+	defer restoreAfterInit()
+
+	if err := sb.binding.DeleteNamespace(sb.log, req.PciAddr, req.NsID); err != nil {
+		return nil, errors.Wrapf(err, "delete namespace %d on %s", req.NsID, req.PciAddr)
+	}
+
+	return &storage.BdevNamespaceDeleteResponse{}, nil
+}
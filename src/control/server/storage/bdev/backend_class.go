@@ -9,7 +9,10 @@ package bdev
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -19,37 +22,77 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/pbin"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 const (
-	aioBlockSize       = humanize.KiByte * 4 // device block size hardcoded to 4096 bytes
-	defaultAioFileMode = 0600                // AIO file permissions set to owner +rw
+	aioBlockSize         = humanize.KiByte * 4 // default device block size of 4096 bytes
+	defaultAioFileMode   = 0600                // AIO file permissions set to owner +rw
+	defaultAioDirMode    = 0755                // AIO file parent directory permissions
+	cryptoConfigFileMode = 0600                // config with crypto keys set to owner +rw
+
+	// configChecksumSuffix is appended to the SPDK config file path to give the path of the
+	// file recording checksums of the config and of the inputs it was generated from.
+	configChecksumSuffix = ".sha256"
 )
 
-func createEmptyFile(log logging.Logger, path string, size uint64) error {
+// aioFileBlockSize returns the block size of the AIO bdev backed by the file at the given path.
+func aioFileBlockSize(props storage.BdevTierProperties, path string) uint64 {
+	if size := props.BlockSize.ForDevice(path); size != 0 {
+		return uint64(size)
+	}
+
+	return aioBlockSize
+}
+
+func createEmptyFile(log logging.Logger, path string, size, blockSize uint64, sparse bool) error {
 	if !filepath.IsAbs(path) {
 		return errors.Errorf("expected absolute file path but got relative (%s)", path)
 	}
 	if size == 0 {
 		return errors.New("expected non-zero file size")
 	}
+	if blockSize == 0 {
+		return errors.New("expected non-zero block size")
+	}
 
 	if _, err := os.Stat(path); err != nil && !os.IsNotExist(err) {
 		return errors.Wrapf(err, "stat %q", path)
 	}
 
+	if err := os.MkdirAll(filepath.Dir(path), defaultAioDirMode); err != nil {
+		return errors.Wrapf(err, "create parent directory of %q", path)
+	}
+
 	// adjust file size to align with block size
-	size = (size / aioBlockSize) * aioBlockSize
+	size = (size / blockSize) * blockSize
 
-	log.Debugf("allocating blank file %s of size %s", path, humanize.IBytes(size))
 	file, err := common.TruncFile(path)
 	if err != nil {
 		return errors.Wrapf(err, "open %q for truncate", path)
 	}
 	defer file.Close()
 
+	if sparse {
+		log.Debugf("creating sparse file %s of size %s", path, humanize.IBytes(size))
+
+		return errors.Wrapf(file.Truncate(int64(size)), "truncate %q", path)
+	}
+
+	// check available space after any previous file contents have been discarded
+	var st syscall.Statfs_t
+	if err := syscall.Fstatfs(int(file.Fd()), &st); err != nil {
+		return errors.Wrapf(err, "statfs %q", path)
+	}
+	avail := st.Bavail * uint64(st.Bsize)
+	if avail < size {
+		return FaultFormatNoSpace(path, size, avail)
+	}
+
+	log.Debugf("allocating blank file %s of size %s", path, humanize.IBytes(size))
 	if err := syscall.Fallocate(int(file.Fd()), 0, 0, int64(size)); err != nil {
 		e, ok := err.(syscall.Errno)
 		if ok && (e == syscall.ENOSYS || e == syscall.EOPNOTSUPP) {
@@ -57,6 +100,9 @@ func createEmptyFile(log logging.Logger, path string, size uint64) error {
 
 			return errors.Wrapf(file.Truncate(int64(size)), "truncate %q", path)
 		}
+		if ok && e == syscall.ENOSPC {
+			return FaultFormatNoSpace(path, size, avail)
+		}
 
 		return errors.Wrapf(err, "fallocate %q", path)
 	}
@@ -72,7 +118,12 @@ func createAioFile(log logging.Logger, path string, req *storage.BdevFormatReque
 		}
 	}()
 
-	if err := createEmptyFile(log, path, req.Properties.DeviceFileSize); err != nil {
+	if err := createEmptyFile(log, path, req.Properties.DeviceFileSize,
+		aioFileBlockSize(req.Properties, path), req.Properties.DeviceFileSparse); err != nil {
+		if f, ok := err.(*fault.Fault); ok {
+			devResp.Error = f
+			return
+		}
 		devResp.Error = FaultFormatError(path, err)
 		return
 	}
@@ -112,9 +163,21 @@ func writeConfigFile(log logging.Logger, buf *bytes.Buffer, req *storage.BdevWri
 		return errors.Wrap(err, "write")
 	}
 
-	return errors.Wrapf(os.Chown(req.ConfigOutputPath, req.OwnerUID, req.OwnerGID),
-		"failed to set ownership of %q to %d.%d", req.ConfigOutputPath,
-		req.OwnerUID, req.OwnerGID)
+	if err := os.Chown(req.ConfigOutputPath, req.OwnerUID, req.OwnerGID); err != nil {
+		return errors.Wrapf(err, "failed to set ownership of %q to %d.%d",
+			req.ConfigOutputPath, req.OwnerUID, req.OwnerGID)
+	}
+
+	// Restrict access to the file if it contains crypto key material.
+	for _, props := range req.TierProps {
+		if props.Crypto.Enabled {
+			return errors.Wrapf(os.Chmod(req.ConfigOutputPath, cryptoConfigFileMode),
+				"failed to set file mode of %q to %s", req.ConfigOutputPath,
+				os.FileMode(cryptoConfigFileMode))
+		}
+	}
+
+	return nil
 }
 
 // writeJsonConfig generates nvme config file for given bdev type to be consumed
@@ -133,7 +196,7 @@ func writeJsonConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) er
 	}
 	hasBdevs := false
 	for _, tierProp := range req.TierProps {
-		if tierProp.Class != storage.ClassNvme || tierProp.DeviceList.Len() > 0 {
+		if !tierProp.Class.IsLocalNVMe() || tierProp.DeviceList.Len() > 0 {
 			hasBdevs = true
 			break
 		}
@@ -143,21 +206,120 @@ func writeJsonConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) er
 		return nil
 	}
 
-	nsc, err := newSpdkConfig(log, req)
+	buf, err := renderJsonConfig(log, req, false)
 	if err != nil {
 		return err
 	}
 
-	buf, err := json.MarshalIndent(nsc, "", "  ")
-	if err != nil {
+	if err := writeConfigFile(log, bytes.NewBuffer(buf), req); err != nil {
 		return err
 	}
 
-	if err := writeConfigFile(log, bytes.NewBuffer(buf), req); err != nil {
+	return writeConfigChecksum(buf, req)
+}
+
+// configChecksum records SHA-256 checksums of a generated SPDK config file and of the request
+// inputs that it was generated from.
+type configChecksum struct {
+	Config string `json:"config"`
+	Inputs string `json:"inputs"`
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// configInputsChecksum returns a checksum of the fields of the request that determine the
+// content of the generated SPDK config.
+func configInputsChecksum(req *storage.BdevWriteConfigRequest) (string, error) {
+	inputs := *req
+	inputs.ForwardableRequest = pbin.ForwardableRequest{}
+	inputs.ConfigOutputPath = ""
+	inputs.OwnerUID = 0
+	inputs.OwnerGID = 0
+	inputs.ScannedBdevs = nil
+	inputs.ExcludedBdevs = nil
+
+	data, err := json.Marshal(&inputs)
+	if err != nil {
+		return "", errors.Wrap(err, "encode spdk config inputs")
+	}
+
+	return sha256Hex(data), nil
+}
+
+// writeConfigChecksum records checksums of the SPDK config file content and of the request it
+// was generated from so that changes can be detected when the config is next read.
+func writeConfigChecksum(content []byte, req *storage.BdevWriteConfigRequest) error {
+	inputs, err := configInputsChecksum(req)
+	if err != nil {
 		return err
 	}
 
-	return nil
+	data, err := json.Marshal(&configChecksum{
+		Config: sha256Hex(content),
+		Inputs: inputs,
+	})
+	if err != nil {
+		return errors.Wrap(err, "encode spdk config checksum")
+	}
+
+	path := req.ConfigOutputPath + configChecksumSuffix
+	if err := os.WriteFile(path, data, cryptoConfigFileMode); err != nil {
+		return errors.Wrapf(err, "write %q", path)
+	}
+
+	return errors.Wrapf(os.Chown(path, req.OwnerUID, req.OwnerGID),
+		"failed to set ownership of %q to %d.%d", path, req.OwnerUID, req.OwnerGID)
+}
+
+// readConfigChecksum reads the checksums recorded for the SPDK config file at the given path.
+func readConfigChecksum(cfgPath string) (*configChecksum, error) {
+	data, err := os.ReadFile(cfgPath + configChecksumSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := new(configChecksum)
+	if err := json.Unmarshal(data, sum); err != nil {
+		return nil, errors.Wrapf(err, "decode spdk config checksum for %q", cfgPath)
+	}
+
+	return sum, nil
+}
+
+func renderJsonConfig(log logging.Logger, req *storage.BdevWriteConfigRequest, redact bool) ([]byte, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	nsc, err := newSpdkConfig(log, req)
+	if err != nil {
+		return nil, err
+	}
+	if redact {
+		nsc = nsc.redacted()
+	}
+
+	return json.MarshalIndent(nsc, "", "  ")
+}
+
+// RenderJsonConfig generates the SPDK JSON config for the given request and returns the
+// encoded content without writing anything to disk. As when the config is written, VMD endpoint
+// addresses are replaced with those of the backing devices in the request's scanned controllers.
+// Crypto key material is redacted.
+func RenderJsonConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) ([]byte, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	r := *req
+	if err := substituteTierVMDAddresses(log, &r); err != nil {
+		return nil, err
+	}
+
+	return renderJsonConfig(log, &r, true)
 }
 
 func strictJsonUnmarshal(r io.Reader, v any) error {
@@ -179,3 +341,103 @@ func readSpdkConfig(r io.Reader) (*SpdkConfig, error) {
 
 	return cfg, nil
 }
+
+// spdkConfigEntry is a config method encoded together with its parameters. The display form has
+// any secrets in the parameters redacted.
+type spdkConfigEntry struct {
+	value   string
+	display string
+}
+
+// spdkConfigEntries returns the methods of each section of an SpdkConfig, encoded together with
+// their parameters and keyed by section name.
+func spdkConfigEntries(sc *SpdkConfig) (map[string][]spdkConfigEntry, error) {
+	entries := make(map[string][]spdkConfigEntry)
+	if sc == nil {
+		return entries, nil
+	}
+
+	encode := func(method string, params any) (spdkConfigEntry, error) {
+		var entry spdkConfigEntry
+		buf, err := json.Marshal(params)
+		if err != nil {
+			return entry, errors.Wrapf(err, "encode %q params", method)
+		}
+		entry.value = fmt.Sprintf("%s %s", method, buf)
+		entry.display = entry.value
+
+		if r, ok := params.(redactor); ok {
+			if buf, err = json.Marshal(r.redacted()); err != nil {
+				return entry, errors.Wrapf(err, "encode %q params", method)
+			}
+			entry.display = fmt.Sprintf("%s %s", method, buf)
+		}
+
+		return entry, nil
+	}
+
+	if sc.DaosData != nil {
+		for _, dc := range sc.DaosData.Configs {
+			entry, err := encode(dc.Method, dc.Params)
+			if err != nil {
+				return nil, err
+			}
+			entries["daos_data"] = append(entries["daos_data"], entry)
+		}
+	}
+	for _, ss := range sc.Subsystems {
+		for _, ssc := range ss.Configs {
+			entry, err := encode(ssc.Method, ssc.Params)
+			if err != nil {
+				return nil, err
+			}
+			entries[ss.Name] = append(entries[ss.Name], entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// diffSpdkConfigs compares two SpdkConfigs and returns a description of each method that is
+// missing from or unexpected in the got config. Method order within a section is ignored.
+func diffSpdkConfigs(want, got *SpdkConfig) ([]string, error) {
+	wantEntries, err := spdkConfigEntries(want)
+	if err != nil {
+		return nil, err
+	}
+	gotEntries, err := spdkConfigEntries(got)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := common.NewStringSet()
+	for name := range wantEntries {
+		sections.Add(name)
+	}
+	for name := range gotEntries {
+		sections.Add(name)
+	}
+
+	var diffs []string
+	for _, name := range sections.ToSlice() {
+		remaining := make(map[string]int)
+		for _, entry := range gotEntries[name] {
+			remaining[entry.value]++
+		}
+		for _, entry := range wantEntries[name] {
+			if remaining[entry.value] > 0 {
+				remaining[entry.value]--
+				continue
+			}
+			diffs = append(diffs, fmt.Sprintf("%s: missing %s", name, entry.display))
+		}
+		for _, entry := range gotEntries[name] {
+			if remaining[entry.value] > 0 {
+				remaining[entry.value]--
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected %s", name, entry.display))
+			}
+		}
+	}
+
+	return diffs, nil
+}
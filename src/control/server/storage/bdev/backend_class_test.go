@@ -8,8 +8,12 @@
 package bdev
 
 import (
+	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"syscall"
 	"testing"
 
 	"github.com/dustin/go-humanize"
@@ -28,6 +32,9 @@ func TestBackend_createAioFile(t *testing.T) {
 		path          string
 		pathImmutable bool // avoid adjusting path in test if set
 		size          uint64
+		blockSize     uint32
+		sparse        bool
+		parentIsFile  bool // create a regular file in place of the parent directory
 		expErr        error
 	}{
 		"relative path": {
@@ -39,15 +46,35 @@ func TestBackend_createAioFile(t *testing.T) {
 			size:   0,
 			expErr: errors.New("zero"),
 		},
-		"non-existent path": {
-			path:   "/timbuk/tu",
-			size:   humanize.MiByte,
-			expErr: errors.New("no such file or directory"),
+		"non-existent parent directory": {
+			path: "/timbuk/tu",
+			size: humanize.MiByte,
+		},
+		"parent path is not a directory": {
+			path:         "/timbuk/tu",
+			size:         humanize.MiByte,
+			parentIsFile: true,
+			expErr:       errors.New("not a directory"),
+		},
+		"insufficient space": {
+			path:   "/outfile",
+			size:   humanize.EiByte,
+			expErr: errors.New("insufficient space"),
 		},
 		"successful create": {
 			path: "/outfile",
 			size: humanize.MiByte,
 		},
+		"successful create; sparse": {
+			path:   "/outfile",
+			size:   humanize.GiByte,
+			sparse: true,
+		},
+		"successful create; size aligned to block size override": {
+			path:      "/outfile",
+			size:      humanize.MiByte + humanize.KiByte*12,
+			blockSize: humanize.KiByte * 8,
+		},
 	}
 
 	for name, tc := range tests {
@@ -61,12 +88,19 @@ func TestBackend_createAioFile(t *testing.T) {
 			if !tc.pathImmutable {
 				tc.path = filepath.Join(testDir, tc.path)
 			}
+			if tc.parentIsFile {
+				if err := os.WriteFile(filepath.Dir(tc.path), nil, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			req := &storage.BdevFormatRequest{
 				OwnerUID: os.Getuid(),
 				OwnerGID: os.Getgid(),
 				Properties: storage.BdevTierProperties{
-					DeviceFileSize: tc.size,
+					DeviceFileSize:   tc.size,
+					DeviceFileSparse: tc.sparse,
+					BlockSize:        storage.BdevBlockSize{Size: tc.blockSize},
 				},
 			}
 
@@ -86,7 +120,11 @@ func TestBackend_createAioFile(t *testing.T) {
 				t.Fatal("expected nil error in response")
 			}
 
-			expSize := (tc.size / aioBlockSize) * aioBlockSize
+			blockSize := uint64(aioBlockSize)
+			if tc.blockSize != 0 {
+				blockSize = uint64(tc.blockSize)
+			}
+			expSize := (tc.size / blockSize) * blockSize
 
 			st, err := os.Stat(tc.path)
 			if err != nil {
@@ -97,6 +135,13 @@ func TestBackend_createAioFile(t *testing.T) {
 				t.Fatalf("expected %s size to be %d, but got %d",
 					tc.path, expSize, gotSize)
 			}
+
+			// A sparse file should have no blocks allocated to it.
+			gotBlocks := st.Sys().(*syscall.Stat_t).Blocks
+			if tc.sparse && gotBlocks != 0 {
+				t.Fatalf("expected sparse %s to have no allocated blocks, but got %d",
+					tc.path, gotBlocks)
+			}
 		})
 	}
 }
@@ -137,7 +182,7 @@ func TestBackend_writeJSONFile(t *testing.T) {
 		expCfg    *SpdkConfig
 		expErr    error
 	}{
-		"nvme; single ssds; hotplug enabled": {
+		"nvme; single ssds; hotplug enabled; range derived from bdev_list": {
 			confIn: engine.MockConfig().WithStorage(&storage.TierConfig{
 				Tier:  tierID,
 				Class: storage.ClassNvme,
@@ -150,8 +195,8 @@ func TestBackend_writeJSONFile(t *testing.T) {
 					{
 						Method: "hotplug_busid_range",
 						Params: &HotplugBusidRangeParams{
-							Begin: 0,
-							End:   7,
+							Begin: 1,
+							End:   1,
 						},
 					},
 				}
@@ -210,6 +255,48 @@ func TestBackend_writeJSONFile(t *testing.T) {
 				return in
 			}),
 		},
+		"nvme; md-on-ssd; wal, meta and data roles on separate tiers": {
+			confIn: engine.MockConfig().WithStorage(
+				storage.NewTierConfig().
+					WithTier(1).
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(test.MockPCIAddr(1)).
+					WithBdevDeviceRoles(storage.BdevRoleWAL),
+				storage.NewTierConfig().
+					WithTier(2).
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(test.MockPCIAddr(2)).
+					WithBdevDeviceRoles(storage.BdevRoleMeta),
+				storage.NewTierConfig().
+					WithTier(3).
+					WithStorageClass(storage.ClassNvme.String()).
+					WithBdevDeviceList(test.MockPCIAddrs(3, 4)...).
+					WithBdevDeviceRoles(storage.BdevRoleData),
+			),
+			expCfg: genSpdkCfg(defaultSpdkConfig(), func(in *SpdkConfig) *SpdkConfig {
+				attach := func(name string, addrIdx int) *SpdkSubsystemConfig {
+					return &SpdkSubsystemConfig{
+						Method: "bdev_nvme_attach_controller",
+						Params: &NvmeAttachControllerParams{
+							TransportType:    "PCIe",
+							DeviceName:       name,
+							TransportAddress: test.MockPCIAddr(int32(addrIdx)),
+						},
+					}
+				}
+				in.Subsystems[0].Configs = append(in.Subsystems[0].Configs,
+					attach(fmt.Sprintf("Nvme_testHost_0_1_%d", storage.BdevRoleWAL), 1),
+					attach(fmt.Sprintf("Nvme_testHost_0_2_%d", storage.BdevRoleMeta), 2),
+					attach(fmt.Sprintf("Nvme_testHost_0_3_%d", storage.BdevRoleData), 3),
+					attach(fmt.Sprintf("Nvme_testHost_1_3_%d", storage.BdevRoleData), 4),
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_set_hotplug",
+						Params: &NvmeSetHotplugParams{},
+					},
+				)
+				return in
+			}),
+		},
 		"nvme; multiple ssds; vmd enabled; bus-id range": {
 			confIn: engine.MockConfig().WithStorage(&storage.TierConfig{
 				Tier:  tierID,
@@ -432,6 +519,33 @@ func TestBackend_writeJSONFile(t *testing.T) {
 				return in
 			}),
 		},
+		"nvme; single controller; rpc srv set with socket ownership and mode": {
+			confIn: engine.MockConfig().WithStorage(&storage.TierConfig{
+				Tier:  tierID,
+				Class: storage.ClassNvme,
+				Bdev: storage.BdevConfig{
+					DeviceList: storage.MustNewBdevDeviceList(test.MockPCIAddrs(1)...),
+				},
+			}).
+				WithStorageSpdkRpcSrvProps(true, "/tmp/spdk.sock").
+				WithStorageSpdkRpcSockPerms("0", "0", 0660),
+			expCfg: genSpdkCfg(defaultTestCfg(), func(in *SpdkConfig) *SpdkConfig {
+				rootID := uint32(0)
+				in.DaosData.Configs = []*DaosConfig{
+					{
+						Method: "spdk_rpc_srv",
+						Params: &SpdkRpcServerParams{
+							Enable:   true,
+							SockAddr: "/tmp/spdk.sock",
+							SockMode: 0660,
+							SockUID:  &rootID,
+							SockGID:  &rootID,
+						},
+					},
+				}
+				return in
+			}),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -445,7 +559,7 @@ func TestBackend_writeJSONFile(t *testing.T) {
 
 			req, err := storage.BdevWriteConfigRequestFromConfig(test.Context(t), log,
 				&(tc.confIn.WithStorageConfigOutputPath(cfgOutputPath)).Storage,
-				tc.enableVmd, storage.MockGetTopology)
+				tc.enableVmd, storage.MockGetTopology, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -472,3 +586,244 @@ func TestBackend_writeJSONFile(t *testing.T) {
 		})
 	}
 }
+
+func TestBackend_RenderJsonConfig(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *storage.BdevWriteConfigRequest
+		expCfg *SpdkConfig
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *storage.BdevWriteConfigRequest request"),
+		},
+		"nvme; single controller": {
+			req: &storage.BdevWriteConfigRequest{
+				Hostname:         "testHost",
+				ConfigOutputPath: "/nonexistent/daos_nvme.conf",
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:      storage.ClassNvme,
+						DeviceList: storage.MustNewBdevDeviceList(test.MockPCIAddrs(1)...),
+						Tier:       1,
+					},
+				},
+			},
+			expCfg: func() *SpdkConfig {
+				sc := defaultSpdkConfig()
+				sc.Subsystems[0].Configs = append(sc.Subsystems[0].Configs,
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_attach_controller",
+						Params: &NvmeAttachControllerParams{
+							TransportType:    "PCIe",
+							DeviceName:       "Nvme_testHost_0_1_0",
+							TransportAddress: "0000:01:00.0",
+						},
+					},
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_set_hotplug",
+						Params: &NvmeSetHotplugParams{},
+					},
+				)
+				return sc
+			}(),
+		},
+		"nvme; vmd enabled; backing device addresses substituted": {
+			req: &storage.BdevWriteConfigRequest{
+				Hostname:         "testHost",
+				ConfigOutputPath: "/nonexistent/daos_nvme.conf",
+				VMDEnabled:       true,
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:      storage.ClassNvme,
+						DeviceList: storage.MustNewBdevDeviceList(vmdAddr),
+						Tier:       1,
+					},
+				},
+				ScannedBdevs: mockCtrlrsInclVMD(),
+			},
+			expCfg: func() *SpdkConfig {
+				sc := defaultSpdkConfig()
+				for _, addr := range []string{vmdBackingAddr1, vmdBackingAddr2} {
+					sc.Subsystems[0].Configs = append(sc.Subsystems[0].Configs,
+						&SpdkSubsystemConfig{
+							Method: "bdev_nvme_attach_controller",
+							Params: &NvmeAttachControllerParams{
+								TransportType: "PCIe",
+								DeviceName: fmt.Sprintf("Nvme_testHost_%s_1_0",
+									addr),
+								TransportAddress: addr,
+							},
+						})
+				}
+				sc.Subsystems[0].Configs = append(sc.Subsystems[0].Configs,
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_set_hotplug",
+						Params: &NvmeSetHotplugParams{},
+					},
+				)
+				return sc.WithVMDEnabled()
+			}(),
+		},
+		"nvme; encryption enabled; key material redacted": {
+			req: &storage.BdevWriteConfigRequest{
+				Hostname:         "testHost",
+				ConfigOutputPath: "/nonexistent/daos_nvme.conf",
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:      storage.ClassNvme,
+						DeviceList: storage.MustNewBdevDeviceList(test.MockPCIAddrs(1)...),
+						Tier:       1,
+						Crypto: storage.BdevCrypto{
+							Enabled:     true,
+							Cipher:      storage.BdevCryptoCipherAesXts,
+							KeyProvider: storage.BdevCryptoKeyProviderEnv,
+						},
+					},
+				},
+			},
+			expCfg: func() *SpdkConfig {
+				sc := defaultSpdkConfig()
+				sc.Subsystems[0].Configs = append(sc.Subsystems[0].Configs,
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_attach_controller",
+						Params: &NvmeAttachControllerParams{
+							TransportType:    "PCIe",
+							DeviceName:       "Nvme_testHost_0_1_0",
+							TransportAddress: "0000:01:00.0",
+						},
+					},
+					&SpdkSubsystemConfig{
+						Method: "bdev_crypto_create",
+						Params: &CryptoCreateParams{
+							BaseDeviceName: "Nvme_testHost_0_1_0n1",
+							DeviceName:     "Crypto_testHost_0_1_0",
+							KeyName:        "Key_testHost_1",
+						},
+					},
+					&SpdkSubsystemConfig{
+						Method: "bdev_nvme_set_hotplug",
+						Params: &NvmeSetHotplugParams{},
+					},
+				)
+				sc.Subsystems = append(sc.Subsystems, &SpdkSubsystem{
+					Name: "accel",
+					Configs: []*SpdkSubsystemConfig{
+						{
+							Method: "accel_crypto_key_create",
+							Params: &AccelCryptoKeyCreateParams{
+								Cipher: storage.BdevCryptoCipherAesXts,
+								Key:    redactedKey,
+								Key2:   redactedKey,
+								Name:   "Key_testHost_1",
+							},
+						},
+					},
+				})
+				return sc
+			}(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			t.Setenv(cryptoKeyEnvVar, testCryptoKey)
+			t.Setenv(cryptoKey2EnvVar, testCryptoKey2)
+
+			gotBuf, gotErr := RenderJsonConfig(log, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if _, err := os.Stat(tc.req.ConfigOutputPath); !os.IsNotExist(err) {
+				t.Fatalf("expected no config file to be written, stat returned %v", err)
+			}
+
+			gotCfg, err := readSpdkConfig(bytes.NewReader(gotBuf))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expCfg, gotCfg); diff != "" {
+				t.Fatalf("unexpected rendered config (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackend_diffSpdkConfigs(t *testing.T) {
+	keyCfg := func(key, key2 string) *SpdkConfig {
+		return &SpdkConfig{
+			Subsystems: []*SpdkSubsystem{
+				{
+					Name: "accel",
+					Configs: []*SpdkSubsystemConfig{
+						{
+							Method: storage.ConfAccelCryptoKeyCreate,
+							Params: &AccelCryptoKeyCreateParams{
+								Cipher: "AES_XTS",
+								Key:    key,
+								Key2:   key2,
+								Name:   "Key_testHost_1",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		want     *SpdkConfig
+		got      *SpdkConfig
+		expDiffs []string
+	}{
+		"no differences": {
+			want: keyCfg("00112233", "44556677"),
+			got:  keyCfg("00112233", "44556677"),
+		},
+		"key differs; key material redacted": {
+			want: keyCfg("00112233", "44556677"),
+			got:  keyCfg("8899aabb", "44556677"),
+			expDiffs: []string{
+				`accel: missing accel_crypto_key_create {"cipher":"AES_XTS","key":"REDACTED","key2":"REDACTED","name":"Key_testHost_1"}`,
+				`accel: unexpected accel_crypto_key_create {"cipher":"AES_XTS","key":"REDACTED","key2":"REDACTED","name":"Key_testHost_1"}`,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotDiffs, err := diffSpdkConfigs(tc.want, tc.got)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expDiffs, gotDiffs); diff != "" {
+				t.Fatalf("unexpected differences (-want, +got):\n%s", diff)
+			}
+			for _, key := range []string{"00112233", "44556677", "8899aabb"} {
+				if strings.Contains(strings.Join(gotDiffs, "\n"), key) {
+					t.Fatalf("key material %q found in differences", key)
+				}
+			}
+		})
+	}
+}
+
+func TestBackend_AccelCryptoKeyCreateParams_String(t *testing.T) {
+	params := AccelCryptoKeyCreateParams{
+		Cipher: "AES_XTS",
+		Key:    "00112233",
+		Key2:   "44556677",
+		Name:   "Key_testHost_1",
+	}
+
+	for _, got := range []string{fmt.Sprintf("%v", params), fmt.Sprintf("%+v", &params)} {
+		if strings.Contains(got, params.Key) || strings.Contains(got, params.Key2) {
+			t.Fatalf("key material found in %q", got)
+		}
+		if !strings.Contains(got, params.Name) {
+			t.Fatalf("key name not found in %q", got)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"bufio"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// Environment variables read by the env crypto key provider.
+const (
+	cryptoKeyEnvVar  = "DAOS_BDEV_CRYPTO_KEY"
+	cryptoKey2EnvVar = "DAOS_BDEV_CRYPTO_KEY2"
+)
+
+// cryptoKeyProvider retrieves hex-encoded key material for the given encryption parameters.
+type cryptoKeyProvider func(storage.BdevCrypto) ([]string, error)
+
+var cryptoKeyProviders = map[string]cryptoKeyProvider{
+	storage.BdevCryptoKeyProviderFile: fileCryptoKeys,
+	storage.BdevCryptoKeyProviderEnv:  envCryptoKeys,
+}
+
+// fileCryptoKeys reads keys from a file containing one hex-encoded key per line.
+func fileCryptoKeys(crypto storage.BdevCrypto) ([]string, error) {
+	f, err := os.Open(crypto.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "open key file")
+	}
+	defer f.Close()
+
+	var keys []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys = append(keys, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read key file")
+	}
+
+	return keys, nil
+}
+
+// envCryptoKeys reads keys from the daos_server environment.
+func envCryptoKeys(_ storage.BdevCrypto) ([]string, error) {
+	var keys []string
+	for _, name := range []string{cryptoKeyEnvVar, cryptoKey2EnvVar} {
+		if val := strings.TrimSpace(os.Getenv(name)); val != "" {
+			keys = append(keys, val)
+		}
+	}
+
+	return keys, nil
+}
+
+// checkCryptoKeys verifies that the number and length of keys are valid for the cipher. Keys must
+// be hex-encoded 128 or 256 bit values and AES_XTS requires a second key of the same length that
+// differs from the first.
+func checkCryptoKeys(cipher string, keys []string) error {
+	expKeys := 1
+	if cipher == storage.BdevCryptoCipherAesXts {
+		expKeys = 2
+	}
+	if len(keys) != expKeys {
+		return errors.Errorf("cipher %s requires %d key(s), got %d", cipher, expKeys, len(keys))
+	}
+
+	for i, key := range keys {
+		buf, err := hex.DecodeString(key)
+		if err != nil {
+			return errors.Errorf("key %d is not hex-encoded", i+1)
+		}
+		if len(buf) != 16 && len(buf) != 32 {
+			return errors.Errorf("key %d must be 128 or 256 bits, got %d", i+1, len(buf)*8)
+		}
+	}
+
+	if expKeys == 2 {
+		if len(keys[0]) != len(keys[1]) {
+			return errors.Errorf("cipher %s requires keys of equal length", cipher)
+		}
+		if strings.EqualFold(keys[0], keys[1]) {
+			return errors.Errorf("cipher %s requires different keys", cipher)
+		}
+	}
+
+	return nil
+}
+
+// getCryptoKeys retrieves and validates key material from the provider selected in the input
+// encryption parameters.
+func getCryptoKeys(crypto storage.BdevCrypto) ([]string, error) {
+	provider, exists := cryptoKeyProviders[crypto.KeyProvider]
+	if !exists {
+		return nil, errors.Errorf("unknown crypto key provider %q", crypto.KeyProvider)
+	}
+
+	keys, err := provider(crypto)
+	if err != nil {
+		return nil, errors.Wrapf(err, "crypto key provider %q", crypto.KeyProvider)
+	}
+
+	if err := checkCryptoKeys(crypto.Cipher, keys); err != nil {
+		return nil, errors.Wrapf(err, "crypto key provider %q", crypto.KeyProvider)
+	}
+
+	return keys, nil
+}
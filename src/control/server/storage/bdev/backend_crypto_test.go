@@ -0,0 +1,139 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	testCryptoKey  = "00112233445566778899aabbccddeeff"
+	testCryptoKey2 = "ffeeddccbbaa99887766554433221100"
+)
+
+func TestBackend_getCryptoKeys(t *testing.T) {
+	for name, tc := range map[string]struct {
+		crypto    storage.BdevCrypto
+		fileLines []string
+		envKeys   []string
+		expKeys   []string
+		expErr    error
+	}{
+		"unknown provider": {
+			crypto: storage.BdevCrypto{KeyProvider: "vault"},
+			expErr: errors.New("unknown crypto key provider"),
+		},
+		"file; aes_xts": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesXts,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{"# data key", testCryptoKey, "", testCryptoKey2},
+			expKeys:   []string{testCryptoKey, testCryptoKey2},
+		},
+		"file; aes_cbc": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesCbc,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{testCryptoKey + testCryptoKey2},
+			expKeys:   []string{testCryptoKey + testCryptoKey2},
+		},
+		"file; aes_xts; missing second key": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesXts,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{testCryptoKey},
+			expErr:    errors.New("requires 2 key(s), got 1"),
+		},
+		"file; aes_xts; identical keys": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesXts,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{testCryptoKey, strings.ToUpper(testCryptoKey)},
+			expErr:    errors.New("requires different keys"),
+		},
+		"file; aes_xts; keys of different lengths": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesXts,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{testCryptoKey, testCryptoKey2 + testCryptoKey},
+			expErr:    errors.New("keys of equal length"),
+		},
+		"file; not hex": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesCbc,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{"not a key"},
+			expErr:    errors.New("not hex-encoded"),
+		},
+		"file; bad key length": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesCbc,
+				KeyProvider: storage.BdevCryptoKeyProviderFile,
+			},
+			fileLines: []string{"0011223344556677"},
+			expErr:    errors.New("must be 128 or 256 bits, got 64"),
+		},
+		"env; aes_xts": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesXts,
+				KeyProvider: storage.BdevCryptoKeyProviderEnv,
+			},
+			envKeys: []string{testCryptoKey, testCryptoKey2},
+			expKeys: []string{testCryptoKey, testCryptoKey2},
+		},
+		"env; no keys set": {
+			crypto: storage.BdevCrypto{
+				Cipher:      storage.BdevCryptoCipherAesCbc,
+				KeyProvider: storage.BdevCryptoKeyProviderEnv,
+			},
+			expErr: errors.New("requires 1 key(s), got 0"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if tc.fileLines != nil {
+				testDir, clean := test.CreateTestDir(t)
+				defer clean()
+
+				tc.crypto.KeyFile = filepath.Join(testDir, "key")
+				content := strings.Join(tc.fileLines, "\n")
+				if err := os.WriteFile(tc.crypto.KeyFile, []byte(content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			envKeys := make([]string, 2)
+			copy(envKeys, tc.envKeys)
+			t.Setenv(cryptoKeyEnvVar, envKeys[0])
+			t.Setenv(cryptoKey2EnvVar, envKeys[1])
+
+			gotKeys, gotErr := getCryptoKeys(tc.crypto)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expKeys, gotKeys); diff != "" {
+				t.Fatalf("unexpected keys (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -10,11 +10,14 @@ package bdev
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
@@ -23,6 +26,7 @@ import (
 
 const (
 	hotplugPeriod = 5 * time.Second
+	redactedKey   = "REDACTED"
 )
 
 // SpdkSubsystemConfigParams is an interface that defines an object that
@@ -37,10 +41,18 @@ type DaosConfigParams interface {
 	isDaosConfigParams()
 }
 
+// redactor is implemented by subsystem config method parameters that contain secrets which must
+// not appear in logs, rendered configs or descriptions of config differences.
+type redactor interface {
+	redacted() SpdkSubsystemConfigParams
+}
+
 // SetOptionsParams specifies details for a storage.ConfBdevSetOptions method.
 type SetOptionsParams struct {
-	BdevIoPoolSize  uint64 `json:"bdev_io_pool_size"`
-	BdevIoCacheSize uint64 `json:"bdev_io_cache_size"`
+	BdevIoPoolSize   uint64 `json:"bdev_io_pool_size"`
+	BdevIoCacheSize  uint64 `json:"bdev_io_cache_size"`
+	SmallBufPoolSize uint64 `json:"small_buf_pool_size,omitempty"`
+	LargeBufPoolSize uint64 `json:"large_buf_pool_size,omitempty"`
 }
 
 func (_ SetOptionsParams) isSpdkSubsystemConfigParams() {}
@@ -52,6 +64,7 @@ type NvmeSetOptionsParams struct {
 	NvmeAdminqPollPeriodUsec uint32 `json:"nvme_adminq_poll_period_us"`
 	ActionOnTimeout          string `json:"action_on_timeout"`
 	NvmeIoqPollPeriodUsec    uint32 `json:"nvme_ioq_poll_period_us"`
+	IoQueueRequests          uint32 `json:"io_queue_requests,omitempty"`
 }
 
 func (_ NvmeSetOptionsParams) isSpdkSubsystemConfigParams() {}
@@ -62,6 +75,9 @@ type NvmeAttachControllerParams struct {
 	TransportType    string `json:"trtype"`
 	DeviceName       string `json:"name"`
 	TransportAddress string `json:"traddr"`
+	AddressFamily    string `json:"adrfam,omitempty"`
+	TransportSvcID   string `json:"trsvcid,omitempty"`
+	SubsystemNQN     string `json:"subnqn,omitempty"`
 }
 
 func (_ NvmeAttachControllerParams) isSpdkSubsystemConfigParams() {}
@@ -88,6 +104,125 @@ type AioCreateParams struct {
 
 func (_ AioCreateParams) isSpdkSubsystemConfigParams() {}
 
+// NullCreateParams specifies details for a storage.ConfBdevNullCreate method.
+type NullCreateParams struct {
+	DeviceName string `json:"name"`
+	NumBlocks  uint64 `json:"num_blocks"`
+	BlockSize  uint64 `json:"block_size"`
+}
+
+func (_ NullCreateParams) isSpdkSubsystemConfigParams() {}
+
+// MallocCreateParams specifies details for a storage.ConfBdevMallocCreate method.
+type MallocCreateParams struct {
+	DeviceName string `json:"name"`
+	NumBlocks  uint64 `json:"num_blocks"`
+	BlockSize  uint64 `json:"block_size"`
+}
+
+func (_ MallocCreateParams) isSpdkSubsystemConfigParams() {}
+
+// RaidCreateParams specifies details for a storage.ConfBdevRaidCreate method.
+type RaidCreateParams struct {
+	DeviceName   string   `json:"name"`
+	RaidLevel    string   `json:"raid_level"`
+	StripSizeKiB uint32   `json:"strip_size_kb,omitempty"`
+	BaseBdevs    []string `json:"base_bdevs"`
+}
+
+func (_ RaidCreateParams) isSpdkSubsystemConfigParams() {}
+
+// DelayCreateParams specifies details for a storage.ConfBdevDelayCreate method.
+type DelayCreateParams struct {
+	BaseDeviceName      string `json:"base_bdev_name"`
+	DeviceName          string `json:"name"`
+	AvgReadLatencyUsec  uint64 `json:"avg_read_latency"`
+	P99ReadLatencyUsec  uint64 `json:"p99_read_latency"`
+	AvgWriteLatencyUsec uint64 `json:"avg_write_latency"`
+	P99WriteLatencyUsec uint64 `json:"p99_write_latency"`
+}
+
+func (_ DelayCreateParams) isSpdkSubsystemConfigParams() {}
+
+// ErrorCreateParams specifies details for a storage.ConfBdevErrorCreate method.
+type ErrorCreateParams struct {
+	BaseDeviceName string `json:"base_name"`
+}
+
+func (_ ErrorCreateParams) isSpdkSubsystemConfigParams() {}
+
+// ErrorInjectParams specifies details for a storage.ConfBdevErrorInjectError method. SPDK only
+// accepts the method at runtime so it is recorded in DAOS config data and issued through the
+// engine's SPDK JSON-RPC server once the engine has started.
+type ErrorInjectParams struct {
+	DeviceName string `json:"name"`
+	IoType     string `json:"io_type"`
+	ErrorType  string `json:"error_type"`
+	Count      uint32 `json:"num"`
+}
+
+func (_ ErrorInjectParams) isDaosConfigParams() {}
+
+// IdxdScanAccelEngineParams specifies details for a storage.ConfIdxdScanAccelEngine method.
+type IdxdScanAccelEngineParams struct {
+	ConfigNumber     uint32 `json:"config_number"`
+	ConfigKernelMode bool   `json:"config_kernel_mode"`
+}
+
+func (_ IdxdScanAccelEngineParams) isSpdkSubsystemConfigParams() {}
+
+// AccelCryptoKeyCreateParams specifies details for a storage.ConfAccelCryptoKeyCreate method.
+type AccelCryptoKeyCreateParams struct {
+	Cipher string `json:"cipher"`
+	Key    string `json:"key"`
+	Key2   string `json:"key2,omitempty"`
+	Name   string `json:"name"`
+}
+
+func (_ AccelCryptoKeyCreateParams) isSpdkSubsystemConfigParams() {}
+
+// redacted returns a copy of the parameters with the key material removed so that they can be
+// logged or displayed.
+func (p AccelCryptoKeyCreateParams) redacted() SpdkSubsystemConfigParams {
+	if p.Key != "" {
+		p.Key = redactedKey
+	}
+	if p.Key2 != "" {
+		p.Key2 = redactedKey
+	}
+	return p
+}
+
+// String implements fmt.Stringer and ensures key material is never printed.
+func (p AccelCryptoKeyCreateParams) String() string {
+	return fmt.Sprintf("%+v", struct {
+		Cipher string
+		Key    string
+		Key2   string
+		Name   string
+	}(p.redacted().(AccelCryptoKeyCreateParams)))
+}
+
+// CryptoCreateParams specifies details for a storage.ConfBdevCryptoCreate method.
+type CryptoCreateParams struct {
+	BaseDeviceName string `json:"base_bdev_name"`
+	DeviceName     string `json:"name"`
+	KeyName        string `json:"key_name"`
+}
+
+func (_ CryptoCreateParams) isSpdkSubsystemConfigParams() {}
+
+// OcfCreateParams specifies details for a storage.ConfBdevOcfCreate method.
+type OcfCreateParams struct {
+	DeviceName       string `json:"name"`
+	Mode             string `json:"mode"`
+	CacheLineSizeKiB uint32 `json:"cache_line_size,omitempty"`
+	CacheDeviceName  string `json:"cache_bdev_name"`
+	CoreDeviceName   string `json:"core_bdev_name"`
+}
+
+func (_ OcfCreateParams) isSpdkSubsystemConfigParams() {}
+
 // HotplugBusidRangeParams specifies details for a storage.ConfSetHotplugBusidRange method.
 type HotplugBusidRangeParams struct {
 	Begin uint8 `json:"begin"`
@@ -111,6 +246,23 @@ type AutoFaultyParams storage.BdevAutoFaulty
 
 func (_ AutoFaultyParams) isDaosConfigParams() {}
 
+// SpdkLogParams specifies details for a storage.ConfSetSpdkLog method.
+type SpdkLogParams storage.SpdkLog
+
+func (_ SpdkLogParams) isDaosConfigParams() {}
+
+// SpdkEnvOptsParams specifies details for a storage.ConfSetSpdkEnvOpts method.
+type SpdkEnvOptsParams storage.SpdkEnvOptions
+
+func (_ SpdkEnvOptsParams) isDaosConfigParams() {}
+
+// NsSelectParams specifies details for a storage.ConfSetNsSelect method.
+type NsSelectParams struct {
+	BdevNames []string `json:"bdev_names"`
+}
+
+func (_ NsSelectParams) isDaosConfigParams() {}
+
 // SpdkSubsystemConfig entries apply to any SpdkSubsystem.
 type SpdkSubsystemConfig struct {
 	Params SpdkSubsystemConfigParams `json:"params"`
@@ -140,6 +292,20 @@ func (ssc *SpdkSubsystemConfig) UnmarshalJSON(data []byte) error {
 		ssc.Params = &VmdEnableParams{}
 	case storage.ConfBdevAioCreate:
 		ssc.Params = &AioCreateParams{}
+	case storage.ConfBdevRaidCreate:
+		ssc.Params = &RaidCreateParams{}
+	case storage.ConfBdevDelayCreate:
+		ssc.Params = &DelayCreateParams{}
+	case storage.ConfBdevErrorCreate:
+		ssc.Params = &ErrorCreateParams{}
+	case storage.ConfIdxdScanAccelEngine:
+		ssc.Params = &IdxdScanAccelEngineParams{}
+	case storage.ConfAccelCryptoKeyCreate:
+		ssc.Params = &AccelCryptoKeyCreateParams{}
+	case storage.ConfBdevCryptoCreate:
+		ssc.Params = &CryptoCreateParams{}
+	case storage.ConfBdevOcfCreate:
+		ssc.Params = &OcfCreateParams{}
 	default:
 		return errors.Errorf("unknown SPDK subsystem config method %q", ssc.Method)
 	}
@@ -178,6 +344,14 @@ func (dc *DaosConfig) UnmarshalJSON(data []byte) error {
 		dc.Params = &SpdkRpcServerParams{}
 	case storage.ConfSetAutoFaultyProps:
 		dc.Params = &AutoFaultyParams{}
+	case storage.ConfSetSpdkLog:
+		dc.Params = &SpdkLogParams{}
+	case storage.ConfSetSpdkEnvOpts:
+		dc.Params = &SpdkEnvOptsParams{}
+	case storage.ConfSetNsSelect:
+		dc.Params = &NsSelectParams{}
+	case storage.ConfBdevErrorInjectError:
+		dc.Params = &ErrorInjectParams{}
 	default:
 		return errors.Errorf("unknown DAOS config method %q", dc.Method)
 	}
@@ -198,6 +372,23 @@ type SpdkConfig struct {
 	Subsystems []*SpdkSubsystem `json:"subsystems"`
 }
 
+// redacted returns a copy of the SpdkConfig with secrets removed from method parameters.
+func (sc *SpdkConfig) redacted() *SpdkConfig {
+	out := &SpdkConfig{DaosData: sc.DaosData}
+	for _, ss := range sc.Subsystems {
+		oss := &SpdkSubsystem{Name: ss.Name}
+		for _, ssc := range ss.Configs {
+			if r, ok := ssc.Params.(redactor); ok {
+				ssc = &SpdkSubsystemConfig{Method: ssc.Method, Params: r.redacted()}
+			}
+			oss.Configs = append(oss.Configs, ssc)
+		}
+		out.Subsystems = append(out.Subsystems, oss)
+	}
+
+	return out
+}
+
 func defaultSpdkConfig() *SpdkConfig {
 	bdevSubsystemConfigs := []*SpdkSubsystemConfig{
 		{
@@ -247,6 +438,151 @@ func getNvmeAttachMethod(name, pci string) *SpdkSubsystemConfig {
 	}
 }
 
+func getNvmeFabricsAttachMethod(tr storage.BdevTransport) configMethodGetter {
+	return func(name, addr string) *SpdkSubsystemConfig {
+		adrFam := "IPv4"
+		if ip := net.ParseIP(addr); ip != nil && ip.To4() == nil {
+			adrFam = "IPv6"
+		}
+		svcID := tr.SvcID
+		if svcID == "" {
+			svcID = storage.DefaultBdevTransportSvcID
+		}
+
+		return &SpdkSubsystemConfig{
+			Method: storage.ConfBdevNvmeAttachController,
+			Params: &NvmeAttachControllerParams{
+				TransportType:    strings.ToUpper(tr.Type),
+				DeviceName:       fmt.Sprintf("Nvme_%s", name),
+				TransportAddress: addr,
+				AddressFamily:    adrFam,
+				TransportSvcID:   svcID,
+				SubsystemNQN:     tr.SubNQN,
+			},
+		}
+	}
+}
+
+// getRaidCreateMethod returns a method to combine the namespaces of the attached NVMe controllers
+// into a single RAID bdev. Bdevs created by SPDK for attached controllers are named after the
+// controller with a namespace suffix.
+func getRaidCreateMethod(name string, raid storage.BdevRaid, baseNames []string) *SpdkSubsystemConfig {
+	params := &RaidCreateParams{
+		DeviceName: fmt.Sprintf("Raid_%s", name),
+		RaidLevel:  raid.Level,
+		BaseBdevs:  baseNames,
+	}
+	if raid.Level == storage.BdevRaidLevel0 {
+		params.StripSizeKiB = raid.StripSizeKiB
+		if params.StripSizeKiB == 0 {
+			params.StripSizeKiB = storage.DefaultBdevRaidStripSizeKiB
+		}
+	}
+	return &SpdkSubsystemConfig{
+		Method: storage.ConfBdevRaidCreate,
+		Params: params,
+	}
+}
+
+// nvmeBdevName returns the name of the bdev created by SPDK for the given namespace of a
+// controller attached with the given method.
+func nvmeBdevName(attach *SpdkSubsystemConfig, nsID uint32) string {
+	return fmt.Sprintf("%sn%d", attach.Params.(*NvmeAttachControllerParams).DeviceName, nsID)
+}
+
+// getDelayCreateMethod returns a method to wrap the bdev of an attached NVMe controller in a
+// delay bdev that adds latency to I/O.
+func getDelayCreateMethod(name string, delay storage.BdevDelay, baseName string) *SpdkSubsystemConfig {
+	return &SpdkSubsystemConfig{
+		Method: storage.ConfBdevDelayCreate,
+		Params: &DelayCreateParams{
+			BaseDeviceName:      baseName,
+			DeviceName:          fmt.Sprintf("Delay_%s", name),
+			AvgReadLatencyUsec:  delay.AvgReadLatencyUsec,
+			P99ReadLatencyUsec:  delay.P99ReadLatencyUsec,
+			AvgWriteLatencyUsec: delay.AvgWriteLatencyUsec,
+			P99WriteLatencyUsec: delay.P99WriteLatencyUsec,
+		},
+	}
+}
+
+// getErrorCreateMethod returns a method to wrap the bdev of an attached NVMe controller in an
+// error bdev.
+func getErrorCreateMethod(baseName string) *SpdkSubsystemConfig {
+	return &SpdkSubsystemConfig{
+		Method: storage.ConfBdevErrorCreate,
+		Params: &ErrorCreateParams{
+			BaseDeviceName: baseName,
+		},
+	}
+}
+
+// errorBdevName returns the name of the error bdev created by SPDK on top of the named bdev.
+func errorBdevName(baseName string) string {
+	return "EE_" + baseName
+}
+
+// getErrorInjectConfig returns DAOS config data describing the errors to inject into I/O on the
+// error bdev created on top of the named bdev.
+func getErrorInjectConfig(inject storage.BdevErrorInject, baseName string) *DaosConfig {
+	return &DaosConfig{
+		Method: storage.ConfBdevErrorInjectError,
+		Params: &ErrorInjectParams{
+			DeviceName: errorBdevName(baseName),
+			IoType:     inject.IoType,
+			ErrorType:  inject.ErrorType,
+			Count:      inject.Count,
+		},
+	}
+}
+
+// getOcfCreateMethod returns a method to front the bdev of an attached NVMe controller with an OCF
+// cache bdev. The cache bdev may be shared by multiple OCF bdevs.
+func getOcfCreateMethod(name string, cache storage.BdevCache, cacheName, coreName string) *SpdkSubsystemConfig {
+	return &SpdkSubsystemConfig{
+		Method: storage.ConfBdevOcfCreate,
+		Params: &OcfCreateParams{
+			DeviceName:       fmt.Sprintf("Ocf_%s", name),
+			Mode:             cache.Mode,
+			CacheLineSizeKiB: cache.LineSizeKiB,
+			CacheDeviceName:  cacheName,
+			CoreDeviceName:   coreName,
+		},
+	}
+}
+
+// baseBdevName returns the name of the bdev created by SPDK for the given attach or create method.
+func baseBdevName(base *SpdkSubsystemConfig, nsID uint32) string {
+	switch params := base.Params.(type) {
+	case *AioCreateParams:
+		return params.DeviceName
+	case *NullCreateParams:
+		return params.DeviceName
+	case *MallocCreateParams:
+		return params.DeviceName
+	}
+
+	return nvmeBdevName(base, nsID)
+}
+
+// cryptoKeyName returns the name of the accel framework crypto key used to encrypt a tier's bdevs.
+func cryptoKeyName(hostname string, tier int) string {
+	return fmt.Sprintf("Key_%s_%d", hostname, tier)
+}
+
+// getCryptoCreateMethod returns a method to wrap a bdev in a crypto bdev that encrypts I/O with
+// the named key.
+func getCryptoCreateMethod(name, keyName, baseName string) *SpdkSubsystemConfig {
+	return &SpdkSubsystemConfig{
+		Method: storage.ConfBdevCryptoCreate,
+		Params: &CryptoCreateParams{
+			BaseDeviceName: baseName,
+			DeviceName:     fmt.Sprintf("Crypto_%s", name),
+			KeyName:        keyName,
+		},
+	}
+}
+
 func getAioFileCreateMethod(name, path string) *SpdkSubsystemConfig {
 	return &SpdkSubsystemConfig{
 		Method: storage.ConfBdevAioCreate,
@@ -268,30 +604,180 @@ func getAioKdevCreateMethod(name, path string) *SpdkSubsystemConfig {
 	}
 }
 
-func getSpdkConfigMethods(req *storage.BdevWriteConfigRequest) (sscs []*SpdkSubsystemConfig) {
+// memBdevGeometry returns the number of blocks and block size of a memory bdev created for the
+// given device of a null or malloc tier.
+func memBdevGeometry(tier storage.BdevTierProperties, dev string) (uint64, uint64) {
+	blockSize := uint64(tier.BlockSize.ForDevice(dev))
+	if blockSize == 0 {
+		blockSize = aioBlockSize
+	}
+
+	return tier.DeviceFileSize / blockSize, blockSize
+}
+
+func getNullCreateMethod(tier storage.BdevTierProperties) configMethodGetter {
+	return func(name, dev string) *SpdkSubsystemConfig {
+		numBlocks, blockSize := memBdevGeometry(tier, dev)
+
+		return &SpdkSubsystemConfig{
+			Method: storage.ConfBdevNullCreate,
+			Params: &NullCreateParams{
+				DeviceName: fmt.Sprintf("Null_%s", name),
+				NumBlocks:  numBlocks,
+				BlockSize:  blockSize,
+			},
+		}
+	}
+}
+
+func getMallocCreateMethod(tier storage.BdevTierProperties) configMethodGetter {
+	return func(name, dev string) *SpdkSubsystemConfig {
+		numBlocks, blockSize := memBdevGeometry(tier, dev)
+
+		return &SpdkSubsystemConfig{
+			Method: storage.ConfBdevMallocCreate,
+			Params: &MallocCreateParams{
+				DeviceName: fmt.Sprintf("Malloc_%s", name),
+				NumBlocks:  numBlocks,
+				BlockSize:  blockSize,
+			},
+		}
+	}
+}
+
+// getSpdkConfigMethods returns the bdev subsystem methods for the tiers in the request along with
+// any DAOS config data describing methods to be issued once the engine has started.
+func getSpdkConfigMethods(req *storage.BdevWriteConfigRequest) (sscs []*SpdkSubsystemConfig, dcs []*DaosConfig) {
+	// Bdev names of NVMe namespaces explicitly selected in config, the engine ignores other
+	// namespaces of the same controllers.
+	var nsNames []string
+
 	for _, tier := range req.TierProps {
 		var f configMethodGetter
 
 		switch tier.Class {
-		case storage.ClassNvme:
+		case storage.ClassNvme, storage.ClassNvmeRaid, storage.ClassDelay, storage.ClassError,
+			storage.ClassNvmeCache:
 			f = getNvmeAttachMethod
 		case storage.ClassFile:
 			f = getAioFileCreateMethod
 		case storage.ClassKdev:
 			f = getAioKdevCreateMethod
+		case storage.ClassNvmeFabrics:
+			f = getNvmeFabricsAttachMethod(tier.Transport)
+		case storage.ClassNull:
+			f = getNullCreateMethod(tier)
+		case storage.ClassMalloc:
+			f = getMallocCreateMethod(tier)
 		}
 
-		for index, dev := range tier.DeviceList.Devices() {
-			// Encode bdev tier info in RPC name field.
-			name := fmt.Sprintf("%s_%d_%d_%d", req.Hostname, index, tier.Tier,
+		// Encode bdev tier info in RPC name field.
+		tierName := func(index int) string {
+			return fmt.Sprintf("%s_%d_%d_%d", req.Hostname, index, tier.Tier,
 				tier.DeviceRoles.OptionBits)
-			sscs = append(sscs, f(name, dev))
+		}
+
+		// Only the bdev that bio builds a blobstore on is assigned the tier's roles, bdevs
+		// that are composed into a RAID, delay, OCF or crypto bdev are named without role
+		// bits. Error bdevs are named by SPDK after their base bdev which therefore keeps
+		// the roles.
+		memberRoles := tier.DeviceRoles.OptionBits
+		switch tier.Class {
+		case storage.ClassNvmeRaid, storage.ClassDelay, storage.ClassNvmeCache:
+			memberRoles = 0
+		}
+		if tier.Crypto.Enabled {
+			memberRoles = 0
+		}
+
+		// Name VMD backing devices after their address rather than their index so that
+		// names identify the device and remain stable as devices in a domain come and go.
+		devName := func(index int, dev string, roles storage.OptionBits) string {
+			id := fmt.Sprintf("%d", index)
+			if req.VMDEnabled && tier.Class.IsLocalNVMe() {
+				addr, err := hardware.NewPCIAddress(dev)
+				if err == nil && addr.IsVMDBackingAddress() {
+					id = addr.String()
+				}
+			}
+			return fmt.Sprintf("%s_%s_%d_%d", req.Hostname, id, tier.Tier, roles)
+		}
+
+		tierCfgs := make([]*SpdkSubsystemConfig, 0, tier.DeviceList.Len())
+		baseNames := make([]string, 0, tier.DeviceList.Len())
+		devNames := make([]string, 0, tier.DeviceList.Len())
+		for index, dev := range tier.DeviceList.Devices() {
+			devNames = append(devNames, devName(index, dev, tier.DeviceRoles.OptionBits))
+			ssc := f(devName(index, dev, memberRoles), dev)
+			if aio, ok := ssc.Params.(*AioCreateParams); ok {
+				if size := tier.BlockSize.ForDevice(dev); size != 0 {
+					aio.BlockSize = uint64(size)
+				}
+			}
+			tierCfgs = append(tierCfgs, ssc)
+			baseNames = append(baseNames, baseBdevName(ssc, tier.DeviceList.Namespace(dev)))
+			if tier.Class.IsLocalNVMe() && tier.DeviceList.HasNamespace(dev) {
+				nsNames = append(nsNames, baseNames[len(baseNames)-1])
+			}
+		}
+		sscs = append(sscs, tierCfgs...)
+
+		// Compose any bdevs layered on top of those attached for the tier's devices.
+		switch tier.Class {
+		case storage.ClassNvmeRaid:
+			sscs = append(sscs, getRaidCreateMethod(tierName(0), tier.Raid, baseNames))
+		case storage.ClassDelay:
+			for index, baseName := range baseNames {
+				sscs = append(sscs, getDelayCreateMethod(devNames[index], tier.Delay, baseName))
+			}
+		case storage.ClassError:
+			for _, baseName := range baseNames {
+				sscs = append(sscs, getErrorCreateMethod(baseName))
+				dcs = append(dcs, getErrorInjectConfig(tier.ErrorInject, baseName))
+			}
+		case storage.ClassNvmeCache:
+			cacheCfg := f(devName(len(tierCfgs), tier.Cache.Device, 0), tier.Cache.Device)
+			cacheName := nvmeBdevName(cacheCfg, storage.DefaultBdevNamespaceID)
+			sscs = append(sscs, cacheCfg)
+			for index, baseName := range baseNames {
+				sscs = append(sscs, getOcfCreateMethod(devNames[index], tier.Cache,
+					cacheName, baseName))
+			}
+		}
+
+		if tier.Crypto.Enabled {
+			keyName := cryptoKeyName(req.Hostname, tier.Tier)
+			for index, baseName := range baseNames {
+				sscs = append(sscs, getCryptoCreateMethod(devNames[index], keyName, baseName))
+			}
 		}
 	}
 
+	if len(nsNames) > 0 {
+		dcs = append(dcs, &DaosConfig{
+			Method: storage.ConfSetNsSelect,
+			Params: &NsSelectParams{BdevNames: nsNames},
+		})
+	}
+
 	return
 }
 
+// ControllerName returns the name given to the NVMe controller at the input address in the SPDK
+// config generated from the input request, bdevs created for the controller's namespaces are
+// named with this prefix.
+func ControllerName(req *storage.BdevWriteConfigRequest, addr string) (string, error) {
+	sscs, _ := getSpdkConfigMethods(req)
+	for _, ssc := range sscs {
+		params, ok := ssc.Params.(*NvmeAttachControllerParams)
+		if ok && params.TransportAddress == addr {
+			return params.DeviceName, nil
+		}
+	}
+
+	return "", errors.Errorf("no controller with address %s in spdk config", addr)
+}
+
 // WithVMDEnabled adds vmd subsystem with enable method to an SpdkConfig.
 func (sc *SpdkConfig) WithVMDEnabled() *SpdkConfig {
 	sc.Subsystems = append(sc.Subsystems, &SpdkSubsystem{
@@ -307,6 +793,129 @@ func (sc *SpdkConfig) WithVMDEnabled() *SpdkConfig {
 	return sc
 }
 
+// WithAccelModules adds an accel subsystem to an SpdkConfig enabling the hardware offload modules
+// selected in the input acceleration properties. Devices use the predefined idxd configuration 0
+// when the user-space driver is used.
+func (sc *SpdkConfig) WithAccelModules(props storage.AccelProps) *SpdkConfig {
+	if !props.HasModule(storage.AccelModuleDSA) {
+		return sc
+	}
+
+	ss := sc.accelSubsystem()
+	ss.Configs = append(ss.Configs, &SpdkSubsystemConfig{
+		Method: storage.ConfIdxdScanAccelEngine,
+		Params: &IdxdScanAccelEngineParams{
+			ConfigKernelMode: props.KernelMode,
+		},
+	})
+
+	return sc
+}
+
+// accelSubsystem returns the accel subsystem of an SpdkConfig, adding one if not already present.
+func (sc *SpdkConfig) accelSubsystem() *SpdkSubsystem {
+	for _, ss := range sc.Subsystems {
+		if ss.Name == "accel" {
+			return ss
+		}
+	}
+
+	ss := &SpdkSubsystem{Name: "accel"}
+	sc.Subsystems = append(sc.Subsystems, ss)
+
+	return ss
+}
+
+// WithCryptoKeys adds accel framework crypto keys to an SpdkConfig for each tier in the input
+// request that has encryption enabled. Key material is retrieved from the tier's key provider.
+func (sc *SpdkConfig) WithCryptoKeys(req *storage.BdevWriteConfigRequest) (*SpdkConfig, error) {
+	for _, tier := range req.TierProps {
+		if !tier.Crypto.Enabled {
+			continue
+		}
+
+		keys, err := getCryptoKeys(tier.Crypto)
+		if err != nil {
+			return nil, errors.Wrapf(err, "tier %d crypto key", tier.Tier)
+		}
+
+		params := &AccelCryptoKeyCreateParams{
+			Cipher: tier.Crypto.Cipher,
+			Key:    keys[0],
+			Name:   cryptoKeyName(req.Hostname, tier.Tier),
+		}
+		if len(keys) > 1 {
+			params.Key2 = keys[1]
+		}
+
+		ss := sc.accelSubsystem()
+		ss.Configs = append(ss.Configs, &SpdkSubsystemConfig{
+			Method: storage.ConfAccelCryptoKeyCreate,
+			Params: params,
+		})
+	}
+
+	return sc, nil
+}
+
+// WithNvmeOptions overrides the defaults of the bdev_nvme_set_options method in the bdev subsystem
+// of an SpdkConfig with any non-zero values in the input options.
+func (sc *SpdkConfig) WithNvmeOptions(opts storage.BdevNvmeOptions) *SpdkConfig {
+	for _, ss := range sc.Subsystems {
+		if ss.Name != "bdev" {
+			continue
+		}
+
+		for _, ssc := range ss.Configs {
+			params, ok := ssc.Params.(*NvmeSetOptionsParams)
+			if !ok {
+				continue
+			}
+			if opts.TimeoutUsec != 0 {
+				params.TimeoutUsec = opts.TimeoutUsec
+			}
+			if opts.TimeoutAction != "" {
+				params.ActionOnTimeout = opts.TimeoutAction
+			}
+			if opts.RetryCount != 0 {
+				params.TransportRetryCount = opts.RetryCount
+			}
+			if opts.IoQueueRequests != 0 {
+				params.IoQueueRequests = opts.IoQueueRequests
+			}
+		}
+	}
+
+	return sc
+}
+
+// WithBdevOptions overrides bdev_io and data buffer pool sizes in the bdev_set_options method of
+// the bdev subsystem of an SpdkConfig.
+func (sc *SpdkConfig) WithBdevOptions(opts storage.BdevOptions) *SpdkConfig {
+	for _, ss := range sc.Subsystems {
+		if ss.Name != "bdev" {
+			continue
+		}
+
+		for _, ssc := range ss.Configs {
+			params, ok := ssc.Params.(*SetOptionsParams)
+			if !ok {
+				continue
+			}
+			if opts.IoPoolSize != 0 {
+				params.BdevIoPoolSize = opts.IoPoolSize
+			}
+			if opts.IoCacheSize != 0 {
+				params.BdevIoCacheSize = opts.IoCacheSize
+			}
+			params.SmallBufPoolSize = opts.SmallBufPoolSize
+			params.LargeBufPoolSize = opts.LargeBufPoolSize
+		}
+	}
+
+	return sc
+}
+
 // WithBdevConfigs adds config methods derived from the input
 // BdevWriteConfigRequest to the bdev subsystem of an SpdkConfig.
 func (sc *SpdkConfig) WithBdevConfigs(log logging.Logger, req *storage.BdevWriteConfigRequest) *SpdkConfig {
@@ -315,7 +924,9 @@ func (sc *SpdkConfig) WithBdevConfigs(log logging.Logger, req *storage.BdevWrite
 			continue
 		}
 
-		ss.Configs = append(ss.Configs, getSpdkConfigMethods(req)...)
+		sscs, dcs := getSpdkConfigMethods(req)
+		ss.Configs = append(ss.Configs, sscs...)
+		sc.DaosData.Configs = append(sc.DaosData.Configs, dcs...)
 
 		return sc
 	}
@@ -372,12 +983,34 @@ func autoFaultySet(req *storage.BdevWriteConfigRequest, data *DaosData) {
 	}
 }
 
+// Add SPDK log settings to DAOS config data.
+func spdkLogSet(req *storage.BdevWriteConfigRequest, data *DaosData) {
+	props := req.SpdkLogProps
+	if !props.IsEmpty() {
+		data.Configs = append(data.Configs, &DaosConfig{
+			Method: storage.ConfSetSpdkLog,
+			Params: (*SpdkLogParams)(&props),
+		})
+	}
+}
+
+// Add SPDK environment options to DAOS config data.
+func spdkEnvOptsSet(req *storage.BdevWriteConfigRequest, data *DaosData) {
+	opts := req.SpdkEnvOpts
+	if opts.NoHugepages {
+		data.Configs = append(data.Configs, &DaosConfig{
+			Method: storage.ConfSetSpdkEnvOpts,
+			Params: (*SpdkEnvOptsParams)(&opts),
+		})
+	}
+}
+
 func newSpdkConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) (*SpdkConfig, error) {
 	sc := defaultSpdkConfig()
 
 	if req.VMDEnabled {
 		for _, tp := range req.TierProps {
-			if tp.Class == storage.ClassNvme {
+			if tp.Class.IsLocalNVMe() {
 				sc.WithVMDEnabled()
 				break
 			}
@@ -385,8 +1018,16 @@ func newSpdkConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) (*Sp
 	}
 
 	accelPropSet(req, sc.DaosData)
+	sc.WithAccelModules(req.AccelProps)
+	if _, err := sc.WithCryptoKeys(req); err != nil {
+		return nil, err
+	}
 	rpcSrvSet(req, sc.DaosData)
 	autoFaultySet(req, sc.DaosData)
+	spdkLogSet(req, sc.DaosData)
+	spdkEnvOptsSet(req, sc.DaosData)
+	sc.WithNvmeOptions(req.NvmeOptions)
+	sc.WithBdevOptions(req.BdevOptions)
 	sc.WithBdevConfigs(log, req)
 
 	// SPDK-3370: Ensure hotplug config appears after attach directives to avoid race when VMD
@@ -395,6 +1036,9 @@ func newSpdkConfig(log logging.Logger, req *storage.BdevWriteConfigRequest) (*Sp
 	if req.HotplugEnabled {
 		hpParams.Enable = true
 		hpParams.PeriodUsec = uint64(hotplugPeriod.Microseconds())
+		if req.HotplugPollUsec != 0 {
+			hpParams.PeriodUsec = req.HotplugPollUsec
+		}
 		hotplugPropSet(req, sc.DaosData)
 	}
 	var found bool
@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2021-2024 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -73,14 +74,29 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 	tests := map[string]struct {
 		class              storage.Class
 		fileSizeGB         int
+		fileSparse         bool
+		zoned              bool
 		devList            []string
 		devRoles           int
+		transport          storage.BdevTransport
+		nvmeOptions        storage.BdevNvmeOptions
+		raid               storage.BdevRaid
+		delay              storage.BdevDelay
+		errInject          storage.BdevErrorInject
+		blockSize          storage.BdevBlockSize
+		crypto             storage.BdevCrypto
+		cache              storage.BdevCache
 		enableVmd          bool
 		vosEnv             string
 		enableHotplug      bool
+		hotplugPollUsec    uint64
+		bdevOptions        storage.BdevOptions
+		spdkEnvOpts        storage.SpdkEnvOptions
+		spdkLog            storage.SpdkLog
 		busidRange         string
 		accelEngine        string
 		accelOptMask       storage.AccelOptionBits
+		accelModules       []string
 		rpcSrvEnable       bool
 		rpcSrvSockAddr     string
 		autoFaultyEnable   bool
@@ -120,6 +136,37 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				},
 			},
 		},
+		"vmd backing devices; vmd enabled; named after backing address": {
+			class:     storage.ClassNvme,
+			enableVmd: true,
+			devList:   []string{"5d0505:01:00.0", "5d0505:03:00.0"},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				for i, addr := range []string{"5d0505:01:00.0", "5d0505:03:00.0"} {
+					cfgs[len(cfgs)-3+i] = &SpdkSubsystemConfig{
+						Method: storage.ConfBdevNvmeAttachController,
+						Params: &NvmeAttachControllerParams{
+							TransportType: "PCIe",
+							DeviceName: fmt.Sprintf("Nvme_%s_%s_%d_0", host, addr,
+								tierID),
+							TransportAddress: addr,
+						},
+					}
+				}
+				return cfgs
+			}(),
+			expExtraSubsystems: []*SpdkSubsystem{
+				{
+					Name: "vmd",
+					Configs: []*SpdkSubsystemConfig{
+						{
+							Method: storage.ConfVmdEnable,
+							Params: &VmdEnableParams{},
+						},
+					},
+				},
+			},
+		},
 		"multiple controllers; hotplug enabled; bus-id range specified": {
 			class:         storage.ClassNvme,
 			devList:       []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
@@ -135,6 +182,49 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				},
 			},
 		},
+		"multiple controllers; hotplug enabled; poll period set": {
+			class:           storage.ClassNvme,
+			devList:         []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			enableHotplug:   true,
+			hotplugPollUsec: 1000000,
+			busidRange:      "0x8a-0x8f",
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, true)
+				cfgs[len(cfgs)-1].Params = &NvmeSetHotplugParams{
+					Enable:     true,
+					PeriodUsec: 1000000,
+				}
+				return cfgs
+			}(),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetHotplugBusidRange,
+					Params: &HotplugBusidRangeParams{
+						Begin: 138, End: 143,
+					},
+				},
+			},
+		},
+		"hotplug poll period too long": {
+			class:           storage.ClassNvme,
+			devList:         []string{test.MockPCIAddr(1)},
+			enableHotplug:   true,
+			hotplugPollUsec: storage.MaxHotplugPollPeriodUsec + 1,
+			expValidateErr:  errors.New("bdev_hotplug_poll_us 10000001 exceeds maximum"),
+		},
+		"sparse files requested for nvme class": {
+			class:          storage.ClassNvme,
+			devList:        []string{test.MockPCIAddr(1)},
+			fileSparse:     true,
+			expValidateErr: errors.New("bdev_file_sparse may only be set"),
+		},
+		"zoned set on emulated class": {
+			class:          storage.ClassFile,
+			fileSizeGB:     1,
+			devList:        []string{"/path/to/myfile"},
+			zoned:          true,
+			expValidateErr: errors.New("bdev_zoned may only be set"),
+		},
 		"AIO file class; multiple files; zero file size": {
 			class:          storage.ClassFile,
 			devList:        []string{"/path/to/myfile", "/path/to/myotherfile"},
@@ -196,6 +286,651 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				}...),
 			vosEnv: "AIO",
 		},
+		"AIO kdev class; multiple devices; block size with device override": {
+			class:   storage.ClassKdev,
+			devList: []string{"/dev/sdb", "/dev/sdc"},
+			blockSize: storage.BdevBlockSize{
+				Size:      512,
+				Overrides: map[string]uint32{"/dev/sdc": 8192},
+			},
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevAioCreate,
+						Params: &AioCreateParams{
+							DeviceName: aioName(0, disabledRoleBits),
+							Filename:   "/dev/sdb",
+							BlockSize:  512,
+						},
+					},
+					{
+						Method: storage.ConfBdevAioCreate,
+						Params: &AioCreateParams{
+							DeviceName: aioName(1, disabledRoleBits),
+							Filename:   "/dev/sdc",
+							BlockSize:  8192,
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			vosEnv: "AIO",
+		},
+		"AIO file class; block size set": {
+			class:      storage.ClassFile,
+			fileSizeGB: 1,
+			devList:    []string{"/path/to/myfile"},
+			blockSize:  storage.BdevBlockSize{Size: 512},
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevAioCreate,
+						Params: &AioCreateParams{
+							DeviceName: aioName(0, disabledRoleBits),
+							Filename:   "/path/to/myfile",
+							BlockSize:  512,
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			vosEnv: "AIO",
+		},
+		"null class; multiple devices; block size with device override": {
+			class:      storage.ClassNull,
+			fileSizeGB: 1,
+			devList:    []string{"null0", "null1"},
+			blockSize: storage.BdevBlockSize{
+				Overrides: map[string]uint32{"null1": 512},
+			},
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevNullCreate,
+						Params: &NullCreateParams{
+							DeviceName: fmt.Sprintf("Null_%s", namePostfix(0, disabledRoleBits)),
+							NumBlocks:  humanize.GiByte / (humanize.KiByte * 4),
+							BlockSize:  humanize.KiByte * 4,
+						},
+					},
+					{
+						Method: storage.ConfBdevNullCreate,
+						Params: &NullCreateParams{
+							DeviceName: fmt.Sprintf("Null_%s", namePostfix(1, disabledRoleBits)),
+							NumBlocks:  humanize.GiByte / 512,
+							BlockSize:  512,
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			vosEnv: "NULL",
+		},
+		"malloc class; roles enabled": {
+			class:      storage.ClassMalloc,
+			fileSizeGB: 2,
+			devList:    []string{"malloc0"},
+			devRoles:   storage.BdevRoleAll,
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevMallocCreate,
+						Params: &MallocCreateParams{
+							DeviceName: fmt.Sprintf("Malloc_%s",
+								namePostfix(0, storage.BdevRoleAll)),
+							NumBlocks: 2 * humanize.GiByte / (humanize.KiByte * 4),
+							BlockSize: humanize.KiByte * 4,
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			vosEnv: "MALLOC",
+		},
+		"malloc class; zero size": {
+			class:          storage.ClassMalloc,
+			devList:        []string{"malloc0"},
+			expValidateErr: errors.New("requires non-zero bdev_size"),
+		},
+		"block size set on nvme class": {
+			class:          storage.ClassNvme,
+			devList:        []string{test.MockPCIAddr(1)},
+			blockSize:      storage.BdevBlockSize{Size: 512},
+			expValidateErr: errors.New("bdev_block_size may only be set"),
+		},
+		"AIO kdev class; block size override for unknown device": {
+			class:          storage.ClassKdev,
+			devList:        []string{"/dev/sdb"},
+			blockSize:      storage.BdevBlockSize{Overrides: map[string]uint32{"/dev/sdz": 512}},
+			expValidateErr: errors.New("not in bdev_list"),
+		},
+		"AIO kdev class; block size not power of two": {
+			class:          storage.ClassKdev,
+			devList:        []string{"/dev/sdb"},
+			blockSize:      storage.BdevBlockSize{Size: 3072},
+			expValidateErr: errors.New("must be a power of two"),
+		},
+		"multiple controllers; encryption enabled; not supported by spdk build": {
+			class:    storage.ClassNvme,
+			devList:  []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			devRoles: storage.BdevRoleAll,
+			crypto: storage.BdevCrypto{
+				Enabled:     true,
+				KeyProvider: storage.BdevCryptoKeyProviderEnv,
+			},
+			expValidateErr: storage.FaultBdevFeatureNotInSpdk("bdev_encrypt"),
+		},
+		"encryption enabled on nvme-raid class": {
+			class:   storage.ClassNvmeRaid,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			raid:    storage.BdevRaid{Level: storage.BdevRaidLevel0},
+			crypto: storage.BdevCrypto{
+				Enabled:     true,
+				KeyProvider: storage.BdevCryptoKeyProviderEnv,
+			},
+			expValidateErr: errors.New("bdev_encrypt may only be set"),
+		},
+		"encryption options set without encryption enabled": {
+			class:          storage.ClassNvme,
+			devList:        []string{test.MockPCIAddr(1)},
+			crypto:         storage.BdevCrypto{Cipher: storage.BdevCryptoCipherAesCbc},
+			expValidateErr: errors.New("bdev_encrypt is true"),
+		},
+		"multiple controllers; nvme options set": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			nvmeOptions: storage.BdevNvmeOptions{
+				TimeoutUsec:     30000000,
+				TimeoutAction:   storage.BdevNvmeTimeoutActionReset,
+				RetryCount:      8,
+				IoQueueRequests: 2048,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				cfgs[1] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevNvmeSetOptions,
+					Params: &NvmeSetOptionsParams{
+						TransportRetryCount:      8,
+						TimeoutUsec:              30000000,
+						NvmeAdminqPollPeriodUsec: 100 * 1000,
+						ActionOnTimeout:          storage.BdevNvmeTimeoutActionReset,
+						IoQueueRequests:          2048,
+					},
+				}
+				return cfgs
+			}(),
+		},
+		"multiple controllers; bdev pool options set": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			bdevOptions: storage.BdevOptions{
+				IoPoolSize:       262144,
+				IoCacheSize:      1024,
+				SmallBufPoolSize: 16383,
+				LargeBufPoolSize: 2047,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				cfgs[0] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevSetOptions,
+					Params: &SetOptionsParams{
+						BdevIoPoolSize:   262144,
+						BdevIoCacheSize:  1024,
+						SmallBufPoolSize: 16383,
+						LargeBufPoolSize: 2047,
+					},
+				}
+				return cfgs
+			}(),
+		},
+		"bdev pool options; only buffer counts set": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			bdevOptions: storage.BdevOptions{
+				LargeBufPoolSize: 4095,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				cfgs[0] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevSetOptions,
+					Params: &SetOptionsParams{
+						BdevIoPoolSize:   humanize.KiByte * 64,
+						BdevIoCacheSize:  256,
+						LargeBufPoolSize: 4095,
+					},
+				}
+				return cfgs
+			}(),
+		},
+		"bdev pool options; cache size exceeds pool size": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1)},
+			bdevOptions: storage.BdevOptions{
+				IoPoolSize:  1024,
+				IoCacheSize: 2048,
+			},
+			expValidateErr: errors.New("bdev_io_cache_size 2048 exceeds bdev_io_pool_size 1024"),
+		},
+		"bdev pool options; small buffer count below minimum": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1)},
+			bdevOptions: storage.BdevOptions{
+				SmallBufPoolSize: 1024,
+			},
+			expValidateErr: errors.New("bdev_small_buf_pool_size 1024 is less than minimum"),
+		},
+		"spdk log settings": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			spdkLog: storage.SpdkLog{
+				Level:      "debug",
+				PrintLevel: "notice",
+				Flags:      []string{"bdev_nvme", "nvme"},
+			},
+			expBdevCfgs: multiCtrlrConfs(0, false),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetSpdkLog,
+					Params: &SpdkLogParams{
+						Level:      storage.SpdkLogLevelDebug,
+						PrintLevel: storage.SpdkLogLevelNotice,
+						Flags:      []string{"bdev_nvme", "nvme"},
+					},
+				},
+			},
+		},
+		"spdk log settings; unknown level": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1)},
+			spdkLog: storage.SpdkLog{
+				Level: "verbose",
+			},
+			expValidateErr: errors.New("unknown spdk log level"),
+		},
+		"spdk log settings; duplicate flag": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1)},
+			spdkLog: storage.SpdkLog{
+				Flags: []string{"bdev_nvme", "bdev_nvme"},
+			},
+			expValidateErr: errors.New("spdk log flags"),
+		},
+		"no hugepages; emulated nvme": {
+			class:      storage.ClassFile,
+			fileSizeGB: 1,
+			devList:    []string{"/path/to/myfile"},
+			spdkEnvOpts: storage.SpdkEnvOptions{
+				NoHugepages: true,
+				MemSizeMiB:  4096,
+			},
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevAioCreate,
+						Params: &AioCreateParams{
+							BlockSize:  humanize.KiByte * 4,
+							DeviceName: aioName(0, disabledRoleBits),
+							Filename:   "/path/to/myfile",
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetSpdkEnvOpts,
+					Params: &SpdkEnvOptsParams{
+						NoHugepages: true,
+						MemSizeMiB:  4096,
+					},
+				},
+			},
+			vosEnv: "AIO",
+		},
+		"no hugepages; missing memory size": {
+			class:      storage.ClassFile,
+			fileSizeGB: 1,
+			devList:    []string{"/path/to/myfile"},
+			spdkEnvOpts: storage.SpdkEnvOptions{
+				NoHugepages: true,
+			},
+			expValidateErr: errors.New("spdk_mem_size_mb must be set"),
+		},
+		"no hugepages; memory size set without no hugepages": {
+			class:      storage.ClassFile,
+			fileSizeGB: 1,
+			devList:    []string{"/path/to/myfile"},
+			spdkEnvOpts: storage.SpdkEnvOptions{
+				MemSizeMiB: 4096,
+			},
+			expValidateErr: errors.New("spdk_mem_size_mb can only be set"),
+		},
+		"no hugepages; real nvme": {
+			class:   storage.ClassNvme,
+			devList: []string{test.MockPCIAddr(1)},
+			spdkEnvOpts: storage.SpdkEnvOptions{
+				NoHugepages: true,
+				MemSizeMiB:  4096,
+			},
+			expValidateErr: storage.FaultBdevConfigNoHugepagesWithRealNVMe,
+		},
+		"nvme options set on emulated class": {
+			class:      storage.ClassFile,
+			fileSizeGB: 1,
+			devList:    []string{"/path/to/myfile"},
+			nvmeOptions: storage.BdevNvmeOptions{
+				RetryCount: 8,
+			},
+			expValidateErr: errors.New("bdev_nvme options may not be set"),
+		},
+		"nvme-raid class; single device": {
+			class:   storage.ClassNvmeRaid,
+			devList: []string{test.MockPCIAddr(1)},
+			raid: storage.BdevRaid{
+				Level: storage.BdevRaidLevel0,
+			},
+			expValidateErr: errors.New("requires at least 2 devices"),
+		},
+		"nvme-raid class; raid1 not supported by spdk build": {
+			class:   storage.ClassNvmeRaid,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			raid: storage.BdevRaid{
+				Level: storage.BdevRaidLevel1,
+			},
+			expValidateErr: storage.FaultBdevFeatureNotInSpdk("bdev_raid_level raid1"),
+		},
+		"nvme-raid class; raid0 with default strip size": {
+			class:   storage.ClassNvmeRaid,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			raid: storage.BdevRaid{
+				Level: storage.BdevRaidLevel0,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				hp := cfgs[len(cfgs)-1]
+				cfgs[len(cfgs)-1] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevRaidCreate,
+					Params: &RaidCreateParams{
+						DeviceName:   fmt.Sprintf("Raid_%s", namePostfix(0, 0)),
+						RaidLevel:    storage.BdevRaidLevel0,
+						StripSizeKiB: storage.DefaultBdevRaidStripSizeKiB,
+						BaseBdevs:    []string{nvmeName(0, 0) + "n1", nvmeName(1, 0) + "n1"},
+					},
+				}
+				return append(cfgs, hp)
+			}(),
+			vosEnv: "RAID",
+		},
+		"nvme-raid class; roles assigned to raid bdev only": {
+			class:    storage.ClassNvmeRaid,
+			devList:  []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			devRoles: storage.BdevRoleAll,
+			raid: storage.BdevRaid{
+				Level: storage.BdevRaidLevel0,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				hp := cfgs[len(cfgs)-1]
+				cfgs[len(cfgs)-1] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevRaidCreate,
+					Params: &RaidCreateParams{
+						DeviceName: fmt.Sprintf("Raid_%s",
+							namePostfix(0, storage.BdevRoleAll)),
+						RaidLevel:    storage.BdevRaidLevel0,
+						StripSizeKiB: storage.DefaultBdevRaidStripSizeKiB,
+						BaseBdevs:    []string{nvmeName(0, 0) + "n1", nvmeName(1, 0) + "n1"},
+					},
+				}
+				return append(cfgs, hp)
+			}(),
+			vosEnv: "RAID",
+		},
+		"nvme-raid class; namespaces selected": {
+			class:   storage.ClassNvmeRaid,
+			devList: []string{test.MockPCIAddr(1) + ":ns=2", test.MockPCIAddr(2)},
+			raid: storage.BdevRaid{
+				Level: storage.BdevRaidLevel0,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				hp := cfgs[len(cfgs)-1]
+				cfgs[len(cfgs)-1] = &SpdkSubsystemConfig{
+					Method: storage.ConfBdevRaidCreate,
+					Params: &RaidCreateParams{
+						DeviceName:   fmt.Sprintf("Raid_%s", namePostfix(0, 0)),
+						RaidLevel:    storage.BdevRaidLevel0,
+						StripSizeKiB: storage.DefaultBdevRaidStripSizeKiB,
+						BaseBdevs:    []string{nvmeName(0, 0) + "n2", nvmeName(1, 0) + "n1"},
+					},
+				}
+				return append(cfgs, hp)
+			}(),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetNsSelect,
+					Params: &NsSelectParams{
+						BdevNames: []string{nvmeName(0, 0) + "n2"},
+					},
+				},
+			},
+			vosEnv: "RAID",
+		},
+		"multiple controllers; namespaces selected": {
+			class:       storage.ClassNvme,
+			devList:     []string{test.MockPCIAddr(1) + ":ns=2", test.MockPCIAddr(2) + ":ns=1"},
+			expBdevCfgs: multiCtrlrConfs(0, false),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetNsSelect,
+					Params: &NsSelectParams{
+						BdevNames: []string{nvmeName(0, 0) + "n2", nvmeName(1, 0) + "n1"},
+					},
+				},
+			},
+		},
+		"nvme-cache class; not supported by spdk build": {
+			class:    storage.ClassNvmeCache,
+			devList:  []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			devRoles: storage.BdevRoleAll,
+			cache: storage.BdevCache{
+				Device:      test.MockPCIAddr(3),
+				LineSizeKiB: 16,
+			},
+			expValidateErr: storage.FaultBdevFeatureNotInSpdk("class nvme-cache"),
+		},
+		"nvme-delay class; p99 less than average": {
+			class:   storage.ClassDelay,
+			devList: []string{test.MockPCIAddr(1)},
+			delay: storage.BdevDelay{
+				AvgReadLatencyUsec: 100,
+				P99ReadLatencyUsec: 50,
+			},
+			expValidateErr: errors.New("may not be less than"),
+		},
+		"nvme-delay class; multiple controllers": {
+			class:   storage.ClassDelay,
+			devList: []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			delay: storage.BdevDelay{
+				AvgReadLatencyUsec:  100,
+				P99ReadLatencyUsec:  1000,
+				AvgWriteLatencyUsec: 200,
+				P99WriteLatencyUsec: 2000,
+			},
+			expBdevCfgs: func() []*SpdkSubsystemConfig {
+				cfgs := multiCtrlrConfs(0, false)
+				hp := cfgs[len(cfgs)-1]
+				cfgs = cfgs[:len(cfgs)-1]
+				for i := 0; i < 2; i++ {
+					cfgs = append(cfgs, &SpdkSubsystemConfig{
+						Method: storage.ConfBdevDelayCreate,
+						Params: &DelayCreateParams{
+							BaseDeviceName:      nvmeName(i, 0) + "n1",
+							DeviceName:          fmt.Sprintf("Delay_%s", namePostfix(i, 0)),
+							AvgReadLatencyUsec:  100,
+							P99ReadLatencyUsec:  1000,
+							AvgWriteLatencyUsec: 200,
+							P99WriteLatencyUsec: 2000,
+						},
+					})
+				}
+				return append(cfgs, hp)
+			}(),
+			vosEnv: "DELAY",
+		},
+		"nvme-error class; missing count": {
+			class:   storage.ClassError,
+			devList: []string{test.MockPCIAddr(1)},
+			errInject: storage.BdevErrorInject{
+				IoType:    storage.BdevErrorIoTypeRead,
+				ErrorType: storage.BdevErrorTypeFailure,
+			},
+			expValidateErr: errors.New("requires non-zero bdev_error_count"),
+		},
+		"nvme-error class; spdk rpc server disabled": {
+			class:   storage.ClassError,
+			devList: []string{test.MockPCIAddr(1)},
+			errInject: storage.BdevErrorInject{
+				IoType:    storage.BdevErrorIoTypeWrite,
+				ErrorType: storage.BdevErrorTypeFailure,
+				Count:     10,
+			},
+			expValidateErr: errors.New("requires spdk_rpc_server to be enabled"),
+		},
+		"nvme-error class; single controller": {
+			class:   storage.ClassError,
+			devList: []string{test.MockPCIAddr(1)},
+			errInject: storage.BdevErrorInject{
+				IoType:    storage.BdevErrorIoTypeWrite,
+				ErrorType: storage.BdevErrorTypeFailure,
+				Count:     10,
+			},
+			rpcSrvEnable: true,
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					bdevCfg(0, 0),
+					{
+						Method: storage.ConfBdevErrorCreate,
+						Params: &ErrorCreateParams{
+							BaseDeviceName: nvmeName(0, 0) + "n1",
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetSpdkRpcServer,
+					Params: &SpdkRpcServerParams{
+						Enable: true,
+					},
+				},
+				{
+					Method: storage.ConfBdevErrorInjectError,
+					Params: &ErrorInjectParams{
+						DeviceName: "EE_" + nvmeName(0, 0) + "n1",
+						IoType:     storage.BdevErrorIoTypeWrite,
+						ErrorType:  storage.BdevErrorTypeFailure,
+						Count:      10,
+					},
+				},
+			},
+			vosEnv: "ERROR",
+		},
+		"multiple controllers; dsa accel module": {
+			class:        storage.ClassNvme,
+			devList:      []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
+			accelEngine:  storage.AccelEngineSPDK,
+			accelOptMask: storage.AccelOptCRCFlag | storage.AccelOptMoveFlag,
+			accelModules: []string{storage.AccelModuleDSA},
+			expBdevCfgs:  multiCtrlrConfs(0, false),
+			expExtraSubsystems: []*SpdkSubsystem{
+				{
+					Name: "accel",
+					Configs: []*SpdkSubsystemConfig{
+						{
+							Method: storage.ConfIdxdScanAccelEngine,
+							Params: &IdxdScanAccelEngineParams{},
+						},
+					},
+				},
+			},
+			expDaosCfgs: []*DaosConfig{
+				{
+					Method: storage.ConfSetAccelProps,
+					Params: &AccelPropsParams{
+						Engine:  storage.AccelEngineSPDK,
+						Options: storage.AccelOptCRCFlag | storage.AccelOptMoveFlag,
+						Modules: []string{storage.AccelModuleDSA},
+					},
+				},
+			},
+		},
+		"nvmf class; missing subsystem nqn": {
+			class:   storage.ClassNvmeFabrics,
+			devList: []string{"10.0.0.1"},
+			transport: storage.BdevTransport{
+				Type: storage.BdevTransportRDMA,
+			},
+			expValidateErr: errors.New("requires bdev_subnqn"),
+		},
+		"nvmf class; pci address in device list": {
+			class:   storage.ClassNvmeFabrics,
+			devList: []string{test.MockPCIAddr(1)},
+			transport: storage.BdevTransport{
+				Type:   storage.BdevTransportTCP,
+				SubNQN: "nqn.2016-06.io.spdk:cnode1",
+			},
+			expValidateErr: errors.New("requires IP addresses"),
+		},
+		"nvmf class; multiple remote controllers": {
+			class:   storage.ClassNvmeFabrics,
+			devList: []string{"10.0.0.1", "fd00::2"},
+			transport: storage.BdevTransport{
+				Type:   storage.BdevTransportTCP,
+				SubNQN: "nqn.2016-06.io.spdk:cnode1",
+			},
+			expBdevCfgs: append(defaultSpdkConfig().Subsystems[0].Configs,
+				[]*SpdkSubsystemConfig{
+					{
+						Method: storage.ConfBdevNvmeAttachController,
+						Params: &NvmeAttachControllerParams{
+							TransportType:    "TCP",
+							DeviceName:       nvmeName(0, disabledRoleBits),
+							TransportAddress: "10.0.0.1",
+							AddressFamily:    "IPv4",
+							TransportSvcID:   storage.DefaultBdevTransportSvcID,
+							SubsystemNQN:     "nqn.2016-06.io.spdk:cnode1",
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeAttachController,
+						Params: &NvmeAttachControllerParams{
+							TransportType:    "TCP",
+							DeviceName:       nvmeName(1, disabledRoleBits),
+							TransportAddress: "fd00::2",
+							AddressFamily:    "IPv6",
+							TransportSvcID:   storage.DefaultBdevTransportSvcID,
+							SubsystemNQN:     "nqn.2016-06.io.spdk:cnode1",
+						},
+					},
+					{
+						Method: storage.ConfBdevNvmeSetHotplug,
+						Params: &NvmeSetHotplugParams{},
+					},
+				}...),
+		},
 		"multiple controllers; accel, rpc server & auto faulty settings": {
 			class:            storage.ClassNvme,
 			devList:          []string{test.MockPCIAddr(1), test.MockPCIAddr(2)},
@@ -234,6 +969,9 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 		},
 	}
 
+	t.Setenv(cryptoKeyEnvVar, testCryptoKey)
+	t.Setenv(cryptoKey2EnvVar, testCryptoKey2)
+
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -245,8 +983,18 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				Bdev: storage.BdevConfig{
 					DeviceList:  storage.MustNewBdevDeviceList(tc.devList...),
 					FileSize:    tc.fileSizeGB,
+					FileSparse:  tc.fileSparse,
+					Zoned:       tc.zoned,
 					BusidRange:  storage.MustNewBdevBusRange(tc.busidRange),
 					DeviceRoles: storage.BdevRolesFromBits(tc.devRoles),
+					Transport:   tc.transport,
+					NvmeOptions: tc.nvmeOptions,
+					Raid:        tc.raid,
+					Delay:       tc.delay,
+					ErrorInject: tc.errInject,
+					BlockSize:   tc.blockSize,
+					Crypto:      tc.crypto,
+					Cache:       tc.cache,
 				},
 			}
 			if tc.class != "" {
@@ -268,6 +1016,11 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 					cfg,
 				).
 				WithStorageEnableHotplug(tc.enableHotplug).
+				WithStorageHotplugPollPeriod(tc.hotplugPollUsec).
+				WithStorageBdevOptions(tc.bdevOptions).
+				WithStorageSpdkEnvOptions(tc.spdkEnvOpts).
+				WithStorageSpdkLog(tc.spdkLog.Level, tc.spdkLog.PrintLevel,
+					tc.spdkLog.Flags...).
 				WithTargetCount(8).
 				WithPinnedNumaNode(0).
 				WithStorageAccelProps(tc.accelEngine, tc.accelOptMask).
@@ -275,6 +1028,8 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 				WithStorageAutoFaultyCriteria(tc.autoFaultyEnable, tc.autoFaultyIO,
 					tc.autoFaultyCsum)
 
+			engineConfig.Storage.AccelProps.Modules = tc.accelModules
+
 			if tc.devRoles != 0 {
 				engineConfig.Storage.ControlMetadata = storage.ControlMetadata{
 					Path: "/opt/daos_md",
@@ -288,7 +1043,7 @@ func TestBackend_newSpdkConfig(t *testing.T) {
 			}
 
 			writeReq, _ := storage.BdevWriteConfigRequestFromConfig(test.Context(t), log,
-				&engineConfig.Storage, tc.enableVmd, storage.MockGetTopology)
+				&engineConfig.Storage, tc.enableVmd, storage.MockGetTopology, nil)
 
 			gotCfg, gotErr := newSpdkConfig(log, writeReq)
 			test.CmpErr(t, tc.expErr, gotErr)
@@ -332,3 +1087,142 @@ func TestBackend_unreadableSpdkConfig(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+// TestBackend_nvmfSpdkConfigRoundTrip verifies that NVMe-oF controller entries survive a write and
+// read of the SPDK config file and carry the transport type that the engine relies on to keep
+// remote controller addresses out of the SPDK PCI allowed list.
+func TestBackend_nvmfSpdkConfigRoundTrip(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	engineConfig := engine.MockConfig().
+		WithFabricProvider("test").
+		WithFabricInterface("ib0").
+		WithFabricInterfacePort(42).
+		WithStorage(
+			storage.NewTierConfig().
+				WithStorageClass("ram").
+				WithScmRamdiskSize(16).
+				WithScmMountPoint("/mock/mnt/daos"),
+			&storage.TierConfig{
+				Tier:  1,
+				Class: storage.ClassNvmeFabrics,
+				Bdev: storage.BdevConfig{
+					DeviceList: storage.MustNewBdevDeviceList("10.0.0.1", "fd00::2"),
+					Transport: storage.BdevTransport{
+						Type:   storage.BdevTransportRDMA,
+						SvcID:  "4421",
+						SubNQN: "nqn.2016-06.io.spdk:cnode1",
+					},
+				},
+			},
+		).
+		WithTargetCount(8).
+		WithPinnedNumaNode(0)
+
+	if err := engineConfig.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	writeReq, err := storage.BdevWriteConfigRequestFromConfig(test.Context(t), log,
+		&engineConfig.Storage, false, storage.MockGetTopology, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantCfg, err := newSpdkConfig(log, writeReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(wantCfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotCfg, err := readSpdkConfig(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantCfg, gotCfg); diff != "" {
+		t.Fatalf("(-want, +got):\n%s", diff)
+	}
+
+	// Inspect the raw JSON as the engine does when building the PCI allowed list.
+	var raw struct {
+		Subsystems []struct {
+			Configs []struct {
+				Method string         `json:"method"`
+				Params map[string]any `json:"params"`
+			} `json:"config"`
+		} `json:"subsystems"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	var gotAddrs []string
+	for _, ss := range raw.Subsystems {
+		for _, c := range ss.Configs {
+			if c.Method != storage.ConfBdevNvmeAttachController {
+				continue
+			}
+			test.AssertEqual(t, "RDMA", c.Params["trtype"], "unexpected trtype")
+			test.AssertEqual(t, "4421", c.Params["trsvcid"], "unexpected trsvcid")
+			test.AssertEqual(t, "nqn.2016-06.io.spdk:cnode1", c.Params["subnqn"],
+				"unexpected subnqn")
+			gotAddrs = append(gotAddrs,
+				fmt.Sprintf("%s/%s", c.Params["traddr"], c.Params["adrfam"]))
+		}
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1/IPv4", "fd00::2/IPv6"}, gotAddrs); diff != "" {
+		t.Fatalf("unexpected controller addresses (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBackend_ControllerName(t *testing.T) {
+	req := &storage.BdevWriteConfigRequest{
+		Hostname: "host",
+		TierProps: []storage.BdevTierProperties{
+			{
+				Class:      storage.ClassNvme,
+				DeviceList: storage.MustNewBdevDeviceList(test.MockPCIAddrs(1, 2)...),
+				Tier:       1,
+			},
+			{
+				Class:      storage.ClassFile,
+				DeviceList: storage.MustNewBdevDeviceList("/tmp/daos0.aio"),
+				Tier:       2,
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		addr    string
+		expName string
+		expErr  error
+	}{
+		"first controller": {
+			addr:    test.MockPCIAddr(1),
+			expName: "Nvme_host_0_1_0",
+		},
+		"second controller": {
+			addr:    test.MockPCIAddr(2),
+			expName: "Nvme_host_1_1_0",
+		},
+		"aio file": {
+			addr:   "/tmp/daos0.aio",
+			expErr: errors.New("no controller with address"),
+		},
+		"unknown address": {
+			addr:   test.MockPCIAddr(3),
+			expErr: errors.New("no controller with address"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotName, gotErr := ControllerName(req, tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			test.AssertEqual(t, tc.expName, gotName, "unexpected controller name")
+		})
+	}
+}
@@ -241,7 +241,7 @@ func TestBackend_Scan(t *testing.T) {
 					Env:  mei,
 					Nvme: &spdk.MockNvmeImpl{Cfg: tc.mnc},
 				},
-				script: sr,
+				binder: sr,
 			}
 
 			gotResp, gotErr := b.Scan(tc.req)
@@ -554,7 +554,7 @@ func TestBackend_Format(t *testing.T) {
 					Env:  mei,
 					Nvme: &spdk.MockNvmeImpl{Cfg: tc.mnc},
 				},
-				script: sr,
+				binder: sr,
 			}
 
 			// output path would be set during config validate
@@ -592,6 +592,14 @@ func TestBackend_Format(t *testing.T) {
 }
 
 func TestBackend_writeNvmeConfig(t *testing.T) {
+	zonedCtrlr := storage.MockNvmeController(1)
+	zonedCtrlr.Namespaces = append(zonedCtrlr.Namespaces, &storage.NvmeNamespace{
+		ID:    2,
+		Size:  humanize.TByte,
+		Zoned: true,
+	})
+	zonedCtrlrs := storage.NvmeControllers{zonedCtrlr}
+
 	for name, tc := range map[string]struct {
 		req      storage.BdevWriteConfigRequest
 		writeErr error
@@ -673,6 +681,40 @@ func TestBackend_writeNvmeConfig(t *testing.T) {
 				ScannedBdevs: mockCtrlrsInclVMD(),
 			},
 		},
+		"zoned namespace not enabled": {
+			req: storage.BdevWriteConfigRequest{
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:      storage.ClassNvme,
+						DeviceList: storage.MustNewBdevDeviceList(test.MockPCIAddr(1)),
+					},
+				},
+				ScannedBdevs: zonedCtrlrs,
+			},
+			expErr: FaultZonedNamespace(test.MockPCIAddr(1), 2),
+		},
+		"zoned namespace enabled": {
+			req: storage.BdevWriteConfigRequest{
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:       storage.ClassNvme,
+						DeviceList:  storage.MustNewBdevDeviceList(test.MockPCIAddr(1)),
+						DeviceZoned: true,
+					},
+				},
+				ScannedBdevs: zonedCtrlrs,
+			},
+			expCall: &storage.BdevWriteConfigRequest{
+				TierProps: []storage.BdevTierProperties{
+					{
+						Class:       storage.ClassNvme,
+						DeviceList:  storage.MustNewBdevDeviceList(test.MockPCIAddr(1)),
+						DeviceZoned: true,
+					},
+				},
+				ScannedBdevs: zonedCtrlrs,
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
@@ -736,7 +778,134 @@ func TestBackend_Update(t *testing.T) {
 
 			b := backendWithMockBinding(log, tc.mec, tc.mnc)
 
-			gotErr := b.UpdateFirmware(tc.pciAddr, "/some/path", 0)
+			gotErr := b.UpdateFirmware(tc.pciAddr, "/some/path", 0, false)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestBackend_CreateNamespaces(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     storage.BdevNamespaceCreateRequest
+		mec     spdk.MockEnvCfg
+		mnc     spdk.MockNvmeCfg
+		expResp *storage.BdevNamespaceCreateResponse
+		expErr  error
+	}{
+		"no PCI addr": {
+			req:    storage.BdevNamespaceCreateRequest{Count: 1},
+			expErr: FaultBadPCIAddr(""),
+		},
+		"multiple PCI addrs": {
+			req: storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1) + " " + test.MockPCIAddr(2),
+				Count:   1,
+			},
+			expErr: FaultBadPCIAddr(test.MockPCIAddr(1) + " " + test.MockPCIAddr(2)),
+		},
+		"binding init fail": {
+			req: storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   1,
+			},
+			mec: spdk.MockEnvCfg{
+				InitErr: errors.New("spdk says no"),
+			},
+			expErr: errors.New("spdk says no"),
+		},
+		"binding create fail": {
+			req: storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   1,
+			},
+			mnc: spdk.MockNvmeCfg{
+				CreateNsErr: errors.New("spdk says no"),
+			},
+			expErr: errors.New("create namespaces on " + test.MockPCIAddr(1)),
+		},
+		"binding create success": {
+			req: storage.BdevNamespaceCreateRequest{
+				PciAddr: test.MockPCIAddr(1),
+				Count:   2,
+			},
+			mnc: spdk.MockNvmeCfg{
+				CreateNsRes: []*storage.NvmeNamespace{
+					{ID: 1, Size: humanize.TByte},
+					{ID: 2, Size: humanize.TByte},
+				},
+			},
+			expResp: &storage.BdevNamespaceCreateResponse{
+				Namespaces: []*storage.NvmeNamespace{
+					{ID: 1, Size: humanize.TByte},
+					{ID: 2, Size: humanize.TByte},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			b := backendWithMockBinding(log, tc.mec, tc.mnc)
+
+			gotResp, gotErr := b.CreateNamespaces(tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if gotErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("\nunexpected response (-want, +got):\n%s\n", diff)
+			}
+
+			mei := b.binding.Env.(*spdk.MockEnvImpl)
+			if len(mei.InitCalls) != 1 {
+				t.Fatalf("expected 1 spdk env init call, got %d", len(mei.InitCalls))
+			}
+			if diff := cmp.Diff(tc.req.PciAddr, mei.InitCalls[0].PCIAllowList.String()); diff != "" {
+				t.Fatalf("\nunexpected spdk env allow list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestBackend_DeleteNamespace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    storage.BdevNamespaceDeleteRequest
+		mnc    spdk.MockNvmeCfg
+		expErr error
+	}{
+		"bad PCI addr": {
+			req: storage.BdevNamespaceDeleteRequest{
+				PciAddr: "abc",
+				NsID:    1,
+			},
+			expErr: FaultBadPCIAddr("abc"),
+		},
+		"binding delete fail": {
+			req: storage.BdevNamespaceDeleteRequest{
+				PciAddr: test.MockPCIAddr(1),
+				NsID:    2,
+			},
+			mnc: spdk.MockNvmeCfg{
+				DeleteNsErr: errors.New("spdk says no"),
+			},
+			expErr: errors.New("delete namespace 2 on " + test.MockPCIAddr(1)),
+		},
+		"binding delete success": {
+			req: storage.BdevNamespaceDeleteRequest{
+				PciAddr: test.MockPCIAddr(1),
+				NsID:    2,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			b := backendWithMockBinding(log, spdk.MockEnvCfg{}, tc.mnc)
+
+			_, gotErr := b.DeleteNamespace(tc.req)
 			test.CmpErr(t, tc.expErr, gotErr)
 		})
 	}
@@ -1425,7 +1594,7 @@ func TestBackend_prepare_reset(t *testing.T) {
 				binding: &spdkWrapper{
 					Nvme: tc.nvme,
 				},
-				script: sss,
+				binder: sss,
 			}
 
 			if tc.expResp == nil {
@@ -1492,6 +1661,47 @@ func TestBackend_prepare_reset(t *testing.T) {
 	}
 }
 
+func testWriteReq(hotplug bool) *storage.BdevWriteConfigRequest {
+	return &storage.BdevWriteConfigRequest{
+		Hostname:       "testHost",
+		HotplugEnabled: hotplug,
+		TierProps: []storage.BdevTierProperties{
+			{
+				Class:      storage.ClassKdev,
+				DeviceList: storage.MustNewBdevDeviceList("/dev/sdb"),
+				Tier:       1,
+			},
+		},
+	}
+}
+
+func writeTestSpdkConfig(t *testing.T, req *storage.BdevWriteConfigRequest) string {
+	t.Helper()
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	data, err := RenderJsonConfig(log, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCfg := filepath.Join(t.TempDir(), "spdk.conf")
+	if err := os.WriteFile(testCfg, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sumReq := *req
+	sumReq.ConfigOutputPath = testCfg
+	sumReq.OwnerUID = os.Getuid()
+	sumReq.OwnerGID = os.Getgid()
+	if err := writeConfigChecksum(data, &sumReq); err != nil {
+		t.Fatal(err)
+	}
+
+	return testCfg
+}
+
 func TestBdev_spdkBackend_ReadConfig(t *testing.T) {
 	for name, tc := range map[string]struct {
 		setup   func(t *testing.T, req *storage.BdevReadConfigRequest)
@@ -1575,9 +1785,56 @@ func TestBdev_spdkBackend_ReadConfig(t *testing.T) {
 				}
 				req.ConfigPath = testCfg
 			},
-			req:     storage.BdevReadConfigRequest{},
+			req: storage.BdevReadConfigRequest{},
+			expResp: &storage.BdevReadConfigResponse{
+				ChecksumMissing: true,
+			},
+		},
+		"good config path; modified since generated": {
+			setup: func(t *testing.T, req *storage.BdevReadConfigRequest) {
+				t.Helper()
+				req.ConfigPath = writeTestSpdkConfig(t, testWriteReq(false))
+				data, err := os.ReadFile(req.ConfigPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				data = bytes.Replace(data, []byte("/dev/sdb"), []byte("/dev/sdc"), 1)
+				if err := os.WriteFile(req.ConfigPath, data, 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			req: storage.BdevReadConfigRequest{},
+			expResp: &storage.BdevReadConfigResponse{
+				ConfigModified: true,
+			},
+		},
+		"good config path; matches expected config": {
+			setup: func(t *testing.T, req *storage.BdevReadConfigRequest) {
+				t.Helper()
+				req.ConfigPath = writeTestSpdkConfig(t, testWriteReq(false))
+			},
+			req: storage.BdevReadConfigRequest{
+				ExpectedConfig: testWriteReq(false),
+			},
 			expResp: &storage.BdevReadConfigResponse{},
 		},
+		"good config path; differs from expected config": {
+			setup: func(t *testing.T, req *storage.BdevReadConfigRequest) {
+				t.Helper()
+				req.ConfigPath = writeTestSpdkConfig(t, testWriteReq(true))
+			},
+			req: storage.BdevReadConfigRequest{
+				ExpectedConfig: testWriteReq(false),
+			},
+			expResp: &storage.BdevReadConfigResponse{
+				InputsChanged: true,
+				Differences: []string{
+					`bdev: missing bdev_nvme_set_hotplug {"enable":false,"period_us":0}`,
+					`bdev: unexpected bdev_nvme_set_hotplug {"enable":true,"period_us":5000000}`,
+					`daos_data: unexpected hotplug_busid_range {"begin":0,"end":0}`,
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			ctx := test.MustLogContext(t, test.Context(t))
@@ -9,6 +9,8 @@ package bdev
 import (
 	"fmt"
 
+	"github.com/dustin/go-humanize"
+
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 )
@@ -60,6 +62,39 @@ func FaultFormatError(dev string, err error) *fault.Fault {
 	)
 }
 
+// FaultFormatNoSpace creates a Fault for the case where the filesystem holding an AIO backing
+// file has insufficient free space to allocate it.
+func FaultFormatNoSpace(path string, size, avail uint64) *fault.Fault {
+	return bdevFault(
+		code.BdevFormatNoSpace,
+		fmt.Sprintf("insufficient space to allocate %s AIO file %q, %s available",
+			humanize.IBytes(size), path, humanize.IBytes(avail)),
+		"free space on the filesystem holding the file, or reduce bdev_size or set bdev_file_sparse, then retry the format",
+	)
+}
+
+// FaultZonedNamespace creates a Fault for the case where a configured NVMe controller has a zoned
+// namespace (ZNS) but zoned namespaces have not been enabled on the storage tier.
+func FaultZonedNamespace(pciAddr string, nsID uint32) *fault.Fault {
+	return bdevFault(
+		code.BdevZonedNamespace,
+		fmt.Sprintf("NVMe controller %s has zoned namespace %d which is not enabled in config",
+			pciAddr, nsID),
+		"remove the device from the bdev_list of the storage tier or set bdev_zoned on the tier in the server config file then restart daos_server",
+	)
+}
+
+// FaultIommuGroupNotViable creates a Fault for the case where an NVMe device cannot be bound to
+// vfio-pci because another device in the same IOMMU group is in use by a kernel driver.
+func FaultIommuGroupNotViable(pciAddr, group, member, driver string) *fault.Fault {
+	return bdevFault(
+		code.BdevIommuGroupNotViable,
+		fmt.Sprintf("IOMMU group %s of NVMe device %s is not viable, device %s is bound to driver %s",
+			group, pciAddr, member, driver),
+		"unbind the other devices in the IOMMU group from their kernel drivers or add the NVMe device to bdev_exclude, then retry",
+	)
+}
+
 func bdevFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "bdev",
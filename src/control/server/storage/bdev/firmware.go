@@ -14,9 +14,9 @@ import (
 )
 
 const (
-	// defaultFirmwareSlot is the slot automatically chosen for the firmware
-	// update
-	defaultFirmwareSlot = 0
+	// maxFirmwareSlot is the highest firmware slot number supported by NVMe. Slot zero
+	// requests that the controller chooses the slot to update.
+	maxFirmwareSlot = 7
 )
 
 // QueryFirmware requests the firmware information for the NVMe device controller.
@@ -110,6 +110,10 @@ func (p *Provider) UpdateFirmware(req storage.NVMeFirmwareUpdateRequest) (*stora
 	if len(req.FirmwarePath) == 0 {
 		return nil, errors.New("missing path to firmware file")
 	}
+	if req.Slot < 0 || req.Slot > maxFirmwareSlot {
+		return nil, errors.Errorf("invalid firmware slot %d (valid: 0-%d)", req.Slot,
+			maxFirmwareSlot)
+	}
 
 	controllers, err := p.getRequestedControllers(req.DeviceAddrs, req.ModelID, req.FirmwareRev, false)
 	if err != nil {
@@ -124,7 +128,7 @@ func (p *Provider) UpdateFirmware(req storage.NVMeFirmwareUpdateRequest) (*stora
 		Results: make([]storage.NVMeDeviceFirmwareUpdateResult, len(controllers)),
 	}
 	for i, con := range controllers {
-		err = p.backend.UpdateFirmware(con.PciAddr, req.FirmwarePath, defaultFirmwareSlot)
+		err = p.backend.UpdateFirmware(con.PciAddr, req.FirmwarePath, req.Slot, req.Stage)
 		resp.Results[i].Device = *con
 		if err != nil {
 			resp.Results[i].Error = err.Error()
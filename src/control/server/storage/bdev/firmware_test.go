@@ -215,6 +215,13 @@ func TestProvider_UpdateFirmware(t *testing.T) {
 		"empty path": {
 			expErr: errors.New("missing path to firmware file"),
 		},
+		"invalid slot": {
+			input: storage.NVMeFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				Slot:         maxFirmwareSlot + 1,
+			},
+			expErr: errors.New("invalid firmware slot 8"),
+		},
 		"NVMe device scan failed": {
 			input:      storage.NVMeFirmwareUpdateRequest{FirmwarePath: testPath},
 			backendCfg: &MockBackendConfig{ScanErr: errors.New("mock scan")},
@@ -28,6 +28,14 @@ type (
 		WriteConfRes *storage.BdevWriteConfigResponse
 		WriteConfErr error
 		UpdateErr    error
+		AttachRes    *storage.BdevAttachResponse
+		AttachErr    error
+		DetachErr    error
+		InjectRes    *storage.BdevInjectErrorsResponse
+		InjectErr    error
+		CreateNsRes  *storage.BdevNamespaceCreateResponse
+		CreateNsErr  error
+		DeleteNsErr  error
 	}
 
 	MockBackend struct {
@@ -37,6 +45,11 @@ type (
 		ResetCalls     []storage.BdevPrepareRequest
 		WriteConfCalls []storage.BdevWriteConfigRequest
 		ScanCalls      []storage.BdevScanRequest
+		AttachCalls    []storage.BdevAttachRequest
+		DetachCalls    []storage.BdevDetachRequest
+		InjectCalls    []storage.BdevInjectErrorsRequest
+		CreateNsCalls  []storage.BdevNamespaceCreateRequest
+		DeleteNsCalls  []storage.BdevNamespaceDeleteRequest
 	}
 )
 
@@ -110,7 +123,7 @@ func (mb *MockBackend) Reset(req storage.BdevPrepareRequest) (*storage.BdevPrepa
 	}
 }
 
-func (mb *MockBackend) UpdateFirmware(_ string, _ string, _ int32) error {
+func (mb *MockBackend) UpdateFirmware(_ string, _ string, _ int32, _ bool) error {
 	return mb.cfg.UpdateErr
 }
 
@@ -133,6 +146,75 @@ func (mb *MockBackend) ReadConfig(_ storage.BdevReadConfigRequest) (*storage.Bde
 	return &storage.BdevReadConfigResponse{}, nil
 }
 
+func (mb *MockBackend) AttachController(req storage.BdevAttachRequest) (*storage.BdevAttachResponse, error) {
+	mb.Lock()
+	mb.AttachCalls = append(mb.AttachCalls, req)
+	mb.Unlock()
+
+	switch {
+	case mb.cfg.AttachErr != nil:
+		return nil, mb.cfg.AttachErr
+	case mb.cfg.AttachRes == nil:
+		return &storage.BdevAttachResponse{}, nil
+	default:
+		return mb.cfg.AttachRes, nil
+	}
+}
+
+func (mb *MockBackend) DetachController(req storage.BdevDetachRequest) (*storage.BdevDetachResponse, error) {
+	mb.Lock()
+	mb.DetachCalls = append(mb.DetachCalls, req)
+	mb.Unlock()
+
+	if mb.cfg.DetachErr != nil {
+		return nil, mb.cfg.DetachErr
+	}
+
+	return &storage.BdevDetachResponse{}, nil
+}
+
+func (mb *MockBackend) InjectErrors(req storage.BdevInjectErrorsRequest) (*storage.BdevInjectErrorsResponse, error) {
+	mb.Lock()
+	mb.InjectCalls = append(mb.InjectCalls, req)
+	mb.Unlock()
+
+	switch {
+	case mb.cfg.InjectErr != nil:
+		return nil, mb.cfg.InjectErr
+	case mb.cfg.InjectRes == nil:
+		return &storage.BdevInjectErrorsResponse{}, nil
+	default:
+		return mb.cfg.InjectRes, nil
+	}
+}
+
+func (mb *MockBackend) CreateNamespaces(req storage.BdevNamespaceCreateRequest) (*storage.BdevNamespaceCreateResponse, error) {
+	mb.Lock()
+	mb.CreateNsCalls = append(mb.CreateNsCalls, req)
+	mb.Unlock()
+
+	switch {
+	case mb.cfg.CreateNsErr != nil:
+		return nil, mb.cfg.CreateNsErr
+	case mb.cfg.CreateNsRes == nil:
+		return &storage.BdevNamespaceCreateResponse{}, nil
+	default:
+		return mb.cfg.CreateNsRes, nil
+	}
+}
+
+func (mb *MockBackend) DeleteNamespace(req storage.BdevNamespaceDeleteRequest) (*storage.BdevNamespaceDeleteResponse, error) {
+	mb.Lock()
+	mb.DeleteNsCalls = append(mb.DeleteNsCalls, req)
+	mb.Unlock()
+
+	if mb.cfg.DeleteNsErr != nil {
+		return nil, mb.cfg.DeleteNsErr
+	}
+
+	return &storage.BdevNamespaceDeleteResponse{}, nil
+}
+
 func NewMockProvider(log logging.Logger, mbc *MockBackendConfig) *Provider {
 	return NewProvider(log, NewMockBackend(mbc))
 }
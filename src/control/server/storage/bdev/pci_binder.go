@@ -0,0 +1,637 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/hardware"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	pciClassNVMe          = "0x010802"
+	pciVendorIntel        = "0x8086"
+	vfioDriver            = "vfio-pci"
+	defaultHugepageSzKiB  = 2048
+	vfioNoIommuParam      = "module/vfio/parameters/enable_unsafe_noiommu_mode"
+	clearDriverOverride   = "\n"
+	defaultProcMountsPath = "/proc/self/mounts"
+)
+
+var (
+	// PCI device IDs of Intel Volume Management Device (VMD) controllers.
+	vmdDeviceIDs = []string{"0x201d", "0x28c0"}
+
+	// Drivers that may be bound to other members of the IOMMU group of a device bound to
+	// vfio-pci without making the group unusable.
+	vfioViableDrivers = []string{vfioDriver, "pcieport", "pci-stub"}
+
+	hugeNodeRe = regexp.MustCompile(`^nodes_hp\[([0-9]+)\]=([0-9]+)$`)
+)
+
+// pciDeviceBinder rebinds PCI devices between kernel and userspace drivers.
+type pciDeviceBinder interface {
+	Prepare(*storage.BdevPrepareRequest) error
+	Unbind(*storage.BdevPrepareRequest) error
+	Reset(*storage.BdevPrepareRequest) error
+}
+
+// sysfsBinder implements pciDeviceBinder by writing directly to sysfs driver_override, unbind and
+// drivers_probe files rather than calling into the SPDK setup script, so that devices can be
+// prepared on images that don't ship the SPDK scripts.
+type sysfsBinder struct {
+	log        logging.Logger
+	sysRoot    string
+	devRoot    string
+	mountsPath string
+	getMemInfo common.GetSysMemInfoFn
+	loadModule func(string) error
+	lookupUser func(string) (*user.User, error)
+}
+
+func defaultSysfsBinder(log logging.Logger) *sysfsBinder {
+	return &sysfsBinder{
+		log:        log,
+		sysRoot:    "/sys",
+		devRoot:    "/dev",
+		mountsPath: defaultProcMountsPath,
+		getMemInfo: common.GetSysMemInfo,
+		loadModule: modprobe,
+		lookupUser: user.Lookup,
+	}
+}
+
+func modprobe(module string) error {
+	out, err := exec.Command("modprobe", module).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "modprobe %s (%s)", module, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func writeSysfs(path, val string) error {
+	return errors.Wrapf(os.WriteFile(path, []byte(val), 0644), "write %q to %s",
+		strings.TrimSpace(val), path)
+}
+
+func (b *sysfsBinder) devPath(addr string, elems ...string) string {
+	return filepath.Join(append([]string{b.sysRoot, "bus", "pci", "devices", addr}, elems...)...)
+}
+
+func (b *sysfsBinder) readAttr(addr, name string) string {
+	buf, err := os.ReadFile(b.devPath(addr, name))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(buf))
+}
+
+// currentDriver returns the name of the driver the device is bound to, empty if unbound.
+func (b *sysfsBinder) currentDriver(addr string) (string, error) {
+	tgt, err := os.Readlink(b.devPath(addr, "driver"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "read driver of %s", addr)
+	}
+
+	return filepath.Base(tgt), nil
+}
+
+func (b *sysfsBinder) isVMD(addr string) bool {
+	return b.readAttr(addr, "vendor") == pciVendorIntel &&
+		common.Includes(vmdDeviceIDs, b.readAttr(addr, "device"))
+}
+
+// selectDevices returns addresses of NVMe and VMD devices filtered by allow and block lists. VMD
+// controllers are only selected when explicitly allowed.
+func (b *sysfsBinder) selectDevices(allowList, blockList string) ([]string, error) {
+	allowed, err := hardware.NewPCIAddressSetFromString(allowList)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse pci allow list")
+	}
+	blocked, err := hardware.NewPCIAddressSetFromString(blockList)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse pci block list")
+	}
+
+	entries, err := os.ReadDir(filepath.Join(b.sysRoot, "bus", "pci", "devices"))
+	if err != nil {
+		return nil, errors.Wrap(err, "read pci devices")
+	}
+
+	var addrs []string
+	for _, entry := range entries {
+		name := entry.Name()
+		isVMD := b.isVMD(name)
+		if b.readAttr(name, "class") != pciClassNVMe && !isVMD {
+			continue
+		}
+
+		addr, err := hardware.NewPCIAddress(name)
+		if err != nil {
+			b.log.Debugf("skipping device with unrecognized address %q: %s", name, err)
+			continue
+		}
+		switch {
+		case blocked.Contains(addr):
+			b.log.Debugf("skipping blocked device %s", name)
+			continue
+		case !allowed.IsEmpty() && !allowed.Contains(addr):
+			continue
+		case allowed.IsEmpty() && isVMD:
+			continue
+		}
+
+		addrs = append(addrs, name)
+	}
+	sort.Strings(addrs)
+
+	return addrs, nil
+}
+
+// scanControllers returns details of all NVMe controllers present in sysfs, regardless of which
+// driver they are bound to. Identity and namespace details are only available for controllers
+// bound to the kernel nvme driver.
+func (b *sysfsBinder) scanControllers() (storage.NvmeControllers, error) {
+	addrs, err := b.selectDevices("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	ctrlrs := make(storage.NvmeControllers, 0, len(addrs))
+	for _, addr := range addrs {
+		driver, err := b.currentDriver(addr)
+		if err != nil {
+			return nil, err
+		}
+		ctrlr := &storage.NvmeController{
+			PciAddr:  addr,
+			VendorID: b.readAttr(addr, "vendor"),
+			Driver:   driver,
+		}
+		if numa, err := strconv.Atoi(b.readAttr(addr, "numa_node")); err == nil && numa > 0 {
+			ctrlr.SocketID = int32(numa)
+		}
+
+		ctrlrPaths, err := filepath.Glob(b.devPath(addr, "nvme", "nvme*"))
+		if err != nil {
+			return nil, err
+		}
+		if len(ctrlrPaths) > 0 {
+			readCtrlrAttr := func(elems ...string) string {
+				buf, err := os.ReadFile(filepath.Join(append([]string{ctrlrPaths[0]}, elems...)...))
+				if err != nil {
+					return ""
+				}
+				return strings.TrimSpace(string(buf))
+			}
+			ctrlr.Model = readCtrlrAttr("model")
+			ctrlr.Serial = readCtrlrAttr("serial")
+			ctrlr.FwRev = readCtrlrAttr("firmware_rev")
+
+			nsPaths, err := filepath.Glob(filepath.Join(ctrlrPaths[0], "nvme*n*"))
+			if err != nil {
+				return nil, err
+			}
+			for _, nsPath := range nsPaths {
+				ns := filepath.Base(nsPath)
+				id, err := strconv.ParseUint(ns[strings.LastIndex(ns, "n")+1:], 10, 32)
+				if err != nil {
+					continue
+				}
+				// Block device size attribute is in 512-byte sectors.
+				sectors, _ := strconv.ParseUint(readCtrlrAttr(ns, "size"), 10, 64)
+				ctrlr.Namespaces = append(ctrlr.Namespaces, &storage.NvmeNamespace{
+					ID:   uint32(id),
+					Size: sectors * 512,
+				})
+			}
+		}
+
+		ctrlrs = append(ctrlrs, ctrlr)
+	}
+
+	return ctrlrs, nil
+}
+
+// ScanSysfs returns details of all NVMe controllers present on the host by reading sysfs, this
+// includes devices bound to kernel drivers that are not visible to SPDK.
+func ScanSysfs(log logging.Logger) (storage.NvmeControllers, error) {
+	return defaultSysfsBinder(log).scanControllers()
+}
+
+// inUse returns a reason if any of the device's namespaces are mounted or held by another block
+// device, e.g. a device-mapper or md volume, otherwise an empty string is returned.
+func (b *sysfsBinder) inUse(addr string) (string, error) {
+	nsPaths, err := filepath.Glob(b.devPath(addr, "nvme", "nvme*", "nvme*n*"))
+	if err != nil {
+		return "", err
+	}
+	if len(nsPaths) == 0 {
+		return "", nil
+	}
+
+	var mounts []string
+	if f, err := os.Open(b.mountsPath); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			mounts = append(mounts, scanner.Text())
+		}
+		f.Close()
+	}
+
+	for _, nsPath := range nsPaths {
+		ns := filepath.Base(nsPath)
+
+		holders, _ := filepath.Glob(filepath.Join(nsPath, "holders", "*"))
+		partHolders, _ := filepath.Glob(filepath.Join(nsPath, ns+"p*", "holders", "*"))
+		if held := append(holders, partHolders...); len(held) > 0 {
+			return fmt.Sprintf("%s is held by %s", ns, filepath.Base(held[0])), nil
+		}
+
+		for _, line := range mounts {
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				continue
+			}
+			dev := "/dev/" + ns
+			if fields[0] == dev || strings.HasPrefix(fields[0], dev+"p") {
+				return fmt.Sprintf("%s is mounted at %s", fields[0], fields[1]), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// iommuGroup returns the IOMMU group of the device, empty if the device has none.
+func (b *sysfsBinder) iommuGroup(addr string) (string, error) {
+	tgt, err := os.Readlink(b.devPath(addr, "iommu_group"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "read iommu group of %s", addr)
+	}
+
+	return filepath.Base(tgt), nil
+}
+
+// checkIommuGroup verifies that other members of the device's IOMMU group are either being bound
+// to vfio-pci alongside the device or are bound to a driver that doesn't prevent vfio from using
+// the group.
+func (b *sysfsBinder) checkIommuGroup(addr, group string, binding []string) error {
+	members, err := os.ReadDir(b.devPath(addr, "iommu_group", "devices"))
+	if err != nil {
+		return errors.Wrapf(err, "read iommu group %s devices", group)
+	}
+
+	for _, member := range members {
+		name := member.Name()
+		if name == addr || common.Includes(binding, name) {
+			continue
+		}
+		drv, err := b.currentDriver(name)
+		if err != nil {
+			return err
+		}
+		if drv != "" && !common.Includes(vfioViableDrivers, drv) {
+			return FaultIommuGroupNotViable(addr, group, name, drv)
+		}
+	}
+
+	return nil
+}
+
+// vfioNoIommu returns true if vfio has been loaded in unsafe no-IOMMU mode.
+func (b *sysfsBinder) vfioNoIommu() bool {
+	buf, err := os.ReadFile(filepath.Join(b.sysRoot, vfioNoIommuParam))
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimSpace(string(buf)) == "Y"
+}
+
+// ensureDriver loads the driver's kernel module if it isn't already available.
+func (b *sysfsBinder) ensureDriver(driver string) error {
+	drvPath := filepath.Join(b.sysRoot, "bus", "pci", "drivers", driver)
+	if _, err := os.Stat(drvPath); err == nil {
+		return nil
+	}
+
+	if err := b.loadModule(driver); err != nil {
+		return err
+	}
+	if _, err := os.Stat(drvPath); err != nil {
+		return errors.Wrapf(err, "driver %s not available after loading module", driver)
+	}
+
+	return nil
+}
+
+// bind rebinds the device to the given driver via its driver_override file.
+func (b *sysfsBinder) bind(addr, driver string) error {
+	cur, err := b.currentDriver(addr)
+	if err != nil {
+		return err
+	}
+	if cur == driver {
+		b.log.Debugf("%s already bound to %s", addr, driver)
+		return nil
+	}
+
+	if cur != "" {
+		if err := writeSysfs(b.devPath(addr, "driver", "unbind"), addr); err != nil {
+			return err
+		}
+	}
+	if err := writeSysfs(b.devPath(addr, "driver_override"), driver); err != nil {
+		return err
+	}
+	b.log.Debugf("binding %s from %q to %s", addr, cur, driver)
+
+	return writeSysfs(filepath.Join(b.sysRoot, "bus", "pci", "drivers_probe"), addr)
+}
+
+// release unbinds the device from any driver and clears its driver override. If probe is set the
+// device is then reprobed so that it is bound to its default kernel driver.
+func (b *sysfsBinder) release(addr string, probe bool) error {
+	cur, err := b.currentDriver(addr)
+	if err != nil {
+		return err
+	}
+
+	if cur != "" {
+		if err := writeSysfs(b.devPath(addr, "driver", "unbind"), addr); err != nil {
+			return err
+		}
+	}
+	if err := writeSysfs(b.devPath(addr, "driver_override"), clearDriverOverride); err != nil {
+		return err
+	}
+	if !probe {
+		return nil
+	}
+	b.log.Debugf("returning %s from %q to kernel driver", addr, cur)
+
+	return writeSysfs(filepath.Join(b.sysRoot, "bus", "pci", "drivers_probe"), addr)
+}
+
+type hugeNodeCount struct {
+	node int
+	nr   int
+}
+
+// parseHugeNodes parses a HUGENODE string of the form "nodes_hp[0]=2048,nodes_hp[1]=512". Plain
+// NUMA node indexes are assigned the default number of hugepages.
+func parseHugeNodes(in string, nrDefault int) ([]hugeNodeCount, error) {
+	var counts []hugeNodeCount
+	for _, field := range strings.Split(in, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		if matches := hugeNodeRe.FindStringSubmatch(field); matches != nil {
+			node, _ := strconv.Atoi(matches[1])
+			nr, _ := strconv.Atoi(matches[2])
+			counts = append(counts, hugeNodeCount{node: node, nr: nr})
+			continue
+		}
+
+		node, err := strconv.Atoi(field)
+		if err != nil || node < 0 {
+			return nil, errors.Errorf("invalid hugepage node specification %q", field)
+		}
+		counts = append(counts, hugeNodeCount{node: node, nr: nrDefault})
+	}
+
+	return counts, nil
+}
+
+// setHugepages writes the requested number of hugepages either per-NUMA-node or system-wide.
+func (b *sysfsBinder) setHugepages(req *storage.BdevPrepareRequest) error {
+	nrHugepages := req.HugepageCount
+	if nrHugepages <= 0 {
+		nrHugepages = defaultNrHugepages
+	}
+
+	szKiB := defaultHugepageSzKiB
+	if smi, err := b.getMemInfo(); err == nil && smi.HugepageSizeKiB > 0 {
+		szKiB = smi.HugepageSizeKiB
+	}
+	hpDir := fmt.Sprintf("hugepages-%dkB", szKiB)
+
+	setNr := func(path string, nr int) error {
+		if err := writeSysfs(path, strconv.Itoa(nr)); err != nil {
+			return err
+		}
+		if buf, err := os.ReadFile(path); err == nil {
+			if got, err := strconv.Atoi(strings.TrimSpace(string(buf))); err == nil && got < nr {
+				b.log.Noticef("requested %d hugepages but only %d allocated (%s)", nr,
+					got, path)
+			}
+		}
+		return nil
+	}
+
+	if req.HugeNodes == "" {
+		return setNr(filepath.Join(b.sysRoot, "kernel", "mm", "hugepages", hpDir,
+			"nr_hugepages"), nrHugepages)
+	}
+
+	counts, err := parseHugeNodes(req.HugeNodes, nrHugepages)
+	if err != nil {
+		return err
+	}
+	for _, hnc := range counts {
+		path := filepath.Join(b.sysRoot, "devices", "system", "node",
+			fmt.Sprintf("node%d", hnc.node), "hugepages", hpDir, "nr_hugepages")
+		if err := setNr(path, hnc.nr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setAccess gives the target user access to hugepages and the vfio group files.
+func (b *sysfsBinder) setAccess(targetUser string, vfio bool) error {
+	if vfio {
+		vfioDir := filepath.Join(b.devRoot, "vfio")
+		if fi, err := os.Stat(vfioDir); err == nil {
+			if err := os.Chmod(vfioDir, fi.Mode().Perm()|0111); err != nil {
+				return errors.Wrapf(err, "chmod %s", vfioDir)
+			}
+			entries, err := os.ReadDir(vfioDir)
+			if err != nil {
+				return errors.Wrapf(err, "read %s", vfioDir)
+			}
+			for _, entry := range entries {
+				path := filepath.Join(vfioDir, entry.Name())
+				if err := os.Chmod(path, 0666); err != nil {
+					return errors.Wrapf(err, "chmod %s", path)
+				}
+			}
+		}
+	}
+
+	if targetUser == "" {
+		return nil
+	}
+	u, err := b.lookupUser(targetUser)
+	if err != nil {
+		return errors.Wrapf(err, "lookup target user %q", targetUser)
+	}
+	uid, err := strconv.Atoi(u.Uid)
+	if err != nil {
+		return errors.Wrapf(err, "parse uid of %q", targetUser)
+	}
+
+	hpDir := filepath.Join(b.devRoot, "hugepages")
+	if _, err := os.Stat(hpDir); err != nil {
+		return nil
+	}
+
+	return filepath.Walk(hpDir, func(path string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return errors.Wrapf(os.Lchown(path, uid, -1), "chown %s", path)
+	})
+}
+
+// Prepare allocates hugepages and binds NVMe devices (and VMD controllers if explicitly allowed)
+// that aren't in use by the OS to vfio-pci, or uio_pci_generic if VFIO is disabled or the device
+// has no IOMMU group.
+func (b *sysfsBinder) Prepare(req *storage.BdevPrepareRequest) error {
+	if err := b.setHugepages(req); err != nil {
+		return errors.Wrap(err, "allocate hugepages")
+	}
+
+	addrs, err := b.selectDevices(req.PCIAllowList, req.PCIBlockList)
+	if err != nil {
+		return err
+	}
+
+	var toBind []string
+	for _, addr := range addrs {
+		reason, err := b.inUse(addr)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			b.log.Noticef("skipping NVMe device %s as it is in use: %s", addr, reason)
+			continue
+		}
+		toBind = append(toBind, addr)
+	}
+
+	drivers := make(map[string]string)
+	for _, addr := range toBind {
+		drv := vfioDriver
+		if req.DisableVFIO {
+			drv = vfioDisabledDriver
+		} else {
+			group, err := b.iommuGroup(addr)
+			if err != nil {
+				return err
+			}
+			switch {
+			case group != "":
+				if err := b.checkIommuGroup(addr, group, toBind); err != nil {
+					return err
+				}
+			case !b.vfioNoIommu():
+				b.log.Noticef("%s has no IOMMU group, using %s", addr,
+					vfioDisabledDriver)
+				drv = vfioDisabledDriver
+			}
+		}
+		drivers[addr] = drv
+	}
+
+	usingVFIO := false
+	for _, addr := range toBind {
+		if err := b.ensureDriver(drivers[addr]); err != nil {
+			return err
+		}
+		if err := b.bind(addr, drivers[addr]); err != nil {
+			return err
+		}
+		usingVFIO = usingVFIO || drivers[addr] == vfioDriver
+	}
+
+	return b.setAccess(req.TargetUser, usingVFIO)
+}
+
+// Unbind removes driver bindings from all NVMe devices that aren't in the block list or in use.
+func (b *sysfsBinder) Unbind(req *storage.BdevPrepareRequest) error {
+	addrs, err := b.selectDevices("", req.PCIBlockList)
+	if err != nil {
+		return errors.Wrap(err, "unbind devices")
+	}
+
+	for _, addr := range addrs {
+		reason, err := b.inUse(addr)
+		if err != nil {
+			return errors.Wrap(err, "unbind devices")
+		}
+		if reason != "" {
+			b.log.Debugf("not unbinding %s as it is in use: %s", addr, reason)
+			continue
+		}
+		if err := b.release(addr, false); err != nil {
+			return errors.Wrap(err, "unbind devices")
+		}
+	}
+
+	return nil
+}
+
+// Reset returns selected devices that are bound to a userspace driver, or to no driver, to their
+// default kernel driver.
+func (b *sysfsBinder) Reset(req *storage.BdevPrepareRequest) error {
+	addrs, err := b.selectDevices(req.PCIAllowList, req.PCIBlockList)
+	if err != nil {
+		return errors.Wrap(err, "reset")
+	}
+
+	for _, addr := range addrs {
+		cur, err := b.currentDriver(addr)
+		if err != nil {
+			return errors.Wrap(err, "reset")
+		}
+		if cur != "" && cur != vfioDriver && cur != vfioDisabledDriver {
+			continue
+		}
+		if err := b.release(addr, true); err != nil {
+			return errors.Wrap(err, "reset")
+		}
+	}
+
+	return nil
+}
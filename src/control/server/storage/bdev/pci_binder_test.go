@@ -0,0 +1,447 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+type mockPciDev struct {
+	addr       string
+	class      string
+	vendor     string
+	device     string
+	driver     string
+	iommuGroup string
+	namespace  string // block device name of an NVMe namespace e.g. nvme0n1
+	holder     string // block device holding the namespace e.g. dm-0
+	numaNode   string
+	serial     string // kernel nvme driver controller attributes
+	nsSectors  string // namespace size in 512-byte sectors
+}
+
+// mockSysfs creates a minimal sysfs tree with PCI devices, drivers and IOMMU groups under root.
+func mockSysfs(t *testing.T, root string, drivers []string, devs ...mockPciDev) {
+	t.Helper()
+
+	mkdir := func(elems ...string) string {
+		path := filepath.Join(elems...)
+		if err := os.MkdirAll(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	write := func(path, val string) {
+		if err := os.WriteFile(path, []byte(val), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	link := func(tgt, path string) {
+		if err := os.Symlink(tgt, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pciDir := mkdir(root, "bus", "pci")
+	write(filepath.Join(pciDir, "drivers_probe"), "")
+	for _, drv := range drivers {
+		write(filepath.Join(mkdir(pciDir, "drivers", drv), "unbind"), "")
+	}
+	mkdir(root, "kernel", "mm", "hugepages", "hugepages-2048kB")
+
+	for _, dev := range devs {
+		devDir := mkdir(pciDir, "devices", dev.addr)
+		write(filepath.Join(devDir, "class"), dev.class+"\n")
+		write(filepath.Join(devDir, "vendor"), dev.vendor+"\n")
+		write(filepath.Join(devDir, "device"), dev.device+"\n")
+		write(filepath.Join(devDir, "driver_override"), "(null)\n")
+		if dev.numaNode != "" {
+			write(filepath.Join(devDir, "numa_node"), dev.numaNode+"\n")
+		}
+		if dev.driver != "" {
+			drvDir := mkdir(pciDir, "drivers", dev.driver)
+			if _, err := os.Stat(filepath.Join(drvDir, "unbind")); err != nil {
+				write(filepath.Join(drvDir, "unbind"), "")
+			}
+			link(drvDir, filepath.Join(devDir, "driver"))
+		}
+		if dev.iommuGroup != "" {
+			grpDir := mkdir(root, "kernel", "iommu_groups", dev.iommuGroup)
+			write(filepath.Join(mkdir(grpDir, "devices"), dev.addr), "")
+			link(grpDir, filepath.Join(devDir, "iommu_group"))
+		}
+		if dev.serial != "" {
+			ctrlrDir := mkdir(devDir, "nvme", "nvme0")
+			write(filepath.Join(ctrlrDir, "model"), "INTEL SSDPE2KE016T8 \n")
+			write(filepath.Join(ctrlrDir, "serial"), dev.serial+"\n")
+			write(filepath.Join(ctrlrDir, "firmware_rev"), "VDV10170\n")
+		}
+		if dev.namespace != "" {
+			nsDir := mkdir(devDir, "nvme", "nvme0", dev.namespace, "holders")
+			if dev.holder != "" {
+				write(filepath.Join(nsDir, dev.holder), "")
+			}
+			if dev.nsSectors != "" {
+				write(filepath.Join(filepath.Dir(nsDir), "size"), dev.nsSectors+"\n")
+			}
+		}
+	}
+}
+
+func mockNvmeDev(addr, driver, group string) mockPciDev {
+	return mockPciDev{
+		addr:       addr,
+		class:      pciClassNVMe,
+		vendor:     pciVendorIntel,
+		device:     "0x0a54",
+		driver:     driver,
+		iommuGroup: group,
+	}
+}
+
+func TestBdev_sysfsBinder(t *testing.T) {
+	nvme1 := mockNvmeDev("0000:81:00.0", "nvme", "10")
+	nvme2 := mockNvmeDev("0000:82:00.0", "nvme", "11")
+	vfioNvme := mockNvmeDev("0000:83:00.0", vfioDriver, "12")
+	nic := mockPciDev{
+		addr: "0000:18:00.0", class: "0x020000", vendor: "0x15b3", device: "0x1017",
+		driver: "mlx5_core", iommuGroup: "10",
+	}
+	vmd := mockPciDev{
+		addr: "0000:5d:05.5", class: "0x010400", vendor: pciVendorIntel, device: "0x201d",
+		driver: "vmd", iommuGroup: "13",
+	}
+	mountedNvme := nvme2
+	mountedNvme.namespace = "nvme0n1"
+	heldNvme := nvme2
+	heldNvme.namespace = "nvme0n1"
+	heldNvme.holder = "dm-0"
+	noIommuNvme := nvme2
+	noIommuNvme.iommuGroup = ""
+
+	for name, tc := range map[string]struct {
+		method       string
+		req          storage.BdevPrepareRequest
+		drivers      []string
+		devs         []mockPciDev
+		mounts       string
+		loadErr      error
+		expOverrides map[string]string
+		expLoaded    []string
+		expHugepages map[string]string
+		expErr       error
+	}{
+		"prepare; defaults": {
+			method:  "prepare",
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, nvme2, vfioNvme},
+			expOverrides: map[string]string{
+				nvme1.addr: vfioDriver,
+				nvme2.addr: vfioDriver,
+			},
+			expHugepages: map[string]string{
+				"kernel/mm/hugepages/hugepages-2048kB/nr_hugepages": "1024",
+			},
+		},
+		"prepare; allow and block lists; vfio disabled": {
+			method: "prepare",
+			req: storage.BdevPrepareRequest{
+				HugepageCount: 4096,
+				PCIAllowList:  nvme1.addr + storage.BdevPciAddrSep + nvme2.addr,
+				PCIBlockList:  nvme2.addr,
+				DisableVFIO:   true,
+			},
+			drivers: []string{vfioDisabledDriver},
+			devs:    []mockPciDev{nvme1, nvme2, nic},
+			expOverrides: map[string]string{
+				nvme1.addr: vfioDisabledDriver,
+			},
+			expHugepages: map[string]string{
+				"kernel/mm/hugepages/hugepages-2048kB/nr_hugepages": "4096",
+			},
+		},
+		"prepare; per-numa hugepages": {
+			method: "prepare",
+			req: storage.BdevPrepareRequest{
+				HugeNodes: "nodes_hp[0]=2048,nodes_hp[1]=512",
+			},
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme2},
+			expOverrides: map[string]string{
+				nvme2.addr: vfioDriver,
+			},
+			expHugepages: map[string]string{
+				"devices/system/node/node0/hugepages/hugepages-2048kB/nr_hugepages": "2048",
+				"devices/system/node/node1/hugepages/hugepages-2048kB/nr_hugepages": "512",
+			},
+		},
+		"prepare; bad hugepage node specification": {
+			method: "prepare",
+			req: storage.BdevPrepareRequest{
+				HugeNodes: "nodes_hp[a]=2048",
+			},
+			devs:   []mockPciDev{nvme2},
+			expErr: errors.New("invalid hugepage node specification"),
+		},
+		"prepare; vfio module loaded": {
+			method:    "prepare",
+			devs:      []mockPciDev{nvme2},
+			expLoaded: []string{vfioDriver},
+			expOverrides: map[string]string{
+				nvme2.addr: vfioDriver,
+			},
+		},
+		"prepare; vfio module load fails": {
+			method:    "prepare",
+			devs:      []mockPciDev{nvme2},
+			loadErr:   errors.New("no such module"),
+			expLoaded: []string{vfioDriver},
+			expErr:    errors.New("no such module"),
+		},
+		"prepare; mounted device skipped": {
+			method:  "prepare",
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, mountedNvme},
+			mounts:  "/dev/nvme0n1p1 /boot ext4 rw 0 0\n",
+			expOverrides: map[string]string{
+				nvme1.addr: vfioDriver,
+			},
+		},
+		"prepare; held device skipped": {
+			method:  "prepare",
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, heldNvme},
+			expOverrides: map[string]string{
+				nvme1.addr: vfioDriver,
+			},
+		},
+		"prepare; iommu group not viable": {
+			method:  "prepare",
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, nic},
+			expErr:  FaultIommuGroupNotViable(nvme1.addr, "10", nic.addr, nic.driver),
+		},
+		"prepare; no iommu group": {
+			method:  "prepare",
+			drivers: []string{vfioDriver, vfioDisabledDriver},
+			devs:    []mockPciDev{nvme1, noIommuNvme},
+			expOverrides: map[string]string{
+				nvme1.addr:       vfioDriver,
+				noIommuNvme.addr: vfioDisabledDriver,
+			},
+		},
+		"prepare; vmd skipped unless allowed": {
+			method:  "prepare",
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, vmd},
+			expOverrides: map[string]string{
+				nvme1.addr: vfioDriver,
+			},
+		},
+		"prepare; vmd allowed": {
+			method: "prepare",
+			req: storage.BdevPrepareRequest{
+				PCIAllowList: vmd.addr,
+			},
+			drivers: []string{vfioDriver},
+			devs:    []mockPciDev{nvme1, vmd},
+			expOverrides: map[string]string{
+				vmd.addr: vfioDriver,
+			},
+		},
+		"unbind; block list applied": {
+			method: "unbind",
+			req: storage.BdevPrepareRequest{
+				PCIBlockList: nvme2.addr,
+			},
+			devs: []mockPciDev{nvme1, nvme2, vfioNvme, nic},
+			expOverrides: map[string]string{
+				nvme1.addr:    clearDriverOverride,
+				vfioNvme.addr: clearDriverOverride,
+			},
+		},
+		"reset; only userspace bound devices released": {
+			method: "reset",
+			devs:   []mockPciDev{nvme1, vfioNvme, nic},
+			expOverrides: map[string]string{
+				vfioNvme.addr: clearDriverOverride,
+			},
+		},
+		"reset; bad allow list": {
+			method: "reset",
+			req: storage.BdevPrepareRequest{
+				PCIAllowList: "bad",
+			},
+			devs:   []mockPciDev{vfioNvme},
+			expErr: errors.New("parse pci allow list"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, clean := test.CreateTestDir(t)
+			defer clean()
+
+			sysRoot := filepath.Join(testDir, "sys")
+			mockSysfs(t, sysRoot, tc.drivers, tc.devs...)
+			mountsPath := filepath.Join(testDir, "mounts")
+			if err := os.WriteFile(mountsPath, []byte(tc.mounts), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			var loaded []string
+			b := &sysfsBinder{
+				log:        log,
+				sysRoot:    sysRoot,
+				devRoot:    filepath.Join(testDir, "dev"),
+				mountsPath: mountsPath,
+				getMemInfo: func() (*common.SysMemInfo, error) {
+					return &common.SysMemInfo{
+						MemInfo: common.MemInfo{HugepageSizeKiB: 2048},
+					}, nil
+				},
+				loadModule: func(module string) error {
+					loaded = append(loaded, module)
+					if tc.loadErr != nil {
+						return tc.loadErr
+					}
+					return os.MkdirAll(filepath.Join(sysRoot, "bus", "pci",
+						"drivers", module), 0755)
+				},
+				lookupUser: func(string) (*user.User, error) {
+					return user.Current()
+				},
+			}
+
+			// Per-NUMA hugepage directories only exist on multi-node systems.
+			for path := range tc.expHugepages {
+				if err := os.MkdirAll(filepath.Dir(filepath.Join(sysRoot, path)),
+					0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var gotErr error
+			switch tc.method {
+			case "prepare":
+				gotErr = b.Prepare(&tc.req)
+			case "unbind":
+				gotErr = b.Unbind(&tc.req)
+			case "reset":
+				gotErr = b.Reset(&tc.req)
+			}
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expLoaded, loaded); diff != "" {
+				t.Fatalf("unexpected modules loaded (-want, +got):\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			gotOverrides := make(map[string]string)
+			for _, dev := range tc.devs {
+				buf, err := os.ReadFile(filepath.Join(sysRoot, "bus", "pci", "devices",
+					dev.addr, "driver_override"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(buf) != "(null)\n" {
+					gotOverrides[dev.addr] = string(buf)
+				}
+			}
+			if tc.expOverrides == nil {
+				tc.expOverrides = map[string]string{}
+			}
+			if diff := cmp.Diff(tc.expOverrides, gotOverrides); diff != "" {
+				t.Fatalf("unexpected driver overrides (-want, +got):\n%s\n", diff)
+			}
+
+			for path, expNr := range tc.expHugepages {
+				buf, err := os.ReadFile(filepath.Join(sysRoot, path))
+				if err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, expNr, strings.TrimSpace(string(buf)),
+					"unexpected number of hugepages in "+path)
+			}
+		})
+	}
+}
+
+func TestBdev_sysfsBinder_scanControllers(t *testing.T) {
+	kernelNvme := mockNvmeDev("0000:81:00.0", "nvme", "10")
+	kernelNvme.numaNode = "1"
+	kernelNvme.serial = "PHLN0001"
+	kernelNvme.namespace = "nvme0n1"
+	kernelNvme.nsSectors = "3125627568"
+	vfioNvme := mockNvmeDev("0000:83:00.0", vfioDriver, "12")
+	vfioNvme.numaNode = "-1"
+	unboundNvme := mockNvmeDev("0000:84:00.0", "", "13")
+	nic := mockPciDev{
+		addr: "0000:18:00.0", class: "0x020000", vendor: "0x15b3", device: "0x1017",
+		driver: "mlx5_core", iommuGroup: "10",
+	}
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, clean := test.CreateTestDir(t)
+	defer clean()
+	sysRoot := filepath.Join(testDir, "sys")
+	mockSysfs(t, sysRoot, nil, kernelNvme, vfioNvme, unboundNvme, nic)
+
+	b := &sysfsBinder{
+		log:     log,
+		sysRoot: sysRoot,
+	}
+
+	gotCtrlrs, gotErr := b.scanControllers()
+	if gotErr != nil {
+		t.Fatal(gotErr)
+	}
+
+	expCtrlrs := storage.NvmeControllers{
+		{
+			PciAddr:  kernelNvme.addr,
+			VendorID: pciVendorIntel,
+			SocketID: 1,
+			Driver:   "nvme",
+			Model:    "INTEL SSDPE2KE016T8",
+			Serial:   "PHLN0001",
+			FwRev:    "VDV10170",
+			Namespaces: []*storage.NvmeNamespace{
+				{ID: 1, Size: 3125627568 * 512},
+			},
+		},
+		{
+			PciAddr:  vfioNvme.addr,
+			VendorID: pciVendorIntel,
+			Driver:   vfioDriver,
+		},
+		{
+			PciAddr:  unboundNvme.addr,
+			VendorID: pciVendorIntel,
+		},
+	}
+	if diff := cmp.Diff(expCtrlrs, gotCtrlrs); diff != "" {
+		t.Fatalf("unexpected controllers (-want, +got):\n%s\n", diff)
+	}
+}
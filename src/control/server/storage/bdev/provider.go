@@ -23,9 +23,14 @@ type (
 		Reset(storage.BdevPrepareRequest) (*storage.BdevPrepareResponse, error)
 		Scan(storage.BdevScanRequest) (*storage.BdevScanResponse, error)
 		Format(storage.BdevFormatRequest) (*storage.BdevFormatResponse, error)
-		UpdateFirmware(pciAddr string, path string, slot int32) error
+		UpdateFirmware(pciAddr string, path string, slot int32, stage bool) error
 		WriteConfig(storage.BdevWriteConfigRequest) (*storage.BdevWriteConfigResponse, error)
 		ReadConfig(storage.BdevReadConfigRequest) (*storage.BdevReadConfigResponse, error)
+		AttachController(storage.BdevAttachRequest) (*storage.BdevAttachResponse, error)
+		DetachController(storage.BdevDetachRequest) (*storage.BdevDetachResponse, error)
+		InjectErrors(storage.BdevInjectErrorsRequest) (*storage.BdevInjectErrorsResponse, error)
+		CreateNamespaces(storage.BdevNamespaceCreateRequest) (*storage.BdevNamespaceCreateResponse, error)
+		DeleteNamespace(storage.BdevNamespaceDeleteRequest) (*storage.BdevNamespaceDeleteResponse, error)
 	}
 
 	// Provider encapsulates configuration and logic for interacting with a Block
@@ -89,3 +94,42 @@ func (p *Provider) WriteConfig(req storage.BdevWriteConfigRequest) (*storage.Bde
 func (p *Provider) ReadConfig(req storage.BdevReadConfigRequest) (*storage.BdevReadConfigResponse, error) {
 	return p.backend.ReadConfig(req)
 }
+
+// AttachController calls into the bdev backend to attach an NVMe controller to a running engine.
+func (p *Provider) AttachController(req storage.BdevAttachRequest) (*storage.BdevAttachResponse, error) {
+	p.log.Debugf("run bdev storage provider attach controller, req: %+v", req)
+	return p.backend.AttachController(req)
+}
+
+// DetachController calls into the bdev backend to detach an NVMe controller from a running engine.
+func (p *Provider) DetachController(req storage.BdevDetachRequest) (*storage.BdevDetachResponse, error) {
+	p.log.Debugf("run bdev storage provider detach controller, req: %+v", req)
+	return p.backend.DetachController(req)
+}
+
+// InjectErrors calls into the bdev backend to inject I/O errors into the error bdevs of a running
+// engine.
+func (p *Provider) InjectErrors(req storage.BdevInjectErrorsRequest) (*storage.BdevInjectErrorsResponse, error) {
+	p.log.Debugf("run bdev storage provider inject errors, req: %+v", req)
+	return p.backend.InjectErrors(req)
+}
+
+// CreateNamespaces calls into the bdev backend to create namespaces on an NVMe controller.
+func (p *Provider) CreateNamespaces(req storage.BdevNamespaceCreateRequest) (*storage.BdevNamespaceCreateResponse, error) {
+	p.log.Debugf("run bdev storage provider create namespaces, req: %+v", req)
+	if req.Count == 0 {
+		return nil, errors.New("number of namespaces to create must be nonzero")
+	}
+
+	return p.backend.CreateNamespaces(req)
+}
+
+// DeleteNamespace calls into the bdev backend to delete a namespace on an NVMe controller.
+func (p *Provider) DeleteNamespace(req storage.BdevNamespaceDeleteRequest) (*storage.BdevNamespaceDeleteResponse, error) {
+	p.log.Debugf("run bdev storage provider delete namespace, req: %+v", req)
+	if req.NsID == 0 {
+		return nil, errors.New("namespace id must be nonzero")
+	}
+
+	return p.backend.DeleteNamespace(req)
+}
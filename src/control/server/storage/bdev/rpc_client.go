@@ -0,0 +1,143 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	spdkRpcVersion = "2.0"
+	// spdkRpcTimeout bounds the time taken to connect to and receive a response from an SPDK
+	// JSON-RPC server.
+	spdkRpcTimeout = 30 * time.Second
+
+	rpcBdevNvmeDetachController = "bdev_nvme_detach_controller"
+)
+
+type spdkRpcRequest struct {
+	Version string      `json:"jsonrpc"`
+	ID      uint64      `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// spdkRpcError is the error object returned in a failed SPDK JSON-RPC response.
+type spdkRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *spdkRpcError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+type spdkRpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      uint64          `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *spdkRpcError   `json:"error"`
+}
+
+// NvmeDetachControllerParams specifies details for a bdev_nvme_detach_controller RPC.
+type NvmeDetachControllerParams struct {
+	DeviceName string `json:"name"`
+}
+
+// spdkRpcClient issues JSON-RPC 2.0 requests to the SPDK JSON-RPC server of a running engine
+// over a unix domain socket.
+type spdkRpcClient struct {
+	sockAddr string
+	timeout  time.Duration
+	nextID   uint64
+}
+
+func newSpdkRpcClient(sockAddr string) *spdkRpcClient {
+	return &spdkRpcClient{
+		sockAddr: sockAddr,
+		timeout:  spdkRpcTimeout,
+	}
+}
+
+// call sends a request for the given method and decodes the result of the response into the
+// supplied value, which may be nil if the result is not required.
+func (c *spdkRpcClient) call(method string, params, result interface{}) error {
+	conn, err := net.DialTimeout("unix", c.sockAddr, c.timeout)
+	if err != nil {
+		return errors.Wrapf(err, "connect to spdk json-rpc server at %s", c.sockAddr)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return errors.Wrap(err, "set spdk json-rpc deadline")
+	}
+
+	c.nextID++
+	req := spdkRpcRequest{
+		Version: spdkRpcVersion,
+		ID:      c.nextID,
+		Method:  method,
+		Params:  params,
+	}
+	if err := json.NewEncoder(conn).Encode(&req); err != nil {
+		return errors.Wrapf(err, "send spdk rpc %s", method)
+	}
+
+	var resp spdkRpcResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return errors.Wrapf(err, "receive spdk rpc %s", method)
+	}
+	if resp.ID != req.ID {
+		return errors.Errorf("spdk rpc %s: response id %d does not match request id %d",
+			method, resp.ID, req.ID)
+	}
+	if resp.Error != nil {
+		return errors.Wrapf(resp.Error, "spdk rpc %s", method)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return errors.Wrapf(json.Unmarshal(resp.Result, result), "decode spdk rpc %s result",
+		method)
+}
+
+// attachController attaches the NVMe controller at the given PCI address and returns the names
+// of the bdevs created for its namespaces.
+func (c *spdkRpcClient) attachController(name, pciAddr string) ([]string, error) {
+	params := &NvmeAttachControllerParams{
+		TransportType:    "PCIe",
+		DeviceName:       name,
+		TransportAddress: pciAddr,
+	}
+
+	var bdevs []string
+	if err := c.call(storage.ConfBdevNvmeAttachController, params, &bdevs); err != nil {
+		return nil, err
+	}
+
+	return bdevs, nil
+}
+
+// injectError injects I/O errors into the named error bdev.
+func (c *spdkRpcClient) injectError(params *ErrorInjectParams) error {
+	return c.call(storage.ConfBdevErrorInjectError, params, nil)
+}
+
+// detachController detaches the named NVMe controller, removing the bdevs of its namespaces.
+func (c *spdkRpcClient) detachController(name string) error {
+	return c.call(rpcBdevNvmeDetachController, &NvmeDetachControllerParams{DeviceName: name},
+		nil)
+}
@@ -0,0 +1,190 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package bdev
+
+import (
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// startMockSpdkRpcServer listens on a unix socket and answers a single JSON-RPC request by
+// calling the supplied handler. The received request is sent on the returned channel.
+func startMockSpdkRpcServer(t *testing.T, handler func(*spdkRpcRequest) *spdkRpcResponse) (string, chan map[string]interface{}) {
+	t.Helper()
+
+	testDir, clean := test.CreateTestDir(t)
+	t.Cleanup(clean)
+
+	sockAddr := filepath.Join(testDir, "spdk.sock")
+	lis, err := net.Listen("unix", sockAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+
+	reqs := make(chan map[string]interface{}, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var raw json.RawMessage
+		if err := json.NewDecoder(conn).Decode(&raw); err != nil {
+			return
+		}
+		var req spdkRpcRequest
+		var fields map[string]interface{}
+		if json.Unmarshal(raw, &req) != nil || json.Unmarshal(raw, &fields) != nil {
+			return
+		}
+		reqs <- fields
+
+		json.NewEncoder(conn).Encode(handler(&req))
+	}()
+
+	return sockAddr, reqs
+}
+
+func TestBackend_AttachController(t *testing.T) {
+	for name, tc := range map[string]struct {
+		noServer bool
+		handler  func(*spdkRpcRequest) *spdkRpcResponse
+		expReq   map[string]interface{}
+		expResp  *storage.BdevAttachResponse
+		expErr   error
+	}{
+		"no server": {
+			noServer: true,
+			expErr:   errors.New("connect to spdk json-rpc server"),
+		},
+		"success": {
+			handler: func(req *spdkRpcRequest) *spdkRpcResponse {
+				return &spdkRpcResponse{
+					Version: spdkRpcVersion,
+					ID:      req.ID,
+					Result:  json.RawMessage(`["Nvme_0n1","Nvme_0n2"]`),
+				}
+			},
+			expReq: map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      float64(1),
+				"method":  storage.ConfBdevNvmeAttachController,
+				"params": map[string]interface{}{
+					"trtype": "PCIe",
+					"name":   "Nvme_0",
+					"traddr": test.MockPCIAddr(1),
+				},
+			},
+			expResp: &storage.BdevAttachResponse{
+				Bdevs: []string{"Nvme_0n1", "Nvme_0n2"},
+			},
+		},
+		"rpc error": {
+			handler: func(req *spdkRpcRequest) *spdkRpcResponse {
+				return &spdkRpcResponse{
+					Version: spdkRpcVersion,
+					ID:      req.ID,
+					Error: &spdkRpcError{
+						Code:    -32602,
+						Message: "Invalid parameters",
+					},
+				}
+			},
+			expErr: errors.New("Invalid parameters (code -32602)"),
+		},
+		"mismatched response id": {
+			handler: func(req *spdkRpcRequest) *spdkRpcResponse {
+				return &spdkRpcResponse{
+					Version: spdkRpcVersion,
+					ID:      req.ID + 1,
+					Result:  json.RawMessage(`[]`),
+				}
+			},
+			expErr: errors.New("does not match request id"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var sockAddr string
+			var reqs chan map[string]interface{}
+			if tc.noServer {
+				testDir, clean := test.CreateTestDir(t)
+				defer clean()
+				sockAddr = filepath.Join(testDir, "missing.sock")
+			} else {
+				sockAddr, reqs = startMockSpdkRpcServer(t, tc.handler)
+			}
+
+			b := newBackend(log, &spdkSetupScript{})
+			resp, err := b.AttachController(storage.BdevAttachRequest{
+				SockAddr:   sockAddr,
+				DeviceName: "Nvme_0",
+				PciAddr:    test.MockPCIAddr(1),
+			})
+			test.CmpErr(t, tc.expErr, err)
+
+			if tc.expReq != nil {
+				if diff := cmp.Diff(tc.expReq, <-reqs); diff != "" {
+					t.Fatalf("unexpected request (-want, +got):\n%s", diff)
+				}
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackend_DetachController(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	sockAddr, reqs := startMockSpdkRpcServer(t, func(req *spdkRpcRequest) *spdkRpcResponse {
+		return &spdkRpcResponse{
+			Version: spdkRpcVersion,
+			ID:      req.ID,
+			Result:  json.RawMessage(`true`),
+		}
+	})
+
+	b := newBackend(log, &spdkSetupScript{})
+	if _, err := b.DetachController(storage.BdevDetachRequest{
+		SockAddr:   sockAddr,
+		DeviceName: "Nvme_0",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	expReq := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      float64(1),
+		"method":  rpcBdevNvmeDetachController,
+		"params": map[string]interface{}{
+			"name": "Nvme_0",
+		},
+	}
+	if diff := cmp.Diff(expReq, <-reqs); diff != "" {
+		t.Fatalf("unexpected request (-want, +got):\n%s", diff)
+	}
+}
@@ -20,7 +20,6 @@ import (
 )
 
 const (
-	spdkSetupPath      = "../share/daos/control/setup_spdk.sh"
 	defaultNrHugepages = 1024 // default number applied by SPDK
 	nrHugepagesEnv     = "_NRHUGE"
 	hugeNodeEnv        = "_HUGENODE"
@@ -66,6 +65,8 @@ func run(log logging.Logger, env []string, cmdStr string, args ...string) (strin
 	return string(out), nil
 }
 
+// spdkSetupScript implements pciDeviceBinder by calling into the SPDK setup script wrapper. The
+// default backend uses the native sysfsBinder instead.
 type spdkSetupScript struct {
 	log        logging.Logger
 	scriptPath string
@@ -73,15 +74,6 @@ type spdkSetupScript struct {
 	runCmd     runCmdFn
 }
 
-func defaultScriptRunner(log logging.Logger) *spdkSetupScript {
-	return &spdkSetupScript{
-		log:        log,
-		scriptPath: spdkSetupPath,
-		env:        make(map[string]string),
-		runCmd:     run,
-	}
-}
-
 func (s *spdkSetupScript) run(args ...string) error {
 	envStrs := make([]string, 0, len(s.env))
 	for k, v := range s.env {
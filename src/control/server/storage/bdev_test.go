@@ -195,8 +195,8 @@ func Test_Convert_SmdDevice(t *testing.T) {
 		`host_bytes_written":1,"cluster_size":0,"meta_wal_size":0,"rdb_wal` +
 		`_size":0,"link_port_id":1,"link_max_speed":1000000000,"link_max_width":4,` +
 		`"link_neg_speed":1000000000,"link_neg_width":4},"namespaces":[{"id":1,` +
-		`"size":2000000000000}],"smd_devices":null,"dev_state":"EVICTED",` +
-		`"led_state":"ON"},"ctrlr_namespace_id":0}`
+		`"size":2000000000000,"zoned":false}],"smd_devices":null,"dev_state":` +
+		`"EVICTED","led_state":"ON","driver":""},"ctrlr_namespace_id":0}`
 	if diff := cmp.Diff(expOut, string(out)); diff != "" {
 		t.Fatalf("expected json output to match (-want, +got):\n%s\n", diff)
 	}
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2024 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,8 @@ package storage
 import (
 	"encoding/json"
 	"fmt"
+	"net"
+	"os/user"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -18,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 )
@@ -92,7 +96,8 @@ func (c *Class) UnmarshalYAML(unmarshal func(interface{}) error) error {
 
 	class := Class(tmp)
 	switch class {
-	case ClassDcpm, ClassRam, ClassNvme, ClassFile, ClassKdev:
+	case ClassDcpm, ClassRam, ClassNvme, ClassFile, ClassKdev, ClassNvmeFabrics, ClassNvmeRaid,
+		ClassDelay, ClassError, ClassNvmeCache, ClassNull, ClassMalloc:
 		*c = class
 	default:
 		return errors.Errorf("unsupported storage class %q", tmp)
@@ -112,8 +117,47 @@ const (
 	ClassNvme Class = "nvme"
 	ClassKdev Class = "kdev"
 	ClassFile Class = "file"
+
+	// ClassNvmeFabrics attaches remote NVMe controllers over an NVMe-oF transport.
+	ClassNvmeFabrics Class = "nvmf"
+	// ClassNvmeRaid combines locally attached NVMe SSDs into a single RAID bdev.
+	ClassNvmeRaid Class = "nvme-raid"
+	// ClassDelay wraps locally attached NVMe SSDs in bdevs that add I/O latency.
+	ClassDelay Class = "nvme-delay"
+	// ClassError wraps locally attached NVMe SSDs in bdevs that inject I/O errors.
+	ClassError Class = "nvme-error"
+	// ClassNvmeCache fronts locally attached NVMe SSDs with a faster NVMe SSD using OCF cache
+	// bdevs.
+	ClassNvmeCache Class = "nvme-cache"
+	// ClassNull emulates NVMe SSDs with null bdevs that discard writes and return zeroes on
+	// reads, for benchmarking without media overheads.
+	ClassNull Class = "null"
+	// ClassMalloc emulates NVMe SSDs with RAM-backed malloc bdevs, for benchmarking without
+	// media overheads.
+	ClassMalloc Class = "malloc"
 )
 
+// IsLocalNVMe returns true if the class uses NVMe SSDs attached to the local PCIe bus.
+func (c Class) IsLocalNVMe() bool {
+	switch c {
+	case ClassNvme, ClassNvmeRaid, ClassDelay, ClassError, ClassNvmeCache:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEmulatedNVMe returns true if the class uses a regular file, kernel block device or memory to
+// emulate an NVMe SSD.
+func (c Class) IsEmulatedNVMe() bool {
+	switch c {
+	case ClassFile, ClassKdev, ClassNull, ClassMalloc:
+		return true
+	default:
+		return false
+	}
+}
+
 type TierConfig struct {
 	Tier  int        `yaml:"-"`
 	Class Class      `yaml:"class"`
@@ -125,6 +169,21 @@ func NewTierConfig() *TierConfig {
 	return new(TierConfig)
 }
 
+// DeviceBinding identifies the engine storage tier that a device is assigned to in the server
+// configuration.
+type DeviceBinding struct {
+	EngineIdx uint32 `json:"engine_idx"`
+	TierIdx   uint32 `json:"tier_idx"`
+	Class     Class  `json:"class"`
+}
+
+func (db *DeviceBinding) String() string {
+	if db == nil {
+		return "unused"
+	}
+	return fmt.Sprintf("engine %d tier %d (%s)", db.EngineIdx, db.TierIdx, db.Class)
+}
+
 func (tc *TierConfig) IsSCM() bool {
 	switch tc.Class {
 	case ClassDcpm, ClassRam:
@@ -136,7 +195,8 @@ func (tc *TierConfig) IsSCM() bool {
 
 func (tc *TierConfig) IsBdev() bool {
 	switch tc.Class {
-	case ClassNvme, ClassFile, ClassKdev:
+	case ClassNvme, ClassFile, ClassKdev, ClassNvmeFabrics, ClassNvmeRaid, ClassDelay,
+		ClassError, ClassNvmeCache, ClassNull, ClassMalloc:
 		return true
 	default:
 		return false
@@ -196,6 +256,12 @@ func (tc *TierConfig) WithScmDeviceList(devices ...string) *TierConfig {
 	return tc
 }
 
+// WithScmFsck enables a filesystem check of the PMem device before it is remounted.
+func (tc *TierConfig) WithScmFsck(enabled bool) *TierConfig {
+	tc.Scm.Fsck = enabled
+	return tc
+}
+
 // WithBdevDeviceList sets the list of block devices to be used.
 func (tc *TierConfig) WithBdevDeviceList(devices ...string) *TierConfig {
 	if set, err := NewBdevDeviceList(devices...); err == nil {
@@ -209,6 +275,12 @@ func (tc *TierConfig) WithBdevDeviceList(devices ...string) *TierConfig {
 	return tc
 }
 
+// WithBdevSpares sets the list of spare NVMe SSDs to be substituted for faulty devices.
+func (tc *TierConfig) WithBdevSpares(devices ...string) *TierConfig {
+	tc.Bdev.Spares = MustNewBdevDeviceList(devices...)
+	return tc
+}
+
 // WithBdevDeviceCount sets the number of devices to be created when BdevClass is malloc.
 func (tc *TierConfig) WithBdevDeviceCount(count int) *TierConfig {
 	tc.Bdev.DeviceCount = count
@@ -221,12 +293,88 @@ func (tc *TierConfig) WithBdevFileSize(size int) *TierConfig {
 	return tc
 }
 
+// WithBdevFileSparse sets whether backing files are created sparse rather than fully allocated.
+func (tc *TierConfig) WithBdevFileSparse(sparse bool) *TierConfig {
+	tc.Bdev.FileSparse = sparse
+	return tc
+}
+
+// WithBdevZoned sets whether zoned namespaces (ZNS) are permitted on the tier's NVMe devices.
+func (tc *TierConfig) WithBdevZoned(zoned bool) *TierConfig {
+	tc.Bdev.Zoned = zoned
+	return tc
+}
+
 // WithBdevBusidRange sets the bus-ID range to be used to filter hot plug events.
 func (tc *TierConfig) WithBdevBusidRange(rangeStr string) *TierConfig {
 	tc.Bdev.BusidRange = MustNewBdevBusRange(rangeStr)
 	return tc
 }
 
+// WithBdevTransport sets the NVMe-oF transport parameters used to attach remote controllers.
+func (tc *TierConfig) WithBdevTransport(trType, svcID, subNQN string) *TierConfig {
+	tc.Bdev.Transport = BdevTransport{
+		Type:   trType,
+		SvcID:  svcID,
+		SubNQN: subNQN,
+	}
+	return tc
+}
+
+// WithBdevNvmeOptions sets the NVMe bdev options for the tier.
+func (tc *TierConfig) WithBdevNvmeOptions(opts BdevNvmeOptions) *TierConfig {
+	tc.Bdev.NvmeOptions = opts
+	return tc
+}
+
+// WithBdevRaid sets the RAID level and strip size used to combine the tier's NVMe SSDs.
+func (tc *TierConfig) WithBdevRaid(level string, stripSizeKiB uint32) *TierConfig {
+	tc.Bdev.Raid = BdevRaid{
+		Level:        level,
+		StripSizeKiB: stripSizeKiB,
+	}
+	return tc
+}
+
+// WithBdevDelay sets the latencies added to I/O by the tier's delay bdevs.
+func (tc *TierConfig) WithBdevDelay(delay BdevDelay) *TierConfig {
+	tc.Bdev.Delay = delay
+	return tc
+}
+
+// WithBdevErrorInject sets the errors injected into I/O by the tier's error bdevs.
+func (tc *TierConfig) WithBdevErrorInject(inject BdevErrorInject) *TierConfig {
+	tc.Bdev.ErrorInject = inject
+	return tc
+}
+
+// WithBdevCache sets the cache device and parameters of the OCF bdevs fronting the tier's NVMe SSDs.
+func (tc *TierConfig) WithBdevCache(cache BdevCache) *TierConfig {
+	tc.Bdev.Cache = cache
+	return tc
+}
+
+// WithBdevBlockSize sets the block size of the tier's AIO bdevs.
+func (tc *TierConfig) WithBdevBlockSize(size uint32) *TierConfig {
+	tc.Bdev.BlockSize.Size = size
+	return tc
+}
+
+// WithBdevBlockSizeOverride sets the block size of the AIO bdev for a specific device path.
+func (tc *TierConfig) WithBdevBlockSizeOverride(path string, size uint32) *TierConfig {
+	if tc.Bdev.BlockSize.Overrides == nil {
+		tc.Bdev.BlockSize.Overrides = make(map[string]uint32)
+	}
+	tc.Bdev.BlockSize.Overrides[path] = size
+	return tc
+}
+
+// WithBdevCrypto sets the encryption parameters of the tier's bdevs.
+func (tc *TierConfig) WithBdevCrypto(crypto BdevCrypto) *TierConfig {
+	tc.Bdev.Crypto = crypto
+	return tc
+}
+
 // WithBdevDeviceRoles sets the role assignments for the bdev tier.
 func (tc *TierConfig) WithBdevDeviceRoles(bits int) *TierConfig {
 	tc.Bdev.DeviceRoles = BdevRolesFromBits(bits)
@@ -244,15 +392,39 @@ type TierConfigs []*TierConfig
 func (tcs TierConfigs) getBdevs(nvmeOnly bool) *BdevDeviceList {
 	bdevs := []string{}
 	for _, bc := range tcs.BdevConfigs() {
-		if nvmeOnly && bc.Class != ClassNvme {
+		if nvmeOnly && !bc.Class.IsLocalNVMe() {
 			continue
 		}
 		bdevs = append(bdevs, bc.Bdev.DeviceList.Devices()...)
+		if bc.Class == ClassNvmeCache && bc.Bdev.Cache.Device != "" {
+			bdevs = append(bdevs, bc.Bdev.Cache.Device)
+		}
 	}
 
 	return MustNewBdevDeviceList(bdevs...)
 }
 
+// NVMeSpares returns the spare NVMe SSDs of all tiers.
+func (tcs TierConfigs) NVMeSpares() *BdevDeviceList {
+	spares := []string{}
+	for _, bc := range tcs.BdevConfigs() {
+		spares = append(spares, bc.Bdev.Spares.Devices()...)
+	}
+
+	return MustNewBdevDeviceList(spares...)
+}
+
+// NVMeBdevsWithSpares returns the NVMe SSDs of all tiers including any spares. All of these
+// SSDs are bound to a user-space driver so that they can be used by the engine.
+func (tcs TierConfigs) NVMeBdevsWithSpares() *BdevDeviceList {
+	if len(tcs) == 0 {
+		return new(BdevDeviceList)
+	}
+
+	return MustNewBdevDeviceList(append(tcs.getBdevs(true).Devices(),
+		tcs.NVMeSpares().Devices()...)...)
+}
+
 func (tcs TierConfigs) Bdevs() *BdevDeviceList {
 	if len(tcs) == 0 {
 		return new(BdevDeviceList)
@@ -274,11 +446,11 @@ func (tcs TierConfigs) checkBdevs(nvmeOnly, emulOnly bool) bool {
 		if bc.Bdev.DeviceList.Len() > 0 {
 			switch {
 			case nvmeOnly:
-				if bc.Class == ClassNvme {
+				if bc.Class.IsLocalNVMe() {
 					return true
 				}
 			case emulOnly:
-				if bc.Class != ClassNvme {
+				if bc.Class.IsEmulatedNVMe() {
 					return true
 				}
 			default:
@@ -314,6 +486,88 @@ func (tcs TierConfigs) HaveEmulatedNVMe() bool {
 	return tcs.checkBdevs(false, true)
 }
 
+// validateBdevDevices checks that no device is assigned to more than one bdev tier. Tiers of
+// different classes may be combined in an engine but each device may only be claimed once.
+func (tcs TierConfigs) validateBdevDevices() error {
+	seen := make(map[string]int)
+	for _, bc := range tcs.BdevConfigs() {
+		devs := bc.Bdev.DeviceList.Devices()
+		if bc.Class == ClassNvmeCache && bc.Bdev.Cache.Device != "" {
+			devs = append(devs, bc.Bdev.Cache.Device)
+		}
+		devs = append(devs, bc.Bdev.Spares.Devices()...)
+		for _, dev := range devs {
+			if tier, exists := seen[dev]; exists && tier != bc.Tier {
+				return errors.Errorf("bdev device %s on tier %d already assigned to tier %d",
+					dev, bc.Tier, tier)
+			}
+			seen[dev] = bc.Tier
+		}
+	}
+
+	return nil
+}
+
+// vosEnv returns the value of the VOS_BDEV_CLASS environment variable for the bdev tiers, a comma
+// separated list of the bio bdev classes in use in order of first appearance.
+func (tcs TierConfigs) vosEnv() string {
+	var classes []string
+	for _, bc := range tcs.BdevConfigs() {
+		var vc string
+		switch bc.Class {
+		case ClassNvme, ClassNvmeFabrics:
+			vc = "NVME"
+		case ClassNvmeCache:
+			vc = "OCF"
+		case ClassNvmeRaid:
+			vc = "RAID"
+		case ClassDelay:
+			vc = "DELAY"
+		case ClassError:
+			vc = "ERROR"
+		case ClassFile, ClassKdev:
+			vc = "AIO"
+		case ClassNull:
+			vc = "NULL"
+		case ClassMalloc:
+			vc = "MALLOC"
+		default:
+			continue
+		}
+		if !common.Includes(classes, vc) {
+			classes = append(classes, vc)
+		}
+		// Encrypted devices are used through the crypto bdevs layered on top of them.
+		if bc.Bdev.Crypto.Enabled && !common.Includes(classes, "CRYPTO") {
+			classes = append(classes, "CRYPTO")
+		}
+	}
+
+	return strings.Join(classes, ",")
+}
+
+// HaveFabricsNVMe returns true if any bdev tier attaches remote NVMe-oF controllers.
+func (tcs TierConfigs) HaveFabricsNVMe() bool {
+	for _, bc := range tcs.BdevConfigs() {
+		if bc.Class == ClassNvmeFabrics && bc.Bdev.DeviceList.Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HaveErrorBdevs returns true if any bdev tier wraps its devices in error bdevs.
+func (tcs TierConfigs) HaveErrorBdevs() bool {
+	for _, bc := range tcs.BdevConfigs() {
+		if bc.Class == ClassError && bc.Bdev.DeviceList.Len() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (tcs TierConfigs) HasBdevRoleMeta() bool {
 	if len(tcs) == 0 {
 		return false
@@ -338,8 +592,11 @@ func (tcs TierConfigs) Validate() error {
 		return FaultScmConfigTierMissing
 	}
 
-	if tcs.HaveRealNVMe() && tcs.HaveEmulatedNVMe() {
-		return FaultBdevConfigTierTypeMismatch
+	if tcs.HaveFabricsNVMe() && tcs.HaveRealNVMe() {
+		return FaultBdevConfigTierTransportMismatch
+	}
+	if err := tcs.validateBdevDevices(); err != nil {
+		return err
 	}
 
 	for _, cfg := range tcs {
@@ -348,9 +605,42 @@ func (tcs TierConfigs) Validate() error {
 		}
 	}
 
+	if err := tcs.validateBdevNvmeOptions(); err != nil {
+		return err
+	}
+
 	return tcs.validateBdevRoles()
 }
 
+// SPDK NVMe bdev options are global to the SPDK instance running in an engine so verify that
+// any options specified on multiple tiers are consistent.
+func (tcs TierConfigs) validateBdevNvmeOptions() error {
+	var seen *TierConfig
+	for _, bc := range tcs.BdevConfigs() {
+		if bc.Bdev.NvmeOptions.IsEmpty() {
+			continue
+		}
+		if seen != nil && bc.Bdev.NvmeOptions != seen.Bdev.NvmeOptions {
+			return errors.Errorf("bdev_nvme options on tier %d differ from tier %d, "+
+				"options apply to all bdev tiers of an engine", bc.Tier, seen.Tier)
+		}
+		seen = bc
+	}
+
+	return nil
+}
+
+// BdevNvmeOptions returns the NVMe bdev options set on the bdev tiers.
+func (tcs TierConfigs) BdevNvmeOptions() BdevNvmeOptions {
+	for _, bc := range tcs.BdevConfigs() {
+		if !bc.Bdev.NvmeOptions.IsEmpty() {
+			return bc.Bdev.NvmeOptions
+		}
+	}
+
+	return BdevNvmeOptions{}
+}
+
 // Validation of configuration options and intended behavior to use or not use
 // the MD-on-SSD code path are as follows:
 //
@@ -574,6 +864,7 @@ type ScmConfig struct {
 	RamdiskSize      uint     `yaml:"scm_size,omitempty"`
 	DisableHugepages bool     `yaml:"scm_hugepages_disabled,omitempty"`
 	DeviceList       []string `yaml:"scm_list,omitempty"`
+	Fsck             bool     `yaml:"scm_fsck,omitempty"`
 	NumaNodeIndex    uint     `yaml:"-"`
 }
 
@@ -594,10 +885,20 @@ func (sc *ScmConfig) Validate(class Class) error {
 		if sc.DisableHugepages {
 			return errors.New("scm_hugepages_disabled may not be set when class is dcpm")
 		}
+		for _, dev := range sc.DeviceList {
+			// Character devices exposing devdax namespaces cannot host a filesystem.
+			if strings.HasPrefix(filepath.Base(dev), "dax") {
+				return errors.Errorf("scm_list device %q is a %s namespace, %s is required "+
+					"when class is dcpm", dev, ScmNsModeDevdax, ScmNsModeFsdax)
+			}
+		}
 	case ClassRam:
 		if len(sc.DeviceList) > 0 {
 			return errors.New("scm_list may not be set when class is ram")
 		}
+		if sc.Fsck {
+			return errors.New("scm_fsck may not be set when class is ram")
+		}
 		// Note: RAM-disk size can be auto-sized so allow if zero.
 		if sc.RamdiskSize != 0 {
 			confScmSize := uint64(humanize.GiByte * sc.RamdiskSize)
@@ -622,6 +923,37 @@ type BdevDeviceList struct {
 
 	// As a fallback for non-PCI bdevs, maintain a map of strings.
 	stringBdevSet common.StringSet
+
+	// Namespace IDs explicitly selected for PCI addresses, keyed on address string.
+	namespaces map[string]uint32
+}
+
+// DefaultBdevNamespaceID is the ID of the NVMe namespace used when none is specified.
+const DefaultBdevNamespaceID = 1
+
+// bdevNamespaceSep separates a PCI address from a namespace ID in a bdev_list entry.
+const bdevNamespaceSep = ":ns="
+
+// splitBdevNamespace splits a bdev_list entry of the form "<pci-address>:ns=<id>" into address and
+// namespace ID. A zero ID is returned if the entry doesn't specify a namespace.
+func splitBdevNamespace(entry string) (string, uint32, error) {
+	idx := strings.LastIndex(entry, bdevNamespaceSep)
+	if idx == -1 {
+		return entry, 0, nil
+	}
+
+	addr, idStr := entry[:idx], entry[idx+len(bdevNamespaceSep):]
+	if !maybePCI(addr) {
+		return "", 0, errors.Errorf("namespace can only be specified for a PCI address, got %q",
+			entry)
+	}
+
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil || id == 0 {
+		return "", 0, errors.Errorf("invalid namespace ID in %q", entry)
+	}
+
+	return addr, uint32(id), nil
 }
 
 // maybePCI does a quick check to see if a string could possibly be a PCI address.
@@ -643,7 +975,12 @@ func (bdl *BdevDeviceList) fromStrings(addrs []string) error {
 		bdl.stringBdevSet = common.StringSet{}
 	}
 
-	for _, strAddr := range addrs {
+	for _, entry := range addrs {
+		strAddr, nsID, err := splitBdevNamespace(entry)
+		if err != nil {
+			return errors.Wrap(err, "bdev_list")
+		}
+
 		if !maybePCI(strAddr) {
 			if err := bdl.stringBdevSet.AddUnique(strAddr); err != nil {
 				return errors.Wrap(err, "bdev_list")
@@ -663,6 +1000,13 @@ func (bdl *BdevDeviceList) fromStrings(addrs []string) error {
 		if err := bdl.Add(addr); err != nil {
 			return errors.Wrap(err, "bdev_list")
 		}
+
+		if nsID != 0 {
+			if bdl.namespaces == nil {
+				bdl.namespaces = make(map[string]uint32)
+			}
+			bdl.namespaces[addr.String()] = nsID
+		}
 	}
 
 	if len(bdl.stringBdevSet) > 0 && bdl.PCIAddressSet.Len() > 0 {
@@ -682,7 +1026,7 @@ func (bdl *BdevDeviceList) UnmarshalYAML(unmarshal func(interface{}) error) erro
 }
 
 func (bdl *BdevDeviceList) MarshalYAML() (interface{}, error) {
-	return bdl.Devices(), nil
+	return bdl.entries(), nil
 }
 
 func (bdl *BdevDeviceList) UnmarshalJSON(data []byte) error {
@@ -695,7 +1039,7 @@ func (bdl *BdevDeviceList) UnmarshalJSON(data []byte) error {
 }
 
 func (bdl *BdevDeviceList) MarshalJSON() ([]byte, error) {
-	return json.Marshal(bdl.Devices())
+	return json.Marshal(bdl.entries())
 }
 
 // PCIAddressSetPtr returns a pointer to the underlying hardware.PCIAddressSet.
@@ -731,7 +1075,15 @@ func (bdl *BdevDeviceList) Equals(other *BdevDeviceList) bool {
 	}
 
 	if bdl.PCIAddressSet.Len() > 0 {
-		return bdl.PCIAddressSet.Equals(&other.PCIAddressSet)
+		if !bdl.PCIAddressSet.Equals(&other.PCIAddressSet) {
+			return false
+		}
+		for _, addr := range bdl.Devices() {
+			if bdl.Namespace(addr) != other.Namespace(addr) {
+				return false
+			}
+		}
+		return true
 	}
 
 	for addr := range bdl.stringBdevSet {
@@ -760,6 +1112,107 @@ func (bdl *BdevDeviceList) Devices() []string {
 	return addresses
 }
 
+// Namespace returns the ID of the NVMe namespace selected for the given PCI address, defaulting to
+// the first namespace if none was specified.
+func (bdl *BdevDeviceList) Namespace(addr string) uint32 {
+	if bdl != nil {
+		if id, exists := bdl.namespaces[addr]; exists {
+			return id
+		}
+	}
+
+	return DefaultBdevNamespaceID
+}
+
+// HasNamespace returns true if a namespace was explicitly specified for the given PCI address.
+func (bdl *BdevDeviceList) HasNamespace(addr string) bool {
+	if bdl == nil {
+		return false
+	}
+
+	_, exists := bdl.namespaces[addr]
+	return exists
+}
+
+// entries returns the block device addresses as specified in config, including any namespace
+// selections.
+func (bdl *BdevDeviceList) entries() []string {
+	devices := bdl.Devices()
+	for i, addr := range devices {
+		if bdl.HasNamespace(addr) {
+			devices[i] = fmt.Sprintf("%s%s%d", addr, bdevNamespaceSep, bdl.Namespace(addr))
+		}
+	}
+
+	return devices
+}
+
+// Remove removes the PCI address from the list.
+func (bdl *BdevDeviceList) Remove(addr string) error {
+	if bdl == nil {
+		return errors.New("nil BdevDeviceList")
+	}
+
+	pciAddr, err := hardware.NewPCIAddress(addr)
+	if err != nil {
+		return err
+	}
+	if !bdl.Contains(pciAddr) {
+		return errors.Errorf("PCI address %s not in list", pciAddr)
+	}
+
+	var entries []string
+	for _, entry := range bdl.entries() {
+		if strAddr, _, _ := splitBdevNamespace(entry); strAddr != pciAddr.String() {
+			entries = append(entries, entry)
+		}
+	}
+
+	newList, err := NewBdevDeviceList(entries...)
+	if err != nil {
+		return err
+	}
+	*bdl = *newList
+
+	return nil
+}
+
+// Replace substitutes the new PCI address for the old one in the list, keeping any namespace
+// selection made for the old address.
+func (bdl *BdevDeviceList) Replace(oldAddr, newAddr string) error {
+	if bdl == nil {
+		return errors.New("nil BdevDeviceList")
+	}
+
+	old, err := hardware.NewPCIAddress(oldAddr)
+	if err != nil {
+		return err
+	}
+	addr, err := hardware.NewPCIAddress(newAddr)
+	if err != nil {
+		return err
+	}
+	if !bdl.Contains(old) {
+		return errors.Errorf("PCI address %s not in bdev_list", old)
+	}
+
+	entries := bdl.entries()
+	for i, entry := range entries {
+		strAddr, _, _ := splitBdevNamespace(entry)
+		if strAddr == old.String() {
+			entries[i] = strings.Replace(entry, strAddr, addr.String(), 1)
+		}
+	}
+
+	newList, err := NewBdevDeviceList(entries...)
+	if err != nil {
+		return err
+	}
+	*bdl = *newList
+
+	return nil
+}
+
 func (bdl *BdevDeviceList) String() string {
 	return strings.Join(bdl.Devices(), ",")
 }
@@ -984,65 +1437,603 @@ func BdevRolesFromBits(bits int) BdevRoles {
 	return BdevRoles{OptionBits(bits)}
 }
 
-// BdevConfig represents a Block Device (NVMe, etc.) configuration entry.
-type BdevConfig struct {
-	DeviceList    *BdevDeviceList `yaml:"bdev_list,omitempty"`
-	DeviceCount   int             `yaml:"bdev_number,omitempty"`
-	FileSize      int             `yaml:"bdev_size,omitempty"`
-	BusidRange    *BdevBusRange   `yaml:"bdev_busid_range,omitempty"`
-	DeviceRoles   BdevRoles       `yaml:"bdev_roles,omitempty"`
-	NumaNodeIndex uint            `yaml:"-"`
-}
+// NVMe-oF transport types that can be used to attach remote NVMe controllers.
+const (
+	BdevTransportRDMA = "rdma"
+	BdevTransportTCP  = "tcp"
 
-func (bc *BdevConfig) checkNonZeroDevFileSize(class Class) error {
-	if bc.FileSize == 0 {
-		return errors.Errorf("class %s requires non-zero bdev_size", class)
-	}
+	// DefaultBdevTransportSvcID is the IANA assigned NVMe-oF transport service port.
+	DefaultBdevTransportSvcID = "4420"
+)
 
-	return nil
+// BdevTransport describes how remote NVMe controllers are attached over NVMe-oF.
+type BdevTransport struct {
+	Type   string `yaml:"bdev_transport,omitempty"`
+	SvcID  string `yaml:"bdev_trsvcid,omitempty"`
+	SubNQN string `yaml:"bdev_subnqn,omitempty"`
 }
 
-func (bc *BdevConfig) checkNonEmptyDevList(class Class) error {
-	if bc.DeviceList == nil || bc.DeviceList.Len() == 0 {
-		return errors.Errorf("class %s requires non-empty bdev_list", class)
-	}
-
-	return nil
+// IsEmpty returns true if no transport parameters have been set.
+func (bt *BdevTransport) IsEmpty() bool {
+	return bt == nil || *bt == BdevTransport{}
 }
 
-// Validate sanity checks engine bdev config parameters and update VOS env.
-func (bc *BdevConfig) Validate(class Class) error {
-	if bc.FileSize < 0 {
-		return errors.New("negative bdev_size")
+// Validate sanity checks NVMe-oF transport parameters.
+func (bt *BdevTransport) Validate() error {
+	switch strings.ToLower(bt.Type) {
+	case BdevTransportRDMA, BdevTransportTCP:
+	case "":
+		return errors.Errorf("class %s requires bdev_transport", ClassNvmeFabrics)
+	default:
+		return errors.Errorf("bdev_transport value %q not supported (valid: %s/%s)",
+			bt.Type, BdevTransportRDMA, BdevTransportTCP)
 	}
 
-	switch class {
-	case ClassFile:
-		if err := bc.checkNonEmptyDevList(class); err != nil {
-			return err
-		}
-		if err := bc.checkNonZeroDevFileSize(class); err != nil {
-			return err
-		}
-	case ClassKdev:
-		if err := bc.checkNonEmptyDevList(class); err != nil {
-			return err
-		}
-	case ClassNvme:
-		// NB: We are specifically checking that the embedded PCIAddressSet is non-empty.
-		if bc.DeviceList == nil || bc.DeviceList.PCIAddressSet.Len() == 0 {
-			return errors.New("class nvme requires valid PCI addresses in bdev_list")
+	if bt.SubNQN == "" {
+		return errors.Errorf("class %s requires bdev_subnqn", ClassNvmeFabrics)
+	}
+
+	if bt.SvcID != "" {
+		if _, err := strconv.ParseUint(bt.SvcID, 10, 16); err != nil {
+			return errors.Errorf("invalid bdev_trsvcid %q", bt.SvcID)
 		}
-	default:
-		return errors.Errorf("class value %q not supported (valid: nvme/kdev/file)", class)
 	}
 
 	return nil
 }
 
-// parsePCIBusRange takes a string of format <Begin-End> and returns the begin and end values.
-// Number base is detected from the string prefixes e.g. 0x for hexadecimal.
-// bitSize parameter sets a cut-off for the return values e.g. 8 for uint8.
+// SPDK actions that can be taken when an NVMe I/O timeout is detected.
+const (
+	BdevNvmeTimeoutActionNone  = "none"
+	BdevNvmeTimeoutActionReset = "reset"
+	BdevNvmeTimeoutActionAbort = "abort"
+)
+
+// BdevNvmeOptions describes tunables rendered into the SPDK bdev_nvme_set_options method. Zero
+// values indicate that the SPDK defaults generated by the control plane should be used.
+type BdevNvmeOptions struct {
+	TimeoutUsec     uint64 `yaml:"bdev_nvme_timeout_us,omitempty"`
+	TimeoutAction   string `yaml:"bdev_nvme_timeout_action,omitempty"`
+	RetryCount      uint32 `yaml:"bdev_nvme_retry_count,omitempty"`
+	IoQueueRequests uint32 `yaml:"bdev_nvme_io_queue_requests,omitempty"`
+}
+
+// IsEmpty returns true if no NVMe options have been set.
+func (bno *BdevNvmeOptions) IsEmpty() bool {
+	return bno == nil || *bno == BdevNvmeOptions{}
+}
+
+// Validate sanity checks NVMe bdev options.
+func (bno *BdevNvmeOptions) Validate() error {
+	switch bno.TimeoutAction {
+	case "", BdevNvmeTimeoutActionNone, BdevNvmeTimeoutActionReset, BdevNvmeTimeoutActionAbort:
+	default:
+		return errors.Errorf("bdev_nvme_timeout_action value %q not supported (valid: %s/%s/%s)",
+			bno.TimeoutAction, BdevNvmeTimeoutActionNone, BdevNvmeTimeoutActionReset,
+			BdevNvmeTimeoutActionAbort)
+	}
+
+	if bno.TimeoutAction != "" && bno.TimeoutAction != BdevNvmeTimeoutActionNone &&
+		bno.TimeoutUsec == 0 {
+		return errors.New("bdev_nvme_timeout_action requires non-zero bdev_nvme_timeout_us")
+	}
+
+	return nil
+}
+
+// RAID levels recognized when combining NVMe SSDs into a RAID bdev, raid1 is rejected until the
+// SPDK release DAOS is built against provides it.
+const (
+	BdevRaidLevel0 = "raid0"
+	BdevRaidLevel1 = "raid1"
+
+	// DefaultBdevRaidStripSizeKiB is the strip size used for striped RAID bdevs if unset.
+	DefaultBdevRaidStripSizeKiB = 64
+)
+
+// BdevRaid describes how the NVMe SSDs of a tier are combined into a single RAID bdev.
+type BdevRaid struct {
+	Level        string `yaml:"bdev_raid_level,omitempty"`
+	StripSizeKiB uint32 `yaml:"bdev_raid_strip_size_kb,omitempty"`
+}
+
+// IsEmpty returns true if no RAID parameters have been set.
+func (br *BdevRaid) IsEmpty() bool {
+	return br == nil || *br == BdevRaid{}
+}
+
+// Validate sanity checks RAID bdev parameters against the number of member devices.
+func (br *BdevRaid) Validate(nrDevs int) error {
+	switch br.Level {
+	case BdevRaidLevel0:
+		if br.StripSizeKiB != 0 && br.StripSizeKiB&(br.StripSizeKiB-1) != 0 {
+			return errors.Errorf("bdev_raid_strip_size_kb %d is not a power of two",
+				br.StripSizeKiB)
+		}
+	case BdevRaidLevel1:
+		// Mirrored RAID bdevs are only available from SPDK v23.09.
+		return FaultBdevFeatureNotInSpdk("bdev_raid_level " + BdevRaidLevel1)
+	case "":
+		return errors.Errorf("class %s requires bdev_raid_level", ClassNvmeRaid)
+	default:
+		return errors.Errorf("bdev_raid_level value %q not supported (valid: %s)",
+			br.Level, BdevRaidLevel0)
+	}
+
+	if nrDevs < 2 {
+		return errors.Errorf("class %s requires at least 2 devices in bdev_list",
+			ClassNvmeRaid)
+	}
+
+	return nil
+}
+
+// BdevDelay describes the latencies (in microseconds) added to I/O by delay bdevs.
+type BdevDelay struct {
+	AvgReadLatencyUsec  uint64 `yaml:"bdev_delay_avg_read_us,omitempty"`
+	P99ReadLatencyUsec  uint64 `yaml:"bdev_delay_p99_read_us,omitempty"`
+	AvgWriteLatencyUsec uint64 `yaml:"bdev_delay_avg_write_us,omitempty"`
+	P99WriteLatencyUsec uint64 `yaml:"bdev_delay_p99_write_us,omitempty"`
+}
+
+// IsEmpty returns true if no delay parameters have been set.
+func (bd *BdevDelay) IsEmpty() bool {
+	return bd == nil || *bd == BdevDelay{}
+}
+
+// Validate sanity checks delay bdev parameters.
+func (bd *BdevDelay) Validate() error {
+	if bd.IsEmpty() {
+		return errors.Errorf("class %s requires at least one bdev_delay latency", ClassDelay)
+	}
+	if bd.P99ReadLatencyUsec < bd.AvgReadLatencyUsec {
+		return errors.New("bdev_delay_p99_read_us may not be less than bdev_delay_avg_read_us")
+	}
+	if bd.P99WriteLatencyUsec < bd.AvgWriteLatencyUsec {
+		return errors.New("bdev_delay_p99_write_us may not be less than bdev_delay_avg_write_us")
+	}
+
+	return nil
+}
+
+// I/O and error types that can be used when injecting errors with error bdevs.
+const (
+	BdevErrorIoTypeAll   = "all"
+	BdevErrorIoTypeRead  = "read"
+	BdevErrorIoTypeWrite = "write"
+	BdevErrorIoTypeUnmap = "unmap"
+	BdevErrorIoTypeFlush = "flush"
+
+	BdevErrorTypeFailure = "failure"
+	BdevErrorTypePending = "pending"
+)
+
+// BdevErrorInject describes the errors injected into I/O by error bdevs.
+type BdevErrorInject struct {
+	IoType    string `yaml:"bdev_error_io_type,omitempty"`
+	ErrorType string `yaml:"bdev_error_type,omitempty"`
+	Count     uint32 `yaml:"bdev_error_count,omitempty"`
+}
+
+// IsEmpty returns true if no error injection parameters have been set.
+func (bei *BdevErrorInject) IsEmpty() bool {
+	return bei == nil || *bei == BdevErrorInject{}
+}
+
+// Validate sanity checks error bdev parameters.
+func (bei *BdevErrorInject) Validate() error {
+	switch bei.IoType {
+	case BdevErrorIoTypeAll, BdevErrorIoTypeRead, BdevErrorIoTypeWrite, BdevErrorIoTypeUnmap,
+		BdevErrorIoTypeFlush:
+	default:
+		return errors.Errorf("bdev_error_io_type value %q not supported (valid: %s)",
+			bei.IoType, strings.Join([]string{BdevErrorIoTypeAll, BdevErrorIoTypeRead,
+				BdevErrorIoTypeWrite, BdevErrorIoTypeUnmap, BdevErrorIoTypeFlush}, "/"))
+	}
+
+	switch bei.ErrorType {
+	case BdevErrorTypeFailure, BdevErrorTypePending:
+	default:
+		return errors.Errorf("bdev_error_type value %q not supported (valid: %s/%s)",
+			bei.ErrorType, BdevErrorTypeFailure, BdevErrorTypePending)
+	}
+
+	if bei.Count == 0 {
+		return errors.Errorf("class %s requires non-zero bdev_error_count", ClassError)
+	}
+
+	return nil
+}
+
+// Cache modes supported by OCF cache bdevs.
+const (
+	BdevCacheModeWriteThrough = "wt"
+	BdevCacheModeWriteBack    = "wb"
+	BdevCacheModeWriteAround  = "wa"
+	BdevCacheModePassThrough  = "pt"
+	BdevCacheModeWriteInval   = "wi"
+	BdevCacheModeWriteOnly    = "wo"
+
+	// DefaultBdevCacheMode is the OCF cache mode used if unset.
+	DefaultBdevCacheMode = BdevCacheModeWriteBack
+)
+
+// BdevCache describes the NVMe SSD and OCF parameters used to cache I/O to the NVMe SSDs of a
+// tier. The same cache device is shared by all SSDs in the tier.
+type BdevCache struct {
+	Device      string `yaml:"bdev_cache_device,omitempty"`
+	Mode        string `yaml:"bdev_cache_mode,omitempty"`
+	LineSizeKiB uint32 `yaml:"bdev_cache_line_size_kb,omitempty"`
+}
+
+// IsEmpty returns true if no cache parameters have been set.
+func (bc *BdevCache) IsEmpty() bool {
+	return bc == nil || *bc == BdevCache{}
+}
+
+// Validate sanity checks cache bdev parameters against the tier's device list and sets defaults.
+func (bc *BdevCache) Validate(devs *BdevDeviceList) error {
+	if bc.Device == "" {
+		return errors.Errorf("class %s requires bdev_cache_device", ClassNvmeCache)
+	}
+	addr, err := hardware.NewPCIAddress(bc.Device)
+	if err != nil {
+		return errors.Wrap(err, "bdev_cache_device")
+	}
+	if devs.Contains(addr) {
+		return errors.Errorf("bdev_cache_device %s may not also be in bdev_list", addr)
+	}
+	bc.Device = addr.String()
+
+	switch bc.Mode {
+	case "":
+		bc.Mode = DefaultBdevCacheMode
+	case BdevCacheModeWriteThrough, BdevCacheModeWriteBack, BdevCacheModeWriteAround,
+		BdevCacheModePassThrough, BdevCacheModeWriteInval, BdevCacheModeWriteOnly:
+	default:
+		return errors.Errorf("bdev_cache_mode value %q not supported (valid: %s)", bc.Mode,
+			strings.Join([]string{BdevCacheModeWriteThrough, BdevCacheModeWriteBack,
+				BdevCacheModeWriteAround, BdevCacheModePassThrough,
+				BdevCacheModeWriteInval, BdevCacheModeWriteOnly}, "/"))
+	}
+
+	switch bc.LineSizeKiB {
+	case 0, 4, 8, 16, 32, 64:
+	default:
+		return errors.Errorf("bdev_cache_line_size_kb value %d not supported (valid: 4/8/16/32/64)",
+			bc.LineSizeKiB)
+	}
+
+	return nil
+}
+
+// Block size limits for emulated NVMe (AIO) bdevs.
+const (
+	MinBdevBlockSize = 512
+	MaxBdevBlockSize = 65536
+)
+
+// BdevBlockSize describes the block size reported by AIO bdevs of emulated NVMe tiers. Size
+// applies to all devices in the tier unless overridden for a specific device path.
+type BdevBlockSize struct {
+	Size      uint32            `yaml:"bdev_block_size,omitempty"`
+	Overrides map[string]uint32 `yaml:"bdev_block_size_overrides,omitempty"`
+}
+
+// IsEmpty returns true if no block sizes have been set.
+func (bbs *BdevBlockSize) IsEmpty() bool {
+	return bbs == nil || (bbs.Size == 0 && len(bbs.Overrides) == 0)
+}
+
+// ForDevice returns the block size to use for the given device path, zero indicates that no
+// block size has been set.
+func (bbs *BdevBlockSize) ForDevice(path string) uint32 {
+	if bbs == nil {
+		return 0
+	}
+	if size, exists := bbs.Overrides[path]; exists {
+		return size
+	}
+
+	return bbs.Size
+}
+
+func validateBdevBlockSize(size uint32) error {
+	if size < MinBdevBlockSize || size > MaxBdevBlockSize || size&(size-1) != 0 {
+		return errors.Errorf("bdev block size %d invalid, must be a power of two between "+
+			"%d and %d", size, MinBdevBlockSize, MaxBdevBlockSize)
+	}
+
+	return nil
+}
+
+// Validate sanity checks block sizes and that overrides refer to devices in the tier.
+func (bbs *BdevBlockSize) Validate(devices []string) error {
+	if bbs.Size != 0 {
+		if err := validateBdevBlockSize(bbs.Size); err != nil {
+			return err
+		}
+	}
+
+	for path, size := range bbs.Overrides {
+		if !common.Includes(devices, path) {
+			return errors.Errorf("bdev_block_size_overrides entry %q not in bdev_list", path)
+		}
+		if err := validateBdevBlockSize(size); err != nil {
+			return errors.Wrapf(err, "device %q", path)
+		}
+	}
+
+	return nil
+}
+
+// spdkBuildVersion is the SPDK release DAOS is built against, see utils/build.config. Bdev features
+// missing from this release or disabled in its build are rejected when validating tier configs.
+const spdkBuildVersion = "v22.01.2"
+
+// Ciphers and key providers supported for encryption of bdevs.
+const (
+	BdevCryptoCipherAesCbc       = "AES_CBC"
+	BdevCryptoCipherAesXts       = "AES_XTS"
+	BdevCryptoKeyProviderFile    = "file"
+	BdevCryptoKeyProviderEnv     = "env"
+	DefaultBdevCryptoCipher      = BdevCryptoCipherAesXts
+	DefaultBdevCryptoKeyProvider = BdevCryptoKeyProviderFile
+)
+
+// BdevCrypto describes the encryption of a tier's bdevs with SPDK crypto bdevs and where the key
+// material is to be retrieved from when generating the SPDK config.
+type BdevCrypto struct {
+	Enabled     bool   `yaml:"bdev_encrypt,omitempty"`
+	Cipher      string `yaml:"bdev_crypto_cipher,omitempty"`
+	KeyProvider string `yaml:"bdev_crypto_key_provider,omitempty"`
+	KeyFile     string `yaml:"bdev_crypto_key_file,omitempty"`
+}
+
+// IsEmpty returns true if no encryption parameters have been set.
+func (bc *BdevCrypto) IsEmpty() bool {
+	return bc == nil || *bc == BdevCrypto{}
+}
+
+// Validate sanity checks encryption parameters and applies defaults.
+func (bc *BdevCrypto) Validate() error {
+	if !bc.Enabled {
+		return errors.New("bdev_crypto options may only be set when bdev_encrypt is true")
+	}
+
+	switch bc.Cipher {
+	case "":
+		bc.Cipher = DefaultBdevCryptoCipher
+	case BdevCryptoCipherAesCbc, BdevCryptoCipherAesXts:
+	default:
+		return errors.Errorf("bdev_crypto_cipher value %q not supported (valid: %s/%s)",
+			bc.Cipher, BdevCryptoCipherAesCbc, BdevCryptoCipherAesXts)
+	}
+
+	switch bc.KeyProvider {
+	case "":
+		bc.KeyProvider = DefaultBdevCryptoKeyProvider
+		fallthrough
+	case BdevCryptoKeyProviderFile:
+		if bc.KeyFile == "" {
+			return errors.Errorf("bdev_crypto_key_provider %q requires bdev_crypto_key_file",
+				BdevCryptoKeyProviderFile)
+		}
+		if !filepath.IsAbs(bc.KeyFile) {
+			return errors.Errorf("bdev_crypto_key_file %q must be an absolute path",
+				bc.KeyFile)
+		}
+	case BdevCryptoKeyProviderEnv:
+		if bc.KeyFile != "" {
+			return errors.Errorf("bdev_crypto_key_file may not be set with "+
+				"bdev_crypto_key_provider %q", BdevCryptoKeyProviderEnv)
+		}
+	default:
+		return errors.Errorf("bdev_crypto_key_provider value %q not supported (valid: %s/%s)",
+			bc.KeyProvider, BdevCryptoKeyProviderFile, BdevCryptoKeyProviderEnv)
+	}
+
+	return nil
+}
+
+// BdevConfig represents a Block Device (NVMe, etc.) configuration entry.
+type BdevConfig struct {
+	DeviceList    *BdevDeviceList `yaml:"bdev_list,omitempty"`
+	Spares        *BdevDeviceList `yaml:"bdev_spares,omitempty"`
+	DeviceCount   int             `yaml:"bdev_number,omitempty"`
+	FileSize      int             `yaml:"bdev_size,omitempty"`
+	FileSparse    bool            `yaml:"bdev_file_sparse,omitempty"`
+	Zoned         bool            `yaml:"bdev_zoned,omitempty"`
+	BusidRange    *BdevBusRange   `yaml:"bdev_busid_range,omitempty"`
+	DeviceRoles   BdevRoles       `yaml:"bdev_roles,omitempty"`
+	Transport     BdevTransport   `yaml:",inline"`
+	NvmeOptions   BdevNvmeOptions `yaml:",inline"`
+	Raid          BdevRaid        `yaml:",inline"`
+	Delay         BdevDelay       `yaml:",inline"`
+	ErrorInject   BdevErrorInject `yaml:",inline"`
+	BlockSize     BdevBlockSize   `yaml:",inline"`
+	Crypto        BdevCrypto      `yaml:",inline"`
+	Cache         BdevCache       `yaml:",inline"`
+	NumaNodeIndex uint            `yaml:"-"`
+}
+
+func (bc *BdevConfig) checkNonZeroDevFileSize(class Class) error {
+	if bc.FileSize == 0 {
+		return errors.Errorf("class %s requires non-zero bdev_size", class)
+	}
+
+	return nil
+}
+
+func (bc *BdevConfig) checkNonEmptyDevList(class Class) error {
+	if bc.DeviceList == nil || bc.DeviceList.Len() == 0 {
+		return errors.Errorf("class %s requires non-empty bdev_list", class)
+	}
+
+	return nil
+}
+
+// validateSpares checks that spare SSDs are only specified by PCI address for an nvme class
+// tier and are not also in the tier's bdev_list.
+func (bc *BdevConfig) validateSpares(class Class) error {
+	if bc.Spares.Len() == 0 {
+		return nil
+	}
+
+	if class != ClassNvme {
+		return errors.Errorf("bdev_spares may only be set when class is %s", ClassNvme)
+	}
+	if bc.Spares.PCIAddressSet.Len() != bc.Spares.Len() {
+		return errors.New("bdev_spares requires PCI addresses")
+	}
+	for _, addr := range bc.Spares.Devices() {
+		if bc.Spares.HasNamespace(addr) {
+			return errors.Errorf("bdev_spares entry %s may not select a namespace", addr)
+		}
+		if common.Includes(bc.DeviceList.Devices(), addr) {
+			return errors.Errorf("bdev_spares entry %s is also in bdev_list", addr)
+		}
+	}
+
+	return nil
+}
+
+// Validate sanity checks engine bdev config parameters and update VOS env.
+func (bc *BdevConfig) Validate(class Class) error {
+	if bc.FileSize < 0 {
+		return errors.New("negative bdev_size")
+	}
+
+	if err := bc.validateSpares(class); err != nil {
+		return err
+	}
+
+	if class != ClassFile && bc.FileSparse {
+		return errors.Errorf("bdev_file_sparse may only be set when class is %s", ClassFile)
+	}
+
+	if class != ClassNvme && bc.Zoned {
+		return errors.Errorf("bdev_zoned may only be set when class is %s", ClassNvme)
+	}
+
+	if class != ClassNvmeFabrics && !bc.Transport.IsEmpty() {
+		return errors.Errorf("bdev_transport may only be set when class is %s",
+			ClassNvmeFabrics)
+	}
+
+	if class != ClassNvmeRaid && !bc.Raid.IsEmpty() {
+		return errors.Errorf("bdev_raid options may only be set when class is %s",
+			ClassNvmeRaid)
+	}
+
+	if class != ClassDelay && !bc.Delay.IsEmpty() {
+		return errors.Errorf("bdev_delay options may only be set when class is %s",
+			ClassDelay)
+	}
+
+	if class != ClassError && !bc.ErrorInject.IsEmpty() {
+		return errors.Errorf("bdev_error options may only be set when class is %s",
+			ClassError)
+	}
+
+	if class != ClassNvmeCache && !bc.Cache.IsEmpty() {
+		return errors.Errorf("bdev_cache options may only be set when class is %s",
+			ClassNvmeCache)
+	}
+
+	if !class.IsEmulatedNVMe() && !bc.BlockSize.IsEmpty() {
+		return errors.Errorf("bdev_block_size may only be set when class is %s, %s, %s or %s",
+			ClassFile, ClassKdev, ClassNull, ClassMalloc)
+	}
+
+	if !bc.Crypto.IsEmpty() {
+		switch class {
+		case ClassNvme, ClassFile, ClassKdev:
+		default:
+			return errors.Errorf("bdev_encrypt may only be set when class is %s, %s or %s",
+				ClassNvme, ClassFile, ClassKdev)
+		}
+		if err := bc.Crypto.Validate(); err != nil {
+			return err
+		}
+		// SPDK is built --without-crypto and lacks accel_crypto_key_create.
+		return FaultBdevFeatureNotInSpdk("bdev_encrypt")
+	}
+
+	if !bc.NvmeOptions.IsEmpty() {
+		if class.IsEmulatedNVMe() {
+			return errors.Errorf("bdev_nvme options may not be set when class is %s", class)
+		}
+		if err := bc.NvmeOptions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	switch class {
+	case ClassFile:
+		if err := bc.checkNonEmptyDevList(class); err != nil {
+			return err
+		}
+		if err := bc.checkNonZeroDevFileSize(class); err != nil {
+			return err
+		}
+		return bc.BlockSize.Validate(bc.DeviceList.Devices())
+	case ClassKdev:
+		if err := bc.checkNonEmptyDevList(class); err != nil {
+			return err
+		}
+		return bc.BlockSize.Validate(bc.DeviceList.Devices())
+	case ClassNull, ClassMalloc:
+		// Entries in bdev_list are only used to name the bdevs created in memory.
+		if err := bc.checkNonEmptyDevList(class); err != nil {
+			return err
+		}
+		if err := bc.checkNonZeroDevFileSize(class); err != nil {
+			return err
+		}
+		return bc.BlockSize.Validate(bc.DeviceList.Devices())
+	case ClassNvme, ClassNvmeRaid, ClassDelay, ClassError, ClassNvmeCache:
+		// NB: We are specifically checking that the embedded PCIAddressSet is non-empty.
+		if bc.DeviceList == nil || bc.DeviceList.PCIAddressSet.Len() == 0 {
+			return errors.Errorf("class %s requires valid PCI addresses in bdev_list", class)
+		}
+		switch class {
+		case ClassNvmeRaid:
+			return bc.Raid.Validate(bc.DeviceList.Len())
+		case ClassDelay:
+			return bc.Delay.Validate()
+		case ClassError:
+			return bc.ErrorInject.Validate()
+		case ClassNvmeCache:
+			if err := bc.Cache.Validate(bc.DeviceList); err != nil {
+				return err
+			}
+			// SPDK is built without --with-ocf so bdev_ocf_create is unavailable.
+			return FaultBdevFeatureNotInSpdk("class " + string(ClassNvmeCache))
+		}
+	case ClassNvmeFabrics:
+		if err := bc.checkNonEmptyDevList(class); err != nil {
+			return err
+		}
+		for _, addr := range bc.DeviceList.Devices() {
+			if net.ParseIP(addr) == nil {
+				return errors.Errorf("class %s requires IP addresses in bdev_list, got %q",
+					class, addr)
+			}
+		}
+		if err := bc.Transport.Validate(); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("class value %q not supported (valid: nvme/kdev/file/nvmf/nvme-raid/nvme-delay/nvme-error/nvme-cache/null/malloc)", class)
+	}
+
+	return nil
+}
+
+// parsePCIBusRange takes a string of format <Begin-End> and returns the begin and end values.
+// Number base is detected from the string prefixes e.g. 0x for hexadecimal.
+// bitSize parameter sets a cut-off for the return values e.g. 8 for uint8.
 func parsePCIBusRange(numRange string, bitSize int) (uint8, uint8, error) {
 	if numRange == "" {
 		return 0, 0, nil
@@ -1091,11 +2082,55 @@ func (obs *AccelOptionBits) UnmarshalYAML(unmarshal func(interface{}) error) err
 	return obs.fromStrings(accelOptFlags, opts...)
 }
 
+// Hardware modules that can be enabled in the SPDK accel framework to offload operations. SPDK
+// v22.01 provides the idxd accel engine for DSA devices only, which offloads any operation the
+// device supports, so operations are not assigned to modules individually.
+const (
+	AccelModuleDSA = "dsa"
+)
+
 // AccelProps struct describes acceleration engine setting and optional capabilities expressed
 // as a bitset. AccelProps is used both in YAML server config and JSON NVMe config files.
+//
+// Hardware offload settings are only used to generate SPDK accel framework subsystem config so are
+// not included in the JSON representation.
 type AccelProps struct {
-	Engine  string          `yaml:"engine,omitempty" json:"accel_engine"`
-	Options AccelOptionBits `yaml:"options,omitempty" json:"accel_opts"`
+	Engine     string          `yaml:"engine,omitempty" json:"accel_engine"`
+	Options    AccelOptionBits `yaml:"options,omitempty" json:"accel_opts"`
+	Modules    []string        `yaml:"modules,omitempty" json:"-"`
+	KernelMode bool            `yaml:"kernel_mode,omitempty" json:"-"`
+}
+
+// HasModule returns true if the given hardware accel module has been enabled.
+func (ap *AccelProps) HasModule(module string) bool {
+	return common.Includes(ap.Modules, module)
+}
+
+// validateModules checks hardware offload settings are consistent with the selected engine.
+func (ap *AccelProps) validateModules() error {
+	if len(ap.Modules) == 0 {
+		if ap.KernelMode {
+			return errors.New("acceleration kernel_mode requires modules")
+		}
+		return nil
+	}
+
+	if ap.Engine != AccelEngineSPDK {
+		return errors.Errorf("acceleration modules require engine %q", AccelEngineSPDK)
+	}
+
+	seen := common.NewStringSet()
+	for _, m := range ap.Modules {
+		if m != AccelModuleDSA {
+			return errors.Errorf("unsupported acceleration module %q (valid: %s)", m,
+				AccelModuleDSA)
+		}
+		if err := seen.AddUnique(m); err != nil {
+			return errors.Wrap(err, "acceleration modules")
+		}
+	}
+
+	return nil
 }
 
 func (ap *AccelProps) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -1127,16 +2162,89 @@ func (ap *AccelProps) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return FaultBdevAccelEngineUnknown(ap.Engine, AccelEngineSPDK, AccelEngineDML)
 	}
 
+	if err := out.validateModules(); err != nil {
+		return err
+	}
+
 	*ap = out
 
 	return nil
 }
 
 // SpdkRpcServer struct describes settings for an optional SPDK JSON-RPC server instance that can
-// run in the engine process.
+// run in the engine process. SockOwner and SockGroup are user and group names (or numeric IDs)
+// that are resolved to SockUID and SockGID when the engine's SPDK config file is generated.
 type SpdkRpcServer struct {
-	Enable   bool   `yaml:"enable,omitempty" json:"enable"`
-	SockAddr string `yaml:"sock_addr,omitempty" json:"sock_addr"`
+	Enable    bool    `yaml:"enable,omitempty" json:"enable"`
+	SockAddr  string  `yaml:"sock_addr,omitempty" json:"sock_addr"`
+	SockOwner string  `yaml:"sock_owner,omitempty" json:"-"`
+	SockGroup string  `yaml:"sock_group,omitempty" json:"-"`
+	SockMode  uint32  `yaml:"sock_mode,omitempty" json:"sock_mode,omitempty"`
+	SockUID   *uint32 `yaml:"-" json:"sock_uid,omitempty"`
+	SockGID   *uint32 `yaml:"-" json:"sock_gid,omitempty"`
+}
+
+// Validate sanity checks SPDK JSON-RPC server settings.
+func (srs *SpdkRpcServer) Validate() error {
+	// The engine refuses to start an SPDK JSON-RPC server in release builds.
+	if srs.Enable && build.ReleaseBuild {
+		return errors.New("spdk_rpc_server may not be enabled in release builds")
+	}
+	if srs.SockMode&^uint32(0777) != 0 {
+		return errors.Errorf("spdk_rpc_server sock_mode %#o is not a valid permission mode",
+			srs.SockMode)
+	}
+
+	return nil
+}
+
+// parseSockID returns the numeric form of an ID that is either specified as a number or as a name
+// resolved by the supplied lookup function.
+func parseSockID(name string, lookup func(string) (string, error)) (*uint32, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseUint(name, 10, 32)
+	if err != nil {
+		idStr, err := lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		if id, err = strconv.ParseUint(idStr, 10, 32); err != nil {
+			return nil, err
+		}
+	}
+	v := uint32(id)
+
+	return &v, nil
+}
+
+// resolveSockOwnership sets the IDs of the configured socket owner and group.
+func (srs *SpdkRpcServer) resolveSockOwnership() (err error) {
+	srs.SockUID, err = parseSockID(srs.SockOwner, func(name string) (string, error) {
+		u, err := user.Lookup(name)
+		if err != nil {
+			return "", err
+		}
+		return u.Uid, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "spdk_rpc_server sock_owner %q", srs.SockOwner)
+	}
+
+	srs.SockGID, err = parseSockID(srs.SockGroup, func(name string) (string, error) {
+		g, err := user.LookupGroup(name)
+		if err != nil {
+			return "", err
+		}
+		return g.Gid, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "spdk_rpc_server sock_group %q", srs.SockGroup)
+	}
+
+	return nil
 }
 
 // BdevAutoFaulty struct describes settings for detection of faulty NVMe devices within the BIO
@@ -1147,6 +2255,128 @@ type BdevAutoFaulty struct {
 	MaxCsumErrs uint32 `yaml:"max_csum_errs,omitempty" json:"max_csum_errs"`
 }
 
+// SPDK log levels.
+const (
+	SpdkLogLevelDisabled = "DISABLED"
+	SpdkLogLevelError    = "ERROR"
+	SpdkLogLevelWarning  = "WARNING"
+	SpdkLogLevelNotice   = "NOTICE"
+	SpdkLogLevelInfo     = "INFO"
+	SpdkLogLevelDebug    = "DEBUG"
+)
+
+var spdkLogLevels = []string{
+	SpdkLogLevelDisabled, SpdkLogLevelError, SpdkLogLevelWarning, SpdkLogLevelNotice,
+	SpdkLogLevelInfo, SpdkLogLevelDebug,
+}
+
+// SpdkLog struct describes SPDK logging settings applied by the BIO module of the engine process
+// when initializing SPDK. Level sets the minimum level of messages logged to syslog, PrintLevel
+// the minimum level of messages printed to stderr and Flags enables named SPDK log components
+// (e.g. bdev_nvme) for debug output.
+type SpdkLog struct {
+	Level      string   `yaml:"level,omitempty" json:"level,omitempty"`
+	PrintLevel string   `yaml:"print_level,omitempty" json:"print_level,omitempty"`
+	Flags      []string `yaml:"flags,omitempty" json:"flags,omitempty"`
+}
+
+// IsEmpty returns true if no SPDK log settings have been specified.
+func (sl *SpdkLog) IsEmpty() bool {
+	return sl == nil || (sl.Level == "" && sl.PrintLevel == "" && len(sl.Flags) == 0)
+}
+
+// Validate sanity checks SPDK log settings and normalizes level names to upper case.
+func (sl *SpdkLog) Validate() error {
+	for _, lvl := range []*string{&sl.Level, &sl.PrintLevel} {
+		if *lvl == "" {
+			continue
+		}
+		*lvl = strings.ToUpper(*lvl)
+		if !common.Includes(spdkLogLevels, *lvl) {
+			return errors.Errorf("unknown spdk log level %q (valid: %s)", *lvl,
+				strings.Join(spdkLogLevels, ", "))
+		}
+	}
+
+	seen := common.NewStringSet()
+	for _, flag := range sl.Flags {
+		if flag == "" {
+			return errors.New("spdk log flag may not be empty")
+		}
+		if err := seen.AddUnique(flag); err != nil {
+			return errors.Wrap(err, "spdk log flags")
+		}
+	}
+
+	return nil
+}
+
+// MaxHotplugPollPeriodUsec is the longest hotplug poll period accepted by SPDK.
+const MaxHotplugPollPeriodUsec = 10000000
+
+// Minimum data buffer pool sizes accepted by SPDK.
+const (
+	MinBdevSmallBufPoolSize = 8191
+	MinBdevLargeBufPoolSize = 1023
+)
+
+// BdevOptions describes tunables rendered into the SPDK bdev_set_options method that size the
+// bdev_io and data buffer pools shared by all threads of an engine. Zero values indicate that
+// the SPDK defaults generated by the control plane should be used.
+type BdevOptions struct {
+	IoPoolSize       uint64 `yaml:"bdev_io_pool_size,omitempty"`
+	IoCacheSize      uint64 `yaml:"bdev_io_cache_size,omitempty"`
+	SmallBufPoolSize uint64 `yaml:"bdev_small_buf_pool_size,omitempty"`
+	LargeBufPoolSize uint64 `yaml:"bdev_large_buf_pool_size,omitempty"`
+}
+
+// IsEmpty returns true if no bdev options have been set.
+func (bo *BdevOptions) IsEmpty() bool {
+	return bo == nil || *bo == BdevOptions{}
+}
+
+// Validate sanity checks bdev options.
+func (bo *BdevOptions) Validate() error {
+	if bo.IoPoolSize != 0 && bo.IoCacheSize > bo.IoPoolSize {
+		return errors.Errorf("bdev_io_cache_size %d exceeds bdev_io_pool_size %d",
+			bo.IoCacheSize, bo.IoPoolSize)
+	}
+	if bo.SmallBufPoolSize != 0 && bo.SmallBufPoolSize < MinBdevSmallBufPoolSize {
+		return errors.Errorf("bdev_small_buf_pool_size %d is less than minimum of %d",
+			bo.SmallBufPoolSize, MinBdevSmallBufPoolSize)
+	}
+	if bo.LargeBufPoolSize != 0 && bo.LargeBufPoolSize < MinBdevLargeBufPoolSize {
+		return errors.Errorf("bdev_large_buf_pool_size %d is less than minimum of %d",
+			bo.LargeBufPoolSize, MinBdevLargeBufPoolSize)
+	}
+
+	return nil
+}
+
+// SpdkEnvOptions describes settings that run the SPDK environment of an engine without
+// hugepages, using memory from the process heap instead (iova-mode=va). This is intended for
+// containerized and CI deployments where hugepages cannot be reserved and is only supported with
+// emulated NVMe bdevs.
+type SpdkEnvOptions struct {
+	NoHugepages bool   `yaml:"spdk_no_hugepages,omitempty" json:"no_huge"`
+	MemSizeMiB  uint32 `yaml:"spdk_mem_size_mb,omitempty" json:"mem_size_mb"`
+}
+
+// Validate sanity checks SPDK environment options.
+func (seo *SpdkEnvOptions) Validate() error {
+	if !seo.NoHugepages {
+		if seo.MemSizeMiB != 0 {
+			return errors.New("spdk_mem_size_mb can only be set with spdk_no_hugepages")
+		}
+		return nil
+	}
+	if seo.MemSizeMiB == 0 {
+		return errors.New("spdk_mem_size_mb must be set with spdk_no_hugepages")
+	}
+
+	return nil
+}
+
 // Config defines engine storage.
 type Config struct {
 	ControlMetadata  ControlMetadata `yaml:"-"` // inherited from server
@@ -1159,6 +2389,12 @@ type Config struct {
 	AccelProps       AccelProps      `yaml:"acceleration,omitempty"`
 	SpdkRpcSrvProps  SpdkRpcServer   `yaml:"spdk_rpc_server,omitempty"`
 	AutoFaultyProps  BdevAutoFaulty  `yaml:"bdev_auto_faulty,omitempty"`
+	SpdkLogProps     SpdkLog         `yaml:"spdk_log,omitempty"`
+	HotplugPollUsec  uint64          `yaml:"bdev_hotplug_poll_us,omitempty"`
+	BdevOptions      BdevOptions     `yaml:",inline"`
+	SpdkEnvOpts      SpdkEnvOptions  `yaml:",inline"`
+	BdevExclude      []string        `yaml:"bdev_exclude,omitempty"`
+	ExcludeUnhealthy bool            `yaml:"bdev_exclude_unhealthy,omitempty"`
 }
 
 // SetNUMAAffinity enables the assignment of NUMA affinity to tier configs.
@@ -1174,12 +2410,67 @@ func (c *Config) GetBdevs() *BdevDeviceList {
 	return c.Tiers.Bdevs()
 }
 
+// validateBdevExclude checks that bdev_exclude only lists PCI addresses of devices assigned to
+// NVMe bdev tiers.
+func (c *Config) validateBdevExclude() error {
+	if len(c.BdevExclude) == 0 {
+		return nil
+	}
+
+	nvmeBdevs := c.Tiers.NVMeBdevs()
+	for _, entry := range c.BdevExclude {
+		addr, err := hardware.NewPCIAddress(entry)
+		if err != nil {
+			return errors.Wrap(err, "bdev_exclude")
+		}
+		if !nvmeBdevs.Contains(addr) {
+			return errors.Errorf("bdev_exclude address %s not found in any nvme bdev_list",
+				addr)
+		}
+	}
+
+	return nil
+}
+
 // Validate checks the validity of the storage config.
 func (c *Config) Validate() error {
 	if err := c.Tiers.Validate(); err != nil {
 		return err
 	}
 
+	if c.HotplugPollUsec > MaxHotplugPollPeriodUsec {
+		return errors.Errorf("bdev_hotplug_poll_us %d exceeds maximum of %d",
+			c.HotplugPollUsec, MaxHotplugPollPeriodUsec)
+	}
+
+	if err := c.BdevOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.SpdkEnvOpts.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.SpdkLogProps.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.SpdkRpcSrvProps.Validate(); err != nil {
+		return err
+	}
+	// Errors are injected into error bdevs through the SPDK JSON-RPC server once the engine
+	// has started.
+	if c.Tiers.HaveErrorBdevs() && !c.SpdkRpcSrvProps.Enable {
+		return errors.Errorf("class %s requires spdk_rpc_server to be enabled", ClassError)
+	}
+	if c.SpdkEnvOpts.NoHugepages && c.Tiers.HaveRealNVMe() {
+		return FaultBdevConfigNoHugepagesWithRealNVMe
+	}
+
+	if err := c.validateBdevExclude(); err != nil {
+		return err
+	}
+
 	bdevCfgs := c.Tiers.BdevConfigs()
 
 	// set persistent location for engine bdev config file to be consumed by provider
@@ -1193,13 +2484,7 @@ func (c *Config) Validate() error {
 		return nil
 	}
 
-	// set vos environment variable based on class of first bdev config
-	switch bdevCfgs[0].Class {
-	case ClassNvme:
-		c.VosEnv = "NVME"
-	case ClassFile, ClassKdev:
-		c.VosEnv = "AIO"
-	}
+	c.VosEnv = bdevCfgs.vosEnv()
 
 	var nvmeConfigRoot string
 	if c.ControlMetadata.HasPath() {
@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2023 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -15,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -110,6 +112,34 @@ func TestStorage_BdevDeviceList(t *testing.T) {
 `,
 			expJSONStr: `["/dev/block0","/dev/block1"]`,
 		},
+		"pci addresses with namespaces": {
+			devices: []string{"0000:81:00.0:ns=2", "0000:82:00.0"},
+			expList: &BdevDeviceList{
+				PCIAddressSet: *hardware.MustNewPCIAddressSet("0000:81:00.0", "0000:82:00.0"),
+				namespaces:    map[string]uint32{"0000:81:00.0": 2},
+			},
+			expYamlStr: `
+- 0000:81:00.0:ns=2
+- 0000:82:00.0
+`,
+			expJSONStr: `["0000:81:00.0:ns=2","0000:82:00.0"]`,
+		},
+		"namespace on non-pci device": {
+			devices: []string{"/dev/block0:ns=1"},
+			expErr:  errors.New("only be specified for a PCI address"),
+		},
+		"zero namespace id": {
+			devices: []string{"0000:81:00.0:ns=0"},
+			expErr:  errors.New("invalid namespace ID"),
+		},
+		"non-numeric namespace id": {
+			devices: []string{"0000:81:00.0:ns=two"},
+			expErr:  errors.New("invalid namespace ID"),
+		},
+		"duplicate pci device with different namespaces": {
+			devices: []string{"0000:81:00.0:ns=1", "0000:81:00.0:ns=2"},
+			expErr:  errors.New("duplicate"),
+		},
 		"invalid pci device": {
 			devices: []string{"0000:8g:00.0"},
 			expErr:  errors.New("unable to parse \"0000:8g:00.0\""),
@@ -356,10 +386,270 @@ storage:
 -
   class: nvme
   bdev_list: [0000:80:00.0]
+  bdev_roles: [wal,meta]
+-
+  class: file
+  bdev_list: [/tmp/daos0.aio]
+  bdev_size: 16
+  bdev_roles: [data]`,
+			expTierCfgs: TierConfigs{
+				NewTierConfig().
+					WithStorageClass("ram").
+					WithScmRamdiskSize(16).
+					WithScmMountPoint("/mnt/daos"),
+				NewTierConfig().
+					WithTier(1).
+					WithStorageClass("nvme").
+					WithBdevDeviceList("0000:80:00.0").
+					WithBdevDeviceRoles(BdevRoleWAL | BdevRoleMeta),
+				NewTierConfig().
+					WithTier(2).
+					WithStorageClass("file").
+					WithBdevDeviceList("/tmp/daos0.aio").
+					WithBdevFileSize(16).
+					WithBdevDeviceRoles(BdevRoleData),
+			},
+		},
+		"nvmf and nvme bdev tiers mixed": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_roles: [wal]
+-
+  class: nvmf
+  bdev_list: [10.0.0.1]
+  bdev_transport: rdma
+  bdev_subnqn: nqn.2016-06.io.spdk:cnode1
+  bdev_roles: [meta,data]`,
+			expValidateErr: FaultBdevConfigTierTransportMismatch,
+		},
+		"device in multiple bdev tiers": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
 -
   class: file
-  bdev_list: [/tmp/daos0.aio]`,
-			expValidateErr: FaultBdevConfigTierTypeMismatch,
+  bdev_list: [/tmp/daos0.aio]
+  bdev_size: 16
+-
+  class: file
+  bdev_list: [/tmp/daos1.aio, /tmp/daos0.aio]
+  bdev_size: 16`,
+			expValidateErr: errors.New("/tmp/daos0.aio on tier 2 already assigned to tier 1"),
+		},
+		"nvme bdev tier with spares": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0,0000:81:00.0]
+  bdev_spares: [0000:82:00.0]`,
+			expTierCfgs: TierConfigs{
+				NewTierConfig().
+					WithStorageClass("ram").
+					WithScmRamdiskSize(16).
+					WithScmMountPoint("/mnt/daos"),
+				NewTierConfig().
+					WithTier(1).
+					WithStorageClass("nvme").
+					WithBdevDeviceList("0000:80:00.0", "0000:81:00.0").
+					WithBdevSpares("0000:82:00.0"),
+			},
+		},
+		"spares on non-nvme bdev tier": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: file
+  bdev_list: [/tmp/daos0.aio]
+  bdev_size: 16
+  bdev_spares: [/tmp/daos1.aio]`,
+			expValidateErr: errors.New("bdev_spares may only be set when class is nvme"),
+		},
+		"spare also in bdev_list": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0,0000:81:00.0]
+  bdev_spares: [0000:81:00.0]`,
+			expValidateErr: errors.New("bdev_spares entry 0000:81:00.0 is also in bdev_list"),
+		},
+		"spare with namespace": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_spares: ["0000:81:00.0:ns=2"]`,
+			expValidateErr: errors.New("bdev_spares entry 0000:81:00.0 may not select a namespace"),
+		},
+		"spare assigned to another bdev tier": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_roles: [wal]
+-
+  class: nvme
+  bdev_list: [0000:81:00.0]
+  bdev_roles: [meta,data]
+  bdev_spares: [0000:80:00.0]`,
+			expValidateErr: errors.New("0000:80:00.0 on tier 2 already assigned to tier 1"),
+		},
+		"nvmf bdev tier; unsupported transport": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvmf
+  bdev_list: [10.0.0.1]
+  bdev_transport: fc
+  bdev_subnqn: nqn.2016-06.io.spdk:cnode1`,
+			expValidateErr: errors.New("bdev_transport value \"fc\" not supported"),
+		},
+		"transport set on nvme bdev tier": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_transport: tcp`,
+			expValidateErr: errors.New("bdev_transport may only be set"),
+		},
+		"nvme options differ between bdev tiers": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_roles: [wal]
+  bdev_nvme_retry_count: 8
+-
+  class: nvme
+  bdev_list: [0000:81:00.0]
+  bdev_roles: [meta,data]
+  bdev_nvme_retry_count: 4`,
+			expValidateErr: errors.New("options on tier 2 differ from tier 1"),
+		},
+		"nvme-raid bdev tier; unsupported raid level": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme-raid
+  bdev_list: [0000:80:00.0,0000:81:00.0]
+  bdev_raid_level: raid5`,
+			expValidateErr: errors.New("bdev_raid_level value \"raid5\" not supported"),
+		},
+		"nvme-cache bdev tier; not supported by spdk build": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme-cache
+  bdev_list: [0000:80:00.0,0000:81:00.0]
+  bdev_cache_device: 0000:5e:00.0`,
+			expValidateErr: FaultBdevFeatureNotInSpdk("class nvme-cache"),
+		},
+		"nvme-cache bdev tier; missing cache device": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme-cache
+  bdev_list: [0000:80:00.0]`,
+			expValidateErr: errors.New("requires bdev_cache_device"),
+		},
+		"nvme-cache bdev tier; cache device in bdev_list": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme-cache
+  bdev_list: [0000:80:00.0,0000:81:00.0]
+  bdev_cache_device: 0000:81:00.0`,
+			expValidateErr: errors.New("may not also be in bdev_list"),
+		},
+		"nvme-cache bdev tier; unsupported cache mode": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme-cache
+  bdev_list: [0000:80:00.0]
+  bdev_cache_device: 0000:5e:00.0
+  bdev_cache_mode: wx`,
+			expValidateErr: errors.New("bdev_cache_mode value \"wx\" not supported"),
+		},
+		"cache options on nvme bdev tier": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+-
+  class: nvme
+  bdev_list: [0000:80:00.0]
+  bdev_cache_device: 0000:5e:00.0`,
+			expValidateErr: errors.New("bdev_cache options may only be set"),
 		},
 		"tier 1 fails validation": {
 			input: `
@@ -441,6 +731,25 @@ storage:
   bdev_list: [0000:81:00.0,0000:82:00.0]`,
 			expValidateErr: FaultBdevConfigMultiTiersWithoutRoles,
 		},
+		"ram scm tier; fsck set": {
+			input: `
+storage:
+-
+  class: ram
+  scm_size: 16
+  scm_mount: /mnt/daos
+  scm_fsck: true`,
+			expValidateErr: errors.New("scm_fsck may not be set when class is ram"),
+		},
+		"dcpm scm tier; devdax namespace": {
+			input: `
+storage:
+-
+  class: dcpm
+  scm_list: [/dev/dax0.0]
+  scm_mount: /mnt/daos`,
+			expValidateErr: errors.New("is a devdax namespace"),
+		},
 		"roles unspecified; dcpm scm tier; three bdev tiers": {
 			input: `
 storage:
@@ -872,6 +1181,41 @@ acceleration:
 				Options: AccelOptCRCFlag | AccelOptMoveFlag,
 			},
 		},
+		"engine set; hardware modules set": {
+			input: `
+acceleration:
+  engine: spdk
+  options:
+  - crc
+  modules:
+  - dsa
+  kernel_mode: true
+`,
+			expProps: AccelProps{
+				Engine:     AccelEngineSPDK,
+				Options:    AccelOptCRCFlag,
+				Modules:    []string{AccelModuleDSA},
+				KernelMode: true,
+			},
+		},
+		"hardware modules set with dml engine": {
+			input: `
+acceleration:
+  engine: dml
+  modules:
+  - dsa
+`,
+			expErr: errors.New("modules require engine \"spdk\""),
+		},
+		"iaa module not supported": {
+			input: `
+acceleration:
+  engine: spdk
+  modules:
+  - iaa
+`,
+			expErr: errors.New("unsupported acceleration module \"iaa\""),
+		},
 		"unrecognized engine": {
 			input: `
 acceleration:
@@ -1157,6 +1501,36 @@ storage:
 				},
 			},
 		},
+		"kdev tier with block sizes": {
+			input: `
+storage:
+-
+  class: ram
+  scm_mount: /mnt/daos/1
+  scm_size: 16
+-
+  class: kdev
+  bdev_list: [/dev/sdb, /dev/sdc]
+  bdev_block_size: 512
+  bdev_block_size_overrides:
+    /dev/sdc: 8192
+`,
+			expTiers: TierConfigs{
+				&TierConfig{
+					Class: ClassRam,
+					Scm: ScmConfig{
+						MountPoint:  "/mnt/daos/1",
+						RamdiskSize: 16,
+					},
+				},
+				NewTierConfig().
+					WithTier(1).
+					WithStorageClass("kdev").
+					WithBdevDeviceList("/dev/sdb", "/dev/sdc").
+					WithBdevBlockSize(512).
+					WithBdevBlockSizeOverride("/dev/sdc", 8192),
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			cfg := new(Config)
@@ -1292,6 +1666,154 @@ func TestStorage_Config_Validate(t *testing.T) {
 			expVosEnv:           "AIO",
 			expConfigOutputPath: "/daos_control/engine0/daos_nvme.conf",
 		},
+		"roles configured with control_metadata path and mixed bdev classes": {
+			cfg: Config{
+				ControlMetadata: ControlMetadata{
+					Path: "/",
+				},
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("file").
+						WithBdevDeviceList("/tmp/daos0.aio").
+						WithBdevFileSize(16).
+						WithBdevDeviceRoles(BdevRoleWAL),
+					NewTierConfig().
+						WithTier(2).
+						WithStorageClass("nvme").
+						WithBdevDeviceList("0000:80:00.0").
+						WithBdevDeviceRoles(BdevRoleMeta),
+					NewTierConfig().
+						WithTier(3).
+						WithStorageClass("file").
+						WithBdevDeviceList("/tmp/daos1.aio").
+						WithBdevFileSize(16).
+						WithBdevDeviceRoles(BdevRoleData),
+				},
+			},
+			expVosEnv:           "AIO,NVME",
+			expConfigOutputPath: "/daos_control/engine0/daos_nvme.conf",
+		},
+		"nvme-raid tier with nvme tier": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme").
+						WithBdevDeviceList("0000:80:00.0"),
+					NewTierConfig().
+						WithTier(2).
+						WithStorageClass("nvme-raid").
+						WithBdevDeviceList("0000:81:00.0", "0000:82:00.0").
+						WithBdevRaid(BdevRaidLevel1, 0),
+				},
+			},
+			expErr: FaultBdevFeatureNotInSpdk("bdev_raid_level raid1"),
+		},
+		"spdk rpc server; invalid socket mode": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme").
+						WithBdevDeviceList("0000:80:00.0"),
+				},
+				SpdkRpcSrvProps: SpdkRpcServer{
+					Enable:   true,
+					SockMode: 01777,
+				},
+			},
+			expErr: errors.New("sock_mode 01777 is not a valid"),
+		},
+		"bdev_exclude address not in bdev_list": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme").
+						WithBdevDeviceList("0000:80:00.0", "0000:81:00.0"),
+				},
+				BdevExclude: []string{"0000:82:00.0"},
+			},
+			expErr: errors.New("not found in any nvme bdev_list"),
+		},
+		"nvme-error tier; spdk rpc server disabled": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme-error").
+						WithBdevDeviceList("0000:80:00.0").
+						WithBdevErrorInject(BdevErrorInject{
+							IoType:    BdevErrorIoTypeWrite,
+							ErrorType: BdevErrorTypeFailure,
+							Count:     10,
+						}),
+				},
+			},
+			expErr: errors.New("requires spdk_rpc_server"),
+		},
+		"nvme-error tier; spdk rpc server enabled": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme-error").
+						WithBdevDeviceList("0000:80:00.0").
+						WithBdevErrorInject(BdevErrorInject{
+							IoType:    BdevErrorIoTypeWrite,
+							ErrorType: BdevErrorTypeFailure,
+							Count:     10,
+						}),
+				},
+				SpdkRpcSrvProps: SpdkRpcServer{
+					Enable: true,
+				},
+			},
+			expVosEnv:           "ERROR",
+			expConfigOutputPath: "/mnt/daos/daos_nvme.conf",
+		},
+		"bdev_exclude address in bdev_list": {
+			cfg: Config{
+				Tiers: TierConfigs{
+					NewTierConfig().
+						WithStorageClass("ram").
+						WithScmRamdiskSize(16).
+						WithScmMountPoint("/mnt/daos"),
+					NewTierConfig().
+						WithTier(1).
+						WithStorageClass("nvme").
+						WithBdevDeviceList("0000:80:00.0", "0000:81:00.0"),
+				},
+				BdevExclude: []string{"0000:81:00.0"},
+			},
+			expVosEnv:           "NVME",
+			expConfigOutputPath: "/mnt/daos/daos_nvme.conf",
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
@@ -1308,3 +1830,177 @@ func TestStorage_Config_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestStorage_BdevDeviceList_Namespace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		list     *BdevDeviceList
+		addr     string
+		expNsID  uint32
+		expHasNs bool
+	}{
+		"nil list": {
+			addr:    "0000:81:00.0",
+			expNsID: DefaultBdevNamespaceID,
+		},
+		"namespace not specified": {
+			list:    MustNewBdevDeviceList("0000:81:00.0"),
+			addr:    "0000:81:00.0",
+			expNsID: DefaultBdevNamespaceID,
+		},
+		"namespace specified": {
+			list:     MustNewBdevDeviceList("0000:81:00.0:ns=3"),
+			addr:     "0000:81:00.0",
+			expNsID:  3,
+			expHasNs: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expNsID, tc.list.Namespace(tc.addr), "bad namespace")
+			test.AssertEqual(t, tc.expHasNs, tc.list.HasNamespace(tc.addr), "bad has namespace")
+		})
+	}
+}
+
+func TestStorage_BdevDeviceList_Equals_Namespaces(t *testing.T) {
+	a := MustNewBdevDeviceList("0000:81:00.0:ns=2")
+
+	test.AssertTrue(t, a.Equals(MustNewBdevDeviceList("0000:81:00.0:ns=2")), "expected equal")
+	test.AssertFalse(t, a.Equals(MustNewBdevDeviceList("0000:81:00.0")), "expected not equal")
+	test.AssertFalse(t, a.Equals(MustNewBdevDeviceList("0000:81:00.0:ns=3")), "expected not equal")
+}
+
+func TestStorage_BdevDeviceList_Replace(t *testing.T) {
+	for name, tc := range map[string]struct {
+		list    *BdevDeviceList
+		oldAddr string
+		newAddr string
+		expList *BdevDeviceList
+		expErr  error
+	}{
+		"nil list": {
+			oldAddr: "0000:81:00.0",
+			newAddr: "0000:82:00.0",
+			expErr:  errors.New("nil"),
+		},
+		"old address not in list": {
+			list:    MustNewBdevDeviceList("0000:81:00.0"),
+			oldAddr: "0000:83:00.0",
+			newAddr: "0000:82:00.0",
+			expErr:  errors.New("not in bdev_list"),
+		},
+		"new address already in list": {
+			list:    MustNewBdevDeviceList("0000:81:00.0", "0000:82:00.0"),
+			oldAddr: "0000:81:00.0",
+			newAddr: "0000:82:00.0",
+			expErr:  errors.New("duplicate"),
+		},
+		"bad new address": {
+			list:    MustNewBdevDeviceList("0000:81:00.0"),
+			oldAddr: "0000:81:00.0",
+			newAddr: "foo",
+			expErr:  errors.New("unable to parse"),
+		},
+		"replaced": {
+			list:    MustNewBdevDeviceList("0000:80:00.0", "0000:81:00.0"),
+			oldAddr: "0000:81:00.0",
+			newAddr: "0000:82:00.0",
+			expList: MustNewBdevDeviceList("0000:80:00.0", "0000:82:00.0"),
+		},
+		"namespace kept": {
+			list:    MustNewBdevDeviceList("0000:81:00.0:ns=2"),
+			oldAddr: "0000:81:00.0",
+			newAddr: "0000:82:00.0",
+			expList: MustNewBdevDeviceList("0000:82:00.0:ns=2"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.list.Replace(tc.oldAddr, tc.newAddr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expList, tc.list, defConfigCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestStorage_BdevDeviceList_Remove(t *testing.T) {
+	for name, tc := range map[string]struct {
+		list    *BdevDeviceList
+		addr    string
+		expList *BdevDeviceList
+		expErr  error
+	}{
+		"nil list": {
+			addr:   "0000:81:00.0",
+			expErr: errors.New("nil"),
+		},
+		"address not in list": {
+			list:   MustNewBdevDeviceList("0000:81:00.0"),
+			addr:   "0000:83:00.0",
+			expErr: errors.New("not in list"),
+		},
+		"bad address": {
+			list:   MustNewBdevDeviceList("0000:81:00.0"),
+			addr:   "foo",
+			expErr: errors.New("unable to parse"),
+		},
+		"removed": {
+			list:    MustNewBdevDeviceList("0000:80:00.0", "0000:81:00.0:ns=2"),
+			addr:    "0000:80:00.0",
+			expList: MustNewBdevDeviceList("0000:81:00.0:ns=2"),
+		},
+		"last address removed": {
+			list:    MustNewBdevDeviceList("0000:81:00.0"),
+			addr:    "0000:81:00.0",
+			expList: MustNewBdevDeviceList(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.list.Remove(tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expList, tc.list, defConfigCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected list (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestStorage_SpdkRpcServer_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		srs     SpdkRpcServer
+		release bool
+		expErr  error
+	}{
+		"disabled": {},
+		"enabled": {
+			srs: SpdkRpcServer{Enable: true, SockMode: 0660},
+		},
+		"disabled in release build": {
+			release: true,
+		},
+		"enabled in release build": {
+			srs:     SpdkRpcServer{Enable: true},
+			release: true,
+			expErr:  errors.New("may not be enabled in release builds"),
+		},
+		"invalid socket mode": {
+			srs:    SpdkRpcServer{Enable: true, SockMode: 01777},
+			expErr: errors.New("not a valid permission mode"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func(release bool) { build.ReleaseBuild = release }(build.ReleaseBuild)
+			build.ReleaseBuild = tc.release
+
+			test.CmpErr(t, tc.expErr, tc.srs.Validate())
+		})
+	}
+}
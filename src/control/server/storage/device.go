@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,4 +12,10 @@ type (
 	DeviceParams struct {
 		Device string
 	}
+
+	// PoolStorageUsage is the capacity of a storage device consumed by a pool.
+	PoolStorageUsage struct {
+		UUID      string `json:"uuid"`
+		UsedBytes uint64 `json:"used_bytes"`
+	}
 )
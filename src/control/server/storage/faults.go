@@ -69,6 +69,17 @@ func FaultScmInvalidPMem(msg string) *fault.Fault {
 		recreateRegionsStr)
 }
 
+// FaultScmForeignOwner creates a fault for the case where formatted SCM carries an owner label
+// for a different system or engine.
+func FaultScmForeignOwner(mountPoint string, owner, expected *ScmOwnerLabel) *fault.Fault {
+	return storageFault(
+		code.ScmForeignOwner,
+		fmt.Sprintf("SCM mounted at %s is owned by %s but expected %s", mountPoint, owner,
+			expected),
+		"check the engine storage config is correct for this host, otherwise reformat "+
+			"to take over the storage with 'dmg storage format --force'")
+}
+
 // FaultRamdiskLowMem indicates that total RAM is insufficient to support given configuration.
 func FaultRamdiskLowMem(memType string, confRamdiskSize, memNeed, memHave uint64) *fault.Fault {
 	return storageFault(
@@ -80,6 +91,18 @@ func FaultRamdiskLowMem(memType string, confRamdiskSize, memNeed, memHave uint64
 			"file if reducing the requested amount of RAM is not possible")
 }
 
+// FaultRamdiskInsufficientMem indicates that total RAM is insufficient to automatically size
+// RAM-disks of the minimum size for all engines.
+func FaultRamdiskInsufficientMem(rmb *RamdiskMemBreakdown) *fault.Fault {
+	return storageFault(
+		code.ScmRamdiskLowMem,
+		fmt.Sprintf("Total memory (RAM) insufficient for minimum %s ram-disk size per "+
+			"engine, want %s RAM but only have %s (%s)", humanize.IBytes(MinRamdiskMem),
+			humanize.IBytes(rmb.MinMemTotal()), humanize.IBytes(rmb.MemTotal), rmb),
+		"Reduce engine targets, nr_hugepages, system_ram_reserved or ram_disk_mem_margin "+
+			"values in server config file if adding RAM is not possible")
+}
+
 // FaultRamdiskBadSize indicates that the already-mounted ramdisk is out
 // of spec with the calculated ramdisk size for the engine.
 func FaultRamdiskBadSize(existingSize, calcSize uint64) *fault.Fault {
@@ -135,12 +158,20 @@ var (
 		"add a scm tier in the first position of the engine storage tiers list in server config file and "+
 			"restart daos_server")
 
-	// FaultBdevConfigTierTypeMismatch represents an error where an incompatible mix of
-	// emulated and non-emulated NVMe devices are present in the storage config.
-	FaultBdevConfigTierTypeMismatch = storageFault(
-		code.BdevConfigTierTypeMismatch,
-		"bdev tiers found with both emulated and non-emulated NVMe types specified in config",
-		"change config tiers to specify either emulated or non-emulated NVMe devices, but not a mix of both")
+	// FaultBdevConfigTierTransportMismatch represents an error where remote NVMe-oF tiers are
+	// mixed with locally attached NVMe devices in the storage config.
+	FaultBdevConfigTierTransportMismatch = storageFault(
+		code.BdevConfigTierTransportMismatch,
+		"bdev tiers found with both NVMe-oF and locally attached devices specified in config",
+		"change config tiers to specify either remote NVMe-oF or local devices, but not a mix of both")
+
+	// FaultBdevConfigNoHugepagesWithRealNVMe represents an error where SPDK has been configured
+	// to run without hugepages on an engine with non-emulated NVMe devices.
+	FaultBdevConfigNoHugepagesWithRealNVMe = storageFault(
+		code.BdevConfigNoHugepagesWithRealNVMe,
+		"spdk_no_hugepages cannot be set on an engine with non-emulated NVMe bdev tiers",
+		"remove spdk_no_hugepages from the engine section of the server config file or use only "+
+			"emulated NVMe bdev tiers then restart daos_server")
 
 	// FaultBdevConfigRolesWithDCPM indicates a Fault when bdev roles are specified with DCPM
 	// SCM class.
@@ -251,6 +282,17 @@ func FaultBdevConfigOptFlagUnknown(input string, options ...string) *fault.Fault
 			options))
 }
 
+// FaultBdevFeatureNotInSpdk creates a Fault when a bdev tier requests a feature that the SPDK
+// release DAOS is built against does not provide.
+func FaultBdevFeatureNotInSpdk(feature string) *fault.Fault {
+	return storageFault(
+		code.BdevFeatureNotInSpdk,
+		fmt.Sprintf("%s not supported by the SPDK %s build used by DAOS", feature,
+			spdkBuildVersion),
+		fmt.Sprintf("remove %s from the server config file then restart daos_server",
+			feature))
+}
+
 var (
 	// FaultBdevNonRootVFIODisable indicates VFIO has been disabled but user is not privileged.
 	FaultBdevNonRootVFIODisable = storageFault(
@@ -284,6 +326,29 @@ func FaultInvalidSPDKConfig(err error) *fault.Fault {
 	)
 }
 
+// FaultBdevConfigModified creates a Fault for the case where the SPDK config file has been
+// changed since it was generated.
+func FaultBdevConfigModified(path string) *fault.Fault {
+	return storageFault(
+		code.BdevConfigModified,
+		fmt.Sprintf("bdev config file %q has been modified since it was generated", path),
+		"remove the manual changes to the bdev config file or restart daos_server with "+
+			"--force-regenerate to regenerate it from the server config file",
+	)
+}
+
+// FaultBdevConfigInputsChanged creates a Fault for the case where the server config file no
+// longer matches the inputs that the SPDK config file was generated from.
+func FaultBdevConfigInputsChanged(path string) *fault.Fault {
+	return storageFault(
+		code.BdevConfigInputsChanged,
+		fmt.Sprintf("bdev config file %q was generated from different engine storage "+
+			"settings than those in the server config file", path),
+		"revert the engine storage changes in the server config file or restart daos_server "+
+			"with --force-regenerate to regenerate the bdev config file",
+	)
+}
+
 func storageFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "storage",
@@ -0,0 +1,163 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"sync"
+	"time"
+)
+
+// NvmeHealthSample is the subset of a NVMe device's health statistics retained to track its
+// wear over time.
+type NvmeHealthSample struct {
+	Timestamp           uint64
+	WearLevelingCntNorm uint8
+	NandBytesWritten    uint64
+	HostBytesWritten    uint64
+	MediaErrors         uint64
+	IOErrors            uint64
+	ChecksumErrors      uint32
+	Temperature         uint32
+	Warnings            []string
+}
+
+// NvmeHealthHistory retains samples of the health statistics of NVMe devices, keyed by the PCI
+// address of the controller, for the configured retention period.
+type NvmeHealthHistory struct {
+	sync.RWMutex
+	retention time.Duration
+	samples   map[string][]NvmeHealthSample
+}
+
+// NewNvmeHealthHistory returns an empty history retaining samples for the given period.
+func NewNvmeHealthHistory(retention time.Duration) *NvmeHealthHistory {
+	return &NvmeHealthHistory{
+		retention: retention,
+		samples:   make(map[string][]NvmeHealthSample),
+	}
+}
+
+// Add records a sample of the supplied health statistics for the controller at the given PCI
+// address. Samples not newer than the most recent one are ignored and samples older than the
+// retention period are discarded. If the counters of the device have gone backwards it is
+// assumed that the device has been replaced and the existing history is discarded.
+func (h *NvmeHealthHistory) Add(pciAddr string, health *NvmeHealth) {
+	if h == nil || health == nil || health.Timestamp == 0 {
+		return
+	}
+
+	sample := NvmeHealthSample{
+		Timestamp:           health.Timestamp,
+		WearLevelingCntNorm: health.WearLevelingCntNorm,
+		NandBytesWritten:    health.NandBytesWritten,
+		HostBytesWritten:    health.HostBytesWritten,
+		MediaErrors:         health.MediaErrors,
+		IOErrors: uint64(health.ReadErrors) + uint64(health.WriteErrors) +
+			uint64(health.UnmapErrors),
+		ChecksumErrors: health.ChecksumErrors,
+		Temperature:    health.Temperature,
+		Warnings:       health.CriticalWarnings(),
+	}
+
+	h.Lock()
+	defer h.Unlock()
+
+	samples := h.samples[pciAddr]
+	if len(samples) > 0 {
+		last := samples[len(samples)-1]
+		switch {
+		case sample.Timestamp <= last.Timestamp:
+			return
+		case sample.NandBytesWritten < last.NandBytesWritten,
+			sample.HostBytesWritten < last.HostBytesWritten:
+			samples = nil
+		}
+	}
+	samples = append(samples, sample)
+
+	oldest := sample.Timestamp - uint64(h.retention/time.Second)
+	if oldest > sample.Timestamp {
+		oldest = 0
+	}
+	i := 0
+	for i < len(samples)-1 && samples[i].Timestamp < oldest {
+		i++
+	}
+	h.samples[pciAddr] = append(samples[:0:0], samples[i:]...)
+}
+
+// Samples returns a copy of the samples retained for the controller at the given PCI address,
+// oldest first.
+func (h *NvmeHealthHistory) Samples(pciAddr string) []NvmeHealthSample {
+	if h == nil {
+		return nil
+	}
+
+	h.RLock()
+	defer h.RUnlock()
+
+	return append([]NvmeHealthSample(nil), h.samples[pciAddr]...)
+}
+
+// Trend returns a summary of the wear of the controller at the given PCI address over the
+// retained history, or nil if no samples have been retained.
+func (h *NvmeHealthHistory) Trend(pciAddr string) *NvmeHealthTrend {
+	return NewNvmeHealthTrend(h.Samples(pciAddr))
+}
+
+// NewNvmeHealthTrend summarizes the supplied samples, which are expected to be ordered oldest
+// first.
+//
+// The write amplification factor is the ratio of NAND to host bytes written over the window
+// covered by the samples, falling back to the lifetime ratio if there were no writes in the
+// window. The rated endurance of the device is estimated by extrapolating the NAND bytes
+// written to date against the percentage of the endurance used, from which the time remaining
+// at the current host write rate and write amplification is predicted. The trend also includes
+// the failure score of the device, see ScoreNvmeHealth.
+func NewNvmeHealthTrend(samples []NvmeHealthSample) *NvmeHealthTrend {
+	if len(samples) == 0 {
+		return nil
+	}
+	first := samples[0]
+	last := samples[len(samples)-1]
+
+	trend := &NvmeHealthTrend{
+		NrSamples:      uint32(len(samples)),
+		FirstTimestamp: first.Timestamp,
+		LastTimestamp:  last.Timestamp,
+	}
+	if last.WearLevelingCntNorm <= 100 {
+		trend.WearUsed = 100 - uint32(last.WearLevelingCntNorm)
+	}
+
+	hostWritten := last.HostBytesWritten - first.HostBytesWritten
+	nandWritten := last.NandBytesWritten - first.NandBytesWritten
+	if elapsed := last.Timestamp - first.Timestamp; elapsed > 0 {
+		trend.HostWriteRate = float64(hostWritten) / float64(elapsed)
+	}
+	switch {
+	case hostWritten > 0 && nandWritten > 0:
+		trend.WriteAmp = float64(nandWritten) / float64(hostWritten)
+	case last.HostBytesWritten > 0:
+		trend.WriteAmp = float64(last.NandBytesWritten) / float64(last.HostBytesWritten)
+	}
+
+	// The vendor wear attributes are zero on devices that don't report them, in which case no
+	// prediction can be made.
+	if last.WearLevelingCntNorm == 0 && last.NandBytesWritten == 0 {
+		trend.WearUsed = 0
+	} else if trend.WearUsed > 0 && trend.WearUsed < 100 && trend.HostWriteRate > 0 &&
+		trend.WriteAmp > 0 {
+		rated := float64(last.NandBytesWritten) * 100 / float64(trend.WearUsed)
+		nandRate := trend.HostWriteRate * trend.WriteAmp
+		trend.RemainingSecs = uint64((rated - float64(last.NandBytesWritten)) / nandRate)
+	}
+
+	trend.FailureScore, trend.FailureReasons = ScoreNvmeHealth(samples, trend)
+
+	return trend
+}
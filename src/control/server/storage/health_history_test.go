@@ -0,0 +1,219 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStorage_NvmeHealthHistory_Add(t *testing.T) {
+	health := func(ts, nand, host uint64) *NvmeHealth {
+		return &NvmeHealth{
+			Timestamp:           ts,
+			WearLevelingCntNorm: 99,
+			NandBytesWritten:    nand,
+			HostBytesWritten:    host,
+		}
+	}
+	sample := func(ts, nand, host uint64) NvmeHealthSample {
+		return NvmeHealthSample{
+			Timestamp:           ts,
+			WearLevelingCntNorm: 99,
+			NandBytesWritten:    nand,
+			HostBytesWritten:    host,
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		retention  time.Duration
+		health     []*NvmeHealth
+		expSamples []NvmeHealthSample
+	}{
+		"no samples": {
+			retention: time.Hour,
+		},
+		"nil and unset timestamps ignored": {
+			retention: time.Hour,
+			health:    []*NvmeHealth{nil, health(0, 1, 1)},
+		},
+		"samples retained in order": {
+			retention: time.Hour,
+			health:    []*NvmeHealth{health(100, 1, 1), health(200, 2, 1)},
+			expSamples: []NvmeHealthSample{
+				sample(100, 1, 1), sample(200, 2, 1),
+			},
+		},
+		"stale samples ignored": {
+			retention: time.Hour,
+			health:    []*NvmeHealth{health(200, 2, 1), health(200, 3, 1), health(100, 4, 1)},
+			expSamples: []NvmeHealthSample{
+				sample(200, 2, 1),
+			},
+		},
+		"samples beyond retention discarded": {
+			retention: 100 * time.Second,
+			health: []*NvmeHealth{
+				health(100, 1, 1), health(150, 2, 2), health(250, 3, 3),
+			},
+			expSamples: []NvmeHealthSample{
+				sample(150, 2, 2), sample(250, 3, 3),
+			},
+		},
+		"device replaced": {
+			retention: time.Hour,
+			health: []*NvmeHealth{
+				health(100, 5, 5), health(200, 6, 6), health(300, 1, 1),
+			},
+			expSamples: []NvmeHealthSample{
+				sample(300, 1, 1),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			hist := NewNvmeHealthHistory(tc.retention)
+			for _, h := range tc.health {
+				hist.Add("0000:80:00.0", h)
+			}
+
+			if diff := cmp.Diff(tc.expSamples, hist.Samples("0000:80:00.0")); diff != "" {
+				t.Fatalf("unexpected samples (-want, +got):\n%s\n", diff)
+			}
+			if len(hist.Samples("0000:81:00.0")) != 0 {
+				t.Fatal("unexpected samples for other device")
+			}
+		})
+	}
+}
+
+func TestStorage_NewNvmeHealthTrend(t *testing.T) {
+	const day = 86400
+
+	for name, tc := range map[string]struct {
+		samples  []NvmeHealthSample
+		expTrend *NvmeHealthTrend
+	}{
+		"no samples": {},
+		"no vendor attributes": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100},
+				{Timestamp: 100 + day},
+			},
+			expTrend: &NvmeHealthTrend{
+				NrSamples:      2,
+				FirstTimestamp: 100,
+				LastTimestamp:  100 + day,
+			},
+		},
+		"single sample uses lifetime write amplification": {
+			samples: []NvmeHealthSample{
+				{
+					Timestamp:           100,
+					WearLevelingCntNorm: 90,
+					NandBytesWritten:    3000,
+					HostBytesWritten:    1000,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NrSamples:      1,
+				FirstTimestamp: 100,
+				LastTimestamp:  100,
+				WriteAmp:       3,
+				WearUsed:       10,
+			},
+		},
+		"no wear": {
+			samples: []NvmeHealthSample{
+				{
+					Timestamp:           100,
+					WearLevelingCntNorm: 100,
+					NandBytesWritten:    2e12,
+					HostBytesWritten:    1e12,
+				},
+				{
+					Timestamp:           100 + day,
+					WearLevelingCntNorm: 100,
+					NandBytesWritten:    2e12 + 2*day*1e6,
+					HostBytesWritten:    1e12 + day*1e6,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NrSamples:      2,
+				FirstTimestamp: 100,
+				LastTimestamp:  100 + day,
+				HostWriteRate:  1e6,
+				WriteAmp:       2,
+			},
+		},
+		"remaining endurance predicted": {
+			samples: []NvmeHealthSample{
+				{
+					Timestamp:           100,
+					WearLevelingCntNorm: 90,
+					NandBytesWritten:    2e12,
+					HostBytesWritten:    1e12,
+				},
+				{
+					Timestamp:           100 + day/2,
+					WearLevelingCntNorm: 90,
+					NandBytesWritten:    2e12 + day*1e6,
+					HostBytesWritten:    1e12 + day/2*1e6,
+				},
+				{
+					Timestamp:           100 + day,
+					WearLevelingCntNorm: 90,
+					NandBytesWritten:    2e12 + 2*day*1e6,
+					HostBytesWritten:    1e12 + day*1e6,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NrSamples:      3,
+				FirstTimestamp: 100,
+				LastTimestamp:  100 + day,
+				HostWriteRate:  1e6,
+				WriteAmp:       2,
+				WearUsed:       10,
+				// Rated endurance is 10x the NAND bytes written to date, consumed at 2MB/s.
+				RemainingSecs: (9 * (2e12 + 2*day*1e6)) / 2e6,
+			},
+		},
+		"endurance exhausted": {
+			samples: []NvmeHealthSample{
+				{
+					Timestamp:        100,
+					NandBytesWritten: 2e12,
+					HostBytesWritten: 1e12,
+				},
+				{
+					Timestamp:        100 + day,
+					NandBytesWritten: 2e12 + 2*day*1e6,
+					HostBytesWritten: 1e12 + day*1e6,
+				},
+			},
+			expTrend: &NvmeHealthTrend{
+				NrSamples:      2,
+				FirstTimestamp: 100,
+				LastTimestamp:  100 + day,
+				HostWriteRate:  1e6,
+				WriteAmp:       2,
+				WearUsed:       100,
+				FailureScore:   50,
+				FailureReasons: []string{"100% of endurance used"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTrend := NewNvmeHealthTrend(tc.samples)
+
+			if diff := cmp.Diff(tc.expTrend, gotTrend); diff != "" {
+				t.Fatalf("unexpected trend (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxNvmeFailureScore is the failure score of a NVMe device that is expected to fail imminently.
+const MaxNvmeFailureScore = 100
+
+// Critical warnings that indicate a device has failed or is about to, as opposed to those that
+// indicate a condition that may be transient.
+var fatalNvmeWarnings = map[string]bool{
+	"available spare":    true,
+	"device reliability": true,
+	"read only":          true,
+}
+
+func counterDelta(first, last uint64) uint64 {
+	// Error counters maintained by the engine are reset when it restarts.
+	if last < first {
+		return last
+	}
+	return last - first
+}
+
+func capScore(score, limit uint64) uint32 {
+	if score > limit {
+		return uint32(limit)
+	}
+	return uint32(score)
+}
+
+// ScoreNvmeHealth returns a score from 0 to MaxNvmeFailureScore indicating how likely a NVMe
+// device is to fail, based on the supplied health samples, ordered oldest first, and their
+// trend. The reasons contributing to the score are also returned.
+//
+// The score is the sum of the following, capped at MaxNvmeFailureScore:
+//   - a critical warning for available spare, device reliability or read only: 100
+//   - any other critical warning: 25 each
+//   - new media errors within the retained samples: 40, plus 5 per error up to 60
+//   - new I/O errors: 5 per error up to 40
+//   - new checksum errors: 2 per error up to 20
+//   - endurance used: 50 at 95% or more, 30 at 90% or more, 10 at 80% or more
+//   - endurance predicted to be exhausted: 50 within a week, 25 within 30 days
+func ScoreNvmeHealth(samples []NvmeHealthSample, trend *NvmeHealthTrend) (uint32, []string) {
+	if len(samples) == 0 {
+		return 0, nil
+	}
+	first := samples[0]
+	last := samples[len(samples)-1]
+
+	var score uint32
+	var reasons []string
+	add := func(points uint32, reason string, args ...interface{}) {
+		score += points
+		reasons = append(reasons, fmt.Sprintf(reason, args...))
+	}
+
+	for _, w := range last.Warnings {
+		if fatalNvmeWarnings[w] {
+			add(MaxNvmeFailureScore, "critical warning: %s", w)
+		} else {
+			add(25, "critical warning: %s", w)
+		}
+	}
+
+	if n := counterDelta(first.MediaErrors, last.MediaErrors); n > 0 {
+		add(capScore(40+5*n, 60), "%d new media errors", n)
+	}
+	if n := counterDelta(first.IOErrors, last.IOErrors); n > 0 {
+		add(capScore(5*n, 40), "%d new I/O errors", n)
+	}
+	if n := counterDelta(uint64(first.ChecksumErrors), uint64(last.ChecksumErrors)); n > 0 {
+		add(capScore(2*n, 20), "%d new checksum errors", n)
+	}
+
+	if trend != nil {
+		switch {
+		case trend.WearUsed >= 95:
+			add(50, "%d%% of endurance used", trend.WearUsed)
+		case trend.WearUsed >= 90:
+			add(30, "%d%% of endurance used", trend.WearUsed)
+		case trend.WearUsed >= 80:
+			add(10, "%d%% of endurance used", trend.WearUsed)
+		}
+
+		const day = uint64(24 * time.Hour / time.Second)
+		switch secs := trend.RemainingSecs; {
+		case secs == 0:
+		case secs < 7*day:
+			add(50, "endurance predicted to be exhausted in %s",
+				time.Duration(secs)*time.Second)
+		case secs < 30*day:
+			add(25, "endurance predicted to be exhausted in %d days", secs/day)
+		}
+	}
+
+	if score > MaxNvmeFailureScore {
+		score = MaxNvmeFailureScore
+	}
+
+	return score, reasons
+}
@@ -0,0 +1,102 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStorage_ScoreNvmeHealth(t *testing.T) {
+	const day = 86400
+
+	for name, tc := range map[string]struct {
+		samples    []NvmeHealthSample
+		trend      *NvmeHealthTrend
+		expScore   uint32
+		expReasons []string
+	}{
+		"no samples": {},
+		"healthy": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100, MediaErrors: 2, IOErrors: 1},
+				{Timestamp: 200, MediaErrors: 2, IOErrors: 1},
+			},
+			trend: &NvmeHealthTrend{WearUsed: 10, RemainingSecs: 1000 * day},
+		},
+		"fatal critical warning": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100, Warnings: []string{"temperature", "read only"}},
+			},
+			expScore:   100,
+			expReasons: []string{"critical warning: temperature", "critical warning: read only"},
+		},
+		"transient critical warning": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100, Warnings: []string{"temperature"}},
+			},
+			expScore:   25,
+			expReasons: []string{"critical warning: temperature"},
+		},
+		"new errors": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100, MediaErrors: 1, IOErrors: 10, ChecksumErrors: 1},
+				{Timestamp: 200, MediaErrors: 3, IOErrors: 12, ChecksumErrors: 4},
+			},
+			expScore: 66,
+			expReasons: []string{
+				"2 new media errors", "2 new I/O errors", "3 new checksum errors",
+			},
+		},
+		"error scores capped": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100},
+				{Timestamp: 200, IOErrors: 100, ChecksumErrors: 100},
+			},
+			expScore:   60,
+			expReasons: []string{"100 new I/O errors", "100 new checksum errors"},
+		},
+		"engine restarted": {
+			samples: []NvmeHealthSample{
+				{Timestamp: 100, IOErrors: 10},
+				{Timestamp: 200, IOErrors: 1},
+			},
+			expScore:   5,
+			expReasons: []string{"1 new I/O errors"},
+		},
+		"worn with endurance remaining": {
+			samples:  []NvmeHealthSample{{Timestamp: 100}},
+			trend:    &NvmeHealthTrend{WearUsed: 91, RemainingSecs: 20 * day},
+			expScore: 55,
+			expReasons: []string{
+				"91% of endurance used",
+				"endurance predicted to be exhausted in 20 days",
+			},
+		},
+		"endurance imminently exhausted": {
+			samples:  []NvmeHealthSample{{Timestamp: 100}},
+			trend:    &NvmeHealthTrend{WearUsed: 99, RemainingSecs: 3600},
+			expScore: 100,
+			expReasons: []string{
+				"99% of endurance used",
+				"endurance predicted to be exhausted in 1h0m0s",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotScore, gotReasons := ScoreNvmeHealth(tc.samples, tc.trend)
+
+			if gotScore != tc.expScore {
+				t.Fatalf("want score %d, got %d", tc.expScore, gotScore)
+			}
+			if diff := cmp.Diff(tc.expReasons, gotReasons); diff != "" {
+				t.Fatalf("unexpected reasons (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -437,6 +437,8 @@ type MockScmProvider struct {
 	FirmwareQueryErr  error
 	FirmwareUpdateRes *ScmFirmwareUpdateResponse
 	FirmwareUpdateErr error
+	UnlockRes         *ScmUnlockResponse
+	UnlockErr         error
 }
 
 func (m *MockScmProvider) Mount(ScmMountRequest) (*MountResponse, error) {
@@ -471,6 +473,10 @@ func (m *MockScmProvider) UpdateFirmware(ScmFirmwareUpdateRequest) (*ScmFirmware
 	return m.FirmwareUpdateRes, m.FirmwareUpdateErr
 }
 
+func (m *MockScmProvider) Unlock(ScmUnlockRequest) (*ScmUnlockResponse, error) {
+	return m.UnlockRes, m.UnlockErr
+}
+
 type mockBdevProvider struct {
 	callCounts         map[string]int
 	PrepareErr         error
@@ -487,6 +493,21 @@ type mockBdevProvider struct {
 	QueryFirmwareResp  *NVMeFirmwareQueryResponse
 	UpdateFirmwareErr  error
 	UpdateFirmwareResp *NVMeFirmwareUpdateResponse
+	AttachErr          error
+	AttachResp         *BdevAttachResponse
+	AttachReqs         []BdevAttachRequest
+	DetachErr          error
+	DetachResp         *BdevDetachResponse
+	DetachReqs         []BdevDetachRequest
+	InjectErrorsErr    error
+	InjectErrorsResp   *BdevInjectErrorsResponse
+	InjectErrorsReqs   []BdevInjectErrorsRequest
+	CreateNsErr        error
+	CreateNsResp       *BdevNamespaceCreateResponse
+	CreateNsReqs       []BdevNamespaceCreateRequest
+	DeleteNsErr        error
+	DeleteNsResp       *BdevNamespaceDeleteResponse
+	DeleteNsReqs       []BdevNamespaceDeleteRequest
 }
 
 func (m *mockBdevProvider) addCall(name string) {
@@ -530,3 +551,33 @@ func (m *mockBdevProvider) UpdateFirmware(NVMeFirmwareUpdateRequest) (*NVMeFirmw
 	m.addCall("UpdateFirmware")
 	return m.UpdateFirmwareResp, m.UpdateFirmwareErr
 }
+
+func (m *mockBdevProvider) AttachController(req BdevAttachRequest) (*BdevAttachResponse, error) {
+	m.addCall("AttachController")
+	m.AttachReqs = append(m.AttachReqs, req)
+	return m.AttachResp, m.AttachErr
+}
+
+func (m *mockBdevProvider) DetachController(req BdevDetachRequest) (*BdevDetachResponse, error) {
+	m.addCall("DetachController")
+	m.DetachReqs = append(m.DetachReqs, req)
+	return m.DetachResp, m.DetachErr
+}
+
+func (m *mockBdevProvider) InjectErrors(req BdevInjectErrorsRequest) (*BdevInjectErrorsResponse, error) {
+	m.addCall("InjectErrors")
+	m.InjectErrorsReqs = append(m.InjectErrorsReqs, req)
+	return m.InjectErrorsResp, m.InjectErrorsErr
+}
+
+func (m *mockBdevProvider) CreateNamespaces(req BdevNamespaceCreateRequest) (*BdevNamespaceCreateResponse, error) {
+	m.addCall("CreateNamespaces")
+	m.CreateNsReqs = append(m.CreateNsReqs, req)
+	return m.CreateNsResp, m.CreateNsErr
+}
+
+func (m *mockBdevProvider) DeleteNamespace(req BdevNamespaceDeleteRequest) (*BdevNamespaceDeleteResponse, error) {
+	m.addCall("DeleteNamespace")
+	m.DeleteNsReqs = append(m.DeleteNsReqs, req)
+	return m.DeleteNsResp, m.DeleteNsErr
+}
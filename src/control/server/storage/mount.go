@@ -29,5 +29,6 @@ type (
 	MountResponse struct {
 		Target  string
 		Mounted bool
+		Repairs []string // actions taken to make the device mountable
 	}
 )
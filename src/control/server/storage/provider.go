@@ -12,11 +12,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
@@ -45,6 +49,7 @@ type Provider struct {
 	scm           ScmProvider
 	bdev          BdevProvider
 	vmdEnabled    bool
+	forceRegen    bool
 }
 
 // DefaultProvider returns a provider populated with default parameters.
@@ -215,6 +220,12 @@ func (p *Provider) ScanScm(req ScmScanRequest) (*ScmScanResponse, error) {
 	return p.scm.Scan(req)
 }
 
+// UnlockScm calls into storage SCM provider to unlock passphrase-protected PMem modules.
+func (p *Provider) UnlockScm(req ScmUnlockRequest) (*ScmUnlockResponse, error) {
+	p.log.Debugf("calling scm storage provider unlock: %+v", req)
+	return p.scm.Unlock(req)
+}
+
 // GetScmConfig returns the only SCM tier config.
 func (p *Provider) GetScmConfig() (*TierConfig, error) {
 	// NB: A bit wary of building in assumptions again about the number of
@@ -258,6 +269,129 @@ func (p *Provider) ScmIsMounted() (bool, error) {
 	return p.Sys.IsMounted(cfg.Scm.MountPoint)
 }
 
+func (p *Provider) scmOwnerLabelPath() (string, error) {
+	cfg, err := p.GetScmConfig()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg.Scm.MountPoint, ScmOwnerLabelFile), nil
+}
+
+// scmOwnerLabel returns the label expected on SCM formatted by this provider's engine.
+func (p *Provider) scmOwnerLabel(system string) (*ScmOwnerLabel, error) {
+	cfg, err := p.GetScmConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &ScmOwnerLabel{
+		System:    system,
+		EngineIdx: uint32(p.engineIndex),
+		Tier:      uint32(cfg.Tier),
+	}, nil
+}
+
+// WriteScmOwnerLabel records the given system and this provider's engine as the owner of the
+// mounted SCM tier.
+func (p *Provider) WriteScmOwnerLabel(system string) error {
+	label, err := p.scmOwnerLabel(system)
+	if err != nil {
+		return err
+	}
+	labelPath, err := p.scmOwnerLabelPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(label)
+	if err != nil {
+		return errors.Wrapf(err, "marshal %+v", label)
+	}
+
+	p.log.Debugf("writing scm owner label (%s) to %s", label, labelPath)
+	return errors.Wrapf(common.WriteFileAtomic(labelPath, data, 0600),
+		"write scm owner label to %s", labelPath)
+}
+
+// ReadScmOwnerLabel returns the owner label of the mounted SCM tier. A nil label is returned if
+// none exists.
+func (p *Provider) ReadScmOwnerLabel() (*ScmOwnerLabel, error) {
+	labelPath, err := p.scmOwnerLabelPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := p.Sys.ReadFile(labelPath)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read scm owner label from %s", labelPath)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	label := new(ScmOwnerLabel)
+	if err := yaml.Unmarshal(data, label); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal scm owner label from %s", labelPath)
+	}
+
+	return label, nil
+}
+
+// ScmOwnership returns the owner label of the SCM tier and whether it belongs to the given system
+// and this provider's engine. Ownership is unknown if SCM is not mounted.
+func (p *Provider) ScmOwnership(system string) (*ScmOwnerLabel, ScmOwnership, error) {
+	mounted, err := p.ScmIsMounted()
+	if err != nil {
+		return nil, ScmOwnershipUnknown, err
+	}
+	if !mounted {
+		return nil, ScmOwnershipUnknown, nil
+	}
+
+	label, err := p.ReadScmOwnerLabel()
+	if err != nil {
+		return nil, ScmOwnershipUnknown, err
+	}
+	expected, err := p.scmOwnerLabel(system)
+	if err != nil {
+		return nil, ScmOwnershipUnknown, err
+	}
+
+	return label, label.CheckOwnership(expected), nil
+}
+
+// CheckScmOwnership returns a fault if mounted SCM is owned by a different system or engine. SCM
+// without an owner label, e.g. formatted by an older release, is adopted by writing a label for
+// this provider's engine.
+func (p *Provider) CheckScmOwnership(system string) error {
+	label, ownership, err := p.ScmOwnership(system)
+	if err != nil {
+		return err
+	}
+
+	switch ownership {
+	case ScmOwnershipForeign:
+		cfg, err := p.GetScmConfig()
+		if err != nil {
+			return err
+		}
+		expected, err := p.scmOwnerLabel(system)
+		if err != nil {
+			return err
+		}
+		return FaultScmForeignOwner(cfg.Scm.MountPoint, label, expected)
+	case ScmOwnershipUnowned:
+		p.log.Noticef("instance %d: adopting unlabeled scm", p.engineIndex)
+		if err := p.WriteScmOwnerLabel(system); err != nil {
+			p.log.Errorf("instance %d: %s", p.engineIndex, err)
+		}
+	}
+
+	return nil
+}
+
 // MountScm mounts SCM based on provider config.
 func (p *Provider) MountScm() error {
 	cfg, err := p.GetScmConfig()
@@ -353,6 +487,51 @@ func createScmFormatRequest(class Class, scmCfg ScmConfig, force bool) (*ScmForm
 	return &req, nil
 }
 
+// RestoreScm mounts formatted PMem that was found unmounted, recreating a missing mount point and
+// checking the filesystem if configured. Actions taken are returned so they can be reported.
+// No action is taken for RAM-disks or PMem that is already mounted or requires format.
+func (p *Provider) RestoreScm() ([]string, error) {
+	cfg, err := p.GetScmConfig()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Class != ClassDcpm {
+		return nil, nil
+	}
+
+	fmtReq, err := createScmFormatRequest(cfg.Class, cfg.Scm, false)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.scm.CheckFormat(*fmtReq)
+	switch {
+	case fault.IsFaultCode(err, code.StorageDeviceWithFsNoMountpoint):
+	case err != nil:
+		return nil, err
+	case res.Mounted || !res.Mountable:
+		return nil, nil
+	}
+
+	p.log.Noticef("%s: formatted SCM found unmounted, remounting", cfg.Scm.MountPoint)
+
+	mntRes, err := p.scm.Mount(ScmMountRequest{
+		Class:    cfg.Class,
+		Device:   fmtReq.Dcpm.Device,
+		Target:   cfg.Scm.MountPoint,
+		Restore:  true,
+		Fsck:     cfg.Scm.Fsck,
+		OwnerUID: fmtReq.OwnerUID,
+		OwnerGID: fmtReq.OwnerGID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "remount %s", cfg.Scm.MountPoint)
+	}
+
+	return append(mntRes.Repairs, fmt.Sprintf("mounted %s on %s", fmtReq.Dcpm.Device,
+		cfg.Scm.MountPoint)), nil
+}
+
 // ScmNeedsFormat returns true if SCM is found to require formatting.
 func (p *Provider) ScmNeedsFormat() (bool, error) {
 	cfg, err := p.GetScmConfig()
@@ -446,11 +625,20 @@ func (p *Provider) HasBlockDevices() bool {
 // BdevTierPropertiesFromConfig returns BdevTierProperties struct from given TierConfig.
 func BdevTierPropertiesFromConfig(cfg *TierConfig) BdevTierProperties {
 	return BdevTierProperties{
-		Class:          cfg.Class,
-		DeviceList:     cfg.Bdev.DeviceList,
-		DeviceFileSize: uint64(humanize.GiByte * cfg.Bdev.FileSize),
-		Tier:           cfg.Tier,
-		DeviceRoles:    cfg.Bdev.DeviceRoles,
+		Class:            cfg.Class,
+		DeviceList:       cfg.Bdev.DeviceList,
+		DeviceFileSize:   uint64(humanize.GiByte * cfg.Bdev.FileSize),
+		DeviceFileSparse: cfg.Bdev.FileSparse,
+		DeviceZoned:      cfg.Bdev.Zoned,
+		Tier:             cfg.Tier,
+		DeviceRoles:      cfg.Bdev.DeviceRoles,
+		Transport:        cfg.Bdev.Transport,
+		Raid:             cfg.Bdev.Raid,
+		Delay:            cfg.Bdev.Delay,
+		ErrorInject:      cfg.Bdev.ErrorInject,
+		BlockSize:        cfg.Bdev.BlockSize,
+		Crypto:           cfg.Bdev.Crypto,
+		Cache:            cfg.Bdev.Cache,
 	}
 }
 
@@ -479,6 +667,7 @@ type BdevTierFormatResult struct {
 	DeviceRoles BdevRoles
 	Error       error
 	Result      *BdevFormatResponse
+	Excluded    []BdevExclusion // devices skipped during format
 }
 
 // FormatBdevTiers formats all the Bdev tiers in the engine storage
@@ -492,6 +681,8 @@ func (p *Provider) FormatBdevTiers(ctrlrs NvmeControllers) (results []BdevTierFo
 		return
 	}
 
+	excluded := excludedBdevs(p.engineStorage, ctrlrs)
+
 	for i, cfg := range bdevCfgs {
 		p.log.Infof("Instance %d: starting format of %s block devices %v",
 			p.engineIndex, cfg.Class, cfg.Bdev.DeviceList)
@@ -504,6 +695,17 @@ func (p *Provider) FormatBdevTiers(ctrlrs NvmeControllers) (results []BdevTierFo
 		}
 		req.ScannedBdevs = ctrlrs
 
+		req.Properties, results[i].Excluded, err = excludeTierBdevs(req.Properties, excluded)
+		if err != nil {
+			results[i].Error = err
+			p.log.Errorf("Instance %d: format failed (%s)", p.engineIndex, err)
+			continue
+		}
+		for _, ex := range results[i].Excluded {
+			p.log.Noticef("Instance %d: skipping format of NVMe device %s: %s",
+				p.engineIndex, ex.PciAddr, ex.Reason)
+		}
+
 		p.RLock()
 		req.VMDEnabled = p.vmdEnabled
 		results[i].Result, results[i].Error = p.bdev.Format(req)
@@ -524,9 +726,13 @@ func (p *Provider) FormatBdevTiers(ctrlrs NvmeControllers) (results []BdevTierFo
 }
 
 // setHotplugRange sets request parameters related to bus-id range limits to restrict hotplug
-// actions of engine to a set of ssd devices.
-func setHotplugRange(ctx context.Context, log logging.Logger, getTopo topologyGetter, numaNode uint, tier *TierConfig, req *BdevWriteConfigRequest) error {
+// actions of engine to a set of ssd devices. Unless specified by the user, the range is derived
+// from the PCI addresses of the engine's NVMe SSDs and spares, falling back to the range of buses attached
+// to the engine's NUMA node if no PCI addresses are configured. When VMD is enabled, backing
+// devices are enumerated on bus-IDs local to each VMD domain so the range cannot be restricted.
+func setHotplugRange(ctx context.Context, log logging.Logger, getTopo topologyGetter, numaNode uint, tier *TierConfig, devs *BdevDeviceList, req *BdevWriteConfigRequest) error {
 	var begin, end uint8
+	devBegin, devEnd, haveDevs := getDeviceListBusidRange(devs)
 
 	switch {
 	case req.VMDEnabled:
@@ -537,6 +743,11 @@ func setHotplugRange(ctx context.Context, log logging.Logger, getTopo topologyGe
 		log.Debugf("received user-specified hotplug bus-id range %q", tier.Bdev.BusidRange)
 		begin = tier.Bdev.BusidRange.LowAddress.Bus
 		end = tier.Bdev.BusidRange.HighAddress.Bus
+	case haveDevs:
+		log.Debugf("generating hotplug bus-id range based on bdev_list and bdev_spares "+
+			"entries %q", devs)
+		begin = devBegin
+		end = devEnd
 	default:
 		var err error
 		log.Debug("generating hotplug bus-id range based on hardware topology")
@@ -555,8 +766,75 @@ func setHotplugRange(ctx context.Context, log logging.Logger, getTopo topologyGe
 
 type topologyGetter func(ctx context.Context) (*hardware.Topology, error)
 
+// excludedBdevs returns the reasons for excluding NVMe devices from the engine's bdev tiers, keyed
+// on PCI address. Devices are excluded if listed in bdev_exclude or, if bdev_exclude_unhealthy is
+// set, if scanned health stats show critical warnings.
+func excludedBdevs(cfg *Config, ctrlrs NvmeControllers) map[string]string {
+	excluded := make(map[string]string)
+
+	if cfg.ExcludeUnhealthy {
+		for _, c := range ctrlrs {
+			if warnings := c.HealthStats.CriticalWarnings(); len(warnings) > 0 {
+				excluded[c.PciAddr] = fmt.Sprintf("critical health warnings: %s",
+					strings.Join(warnings, ", "))
+			}
+		}
+	}
+
+	for _, entry := range cfg.BdevExclude {
+		addr, err := hardware.NewPCIAddress(entry)
+		if err != nil {
+			continue // already validated
+		}
+		excluded[addr.String()] = "listed in bdev_exclude"
+	}
+
+	return excluded
+}
+
+// excludeTierBdevs returns tier properties with excluded devices removed from the device list
+// together with details of the devices removed.
+func excludeTierBdevs(props BdevTierProperties, excluded map[string]string) (BdevTierProperties, []BdevExclusion, error) {
+	if len(excluded) == 0 || !props.Class.IsLocalNVMe() || props.DeviceList.Len() == 0 {
+		return props, nil, nil
+	}
+
+	var kept []string
+	var exclusions []BdevExclusion
+	entries := props.DeviceList.entries()
+	for i, addr := range props.DeviceList.Devices() {
+		reason, exists := excluded[addr]
+		if !exists {
+			kept = append(kept, entries[i])
+			continue
+		}
+		exclusions = append(exclusions, BdevExclusion{
+			Tier:    props.Tier,
+			PciAddr: addr,
+			Reason:  reason,
+		})
+	}
+
+	if len(exclusions) == 0 {
+		return props, nil, nil
+	}
+	if len(kept) == 0 {
+		return props, nil, errors.Errorf("all devices in bdev tier %d have been excluded",
+			props.Tier)
+	}
+
+	dl, err := NewBdevDeviceList(kept...)
+	if err != nil {
+		return props, nil, err
+	}
+	props.DeviceList = dl
+
+	return props, exclusions, nil
+}
+
 // BdevWriteConfigRequestFromConfig returns a config write request derived from a storage config.
-func BdevWriteConfigRequestFromConfig(ctx context.Context, log logging.Logger, cfg *Config, vmdEnabled bool, getTopo topologyGetter) (*BdevWriteConfigRequest, error) {
+// Devices excluded from bdev tiers based on config or scanned controller health are omitted.
+func BdevWriteConfigRequestFromConfig(ctx context.Context, log logging.Logger, cfg *Config, vmdEnabled bool, getTopo topologyGetter, ctrlrs NvmeControllers) (*BdevWriteConfigRequest, error) {
 	if cfg == nil {
 		return nil, errors.New("received nil config")
 	}
@@ -569,28 +847,54 @@ func BdevWriteConfigRequestFromConfig(ctx context.Context, log logging.Logger, c
 		return nil, errors.Wrap(err, "get hostname")
 	}
 
+	rpcSrvProps := cfg.SpdkRpcSrvProps
+	if rpcSrvProps.Enable {
+		if err := rpcSrvProps.resolveSockOwnership(); err != nil {
+			return nil, err
+		}
+	}
+
 	req := &BdevWriteConfigRequest{
 		OwnerUID:         os.Geteuid(),
 		OwnerGID:         os.Getegid(),
 		Hostname:         hn,
 		ConfigOutputPath: cfg.ConfigOutputPath,
 		HotplugEnabled:   cfg.EnableHotplug,
+		HotplugPollUsec:  cfg.HotplugPollUsec,
 		VMDEnabled:       vmdEnabled,
 		TierProps:        []BdevTierProperties{},
+		ScannedBdevs:     ctrlrs,
 		AccelProps:       cfg.AccelProps,
-		SpdkRpcSrvProps:  cfg.SpdkRpcSrvProps,
+		SpdkRpcSrvProps:  rpcSrvProps,
 		AutoFaultyProps:  cfg.AutoFaultyProps,
+		SpdkLogProps:     cfg.SpdkLogProps,
+		NvmeOptions:      cfg.Tiers.BdevNvmeOptions(),
+		BdevOptions:      cfg.BdevOptions,
+		SpdkEnvOpts:      cfg.SpdkEnvOpts,
 	}
 
+	excluded := excludedBdevs(cfg, ctrlrs)
+
 	for idx, tier := range cfg.Tiers.BdevConfigs() {
-		req.TierProps = append(req.TierProps, BdevTierPropertiesFromConfig(tier))
+		props, exclusions, err := excludeTierBdevs(BdevTierPropertiesFromConfig(tier),
+			excluded)
+		if err != nil {
+			return nil, err
+		}
+		for _, ex := range exclusions {
+			log.Noticef("excluding NVMe device %s from bdev tier %d: %s", ex.PciAddr,
+				ex.Tier, ex.Reason)
+		}
+		req.TierProps = append(req.TierProps, props)
+		req.ExcludedBdevs = append(req.ExcludedBdevs, exclusions...)
 
 		if !req.HotplugEnabled || idx != 0 {
 			continue
 		}
 
 		// Populate hotplug bus-ID range limits when processing the first bdev tier.
-		if err := setHotplugRange(ctx, log, getTopo, cfg.NumaNodeIndex, tier, req); err != nil {
+		if err := setHotplugRange(ctx, log, getTopo, cfg.NumaNodeIndex, tier,
+			cfg.Tiers.NVMeBdevsWithSpares(), req); err != nil {
 			return nil, errors.Wrapf(err, "set busid range limits")
 		}
 	}
@@ -599,21 +903,34 @@ func BdevWriteConfigRequestFromConfig(ctx context.Context, log logging.Logger, c
 	return req, nil
 }
 
+// NvmeConfigRequest returns the request that WriteNvmeConfig would issue to create the NVMe
+// config file of the DAOS engine, e.g. so that the config can be rendered without being written.
+func (p *Provider) NvmeConfigRequest(ctx context.Context, log logging.Logger, ctrlrs NvmeControllers) (*BdevWriteConfigRequest, error) {
+	p.RLock()
+	vmdEnabled := p.vmdEnabled
+	engineStorage := p.engineStorage
+	p.RUnlock()
+
+	req, err := BdevWriteConfigRequestFromConfig(ctx, log, engineStorage,
+		vmdEnabled, hwloc.NewProvider(log).GetTopology, ctrlrs)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating write config request")
+	}
+
+	return req, nil
+}
+
 // WriteNvmeConfig creates an NVMe config file which describes what devices
 // should be used by a DAOS engine process.
 func (p *Provider) WriteNvmeConfig(ctx context.Context, log logging.Logger, ctrlrs NvmeControllers) error {
 	p.RLock()
-	vmdEnabled := p.vmdEnabled
 	engineIndex := p.engineIndex
-	engineStorage := p.engineStorage
 	p.RUnlock()
 
-	req, err := BdevWriteConfigRequestFromConfig(ctx, log, engineStorage,
-		vmdEnabled, hwloc.NewProvider(log).GetTopology)
+	req, err := p.NvmeConfigRequest(ctx, log, ctrlrs)
 	if err != nil {
-		return errors.Wrap(err, "creating write config request")
+		return err
 	}
-	req.ScannedBdevs = ctrlrs
 
 	log.Infof("Writing NVMe config file for engine instance %d to %q", engineIndex,
 		req.ConfigOutputPath)
@@ -632,6 +949,120 @@ func (p *Provider) ReadNvmeConfig(ctx context.Context) (*BdevReadConfigResponse,
 	return p.bdev.ReadConfig(req)
 }
 
+// checkNvmeConfig reads the NVMe config file and compares it against the config that would be
+// written based on the engine storage config. If the expected config cannot be generated, the
+// file is read without comparison.
+func (p *Provider) checkNvmeConfig(ctx context.Context, ctrlrs NvmeControllers) (*BdevReadConfigResponse, error) {
+	p.RLock()
+	vmdEnabled := p.vmdEnabled
+	p.RUnlock()
+
+	req := BdevReadConfigRequest{
+		ConfigPath: p.engineStorage.ConfigOutputPath,
+	}
+
+	expReq, err := BdevWriteConfigRequestFromConfig(ctx, p.log, p.engineStorage, vmdEnabled,
+		hwloc.NewProvider(p.log).GetTopology, ctrlrs)
+	if err != nil {
+		p.log.Debugf("skip bdev config file comparison: %s", err)
+	} else {
+		req.ExpectedConfig = expReq
+	}
+
+	return p.bdev.ReadConfig(req)
+}
+
+// SpdkRpcSrvEnabled returns true if the engine runs an SPDK JSON-RPC server.
+func (p *Provider) SpdkRpcSrvEnabled() bool {
+	return p.engineStorage.SpdkRpcSrvProps.Enable
+}
+
+// spdkRpcSockAddr returns the socket of the engine's SPDK JSON-RPC server if enabled.
+func (p *Provider) spdkRpcSockAddr() (string, error) {
+	props := p.engineStorage.SpdkRpcSrvProps
+	if !props.Enable {
+		return "", errors.Errorf("spdk json-rpc server not enabled for engine %d",
+			p.engineIndex)
+	}
+	if props.SockAddr == "" {
+		return DefaultSpdkRpcSockAddr, nil
+	}
+
+	return props.SockAddr, nil
+}
+
+// AttachBdevController calls into the bdev storage provider to attach an NVMe controller to the
+// running engine so that it can be used without an engine restart.
+func (p *Provider) AttachBdevController(devName, pciAddr string) (*BdevAttachResponse, error) {
+	sockAddr, err := p.spdkRpcSockAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.bdev.AttachController(BdevAttachRequest{
+		SockAddr:   sockAddr,
+		DeviceName: devName,
+		PciAddr:    pciAddr,
+	})
+}
+
+// DetachBdevController calls into the bdev storage provider to detach an NVMe controller from the
+// running engine.
+func (p *Provider) DetachBdevController(devName string) error {
+	sockAddr, err := p.spdkRpcSockAddr()
+	if err != nil {
+		return err
+	}
+
+	_, err = p.bdev.DetachController(BdevDetachRequest{
+		SockAddr:   sockAddr,
+		DeviceName: devName,
+	})
+
+	return err
+}
+
+// InjectBdevErrors calls into the bdev storage provider to inject the I/O errors configured for
+// the error bdevs of the running engine.
+func (p *Provider) InjectBdevErrors() (*BdevInjectErrorsResponse, error) {
+	sockAddr, err := p.spdkRpcSockAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.bdev.InjectErrors(BdevInjectErrorsRequest{
+		SockAddr:   sockAddr,
+		ConfigPath: p.engineStorage.ConfigOutputPath,
+	})
+}
+
+// CreateBdevNamespaces calls into the bdev storage provider to create namespaces on an NVMe
+// controller. If size is zero, the unallocated capacity of the controller is split between the
+// namespaces.
+func (p *Provider) CreateBdevNamespaces(pciAddr string, size uint64, count uint32) ([]*NvmeNamespace, error) {
+	resp, err := p.bdev.CreateNamespaces(BdevNamespaceCreateRequest{
+		PciAddr: pciAddr,
+		Size:    size,
+		Count:   count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Namespaces, nil
+}
+
+// DeleteBdevNamespace calls into the bdev storage provider to delete a namespace on an NVMe
+// controller.
+func (p *Provider) DeleteBdevNamespace(pciAddr string, nsID uint32) error {
+	_, err := p.bdev.DeleteNamespace(BdevNamespaceDeleteRequest{
+		PciAddr: pciAddr,
+		NsID:    nsID,
+	})
+
+	return err
+}
+
 // BdevTierScanResult contains details of a scan operation result.
 type BdevTierScanResult struct {
 	Tier   int
@@ -654,6 +1085,13 @@ func (p *Provider) WithVMDEnabled(b bool) *Provider {
 	return p
 }
 
+// WithForceBdevConfigRegen sets whether an existing SPDK bdev config file that fails verification
+// should be regenerated rather than preventing the engine from starting.
+func (p *Provider) WithForceBdevConfigRegen(b bool) *Provider {
+	p.forceRegen = b
+	return p
+}
+
 // IsVMDEnabled queries whether VMD is enabled on storage provider.
 func (p *Provider) IsVMDEnabled() bool {
 	return p.vmdEnabled
@@ -680,16 +1118,48 @@ func (p *Provider) UpdateBdevFirmware(req NVMeFirmwareUpdateRequest) (*NVMeFirmw
 	return p.bdev.UpdateFirmware(req)
 }
 
+// verifyNvmeConfig returns an error if the SPDK bdev config file has been modified or if it was
+// generated from storage settings that differ from the current engine config.
+func (p *Provider) verifyNvmeConfig(resp *BdevReadConfigResponse) error {
+	cfgPath := p.engineStorage.ConfigOutputPath
+
+	switch {
+	case resp == nil:
+		return nil
+	case resp.ChecksumMissing:
+		p.log.Noticef("No checksum recorded for bdev config file %s, unable to verify it",
+			cfgPath)
+	case resp.ConfigModified:
+		return FaultBdevConfigModified(cfgPath)
+	case resp.InputsChanged:
+		return FaultBdevConfigInputsChanged(cfgPath)
+	}
+
+	return nil
+}
+
 // UpgradeBdevConfig updates an existing SPDK bdev config, if necessary.
 func (p *Provider) UpgradeBdevConfig(ctx context.Context, ctrlrs NvmeControllers) error {
 	if !p.HasBlockDevices() {
 		return nil
 	}
 
-	_, err := p.ReadNvmeConfig(ctx)
+	resp, err := p.checkNvmeConfig(ctx, ctrlrs)
 	if err == nil {
-		// For now, we'll just assume that if we can read the config file, then
-		// we don't need to do anything else.
+		if err := p.verifyNvmeConfig(resp); err != nil {
+			if !p.forceRegen {
+				return err
+			}
+			p.log.Noticef("%s; regenerating it as requested", err)
+			return p.WriteNvmeConfig(ctx, p.log, ctrlrs)
+		}
+
+		// If we can read the config file then we don't need to regenerate it, but warn
+		// if it no longer reflects the server config file.
+		if resp != nil && len(resp.Differences) > 0 {
+			p.log.Noticef("The bdev config file %s differs from the server config:\n  %s",
+				p.engineStorage.ConfigOutputPath, strings.Join(resp.Differences, "\n  "))
+		}
 		return nil
 	}
 
@@ -1,6 +1,7 @@
 //
 // (C) Copyright 2021-2023 Intel Corporation.
 // (C) Copyright 2025 Google LLC
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -9,6 +10,7 @@ package storage
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -38,10 +40,19 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	healthyCtrlr := MockNvmeController(1)
+	healthyCtrlr.HealthStats = MockNvmeHealth(0) // no warnings set
+	unhealthyCtrlr := MockNvmeController(2)
+	unhealthyCtrlr.HealthStats = MockNvmeHealth(0)
+	unhealthyCtrlr.HealthStats.AvailSpareWarn = true
+	unhealthyCtrlr.HealthStats.ReadOnlyWarn = true
+	unhealthyCtrlrs := NvmeControllers{healthyCtrlr, unhealthyCtrlr}
+
 	for name, tc := range map[string]struct {
 		cfg        *Config
 		vmdEnabled bool
 		getTopoFn  topologyGetter
+		ctrlrs     NvmeControllers
 		expReq     *BdevWriteConfigRequest
 		expErr     error
 	}{
@@ -150,6 +161,89 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 				HotplugBusidEnd: 0x07,
 			},
 		},
+		"range unspecified; derived from bdev_list of all nvme tiers": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList("0000:84:00.0", "0000:81:00.0"),
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList("0000:8a:00.0"),
+				},
+				EnableHotplug: true,
+			},
+			getTopoFn: MockGetTopology,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList("0000:81:00.0", "0000:84:00.0"),
+					},
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList("0000:8a:00.0"),
+					},
+				},
+				Hostname:          hostname,
+				HotplugEnabled:    true,
+				HotplugBusidBegin: 0x81,
+				HotplugBusidEnd:   0x8a,
+			},
+		},
+		"range unspecified; derived from bdev_list and bdev_spares": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList("0000:84:00.0", "0000:85:00.0").
+						WithBdevSpares("0000:8c:00.0"),
+				},
+				EnableHotplug: true,
+			},
+			getTopoFn: MockGetTopology,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList("0000:84:00.0", "0000:85:00.0"),
+					},
+				},
+				Hostname:          hostname,
+				HotplugEnabled:    true,
+				HotplugBusidBegin: 0x84,
+				HotplugBusidEnd:   0x8c,
+			},
+		},
+		"range specified; overrides bdev_list derived range": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList("0000:81:00.0").
+						WithBdevBusidRange("0x80-0x8f"),
+				},
+				EnableHotplug: true,
+			},
+			getTopoFn: MockGetTopology,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList("0000:81:00.0"),
+					},
+				},
+				Hostname:          hostname,
+				HotplugEnabled:    true,
+				HotplugBusidBegin: 0x80,
+				HotplugBusidEnd:   0x8f,
+			},
+		},
 		"range unspecified; vmd enabled": {
 			cfg: &Config{
 				Tiers: TierConfigs{
@@ -223,6 +317,51 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 				},
 			},
 		},
+		"spdk rpc server enabled; socket ownership and mode": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()),
+				},
+				SpdkRpcSrvProps: SpdkRpcServer{
+					Enable:    true,
+					SockOwner: "0",
+					SockGroup: "0",
+					SockMode:  0660,
+				},
+			},
+			getTopoFn: MockGetTopology,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{Class: ClassNvme},
+				},
+				Hostname: hostname,
+				SpdkRpcSrvProps: SpdkRpcServer{
+					Enable:    true,
+					SockOwner: "0",
+					SockGroup: "0",
+					SockMode:  0660,
+					SockUID:   new(uint32),
+					SockGID:   new(uint32),
+				},
+			},
+		},
+		"spdk rpc server enabled; unknown socket owner": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()),
+				},
+				SpdkRpcSrvProps: SpdkRpcServer{
+					Enable:    true,
+					SockOwner: "no-such-daos-user",
+				},
+			},
+			getTopoFn: MockGetTopology,
+			expErr:    errors.New("sock_owner \"no-such-daos-user\""),
+		},
 		"auto faulty criteria applied": {
 			cfg: &Config{
 				Tiers: TierConfigs{
@@ -250,19 +389,91 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 				},
 			},
 		},
+		"devices excluded by config and health": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddrs(1, 2, 3)...),
+				},
+				BdevExclude:      []string{test.MockPCIAddr(1)},
+				ExcludeUnhealthy: true,
+			},
+			getTopoFn: MockGetTopology,
+			ctrlrs:    unhealthyCtrlrs,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList(test.MockPCIAddr(3)),
+					},
+				},
+				Hostname:     hostname,
+				ScannedBdevs: unhealthyCtrlrs,
+				ExcludedBdevs: []BdevExclusion{
+					{
+						PciAddr: test.MockPCIAddr(1),
+						Reason:  "listed in bdev_exclude",
+					},
+					{
+						PciAddr: test.MockPCIAddr(2),
+						Reason: "critical health warnings: available spare, " +
+							"read only",
+					},
+				},
+			},
+		},
+		"unhealthy devices not excluded by default": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddr(2)),
+				},
+			},
+			getTopoFn: MockGetTopology,
+			ctrlrs:    unhealthyCtrlrs,
+			expReq: &BdevWriteConfigRequest{
+				OwnerUID: os.Geteuid(),
+				OwnerGID: os.Getegid(),
+				TierProps: []BdevTierProperties{
+					{
+						Class:      ClassNvme,
+						DeviceList: MustNewBdevDeviceList(test.MockPCIAddr(2)),
+					},
+				},
+				Hostname:     hostname,
+				ScannedBdevs: unhealthyCtrlrs,
+			},
+		},
+		"all devices excluded": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					mockScmTier,
+					NewTierConfig().WithStorageClass(ClassNvme.String()).
+						WithBdevDeviceList(test.MockPCIAddr(1)),
+				},
+				BdevExclude: []string{test.MockPCIAddr(1)},
+			},
+			getTopoFn: MockGetTopology,
+			expErr:    errors.New("all devices in bdev tier 0 have been excluded"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(name)
 			defer test.ShowBufferOnFailure(t, buf)
 
 			gotReq, gotErr := BdevWriteConfigRequestFromConfig(test.Context(t), log, tc.cfg,
-				tc.vmdEnabled, tc.getTopoFn)
+				tc.vmdEnabled, tc.getTopoFn, tc.ctrlrs)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if gotErr != nil {
 				return
 			}
 
-			if diff := cmp.Diff(tc.expReq, gotReq, defBdevCmpOpts()...); diff != "" {
+			cmpOpts := append(defBdevCmpOpts(), defConfigCmpOpts()...)
+			if diff := cmp.Diff(tc.expReq, gotReq, cmpOpts...); diff != "" {
 				t.Fatalf("\nunexpected generated request (-want, +got):\n%s\n", diff)
 			}
 		})
@@ -271,11 +482,12 @@ func Test_BdevWriteRequestFromConfig(t *testing.T) {
 
 func TestStorage_ProviderUpgradeBdevConfig(t *testing.T) {
 	for name, tc := range map[string]struct {
-		cfg      *Config
-		ctrlrs   NvmeControllers
-		bdevProv *mockBdevProvider
-		expCalls map[string]int
-		expErr   error
+		cfg        *Config
+		forceRegen bool
+		ctrlrs     NvmeControllers
+		bdevProv   *mockBdevProvider
+		expCalls   map[string]int
+		expErr     error
 	}{
 		"one bdev: read fails, write fails": {
 			cfg: &Config{
@@ -330,6 +542,86 @@ func TestStorage_ProviderUpgradeBdevConfig(t *testing.T) {
 				"ReadConfig": 1,
 			},
 		},
+		"one bdev: config drift detected": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassNvme.String()).WithBdevDeviceList("/dev/loop0"),
+				},
+			},
+			ctrlrs: MockNvmeControllers(1),
+			bdevProv: &mockBdevProvider{
+				ReadConfigResp: &BdevReadConfigResponse{
+					Differences: []string{"bdev: unexpected bdev_aio_create {}"},
+				},
+			},
+			expCalls: map[string]int{
+				"ReadConfig": 1,
+			},
+		},
+		"one bdev: config modified": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassNvme.String()).WithBdevDeviceList("/dev/loop0"),
+				},
+				ConfigOutputPath: "/mnt/daos/daos_nvme.conf",
+			},
+			ctrlrs: MockNvmeControllers(1),
+			bdevProv: &mockBdevProvider{
+				ReadConfigResp: &BdevReadConfigResponse{
+					ConfigModified: true,
+				},
+			},
+			expErr: FaultBdevConfigModified("/mnt/daos/daos_nvme.conf"),
+		},
+		"one bdev: config inputs changed": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassNvme.String()).WithBdevDeviceList("/dev/loop0"),
+				},
+				ConfigOutputPath: "/mnt/daos/daos_nvme.conf",
+			},
+			ctrlrs: MockNvmeControllers(1),
+			bdevProv: &mockBdevProvider{
+				ReadConfigResp: &BdevReadConfigResponse{
+					InputsChanged: true,
+				},
+			},
+			expErr: FaultBdevConfigInputsChanged("/mnt/daos/daos_nvme.conf"),
+		},
+		"one bdev: config inputs changed; forced regeneration": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassNvme.String()).WithBdevDeviceList("/dev/loop0"),
+				},
+			},
+			forceRegen: true,
+			ctrlrs:     MockNvmeControllers(1),
+			bdevProv: &mockBdevProvider{
+				ReadConfigResp: &BdevReadConfigResponse{
+					InputsChanged: true,
+				},
+			},
+			expCalls: map[string]int{
+				"ReadConfig":  1,
+				"WriteConfig": 1,
+			},
+		},
+		"one bdev: no checksum recorded": {
+			cfg: &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassNvme.String()).WithBdevDeviceList("/dev/loop0"),
+				},
+			},
+			ctrlrs: MockNvmeControllers(1),
+			bdevProv: &mockBdevProvider{
+				ReadConfigResp: &BdevReadConfigResponse{
+					ChecksumMissing: true,
+				},
+			},
+			expCalls: map[string]int{
+				"ReadConfig": 1,
+			},
+		},
 		"no bdevs: success": {
 			cfg: &Config{
 				Tiers: TierConfigs{},
@@ -341,7 +633,8 @@ func TestStorage_ProviderUpgradeBdevConfig(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctx := test.MustLogContext(t, test.Context(t))
 
-			p := NewProvider(logging.FromContext(ctx), 0, tc.cfg, nil, nil, tc.bdevProv, nil)
+			p := NewProvider(logging.FromContext(ctx), 0, tc.cfg, nil, nil, tc.bdevProv, nil).
+				WithForceBdevConfigRegen(tc.forceRegen)
 			gotErr := p.UpgradeBdevConfig(ctx, tc.ctrlrs)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
@@ -764,3 +1057,264 @@ func TestStorage_ControlMetadataEnginePath(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_AttachBdevController(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rpcSrv   SpdkRpcServer
+		bdevProv *mockBdevProvider
+		expReqs  []BdevAttachRequest
+		expResp  *BdevAttachResponse
+		expErr   error
+	}{
+		"rpc server disabled": {
+			bdevProv: &mockBdevProvider{},
+			expErr:   errors.New("not enabled"),
+		},
+		"default socket": {
+			rpcSrv: SpdkRpcServer{Enable: true},
+			bdevProv: &mockBdevProvider{
+				AttachResp: &BdevAttachResponse{Bdevs: []string{"Nvme_0n1"}},
+			},
+			expReqs: []BdevAttachRequest{
+				{
+					SockAddr:   DefaultSpdkRpcSockAddr,
+					DeviceName: "Nvme_0",
+					PciAddr:    test.MockPCIAddr(1),
+				},
+			},
+			expResp: &BdevAttachResponse{Bdevs: []string{"Nvme_0n1"}},
+		},
+		"configured socket; attach fails": {
+			rpcSrv: SpdkRpcServer{Enable: true, SockAddr: "/tmp/spdk0.sock"},
+			bdevProv: &mockBdevProvider{
+				AttachErr: errors.New("attach failed"),
+			},
+			expReqs: []BdevAttachRequest{
+				{
+					SockAddr:   "/tmp/spdk0.sock",
+					DeviceName: "Nvme_0",
+					PciAddr:    test.MockPCIAddr(1),
+				},
+			},
+			expErr: errors.New("attach failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := &Config{SpdkRpcSrvProps: tc.rpcSrv}
+			p := NewProvider(log, 0, cfg, nil, nil, tc.bdevProv, nil)
+
+			resp, err := p.AttachBdevController("Nvme_0", test.MockPCIAddr(1))
+			test.CmpErr(t, tc.expErr, err)
+
+			if diff := cmp.Diff(tc.expReqs, tc.bdevProv.AttachReqs); diff != "" {
+				t.Fatalf("unexpected attach requests (-want, +got):\n%s", diff)
+			}
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, resp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStorage_CheckScmOwnership(t *testing.T) {
+	ownedLabel := []byte("system: daos_server\nengine_idx: 1\ntier: 0\n")
+
+	for name, tc := range map[string]struct {
+		sysProvCfg   *system.MockSysConfig
+		labelData    []byte
+		labelErr     error
+		expOwner     *ScmOwnerLabel
+		expOwnership ScmOwnership
+		expErr       error
+		expCheckErr  error
+		expWritten   bool
+	}{
+		"not mounted": {
+			sysProvCfg:   &system.MockSysConfig{},
+			expOwnership: ScmOwnershipUnknown,
+		},
+		"IsMounted failed": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedErr: errors.New("mock IsMounted"),
+			},
+			expErr: errors.New("mock IsMounted"),
+		},
+		"no label; adopted": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedBool: true,
+			},
+			labelErr:     os.ErrNotExist,
+			expOwnership: ScmOwnershipUnowned,
+			expWritten:   true,
+		},
+		"read label failed": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedBool: true,
+			},
+			labelErr: errors.New("mock ReadFile"),
+			expErr:   errors.New("mock ReadFile"),
+		},
+		"owned": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedBool: true,
+			},
+			labelData: ownedLabel,
+			expOwner: &ScmOwnerLabel{
+				System:    "daos_server",
+				EngineIdx: 1,
+			},
+			expOwnership: ScmOwnershipOwned,
+		},
+		"different engine": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedBool: true,
+			},
+			labelData: []byte("system: daos_server\nengine_idx: 0\ntier: 0\n"),
+			expOwner: &ScmOwnerLabel{
+				System: "daos_server",
+			},
+			expOwnership: ScmOwnershipForeign,
+			expCheckErr:  errors.New("owned by system daos_server engine 0 tier 0"),
+		},
+		"different system": {
+			sysProvCfg: &system.MockSysConfig{
+				IsMountedBool: true,
+			},
+			labelData: []byte("system: other\nengine_idx: 1\ntier: 0\n"),
+			expOwner: &ScmOwnerLabel{
+				System:    "other",
+				EngineIdx: 1,
+			},
+			expOwnership: ScmOwnershipForeign,
+			expCheckErr: errors.New("owned by system other engine 1 tier 0 but expected " +
+				"system daos_server engine 1 tier 0"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			labelPath := filepath.Join(testDir, ScmOwnerLabelFile)
+			tc.sysProvCfg.ReadFileResults = map[string][]byte{labelPath: tc.labelData}
+			tc.sysProvCfg.ReadFileErrors = map[string]error{labelPath: tc.labelErr}
+
+			cfg := &Config{
+				Tiers: TierConfigs{
+					NewTierConfig().WithStorageClass(ClassDcpm.String()).
+						WithScmMountPoint(testDir).
+						WithScmDeviceList("/dev/pmem1"),
+				},
+			}
+			p := NewProvider(log, 1, cfg, system.NewMockSysProvider(log, tc.sysProvCfg),
+				nil, nil, nil)
+
+			owner, ownership, err := p.ScmOwnership("daos_server")
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expOwner, owner, "unexpected owner")
+			test.AssertEqual(t, tc.expOwnership, ownership, "unexpected ownership")
+
+			test.CmpErr(t, tc.expCheckErr, p.CheckScmOwnership("daos_server"))
+
+			_, err = os.Stat(labelPath)
+			test.AssertEqual(t, tc.expWritten, err == nil, "unexpected label file state")
+		})
+	}
+}
+
+func TestStorage_RestoreScm(t *testing.T) {
+	const (
+		mnt = "/mnt/daos"
+		dev = "/dev/pmem0"
+	)
+
+	for name, tc := range map[string]struct {
+		class          Class
+		checkFormatRes *ScmFormatResponse
+		checkFormatErr error
+		mountRes       *MountResponse
+		mountErr       error
+		expActions     []string
+		expErr         error
+	}{
+		"ram class": {
+			class:          ClassRam,
+			checkFormatErr: errors.New("not expected"),
+		},
+		"already mounted": {
+			checkFormatRes: &ScmFormatResponse{Formatted: true, Mounted: true},
+		},
+		"needs format": {
+			checkFormatRes: &ScmFormatResponse{},
+		},
+		"check format fails": {
+			checkFormatErr: errors.New("check failed"),
+			expErr:         errors.New("check failed"),
+		},
+		"formatted; unmounted": {
+			checkFormatRes: &ScmFormatResponse{Formatted: true, Mountable: true},
+			mountRes:       &MountResponse{Target: mnt, Mounted: true},
+			expActions:     []string{"mounted " + dev + " on " + mnt},
+		},
+		"formatted; mount point missing; repaired": {
+			checkFormatErr: FaultDeviceWithFsNoMountpoint(dev, mnt),
+			mountRes: &MountResponse{
+				Target:  mnt,
+				Mounted: true,
+				Repairs: []string{"created missing mount point " + mnt},
+			},
+			expActions: []string{
+				"created missing mount point " + mnt,
+				"mounted " + dev + " on " + mnt,
+			},
+		},
+		"formatted; unmounted; mount fails": {
+			checkFormatRes: &ScmFormatResponse{Formatted: true, Mountable: true},
+			mountErr:       errors.New("mount failed"),
+			expErr:         errors.New("remount /mnt/daos: mount failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			if tc.class == "" {
+				tc.class = ClassDcpm
+			}
+			tierCfg := NewTierConfig().WithStorageClass(tc.class.String()).
+				WithScmMountPoint(mnt)
+			if tc.class == ClassDcpm {
+				tierCfg.WithScmDeviceList(dev)
+			}
+			cfg := &Config{Tiers: TierConfigs{tierCfg}}
+
+			msp := &MockScmProvider{
+				CheckFormatRes: tc.checkFormatRes,
+				CheckFormatErr: tc.checkFormatErr,
+				MountRes:       tc.mountRes,
+				MountErr:       tc.mountErr,
+			}
+			p := NewProvider(log, 0, cfg, system.NewMockSysProvider(log, nil), msp,
+				nil, nil)
+
+			actions, err := p.RestoreScm()
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+			test.AssertEqual(t, tc.expActions, actions, "unexpected actions")
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -55,8 +56,114 @@ const (
 	DefaultSysMemRsvd    = humanize.GiByte * 26  // per-system
 	DefaultTgtMemRsvd    = humanize.MiByte * 128 // per-engine-target
 	DefaultEngineMemRsvd = humanize.GiByte * 1   // per-engine
+
+	// MaxRamdiskMemMargin is the largest percentage of total memory that can be withheld from
+	// RAM-disks as a safety margin.
+	MaxRamdiskMemMargin = 50
+)
+
+// ScmNamespaceMode describes the access mode of a PMem namespace as understood by ndctl.
+type ScmNamespaceMode string
+
+const (
+	// ScmNsModeFsdax exposes a namespace as a block device that can host a DAX-capable
+	// filesystem, this is the only mode DAOS can mount as an SCM tier.
+	ScmNsModeFsdax ScmNamespaceMode = "fsdax"
+	// ScmNsModeDevdax exposes a namespace as a character device for direct mapping by
+	// applications outside of DAOS.
+	ScmNsModeDevdax ScmNamespaceMode = "devdax"
+)
+
+func (snm ScmNamespaceMode) String() string {
+	return string(snm)
+}
+
+// Validate returns an error if the namespace mode is not supported.
+func (snm ScmNamespaceMode) Validate() error {
+	switch snm {
+	case ScmNsModeFsdax, ScmNsModeDevdax:
+		return nil
+	default:
+		return errors.Errorf("unsupported pmem namespace mode %q, want %q or %q", snm,
+			ScmNsModeFsdax, ScmNsModeDevdax)
+	}
+}
+
+// PMem discovery backend names reported in ScmCapabilities.
+const (
+	ScmBackendTools = "ipmctl/ndctl"
+	ScmBackendSysfs = "sysfs"
 )
 
+// Limited returns true if any PMem operation is unavailable.
+func (sc *ScmCapabilities) Limited() bool {
+	return sc != nil && !(sc.Prepare && sc.Firmware && sc.Health)
+}
+
+// Unavailable returns the names of PMem operations that are not supported.
+func (sc *ScmCapabilities) Unavailable() []string {
+	if sc == nil {
+		return nil
+	}
+
+	var ops []string
+	if !sc.Prepare {
+		ops = append(ops, "prepare")
+	}
+	if !sc.Firmware {
+		ops = append(ops, "firmware")
+	}
+	if !sc.Health {
+		ops = append(ops, "health")
+	}
+	return ops
+}
+
+// ScmOwnerLabelFile is the name of the file written to the root of a formatted SCM mount that
+// records which DAOS engine owns the storage.
+const ScmOwnerLabelFile = "daos_owner"
+
+// ScmOwnerLabel identifies the DAOS system, engine and storage tier that formatted an SCM mount.
+type ScmOwnerLabel struct {
+	System    string `yaml:"system" json:"system"`
+	EngineIdx uint32 `yaml:"engine_idx" json:"engine_idx"`
+	Tier      uint32 `yaml:"tier" json:"tier"`
+}
+
+func (sol *ScmOwnerLabel) String() string {
+	if sol == nil {
+		return "none"
+	}
+	return fmt.Sprintf("system %s engine %d tier %d", sol.System, sol.EngineIdx, sol.Tier)
+}
+
+// ScmOwnership indicates whether a formatted SCM mount belongs to the local engine.
+type ScmOwnership string
+
+const (
+	// ScmOwnershipUnknown indicates ownership could not be determined, e.g. when not mounted.
+	ScmOwnershipUnknown ScmOwnership = ""
+	// ScmOwnershipOwned indicates the owner label matches the local engine.
+	ScmOwnershipOwned ScmOwnership = "owned"
+	// ScmOwnershipUnowned indicates that no owner label exists.
+	ScmOwnershipUnowned ScmOwnership = "unowned"
+	// ScmOwnershipForeign indicates the owner label belongs to another system or engine.
+	ScmOwnershipForeign ScmOwnership = "foreign"
+)
+
+// CheckOwnership compares the label with that expected for the local engine. A nil or empty
+// label indicates that the storage is unowned.
+func (sol *ScmOwnerLabel) CheckOwnership(expected *ScmOwnerLabel) ScmOwnership {
+	switch {
+	case sol == nil || sol.System == "":
+		return ScmOwnershipUnowned
+	case expected == nil || *sol != *expected:
+		return ScmOwnershipForeign
+	default:
+		return ScmOwnershipOwned
+	}
+}
+
 func (ss ScmState) String() string {
 	if val, exists := map[ScmState]string{
 		ScmStateUnknown:   "Unknown",
@@ -95,6 +202,12 @@ type (
 		PartNumber       string
 		FirmwareRevision string
 		HealthState      string
+		SecurityState    ScmSecurityState
+		// Health statistics vary over time so are excluded when grouping hosts.
+		MediaTemperature      uint32 `hash:"ignore"` // Degrees Celsius.
+		ControllerTemperature uint32 `hash:"ignore"` // Degrees Celsius.
+		SparePercentage       uint32 `hash:"ignore"` // Remaining spare capacity.
+		LifespanRemaining     uint32 `hash:"ignore"` // Percentage of expected life span.
 	}
 
 	// ScmModules is a type alias for []ScmModule that implements fmt.Stringer.
@@ -102,14 +215,17 @@ type (
 
 	// ScmMountPoint represents location PMem filesystem is mounted.
 	ScmMountPoint struct {
-		Class       Class         `json:"class"`
-		DeviceList  []string      `json:"device_list"`
-		Info        string        `json:"info"`
-		Path        string        `json:"path"`
-		Rank        ranklist.Rank `json:"rank"`
-		TotalBytes  uint64        `json:"total_bytes"`
-		AvailBytes  uint64        `json:"avail_bytes"`
-		UsableBytes uint64        `json:"usable_bytes"`
+		Class       Class               `json:"class"`
+		DeviceList  []string            `json:"device_list"`
+		Info        string              `json:"info"`
+		Path        string              `json:"path"`
+		Rank        ranklist.Rank       `json:"rank"`
+		TotalBytes  uint64              `json:"total_bytes"`
+		AvailBytes  uint64              `json:"avail_bytes"`
+		UsableBytes uint64              `json:"usable_bytes"`
+		Owner       *ScmOwnerLabel      `json:"owner"`
+		Ownership   ScmOwnership        `json:"ownership"`
+		PoolUsage   []*PoolStorageUsage `json:"pool_usage,omitempty"`
 	}
 
 	// ScmMountPoints is a type alias for []ScmMountPoint that implements fmt.Stringer.
@@ -117,12 +233,14 @@ type (
 
 	// ScmNamespace is a block device exposing a PMem AppDirect region.
 	ScmNamespace struct {
-		UUID        string         `json:"uuid" hash:"ignore"`
-		BlockDevice string         `json:"blockdev"`
-		Name        string         `json:"dev"`
-		NumaNode    uint32         `json:"numa_node"`
-		Size        uint64         `json:"size"`
-		Mount       *ScmMountPoint `json:"mount"`
+		UUID        string           `json:"uuid" hash:"ignore"`
+		BlockDevice string           `json:"blockdev"`
+		Name        string           `json:"dev"`
+		NumaNode    uint32           `json:"numa_node"`
+		Size        uint64           `json:"size"`
+		Mode        ScmNamespaceMode `json:"mode"`
+		Mount       *ScmMountPoint   `json:"mount"`
+		Binding     *DeviceBinding   `json:"binding,omitempty"`
 	}
 
 	// ScmNamespaces is a type alias for a slice of ScmNamespace references.
@@ -131,6 +249,10 @@ type (
 	// ScmFirmwareUpdateStatus represents the status of a firmware update on the module.
 	ScmFirmwareUpdateStatus uint32
 
+	// ScmSecurityState represents the passphrase security state of a PMem module. Multiple
+	// comma-separated states may be reported e.g. "Unlocked, Frozen".
+	ScmSecurityState string
+
 	// ScmFirmwareInfo describes the firmware information of an PMem module.
 	ScmFirmwareInfo struct {
 		ActiveVersion     string
@@ -164,6 +286,67 @@ func (s ScmFirmwareUpdateStatus) String() string {
 	return "Unknown"
 }
 
+// ScmFirmwarePhase identifies the step of a staged PMem firmware update to perform.
+type ScmFirmwarePhase uint32
+
+const (
+	// ScmFirmwarePhaseStage writes the firmware image to the module for later activation.
+	ScmFirmwarePhaseStage ScmFirmwarePhase = iota
+	// ScmFirmwarePhaseVerify checks that a firmware image has been staged on the module.
+	ScmFirmwarePhaseVerify
+	// ScmFirmwarePhaseActivate activates staged firmware without a reboot.
+	ScmFirmwarePhaseActivate
+)
+
+func (p ScmFirmwarePhase) String() string {
+	switch p {
+	case ScmFirmwarePhaseStage:
+		return "stage"
+	case ScmFirmwarePhaseVerify:
+		return "verify"
+	case ScmFirmwarePhaseActivate:
+		return "activate"
+	}
+	return fmt.Sprintf("unknown phase %d", uint32(p))
+}
+
+const (
+	// ScmSecurityDisabled indicates that no passphrase is set on the module.
+	ScmSecurityDisabled ScmSecurityState = "Disabled"
+	// ScmSecurityUnlocked indicates that a passphrase is set and the module is accessible.
+	ScmSecurityUnlocked ScmSecurityState = "Unlocked"
+	// ScmSecurityLocked indicates that the module is inaccessible until unlocked.
+	ScmSecurityLocked ScmSecurityState = "Locked"
+	// ScmSecurityFrozen indicates that security state cannot be changed until reboot.
+	ScmSecurityFrozen ScmSecurityState = "Frozen"
+	// ScmSecurityExceeded indicates that the passphrase retry limit has been reached.
+	ScmSecurityExceeded ScmSecurityState = "Exceeded"
+)
+
+func (s ScmSecurityState) has(state ScmSecurityState) bool {
+	for _, field := range strings.Split(string(s), ",") {
+		if ScmSecurityState(strings.TrimSpace(field)) == state {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLocked returns true if the module is locked and needs to be unlocked before use.
+func (s ScmSecurityState) IsLocked() bool {
+	return s.has(ScmSecurityLocked)
+}
+
+// IsFrozen returns true if the module security state cannot be changed until reboot.
+func (s ScmSecurityState) IsFrozen() bool {
+	return s.has(ScmSecurityFrozen)
+}
+
+// IsExceeded returns true if the passphrase retry limit has been reached.
+func (s ScmSecurityState) IsExceeded() bool {
+	return s.has(ScmSecurityExceeded)
+}
+
 func (sm *ScmModule) String() string {
 	health := ""
 	if sm.HealthState != "" {
@@ -200,6 +383,16 @@ func (sms ScmModules) Capacity() (tb uint64) {
 	return
 }
 
+// Locked returns the modules that are locked and need to be unlocked before use.
+func (sms ScmModules) Locked() (locked ScmModules) {
+	for _, sm := range sms {
+		if sm.SecurityState.IsLocked() {
+			locked = append(locked, sm)
+		}
+	}
+	return
+}
+
 // Summary reports total storage space and the number of modules. Memory capacity printed with IEC
 // (binary representation) units.
 func (sms ScmModules) Summary() string {
@@ -321,14 +514,40 @@ type (
 		Prepare(ScmPrepareRequest) (*ScmPrepareResponse, error)
 		QueryFirmware(ScmFirmwareQueryRequest) (*ScmFirmwareQueryResponse, error)
 		UpdateFirmware(ScmFirmwareUpdateRequest) (*ScmFirmwareUpdateResponse, error)
+		Unlock(ScmUnlockRequest) (*ScmUnlockResponse, error)
+	}
+
+	// ScmUnlockRequest defines the parameters for an Unlock operation.
+	ScmUnlockRequest struct {
+		pbin.ForwardableRequest
+		PassphraseFile string   // File containing the passphrase in ipmctl source format.
+		DeviceUIDs     []string // Only unlock these modules, all locked modules if empty.
+	}
+
+	// ScmUnlockResponse contains the results of a successful Unlock operation.
+	ScmUnlockResponse struct {
+		Modules ScmModules // Modules that have been unlocked.
 	}
 
 	// ScmPrepareRequest defines the parameters for a Prepare operation.
 	ScmPrepareRequest struct {
 		pbin.ForwardableRequest
-		Reset                 bool  // Clear PMem namespaces and regions.
-		NrNamespacesPerSocket uint  // Request this many PMem namespaces per socket.
-		SocketID              *uint // Only process PMem attached to this socket.
+		Reset                 bool             // Clear PMem namespaces and regions.
+		NrNamespacesPerSocket uint             // Request this many PMem namespaces per socket.
+		SocketID              *uint            // Only process PMem attached to this socket.
+		NamespaceMode         ScmNamespaceMode // Create PMem namespaces in this mode.
+		DryRun                bool             // Report planned changes without applying them.
+	}
+
+	// ScmSocketPreview describes the changes that a Prepare operation would make to the PMem
+	// attached to a single socket.
+	ScmSocketPreview struct {
+		SocketID         uint     `json:"socket_id"`
+		Goals            []string `json:"goals"`             // Region goal commands to be issued.
+		RemoveNamespaces []string `json:"remove_namespaces"` // Namespaces to be destroyed.
+		CreateNamespaces uint     `json:"create_namespaces"` // Number of namespaces to be created.
+		NamespaceSize    uint64   `json:"namespace_size"`    // Size of each namespace to be created.
+		Capacity         uint64   `json:"capacity"`          // Resulting AppDirect capacity.
 	}
 
 	// ScmPrepareResponse contains the results of a successful Prepare operation.
@@ -336,6 +555,7 @@ type (
 		Socket         *ScmSocketState
 		RebootRequired bool
 		Namespaces     ScmNamespaces
+		Preview        []*ScmSocketPreview // Populated on dry-run.
 	}
 
 	// ScmScanRequest defines the parameters for a Scan operation.
@@ -345,10 +565,20 @@ type (
 		PMemInConfig bool  // Indicate whether server config file contains PMem.
 	}
 
+	// ScmCapabilities indicates which PMem operations are supported by the backend used for
+	// discovery.
+	ScmCapabilities struct {
+		Backend  string `json:"backend"`
+		Prepare  bool   `json:"prepare"`
+		Firmware bool   `json:"firmware"`
+		Health   bool   `json:"health"`
+	}
+
 	// ScmScanResponse contains information gleaned during a successful Scan operation.
 	ScmScanResponse struct {
-		Modules    ScmModules
-		Namespaces ScmNamespaces
+		Modules      ScmModules
+		Namespaces   ScmNamespaces
+		Capabilities *ScmCapabilities
 	}
 
 	// RamdiskParams defines the sub-parameters of a Format or Mount operation that
@@ -381,10 +611,14 @@ type (
 	// ScmMountRequest represents an SCM mount request.
 	ScmMountRequest struct {
 		pbin.ForwardableRequest
-		Class   Class
-		Device  string
-		Target  string
-		Ramdisk *RamdiskParams
+		Class    Class
+		Device   string
+		Target   string
+		Ramdisk  *RamdiskParams
+		Restore  bool // create missing mount point before mounting PMem
+		Fsck     bool // check and repair PMem filesystem before mounting
+		OwnerUID int
+		OwnerGID int
 	}
 
 	// ScmFirmwareQueryRequest defines the parameters for a firmware query.
@@ -415,6 +649,7 @@ type (
 		FirmwarePath string   // location of the firmware binary
 		ModelID      string   // filter devices by model ID
 		FirmwareRev  string   // filter devices by current FW revision
+		Phase        ScmFirmwarePhase
 	}
 
 	// ScmFirmwareUpdateResult represents the result of a firmware update for
@@ -516,6 +751,18 @@ func (f *ScmAdminForwarder) Scan(req ScmScanRequest) (*ScmScanResponse, error) {
 	return res, nil
 }
 
+// Unlock forwards a request to unlock passphrase-protected PMem modules.
+func (f *ScmAdminForwarder) Unlock(req ScmUnlockRequest) (*ScmUnlockResponse, error) {
+	req.Forwarded = true
+
+	res := new(ScmUnlockResponse)
+	if err := f.SendReq("ScmUnlock", req, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // Prepare forwards a request to prep the SCM.
 func (f *ScmAdminForwarder) Prepare(req ScmPrepareRequest) (*ScmPrepareResponse, error) {
 	req.Forwarded = true
@@ -590,18 +837,33 @@ func (f *ScmFwForwarder) UpdateFirmware(req ScmFirmwareUpdateRequest) (*ScmFirmw
 	return res, nil
 }
 
-// CalcRamdiskSize returns recommended tmpfs RAM-disk size calculated as
-// (total mem - hugepage mem - sys rsvd mem - engine rsvd mem) / nr engines.
-// All values in units of bytes and return value is for a single RAM-disk/engine.
-func CalcRamdiskSize(log logging.Logger, memTotal, memHuge, memSys uint64, tgtCount, engCount int) (uint64, error) {
+// RamdiskMemBreakdown records how total memory (RAM) is divided up when calculating the size of
+// tmpfs RAM-disks for DAOS I/O engines. All memory values are in units of bytes.
+type RamdiskMemBreakdown struct {
+	MemTotal  uint64 // total system memory
+	MemHuge   uint64 // memory assigned to hugepages
+	MemSys    uint64 // memory reserved for system (non-DAOS) use
+	MemMargin uint64 // safety margin withheld from RAM-disks
+	MemEngine uint64 // memory reserved for each engine
+	TgtCount  int    // number of targets per engine
+	EngCount  int    // number of engines
+}
+
+// NewRamdiskMemBreakdown returns a RamdiskMemBreakdown with the per-engine reservation derived
+// from the number of targets per engine. The margin is a percentage of total memory.
+func NewRamdiskMemBreakdown(memTotal, memHuge, memSys uint64, marginPct, tgtCount, engCount int) (*RamdiskMemBreakdown, error) {
 	if memTotal == 0 {
-		return 0, errors.New("requires nonzero total mem")
+		return nil, errors.New("requires nonzero total mem")
 	}
 	if tgtCount <= 0 {
-		return 0, errors.New("requires positive nonzero nr engine targets")
+		return nil, errors.New("requires positive nonzero nr engine targets")
 	}
 	if engCount <= 0 {
-		return 0, errors.New("requires positive nonzero nr engines")
+		return nil, errors.New("requires positive nonzero nr engines")
+	}
+	if marginPct < 0 || marginPct > MaxRamdiskMemMargin {
+		return nil, errors.Errorf("ram-disk mem margin %d%% out of range (0 - %d%%)",
+			marginPct, MaxRamdiskMemMargin)
 	}
 
 	memEng := uint64(tgtCount) * DefaultTgtMemRsvd
@@ -609,51 +871,68 @@ func CalcRamdiskSize(log logging.Logger, memTotal, memHuge, memSys uint64, tgtCo
 		memEng = DefaultEngineMemRsvd
 	}
 
-	msgStats := fmt.Sprintf("mem stats: total %s (%d) - (hugepages %s + sys rsvd %s + "+
-		"(engine rsvd %s * nr engines %d), engine rsvd: max(%d tgts-per-engine * %s, %s)",
-		humanize.IBytes(memTotal), memTotal, humanize.IBytes(memHuge),
-		humanize.IBytes(memSys), humanize.IBytes(memEng), engCount, tgtCount,
-		humanize.IBytes(DefaultTgtMemRsvd), humanize.IBytes(DefaultEngineMemRsvd))
+	return &RamdiskMemBreakdown{
+		MemTotal:  memTotal,
+		MemHuge:   memHuge,
+		MemSys:    memSys,
+		MemMargin: memTotal / 100 * uint64(marginPct),
+		MemEngine: memEng,
+		TgtCount:  tgtCount,
+		EngCount:  engCount,
+	}, nil
+}
+
+// Reserved returns the total amount of memory that is unavailable for RAM-disks.
+func (rmb *RamdiskMemBreakdown) Reserved() uint64 {
+	return rmb.MemHuge + rmb.MemSys + rmb.MemMargin + (rmb.MemEngine * uint64(rmb.EngCount))
+}
 
-	memRsvd := memHuge + memSys + (memEng * uint64(engCount))
-	if memTotal < memRsvd {
-		return 0, errors.Errorf("insufficient ram to meet minimum requirements (%s)",
-			msgStats)
+// RamdiskSize returns the size of each engine's RAM-disk after reservations have been applied,
+// zero is returned if reservations exceed total memory.
+func (rmb *RamdiskMemBreakdown) RamdiskSize() uint64 {
+	if rmb.MemTotal < rmb.Reserved() {
+		return 0
 	}
 
-	ramdiskSize := (memTotal - memRsvd) / uint64(engCount)
+	return (rmb.MemTotal - rmb.Reserved()) / uint64(rmb.EngCount)
+}
 
-	log.Debugf("ram-disk size %s calculated using %s", humanize.IBytes(ramdiskSize), msgStats)
+// MinMemTotal returns the total memory needed for each engine to have a RAM-disk of the minimum
+// size.
+func (rmb *RamdiskMemBreakdown) MinMemTotal() uint64 {
+	return rmb.Reserved() + (MinRamdiskMem * uint64(rmb.EngCount))
+}
 
-	return ramdiskSize, nil
+func (rmb *RamdiskMemBreakdown) String() string {
+	return fmt.Sprintf("total %s - (hugepages %s + sys rsvd %s + margin %s + "+
+		"(engine rsvd %s * nr engines %d)), engine rsvd: max(%d tgts-per-engine * %s, %s)",
+		humanize.IBytes(rmb.MemTotal), humanize.IBytes(rmb.MemHuge),
+		humanize.IBytes(rmb.MemSys), humanize.IBytes(rmb.MemMargin),
+		humanize.IBytes(rmb.MemEngine), rmb.EngCount, rmb.TgtCount,
+		humanize.IBytes(DefaultTgtMemRsvd), humanize.IBytes(DefaultEngineMemRsvd))
 }
 
-// CalcMemForRamdiskSize returns the minimum RAM required for the input requested RAM-disk size.
-func CalcMemForRamdiskSize(log logging.Logger, ramdiskSize, memHuge, memSys uint64, tgtCount, engCount int) (uint64, error) {
-	if ramdiskSize == 0 {
-		return 0, errors.New("requires nonzero ram-disk size")
-	}
-	if tgtCount <= 0 {
-		return 0, errors.New("requires positive nonzero nr engine targets")
-	}
-	if engCount == 0 {
-		return 0, errors.New("requires nonzero nr engines")
+// CalcRamdiskSize returns recommended tmpfs RAM-disk size calculated as
+// (total mem - hugepage mem - sys rsvd mem - margin mem - engine rsvd mem) / nr engines.
+// All values in units of bytes and return value is for a single RAM-disk/engine. The margin is
+// given as a percentage of total mem. A fault detailing the memory breakdown is returned if the
+// calculated size is less than the minimum allowed.
+func CalcRamdiskSize(log logging.Logger, memTotal, memHuge, memSys uint64, marginPct, tgtCount, engCount int) (uint64, error) {
+	rmb, err := NewRamdiskMemBreakdown(memTotal, memHuge, memSys, marginPct, tgtCount,
+		engCount)
+	if err != nil {
+		return 0, err
 	}
 
-	memEng := uint64(tgtCount) * DefaultTgtMemRsvd
-	if memEng < DefaultEngineMemRsvd {
-		memEng = DefaultEngineMemRsvd
+	ramdiskSize := rmb.RamdiskSize()
+	if ramdiskSize < MinRamdiskMem {
+		log.Errorf("ram-disk size %s below minimum %s, mem stats: %s",
+			humanize.IBytes(ramdiskSize), humanize.IBytes(MinRamdiskMem), rmb)
+		return 0, FaultRamdiskInsufficientMem(rmb)
 	}
 
-	msgStats := fmt.Sprintf("required ram-disk size %s (%d). mem hugepage: %s, nr engines: %d, "+
-		"sys mem rsvd: %s, engine mem rsvd: %s, %d tgts-per-engine",
-		humanize.IBytes(ramdiskSize), ramdiskSize, humanize.IBytes(memHuge), engCount,
-		humanize.IBytes(memSys), humanize.IBytes(memEng), tgtCount)
+	log.Debugf("ram-disk size %s calculated using mem stats: %s", humanize.IBytes(ramdiskSize),
+		rmb)
 
-	memRsvd := memHuge + memSys + (memEng * uint64(engCount))
-	memReqd := memRsvd + (ramdiskSize * uint64(engCount))
-
-	log.Debugf("%s RAM needed for %s", humanize.IBytes(memReqd), msgStats)
-
-	return memReqd, nil
+	return ramdiskSize, nil
 }
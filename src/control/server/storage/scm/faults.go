@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2019-2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -11,6 +12,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
 var (
@@ -89,6 +91,12 @@ var (
 	FaultNoFilterMatch = scmFault(code.ScmNoDevicesMatchFilter,
 		"no SCM modules matched the filter criteria",
 		"adjust or relax the filters and try again")
+
+	// FaultUnlockMissingPassphrase represents an error where an unlock was requested without
+	// a passphrase source.
+	FaultUnlockMissingPassphrase = scmFault(code.ScmUnlockMissingPassphrase,
+		"unlock request must specify a passphrase file",
+		"set scm_unlock in the server config file or supply a key file and try again")
 )
 
 // FaultIpmctlBadVersion represents an error where an incompatible version of
@@ -111,6 +119,38 @@ func FaultFormatMissingDevice(device string) *fault.Fault {
 	)
 }
 
+// FaultSysfsUnsupported creates a Fault for the case where a PMem operation is requested but only
+// sysfs discovery is available because the ipmctl or ndctl tools are not installed.
+func FaultSysfsUnsupported(operation string) *fault.Fault {
+	return scmFault(
+		code.MissingSoftwareDependency,
+		fmt.Sprintf("PMem %s is not supported without ipmctl and ndctl, only discovery "+
+			"through sysfs is available", operation),
+		"install the ipmctl and ndctl software for your OS",
+	)
+}
+
+// FaultFsckFailed creates a Fault for the case where a filesystem check of a PMem device found
+// errors that could not be repaired automatically.
+func FaultFsckFailed(device string, err error) *fault.Fault {
+	return scmFault(
+		code.ScmFsckFailed,
+		fmt.Sprintf("filesystem check on %s failed: %s", device, err),
+		fmt.Sprintf("run fsck.%s manually on %s or reformat SCM with dmg storage format --force",
+			dcpmFsType, device),
+	)
+}
+
+// FaultUnlockNotPossible creates a Fault for the case where a locked PMem module cannot be
+// unlocked because its security state is frozen or the passphrase retry limit was reached.
+func FaultUnlockNotPossible(uid string, state storage.ScmSecurityState) *fault.Fault {
+	return scmFault(
+		code.ScmUnlockNotPossible,
+		fmt.Sprintf("PMem module %s cannot be unlocked (security state: %s)", uid, state),
+		"reboot the host to reset the module security state and retry",
+	)
+}
+
 func scmFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "scm",
@@ -115,13 +115,53 @@ func (p *Provider) UpdateFirmware(req storage.ScmFirmwareUpdateRequest) (*storag
 	resp := &storage.ScmFirmwareUpdateResponse{
 		Results: make([]storage.ScmFirmwareUpdateResult, len(modules)),
 	}
-	for i, mod := range modules {
-		err = p.backend.UpdateFirmware(mod.UID, req.FirmwarePath)
-		resp.Results[i].Module = *mod
-		if err != nil {
-			resp.Results[i].Error = err.Error()
+
+	switch req.Phase {
+	case storage.ScmFirmwarePhaseStage:
+		for i, mod := range modules {
+			err = p.backend.UpdateFirmware(mod.UID, req.FirmwarePath)
+			resp.Results[i].Module = *mod
+			if err != nil {
+				resp.Results[i].Error = err.Error()
+			}
+		}
+	case storage.ScmFirmwarePhaseVerify:
+		for i, mod := range modules {
+			resp.Results[i].Module = *mod
+			if err := p.verifyStagedFirmware(mod.UID); err != nil {
+				resp.Results[i].Error = err.Error()
+			}
+		}
+	case storage.ScmFirmwarePhaseActivate:
+		// Activation applies to all modules on the host at once.
+		err = p.backend.ActivateFirmware()
+		for i, mod := range modules {
+			resp.Results[i].Module = *mod
+			if err != nil {
+				resp.Results[i].Error = err.Error()
+			}
 		}
+	default:
+		return nil, errors.Errorf("unsupported firmware update %s", req.Phase)
 	}
 
 	return resp, nil
 }
+
+func (p *Provider) verifyStagedFirmware(uid string) error {
+	fwInfo, err := p.backend.GetFirmwareStatus(uid)
+	if err != nil {
+		return err
+	}
+
+	if fwInfo == nil || fwInfo.StagedVersion == "" ||
+		fwInfo.UpdateStatus != storage.ScmUpdateStatusStaged {
+		status := storage.ScmUpdateStatusUnknown
+		if fwInfo != nil {
+			status = fwInfo.UpdateStatus
+		}
+		return errors.Errorf("no firmware staged (update status: %s)", status)
+	}
+
+	return nil
+}
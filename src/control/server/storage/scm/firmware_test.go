@@ -320,6 +320,112 @@ func TestProvider_UpdateFirmware(t *testing.T) {
 				},
 			},
 		},
+		"verify staged": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1"},
+				Phase:        storage.ScmFirmwarePhaseVerify,
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes: defaultModules,
+				GetFirmwareStatusRes: &storage.ScmFirmwareInfo{
+					ActiveVersion: "FWRev1",
+					StagedVersion: "FWRev2",
+					UpdateStatus:  storage.ScmUpdateStatusStaged,
+				},
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+					},
+				},
+			},
+		},
+		"verify not staged": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1"},
+				Phase:        storage.ScmFirmwarePhaseVerify,
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes: defaultModules,
+				GetFirmwareStatusRes: &storage.ScmFirmwareInfo{
+					ActiveVersion: "FWRev1",
+					UpdateStatus:  storage.ScmUpdateStatusFailed,
+				},
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+						Error:  "no firmware staged (update status: Failed)",
+					},
+				},
+			},
+		},
+		"verify query failed": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1"},
+				Phase:        storage.ScmFirmwarePhaseVerify,
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes:        defaultModules,
+				GetFirmwareStatusErr: testErr,
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+						Error:  testErr.Error(),
+					},
+				},
+			},
+		},
+		"activate": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1", "Device2"},
+				Phase:        storage.ScmFirmwarePhaseActivate,
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes: defaultModules,
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+					},
+					{
+						Module: *defaultModules[1],
+					},
+				},
+			},
+		},
+		"activate failed": {
+			input: storage.ScmFirmwareUpdateRequest{
+				FirmwarePath: testPath,
+				DeviceUIDs:   []string{"Device1", "Device2"},
+				Phase:        storage.ScmFirmwarePhaseActivate,
+			},
+			backendCfg: &MockBackendConfig{
+				GetModulesRes:       defaultModules,
+				ActivateFirmwareErr: testErr,
+			},
+			expRes: &storage.ScmFirmwareUpdateResponse{
+				Results: []storage.ScmFirmwareUpdateResult{
+					{
+						Module: *defaultModules[0],
+						Error:  testErr.Error(),
+					},
+					{
+						Module: *defaultModules[1],
+						Error:  testErr.Error(),
+					},
+				},
+			},
+		},
 		"request device subset": {
 			input: storage.ScmFirmwareUpdateRequest{
 				FirmwarePath: testPath,
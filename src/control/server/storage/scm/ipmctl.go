@@ -76,6 +76,25 @@ func (cr *cmdRunner) runCmd(cmd pmemCmd) (string, error) {
 	return cr.runInternal(cr.log, cmd)
 }
 
+// toolsInstalled returns true if both ipmctl and ndctl binaries can be found.
+func (cr *cmdRunner) toolsInstalled() bool {
+	for _, name := range []string{ipmctlName, ndctlName} {
+		if _, err := cr.lookPath(name); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (cr *cmdRunner) capabilities() *storage.ScmCapabilities {
+	return &storage.ScmCapabilities{
+		Backend:  storage.ScmBackendTools,
+		Prepare:  true,
+		Firmware: true,
+		Health:   true,
+	}
+}
+
 type semVer []string
 
 func (sv semVer) String() string {
@@ -118,14 +137,11 @@ func (cr *cmdRunner) checkIpmctl(badList []semVer) (errOut error) {
 	return
 }
 
-func (cr *cmdRunner) runSockAwareCmd(sockID int, cmd pmemCmd) (string, error) {
-	if cmd.BinaryName == ipmctlName {
-		if err := cr.checkIpmctl(badIpmctlVers); err != nil {
-			return "", errors.WithMessage(err, "checkIpmctl")
-		}
-	}
-
+// sockAwareCmd returns a copy of the command with the socket ID arg inserted if a socket is
+// selected.
+func sockAwareCmd(sockID int, cmd pmemCmd) pmemCmd {
 	cmdTmp := cmd
+	cmdTmp.Args = append([]string{}, cmd.Args...)
 
 	// Insert socket ID arg after -goal flag if present otherwise at end.
 	if sockID != sockAny {
@@ -149,7 +165,17 @@ func (cr *cmdRunner) runSockAwareCmd(sockID int, cmd pmemCmd) (string, error) {
 		}
 	}
 
-	return cr.runCmd(cmdTmp)
+	return cmdTmp
+}
+
+func (cr *cmdRunner) runSockAwareCmd(sockID int, cmd pmemCmd) (string, error) {
+	if cmd.BinaryName == ipmctlName {
+		if err := cr.checkIpmctl(badIpmctlVers); err != nil {
+			return "", errors.WithMessage(err, "checkIpmctl")
+		}
+	}
+
+	return cr.runCmd(sockAwareCmd(sockID, cmd))
 }
 
 func checkStateHasSock(sockState *storage.ScmSocketState, faultFunc func(uint) *fault.Fault) error {
@@ -190,13 +216,13 @@ func checkStateForErrors(sockState *storage.ScmSocketState) error {
 	return nil
 }
 
-func (cr *cmdRunner) handleFreeCapacity(sockSelector int, nrNsPerSock uint, regions Regions) (storage.ScmNamespaces, *storage.ScmSocketState, error) {
+func (cr *cmdRunner) handleFreeCapacity(sockSelector int, nrNsPerSock uint, mode storage.ScmNamespaceMode, regions Regions) (storage.ScmNamespaces, *storage.ScmSocketState, error) {
 	regionPerSocket, err := mapRegionsToSocket(regions)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "mapRegionsToSocket")
 	}
 
-	numaIDs, err := cr.createNamespaces(regionPerSocket, nrNsPerSock)
+	numaIDs, err := cr.createNamespaces(regionPerSocket, nrNsPerSock, mode)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "createNamespaces")
 	}
@@ -275,7 +301,8 @@ func (cr *cmdRunner) processActionableState(req storage.ScmPrepareRequest, state
 		// At least one region exists without a namespace so create block devices on those PMem regions
 		// with available capacity and populate response with namespace details.
 		cr.log.Info("Creating PMem namespaces...")
-		nss, sockState, err := cr.handleFreeCapacity(sockSelector, req.NrNamespacesPerSocket, regions)
+		nss, sockState, err := cr.handleFreeCapacity(sockSelector, req.NrNamespacesPerSocket,
+			req.NamespaceMode, regions)
 		if err != nil {
 			return nil, errors.Wrap(err, "handleFreeCapacity")
 		}
@@ -292,8 +319,9 @@ func (cr *cmdRunner) processActionableState(req storage.ScmPrepareRequest, state
 }
 
 // Verify state is as expected and that created namespaces' block device names match the socket ID
-// of the underlying PMem region and that the the expected number of namespaces exist per region.
-func verifyPMem(log logging.Logger, resp *storage.ScmPrepareResponse, regions Regions, nrNsPerSock uint) error {
+// of the underlying PMem region, that the the expected number of namespaces exist per region and
+// that namespaces are in the requested mode.
+func verifyPMem(log logging.Logger, resp *storage.ScmPrepareResponse, regions Regions, nrNsPerSock uint, mode storage.ScmNamespaceMode) error {
 	if resp == nil {
 		return errors.New("verifyPMem received nil ScmScanResponse")
 	}
@@ -335,6 +363,11 @@ func verifyPMem(log logging.Logger, resp *storage.ScmPrepareResponse, regions Re
 			return errors.Errorf("unexpected negative value in regex matches %v", matches)
 		}
 
+		if ns.Mode != "" && ns.Mode != mode {
+			return errors.Errorf("namespace %s is in %s mode, want %s: reset pmem and rerun prepare",
+				ns.Name, ns.Mode, mode)
+		}
+
 		log.Debugf("found namespace %d.%d on numa %d", maj, min, ns.NumaNode)
 
 		nsMajMinMap[maj] = append(nsMajMinMap[maj], min)
@@ -363,6 +396,113 @@ func verifyPMem(log logging.Logger, resp *storage.ScmPrepareResponse, regions Re
 	return nil
 }
 
+// previewPrep reports the goals and namespaces that prep would create for the given state without
+// issuing any commands that modify PMem configuration.
+func previewPrep(req storage.ScmPrepareRequest, sockState *storage.ScmSocketState, scanRes *storage.ScmScanResponse, regions Regions) (*storage.ScmPrepareResponse, error) {
+	sockSelector := sockAny
+	if req.SocketID != nil {
+		sockSelector = int(*req.SocketID)
+	}
+
+	resp := &storage.ScmPrepareResponse{
+		Namespaces: scanRes.Namespaces,
+		Socket: &storage.ScmSocketState{
+			State:    sockState.State,
+			SocketID: req.SocketID,
+		},
+		Preview: []*storage.ScmSocketPreview{},
+	}
+
+	switch sockState.State {
+	case storage.ScmNoRegions:
+		// Regions will be created from all module capacity on each selected socket.
+		goal := sockAwareCmd(sockSelector, cmdCreateRegions)
+		sockCap := make(map[uint]uint64)
+		for _, mod := range scanRes.Modules {
+			if sockSelector != sockAny && int(mod.SocketID) != sockSelector {
+				continue
+			}
+			sockCap[uint(mod.SocketID)] += mod.Capacity
+		}
+		for _, sid := range sortedSockIDs(sockCap) {
+			resp.Preview = append(resp.Preview, &storage.ScmSocketPreview{
+				SocketID: sid,
+				Goals:    []string{goal.String()},
+				Capacity: sockCap[sid],
+			})
+		}
+		resp.RebootRequired = true
+	case storage.ScmFreeCap, storage.ScmNoFreeCap:
+		// Namespaces will be created on regions with free capacity.
+		for _, region := range regions {
+			sp := &storage.ScmSocketPreview{
+				SocketID: uint(region.SocketID),
+				Capacity: uint64(region.Capacity),
+			}
+			if region.FreeCapacity > 0 {
+				nsSize := uint64(region.FreeCapacity) / uint64(req.NrNamespacesPerSocket)
+				if nsSize%alignmentBoundaryBytes != 0 {
+					return nil, errors.Errorf("socket %d: namespace size (%s) is not %s aligned",
+						region.SocketID, humanize.IBytes(nsSize),
+						humanize.IBytes(alignmentBoundaryBytes))
+				}
+				sp.CreateNamespaces = req.NrNamespacesPerSocket
+				sp.NamespaceSize = nsSize
+			}
+			resp.Preview = append(resp.Preview, sp)
+		}
+	default:
+		return nil, errors.Errorf("unhandled scm state %q (%d)", sockState.State,
+			sockState.State)
+	}
+
+	return resp, nil
+}
+
+// previewPrepReset reports the namespaces that prepReset would destroy and the goals that it
+// would create without issuing any commands that modify PMem configuration.
+func previewPrepReset(req storage.ScmPrepareRequest, resp *storage.ScmPrepareResponse, scanRes *storage.ScmScanResponse, regions Regions) (*storage.ScmPrepareResponse, error) {
+	sockSelector := sockAny
+	if req.SocketID != nil {
+		sockSelector = int(*req.SocketID)
+	}
+
+	resp.Preview = []*storage.ScmSocketPreview{}
+	if resp.Socket.State == storage.ScmNoRegions {
+		return resp, nil
+	}
+	resp.RebootRequired = true
+
+	goal := sockAwareCmd(sockSelector, cmdCreateRegions)
+	for _, region := range regions {
+		sp := &storage.ScmSocketPreview{
+			SocketID:         uint(region.SocketID),
+			Goals:            []string{goal.String()},
+			RemoveNamespaces: []string{},
+			Capacity:         uint64(region.Capacity),
+		}
+		// Assume 1:1 mapping of sockets to NUMA nodes as when creating namespaces.
+		for _, ns := range scanRes.Namespaces {
+			if ns.NumaNode == uint32(region.SocketID) {
+				sp.RemoveNamespaces = append(sp.RemoveNamespaces, ns.Name)
+			}
+		}
+		resp.Preview = append(resp.Preview, sp)
+	}
+
+	return resp, nil
+}
+
+func sortedSockIDs(sockCap map[uint]uint64) []uint {
+	ids := make([]uint, 0, len(sockCap))
+	for id := range sockCap {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
 // prep executes commands to configure PMem modules into AppDirect interleaved
 // regions (sets) hosting pmem block-device namespaces.
 //
@@ -376,6 +516,9 @@ func verifyPMem(log logging.Logger, resp *storage.ScmPrepareResponse, regions Re
 // * modules exist and no regions -> create all regions (needs reboot)
 // * regions exist and free capacity -> create all namespaces, return created
 // * regions exist but no free capacity -> no-op, return namespaces
+//
+// If a dry-run is requested, planned changes are reported after state has been established and
+// no goals or namespaces are modified.
 func (cr *cmdRunner) prep(req storage.ScmPrepareRequest, scanRes *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error) {
 	if scanRes == nil {
 		return nil, errors.New("nil scan response")
@@ -401,6 +544,14 @@ func (cr *cmdRunner) prep(req storage.ScmPrepareRequest, scanRes *storage.ScmSca
 		req.NrNamespacesPerSocket = minNrNssPerSocket
 	}
 
+	// Handle unspecified NamespaceMode in request.
+	if req.NamespaceMode == "" {
+		req.NamespaceMode = storage.ScmNsModeFsdax
+	}
+	if err := req.NamespaceMode.Validate(); err != nil {
+		return nil, err
+	}
+
 	cr.log.Info("Reading PMem configuration...")
 
 	regions, err := cr.getRegions(sockSelector)
@@ -421,6 +572,10 @@ func (cr *cmdRunner) prep(req storage.ScmPrepareRequest, scanRes *storage.ScmSca
 		return nil, errors.Wrap(err, "checkStateForErrors after getPMemState")
 	}
 
+	if req.DryRun {
+		return previewPrep(req, sockState, scanRes, regions)
+	}
+
 	// After initial validation, process actionable states.
 	resp, err := cr.processActionableState(req, sockState.State, scanRes.Namespaces, regions)
 	if err != nil {
@@ -429,7 +584,7 @@ func (cr *cmdRunner) prep(req storage.ScmPrepareRequest, scanRes *storage.ScmSca
 
 	cr.log.Info("Verifying that PMem is in a valid state...")
 
-	if err := verifyPMem(cr.log, resp, regions, req.NrNamespacesPerSocket); err != nil {
+	if err := verifyPMem(cr.log, resp, regions, req.NrNamespacesPerSocket, req.NamespaceMode); err != nil {
 		return nil, storage.FaultScmInvalidPMem(err.Error())
 	}
 
@@ -481,6 +636,10 @@ func (cr *cmdRunner) prepReset(req storage.ScmPrepareRequest, scanRes *storage.S
 
 	cr.log.Debugf("scm backend prep reset: req %+v, pmem state %+v", req, resp.Socket)
 
+	if req.DryRun {
+		return previewPrepReset(req, resp, scanRes, regions)
+	}
+
 	if err := cr.deleteGoals(sockSelector); err != nil {
 		return nil, errors.Wrapf(err, "deleteGoals")
 	}
@@ -9,6 +9,7 @@ package scm
 import (
 	"encoding/xml"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/dustin/go-humanize"
@@ -32,6 +33,7 @@ import (
 //   <ChannelID>0x0000</ChannelID>
 //   <ChannelPos>1</ChannelPos>
 //   <PartNumber>NMA1XXD512GQS</PartNumber>
+//   <SecurityState>Unlocked, Frozen</SecurityState>
 //  </Dimm>
 // </DimmList>
 
@@ -54,6 +56,7 @@ type (
 		ChannelID        hexShort    `xml:"ChannelID",json:"channel_id"`
 		ChannelPosition  uint32      `xml:"ChannelPos",json:"channel_pos"`
 		PartNumber       stringPlain `xml:"PartNumber",json:"part_number"`
+		SecurityState    stringPlain `xml:"SecurityState",json:"security_state"`
 	}
 )
 
@@ -110,7 +113,7 @@ const outNoPMemDIMMs = `No PMem modules in the system`
 var (
 	dimmFields = []string{
 		"DimmID", "ChannelID", "ChannelPos", "MemControllerID", "SocketID", "PhysicalID",
-		"Capacity", "DimmUID", "PartNumber", "FWVersion", "HealthState",
+		"Capacity", "DimmUID", "PartNumber", "FWVersion", "HealthState", "SecurityState",
 	}
 	cmdShowDIMMs = pmemCmd{
 		BinaryName: ipmctlName,
@@ -146,6 +149,125 @@ func (cr *cmdRunner) dimmInfoFromXML(sockID int) (DIMMs, error) {
 	return dl.DIMMs, nil
 }
 
+// <SensorList>
+//  <Dimm>
+//   <DimmID>0x0001</DimmID>
+//   <Sensor>
+//    <Type>MediaTemperature</Type>
+//    <CurrentValue>36C</CurrentValue>
+//   </Sensor>
+//   <Sensor>
+//    <Type>PercentageRemaining</Type>
+//    <CurrentValue>100%</CurrentValue>
+//   </Sensor>
+//  </Dimm>
+// </SensorList>
+
+const (
+	sensorMediaTemp        = "MediaTemperature"
+	sensorControllerTemp   = "ControllerTemperature"
+	sensorSpareCapacity    = "SpareCapacity"
+	sensorPercentRemaining = "PercentageRemaining"
+)
+
+type (
+	// DIMMSensor struct represents a single PMem DIMM sensor reading.
+	DIMMSensor struct {
+		Type         string `xml:"Type"`
+		CurrentValue string `xml:"CurrentValue"`
+	}
+
+	// DIMMSensors struct contains the sensor readings for a single PMem DIMM.
+	DIMMSensors struct {
+		ID      hexShort     `xml:"DimmID"`
+		Sensors []DIMMSensor `xml:"Sensor"`
+	}
+
+	// DIMMSensorList struct contains sensor readings for all the PMem DIMMs.
+	DIMMSensorList struct {
+		XMLName xml.Name      `xml:"SensorList"`
+		DIMMs   []DIMMSensors `xml:"Dimm"`
+	}
+)
+
+var cmdShowDIMMSensors = pmemCmd{
+	BinaryName: ipmctlName,
+	Args:       []string{"show", "-o nvmxml", "-sensor", "-dimm"},
+}
+
+// parseSensorValue returns the leading integer of a sensor reading e.g. "36C" or "100%".
+func parseSensorValue(val string) (uint32, error) {
+	digits := strings.TrimRightFunc(strings.TrimSpace(val), func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+
+	n, err := strconv.ParseUint(digits, 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "sensor value %q could not be parsed", val)
+	}
+
+	return uint32(n), nil
+}
+
+// setModuleHealth updates module with health statistics from sensor readings.
+func setModuleHealth(module *storage.ScmModule, sensors []DIMMSensor) error {
+	for _, sensor := range sensors {
+		var field *uint32
+		switch sensor.Type {
+		case sensorMediaTemp:
+			field = &module.MediaTemperature
+		case sensorControllerTemp:
+			field = &module.ControllerTemperature
+		case sensorSpareCapacity:
+			field = &module.SparePercentage
+		case sensorPercentRemaining:
+			field = &module.LifespanRemaining
+		default:
+			continue
+		}
+
+		val, err := parseSensorValue(sensor.CurrentValue)
+		if err != nil {
+			return errors.Wrap(err, sensor.Type)
+		}
+		*field = val
+	}
+
+	return nil
+}
+
+// addModuleHealth uses XML output from `ipmctl show -o nvmxml -sensor -dimm` to populate health
+// statistics of the given PMem modules. Health statistics are informational so failure to
+// retrieve them is logged rather than returned.
+func (cr *cmdRunner) addModuleHealth(sockID int, dimms DIMMs, modules storage.ScmModules) {
+	out, err := cr.runSockAwareCmd(sockID, cmdShowDIMMSensors)
+	if err != nil {
+		cr.log.Noticef("pmem module health could not be retrieved: %s", err)
+		return
+	}
+
+	var sl DIMMSensorList
+	if err := xml.Unmarshal([]byte(out), &sl); err != nil {
+		cr.log.Noticef("parse show sensor cmd output: %s", err)
+		return
+	}
+
+	sensorsByID := make(map[hexShort][]DIMMSensor)
+	for _, ds := range sl.DIMMs {
+		sensorsByID[ds.ID] = ds.Sensors
+	}
+
+	for i, dimm := range dimms {
+		sensors, found := sensorsByID[dimm.ID]
+		if !found {
+			continue
+		}
+		if err := setModuleHealth(modules[i], sensors); err != nil {
+			cr.log.Noticef("pmem module %s health: %s", dimm.UID, err)
+		}
+	}
+}
+
 // getModules scans the storage host for PMem modules and returns a slice.
 func (cr *cmdRunner) getModules(sockID int) (storage.ScmModules, error) {
 	dimms, err := cr.dimmInfoFromXML(sockID)
@@ -163,7 +285,34 @@ func (cr *cmdRunner) getModules(sockID int) (storage.ScmModules, error) {
 	if err := convert.Types(dimms, &modules); err != nil {
 		return nil, err
 	}
+	if len(modules) != 0 {
+		cr.addModuleHealth(sockID, dimms, modules)
+	}
 	cr.log.Tracef("discovered pmem modules details: %+v", modules)
 
 	return modules, nil
 }
+
+// unlockModules calls ipmctl to unlock the given PMem modules with the passphrase read from the
+// supplied file which should be in the ipmctl source format e.g. "#ascii\npassphrase=secret".
+func (cr *cmdRunner) unlockModules(passphraseFile string, uids []string) error {
+	if err := cr.checkIpmctl(badIpmctlVers); err != nil {
+		return errors.WithMessage(err, "checkIpmctl")
+	}
+
+	cmd := pmemCmd{
+		BinaryName: ipmctlName,
+		Args: []string{
+			"set", "-source", passphraseFile, "-dimm", strings.Join(uids, ","),
+			"LockState=Unlocked", "Passphrase=",
+		},
+	}
+
+	cr.log.Debugf("unlocking pmem modules %v", uids)
+
+	if _, err := cr.runCmd(cmd); err != nil {
+		return errors.Wrap(err, "failed to unlock modules")
+	}
+
+	return nil
+}
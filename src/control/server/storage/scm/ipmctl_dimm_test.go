@@ -9,6 +9,7 @@ package scm
 import (
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -89,8 +90,53 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
    <PartNumber>NMA1XXD512GQS</PartNumber>
   </Dimm>
 ` + sockOneOut + `</DimmList>`,
+			cmdShowDIMMSensors.String(): `
+<?xml version="1.0"?>
+ <SensorList>
+  <Dimm>
+   <DimmID>0x0001</DimmID>
+   <Sensor>
+    <Type>Health</Type>
+    <CurrentValue>Healthy</CurrentValue>
+   </Sensor>
+   <Sensor>
+    <Type>MediaTemperature</Type>
+    <CurrentValue>36C</CurrentValue>
+   </Sensor>
+   <Sensor>
+    <Type>ControllerTemperature</Type>
+    <CurrentValue>41C</CurrentValue>
+   </Sensor>
+   <Sensor>
+    <Type>PercentageRemaining</Type>
+    <CurrentValue>98%</CurrentValue>
+   </Sensor>
+  </Dimm>
+  <Dimm>
+   <DimmID>0x0101</DimmID>
+   <Sensor>
+    <Type>SpareCapacity</Type>
+    <CurrentValue>100%</CurrentValue>
+   </Sensor>
+   <Sensor>
+    <Type>MediaTemperature</Type>
+    <CurrentValue>N/A</CurrentValue>
+   </Sensor>
+  </Dimm>
+ </SensorList>`,
 		}
 	}
+	withHealth := func(m *storage.ScmModule, mediaTemp, ctrlrTemp, spare, life uint32) *storage.ScmModule {
+		m.MediaTemperature = mediaTemp
+		m.ControllerTemperature = ctrlrTemp
+		m.SparePercentage = spare
+		m.LifespanRemaining = life
+		return m
+	}
+	withSecurity := func(m *storage.ScmModule, state storage.ScmSecurityState) *storage.ScmModule {
+		m.SecurityState = state
+		return m
+	}
 	one := 1
 
 	for name, tc := range map[string]struct {
@@ -120,7 +166,7 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 				}, cmdShowDIMMs.String())
 				return em
 			}(),
-			expErr: errors.Errorf("ipmctl show -o nvmxml -d DimmID,ChannelID,ChannelPos,MemControllerID,SocketID,PhysicalID,Capacity,DimmUID,PartNumber,FWVersion,HealthState -dimm: exit status 0: stdout: Sorry, the %s; stderr: ", outNoCLIPerms),
+			expErr: errors.Errorf("ipmctl show -o nvmxml -d DimmID,ChannelID,ChannelPos,MemControllerID,SocketID,PhysicalID,Capacity,DimmUID,PartNumber,FWVersion,HealthState,SecurityState -dimm: exit status 0: stdout: Sorry, the %s; stderr: ", outNoCLIPerms),
 		},
 		"ipmctl version command fails": {
 			cmdErrorMap: func() ErrorMap {
@@ -140,7 +186,7 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 				}, cmdShowDIMMs.String())
 				return em
 			}(),
-			expErr: errors.New("ipmctl show -o nvmxml -d DimmID,ChannelID,ChannelPos,MemControllerID,SocketID,PhysicalID,Capacity,DimmUID,PartNumber,FWVersion,HealthState -dimm: exit status 0: stdout: ; stderr: "),
+			expErr: errors.New("ipmctl show -o nvmxml -d DimmID,ChannelID,ChannelPos,MemControllerID,SocketID,PhysicalID,Capacity,DimmUID,PartNumber,FWVersion,HealthState,SecurityState -dimm: exit status 0: stdout: ; stderr: "),
 		},
 		"no modules": {
 			cmdErrorMap: func() ErrorMap {
@@ -163,6 +209,28 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 			expCalls: CallMap{
 				cmdShowIpmctlVersion.String(): 1,
 				cmdShowDIMMs.String():         1,
+				cmdShowDIMMSensors.String():   1,
+			},
+			expModules: storage.ScmModules{
+				withHealth(mockModule("8089-a2-1839-000010ce", 0x1e, 0x0, 0x0, 0x0, 1),
+					36, 41, 0, 98),
+				// Unparsable media temperature reading is skipped.
+				withHealth(mockModule("8089-a2-1839-000010e7", 0x24, 0x0, 0x1, 0x0, 1),
+					0, 0, 100, 0),
+				mockModule("8089-a2-1839-00001105", 0x2a, 0x1, 0x0, 0x0, 1),
+				mockModule("8089-a2-1839-00001112", 0x30, 0x1, 0x1, 0x0, 1),
+			},
+		},
+		"multiple modules per socket; show sensors command fails": {
+			cmdErrorMap: func() ErrorMap {
+				em := genCmdErrorMap()
+				em[cmdShowDIMMSensors.String()] = errors.New("fail sensors")
+				return em
+			}(),
+			expCalls: CallMap{
+				cmdShowIpmctlVersion.String(): 1,
+				cmdShowDIMMs.String():         1,
+				cmdShowDIMMSensors.String():   1,
 			},
 			expModules: storage.ScmModules{
 				mockModule("8089-a2-1839-000010ce", 0x1e, 0x0, 0x0, 0x0, 1),
@@ -171,6 +239,24 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 				mockModule("8089-a2-1839-00001112", 0x30, 0x1, 0x1, 0x0, 1),
 			},
 		},
+		"security state reported": {
+			cmdOutputMap: func() OutputMap {
+				om := genCmdOutputMap()
+				om[cmdShowDIMMs.String()] = strings.Replace(sockOneOutList,
+					"</PartNumber>", "</PartNumber>\n   <SecurityState>Locked</SecurityState>", 1)
+				return om
+			}(),
+			expCalls: CallMap{
+				cmdShowIpmctlVersion.String(): 1,
+				cmdShowDIMMs.String():         1,
+				cmdShowDIMMSensors.String():   1,
+			},
+			expModules: storage.ScmModules{
+				withSecurity(mockModule("8089-a2-1839-00001105", 0x2a, 0x1, 0x0, 0x0, 1),
+					storage.ScmSecurityLocked),
+				mockModule("8089-a2-1839-00001112", 0x30, 0x1, 0x1, 0x0, 1),
+			},
+		},
 		"multiple modules per socket; sock one selected": {
 			sockSelector: &one,
 			cmdOutputMap: func() OutputMap {
@@ -179,8 +265,9 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 				return om
 			}(),
 			expCalls: CallMap{
-				cmdShowIpmctlVersion.String():        1,
-				cmdShowDIMMs.String() + " -socket 1": 1,
+				cmdShowIpmctlVersion.String():              1,
+				cmdShowDIMMs.String() + " -socket 1":       1,
+				cmdShowDIMMSensors.String() + " -socket 1": 1,
 			},
 			expModules: storage.ScmModules{
 				mockModule("8089-a2-1839-00001105", 0x2a, 0x1, 0x0, 0x0, 1),
@@ -232,3 +319,52 @@ Intel(R) Optane(TM) Persistent Memory Command Line Interface Version 03.00.00.04
 		})
 	}
 }
+
+func TestIpmctl_unlockModules(t *testing.T) {
+	for name, tc := range map[string]struct {
+		runErr  error
+		expCmds []string
+		expErr  error
+	}{
+		"success": {
+			expCmds: []string{
+				"ipmctl version",
+				"ipmctl set -source /etc/daos/pmem.key -dimm uid0,uid1 LockState=Unlocked Passphrase=",
+			},
+		},
+		"failure": {
+			runErr: errors.New("invalid passphrase"),
+			expErr: errors.New("failed to unlock modules"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotCmds []string
+			mockRun := func(_ logging.Logger, cmd pmemCmd) (string, error) {
+				gotCmds = append(gotCmds, cmd.String())
+				if cmd.String() == cmdShowIpmctlVersion.String() {
+					return "Intel(R) Optane(TM) Persistent Memory Command Line Interface " +
+						"Version 03.00.00.0468", nil
+				}
+				return "", tc.runErr
+			}
+
+			cr, err := newCmdRunner(log, mockRun, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotErr := cr.unlockModules("/etc/daos/pmem.key", []string{"uid0", "uid1"})
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCmds, gotCmds); diff != "" {
+				t.Fatalf("unexpected commands (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -59,6 +59,7 @@ func mockCmdCreateNamespace(regionID int, bytes int) pmemCmd {
 			"create-namespace", "--region",
 			fmt.Sprintf("region%d", regionID),
 			"--size", fmt.Sprintf("%d", bytes),
+			"--mode", "fsdax", "--align", "2097152",
 		},
 	}
 }
@@ -119,6 +120,7 @@ func TestIpmctl_prep(t *testing.T) {
 			genNsJSON(t, "1", "0", "1506GiB", "30"), genNsJSON(t, "1", "1", "1506GiB", "31"))
 		dualNS        = getNsFromJSON(t, ndctlDualNsStr)
 		dualNSPerSock = getNsFromJSON(t, ndctlDualNsPerSockStr)
+		sock1Goal     = mockCmdCreateRegionsWithSock(1)
 	)
 
 	for name, tc := range map[string]struct {
@@ -195,6 +197,92 @@ func TestIpmctl_prep(t *testing.T) {
 				cmdCreateRegions,
 			},
 		},
+		"dry run; no regions": {
+			prepReq: &storage.ScmPrepareRequest{
+				DryRun: true,
+			},
+			runOut: []string{
+				verStr, outNoPMemRegions,
+			},
+			expPrepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoRegions,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{
+						SocketID: 0,
+						Goals:    []string{cmdCreateRegions.String()},
+						Capacity: testModules[0].Capacity + testModules[1].Capacity,
+					},
+					{
+						SocketID: 1,
+						Goals:    []string{cmdCreateRegions.String()},
+						Capacity: testModules[2].Capacity + testModules[3].Capacity,
+					},
+				},
+			},
+			expCalls: []pmemCmd{
+				cmdShowIpmctlVersion, cmdShowRegions,
+			},
+		},
+		"dry run; no regions; sock selected": {
+			prepReq: &storage.ScmPrepareRequest{
+				DryRun:   true,
+				SocketID: &sock1,
+			},
+			runOut: []string{
+				verStr, outNoPMemRegions,
+			},
+			expPrepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State:    storage.ScmNoRegions,
+					SocketID: &sock1,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{
+						SocketID: 1,
+						Goals:    []string{sock1Goal.String()},
+						Capacity: testModules[2].Capacity + testModules[3].Capacity,
+					},
+				},
+			},
+			expCalls: []pmemCmd{
+				cmdShowIpmctlVersion, mockCmdShowRegionsWithSock(1),
+			},
+		},
+		"dry run; free capacity": {
+			prepReq: &storage.ScmPrepareRequest{
+				DryRun:                true,
+				NrNamespacesPerSocket: 2,
+			},
+			runOut: []string{
+				verStr, mockXMLRegions(t, "dual-sock-full-free"),
+			},
+			expPrepResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmFreeCap,
+				},
+				Preview: []*storage.ScmSocketPreview{
+					{
+						SocketID:         0,
+						CreateNamespaces: 2,
+						NamespaceSize:    541165879296,
+						Capacity:         1082331758592,
+					},
+					{
+						SocketID:         1,
+						CreateNamespaces: 2,
+						NamespaceSize:    541165879296,
+						Capacity:         1082331758592,
+					},
+				},
+			},
+			expCalls: []pmemCmd{
+				cmdShowIpmctlVersion, cmdShowRegions,
+			},
+		},
 		"no regions; sock selected": {
 			prepReq: &storage.ScmPrepareRequest{
 				SocketID: &sock1,
@@ -257,6 +345,28 @@ func TestIpmctl_prep(t *testing.T) {
 				cmdShowIpmctlVersion, cmdShowRegions, cmdDeleteGoals,
 			},
 		},
+		"invalid namespace mode": {
+			prepReq: &storage.ScmPrepareRequest{
+				NamespaceMode: "sector",
+			},
+			expErr: errors.New("unsupported pmem namespace mode"),
+		},
+		"no free capacity; namespace mode mismatch": {
+			prepReq: &storage.ScmPrepareRequest{
+				NamespaceMode: storage.ScmNsModeDevdax,
+			},
+			scanResp: &storage.ScmScanResponse{
+				Modules:    testModules,
+				Namespaces: dualNS,
+			},
+			runOut: []string{
+				verStr, mockXMLRegions(t, "dual-sock"), "",
+			},
+			expCalls: []pmemCmd{
+				cmdShowIpmctlVersion, cmdShowRegions, cmdDeleteGoals,
+			},
+			expErr: errors.New("namespace namespace0.0 is in fsdax mode, want devdax"),
+		},
 		"no free capacity; multiple namespaces per socket": {
 			prepReq: &storage.ScmPrepareRequest{
 				NrNamespacesPerSocket: 2,
@@ -805,6 +915,43 @@ func TestIpmctl_prepReset(t *testing.T) {
 				cmdCreateRegions,
 			},
 		},
+		"dry run; remove regions; with namespaces": {
+			prepReq: &storage.ScmPrepareRequest{
+				Reset:  true,
+				DryRun: true,
+			},
+			scanResp: &storage.ScmScanResponse{
+				Modules:    testModules,
+				Namespaces: dualNS,
+			},
+			runOut: []string{
+				verStr, mockXMLRegions(t, "dual-sock"),
+			},
+			expPrepResp: &storage.ScmPrepareResponse{
+				Namespaces: storage.ScmNamespaces{},
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoFreeCap,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{
+						SocketID:         0,
+						Goals:            []string{cmdCreateRegions.String()},
+						RemoveNamespaces: []string{"namespace0.0"},
+						Capacity:         1082331758592,
+					},
+					{
+						SocketID:         1,
+						Goals:            []string{cmdCreateRegions.String()},
+						RemoveNamespaces: []string{"namespace1.0"},
+						Capacity:         1082331758592,
+					},
+				},
+			},
+			expCalls: []pmemCmd{
+				cmdShowIpmctlVersion, cmdShowRegions,
+			},
+		},
 		"remove regions; with namespaces": {
 			scanResp: &storage.ScmScanResponse{
 				Modules:    testModules,
@@ -402,6 +402,9 @@ type MockBackendConfig struct {
 	GetFirmwareStatusErr error
 	GetFirmwareStatusRes *storage.ScmFirmwareInfo
 	UpdateFirmwareErr    error
+	ActivateFirmwareErr  error
+	UnlockModulesErr     error
+	Capabilities         *storage.ScmCapabilities
 }
 
 type MockBackend struct {
@@ -411,6 +414,7 @@ type MockBackend struct {
 	ResetCalls         []storage.ScmPrepareRequest
 	GetModulesCalls    []int
 	GetNamespacesCalls []int
+	UnlockCalls        [][]string
 }
 
 func (mb *MockBackend) getModules(sockID int) (storage.ScmModules, error) {
@@ -465,6 +469,22 @@ func (mb *MockBackend) UpdateFirmware(deviceUID string, firmwarePath string) err
 	return mb.cfg.UpdateFirmwareErr
 }
 
+func (mb *MockBackend) ActivateFirmware() error {
+	return mb.cfg.ActivateFirmwareErr
+}
+
+func (mb *MockBackend) unlockModules(_ string, uids []string) error {
+	mb.Lock()
+	mb.UnlockCalls = append(mb.UnlockCalls, uids)
+	mb.Unlock()
+
+	return mb.cfg.UnlockModulesErr
+}
+
+func (mb *MockBackend) capabilities() *storage.ScmCapabilities {
+	return mb.cfg.Capabilities
+}
+
 func NewMockBackend(cfg *MockBackendConfig) *MockBackend {
 	if cfg == nil {
 		cfg = &MockBackendConfig{}
@@ -53,6 +53,11 @@ var (
 		BinaryName: ndctlName,
 		Args:       []string{"list", "-R", "-v"},
 	}
+	// activates staged firmware on all modules of all buses
+	cmdActivateFirmware = pmemCmd{
+		BinaryName: ndctlName,
+		Args:       []string{"activate-firmware", "all"},
+	}
 )
 
 func (cr *cmdRunner) checkNdctl() (errOut error) {
@@ -65,9 +70,12 @@ func (cr *cmdRunner) checkNdctl() (errOut error) {
 	return
 }
 
-// For each region, create <nrNsPerSocket> namespaces. Return slice indicating which NUMA nodes the
-// name spaces were created on.
-func (cr *cmdRunner) createNamespaces(regionPerSocket socketRegionMap, nrNsPerSock uint) ([]int, error) {
+// For each region, create <nrNsPerSocket> namespaces in the requested mode. Return slice indicating
+// which NUMA nodes the name spaces were created on.
+func (cr *cmdRunner) createNamespaces(regionPerSocket socketRegionMap, nrNsPerSock uint, mode storage.ScmNamespaceMode) ([]int, error) {
+	if err := mode.Validate(); err != nil {
+		return nil, err
+	}
 	if nrNsPerSock < minNrNssPerSocket || nrNsPerSock > maxNrNssPerSocket {
 		return nil, errors.Errorf("unexpected number of namespaces requested per socket: want [%d-%d], got %d",
 			minNrNssPerSocket, maxNrNssPerSocket, nrNsPerSock)
@@ -137,8 +145,8 @@ func (cr *cmdRunner) createNamespaces(regionPerSocket socketRegionMap, nrNsPerSo
 		}
 	}
 
-	cr.log.Debugf("attempting to create %d namespaces on each of the following socket(s): %v",
-		nrNsPerSock, sockIDs)
+	cr.log.Debugf("attempting to create %d %s namespaces on each of the following socket(s): %v",
+		nrNsPerSock, mode, sockIDs)
 
 	var numaNodesPrepped []int
 	for _, region := range regionsToPrep {
@@ -156,15 +164,18 @@ func (cr *cmdRunner) createNamespaces(regionPerSocket socketRegionMap, nrNsPerSo
 				humanize.IBytes(alignmentBoundaryBytes))
 		}
 
-		// Create specified number of namespaces on a single region (NUMA node).
+		// Create specified number of namespaces on a single region (NUMA node). Mode and
+		// alignment are set explicitly rather than relying on ndctl defaults so that fsdax
+		// namespaces can back a DAX mount using 2MiB pages.
 		for j := uint(0); j < nrNsPerSock; j++ {
 			cmd := cmdCreateNamespace
 			cmd.Args = append(cmd.Args, "--region", region.Dev, "--size",
-				fmt.Sprintf("%d", pmemBytes))
+				fmt.Sprintf("%d", pmemBytes), "--mode", mode.String(), "--align",
+				fmt.Sprintf("%d", alignmentBoundaryBytes))
 			if _, err := cr.runCmd(cmd); err != nil {
 				return nil, errors.WithMessagef(err, "%s", region.Dev)
 			}
-			cr.log.Debugf("created namespace on %s size %s (numa %d)", region.Dev,
+			cr.log.Debugf("created %s namespace on %s size %s (numa %d)", mode, region.Dev,
 				humanize.IBytes(pmemBytes), region.NumaNode)
 		}
 
@@ -193,6 +204,21 @@ func (cr *cmdRunner) removeNamespace(devName string) error {
 	return err
 }
 
+// ActivateFirmware calls ndctl to activate staged firmware on all PMem modules without a reboot.
+func (cr *cmdRunner) ActivateFirmware() error {
+	if err := cr.checkNdctl(); err != nil {
+		return err
+	}
+
+	cr.log.Debug("activating staged pmem firmware")
+
+	if _, err := cr.runCmd(cmdActivateFirmware); err != nil {
+		return errors.Wrap(err, "failed to activate firmware")
+	}
+
+	return nil
+}
+
 func parseNamespaces(jsonData string) (storage.ScmNamespaces, error) {
 	nss := storage.ScmNamespaces{}
 
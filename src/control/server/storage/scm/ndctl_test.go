@@ -51,6 +51,7 @@ func TestNdctl_parseNamespaces(t *testing.T) {
 					BlockDevice: "pmem0",
 					NumaNode:    0,
 					Size:        3183575302144,
+					Mode:        storage.ScmNsModeFsdax,
 					UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1528",
 				},
 			},
@@ -65,6 +66,7 @@ func TestNdctl_parseNamespaces(t *testing.T) {
 					BlockDevice: "pmem0",
 					NumaNode:    0,
 					Size:        3183575302144,
+					Mode:        storage.ScmNsModeFsdax,
 					UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1528",
 				},
 				{
@@ -72,6 +74,7 @@ func TestNdctl_parseNamespaces(t *testing.T) {
 					BlockDevice: "pmem1",
 					NumaNode:    1,
 					Size:        3183575302144,
+					Mode:        storage.ScmNsModeFsdax,
 					UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1528",
 				},
 			},
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/sys/unix"
 
+	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/provider/system"
 	"github.com/daos-stack/daos/src/control/server/storage"
@@ -42,6 +43,9 @@ type (
 		prepReset(storage.ScmPrepareRequest, *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error)
 		GetFirmwareStatus(deviceUID string) (*storage.ScmFirmwareInfo, error)
 		UpdateFirmware(deviceUID string, firmwarePath string) error
+		ActivateFirmware() error
+		unlockModules(passphraseFile string, uids []string) error
+		capabilities() *storage.ScmCapabilities
 	}
 
 	// SystemProvider provides operating system capabilities.
@@ -49,6 +53,7 @@ type (
 		Chown(string, int, int) error
 		Getfs(string) (string, error)
 		Mkfs(system.MkfsReq) error
+		Fsck(system.FsckReq) (bool, error)
 	}
 
 	// Provider encapsulates configuration and logic for
@@ -91,7 +96,19 @@ func validateFormatRequest(r storage.ScmFormatRequest) error {
 
 // DefaultProvider returns an initialized *Provider suitable for use with production code.
 func DefaultProvider(log logging.Logger) *Provider {
-	return NewProvider(log, defaultCmdRunner(log), system.DefaultProvider(), mount.DefaultProvider(log))
+	return NewProvider(log, defaultBackend(log), system.DefaultProvider(), mount.DefaultProvider(log))
+}
+
+// defaultBackend returns the commandline tool backend if ipmctl and ndctl are installed, otherwise
+// a sysfs backend that supports discovery only.
+func defaultBackend(log logging.Logger) Backend {
+	cr := defaultCmdRunner(log)
+	if cr.toolsInstalled() {
+		return cr
+	}
+
+	log.Debugf("%s or %s not found, using sysfs for pmem discovery", ipmctlName, ndctlName)
+	return newSysfsBackend(log, defaultSysfsRoot)
 }
 
 // NewProvider returns an initialized *Provider.
@@ -116,8 +133,9 @@ func (p *Provider) Scan(req storage.ScmScanRequest) (resp *storage.ScmScanRespon
 	}()
 
 	resp = &storage.ScmScanResponse{
-		Modules:    storage.ScmModules{},
-		Namespaces: storage.ScmNamespaces{},
+		Modules:      storage.ScmModules{},
+		Namespaces:   storage.ScmNamespaces{},
+		Capabilities: p.backend.capabilities(),
 	}
 
 	// If socket ID set in request, only scan devices attached to that socket.
@@ -175,8 +193,8 @@ func (p *Provider) prepare(req storage.ScmPrepareRequest, scan scanFn) (*storage
 	}
 
 	if req.Reset {
-		// Unmount PMem namespaces before removing them.
-		if len(scanResp.Namespaces) > 0 {
+		// Unmount PMem namespaces before removing them, unless only previewing changes.
+		if len(scanResp.Namespaces) > 0 && !req.DryRun {
 			for _, ns := range scanResp.Namespaces {
 				nsDev := "/dev/" + ns.BlockDevice
 				isMounted, err := p.mounter.IsMounted(nsDev)
@@ -211,6 +229,56 @@ func (p *Provider) Prepare(req storage.ScmPrepareRequest) (*storage.ScmPrepareRe
 	return p.prepare(req, p.Scan)
 }
 
+// Unlock attempts to unlock passphrase-protected PMem modules so that they can be used. Modules
+// that are not locked are skipped and frozen modules or those that have reached the passphrase
+// retry limit are reported as errors as they cannot be unlocked until the next reboot.
+func (p *Provider) Unlock(req storage.ScmUnlockRequest) (*storage.ScmUnlockResponse, error) {
+	if req.PassphraseFile == "" {
+		return nil, FaultUnlockMissingPassphrase
+	}
+
+	modules, err := p.backend.getModules(sockAny)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := common.NewStringSet(req.DeviceUIDs...)
+	var toUnlock storage.ScmModules
+	for _, module := range modules.Locked() {
+		if len(wanted) != 0 && !wanted.Has(module.UID) {
+			continue
+		}
+		if module.SecurityState.IsFrozen() || module.SecurityState.IsExceeded() {
+			return nil, FaultUnlockNotPossible(module.UID, module.SecurityState)
+		}
+		toUnlock = append(toUnlock, module)
+	}
+
+	resp := &storage.ScmUnlockResponse{
+		Modules: storage.ScmModules{},
+	}
+	if len(toUnlock) == 0 {
+		p.log.Debug("no locked pmem modules to unlock")
+		return resp, nil
+	}
+
+	uids := make([]string, 0, len(toUnlock))
+	for _, module := range toUnlock {
+		uids = append(uids, module.UID)
+	}
+	if err := p.backend.unlockModules(req.PassphraseFile, uids); err != nil {
+		return nil, err
+	}
+
+	for _, module := range toUnlock {
+		unlocked := *module
+		unlocked.SecurityState = storage.ScmSecurityUnlocked
+		resp.Modules = append(resp.Modules, &unlocked)
+	}
+
+	return resp, nil
+}
+
 // CheckFormat attempts to determine whether or not the SCM specified in the
 // request is already formatted. If it is mounted, it is assumed to be formatted.
 // In the case of DCPM, the device is checked directly for the presence of a
@@ -469,6 +537,9 @@ func (p *Provider) mountRamdisk(target string, params *storage.RamdiskParams) (*
 func (p *Provider) Mount(req storage.ScmMountRequest) (*storage.MountResponse, error) {
 	switch req.Class {
 	case storage.ClassDcpm:
+		if req.Restore {
+			return p.restoreDcpm(req)
+		}
 		return p.mountDcpm(req.Device, req.Target)
 	case storage.ClassRam:
 		return p.mountRamdisk(req.Target, req.Ramdisk)
@@ -477,6 +548,48 @@ func (p *Provider) Mount(req storage.ScmMountRequest) (*storage.MountResponse, e
 	}
 }
 
+// restoreDcpm mounts a formatted PMem namespace after recreating a missing mount point and
+// optionally checking the filesystem. Actions taken are recorded in the response.
+func (p *Provider) restoreDcpm(req storage.ScmMountRequest) (*storage.MountResponse, error) {
+	var repairs []string
+
+	isMounted, err := p.mounter.IsMounted(req.Target)
+	switch {
+	case os.IsNotExist(errors.Cause(err)):
+		if err := p.mounter.MakeMountPath(req.Target, req.OwnerUID, req.OwnerGID); err != nil {
+			return nil, errors.Wrap(err, "failed to create mount path")
+		}
+		repairs = append(repairs, fmt.Sprintf("created missing mount point %s", req.Target))
+	case err != nil:
+		return nil, err
+	case isMounted:
+		return &storage.MountResponse{Target: req.Target, Mounted: true}, nil
+	}
+
+	if req.Fsck {
+		p.log.Debugf("checking filesystem on %s", req.Device)
+		repaired, err := p.sys.Fsck(system.FsckReq{
+			Device:     req.Device,
+			Filesystem: dcpmFsType,
+		})
+		if err != nil {
+			return nil, FaultFsckFailed(req.Device, err)
+		}
+		if repaired {
+			repairs = append(repairs, fmt.Sprintf("repaired filesystem errors on %s",
+				req.Device))
+		}
+	}
+
+	res, err := p.mountDcpm(req.Device, req.Target)
+	if err != nil {
+		return nil, err
+	}
+	res.Repairs = repairs
+
+	return res, nil
+}
+
 // Unmount attempts to unmount the target specified in the supplied request.
 func (p *Provider) Unmount(req storage.ScmMountRequest) (*storage.MountResponse, error) {
 	return p.mounter.Unmount(storage.MountRequest{
@@ -122,6 +122,7 @@ func TestProvider_Prepare(t *testing.T) {
 
 	for name, tc := range map[string]struct {
 		reset    bool
+		dryRun   bool
 		mbc      *MockBackendConfig
 		scanErr  error
 		scanResp *storage.ScmScanResponse
@@ -217,6 +218,36 @@ func TestProvider_Prepare(t *testing.T) {
 				RebootRequired: true,
 			},
 		},
+		"reset; with namespaces; dry run": {
+			reset:  true,
+			dryRun: true,
+			scanResp: &storage.ScmScanResponse{
+				Modules: storage.ScmModules{defaultModule},
+				Namespaces: storage.ScmNamespaces{
+					defaultNamespace,
+				},
+			},
+			mbc: &MockBackendConfig{
+				PrepResetRes: &storage.ScmPrepareResponse{
+					Socket: &storage.ScmSocketState{
+						State: storage.ScmNoFreeCap,
+					},
+					RebootRequired: true,
+					Preview: []*storage.ScmSocketPreview{
+						{RemoveNamespaces: []string{defaultNamespace.Name}},
+					},
+				},
+			},
+			expResp: &storage.ScmPrepareResponse{
+				Socket: &storage.ScmSocketState{
+					State: storage.ScmNoFreeCap,
+				},
+				RebootRequired: true,
+				Preview: []*storage.ScmSocketPreview{
+					{RemoveNamespaces: []string{defaultNamespace.Name}},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
@@ -237,7 +268,8 @@ func TestProvider_Prepare(t *testing.T) {
 			}
 
 			res, err := p.prepare(storage.ScmPrepareRequest{
-				Reset: tc.reset,
+				Reset:  tc.reset,
+				DryRun: tc.dryRun,
 			}, mockScan)
 
 			test.CmpErr(t, tc.expErr, err)
@@ -247,15 +279,16 @@ func TestProvider_Prepare(t *testing.T) {
 
 			cmpRes(t, tc.expResp, res)
 
-			// Verify namespaces get unmounted on reset.
+			// Verify namespaces get unmounted on reset but not on dry-run.
+			expMounted := !tc.reset || tc.dryRun
 			for _, ns := range tc.scanResp.Namespaces {
 				isMounted, err := p.mounter.IsMounted("/dev/" + ns.BlockDevice)
 				if err != nil {
 					t.Fatal(err)
 				}
-				test.AssertEqual(t, !tc.reset, isMounted,
+				test.AssertEqual(t, expMounted, isMounted,
 					fmt.Sprintf("unexpected ns %s mounted state, want %v got %v",
-						ns.BlockDevice, !tc.reset, isMounted))
+						ns.BlockDevice, expMounted, isMounted))
 			}
 		})
 	}
@@ -430,6 +463,203 @@ func TestProvider_CheckFormat(t *testing.T) {
 	}
 }
 
+func TestProvider_RestoreMount(t *testing.T) {
+	const (
+		mnt = "/mnt/daos"
+		dev = "/dev/pmem0"
+	)
+
+	for name, tc := range map[string]struct {
+		alreadyMounted bool
+		isMountedErr   error
+		fsck           bool
+		fsckRepaired   bool
+		fsckErr        error
+		mountErr       error
+		expResponse    *storage.MountResponse
+		expErr         error
+	}{
+		"already mounted": {
+			alreadyMounted: true,
+			fsck:           true,
+			fsckErr:        errors.New("not expected"),
+			expResponse: &storage.MountResponse{
+				Target:  mnt,
+				Mounted: true,
+			},
+		},
+		"mount check fails": {
+			isMountedErr: errors.New("failed"),
+			expErr:       errors.New("failed"),
+		},
+		"remount without fsck": {
+			fsckErr: errors.New("not expected"),
+			expResponse: &storage.MountResponse{
+				Target:  mnt,
+				Mounted: true,
+			},
+		},
+		"fsck clean": {
+			fsck: true,
+			expResponse: &storage.MountResponse{
+				Target:  mnt,
+				Mounted: true,
+			},
+		},
+		"fsck repaired": {
+			fsck:         true,
+			fsckRepaired: true,
+			expResponse: &storage.MountResponse{
+				Target:  mnt,
+				Mounted: true,
+				Repairs: []string{"repaired filesystem errors on " + dev},
+			},
+		},
+		"fsck fails": {
+			fsck:    true,
+			fsckErr: errors.New("bad superblock"),
+			expErr:  FaultFsckFailed(dev, errors.New("bad superblock")),
+		},
+		"mount fails": {
+			mountErr: errors.New("mount failed"),
+			expErr:   errors.New("mount failed"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			msc := &system.MockSysConfig{
+				IsMountedBool: tc.alreadyMounted,
+				IsMountedErr:  tc.isMountedErr,
+				MountErr:      tc.mountErr,
+				FsckRepaired:  tc.fsckRepaired,
+				FsckErr:       tc.fsckErr,
+			}
+
+			p := NewMockProvider(log, nil, msc)
+
+			res, err := p.Mount(storage.ScmMountRequest{
+				Class:   storage.ClassDcpm,
+				Device:  dev,
+				Target:  mnt,
+				Restore: true,
+				Fsck:    tc.fsck,
+			})
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResponse, res); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestProvider_Unlock(t *testing.T) {
+	withState := func(idx int32, state storage.ScmSecurityState) *storage.ScmModule {
+		m := storage.MockScmModule(idx)
+		m.SecurityState = state
+		return m
+	}
+
+	for name, tc := range map[string]struct {
+		req        storage.ScmUnlockRequest
+		modules    storage.ScmModules
+		getModErr  error
+		unlockErr  error
+		expCalls   [][]string
+		expModules storage.ScmModules
+		expErr     error
+	}{
+		"missing passphrase file": {
+			expErr: FaultUnlockMissingPassphrase,
+		},
+		"scan fails": {
+			req:       storage.ScmUnlockRequest{PassphraseFile: "/key"},
+			getModErr: errors.New("scan failed"),
+			expErr:    errors.New("scan failed"),
+		},
+		"no locked modules": {
+			req: storage.ScmUnlockRequest{PassphraseFile: "/key"},
+			modules: storage.ScmModules{
+				withState(0, storage.ScmSecurityDisabled),
+				withState(1, "Unlocked, Frozen"),
+			},
+			expModules: storage.ScmModules{},
+		},
+		"locked and frozen": {
+			req: storage.ScmUnlockRequest{PassphraseFile: "/key"},
+			modules: storage.ScmModules{
+				withState(0, "Locked, Frozen"),
+			},
+			expErr: FaultUnlockNotPossible("Device0", "Locked, Frozen"),
+		},
+		"unlock all locked": {
+			req: storage.ScmUnlockRequest{PassphraseFile: "/key"},
+			modules: storage.ScmModules{
+				withState(0, storage.ScmSecurityLocked),
+				withState(1, storage.ScmSecurityDisabled),
+				withState(2, storage.ScmSecurityLocked),
+			},
+			expCalls: [][]string{{"Device0", "Device2"}},
+			expModules: storage.ScmModules{
+				withState(0, storage.ScmSecurityUnlocked),
+				withState(2, storage.ScmSecurityUnlocked),
+			},
+		},
+		"unlock selected": {
+			req: storage.ScmUnlockRequest{
+				PassphraseFile: "/key",
+				DeviceUIDs:     []string{"Device2"},
+			},
+			modules: storage.ScmModules{
+				withState(0, storage.ScmSecurityLocked),
+				withState(2, storage.ScmSecurityLocked),
+			},
+			expCalls: [][]string{{"Device2"}},
+			expModules: storage.ScmModules{
+				withState(2, storage.ScmSecurityUnlocked),
+			},
+		},
+		"unlock fails": {
+			req: storage.ScmUnlockRequest{PassphraseFile: "/key"},
+			modules: storage.ScmModules{
+				withState(0, storage.ScmSecurityLocked),
+			},
+			unlockErr: errors.New("invalid passphrase"),
+			expErr:    errors.New("invalid passphrase"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mb := NewMockBackend(&MockBackendConfig{
+				GetModulesRes:    tc.modules,
+				GetModulesErr:    tc.getModErr,
+				UnlockModulesErr: tc.unlockErr,
+			})
+			p := NewProvider(log, mb, system.NewMockSysProvider(log, nil), nil)
+
+			res, err := p.Unlock(tc.req)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCalls, mb.UnlockCalls); diff != "" {
+				t.Fatalf("unexpected unlock calls (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expModules, res.Modules); diff != "" {
+				t.Fatalf("unexpected modules (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestProvider_Format(t *testing.T) {
 	const (
 		goodMountPoint     = "/mnt/daos"
@@ -0,0 +1,275 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package scm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const (
+	defaultSysfsRoot = "/sys"
+	sysfsNdDevices   = "bus/nd/devices"
+	sysfsNmemPrefix  = "nmem"
+	sysfsNsPrefix    = "namespace"
+)
+
+// sysfsBackend discovers PMem modules and namespaces by reading the libnvdimm device tree in
+// sysfs. It is used when ipmctl and ndctl are not installed and supports discovery only.
+type sysfsBackend struct {
+	log  logging.Logger
+	root string
+}
+
+func newSysfsBackend(log logging.Logger, root string) *sysfsBackend {
+	return &sysfsBackend{
+		log:  log,
+		root: root,
+	}
+}
+
+func (sb *sysfsBackend) devicesPath(elems ...string) string {
+	return filepath.Join(append([]string{sb.root, sysfsNdDevices}, elems...)...)
+}
+
+// listDevices returns names of nd bus devices with the given prefix. An empty list is returned if
+// the nd bus does not exist, e.g. when the libnvdimm kernel module is not loaded.
+func (sb *sysfsBackend) listDevices(prefix string) ([]string, error) {
+	entries, err := os.ReadDir(sb.devicesPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			sb.log.Debugf("%s not found, no pmem present", sb.devicesPath())
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "read nd bus devices")
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), prefix) {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return names, nil
+}
+
+func (sb *sysfsBackend) readAttr(dev, attr string) (string, error) {
+	data, err := os.ReadFile(sb.devicesPath(dev, attr))
+	if err != nil {
+		return "", errors.Wrapf(err, "read %s attribute %s", dev, attr)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (sb *sysfsBackend) readUintAttr(dev, attr string) (uint64, error) {
+	str, err := sb.readAttr(dev, attr)
+	if err != nil {
+		return 0, err
+	}
+
+	val, err := strconv.ParseUint(str, 0, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse %s attribute %s", dev, attr)
+	}
+
+	return val, nil
+}
+
+// moduleFromHandle populates location fields from an NFIT device handle which encodes the DIMM
+// number in bits 0-3, channel in bits 4-7, memory controller in bits 8-11 and socket in bits 12-15.
+func moduleFromHandle(handle uint64) *storage.ScmModule {
+	return &storage.ScmModule{
+		ChannelPosition: uint32(handle & 0xf),
+		ChannelID:       uint32((handle >> 4) & 0xf),
+		ControllerID:    uint32((handle >> 8) & 0xf),
+		SocketID:        uint32((handle >> 12) & 0xf),
+	}
+}
+
+// sysfsSecurityState converts a libnvdimm security attribute value e.g. "locked" to the equivalent
+// ipmctl reported state.
+func sysfsSecurityState(val string) storage.ScmSecurityState {
+	if val == "" {
+		return ""
+	}
+	return storage.ScmSecurityState(strings.ToUpper(val[:1]) + val[1:])
+}
+
+// getModules returns PMem modules found on the nd bus. Capacity and health are not reported as
+// they are only available through ipmctl.
+func (sb *sysfsBackend) getModules(sockID int) (storage.ScmModules, error) {
+	names, err := sb.listDevices(sysfsNmemPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := storage.ScmModules{}
+	for _, name := range names {
+		handle, err := sb.readUintAttr(name, "nfit/handle")
+		if err != nil {
+			return nil, err
+		}
+		module := moduleFromHandle(handle)
+		if sockID != sockAny && module.SocketID != uint32(sockID) {
+			continue
+		}
+
+		physID, err := sb.readUintAttr(name, "nfit/phys_id")
+		if err != nil {
+			return nil, err
+		}
+		module.PhysicalID = uint32(physID)
+
+		if module.UID, err = sb.readAttr(name, "nfit/id"); err != nil {
+			return nil, err
+		}
+
+		// Security attribute is only present if the module supports passphrases.
+		security, err := sb.readAttr(name, "security")
+		switch {
+		case err == nil:
+			module.SecurityState = sysfsSecurityState(security)
+		case !os.IsNotExist(errors.Cause(err)):
+			return nil, err
+		}
+
+		modules = append(modules, module)
+	}
+
+	msg := fmt.Sprintf("discovered %d pmem modules through sysfs", len(modules))
+	if sockID != sockAny {
+		msg = fmt.Sprintf("%s on sock %d", msg, sockID)
+	}
+	sb.log.Debug(msg)
+
+	return modules, nil
+}
+
+// blockDevice returns the name of the block device exposed by a namespace, if any. In fsdax mode
+// the block device belongs to the pfn device holding the namespace.
+func (sb *sysfsBackend) blockDevice(ns, holder string) (string, error) {
+	dev := ns
+	if holder != "" {
+		dev = holder
+	}
+
+	entries, err := os.ReadDir(sb.devicesPath(dev, "block"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil // e.g. devdax namespaces only expose a character device
+		}
+		return "", errors.Wrapf(err, "read %s block devices", dev)
+	}
+	if len(entries) == 0 {
+		return "", nil
+	}
+
+	return entries[0].Name(), nil
+}
+
+// getNamespaces returns PMem namespaces found on the nd bus, skipping zero-sized seed devices.
+func (sb *sysfsBackend) getNamespaces(numaID int) (storage.ScmNamespaces, error) {
+	names, err := sb.listDevices(sysfsNsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	nss := storage.ScmNamespaces{}
+	for _, name := range names {
+		size, err := sb.readUintAttr(name, "size")
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			continue
+		}
+
+		numaStr, err := sb.readAttr(name, "numa_node")
+		if err != nil {
+			return nil, err
+		}
+		numa, err := strconv.Atoi(numaStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse %s attribute numa_node", name)
+		}
+		if numa < 0 {
+			numa = 0
+		}
+		if numaID != sockAny && numa != numaID {
+			continue
+		}
+
+		ns := &storage.ScmNamespace{
+			Name:     name,
+			NumaNode: uint32(numa),
+			Size:     size,
+		}
+
+		mode, err := sb.readAttr(name, "mode")
+		if err != nil {
+			return nil, err
+		}
+		ns.Mode = storage.ScmNamespaceMode(mode)
+
+		if ns.UUID, err = sb.readAttr(name, "uuid"); err != nil {
+			return nil, err
+		}
+
+		holder, err := sb.readAttr(name, "holder")
+		if err != nil {
+			return nil, err
+		}
+		if ns.BlockDevice, err = sb.blockDevice(name, holder); err != nil {
+			return nil, err
+		}
+
+		nss = append(nss, ns)
+	}
+	sb.log.Debugf("discovered %d pmem namespaces through sysfs", len(nss))
+
+	return nss, nil
+}
+
+func (sb *sysfsBackend) prep(storage.ScmPrepareRequest, *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error) {
+	return nil, FaultSysfsUnsupported("prepare")
+}
+
+func (sb *sysfsBackend) prepReset(storage.ScmPrepareRequest, *storage.ScmScanResponse) (*storage.ScmPrepareResponse, error) {
+	return nil, FaultSysfsUnsupported("reset")
+}
+
+func (sb *sysfsBackend) GetFirmwareStatus(string) (*storage.ScmFirmwareInfo, error) {
+	return nil, FaultSysfsUnsupported("firmware query")
+}
+
+func (sb *sysfsBackend) UpdateFirmware(string, string) error {
+	return FaultSysfsUnsupported("firmware update")
+}
+
+func (sb *sysfsBackend) ActivateFirmware() error {
+	return FaultSysfsUnsupported("firmware activation")
+}
+
+func (sb *sysfsBackend) unlockModules(string, []string) error {
+	return FaultSysfsUnsupported("module unlock")
+}
+
+func (sb *sysfsBackend) capabilities() *storage.ScmCapabilities {
+	return &storage.ScmCapabilities{
+		Backend: storage.ScmBackendSysfs,
+	}
+}
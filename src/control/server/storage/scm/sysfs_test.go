@@ -0,0 +1,257 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package scm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// mockSysfsNd creates nd bus device attribute files under the given sysfs root.
+func mockSysfsNd(t *testing.T, root string, devAttrs map[string]map[string]string) {
+	t.Helper()
+
+	for dev, attrs := range devAttrs {
+		for attr, val := range attrs {
+			path := filepath.Join(root, sysfsNdDevices, dev, attr)
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(val+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func mockSysfsNmem(handle, physID, id string) map[string]string {
+	return map[string]string{
+		"nfit/handle":  handle,
+		"nfit/phys_id": physID,
+		"nfit/id":      id,
+	}
+}
+
+func TestSysfs_getModules(t *testing.T) {
+	for name, tc := range map[string]struct {
+		devAttrs   map[string]map[string]string
+		noNdBus    bool
+		sockID     int
+		expModules storage.ScmModules
+		expErr     error
+	}{
+		"no nd bus": {
+			noNdBus:    true,
+			sockID:     sockAny,
+			expModules: storage.ScmModules{},
+		},
+		"missing handle": {
+			devAttrs: map[string]map[string]string{
+				"nmem0": {"nfit/id": "8089-a2-1837-00000b4b"},
+			},
+			sockID: sockAny,
+			expErr: errors.New("read nmem0 attribute nfit/handle"),
+		},
+		"bad handle": {
+			devAttrs: map[string]map[string]string{
+				"nmem0": mockSysfsNmem("foo", "0x1c", "8089-a2-1837-00000b4b"),
+			},
+			sockID: sockAny,
+			expErr: errors.New("parse nmem0 attribute nfit/handle"),
+		},
+		"dual socket": {
+			devAttrs: map[string]map[string]string{
+				"nmem0":   mockSysfsNmem("0x0001", "0x1c", "8089-a2-1837-00000b4b"),
+				"nmem1":   mockSysfsNmem("0x1121", "0x2c", "8089-a2-1837-00000b5b"),
+				"region0": {"size": "0"},
+			},
+			sockID: sockAny,
+			expModules: storage.ScmModules{
+				{
+					ChannelPosition: 1,
+					PhysicalID:      0x1c,
+					UID:             "8089-a2-1837-00000b4b",
+				},
+				{
+					ChannelPosition: 1,
+					ChannelID:       2,
+					ControllerID:    1,
+					SocketID:        1,
+					PhysicalID:      0x2c,
+					UID:             "8089-a2-1837-00000b5b",
+				},
+			},
+		},
+		"security state": {
+			devAttrs: map[string]map[string]string{
+				"nmem0": func() map[string]string {
+					attrs := mockSysfsNmem("0x0001", "0x1c", "8089-a2-1837-00000b4b")
+					attrs["security"] = "locked"
+					return attrs
+				}(),
+			},
+			sockID: sockAny,
+			expModules: storage.ScmModules{
+				{
+					ChannelPosition: 1,
+					PhysicalID:      0x1c,
+					UID:             "8089-a2-1837-00000b4b",
+					SecurityState:   storage.ScmSecurityLocked,
+				},
+			},
+		},
+		"dual socket; select socket 1": {
+			devAttrs: map[string]map[string]string{
+				"nmem0": mockSysfsNmem("0x0001", "0x1c", "8089-a2-1837-00000b4b"),
+				"nmem1": mockSysfsNmem("0x1121", "0x2c", "8089-a2-1837-00000b5b"),
+			},
+			sockID: 1,
+			expModules: storage.ScmModules{
+				{
+					ChannelPosition: 1,
+					ChannelID:       2,
+					ControllerID:    1,
+					SocketID:        1,
+					PhysicalID:      0x2c,
+					UID:             "8089-a2-1837-00000b5b",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			root := t.TempDir()
+			if !tc.noNdBus {
+				mockSysfsNd(t, root, tc.devAttrs)
+			}
+
+			modules, err := newSysfsBackend(log, root).getModules(tc.sockID)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expModules, modules); diff != "" {
+				t.Fatalf("unexpected modules (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSysfs_getNamespaces(t *testing.T) {
+	fsdaxNs := map[string]string{
+		"size":      "3183575302144",
+		"numa_node": "0",
+		"mode":      "fsdax",
+		"uuid":      "842fc847-28e0-4bb6-8dfc-d24afdba1528",
+		"holder":    "pfn0.1",
+	}
+	devdaxNs := map[string]string{
+		"size":      "3183575302144",
+		"numa_node": "1",
+		"mode":      "devdax",
+		"uuid":      "3de2f9e4-2d4d-4a1f-bb9e-2b3b8a6f8d2e",
+		"holder":    "dax1.1",
+	}
+	seedNs := map[string]string{
+		"size":      "0",
+		"numa_node": "0",
+	}
+
+	for name, tc := range map[string]struct {
+		devAttrs map[string]map[string]string
+		noNdBus  bool
+		numaID   int
+		expNss   storage.ScmNamespaces
+		expErr   error
+	}{
+		"no nd bus": {
+			noNdBus: true,
+			numaID:  sockAny,
+			expNss:  storage.ScmNamespaces{},
+		},
+		"bad numa node": {
+			devAttrs: map[string]map[string]string{
+				"namespace0.0": {"size": "1024", "numa_node": "foo"},
+			},
+			numaID: sockAny,
+			expErr: errors.New("parse namespace0.0 attribute numa_node"),
+		},
+		"fsdax and devdax; seed skipped": {
+			devAttrs: map[string]map[string]string{
+				"namespace0.0": fsdaxNs,
+				"namespace0.1": seedNs,
+				"pfn0.1":       {"block/pmem0": ""},
+				"namespace1.0": devdaxNs,
+			},
+			numaID: sockAny,
+			expNss: storage.ScmNamespaces{
+				{
+					UUID:        "842fc847-28e0-4bb6-8dfc-d24afdba1528",
+					BlockDevice: "pmem0",
+					Name:        "namespace0.0",
+					Size:        3183575302144,
+					Mode:        storage.ScmNsModeFsdax,
+				},
+				{
+					UUID:     "3de2f9e4-2d4d-4a1f-bb9e-2b3b8a6f8d2e",
+					Name:     "namespace1.0",
+					NumaNode: 1,
+					Size:     3183575302144,
+					Mode:     storage.ScmNsModeDevdax,
+				},
+			},
+		},
+		"select numa node 1": {
+			devAttrs: map[string]map[string]string{
+				"namespace0.0": fsdaxNs,
+				"pfn0.1":       {"block/pmem0": ""},
+				"namespace1.0": devdaxNs,
+			},
+			numaID: 1,
+			expNss: storage.ScmNamespaces{
+				{
+					UUID:     "3de2f9e4-2d4d-4a1f-bb9e-2b3b8a6f8d2e",
+					Name:     "namespace1.0",
+					NumaNode: 1,
+					Size:     3183575302144,
+					Mode:     storage.ScmNsModeDevdax,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			root := t.TempDir()
+			if !tc.noNdBus {
+				mockSysfsNd(t, root, tc.devAttrs)
+			}
+
+			nss, err := newSysfsBackend(log, root).getNamespaces(tc.numaID)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expNss, nss); diff != "" {
+				t.Fatalf("unexpected namespaces (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ func Test_CalcRamdiskSize(t *testing.T) {
 		memTotal uint64
 		memHuge  uint64
 		memSys   uint64
+		margin   int
 		tgtCount int
 		engCount int
 		expSize  uint64
@@ -44,7 +45,7 @@ func Test_CalcRamdiskSize(t *testing.T) {
 			memSys:   DefaultSysMemRsvd,
 			tgtCount: 8,
 			engCount: 1,
-			expErr:   errors.New("insufficient ram"), // 30 - (14+26+1) = -1
+			expErr:   errors.New("want 45 GiB RAM but only have 40 GiB"), // 40 - (14+26+1) = -1
 		},
 		"default values; high mem": {
 			memTotal: humanize.GiByte * 70,
@@ -76,7 +77,39 @@ func Test_CalcRamdiskSize(t *testing.T) {
 			memSys:   humanize.GiByte * 27,
 			tgtCount: 16,
 			engCount: 2,
-			expErr:   errors.New("insufficient ram"), // 60 - (30+27+4) = -1
+			expErr:   errors.New("want 69 GiB RAM but only have 60 GiB"), // 60 - (30+27+4) = -1
+		},
+		"custom values; below minimum size": {
+			memTotal: humanize.GiByte * 60,
+			memHuge:  humanize.GiByte * 30,
+			memSys:   humanize.GiByte * 20,
+			tgtCount: 16,
+			engCount: 2,
+			// 60 - (30+20+4) = 6, 3 per engine
+			expErr: FaultRamdiskInsufficientMem(&RamdiskMemBreakdown{
+				MemTotal:  humanize.GiByte * 60,
+				MemHuge:   humanize.GiByte * 30,
+				MemSys:    humanize.GiByte * 20,
+				MemEngine: humanize.GiByte * 2,
+				TgtCount:  16,
+				EngCount:  2,
+			}),
+		},
+		"custom values; margin": {
+			memTotal: humanize.GiByte * 100,
+			memHuge:  humanize.GiByte * 30,
+			memSys:   humanize.GiByte * 4,
+			margin:   10,
+			tgtCount: 16,
+			engCount: 2,
+			expSize:  humanize.GiByte * 26, // (100 - (30+4+10+4)) / 2
+		},
+		"margin out of range": {
+			memTotal: humanize.GiByte * 100,
+			margin:   MaxRamdiskMemMargin + 1,
+			tgtCount: 16,
+			engCount: 2,
+			expErr:   errors.New("margin 51% out of range"),
 		},
 	} {
 		t.Run(name, func(t *testing.T) {
@@ -84,7 +117,7 @@ func Test_CalcRamdiskSize(t *testing.T) {
 			defer test.ShowBufferOnFailure(t, buf)
 
 			gotSize, gotErr := CalcRamdiskSize(log, tc.memTotal, tc.memHuge, tc.memSys,
-				tc.tgtCount, tc.engCount)
+				tc.margin, tc.tgtCount, tc.engCount)
 			test.CmpErr(t, tc.expErr, gotErr)
 			if tc.expErr != nil {
 				return
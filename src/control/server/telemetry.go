@@ -9,26 +9,243 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/lib/telemetry"
+	"github.com/daos-stack/daos/src/control/lib/telemetry/otlpexp"
 	"github.com/daos-stack/daos/src/control/lib/telemetry/promexp"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
-func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Engine) error {
+const (
+	// poolMetricsTimeout bounds the time spent querying pools for a single scrape.
+	poolMetricsTimeout = 10 * time.Second
+	// otlpServiceName identifies the server in telemetry pushed to an OTLP collector.
+	otlpServiceName = "daos_server"
+)
+
+// poolCollector exports the space usage, rebuild state and target health of the system's pools.
+// Metrics are only exported by the MS leader.
+type poolCollector struct {
+	log            logging.Logger
+	svc            *mgmtSvc
+	collectPools   bool
+	collectTargets bool
+	spaceTotal     *prometheus.Desc
+	spaceFree      *prometheus.Desc
+	targets        *prometheus.Desc
+	rebuildState   *prometheus.Desc
+	rebuildStatus  *prometheus.Desc
+	rebuildObjects *prometheus.Desc
+	rebuildRecords *prometheus.Desc
+	targetState    *prometheus.Desc
+}
+
+func newPoolCollector(log logging.Logger, svc *mgmtSvc, collectPools, collectTargets bool) *poolCollector {
+	desc := func(name, help string, labels ...string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("system", "pool", name), help, labels, nil)
+	}
+
+	return &poolCollector{
+		log:            log,
+		svc:            svc,
+		collectPools:   collectPools,
+		collectTargets: collectTargets,
+		spaceTotal: desc("space_total_bytes", "Total space of the pool storage tier.",
+			"pool", "label", "tier"),
+		spaceFree: desc("space_free_bytes", "Free space of the pool storage tier.",
+			"pool", "label", "tier"),
+		targets: desc("targets", "Number of pool targets in each state.",
+			"pool", "label", "state"),
+		rebuildState: desc("rebuild_state", "Current pool rebuild state (1 for the current state).",
+			"pool", "label", "state"),
+		rebuildStatus: desc("rebuild_status", "DAOS error code of the pool rebuild (0 if successful).",
+			"pool", "label"),
+		rebuildObjects: desc("rebuild_objects", "Number of objects rebuilt.",
+			"pool", "label"),
+		rebuildRecords: desc("rebuild_records", "Number of records rebuilt.",
+			"pool", "label"),
+		targetState: desc("target_state", "Current pool target state (1 for the current state).",
+			"pool", "label", "rank", "target", "state"),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	if c.collectPools {
+		ch <- c.spaceTotal
+		ch <- c.spaceFree
+		ch <- c.targets
+		ch <- c.rebuildState
+		ch <- c.rebuildStatus
+		ch <- c.rebuildObjects
+		ch <- c.rebuildRecords
+	}
+	if c.collectTargets {
+		ch <- c.targetState
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	if !c.svc.sysdb.IsLeader() {
+		return
+	}
+
+	psList, err := c.svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		c.log.Errorf("pool metrics: failed to fetch pool service list: %s", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), poolMetricsTimeout)
+	defer cancel()
+
+	mask := daos.DefaultPoolQueryMask
+	if c.collectTargets {
+		mask |= daos.MustNewPoolQueryMask(daos.PoolQueryOptionEnabledEngines)
+	}
+
+	for _, ps := range psList {
+		if ps.State != system.PoolServiceStateReady {
+			continue
+		}
+
+		resp, err := c.svc.queryPoolService(ctx, ps.PoolUUID.String(), mask)
+		if err != nil {
+			c.log.Debugf("pool metrics: query of pool %s failed: %s", ps.PoolUUID, err)
+			continue
+		}
+
+		if c.collectPools {
+			c.collectPool(ch, resp)
+		}
+		if c.collectTargets {
+			c.collectPoolTargets(ctx, ch, resp)
+		}
+	}
+}
+
+func (c *poolCollector) collectPool(ch chan<- prometheus.Metric, resp *mgmtpb.PoolQueryResp) {
+	gauge := func(desc *prometheus.Desc, value float64, labels ...string) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value,
+			append([]string{resp.Uuid, resp.Label}, labels...)...)
+	}
+
+	for _, tier := range resp.TierStats {
+		tierName := strings.ToLower(tier.MediaType.String())
+		gauge(c.spaceTotal, float64(tier.Total), tierName)
+		gauge(c.spaceFree, float64(tier.Free), tierName)
+	}
+
+	gauge(c.targets, float64(resp.ActiveTargets), "active")
+	gauge(c.targets, float64(resp.DisabledTargets), "disabled")
+
+	if resp.Rebuild == nil {
+		return
+	}
+	for _, state := range []mgmtpb.PoolRebuildStatus_State{
+		mgmtpb.PoolRebuildStatus_IDLE,
+		mgmtpb.PoolRebuildStatus_BUSY,
+		mgmtpb.PoolRebuildStatus_DONE,
+	} {
+		var value float64
+		if resp.Rebuild.State == state {
+			value = 1
+		}
+		gauge(c.rebuildState, value, strings.ToLower(state.String()))
+	}
+	gauge(c.rebuildStatus, float64(resp.Rebuild.Status))
+	gauge(c.rebuildObjects, float64(resp.Rebuild.Objects))
+	gauge(c.rebuildRecords, float64(resp.Rebuild.Records))
+}
+
+// collectPoolTargets queries the state of the targets on each of the pool's engines. Targets
+// are assumed to be spread evenly across the engines.
+func (c *poolCollector) collectPoolTargets(ctx context.Context, ch chan<- prometheus.Metric, resp *mgmtpb.PoolQueryResp) {
+	if resp.TotalEngines == 0 {
+		return
+	}
+	tgtsPerRank := resp.TotalTargets / resp.TotalEngines
+
+	ranks := ranklist.MustCreateRankSet("")
+	for _, rankStr := range []string{resp.EnabledRanks, resp.DisabledRanks} {
+		rs, err := ranklist.CreateRankSet(rankStr)
+		if err != nil {
+			c.log.Errorf("pool metrics: pool %s: %s", resp.Uuid, err)
+			return
+		}
+		ranks.Merge(rs)
+	}
+
+	targets := make([]uint32, tgtsPerRank)
+	for i := range targets {
+		targets[i] = uint32(i)
+	}
+
+	for _, rank := range ranks.Ranks() {
+		req := &mgmtpb.PoolQueryTargetReq{
+			Id:      resp.Uuid,
+			Rank:    rank.Uint32(),
+			Targets: targets,
+		}
+		dResp, err := c.svc.makePoolServiceCall(ctx, daos.MethodPoolQueryTarget, req)
+		if err != nil {
+			c.log.Debugf("pool metrics: target query of pool %s rank %d failed: %s",
+				resp.Uuid, rank, err)
+			continue
+		}
+		tResp := new(mgmtpb.PoolQueryTargetResp)
+		if err := c.svc.unmarshalPB(dResp.Body, tResp); err != nil {
+			continue
+		}
+		if tResp.Status != 0 {
+			c.log.Debugf("pool metrics: target query of pool %s rank %d failed: %s",
+				resp.Uuid, rank, daos.Status(tResp.Status))
+			continue
+		}
+
+		for i, info := range tResp.Infos {
+			if i >= len(targets) {
+				break
+			}
+			ch <- prometheus.MustNewConstMetric(c.targetState, prometheus.GaugeValue, 1,
+				resp.Uuid, resp.Label, rank.String(), fmt.Sprintf("%d", targets[i]),
+				strings.ToLower(info.State.String()))
+		}
+	}
+}
+
+func regPromEngineSources(ctx context.Context, log logging.Logger, reg prometheus.Registerer, engines []Engine, sets []string) error {
 	numEngines := len(engines)
 	if numEngines == 0 {
 		return nil
 	}
+	if !common.Includes(sets, config.TelemetryCollectEngine) &&
+		!common.Includes(sets, config.TelemetryCollectDevice) {
+		return nil
+	}
 
-	c, err := promexp.NewEngineCollector(log, &promexp.CollectorOpts{})
+	c, err := promexp.NewEngineCollector(log, &promexp.CollectorOpts{Sets: sets})
 	if err != nil {
 		return err
 	}
-	prometheus.MustRegister(c)
+	if err := reg.Register(c); err != nil {
+		return errors.Wrap(err, "registering engine collector")
+	}
 
 	addFn := func(idx uint32, rank ranklist.Rank) func(context.Context) error {
 		return func(context.Context) error {
@@ -69,14 +286,104 @@ func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Eng
 	return nil
 }
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine) (func(), error) {
+// regTelemetryCollectors registers the collectors for the configured metric sets.
+func regTelemetryCollectors(ctx context.Context, log logging.Logger, reg prometheus.Registerer, sets []string, engines []Engine, svc *mgmtSvc, metrics *controlMetrics) error {
+	if err := regPromEngineSources(ctx, log, reg, engines, sets); err != nil {
+		return err
+	}
+
+	if common.Includes(sets, config.TelemetryCollectControl) {
+		if err := regControlCollectors(reg, metrics, engines); err != nil {
+			return err
+		}
+	}
+
+	collectPools := common.Includes(sets, config.TelemetryCollectPool)
+	collectTargets := common.Includes(sets, config.TelemetryCollectTarget)
+	if svc != nil && (collectPools || collectTargets) {
+		if err := reg.Register(newPoolCollector(log, svc, collectPools, collectTargets)); err != nil {
+			return errors.Wrap(err, "registering pool collector")
+		}
+	}
+
+	return nil
+}
+
+func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, sets []string, histCfg *config.TelemetryHistoryConfig, engines []Engine, svc *mgmtSvc, metrics *controlMetrics) (func(), error) {
 	expCfg := &promexp.ExporterConfig{
 		Port:  port,
 		Title: "DAOS Engine Telemetry",
 		Register: func(ctx context.Context, log logging.Logger) error {
-			return regPromEngineSources(ctx, log, engines)
+			return regTelemetryCollectors(ctx, log, prometheus.DefaultRegisterer, sets, engines, svc,
+				metrics)
 		},
 	}
 
+	if histCfg != nil {
+		history, err := promexp.NewHistory(log, histCfg.Retention, histCfg.GetInterval())
+		if err != nil {
+			return nil, errors.Wrap(err, "telemetry history")
+		}
+		expCfg.History = history
+	}
+
 	return promexp.StartExporter(ctx, log, expCfg)
 }
+
+// startOTLPExporter starts pushing the configured metric sets, and any spans recorded for
+// management requests, to an OTLP collector. The collectors are registered with a private
+// registry so that the exporter is independent of the Prometheus endpoint.
+func startOTLPExporter(ctx context.Context, log logging.Logger, cfg *config.Server, hostname string, engines []Engine, svc *mgmtSvc, metrics *controlMetrics, spans *otlpexp.SpanRecorder) (func(), error) {
+	reg := prometheus.NewRegistry()
+	if err := regTelemetryCollectors(ctx, log, reg, cfg.GetTelemetryCollect(), engines, svc, metrics); err != nil {
+		return nil, err
+	}
+
+	otlpCfg := cfg.TelemetryOTLP
+	return otlpexp.StartExporter(ctx, log, &otlpexp.ExporterConfig{
+		Endpoint: otlpCfg.Endpoint,
+		Interval: otlpCfg.GetInterval(),
+		Insecure: otlpCfg.Insecure,
+		CACert:   otlpCfg.CACert,
+		Headers:  otlpCfg.Headers,
+		Resource: map[string]string{
+			"service.name":    otlpServiceName,
+			"service.version": build.DaosVersion,
+			"host.name":       hostname,
+			"daos.system":     cfg.SystemName,
+		},
+		Gatherer: reg,
+		Spans:    spans,
+	})
+}
+
+// activeRPCsPath matches the per-context gauges reporting RPCs being processed by an engine.
+var activeRPCsPath = regexp.MustCompile(`/hg/active_rpcs/ctx_\d+$`)
+
+// getEngineActiveRPCs returns the number of RPCs currently being processed by the engine, as
+// reported in its telemetry.
+func getEngineActiveRPCs(ctx context.Context, ei Engine) (uint64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	tmCtx, err := telemetry.Init(ctx, ei.Index())
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to attach to telemetry of engine %d", ei.Index())
+	}
+	defer telemetry.Detach(tmCtx)
+
+	metrics := make(chan telemetry.Metric)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- telemetry.CollectMetrics(tmCtx, telemetry.NewSchema(), metrics)
+	}()
+
+	var active uint64
+	for m := range metrics {
+		if activeRPCsPath.MatchString(m.FullPath()) {
+			active += uint64(m.FloatValue())
+		}
+	}
+
+	return active, <-errCh
+}
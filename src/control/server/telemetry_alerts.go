@@ -0,0 +1,359 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+const (
+	// alertWebhookTimeout bounds the time spent posting a single alert to the webhook.
+	alertWebhookTimeout = 10 * time.Second
+
+	alertStateRaised  = "raised"
+	alertStateCleared = "cleared"
+)
+
+// telemetryAlert describes a metric series that has crossed the threshold of an alert rule. It
+// is posted to the webhook as JSON when the alert is raised and when it is cleared.
+type telemetryAlert struct {
+	Rule      string            `json:"rule"`
+	State     string            `json:"state"`
+	Severity  string            `json:"severity"`
+	Hostname  string            `json:"host"`
+	System    string            `json:"system"`
+	Metric    string            `json:"metric"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Value     float64           `json:"value"`
+	Op        string            `json:"op"`
+	Threshold float64           `json:"threshold"`
+	Timestamp string            `json:"timestamp"`
+}
+
+func (ta *telemetryAlert) series() string {
+	keys := make([]string, 0, len(ta.Labels))
+	for key := range ta.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, ta.Labels[key]))
+	}
+	return ta.Metric + "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatAlertValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func newTelemetryAlertEvent(alert *telemetryAlert) *events.RASEvent {
+	var evt *events.RASEvent
+	if alert.State == alertStateRaised {
+		sev := events.RASSeverityWarning
+		if alert.Severity == config.AlertSeverityError {
+			sev = events.RASSeverityError
+		}
+		msg := fmt.Sprintf("telemetry alert %s raised: %s = %s %s %s", alert.Rule,
+			alert.series(), formatAlertValue(alert.Value), alert.Op,
+			formatAlertValue(alert.Threshold))
+		evt = events.NewGenericEvent(events.RASTelemetryAlertRaised, sev, msg, "")
+	} else {
+		msg := fmt.Sprintf("telemetry alert %s cleared: %s = %s", alert.Rule, alert.series(),
+			formatAlertValue(alert.Value))
+		evt = events.NewGenericEvent(events.RASTelemetryAlertCleared, events.RASSeverityNotice,
+			msg, "")
+	}
+
+	if alert.Hostname != "" {
+		evt.Hostname = alert.Hostname
+	}
+	if rank, err := strconv.ParseUint(alert.Labels["rank"], 10, 32); err == nil {
+		evt.Rank = uint32(rank)
+	}
+	evt.PoolUUID = alert.Labels["pool"]
+
+	return evt
+}
+
+// compareAlertValue reports whether the value crosses the threshold using the given operator.
+func compareAlertValue(op string, value, threshold float64) bool {
+	switch op {
+	case config.AlertOpGreater:
+		return value > threshold
+	case config.AlertOpGreaterEqual:
+		return value >= threshold
+	case config.AlertOpLess:
+		return value < threshold
+	case config.AlertOpLessEqual:
+		return value <= threshold
+	case config.AlertOpEqual:
+		return value == threshold
+	case config.AlertOpNotEqual:
+		return value != threshold
+	default:
+		return false
+	}
+}
+
+// alertSeries holds the value of a single series of a metric along with its labels.
+type alertSeries struct {
+	labels map[string]string
+	value  float64
+}
+
+func labelsKey(labels map[string]string) string {
+	return (&telemetryAlert{Labels: labels}).series()
+}
+
+// familySeries returns the series of a metric family keyed by their labels. Only counters,
+// gauges and untyped metrics have a single value that can be compared against a threshold.
+func familySeries(mf *dto.MetricFamily) map[string]*alertSeries {
+	series := make(map[string]*alertSeries)
+	if mf == nil {
+		return series
+	}
+
+	for _, m := range mf.GetMetric() {
+		var value float64
+		switch mf.GetType() {
+		case dto.MetricType_COUNTER:
+			value = m.GetCounter().GetValue()
+		case dto.MetricType_GAUGE:
+			value = m.GetGauge().GetValue()
+		case dto.MetricType_UNTYPED:
+			value = m.GetUntyped().GetValue()
+		default:
+			continue
+		}
+
+		labels := make(map[string]string)
+		for _, lp := range m.GetLabel() {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+		series[labelsKey(labels)] = &alertSeries{labels: labels, value: value}
+	}
+
+	return series
+}
+
+func matchAlertLabels(want, labels map[string]string) bool {
+	for key, val := range want {
+		if labels[key] != val {
+			return false
+		}
+	}
+	return true
+}
+
+// alertEvaluator periodically evaluates the configured alert rules against gathered metrics.
+// Raised alerts are recorded so that each is reported once until the series is observed back
+// within the rule's threshold. Alerts for series that are no longer reported, e.g. because an
+// engine is stopped, remain raised so that they aren't reported again when the series returns.
+type alertEvaluator struct {
+	log      logging.Logger
+	rules    []*config.TelemetryAlertRule
+	gatherer prometheus.Gatherer
+	publish  func(*events.RASEvent)
+	notify   func(context.Context, *telemetryAlert) error
+	hostname string
+	system   string
+	raised   map[string]*telemetryAlert
+}
+
+func newAlertEvaluator(log logging.Logger, cfg *config.TelemetryAlertsConfig, gatherer prometheus.Gatherer, publish func(*events.RASEvent), hostname, system string) *alertEvaluator {
+	ae := &alertEvaluator{
+		log:      log,
+		rules:    cfg.Rules,
+		gatherer: gatherer,
+		publish:  publish,
+		hostname: hostname,
+		system:   system,
+		raised:   make(map[string]*telemetryAlert),
+	}
+	if cfg.Webhook != "" {
+		ae.notify = newAlertWebhook(cfg.Webhook)
+	}
+
+	return ae
+}
+
+// newAlertWebhook returns a function that posts alerts as JSON to the given URL.
+func newAlertWebhook(url string) func(context.Context, *telemetryAlert) error {
+	client := &http.Client{Timeout: alertWebhookTimeout}
+
+	return func(ctx context.Context, alert *telemetryAlert) error {
+		body, err := json.Marshal(alert)
+		if err != nil {
+			return errors.Wrap(err, "marshal alert")
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return errors.Errorf("webhook returned %s", resp.Status)
+		}
+		return nil
+	}
+}
+
+func (ae *alertEvaluator) report(ctx context.Context, alert *telemetryAlert) {
+	ae.log.Noticef("telemetry alert %s %s: %s = %s", alert.Rule, alert.State, alert.series(),
+		formatAlertValue(alert.Value))
+	ae.publish(newTelemetryAlertEvent(alert))
+
+	if ae.notify == nil {
+		return
+	}
+	if err := ae.notify(ctx, alert); err != nil {
+		ae.log.Errorf("telemetry alerts: failed to post alert %s to webhook: %s", alert.Rule, err)
+	}
+}
+
+// evaluate gathers the current metrics and raises or clears alerts for each series that has
+// crossed a rule's threshold since the last evaluation.
+func (ae *alertEvaluator) evaluate(ctx context.Context, now time.Time) {
+	families, err := ae.gatherer.Gather()
+	if err != nil {
+		ae.log.Debugf("telemetry alerts: failed to gather some metrics: %s", err)
+	}
+	byName := make(map[string]*dto.MetricFamily)
+	for _, mf := range families {
+		byName[mf.GetName()] = mf
+	}
+
+	for _, rule := range ae.rules {
+		mf, found := byName[rule.Metric]
+		if !found {
+			continue
+		}
+
+		metric := rule.Metric
+		var divisors map[string]*alertSeries
+		if rule.Divisor != "" {
+			metric += "/" + rule.Divisor
+			divisors = familySeries(byName[rule.Divisor])
+		}
+
+		for key, series := range familySeries(mf) {
+			if !matchAlertLabels(rule.Labels, series.labels) {
+				continue
+			}
+
+			value := series.value
+			if divisors != nil {
+				div, found := divisors[key]
+				if !found || div.value == 0 {
+					continue
+				}
+				value /= div.value
+			}
+
+			alertKey := rule.Name + "/" + key
+			prev, raised := ae.raised[alertKey]
+			crossed := compareAlertValue(rule.Op, value, rule.Threshold)
+			if crossed == raised {
+				continue
+			}
+
+			alert := &telemetryAlert{
+				Rule:      rule.Name,
+				State:     alertStateRaised,
+				Severity:  rule.Severity,
+				Hostname:  ae.hostname,
+				System:    ae.system,
+				Metric:    metric,
+				Labels:    series.labels,
+				Value:     value,
+				Op:        rule.Op,
+				Threshold: rule.Threshold,
+				Timestamp: common.FormatTime(now),
+			}
+			if alert.Severity == "" {
+				alert.Severity = config.AlertSeverityWarning
+			}
+
+			if raised {
+				alert.State = alertStateCleared
+				alert.Severity = prev.Severity
+				delete(ae.raised, alertKey)
+			} else {
+				ae.raised[alertKey] = alert
+			}
+			ae.report(ctx, alert)
+		}
+	}
+}
+
+// run evaluates the alert rules at the given interval until the context is canceled.
+func (ae *alertEvaluator) run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			ae.evaluate(ctx, now)
+		}
+	}
+}
+
+// startTelemetryAlerts starts evaluating the configured alert rules against the server's
+// telemetry. The collectors are registered with a private registry so that alerting is
+// independent of the Prometheus endpoint.
+func startTelemetryAlerts(ctx context.Context, log logging.Logger, cfg *config.Server, hostname string, engines []Engine, svc *mgmtSvc, metrics *controlMetrics, publish func(*events.RASEvent)) (func(), error) {
+	reg := prometheus.NewRegistry()
+	if err := regTelemetryCollectors(ctx, log, reg, cfg.GetTelemetryCollect(), engines, svc, metrics); err != nil {
+		return nil, err
+	}
+
+	ae := newAlertEvaluator(log, cfg.TelemetryAlerts, reg, publish, hostname, cfg.SystemName)
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ae.run(ctx, cfg.TelemetryAlerts.GetInterval())
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+	}, nil
+}
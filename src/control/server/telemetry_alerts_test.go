@@ -0,0 +1,187 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_compareAlertValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		op     string
+		value  float64
+		expRes bool
+	}{
+		"greater":              {op: ">", value: 2, expRes: true},
+		"not greater":          {op: ">", value: 1},
+		"greater or equal":     {op: ">=", value: 1, expRes: true},
+		"less":                 {op: "<", value: 0, expRes: true},
+		"not less":             {op: "<", value: 1},
+		"less or equal":        {op: "<=", value: 1, expRes: true},
+		"equal":                {op: "==", value: 1, expRes: true},
+		"not equal":            {op: "!=", value: 2, expRes: true},
+		"unsupported operator": {op: "=>", value: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expRes, compareAlertValue(tc.op, tc.value, 1), "")
+		})
+	}
+}
+
+func TestServer_alertEvaluator_evaluate(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	reg := prometheus.NewRegistry()
+	temp := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "engine_nvme_temp_current",
+	}, []string{"rank", "device"})
+	free := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "system_pool_space_free_bytes",
+	}, []string{"pool", "tier"})
+	total := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "system_pool_space_total_bytes",
+	}, []string{"pool", "tier"})
+	reg.MustRegister(temp, free, total)
+
+	poolUUID := test.MockUUID(1)
+	temp.WithLabelValues("1", "0000:81:00.0").Set(340)
+	temp.WithLabelValues("1", "0000:82:00.0").Set(350)
+	free.WithLabelValues(poolUUID, "scm").Set(4)
+	total.WithLabelValues(poolUUID, "scm").Set(100)
+	free.WithLabelValues(poolUUID, "nvme").Set(1)
+	total.WithLabelValues(poolUUID, "nvme").Set(100)
+
+	var published []*events.RASEvent
+	var notified []*telemetryAlert
+	ae := newAlertEvaluator(log, &config.TelemetryAlertsConfig{
+		Rules: []*config.TelemetryAlertRule{
+			{
+				Name:      "nvme_hot",
+				Metric:    "engine_nvme_temp_current",
+				Op:        ">",
+				Threshold: 343,
+				Severity:  config.AlertSeverityError,
+			},
+			{
+				Name:      "scm_low",
+				Metric:    "system_pool_space_free_bytes",
+				Divisor:   "system_pool_space_total_bytes",
+				Labels:    map[string]string{"tier": "scm"},
+				Op:        "<",
+				Threshold: 0.05,
+			},
+		},
+	}, reg, func(evt *events.RASEvent) {
+		published = append(published, evt)
+	}, "host1", "daos_server")
+	ae.notify = func(_ context.Context, alert *telemetryAlert) error {
+		notified = append(notified, alert)
+		return nil
+	}
+
+	ae.evaluate(test.Context(t), time.Now())
+
+	if len(published) != 2 {
+		t.Fatalf("expected 2 raised events, got %d", len(published))
+	}
+	for _, evt := range published {
+		test.AssertEqual(t, events.RASTelemetryAlertRaised, evt.ID, "unexpected event ID")
+		test.AssertEqual(t, "host1", evt.Hostname, "unexpected event hostname")
+		switch evt.PoolUUID {
+		case "":
+			test.AssertEqual(t, events.RASSeverityError, evt.Severity, "unexpected severity")
+			test.AssertEqual(t, uint32(1), evt.Rank, "unexpected event rank")
+		case poolUUID:
+			test.AssertEqual(t, events.RASSeverityWarning, evt.Severity, "unexpected severity")
+		default:
+			t.Fatalf("unexpected pool %q", evt.PoolUUID)
+		}
+	}
+	if len(notified) != 2 {
+		t.Fatalf("expected 2 webhook notifications, got %d", len(notified))
+	}
+
+	// Alerts that remain raised are not reported again.
+	ae.evaluate(test.Context(t), time.Now())
+	test.AssertEqual(t, 2, len(published), "unexpected events after second evaluation")
+
+	temp.WithLabelValues("1", "0000:82:00.0").Set(330)
+	ae.evaluate(test.Context(t), time.Now())
+
+	if len(published) != 3 {
+		t.Fatalf("expected a cleared event, got %d events", len(published))
+	}
+	cleared := published[2]
+	test.AssertEqual(t, events.RASTelemetryAlertCleared, cleared.ID, "unexpected event ID")
+	test.AssertEqual(t, events.RASSeverityNotice, cleared.Severity, "unexpected severity")
+	test.AssertEqual(t, "nvme_hot", notified[2].Rule, "unexpected cleared rule")
+	test.AssertEqual(t, alertStateCleared, notified[2].State, "unexpected cleared state")
+	test.AssertEqual(t, config.AlertSeverityError, notified[2].Severity, "unexpected cleared severity")
+}
+
+func TestServer_alertWebhook(t *testing.T) {
+	var mu sync.Mutex
+	var received []*telemetryAlert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return
+		}
+		alert := new(telemetryAlert)
+		if err := json.NewDecoder(r.Body).Decode(alert); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		received = append(received, alert)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	alert := &telemetryAlert{
+		Rule:      "nvme_hot",
+		State:     alertStateRaised,
+		Metric:    "engine_nvme_temp_current",
+		Labels:    map[string]string{"rank": "1"},
+		Value:     350,
+		Op:        ">",
+		Threshold: 343,
+	}
+	if err := newAlertWebhook(srv.URL)(test.Context(t), alert); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(received) != 1 {
+		t.Fatalf("expected 1 alert to be posted, got %d", len(received))
+	}
+	test.AssertEqual(t, alert, received[0], "unexpected alert posted")
+
+	failSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failSrv.Close()
+
+	err := newAlertWebhook(failSrv.URL)(test.Context(t), alert)
+	test.CmpErr(t, errors.New("webhook returned 500"), err)
+}
@@ -0,0 +1,113 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+)
+
+// controlMetrics holds the metrics recorded for the gRPC requests handled by the control plane.
+// The metrics are created once for the lifetime of the server so that their values are retained
+// across restarts of the telemetry exporters.
+type controlMetrics struct {
+	grpcRequests *prometheus.CounterVec
+	grpcDuration *prometheus.HistogramVec
+}
+
+func newControlMetrics() *controlMetrics {
+	return &controlMetrics{
+		grpcRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "control",
+			Subsystem: "grpc",
+			Name:      "requests_total",
+			Help:      "Number of gRPC requests handled by the control plane.",
+		}, []string{"method", "code"}),
+		grpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "control",
+			Subsystem: "grpc",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken to handle gRPC requests to the control plane.",
+			// Management requests range from milliseconds to minutes, e.g. storage format.
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"method"}),
+	}
+}
+
+// observeRequest records the result and duration of a gRPC request.
+func (cm *controlMetrics) observeRequest(fullMethod string, code codes.Code, elapsed time.Duration) {
+	method := strings.TrimPrefix(fullMethod, "/")
+	cm.grpcRequests.WithLabelValues(method, code.String()).Inc()
+	cm.grpcDuration.WithLabelValues(method).Observe(elapsed.Seconds())
+}
+
+// drpcCollector exports the number of outstanding dRPC calls to each engine. A growing count
+// indicates that the engine is slow to service requests from the control plane.
+type drpcCollector struct {
+	engines  []Engine
+	inFlight *prometheus.Desc
+}
+
+func newDrpcCollector(engines []Engine) *drpcCollector {
+	return &drpcCollector{
+		engines: engines,
+		inFlight: prometheus.NewDesc(
+			prometheus.BuildFQName("control", "drpc", "calls_in_flight"),
+			"Number of dRPC calls to the engine that have not completed.",
+			[]string{"engine"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *drpcCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.inFlight
+}
+
+// Collect implements prometheus.Collector.
+func (c *drpcCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, ei := range c.engines {
+		ch <- prometheus.MustNewConstMetric(c.inFlight, prometheus.GaugeValue,
+			float64(ei.DrpcCallsInFlight()), fmt.Sprintf("%d", ei.Index()))
+	}
+}
+
+// registerOnce registers the collector, ignoring the error if it has already been registered,
+// e.g. by a previous start of the exporter.
+func registerOnce(reg prometheus.Registerer, c prometheus.Collector) error {
+	if err := reg.Register(c); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// regControlCollectors registers the collectors of the control plane's own metrics: those of
+// the Go runtime and process, gRPC requests handled and dRPC calls to the engines.
+func regControlCollectors(reg prometheus.Registerer, metrics *controlMetrics, engines []Engine) error {
+	collectors := []prometheus.Collector{
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		newDrpcCollector(engines),
+	}
+	if metrics != nil {
+		collectors = append(collectors, metrics.grpcRequests, metrics.grpcDuration)
+	}
+
+	for _, c := range collectors {
+		if err := registerOnce(reg, c); err != nil {
+			return errors.Wrap(err, "registering control plane collector")
+		}
+	}
+
+	return nil
+}
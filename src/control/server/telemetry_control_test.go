@@ -0,0 +1,56 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestServer_regControlCollectors(t *testing.T) {
+	engines := []Engine{
+		NewMockInstance(&MockInstanceConfig{Index: 0, DrpcCallsInFlight: 3}),
+		NewMockInstance(&MockInstanceConfig{Index: 1}),
+	}
+
+	reg := prometheus.NewRegistry()
+	metrics := newControlMetrics()
+	if err := regControlCollectors(reg, metrics, engines); err != nil {
+		t.Fatal(err)
+	}
+	// Registering again, e.g. on restart of the exporter, is not an error.
+	if err := regControlCollectors(reg, metrics, engines); err != nil {
+		t.Fatal(err)
+	}
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inFlight := make(map[string]float64)
+	var foundGoroutines bool
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "go_goroutines":
+			foundGoroutines = true
+		case "control_drpc_calls_in_flight":
+			for _, m := range mf.GetMetric() {
+				inFlight[m.GetLabel()[0].GetValue()] = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	test.AssertTrue(t, foundGoroutines, "expected Go runtime metrics to be exported")
+	if diff := cmp.Diff(map[string]float64{"0": 3, "1": 0}, inFlight); diff != "" {
+		t.Fatalf("unexpected dRPC calls in flight (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,203 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+// collectedMetrics returns the metrics gathered by the collector, formatted as
+// "name{label=value,...} value" and sorted.
+func collectedMetrics(t *testing.T, c prometheus.Collector) []string {
+	t.Helper()
+
+	ch := make(chan prometheus.Metric)
+	go func() {
+		c.Collect(ch)
+		close(ch)
+	}()
+
+	var out []string
+	for m := range ch {
+		pb := new(dto.Metric)
+		if err := m.Write(pb); err != nil {
+			t.Fatal(err)
+		}
+
+		name := strings.Split(strings.Split(m.Desc().String(), `fqName: "`)[1], `"`)[0]
+		var labels []string
+		for _, lp := range pb.GetLabel() {
+			labels = append(labels, fmt.Sprintf("%s=%s", lp.GetName(), lp.GetValue()))
+		}
+		out = append(out, fmt.Sprintf("%s{%s} %g", name, strings.Join(labels, ","),
+			pb.GetGauge().GetValue()))
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+func TestServer_poolCollector_Collect(t *testing.T) {
+	poolResp := &mgmtpb.PoolQueryResp{
+		Uuid:            mockUUID,
+		Label:           "pool1",
+		TotalTargets:    4,
+		ActiveTargets:   3,
+		DisabledTargets: 1,
+		TotalEngines:    2,
+		EnabledRanks:    "0",
+		DisabledRanks:   "1",
+		TierStats: []*mgmtpb.StorageUsageStats{
+			{Total: 100, Free: 40, MediaType: mgmtpb.StorageMediaType_SCM},
+			{Total: 1000, Free: 600, MediaType: mgmtpb.StorageMediaType_NVME},
+		},
+		Rebuild: &mgmtpb.PoolRebuildStatus{
+			State:   mgmtpb.PoolRebuildStatus_BUSY,
+			Objects: 10,
+			Records: 20,
+		},
+	}
+	poolMetrics := []string{
+		"system_pool_rebuild_objects{label=pool1,pool=" + mockUUID + "} 10",
+		"system_pool_rebuild_records{label=pool1,pool=" + mockUUID + "} 20",
+		"system_pool_rebuild_state{label=pool1,pool=" + mockUUID + ",state=busy} 1",
+		"system_pool_rebuild_state{label=pool1,pool=" + mockUUID + ",state=done} 0",
+		"system_pool_rebuild_state{label=pool1,pool=" + mockUUID + ",state=idle} 0",
+		"system_pool_rebuild_status{label=pool1,pool=" + mockUUID + "} 0",
+		"system_pool_space_free_bytes{label=pool1,pool=" + mockUUID + ",tier=nvme} 600",
+		"system_pool_space_free_bytes{label=pool1,pool=" + mockUUID + ",tier=scm} 40",
+		"system_pool_space_total_bytes{label=pool1,pool=" + mockUUID + ",tier=nvme} 1000",
+		"system_pool_space_total_bytes{label=pool1,pool=" + mockUUID + ",tier=scm} 100",
+		"system_pool_targets{label=pool1,pool=" + mockUUID + ",state=active} 3",
+		"system_pool_targets{label=pool1,pool=" + mockUUID + ",state=disabled} 1",
+	}
+	targetMetrics := []string{
+		"system_pool_target_state{label=pool1,pool=" + mockUUID + ",rank=0,state=up_in,target=0} 1",
+		"system_pool_target_state{label=pool1,pool=" + mockUUID + ",rank=0,state=up_in,target=1} 1",
+		"system_pool_target_state{label=pool1,pool=" + mockUUID + ",rank=1,state=down,target=0} 1",
+		"system_pool_target_state{label=pool1,pool=" + mockUUID + ",rank=1,state=up_in,target=1} 1",
+	}
+	targetResps := []*mockDrpcResponse{
+		{
+			Message: poolResp,
+		},
+		{
+			Message: &mgmtpb.PoolQueryTargetResp{
+				Infos: []*mgmtpb.PoolQueryTargetInfo{
+					{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+					{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+				},
+			},
+		},
+		{
+			Message: &mgmtpb.PoolQueryTargetResp{
+				Infos: []*mgmtpb.PoolQueryTargetInfo{
+					{State: mgmtpb.PoolQueryTargetInfo_DOWN},
+					{State: mgmtpb.PoolQueryTargetInfo_UP_IN},
+				},
+			},
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		notLeader      bool
+		collectPools   bool
+		collectTargets bool
+		drpcResps      []*mockDrpcResponse
+		expMetrics     []string
+		expMethods     []int32
+	}{
+		"not leader": {
+			notLeader:    true,
+			collectPools: true,
+			drpcResps: []*mockDrpcResponse{
+				{Message: poolResp},
+			},
+		},
+		"pool set": {
+			collectPools: true,
+			drpcResps: []*mockDrpcResponse{
+				{Message: poolResp},
+			},
+			expMetrics: poolMetrics,
+			expMethods: []int32{daos.MethodPoolQuery.ID()},
+		},
+		"pool query fails": {
+			collectPools: true,
+			drpcResps: []*mockDrpcResponse{
+				{Message: &mgmtpb.PoolQueryResp{Status: -1005}},
+			},
+			expMethods: []int32{daos.MethodPoolQuery.ID()},
+		},
+		"target set": {
+			collectTargets: true,
+			drpcResps:      targetResps,
+			expMetrics:     targetMetrics,
+			expMethods: []int32{
+				daos.MethodPoolQuery.ID(),
+				daos.MethodPoolQueryTarget.ID(),
+				daos.MethodPoolQueryTarget.ID(),
+			},
+		},
+		"pool and target sets": {
+			collectPools:   true,
+			collectTargets: true,
+			drpcResps:      targetResps,
+			expMetrics:     append(append([]string{}, poolMetrics...), targetMetrics...),
+			expMethods: []int32{
+				daos.MethodPoolQuery.ID(),
+				daos.MethodPoolQueryTarget.ID(),
+				daos.MethodPoolQueryTarget.ID(),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			addTestPools(t, svc.sysdb, mockUUID)
+			if tc.notLeader {
+				svc.sysdb = raft.MockDatabaseWithCfg(t, log, &raft.DatabaseConfig{
+					SystemName: build.DefaultSystemName,
+					Replicas:   []*net.TCPAddr{{IP: net.IP{111, 222, 1, 1}}},
+				})
+			}
+
+			cfg := new(mockDrpcClientConfig)
+			cfg.setSendMsgResponseList(t, tc.drpcResps...)
+			mdc := newMockDrpcClient(cfg)
+			setupSvcDrpcClient(svc, 0, mdc)
+
+			c := newPoolCollector(log, svc, tc.collectPools, tc.collectTargets)
+			gotMetrics := collectedMetrics(t, c)
+
+			sort.Strings(tc.expMetrics)
+			if diff := cmp.Diff(tc.expMetrics, gotMetrics); diff != "" {
+				t.Fatalf("unexpected metrics (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expMethods, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
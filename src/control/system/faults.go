@@ -50,6 +50,14 @@ func FaultJoinReplaceRankNotFound(nrFieldsNotMatching int) *fault.Fault {
 	)
 }
 
+// FaultPoolProfileNotFound generates a fault indicating that the named pool profile is not
+// stored by the management service.
+func FaultPoolProfileNotFound(name string) *fault.Fault {
+	return systemFault(code.SystemPoolProfileNotFound,
+		fmt.Sprintf("pool profile %q not found", name),
+		"run 'dmg pool profile list' to see the stored pool profiles")
+}
+
 func systemFault(code code.Code, desc, res string) *fault.Fault {
 	return &fault.Fault{
 		Domain:      "system",
@@ -0,0 +1,69 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SetPoolProfile stores the encoded pool profile for the supplied name, replacing any existing
+// profile with the same name.
+func SetPoolProfile(db SysAttrSetter, name, value string) error {
+	if name == "" {
+		return errors.New("empty pool profile name")
+	}
+	if value == "" {
+		return errors.New("empty pool profile")
+	}
+
+	return db.SetSystemAttrs(map[string]string{poolProfilePrefix + name: value})
+}
+
+// GetPoolProfiles returns the encoded pool profiles for the supplied names, or all stored pool
+// profiles if no names are supplied.
+func GetPoolProfiles(db SysAttrGetter, names []string) (map[string]string, error) {
+	attrs, err := db.GetSystemAttrs(nil, func(k string) bool {
+		return !strings.HasPrefix(k, poolProfilePrefix)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]string)
+	for k, v := range attrs {
+		profiles[strings.TrimPrefix(k, poolProfilePrefix)] = v
+	}
+
+	if len(names) == 0 {
+		return profiles, nil
+	}
+
+	out := make(map[string]string)
+	for _, name := range names {
+		value, found := profiles[name]
+		if !found {
+			return nil, FaultPoolProfileNotFound(name)
+		}
+		out[name] = value
+	}
+
+	return out, nil
+}
+
+// DelPoolProfile deletes the pool profile for the supplied name.
+func DelPoolProfile(db interface {
+	SysAttrGetter
+	SysAttrSetter
+}, name string) error {
+	if _, err := GetPoolProfiles(db, []string{name}); err != nil {
+		return err
+	}
+
+	return db.SetSystemAttrs(map[string]string{poolProfilePrefix + name: ""})
+}
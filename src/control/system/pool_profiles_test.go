@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_PoolProfiles(t *testing.T) {
+	attrDb := newAttrDb(map[string]string{
+		"foo":                "bar",
+		mgmtPropPrefix + "x": "y",
+	})
+
+	test.CmpErr(t, errors.New("empty pool profile name"), SetPoolProfile(attrDb, "", "{}"))
+	test.CmpErr(t, errors.New("empty pool profile"), SetPoolProfile(attrDb, "prod", ""))
+
+	for name, value := range map[string]string{
+		"prod": `{"tier_ratio":[0.1,0.9]}`,
+		"test": `{}`,
+	} {
+		if err := SetPoolProfile(attrDb, name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Profiles are stored as reserved attributes.
+	if err := SetAttributes(attrDb, map[string]string{poolProfilePrefix + "x": "y"}); err == nil {
+		t.Fatal("expected error setting reserved pool profile key")
+	}
+
+	gotAll, err := GetPoolProfiles(attrDb, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{
+		"prod": `{"tier_ratio":[0.1,0.9]}`,
+		"test": `{}`,
+	}, gotAll); diff != "" {
+		t.Fatalf("unexpected profiles (-want +got):\n%s", diff)
+	}
+
+	gotOne, err := GetPoolProfiles(attrDb, []string{"test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"test": `{}`}, gotOne); diff != "" {
+		t.Fatalf("unexpected profiles (-want +got):\n%s", diff)
+	}
+
+	_, err = GetPoolProfiles(attrDb, []string{"missing"})
+	test.CmpErr(t, FaultPoolProfileNotFound("missing"), err)
+
+	test.CmpErr(t, FaultPoolProfileNotFound("missing"), DelPoolProfile(attrDb, "missing"))
+	if err := DelPoolProfile(attrDb, "test"); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(map[string]string{
+		"foo":                      "bar",
+		mgmtPropPrefix + "x":       "y",
+		poolProfilePrefix + "prod": `{"tier_ratio":[0.1,0.9]}`,
+	}, attrDb.attrs); diff != "" {
+		t.Fatalf("unexpected attributes (-want +got):\n%s", diff)
+	}
+}
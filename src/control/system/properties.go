@@ -1,5 +1,6 @@
 //
 // (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -19,10 +20,13 @@ const (
 	userPropPrefix = "prop."
 	// mgmtPropPrefix is the prefix for MS properties.
 	mgmtPropPrefix = "mgmt."
+	// poolProfilePrefix is the prefix for stored pool profiles.
+	poolProfilePrefix = "pool_profile."
 )
 
 func isReservedKey(key string) bool {
-	return strings.HasPrefix(key, userPropPrefix) || strings.HasPrefix(key, mgmtPropPrefix)
+	return strings.HasPrefix(key, userPropPrefix) || strings.HasPrefix(key, mgmtPropPrefix) ||
+		strings.HasPrefix(key, poolProfilePrefix)
 }
 
 // SetMgmtProperty updates the MS property for the supplied key/value.
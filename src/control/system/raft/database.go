@@ -78,6 +78,7 @@ type (
 		Members       *MemberDatabase
 		Pools         *PoolDatabase
 		Checker       *CheckerDatabase
+		Events        *EventDatabase
 		System        *SystemDatabase
 		SchemaVersion uint
 	}
@@ -269,6 +270,7 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			Checker: &CheckerDatabase{
 				Findings: make(CheckerFindingMap),
 			},
+			Events: &EventDatabase{},
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
@@ -0,0 +1,127 @@
+//
+// (C) Copyright 2025 Hewlett Packard Enterprise Development LP
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
+)
+
+// MaxRetainedEvents is the number of most recent RAS events retained in the database.
+const MaxRetainedEvents = 2048
+
+type (
+	// EventRecord is a RAS event retained in the database along with the sequence number
+	// assigned to it when it was retained.
+	EventRecord struct {
+		Seq   uint64
+		Event *sharedpb.RASEvent
+	}
+
+	// EventDatabase is the database containing the most recent RAS events raised in the
+	// system, in the order they were retained.
+	EventDatabase struct {
+		LastSeq uint64
+		Records []*EventRecord
+	}
+)
+
+// MarshalJSON marshals the record to JSON, using the canonical JSON encoding for the event.
+func (er *EventRecord) MarshalJSON() ([]byte, error) {
+	evt, err := protojson.Marshal(er.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&struct {
+		Seq   uint64
+		Event json.RawMessage
+	}{
+		Seq:   er.Seq,
+		Event: evt,
+	})
+}
+
+// UnmarshalJSON unmarshals the record from JSON.
+func (er *EventRecord) UnmarshalJSON(data []byte) error {
+	from := &struct {
+		Seq   uint64
+		Event json.RawMessage
+	}{}
+	if err := json.Unmarshal(data, from); err != nil {
+		return err
+	}
+
+	er.Seq = from.Seq
+	er.Event = new(sharedpb.RASEvent)
+	return protojson.Unmarshal(from.Event, er.Event)
+}
+
+func copyEventRecord(in *EventRecord) *EventRecord {
+	return &EventRecord{
+		Seq:   in.Seq,
+		Event: proto.Clone(in.Event).(*sharedpb.RASEvent),
+	}
+}
+
+// addEvent retains the event with the next sequence number, discarding the oldest events
+// once the maximum number are retained.
+func (edb *EventDatabase) addEvent(evt *sharedpb.RASEvent) {
+	edb.LastSeq++
+	edb.Records = append(edb.Records, &EventRecord{Seq: edb.LastSeq, Event: evt})
+	if excess := len(edb.Records) - MaxRetainedEvents; excess > 0 {
+		edb.Records = append(edb.Records[:0:0], edb.Records[excess:]...)
+	}
+}
+
+// AddEvent retains a RAS event in the database.
+func (db *Database) AddEvent(evt *events.RASEvent) error {
+	if evt == nil {
+		return errors.New("nil event")
+	}
+
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		return errors.Wrap(err, "convert event to proto")
+	}
+
+	db.Lock()
+	defer db.Unlock()
+
+	return db.submitEventUpdate(&EventRecord{Event: pbEvt})
+}
+
+// GetEvents returns the retained events with a sequence number greater than the one supplied
+// along with the sequence number of the most recently retained event.
+func (db *Database) GetEvents(afterSeq uint64) ([]*EventRecord, uint64, error) {
+	if err := db.CheckReplica(); err != nil {
+		return nil, 0, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := make([]*EventRecord, 0, len(db.data.Events.Records))
+	for _, rec := range db.data.Events.Records {
+		if rec.Seq <= afterSeq {
+			continue
+		}
+		out = append(out, copyEventRecord(rec))
+	}
+
+	return out, db.data.Events.LastSeq, nil
+}
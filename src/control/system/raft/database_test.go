@@ -29,6 +29,7 @@ import (
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/events"
 	. "github.com/daos-stack/daos/src/control/lib/ranklist"
@@ -236,6 +237,7 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 	maxPools := 1024
 	maxAttrs := 4096
 	maxFindings := 512
+	maxEvents := 256
 
 	log, buf := logging.NewTestLogger(t.Name())
 	defer test.ShowBufferOnFailure(t, buf)
@@ -301,6 +303,22 @@ func TestSystem_Database_SnapshotRestore(t *testing.T) {
 		(*fsm)(db0).Apply(rl)
 	}
 
+	for i := 0; i < maxEvents; i++ {
+		evt, err := events.NewGenericEvent(events.RASEngineDied, events.RASSeverityError,
+			fmt.Sprintf("event %d", i), "info").ToProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := createRaftUpdate(raftOpAddEvent, &EventRecord{Event: evt})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rl := &raft.Log{
+			Data: data,
+		}
+		(*fsm)(db0).Apply(rl)
+	}
+
 	attrs := make(map[string]string)
 	for i := 0; i < maxAttrs; i++ {
 		attrs[fmt.Sprintf("prop%04d", i)] = fmt.Sprintf("value%04d", i)
@@ -957,6 +975,53 @@ func TestSystem_Database_OnEvent(t *testing.T) {
 	}
 }
 
+func TestSystem_Database_Events(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+
+	test.CmpErr(t, errors.New("nil event"), db.AddEvent(nil))
+
+	numEvents := MaxRetainedEvents + 2
+	for i := 0; i < numEvents; i++ {
+		evt := events.NewEngineDiedEvent("foo", 0, uint32(i), 1, common.ExitStatus("test"), 42)
+		if err := db.AddEvent(evt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, lastSeq, err := db.GetEvents(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(numEvents), lastSeq, "unexpected last sequence number")
+	test.AssertEqual(t, MaxRetainedEvents, len(records), "unexpected number of retained events")
+	// The oldest events are discarded once the maximum number are retained.
+	test.AssertEqual(t, uint64(3), records[0].Seq, "unexpected oldest sequence number")
+	test.AssertEqual(t, uint32(2), records[0].Event.Rank, "unexpected oldest event")
+	if _, ok := records[0].Event.ExtendedInfo.(*sharedpb.RASEvent_EngineStateInfo); !ok {
+		t.Fatalf("unexpected extended info %T", records[0].Event.ExtendedInfo)
+	}
+
+	records, _, err = db.GetEvents(lastSeq - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(records), "unexpected number of events after sequence number")
+	test.AssertEqual(t, lastSeq, records[0].Seq, "unexpected sequence number")
+
+	// Returned events must not alias the retained ones.
+	records[0].Event.Msg = "changed"
+	records, _, err = db.GetEvents(lastSeq - 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records[0].Event.Msg == "changed" {
+		t.Fatal("retained event modified through returned copy")
+	}
+}
+
 func TestSystem_Database_PoolServiceList(t *testing.T) {
 	ready := &PoolService{
 		PoolUUID:   uuid.New(),
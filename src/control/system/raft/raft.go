@@ -52,6 +52,7 @@ const (
 	raftOpUpdateCheckerFinding
 	raftOpRemoveCheckerFinding
 	raftOpClearCheckerFindings
+	raftOpAddEvent
 
 	sysDBFile = "daos_system.db"
 )
@@ -91,6 +92,7 @@ func (ro raftOp) String() string {
 		"updateCheckerFinding",
 		"removeCheckerFinding",
 		"clearCheckerFindings",
+		"addEvent",
 	}[ro]
 }
 
@@ -529,6 +531,16 @@ func (db *Database) submitCheckerUpdate(op raftOp, f *checker.Finding) error {
 	return db.submitRaftUpdate(data)
 }
 
+// submitEventUpdate submits the given RAS event to be retained. The sequence number is
+// assigned when the update is applied.
+func (db *Database) submitEventUpdate(rec *EventRecord) error {
+	data, err := createRaftUpdate(raftOpAddEvent, rec)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
@@ -588,6 +600,8 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	case raftOpAddCheckerFinding, raftOpUpdateCheckerFinding, raftOpRemoveCheckerFinding, raftOpClearCheckerFindings:
 		f.data.applyCheckerUpdate(c.Op, c.Data, f.EmergencyShutdown)
+	case raftOpAddEvent:
+		f.data.applyEventUpdate(c.Op, c.Data, f.EmergencyShutdown)
 	default:
 		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
 		return nil
@@ -736,6 +750,27 @@ func (d *dbData) applyCheckerUpdate(op raftOp, data []byte, panicFn func(error))
 	}
 }
 
+// applyEventUpdate is responsible for applying the RAS event update
+// operation to the database.
+func (d *dbData) applyEventUpdate(op raftOp, data []byte, panicFn func(error)) {
+	rec := new(EventRecord)
+	if err := json.Unmarshal(data, rec); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode event update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddEvent:
+		d.Events.addEvent(rec.Event)
+	default:
+		panicFn(errors.Errorf("unhandled Event Apply operation: %d", op))
+		return
+	}
+}
+
 // Snapshot is called to support log compaction, so that we don't have to keep
 // every log entry from the start of the system. Instead, the raft service periodically
 // creates a point-in-time snapshot which can be used to restore the current state, or
@@ -772,6 +807,7 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Checker = db.data.Checker
+	f.data.Events = db.data.Events
 	f.data.Version = db.data.Version
 	f.data.Unlock()
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
@@ -269,7 +269,7 @@ const ProtobufCMessageDescriptor shared__rasevent__pool_svc_event_info__descript
   (ProtobufCMessageInit) shared__rasevent__pool_svc_event_info__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor shared__rasevent__field_descriptors[19] =
+static const ProtobufCFieldDescriptor shared__rasevent__field_descriptors[21] =
 {
   {
     "id",
@@ -498,10 +498,35 @@ static const ProtobufCFieldDescriptor shared__rasevent__field_descriptors[19] =
     NULL,
     0 | PROTOBUF_C_FIELD_FLAG_ONEOF,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
+  },  {
+    "correlation_id",
+    20,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Shared__RASEvent, correlation_id),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "count",
+    21,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Shared__RASEvent, count),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
   },
 };
 static const unsigned shared__rasevent__field_indices_by_name[] = {
   13,   /* field[13] = cont_uuid */
+  19,   /* field[19] = correlation_id */
+  20,   /* field[20] = count */
   15,   /* field[15] = ctl_op */
   17,   /* field[17] = engine_state_info */
   5,   /* field[5] = hostname */
@@ -524,7 +549,7 @@ static const unsigned shared__rasevent__field_indices_by_name[] = {
 static const ProtobufCIntRange shared__rasevent__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 19 }
+  { 0, 21 }
 };
 const ProtobufCMessageDescriptor shared__rasevent__descriptor =
 {
@@ -534,7 +559,7 @@ const ProtobufCMessageDescriptor shared__rasevent__descriptor =
   "Shared__RASEvent",
   "shared",
   sizeof(Shared__RASEvent),
-  19,
+  21,
   shared__rasevent__field_descriptors,
   shared__rasevent__field_indices_by_name,
   1,  shared__rasevent__number_ranges,
@@ -150,6 +150,14 @@ struct  _Shared__RASEvent
    * (optional) Recommended automatic action.
    */
   char *ctl_op;
+  /*
+   * (optional) Management operation that raised event.
+   */
+  char *correlation_id;
+  /*
+   * (optional) Occurrences of a rate-limited event.
+   */
+  uint32_t count;
   Shared__RASEvent__ExtendedInfoCase extended_info_case;
   union {
     /*
@@ -162,7 +170,7 @@ struct  _Shared__RASEvent
 };
 #define SHARED__RASEVENT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&shared__rasevent__descriptor) \
-    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, SHARED__RASEVENT__EXTENDED_INFO__NOT_SET, {0} }
+    , 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, 0, 0, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0, SHARED__RASEVENT__EXTENDED_INFO__NOT_SET, {0} }
 
 
 /*
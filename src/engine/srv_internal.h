@@ -199,7 +199,6 @@ int dss_module_cleanup_all(void);
 extern struct dss_module_key daos_srv_modkey;
 int dss_srv_init(void);
 int dss_srv_fini(bool force);
-void dss_srv_set_shutting_down(void);
 void dss_dump_ABT_state(FILE *fp);
 void
 		    dss_xstreams_open_barrier(bool stopping);
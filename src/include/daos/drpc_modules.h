@@ -29,6 +29,7 @@ enum drpc_module {
 
 enum drpc_sec_agent_method {
 	DRPC_METHOD_SEC_AGENT_REQUEST_CREDS	= 101,
+	DRPC_METHOD_SEC_AGENT_REQUEST_DELEGATED_CREDS	= 102,
 
 	NUM_DRPC_SEC_AGENT_METHODS		/* Must be last */
 };
@@ -80,6 +81,8 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_POOL_REBUILD_START     = 250,
 	DRPC_METHOD_MGMT_POOL_REBUILD_STOP      = 251,
 	DRPC_METHOD_MGMT_POOL_SELF_HEAL_EVAL    = 252,
+	DRPC_METHOD_MGMT_POOL_RESIZE            = 253,
+	DRPC_METHOD_MGMT_DRAIN_RANK             = 255,
 
 	NUM_DRPC_MGMT_METHODS /* Must be last */
 };
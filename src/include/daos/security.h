@@ -1,5 +1,6 @@
 /*
  * (C) Copyright 2018-2023 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -39,6 +40,28 @@ struct d_ownership {
  */
 int dc_sec_request_creds(d_iov_t *creds);
 
+/** Environment variable with the uid that a delegating client requests credentials for */
+#define DAOS_DELEGATED_UID_ENV	"DAOS_DELEGATED_UID"
+
+/**
+ * Request security credentials from the DAOS agent on behalf of a user mapped
+ * to the calling (delegating) user in the agent's delegation map, such as the
+ * end user of a container runtime. dc_sec_request_creds() requests delegated
+ * credentials instead of those of the calling user when the uid is set in the
+ * DAOS_DELEGATED_UID environment variable.
+ *
+ * \param[in]	uid		Mapped uid of the user.
+ * \param[out]	creds		Returned security credentials for the user.
+ *
+ * \return	0		Success. The security credential has
+ *				been returned in \a creds.
+ *		-DER_INVAL	Invalid parameter
+ *		-DER_NO_PERM	The calling user may not request credentials
+ *				for \a uid
+ *		Other errors as for dc_sec_request_creds()
+ */
+int dc_sec_request_delegated_creds(uint32_t uid, d_iov_t *creds);
+
 /**
  * Request a user's permissions for a specific pool.
  *
@@ -628,6 +628,16 @@ int bio_ioctxt_close(struct bio_io_context *ctxt);
  */
 int bio_blob_unmap(struct bio_io_context *ctxt, uint64_t off, uint64_t len);
 
+/*
+ * Grow the blob of an I/O context, the new size is recorded in SMD on success.
+ *
+ * \param[IN] ctxt	I/O context
+ * \param[IN] blob_sz	New blob size in bytes, can't be smaller than current size
+ *
+ * \returns		Zero on success, negative value on error
+ */
+int bio_blob_resize(struct bio_io_context *ctxt, uint64_t blob_sz);
+
 /*
  * Unmap (TRIM) a SGL consists of freed extents.
  *
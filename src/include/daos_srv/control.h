@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2020-2024 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -52,11 +53,20 @@ dpdk_cli_override_opts;
 /** NVMe config keys */
 #define NVME_CONF_ATTACH_CONTROLLER	"bdev_nvme_attach_controller"
 #define NVME_CONF_AIO_CREATE		"bdev_aio_create"
+#define NVME_CONF_NULL_CREATE		"bdev_null_create"
+#define NVME_CONF_MALLOC_CREATE		"bdev_malloc_create"
+#define NVME_CONF_RAID_CREATE		"bdev_raid_create"
+#define NVME_CONF_DELAY_CREATE		"bdev_delay_create"
+#define NVME_CONF_CRYPTO_CREATE		"bdev_crypto_create"
+#define NVME_CONF_OCF_CREATE		"bdev_ocf_create"
 #define NVME_CONF_ENABLE_VMD		"enable_vmd"
 #define NVME_CONF_SET_HOTPLUG_RANGE	"hotplug_busid_range"
 #define NVME_CONF_SET_ACCEL_PROPS	"accel_props"
 #define NVME_CONF_SET_SPDK_RPC_SERVER	"spdk_rpc_srv"
 #define NVME_CONF_SET_AUTO_FAULTY       "auto_faulty"
+#define NVME_CONF_SET_SPDK_ENV_OPTS	"spdk_env_opts"
+#define NVME_CONF_SET_SPDK_LOG		"spdk_log"
+#define NVME_CONF_SET_NS_SELECT		"nvme_ns_select"
 
 /** Supported acceleration engine settings */
 #define NVME_ACCEL_NONE		"none"
@@ -155,6 +165,7 @@ struct nvme_ctrlr_t {
 struct nvme_ns_t {
 	uint32_t          id;
 	uint64_t          size;
+	bool              zoned;
 	struct nvme_ns_t *next;
 };
 
@@ -126,6 +126,13 @@ dss_current_xstream(void)
 bool
 dss_srv_shutting_down(void);
 
+/**
+ * Put the engine into shutdown mode so that new pool connections are refused
+ * ahead of a controlled shutdown.
+ */
+void
+dss_srv_set_shutting_down(void);
+
 /**
  * Module facility feature bits
  * DSS_FAC_LOAD_CLI - the module requires loading client stack.
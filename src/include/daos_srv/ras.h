@@ -65,7 +65,17 @@
 	X(RAS_SYSTEM_FABRIC_PROV_CHANGED, "system_fabric_provider_changed")                        \
 	X(RAS_ENGINE_JOIN_FAILED, "engine_join_failed")                                            \
 	X(RAS_DEVICE_LINK_SPEED_CHANGED, "device_link_speed_changed")                              \
-	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")
+	X(RAS_DEVICE_LINK_WIDTH_CHANGED, "device_link_width_changed")                              \
+	X(RAS_ENGINE_SCM_REMOUNTED, "engine_scm_remounted")                                        \
+	X(RAS_MGMT_REQUEST_AUDITED, "mgmt_request_audited")                                        \
+	X(RAS_REVOKED_CERT_REJECTED, "revoked_cert_rejected")                                      \
+	X(RAS_TELEMETRY_ALERT_RAISED, "telemetry_alert_raised")                                    \
+	X(RAS_TELEMETRY_ALERT_CLEARED, "telemetry_alert_cleared")                                  \
+	X(RAS_DEVICE_FAILURE_PREDICTED, "device_failure_predicted")                                \
+	X(RAS_ENGINE_CRASH_REPORTED, "engine_crash_reported")                                      \
+	X(RAS_DEVICE_SPARE_SUBSTITUTED, "device_spare_substituted")                                \
+	X(RAS_DEVICE_INSERTED, "device_inserted")                                                  \
+	X(RAS_DEVICE_HOTPLUG_HANDLED, "device_hotplug_handled")
 
 /** Define RAS event enum */
 typedef enum {
@@ -275,6 +275,19 @@ char *smd_dev_stat2str(enum smd_dev_state state);
  */
 int smd_pool_mark_ready(uuid_t pool_id);
 
+/**
+ * Update the size of the blobs assigned to a pool after they have been grown.
+ *
+ * \param [IN]	pool_id		Pool UUID
+ * \param [IN]	smd_type	SMD type
+ * \param [IN]	blob_sz		New blob size in bytes
+ *
+ * \return			Zero on success, -DER_INVAL when the size is
+ *				smaller than the recorded one, negative value
+ *				on other error
+ */
+int smd_pool_set_blob_sz(uuid_t pool_id, enum smd_dev_type smd_type, uint64_t blob_sz);
+
 static inline void
 smd_pool_free_info(struct smd_pool_info *pool_info)
 {
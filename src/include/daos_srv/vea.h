@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2018-2023 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -271,6 +272,19 @@ int vea_tx_publish(struct vea_space_info *vsi, struct vea_hint_context *hint,
  */
 int vea_free(struct vea_space_info *vsi, uint64_t blk_off, uint32_t blk_cnt);
 
+/**
+ * Grow the managed space after the underlying block device has been enlarged.
+ * The appended blocks are free and can be allocated on transaction commit.
+ *
+ * \param vsi      [IN]	In-memory compound index
+ * \param capacity [IN]	New block device capacity in bytes
+ *
+ * \return			Zero on success; -DER_INVAL when the capacity
+ *				is smaller than the managed space or too large;
+ *				Appropriated negative value on other error
+ */
+int vea_extend(struct vea_space_info *vsi, uint64_t capacity);
+
 /**
  * Set an arbitrary age to a free extent with specified start offset.
  *
@@ -483,6 +483,20 @@ vos_pool_open(const char *path, uuid_t uuid, unsigned int flags,
 int
 vos_pool_upgrade(daos_handle_t poh, uint32_t version);
 
+/**
+ * Grow the NVMe space of a vos pool, the data blob is resized and the added
+ * space is made available for allocation. Retrying with the same size after
+ * a partial failure is harmless.
+ *
+ * \param poh		[IN]	Pool open handle
+ * \param nvme_sz	[IN]	New NVMe size in bytes
+ *
+ * \return	0 on success, -DER_NOTSUPPORTED if the pool has no NVMe space,
+ *		-DER_INVAL if @nvme_sz is smaller than current size, error otherwise
+ */
+int
+vos_pool_extend(daos_handle_t poh, daos_size_t nvme_sz);
+
 /**
  * Extended vos_pool_open() with an additional 'metrics' parameter to VOS telemetry.
  */
@@ -547,6 +547,96 @@ void   mgmt__pool_extend_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_extend_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_resize_req__init
+                     (Mgmt__PoolResizeReq         *message)
+{
+  static const Mgmt__PoolResizeReq init_value = MGMT__POOL_RESIZE_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_resize_req__get_packed_size
+                     (const Mgmt__PoolResizeReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_resize_req__pack
+                     (const Mgmt__PoolResizeReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_resize_req__pack_to_buffer
+                     (const Mgmt__PoolResizeReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolResizeReq *
+       mgmt__pool_resize_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolResizeReq *)
+     protobuf_c_message_unpack (&mgmt__pool_resize_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_resize_req__free_unpacked
+                     (Mgmt__PoolResizeReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_resize_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_resize_resp__init
+                     (Mgmt__PoolResizeResp         *message)
+{
+  static const Mgmt__PoolResizeResp init_value = MGMT__POOL_RESIZE_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_resize_resp__get_packed_size
+                     (const Mgmt__PoolResizeResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_resize_resp__pack
+                     (const Mgmt__PoolResizeResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_resize_resp__pack_to_buffer
+                     (const Mgmt__PoolResizeResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolResizeResp *
+       mgmt__pool_resize_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolResizeResp *)
+     protobuf_c_message_unpack (&mgmt__pool_resize_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_resize_resp__free_unpacked
+                     (Mgmt__PoolResizeResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_resize_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_reint_req__init
                      (Mgmt__PoolReintReq         *message)
 {
@@ -2219,6 +2309,95 @@ const ProtobufCMessageDescriptor mgmt__pool_extend_resp__descriptor           =
     NULL,
     NULL /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_resize_req__field_descriptors[5] = {
+    {
+	"sys", 1, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
+	offsetof(Mgmt__PoolResizeReq, sys), NULL, &protobuf_c_empty_string, 0, /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+    {
+	"id", 2, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0,            /* quantifier_offset */
+	offsetof(Mgmt__PoolResizeReq, id), NULL, &protobuf_c_empty_string, 0, /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+    {
+	"svc_ranks", 3, PROTOBUF_C_LABEL_REPEATED, PROTOBUF_C_TYPE_UINT32,
+	offsetof(Mgmt__PoolResizeReq, n_svc_ranks), offsetof(Mgmt__PoolResizeReq, svc_ranks), NULL,
+	NULL, 0 | PROTOBUF_C_FIELD_FLAG_PACKED, /* flags */
+	0, NULL, NULL                           /* reserved1,reserved2, etc */
+    },
+    {
+	"tier_bytes", 4, PROTOBUF_C_LABEL_REPEATED, PROTOBUF_C_TYPE_UINT64,
+	offsetof(Mgmt__PoolResizeReq, n_tier_bytes), offsetof(Mgmt__PoolResizeReq, tier_bytes),
+	NULL, NULL, 0 | PROTOBUF_C_FIELD_FLAG_PACKED, /* flags */
+	0, NULL, NULL                                 /* reserved1,reserved2, etc */
+    },
+    {
+	"mem_ratio", 5, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_FLOAT, 0, /* quantifier_offset */
+	offsetof(Mgmt__PoolResizeReq, mem_ratio), NULL, NULL, 0,         /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+};
+static const unsigned mgmt__pool_resize_req__field_indices_by_name[] = {
+    1, /* field[1] = id */
+    4, /* field[4] = mem_ratio */
+    2, /* field[2] = svc_ranks */
+    0, /* field[0] = sys */
+    3, /* field[3] = tier_bytes */
+};
+static const ProtobufCIntRange   mgmt__pool_resize_req__number_ranges[1 + 1] = {{1, 0}, {0, 5}};
+const ProtobufCMessageDescriptor mgmt__pool_resize_req__descriptor           = {
+    PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+    "mgmt.PoolResizeReq",
+    "PoolResizeReq",
+    "Mgmt__PoolResizeReq",
+    "mgmt",
+    sizeof(Mgmt__PoolResizeReq),
+    5,
+    mgmt__pool_resize_req__field_descriptors,
+    mgmt__pool_resize_req__field_indices_by_name,
+    1,
+    mgmt__pool_resize_req__number_ranges,
+    (ProtobufCMessageInit)mgmt__pool_resize_req__init,
+    NULL,
+    NULL,
+    NULL /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_resize_resp__field_descriptors[2] = {
+    {
+	"status", 1, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_INT32, 0, /* quantifier_offset */
+	offsetof(Mgmt__PoolResizeResp, status), NULL, NULL, 0,        /* flags */
+	0, NULL, NULL                                                 /* reserved1,reserved2, etc */
+    },
+    {
+	"tier_bytes", 2, PROTOBUF_C_LABEL_REPEATED, PROTOBUF_C_TYPE_UINT64,
+	offsetof(Mgmt__PoolResizeResp, n_tier_bytes), offsetof(Mgmt__PoolResizeResp, tier_bytes),
+	NULL, NULL, 0 | PROTOBUF_C_FIELD_FLAG_PACKED, /* flags */
+	0, NULL, NULL                                 /* reserved1,reserved2, etc */
+    },
+};
+static const unsigned mgmt__pool_resize_resp__field_indices_by_name[] = {
+    0, /* field[0] = status */
+    1, /* field[1] = tier_bytes */
+};
+static const ProtobufCIntRange   mgmt__pool_resize_resp__number_ranges[1 + 1] = {{1, 0}, {0, 2}};
+const ProtobufCMessageDescriptor mgmt__pool_resize_resp__descriptor           = {
+    PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+    "mgmt.PoolResizeResp",
+    "PoolResizeResp",
+    "Mgmt__PoolResizeResp",
+    "mgmt",
+    sizeof(Mgmt__PoolResizeResp),
+    2,
+    mgmt__pool_resize_resp__field_descriptors,
+    mgmt__pool_resize_resp__field_indices_by_name,
+    1,
+    mgmt__pool_resize_resp__number_ranges,
+    (ProtobufCMessageInit)mgmt__pool_resize_resp__init,
+    NULL,
+    NULL,
+    NULL /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_reint_req__field_descriptors[7] = {
     {
 	"sys", 1, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
@@ -2480,17 +2659,44 @@ const ProtobufCMessageDescriptor mgmt__list_cont_req__descriptor           = {
     NULL,
     NULL /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[1] = {
+static const ProtobufCFieldDescriptor mgmt__list_cont_resp__cont__field_descriptors[5] = {
     {
 	"uuid", 1, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
 	offsetof(Mgmt__ListContResp__Cont, uuid), NULL, &protobuf_c_empty_string, 0, /* flags */
 	0, NULL, NULL /* reserved1,reserved2, etc */
     },
+    {
+	"label", 2, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
+	offsetof(Mgmt__ListContResp__Cont, label), NULL, &protobuf_c_empty_string, 0, /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+    {
+	"owner_user", 3, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
+	offsetof(Mgmt__ListContResp__Cont, owner_user), NULL, &protobuf_c_empty_string,
+	0,            /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+    {
+	"owner_group", 4, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_STRING, 0, /* quantifier_offset */
+	offsetof(Mgmt__ListContResp__Cont, owner_group), NULL, &protobuf_c_empty_string,
+	0,            /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
+    {
+	"num_snapshots", 5, PROTOBUF_C_LABEL_NONE, PROTOBUF_C_TYPE_UINT32,
+	0, /* quantifier_offset */
+	offsetof(Mgmt__ListContResp__Cont, num_snapshots), NULL, NULL, 0, /* flags */
+	0, NULL, NULL /* reserved1,reserved2, etc */
+    },
 };
 static const unsigned mgmt__list_cont_resp__cont__field_indices_by_name[] = {
+    1, /* field[1] = label */
+    4, /* field[4] = num_snapshots */
+    3, /* field[3] = owner_group */
+    2, /* field[2] = owner_user */
     0, /* field[0] = uuid */
 };
-static const ProtobufCIntRange mgmt__list_cont_resp__cont__number_ranges[1 + 1] = {{1, 0}, {0, 1}};
+static const ProtobufCIntRange mgmt__list_cont_resp__cont__number_ranges[1 + 1] = {{1, 0}, {0, 5}};
 const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor         = {
     PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
     "mgmt.ListContResp.Cont",
@@ -2498,7 +2704,7 @@ const ProtobufCMessageDescriptor mgmt__list_cont_resp__cont__descriptor
     "Mgmt__ListContResp__Cont",
     "mgmt",
     sizeof(Mgmt__ListContResp__Cont),
-    1,
+    5,
     mgmt__list_cont_resp__cont__field_descriptors,
     mgmt__list_cont_resp__cont__field_indices_by_name,
     1,
@@ -27,6 +27,8 @@ typedef struct _Mgmt__PoolDrainReq Mgmt__PoolDrainReq;
 typedef struct _Mgmt__PoolDrainResp Mgmt__PoolDrainResp;
 typedef struct _Mgmt__PoolExtendReq Mgmt__PoolExtendReq;
 typedef struct _Mgmt__PoolExtendResp Mgmt__PoolExtendResp;
+typedef struct _Mgmt__PoolResizeReq Mgmt__PoolResizeReq;
+typedef struct _Mgmt__PoolResizeResp Mgmt__PoolResizeResp;
 typedef struct _Mgmt__PoolReintReq Mgmt__PoolReintReq;
 typedef struct _Mgmt__PoolReintResp Mgmt__PoolReintResp;
 typedef struct _Mgmt__ListPoolsReq Mgmt__ListPoolsReq;
@@ -543,6 +545,61 @@ struct  _Mgmt__PoolExtendResp
     , 0, 0,NULL }
 
 
+/*
+ * PoolResizeReq supplies pool identifier and the new per-rank storage tier sizes.
+ */
+struct  _Mgmt__PoolResizeReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+  /*
+   * uuid or label of pool to resize
+   */
+  char *id;
+  /*
+   * List of pool service ranks
+   */
+  size_t n_svc_ranks;
+  uint32_t *svc_ranks;
+  /*
+   * New per-rank size in bytes of storage tiers
+   */
+  size_t n_tier_bytes;
+  uint64_t *tier_bytes;
+  /*
+   * Fraction of meta-blob-sz to use as mem-file-sz
+   */
+  float mem_ratio;
+};
+#define MGMT__POOL_RESIZE_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_resize_req__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0 }
+
+
+/*
+ * PoolResizeResp returns the per-rank storage tier sizes after a resize.
+ */
+struct  _Mgmt__PoolResizeResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * per-rank storage tiers allocated to pool
+   */
+  size_t n_tier_bytes;
+  uint64_t *tier_bytes;
+};
+#define MGMT__POOL_RESIZE_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_resize_resp__descriptor) \
+    , 0, 0,NULL }
+
+
 /*
  * PoolReintReq supplies pool identifier, rank, and target_idxs.
  */
@@ -707,10 +764,26 @@ struct  _Mgmt__ListContResp__Cont
    * uuid of container
    */
   char *uuid;
+  /*
+   * container label
+   */
+  char *label;
+  /*
+   * formatted owner user e.g. "bob@"
+   */
+  char *owner_user;
+  /*
+   * formatted owner group e.g. "builders@"
+   */
+  char *owner_group;
+  /*
+   * number of container snapshots
+   */
+  uint32_t num_snapshots;
 };
 #define MGMT__LIST_CONT_RESP__CONT__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_cont_resp__cont__descriptor) \
-    , (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0 }
 
 
 struct  _Mgmt__ListContResp
@@ -1468,6 +1541,44 @@ Mgmt__PoolExtendResp *
 void   mgmt__pool_extend_resp__free_unpacked
                      (Mgmt__PoolExtendResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolResizeReq methods */
+void   mgmt__pool_resize_req__init
+                     (Mgmt__PoolResizeReq         *message);
+size_t mgmt__pool_resize_req__get_packed_size
+                     (const Mgmt__PoolResizeReq   *message);
+size_t mgmt__pool_resize_req__pack
+                     (const Mgmt__PoolResizeReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_resize_req__pack_to_buffer
+                     (const Mgmt__PoolResizeReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolResizeReq *
+       mgmt__pool_resize_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_resize_req__free_unpacked
+                     (Mgmt__PoolResizeReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolResizeResp methods */
+void   mgmt__pool_resize_resp__init
+                     (Mgmt__PoolResizeResp         *message);
+size_t mgmt__pool_resize_resp__get_packed_size
+                     (const Mgmt__PoolResizeResp   *message);
+size_t mgmt__pool_resize_resp__pack
+                     (const Mgmt__PoolResizeResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_resize_resp__pack_to_buffer
+                     (const Mgmt__PoolResizeResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolResizeResp *
+       mgmt__pool_resize_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_resize_resp__free_unpacked
+                     (Mgmt__PoolResizeResp *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolReintReq methods */
 void   mgmt__pool_reint_req__init
                      (Mgmt__PoolReintReq         *message);
@@ -1938,6 +2049,12 @@ typedef void (*Mgmt__PoolExtendReq_Closure)
 typedef void (*Mgmt__PoolExtendResp_Closure)
                  (const Mgmt__PoolExtendResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolResizeReq_Closure)
+                 (const Mgmt__PoolResizeReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolResizeResp_Closure)
+                 (const Mgmt__PoolResizeResp *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolReintReq_Closure)
                  (const Mgmt__PoolReintReq *message,
                   void *closure_data);
@@ -2029,6 +2146,8 @@ extern const ProtobufCMessageDescriptor mgmt__pool_drain_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_drain_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_extend_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_extend_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_resize_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_resize_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_reint_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_reint_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor;
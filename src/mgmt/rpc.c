@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2016-2024 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -86,6 +87,9 @@ CRT_RPC_DEFINE(mgmt_get_bs_state, DAOS_ISEQ_MGMT_GET_BS_STATE,
 CRT_RPC_DEFINE(mgmt_tgt_shard_destroy, DAOS_ISEQ_MGMT_TGT_SHARD_DESTROY,
 	       DAOS_OSEQ_MGMT_TGT_SHARD_DESTROY)
 
+CRT_RPC_DEFINE(mgmt_tgt_resize, DAOS_ISEQ_MGMT_TGT_RESIZE,
+	       DAOS_OSEQ_MGMT_TGT_RESIZE)
+
 /* Define for cont_rpcs[] array population below.
  * See MGMT_PROTO_*_RPC_LIST macro definition
  */
@@ -2056,7 +2056,7 @@ const ProtobufCMessageDescriptor ctl__smd_pool_req__descriptor =
   (ProtobufCMessageInit) ctl__smd_pool_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor ctl__smd_pool_resp__pool__field_descriptors[3] =
+static const ProtobufCFieldDescriptor ctl__smd_pool_resp__pool__field_descriptors[5] =
 {
   {
     "uuid",
@@ -2094,16 +2094,42 @@ static const ProtobufCFieldDescriptor ctl__smd_pool_resp__pool__field_descriptor
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "scm_size",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__SmdPoolResp__Pool, scm_size),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "blob_size",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Ctl__SmdPoolResp__Pool, blob_size),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned ctl__smd_pool_resp__pool__field_indices_by_name[] = {
+  4,   /* field[4] = blob_size */
   2,   /* field[2] = blobs */
+  3,   /* field[3] = scm_size */
   1,   /* field[1] = tgt_ids */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange ctl__smd_pool_resp__pool__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 3 }
+  { 0, 5 }
 };
 const ProtobufCMessageDescriptor ctl__smd_pool_resp__pool__descriptor =
 {
@@ -2113,7 +2139,7 @@ const ProtobufCMessageDescriptor ctl__smd_pool_resp__pool__descriptor =
   "Ctl__SmdPoolResp__Pool",
   "ctl",
   sizeof(Ctl__SmdPoolResp__Pool),
-  3,
+  5,
   ctl__smd_pool_resp__pool__field_descriptors,
   ctl__smd_pool_resp__pool__field_indices_by_name,
   1,  ctl__smd_pool_resp__pool__number_ranges,
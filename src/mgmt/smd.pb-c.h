@@ -452,10 +452,18 @@ struct  _Ctl__SmdPoolResp__Pool
    */
   size_t n_blobs;
   uint64_t *blobs;
+  /*
+   * Size of the VOS file of each target
+   */
+  uint64_t scm_size;
+  /*
+   * Size of the data blob of each target
+   */
+  uint64_t blob_size;
 };
 #define CTL__SMD_POOL_RESP__POOL__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&ctl__smd_pool_resp__pool__descriptor) \
-    , (char *)protobuf_c_empty_string, 0,NULL, 0,NULL }
+    , (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0, 0 }
 
 
 struct  _Ctl__SmdPoolResp
@@ -40,6 +40,10 @@ static struct crt_corpc_ops ds_mgmt_hdlr_tgt_destroy_co_ops = {
 	.co_aggregate	= ds_mgmt_tgt_destroy_aggregator
 };
 
+static struct crt_corpc_ops ds_mgmt_hdlr_tgt_resize_co_ops = {
+	.co_aggregate	= ds_mgmt_tgt_resize_aggregator
+};
+
 static struct crt_corpc_ops ds_mgmt_hdlr_tgt_map_update_co_ops = {
 	.co_aggregate	= ds_mgmt_tgt_map_update_aggregator,
 	.co_pre_forward	= ds_mgmt_tgt_map_update_pre_forward,
@@ -75,6 +79,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_PING_RANK:
 		ds_mgmt_drpc_ping_rank(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_DRAIN_RANK:
+		ds_mgmt_drpc_drain_rank(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_SET_UP:
 		ds_mgmt_drpc_set_up(drpc_req, drpc_resp);
 		break;
@@ -108,6 +115,9 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_POOL_EXTEND:
 		ds_mgmt_drpc_pool_extend(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_POOL_RESIZE:
+		ds_mgmt_drpc_pool_resize(drpc_req, drpc_resp);
+		break;
 	case DRPC_METHOD_MGMT_POOL_REBUILD_START:
 		ds_mgmt_drpc_pool_rebuild_start(drpc_req, drpc_resp);
 		break;
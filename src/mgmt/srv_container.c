@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2020-2024 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -9,6 +10,7 @@
 #define D_LOGFAC	DD_FAC(mgmt)
 
 #include <daos_srv/container.h>
+#include <daos_srv/pool.h>
 #include <daos/rpc.h>
 
 #include "srv_internal.h"
@@ -66,3 +68,57 @@ out_prop:
 	daos_prop_free(prop);
 	return rc;
 }
+
+/**
+ * Fetch the owner, owner group and snapshot count of a container. On success
+ * the returned owner strings are owned by the caller and must be freed.
+ */
+int
+ds_mgmt_cont_get_info(uuid_t pool_uuid, uuid_t cont_uuid, char **owner_user, char **owner_group,
+		      uint32_t *nsnapshots)
+{
+	struct ds_pool *pool;
+	daos_prop_t    *prop;
+	int             snap_cnt = 0;
+	int             rc;
+
+	D_ASSERT(owner_user != NULL && owner_group != NULL && nsnapshots != NULL);
+
+	rc = ds_pool_lookup(pool_uuid, &pool);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_UUID ": failed to look up pool", DP_UUID(pool_uuid));
+		return rc;
+	}
+
+	prop = daos_prop_alloc(2);
+	if (prop == NULL)
+		D_GOTO(out_pool, rc = -DER_NOMEM);
+	prop->dpp_entries[0].dpe_type = DAOS_PROP_CO_OWNER;
+	prop->dpp_entries[1].dpe_type = DAOS_PROP_CO_OWNER_GROUP;
+
+	rc = ds_cont_fetch_prop(pool_uuid, cont_uuid, prop);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to fetch owner properties",
+			 DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_prop, rc);
+	}
+
+	rc = ds_cont_fetch_snaps(pool->sp_iv_ns, cont_uuid, NULL, &snap_cnt);
+	if (rc != 0) {
+		DL_ERROR(rc, DF_CONT ": failed to fetch snapshots", DP_CONT(pool_uuid, cont_uuid));
+		D_GOTO(out_prop, rc);
+	}
+
+	/* Hand the fetched strings over to the caller */
+	*owner_user                  = prop->dpp_entries[0].dpe_str;
+	prop->dpp_entries[0].dpe_str = NULL;
+	*owner_group                 = prop->dpp_entries[1].dpe_str;
+	prop->dpp_entries[1].dpe_str = NULL;
+	*nsnapshots                  = snap_cnt;
+
+out_prop:
+	daos_prop_free(prop);
+out_pool:
+	ds_pool_put(pool);
+	return rc;
+}
@@ -75,6 +75,37 @@ ds_mgmt_drpc_prep_shutdown(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	mgmt__prep_shutdown_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_drain_rank(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	 alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PrepShutdownReq	*req = NULL;
+	Mgmt__DaosResp		 resp = MGMT__DAOS_RESP__INIT;
+
+	/* Drain takes the same input as prep shutdown */
+	req = mgmt__prep_shutdown_req__unpack(&alloc.alloc,
+					      drpc_req->body.len,
+					      drpc_req->body.data);
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (drain rank)\n");
+		return;
+	}
+
+	D_INFO("Received request to drain rank %u\n", req->rank);
+
+#ifndef DRPC_TEST
+	ds_pool_disable_exclude();
+	dss_srv_set_shutting_down();
+#endif
+
+	D_INFO("Service rank %d is draining, new pool connections will be refused\n",
+	       req->rank);
+
+	pack_daos_response(&resp, drpc_resp);
+	mgmt__prep_shutdown_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 ds_mgmt_drpc_ping_rank(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -944,6 +975,73 @@ out:
 	mgmt__pool_extend_req__free_unpacked(req, &alloc.alloc);
 }
 
+void
+ds_mgmt_drpc_pool_resize(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	struct drpc_alloc	alloc = PROTO_ALLOCATOR_INIT(alloc);
+	Mgmt__PoolResizeReq	*req = NULL;
+	Mgmt__PoolResizeResp	resp;
+	d_rank_list_t		*svc_ranks = NULL;
+	uuid_t			uuid;
+	uint8_t			*body;
+	size_t			len;
+	int			rc;
+
+	mgmt__pool_resize_resp__init(&resp);
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_resize_req__unpack(&alloc.alloc,
+					    drpc_req->body.len,
+					    drpc_req->body.data);
+
+	if (alloc.oom || req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_UNMARSHAL_PAYLOAD;
+		D_ERROR("Failed to unpack req (Resize pool)\n");
+		return;
+	}
+
+	/* Only the NVMe tier of an existing pool can be grown by the engine */
+	if (req->n_tier_bytes <= DAOS_MEDIA_NVME || req->n_tier_bytes > DAOS_MEDIA_MAX ||
+	    req->tier_bytes[DAOS_MEDIA_NVME] == 0) {
+		D_ERROR("Invalid storage tiers for resize, count: " DF_U64 "\n",
+			req->n_tier_bytes);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	if (uuid_parse(req->id, uuid) != 0) {
+		rc = -DER_INVAL;
+		DL_ERROR(rc, "Pool UUID is invalid");
+		D_GOTO(out, rc);
+	}
+
+	svc_ranks = uint32_array_to_rank_list(req->svc_ranks, req->n_svc_ranks);
+	if (svc_ranks == NULL)
+		D_GOTO(out, rc = -DER_NOMEM);
+
+	rc = ds_mgmt_pool_resize(uuid, svc_ranks, req->tier_bytes[DAOS_MEDIA_NVME]);
+	if (rc != 0)
+		D_ERROR("Failed to resize pool %s: "DF_RC"\n", req->id,
+			DP_RC(rc));
+
+	d_rank_list_free(svc_ranks);
+
+	resp.n_tier_bytes = req->n_tier_bytes;
+	resp.tier_bytes   = req->tier_bytes;
+out:
+	resp.status = rc;
+	len = mgmt__pool_resize_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILED_MARSHAL;
+	} else {
+		mgmt__pool_resize_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__pool_resize_req__free_unpacked(req, &alloc.alloc);
+}
+
 void
 ds_mgmt_drpc_pool_reintegrate(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -1689,6 +1787,28 @@ ds_mgmt_drpc_pool_list_cont(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 		if (resp.containers[i]->uuid == NULL)
 			D_GOTO(out_ranks, rc = -DER_NOMEM);
 		uuid_unparse(containers[i].pci_uuid, resp.containers[i]->uuid);
+
+		if (containers[i].pci_label[0] != '\0') {
+			D_STRNDUP(resp.containers[i]->label, containers[i].pci_label,
+				  DAOS_PROP_LABEL_MAX_LEN);
+			if (resp.containers[i]->label == NULL)
+				D_GOTO(out_ranks, rc = -DER_NOMEM);
+		}
+
+		rc = ds_mgmt_cont_get_info(req_uuid, containers[i].pci_uuid,
+					   &resp.containers[i]->owner_user,
+					   &resp.containers[i]->owner_group,
+					   &resp.containers[i]->num_snapshots);
+		if (rc == -DER_NONEXIST) {
+			/* destroyed since the list was taken; report the UUID only */
+			D_DEBUG(DB_MGMT, "Container %s in pool %s no longer exists\n",
+				resp.containers[i]->uuid, req->id);
+			rc = 0;
+		} else if (rc != 0) {
+			DL_ERROR(rc, "Failed to get info for container %s in pool %s",
+				 resp.containers[i]->uuid, req->id);
+			D_GOTO(out_ranks, rc);
+		}
 	}
 
 out_ranks:
@@ -1712,6 +1832,12 @@ out:
 			if (resp.containers[i]) {
 				if (resp.containers[i]->uuid)
 					D_FREE(resp.containers[i]->uuid);
+				if (resp.containers[i]->label != protobuf_c_empty_string)
+					D_FREE(resp.containers[i]->label);
+				if (resp.containers[i]->owner_user != protobuf_c_empty_string)
+					D_FREE(resp.containers[i]->owner_user);
+				if (resp.containers[i]->owner_group != protobuf_c_empty_string)
+					D_FREE(resp.containers[i]->owner_group);
 				D_FREE(resp.containers[i]);
 			}
 		}
@@ -105,6 +105,8 @@ ds_mgmt_pool_extend(uuid_t pool_uuid, d_rank_list_t *svc_ranks, d_rank_list_t *r
 		    size_t scm_size, size_t nvme_size, size_t meta_size, size_t domains_nr,
 		    uint32_t *domains);
 int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *svc_ranks, size_t nvme_size);
+int
 ds_mgmt_pool_set_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *prop);
 int
     ds_mgmt_pool_get_prop(uuid_t pool_uuid, d_rank_list_t *svc_ranks, daos_prop_t *prop);
@@ -136,6 +138,9 @@ int
 int
      ds_mgmt_cont_set_owner(uuid_t pool_uuid, d_rank_list_t *svc_ranks, const char *cont_id,
 			    const char *user, const char *group);
+int
+     ds_mgmt_cont_get_info(uuid_t pool_uuid, uuid_t cont_uuid, char **owner_user,
+			   char **owner_group, uint32_t *nsnapshots);
 
 /** srv_chk.c */
 int ds_mgmt_check_start(uint32_t rank_nr, d_rank_t *ranks, uint32_t policy_nr,
@@ -187,6 +192,8 @@ void ds_mgmt_hdlr_tgt_create(crt_rpc_t *rpc_req);
 void ds_mgmt_hdlr_tgt_destroy(crt_rpc_t *rpc_req);
 int ds_mgmt_tgt_destroy_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv);
 void ds_mgmt_hdlr_tgt_shard_destroy(crt_rpc_t *rpc_req);
+void ds_mgmt_hdlr_tgt_resize(crt_rpc_t *rpc_req);
+int ds_mgmt_tgt_resize_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv);
 int ds_mgmt_tgt_create_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				  void *priv);
 int ds_mgmt_tgt_create_post_reply(crt_rpc_t *rpc, void *priv);
@@ -309,6 +309,96 @@ out:
 	return rc;
 }
 
+static int
+ds_mgmt_tgt_pool_resize_ranks(uuid_t pool_uuid, d_rank_list_t *rank_list, size_t nvme_size)
+{
+	crt_rpc_t			*tr_req;
+	crt_opcode_t			 opc;
+	struct mgmt_tgt_resize_in	*tr_in;
+	struct mgmt_tgt_resize_out	*tr_out;
+	int				 rc;
+	uint8_t				 mgmt_ver;
+
+	rc = ds_mgmt_rpc_protocol(&mgmt_ver);
+	if (rc)
+		return rc;
+
+	/* Only the ranks in rank_list are resized, see ds_mgmt_tgt_pool_create_ranks() */
+	opc = DAOS_RPC_OPCODE(MGMT_TGT_RESIZE, DAOS_MGMT_MODULE, mgmt_ver);
+	rc = crt_corpc_req_create(dss_get_module_info()->dmi_ctx, NULL, rank_list, opc, NULL, NULL,
+				  CRT_RPC_FLAG_FILTER_INVERT | CRT_RPC_FLAG_CO_FAILOUT,
+				  crt_tree_topo(CRT_TREE_KNOMIAL, 4), &tr_req);
+	if (rc) {
+		D_ERROR(DF_UUID": corpc_req_create failed: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		return rc;
+	}
+
+	tr_in = crt_req_get(tr_req);
+	D_ASSERT(tr_in != NULL);
+	uuid_copy(tr_in->tr_pool_uuid, pool_uuid);
+	tr_in->tr_nvme_size = nvme_size;
+
+	rc = dss_rpc_send(tr_req);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": dss_rpc_send MGMT_TGT_RESIZE: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+		D_GOTO(decref, rc);
+	}
+
+	tr_out = crt_reply_get(tr_req);
+	rc = tr_out->tr_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to resize targets: rc="DF_RC"\n",
+			DP_UUID(pool_uuid), DP_RC(rc));
+
+decref:
+	crt_req_decref(tr_req);
+	return rc;
+}
+
+/**
+ * Grow the per-rank NVMe space of a pool on all of its ranks. A rank that is
+ * excluded can't be grown, so the request is rejected until it's reintegrated.
+ */
+int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *svc_ranks, size_t nvme_size)
+{
+	d_rank_list_t		*enabled_ranks = NULL;
+	d_rank_list_t		*disabled_ranks = NULL;
+	daos_pool_info_t	 pool_info = {0};
+	int			 rc;
+
+	D_DEBUG(DB_MGMT, "resize pool "DF_UUID" nvme size %zu\n", DP_UUID(pool_uuid),
+		nvme_size);
+
+	pool_info.pi_bits = DPI_ENGINES_ENABLED | DPI_ENGINES_DISABLED;
+	rc = ds_mgmt_pool_query(pool_uuid, svc_ranks, &enabled_ranks, &disabled_ranks, NULL,
+				&pool_info, NULL, NULL, NULL);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to query pool ranks: "DF_RC"\n", DP_UUID(pool_uuid),
+			DP_RC(rc));
+		D_GOTO(out, rc);
+	}
+
+	if (disabled_ranks != NULL && disabled_ranks->rl_nr > 0) {
+		D_ERROR(DF_UUID": %u pool ranks are disabled, can't resize\n", DP_UUID(pool_uuid),
+			disabled_ranks->rl_nr);
+		D_GOTO(out, rc = -DER_BUSY);
+	}
+
+	if (enabled_ranks == NULL || enabled_ranks->rl_nr == 0) {
+		D_ERROR(DF_UUID": no enabled pool ranks\n", DP_UUID(pool_uuid));
+		D_GOTO(out, rc = -DER_NONEXIST);
+	}
+
+	rc = ds_mgmt_tgt_pool_resize_ranks(pool_uuid, enabled_ranks, nvme_size);
+out:
+	d_rank_list_free(enabled_ranks);
+	d_rank_list_free(disabled_ranks);
+	return rc;
+}
+
 int
 ds_mgmt_evict_pool(uuid_t pool_uuid, d_rank_list_t *svc_ranks, uuid_t *handles, size_t n_handles,
 		   uint32_t destroy, uint32_t force_destroy, char *machine, uint32_t *count)
@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2016-2024 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -614,6 +615,8 @@ ds_mgmt_smd_list_pools(Ctl__SmdPoolResp *resp)
 		for (j = 0; j < pool_info->spi_tgt_cnt[SMD_DEV_TYPE_DATA]; j++)
 			resp->pools[i]->blobs[j] = pool_info->spi_blobs[SMD_DEV_TYPE_DATA][j];
 
+		resp->pools[i]->scm_size  = pool_info->spi_scm_sz;
+		resp->pools[i]->blob_size = pool_info->spi_blob_sz[SMD_DEV_TYPE_DATA];
 
 		d_list_del(&pool_info->spi_link);
 		/* Frees spi_tgts, spi_blobs, and pool_info */
@@ -1283,3 +1283,67 @@ out:
 	tsdo->tsdo_rc = rc;
 	crt_reply_send(req);
 }
+
+struct tgt_resize_arg {
+	uuid_t		tra_pool_uuid;
+	daos_size_t	tra_nvme_size;
+};
+
+static int
+tgt_resize_pool(void *data)
+{
+	struct tgt_resize_arg	*arg = data;
+	struct ds_pool_child	*child;
+	int			 rc;
+
+	child = ds_pool_child_lookup(arg->tra_pool_uuid);
+	if (child == NULL)
+		return -DER_NONEXIST;
+
+	rc = vos_pool_extend(child->spc_hdl, arg->tra_nvme_size);
+	ds_pool_child_put(child);
+	return rc;
+}
+
+/**
+ * RPC handler for target resize, grows the NVMe space of every local target
+ * of the pool. Targets already grown by an earlier attempt are left as is.
+ */
+void
+ds_mgmt_hdlr_tgt_resize(crt_rpc_t *req)
+{
+	struct mgmt_tgt_resize_in	*tr_in;
+	struct mgmt_tgt_resize_out	*tr_out;
+	struct tgt_resize_arg		 arg;
+	int				 rc;
+
+	tr_in = crt_req_get(req);
+	tr_out = crt_reply_get(req);
+	D_ASSERT(tr_in != NULL && tr_out != NULL);
+
+	D_DEBUG(DB_MGMT, DF_UUID": processing rpc %p, nvme size "DF_U64"\n",
+		DP_UUID(tr_in->tr_pool_uuid), req, tr_in->tr_nvme_size);
+
+	D_ASSERT(dss_tgt_nr > 0);
+	uuid_copy(arg.tra_pool_uuid, tr_in->tr_pool_uuid);
+	arg.tra_nvme_size = tr_in->tr_nvme_size / dss_tgt_nr;
+
+	rc = dss_thread_collective(tgt_resize_pool, &arg, 0);
+	if (rc)
+		D_ERROR(DF_UUID": failed to resize targets: "DF_RC"\n",
+			DP_UUID(tr_in->tr_pool_uuid), DP_RC(rc));
+
+	tr_out->tr_rc = rc;
+	crt_reply_send(req);
+}
+
+int
+ds_mgmt_tgt_resize_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv)
+{
+	struct mgmt_tgt_resize_out *out_source = crt_reply_get(source);
+	struct mgmt_tgt_resize_out *out_result = crt_reply_get(result);
+
+	if (out_source->tr_rc != 0)
+		out_result->tr_rc = out_source->tr_rc;
+	return 0;
+}
@@ -255,8 +255,13 @@ mock_ds_mgmt_list_cont_gen_cont(size_t ncont) {
 
 	D_ALLOC_ARRAY(ds_mgmt_pool_list_cont_out, ncont);
 	ds_mgmt_pool_list_cont_nc_out = ncont;
-	for (i = 0; i < ncont; i++)
+	for (i = 0; i < ncont; i++) {
 		uuid_generate(ds_mgmt_pool_list_cont_out[i].pci_uuid);
+		/* leave every other container unlabeled */
+		if (i % 2 == 0)
+			snprintf(ds_mgmt_pool_list_cont_out[i].pci_label, DAOS_PROP_LABEL_MAX_LEN,
+				 "cont%zu", i);
+	}
 }
 
 void
@@ -403,6 +408,35 @@ mock_ds_mgmt_pool_query_targets_teardown(void)
 	}
 }
 
+int      ds_mgmt_cont_get_info_return;
+uint32_t ds_mgmt_cont_get_info_nsnapshots;
+int
+ds_mgmt_cont_get_info(uuid_t pool_uuid, uuid_t cont_uuid, char **owner_user, char **owner_group,
+		      uint32_t *nsnapshots)
+{
+	if (ds_mgmt_cont_get_info_return != 0)
+		return ds_mgmt_cont_get_info_return;
+
+	D_STRNDUP_S(*owner_user, MOCK_CONT_OWNER_USER);
+	if (*owner_user == NULL)
+		return -DER_NOMEM;
+	D_STRNDUP_S(*owner_group, MOCK_CONT_OWNER_GROUP);
+	if (*owner_group == NULL) {
+		D_FREE(*owner_user);
+		return -DER_NOMEM;
+	}
+	*nsnapshots = ds_mgmt_cont_get_info_nsnapshots;
+
+	return 0;
+}
+
+void
+mock_ds_mgmt_cont_get_info_setup(void)
+{
+	ds_mgmt_cont_get_info_return     = 0;
+	ds_mgmt_cont_get_info_nsnapshots = 0;
+}
+
 int      ds_mgmt_cont_set_owner_return;
 uuid_t   ds_mgmt_cont_set_owner_pool;
 char    *ds_mgmt_cont_set_owner_cont;
@@ -478,6 +512,25 @@ mock_ds_mgmt_pool_extend_setup(void)
 	uuid_clear(ds_mgmt_pool_extend_uuid);
 }
 
+int     ds_mgmt_pool_resize_return;
+uuid_t  ds_mgmt_pool_resize_uuid;
+size_t  ds_mgmt_pool_resize_nvme_size;
+int
+ds_mgmt_pool_resize(uuid_t pool_uuid, d_rank_list_t *svc_ranks, size_t nvme_size)
+{
+	uuid_copy(ds_mgmt_pool_resize_uuid, pool_uuid);
+	ds_mgmt_pool_resize_nvme_size = nvme_size;
+	return ds_mgmt_pool_resize_return;
+}
+
+void
+mock_ds_mgmt_pool_resize_setup(void)
+{
+	ds_mgmt_pool_resize_return = 0;
+	uuid_clear(ds_mgmt_pool_resize_uuid);
+	ds_mgmt_pool_resize_nvme_size = 0;
+}
+
 int     ds_mgmt_pool_evict_return;
 uuid_t  ds_mgmt_pool_evict_uuid;
 
@@ -101,6 +101,14 @@ extern int		ds_mgmt_pool_extend_return;
 extern uuid_t		ds_mgmt_pool_extend_uuid;
 void mock_ds_mgmt_pool_extend_setup(void);
 
+/*
+ * Mock ds_mgmt_pool_resize
+ */
+extern int		ds_mgmt_pool_resize_return;
+extern uuid_t		ds_mgmt_pool_resize_uuid;
+extern size_t		ds_mgmt_pool_resize_nvme_size;
+void mock_ds_mgmt_pool_resize_setup(void);
+
 /*
  * Mock ds_mgmt_pool_query
  */
@@ -140,6 +148,15 @@ extern int		ds_mgmt_pool_evict_return;
 extern uuid_t		ds_mgmt_pool_evict_uuid;
 void mock_ds_mgmt_pool_evict_setup(void);
 
+/*
+ * Mock ds_mgmt_cont_get_info
+ */
+#define MOCK_CONT_OWNER_USER  "alice@"
+#define MOCK_CONT_OWNER_GROUP "builders@"
+extern int      ds_mgmt_cont_get_info_return;
+extern uint32_t ds_mgmt_cont_get_info_nsnapshots;
+void            mock_ds_mgmt_cont_get_info_setup(void);
+
 /*
  * Mock ds_mgmt_cont_set_owner
  */
@@ -94,6 +94,7 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	 * to test for proper handling of garbage in the payload
 	 */
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_prep_shutdown);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_drain_rank);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_ping_rank);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_set_log_masks);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_set_rank);
@@ -103,6 +104,7 @@ test_mgmt_drpc_handlers_bad_call_payload(void **state)
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_exclude);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_drain);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_extend);
+	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_resize);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_reintegrate);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_set_prop);
 	expect_failure_for_bad_call_payload(ds_mgmt_drpc_pool_get_prop);
@@ -647,6 +649,7 @@ static int
 drpc_list_cont_setup(void **state)
 {
 	mock_ds_mgmt_pool_list_cont_setup();
+	mock_ds_mgmt_cont_get_info_setup();
 
 	return 0;
 }
@@ -729,6 +732,11 @@ expect_drpc_list_cont_resp_with_containers(Drpc__Response *resp,
 
 		uuid_unparse(exp_cont[i].pci_uuid, exp_uuid);
 		assert_string_equal(cont_resp->containers[i]->uuid, exp_uuid);
+		assert_string_equal(cont_resp->containers[i]->label, exp_cont[i].pci_label);
+		assert_string_equal(cont_resp->containers[i]->owner_user, MOCK_CONT_OWNER_USER);
+		assert_string_equal(cont_resp->containers[i]->owner_group, MOCK_CONT_OWNER_GROUP);
+		assert_int_equal(cont_resp->containers[i]->num_snapshots,
+				 ds_mgmt_cont_get_info_nsnapshots);
 	}
 	mgmt__list_cont_resp__free_unpacked(cont_resp, NULL);
 }
@@ -792,6 +800,7 @@ test_drpc_pool_list_cont_with_containers(void **state)
 
 	setup_list_cont_drpc_call(&call, TEST_UUID);
 	mock_ds_mgmt_list_cont_gen_cont(ncont);
+	ds_mgmt_cont_get_info_nsnapshots = 3;
 
 	ds_mgmt_drpc_pool_list_cont(&call, &resp);
 
@@ -803,6 +812,24 @@ test_drpc_pool_list_cont_with_containers(void **state)
 	D_FREE(resp.body.data);
 }
 
+static void
+test_drpc_pool_list_cont_get_info_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+
+	setup_list_cont_drpc_call(&call, TEST_UUID);
+	mock_ds_mgmt_list_cont_gen_cont(4);
+	ds_mgmt_cont_get_info_return = -DER_MISC;
+
+	ds_mgmt_drpc_pool_list_cont(&call, &resp);
+
+	expect_drpc_list_cont_resp_with_error(&resp, -DER_MISC);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC Pool SetProp setup/teardown
  */
@@ -2070,6 +2097,134 @@ test_drpc_extend_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+/*
+ * dRPC pool resize test setup
+ */
+static int
+drpc_pool_resize_setup(void **state)
+{
+	mock_ds_mgmt_pool_resize_setup();
+	return 0;
+}
+
+/*
+ * dRPC pool resize tests
+ */
+static void
+setup_resize_drpc_call(Drpc__Call *call, char *uuid, uint64_t *tier_bytes, size_t n_tier_bytes)
+{
+	Mgmt__PoolResizeReq	req = MGMT__POOL_RESIZE_REQ__INIT;
+	size_t			len;
+	uint8_t			*body;
+
+	req.id = uuid;
+	req.n_tier_bytes = n_tier_bytes;
+	req.tier_bytes = tier_bytes;
+
+	len = mgmt__pool_resize_req__get_packed_size(&req);
+	D_ALLOC(body, len);
+	assert_non_null(body);
+
+	mgmt__pool_resize_req__pack(&req, body);
+
+	call->body.data = body;
+	call->body.len = len;
+}
+
+static void
+expect_drpc_resize_resp_with_error(Drpc__Response *resp, int exp_error)
+{
+	Mgmt__PoolResizeResp	*pr_resp = NULL;
+
+	assert_int_equal(resp->status, DRPC__STATUS__SUCCESS);
+	assert_non_null(resp->body.data);
+
+	pr_resp = mgmt__pool_resize_resp__unpack(NULL, resp->body.len,
+						 resp->body.data);
+	assert_non_null(pr_resp);
+	assert_int_equal(pr_resp->status, exp_error);
+
+	mgmt__pool_resize_resp__free_unpacked(pr_resp, NULL);
+}
+
+static void
+test_drpc_resize_bad_uuid(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uint64_t	tier_bytes[] = {1000000000, 2000000000};
+
+	setup_resize_drpc_call(&call, "BAD", tier_bytes, ARRAY_SIZE(tier_bytes));
+
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	expect_drpc_resize_resp_with_error(&resp, -DER_INVAL);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_resize_no_nvme(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uint64_t	tier_bytes[] = {1000000000, 0};
+
+	/* SCM only */
+	setup_resize_drpc_call(&call, TEST_UUID, tier_bytes, 1);
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+	expect_drpc_resize_resp_with_error(&resp, -DER_INVAL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+
+	/* Zero NVMe size */
+	setup_resize_drpc_call(&call, TEST_UUID, tier_bytes, ARRAY_SIZE(tier_bytes));
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+	expect_drpc_resize_resp_with_error(&resp, -DER_INVAL);
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+
+	assert_true(uuid_is_null(ds_mgmt_pool_resize_uuid));
+}
+
+static void
+test_drpc_resize_mgmt_svc_fails(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uint64_t	tier_bytes[] = {1000000000, 2000000000};
+
+	setup_resize_drpc_call(&call, TEST_UUID, tier_bytes, ARRAY_SIZE(tier_bytes));
+	ds_mgmt_pool_resize_return = -DER_NOSPACE;
+
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+	expect_drpc_resize_resp_with_error(&resp, ds_mgmt_pool_resize_return);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
+static void
+test_drpc_resize_success(void **state)
+{
+	Drpc__Call	call = DRPC__CALL__INIT;
+	Drpc__Response	resp = DRPC__RESPONSE__INIT;
+	uint64_t	tier_bytes[] = {1000000000, 2000000000};
+	uuid_t		exp_uuid;
+
+	setup_resize_drpc_call(&call, TEST_UUID, tier_bytes, ARRAY_SIZE(tier_bytes));
+	ds_mgmt_drpc_pool_resize(&call, &resp);
+
+	expect_drpc_resize_resp_with_error(&resp, 0);
+	assert_int_equal(uuid_parse(TEST_UUID, exp_uuid), 0);
+	assert_int_equal(uuid_compare(exp_uuid, ds_mgmt_pool_resize_uuid), 0);
+	assert_int_equal(ds_mgmt_pool_resize_nvme_size, tier_bytes[1]);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC pool reintegrate tests
  */
@@ -2323,6 +2478,23 @@ test_drpc_prep_shutdown_success(void **state)
 	D_FREE(resp.body.data);
 }
 
+static void
+test_drpc_drain_rank_success(void **state)
+{
+	Drpc__Call		call = DRPC__CALL__INIT;
+	Drpc__Response		resp = DRPC__RESPONSE__INIT;
+	Mgmt__PrepShutdownReq	ps_req = MGMT__PREP_SHUTDOWN_REQ__INIT;
+
+	pack_prep_shutdown_req(&ps_req, &call);
+
+	ds_mgmt_drpc_drain_rank(&call, &resp);
+
+	expect_daos_resp_with_der(&resp, 0);
+
+	D_FREE(call.body.data);
+	D_FREE(resp.body.data);
+}
+
 /*
  * dRPC set log masks tests
  */
@@ -3326,6 +3498,9 @@ test_drpc_check_act_success(void **state)
 #define POOL_EXTEND_TEST(x)	cmocka_unit_test_setup(x, \
 						drpc_pool_extend_setup)
 
+#define POOL_RESIZE_TEST(x)	cmocka_unit_test_setup(x, \
+						drpc_pool_resize_setup)
+
 #define REINT_TEST(x)           cmocka_unit_test(x)
 
 #define POOL_CREATE_TEST(x)	cmocka_unit_test(x)
@@ -3391,6 +3566,7 @@ main(void)
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_mgmt_svc_fails),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_no_containers),
 	    LIST_CONT_TEST(test_drpc_pool_list_cont_with_containers),
+	    LIST_CONT_TEST(test_drpc_pool_list_cont_get_info_fails),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_invalid_value_type),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_bad_uuid),
 	    POOL_SET_PROP_TEST(test_drpc_pool_set_prop_success),
@@ -3408,6 +3584,10 @@ main(void)
 	    POOL_EXTEND_TEST(test_drpc_extend_bad_uuid),
 	    POOL_EXTEND_TEST(test_drpc_extend_mgmt_svc_fails),
 	    POOL_EXTEND_TEST(test_drpc_extend_success),
+	    POOL_RESIZE_TEST(test_drpc_resize_bad_uuid),
+	    POOL_RESIZE_TEST(test_drpc_resize_no_nvme),
+	    POOL_RESIZE_TEST(test_drpc_resize_mgmt_svc_fails),
+	    POOL_RESIZE_TEST(test_drpc_resize_success),
 	    REINT_TEST(test_drpc_reint_bad_uuid),
 	    QUERY_TEST(test_drpc_pool_query_bad_uuid),
 	    QUERY_TEST(test_drpc_pool_query_mgmt_svc_fails),
@@ -3424,6 +3604,7 @@ main(void)
 	    POOL_EVICT_TEST(test_drpc_pool_evict_success),
 	    PING_RANK_TEST(test_drpc_ping_rank_success),
 	    PREP_SHUTDOWN_TEST(test_drpc_prep_shutdown_success),
+	    PREP_SHUTDOWN_TEST(test_drpc_drain_rank_success),
 	    SET_LOG_MASKS_TEST(test_drpc_set_log_masks_success),
 	    CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_cont_label),
 	    CONT_SET_OWNER_TEST(test_drpc_cont_set_owner_bad_pool_uuid),
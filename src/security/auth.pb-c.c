@@ -277,6 +277,51 @@ void   auth__validate_cred_resp__free_unpacked
   assert(message->base.descriptor == &auth__validate_cred_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   auth__get_delegated_cred_req__init
+                     (Auth__GetDelegatedCredReq         *message)
+{
+  static const Auth__GetDelegatedCredReq init_value = AUTH__GET_DELEGATED_CRED_REQ__INIT;
+  *message = init_value;
+}
+size_t auth__get_delegated_cred_req__get_packed_size
+                     (const Auth__GetDelegatedCredReq *message)
+{
+  assert(message->base.descriptor == &auth__get_delegated_cred_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t auth__get_delegated_cred_req__pack
+                     (const Auth__GetDelegatedCredReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &auth__get_delegated_cred_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t auth__get_delegated_cred_req__pack_to_buffer
+                     (const Auth__GetDelegatedCredReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &auth__get_delegated_cred_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Auth__GetDelegatedCredReq *
+       auth__get_delegated_cred_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Auth__GetDelegatedCredReq *)
+     protobuf_c_message_unpack (&auth__get_delegated_cred_req__descriptor,
+                                allocator, len, data);
+}
+void   auth__get_delegated_cred_req__free_unpacked
+                     (Auth__GetDelegatedCredReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &auth__get_delegated_cred_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor auth__token__field_descriptors[2] =
 {
   {
@@ -635,6 +680,44 @@ const ProtobufCMessageDescriptor auth__validate_cred_resp__descriptor =
   (ProtobufCMessageInit) auth__validate_cred_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor auth__get_delegated_cred_req__field_descriptors[1] =
+{
+  {
+    "uid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Auth__GetDelegatedCredReq, uid),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned auth__get_delegated_cred_req__field_indices_by_name[] = {
+  0,   /* field[0] = uid */
+};
+static const ProtobufCIntRange auth__get_delegated_cred_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor auth__get_delegated_cred_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "auth.GetDelegatedCredReq",
+  "GetDelegatedCredReq",
+  "Auth__GetDelegatedCredReq",
+  "auth",
+  sizeof(Auth__GetDelegatedCredReq),
+  1,
+  auth__get_delegated_cred_req__field_descriptors,
+  auth__get_delegated_cred_req__field_indices_by_name,
+  1,  auth__get_delegated_cred_req__number_ranges,
+  (ProtobufCMessageInit) auth__get_delegated_cred_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCEnumValue auth__flavor__enum_values_by_number[2] =
 {
   { "AUTH_NONE", "AUTH__FLAVOR__AUTH_NONE", 0 },
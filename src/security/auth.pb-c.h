@@ -21,6 +21,7 @@ typedef struct _Auth__Credential Auth__Credential;
 typedef struct _Auth__GetCredResp Auth__GetCredResp;
 typedef struct _Auth__ValidateCredReq Auth__ValidateCredReq;
 typedef struct _Auth__ValidateCredResp Auth__ValidateCredResp;
+typedef struct _Auth__GetDelegatedCredReq Auth__GetDelegatedCredReq;
 
 
 /* --- enums --- */
@@ -173,6 +174,24 @@ struct  _Auth__ValidateCredResp
     , 0, NULL }
 
 
+/*
+ * GetDelegatedCredReq represents a request from a delegating client, such as a
+ * container runtime, to fetch authentication credentials on behalf of one of
+ * the users mapped to it.
+ */
+struct  _Auth__GetDelegatedCredReq
+{
+  ProtobufCMessage base;
+  /*
+   * Mapped uid of the user
+   */
+  uint32_t uid;
+};
+#define AUTH__GET_DELEGATED_CRED_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&auth__get_delegated_cred_req__descriptor) \
+    , 0 }
+
+
 /* Auth__Token methods */
 void   auth__token__init
                      (Auth__Token         *message);
@@ -287,6 +306,25 @@ Auth__ValidateCredResp *
 void   auth__validate_cred_resp__free_unpacked
                      (Auth__ValidateCredResp *message,
                       ProtobufCAllocator *allocator);
+/* Auth__GetDelegatedCredReq methods */
+void   auth__get_delegated_cred_req__init
+                     (Auth__GetDelegatedCredReq         *message);
+size_t auth__get_delegated_cred_req__get_packed_size
+                     (const Auth__GetDelegatedCredReq   *message);
+size_t auth__get_delegated_cred_req__pack
+                     (const Auth__GetDelegatedCredReq   *message,
+                      uint8_t             *out);
+size_t auth__get_delegated_cred_req__pack_to_buffer
+                     (const Auth__GetDelegatedCredReq   *message,
+                      ProtobufCBuffer     *buffer);
+Auth__GetDelegatedCredReq *
+       auth__get_delegated_cred_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   auth__get_delegated_cred_req__free_unpacked
+                     (Auth__GetDelegatedCredReq *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Auth__Token_Closure)
@@ -307,6 +345,9 @@ typedef void (*Auth__ValidateCredReq_Closure)
 typedef void (*Auth__ValidateCredResp_Closure)
                  (const Auth__ValidateCredResp *message,
                   void *closure_data);
+typedef void (*Auth__GetDelegatedCredReq_Closure)
+                 (const Auth__GetDelegatedCredReq *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -320,6 +361,7 @@ extern const ProtobufCMessageDescriptor auth__credential__descriptor;
 extern const ProtobufCMessageDescriptor auth__get_cred_resp__descriptor;
 extern const ProtobufCMessageDescriptor auth__validate_cred_req__descriptor;
 extern const ProtobufCMessageDescriptor auth__validate_cred_resp__descriptor;
+extern const ProtobufCMessageDescriptor auth__get_delegated_cred_req__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -1,5 +1,6 @@
 /*
  * (C) Copyright 2018-2023 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -21,7 +22,9 @@
 #include "acl.h"
 
 /* Prototypes for static helper functions */
-static int request_credentials_via_drpc(Drpc__Response **response);
+static int request_credentials_via_drpc(int32_t method, uint8_t *body, size_t body_len,
+					Drpc__Response **response);
+static int pack_delegated_cred_req(uint32_t uid, uint8_t **body, size_t *body_len);
 static int process_credential_response(Drpc__Response *response,
 				       d_iov_t *creds);
 static int get_cred_from_response(Drpc__Response *response, d_iov_t *cred);
@@ -30,26 +33,94 @@ int
 dc_sec_request_creds(d_iov_t *creds)
 {
 	Drpc__Response	*response = NULL;
+	uint32_t	 uid;
 	int		rc;
 
 	if (creds == NULL) {
 		return -DER_INVAL;
 	}
 
-	rc = request_credentials_via_drpc(&response);
+	/* A delegating client, such as a container runtime, acts on behalf of a mapped user */
+	if (d_getenv_uint32_t(DAOS_DELEGATED_UID_ENV, &uid) == -DER_SUCCESS)
+		return dc_sec_request_delegated_creds(uid, creds);
+
+	rc = request_credentials_via_drpc(DRPC_METHOD_SEC_AGENT_REQUEST_CREDS, NULL, 0, &response);
+	if (rc != DER_SUCCESS) {
+		drpc_response_free(response);
+		return rc;
+	}
+
+	rc = process_credential_response(response, creds);
+
+	drpc_response_free(response);
+	return rc;
+}
+
+int
+dc_sec_request_delegated_creds(uint32_t uid, d_iov_t *creds)
+{
+	Drpc__Response	*response = NULL;
+	uint8_t		*body;
+	size_t		 body_len;
+	int		rc;
+
+	if (creds == NULL) {
+		return -DER_INVAL;
+	}
+
+	rc = pack_delegated_cred_req(uid, &body, &body_len);
+	if (rc != DER_SUCCESS)
+		return rc;
+
+	rc = request_credentials_via_drpc(DRPC_METHOD_SEC_AGENT_REQUEST_DELEGATED_CREDS, body,
+					  body_len, &response);
 	if (rc != DER_SUCCESS) {
 		drpc_response_free(response);
 		return rc;
 	}
 
 	rc = process_credential_response(response, creds);
+	if (rc != DER_SUCCESS)
+		DL_ERROR(rc, "failed to obtain delegated credential for uid %u", uid);
 
 	drpc_response_free(response);
 	return rc;
 }
 
 static int
-request_credentials_via_drpc(Drpc__Response **response)
+pack_delegated_cred_req(uint32_t uid, uint8_t **body, size_t *body_len)
+{
+	Auth__GetDelegatedCredReq	req = AUTH__GET_DELEGATED_CRED_REQ__INIT;
+	uint8_t				*buf;
+	size_t				len;
+
+	req.uid = uid;
+	*body = NULL;
+	*body_len = 0;
+
+	/* A request for uid 0 has no fields set, and packs to nothing */
+	len = auth__get_delegated_cred_req__get_packed_size(&req);
+	if (len == 0)
+		return 0;
+
+	D_ALLOC(buf, len);
+	if (buf == NULL)
+		return -DER_NOMEM;
+
+	auth__get_delegated_cred_req__pack(&req, buf);
+	*body = buf;
+	*body_len = len;
+
+	return 0;
+}
+
+/*
+ * Sends a credential request to the agent. The body, if any, is owned by the
+ * call and freed with it.
+ */
+static int
+request_credentials_via_drpc(int32_t method, uint8_t *body, size_t body_len,
+			     Drpc__Response **response)
 {
 	Drpc__Call	*request;
 	struct drpc	*agent_socket;
@@ -57,24 +128,26 @@ request_credentials_via_drpc(Drpc__Response **response)
 
 	if (dc_agent_sockpath == NULL) {
 		D_ERROR("DAOS Socket Path is Uninitialized\n");
+		D_FREE(body);
 		return -DER_UNINIT;
 	}
 
 	rc = drpc_connect(dc_agent_sockpath, &agent_socket);
 	if (rc != -DER_SUCCESS) {
 		D_ERROR("Can't connect to agent socket "DF_RC"\n", DP_RC(rc));
+		D_FREE(body);
 		return rc;
 	}
 
-	rc = drpc_call_create(agent_socket,
-			      DRPC_MODULE_SEC_AGENT,
-			      DRPC_METHOD_SEC_AGENT_REQUEST_CREDS,
-			      &request);
+	rc = drpc_call_create(agent_socket, DRPC_MODULE_SEC_AGENT, method, &request);
 	if (rc != -DER_SUCCESS) {
 		D_ERROR("Couldn't allocate dRPC call "DF_RC"\n", DP_RC(rc));
 		drpc_close(agent_socket);
+		D_FREE(body);
 		return rc;
 	}
+	request->body.data = body;
+	request->body.len = body_len;
 
 	rc = drpc_call(agent_socket, R_SYNC, request, response);
 
@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2018-2023 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -350,6 +351,73 @@ test_request_credentials_returns_raw_bytes(void **state)
 	daos_iov_free(&creds);
 }
 
+static void
+expect_delegated_cred_req(uint32_t uid)
+{
+	Auth__GetDelegatedCredReq *req;
+
+	assert_int_equal(drpc_call_msg_content.module, DRPC_MODULE_SEC_AGENT);
+	assert_int_equal(drpc_call_msg_content.method,
+			 DRPC_METHOD_SEC_AGENT_REQUEST_DELEGATED_CREDS);
+
+	req = auth__get_delegated_cred_req__unpack(NULL, drpc_call_msg_content.body.len,
+						   drpc_call_msg_content.body.data);
+	assert_non_null(req);
+	assert_int_equal(req->uid, uid);
+	auth__get_delegated_cred_req__free_unpacked(req, NULL);
+}
+
+static void
+test_request_delegated_credentials_fails_with_null_creds(void **state)
+{
+	assert_rc_equal(dc_sec_request_delegated_creds(1000, NULL), -DER_INVAL);
+}
+
+static void
+test_request_delegated_credentials_sends_uid(void **state)
+{
+	d_iov_t creds;
+
+	memset(&creds, 0, sizeof(d_iov_t));
+
+	assert_rc_equal(dc_sec_request_delegated_creds(1000, &creds), DER_SUCCESS);
+
+	expect_delegated_cred_req(1000);
+	assert_ptr_equal(drpc_close_ctx, drpc_connect_return);
+	assert_non_null(creds.iov_buf);
+
+	daos_iov_free(&creds);
+}
+
+static void
+test_request_delegated_credentials_fails_if_not_permitted(void **state)
+{
+	d_iov_t			creds;
+	Auth__GetCredResp	resp = AUTH__GET_CRED_RESP__INIT;
+
+	resp.status = -DER_NO_PERM;
+	pack_get_cred_resp_in_drpc_call_resp_body(&resp);
+	memset(&creds, 0, sizeof(d_iov_t));
+
+	assert_rc_equal(dc_sec_request_delegated_creds(1000, &creds), -DER_NO_PERM);
+}
+
+static void
+test_request_credentials_uses_delegated_uid_env(void **state)
+{
+	d_iov_t creds;
+
+	memset(&creds, 0, sizeof(d_iov_t));
+	assert_rc_equal(d_setenv(DAOS_DELEGATED_UID_ENV, "1001", 1), 0);
+
+	assert_rc_equal(dc_sec_request_creds(&creds), DER_SUCCESS);
+
+	d_unsetenv(DAOS_DELEGATED_UID_ENV);
+	expect_delegated_cred_req(1001);
+
+	daos_iov_free(&creds);
+}
+
 static daos_prop_t *
 get_acl_prop(uint32_t owner_type, char *owner_user, uint32_t group_type, char *owner_group,
 	     uint32_t acl_type, struct daos_acl *acl)
@@ -755,6 +823,14 @@ main(void)
 			test_request_credentials_fails_if_reply_cred_status),
 		SECURITY_UTEST(
 			test_request_credentials_returns_raw_bytes),
+		SECURITY_UTEST(
+			test_request_delegated_credentials_fails_with_null_creds),
+		SECURITY_UTEST(
+			test_request_delegated_credentials_sends_uid),
+		SECURITY_UTEST(
+			test_request_delegated_credentials_fails_if_not_permitted),
+		SECURITY_UTEST(
+			test_request_credentials_uses_delegated_uid_env),
 		cmocka_unit_test(test_get_pool_perms_invalid_input),
 		cmocka_unit_test(test_get_cont_perms_invalid_input),
 		cmocka_unit_test(test_get_pool_perms_valid),
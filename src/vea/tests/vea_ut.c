@@ -1,5 +1,6 @@
 /**
  * (C) Copyright 2018-2024 Intel Corporation.
+ * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -1584,6 +1585,103 @@ ut_reclaim_unused_bitmap(void **state)
 	ut_teardown(&args);
 }
 
+static void
+ut_extend(void **state)
+{
+	/* Use a temporary device instead of the main one the other tests use */
+	struct vea_ut_args args;
+	struct vea_unmap_context unmap_ctxt = { 0 };
+	struct vea_resrvd_ext *ext;
+	struct vea_attr attr;
+	struct vea_stat stat;
+	d_list_t *r_list;
+	uint32_t hdr_blks = 1;
+	uint64_t capacity = 1UL << 30; /* 1GB, 256k 4k blocks in total */
+	uint64_t old_blks, new_blks;
+	uint32_t blk_cnt;
+	int rc;
+
+	ut_setup(&args);
+
+	rc = vea_format(&args.vua_umm, &args.vua_txd, args.vua_md, 0,
+			hdr_blks, capacity, NULL, NULL, false, VEA_COMPAT_MASK);
+	assert_rc_equal(rc, 0);
+
+	rc = vea_load(&args.vua_umm, &args.vua_txd, args.vua_md, &unmap_ctxt,
+		      NULL, &args.vua_vsi);
+	assert_rc_equal(rc, 0);
+
+	old_blks = capacity / VEA_BLK_SZ - hdr_blks;
+	new_blks = capacity * 2 / VEA_BLK_SZ - hdr_blks;
+	r_list = &args.vua_resrvd_list[0];
+
+	print_message("Allocate all the space\n");
+	rc = vea_reserve(args.vua_vsi, old_blks, NULL, r_list);
+	assert_rc_equal(rc, 0);
+
+	rc = umem_tx_begin(&args.vua_umm, &args.vua_txd);
+	assert_int_equal(rc, 0);
+	rc = vea_tx_publish(args.vua_vsi, NULL, r_list);
+	assert_int_equal(rc, 0);
+	rc = umem_tx_commit(&args.vua_umm);
+	assert_int_equal(rc, 0);
+
+	blk_cnt = 1 << 16;
+	rc = vea_reserve(args.vua_vsi, blk_cnt, NULL, r_list);
+	assert_rc_equal(rc, -DER_NOSPACE);
+
+	print_message("Shrink and too large capacity should fail\n");
+	rc = vea_extend(args.vua_vsi, capacity / 2);
+	assert_rc_equal(rc, -DER_INVAL);
+	rc = vea_extend(args.vua_vsi, ((uint64_t)UINT32_MAX + 2) * VEA_BLK_SZ);
+	assert_rc_equal(rc, -DER_INVAL);
+
+	print_message("Extend to the same capacity is no-op\n");
+	rc = vea_extend(args.vua_vsi, capacity);
+	assert_rc_equal(rc, 0);
+	rc = vea_query(args.vua_vsi, &attr, &stat);
+	assert_rc_equal(rc, 0);
+	assert_int_equal(attr.va_tot_blks, old_blks);
+	assert_int_equal(stat.vs_free_persistent, 0);
+
+	print_message("Extend to double capacity\n");
+	rc = vea_extend(args.vua_vsi, capacity * 2);
+	assert_rc_equal(rc, 0);
+	rc = vea_query(args.vua_vsi, &attr, &stat);
+	assert_rc_equal(rc, 0);
+	assert_int_equal(attr.va_tot_blks, new_blks);
+	assert_int_equal(stat.vs_free_persistent, new_blks - old_blks);
+	assert_int_equal(stat.vs_free_transient, new_blks - old_blks);
+
+	print_message("Allocate from the appended space\n");
+	rc = vea_reserve(args.vua_vsi, blk_cnt, NULL, r_list);
+	assert_rc_equal(rc, 0);
+	ext = d_list_entry(r_list->prev, struct vea_resrvd_ext, vre_link);
+	assert_true(ext->vre_blk_off >= hdr_blks + old_blks);
+	assert_true(ext->vre_blk_off + blk_cnt <= hdr_blks + new_blks);
+
+	rc = umem_tx_begin(&args.vua_umm, &args.vua_txd);
+	assert_int_equal(rc, 0);
+	rc = vea_tx_publish(args.vua_vsi, NULL, r_list);
+	assert_int_equal(rc, 0);
+	rc = umem_tx_commit(&args.vua_umm);
+	assert_int_equal(rc, 0);
+
+	print_message("Extended space persists across reload\n");
+	vea_unload(args.vua_vsi);
+	rc = vea_load(&args.vua_umm, &args.vua_txd, args.vua_md, &unmap_ctxt,
+		      NULL, &args.vua_vsi);
+	assert_rc_equal(rc, 0);
+	rc = vea_query(args.vua_vsi, &attr, &stat);
+	assert_rc_equal(rc, 0);
+	assert_int_equal(attr.va_tot_blks, new_blks);
+	assert_int_equal(stat.vs_free_persistent, new_blks - old_blks - blk_cnt);
+	assert_int_equal(stat.vs_free_transient, new_blks - old_blks - blk_cnt);
+
+	vea_unload(args.vua_vsi);
+	ut_teardown(&args);
+}
+
 static const struct CMUnitTest vea_uts[] = {
 	{ "vea_format", ut_format, NULL, NULL},
 	{ "vea_load", ut_load, NULL, NULL},
@@ -1600,6 +1698,7 @@ static const struct CMUnitTest vea_uts[] = {
 	{ "vea_tx_publish_bitmap", ut_tx_publish, NULL, NULL},
 	{ "vea_free_bitmap", ut_free_bitmap, NULL, NULL},
 	{ "vea_reserve_special", ut_reserve_special, NULL, NULL},
+	{ "vea_extend", ut_extend, NULL, NULL},
 	{ "vea_inval_params_format", ut_inval_params_format, NULL, NULL},
 	{ "vea_inval_params_load", ut_inval_params_load, NULL, NULL},
 	{ "vea_inval_param_reserve", ut_inval_params_reserve, NULL, NULL},
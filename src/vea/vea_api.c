@@ -794,6 +794,99 @@ error:
 	return rc;
 }
 
+static void
+extend_commit_cb(void *data, bool noop)
+{
+	struct free_commit_cb_arg *fca = data;
+	int rc;
+
+	/* Transaction aborted, only need to free callback arg */
+	if (noop)
+		goto free;
+
+	/*
+	 * The appended space was never allocated, so it bypasses the aging
+	 * buffer and becomes visible for allocation immediately. On failure
+	 * the space stays in the persistent free tree and will be loaded on
+	 * next server restart.
+	 */
+	rc = compound_free(fca->fca_vsi, &fca->fca_vfe, 0);
+
+	D_CDEBUG(rc, DLOG_ERR, DB_IO, "Extend free on vsi:%p rc %d\n",
+		 fca->fca_vsi, rc);
+free:
+	D_FREE(fca);
+}
+
+/*
+ * Grow the managed space to a larger block device capacity, the appended
+ * blocks are added to the free extent trees as one free extent.
+ */
+int
+vea_extend(struct vea_space_info *vsi, uint64_t capacity)
+{
+	D_ASSERT(vsi != NULL);
+	struct umem_instance *umem = vsi->vsi_umem;
+	struct vea_space_df *md = vsi->vsi_md;
+	struct free_commit_cb_arg *fca;
+	uint64_t tot_blks;
+	int rc;
+
+	tot_blks = capacity / md->vsd_blk_sz;
+	if (tot_blks <= md->vsd_hdr_blks)
+		return -DER_INVAL;
+	tot_blks -= md->vsd_hdr_blks;
+
+	if (tot_blks == md->vsd_tot_blks)
+		return 0;
+
+	if (tot_blks < md->vsd_tot_blks) {
+		D_ERROR("Can't shrink space from "DF_U64" to "DF_U64" blocks\n",
+			md->vsd_tot_blks, tot_blks);
+		return -DER_INVAL;
+	}
+
+	/* Same limitation as vea_format(), the largest extent can't overflow */
+	if (tot_blks > UINT32_MAX) {
+		D_ERROR("Capacity "DF_U64" is too large.\n", capacity);
+		return -DER_INVAL;
+	}
+
+	D_ALLOC_PTR(fca);
+	if (fca == NULL)
+		return -DER_NOMEM;
+
+	fca->fca_vsi = vsi;
+	fca->fca_vfe.vfe_ext.vfe_blk_off = md->vsd_hdr_blks + md->vsd_tot_blks;
+	fca->fca_vfe.vfe_ext.vfe_blk_cnt = tot_blks - md->vsd_tot_blks;
+
+	rc = umem_tx_begin(umem, vsi->vsi_txd);
+	if (rc != 0)
+		goto error;
+
+	rc = umem_tx_add_ptr(umem, &md->vsd_tot_blks, sizeof(md->vsd_tot_blks));
+	if (rc != 0)
+		goto done;
+	md->vsd_tot_blks = tot_blks;
+
+	/* Add the appended extent in persistent free extent tree */
+	rc = persistent_free(vsi, &fca->fca_vfe);
+	if (rc)
+		goto done;
+
+	rc = umem_tx_add_callback(umem, vsi->vsi_txd, UMEM_STAGE_ONCOMMIT,
+				  extend_commit_cb, fca);
+	if (rc == 0)
+		fca = NULL;	/* Will be freed by commit callback */
+done:
+	/* Commit/Abort transaction on success/error */
+	rc = rc ? umem_tx_abort(umem, rc) : umem_tx_commit(umem);
+error:
+	if (fca != NULL)
+		D_FREE(fca);
+	return rc;
+}
+
 /* Set an arbitrary age to a free extent with specified start offset. */
 int
 vea_set_ext_age(struct vea_space_info *vsi, uint64_t blk_off, uint64_t age)
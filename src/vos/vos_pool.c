@@ -1977,6 +1977,64 @@ end:
 	return 0;
 }
 
+int
+vos_pool_extend(daos_handle_t poh, daos_size_t nvme_sz)
+{
+	struct vos_pool    *pool;
+	struct vos_pool_df *pool_df;
+	int                 rc;
+
+	pool = vos_hdl2pool(poh);
+	if (pool == NULL)
+		return -DER_NO_HDL;
+
+	pool_df = pool->vp_pool_df;
+
+	if (pool->vp_vea_info == NULL) {
+		D_ERROR(DF_UUID ": Pool has no NVMe space to extend\n", DP_UUID(pool->vp_id));
+		return -DER_NOTSUPPORTED;
+	}
+
+	if (nvme_sz == pool_df->pd_nvme_sz)
+		return 0;
+
+	if (nvme_sz < pool_df->pd_nvme_sz) {
+		D_ERROR(DF_UUID ": Can't shrink NVMe space from " DF_U64 " to " DF_U64 "\n",
+			DP_UUID(pool->vp_id), pool_df->pd_nvme_sz, nvme_sz);
+		return -DER_INVAL;
+	}
+
+	D_INFO(DF_UUID ": Extending NVMe space from " DF_U64 " to " DF_U64 "\n",
+	       DP_UUID(pool->vp_id), pool_df->pd_nvme_sz, nvme_sz);
+
+	/* Grow the blob first, the allocator can't hand out space beyond the blob end */
+	rc = bio_blob_resize(vos_data_ioctxt(pool), nvme_sz);
+	if (rc) {
+		DL_ERROR(rc, DF_UUID ": Failed to resize data blob", DP_UUID(pool->vp_id));
+		return rc;
+	}
+
+	rc = umem_tx_begin(&pool->vp_umm, vos_txd_get(pool->vp_sysdb));
+	if (rc != 0)
+		return rc;
+
+	rc = vea_extend(pool->vp_vea_info, nvme_sz);
+	if (rc != 0)
+		goto end;
+
+	rc = umem_tx_add_ptr(&pool->vp_umm, &pool_df->pd_nvme_sz, sizeof(pool_df->pd_nvme_sz));
+	if (rc != 0)
+		goto end;
+
+	pool_df->pd_nvme_sz = nvme_sz;
+end:
+	rc = umem_tx_end(&pool->vp_umm, rc);
+	if (rc)
+		DL_ERROR(rc, DF_UUID ": Failed to extend NVMe space", DP_UUID(pool->vp_id));
+
+	return rc;
+}
+
 /**
  * Close a VOSP, all opened containers sharing this pool handle
  * will be revoked.
@@ -1,6 +1,7 @@
 #!/bin/bash
 # /*
 #  * (C) Copyright 2016-2022 Intel Corporation.
+#  * (C) Copyright 2025 Hewlett Packard Enterprise Development LP
 #  *
 #  * SPDX-License-Identifier: BSD-2-Clause-Patent
 # */
@@ -9,6 +10,10 @@ __usage="
 Usage: gen_certificates.sh [DIR]
 Generate certificates for DAOS deployment in the [DIR]/daosCA.
 By default [DIR] is the current directory.
+
+If daos_server is installed, certificate generation is delegated to
+'daos_server security gen-certs', which also supports per-host server
+certificates and installing the certificates on each host.
 "
 
 function print_usage () {
@@ -201,6 +206,10 @@ function cleanup () {
 }
 
 function main () {
+    if command -v daos_server >/dev/null 2>&1
+    then
+      exec daos_server security gen-certs --dir "${1:-.}"
+    fi
     if [[ -d "$CA_HOME" ]]
     then
       echo "$CA_HOME already exists, exiting."
@@ -216,4 +225,4 @@ function main () {
     cleanup
 }
 
-main
+main "$@"
@@ -248,6 +248,8 @@ class FileTypeList():
 
         if 'src/control/vendor' in file:
             return
+        if 'src/control/python/daos_control/proto/' in file and '_pb2' in file:
+            return
         if 'src/vos/storage_estimator' in file:
             return
 
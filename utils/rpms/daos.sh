@@ -217,6 +217,11 @@ TARGET_PATH="${sysconfdir}/daos"
 list_files files "${SL_PREFIX}/etc/daos_control.yml"
 append_install_list "${files[@]}"
 
+daos_control="$(find "${SL_PREFIX}/lib64" -name daos_control | sed "s#${SL_PREFIX}/lib64##")"
+TARGET_PATH="${libdir}${daos_control}"
+list_files files "${SL_PREFIX}/lib64${daos_control}/*"
+append_install_list "${files[@]}"
+
 DEPENDS=( "daos = ${VERSION}-${RELEASE}" )
 build_package "daos-admin"
 